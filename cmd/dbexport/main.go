@@ -0,0 +1,179 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/common"
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/core"
+	"github.com/yurulab/gocryptotrader/database"
+	dbmysql "github.com/yurulab/gocryptotrader/database/drivers/mysql"
+	dbPSQL "github.com/yurulab/gocryptotrader/database/drivers/postgres"
+	dbsqlite3 "github.com/yurulab/gocryptotrader/database/drivers/sqlite3"
+	"github.com/yurulab/gocryptotrader/database/repository/export"
+)
+
+var (
+	configFile     string
+	defaultDataDir string
+	command        string
+	table          string
+	format         string
+	output         string
+	startTime      string
+	endTime        string
+	maxAge         time.Duration
+	archivePath    string
+)
+
+func openDBConnection(driver string) (err error) {
+	if driver == database.DBPostgreSQL {
+		_, err = dbPSQL.Connect()
+		if err != nil {
+			return fmt.Errorf("database failed to connect: %v", err)
+		}
+		return nil
+	} else if driver == database.DBSQLite || driver == database.DBSQLite3 {
+		_, err = dbsqlite3.Connect()
+		if err != nil {
+			return fmt.Errorf("database failed to connect: %v", err)
+		}
+		return nil
+	} else if driver == database.DBMySQL {
+		_, err = dbmysql.Connect()
+		if err != nil {
+			return fmt.Errorf("database failed to connect: %v", err)
+		}
+		return nil
+	}
+	return errors.New("no connection established")
+}
+
+func main() {
+	// Exported rows may be written to stdout so all other output goes to
+	// stderr
+	fmt.Fprintln(os.Stderr, "GoCryptoTrader database export tool")
+	fmt.Fprintln(os.Stderr, core.Copyright)
+	fmt.Fprintln(os.Stderr)
+
+	flag.StringVar(&command, "command", "", "command to run tables|export|archive|delete")
+	flag.StringVar(&configFile, "config", config.DefaultFilePath(), "config file to load")
+	flag.StringVar(&defaultDataDir, "datadir", common.GetDefaultDataDir(runtime.GOOS), "default data directory for GoCryptoTrader files")
+	flag.StringVar(&table, "table", "", "table to export, archive or delete rows from")
+	flag.StringVar(&format, "format", export.FormatCSV, "export format "+strings.Join(export.Formats, "|"))
+	flag.StringVar(&output, "output", "", "file to export to, defaults to stdout")
+	flag.StringVar(&startTime, "start", time.Now().AddDate(0, -1, 0).Format(common.SimpleTimeFormat), "export rows from this local time")
+	flag.StringVar(&endTime, "end", time.Now().Format(common.SimpleTimeFormat), "export rows up to this local time")
+	flag.DurationVar(&maxAge, "maxage", 0, "archive or delete rows older than this duration, e.g. 720h")
+	flag.StringVar(&archivePath, "archivepath", "", "directory to write archives to, defaults to the configured retention archive path")
+
+	flag.Parse()
+
+	if command == "tables" {
+		for i := range export.Tables {
+			fmt.Printf("%s (%s)\n", export.Tables[i].Name, export.Tables[i].TimeColumn)
+		}
+		return
+	}
+
+	var conf config.Config
+	err := conf.LoadConfig(configFile, true)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if !conf.Database.Enabled {
+		fmt.Fprintln(os.Stderr, "Database support is disabled")
+		os.Exit(1)
+	}
+
+	err = openDBConnection(conf.Database.Driver)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	switch command {
+	case "export":
+		err = exportTable()
+	case "archive":
+		if archivePath == "" {
+			archivePath = conf.Database.Retention.ArchivePath
+		}
+		if archivePath == "" {
+			archivePath = filepath.Join(defaultDataDir, "database", database.DefaultArchiveDir)
+		}
+		err = archiveTable()
+	case "delete":
+		err = deleteTable()
+	default:
+		flag.Usage()
+		return
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func exportTable() error {
+	start, err := time.ParseInLocation(common.SimpleTimeFormat, startTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid start time: %v", err)
+	}
+	end, err := time.ParseInLocation(common.SimpleTimeFormat, endTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid end time: %v", err)
+	}
+	if !end.After(start) {
+		return errors.New("end time must be after start time")
+	}
+
+	var count int64
+	if output != "" {
+		count, err = export.ToFile(table, start, end, format, output)
+	} else {
+		count, err = export.Export(table, start, end, format, os.Stdout)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Exported %d %s rows.\n", count, table)
+	return nil
+}
+
+func archiveTable() error {
+	if maxAge <= 0 {
+		return errors.New("maxage must be set")
+	}
+	count, path, err := export.Archive(table, time.Now().Add(-maxAge), archivePath)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		fmt.Fprintf(os.Stderr, "No %s rows older than %v to archive.\n", table, maxAge)
+		return nil
+	}
+	fmt.Fprintf(os.Stderr, "Archived %d %s rows to %s.\n", count, table, path)
+	return nil
+}
+
+func deleteTable() error {
+	if maxAge <= 0 {
+		return errors.New("maxage must be set")
+	}
+	count, err := export.Delete(table, time.Now().Add(-maxAge))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Deleted %d %s rows older than %v.\n", count, table, maxAge)
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/core"
 	"github.com/yurulab/gocryptotrader/database"
+	dbmysql "github.com/yurulab/gocryptotrader/database/drivers/mysql"
 	dbPSQL "github.com/yurulab/gocryptotrader/database/drivers/postgres"
 	dbsqlite3 "github.com/yurulab/gocryptotrader/database/drivers/sqlite3"
 	"github.com/yurulab/gocryptotrader/database/repository"
@@ -39,6 +40,12 @@ func openDBConnection(driver string) (err error) {
 			return fmt.Errorf("database failed to connect: %v, some features that utilise a database will be unavailable", err)
 		}
 		return nil
+	} else if driver == database.DBMySQL {
+		dbConn, err = dbmysql.Connect()
+		if err != nil {
+			return fmt.Errorf("database failed to connect: %v, some features that utilise a database will be unavailable", err)
+		}
+		return nil
 	}
 	return errors.New("no connection established")
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -327,7 +328,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 
 		if !authenticatedOnly {
 			var r1 *ticker.Price
-			r1, err = e.FetchTicker(p, assetTypes[i])
+			r1, err = e.FetchTicker(context.Background(), p, assetTypes[i])
 			msg = ""
 			if err != nil {
 				msg = err.Error()
@@ -341,7 +342,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			})
 
 			var r2 *ticker.Price
-			r2, err = e.UpdateTicker(p, assetTypes[i])
+			r2, err = e.UpdateTicker(context.Background(), p, assetTypes[i])
 			msg = ""
 			if err != nil {
 				msg = err.Error()
@@ -355,7 +356,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			})
 
 			var r3 *orderbook.Base
-			r3, err = e.FetchOrderbook(p, assetTypes[i])
+			r3, err = e.FetchOrderbook(context.Background(), p, assetTypes[i])
 			msg = ""
 			if err != nil {
 				msg = err.Error()
@@ -369,7 +370,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			})
 
 			var r4 *orderbook.Base
-			r4, err = e.UpdateOrderbook(p, assetTypes[i])
+			r4, err = e.UpdateOrderbook(context.Background(), p, assetTypes[i])
 			msg = ""
 			if err != nil {
 				msg = err.Error()
@@ -383,7 +384,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			})
 
 			var r5 []string
-			r5, err = e.FetchTradablePairs(assetTypes[i])
+			r5, err = e.FetchTradablePairs(context.Background(), assetTypes[i])
 			msg = ""
 			if err != nil {
 				msg = err.Error()
@@ -396,7 +397,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 				Response:   jsonifyInterface([]interface{}{r5}),
 			})
 			// r6
-			err = e.UpdateTradablePairs(false)
+			err = e.UpdateTradablePairs(context.Background(), false)
 			msg = ""
 			if err != nil {
 				msg = err.Error()
@@ -411,7 +412,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 		}
 
 		var r7 account.Holdings
-		r7, err = e.FetchAccountInfo()
+		r7, err = e.FetchAccountInfo(context.Background())
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -424,7 +425,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 		})
 
 		var r8 []exchange.TradeHistory
-		r8, err = e.GetExchangeHistory(p, assetTypes[i], time.Now().Add(-time.Minute), time.Now())
+		r8, err = e.GetExchangeHistory(context.Background(), p, assetTypes[i], time.Now().Add(-time.Minute), time.Now())
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -438,7 +439,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 		})
 
 		var r9 []exchange.FundHistory
-		r9, err = e.GetFundingHistory()
+		r9, err = e.GetFundingHistory(context.Background())
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -457,7 +458,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Amount:        config.OrderSubmission.Amount,
 		}
 		var r10 float64
-		r10, err = e.GetFeeByType(&feeType)
+		r10, err = e.GetFeeByType(context.Background(), &feeType)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -479,7 +480,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			ClientID: config.OrderSubmission.OrderID,
 		}
 		var r11 order.SubmitResponse
-		r11, err = e.SubmitOrder(s)
+		r11, err = e.SubmitOrder(context.Background(), s)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -501,7 +502,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Amount: config.OrderSubmission.Amount,
 		}
 		var r12 string
-		r12, err = e.ModifyOrder(&modifyRequest)
+		r12, err = e.ModifyOrder(context.Background(), &modifyRequest)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -520,7 +521,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			ID:        config.OrderSubmission.OrderID,
 			AssetType: assetTypes[i],
 		}
-		err = e.CancelOrder(&cancelRequest)
+		err = e.CancelOrder(context.Background(), &cancelRequest)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -534,7 +535,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 		})
 
 		var r14 order.CancelAllResponse
-		r14, err = e.CancelAllOrders(&cancelRequest)
+		r14, err = e.CancelAllOrders(context.Background(), &cancelRequest)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -548,7 +549,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 		})
 
 		var r15 order.Detail
-		r15, err = e.GetOrderInfo(config.OrderSubmission.OrderID)
+		r15, err = e.GetOrderInfo(context.Background(), config.OrderSubmission.OrderID)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -567,7 +568,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Pairs: []currency.Pair{p},
 		}
 		var r16 []order.Detail
-		r16, err = e.GetOrderHistory(&historyRequest)
+		r16, err = e.GetOrderHistory(context.Background(), &historyRequest)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -586,7 +587,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Pairs: []currency.Pair{p},
 		}
 		var r17 []order.Detail
-		r17, err = e.GetActiveOrders(&orderRequest)
+		r17, err = e.GetActiveOrders(context.Background(), &orderRequest)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -600,7 +601,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 		})
 
 		var r18 string
-		r18, err = e.GetDepositAddress(p.Base, "")
+		r18, err = e.GetDepositAddress(context.Background(), p.Base, "")
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -620,7 +621,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Amount:        config.OrderSubmission.Amount,
 		}
 		var r19 float64
-		r19, err = e.GetFeeByType(&feeType)
+		r19, err = e.GetFeeByType(context.Background(), &feeType)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -641,7 +642,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Amount: config.OrderSubmission.Amount,
 		}
 		var r20 *withdraw.ExchangeResponse
-		r20, err = e.WithdrawCryptocurrencyFunds(&withdrawRequest)
+		r20, err = e.WithdrawCryptocurrencyFunds(context.Background(), &withdrawRequest)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -663,7 +664,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			BankTransactionType: exchange.WireTransfer,
 		}
 		var r21 float64
-		r21, err = e.GetFeeByType(&feeType)
+		r21, err = e.GetFeeByType(context.Background(), &feeType)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -707,7 +708,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			},
 		}
 		var r22 *withdraw.ExchangeResponse
-		r22, err = e.WithdrawFiatFunds(&withdrawRequestFiat)
+		r22, err = e.WithdrawFiatFunds(context.Background(), &withdrawRequestFiat)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -721,7 +722,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 		})
 
 		var r23 *withdraw.ExchangeResponse
-		r23, err = e.WithdrawFiatFundsToInternationalBank(&withdrawRequestFiat)
+		r23, err = e.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawRequestFiat)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -737,7 +738,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 		if !authenticatedOnly {
 			var r24 kline.Item
 			startTime, endTime := time.Now().AddDate(0, -1, 0), time.Now()
-			r24, err = e.GetHistoricCandles(p, assetTypes[i], startTime, endTime, kline.OneDay)
+			r24, err = e.GetHistoricCandles(context.Background(), p, assetTypes[i], startTime, endTime, kline.OneDay)
 			msg = ""
 			if err != nil {
 				msg = err.Error()
@@ -751,7 +752,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			})
 
 			var r25 kline.Item
-			r25, err = e.GetHistoricCandlesExtended(p, assetTypes[i], startTime, endTime, kline.OneDay)
+			r25, err = e.GetHistoricCandlesExtended(context.Background(), p, assetTypes[i], startTime, endTime, kline.OneDay)
 			msg = ""
 			if err != nil {
 				msg = err.Error()
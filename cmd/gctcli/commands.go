@@ -1879,7 +1879,7 @@ func getEvents(_ *cli.Context) error {
 var addEventCommand = cli.Command{
 	Name:      "addevent",
 	Usage:     "adds an event",
-	ArgsUsage: "<exchange> <item> <condition> <price> <check_bids> <check_bids_and_asks> <orderbook_amount> <pair> <asset> <action>",
+	ArgsUsage: "<exchange> <item> <condition> <price> <check_bids> <check_bids_and_asks> <orderbook_amount> <candle_interval> <pair> <asset> <action>",
 	Action:    addEvent,
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -1910,6 +1910,10 @@ var addEventCommand = cli.Command{
 			Name:  "orderbook_amount",
 			Usage: "the orderbook amount to trigger the event",
 		},
+		cli.Int64Flag{
+			Name:  "candle_interval",
+			Usage: "the candle interval in seconds to check the close price of",
+		},
 		cli.StringFlag{
 			Name:  "pair",
 			Usage: "the currency pair",
@@ -1938,6 +1942,7 @@ func addEvent(c *cli.Context) error {
 	var checkBids bool
 	var checkBidsAndAsks bool
 	var orderbookAmount float64
+	var candleInterval int64
 	var currencyPair string
 	var assetType string
 	var action string
@@ -1980,6 +1985,10 @@ func addEvent(c *cli.Context) error {
 		orderbookAmount = c.Float64("orderbook_amount")
 	}
 
+	if c.IsSet("candle_interval") {
+		candleInterval = c.Int64("candle_interval")
+	}
+
 	if c.IsSet("pair") {
 		currencyPair = c.String("pair")
 	} else {
@@ -2026,6 +2035,7 @@ func addEvent(c *cli.Context) error {
 			CheckBids:        checkBids,
 			CheckBidsAndAsks: checkBidsAndAsks,
 			OrderbookAmount:  orderbookAmount,
+			CandleInterval:   int64(time.Duration(candleInterval) * time.Second),
 		},
 		Pair: &gctrpc.CurrencyPair{
 			Delimiter: p.Delimiter,
@@ -3203,99 +3213,1859 @@ func getExchangeTickerStream(c *cli.Context) error {
 	}
 }
 
-var getAuditEventCommand = cli.Command{
-	Name:      "getauditevent",
-	Usage:     "gets audit events matching query parameters",
-	ArgsUsage: "<starttime> <endtime> <orderby> <limit>",
-	Action:    getAuditEvent,
+var candleStreamInterval int64
+var getCandleStreamCommand = cli.Command{
+	Name:      "getcandlestream",
+	Usage:     "gets the live candle stream for a specific currency pair, asset, interval and exchange",
+	ArgsUsage: "<exchange> <pair> <asset> <interval>",
+	Action:    getCandleStream,
 	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name:        "start, s",
-			Usage:       "start date to search",
-			Value:       time.Now().Add(-time.Hour).Format(common.SimpleTimeFormat),
-			Destination: &startTime,
+			Name:  "exchange",
+			Usage: "the exchange to get the candles from",
 		},
 		cli.StringFlag{
-			Name:        "end, e",
-			Usage:       "end time to search",
-			Value:       time.Now().Format(common.SimpleTimeFormat),
-			Destination: &endTime,
+			Name:  "pair",
+			Usage: "currency pair",
 		},
 		cli.StringFlag{
-			Name:        "order, o",
-			Usage:       "order results by ascending/descending",
-			Value:       "asc",
-			Destination: &order,
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
 		},
-		cli.IntFlag{
-			Name:        "limit, l",
-			Usage:       "how many results to retrieve",
-			Value:       100,
-			Destination: &limit,
+		cli.Int64Flag{
+			Name:        "interval, i",
+			Usage:       fmt.Sprintf(klineMessage, "interval"),
+			Value:       60,
+			Destination: &candleStreamInterval,
 		},
 	},
 }
 
-func getAuditEvent(c *cli.Context) error {
-	if !c.IsSet("start") {
-		if c.Args().Get(0) != "" {
-			startTime = c.Args().Get(0)
-		}
+func getCandleStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "getcandlestream")
+		return nil
 	}
 
-	if !c.IsSet("end") {
-		if c.Args().Get(1) != "" {
-			endTime = c.Args().Get(1)
-		}
+	var exchangeName string
+	var pair string
+	var assetType string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
 	}
 
-	if !c.IsSet("order") {
-		if c.Args().Get(2) != "" {
-			order = c.Args().Get(2)
-		}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
 	}
 
-	if !c.IsSet("limit") {
-		if c.Args().Get(3) != "" {
-			limitStr, err := strconv.ParseInt(c.Args().Get(3), 10, 64)
-			if err == nil {
-				limit = int(limitStr)
-			}
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var err error
+	if c.IsSet("interval") {
+		candleStreamInterval = c.Int64("interval")
+	} else if c.Args().Get(3) != "" {
+		candleStreamInterval, err = strconv.ParseInt(c.Args().Get(3), 10, 64)
+		if err != nil {
+			return err
 		}
 	}
 
-	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	conn, err := setupClient()
 	if err != nil {
-		return fmt.Errorf("invalid time format for start: %v", err)
+		return err
 	}
+	defer conn.Close()
 
-	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
 	if err != nil {
-		return fmt.Errorf("invalid time format for end: %v", err)
+		return err
 	}
 
-	if e.Before(s) {
-		return errors.New("start cannot be after before")
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetCandleStream(context.Background(),
+		&gctrpc.GetCandleStreamRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+				Delimiter: p.Delimiter,
+			},
+			AssetType:    assetType,
+			TimeInterval: int64(time.Duration(candleStreamInterval) * time.Second),
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		err = clearScreen()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Candle stream for %s %s %s:\n",
+			exchangeName,
+			resp.Pair.String(),
+			resp.Interval)
+		fmt.Println()
+
+		fmt.Printf("TIME: %d\n OPEN: %f\n HIGH: %f\n LOW: %f\n CLOSE: %f\n VOLUME: %f\n CLOSED: %v\n",
+			resp.Candle.Time,
+			resp.Candle.Open,
+			resp.Candle.High,
+			resp.Candle.Low,
+			resp.Candle.Close,
+			resp.Candle.Volume,
+			resp.Closed)
+	}
+}
+
+var getExchangeCandleStreamCommand = cli.Command{
+	Name:      "getexchangecandlestream",
+	Usage:     "gets a stream for all live candles associated with an exchange",
+	ArgsUsage: "<exchange>",
+	Action:    getExchangeCandleStream,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the candles from",
+		},
+	},
+}
+
+func getExchangeCandleStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "getexchangecandlestream")
+		return nil
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
 	}
 
 	conn, err := setupClient()
 	if err != nil {
 		return err
 	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetExchangeCandleStream(context.Background(),
+		&gctrpc.GetExchangeCandleStreamRequest{
+			Exchange: exchangeName,
+		})
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Candle stream for %s %s %s %s:\n",
+			exchangeName,
+			resp.Pair.String(),
+			resp.AssetType,
+			resp.Interval)
+
+		fmt.Printf("TIME: %d OPEN: %f HIGH: %f LOW: %f CLOSE: %f VOLUME: %f CLOSED: %v\n",
+			resp.Candle.Time,
+			resp.Candle.Open,
+			resp.Candle.High,
+			resp.Candle.Low,
+			resp.Candle.Close,
+			resp.Candle.Volume,
+			resp.Closed)
+	}
+}
+
+var getRecentTradesCommand = cli.Command{
+	Name:      "getrecenttrades",
+	Usage:     "gets the recent trades for a specific currency pair and exchange",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getRecentTrades,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the trades for",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to get the trades for",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair to get the trades for",
+		},
+	},
+}
+
+func getRecentTrades(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "getrecenttrades")
+		return nil
+	}
+
+	var exchangeName string
+	var currencyPair string
+	var assetType string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
 
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
 	defer conn.Close()
 
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
 	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetRecentTrades(context.Background(),
+		&gctrpc.GetRecentTradesRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+		},
+	)
+	if err != nil {
+		return err
+	}
 
-	_, offset := time.Now().Zone()
-	loc := time.FixedZone("", -offset)
+	jsonOutput(result)
+	return nil
+}
+
+var getTradeStreamCommand = cli.Command{
+	Name:      "gettradestream",
+	Usage:     "gets the trade stream for a specific currency pair and exchange",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getTradeStream,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the trades from",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "currency pair",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+	},
+}
+
+func getTradeStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "gettradestream")
+		return nil
+	}
+
+	var exchangeName string
+	var pair string
+	var assetType string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetTradeStream(context.Background(),
+		&gctrpc.GetTradeStreamRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+				Delimiter: p.Delimiter,
+			},
+			AssetType: assetType,
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Trade stream for %s %s:\n", exchangeName, p)
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("ID: %s SIDE: %s PRICE: %f AMOUNT: %f TIMESTAMP: %d\n",
+			resp.Id,
+			resp.Side,
+			resp.Price,
+			resp.Amount,
+			resp.Timestamp)
+	}
+}
+
+var getHistoricTradesCommand = cli.Command{
+	Name:      "gethistorictrades",
+	Usage:     "gets the public trades for a specific currency pair and exchange within a date range",
+	ArgsUsage: "<exchange> <pair> <asset> <start> <end>",
+	Action:    getHistoricTrades,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the trades for",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to get the trades for",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair to get the trades for",
+		},
+		cli.StringFlag{
+			Name:        "start",
+			Usage:       "<start>",
+			Value:       time.Now().Add(-time.Hour).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end",
+			Usage:       "<end>",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+	},
+}
+
+func getHistoricTrades(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "gethistorictrades")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(3) != "" {
+			startTime = c.Args().Get(3)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(4) != "" {
+			endTime = c.Args().Get(4)
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetHistoricTrades(context.Background(),
+		&gctrpc.GetHistoricTradesRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+			Start:     s.Unix(),
+			End:       e.Unix(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getDerivativeInfoCommand = cli.Command{
+	Name:      "getderivativeinfo",
+	Usage:     "gets the funding, open interest and pricing data for a derivative contract",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getDerivativeInfo,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the derivative info from",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair of the derivative contract",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the derivative asset type e.g. perpetualswap or futures",
+		},
+	},
+}
+
+func getDerivativeInfo(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getderivativeinfo")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDerivativeInfo(context.Background(),
+		&gctrpc.GetDerivativeInfoRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getDerivativeInfoStreamCommand = cli.Command{
+	Name:      "getderivativeinfostream",
+	Usage:     "gets a stream of funding, open interest and pricing data for a derivative contract",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getDerivativeInfoStream,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the derivative info from",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair of the derivative contract",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the derivative asset type e.g. perpetualswap or futures",
+		},
+	},
+}
+
+func getDerivativeInfoStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getderivativeinfostream")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDerivativeInfoStream(context.Background(),
+		&gctrpc.GetDerivativeInfoStreamRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Derivative info stream for %s %s:\n", exchangeName, p)
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		printDerivativeInfo(resp)
+	}
+}
+
+var getExchangeDerivativeInfoStreamCommand = cli.Command{
+	Name:      "getexchangederivativeinfostream",
+	Usage:     "gets a stream for all derivative info associated with an exchange",
+	ArgsUsage: "<exchange>",
+	Action:    getExchangeDerivativeInfoStream,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the derivative info from",
+		},
+	},
+}
+
+func getExchangeDerivativeInfoStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getexchangederivativeinfostream")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetExchangeDerivativeInfoStream(context.Background(),
+		&gctrpc.GetExchangeDerivativeInfoStreamRequest{
+			Exchange: exchangeName,
+		})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Derivative info stream for %s %s %s:\n",
+			exchangeName,
+			resp.Pair.String(),
+			resp.AssetType)
+		printDerivativeInfo(resp)
+	}
+}
+
+func printDerivativeInfo(resp *gctrpc.DerivativeInfoResponse) {
+	fmt.Printf("MARK: %f INDEX: %f FUNDING: %f PREDICTED: %f NEXTFUNDING: %d OPENINTEREST: %f LASTUPDATED: %d\n",
+		resp.MarkPrice,
+		resp.IndexPrice,
+		resp.FundingRate,
+		resp.PredictedFundingRate,
+		resp.NextFundingTime,
+		resp.OpenInterest,
+		resp.LastUpdated)
+}
+
+var getLiquidationsCommand = cli.Command{
+	Name:      "getliquidations",
+	Usage:     "gets the forced liquidations for a derivative contract within a date range",
+	ArgsUsage: "<exchange> <pair> <asset> <start> <end>",
+	Action:    getLiquidations,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the liquidations from",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair of the derivative contract",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the derivative asset type e.g. perpetualswap or futures",
+		},
+		cli.StringFlag{
+			Name:        "start",
+			Usage:       "<start>",
+			Value:       time.Now().Add(-time.Hour).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end",
+			Usage:       "<end>",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+	},
+}
+
+func getLiquidations(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getliquidations")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(3) != "" {
+			startTime = c.Args().Get(3)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(4) != "" {
+			endTime = c.Args().Get(4)
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetLiquidations(context.Background(),
+		&gctrpc.GetLiquidationsRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+			Start:     s.Unix(),
+			End:       e.Unix(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getAuditEventCommand = cli.Command{
+	Name:      "getauditevent",
+	Usage:     "gets audit events matching query parameters",
+	ArgsUsage: "<starttime> <endtime> <orderby> <limit>",
+	Action:    getAuditEvent,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:        "start, s",
+			Usage:       "start date to search",
+			Value:       time.Now().Add(-time.Hour).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end, e",
+			Usage:       "end time to search",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+		cli.StringFlag{
+			Name:        "order, o",
+			Usage:       "order results by ascending/descending",
+			Value:       "asc",
+			Destination: &order,
+		},
+		cli.IntFlag{
+			Name:        "limit, l",
+			Usage:       "how many results to retrieve",
+			Value:       100,
+			Destination: &limit,
+		},
+	},
+}
+
+func getAuditEvent(c *cli.Context) error {
+	if !c.IsSet("start") {
+		if c.Args().Get(0) != "" {
+			startTime = c.Args().Get(0)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(1) != "" {
+			endTime = c.Args().Get(1)
+		}
+	}
+
+	if !c.IsSet("order") {
+		if c.Args().Get(2) != "" {
+			order = c.Args().Get(2)
+		}
+	}
+
+	if !c.IsSet("limit") {
+		if c.Args().Get(3) != "" {
+			limitStr, err := strconv.ParseInt(c.Args().Get(3), 10, 64)
+			if err == nil {
+				limit = int(limitStr)
+			}
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after before")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	_, offset := time.Now().Zone()
+	loc := time.FixedZone("", -offset)
 
 	result, err := client.GetAuditEvent(context.Background(),
 		&gctrpc.GetAuditEventRequest{
 			StartDate: s.In(loc).Format(common.SimpleTimeFormat),
 			EndDate:   e.In(loc).Format(common.SimpleTimeFormat),
 			Limit:     int32(limit),
-			OrderBy:   order,
+			OrderBy:   order,
+			Offset:    int32(offset),
+		})
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var verifyAuditEventsCommand = cli.Command{
+	Name:   "verifyauditevents",
+	Usage:  "verifies the audit event hash chain and audit checkpoints",
+	Action: verifyAuditEvents,
+}
+
+func verifyAuditEvents(_ *cli.Context) error {
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.VerifyAuditEvents(context.Background(),
+		&gctrpc.VerifyAuditEventsRequest{},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getRequestJournalCommand = cli.Command{
+	Name:      "getrequestjournal",
+	Usage:     "gets authenticated exchange requests matching query parameters",
+	ArgsUsage: "<exchange> <starttime> <endtime> <orderby> <limit>",
+	Action:    getRequestJournal,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to filter by, all exchanges if unset",
+		},
+		cli.StringFlag{
+			Name:        "start, s",
+			Usage:       "start date to search",
+			Value:       time.Now().Add(-time.Hour).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end, e",
+			Usage:       "end time to search",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+		cli.StringFlag{
+			Name:        "order, o",
+			Usage:       "order results by ascending/descending",
+			Value:       "asc",
+			Destination: &order,
+		},
+		cli.IntFlag{
+			Name:        "limit, l",
+			Usage:       "how many results to retrieve",
+			Value:       100,
+			Destination: &limit,
+		},
+	},
+}
+
+func getRequestJournal(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(1) != "" {
+			startTime = c.Args().Get(1)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(2) != "" {
+			endTime = c.Args().Get(2)
+		}
+	}
+
+	if !c.IsSet("order") {
+		if c.Args().Get(3) != "" {
+			order = c.Args().Get(3)
+		}
+	}
+
+	if !c.IsSet("limit") {
+		if c.Args().Get(4) != "" {
+			limitStr, err := strconv.ParseInt(c.Args().Get(4), 10, 64)
+			if err == nil {
+				limit = int(limitStr)
+			}
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	_, offset := time.Now().Zone()
+	loc := time.FixedZone("", -offset)
+
+	result, err := client.GetRequestJournal(context.Background(),
+		&gctrpc.GetRequestJournalRequest{
+			Exchange:  exchangeName,
+			StartDate: s.In(loc).Format(common.SimpleTimeFormat),
+			EndDate:   e.In(loc).Format(common.SimpleTimeFormat),
+			Limit:     int32(limit),
+			OrderBy:   order,
+			Offset:    int32(offset),
+		})
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getPortfolioHistoryCommand = cli.Command{
+	Name:      "getportfoliohistory",
+	Usage:     "gets recorded exchange account balance snapshots",
+	ArgsUsage: "<exchange> <currency> <starttime> <endtime> <limit>",
+	Action:    getPortfolioHistory,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to filter by, all exchanges if unset",
+		},
+		cli.StringFlag{
+			Name:  "currency",
+			Usage: "the currency to filter by, all currencies if unset",
+		},
+		cli.StringFlag{
+			Name:        "start, s",
+			Usage:       "start date to search",
+			Value:       time.Now().AddDate(0, 0, -1).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end, e",
+			Usage:       "end time to search",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+		cli.IntFlag{
+			Name:        "limit, l",
+			Usage:       "how many results to retrieve",
+			Value:       100,
+			Destination: &limit,
+		},
+	},
+}
+
+func getPortfolioHistory(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var currencyCode string
+	if c.IsSet("currency") {
+		currencyCode = c.String("currency")
+	} else {
+		currencyCode = c.Args().Get(1)
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(2) != "" {
+			startTime = c.Args().Get(2)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(3) != "" {
+			endTime = c.Args().Get(3)
+		}
+	}
+
+	if !c.IsSet("limit") {
+		if c.Args().Get(4) != "" {
+			limitStr, err := strconv.ParseInt(c.Args().Get(4), 10, 64)
+			if err == nil {
+				limit = int(limitStr)
+			}
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	_, offset := time.Now().Zone()
+	loc := time.FixedZone("", -offset)
+
+	result, err := client.GetPortfolioHistory(context.Background(),
+		&gctrpc.GetPortfolioHistoryRequest{
+			Exchange:  exchangeName,
+			Currency:  currencyCode,
+			StartDate: s.In(loc).Format(common.SimpleTimeFormat),
+			EndDate:   e.In(loc).Format(common.SimpleTimeFormat),
+			Limit:     int32(limit),
+			Offset:    int32(offset),
+		})
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getEquityCurveCommand = cli.Command{
+	Name:      "getequitycurve",
+	Usage:     "gets the total fiat value of recorded balance snapshots over time",
+	ArgsUsage: "<exchange> <starttime> <endtime>",
+	Action:    getEquityCurve,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to filter by, all exchanges if unset",
+		},
+		cli.StringFlag{
+			Name:        "start, s",
+			Usage:       "start date to search",
+			Value:       time.Now().AddDate(0, 0, -7).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end, e",
+			Usage:       "end time to search",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+	},
+}
+
+func getEquityCurve(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(1) != "" {
+			startTime = c.Args().Get(1)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(2) != "" {
+			endTime = c.Args().Get(2)
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	_, offset := time.Now().Zone()
+	loc := time.FixedZone("", -offset)
+
+	result, err := client.GetEquityCurve(context.Background(),
+		&gctrpc.GetEquityCurveRequest{
+			Exchange:  exchangeName,
+			StartDate: s.In(loc).Format(common.SimpleTimeFormat),
+			EndDate:   e.In(loc).Format(common.SimpleTimeFormat),
+			Offset:    int32(offset),
+		})
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getFillsCommand = cli.Command{
+	Name:      "getfills",
+	Usage:     "gets imported exchange fills",
+	ArgsUsage: "<exchange> <starttime> <endtime> <limit>",
+	Action:    getFills,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to filter by, all exchanges if unset",
+		},
+		cli.StringFlag{
+			Name:        "start, s",
+			Usage:       "start date to search",
+			Value:       time.Now().AddDate(0, 0, -7).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end, e",
+			Usage:       "end time to search",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+		cli.IntFlag{
+			Name:        "limit, l",
+			Usage:       "how many results to retrieve",
+			Value:       100,
+			Destination: &limit,
+		},
+	},
+}
+
+func getFills(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(1) != "" {
+			startTime = c.Args().Get(1)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(2) != "" {
+			endTime = c.Args().Get(2)
+		}
+	}
+
+	if !c.IsSet("limit") {
+		if c.Args().Get(3) != "" {
+			limitStr, err := strconv.ParseInt(c.Args().Get(3), 10, 64)
+			if err == nil {
+				limit = int(limitStr)
+			}
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	_, offset := time.Now().Zone()
+	loc := time.FixedZone("", -offset)
+
+	result, err := client.GetFills(context.Background(),
+		&gctrpc.GetFillsRequest{
+			Exchange:  exchangeName,
+			StartDate: s.In(loc).Format(common.SimpleTimeFormat),
+			EndDate:   e.In(loc).Format(common.SimpleTimeFormat),
+			Limit:     int32(limit),
+			Offset:    int32(offset),
+		})
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var reconcileFillsCommand = cli.Command{
+	Name:      "reconcilefills",
+	Usage:     "compares imported exchange fills with the order manager's orders",
+	ArgsUsage: "<exchange> <starttime> <endtime>",
+	Action:    reconcileFills,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to reconcile",
+		},
+		cli.StringFlag{
+			Name:        "start, s",
+			Usage:       "start date to search",
+			Value:       time.Now().AddDate(0, 0, -7).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end, e",
+			Usage:       "end time to search",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+	},
+}
+
+func reconcileFills(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "reconcilefills")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(1) != "" {
+			startTime = c.Args().Get(1)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(2) != "" {
+			endTime = c.Args().Get(2)
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	_, offset := time.Now().Zone()
+	loc := time.FixedZone("", -offset)
+
+	result, err := client.ReconcileFills(context.Background(),
+		&gctrpc.ReconcileFillsRequest{
+			Exchange:  exchangeName,
+			StartDate: s.In(loc).Format(common.SimpleTimeFormat),
+			EndDate:   e.In(loc).Format(common.SimpleTimeFormat),
+			Offset:    int32(offset),
+		})
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getFundingHistoryCommand = cli.Command{
+	Name:      "getfundinghistory",
+	Usage:     "gets deposits and withdrawals synced from exchanges",
+	ArgsUsage: "<exchange> <starttime> <endtime> <limit>",
+	Action:    getFundingHistory,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to filter by, all exchanges if unset",
+		},
+		cli.StringFlag{
+			Name:        "start, s",
+			Usage:       "start date to search",
+			Value:       time.Now().AddDate(0, 0, -7).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end, e",
+			Usage:       "end time to search",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+		cli.IntFlag{
+			Name:        "limit, l",
+			Usage:       "how many results to retrieve",
+			Value:       100,
+			Destination: &limit,
+		},
+	},
+}
+
+func getFundingHistory(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(1) != "" {
+			startTime = c.Args().Get(1)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(2) != "" {
+			endTime = c.Args().Get(2)
+		}
+	}
+
+	if !c.IsSet("limit") {
+		if c.Args().Get(3) != "" {
+			limitStr, err := strconv.ParseInt(c.Args().Get(3), 10, 64)
+			if err == nil {
+				limit = int(limitStr)
+			}
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	_, offset := time.Now().Zone()
+	loc := time.FixedZone("", -offset)
+
+	result, err := client.GetFundingHistory(context.Background(),
+		&gctrpc.GetFundingHistoryRequest{
+			Exchange:  exchangeName,
+			StartDate: s.In(loc).Format(common.SimpleTimeFormat),
+			EndDate:   e.In(loc).Format(common.SimpleTimeFormat),
+			Limit:     int32(limit),
+			Offset:    int32(offset),
+		})
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getDatabaseStatusCommand = cli.Command{
+	Name:   "getdatabasestatus",
+	Usage:  "gets the database schema version along with applied and pending migrations",
+	Action: getDatabaseStatus,
+}
+
+func getDatabaseStatus(_ *cli.Context) error {
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDatabaseStatus(context.Background(),
+		&gctrpc.GetDatabaseStatusRequest{},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var migrateDatabaseCommand = cli.Command{
+	Name:   "migratedatabase",
+	Usage:  "applies every pending database migration",
+	Action: migrateDatabase,
+}
+
+func migrateDatabase(_ *cli.Context) error {
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.MigrateDatabase(context.Background(),
+		&gctrpc.MigrateDatabaseRequest{},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getTickerHistoryCommand = cli.Command{
+	Name:      "gettickerhistory",
+	Usage:     "gets the ticker history recorded to the database for a currency pair",
+	ArgsUsage: "<exchange> <pair> <asset> <starttime> <endtime> <limit>",
+	Action:    getTickerHistory,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the ticker history for",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to get the ticker history for",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+		cli.StringFlag{
+			Name:        "start, s",
+			Usage:       "start date to search",
+			Value:       time.Now().Add(-time.Hour).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end, e",
+			Usage:       "end time to search",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+		cli.IntFlag{
+			Name:        "limit, l",
+			Usage:       "how many results to retrieve",
+			Value:       1000,
+			Destination: &limit,
+		},
+	},
+}
+
+func getTickerHistory(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "gettickerhistory")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(3) != "" {
+			startTime = c.Args().Get(3)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(4) != "" {
+			endTime = c.Args().Get(4)
+		}
+	}
+
+	if !c.IsSet("limit") {
+		if c.Args().Get(5) != "" {
+			limitStr, err := strconv.ParseInt(c.Args().Get(5), 10, 64)
+			if err == nil {
+				limit = int(limitStr)
+			}
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	_, offset := time.Now().Zone()
+	loc := time.FixedZone("", -offset)
+
+	result, err := client.GetTickerHistory(context.Background(),
+		&gctrpc.GetTickerHistoryRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+			StartDate: s.In(loc).Format(common.SimpleTimeFormat),
+			EndDate:   e.In(loc).Format(common.SimpleTimeFormat),
+			Limit:     int32(limit),
 			Offset:    int32(offset),
 		})
 
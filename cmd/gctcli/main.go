@@ -132,7 +132,26 @@ func main() {
 		getExchangeOrderbookStreamCommand,
 		getTickerStreamCommand,
 		getExchangeTickerStreamCommand,
+		getCandleStreamCommand,
+		getExchangeCandleStreamCommand,
+		getRecentTradesCommand,
+		getTradeStreamCommand,
+		getHistoricTradesCommand,
+		getDerivativeInfoCommand,
+		getDerivativeInfoStreamCommand,
+		getExchangeDerivativeInfoStreamCommand,
+		getLiquidationsCommand,
 		getAuditEventCommand,
+		getRequestJournalCommand,
+		verifyAuditEventsCommand,
+		getPortfolioHistoryCommand,
+		getEquityCurveCommand,
+		getFillsCommand,
+		reconcileFillsCommand,
+		getFundingHistoryCommand,
+		getDatabaseStatusCommand,
+		migrateDatabaseCommand,
+		getTickerHistoryCommand,
 		getHistoricCandlesCommand,
 		getHistoricCandlesExtendedCommand,
 		gctScriptCommand,
@@ -90,6 +90,10 @@ func convertGCTtoSQLBoilerConfig(c *database.Config) {
 		tempConfig.Host = c.Host
 		tempConfig.DBName = c.Database
 		tempConfig.SSLMode = c.SSLMode
+		if dbType == database.DBMySQL &&
+			(c.SSLMode == "" || c.SSLMode == "disable") {
+			tempConfig.SSLMode = "false"
+		}
 	}
 
 	sqlboilerConfig[dbType] = tempConfig
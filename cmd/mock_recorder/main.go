@@ -0,0 +1,274 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/engine"
+	exchange "github.com/yurulab/gocryptotrader/exchanges"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/kline"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/order"
+)
+
+// variables for command line overrides
+var (
+	exchangesToUseOverride     string
+	exchangesToExcludeOverride string
+	assetTypeOverride          string
+	currencyPairOverride       string
+	outputDirectory            string
+	authenticatedOnly          bool
+	verboseOverride            bool
+	exchangesToUseList         []string
+	exchangesToExcludeList     []string
+
+	errNoPairs = errors.New("no enabled or available currency pairs")
+)
+
+// result holds the outcome of a single recorded wrapper call
+type result struct {
+	Function string
+	Asset    asset.Item
+	Error    error
+}
+
+func main() {
+	parseCLFlags()
+
+	var err error
+	log.Println("Loading engine...")
+	engine.Bot, err = engine.New()
+	if err != nil {
+		log.Fatalf("Failed to initialise engine. Err: %s", err)
+	}
+
+	engine.Bot.Settings = engine.Settings{
+		DisableExchangeAutoPairUpdates: true,
+		Verbose:                        verboseOverride,
+		EnableExchangeHTTPRateLimiter:  true,
+	}
+
+	dir, err := filepath.Abs(outputDirectory)
+	if err != nil {
+		log.Fatalf("Invalid output directory %s. Err: %s", outputDirectory, err)
+	}
+	mock.SetRecordDirectory(dir)
+	log.Printf("Recording fixtures to %s", dir)
+
+	log.Println("Loading exchanges..")
+	var wg sync.WaitGroup
+	for x := range exchange.Exchanges {
+		name := exchange.Exchanges[x]
+		if !shouldLoadExchange(name) {
+			continue
+		}
+		err = engine.LoadExchange(name, true, &wg)
+		if err != nil {
+			log.Printf("Failed to load exchange %s. Err: %s", name, err)
+		}
+	}
+	wg.Wait()
+	log.Println("Done.")
+
+	log.Println("Recording exchange wrappers..")
+	var m sync.Mutex
+	results := make(map[string][]result)
+	exchs := engine.GetExchanges()
+	for x := range exchs {
+		base := exchs[x].GetBase()
+		base.Verbose = verboseOverride
+		base.HTTPDebugging = false
+		base.HTTPRecording = true
+		mock.AddSecrets(base.API.Credentials.Key,
+			base.API.Credentials.Secret,
+			base.API.Credentials.ClientID,
+			base.API.Credentials.PEMKey,
+			base.Config.API.Credentials.OTPSecret)
+
+		wg.Add(1)
+		go func(e exchange.IBotExchange) {
+			defer wg.Done()
+			r := recordWrappers(e)
+			m.Lock()
+			results[e.GetName()] = r
+			m.Unlock()
+		}(exchs[x])
+	}
+	wg.Wait()
+	log.Println("Done.")
+	log.Println()
+
+	outputResults(results)
+}
+
+func parseCLFlags() {
+	flag.StringVar(&exchangesToUseOverride, "exchanges", "", "a + delimited list of exchange names to record eg -exchanges=bitfinex+okex")
+	flag.StringVar(&exchangesToExcludeOverride, "excluded-exchanges", "", "a + delimited list of exchange names to skip eg -excluded-exchanges=lbank")
+	flag.StringVar(&assetTypeOverride, "asset", "", "the asset type to record (where applicable)")
+	flag.StringVar(&currencyPairOverride, "currency", "", "the currency pair to record (where applicable)")
+	flag.StringVar(&outputDirectory, "output", filepath.Join("testdata", "http_mock"), "the directory fixtures are recorded to")
+	flag.BoolVar(&authenticatedOnly, "auth-only", false, "only record wrapper functions which require authentication")
+	flag.BoolVar(&verboseOverride, "verbose", false, "verbose exchange output")
+	flag.Parse()
+
+	if exchangesToUseOverride != "" {
+		exchangesToUseList = strings.Split(exchangesToUseOverride, "+")
+	}
+	if exchangesToExcludeOverride != "" {
+		exchangesToExcludeList = strings.Split(exchangesToExcludeOverride, "+")
+	}
+}
+
+func shouldLoadExchange(name string) bool {
+	for i := range exchangesToExcludeList {
+		if strings.EqualFold(name, exchangesToExcludeList[i]) {
+			return false
+		}
+	}
+	if len(exchangesToUseList) == 0 {
+		return true
+	}
+	for i := range exchangesToUseList {
+		if strings.EqualFold(name, exchangesToUseList[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordWrappers calls the read only IBotExchange functions so their HTTP
+// requests and responses are recorded. Functions which place orders or move
+// funds are never called
+func recordWrappers(e exchange.IBotExchange) []result {
+	var results []result
+	base := e.GetBase()
+	assetTypes := base.GetAssetTypes()
+	if assetTypeOverride != "" {
+		if !asset.IsValid(asset.Item(assetTypeOverride)) {
+			log.Printf("%v Asset Type '%v' not recognised, defaulting to exchange defaults",
+				base.GetName(),
+				assetTypeOverride)
+		} else if base.SupportsAsset(asset.Item(assetTypeOverride)) {
+			assetTypes = asset.Items{asset.Item(assetTypeOverride)}
+		} else {
+			assetTypes = nil
+		}
+	}
+
+	end := time.Now()
+	start := end.Add(-time.Hour * 24)
+	authenticated := base.AllowAuthenticatedRequest()
+	for i := range assetTypes {
+		p, err := getPair(base, assetTypes[i])
+		if err != nil {
+			log.Printf("%v %v skipping: %v", base.GetName(), assetTypes[i], err)
+			continue
+		}
+		log.Printf("Recording %v %v %v", base.GetName(), assetTypes[i], p)
+
+		add := func(function string, err error) {
+			results = append(results, result{
+				Function: function,
+				Asset:    assetTypes[i],
+				Error:    err,
+			})
+		}
+
+		if !authenticatedOnly {
+			_, err = e.FetchTradablePairs(context.Background(), assetTypes[i])
+			add("FetchTradablePairs", err)
+			_, err = e.UpdateTicker(context.Background(), p, assetTypes[i])
+			add("UpdateTicker", err)
+			_, err = e.UpdateOrderbook(context.Background(), p, assetTypes[i])
+			add("UpdateOrderbook", err)
+			_, err = e.GetExchangeHistory(context.Background(), p, assetTypes[i], start, end)
+			add("GetExchangeHistory", err)
+			_, err = e.GetHistoricCandles(context.Background(), p, assetTypes[i], start, end, kline.OneHour)
+			add("GetHistoricCandles", err)
+		}
+
+		if !authenticated {
+			continue
+		}
+
+		req := &order.GetOrdersRequest{
+			Type:  order.AnyType,
+			Side:  order.AnySide,
+			Pairs: currency.Pairs{p},
+		}
+		_, err = e.GetActiveOrders(context.Background(), req)
+		add("GetActiveOrders", err)
+		_, err = e.GetOrderHistory(context.Background(), req)
+		add("GetOrderHistory", err)
+	}
+
+	if authenticated {
+		_, err := e.UpdateAccountInfo(context.Background())
+		results = append(results, result{Function: "UpdateAccountInfo", Error: err})
+		_, err = e.GetFundingHistory(context.Background())
+		results = append(results, result{Function: "GetFundingHistory", Error: err})
+	} else {
+		log.Printf("%v has no valid API credentials, authenticated functions not recorded",
+			base.GetName())
+	}
+	return results
+}
+
+// getPair returns the currency pair to record for the asset type
+func getPair(base *exchange.Base, a asset.Item) (currency.Pair, error) {
+	if currencyPairOverride != "" {
+		return currency.NewPairFromString(currencyPairOverride)
+	}
+	pairs, err := base.GetEnabledPairs(a)
+	if err != nil {
+		return currency.Pair{}, err
+	}
+	if len(pairs) == 0 {
+		pairs, err = base.GetAvailablePairs(a)
+		if err != nil {
+			return currency.Pair{}, err
+		}
+	}
+	if len(pairs) == 0 {
+		return currency.Pair{}, errNoPairs
+	}
+	return pairs[0], nil
+}
+
+func outputResults(results map[string][]result) {
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i := range names {
+		var failed int
+		for j := range results[names[i]] {
+			if results[names[i]][j].Error != nil {
+				failed++
+			}
+		}
+		log.Printf("%s recorded %d/%d wrapper calls to %s",
+			names[i],
+			len(results[names[i]])-failed,
+			len(results[names[i]]),
+			filepath.Join(outputDirectory, strings.ToLower(names[i])))
+		for j := range results[names[i]] {
+			r := results[names[i]][j]
+			if r.Error != nil {
+				log.Printf("\t - %s %s: %v", r.Function, r.Asset, r.Error)
+			}
+		}
+	}
+}
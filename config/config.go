@@ -21,6 +21,7 @@ import (
 	"github.com/yurulab/gocryptotrader/currency"
 	"github.com/yurulab/gocryptotrader/currency/forexprovider"
 	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/database/drivers"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
 	gctscript "github.com/yurulab/gocryptotrader/gctscript/vm"
 	"github.com/yurulab/gocryptotrader/log"
@@ -1047,6 +1048,26 @@ func (c *Config) CheckExchangeConfigValues() error {
 					defaultWebsocketOrderbookBufferLimit)
 				c.Exchanges[i].WebsocketOrderbookBufferLimit = defaultWebsocketOrderbookBufferLimit
 			}
+			if o := c.Exchanges[i].Outbound; o != nil {
+				switch o.Strategy {
+				case "":
+					o.Strategy = DefaultOutboundStrategy
+				case DefaultOutboundStrategy, OutboundStrategyLeastUsed:
+				default:
+					log.Warnf(log.ConfigMgr,
+						"Exchange %s outbound strategy %s unsupported, defaulting to %s.\n",
+						c.Exchanges[i].Name,
+						o.Strategy,
+						DefaultOutboundStrategy)
+					o.Strategy = DefaultOutboundStrategy
+				}
+				if c.Exchanges[i].ProxyAddress != "" &&
+					(len(o.Proxies) > 0 || len(o.SourceAddresses) > 0) {
+					log.Warnf(log.ConfigMgr,
+						"Exchange %s proxy address is ignored as outbound proxies or source addresses are set.\n",
+						c.Exchanges[i].Name)
+				}
+			}
 			err := c.CheckPairConsistency(c.Exchanges[i].Name)
 			if err != nil {
 				log.Errorf(log.ConfigMgr,
@@ -1347,7 +1368,8 @@ func (c *Config) checkDatabaseConfig() error {
 	m.Lock()
 	defer m.Unlock()
 
-	if (c.Database == database.Config{}) {
+	if c.Database.Driver == "" &&
+		c.Database.ConnectionDetails == (drivers.ConnectionDetails{}) {
 		c.Database.Driver = database.DBSQLite3
 		c.Database.Database = database.DefaultSQLiteDatabase
 	}
@@ -1370,11 +1392,241 @@ func (c *Config) checkDatabaseConfig() error {
 		database.DB.DataPath = databaseDir
 	}
 
+	if c.Database.AuditCheckpoint.Enabled {
+		if c.Database.AuditCheckpoint.Key == "" {
+			c.Database.AuditCheckpoint.Enabled = false
+			log.Warnln(log.ConfigMgr,
+				"Audit checkpoints require a signing key, audit checkpoints disabled.")
+		}
+		if c.Database.AuditCheckpoint.Interval <= 0 {
+			c.Database.AuditCheckpoint.Interval = database.DefaultAuditCheckpointInterval
+		}
+		if c.Database.AuditCheckpoint.Path == "" {
+			c.Database.AuditCheckpoint.Path = filepath.Join(common.GetDefaultDataDir(runtime.GOOS),
+				"database",
+				database.DefaultAuditCheckpointFile)
+		}
+	}
+
+	if c.Database.Retention.Enabled {
+		if c.Database.Retention.Interval <= 0 {
+			c.Database.Retention.Interval = database.DefaultRetentionInterval
+		}
+		if c.Database.Retention.ArchivePath == "" {
+			c.Database.Retention.ArchivePath = filepath.Join(common.GetDefaultDataDir(runtime.GOOS),
+				"database",
+				database.DefaultArchiveDir)
+		}
+		policies := c.Database.Retention.Policies[:0]
+		for i := range c.Database.Retention.Policies {
+			if c.Database.Retention.Policies[i].MaxAge <= 0 {
+				log.Warnf(log.ConfigMgr,
+					"Retention policy for table %s has no max age, policy removed.\n",
+					c.Database.Retention.Policies[i].Table)
+				continue
+			}
+			policies = append(policies, c.Database.Retention.Policies[i])
+		}
+		c.Database.Retention.Policies = policies
+	}
+
 	database.DB.Config = &c.Database
 
 	return nil
 }
 
+// checkBalanceSnapshotConfig checks the balance snapshot settings, disabling
+// snapshots if the database is disabled
+func (c *Config) checkBalanceSnapshotConfig() error {
+	m.Lock()
+	defer m.Unlock()
+
+	if !c.BalanceSnapshots.Enabled {
+		return nil
+	}
+
+	if c.BalanceSnapshots.Interval <= 0 {
+		c.BalanceSnapshots.Interval = DefaultBalanceSnapshotInterval
+	}
+
+	if !c.Database.Enabled {
+		c.BalanceSnapshots.Enabled = false
+		return errors.New("balance snapshots require the database to be enabled, balance snapshots disabled")
+	}
+	return nil
+}
+
+// checkFillImportConfig checks the fill import settings, disabling the import
+// if the database is disabled
+func (c *Config) checkFillImportConfig() error {
+	m.Lock()
+	defer m.Unlock()
+
+	if !c.FillImport.Enabled {
+		return nil
+	}
+
+	if c.FillImport.Interval <= 0 {
+		c.FillImport.Interval = DefaultFillImportInterval
+	}
+
+	if c.FillImport.Lookback <= 0 {
+		c.FillImport.Lookback = DefaultFillImportLookback
+	}
+
+	if !c.Database.Enabled {
+		c.FillImport.Enabled = false
+		return errors.New("fill import requires the database to be enabled, fill import disabled")
+	}
+	return nil
+}
+
+// checkFundingSyncConfig checks the funding sync settings, disabling the sync
+// if the database is disabled
+func (c *Config) checkFundingSyncConfig() error {
+	m.Lock()
+	defer m.Unlock()
+
+	if !c.FundingSync.Enabled {
+		return nil
+	}
+
+	if c.FundingSync.Interval <= 0 {
+		c.FundingSync.Interval = DefaultFundingSyncInterval
+	}
+
+	if !c.Database.Enabled {
+		c.FundingSync.Enabled = false
+		return errors.New("funding sync requires the database to be enabled, funding sync disabled")
+	}
+	return nil
+}
+
+// checkTickerHistoryConfig checks the ticker history settings, disabling the
+// history if the database is disabled
+func (c *Config) checkTickerHistoryConfig() error {
+	m.Lock()
+	defer m.Unlock()
+
+	if !c.TickerHistory.Enabled {
+		return nil
+	}
+
+	if c.TickerHistory.TickRetention <= 0 {
+		c.TickerHistory.TickRetention = DefaultTickerHistoryRetention
+	}
+
+	if !c.Database.Enabled {
+		c.TickerHistory.Enabled = false
+		return errors.New("ticker history requires the database to be enabled, ticker history disabled")
+	}
+	return nil
+}
+
+// checkOrderbookRecorderConfig checks the orderbook recorder settings,
+// defaulting to interval snapshots written to files in the data directory
+func (c *Config) checkOrderbookRecorderConfig() error {
+	m.Lock()
+	defer m.Unlock()
+
+	if !c.OrderbookRecorder.Enabled {
+		return nil
+	}
+
+	switch c.OrderbookRecorder.Mode {
+	case "":
+		c.OrderbookRecorder.Mode = OrderbookRecordInterval
+		fallthrough
+	case OrderbookRecordInterval:
+		if c.OrderbookRecorder.Interval <= 0 {
+			c.OrderbookRecorder.Interval = DefaultOrderbookRecordInterval
+		}
+	case OrderbookRecordUpdate:
+	default:
+		c.OrderbookRecorder.Enabled = false
+		return fmt.Errorf("unsupported orderbook recorder mode %v, orderbook recorder disabled", c.OrderbookRecorder.Mode)
+	}
+
+	pairs := c.OrderbookRecorder.Pairs[:0]
+	for i := range c.OrderbookRecorder.Pairs {
+		p := c.OrderbookRecorder.Pairs[i]
+		if p.Exchange == "" || p.Pair.IsEmpty() || !asset.IsValid(p.Asset) {
+			log.Warnf(log.ConfigMgr,
+				"Orderbook recorder pair %s %s %s is invalid, pair removed.\n",
+				p.Exchange,
+				p.Asset,
+				p.Pair)
+			continue
+		}
+		pairs = append(pairs, p)
+	}
+	c.OrderbookRecorder.Pairs = pairs
+	if len(pairs) == 0 {
+		c.OrderbookRecorder.Enabled = false
+		return errors.New("no orderbook recorder pairs set, orderbook recorder disabled")
+	}
+
+	switch c.OrderbookRecorder.Output {
+	case "":
+		c.OrderbookRecorder.Output = OrderbookRecordFile
+		fallthrough
+	case OrderbookRecordFile:
+		if c.OrderbookRecorder.Path == "" {
+			c.OrderbookRecorder.Path = filepath.Join(common.GetDefaultDataDir(runtime.GOOS), DefaultOrderbookRecordDir)
+		}
+		return common.CreateDir(c.OrderbookRecorder.Path)
+	case OrderbookRecordDatabase:
+		if !c.Database.Enabled {
+			c.OrderbookRecorder.Enabled = false
+			return errors.New("database orderbook recorder output requires the database to be enabled, orderbook recorder disabled")
+		}
+		return nil
+	default:
+		c.OrderbookRecorder.Enabled = false
+		return fmt.Errorf("unsupported orderbook recorder output %v, orderbook recorder disabled", c.OrderbookRecorder.Output)
+	}
+}
+
+// checkNonceStoreConfig checks the nonce store settings, defaulting to a file
+// in the data directory
+func (c *Config) checkNonceStoreConfig() error {
+	m.Lock()
+	defer m.Unlock()
+
+	if !c.NonceStore.Enabled {
+		return nil
+	}
+
+	if c.NonceStore.LeaseSize <= 0 {
+		c.NonceStore.LeaseSize = DefaultNonceLeaseSize
+	}
+	if c.NonceStore.LeaseSize > 1 {
+		log.Warnf(log.ConfigMgr,
+			"Nonce store lease size %d is only safe when a single bot instance uses each exchange's API keys.\n",
+			c.NonceStore.LeaseSize)
+	}
+
+	switch c.NonceStore.Backend {
+	case "":
+		c.NonceStore.Backend = NonceStoreFile
+		fallthrough
+	case NonceStoreFile:
+		if c.NonceStore.Path == "" {
+			c.NonceStore.Path = filepath.Join(common.GetDefaultDataDir(runtime.GOOS), DefaultNonceStoreFile)
+		}
+		return common.CreateDir(filepath.Dir(c.NonceStore.Path))
+	case NonceStoreDatabase:
+		if !c.Database.Enabled {
+			c.NonceStore.Enabled = false
+			return errors.New("database nonce store requires the database to be enabled, nonce store disabled")
+		}
+		return nil
+	default:
+		c.NonceStore.Enabled = false
+		return fmt.Errorf("unsupported nonce store backend %v, nonce store disabled", c.NonceStore.Backend)
+	}
+}
+
 // CheckNTPConfig checks for missing or incorrectly configured NTPClient and recreates with known safe defaults
 func (c *Config) CheckNTPConfig() {
 	m.Lock()
@@ -1685,7 +1937,8 @@ func (c *Config) SaveConfig(configPath string, dryrun bool) error {
 }
 
 // CheckRemoteControlConfig checks to see if the old c.Webserver field is used
-// and migrates the existing settings to the new RemoteControl struct
+// and migrates the existing settings to the new RemoteControl struct, then
+// sets the default metrics listen address if unset
 func (c *Config) CheckRemoteControlConfig() {
 	m.Lock()
 	defer m.Unlock()
@@ -1725,6 +1978,10 @@ func (c *Config) CheckRemoteControlConfig() {
 		// Then flush the old webserver settings
 		c.Webserver = nil
 	}
+
+	if c.RemoteControl.Metrics.ListenAddress == "" {
+		c.RemoteControl.Metrics.ListenAddress = DefaultMetricsListenAddress
+	}
 }
 
 // CheckConfig checks all config settings
@@ -1743,6 +2000,48 @@ func (c *Config) CheckConfig() error {
 			err)
 	}
 
+	err = c.checkNonceStoreConfig()
+	if err != nil {
+		log.Errorf(log.ConfigMgr,
+			"Failed to configure nonce store: %v\n",
+			err)
+	}
+
+	err = c.checkBalanceSnapshotConfig()
+	if err != nil {
+		log.Errorf(log.ConfigMgr,
+			"Failed to configure balance snapshots: %v\n",
+			err)
+	}
+
+	err = c.checkFillImportConfig()
+	if err != nil {
+		log.Errorf(log.ConfigMgr,
+			"Failed to configure fill import: %v\n",
+			err)
+	}
+
+	err = c.checkFundingSyncConfig()
+	if err != nil {
+		log.Errorf(log.ConfigMgr,
+			"Failed to configure funding sync: %v\n",
+			err)
+	}
+
+	err = c.checkTickerHistoryConfig()
+	if err != nil {
+		log.Errorf(log.ConfigMgr,
+			"Failed to configure ticker history: %v\n",
+			err)
+	}
+
+	err = c.checkOrderbookRecorderConfig()
+	if err != nil {
+		log.Errorf(log.ConfigMgr,
+			"Failed to configure orderbook recorder: %v\n",
+			err)
+	}
+
 	err = c.CheckExchangeConfigValues()
 	if err != nil {
 		return fmt.Errorf(ErrCheckingConfigValues, err)
@@ -1,8 +1,12 @@
 package config
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/yurulab/gocryptotrader/common"
 	"github.com/yurulab/gocryptotrader/common/convert"
@@ -1316,6 +1320,37 @@ func TestCheckExchangeConfigValues(t *testing.T) {
 		t.Error("unexpected values")
 	}
 
+	// Test outbound strategy defaults
+	cfg.Exchanges[0].Outbound = &OutboundConfig{
+		SourceAddresses: []string{"127.0.0.1"},
+	}
+	err = cfg.CheckExchangeConfigValues()
+	if err != nil {
+		t.Error(err)
+	}
+	if cfg.Exchanges[0].Outbound.Strategy != DefaultOutboundStrategy {
+		t.Errorf("expected outbound strategy %s, received %s",
+			DefaultOutboundStrategy,
+			cfg.Exchanges[0].Outbound.Strategy)
+	}
+	cfg.Exchanges[0].Outbound.Strategy = "random"
+	err = cfg.CheckExchangeConfigValues()
+	if err != nil {
+		t.Error(err)
+	}
+	if cfg.Exchanges[0].Outbound.Strategy != DefaultOutboundStrategy {
+		t.Error("unsupported outbound strategy should be reset to the default")
+	}
+	cfg.Exchanges[0].Outbound.Strategy = OutboundStrategyLeastUsed
+	err = cfg.CheckExchangeConfigValues()
+	if err != nil {
+		t.Error(err)
+	}
+	if cfg.Exchanges[0].Outbound.Strategy != OutboundStrategyLeastUsed {
+		t.Error("supported outbound strategy should not be changed")
+	}
+	cfg.Exchanges[0].Outbound = nil
+
 	// Test feature and endpoint migrations migrations
 	cfg.Exchanges[0].Features = nil
 	cfg.Exchanges[0].SupportsAutoPairUpdates = convert.BoolPtr(true)
@@ -1748,7 +1783,9 @@ func TestCheckRemoteControlConfig(t *testing.T) {
 		c.RemoteControl.WebsocketRPC.ListenAddress != "localhost:9051" ||
 		!c.RemoteControl.WebsocketRPC.AllowInsecureOrigin ||
 		c.RemoteControl.WebsocketRPC.ConnectionLimit != 5 ||
-		c.RemoteControl.WebsocketRPC.MaxAuthFailures != 10 {
+		c.RemoteControl.WebsocketRPC.MaxAuthFailures != 10 ||
+		c.RemoteControl.Metrics.Enabled ||
+		c.RemoteControl.Metrics.ListenAddress != DefaultMetricsListenAddress {
 		t.Error("unexpected results")
 	}
 
@@ -1916,6 +1953,90 @@ func TestCheckDatabaseConfig(t *testing.T) {
 	if err := c.checkDatabaseConfig(); err != nil {
 		t.Error(err)
 	}
+
+	c.Database.AuditCheckpoint.Enabled = true
+	if err := c.checkDatabaseConfig(); err != nil {
+		t.Error(err)
+	}
+	if c.Database.AuditCheckpoint.Enabled {
+		t.Error("audit checkpoints should be disabled without a signing key")
+	}
+
+	c.Database.AuditCheckpoint.Enabled = true
+	c.Database.AuditCheckpoint.Key = "key"
+	if err := c.checkDatabaseConfig(); err != nil {
+		t.Error(err)
+	}
+	if !c.Database.AuditCheckpoint.Enabled ||
+		c.Database.AuditCheckpoint.Interval != database.DefaultAuditCheckpointInterval ||
+		c.Database.AuditCheckpoint.Path == "" {
+		t.Error("audit checkpoints should be enabled with defaults")
+	}
+
+	c.Database.Retention.Enabled = true
+	c.Database.Retention.Policies = []database.RetentionPolicy{
+		{Table: "audit_event", MaxAge: time.Hour, Archive: true},
+		{Table: "request_journal"},
+	}
+	if err := c.checkDatabaseConfig(); err != nil {
+		t.Error(err)
+	}
+	if c.Database.Retention.Interval != database.DefaultRetentionInterval ||
+		c.Database.Retention.ArchivePath == "" {
+		t.Error("retention should be enabled with defaults")
+	}
+	if len(c.Database.Retention.Policies) != 1 ||
+		c.Database.Retention.Policies[0].Table != "audit_event" {
+		t.Errorf("policy without a max age should be removed, received %+v",
+			c.Database.Retention.Policies)
+	}
+}
+
+func TestCheckNonceStoreConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	if err := c.checkNonceStoreConfig(); err != nil {
+		t.Error(err)
+	}
+	if c.NonceStore.Backend != "" {
+		t.Error("disabled nonce store should not be modified")
+	}
+
+	dir, err := ioutil.TempDir("", "gct-nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c.NonceStore.Enabled = true
+	c.NonceStore.Path = filepath.Join(dir, "nonce", "nonce.json")
+	if err = c.checkNonceStoreConfig(); err != nil {
+		t.Error(err)
+	}
+	if c.NonceStore.Backend != NonceStoreFile ||
+		c.NonceStore.LeaseSize != DefaultNonceLeaseSize {
+		t.Error("nonce store should default to a file backend")
+	}
+	if _, err = os.Stat(filepath.Dir(c.NonceStore.Path)); err != nil {
+		t.Error("nonce store directory should be created")
+	}
+
+	c.NonceStore.Backend = NonceStoreDatabase
+	if err = c.checkNonceStoreConfig(); err == nil || c.NonceStore.Enabled {
+		t.Error("database nonce store should be disabled without a database")
+	}
+
+	c.NonceStore.Enabled = true
+	c.Database.Enabled = true
+	if err = c.checkNonceStoreConfig(); err != nil || !c.NonceStore.Enabled {
+		t.Error("database nonce store should be enabled with a database")
+	}
+
+	c.NonceStore.Backend = "redis"
+	if err = c.checkNonceStoreConfig(); err == nil || c.NonceStore.Enabled {
+		t.Error("unsupported nonce store backend should be disabled")
+	}
 }
 
 func TestCheckNTPConfig(t *testing.T) {
@@ -2034,3 +2155,157 @@ func TestRemoveExchange(t *testing.T) {
 		t.Fatal("exchange shouldn't exist")
 	}
 }
+
+func TestCheckBalanceSnapshotConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	if err := c.checkBalanceSnapshotConfig(); err != nil {
+		t.Error(err)
+	}
+	if c.BalanceSnapshots.Interval != 0 {
+		t.Error("disabled balance snapshots should not be modified")
+	}
+
+	c.BalanceSnapshots.Enabled = true
+	if err := c.checkBalanceSnapshotConfig(); err == nil || c.BalanceSnapshots.Enabled {
+		t.Error("balance snapshots should be disabled without a database")
+	}
+
+	c.BalanceSnapshots.Enabled = true
+	c.Database.Enabled = true
+	if err := c.checkBalanceSnapshotConfig(); err != nil || !c.BalanceSnapshots.Enabled {
+		t.Error("balance snapshots should be enabled with a database")
+	}
+	if c.BalanceSnapshots.Interval != DefaultBalanceSnapshotInterval {
+		t.Errorf("expected default interval, received %v", c.BalanceSnapshots.Interval)
+	}
+}
+
+func TestCheckFillImportConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	if err := c.checkFillImportConfig(); err != nil {
+		t.Error(err)
+	}
+	if c.FillImport.Interval != 0 || c.FillImport.Lookback != 0 {
+		t.Error("disabled fill import should not be modified")
+	}
+
+	c.FillImport.Enabled = true
+	if err := c.checkFillImportConfig(); err == nil || c.FillImport.Enabled {
+		t.Error("fill import should be disabled without a database")
+	}
+
+	c.FillImport.Enabled = true
+	c.Database.Enabled = true
+	if err := c.checkFillImportConfig(); err != nil || !c.FillImport.Enabled {
+		t.Error("fill import should be enabled with a database")
+	}
+	if c.FillImport.Interval != DefaultFillImportInterval ||
+		c.FillImport.Lookback != DefaultFillImportLookback {
+		t.Errorf("expected default interval and lookback, received %v %v",
+			c.FillImport.Interval,
+			c.FillImport.Lookback)
+	}
+}
+
+func TestCheckFundingSyncConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	if err := c.checkFundingSyncConfig(); err != nil {
+		t.Error(err)
+	}
+	if c.FundingSync.Interval != 0 {
+		t.Error("disabled funding sync should not be modified")
+	}
+
+	c.FundingSync.Enabled = true
+	if err := c.checkFundingSyncConfig(); err == nil || c.FundingSync.Enabled {
+		t.Error("funding sync should be disabled without a database")
+	}
+
+	c.FundingSync.Enabled = true
+	c.Database.Enabled = true
+	if err := c.checkFundingSyncConfig(); err != nil || !c.FundingSync.Enabled {
+		t.Error("funding sync should be enabled with a database")
+	}
+	if c.FundingSync.Interval != DefaultFundingSyncInterval {
+		t.Errorf("expected default interval, received %v", c.FundingSync.Interval)
+	}
+}
+
+func TestCheckOrderbookRecorderConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	if err := c.checkOrderbookRecorderConfig(); err != nil {
+		t.Error(err)
+	}
+	if c.OrderbookRecorder.Mode != "" || c.OrderbookRecorder.Output != "" {
+		t.Error("disabled orderbook recorder should not be modified")
+	}
+
+	c.OrderbookRecorder.Enabled = true
+	if err := c.checkOrderbookRecorderConfig(); err == nil || c.OrderbookRecorder.Enabled {
+		t.Error("orderbook recorder should be disabled without pairs")
+	}
+
+	c.OrderbookRecorder.Enabled = true
+	c.OrderbookRecorder.Output = OrderbookRecordDatabase
+	c.OrderbookRecorder.Pairs = []OrderbookRecordPair{
+		{Exchange: testFakeExchangeName, Asset: asset.Spot, Pair: currency.NewPair(currency.BTC, currency.USD)},
+		{Exchange: testFakeExchangeName, Asset: "bad", Pair: currency.NewPair(currency.BTC, currency.USD)},
+	}
+	if err := c.checkOrderbookRecorderConfig(); err == nil || c.OrderbookRecorder.Enabled {
+		t.Error("database orderbook recorder should be disabled without a database")
+	}
+	if len(c.OrderbookRecorder.Pairs) != 1 {
+		t.Errorf("expected invalid pair to be removed, received %v", c.OrderbookRecorder.Pairs)
+	}
+	if c.OrderbookRecorder.Mode != OrderbookRecordInterval ||
+		c.OrderbookRecorder.Interval != DefaultOrderbookRecordInterval {
+		t.Errorf("expected default mode and interval, received %v %v",
+			c.OrderbookRecorder.Mode,
+			c.OrderbookRecorder.Interval)
+	}
+
+	c.OrderbookRecorder.Enabled = true
+	c.Database.Enabled = true
+	if err := c.checkOrderbookRecorderConfig(); err != nil || !c.OrderbookRecorder.Enabled {
+		t.Error("database orderbook recorder should be enabled with a database")
+	}
+
+	c.OrderbookRecorder.Mode = "sometimes"
+	if err := c.checkOrderbookRecorderConfig(); err == nil || c.OrderbookRecorder.Enabled {
+		t.Error("orderbook recorder should be disabled with an unsupported mode")
+	}
+}
+
+func TestCheckTickerHistoryConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	if err := c.checkTickerHistoryConfig(); err != nil {
+		t.Error(err)
+	}
+	if c.TickerHistory.TickRetention != 0 {
+		t.Error("disabled ticker history should not be modified")
+	}
+
+	c.TickerHistory.Enabled = true
+	if err := c.checkTickerHistoryConfig(); err == nil || c.TickerHistory.Enabled {
+		t.Error("ticker history should be disabled without a database")
+	}
+
+	c.TickerHistory.Enabled = true
+	c.Database.Enabled = true
+	if err := c.checkTickerHistoryConfig(); err != nil || !c.TickerHistory.Enabled {
+		t.Error("ticker history should be enabled with a database")
+	}
+	if c.TickerHistory.TickRetention != DefaultTickerHistoryRetention {
+		t.Errorf("expected default tick retention, received %v", c.TickerHistory.TickRetention)
+	}
+}
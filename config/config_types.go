@@ -6,6 +6,7 @@ import (
 
 	"github.com/yurulab/gocryptotrader/currency"
 	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
 	"github.com/yurulab/gocryptotrader/exchanges/protocol"
 	gctscript "github.com/yurulab/gocryptotrader/gctscript/vm"
 	"github.com/yurulab/gocryptotrader/log"
@@ -34,6 +35,24 @@ const (
 	DefaultAPIKey                        = "Key"
 	DefaultAPISecret                     = "Secret"
 	DefaultAPIClientID                   = "ClientID"
+	DefaultMetricsListenAddress          = "localhost:9054"
+	DefaultOutboundStrategy              = "roundrobin"
+	OutboundStrategyLeastUsed            = "leastused"
+	NonceStoreFile                       = "file"
+	NonceStoreDatabase                   = "database"
+	DefaultNonceStoreFile                = "nonce.json"
+	DefaultNonceLeaseSize                = 1
+	DefaultBalanceSnapshotInterval       = time.Hour
+	DefaultFillImportInterval            = time.Hour
+	DefaultFillImportLookback            = time.Hour * 24 * 30
+	DefaultFundingSyncInterval           = time.Hour
+	OrderbookRecordInterval              = "interval"
+	OrderbookRecordUpdate                = "update"
+	OrderbookRecordFile                  = "file"
+	OrderbookRecordDatabase              = "database"
+	DefaultOrderbookRecordInterval       = time.Second * 10
+	DefaultOrderbookRecordDir            = "orderbooks"
+	DefaultTickerHistoryRetention        = time.Hour * 24
 )
 
 // Constants here hold some messages
@@ -85,6 +104,12 @@ type Config struct {
 	ConnectionMonitor ConnectionMonitorConfig `json:"connectionMonitor"`
 	Profiler          Profiler                `json:"profiler"`
 	NTPClient         NTPClientConfig         `json:"ntpclient"`
+	NonceStore        NonceStoreConfig        `json:"nonceStore"`
+	BalanceSnapshots  BalanceSnapshotConfig   `json:"balanceSnapshots"`
+	FillImport        FillImportConfig        `json:"fillImport"`
+	FundingSync       FundingSyncConfig       `json:"fundingSync"`
+	OrderbookRecorder OrderbookRecorderConfig `json:"orderbookRecorder"`
+	TickerHistory     TickerHistoryConfig     `json:"tickerHistory"`
 	GCTScript         gctscript.Config        `json:"gctscript"`
 	Currency          CurrencyConfig          `json:"currencyConfig"`
 	Communications    CommunicationsConfig    `json:"communications"`
@@ -123,6 +148,9 @@ type ExchangeConfig struct {
 	WebsocketTrafficTimeout       time.Duration          `json:"websocketTrafficTimeout"`
 	WebsocketOrderbookBufferLimit int                    `json:"websocketOrderbookBufferLimit"`
 	ProxyAddress                  string                 `json:"proxyAddress,omitempty"`
+	Outbound                      *OutboundConfig        `json:"outbound,omitempty"`
+	CircuitBreaker                *CircuitBreakerConfig  `json:"circuitBreaker,omitempty"`
+	SyncServerTime                bool                   `json:"syncServerTime,omitempty"`
 	BaseCurrencies                currency.Currencies    `json:"baseCurrencies"`
 	CurrencyPairs                 *currency.PairsManager `json:"currencyPairs"`
 	API                           APIConfig              `json:"api"`
@@ -150,6 +178,24 @@ type ExchangeConfig struct {
 	WebsocketURL                     *string              `json:"websocketUrl,omitempty"`
 }
 
+// OutboundConfig defines multiple proxies or local source addresses to spread
+// exchange requests across. Authenticated requests always use the first entry,
+// proxies before source addresses, so that only one IP needs whitelisting
+type OutboundConfig struct {
+	Proxies         []string `json:"proxies,omitempty"`
+	SourceAddresses []string `json:"sourceAddresses,omitempty"`
+	Strategy        string   `json:"strategy,omitempty"`
+}
+
+// CircuitBreakerConfig defines when REST requests to an exchange are suspended
+// after consecutive failures. Threshold and Cooldown fall back to the
+// requester defaults when unset
+type CircuitBreakerConfig struct {
+	Enabled   bool          `json:"enabled"`
+	Threshold int           `json:"threshold,omitempty"`
+	Cooldown  time.Duration `json:"cooldown,omitempty"`
+}
+
 // Profiler defines the profiler configuration to enable pprof
 type Profiler struct {
 	Enabled              bool `json:"enabled"`
@@ -163,6 +209,7 @@ type NTPClientConfig struct {
 	Pool                      []string       `json:"pool"`
 	AllowedDifference         *time.Duration `json:"allowedDifference"`
 	AllowedNegativeDifference *time.Duration `json:"allowedNegativeDifference"`
+	CompensateClockSkew       bool           `json:"compensateClockSkew"`
 }
 
 // GRPCConfig stores the gRPC settings
@@ -196,6 +243,73 @@ type RemoteControlConfig struct {
 	GRPC          GRPCConfig           `json:"gRPC"`
 	DeprecatedRPC DepcrecatedRPCConfig `json:"deprecatedRPC"`
 	WebsocketRPC  WebsocketRPCConfig   `json:"websocketRPC"`
+	Metrics       MetricsConfig        `json:"metrics"`
+}
+
+// NonceStoreConfig stores where exchange nonces are persisted so they keep
+// increasing across restarts and bot instances sharing API keys
+type NonceStoreConfig struct {
+	Enabled bool   `json:"enabled"`
+	Backend string `json:"backend"`
+	Path    string `json:"path,omitempty"`
+	// LeaseSize is how many nonces are reserved from the store at a time.
+	// Sizes above one must only be used when a single bot instance uses the
+	// API keys, as nonces leased by different instances interleave
+	LeaseSize int64 `json:"leaseSize"`
+}
+
+// BalanceSnapshotConfig stores how often exchange account balances and their
+// fiat value are recorded to the database
+type BalanceSnapshotConfig struct {
+	Enabled  bool          `json:"enabled"`
+	Interval time.Duration `json:"interval"`
+}
+
+// FillImportConfig stores how often exchange trade history is imported to the
+// database and how far back the first import reaches
+type FillImportConfig struct {
+	Enabled  bool          `json:"enabled"`
+	Interval time.Duration `json:"interval"`
+	Lookback time.Duration `json:"lookback"`
+}
+
+// FundingSyncConfig stores how often exchange deposit and withdrawal history
+// is synced to the database
+type FundingSyncConfig struct {
+	Enabled  bool          `json:"enabled"`
+	Interval time.Duration `json:"interval"`
+}
+
+// OrderbookRecorderConfig stores which orderbooks are recorded, whether a
+// snapshot is taken every interval or on every update and where snapshots are
+// written
+type OrderbookRecorderConfig struct {
+	Enabled  bool                  `json:"enabled"`
+	Mode     string                `json:"mode"`
+	Interval time.Duration         `json:"interval"`
+	Output   string                `json:"output"`
+	Path     string                `json:"path,omitempty"`
+	Pairs    []OrderbookRecordPair `json:"pairs"`
+}
+
+// OrderbookRecordPair is an orderbook to record
+type OrderbookRecordPair struct {
+	Exchange string        `json:"exchange"`
+	Asset    asset.Item    `json:"asset"`
+	Pair     currency.Pair `json:"pair"`
+}
+
+// TickerHistoryConfig stores whether tickers are recorded to the database and
+// how long every ticker is kept before being downsampled to minute aggregates
+type TickerHistoryConfig struct {
+	Enabled       bool          `json:"enabled"`
+	TickRetention time.Duration `json:"tickRetention"`
+}
+
+// MetricsConfig stores the Prometheus metrics exporter settings
+type MetricsConfig struct {
+	Enabled       bool   `json:"enabled"`
+	ListenAddress string `json:"listenAddress"`
 }
 
 // WebserverConfig stores the old webserver config
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/yurulab/gocryptotrader/database/drivers"
 )
@@ -20,12 +21,40 @@ type Instance struct {
 
 // Config holds all database configurable options including enable/disabled & DSN settings
 type Config struct {
-	Enabled                   bool   `json:"enabled"`
-	Verbose                   bool   `json:"verbose"`
-	Driver                    string `json:"driver"`
+	Enabled                   bool                  `json:"enabled"`
+	Verbose                   bool                  `json:"verbose"`
+	Driver                    string                `json:"driver"`
+	RequestJournal            bool                  `json:"requestJournal"`
+	AuditCheckpoint           AuditCheckpointConfig `json:"auditCheckpoint"`
+	Retention                 RetentionConfig       `json:"retention"`
 	drivers.ConnectionDetails `json:"connectionDetails"`
 }
 
+// AuditCheckpointConfig holds the settings for periodically exporting signed
+// checkpoints of the audit event hash chain to a file
+type AuditCheckpointConfig struct {
+	Enabled  bool          `json:"enabled"`
+	Interval time.Duration `json:"interval"`
+	Path     string        `json:"path"`
+	Key      string        `json:"key"`
+}
+
+// RetentionConfig holds how long rows are kept in each table before they are
+// removed, optionally archiving them to compressed files first
+type RetentionConfig struct {
+	Enabled     bool              `json:"enabled"`
+	Interval    time.Duration     `json:"interval"`
+	ArchivePath string            `json:"archivePath"`
+	Policies    []RetentionPolicy `json:"policies"`
+}
+
+// RetentionPolicy holds the retention settings for a single table
+type RetentionPolicy struct {
+	Table   string        `json:"table"`
+	MaxAge  time.Duration `json:"maxAge"`
+	Archive bool          `json:"archive"`
+}
+
 var (
 	// DB Global Database Connection
 	DB = &Instance{}
@@ -40,10 +69,25 @@ var (
 	ErrDatabaseSupportDisabled = errors.New("database support is disabled")
 
 	// SupportedDrivers slice of supported database driver types
-	SupportedDrivers = []string{DBSQLite, DBSQLite3, DBPostgreSQL}
+	SupportedDrivers = []string{DBSQLite, DBSQLite3, DBPostgreSQL, DBMySQL}
 
 	// DefaultSQLiteDatabase is the default sqlite3 database name to use
 	DefaultSQLiteDatabase = "gocryptotrader.db"
+
+	// DefaultAuditCheckpointInterval is how often audit checkpoints are
+	// exported when no interval is configured
+	DefaultAuditCheckpointInterval = time.Hour
+
+	// DefaultAuditCheckpointFile is the default audit checkpoint file name
+	DefaultAuditCheckpointFile = "audit_checkpoints.log"
+
+	// DefaultRetentionInterval is how often retention policies are applied
+	// when no interval is configured
+	DefaultRetentionInterval = time.Hour * 24
+
+	// DefaultArchiveDir is the default directory name archived rows are
+	// written to
+	DefaultArchiveDir = "archive"
 )
 
 const (
@@ -53,4 +97,6 @@ const (
 	DBSQLite3 = "sqlite3"
 	// DBPostgreSQL const string for PostgreSQL across code base
 	DBPostgreSQL = "postgres"
+	// DBMySQL const string for MySQL and MariaDB across code base
+	DBMySQL = "mysql"
 )
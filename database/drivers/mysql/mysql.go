@@ -0,0 +1,57 @@
+package mysql
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/yurulab/gocryptotrader/database"
+)
+
+// Connect opens a connection to MySQL or MariaDB database and returns a pointer to database.DB
+func Connect() (*database.Instance, error) {
+	cfg := mysql.NewConfig()
+	cfg.User = database.DB.Config.Username
+	cfg.Passwd = database.DB.Config.Password
+	cfg.Net = "tcp"
+	cfg.Addr = fmt.Sprintf("%s:%d", database.DB.Config.Host, database.DB.Config.Port)
+	cfg.DBName = database.DB.Config.Database
+	cfg.TLSConfig = tlsMode(database.DB.Config.SSLMode)
+	// Scan DATETIME columns into time.Time and keep session time in UTC so
+	// column defaults match the timestamps written by the repositories
+	cfg.ParseTime = true
+	cfg.Loc = time.UTC
+	cfg.Params = map[string]string{"time_zone": "'+00:00'"}
+
+	db, err := sql.Open(database.DBMySQL, cfg.FormatDSN())
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Ping()
+	if err != nil {
+		return nil, err
+	}
+
+	database.DB.SQL = db
+	database.DB.SQL.SetMaxOpenConns(2)
+	database.DB.SQL.SetMaxIdleConns(1)
+	database.DB.SQL.SetConnMaxLifetime(time.Hour)
+
+	return database.DB, nil
+}
+
+// tlsMode converts the postgres style sslmode setting shared by all drivers
+// to the MySQL driver tls setting
+func tlsMode(sslMode string) string {
+	switch sslMode {
+	case "", "disable":
+		return "false"
+	case "require":
+		return "skip-verify"
+	case "verify-ca", "verify-full":
+		return "true"
+	}
+	return sslMode
+}
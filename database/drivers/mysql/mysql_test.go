@@ -0,0 +1,18 @@
+package mysql
+
+import "testing"
+
+func TestTLSMode(t *testing.T) {
+	t.Parallel()
+	for in, expected := range map[string]string{
+		"":            "false",
+		"disable":     "false",
+		"require":     "skip-verify",
+		"verify-full": "true",
+		"preferred":   "preferred",
+	} {
+		if out := tlsMode(in); out != expected {
+			t.Errorf("tlsMode(%q): expected %s, received %s", in, expected, out)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS withdrawal_history
+(
+    id char(36)      PRIMARY KEY NOT NULL,
+    exchange         varchar(255) NOT NULL,
+    exchange_id      varchar(255) NOT NULL,
+    status           varchar(255)  NOT NULL,
+    currency         varchar(255) NOT NULL,
+    amount           DOUBLE NOT NULL,
+    description      text   NULL,
+    withdraw_type    integer  NOT NULL,
+    created_at       DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    updated_at       DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS  withdrawal_history;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS script_execution
+(
+    id char(36)      PRIMARY KEY NOT NULL,
+    script_id char(36) NULL,
+    execution_type varchar(255) NOT NULL,
+    execution_status varchar(255) NOT NULL,
+    execution_time DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    FOREIGN KEY (script_id) REFERENCES script(id) ON DELETE CASCADE
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE script_execution;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS request_journal
+(
+    id bigint AUTO_INCREMENT PRIMARY KEY NOT NULL,
+    exchange         varchar(255) NOT NULL,
+    method           varchar(16) NOT NULL,
+    path             text NOT NULL,
+    query            text NOT NULL,
+    body             mediumtext NOT NULL,
+    status_code      integer NOT NULL,
+    latency_ms       DOUBLE NOT NULL,
+    error            text NULL,
+    created_at       DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    INDEX request_journal_exchange_created_at (exchange, created_at)
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS request_journal;
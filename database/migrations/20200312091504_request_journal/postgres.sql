@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS request_journal
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    exchange         text NOT NULL,
+    method           varchar(16) NOT NULL,
+    path             text NOT NULL,
+    query            text NOT NULL,
+    body             text NOT NULL,
+    status_code      integer NOT NULL,
+    latency_ms       DOUBLE PRECISION NOT NULL,
+    error            text NULL,
+    created_at       TIMESTAMP NOT NULL DEFAULT (now() at time zone 'utc')
+);
+CREATE INDEX IF NOT EXISTS request_journal_exchange_created_at ON request_journal (exchange, created_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS request_journal;
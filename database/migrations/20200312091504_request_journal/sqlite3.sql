@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "request_journal"
+(
+    id               integer not null primary key,
+    exchange         text not null,
+    method           text not null,
+    path             text not null,
+    query            text not null,
+    body             text not null,
+    status_code      integer not null,
+    latency_ms       real not null,
+    error            text null,
+    created_at       timestamp not null default CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS request_journal_exchange_created_at ON request_journal (exchange, created_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS request_journal;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "nonce"
+(
+    exchange         text not null primary key,
+    value            integer not null,
+    updated_at       timestamp not null default CURRENT_TIMESTAMP
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS nonce;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE audit_event ADD COLUMN prev_hash varchar(64) NOT NULL DEFAULT '';
+ALTER TABLE audit_event ADD COLUMN hash varchar(64) NOT NULL DEFAULT '';
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE audit_event DROP COLUMN prev_hash;
+ALTER TABLE audit_event DROP COLUMN hash;
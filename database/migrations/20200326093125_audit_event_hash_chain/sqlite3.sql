@@ -0,0 +1,16 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE audit_event ADD COLUMN prev_hash text not null default '';
+ALTER TABLE audit_event ADD COLUMN hash text not null default '';
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+CREATE TABLE audit_event_temp (
+    id	        integer not null primary key,
+    type    	text not null,
+    identifier	text not null,
+    message	    text not null,
+    created_at  timestamp not null default CURRENT_TIMESTAMP
+);
+INSERT INTO audit_event_temp SELECT id, type, identifier, message, created_at FROM audit_event;
+DROP TABLE audit_event;
+ALTER TABLE audit_event_temp RENAME TO audit_event;
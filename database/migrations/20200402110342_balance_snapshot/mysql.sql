@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS balance_snapshot
+(
+    id bigint AUTO_INCREMENT PRIMARY KEY NOT NULL,
+    exchange         varchar(255) NOT NULL,
+    account          varchar(255) NOT NULL,
+    currency         varchar(255) NOT NULL,
+    total            DOUBLE NOT NULL,
+    hold             DOUBLE NOT NULL,
+    fiat_currency    varchar(255) NOT NULL,
+    fiat_value       DOUBLE NULL,
+    created_at       DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    INDEX balance_snapshot_exchange_created_at (exchange, created_at),
+    INDEX balance_snapshot_created_at (created_at)
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS balance_snapshot;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS balance_snapshot
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    exchange         text NOT NULL,
+    account          text NOT NULL,
+    currency         text NOT NULL,
+    total            DOUBLE PRECISION NOT NULL,
+    hold             DOUBLE PRECISION NOT NULL,
+    fiat_currency    text NOT NULL,
+    fiat_value       DOUBLE PRECISION NULL,
+    created_at       TIMESTAMP NOT NULL DEFAULT (now() at time zone 'utc')
+);
+CREATE INDEX IF NOT EXISTS balance_snapshot_exchange_created_at ON balance_snapshot (exchange, created_at);
+CREATE INDEX IF NOT EXISTS balance_snapshot_created_at ON balance_snapshot (created_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS balance_snapshot;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "balance_snapshot"
+(
+    id               integer not null primary key,
+    exchange         text not null,
+    account          text not null,
+    currency         text not null,
+    total            real not null,
+    hold             real not null,
+    fiat_currency    text not null,
+    fiat_value       real null,
+    created_at       timestamp not null default CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS balance_snapshot_exchange_created_at ON balance_snapshot (exchange, created_at);
+CREATE INDEX IF NOT EXISTS balance_snapshot_created_at ON balance_snapshot (created_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS balance_snapshot;
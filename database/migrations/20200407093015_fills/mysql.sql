@@ -0,0 +1,25 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS fills
+(
+    id bigint AUTO_INCREMENT PRIMARY KEY NOT NULL,
+    exchange         varchar(255) NOT NULL,
+    asset            varchar(255) NOT NULL,
+    pair             varchar(255) NOT NULL,
+    order_id         varchar(255) NOT NULL,
+    tid              varchar(255) NOT NULL,
+    side             varchar(255) NOT NULL,
+    type             varchar(255) NOT NULL,
+    price            DOUBLE NOT NULL,
+    amount           DOUBLE NOT NULL,
+    fee              DOUBLE NOT NULL,
+    fee_currency     varchar(255) NOT NULL,
+    is_maker         boolean NOT NULL DEFAULT false,
+    traded_at        DATETIME(6) NOT NULL,
+    created_at       DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    CONSTRAINT fills_exchange_tid UNIQUE (exchange, tid),
+    INDEX fills_exchange_traded_at (exchange, traded_at)
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS fills;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS fills
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    exchange         text NOT NULL,
+    asset            text NOT NULL,
+    pair             text NOT NULL,
+    order_id         text NOT NULL,
+    tid              text NOT NULL,
+    side             text NOT NULL,
+    type             text NOT NULL,
+    price            DOUBLE PRECISION NOT NULL,
+    amount           DOUBLE PRECISION NOT NULL,
+    fee              DOUBLE PRECISION NOT NULL,
+    fee_currency     text NOT NULL,
+    is_maker         boolean NOT NULL DEFAULT false,
+    traded_at        TIMESTAMP NOT NULL,
+    created_at       TIMESTAMP NOT NULL DEFAULT (now() at time zone 'utc'),
+    CONSTRAINT fills_exchange_tid UNIQUE (exchange, tid)
+);
+CREATE INDEX IF NOT EXISTS fills_exchange_traded_at ON fills (exchange, traded_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS fills;
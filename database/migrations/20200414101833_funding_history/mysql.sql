@@ -0,0 +1,29 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS funding_history
+(
+    id bigint AUTO_INCREMENT PRIMARY KEY NOT NULL,
+    exchange                 varchar(255) NOT NULL,
+    transfer_id              varchar(255) NOT NULL,
+    transfer_type            varchar(255) NOT NULL,
+    status                   varchar(255) NOT NULL,
+    currency                 varchar(255) NOT NULL,
+    amount                   DOUBLE NOT NULL,
+    fee                      DOUBLE NOT NULL,
+    description              varchar(1024) NOT NULL DEFAULT '',
+    crypto_tx_id             varchar(255) NOT NULL DEFAULT '',
+    crypto_to_address        varchar(255) NOT NULL DEFAULT '',
+    crypto_from_address      varchar(255) NOT NULL DEFAULT '',
+    bank_to                  varchar(255) NOT NULL DEFAULT '',
+    bank_from                varchar(255) NOT NULL DEFAULT '',
+    withdrawal_history_id    char(36) NULL,
+    transferred_at           DATETIME(6) NOT NULL,
+    created_at               DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    updated_at               DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    CONSTRAINT funding_history_exchange_transfer_id UNIQUE (exchange, transfer_id),
+    INDEX funding_history_exchange_transferred_at (exchange, transferred_at),
+    FOREIGN KEY (withdrawal_history_id) REFERENCES withdrawal_history(id) ON DELETE SET NULL
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS funding_history;
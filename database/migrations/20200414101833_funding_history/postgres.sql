@@ -0,0 +1,28 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS funding_history
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    exchange                 text NOT NULL,
+    transfer_id              text NOT NULL,
+    transfer_type            text NOT NULL,
+    status                   text NOT NULL,
+    currency                 text NOT NULL,
+    amount                   DOUBLE PRECISION NOT NULL,
+    fee                      DOUBLE PRECISION NOT NULL,
+    description              text NOT NULL DEFAULT '',
+    crypto_tx_id             text NOT NULL DEFAULT '',
+    crypto_to_address        text NOT NULL DEFAULT '',
+    crypto_from_address      text NOT NULL DEFAULT '',
+    bank_to                  text NOT NULL DEFAULT '',
+    bank_from                text NOT NULL DEFAULT '',
+    withdrawal_history_id    uuid NULL REFERENCES withdrawal_history(id) ON DELETE SET NULL,
+    transferred_at           TIMESTAMP NOT NULL,
+    created_at               TIMESTAMP NOT NULL DEFAULT (now() at time zone 'utc'),
+    updated_at               TIMESTAMP NOT NULL DEFAULT (now() at time zone 'utc'),
+    CONSTRAINT funding_history_exchange_transfer_id UNIQUE (exchange, transfer_id)
+);
+CREATE INDEX IF NOT EXISTS funding_history_exchange_transferred_at ON funding_history (exchange, transferred_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS funding_history;
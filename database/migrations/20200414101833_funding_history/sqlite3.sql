@@ -0,0 +1,29 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "funding_history"
+(
+    id                       integer not null primary key,
+    exchange                 text not null,
+    transfer_id              text not null,
+    transfer_type            text not null,
+    status                   text not null,
+    currency                 text not null,
+    amount                   real not null,
+    fee                      real not null,
+    description              text not null default '',
+    crypto_tx_id             text not null default '',
+    crypto_to_address        text not null default '',
+    crypto_from_address      text not null default '',
+    bank_to                  text not null default '',
+    bank_from                text not null default '',
+    withdrawal_history_id    text null,
+    transferred_at           timestamp not null,
+    created_at               timestamp not null default CURRENT_TIMESTAMP,
+    updated_at               timestamp not null default CURRENT_TIMESTAMP,
+    unique (exchange, transfer_id),
+    FOREIGN KEY(withdrawal_history_id) REFERENCES withdrawal_history(id) ON DELETE SET NULL
+);
+CREATE INDEX IF NOT EXISTS funding_history_exchange_transferred_at ON funding_history (exchange, transferred_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS funding_history;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE withdrawal_fiat RENAME COLUMN withdrawal_fiat_id TO withdrawal_history_id;
+ALTER TABLE withdrawal_crypto RENAME COLUMN withdrawal_crypto_id TO withdrawal_history_id;
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE withdrawal_fiat RENAME COLUMN withdrawal_history_id TO withdrawal_fiat_id;
+ALTER TABLE withdrawal_crypto RENAME COLUMN withdrawal_history_id TO withdrawal_crypto_id;
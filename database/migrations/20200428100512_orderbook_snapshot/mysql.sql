@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS orderbook_snapshot
+(
+    id bigint AUTO_INCREMENT PRIMARY KEY NOT NULL,
+    exchange         varchar(255) NOT NULL,
+    asset            varchar(255) NOT NULL,
+    pair             varchar(255) NOT NULL,
+    last_update_id   bigint NOT NULL,
+    bids             longtext NOT NULL,
+    asks             longtext NOT NULL,
+    created_at       DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    INDEX orderbook_snapshot_book_created_at (exchange, asset, pair, created_at)
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS orderbook_snapshot;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS orderbook_snapshot
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    exchange         text NOT NULL,
+    asset            text NOT NULL,
+    pair             text NOT NULL,
+    last_update_id   bigint NOT NULL,
+    bids             text NOT NULL,
+    asks             text NOT NULL,
+    created_at       TIMESTAMP NOT NULL DEFAULT (now() at time zone 'utc')
+);
+CREATE INDEX IF NOT EXISTS orderbook_snapshot_book_created_at ON orderbook_snapshot (exchange, asset, pair, created_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS orderbook_snapshot;
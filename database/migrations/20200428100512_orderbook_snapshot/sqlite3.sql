@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "orderbook_snapshot"
+(
+    id               integer not null primary key,
+    exchange         text not null,
+    asset            text not null,
+    pair             text not null,
+    last_update_id   integer not null,
+    bids             text not null,
+    asks             text not null,
+    created_at       timestamp not null default CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS orderbook_snapshot_book_created_at ON orderbook_snapshot (exchange, asset, pair, created_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS orderbook_snapshot;
@@ -0,0 +1,42 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS ticker_history
+(
+    id bigint AUTO_INCREMENT PRIMARY KEY NOT NULL,
+    exchange         varchar(255) NOT NULL,
+    asset            varchar(255) NOT NULL,
+    pair             varchar(255) NOT NULL,
+    last             DOUBLE NOT NULL,
+    high             DOUBLE NOT NULL,
+    low              DOUBLE NOT NULL,
+    bid              DOUBLE NOT NULL,
+    ask              DOUBLE NOT NULL,
+    volume           DOUBLE NOT NULL,
+    quote_volume     DOUBLE NOT NULL,
+    created_at       DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    INDEX ticker_history_pair_created_at (exchange, asset, pair, created_at),
+    INDEX ticker_history_created_at (created_at)
+);
+
+CREATE TABLE IF NOT EXISTS ticker_history_minute
+(
+    id bigint AUTO_INCREMENT PRIMARY KEY NOT NULL,
+    exchange         varchar(255) NOT NULL,
+    asset            varchar(255) NOT NULL,
+    pair             varchar(255) NOT NULL,
+    open             DOUBLE NOT NULL,
+    high             DOUBLE NOT NULL,
+    low              DOUBLE NOT NULL,
+    close            DOUBLE NOT NULL,
+    bid              DOUBLE NOT NULL,
+    ask              DOUBLE NOT NULL,
+    volume           DOUBLE NOT NULL,
+    ticks            bigint NOT NULL,
+    started_at       DATETIME NOT NULL,
+    UNIQUE KEY ticker_history_minute_pair_started_at (exchange, asset, pair, started_at),
+    INDEX ticker_history_minute_started_at (started_at)
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS ticker_history_minute;
+DROP TABLE IF EXISTS ticker_history;
@@ -0,0 +1,42 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS ticker_history
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    exchange         text NOT NULL,
+    asset            text NOT NULL,
+    pair             text NOT NULL,
+    last             DOUBLE PRECISION NOT NULL,
+    high             DOUBLE PRECISION NOT NULL,
+    low              DOUBLE PRECISION NOT NULL,
+    bid              DOUBLE PRECISION NOT NULL,
+    ask              DOUBLE PRECISION NOT NULL,
+    volume           DOUBLE PRECISION NOT NULL,
+    quote_volume     DOUBLE PRECISION NOT NULL,
+    created_at       TIMESTAMP NOT NULL DEFAULT (now() at time zone 'utc')
+);
+CREATE INDEX IF NOT EXISTS ticker_history_pair_created_at ON ticker_history (exchange, asset, pair, created_at);
+CREATE INDEX IF NOT EXISTS ticker_history_created_at ON ticker_history (created_at);
+
+CREATE TABLE IF NOT EXISTS ticker_history_minute
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    exchange         text NOT NULL,
+    asset            text NOT NULL,
+    pair             text NOT NULL,
+    open             DOUBLE PRECISION NOT NULL,
+    high             DOUBLE PRECISION NOT NULL,
+    low              DOUBLE PRECISION NOT NULL,
+    close            DOUBLE PRECISION NOT NULL,
+    bid              DOUBLE PRECISION NOT NULL,
+    ask              DOUBLE PRECISION NOT NULL,
+    volume           DOUBLE PRECISION NOT NULL,
+    ticks            bigint NOT NULL,
+    started_at       TIMESTAMP NOT NULL,
+    CONSTRAINT ticker_history_minute_pair_started_at UNIQUE (exchange, asset, pair, started_at)
+);
+CREATE INDEX IF NOT EXISTS ticker_history_minute_started_at ON ticker_history_minute (started_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS ticker_history_minute;
+DROP TABLE IF EXISTS ticker_history;
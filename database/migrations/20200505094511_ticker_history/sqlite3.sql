@@ -0,0 +1,42 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "ticker_history"
+(
+    id               integer not null primary key,
+    exchange         text not null,
+    asset            text not null,
+    pair             text not null,
+    last             real not null,
+    high             real not null,
+    low              real not null,
+    bid              real not null,
+    ask              real not null,
+    volume           real not null,
+    quote_volume     real not null,
+    created_at       timestamp not null default CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS ticker_history_pair_created_at ON ticker_history (exchange, asset, pair, created_at);
+CREATE INDEX IF NOT EXISTS ticker_history_created_at ON ticker_history (created_at);
+
+CREATE TABLE IF NOT EXISTS "ticker_history_minute"
+(
+    id               integer not null primary key,
+    exchange         text not null,
+    asset            text not null,
+    pair             text not null,
+    open             real not null,
+    high             real not null,
+    low              real not null,
+    close            real not null,
+    bid              real not null,
+    ask              real not null,
+    volume           real not null,
+    ticks            integer not null,
+    started_at       timestamp not null,
+    UNIQUE (exchange, asset, pair, started_at)
+);
+CREATE INDEX IF NOT EXISTS ticker_history_minute_started_at ON ticker_history_minute (started_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS ticker_history_minute;
+DROP TABLE IF EXISTS ticker_history;
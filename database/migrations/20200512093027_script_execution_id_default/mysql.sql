@@ -0,0 +1,6 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE script_execution MODIFY id char(36) NOT NULL DEFAULT (uuid());
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE script_execution MODIFY id char(36) NOT NULL;
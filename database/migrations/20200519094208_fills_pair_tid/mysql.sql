@@ -0,0 +1,15 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- asset and pair are shortened to keep the key within the index size limit
+ALTER TABLE fills
+    MODIFY asset varchar(64) NOT NULL,
+    MODIFY pair varchar(128) NOT NULL,
+    DROP INDEX fills_exchange_tid,
+    ADD CONSTRAINT fills_exchange_asset_pair_tid UNIQUE (exchange, asset, pair, tid);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE fills
+    DROP INDEX fills_exchange_asset_pair_tid,
+    ADD CONSTRAINT fills_exchange_tid UNIQUE (exchange, tid),
+    MODIFY asset varchar(255) NOT NULL,
+    MODIFY pair varchar(255) NOT NULL;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE fills DROP CONSTRAINT fills_exchange_tid;
+ALTER TABLE fills ADD CONSTRAINT fills_exchange_asset_pair_tid UNIQUE (exchange, asset, pair, tid);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE fills DROP CONSTRAINT fills_exchange_asset_pair_tid;
+ALTER TABLE fills ADD CONSTRAINT fills_exchange_tid UNIQUE (exchange, tid);
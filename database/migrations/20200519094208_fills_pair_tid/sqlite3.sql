@@ -0,0 +1,50 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE "fills_new"
+(
+    id               integer not null primary key,
+    exchange         text not null,
+    asset            text not null,
+    pair             text not null,
+    order_id         text not null,
+    tid              text not null,
+    side             text not null,
+    type             text not null,
+    price            real not null,
+    amount           real not null,
+    fee              real not null,
+    fee_currency     text not null,
+    is_maker         boolean not null default false,
+    traded_at        timestamp not null,
+    created_at       timestamp not null default CURRENT_TIMESTAMP,
+    unique (exchange, asset, pair, tid)
+);
+INSERT INTO fills_new SELECT * FROM fills;
+DROP TABLE fills;
+ALTER TABLE fills_new RENAME TO fills;
+CREATE INDEX IF NOT EXISTS fills_exchange_traded_at ON fills (exchange, traded_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+CREATE TABLE "fills_old"
+(
+    id               integer not null primary key,
+    exchange         text not null,
+    asset            text not null,
+    pair             text not null,
+    order_id         text not null,
+    tid              text not null,
+    side             text not null,
+    type             text not null,
+    price            real not null,
+    amount           real not null,
+    fee              real not null,
+    fee_currency     text not null,
+    is_maker         boolean not null default false,
+    traded_at        timestamp not null,
+    created_at       timestamp not null default CURRENT_TIMESTAMP,
+    unique (exchange, tid)
+);
+INSERT OR IGNORE INTO fills_old SELECT * FROM fills;
+DROP TABLE fills;
+ALTER TABLE fills_old RENAME TO fills;
+CREATE INDEX IF NOT EXISTS fills_exchange_traded_at ON fills (exchange, traded_at);
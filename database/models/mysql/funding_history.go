@@ -0,0 +1,1230 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// FundingHistory is an object representing the database table.
+type FundingHistory struct {
+	ID                  int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Exchange            string      `boil:"exchange" json:"exchange" toml:"exchange" yaml:"exchange"`
+	TransferID          string      `boil:"transfer_id" json:"transfer_id" toml:"transfer_id" yaml:"transfer_id"`
+	TransferType        string      `boil:"transfer_type" json:"transfer_type" toml:"transfer_type" yaml:"transfer_type"`
+	Status              string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Currency            string      `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	Amount              float64     `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Fee                 float64     `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	Description         string      `boil:"description" json:"description" toml:"description" yaml:"description"`
+	CryptoTXID          string      `boil:"crypto_tx_id" json:"crypto_tx_id" toml:"crypto_tx_id" yaml:"crypto_tx_id"`
+	CryptoToAddress     string      `boil:"crypto_to_address" json:"crypto_to_address" toml:"crypto_to_address" yaml:"crypto_to_address"`
+	CryptoFromAddress   string      `boil:"crypto_from_address" json:"crypto_from_address" toml:"crypto_from_address" yaml:"crypto_from_address"`
+	BankTo              string      `boil:"bank_to" json:"bank_to" toml:"bank_to" yaml:"bank_to"`
+	BankFrom            string      `boil:"bank_from" json:"bank_from" toml:"bank_from" yaml:"bank_from"`
+	WithdrawalHistoryID null.String `boil:"withdrawal_history_id" json:"withdrawal_history_id,omitempty" toml:"withdrawal_history_id" yaml:"withdrawal_history_id,omitempty"`
+	TransferredAt       time.Time   `boil:"transferred_at" json:"transferred_at" toml:"transferred_at" yaml:"transferred_at"`
+	CreatedAt           time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt           time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *fundingHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L fundingHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FundingHistoryColumns = struct {
+	ID                  string
+	Exchange            string
+	TransferID          string
+	TransferType        string
+	Status              string
+	Currency            string
+	Amount              string
+	Fee                 string
+	Description         string
+	CryptoTXID          string
+	CryptoToAddress     string
+	CryptoFromAddress   string
+	BankTo              string
+	BankFrom            string
+	WithdrawalHistoryID string
+	TransferredAt       string
+	CreatedAt           string
+	UpdatedAt           string
+}{
+	ID:                  "id",
+	Exchange:            "exchange",
+	TransferID:          "transfer_id",
+	TransferType:        "transfer_type",
+	Status:              "status",
+	Currency:            "currency",
+	Amount:              "amount",
+	Fee:                 "fee",
+	Description:         "description",
+	CryptoTXID:          "crypto_tx_id",
+	CryptoToAddress:     "crypto_to_address",
+	CryptoFromAddress:   "crypto_from_address",
+	BankTo:              "bank_to",
+	BankFrom:            "bank_from",
+	WithdrawalHistoryID: "withdrawal_history_id",
+	TransferredAt:       "transferred_at",
+	CreatedAt:           "created_at",
+	UpdatedAt:           "updated_at",
+}
+
+// Generated where
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var FundingHistoryWhere = struct {
+	ID                  whereHelperint64
+	Exchange            whereHelperstring
+	TransferID          whereHelperstring
+	TransferType        whereHelperstring
+	Status              whereHelperstring
+	Currency            whereHelperstring
+	Amount              whereHelperfloat64
+	Fee                 whereHelperfloat64
+	Description         whereHelperstring
+	CryptoTXID          whereHelperstring
+	CryptoToAddress     whereHelperstring
+	CryptoFromAddress   whereHelperstring
+	BankTo              whereHelperstring
+	BankFrom            whereHelperstring
+	WithdrawalHistoryID whereHelpernull_String
+	TransferredAt       whereHelpertime_Time
+	CreatedAt           whereHelpertime_Time
+	UpdatedAt           whereHelpertime_Time
+}{
+	ID:                  whereHelperint64{field: "`funding_history`.`id`"},
+	Exchange:            whereHelperstring{field: "`funding_history`.`exchange`"},
+	TransferID:          whereHelperstring{field: "`funding_history`.`transfer_id`"},
+	TransferType:        whereHelperstring{field: "`funding_history`.`transfer_type`"},
+	Status:              whereHelperstring{field: "`funding_history`.`status`"},
+	Currency:            whereHelperstring{field: "`funding_history`.`currency`"},
+	Amount:              whereHelperfloat64{field: "`funding_history`.`amount`"},
+	Fee:                 whereHelperfloat64{field: "`funding_history`.`fee`"},
+	Description:         whereHelperstring{field: "`funding_history`.`description`"},
+	CryptoTXID:          whereHelperstring{field: "`funding_history`.`crypto_tx_id`"},
+	CryptoToAddress:     whereHelperstring{field: "`funding_history`.`crypto_to_address`"},
+	CryptoFromAddress:   whereHelperstring{field: "`funding_history`.`crypto_from_address`"},
+	BankTo:              whereHelperstring{field: "`funding_history`.`bank_to`"},
+	BankFrom:            whereHelperstring{field: "`funding_history`.`bank_from`"},
+	WithdrawalHistoryID: whereHelpernull_String{field: "`funding_history`.`withdrawal_history_id`"},
+	TransferredAt:       whereHelpertime_Time{field: "`funding_history`.`transferred_at`"},
+	CreatedAt:           whereHelpertime_Time{field: "`funding_history`.`created_at`"},
+	UpdatedAt:           whereHelpertime_Time{field: "`funding_history`.`updated_at`"},
+}
+
+// FundingHistoryRels is where relationship names are stored.
+var FundingHistoryRels = struct {
+	WithdrawalHistory string
+}{
+	WithdrawalHistory: "WithdrawalHistory",
+}
+
+// fundingHistoryR is where relationships are stored.
+type fundingHistoryR struct {
+	WithdrawalHistory *WithdrawalHistory
+}
+
+// NewStruct creates a new relationship struct
+func (*fundingHistoryR) NewStruct() *fundingHistoryR {
+	return &fundingHistoryR{}
+}
+
+// fundingHistoryL is where Load methods for each relationship are stored.
+type fundingHistoryL struct{}
+
+var (
+	fundingHistoryAllColumns            = []string{"id", "exchange", "transfer_id", "transfer_type", "status", "currency", "amount", "fee", "description", "crypto_tx_id", "crypto_to_address", "crypto_from_address", "bank_to", "bank_from", "withdrawal_history_id", "transferred_at", "created_at", "updated_at"}
+	fundingHistoryColumnsWithoutDefault = []string{"exchange", "transfer_id", "transfer_type", "status", "currency", "amount", "fee", "description", "crypto_tx_id", "crypto_to_address", "crypto_from_address", "bank_to", "bank_from", "withdrawal_history_id", "transferred_at"}
+	fundingHistoryColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	fundingHistoryPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// FundingHistorySlice is an alias for a slice of pointers to FundingHistory.
+	// This should generally be used opposed to []FundingHistory.
+	FundingHistorySlice []*FundingHistory
+	// FundingHistoryHook is the signature for custom FundingHistory hook methods
+	FundingHistoryHook func(context.Context, boil.ContextExecutor, *FundingHistory) error
+
+	fundingHistoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	fundingHistoryType                 = reflect.TypeOf(&FundingHistory{})
+	fundingHistoryMapping              = queries.MakeStructMapping(fundingHistoryType)
+	fundingHistoryPrimaryKeyMapping, _ = queries.BindMapping(fundingHistoryType, fundingHistoryMapping, fundingHistoryPrimaryKeyColumns)
+	fundingHistoryInsertCacheMut       sync.RWMutex
+	fundingHistoryInsertCache          = make(map[string]insertCache)
+	fundingHistoryUpdateCacheMut       sync.RWMutex
+	fundingHistoryUpdateCache          = make(map[string]updateCache)
+	fundingHistoryUpsertCacheMut       sync.RWMutex
+	fundingHistoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var fundingHistoryBeforeInsertHooks []FundingHistoryHook
+var fundingHistoryBeforeUpdateHooks []FundingHistoryHook
+var fundingHistoryBeforeDeleteHooks []FundingHistoryHook
+var fundingHistoryBeforeUpsertHooks []FundingHistoryHook
+
+var fundingHistoryAfterInsertHooks []FundingHistoryHook
+var fundingHistoryAfterSelectHooks []FundingHistoryHook
+var fundingHistoryAfterUpdateHooks []FundingHistoryHook
+var fundingHistoryAfterDeleteHooks []FundingHistoryHook
+var fundingHistoryAfterUpsertHooks []FundingHistoryHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *FundingHistory) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingHistoryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *FundingHistory) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingHistoryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *FundingHistory) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingHistoryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *FundingHistory) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingHistoryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *FundingHistory) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingHistoryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *FundingHistory) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingHistoryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *FundingHistory) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingHistoryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *FundingHistory) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingHistoryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *FundingHistory) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingHistoryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFundingHistoryHook registers your hook function for all future operations.
+func AddFundingHistoryHook(hookPoint boil.HookPoint, fundingHistoryHook FundingHistoryHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		fundingHistoryBeforeInsertHooks = append(fundingHistoryBeforeInsertHooks, fundingHistoryHook)
+	case boil.BeforeUpdateHook:
+		fundingHistoryBeforeUpdateHooks = append(fundingHistoryBeforeUpdateHooks, fundingHistoryHook)
+	case boil.BeforeDeleteHook:
+		fundingHistoryBeforeDeleteHooks = append(fundingHistoryBeforeDeleteHooks, fundingHistoryHook)
+	case boil.BeforeUpsertHook:
+		fundingHistoryBeforeUpsertHooks = append(fundingHistoryBeforeUpsertHooks, fundingHistoryHook)
+	case boil.AfterInsertHook:
+		fundingHistoryAfterInsertHooks = append(fundingHistoryAfterInsertHooks, fundingHistoryHook)
+	case boil.AfterSelectHook:
+		fundingHistoryAfterSelectHooks = append(fundingHistoryAfterSelectHooks, fundingHistoryHook)
+	case boil.AfterUpdateHook:
+		fundingHistoryAfterUpdateHooks = append(fundingHistoryAfterUpdateHooks, fundingHistoryHook)
+	case boil.AfterDeleteHook:
+		fundingHistoryAfterDeleteHooks = append(fundingHistoryAfterDeleteHooks, fundingHistoryHook)
+	case boil.AfterUpsertHook:
+		fundingHistoryAfterUpsertHooks = append(fundingHistoryAfterUpsertHooks, fundingHistoryHook)
+	}
+}
+
+// One returns a single fundingHistory record from the query.
+func (q fundingHistoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*FundingHistory, error) {
+	o := &FundingHistory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "mysql: failed to execute a one query for funding_history")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all FundingHistory records from the query.
+func (q fundingHistoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (FundingHistorySlice, error) {
+	var o []*FundingHistory
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "mysql: failed to assign all query results to FundingHistory slice")
+	}
+
+	if len(fundingHistoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all FundingHistory records in the query.
+func (q fundingHistoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: failed to count funding_history rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q fundingHistoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "mysql: failed to check if funding_history exists")
+	}
+
+	return count > 0, nil
+}
+
+// WithdrawalHistory pointed to by the foreign key.
+func (o *FundingHistory) WithdrawalHistory(mods ...qm.QueryMod) withdrawalHistoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.WithdrawalHistoryID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := WithdrawalHistories(queryMods...)
+	queries.SetFrom(query.Query, "`withdrawal_history`")
+
+	return query
+}
+
+// LoadWithdrawalHistory allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (fundingHistoryL) LoadWithdrawalHistory(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFundingHistory interface{}, mods queries.Applicator) error {
+	var slice []*FundingHistory
+	var object *FundingHistory
+
+	if singular {
+		object = maybeFundingHistory.(*FundingHistory)
+	} else {
+		slice = *maybeFundingHistory.(*[]*FundingHistory)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &fundingHistoryR{}
+		}
+		if !queries.IsNil(object.WithdrawalHistoryID) {
+			args = append(args, object.WithdrawalHistoryID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &fundingHistoryR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.WithdrawalHistoryID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.WithdrawalHistoryID) {
+				args = append(args, obj.WithdrawalHistoryID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`withdrawal_history`), qm.WhereIn(`withdrawal_history.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load WithdrawalHistory")
+	}
+
+	var resultSlice []*WithdrawalHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice WithdrawalHistory")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for withdrawal_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for withdrawal_history")
+	}
+
+	if len(fundingHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.WithdrawalHistory = foreign
+		if foreign.R == nil {
+			foreign.R = &withdrawalHistoryR{}
+		}
+		foreign.R.FundingHistories = append(foreign.R.FundingHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.WithdrawalHistoryID, foreign.ID) {
+				local.R.WithdrawalHistory = foreign
+				if foreign.R == nil {
+					foreign.R = &withdrawalHistoryR{}
+				}
+				foreign.R.FundingHistories = append(foreign.R.FundingHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWithdrawalHistory of the fundingHistory to the related item.
+// Sets o.R.WithdrawalHistory to related.
+// Adds o to related.R.FundingHistories.
+func (o *FundingHistory) SetWithdrawalHistory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *WithdrawalHistory) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `funding_history` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"withdrawal_history_id"}),
+		strmangle.WhereClause("`", "`", 0, fundingHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.WithdrawalHistoryID, related.ID)
+	if o.R == nil {
+		o.R = &fundingHistoryR{
+			WithdrawalHistory: related,
+		}
+	} else {
+		o.R.WithdrawalHistory = related
+	}
+
+	if related.R == nil {
+		related.R = &withdrawalHistoryR{
+			FundingHistories: FundingHistorySlice{o},
+		}
+	} else {
+		related.R.FundingHistories = append(related.R.FundingHistories, o)
+	}
+
+	return nil
+}
+
+// RemoveWithdrawalHistory relationship.
+// Sets o.R.WithdrawalHistory to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *FundingHistory) RemoveWithdrawalHistory(ctx context.Context, exec boil.ContextExecutor, related *WithdrawalHistory) error {
+	var err error
+
+	queries.SetScanner(&o.WithdrawalHistoryID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("withdrawal_history_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.R.WithdrawalHistory = nil
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.FundingHistories {
+		if queries.Equal(o.WithdrawalHistoryID, ri.WithdrawalHistoryID) {
+			continue
+		}
+
+		ln := len(related.R.FundingHistories)
+		if ln > 1 && i < ln-1 {
+			related.R.FundingHistories[i] = related.R.FundingHistories[ln-1]
+		}
+		related.R.FundingHistories = related.R.FundingHistories[:ln-1]
+		break
+	}
+	return nil
+}
+
+// FundingHistories retrieves all the records using an executor.
+func FundingHistories(mods ...qm.QueryMod) fundingHistoryQuery {
+	mods = append(mods, qm.From("`funding_history`"))
+	return fundingHistoryQuery{NewQuery(mods...)}
+}
+
+// FindFundingHistory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFundingHistory(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*FundingHistory, error) {
+	fundingHistoryObj := &FundingHistory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `funding_history` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, fundingHistoryObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "mysql: unable to select from funding_history")
+	}
+
+	return fundingHistoryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *FundingHistory) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("mysql: no funding_history provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(fundingHistoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	fundingHistoryInsertCacheMut.RLock()
+	cache, cached := fundingHistoryInsertCache[key]
+	fundingHistoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			fundingHistoryAllColumns,
+			fundingHistoryColumnsWithDefault,
+			fundingHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(fundingHistoryType, fundingHistoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(fundingHistoryType, fundingHistoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `funding_history` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `funding_history` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `funding_history` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, fundingHistoryPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "mysql: unable to insert into funding_history")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == fundingHistoryMapping["ID"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "mysql: unable to populate default values for funding_history")
+	}
+
+CacheNoHooks:
+	if !cached {
+		fundingHistoryInsertCacheMut.Lock()
+		fundingHistoryInsertCache[key] = cache
+		fundingHistoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the FundingHistory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *FundingHistory) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	fundingHistoryUpdateCacheMut.RLock()
+	cache, cached := fundingHistoryUpdateCache[key]
+	fundingHistoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			fundingHistoryAllColumns,
+			fundingHistoryPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("mysql: unable to update funding_history, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `funding_history` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, fundingHistoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(fundingHistoryType, fundingHistoryMapping, append(wl, fundingHistoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: unable to update funding_history row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: failed to get rows affected by update for funding_history")
+	}
+
+	if !cached {
+		fundingHistoryUpdateCacheMut.Lock()
+		fundingHistoryUpdateCache[key] = cache
+		fundingHistoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q fundingHistoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: unable to update all for funding_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: unable to retrieve rows affected for funding_history")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FundingHistorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("mysql: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fundingHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `funding_history` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, fundingHistoryPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: unable to update all in fundingHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: unable to retrieve rows affected all in update all fundingHistory")
+	}
+	return rowsAff, nil
+}
+
+var mySQLFundingHistoryUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *FundingHistory) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("mysql: no funding_history provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(fundingHistoryColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLFundingHistoryUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	fundingHistoryUpsertCacheMut.RLock()
+	cache, cached := fundingHistoryUpsertCache[key]
+	fundingHistoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			fundingHistoryAllColumns,
+			fundingHistoryColumnsWithDefault,
+			fundingHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			fundingHistoryAllColumns,
+			fundingHistoryPrimaryKeyColumns,
+		)
+
+		if len(update) == 0 {
+			return errors.New("mysql: unable to upsert funding_history, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "funding_history", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `funding_history` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(fundingHistoryType, fundingHistoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(fundingHistoryType, fundingHistoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "mysql: unable to upsert for funding_history")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == fundingHistoryMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(fundingHistoryType, fundingHistoryMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "mysql: unable to retrieve unique values for funding_history")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, nzUniqueCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "mysql: unable to populate default values for funding_history")
+	}
+
+CacheNoHooks:
+	if !cached {
+		fundingHistoryUpsertCacheMut.Lock()
+		fundingHistoryUpsertCache[key] = cache
+		fundingHistoryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single FundingHistory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *FundingHistory) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("mysql: no FundingHistory provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), fundingHistoryPrimaryKeyMapping)
+	sql := "DELETE FROM `funding_history` WHERE `id`=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: unable to delete from funding_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: failed to get rows affected by delete for funding_history")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q fundingHistoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("mysql: no fundingHistoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: unable to delete all from funding_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: failed to get rows affected by deleteall for funding_history")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FundingHistorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(fundingHistoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fundingHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `funding_history` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, fundingHistoryPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: unable to delete all from fundingHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "mysql: failed to get rows affected by deleteall for funding_history")
+	}
+
+	if len(fundingHistoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *FundingHistory) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFundingHistory(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FundingHistorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FundingHistorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fundingHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `funding_history`.* FROM `funding_history` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, fundingHistoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "mysql: unable to reload all in FundingHistorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FundingHistoryExists checks if the FundingHistory row exists.
+func FundingHistoryExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `funding_history` where `id`=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "mysql: unable to check if funding_history exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,895 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package mysql
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testFundingHistories(t *testing.T) {
+	t.Parallel()
+
+	query := FundingHistories()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testFundingHistoriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingHistory{}
+	if err = randomize.Struct(seed, o, fundingHistoryDBTypes, true, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FundingHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFundingHistoriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingHistory{}
+	if err = randomize.Struct(seed, o, fundingHistoryDBTypes, true, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := FundingHistories().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FundingHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFundingHistoriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingHistory{}
+	if err = randomize.Struct(seed, o, fundingHistoryDBTypes, true, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FundingHistorySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FundingHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFundingHistoriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingHistory{}
+	if err = randomize.Struct(seed, o, fundingHistoryDBTypes, true, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := FundingHistoryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if FundingHistory exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected FundingHistoryExists to return true, but got false.")
+	}
+}
+
+func testFundingHistoriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingHistory{}
+	if err = randomize.Struct(seed, o, fundingHistoryDBTypes, true, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	fundingHistoryFound, err := FindFundingHistory(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if fundingHistoryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testFundingHistoriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingHistory{}
+	if err = randomize.Struct(seed, o, fundingHistoryDBTypes, true, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = FundingHistories().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFundingHistoriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingHistory{}
+	if err = randomize.Struct(seed, o, fundingHistoryDBTypes, true, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := FundingHistories().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testFundingHistoriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	fundingHistoryOne := &FundingHistory{}
+	fundingHistoryTwo := &FundingHistory{}
+	if err = randomize.Struct(seed, fundingHistoryOne, fundingHistoryDBTypes, false, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, fundingHistoryTwo, fundingHistoryDBTypes, false, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = fundingHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = fundingHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FundingHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testFundingHistoriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	fundingHistoryOne := &FundingHistory{}
+	fundingHistoryTwo := &FundingHistory{}
+	if err = randomize.Struct(seed, fundingHistoryOne, fundingHistoryDBTypes, false, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, fundingHistoryTwo, fundingHistoryDBTypes, false, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = fundingHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = fundingHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FundingHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func fundingHistoryBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *FundingHistory) error {
+	*o = FundingHistory{}
+	return nil
+}
+
+func fundingHistoryAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *FundingHistory) error {
+	*o = FundingHistory{}
+	return nil
+}
+
+func fundingHistoryAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *FundingHistory) error {
+	*o = FundingHistory{}
+	return nil
+}
+
+func fundingHistoryBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FundingHistory) error {
+	*o = FundingHistory{}
+	return nil
+}
+
+func fundingHistoryAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FundingHistory) error {
+	*o = FundingHistory{}
+	return nil
+}
+
+func fundingHistoryBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FundingHistory) error {
+	*o = FundingHistory{}
+	return nil
+}
+
+func fundingHistoryAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FundingHistory) error {
+	*o = FundingHistory{}
+	return nil
+}
+
+func fundingHistoryBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FundingHistory) error {
+	*o = FundingHistory{}
+	return nil
+}
+
+func fundingHistoryAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FundingHistory) error {
+	*o = FundingHistory{}
+	return nil
+}
+
+func testFundingHistoriesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &FundingHistory{}
+	o := &FundingHistory{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, fundingHistoryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize FundingHistory object: %s", err)
+	}
+
+	AddFundingHistoryHook(boil.BeforeInsertHook, fundingHistoryBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	fundingHistoryBeforeInsertHooks = []FundingHistoryHook{}
+
+	AddFundingHistoryHook(boil.AfterInsertHook, fundingHistoryAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	fundingHistoryAfterInsertHooks = []FundingHistoryHook{}
+
+	AddFundingHistoryHook(boil.AfterSelectHook, fundingHistoryAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	fundingHistoryAfterSelectHooks = []FundingHistoryHook{}
+
+	AddFundingHistoryHook(boil.BeforeUpdateHook, fundingHistoryBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	fundingHistoryBeforeUpdateHooks = []FundingHistoryHook{}
+
+	AddFundingHistoryHook(boil.AfterUpdateHook, fundingHistoryAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	fundingHistoryAfterUpdateHooks = []FundingHistoryHook{}
+
+	AddFundingHistoryHook(boil.BeforeDeleteHook, fundingHistoryBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	fundingHistoryBeforeDeleteHooks = []FundingHistoryHook{}
+
+	AddFundingHistoryHook(boil.AfterDeleteHook, fundingHistoryAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	fundingHistoryAfterDeleteHooks = []FundingHistoryHook{}
+
+	AddFundingHistoryHook(boil.BeforeUpsertHook, fundingHistoryBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	fundingHistoryBeforeUpsertHooks = []FundingHistoryHook{}
+
+	AddFundingHistoryHook(boil.AfterUpsertHook, fundingHistoryAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	fundingHistoryAfterUpsertHooks = []FundingHistoryHook{}
+}
+
+func testFundingHistoriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingHistory{}
+	if err = randomize.Struct(seed, o, fundingHistoryDBTypes, true, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FundingHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFundingHistoriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingHistory{}
+	if err = randomize.Struct(seed, o, fundingHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(fundingHistoryColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FundingHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFundingHistoryToOneWithdrawalHistoryUsingWithdrawalHistory(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local FundingHistory
+	var foreign WithdrawalHistory
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, fundingHistoryDBTypes, true, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, withdrawalHistoryDBTypes, false, withdrawalHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalHistory struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.WithdrawalHistoryID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.WithdrawalHistory().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := FundingHistorySlice{&local}
+	if err = local.L.LoadWithdrawalHistory(ctx, tx, false, (*[]*FundingHistory)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.WithdrawalHistory == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.WithdrawalHistory = nil
+	if err = local.L.LoadWithdrawalHistory(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.WithdrawalHistory == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testFundingHistoryToOneSetOpWithdrawalHistoryUsingWithdrawalHistory(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FundingHistory
+	var b, c WithdrawalHistory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, fundingHistoryDBTypes, false, strmangle.SetComplement(fundingHistoryPrimaryKeyColumns, fundingHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, withdrawalHistoryDBTypes, false, strmangle.SetComplement(withdrawalHistoryPrimaryKeyColumns, withdrawalHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, withdrawalHistoryDBTypes, false, strmangle.SetComplement(withdrawalHistoryPrimaryKeyColumns, withdrawalHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*WithdrawalHistory{&b, &c} {
+		err = a.SetWithdrawalHistory(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.WithdrawalHistory != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.FundingHistories[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.WithdrawalHistoryID, x.ID) {
+			t.Error("foreign key was wrong value", a.WithdrawalHistoryID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.WithdrawalHistoryID))
+		reflect.Indirect(reflect.ValueOf(&a.WithdrawalHistoryID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.WithdrawalHistoryID, x.ID) {
+			t.Error("foreign key was wrong value", a.WithdrawalHistoryID, x.ID)
+		}
+	}
+}
+
+func testFundingHistoryToOneRemoveOpWithdrawalHistoryUsingWithdrawalHistory(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FundingHistory
+	var b WithdrawalHistory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, fundingHistoryDBTypes, false, strmangle.SetComplement(fundingHistoryPrimaryKeyColumns, fundingHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, withdrawalHistoryDBTypes, false, strmangle.SetComplement(withdrawalHistoryPrimaryKeyColumns, withdrawalHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetWithdrawalHistory(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveWithdrawalHistory(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.WithdrawalHistory().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.WithdrawalHistory != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.WithdrawalHistoryID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.FundingHistories) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testFundingHistoriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingHistory{}
+	if err = randomize.Struct(seed, o, fundingHistoryDBTypes, true, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFundingHistoriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingHistory{}
+	if err = randomize.Struct(seed, o, fundingHistoryDBTypes, true, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FundingHistorySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFundingHistoriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingHistory{}
+	if err = randomize.Struct(seed, o, fundingHistoryDBTypes, true, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FundingHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	fundingHistoryDBTypes = map[string]string{`ID`: `bigint`, `Exchange`: `varchar`, `TransferID`: `varchar`, `TransferType`: `varchar`, `Status`: `varchar`, `Currency`: `varchar`, `Amount`: `double`, `Fee`: `double`, `Description`: `varchar`, `CryptoTXID`: `varchar`, `CryptoToAddress`: `varchar`, `CryptoFromAddress`: `varchar`, `BankTo`: `varchar`, `BankFrom`: `varchar`, `WithdrawalHistoryID`: `char`, `TransferredAt`: `datetime`, `CreatedAt`: `datetime`, `UpdatedAt`: `datetime`}
+	_                     = bytes.MinRead
+)
+
+func testFundingHistoriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(fundingHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(fundingHistoryAllColumns) == len(fundingHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingHistory{}
+	if err = randomize.Struct(seed, o, fundingHistoryDBTypes, true, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FundingHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, fundingHistoryDBTypes, true, fundingHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testFundingHistoriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(fundingHistoryAllColumns) == len(fundingHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingHistory{}
+	if err = randomize.Struct(seed, o, fundingHistoryDBTypes, true, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FundingHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, fundingHistoryDBTypes, true, fundingHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(fundingHistoryAllColumns, fundingHistoryPrimaryKeyColumns) {
+		fields = fundingHistoryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			fundingHistoryAllColumns,
+			fundingHistoryPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := FundingHistorySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testFundingHistoriesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(fundingHistoryAllColumns) == len(fundingHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+	if len(mySQLFundingHistoryUniqueColumns) == 0 {
+		t.Skip("Skipping table with no unique columns to conflict on")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := FundingHistory{}
+	if err = randomize.Struct(seed, &o, fundingHistoryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FundingHistory: %s", err)
+	}
+
+	count, err := FundingHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, fundingHistoryDBTypes, false, fundingHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FundingHistory struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FundingHistory: %s", err)
+	}
+
+	count, err = FundingHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
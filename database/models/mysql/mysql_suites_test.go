@@ -0,0 +1,36 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package mysql
+
+import "testing"
+
+func TestUpsert(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsUpsert)
+
+	t.Run("BalanceSnapshots", testBalanceSnapshotsUpsert)
+
+	t.Run("Fills", testFillsUpsert)
+
+	t.Run("FundingHistories", testFundingHistoriesUpsert)
+
+	t.Run("Nonces", testNoncesUpsert)
+
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsUpsert)
+
+	t.Run("RequestJournals", testRequestJournalsUpsert)
+
+	t.Run("Scripts", testScriptsUpsert)
+
+	t.Run("ScriptExecutions", testScriptExecutionsUpsert)
+
+	t.Run("TickerHistories", testTickerHistoriesUpsert)
+
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesUpsert)
+
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosUpsert)
+
+	t.Run("WithdrawalFiats", testWithdrawalFiatsUpsert)
+
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesUpsert)
+}
@@ -0,0 +1,735 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package mysql
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testTickerHistories(t *testing.T) {
+	t.Parallel()
+
+	query := TickerHistories()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testTickerHistoriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistory{}
+	if err = randomize.Struct(seed, o, tickerHistoryDBTypes, true, tickerHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TickerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTickerHistoriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistory{}
+	if err = randomize.Struct(seed, o, tickerHistoryDBTypes, true, tickerHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TickerHistories().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TickerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTickerHistoriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistory{}
+	if err = randomize.Struct(seed, o, tickerHistoryDBTypes, true, tickerHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TickerHistorySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TickerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTickerHistoriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistory{}
+	if err = randomize.Struct(seed, o, tickerHistoryDBTypes, true, tickerHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := TickerHistoryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if TickerHistory exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected TickerHistoryExists to return true, but got false.")
+	}
+}
+
+func testTickerHistoriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistory{}
+	if err = randomize.Struct(seed, o, tickerHistoryDBTypes, true, tickerHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	tickerHistoryFound, err := FindTickerHistory(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if tickerHistoryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testTickerHistoriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistory{}
+	if err = randomize.Struct(seed, o, tickerHistoryDBTypes, true, tickerHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = TickerHistories().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTickerHistoriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistory{}
+	if err = randomize.Struct(seed, o, tickerHistoryDBTypes, true, tickerHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := TickerHistories().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testTickerHistoriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	tickerHistoryOne := &TickerHistory{}
+	tickerHistoryTwo := &TickerHistory{}
+	if err = randomize.Struct(seed, tickerHistoryOne, tickerHistoryDBTypes, false, tickerHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, tickerHistoryTwo, tickerHistoryDBTypes, false, tickerHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = tickerHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = tickerHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TickerHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testTickerHistoriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	tickerHistoryOne := &TickerHistory{}
+	tickerHistoryTwo := &TickerHistory{}
+	if err = randomize.Struct(seed, tickerHistoryOne, tickerHistoryDBTypes, false, tickerHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, tickerHistoryTwo, tickerHistoryDBTypes, false, tickerHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = tickerHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = tickerHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TickerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func tickerHistoryBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistory) error {
+	*o = TickerHistory{}
+	return nil
+}
+
+func tickerHistoryAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistory) error {
+	*o = TickerHistory{}
+	return nil
+}
+
+func tickerHistoryAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistory) error {
+	*o = TickerHistory{}
+	return nil
+}
+
+func tickerHistoryBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistory) error {
+	*o = TickerHistory{}
+	return nil
+}
+
+func tickerHistoryAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistory) error {
+	*o = TickerHistory{}
+	return nil
+}
+
+func tickerHistoryBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistory) error {
+	*o = TickerHistory{}
+	return nil
+}
+
+func tickerHistoryAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistory) error {
+	*o = TickerHistory{}
+	return nil
+}
+
+func tickerHistoryBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistory) error {
+	*o = TickerHistory{}
+	return nil
+}
+
+func tickerHistoryAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistory) error {
+	*o = TickerHistory{}
+	return nil
+}
+
+func testTickerHistoriesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &TickerHistory{}
+	o := &TickerHistory{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, tickerHistoryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize TickerHistory object: %s", err)
+	}
+
+	AddTickerHistoryHook(boil.BeforeInsertHook, tickerHistoryBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryBeforeInsertHooks = []TickerHistoryHook{}
+
+	AddTickerHistoryHook(boil.AfterInsertHook, tickerHistoryAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryAfterInsertHooks = []TickerHistoryHook{}
+
+	AddTickerHistoryHook(boil.AfterSelectHook, tickerHistoryAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryAfterSelectHooks = []TickerHistoryHook{}
+
+	AddTickerHistoryHook(boil.BeforeUpdateHook, tickerHistoryBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryBeforeUpdateHooks = []TickerHistoryHook{}
+
+	AddTickerHistoryHook(boil.AfterUpdateHook, tickerHistoryAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryAfterUpdateHooks = []TickerHistoryHook{}
+
+	AddTickerHistoryHook(boil.BeforeDeleteHook, tickerHistoryBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryBeforeDeleteHooks = []TickerHistoryHook{}
+
+	AddTickerHistoryHook(boil.AfterDeleteHook, tickerHistoryAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryAfterDeleteHooks = []TickerHistoryHook{}
+
+	AddTickerHistoryHook(boil.BeforeUpsertHook, tickerHistoryBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryBeforeUpsertHooks = []TickerHistoryHook{}
+
+	AddTickerHistoryHook(boil.AfterUpsertHook, tickerHistoryAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryAfterUpsertHooks = []TickerHistoryHook{}
+}
+
+func testTickerHistoriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistory{}
+	if err = randomize.Struct(seed, o, tickerHistoryDBTypes, true, tickerHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TickerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTickerHistoriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistory{}
+	if err = randomize.Struct(seed, o, tickerHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(tickerHistoryColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TickerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTickerHistoriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistory{}
+	if err = randomize.Struct(seed, o, tickerHistoryDBTypes, true, tickerHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTickerHistoriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistory{}
+	if err = randomize.Struct(seed, o, tickerHistoryDBTypes, true, tickerHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TickerHistorySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTickerHistoriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistory{}
+	if err = randomize.Struct(seed, o, tickerHistoryDBTypes, true, tickerHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TickerHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	tickerHistoryDBTypes = map[string]string{`ID`: `bigint`, `Exchange`: `varchar`, `Asset`: `varchar`, `Pair`: `varchar`, `Last`: `double`, `High`: `double`, `Low`: `double`, `Bid`: `double`, `Ask`: `double`, `Volume`: `double`, `QuoteVolume`: `double`, `CreatedAt`: `datetime`}
+	_                    = bytes.MinRead
+)
+
+func testTickerHistoriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(tickerHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(tickerHistoryAllColumns) == len(tickerHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistory{}
+	if err = randomize.Struct(seed, o, tickerHistoryDBTypes, true, tickerHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TickerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, tickerHistoryDBTypes, true, tickerHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testTickerHistoriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(tickerHistoryAllColumns) == len(tickerHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistory{}
+	if err = randomize.Struct(seed, o, tickerHistoryDBTypes, true, tickerHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TickerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, tickerHistoryDBTypes, true, tickerHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(tickerHistoryAllColumns, tickerHistoryPrimaryKeyColumns) {
+		fields = tickerHistoryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			tickerHistoryAllColumns,
+			tickerHistoryPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := TickerHistorySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testTickerHistoriesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(tickerHistoryAllColumns) == len(tickerHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+	if len(mySQLTickerHistoryUniqueColumns) == 0 {
+		t.Skip("Skipping table with no unique columns to conflict on")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := TickerHistory{}
+	if err = randomize.Struct(seed, &o, tickerHistoryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TickerHistory: %s", err)
+	}
+
+	count, err := TickerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, tickerHistoryDBTypes, false, tickerHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TickerHistory struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TickerHistory: %s", err)
+	}
+
+	count, err = TickerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -13,82 +13,230 @@ import "testing"
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
+	t.Run("BalanceSnapshots", testBalanceSnapshots)
+	t.Run("Fills", testFills)
+	t.Run("FundingHistories", testFundingHistories)
+	t.Run("Nonces", testNonces)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshots)
+	t.Run("RequestJournals", testRequestJournals)
 	t.Run("Scripts", testScripts)
+	t.Run("ScriptExecutions", testScriptExecutions)
+	t.Run("TickerHistories", testTickerHistories)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutes)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptos)
+	t.Run("WithdrawalFiats", testWithdrawalFiats)
 	t.Run("WithdrawalHistories", testWithdrawalHistories)
 }
 
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsDelete)
+	t.Run("Fills", testFillsDelete)
+	t.Run("FundingHistories", testFundingHistoriesDelete)
+	t.Run("Nonces", testNoncesDelete)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsDelete)
+	t.Run("RequestJournals", testRequestJournalsDelete)
 	t.Run("Scripts", testScriptsDelete)
+	t.Run("ScriptExecutions", testScriptExecutionsDelete)
+	t.Run("TickerHistories", testTickerHistoriesDelete)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesDelete)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosDelete)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsDelete)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsQueryDeleteAll)
+	t.Run("Fills", testFillsQueryDeleteAll)
+	t.Run("FundingHistories", testFundingHistoriesQueryDeleteAll)
+	t.Run("Nonces", testNoncesQueryDeleteAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsQueryDeleteAll)
+	t.Run("RequestJournals", testRequestJournalsQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
+	t.Run("ScriptExecutions", testScriptExecutionsQueryDeleteAll)
+	t.Run("TickerHistories", testTickerHistoriesQueryDeleteAll)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesQueryDeleteAll)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosQueryDeleteAll)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsQueryDeleteAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsSliceDeleteAll)
+	t.Run("Fills", testFillsSliceDeleteAll)
+	t.Run("FundingHistories", testFundingHistoriesSliceDeleteAll)
+	t.Run("Nonces", testNoncesSliceDeleteAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsSliceDeleteAll)
+	t.Run("RequestJournals", testRequestJournalsSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
+	t.Run("ScriptExecutions", testScriptExecutionsSliceDeleteAll)
+	t.Run("TickerHistories", testTickerHistoriesSliceDeleteAll)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesSliceDeleteAll)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceDeleteAll)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceDeleteAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsExists)
+	t.Run("Fills", testFillsExists)
+	t.Run("FundingHistories", testFundingHistoriesExists)
+	t.Run("Nonces", testNoncesExists)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsExists)
+	t.Run("RequestJournals", testRequestJournalsExists)
 	t.Run("Scripts", testScriptsExists)
+	t.Run("ScriptExecutions", testScriptExecutionsExists)
+	t.Run("TickerHistories", testTickerHistoriesExists)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesExists)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosExists)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsExists)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesExists)
 }
 
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsFind)
+	t.Run("Fills", testFillsFind)
+	t.Run("FundingHistories", testFundingHistoriesFind)
+	t.Run("Nonces", testNoncesFind)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsFind)
+	t.Run("RequestJournals", testRequestJournalsFind)
 	t.Run("Scripts", testScriptsFind)
+	t.Run("ScriptExecutions", testScriptExecutionsFind)
+	t.Run("TickerHistories", testTickerHistoriesFind)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesFind)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosFind)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsFind)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesFind)
 }
 
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsBind)
+	t.Run("Fills", testFillsBind)
+	t.Run("FundingHistories", testFundingHistoriesBind)
+	t.Run("Nonces", testNoncesBind)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsBind)
+	t.Run("RequestJournals", testRequestJournalsBind)
 	t.Run("Scripts", testScriptsBind)
+	t.Run("ScriptExecutions", testScriptExecutionsBind)
+	t.Run("TickerHistories", testTickerHistoriesBind)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesBind)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosBind)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsBind)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesBind)
 }
 
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsOne)
+	t.Run("Fills", testFillsOne)
+	t.Run("FundingHistories", testFundingHistoriesOne)
+	t.Run("Nonces", testNoncesOne)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsOne)
+	t.Run("RequestJournals", testRequestJournalsOne)
 	t.Run("Scripts", testScriptsOne)
+	t.Run("ScriptExecutions", testScriptExecutionsOne)
+	t.Run("TickerHistories", testTickerHistoriesOne)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesOne)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosOne)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsOne)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesOne)
 }
 
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsAll)
+	t.Run("Fills", testFillsAll)
+	t.Run("FundingHistories", testFundingHistoriesAll)
+	t.Run("Nonces", testNoncesAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsAll)
+	t.Run("RequestJournals", testRequestJournalsAll)
 	t.Run("Scripts", testScriptsAll)
+	t.Run("ScriptExecutions", testScriptExecutionsAll)
+	t.Run("TickerHistories", testTickerHistoriesAll)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesAll)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosAll)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesAll)
 }
 
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsCount)
+	t.Run("Fills", testFillsCount)
+	t.Run("FundingHistories", testFundingHistoriesCount)
+	t.Run("Nonces", testNoncesCount)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsCount)
+	t.Run("RequestJournals", testRequestJournalsCount)
 	t.Run("Scripts", testScriptsCount)
+	t.Run("ScriptExecutions", testScriptExecutionsCount)
+	t.Run("TickerHistories", testTickerHistoriesCount)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesCount)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosCount)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsCount)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesCount)
 }
 
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsHooks)
+	t.Run("Fills", testFillsHooks)
+	t.Run("FundingHistories", testFundingHistoriesHooks)
+	t.Run("Nonces", testNoncesHooks)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsHooks)
+	t.Run("RequestJournals", testRequestJournalsHooks)
 	t.Run("Scripts", testScriptsHooks)
+	t.Run("ScriptExecutions", testScriptExecutionsHooks)
+	t.Run("TickerHistories", testTickerHistoriesHooks)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesHooks)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosHooks)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsHooks)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesHooks)
 }
 
 func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsert)
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsInsert)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsInsertWhitelist)
+	t.Run("Fills", testFillsInsert)
+	t.Run("Fills", testFillsInsertWhitelist)
+	t.Run("FundingHistories", testFundingHistoriesInsert)
+	t.Run("FundingHistories", testFundingHistoriesInsertWhitelist)
+	t.Run("Nonces", testNoncesInsert)
+	t.Run("Nonces", testNoncesInsertWhitelist)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsInsert)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsInsertWhitelist)
+	t.Run("RequestJournals", testRequestJournalsInsert)
+	t.Run("RequestJournals", testRequestJournalsInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
+	t.Run("ScriptExecutions", testScriptExecutionsInsert)
+	t.Run("ScriptExecutions", testScriptExecutionsInsertWhitelist)
+	t.Run("TickerHistories", testTickerHistoriesInsert)
+	t.Run("TickerHistories", testTickerHistoriesInsertWhitelist)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesInsert)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesInsertWhitelist)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosInsert)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosInsertWhitelist)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsInsert)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsInsertWhitelist)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesInsert)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesInsertWhitelist)
 }
 
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOne(t *testing.T) {}
+func TestToOne(t *testing.T) {
+	t.Run("FundingHistoryToWithdrawalHistoryUsingWithdrawalHistory", testFundingHistoryToOneWithdrawalHistoryUsingWithdrawalHistory)
+	t.Run("ScriptExecutionToScriptUsingScript", testScriptExecutionToOneScriptUsingScript)
+	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalHistory", testWithdrawalCryptoToOneWithdrawalHistoryUsingWithdrawalHistory)
+	t.Run("WithdrawalFiatToWithdrawalHistoryUsingWithdrawalHistory", testWithdrawalFiatToOneWithdrawalHistoryUsingWithdrawalHistory)
+}
 
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
@@ -96,15 +244,30 @@ func TestOneToOne(t *testing.T) {}
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToMany(t *testing.T) {}
+func TestToMany(t *testing.T) {
+	t.Run("ScriptToScriptExecutions", testScriptToManyScriptExecutions)
+	t.Run("WithdrawalHistoryToFundingHistories", testWithdrawalHistoryToManyFundingHistories)
+	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyWithdrawalCryptos)
+	t.Run("WithdrawalHistoryToWithdrawalFiats", testWithdrawalHistoryToManyWithdrawalFiats)
+}
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneSet(t *testing.T) {}
+func TestToOneSet(t *testing.T) {
+	t.Run("FundingHistoryToWithdrawalHistoryUsingFundingHistories", testFundingHistoryToOneSetOpWithdrawalHistoryUsingWithdrawalHistory)
+	t.Run("ScriptExecutionToScriptUsingScriptExecutions", testScriptExecutionToOneSetOpScriptUsingScript)
+	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalCryptos", testWithdrawalCryptoToOneSetOpWithdrawalHistoryUsingWithdrawalHistory)
+	t.Run("WithdrawalFiatToWithdrawalHistoryUsingWithdrawalFiats", testWithdrawalFiatToOneSetOpWithdrawalHistoryUsingWithdrawalHistory)
+}
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("FundingHistoryToWithdrawalHistoryUsingFundingHistories", testFundingHistoryToOneRemoveOpWithdrawalHistoryUsingWithdrawalHistory)
+	t.Run("ScriptExecutionToScriptUsingScriptExecutions", testScriptExecutionToOneRemoveOpScriptUsingScript)
+	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalCryptos", testWithdrawalCryptoToOneRemoveOpWithdrawalHistoryUsingWithdrawalHistory)
+	t.Run("WithdrawalFiatToWithdrawalHistoryUsingWithdrawalFiats", testWithdrawalFiatToOneRemoveOpWithdrawalHistoryUsingWithdrawalHistory)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
@@ -116,42 +279,112 @@ func TestOneToOneRemove(t *testing.T) {}
 
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyAdd(t *testing.T) {}
+func TestToManyAdd(t *testing.T) {
+	t.Run("ScriptToScriptExecutions", testScriptToManyAddOpScriptExecutions)
+	t.Run("WithdrawalHistoryToFundingHistories", testWithdrawalHistoryToManyAddOpFundingHistories)
+	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyAddOpWithdrawalCryptos)
+	t.Run("WithdrawalHistoryToWithdrawalFiats", testWithdrawalHistoryToManyAddOpWithdrawalFiats)
+}
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManySet(t *testing.T) {}
+func TestToManySet(t *testing.T) {
+	t.Run("ScriptToScriptExecutions", testScriptToManySetOpScriptExecutions)
+	t.Run("WithdrawalHistoryToFundingHistories", testWithdrawalHistoryToManySetOpFundingHistories)
+	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManySetOpWithdrawalCryptos)
+	t.Run("WithdrawalHistoryToWithdrawalFiats", testWithdrawalHistoryToManySetOpWithdrawalFiats)
+}
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyRemove(t *testing.T) {}
+func TestToManyRemove(t *testing.T) {
+	t.Run("ScriptToScriptExecutions", testScriptToManyRemoveOpScriptExecutions)
+	t.Run("WithdrawalHistoryToFundingHistories", testWithdrawalHistoryToManyRemoveOpFundingHistories)
+	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyRemoveOpWithdrawalCryptos)
+	t.Run("WithdrawalHistoryToWithdrawalFiats", testWithdrawalHistoryToManyRemoveOpWithdrawalFiats)
+}
 
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsReload)
+	t.Run("Fills", testFillsReload)
+	t.Run("FundingHistories", testFundingHistoriesReload)
+	t.Run("Nonces", testNoncesReload)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsReload)
+	t.Run("RequestJournals", testRequestJournalsReload)
 	t.Run("Scripts", testScriptsReload)
+	t.Run("ScriptExecutions", testScriptExecutionsReload)
+	t.Run("TickerHistories", testTickerHistoriesReload)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesReload)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosReload)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsReload)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesReload)
 }
 
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsReloadAll)
+	t.Run("Fills", testFillsReloadAll)
+	t.Run("FundingHistories", testFundingHistoriesReloadAll)
+	t.Run("Nonces", testNoncesReloadAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsReloadAll)
+	t.Run("RequestJournals", testRequestJournalsReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
+	t.Run("ScriptExecutions", testScriptExecutionsReloadAll)
+	t.Run("TickerHistories", testTickerHistoriesReloadAll)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesReloadAll)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosReloadAll)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsReloadAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesReloadAll)
 }
 
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsSelect)
+	t.Run("Fills", testFillsSelect)
+	t.Run("FundingHistories", testFundingHistoriesSelect)
+	t.Run("Nonces", testNoncesSelect)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsSelect)
+	t.Run("RequestJournals", testRequestJournalsSelect)
 	t.Run("Scripts", testScriptsSelect)
+	t.Run("ScriptExecutions", testScriptExecutionsSelect)
+	t.Run("TickerHistories", testTickerHistoriesSelect)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesSelect)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosSelect)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsSelect)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesSelect)
 }
 
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsUpdate)
+	t.Run("Fills", testFillsUpdate)
+	t.Run("FundingHistories", testFundingHistoriesUpdate)
+	t.Run("Nonces", testNoncesUpdate)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsUpdate)
+	t.Run("RequestJournals", testRequestJournalsUpdate)
 	t.Run("Scripts", testScriptsUpdate)
+	t.Run("ScriptExecutions", testScriptExecutionsUpdate)
+	t.Run("TickerHistories", testTickerHistoriesUpdate)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesUpdate)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosUpdate)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsUpdate)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsSliceUpdateAll)
+	t.Run("Fills", testFillsSliceUpdateAll)
+	t.Run("FundingHistories", testFundingHistoriesSliceUpdateAll)
+	t.Run("Nonces", testNoncesSliceUpdateAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsSliceUpdateAll)
+	t.Run("RequestJournals", testRequestJournalsSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
+	t.Run("ScriptExecutions", testScriptExecutionsSliceUpdateAll)
+	t.Run("TickerHistories", testTickerHistoriesSliceUpdateAll)
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesSliceUpdateAll)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceUpdateAll)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceUpdateAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesSliceUpdateAll)
 }
@@ -4,17 +4,33 @@
 package postgres
 
 var TableNames = struct {
-	AuditEvent        string
-	Script            string
-	ScriptExecution   string
-	WithdrawalCrypto  string
-	WithdrawalFiat    string
-	WithdrawalHistory string
+	AuditEvent          string
+	BalanceSnapshot     string
+	Fills               string
+	FundingHistory      string
+	Nonce               string
+	OrderbookSnapshot   string
+	RequestJournal      string
+	Script              string
+	ScriptExecution     string
+	TickerHistory       string
+	TickerHistoryMinute string
+	WithdrawalCrypto    string
+	WithdrawalFiat      string
+	WithdrawalHistory   string
 }{
-	AuditEvent:        "audit_event",
-	Script:            "script",
-	ScriptExecution:   "script_execution",
-	WithdrawalCrypto:  "withdrawal_crypto",
-	WithdrawalFiat:    "withdrawal_fiat",
-	WithdrawalHistory: "withdrawal_history",
+	AuditEvent:          "audit_event",
+	BalanceSnapshot:     "balance_snapshot",
+	Fills:               "fills",
+	FundingHistory:      "funding_history",
+	Nonce:               "nonce",
+	OrderbookSnapshot:   "orderbook_snapshot",
+	RequestJournal:      "request_journal",
+	Script:              "script",
+	ScriptExecution:     "script_execution",
+	TickerHistory:       "ticker_history",
+	TickerHistoryMinute: "ticker_history_minute",
+	WithdrawalCrypto:    "withdrawal_crypto",
+	WithdrawalFiat:      "withdrawal_fiat",
+	WithdrawalHistory:   "withdrawal_history",
 }
@@ -7,6 +7,30 @@ import "testing"
 
 func TestUpsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpsert)
+
+	t.Run("BalanceSnapshots", testBalanceSnapshotsUpsert)
+
+	t.Run("Fills", testFillsUpsert)
+
+	t.Run("FundingHistories", testFundingHistoriesUpsert)
+
+	t.Run("Nonces", testNoncesUpsert)
+
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsUpsert)
+
+	t.Run("RequestJournals", testRequestJournalsUpsert)
+
 	t.Run("Scripts", testScriptsUpsert)
+
+	t.Run("ScriptExecutions", testScriptExecutionsUpsert)
+
+	t.Run("TickerHistories", testTickerHistoriesUpsert)
+
+	t.Run("TickerHistoryMinutes", testTickerHistoryMinutesUpsert)
+
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosUpsert)
+
+	t.Run("WithdrawalFiats", testWithdrawalFiatsUpsert)
+
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesUpsert)
 }
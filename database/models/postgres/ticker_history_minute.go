@@ -0,0 +1,919 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// TickerHistoryMinute is an object representing the database table.
+type TickerHistoryMinute struct {
+	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Exchange  string    `boil:"exchange" json:"exchange" toml:"exchange" yaml:"exchange"`
+	Asset     string    `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Pair      string    `boil:"pair" json:"pair" toml:"pair" yaml:"pair"`
+	Open      float64   `boil:"open" json:"open" toml:"open" yaml:"open"`
+	High      float64   `boil:"high" json:"high" toml:"high" yaml:"high"`
+	Low       float64   `boil:"low" json:"low" toml:"low" yaml:"low"`
+	Close     float64   `boil:"close" json:"close" toml:"close" yaml:"close"`
+	Bid       float64   `boil:"bid" json:"bid" toml:"bid" yaml:"bid"`
+	Ask       float64   `boil:"ask" json:"ask" toml:"ask" yaml:"ask"`
+	Volume    float64   `boil:"volume" json:"volume" toml:"volume" yaml:"volume"`
+	Ticks     int64     `boil:"ticks" json:"ticks" toml:"ticks" yaml:"ticks"`
+	StartedAt time.Time `boil:"started_at" json:"started_at" toml:"started_at" yaml:"started_at"`
+
+	R *tickerHistoryMinuteR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tickerHistoryMinuteL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TickerHistoryMinuteColumns = struct {
+	ID        string
+	Exchange  string
+	Asset     string
+	Pair      string
+	Open      string
+	High      string
+	Low       string
+	Close     string
+	Bid       string
+	Ask       string
+	Volume    string
+	Ticks     string
+	StartedAt string
+}{
+	ID:        "id",
+	Exchange:  "exchange",
+	Asset:     "asset",
+	Pair:      "pair",
+	Open:      "open",
+	High:      "high",
+	Low:       "low",
+	Close:     "close",
+	Bid:       "bid",
+	Ask:       "ask",
+	Volume:    "volume",
+	Ticks:     "ticks",
+	StartedAt: "started_at",
+}
+
+// Generated where
+
+var TickerHistoryMinuteWhere = struct {
+	ID        whereHelperint64
+	Exchange  whereHelperstring
+	Asset     whereHelperstring
+	Pair      whereHelperstring
+	Open      whereHelperfloat64
+	High      whereHelperfloat64
+	Low       whereHelperfloat64
+	Close     whereHelperfloat64
+	Bid       whereHelperfloat64
+	Ask       whereHelperfloat64
+	Volume    whereHelperfloat64
+	Ticks     whereHelperint64
+	StartedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint64{field: "\"ticker_history_minute\".\"id\""},
+	Exchange:  whereHelperstring{field: "\"ticker_history_minute\".\"exchange\""},
+	Asset:     whereHelperstring{field: "\"ticker_history_minute\".\"asset\""},
+	Pair:      whereHelperstring{field: "\"ticker_history_minute\".\"pair\""},
+	Open:      whereHelperfloat64{field: "\"ticker_history_minute\".\"open\""},
+	High:      whereHelperfloat64{field: "\"ticker_history_minute\".\"high\""},
+	Low:       whereHelperfloat64{field: "\"ticker_history_minute\".\"low\""},
+	Close:     whereHelperfloat64{field: "\"ticker_history_minute\".\"close\""},
+	Bid:       whereHelperfloat64{field: "\"ticker_history_minute\".\"bid\""},
+	Ask:       whereHelperfloat64{field: "\"ticker_history_minute\".\"ask\""},
+	Volume:    whereHelperfloat64{field: "\"ticker_history_minute\".\"volume\""},
+	Ticks:     whereHelperint64{field: "\"ticker_history_minute\".\"ticks\""},
+	StartedAt: whereHelpertime_Time{field: "\"ticker_history_minute\".\"started_at\""},
+}
+
+// TickerHistoryMinuteRels is where relationship names are stored.
+var TickerHistoryMinuteRels = struct {
+}{}
+
+// tickerHistoryMinuteR is where relationships are stored.
+type tickerHistoryMinuteR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*tickerHistoryMinuteR) NewStruct() *tickerHistoryMinuteR {
+	return &tickerHistoryMinuteR{}
+}
+
+// tickerHistoryMinuteL is where Load methods for each relationship are stored.
+type tickerHistoryMinuteL struct{}
+
+var (
+	tickerHistoryMinuteAllColumns            = []string{"id", "exchange", "asset", "pair", "open", "high", "low", "close", "bid", "ask", "volume", "ticks", "started_at"}
+	tickerHistoryMinuteColumnsWithoutDefault = []string{"exchange", "asset", "pair", "open", "high", "low", "close", "bid", "ask", "volume", "ticks", "started_at"}
+	tickerHistoryMinuteColumnsWithDefault    = []string{"id"}
+	tickerHistoryMinutePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// TickerHistoryMinuteSlice is an alias for a slice of pointers to TickerHistoryMinute.
+	// This should generally be used opposed to []TickerHistoryMinute.
+	TickerHistoryMinuteSlice []*TickerHistoryMinute
+	// TickerHistoryMinuteHook is the signature for custom TickerHistoryMinute hook methods
+	TickerHistoryMinuteHook func(context.Context, boil.ContextExecutor, *TickerHistoryMinute) error
+
+	tickerHistoryMinuteQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tickerHistoryMinuteType                 = reflect.TypeOf(&TickerHistoryMinute{})
+	tickerHistoryMinuteMapping              = queries.MakeStructMapping(tickerHistoryMinuteType)
+	tickerHistoryMinutePrimaryKeyMapping, _ = queries.BindMapping(tickerHistoryMinuteType, tickerHistoryMinuteMapping, tickerHistoryMinutePrimaryKeyColumns)
+	tickerHistoryMinuteInsertCacheMut       sync.RWMutex
+	tickerHistoryMinuteInsertCache          = make(map[string]insertCache)
+	tickerHistoryMinuteUpdateCacheMut       sync.RWMutex
+	tickerHistoryMinuteUpdateCache          = make(map[string]updateCache)
+	tickerHistoryMinuteUpsertCacheMut       sync.RWMutex
+	tickerHistoryMinuteUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tickerHistoryMinuteBeforeInsertHooks []TickerHistoryMinuteHook
+var tickerHistoryMinuteBeforeUpdateHooks []TickerHistoryMinuteHook
+var tickerHistoryMinuteBeforeDeleteHooks []TickerHistoryMinuteHook
+var tickerHistoryMinuteBeforeUpsertHooks []TickerHistoryMinuteHook
+
+var tickerHistoryMinuteAfterInsertHooks []TickerHistoryMinuteHook
+var tickerHistoryMinuteAfterSelectHooks []TickerHistoryMinuteHook
+var tickerHistoryMinuteAfterUpdateHooks []TickerHistoryMinuteHook
+var tickerHistoryMinuteAfterDeleteHooks []TickerHistoryMinuteHook
+var tickerHistoryMinuteAfterUpsertHooks []TickerHistoryMinuteHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TickerHistoryMinute) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryMinuteBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TickerHistoryMinute) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryMinuteBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TickerHistoryMinute) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryMinuteBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TickerHistoryMinute) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryMinuteBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TickerHistoryMinute) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryMinuteAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TickerHistoryMinute) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryMinuteAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TickerHistoryMinute) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryMinuteAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TickerHistoryMinute) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryMinuteAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TickerHistoryMinute) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryMinuteAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTickerHistoryMinuteHook registers your hook function for all future operations.
+func AddTickerHistoryMinuteHook(hookPoint boil.HookPoint, tickerHistoryMinuteHook TickerHistoryMinuteHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		tickerHistoryMinuteBeforeInsertHooks = append(tickerHistoryMinuteBeforeInsertHooks, tickerHistoryMinuteHook)
+	case boil.BeforeUpdateHook:
+		tickerHistoryMinuteBeforeUpdateHooks = append(tickerHistoryMinuteBeforeUpdateHooks, tickerHistoryMinuteHook)
+	case boil.BeforeDeleteHook:
+		tickerHistoryMinuteBeforeDeleteHooks = append(tickerHistoryMinuteBeforeDeleteHooks, tickerHistoryMinuteHook)
+	case boil.BeforeUpsertHook:
+		tickerHistoryMinuteBeforeUpsertHooks = append(tickerHistoryMinuteBeforeUpsertHooks, tickerHistoryMinuteHook)
+	case boil.AfterInsertHook:
+		tickerHistoryMinuteAfterInsertHooks = append(tickerHistoryMinuteAfterInsertHooks, tickerHistoryMinuteHook)
+	case boil.AfterSelectHook:
+		tickerHistoryMinuteAfterSelectHooks = append(tickerHistoryMinuteAfterSelectHooks, tickerHistoryMinuteHook)
+	case boil.AfterUpdateHook:
+		tickerHistoryMinuteAfterUpdateHooks = append(tickerHistoryMinuteAfterUpdateHooks, tickerHistoryMinuteHook)
+	case boil.AfterDeleteHook:
+		tickerHistoryMinuteAfterDeleteHooks = append(tickerHistoryMinuteAfterDeleteHooks, tickerHistoryMinuteHook)
+	case boil.AfterUpsertHook:
+		tickerHistoryMinuteAfterUpsertHooks = append(tickerHistoryMinuteAfterUpsertHooks, tickerHistoryMinuteHook)
+	}
+}
+
+// One returns a single tickerHistoryMinute record from the query.
+func (q tickerHistoryMinuteQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TickerHistoryMinute, error) {
+	o := &TickerHistoryMinute{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for ticker_history_minute")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TickerHistoryMinute records from the query.
+func (q tickerHistoryMinuteQuery) All(ctx context.Context, exec boil.ContextExecutor) (TickerHistoryMinuteSlice, error) {
+	var o []*TickerHistoryMinute
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to TickerHistoryMinute slice")
+	}
+
+	if len(tickerHistoryMinuteAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TickerHistoryMinute records in the query.
+func (q tickerHistoryMinuteQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count ticker_history_minute rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tickerHistoryMinuteQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if ticker_history_minute exists")
+	}
+
+	return count > 0, nil
+}
+
+// TickerHistoryMinutes retrieves all the records using an executor.
+func TickerHistoryMinutes(mods ...qm.QueryMod) tickerHistoryMinuteQuery {
+	mods = append(mods, qm.From("\"ticker_history_minute\""))
+	return tickerHistoryMinuteQuery{NewQuery(mods...)}
+}
+
+// FindTickerHistoryMinute retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTickerHistoryMinute(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*TickerHistoryMinute, error) {
+	tickerHistoryMinuteObj := &TickerHistoryMinute{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"ticker_history_minute\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tickerHistoryMinuteObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from ticker_history_minute")
+	}
+
+	return tickerHistoryMinuteObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TickerHistoryMinute) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no ticker_history_minute provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tickerHistoryMinuteColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tickerHistoryMinuteInsertCacheMut.RLock()
+	cache, cached := tickerHistoryMinuteInsertCache[key]
+	tickerHistoryMinuteInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tickerHistoryMinuteAllColumns,
+			tickerHistoryMinuteColumnsWithDefault,
+			tickerHistoryMinuteColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tickerHistoryMinuteType, tickerHistoryMinuteMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tickerHistoryMinuteType, tickerHistoryMinuteMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"ticker_history_minute\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"ticker_history_minute\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into ticker_history_minute")
+	}
+
+	if !cached {
+		tickerHistoryMinuteInsertCacheMut.Lock()
+		tickerHistoryMinuteInsertCache[key] = cache
+		tickerHistoryMinuteInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TickerHistoryMinute.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TickerHistoryMinute) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tickerHistoryMinuteUpdateCacheMut.RLock()
+	cache, cached := tickerHistoryMinuteUpdateCache[key]
+	tickerHistoryMinuteUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tickerHistoryMinuteAllColumns,
+			tickerHistoryMinutePrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update ticker_history_minute, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"ticker_history_minute\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, tickerHistoryMinutePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tickerHistoryMinuteType, tickerHistoryMinuteMapping, append(wl, tickerHistoryMinutePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update ticker_history_minute row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for ticker_history_minute")
+	}
+
+	if !cached {
+		tickerHistoryMinuteUpdateCacheMut.Lock()
+		tickerHistoryMinuteUpdateCache[key] = cache
+		tickerHistoryMinuteUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tickerHistoryMinuteQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for ticker_history_minute")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for ticker_history_minute")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TickerHistoryMinuteSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tickerHistoryMinutePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"ticker_history_minute\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, tickerHistoryMinutePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in tickerHistoryMinute slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all tickerHistoryMinute")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TickerHistoryMinute) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no ticker_history_minute provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tickerHistoryMinuteColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tickerHistoryMinuteUpsertCacheMut.RLock()
+	cache, cached := tickerHistoryMinuteUpsertCache[key]
+	tickerHistoryMinuteUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			tickerHistoryMinuteAllColumns,
+			tickerHistoryMinuteColumnsWithDefault,
+			tickerHistoryMinuteColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			tickerHistoryMinuteAllColumns,
+			tickerHistoryMinutePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert ticker_history_minute, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(tickerHistoryMinutePrimaryKeyColumns))
+			copy(conflict, tickerHistoryMinutePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"ticker_history_minute\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(tickerHistoryMinuteType, tickerHistoryMinuteMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tickerHistoryMinuteType, tickerHistoryMinuteMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert ticker_history_minute")
+	}
+
+	if !cached {
+		tickerHistoryMinuteUpsertCacheMut.Lock()
+		tickerHistoryMinuteUpsertCache[key] = cache
+		tickerHistoryMinuteUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TickerHistoryMinute record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TickerHistoryMinute) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no TickerHistoryMinute provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tickerHistoryMinutePrimaryKeyMapping)
+	sql := "DELETE FROM \"ticker_history_minute\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from ticker_history_minute")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for ticker_history_minute")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tickerHistoryMinuteQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no tickerHistoryMinuteQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from ticker_history_minute")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for ticker_history_minute")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TickerHistoryMinuteSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tickerHistoryMinuteBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tickerHistoryMinutePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"ticker_history_minute\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, tickerHistoryMinutePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from tickerHistoryMinute slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for ticker_history_minute")
+	}
+
+	if len(tickerHistoryMinuteAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TickerHistoryMinute) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTickerHistoryMinute(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TickerHistoryMinuteSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TickerHistoryMinuteSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tickerHistoryMinutePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"ticker_history_minute\".* FROM \"ticker_history_minute\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, tickerHistoryMinutePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in TickerHistoryMinuteSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TickerHistoryMinuteExists checks if the TickerHistoryMinute row exists.
+func TickerHistoryMinuteExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"ticker_history_minute\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if ticker_history_minute exists")
+	}
+
+	return exists, nil
+}
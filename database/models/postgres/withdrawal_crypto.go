@@ -24,71 +24,56 @@ import (
 
 // WithdrawalCrypto is an object representing the database table.
 type WithdrawalCrypto struct {
-	ID                 int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	WithdrawalCryptoID null.String `boil:"withdrawal_crypto_id" json:"withdrawal_crypto_id,omitempty" toml:"withdrawal_crypto_id" yaml:"withdrawal_crypto_id,omitempty"`
-	Address            string      `boil:"address" json:"address" toml:"address" yaml:"address"`
-	AddressTag         null.String `boil:"address_tag" json:"address_tag,omitempty" toml:"address_tag" yaml:"address_tag,omitempty"`
-	Fee                float64     `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	ID                  int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	WithdrawalHistoryID null.String `boil:"withdrawal_history_id" json:"withdrawal_history_id,omitempty" toml:"withdrawal_history_id" yaml:"withdrawal_history_id,omitempty"`
+	Address             string      `boil:"address" json:"address" toml:"address" yaml:"address"`
+	AddressTag          null.String `boil:"address_tag" json:"address_tag,omitempty" toml:"address_tag" yaml:"address_tag,omitempty"`
+	Fee                 float64     `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
 
 	R *withdrawalCryptoR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L withdrawalCryptoL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var WithdrawalCryptoColumns = struct {
-	ID                 string
-	WithdrawalCryptoID string
-	Address            string
-	AddressTag         string
-	Fee                string
+	ID                  string
+	WithdrawalHistoryID string
+	Address             string
+	AddressTag          string
+	Fee                 string
 }{
-	ID:                 "id",
-	WithdrawalCryptoID: "withdrawal_crypto_id",
-	Address:            "address",
-	AddressTag:         "address_tag",
-	Fee:                "fee",
+	ID:                  "id",
+	WithdrawalHistoryID: "withdrawal_history_id",
+	Address:             "address",
+	AddressTag:          "address_tag",
+	Fee:                 "fee",
 }
 
 // Generated where
 
-type whereHelperfloat64 struct{ field string }
-
-func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperfloat64) NEQ(x float64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelperfloat64) LT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperfloat64) LTE(x float64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelperfloat64) GT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperfloat64) GTE(x float64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var WithdrawalCryptoWhere = struct {
-	ID                 whereHelperint64
-	WithdrawalCryptoID whereHelpernull_String
-	Address            whereHelperstring
-	AddressTag         whereHelpernull_String
-	Fee                whereHelperfloat64
+	ID                  whereHelperint64
+	WithdrawalHistoryID whereHelpernull_String
+	Address             whereHelperstring
+	AddressTag          whereHelpernull_String
+	Fee                 whereHelperfloat64
 }{
-	ID:                 whereHelperint64{field: "\"withdrawal_crypto\".\"id\""},
-	WithdrawalCryptoID: whereHelpernull_String{field: "\"withdrawal_crypto\".\"withdrawal_crypto_id\""},
-	Address:            whereHelperstring{field: "\"withdrawal_crypto\".\"address\""},
-	AddressTag:         whereHelpernull_String{field: "\"withdrawal_crypto\".\"address_tag\""},
-	Fee:                whereHelperfloat64{field: "\"withdrawal_crypto\".\"fee\""},
+	ID:                  whereHelperint64{field: "\"withdrawal_crypto\".\"id\""},
+	WithdrawalHistoryID: whereHelpernull_String{field: "\"withdrawal_crypto\".\"withdrawal_history_id\""},
+	Address:             whereHelperstring{field: "\"withdrawal_crypto\".\"address\""},
+	AddressTag:          whereHelpernull_String{field: "\"withdrawal_crypto\".\"address_tag\""},
+	Fee:                 whereHelperfloat64{field: "\"withdrawal_crypto\".\"fee\""},
 }
 
 // WithdrawalCryptoRels is where relationship names are stored.
 var WithdrawalCryptoRels = struct {
-	WithdrawalCrypto string
+	WithdrawalHistory string
 }{
-	WithdrawalCrypto: "WithdrawalCrypto",
+	WithdrawalHistory: "WithdrawalHistory",
 }
 
 // withdrawalCryptoR is where relationships are stored.
 type withdrawalCryptoR struct {
-	WithdrawalCrypto *WithdrawalHistory
+	WithdrawalHistory *WithdrawalHistory
 }
 
 // NewStruct creates a new relationship struct
@@ -100,8 +85,8 @@ func (*withdrawalCryptoR) NewStruct() *withdrawalCryptoR {
 type withdrawalCryptoL struct{}
 
 var (
-	withdrawalCryptoAllColumns            = []string{"id", "withdrawal_crypto_id", "address", "address_tag", "fee"}
-	withdrawalCryptoColumnsWithoutDefault = []string{"withdrawal_crypto_id", "address", "address_tag", "fee"}
+	withdrawalCryptoAllColumns            = []string{"id", "withdrawal_history_id", "address", "address_tag", "fee"}
+	withdrawalCryptoColumnsWithoutDefault = []string{"withdrawal_history_id", "address", "address_tag", "fee"}
 	withdrawalCryptoColumnsWithDefault    = []string{"id"}
 	withdrawalCryptoPrimaryKeyColumns     = []string{"id"}
 )
@@ -381,10 +366,10 @@ func (q withdrawalCryptoQuery) Exists(ctx context.Context, exec boil.ContextExec
 	return count > 0, nil
 }
 
-// WithdrawalCrypto pointed to by the foreign key.
-func (o *WithdrawalCrypto) WithdrawalCrypto(mods ...qm.QueryMod) withdrawalHistoryQuery {
+// WithdrawalHistory pointed to by the foreign key.
+func (o *WithdrawalCrypto) WithdrawalHistory(mods ...qm.QueryMod) withdrawalHistoryQuery {
 	queryMods := []qm.QueryMod{
-		qm.Where("\"id\" = ?", o.WithdrawalCryptoID),
+		qm.Where("\"id\" = ?", o.WithdrawalHistoryID),
 	}
 
 	queryMods = append(queryMods, mods...)
@@ -395,9 +380,9 @@ func (o *WithdrawalCrypto) WithdrawalCrypto(mods ...qm.QueryMod) withdrawalHisto
 	return query
 }
 
-// LoadWithdrawalCrypto allows an eager lookup of values, cached into the
+// LoadWithdrawalHistory allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (withdrawalCryptoL) LoadWithdrawalCrypto(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWithdrawalCrypto interface{}, mods queries.Applicator) error {
+func (withdrawalCryptoL) LoadWithdrawalHistory(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWithdrawalCrypto interface{}, mods queries.Applicator) error {
 	var slice []*WithdrawalCrypto
 	var object *WithdrawalCrypto
 
@@ -412,8 +397,8 @@ func (withdrawalCryptoL) LoadWithdrawalCrypto(ctx context.Context, e boil.Contex
 		if object.R == nil {
 			object.R = &withdrawalCryptoR{}
 		}
-		if !queries.IsNil(object.WithdrawalCryptoID) {
-			args = append(args, object.WithdrawalCryptoID)
+		if !queries.IsNil(object.WithdrawalHistoryID) {
+			args = append(args, object.WithdrawalHistoryID)
 		}
 
 	} else {
@@ -424,13 +409,13 @@ func (withdrawalCryptoL) LoadWithdrawalCrypto(ctx context.Context, e boil.Contex
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.WithdrawalCryptoID) {
+				if queries.Equal(a, obj.WithdrawalHistoryID) {
 					continue Outer
 				}
 			}
 
-			if !queries.IsNil(obj.WithdrawalCryptoID) {
-				args = append(args, obj.WithdrawalCryptoID)
+			if !queries.IsNil(obj.WithdrawalHistoryID) {
+				args = append(args, obj.WithdrawalHistoryID)
 			}
 
 		}
@@ -476,22 +461,22 @@ func (withdrawalCryptoL) LoadWithdrawalCrypto(ctx context.Context, e boil.Contex
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.WithdrawalCrypto = foreign
+		object.R.WithdrawalHistory = foreign
 		if foreign.R == nil {
 			foreign.R = &withdrawalHistoryR{}
 		}
-		foreign.R.WithdrawalCryptoWithdrawalCryptos = append(foreign.R.WithdrawalCryptoWithdrawalCryptos, object)
+		foreign.R.WithdrawalCryptos = append(foreign.R.WithdrawalCryptos, object)
 		return nil
 	}
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if queries.Equal(local.WithdrawalCryptoID, foreign.ID) {
-				local.R.WithdrawalCrypto = foreign
+			if queries.Equal(local.WithdrawalHistoryID, foreign.ID) {
+				local.R.WithdrawalHistory = foreign
 				if foreign.R == nil {
 					foreign.R = &withdrawalHistoryR{}
 				}
-				foreign.R.WithdrawalCryptoWithdrawalCryptos = append(foreign.R.WithdrawalCryptoWithdrawalCryptos, local)
+				foreign.R.WithdrawalCryptos = append(foreign.R.WithdrawalCryptos, local)
 				break
 			}
 		}
@@ -500,10 +485,10 @@ func (withdrawalCryptoL) LoadWithdrawalCrypto(ctx context.Context, e boil.Contex
 	return nil
 }
 
-// SetWithdrawalCrypto of the withdrawalCrypto to the related item.
-// Sets o.R.WithdrawalCrypto to related.
-// Adds o to related.R.WithdrawalCryptoWithdrawalCryptos.
-func (o *WithdrawalCrypto) SetWithdrawalCrypto(ctx context.Context, exec boil.ContextExecutor, insert bool, related *WithdrawalHistory) error {
+// SetWithdrawalHistory of the withdrawalCrypto to the related item.
+// Sets o.R.WithdrawalHistory to related.
+// Adds o to related.R.WithdrawalCryptos.
+func (o *WithdrawalCrypto) SetWithdrawalHistory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *WithdrawalHistory) error {
 	var err error
 	if insert {
 		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
@@ -513,7 +498,7 @@ func (o *WithdrawalCrypto) SetWithdrawalCrypto(ctx context.Context, exec boil.Co
 
 	updateQuery := fmt.Sprintf(
 		"UPDATE \"withdrawal_crypto\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"withdrawal_crypto_id"}),
+		strmangle.SetParamNames("\"", "\"", 1, []string{"withdrawal_history_id"}),
 		strmangle.WhereClause("\"", "\"", 2, withdrawalCryptoPrimaryKeyColumns),
 	)
 	values := []interface{}{related.ID, o.ID}
@@ -527,52 +512,52 @@ func (o *WithdrawalCrypto) SetWithdrawalCrypto(ctx context.Context, exec boil.Co
 		return errors.Wrap(err, "failed to update local table")
 	}
 
-	queries.Assign(&o.WithdrawalCryptoID, related.ID)
+	queries.Assign(&o.WithdrawalHistoryID, related.ID)
 	if o.R == nil {
 		o.R = &withdrawalCryptoR{
-			WithdrawalCrypto: related,
+			WithdrawalHistory: related,
 		}
 	} else {
-		o.R.WithdrawalCrypto = related
+		o.R.WithdrawalHistory = related
 	}
 
 	if related.R == nil {
 		related.R = &withdrawalHistoryR{
-			WithdrawalCryptoWithdrawalCryptos: WithdrawalCryptoSlice{o},
+			WithdrawalCryptos: WithdrawalCryptoSlice{o},
 		}
 	} else {
-		related.R.WithdrawalCryptoWithdrawalCryptos = append(related.R.WithdrawalCryptoWithdrawalCryptos, o)
+		related.R.WithdrawalCryptos = append(related.R.WithdrawalCryptos, o)
 	}
 
 	return nil
 }
 
-// RemoveWithdrawalCrypto relationship.
-// Sets o.R.WithdrawalCrypto to nil.
+// RemoveWithdrawalHistory relationship.
+// Sets o.R.WithdrawalHistory to nil.
 // Removes o from all passed in related items' relationships struct (Optional).
-func (o *WithdrawalCrypto) RemoveWithdrawalCrypto(ctx context.Context, exec boil.ContextExecutor, related *WithdrawalHistory) error {
+func (o *WithdrawalCrypto) RemoveWithdrawalHistory(ctx context.Context, exec boil.ContextExecutor, related *WithdrawalHistory) error {
 	var err error
 
-	queries.SetScanner(&o.WithdrawalCryptoID, nil)
-	if _, err = o.Update(ctx, exec, boil.Whitelist("withdrawal_crypto_id")); err != nil {
+	queries.SetScanner(&o.WithdrawalHistoryID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("withdrawal_history_id")); err != nil {
 		return errors.Wrap(err, "failed to update local table")
 	}
 
-	o.R.WithdrawalCrypto = nil
+	o.R.WithdrawalHistory = nil
 	if related == nil || related.R == nil {
 		return nil
 	}
 
-	for i, ri := range related.R.WithdrawalCryptoWithdrawalCryptos {
-		if queries.Equal(o.WithdrawalCryptoID, ri.WithdrawalCryptoID) {
+	for i, ri := range related.R.WithdrawalCryptos {
+		if queries.Equal(o.WithdrawalHistoryID, ri.WithdrawalHistoryID) {
 			continue
 		}
 
-		ln := len(related.R.WithdrawalCryptoWithdrawalCryptos)
+		ln := len(related.R.WithdrawalCryptos)
 		if ln > 1 && i < ln-1 {
-			related.R.WithdrawalCryptoWithdrawalCryptos[i] = related.R.WithdrawalCryptoWithdrawalCryptos[ln-1]
+			related.R.WithdrawalCryptos[i] = related.R.WithdrawalCryptos[ln-1]
 		}
-		related.R.WithdrawalCryptoWithdrawalCryptos = related.R.WithdrawalCryptoWithdrawalCryptos[:ln-1]
+		related.R.WithdrawalCryptos = related.R.WithdrawalCryptos[:ln-1]
 		break
 	}
 	return nil
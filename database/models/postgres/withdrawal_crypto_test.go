@@ -494,7 +494,7 @@ func testWithdrawalCryptosInsertWhitelist(t *testing.T) {
 	}
 }
 
-func testWithdrawalCryptoToOneWithdrawalHistoryUsingWithdrawalCrypto(t *testing.T) {
+func testWithdrawalCryptoToOneWithdrawalHistoryUsingWithdrawalHistory(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
@@ -514,12 +514,12 @@ func testWithdrawalCryptoToOneWithdrawalHistoryUsingWithdrawalCrypto(t *testing.
 		t.Fatal(err)
 	}
 
-	queries.Assign(&local.WithdrawalCryptoID, foreign.ID)
+	queries.Assign(&local.WithdrawalHistoryID, foreign.ID)
 	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
-	check, err := local.WithdrawalCrypto().One(ctx, tx)
+	check, err := local.WithdrawalHistory().One(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -529,23 +529,23 @@ func testWithdrawalCryptoToOneWithdrawalHistoryUsingWithdrawalCrypto(t *testing.
 	}
 
 	slice := WithdrawalCryptoSlice{&local}
-	if err = local.L.LoadWithdrawalCrypto(ctx, tx, false, (*[]*WithdrawalCrypto)(&slice), nil); err != nil {
+	if err = local.L.LoadWithdrawalHistory(ctx, tx, false, (*[]*WithdrawalCrypto)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if local.R.WithdrawalCrypto == nil {
+	if local.R.WithdrawalHistory == nil {
 		t.Error("struct should have been eager loaded")
 	}
 
-	local.R.WithdrawalCrypto = nil
-	if err = local.L.LoadWithdrawalCrypto(ctx, tx, true, &local, nil); err != nil {
+	local.R.WithdrawalHistory = nil
+	if err = local.L.LoadWithdrawalHistory(ctx, tx, true, &local, nil); err != nil {
 		t.Fatal(err)
 	}
-	if local.R.WithdrawalCrypto == nil {
+	if local.R.WithdrawalHistory == nil {
 		t.Error("struct should have been eager loaded")
 	}
 }
 
-func testWithdrawalCryptoToOneSetOpWithdrawalHistoryUsingWithdrawalCrypto(t *testing.T) {
+func testWithdrawalCryptoToOneSetOpWithdrawalHistoryUsingWithdrawalHistory(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -574,36 +574,36 @@ func testWithdrawalCryptoToOneSetOpWithdrawalHistoryUsingWithdrawalCrypto(t *tes
 	}
 
 	for i, x := range []*WithdrawalHistory{&b, &c} {
-		err = a.SetWithdrawalCrypto(ctx, tx, i != 0, x)
+		err = a.SetWithdrawalHistory(ctx, tx, i != 0, x)
 		if err != nil {
 			t.Fatal(err)
 		}
 
-		if a.R.WithdrawalCrypto != x {
+		if a.R.WithdrawalHistory != x {
 			t.Error("relationship struct not set to correct value")
 		}
 
-		if x.R.WithdrawalCryptoWithdrawalCryptos[0] != &a {
+		if x.R.WithdrawalCryptos[0] != &a {
 			t.Error("failed to append to foreign relationship struct")
 		}
-		if !queries.Equal(a.WithdrawalCryptoID, x.ID) {
-			t.Error("foreign key was wrong value", a.WithdrawalCryptoID)
+		if !queries.Equal(a.WithdrawalHistoryID, x.ID) {
+			t.Error("foreign key was wrong value", a.WithdrawalHistoryID)
 		}
 
-		zero := reflect.Zero(reflect.TypeOf(a.WithdrawalCryptoID))
-		reflect.Indirect(reflect.ValueOf(&a.WithdrawalCryptoID)).Set(zero)
+		zero := reflect.Zero(reflect.TypeOf(a.WithdrawalHistoryID))
+		reflect.Indirect(reflect.ValueOf(&a.WithdrawalHistoryID)).Set(zero)
 
 		if err = a.Reload(ctx, tx); err != nil {
 			t.Fatal("failed to reload", err)
 		}
 
-		if !queries.Equal(a.WithdrawalCryptoID, x.ID) {
-			t.Error("foreign key was wrong value", a.WithdrawalCryptoID, x.ID)
+		if !queries.Equal(a.WithdrawalHistoryID, x.ID) {
+			t.Error("foreign key was wrong value", a.WithdrawalHistoryID, x.ID)
 		}
 	}
 }
 
-func testWithdrawalCryptoToOneRemoveOpWithdrawalHistoryUsingWithdrawalCrypto(t *testing.T) {
+func testWithdrawalCryptoToOneRemoveOpWithdrawalHistoryUsingWithdrawalHistory(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -625,15 +625,15 @@ func testWithdrawalCryptoToOneRemoveOpWithdrawalHistoryUsingWithdrawalCrypto(t *
 		t.Fatal(err)
 	}
 
-	if err = a.SetWithdrawalCrypto(ctx, tx, true, &b); err != nil {
+	if err = a.SetWithdrawalHistory(ctx, tx, true, &b); err != nil {
 		t.Fatal(err)
 	}
 
-	if err = a.RemoveWithdrawalCrypto(ctx, tx, &b); err != nil {
+	if err = a.RemoveWithdrawalHistory(ctx, tx, &b); err != nil {
 		t.Error("failed to remove relationship")
 	}
 
-	count, err := a.WithdrawalCrypto().Count(ctx, tx)
+	count, err := a.WithdrawalHistory().Count(ctx, tx)
 	if err != nil {
 		t.Error(err)
 	}
@@ -641,15 +641,15 @@ func testWithdrawalCryptoToOneRemoveOpWithdrawalHistoryUsingWithdrawalCrypto(t *
 		t.Error("want no relationships remaining")
 	}
 
-	if a.R.WithdrawalCrypto != nil {
+	if a.R.WithdrawalHistory != nil {
 		t.Error("R struct entry should be nil")
 	}
 
-	if !queries.IsValuerNil(a.WithdrawalCryptoID) {
+	if !queries.IsValuerNil(a.WithdrawalHistoryID) {
 		t.Error("foreign key value should be nil")
 	}
 
-	if len(b.R.WithdrawalCryptoWithdrawalCryptos) != 0 {
+	if len(b.R.WithdrawalCryptos) != 0 {
 		t.Error("failed to remove a from b's relationships")
 	}
 }
@@ -728,7 +728,7 @@ func testWithdrawalCryptosSelect(t *testing.T) {
 }
 
 var (
-	withdrawalCryptoDBTypes = map[string]string{`ID`: `bigint`, `WithdrawalCryptoID`: `uuid`, `Address`: `text`, `AddressTag`: `text`, `Fee`: `double precision`}
+	withdrawalCryptoDBTypes = map[string]string{`ID`: `bigint`, `WithdrawalHistoryID`: `uuid`, `Address`: `text`, `AddressTag`: `text`, `Fee`: `double precision`}
 	_                       = bytes.MinRead
 )
 
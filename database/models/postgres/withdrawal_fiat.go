@@ -24,81 +24,81 @@ import (
 
 // WithdrawalFiat is an object representing the database table.
 type WithdrawalFiat struct {
-	ID                int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	WithdrawalFiatID  null.String `boil:"withdrawal_fiat_id" json:"withdrawal_fiat_id,omitempty" toml:"withdrawal_fiat_id" yaml:"withdrawal_fiat_id,omitempty"`
-	BankName          string      `boil:"bank_name" json:"bank_name" toml:"bank_name" yaml:"bank_name"`
-	BankAddress       string      `boil:"bank_address" json:"bank_address" toml:"bank_address" yaml:"bank_address"`
-	BankAccountName   string      `boil:"bank_account_name" json:"bank_account_name" toml:"bank_account_name" yaml:"bank_account_name"`
-	BankAccountNumber string      `boil:"bank_account_number" json:"bank_account_number" toml:"bank_account_number" yaml:"bank_account_number"`
-	BSB               string      `boil:"bsb" json:"bsb" toml:"bsb" yaml:"bsb"`
-	SwiftCode         string      `boil:"swift_code" json:"swift_code" toml:"swift_code" yaml:"swift_code"`
-	Iban              string      `boil:"iban" json:"iban" toml:"iban" yaml:"iban"`
-	BankCode          float64     `boil:"bank_code" json:"bank_code" toml:"bank_code" yaml:"bank_code"`
+	ID                  int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	WithdrawalHistoryID null.String `boil:"withdrawal_history_id" json:"withdrawal_history_id,omitempty" toml:"withdrawal_history_id" yaml:"withdrawal_history_id,omitempty"`
+	BankName            string      `boil:"bank_name" json:"bank_name" toml:"bank_name" yaml:"bank_name"`
+	BankAddress         string      `boil:"bank_address" json:"bank_address" toml:"bank_address" yaml:"bank_address"`
+	BankAccountName     string      `boil:"bank_account_name" json:"bank_account_name" toml:"bank_account_name" yaml:"bank_account_name"`
+	BankAccountNumber   string      `boil:"bank_account_number" json:"bank_account_number" toml:"bank_account_number" yaml:"bank_account_number"`
+	BSB                 string      `boil:"bsb" json:"bsb" toml:"bsb" yaml:"bsb"`
+	SwiftCode           string      `boil:"swift_code" json:"swift_code" toml:"swift_code" yaml:"swift_code"`
+	Iban                string      `boil:"iban" json:"iban" toml:"iban" yaml:"iban"`
+	BankCode            float64     `boil:"bank_code" json:"bank_code" toml:"bank_code" yaml:"bank_code"`
 
 	R *withdrawalFiatR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L withdrawalFiatL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var WithdrawalFiatColumns = struct {
-	ID                string
-	WithdrawalFiatID  string
-	BankName          string
-	BankAddress       string
-	BankAccountName   string
-	BankAccountNumber string
-	BSB               string
-	SwiftCode         string
-	Iban              string
-	BankCode          string
+	ID                  string
+	WithdrawalHistoryID string
+	BankName            string
+	BankAddress         string
+	BankAccountName     string
+	BankAccountNumber   string
+	BSB                 string
+	SwiftCode           string
+	Iban                string
+	BankCode            string
 }{
-	ID:                "id",
-	WithdrawalFiatID:  "withdrawal_fiat_id",
-	BankName:          "bank_name",
-	BankAddress:       "bank_address",
-	BankAccountName:   "bank_account_name",
-	BankAccountNumber: "bank_account_number",
-	BSB:               "bsb",
-	SwiftCode:         "swift_code",
-	Iban:              "iban",
-	BankCode:          "bank_code",
+	ID:                  "id",
+	WithdrawalHistoryID: "withdrawal_history_id",
+	BankName:            "bank_name",
+	BankAddress:         "bank_address",
+	BankAccountName:     "bank_account_name",
+	BankAccountNumber:   "bank_account_number",
+	BSB:                 "bsb",
+	SwiftCode:           "swift_code",
+	Iban:                "iban",
+	BankCode:            "bank_code",
 }
 
 // Generated where
 
 var WithdrawalFiatWhere = struct {
-	ID                whereHelperint64
-	WithdrawalFiatID  whereHelpernull_String
-	BankName          whereHelperstring
-	BankAddress       whereHelperstring
-	BankAccountName   whereHelperstring
-	BankAccountNumber whereHelperstring
-	BSB               whereHelperstring
-	SwiftCode         whereHelperstring
-	Iban              whereHelperstring
-	BankCode          whereHelperfloat64
+	ID                  whereHelperint64
+	WithdrawalHistoryID whereHelpernull_String
+	BankName            whereHelperstring
+	BankAddress         whereHelperstring
+	BankAccountName     whereHelperstring
+	BankAccountNumber   whereHelperstring
+	BSB                 whereHelperstring
+	SwiftCode           whereHelperstring
+	Iban                whereHelperstring
+	BankCode            whereHelperfloat64
 }{
-	ID:                whereHelperint64{field: "\"withdrawal_fiat\".\"id\""},
-	WithdrawalFiatID:  whereHelpernull_String{field: "\"withdrawal_fiat\".\"withdrawal_fiat_id\""},
-	BankName:          whereHelperstring{field: "\"withdrawal_fiat\".\"bank_name\""},
-	BankAddress:       whereHelperstring{field: "\"withdrawal_fiat\".\"bank_address\""},
-	BankAccountName:   whereHelperstring{field: "\"withdrawal_fiat\".\"bank_account_name\""},
-	BankAccountNumber: whereHelperstring{field: "\"withdrawal_fiat\".\"bank_account_number\""},
-	BSB:               whereHelperstring{field: "\"withdrawal_fiat\".\"bsb\""},
-	SwiftCode:         whereHelperstring{field: "\"withdrawal_fiat\".\"swift_code\""},
-	Iban:              whereHelperstring{field: "\"withdrawal_fiat\".\"iban\""},
-	BankCode:          whereHelperfloat64{field: "\"withdrawal_fiat\".\"bank_code\""},
+	ID:                  whereHelperint64{field: "\"withdrawal_fiat\".\"id\""},
+	WithdrawalHistoryID: whereHelpernull_String{field: "\"withdrawal_fiat\".\"withdrawal_history_id\""},
+	BankName:            whereHelperstring{field: "\"withdrawal_fiat\".\"bank_name\""},
+	BankAddress:         whereHelperstring{field: "\"withdrawal_fiat\".\"bank_address\""},
+	BankAccountName:     whereHelperstring{field: "\"withdrawal_fiat\".\"bank_account_name\""},
+	BankAccountNumber:   whereHelperstring{field: "\"withdrawal_fiat\".\"bank_account_number\""},
+	BSB:                 whereHelperstring{field: "\"withdrawal_fiat\".\"bsb\""},
+	SwiftCode:           whereHelperstring{field: "\"withdrawal_fiat\".\"swift_code\""},
+	Iban:                whereHelperstring{field: "\"withdrawal_fiat\".\"iban\""},
+	BankCode:            whereHelperfloat64{field: "\"withdrawal_fiat\".\"bank_code\""},
 }
 
 // WithdrawalFiatRels is where relationship names are stored.
 var WithdrawalFiatRels = struct {
-	WithdrawalFiat string
+	WithdrawalHistory string
 }{
-	WithdrawalFiat: "WithdrawalFiat",
+	WithdrawalHistory: "WithdrawalHistory",
 }
 
 // withdrawalFiatR is where relationships are stored.
 type withdrawalFiatR struct {
-	WithdrawalFiat *WithdrawalHistory
+	WithdrawalHistory *WithdrawalHistory
 }
 
 // NewStruct creates a new relationship struct
@@ -110,8 +110,8 @@ func (*withdrawalFiatR) NewStruct() *withdrawalFiatR {
 type withdrawalFiatL struct{}
 
 var (
-	withdrawalFiatAllColumns            = []string{"id", "withdrawal_fiat_id", "bank_name", "bank_address", "bank_account_name", "bank_account_number", "bsb", "swift_code", "iban", "bank_code"}
-	withdrawalFiatColumnsWithoutDefault = []string{"withdrawal_fiat_id", "bank_name", "bank_address", "bank_account_name", "bank_account_number", "bsb", "swift_code", "iban", "bank_code"}
+	withdrawalFiatAllColumns            = []string{"id", "withdrawal_history_id", "bank_name", "bank_address", "bank_account_name", "bank_account_number", "bsb", "swift_code", "iban", "bank_code"}
+	withdrawalFiatColumnsWithoutDefault = []string{"withdrawal_history_id", "bank_name", "bank_address", "bank_account_name", "bank_account_number", "bsb", "swift_code", "iban", "bank_code"}
 	withdrawalFiatColumnsWithDefault    = []string{"id"}
 	withdrawalFiatPrimaryKeyColumns     = []string{"id"}
 )
@@ -391,10 +391,10 @@ func (q withdrawalFiatQuery) Exists(ctx context.Context, exec boil.ContextExecut
 	return count > 0, nil
 }
 
-// WithdrawalFiat pointed to by the foreign key.
-func (o *WithdrawalFiat) WithdrawalFiat(mods ...qm.QueryMod) withdrawalHistoryQuery {
+// WithdrawalHistory pointed to by the foreign key.
+func (o *WithdrawalFiat) WithdrawalHistory(mods ...qm.QueryMod) withdrawalHistoryQuery {
 	queryMods := []qm.QueryMod{
-		qm.Where("\"id\" = ?", o.WithdrawalFiatID),
+		qm.Where("\"id\" = ?", o.WithdrawalHistoryID),
 	}
 
 	queryMods = append(queryMods, mods...)
@@ -405,9 +405,9 @@ func (o *WithdrawalFiat) WithdrawalFiat(mods ...qm.QueryMod) withdrawalHistoryQu
 	return query
 }
 
-// LoadWithdrawalFiat allows an eager lookup of values, cached into the
+// LoadWithdrawalHistory allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (withdrawalFiatL) LoadWithdrawalFiat(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWithdrawalFiat interface{}, mods queries.Applicator) error {
+func (withdrawalFiatL) LoadWithdrawalHistory(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWithdrawalFiat interface{}, mods queries.Applicator) error {
 	var slice []*WithdrawalFiat
 	var object *WithdrawalFiat
 
@@ -422,8 +422,8 @@ func (withdrawalFiatL) LoadWithdrawalFiat(ctx context.Context, e boil.ContextExe
 		if object.R == nil {
 			object.R = &withdrawalFiatR{}
 		}
-		if !queries.IsNil(object.WithdrawalFiatID) {
-			args = append(args, object.WithdrawalFiatID)
+		if !queries.IsNil(object.WithdrawalHistoryID) {
+			args = append(args, object.WithdrawalHistoryID)
 		}
 
 	} else {
@@ -434,13 +434,13 @@ func (withdrawalFiatL) LoadWithdrawalFiat(ctx context.Context, e boil.ContextExe
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.WithdrawalFiatID) {
+				if queries.Equal(a, obj.WithdrawalHistoryID) {
 					continue Outer
 				}
 			}
 
-			if !queries.IsNil(obj.WithdrawalFiatID) {
-				args = append(args, obj.WithdrawalFiatID)
+			if !queries.IsNil(obj.WithdrawalHistoryID) {
+				args = append(args, obj.WithdrawalHistoryID)
 			}
 
 		}
@@ -486,22 +486,22 @@ func (withdrawalFiatL) LoadWithdrawalFiat(ctx context.Context, e boil.ContextExe
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.WithdrawalFiat = foreign
+		object.R.WithdrawalHistory = foreign
 		if foreign.R == nil {
 			foreign.R = &withdrawalHistoryR{}
 		}
-		foreign.R.WithdrawalFiatWithdrawalFiats = append(foreign.R.WithdrawalFiatWithdrawalFiats, object)
+		foreign.R.WithdrawalFiats = append(foreign.R.WithdrawalFiats, object)
 		return nil
 	}
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if queries.Equal(local.WithdrawalFiatID, foreign.ID) {
-				local.R.WithdrawalFiat = foreign
+			if queries.Equal(local.WithdrawalHistoryID, foreign.ID) {
+				local.R.WithdrawalHistory = foreign
 				if foreign.R == nil {
 					foreign.R = &withdrawalHistoryR{}
 				}
-				foreign.R.WithdrawalFiatWithdrawalFiats = append(foreign.R.WithdrawalFiatWithdrawalFiats, local)
+				foreign.R.WithdrawalFiats = append(foreign.R.WithdrawalFiats, local)
 				break
 			}
 		}
@@ -510,10 +510,10 @@ func (withdrawalFiatL) LoadWithdrawalFiat(ctx context.Context, e boil.ContextExe
 	return nil
 }
 
-// SetWithdrawalFiat of the withdrawalFiat to the related item.
-// Sets o.R.WithdrawalFiat to related.
-// Adds o to related.R.WithdrawalFiatWithdrawalFiats.
-func (o *WithdrawalFiat) SetWithdrawalFiat(ctx context.Context, exec boil.ContextExecutor, insert bool, related *WithdrawalHistory) error {
+// SetWithdrawalHistory of the withdrawalFiat to the related item.
+// Sets o.R.WithdrawalHistory to related.
+// Adds o to related.R.WithdrawalFiats.
+func (o *WithdrawalFiat) SetWithdrawalHistory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *WithdrawalHistory) error {
 	var err error
 	if insert {
 		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
@@ -523,7 +523,7 @@ func (o *WithdrawalFiat) SetWithdrawalFiat(ctx context.Context, exec boil.Contex
 
 	updateQuery := fmt.Sprintf(
 		"UPDATE \"withdrawal_fiat\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"withdrawal_fiat_id"}),
+		strmangle.SetParamNames("\"", "\"", 1, []string{"withdrawal_history_id"}),
 		strmangle.WhereClause("\"", "\"", 2, withdrawalFiatPrimaryKeyColumns),
 	)
 	values := []interface{}{related.ID, o.ID}
@@ -537,52 +537,52 @@ func (o *WithdrawalFiat) SetWithdrawalFiat(ctx context.Context, exec boil.Contex
 		return errors.Wrap(err, "failed to update local table")
 	}
 
-	queries.Assign(&o.WithdrawalFiatID, related.ID)
+	queries.Assign(&o.WithdrawalHistoryID, related.ID)
 	if o.R == nil {
 		o.R = &withdrawalFiatR{
-			WithdrawalFiat: related,
+			WithdrawalHistory: related,
 		}
 	} else {
-		o.R.WithdrawalFiat = related
+		o.R.WithdrawalHistory = related
 	}
 
 	if related.R == nil {
 		related.R = &withdrawalHistoryR{
-			WithdrawalFiatWithdrawalFiats: WithdrawalFiatSlice{o},
+			WithdrawalFiats: WithdrawalFiatSlice{o},
 		}
 	} else {
-		related.R.WithdrawalFiatWithdrawalFiats = append(related.R.WithdrawalFiatWithdrawalFiats, o)
+		related.R.WithdrawalFiats = append(related.R.WithdrawalFiats, o)
 	}
 
 	return nil
 }
 
-// RemoveWithdrawalFiat relationship.
-// Sets o.R.WithdrawalFiat to nil.
+// RemoveWithdrawalHistory relationship.
+// Sets o.R.WithdrawalHistory to nil.
 // Removes o from all passed in related items' relationships struct (Optional).
-func (o *WithdrawalFiat) RemoveWithdrawalFiat(ctx context.Context, exec boil.ContextExecutor, related *WithdrawalHistory) error {
+func (o *WithdrawalFiat) RemoveWithdrawalHistory(ctx context.Context, exec boil.ContextExecutor, related *WithdrawalHistory) error {
 	var err error
 
-	queries.SetScanner(&o.WithdrawalFiatID, nil)
-	if _, err = o.Update(ctx, exec, boil.Whitelist("withdrawal_fiat_id")); err != nil {
+	queries.SetScanner(&o.WithdrawalHistoryID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("withdrawal_history_id")); err != nil {
 		return errors.Wrap(err, "failed to update local table")
 	}
 
-	o.R.WithdrawalFiat = nil
+	o.R.WithdrawalHistory = nil
 	if related == nil || related.R == nil {
 		return nil
 	}
 
-	for i, ri := range related.R.WithdrawalFiatWithdrawalFiats {
-		if queries.Equal(o.WithdrawalFiatID, ri.WithdrawalFiatID) {
+	for i, ri := range related.R.WithdrawalFiats {
+		if queries.Equal(o.WithdrawalHistoryID, ri.WithdrawalHistoryID) {
 			continue
 		}
 
-		ln := len(related.R.WithdrawalFiatWithdrawalFiats)
+		ln := len(related.R.WithdrawalFiats)
 		if ln > 1 && i < ln-1 {
-			related.R.WithdrawalFiatWithdrawalFiats[i] = related.R.WithdrawalFiatWithdrawalFiats[ln-1]
+			related.R.WithdrawalFiats[i] = related.R.WithdrawalFiats[ln-1]
 		}
-		related.R.WithdrawalFiatWithdrawalFiats = related.R.WithdrawalFiatWithdrawalFiats[:ln-1]
+		related.R.WithdrawalFiats = related.R.WithdrawalFiats[:ln-1]
 		break
 	}
 	return nil
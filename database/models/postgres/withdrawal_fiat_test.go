@@ -494,7 +494,7 @@ func testWithdrawalFiatsInsertWhitelist(t *testing.T) {
 	}
 }
 
-func testWithdrawalFiatToOneWithdrawalHistoryUsingWithdrawalFiat(t *testing.T) {
+func testWithdrawalFiatToOneWithdrawalHistoryUsingWithdrawalHistory(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
@@ -514,12 +514,12 @@ func testWithdrawalFiatToOneWithdrawalHistoryUsingWithdrawalFiat(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	queries.Assign(&local.WithdrawalFiatID, foreign.ID)
+	queries.Assign(&local.WithdrawalHistoryID, foreign.ID)
 	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
-	check, err := local.WithdrawalFiat().One(ctx, tx)
+	check, err := local.WithdrawalHistory().One(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -529,23 +529,23 @@ func testWithdrawalFiatToOneWithdrawalHistoryUsingWithdrawalFiat(t *testing.T) {
 	}
 
 	slice := WithdrawalFiatSlice{&local}
-	if err = local.L.LoadWithdrawalFiat(ctx, tx, false, (*[]*WithdrawalFiat)(&slice), nil); err != nil {
+	if err = local.L.LoadWithdrawalHistory(ctx, tx, false, (*[]*WithdrawalFiat)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if local.R.WithdrawalFiat == nil {
+	if local.R.WithdrawalHistory == nil {
 		t.Error("struct should have been eager loaded")
 	}
 
-	local.R.WithdrawalFiat = nil
-	if err = local.L.LoadWithdrawalFiat(ctx, tx, true, &local, nil); err != nil {
+	local.R.WithdrawalHistory = nil
+	if err = local.L.LoadWithdrawalHistory(ctx, tx, true, &local, nil); err != nil {
 		t.Fatal(err)
 	}
-	if local.R.WithdrawalFiat == nil {
+	if local.R.WithdrawalHistory == nil {
 		t.Error("struct should have been eager loaded")
 	}
 }
 
-func testWithdrawalFiatToOneSetOpWithdrawalHistoryUsingWithdrawalFiat(t *testing.T) {
+func testWithdrawalFiatToOneSetOpWithdrawalHistoryUsingWithdrawalHistory(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -574,36 +574,36 @@ func testWithdrawalFiatToOneSetOpWithdrawalHistoryUsingWithdrawalFiat(t *testing
 	}
 
 	for i, x := range []*WithdrawalHistory{&b, &c} {
-		err = a.SetWithdrawalFiat(ctx, tx, i != 0, x)
+		err = a.SetWithdrawalHistory(ctx, tx, i != 0, x)
 		if err != nil {
 			t.Fatal(err)
 		}
 
-		if a.R.WithdrawalFiat != x {
+		if a.R.WithdrawalHistory != x {
 			t.Error("relationship struct not set to correct value")
 		}
 
-		if x.R.WithdrawalFiatWithdrawalFiats[0] != &a {
+		if x.R.WithdrawalFiats[0] != &a {
 			t.Error("failed to append to foreign relationship struct")
 		}
-		if !queries.Equal(a.WithdrawalFiatID, x.ID) {
-			t.Error("foreign key was wrong value", a.WithdrawalFiatID)
+		if !queries.Equal(a.WithdrawalHistoryID, x.ID) {
+			t.Error("foreign key was wrong value", a.WithdrawalHistoryID)
 		}
 
-		zero := reflect.Zero(reflect.TypeOf(a.WithdrawalFiatID))
-		reflect.Indirect(reflect.ValueOf(&a.WithdrawalFiatID)).Set(zero)
+		zero := reflect.Zero(reflect.TypeOf(a.WithdrawalHistoryID))
+		reflect.Indirect(reflect.ValueOf(&a.WithdrawalHistoryID)).Set(zero)
 
 		if err = a.Reload(ctx, tx); err != nil {
 			t.Fatal("failed to reload", err)
 		}
 
-		if !queries.Equal(a.WithdrawalFiatID, x.ID) {
-			t.Error("foreign key was wrong value", a.WithdrawalFiatID, x.ID)
+		if !queries.Equal(a.WithdrawalHistoryID, x.ID) {
+			t.Error("foreign key was wrong value", a.WithdrawalHistoryID, x.ID)
 		}
 	}
 }
 
-func testWithdrawalFiatToOneRemoveOpWithdrawalHistoryUsingWithdrawalFiat(t *testing.T) {
+func testWithdrawalFiatToOneRemoveOpWithdrawalHistoryUsingWithdrawalHistory(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -625,15 +625,15 @@ func testWithdrawalFiatToOneRemoveOpWithdrawalHistoryUsingWithdrawalFiat(t *test
 		t.Fatal(err)
 	}
 
-	if err = a.SetWithdrawalFiat(ctx, tx, true, &b); err != nil {
+	if err = a.SetWithdrawalHistory(ctx, tx, true, &b); err != nil {
 		t.Fatal(err)
 	}
 
-	if err = a.RemoveWithdrawalFiat(ctx, tx, &b); err != nil {
+	if err = a.RemoveWithdrawalHistory(ctx, tx, &b); err != nil {
 		t.Error("failed to remove relationship")
 	}
 
-	count, err := a.WithdrawalFiat().Count(ctx, tx)
+	count, err := a.WithdrawalHistory().Count(ctx, tx)
 	if err != nil {
 		t.Error(err)
 	}
@@ -641,15 +641,15 @@ func testWithdrawalFiatToOneRemoveOpWithdrawalHistoryUsingWithdrawalFiat(t *test
 		t.Error("want no relationships remaining")
 	}
 
-	if a.R.WithdrawalFiat != nil {
+	if a.R.WithdrawalHistory != nil {
 		t.Error("R struct entry should be nil")
 	}
 
-	if !queries.IsValuerNil(a.WithdrawalFiatID) {
+	if !queries.IsValuerNil(a.WithdrawalHistoryID) {
 		t.Error("foreign key value should be nil")
 	}
 
-	if len(b.R.WithdrawalFiatWithdrawalFiats) != 0 {
+	if len(b.R.WithdrawalFiats) != 0 {
 		t.Error("failed to remove a from b's relationships")
 	}
 }
@@ -728,7 +728,7 @@ func testWithdrawalFiatsSelect(t *testing.T) {
 }
 
 var (
-	withdrawalFiatDBTypes = map[string]string{`ID`: `bigint`, `WithdrawalFiatID`: `uuid`, `BankName`: `text`, `BankAddress`: `text`, `BankAccountName`: `text`, `BankAccountNumber`: `text`, `BSB`: `text`, `SwiftCode`: `text`, `Iban`: `text`, `BankCode`: `double precision`}
+	withdrawalFiatDBTypes = map[string]string{`ID`: `bigint`, `WithdrawalHistoryID`: `uuid`, `BankName`: `text`, `BankAddress`: `text`, `BankAccountName`: `text`, `BankAccountNumber`: `text`, `BSB`: `text`, `SwiftCode`: `text`, `Iban`: `text`, `BankCode`: `double precision`}
 	_                     = bytes.MinRead
 )
 
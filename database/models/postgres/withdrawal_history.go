@@ -65,22 +65,6 @@ var WithdrawalHistoryColumns = struct {
 
 // Generated where
 
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-
 var WithdrawalHistoryWhere = struct {
 	ID           whereHelperstring
 	Exchange     whereHelperstring
@@ -107,17 +91,20 @@ var WithdrawalHistoryWhere = struct {
 
 // WithdrawalHistoryRels is where relationship names are stored.
 var WithdrawalHistoryRels = struct {
-	WithdrawalCryptoWithdrawalCryptos string
-	WithdrawalFiatWithdrawalFiats     string
+	FundingHistories  string
+	WithdrawalCryptos string
+	WithdrawalFiats   string
 }{
-	WithdrawalCryptoWithdrawalCryptos: "WithdrawalCryptoWithdrawalCryptos",
-	WithdrawalFiatWithdrawalFiats:     "WithdrawalFiatWithdrawalFiats",
+	FundingHistories:  "FundingHistories",
+	WithdrawalCryptos: "WithdrawalCryptos",
+	WithdrawalFiats:   "WithdrawalFiats",
 }
 
 // withdrawalHistoryR is where relationships are stored.
 type withdrawalHistoryR struct {
-	WithdrawalCryptoWithdrawalCryptos WithdrawalCryptoSlice
-	WithdrawalFiatWithdrawalFiats     WithdrawalFiatSlice
+	FundingHistories  FundingHistorySlice
+	WithdrawalCryptos WithdrawalCryptoSlice
+	WithdrawalFiats   WithdrawalFiatSlice
 }
 
 // NewStruct creates a new relationship struct
@@ -410,15 +397,36 @@ func (q withdrawalHistoryQuery) Exists(ctx context.Context, exec boil.ContextExe
 	return count > 0, nil
 }
 
-// WithdrawalCryptoWithdrawalCryptos retrieves all the withdrawal_crypto's WithdrawalCryptos with an executor via withdrawal_crypto_id column.
-func (o *WithdrawalHistory) WithdrawalCryptoWithdrawalCryptos(mods ...qm.QueryMod) withdrawalCryptoQuery {
+// FundingHistories retrieves all the funding_history's FundingHistories with an executor.
+func (o *WithdrawalHistory) FundingHistories(mods ...qm.QueryMod) fundingHistoryQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"withdrawal_crypto\".\"withdrawal_crypto_id\"=?", o.ID),
+		qm.Where("\"funding_history\".\"withdrawal_history_id\"=?", o.ID),
+	)
+
+	query := FundingHistories(queryMods...)
+	queries.SetFrom(query.Query, "\"funding_history\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"funding_history\".*"})
+	}
+
+	return query
+}
+
+// WithdrawalCryptos retrieves all the withdrawal_crypto's WithdrawalCryptos with an executor.
+func (o *WithdrawalHistory) WithdrawalCryptos(mods ...qm.QueryMod) withdrawalCryptoQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"withdrawal_crypto\".\"withdrawal_history_id\"=?", o.ID),
 	)
 
 	query := WithdrawalCryptos(queryMods...)
@@ -431,15 +439,15 @@ func (o *WithdrawalHistory) WithdrawalCryptoWithdrawalCryptos(mods ...qm.QueryMo
 	return query
 }
 
-// WithdrawalFiatWithdrawalFiats retrieves all the withdrawal_fiat's WithdrawalFiats with an executor via withdrawal_fiat_id column.
-func (o *WithdrawalHistory) WithdrawalFiatWithdrawalFiats(mods ...qm.QueryMod) withdrawalFiatQuery {
+// WithdrawalFiats retrieves all the withdrawal_fiat's WithdrawalFiats with an executor.
+func (o *WithdrawalHistory) WithdrawalFiats(mods ...qm.QueryMod) withdrawalFiatQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"withdrawal_fiat\".\"withdrawal_fiat_id\"=?", o.ID),
+		qm.Where("\"withdrawal_fiat\".\"withdrawal_history_id\"=?", o.ID),
 	)
 
 	query := WithdrawalFiats(queryMods...)
@@ -452,9 +460,9 @@ func (o *WithdrawalHistory) WithdrawalFiatWithdrawalFiats(mods ...qm.QueryMod) w
 	return query
 }
 
-// LoadWithdrawalCryptoWithdrawalCryptos allows an eager lookup of values, cached into the
+// LoadFundingHistories allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (withdrawalHistoryL) LoadWithdrawalCryptoWithdrawalCryptos(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWithdrawalHistory interface{}, mods queries.Applicator) error {
+func (withdrawalHistoryL) LoadFundingHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWithdrawalHistory interface{}, mods queries.Applicator) error {
 	var slice []*WithdrawalHistory
 	var object *WithdrawalHistory
 
@@ -491,7 +499,102 @@ func (withdrawalHistoryL) LoadWithdrawalCryptoWithdrawalCryptos(ctx context.Cont
 		return nil
 	}
 
-	query := NewQuery(qm.From(`withdrawal_crypto`), qm.WhereIn(`withdrawal_crypto.withdrawal_crypto_id in ?`, args...))
+	query := NewQuery(qm.From(`funding_history`), qm.WhereIn(`funding_history.withdrawal_history_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load funding_history")
+	}
+
+	var resultSlice []*FundingHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice funding_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on funding_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for funding_history")
+	}
+
+	if len(fundingHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.FundingHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &fundingHistoryR{}
+			}
+			foreign.R.WithdrawalHistory = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.WithdrawalHistoryID) {
+				local.R.FundingHistories = append(local.R.FundingHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &fundingHistoryR{}
+				}
+				foreign.R.WithdrawalHistory = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWithdrawalCryptos allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (withdrawalHistoryL) LoadWithdrawalCryptos(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWithdrawalHistory interface{}, mods queries.Applicator) error {
+	var slice []*WithdrawalHistory
+	var object *WithdrawalHistory
+
+	if singular {
+		object = maybeWithdrawalHistory.(*WithdrawalHistory)
+	} else {
+		slice = *maybeWithdrawalHistory.(*[]*WithdrawalHistory)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &withdrawalHistoryR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &withdrawalHistoryR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`withdrawal_crypto`), qm.WhereIn(`withdrawal_crypto.withdrawal_history_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
@@ -521,24 +624,24 @@ func (withdrawalHistoryL) LoadWithdrawalCryptoWithdrawalCryptos(ctx context.Cont
 		}
 	}
 	if singular {
-		object.R.WithdrawalCryptoWithdrawalCryptos = resultSlice
+		object.R.WithdrawalCryptos = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
 				foreign.R = &withdrawalCryptoR{}
 			}
-			foreign.R.WithdrawalCrypto = object
+			foreign.R.WithdrawalHistory = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.WithdrawalCryptoID) {
-				local.R.WithdrawalCryptoWithdrawalCryptos = append(local.R.WithdrawalCryptoWithdrawalCryptos, foreign)
+			if queries.Equal(local.ID, foreign.WithdrawalHistoryID) {
+				local.R.WithdrawalCryptos = append(local.R.WithdrawalCryptos, foreign)
 				if foreign.R == nil {
 					foreign.R = &withdrawalCryptoR{}
 				}
-				foreign.R.WithdrawalCrypto = local
+				foreign.R.WithdrawalHistory = local
 				break
 			}
 		}
@@ -547,9 +650,9 @@ func (withdrawalHistoryL) LoadWithdrawalCryptoWithdrawalCryptos(ctx context.Cont
 	return nil
 }
 
-// LoadWithdrawalFiatWithdrawalFiats allows an eager lookup of values, cached into the
+// LoadWithdrawalFiats allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (withdrawalHistoryL) LoadWithdrawalFiatWithdrawalFiats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWithdrawalHistory interface{}, mods queries.Applicator) error {
+func (withdrawalHistoryL) LoadWithdrawalFiats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWithdrawalHistory interface{}, mods queries.Applicator) error {
 	var slice []*WithdrawalHistory
 	var object *WithdrawalHistory
 
@@ -586,7 +689,7 @@ func (withdrawalHistoryL) LoadWithdrawalFiatWithdrawalFiats(ctx context.Context,
 		return nil
 	}
 
-	query := NewQuery(qm.From(`withdrawal_fiat`), qm.WhereIn(`withdrawal_fiat.withdrawal_fiat_id in ?`, args...))
+	query := NewQuery(qm.From(`withdrawal_fiat`), qm.WhereIn(`withdrawal_fiat.withdrawal_history_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
@@ -616,24 +719,24 @@ func (withdrawalHistoryL) LoadWithdrawalFiatWithdrawalFiats(ctx context.Context,
 		}
 	}
 	if singular {
-		object.R.WithdrawalFiatWithdrawalFiats = resultSlice
+		object.R.WithdrawalFiats = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
 				foreign.R = &withdrawalFiatR{}
 			}
-			foreign.R.WithdrawalFiat = object
+			foreign.R.WithdrawalHistory = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.WithdrawalFiatID) {
-				local.R.WithdrawalFiatWithdrawalFiats = append(local.R.WithdrawalFiatWithdrawalFiats, foreign)
+			if queries.Equal(local.ID, foreign.WithdrawalHistoryID) {
+				local.R.WithdrawalFiats = append(local.R.WithdrawalFiats, foreign)
 				if foreign.R == nil {
 					foreign.R = &withdrawalFiatR{}
 				}
-				foreign.R.WithdrawalFiat = local
+				foreign.R.WithdrawalHistory = local
 				break
 			}
 		}
@@ -642,22 +745,145 @@ func (withdrawalHistoryL) LoadWithdrawalFiatWithdrawalFiats(ctx context.Context,
 	return nil
 }
 
-// AddWithdrawalCryptoWithdrawalCryptos adds the given related objects to the existing relationships
+// AddFundingHistories adds the given related objects to the existing relationships
+// of the withdrawal_history, optionally inserting them as new records.
+// Appends related to o.R.FundingHistories.
+// Sets related.R.WithdrawalHistory appropriately.
+func (o *WithdrawalHistory) AddFundingHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FundingHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.WithdrawalHistoryID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"funding_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"withdrawal_history_id"}),
+				strmangle.WhereClause("\"", "\"", 2, fundingHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.WithdrawalHistoryID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &withdrawalHistoryR{
+			FundingHistories: related,
+		}
+	} else {
+		o.R.FundingHistories = append(o.R.FundingHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &fundingHistoryR{
+				WithdrawalHistory: o,
+			}
+		} else {
+			rel.R.WithdrawalHistory = o
+		}
+	}
+	return nil
+}
+
+// SetFundingHistories removes all previously related items of the
+// withdrawal_history replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.WithdrawalHistory's FundingHistories accordingly.
+// Replaces o.R.FundingHistories with related.
+// Sets related.R.WithdrawalHistory's FundingHistories accordingly.
+func (o *WithdrawalHistory) SetFundingHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FundingHistory) error {
+	query := "update \"funding_history\" set \"withdrawal_history_id\" = null where \"withdrawal_history_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.FundingHistories {
+			queries.SetScanner(&rel.WithdrawalHistoryID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.WithdrawalHistory = nil
+		}
+
+		o.R.FundingHistories = nil
+	}
+	return o.AddFundingHistories(ctx, exec, insert, related...)
+}
+
+// RemoveFundingHistories relationships from objects passed in.
+// Removes related items from R.FundingHistories (uses pointer comparison, removal does not keep order)
+// Sets related.R.WithdrawalHistory.
+func (o *WithdrawalHistory) RemoveFundingHistories(ctx context.Context, exec boil.ContextExecutor, related ...*FundingHistory) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.WithdrawalHistoryID, nil)
+		if rel.R != nil {
+			rel.R.WithdrawalHistory = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("withdrawal_history_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.FundingHistories {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.FundingHistories)
+			if ln > 1 && i < ln-1 {
+				o.R.FundingHistories[i] = o.R.FundingHistories[ln-1]
+			}
+			o.R.FundingHistories = o.R.FundingHistories[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddWithdrawalCryptos adds the given related objects to the existing relationships
 // of the withdrawal_history, optionally inserting them as new records.
-// Appends related to o.R.WithdrawalCryptoWithdrawalCryptos.
-// Sets related.R.WithdrawalCrypto appropriately.
-func (o *WithdrawalHistory) AddWithdrawalCryptoWithdrawalCryptos(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WithdrawalCrypto) error {
+// Appends related to o.R.WithdrawalCryptos.
+// Sets related.R.WithdrawalHistory appropriately.
+func (o *WithdrawalHistory) AddWithdrawalCryptos(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WithdrawalCrypto) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.WithdrawalCryptoID, o.ID)
+			queries.Assign(&rel.WithdrawalHistoryID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
 				"UPDATE \"withdrawal_crypto\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"withdrawal_crypto_id"}),
+				strmangle.SetParamNames("\"", "\"", 1, []string{"withdrawal_history_id"}),
 				strmangle.WhereClause("\"", "\"", 2, withdrawalCryptoPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
@@ -671,38 +897,38 @@ func (o *WithdrawalHistory) AddWithdrawalCryptoWithdrawalCryptos(ctx context.Con
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.WithdrawalCryptoID, o.ID)
+			queries.Assign(&rel.WithdrawalHistoryID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &withdrawalHistoryR{
-			WithdrawalCryptoWithdrawalCryptos: related,
+			WithdrawalCryptos: related,
 		}
 	} else {
-		o.R.WithdrawalCryptoWithdrawalCryptos = append(o.R.WithdrawalCryptoWithdrawalCryptos, related...)
+		o.R.WithdrawalCryptos = append(o.R.WithdrawalCryptos, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
 			rel.R = &withdrawalCryptoR{
-				WithdrawalCrypto: o,
+				WithdrawalHistory: o,
 			}
 		} else {
-			rel.R.WithdrawalCrypto = o
+			rel.R.WithdrawalHistory = o
 		}
 	}
 	return nil
 }
 
-// SetWithdrawalCryptoWithdrawalCryptos removes all previously related items of the
+// SetWithdrawalCryptos removes all previously related items of the
 // withdrawal_history replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.WithdrawalCrypto's WithdrawalCryptoWithdrawalCryptos accordingly.
-// Replaces o.R.WithdrawalCryptoWithdrawalCryptos with related.
-// Sets related.R.WithdrawalCrypto's WithdrawalCryptoWithdrawalCryptos accordingly.
-func (o *WithdrawalHistory) SetWithdrawalCryptoWithdrawalCryptos(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WithdrawalCrypto) error {
-	query := "update \"withdrawal_crypto\" set \"withdrawal_crypto_id\" = null where \"withdrawal_crypto_id\" = $1"
+// Sets o.R.WithdrawalHistory's WithdrawalCryptos accordingly.
+// Replaces o.R.WithdrawalCryptos with related.
+// Sets related.R.WithdrawalHistory's WithdrawalCryptos accordingly.
+func (o *WithdrawalHistory) SetWithdrawalCryptos(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WithdrawalCrypto) error {
+	query := "update \"withdrawal_crypto\" set \"withdrawal_history_id\" = null where \"withdrawal_history_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, query)
@@ -715,31 +941,31 @@ func (o *WithdrawalHistory) SetWithdrawalCryptoWithdrawalCryptos(ctx context.Con
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.WithdrawalCryptoWithdrawalCryptos {
-			queries.SetScanner(&rel.WithdrawalCryptoID, nil)
+		for _, rel := range o.R.WithdrawalCryptos {
+			queries.SetScanner(&rel.WithdrawalHistoryID, nil)
 			if rel.R == nil {
 				continue
 			}
 
-			rel.R.WithdrawalCrypto = nil
+			rel.R.WithdrawalHistory = nil
 		}
 
-		o.R.WithdrawalCryptoWithdrawalCryptos = nil
+		o.R.WithdrawalCryptos = nil
 	}
-	return o.AddWithdrawalCryptoWithdrawalCryptos(ctx, exec, insert, related...)
+	return o.AddWithdrawalCryptos(ctx, exec, insert, related...)
 }
 
-// RemoveWithdrawalCryptoWithdrawalCryptos relationships from objects passed in.
-// Removes related items from R.WithdrawalCryptoWithdrawalCryptos (uses pointer comparison, removal does not keep order)
-// Sets related.R.WithdrawalCrypto.
-func (o *WithdrawalHistory) RemoveWithdrawalCryptoWithdrawalCryptos(ctx context.Context, exec boil.ContextExecutor, related ...*WithdrawalCrypto) error {
+// RemoveWithdrawalCryptos relationships from objects passed in.
+// Removes related items from R.WithdrawalCryptos (uses pointer comparison, removal does not keep order)
+// Sets related.R.WithdrawalHistory.
+func (o *WithdrawalHistory) RemoveWithdrawalCryptos(ctx context.Context, exec boil.ContextExecutor, related ...*WithdrawalCrypto) error {
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.WithdrawalCryptoID, nil)
+		queries.SetScanner(&rel.WithdrawalHistoryID, nil)
 		if rel.R != nil {
-			rel.R.WithdrawalCrypto = nil
+			rel.R.WithdrawalHistory = nil
 		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("withdrawal_crypto_id")); err != nil {
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("withdrawal_history_id")); err != nil {
 			return err
 		}
 	}
@@ -748,16 +974,16 @@ func (o *WithdrawalHistory) RemoveWithdrawalCryptoWithdrawalCryptos(ctx context.
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.WithdrawalCryptoWithdrawalCryptos {
+		for i, ri := range o.R.WithdrawalCryptos {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.WithdrawalCryptoWithdrawalCryptos)
+			ln := len(o.R.WithdrawalCryptos)
 			if ln > 1 && i < ln-1 {
-				o.R.WithdrawalCryptoWithdrawalCryptos[i] = o.R.WithdrawalCryptoWithdrawalCryptos[ln-1]
+				o.R.WithdrawalCryptos[i] = o.R.WithdrawalCryptos[ln-1]
 			}
-			o.R.WithdrawalCryptoWithdrawalCryptos = o.R.WithdrawalCryptoWithdrawalCryptos[:ln-1]
+			o.R.WithdrawalCryptos = o.R.WithdrawalCryptos[:ln-1]
 			break
 		}
 	}
@@ -765,22 +991,22 @@ func (o *WithdrawalHistory) RemoveWithdrawalCryptoWithdrawalCryptos(ctx context.
 	return nil
 }
 
-// AddWithdrawalFiatWithdrawalFiats adds the given related objects to the existing relationships
+// AddWithdrawalFiats adds the given related objects to the existing relationships
 // of the withdrawal_history, optionally inserting them as new records.
-// Appends related to o.R.WithdrawalFiatWithdrawalFiats.
-// Sets related.R.WithdrawalFiat appropriately.
-func (o *WithdrawalHistory) AddWithdrawalFiatWithdrawalFiats(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WithdrawalFiat) error {
+// Appends related to o.R.WithdrawalFiats.
+// Sets related.R.WithdrawalHistory appropriately.
+func (o *WithdrawalHistory) AddWithdrawalFiats(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WithdrawalFiat) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.WithdrawalFiatID, o.ID)
+			queries.Assign(&rel.WithdrawalHistoryID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
 				"UPDATE \"withdrawal_fiat\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"withdrawal_fiat_id"}),
+				strmangle.SetParamNames("\"", "\"", 1, []string{"withdrawal_history_id"}),
 				strmangle.WhereClause("\"", "\"", 2, withdrawalFiatPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
@@ -794,38 +1020,38 @@ func (o *WithdrawalHistory) AddWithdrawalFiatWithdrawalFiats(ctx context.Context
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.WithdrawalFiatID, o.ID)
+			queries.Assign(&rel.WithdrawalHistoryID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &withdrawalHistoryR{
-			WithdrawalFiatWithdrawalFiats: related,
+			WithdrawalFiats: related,
 		}
 	} else {
-		o.R.WithdrawalFiatWithdrawalFiats = append(o.R.WithdrawalFiatWithdrawalFiats, related...)
+		o.R.WithdrawalFiats = append(o.R.WithdrawalFiats, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
 			rel.R = &withdrawalFiatR{
-				WithdrawalFiat: o,
+				WithdrawalHistory: o,
 			}
 		} else {
-			rel.R.WithdrawalFiat = o
+			rel.R.WithdrawalHistory = o
 		}
 	}
 	return nil
 }
 
-// SetWithdrawalFiatWithdrawalFiats removes all previously related items of the
+// SetWithdrawalFiats removes all previously related items of the
 // withdrawal_history replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.WithdrawalFiat's WithdrawalFiatWithdrawalFiats accordingly.
-// Replaces o.R.WithdrawalFiatWithdrawalFiats with related.
-// Sets related.R.WithdrawalFiat's WithdrawalFiatWithdrawalFiats accordingly.
-func (o *WithdrawalHistory) SetWithdrawalFiatWithdrawalFiats(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WithdrawalFiat) error {
-	query := "update \"withdrawal_fiat\" set \"withdrawal_fiat_id\" = null where \"withdrawal_fiat_id\" = $1"
+// Sets o.R.WithdrawalHistory's WithdrawalFiats accordingly.
+// Replaces o.R.WithdrawalFiats with related.
+// Sets related.R.WithdrawalHistory's WithdrawalFiats accordingly.
+func (o *WithdrawalHistory) SetWithdrawalFiats(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WithdrawalFiat) error {
+	query := "update \"withdrawal_fiat\" set \"withdrawal_history_id\" = null where \"withdrawal_history_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, query)
@@ -838,31 +1064,31 @@ func (o *WithdrawalHistory) SetWithdrawalFiatWithdrawalFiats(ctx context.Context
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.WithdrawalFiatWithdrawalFiats {
-			queries.SetScanner(&rel.WithdrawalFiatID, nil)
+		for _, rel := range o.R.WithdrawalFiats {
+			queries.SetScanner(&rel.WithdrawalHistoryID, nil)
 			if rel.R == nil {
 				continue
 			}
 
-			rel.R.WithdrawalFiat = nil
+			rel.R.WithdrawalHistory = nil
 		}
 
-		o.R.WithdrawalFiatWithdrawalFiats = nil
+		o.R.WithdrawalFiats = nil
 	}
-	return o.AddWithdrawalFiatWithdrawalFiats(ctx, exec, insert, related...)
+	return o.AddWithdrawalFiats(ctx, exec, insert, related...)
 }
 
-// RemoveWithdrawalFiatWithdrawalFiats relationships from objects passed in.
-// Removes related items from R.WithdrawalFiatWithdrawalFiats (uses pointer comparison, removal does not keep order)
-// Sets related.R.WithdrawalFiat.
-func (o *WithdrawalHistory) RemoveWithdrawalFiatWithdrawalFiats(ctx context.Context, exec boil.ContextExecutor, related ...*WithdrawalFiat) error {
+// RemoveWithdrawalFiats relationships from objects passed in.
+// Removes related items from R.WithdrawalFiats (uses pointer comparison, removal does not keep order)
+// Sets related.R.WithdrawalHistory.
+func (o *WithdrawalHistory) RemoveWithdrawalFiats(ctx context.Context, exec boil.ContextExecutor, related ...*WithdrawalFiat) error {
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.WithdrawalFiatID, nil)
+		queries.SetScanner(&rel.WithdrawalHistoryID, nil)
 		if rel.R != nil {
-			rel.R.WithdrawalFiat = nil
+			rel.R.WithdrawalHistory = nil
 		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("withdrawal_fiat_id")); err != nil {
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("withdrawal_history_id")); err != nil {
 			return err
 		}
 	}
@@ -871,16 +1097,16 @@ func (o *WithdrawalHistory) RemoveWithdrawalFiatWithdrawalFiats(ctx context.Cont
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.WithdrawalFiatWithdrawalFiats {
+		for i, ri := range o.R.WithdrawalFiats {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.WithdrawalFiatWithdrawalFiats)
+			ln := len(o.R.WithdrawalFiats)
 			if ln > 1 && i < ln-1 {
-				o.R.WithdrawalFiatWithdrawalFiats[i] = o.R.WithdrawalFiatWithdrawalFiats[ln-1]
+				o.R.WithdrawalFiats[i] = o.R.WithdrawalFiats[ln-1]
 			}
-			o.R.WithdrawalFiatWithdrawalFiats = o.R.WithdrawalFiatWithdrawalFiats[:ln-1]
+			o.R.WithdrawalFiats = o.R.WithdrawalFiats[:ln-1]
 			break
 		}
 	}
@@ -494,7 +494,84 @@ func testWithdrawalHistoriesInsertWhitelist(t *testing.T) {
 	}
 }
 
-func testWithdrawalHistoryToManyWithdrawalCryptoWithdrawalCryptos(t *testing.T) {
+func testWithdrawalHistoryToManyFundingHistories(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WithdrawalHistory
+	var b, c FundingHistory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, withdrawalHistoryDBTypes, true, withdrawalHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalHistory struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, fundingHistoryDBTypes, false, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, fundingHistoryDBTypes, false, fundingHistoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.WithdrawalHistoryID, a.ID)
+	queries.Assign(&c.WithdrawalHistoryID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.FundingHistories().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.WithdrawalHistoryID, b.WithdrawalHistoryID) {
+			bFound = true
+		}
+		if queries.Equal(v.WithdrawalHistoryID, c.WithdrawalHistoryID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := WithdrawalHistorySlice{&a}
+	if err = a.L.LoadFundingHistories(ctx, tx, false, (*[]*WithdrawalHistory)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.FundingHistories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.FundingHistories = nil
+	if err = a.L.LoadFundingHistories(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.FundingHistories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testWithdrawalHistoryToManyWithdrawalCryptos(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -519,8 +596,8 @@ func testWithdrawalHistoryToManyWithdrawalCryptoWithdrawalCryptos(t *testing.T)
 		t.Fatal(err)
 	}
 
-	queries.Assign(&b.WithdrawalCryptoID, a.ID)
-	queries.Assign(&c.WithdrawalCryptoID, a.ID)
+	queries.Assign(&b.WithdrawalHistoryID, a.ID)
+	queries.Assign(&c.WithdrawalHistoryID, a.ID)
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -528,17 +605,17 @@ func testWithdrawalHistoryToManyWithdrawalCryptoWithdrawalCryptos(t *testing.T)
 		t.Fatal(err)
 	}
 
-	check, err := a.WithdrawalCryptoWithdrawalCryptos().All(ctx, tx)
+	check, err := a.WithdrawalCryptos().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if queries.Equal(v.WithdrawalCryptoID, b.WithdrawalCryptoID) {
+		if queries.Equal(v.WithdrawalHistoryID, b.WithdrawalHistoryID) {
 			bFound = true
 		}
-		if queries.Equal(v.WithdrawalCryptoID, c.WithdrawalCryptoID) {
+		if queries.Equal(v.WithdrawalHistoryID, c.WithdrawalHistoryID) {
 			cFound = true
 		}
 	}
@@ -551,18 +628,18 @@ func testWithdrawalHistoryToManyWithdrawalCryptoWithdrawalCryptos(t *testing.T)
 	}
 
 	slice := WithdrawalHistorySlice{&a}
-	if err = a.L.LoadWithdrawalCryptoWithdrawalCryptos(ctx, tx, false, (*[]*WithdrawalHistory)(&slice), nil); err != nil {
+	if err = a.L.LoadWithdrawalCryptos(ctx, tx, false, (*[]*WithdrawalHistory)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.WithdrawalCryptoWithdrawalCryptos); got != 2 {
+	if got := len(a.R.WithdrawalCryptos); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.WithdrawalCryptoWithdrawalCryptos = nil
-	if err = a.L.LoadWithdrawalCryptoWithdrawalCryptos(ctx, tx, true, &a, nil); err != nil {
+	a.R.WithdrawalCryptos = nil
+	if err = a.L.LoadWithdrawalCryptos(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.WithdrawalCryptoWithdrawalCryptos); got != 2 {
+	if got := len(a.R.WithdrawalCryptos); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -571,7 +648,7 @@ func testWithdrawalHistoryToManyWithdrawalCryptoWithdrawalCryptos(t *testing.T)
 	}
 }
 
-func testWithdrawalHistoryToManyWithdrawalFiatWithdrawalFiats(t *testing.T) {
+func testWithdrawalHistoryToManyWithdrawalFiats(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -596,8 +673,8 @@ func testWithdrawalHistoryToManyWithdrawalFiatWithdrawalFiats(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	queries.Assign(&b.WithdrawalFiatID, a.ID)
-	queries.Assign(&c.WithdrawalFiatID, a.ID)
+	queries.Assign(&b.WithdrawalHistoryID, a.ID)
+	queries.Assign(&c.WithdrawalHistoryID, a.ID)
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -605,17 +682,17 @@ func testWithdrawalHistoryToManyWithdrawalFiatWithdrawalFiats(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.WithdrawalFiatWithdrawalFiats().All(ctx, tx)
+	check, err := a.WithdrawalFiats().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if queries.Equal(v.WithdrawalFiatID, b.WithdrawalFiatID) {
+		if queries.Equal(v.WithdrawalHistoryID, b.WithdrawalHistoryID) {
 			bFound = true
 		}
-		if queries.Equal(v.WithdrawalFiatID, c.WithdrawalFiatID) {
+		if queries.Equal(v.WithdrawalHistoryID, c.WithdrawalHistoryID) {
 			cFound = true
 		}
 	}
@@ -628,18 +705,18 @@ func testWithdrawalHistoryToManyWithdrawalFiatWithdrawalFiats(t *testing.T) {
 	}
 
 	slice := WithdrawalHistorySlice{&a}
-	if err = a.L.LoadWithdrawalFiatWithdrawalFiats(ctx, tx, false, (*[]*WithdrawalHistory)(&slice), nil); err != nil {
+	if err = a.L.LoadWithdrawalFiats(ctx, tx, false, (*[]*WithdrawalHistory)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.WithdrawalFiatWithdrawalFiats); got != 2 {
+	if got := len(a.R.WithdrawalFiats); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.WithdrawalFiatWithdrawalFiats = nil
-	if err = a.L.LoadWithdrawalFiatWithdrawalFiats(ctx, tx, true, &a, nil); err != nil {
+	a.R.WithdrawalFiats = nil
+	if err = a.L.LoadWithdrawalFiats(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.WithdrawalFiatWithdrawalFiats); got != 2 {
+	if got := len(a.R.WithdrawalFiats); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -648,7 +725,258 @@ func testWithdrawalHistoryToManyWithdrawalFiatWithdrawalFiats(t *testing.T) {
 	}
 }
 
-func testWithdrawalHistoryToManyAddOpWithdrawalCryptoWithdrawalCryptos(t *testing.T) {
+func testWithdrawalHistoryToManyAddOpFundingHistories(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WithdrawalHistory
+	var b, c, d, e FundingHistory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, withdrawalHistoryDBTypes, false, strmangle.SetComplement(withdrawalHistoryPrimaryKeyColumns, withdrawalHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*FundingHistory{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, fundingHistoryDBTypes, false, strmangle.SetComplement(fundingHistoryPrimaryKeyColumns, fundingHistoryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*FundingHistory{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddFundingHistories(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.WithdrawalHistoryID) {
+			t.Error("foreign key was wrong value", a.ID, first.WithdrawalHistoryID)
+		}
+		if !queries.Equal(a.ID, second.WithdrawalHistoryID) {
+			t.Error("foreign key was wrong value", a.ID, second.WithdrawalHistoryID)
+		}
+
+		if first.R.WithdrawalHistory != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.WithdrawalHistory != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.FundingHistories[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.FundingHistories[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.FundingHistories().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testWithdrawalHistoryToManySetOpFundingHistories(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WithdrawalHistory
+	var b, c, d, e FundingHistory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, withdrawalHistoryDBTypes, false, strmangle.SetComplement(withdrawalHistoryPrimaryKeyColumns, withdrawalHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*FundingHistory{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, fundingHistoryDBTypes, false, strmangle.SetComplement(fundingHistoryPrimaryKeyColumns, fundingHistoryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetFundingHistories(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.FundingHistories().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetFundingHistories(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.FundingHistories().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.WithdrawalHistoryID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.WithdrawalHistoryID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.WithdrawalHistoryID) {
+		t.Error("foreign key was wrong value", a.ID, d.WithdrawalHistoryID)
+	}
+	if !queries.Equal(a.ID, e.WithdrawalHistoryID) {
+		t.Error("foreign key was wrong value", a.ID, e.WithdrawalHistoryID)
+	}
+
+	if b.R.WithdrawalHistory != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.WithdrawalHistory != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.WithdrawalHistory != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.WithdrawalHistory != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.FundingHistories[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.FundingHistories[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testWithdrawalHistoryToManyRemoveOpFundingHistories(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WithdrawalHistory
+	var b, c, d, e FundingHistory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, withdrawalHistoryDBTypes, false, strmangle.SetComplement(withdrawalHistoryPrimaryKeyColumns, withdrawalHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*FundingHistory{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, fundingHistoryDBTypes, false, strmangle.SetComplement(fundingHistoryPrimaryKeyColumns, fundingHistoryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddFundingHistories(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.FundingHistories().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveFundingHistories(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.FundingHistories().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.WithdrawalHistoryID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.WithdrawalHistoryID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.WithdrawalHistory != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.WithdrawalHistory != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.WithdrawalHistory != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.WithdrawalHistory != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.FundingHistories) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.FundingHistories[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.FundingHistories[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testWithdrawalHistoryToManyAddOpWithdrawalCryptos(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -685,7 +1013,7 @@ func testWithdrawalHistoryToManyAddOpWithdrawalCryptoWithdrawalCryptos(t *testin
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddWithdrawalCryptoWithdrawalCryptos(ctx, tx, i != 0, x...)
+		err = a.AddWithdrawalCryptos(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -693,28 +1021,28 @@ func testWithdrawalHistoryToManyAddOpWithdrawalCryptoWithdrawalCryptos(t *testin
 		first := x[0]
 		second := x[1]
 
-		if !queries.Equal(a.ID, first.WithdrawalCryptoID) {
-			t.Error("foreign key was wrong value", a.ID, first.WithdrawalCryptoID)
+		if !queries.Equal(a.ID, first.WithdrawalHistoryID) {
+			t.Error("foreign key was wrong value", a.ID, first.WithdrawalHistoryID)
 		}
-		if !queries.Equal(a.ID, second.WithdrawalCryptoID) {
-			t.Error("foreign key was wrong value", a.ID, second.WithdrawalCryptoID)
+		if !queries.Equal(a.ID, second.WithdrawalHistoryID) {
+			t.Error("foreign key was wrong value", a.ID, second.WithdrawalHistoryID)
 		}
 
-		if first.R.WithdrawalCrypto != &a {
+		if first.R.WithdrawalHistory != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
-		if second.R.WithdrawalCrypto != &a {
+		if second.R.WithdrawalHistory != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.WithdrawalCryptoWithdrawalCryptos[i*2] != first {
+		if a.R.WithdrawalCryptos[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.WithdrawalCryptoWithdrawalCryptos[i*2+1] != second {
+		if a.R.WithdrawalCryptos[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.WithdrawalCryptoWithdrawalCryptos().Count(ctx, tx)
+		count, err := a.WithdrawalCryptos().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -724,7 +1052,7 @@ func testWithdrawalHistoryToManyAddOpWithdrawalCryptoWithdrawalCryptos(t *testin
 	}
 }
 
-func testWithdrawalHistoryToManySetOpWithdrawalCryptoWithdrawalCryptos(t *testing.T) {
+func testWithdrawalHistoryToManySetOpWithdrawalCryptos(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -755,12 +1083,12 @@ func testWithdrawalHistoryToManySetOpWithdrawalCryptoWithdrawalCryptos(t *testin
 		t.Fatal(err)
 	}
 
-	err = a.SetWithdrawalCryptoWithdrawalCryptos(ctx, tx, false, &b, &c)
+	err = a.SetWithdrawalCryptos(ctx, tx, false, &b, &c)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.WithdrawalCryptoWithdrawalCryptos().Count(ctx, tx)
+	count, err := a.WithdrawalCryptos().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -768,12 +1096,12 @@ func testWithdrawalHistoryToManySetOpWithdrawalCryptoWithdrawalCryptos(t *testin
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.SetWithdrawalCryptoWithdrawalCryptos(ctx, tx, true, &d, &e)
+	err = a.SetWithdrawalCryptos(ctx, tx, true, &d, &e)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.WithdrawalCryptoWithdrawalCryptos().Count(ctx, tx)
+	count, err = a.WithdrawalCryptos().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -781,41 +1109,41 @@ func testWithdrawalHistoryToManySetOpWithdrawalCryptoWithdrawalCryptos(t *testin
 		t.Error("count was wrong:", count)
 	}
 
-	if !queries.IsValuerNil(b.WithdrawalCryptoID) {
+	if !queries.IsValuerNil(b.WithdrawalHistoryID) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.WithdrawalCryptoID) {
+	if !queries.IsValuerNil(c.WithdrawalHistoryID) {
 		t.Error("want c's foreign key value to be nil")
 	}
-	if !queries.Equal(a.ID, d.WithdrawalCryptoID) {
-		t.Error("foreign key was wrong value", a.ID, d.WithdrawalCryptoID)
+	if !queries.Equal(a.ID, d.WithdrawalHistoryID) {
+		t.Error("foreign key was wrong value", a.ID, d.WithdrawalHistoryID)
 	}
-	if !queries.Equal(a.ID, e.WithdrawalCryptoID) {
-		t.Error("foreign key was wrong value", a.ID, e.WithdrawalCryptoID)
+	if !queries.Equal(a.ID, e.WithdrawalHistoryID) {
+		t.Error("foreign key was wrong value", a.ID, e.WithdrawalHistoryID)
 	}
 
-	if b.R.WithdrawalCrypto != nil {
+	if b.R.WithdrawalHistory != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if c.R.WithdrawalCrypto != nil {
+	if c.R.WithdrawalHistory != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if d.R.WithdrawalCrypto != &a {
+	if d.R.WithdrawalHistory != &a {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
-	if e.R.WithdrawalCrypto != &a {
+	if e.R.WithdrawalHistory != &a {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
 
-	if a.R.WithdrawalCryptoWithdrawalCryptos[0] != &d {
+	if a.R.WithdrawalCryptos[0] != &d {
 		t.Error("relationship struct slice not set to correct value")
 	}
-	if a.R.WithdrawalCryptoWithdrawalCryptos[1] != &e {
+	if a.R.WithdrawalCryptos[1] != &e {
 		t.Error("relationship struct slice not set to correct value")
 	}
 }
 
-func testWithdrawalHistoryToManyRemoveOpWithdrawalCryptoWithdrawalCryptos(t *testing.T) {
+func testWithdrawalHistoryToManyRemoveOpWithdrawalCryptos(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -840,12 +1168,12 @@ func testWithdrawalHistoryToManyRemoveOpWithdrawalCryptoWithdrawalCryptos(t *tes
 		t.Fatal(err)
 	}
 
-	err = a.AddWithdrawalCryptoWithdrawalCryptos(ctx, tx, true, foreigners...)
+	err = a.AddWithdrawalCryptos(ctx, tx, true, foreigners...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.WithdrawalCryptoWithdrawalCryptos().Count(ctx, tx)
+	count, err := a.WithdrawalCryptos().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -853,12 +1181,12 @@ func testWithdrawalHistoryToManyRemoveOpWithdrawalCryptoWithdrawalCryptos(t *tes
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.RemoveWithdrawalCryptoWithdrawalCryptos(ctx, tx, foreigners[:2]...)
+	err = a.RemoveWithdrawalCryptos(ctx, tx, foreigners[:2]...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.WithdrawalCryptoWithdrawalCryptos().Count(ctx, tx)
+	count, err = a.WithdrawalCryptos().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -866,40 +1194,40 @@ func testWithdrawalHistoryToManyRemoveOpWithdrawalCryptoWithdrawalCryptos(t *tes
 		t.Error("count was wrong:", count)
 	}
 
-	if !queries.IsValuerNil(b.WithdrawalCryptoID) {
+	if !queries.IsValuerNil(b.WithdrawalHistoryID) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.WithdrawalCryptoID) {
+	if !queries.IsValuerNil(c.WithdrawalHistoryID) {
 		t.Error("want c's foreign key value to be nil")
 	}
 
-	if b.R.WithdrawalCrypto != nil {
+	if b.R.WithdrawalHistory != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if c.R.WithdrawalCrypto != nil {
+	if c.R.WithdrawalHistory != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if d.R.WithdrawalCrypto != &a {
+	if d.R.WithdrawalHistory != &a {
 		t.Error("relationship to a should have been preserved")
 	}
-	if e.R.WithdrawalCrypto != &a {
+	if e.R.WithdrawalHistory != &a {
 		t.Error("relationship to a should have been preserved")
 	}
 
-	if len(a.R.WithdrawalCryptoWithdrawalCryptos) != 2 {
+	if len(a.R.WithdrawalCryptos) != 2 {
 		t.Error("should have preserved two relationships")
 	}
 
 	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.WithdrawalCryptoWithdrawalCryptos[1] != &d {
+	if a.R.WithdrawalCryptos[1] != &d {
 		t.Error("relationship to d should have been preserved")
 	}
-	if a.R.WithdrawalCryptoWithdrawalCryptos[0] != &e {
+	if a.R.WithdrawalCryptos[0] != &e {
 		t.Error("relationship to e should have been preserved")
 	}
 }
 
-func testWithdrawalHistoryToManyAddOpWithdrawalFiatWithdrawalFiats(t *testing.T) {
+func testWithdrawalHistoryToManyAddOpWithdrawalFiats(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -936,7 +1264,7 @@ func testWithdrawalHistoryToManyAddOpWithdrawalFiatWithdrawalFiats(t *testing.T)
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddWithdrawalFiatWithdrawalFiats(ctx, tx, i != 0, x...)
+		err = a.AddWithdrawalFiats(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -944,28 +1272,28 @@ func testWithdrawalHistoryToManyAddOpWithdrawalFiatWithdrawalFiats(t *testing.T)
 		first := x[0]
 		second := x[1]
 
-		if !queries.Equal(a.ID, first.WithdrawalFiatID) {
-			t.Error("foreign key was wrong value", a.ID, first.WithdrawalFiatID)
+		if !queries.Equal(a.ID, first.WithdrawalHistoryID) {
+			t.Error("foreign key was wrong value", a.ID, first.WithdrawalHistoryID)
 		}
-		if !queries.Equal(a.ID, second.WithdrawalFiatID) {
-			t.Error("foreign key was wrong value", a.ID, second.WithdrawalFiatID)
+		if !queries.Equal(a.ID, second.WithdrawalHistoryID) {
+			t.Error("foreign key was wrong value", a.ID, second.WithdrawalHistoryID)
 		}
 
-		if first.R.WithdrawalFiat != &a {
+		if first.R.WithdrawalHistory != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
-		if second.R.WithdrawalFiat != &a {
+		if second.R.WithdrawalHistory != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.WithdrawalFiatWithdrawalFiats[i*2] != first {
+		if a.R.WithdrawalFiats[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.WithdrawalFiatWithdrawalFiats[i*2+1] != second {
+		if a.R.WithdrawalFiats[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.WithdrawalFiatWithdrawalFiats().Count(ctx, tx)
+		count, err := a.WithdrawalFiats().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -975,7 +1303,7 @@ func testWithdrawalHistoryToManyAddOpWithdrawalFiatWithdrawalFiats(t *testing.T)
 	}
 }
 
-func testWithdrawalHistoryToManySetOpWithdrawalFiatWithdrawalFiats(t *testing.T) {
+func testWithdrawalHistoryToManySetOpWithdrawalFiats(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1006,12 +1334,12 @@ func testWithdrawalHistoryToManySetOpWithdrawalFiatWithdrawalFiats(t *testing.T)
 		t.Fatal(err)
 	}
 
-	err = a.SetWithdrawalFiatWithdrawalFiats(ctx, tx, false, &b, &c)
+	err = a.SetWithdrawalFiats(ctx, tx, false, &b, &c)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.WithdrawalFiatWithdrawalFiats().Count(ctx, tx)
+	count, err := a.WithdrawalFiats().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1019,12 +1347,12 @@ func testWithdrawalHistoryToManySetOpWithdrawalFiatWithdrawalFiats(t *testing.T)
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.SetWithdrawalFiatWithdrawalFiats(ctx, tx, true, &d, &e)
+	err = a.SetWithdrawalFiats(ctx, tx, true, &d, &e)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.WithdrawalFiatWithdrawalFiats().Count(ctx, tx)
+	count, err = a.WithdrawalFiats().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1032,41 +1360,41 @@ func testWithdrawalHistoryToManySetOpWithdrawalFiatWithdrawalFiats(t *testing.T)
 		t.Error("count was wrong:", count)
 	}
 
-	if !queries.IsValuerNil(b.WithdrawalFiatID) {
+	if !queries.IsValuerNil(b.WithdrawalHistoryID) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.WithdrawalFiatID) {
+	if !queries.IsValuerNil(c.WithdrawalHistoryID) {
 		t.Error("want c's foreign key value to be nil")
 	}
-	if !queries.Equal(a.ID, d.WithdrawalFiatID) {
-		t.Error("foreign key was wrong value", a.ID, d.WithdrawalFiatID)
+	if !queries.Equal(a.ID, d.WithdrawalHistoryID) {
+		t.Error("foreign key was wrong value", a.ID, d.WithdrawalHistoryID)
 	}
-	if !queries.Equal(a.ID, e.WithdrawalFiatID) {
-		t.Error("foreign key was wrong value", a.ID, e.WithdrawalFiatID)
+	if !queries.Equal(a.ID, e.WithdrawalHistoryID) {
+		t.Error("foreign key was wrong value", a.ID, e.WithdrawalHistoryID)
 	}
 
-	if b.R.WithdrawalFiat != nil {
+	if b.R.WithdrawalHistory != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if c.R.WithdrawalFiat != nil {
+	if c.R.WithdrawalHistory != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if d.R.WithdrawalFiat != &a {
+	if d.R.WithdrawalHistory != &a {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
-	if e.R.WithdrawalFiat != &a {
+	if e.R.WithdrawalHistory != &a {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
 
-	if a.R.WithdrawalFiatWithdrawalFiats[0] != &d {
+	if a.R.WithdrawalFiats[0] != &d {
 		t.Error("relationship struct slice not set to correct value")
 	}
-	if a.R.WithdrawalFiatWithdrawalFiats[1] != &e {
+	if a.R.WithdrawalFiats[1] != &e {
 		t.Error("relationship struct slice not set to correct value")
 	}
 }
 
-func testWithdrawalHistoryToManyRemoveOpWithdrawalFiatWithdrawalFiats(t *testing.T) {
+func testWithdrawalHistoryToManyRemoveOpWithdrawalFiats(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1091,12 +1419,12 @@ func testWithdrawalHistoryToManyRemoveOpWithdrawalFiatWithdrawalFiats(t *testing
 		t.Fatal(err)
 	}
 
-	err = a.AddWithdrawalFiatWithdrawalFiats(ctx, tx, true, foreigners...)
+	err = a.AddWithdrawalFiats(ctx, tx, true, foreigners...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.WithdrawalFiatWithdrawalFiats().Count(ctx, tx)
+	count, err := a.WithdrawalFiats().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1104,12 +1432,12 @@ func testWithdrawalHistoryToManyRemoveOpWithdrawalFiatWithdrawalFiats(t *testing
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.RemoveWithdrawalFiatWithdrawalFiats(ctx, tx, foreigners[:2]...)
+	err = a.RemoveWithdrawalFiats(ctx, tx, foreigners[:2]...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.WithdrawalFiatWithdrawalFiats().Count(ctx, tx)
+	count, err = a.WithdrawalFiats().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1117,35 +1445,35 @@ func testWithdrawalHistoryToManyRemoveOpWithdrawalFiatWithdrawalFiats(t *testing
 		t.Error("count was wrong:", count)
 	}
 
-	if !queries.IsValuerNil(b.WithdrawalFiatID) {
+	if !queries.IsValuerNil(b.WithdrawalHistoryID) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.WithdrawalFiatID) {
+	if !queries.IsValuerNil(c.WithdrawalHistoryID) {
 		t.Error("want c's foreign key value to be nil")
 	}
 
-	if b.R.WithdrawalFiat != nil {
+	if b.R.WithdrawalHistory != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if c.R.WithdrawalFiat != nil {
+	if c.R.WithdrawalHistory != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if d.R.WithdrawalFiat != &a {
+	if d.R.WithdrawalHistory != &a {
 		t.Error("relationship to a should have been preserved")
 	}
-	if e.R.WithdrawalFiat != &a {
+	if e.R.WithdrawalHistory != &a {
 		t.Error("relationship to a should have been preserved")
 	}
 
-	if len(a.R.WithdrawalFiatWithdrawalFiats) != 2 {
+	if len(a.R.WithdrawalFiats) != 2 {
 		t.Error("should have preserved two relationships")
 	}
 
 	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.WithdrawalFiatWithdrawalFiats[1] != &d {
+	if a.R.WithdrawalFiats[1] != &d {
 		t.Error("relationship to d should have been preserved")
 	}
-	if a.R.WithdrawalFiatWithdrawalFiats[0] != &e {
+	if a.R.WithdrawalFiats[0] != &e {
 		t.Error("relationship to e should have been preserved")
 	}
 }
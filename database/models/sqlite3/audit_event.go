@@ -27,6 +27,8 @@ type AuditEvent struct {
 	Identifier string `boil:"identifier" json:"identifier" toml:"identifier" yaml:"identifier"`
 	Message    string `boil:"message" json:"message" toml:"message" yaml:"message"`
 	CreatedAt  string `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	PrevHash   string `boil:"prev_hash" json:"prev_hash" toml:"prev_hash" yaml:"prev_hash"`
+	Hash       string `boil:"hash" json:"hash" toml:"hash" yaml:"hash"`
 
 	R *auditEventR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L auditEventL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -38,12 +40,16 @@ var AuditEventColumns = struct {
 	Identifier string
 	Message    string
 	CreatedAt  string
+	PrevHash   string
+	Hash       string
 }{
 	ID:         "id",
 	Type:       "type",
 	Identifier: "identifier",
 	Message:    "message",
 	CreatedAt:  "created_at",
+	PrevHash:   "prev_hash",
+	Hash:       "hash",
 }
 
 // Generated where
@@ -79,12 +85,16 @@ var AuditEventWhere = struct {
 	Identifier whereHelperstring
 	Message    whereHelperstring
 	CreatedAt  whereHelperstring
+	PrevHash   whereHelperstring
+	Hash       whereHelperstring
 }{
 	ID:         whereHelperint64{field: "\"audit_event\".\"id\""},
 	Type:       whereHelperstring{field: "\"audit_event\".\"type\""},
 	Identifier: whereHelperstring{field: "\"audit_event\".\"identifier\""},
 	Message:    whereHelperstring{field: "\"audit_event\".\"message\""},
 	CreatedAt:  whereHelperstring{field: "\"audit_event\".\"created_at\""},
+	PrevHash:   whereHelperstring{field: "\"audit_event\".\"prev_hash\""},
+	Hash:       whereHelperstring{field: "\"audit_event\".\"hash\""},
 }
 
 // AuditEventRels is where relationship names are stored.
@@ -104,8 +114,8 @@ func (*auditEventR) NewStruct() *auditEventR {
 type auditEventL struct{}
 
 var (
-	auditEventAllColumns            = []string{"id", "type", "identifier", "message", "created_at"}
-	auditEventColumnsWithoutDefault = []string{"type", "identifier", "message"}
+	auditEventAllColumns            = []string{"id", "type", "identifier", "message", "created_at", "prev_hash", "hash"}
+	auditEventColumnsWithoutDefault = []string{"type", "identifier", "message", "prev_hash", "hash"}
 	auditEventColumnsWithDefault    = []string{"id", "created_at"}
 	auditEventPrimaryKeyColumns     = []string{"id"}
 )
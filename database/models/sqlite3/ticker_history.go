@@ -0,0 +1,826 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// TickerHistory is an object representing the database table.
+type TickerHistory struct {
+	ID          int64   `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Exchange    string  `boil:"exchange" json:"exchange" toml:"exchange" yaml:"exchange"`
+	Asset       string  `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Pair        string  `boil:"pair" json:"pair" toml:"pair" yaml:"pair"`
+	Last        float64 `boil:"last" json:"last" toml:"last" yaml:"last"`
+	High        float64 `boil:"high" json:"high" toml:"high" yaml:"high"`
+	Low         float64 `boil:"low" json:"low" toml:"low" yaml:"low"`
+	Bid         float64 `boil:"bid" json:"bid" toml:"bid" yaml:"bid"`
+	Ask         float64 `boil:"ask" json:"ask" toml:"ask" yaml:"ask"`
+	Volume      float64 `boil:"volume" json:"volume" toml:"volume" yaml:"volume"`
+	QuoteVolume float64 `boil:"quote_volume" json:"quote_volume" toml:"quote_volume" yaml:"quote_volume"`
+	CreatedAt   string  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *tickerHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tickerHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TickerHistoryColumns = struct {
+	ID          string
+	Exchange    string
+	Asset       string
+	Pair        string
+	Last        string
+	High        string
+	Low         string
+	Bid         string
+	Ask         string
+	Volume      string
+	QuoteVolume string
+	CreatedAt   string
+}{
+	ID:          "id",
+	Exchange:    "exchange",
+	Asset:       "asset",
+	Pair:        "pair",
+	Last:        "last",
+	High:        "high",
+	Low:         "low",
+	Bid:         "bid",
+	Ask:         "ask",
+	Volume:      "volume",
+	QuoteVolume: "quote_volume",
+	CreatedAt:   "created_at",
+}
+
+// Generated where
+
+var TickerHistoryWhere = struct {
+	ID          whereHelperint64
+	Exchange    whereHelperstring
+	Asset       whereHelperstring
+	Pair        whereHelperstring
+	Last        whereHelperfloat64
+	High        whereHelperfloat64
+	Low         whereHelperfloat64
+	Bid         whereHelperfloat64
+	Ask         whereHelperfloat64
+	Volume      whereHelperfloat64
+	QuoteVolume whereHelperfloat64
+	CreatedAt   whereHelperstring
+}{
+	ID:          whereHelperint64{field: "\"ticker_history\".\"id\""},
+	Exchange:    whereHelperstring{field: "\"ticker_history\".\"exchange\""},
+	Asset:       whereHelperstring{field: "\"ticker_history\".\"asset\""},
+	Pair:        whereHelperstring{field: "\"ticker_history\".\"pair\""},
+	Last:        whereHelperfloat64{field: "\"ticker_history\".\"last\""},
+	High:        whereHelperfloat64{field: "\"ticker_history\".\"high\""},
+	Low:         whereHelperfloat64{field: "\"ticker_history\".\"low\""},
+	Bid:         whereHelperfloat64{field: "\"ticker_history\".\"bid\""},
+	Ask:         whereHelperfloat64{field: "\"ticker_history\".\"ask\""},
+	Volume:      whereHelperfloat64{field: "\"ticker_history\".\"volume\""},
+	QuoteVolume: whereHelperfloat64{field: "\"ticker_history\".\"quote_volume\""},
+	CreatedAt:   whereHelperstring{field: "\"ticker_history\".\"created_at\""},
+}
+
+// TickerHistoryRels is where relationship names are stored.
+var TickerHistoryRels = struct {
+}{}
+
+// tickerHistoryR is where relationships are stored.
+type tickerHistoryR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*tickerHistoryR) NewStruct() *tickerHistoryR {
+	return &tickerHistoryR{}
+}
+
+// tickerHistoryL is where Load methods for each relationship are stored.
+type tickerHistoryL struct{}
+
+var (
+	tickerHistoryAllColumns            = []string{"id", "exchange", "asset", "pair", "last", "high", "low", "bid", "ask", "volume", "quote_volume", "created_at"}
+	tickerHistoryColumnsWithoutDefault = []string{"exchange", "asset", "pair", "last", "high", "low", "bid", "ask", "volume", "quote_volume"}
+	tickerHistoryColumnsWithDefault    = []string{"id", "created_at"}
+	tickerHistoryPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// TickerHistorySlice is an alias for a slice of pointers to TickerHistory.
+	// This should generally be used opposed to []TickerHistory.
+	TickerHistorySlice []*TickerHistory
+	// TickerHistoryHook is the signature for custom TickerHistory hook methods
+	TickerHistoryHook func(context.Context, boil.ContextExecutor, *TickerHistory) error
+
+	tickerHistoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tickerHistoryType                 = reflect.TypeOf(&TickerHistory{})
+	tickerHistoryMapping              = queries.MakeStructMapping(tickerHistoryType)
+	tickerHistoryPrimaryKeyMapping, _ = queries.BindMapping(tickerHistoryType, tickerHistoryMapping, tickerHistoryPrimaryKeyColumns)
+	tickerHistoryInsertCacheMut       sync.RWMutex
+	tickerHistoryInsertCache          = make(map[string]insertCache)
+	tickerHistoryUpdateCacheMut       sync.RWMutex
+	tickerHistoryUpdateCache          = make(map[string]updateCache)
+	tickerHistoryUpsertCacheMut       sync.RWMutex
+	tickerHistoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tickerHistoryBeforeInsertHooks []TickerHistoryHook
+var tickerHistoryBeforeUpdateHooks []TickerHistoryHook
+var tickerHistoryBeforeDeleteHooks []TickerHistoryHook
+var tickerHistoryBeforeUpsertHooks []TickerHistoryHook
+
+var tickerHistoryAfterInsertHooks []TickerHistoryHook
+var tickerHistoryAfterSelectHooks []TickerHistoryHook
+var tickerHistoryAfterUpdateHooks []TickerHistoryHook
+var tickerHistoryAfterDeleteHooks []TickerHistoryHook
+var tickerHistoryAfterUpsertHooks []TickerHistoryHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TickerHistory) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TickerHistory) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TickerHistory) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TickerHistory) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TickerHistory) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TickerHistory) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TickerHistory) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TickerHistory) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TickerHistory) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tickerHistoryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTickerHistoryHook registers your hook function for all future operations.
+func AddTickerHistoryHook(hookPoint boil.HookPoint, tickerHistoryHook TickerHistoryHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		tickerHistoryBeforeInsertHooks = append(tickerHistoryBeforeInsertHooks, tickerHistoryHook)
+	case boil.BeforeUpdateHook:
+		tickerHistoryBeforeUpdateHooks = append(tickerHistoryBeforeUpdateHooks, tickerHistoryHook)
+	case boil.BeforeDeleteHook:
+		tickerHistoryBeforeDeleteHooks = append(tickerHistoryBeforeDeleteHooks, tickerHistoryHook)
+	case boil.BeforeUpsertHook:
+		tickerHistoryBeforeUpsertHooks = append(tickerHistoryBeforeUpsertHooks, tickerHistoryHook)
+	case boil.AfterInsertHook:
+		tickerHistoryAfterInsertHooks = append(tickerHistoryAfterInsertHooks, tickerHistoryHook)
+	case boil.AfterSelectHook:
+		tickerHistoryAfterSelectHooks = append(tickerHistoryAfterSelectHooks, tickerHistoryHook)
+	case boil.AfterUpdateHook:
+		tickerHistoryAfterUpdateHooks = append(tickerHistoryAfterUpdateHooks, tickerHistoryHook)
+	case boil.AfterDeleteHook:
+		tickerHistoryAfterDeleteHooks = append(tickerHistoryAfterDeleteHooks, tickerHistoryHook)
+	case boil.AfterUpsertHook:
+		tickerHistoryAfterUpsertHooks = append(tickerHistoryAfterUpsertHooks, tickerHistoryHook)
+	}
+}
+
+// One returns a single tickerHistory record from the query.
+func (q tickerHistoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TickerHistory, error) {
+	o := &TickerHistory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for ticker_history")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TickerHistory records from the query.
+func (q tickerHistoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (TickerHistorySlice, error) {
+	var o []*TickerHistory
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to TickerHistory slice")
+	}
+
+	if len(tickerHistoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TickerHistory records in the query.
+func (q tickerHistoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count ticker_history rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tickerHistoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if ticker_history exists")
+	}
+
+	return count > 0, nil
+}
+
+// TickerHistories retrieves all the records using an executor.
+func TickerHistories(mods ...qm.QueryMod) tickerHistoryQuery {
+	mods = append(mods, qm.From("\"ticker_history\""))
+	return tickerHistoryQuery{NewQuery(mods...)}
+}
+
+// FindTickerHistory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTickerHistory(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*TickerHistory, error) {
+	tickerHistoryObj := &TickerHistory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"ticker_history\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tickerHistoryObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from ticker_history")
+	}
+
+	return tickerHistoryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TickerHistory) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no ticker_history provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tickerHistoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tickerHistoryInsertCacheMut.RLock()
+	cache, cached := tickerHistoryInsertCache[key]
+	tickerHistoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tickerHistoryAllColumns,
+			tickerHistoryColumnsWithDefault,
+			tickerHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tickerHistoryType, tickerHistoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tickerHistoryType, tickerHistoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"ticker_history\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"ticker_history\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"ticker_history\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, tickerHistoryPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into ticker_history")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tickerHistoryMapping["ID"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for ticker_history")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tickerHistoryInsertCacheMut.Lock()
+		tickerHistoryInsertCache[key] = cache
+		tickerHistoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TickerHistory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TickerHistory) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tickerHistoryUpdateCacheMut.RLock()
+	cache, cached := tickerHistoryUpdateCache[key]
+	tickerHistoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tickerHistoryAllColumns,
+			tickerHistoryPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update ticker_history, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"ticker_history\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, tickerHistoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tickerHistoryType, tickerHistoryMapping, append(wl, tickerHistoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update ticker_history row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for ticker_history")
+	}
+
+	if !cached {
+		tickerHistoryUpdateCacheMut.Lock()
+		tickerHistoryUpdateCache[key] = cache
+		tickerHistoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tickerHistoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for ticker_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for ticker_history")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TickerHistorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tickerHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"ticker_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tickerHistoryPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in tickerHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all tickerHistory")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single TickerHistory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TickerHistory) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no TickerHistory provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tickerHistoryPrimaryKeyMapping)
+	sql := "DELETE FROM \"ticker_history\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from ticker_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for ticker_history")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tickerHistoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no tickerHistoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from ticker_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for ticker_history")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TickerHistorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tickerHistoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tickerHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"ticker_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tickerHistoryPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from tickerHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for ticker_history")
+	}
+
+	if len(tickerHistoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TickerHistory) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTickerHistory(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TickerHistorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TickerHistorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tickerHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"ticker_history\".* FROM \"ticker_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tickerHistoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in TickerHistorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TickerHistoryExists checks if the TickerHistory row exists.
+func TickerHistoryExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"ticker_history\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if ticker_history exists")
+	}
+
+	return exists, nil
+}
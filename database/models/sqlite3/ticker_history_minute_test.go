@@ -0,0 +1,684 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testTickerHistoryMinutes(t *testing.T) {
+	t.Parallel()
+
+	query := TickerHistoryMinutes()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testTickerHistoryMinutesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistoryMinute{}
+	if err = randomize.Struct(seed, o, tickerHistoryMinuteDBTypes, true, tickerHistoryMinuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TickerHistoryMinutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTickerHistoryMinutesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistoryMinute{}
+	if err = randomize.Struct(seed, o, tickerHistoryMinuteDBTypes, true, tickerHistoryMinuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TickerHistoryMinutes().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TickerHistoryMinutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTickerHistoryMinutesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistoryMinute{}
+	if err = randomize.Struct(seed, o, tickerHistoryMinuteDBTypes, true, tickerHistoryMinuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TickerHistoryMinuteSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TickerHistoryMinutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTickerHistoryMinutesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistoryMinute{}
+	if err = randomize.Struct(seed, o, tickerHistoryMinuteDBTypes, true, tickerHistoryMinuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := TickerHistoryMinuteExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if TickerHistoryMinute exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected TickerHistoryMinuteExists to return true, but got false.")
+	}
+}
+
+func testTickerHistoryMinutesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistoryMinute{}
+	if err = randomize.Struct(seed, o, tickerHistoryMinuteDBTypes, true, tickerHistoryMinuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	tickerHistoryMinuteFound, err := FindTickerHistoryMinute(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if tickerHistoryMinuteFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testTickerHistoryMinutesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistoryMinute{}
+	if err = randomize.Struct(seed, o, tickerHistoryMinuteDBTypes, true, tickerHistoryMinuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = TickerHistoryMinutes().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTickerHistoryMinutesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistoryMinute{}
+	if err = randomize.Struct(seed, o, tickerHistoryMinuteDBTypes, true, tickerHistoryMinuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := TickerHistoryMinutes().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testTickerHistoryMinutesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	tickerHistoryMinuteOne := &TickerHistoryMinute{}
+	tickerHistoryMinuteTwo := &TickerHistoryMinute{}
+	if err = randomize.Struct(seed, tickerHistoryMinuteOne, tickerHistoryMinuteDBTypes, false, tickerHistoryMinuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+	if err = randomize.Struct(seed, tickerHistoryMinuteTwo, tickerHistoryMinuteDBTypes, false, tickerHistoryMinuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = tickerHistoryMinuteOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = tickerHistoryMinuteTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TickerHistoryMinutes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testTickerHistoryMinutesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	tickerHistoryMinuteOne := &TickerHistoryMinute{}
+	tickerHistoryMinuteTwo := &TickerHistoryMinute{}
+	if err = randomize.Struct(seed, tickerHistoryMinuteOne, tickerHistoryMinuteDBTypes, false, tickerHistoryMinuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+	if err = randomize.Struct(seed, tickerHistoryMinuteTwo, tickerHistoryMinuteDBTypes, false, tickerHistoryMinuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = tickerHistoryMinuteOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = tickerHistoryMinuteTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TickerHistoryMinutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func tickerHistoryMinuteBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistoryMinute) error {
+	*o = TickerHistoryMinute{}
+	return nil
+}
+
+func tickerHistoryMinuteAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistoryMinute) error {
+	*o = TickerHistoryMinute{}
+	return nil
+}
+
+func tickerHistoryMinuteAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistoryMinute) error {
+	*o = TickerHistoryMinute{}
+	return nil
+}
+
+func tickerHistoryMinuteBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistoryMinute) error {
+	*o = TickerHistoryMinute{}
+	return nil
+}
+
+func tickerHistoryMinuteAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistoryMinute) error {
+	*o = TickerHistoryMinute{}
+	return nil
+}
+
+func tickerHistoryMinuteBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistoryMinute) error {
+	*o = TickerHistoryMinute{}
+	return nil
+}
+
+func tickerHistoryMinuteAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistoryMinute) error {
+	*o = TickerHistoryMinute{}
+	return nil
+}
+
+func tickerHistoryMinuteBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistoryMinute) error {
+	*o = TickerHistoryMinute{}
+	return nil
+}
+
+func tickerHistoryMinuteAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TickerHistoryMinute) error {
+	*o = TickerHistoryMinute{}
+	return nil
+}
+
+func testTickerHistoryMinutesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &TickerHistoryMinute{}
+	o := &TickerHistoryMinute{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, tickerHistoryMinuteDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute object: %s", err)
+	}
+
+	AddTickerHistoryMinuteHook(boil.BeforeInsertHook, tickerHistoryMinuteBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryMinuteBeforeInsertHooks = []TickerHistoryMinuteHook{}
+
+	AddTickerHistoryMinuteHook(boil.AfterInsertHook, tickerHistoryMinuteAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryMinuteAfterInsertHooks = []TickerHistoryMinuteHook{}
+
+	AddTickerHistoryMinuteHook(boil.AfterSelectHook, tickerHistoryMinuteAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryMinuteAfterSelectHooks = []TickerHistoryMinuteHook{}
+
+	AddTickerHistoryMinuteHook(boil.BeforeUpdateHook, tickerHistoryMinuteBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryMinuteBeforeUpdateHooks = []TickerHistoryMinuteHook{}
+
+	AddTickerHistoryMinuteHook(boil.AfterUpdateHook, tickerHistoryMinuteAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryMinuteAfterUpdateHooks = []TickerHistoryMinuteHook{}
+
+	AddTickerHistoryMinuteHook(boil.BeforeDeleteHook, tickerHistoryMinuteBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryMinuteBeforeDeleteHooks = []TickerHistoryMinuteHook{}
+
+	AddTickerHistoryMinuteHook(boil.AfterDeleteHook, tickerHistoryMinuteAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryMinuteAfterDeleteHooks = []TickerHistoryMinuteHook{}
+
+	AddTickerHistoryMinuteHook(boil.BeforeUpsertHook, tickerHistoryMinuteBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryMinuteBeforeUpsertHooks = []TickerHistoryMinuteHook{}
+
+	AddTickerHistoryMinuteHook(boil.AfterUpsertHook, tickerHistoryMinuteAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	tickerHistoryMinuteAfterUpsertHooks = []TickerHistoryMinuteHook{}
+}
+
+func testTickerHistoryMinutesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistoryMinute{}
+	if err = randomize.Struct(seed, o, tickerHistoryMinuteDBTypes, true, tickerHistoryMinuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TickerHistoryMinutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTickerHistoryMinutesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistoryMinute{}
+	if err = randomize.Struct(seed, o, tickerHistoryMinuteDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(tickerHistoryMinuteColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TickerHistoryMinutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTickerHistoryMinutesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistoryMinute{}
+	if err = randomize.Struct(seed, o, tickerHistoryMinuteDBTypes, true, tickerHistoryMinuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTickerHistoryMinutesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistoryMinute{}
+	if err = randomize.Struct(seed, o, tickerHistoryMinuteDBTypes, true, tickerHistoryMinuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TickerHistoryMinuteSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTickerHistoryMinutesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistoryMinute{}
+	if err = randomize.Struct(seed, o, tickerHistoryMinuteDBTypes, true, tickerHistoryMinuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TickerHistoryMinutes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	tickerHistoryMinuteDBTypes = map[string]string{`ID`: `INTEGER`, `Exchange`: `TEXT`, `Asset`: `TEXT`, `Pair`: `TEXT`, `Open`: `REAL`, `High`: `REAL`, `Low`: `REAL`, `Close`: `REAL`, `Bid`: `REAL`, `Ask`: `REAL`, `Volume`: `REAL`, `Ticks`: `INTEGER`, `StartedAt`: `TIMESTAMP`}
+	_                          = bytes.MinRead
+)
+
+func testTickerHistoryMinutesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(tickerHistoryMinutePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(tickerHistoryMinuteAllColumns) == len(tickerHistoryMinutePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistoryMinute{}
+	if err = randomize.Struct(seed, o, tickerHistoryMinuteDBTypes, true, tickerHistoryMinuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TickerHistoryMinutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, tickerHistoryMinuteDBTypes, true, tickerHistoryMinutePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testTickerHistoryMinutesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(tickerHistoryMinuteAllColumns) == len(tickerHistoryMinutePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TickerHistoryMinute{}
+	if err = randomize.Struct(seed, o, tickerHistoryMinuteDBTypes, true, tickerHistoryMinuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TickerHistoryMinutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, tickerHistoryMinuteDBTypes, true, tickerHistoryMinutePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TickerHistoryMinute struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(tickerHistoryMinuteAllColumns, tickerHistoryMinutePrimaryKeyColumns) {
+		fields = tickerHistoryMinuteAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			tickerHistoryMinuteAllColumns,
+			tickerHistoryMinutePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := TickerHistoryMinuteSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -90,15 +90,18 @@ var WithdrawalHistoryWhere = struct {
 
 // WithdrawalHistoryRels is where relationship names are stored.
 var WithdrawalHistoryRels = struct {
+	FundingHistories  string
 	WithdrawalCryptos string
 	WithdrawalFiats   string
 }{
+	FundingHistories:  "FundingHistories",
 	WithdrawalCryptos: "WithdrawalCryptos",
 	WithdrawalFiats:   "WithdrawalFiats",
 }
 
 // withdrawalHistoryR is where relationships are stored.
 type withdrawalHistoryR struct {
+	FundingHistories  FundingHistorySlice
 	WithdrawalCryptos WithdrawalCryptoSlice
 	WithdrawalFiats   WithdrawalFiatSlice
 }
@@ -393,6 +396,27 @@ func (q withdrawalHistoryQuery) Exists(ctx context.Context, exec boil.ContextExe
 	return count > 0, nil
 }
 
+// FundingHistories retrieves all the funding_history's FundingHistories with an executor.
+func (o *WithdrawalHistory) FundingHistories(mods ...qm.QueryMod) fundingHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"funding_history\".\"withdrawal_history_id\"=?", o.ID),
+	)
+
+	query := FundingHistories(queryMods...)
+	queries.SetFrom(query.Query, "\"funding_history\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"funding_history\".*"})
+	}
+
+	return query
+}
+
 // WithdrawalCryptos retrieves all the withdrawal_crypto's WithdrawalCryptos with an executor.
 func (o *WithdrawalHistory) WithdrawalCryptos(mods ...qm.QueryMod) withdrawalCryptoQuery {
 	var queryMods []qm.QueryMod
@@ -435,6 +459,101 @@ func (o *WithdrawalHistory) WithdrawalFiats(mods ...qm.QueryMod) withdrawalFiatQ
 	return query
 }
 
+// LoadFundingHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (withdrawalHistoryL) LoadFundingHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWithdrawalHistory interface{}, mods queries.Applicator) error {
+	var slice []*WithdrawalHistory
+	var object *WithdrawalHistory
+
+	if singular {
+		object = maybeWithdrawalHistory.(*WithdrawalHistory)
+	} else {
+		slice = *maybeWithdrawalHistory.(*[]*WithdrawalHistory)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &withdrawalHistoryR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &withdrawalHistoryR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`funding_history`), qm.WhereIn(`funding_history.withdrawal_history_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load funding_history")
+	}
+
+	var resultSlice []*FundingHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice funding_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on funding_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for funding_history")
+	}
+
+	if len(fundingHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.FundingHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &fundingHistoryR{}
+			}
+			foreign.R.WithdrawalHistory = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.WithdrawalHistoryID) {
+				local.R.FundingHistories = append(local.R.FundingHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &fundingHistoryR{}
+				}
+				foreign.R.WithdrawalHistory = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadWithdrawalCryptos allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (withdrawalHistoryL) LoadWithdrawalCryptos(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWithdrawalHistory interface{}, mods queries.Applicator) error {
@@ -625,6 +744,129 @@ func (withdrawalHistoryL) LoadWithdrawalFiats(ctx context.Context, e boil.Contex
 	return nil
 }
 
+// AddFundingHistories adds the given related objects to the existing relationships
+// of the withdrawal_history, optionally inserting them as new records.
+// Appends related to o.R.FundingHistories.
+// Sets related.R.WithdrawalHistory appropriately.
+func (o *WithdrawalHistory) AddFundingHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FundingHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.WithdrawalHistoryID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"funding_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"withdrawal_history_id"}),
+				strmangle.WhereClause("\"", "\"", 0, fundingHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.WithdrawalHistoryID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &withdrawalHistoryR{
+			FundingHistories: related,
+		}
+	} else {
+		o.R.FundingHistories = append(o.R.FundingHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &fundingHistoryR{
+				WithdrawalHistory: o,
+			}
+		} else {
+			rel.R.WithdrawalHistory = o
+		}
+	}
+	return nil
+}
+
+// SetFundingHistories removes all previously related items of the
+// withdrawal_history replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.WithdrawalHistory's FundingHistories accordingly.
+// Replaces o.R.FundingHistories with related.
+// Sets related.R.WithdrawalHistory's FundingHistories accordingly.
+func (o *WithdrawalHistory) SetFundingHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FundingHistory) error {
+	query := "update \"funding_history\" set \"withdrawal_history_id\" = null where \"withdrawal_history_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.FundingHistories {
+			queries.SetScanner(&rel.WithdrawalHistoryID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.WithdrawalHistory = nil
+		}
+
+		o.R.FundingHistories = nil
+	}
+	return o.AddFundingHistories(ctx, exec, insert, related...)
+}
+
+// RemoveFundingHistories relationships from objects passed in.
+// Removes related items from R.FundingHistories (uses pointer comparison, removal does not keep order)
+// Sets related.R.WithdrawalHistory.
+func (o *WithdrawalHistory) RemoveFundingHistories(ctx context.Context, exec boil.ContextExecutor, related ...*FundingHistory) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.WithdrawalHistoryID, nil)
+		if rel.R != nil {
+			rel.R.WithdrawalHistory = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("withdrawal_history_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.FundingHistories {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.FundingHistories)
+			if ln > 1 && i < ln-1 {
+				o.R.FundingHistories[i] = o.R.FundingHistories[ln-1]
+			}
+			o.R.FundingHistories = o.R.FundingHistories[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddWithdrawalCryptos adds the given related objects to the existing relationships
 // of the withdrawal_history, optionally inserting them as new records.
 // Appends related to o.R.WithdrawalCryptos.
@@ -2,88 +2,147 @@ package audit
 
 import (
 	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"sync"
 	"time"
 
+	"github.com/yurulab/gocryptotrader/common"
 	"github.com/yurulab/gocryptotrader/database"
-	modelPSQL "github.com/yurulab/gocryptotrader/database/models/postgres"
-	modelSQLite "github.com/yurulab/gocryptotrader/database/models/sqlite3"
 	"github.com/yurulab/gocryptotrader/database/repository"
 	"github.com/yurulab/gocryptotrader/log"
 	"github.com/thrasher-corp/sqlboiler/boil"
 	"github.com/thrasher-corp/sqlboiler/queries/qm"
 )
 
-// Event inserts a new audit event to database
-func Event(id, msgtype, message string) {
-	if database.DB.SQL == nil {
-		return
-	}
+// eventMtx serialises event inserts so each event chains to the one before it
+var eventMtx sync.Mutex
 
-	ctx := context.Background()
-	ctx = boil.SkipTimestamps(ctx)
+// Entry is a stored audit event
+type Entry struct {
+	ID         int64
+	Type       string
+	Identifier string
+	Message    string
+	CreatedAt  time.Time
+	PrevHash   string
+	Hash       string
+}
+
+// store reads and writes audit events through the generated models of a
+// single driver, the hash chain is built and verified above it
+type store interface {
+	// lastHash returns the hash of the most recent event, stopping other
+	// writers from chaining to it until tx completes
+	lastHash(ctx context.Context, tx *sql.Tx) (string, error)
+	insert(ctx context.Context, exec boil.ContextExecutor, e *Entry) error
+	query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Entry, error)
+}
+
+// stores are the audit event stores keyed by dialect
+var stores = map[string]store{
+	database.DBSQLite3:    sqliteStore{},
+	database.DBPostgreSQL: postgresStore{},
+	database.DBMySQL:      mysqlStore{},
+}
+
+// getStore returns the audit event store of the enabled driver
+func getStore() (store, error) {
+	s, ok := stores[repository.GetSQLDialect()]
+	if !ok {
+		return nil, repository.ErrDialectNotSupported
+	}
+	return s, nil
+}
 
-	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+// queryOne returns the first event matching mods, returning sql.ErrNoRows if
+// there is none
+func queryOne(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) (*Entry, error) {
+	s, err := getStore()
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.query(ctx, exec, append(mods, qm.Limit(1))...)
 	if err != nil {
-		log.Errorf(log.Global, "Event transaction begin failed: %v", err)
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &events[0], nil
+}
+
+// Event inserts a new audit event to database, chaining its hash to the hash
+// of the previous event
+func Event(id, msgtype, message string) {
+	if database.DB.SQL == nil {
 		return
 	}
 
-	if repository.GetSQLDialect() == database.DBSQLite3 {
-		var tempEvent = modelSQLite.AuditEvent{
-			Type:       msgtype,
-			Identifier: id,
-			Message:    message,
+	eventMtx.Lock()
+	defer eventMtx.Unlock()
+
+	ctx := context.Background()
+	err := repository.Transaction(ctx, func(tx *sql.Tx) error {
+		s, err := getStore()
+		if err != nil {
+			return err
 		}
-		err = tempEvent.Insert(ctx, tx, boil.Blacklist("created_at"))
-	} else {
-		var tempEvent = modelPSQL.AuditEvent{
+		prevHash, err := s.lastHash(ctx, tx)
+		if err != nil {
+			return err
+		}
+		createdAt := time.Now().UTC().Truncate(time.Second)
+		return s.insert(ctx, tx, &Entry{
 			Type:       msgtype,
 			Identifier: id,
 			Message:    message,
-		}
-		err = tempEvent.Insert(ctx, tx, boil.Blacklist("created_at"))
-	}
-
+			CreatedAt:  createdAt,
+			PrevHash:   prevHash,
+			Hash:       Hash(prevHash, msgtype, id, message, createdAt),
+		})
+	})
 	if err != nil {
 		log.Errorf(log.Global, "Event insert failed: %v", err)
-		err = tx.Rollback()
-		if err != nil {
-			log.Errorf(log.Global, "Event Transaction rollback failed: %v", err)
-		}
-		return
 	}
+}
 
-	err = tx.Commit()
-	if err != nil {
-		log.Errorf(log.Global, "Event Transaction commit failed: %v", err)
-		err = tx.Rollback()
-		if err != nil {
-			log.Errorf(log.Global, "Event Transaction rollback failed: %v", err)
-		}
-		return
+// Hash returns the hex encoded SHA256 hash of an audit event chained to the
+// hash of the previous event
+func Hash(prevHash, msgtype, id, message string, createdAt time.Time) string {
+	h := sha256.New()
+	fields := []string{prevHash,
+		msgtype,
+		id,
+		message,
+		createdAt.UTC().Format(common.SimpleTimeFormat)}
+	for i := range fields {
+		// Length prefix fields so content can't be moved between them
+		fmt.Fprintf(h, "%d:%s", len(fields[i]), fields[i])
 	}
+	return hex.EncodeToString(h.Sum(nil))
 }
 
-// GetEvent () returns list of order events matching query
-func GetEvent(startTime, endTime time.Time, order string, limit int) (interface{}, error) {
+// GetEvent returns audit events created between startTime and endTime
+func GetEvent(startTime, endTime time.Time, order string, limit int) ([]Entry, error) {
 	if database.DB.SQL == nil {
 		return nil, database.ErrDatabaseSupportDisabled
 	}
 
-	query := qm.Where("created_at BETWEEN ? AND ?", startTime, endTime)
-
-	orderByQueryString := "id"
-	if order == "desc" {
-		orderByQueryString += " desc"
+	s, err := getStore()
+	if err != nil {
+		return nil, err
 	}
 
-	orderByQuery := qm.OrderBy(orderByQueryString)
-	limitQuery := qm.Limit(limit)
-
-	ctx := context.Background()
-	if repository.GetSQLDialect() == database.DBSQLite3 {
-		return modelSQLite.AuditEvents(query, orderByQuery, limitQuery).All(ctx, database.DB.SQL)
+	orderBy := "id"
+	if order == "desc" {
+		orderBy += " desc"
 	}
-
-	return modelPSQL.AuditEvents(query, orderByQuery, limitQuery).All(ctx, database.DB.SQL)
+	mods := append([]qm.QueryMod{
+		qm.Where("created_at BETWEEN ? AND ?", repository.Args(startTime, endTime)...),
+		qm.OrderBy(orderBy),
+	}, repository.Limit(limit)...)
+	return s.query(context.Background(), database.DB.SQL, mods...)
 }
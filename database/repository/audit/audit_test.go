@@ -1,24 +1,24 @@
 package audit
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"io/ioutil"
 	"os"
-	"path/filepath"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/yurulab/gocryptotrader/database"
-	"github.com/yurulab/gocryptotrader/database/drivers"
 	"github.com/yurulab/gocryptotrader/database/repository"
 	"github.com/yurulab/gocryptotrader/database/testhelpers"
-	"github.com/thrasher-corp/goose"
 )
 
 func TestMain(m *testing.M) {
 	var err error
 	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
 	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
 	if err != nil {
 		fmt.Printf("failed to create temp file: %v", err)
@@ -36,81 +36,11 @@ func TestMain(m *testing.M) {
 }
 
 func TestAudit(t *testing.T) {
-	testCases := []struct {
-		name   string
-		config *database.Config
-		runner func(t *testing.T)
-		closer func(dbConn *database.Instance) error
-		output interface{}
-	}{
-		{
-			"SQLite-Write",
-			&database.Config{
-				Driver:            database.DBSQLite3,
-				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
-			},
-
-			writeAudit,
-			testhelpers.CloseDatabase,
-			nil,
-		},
-		{
-			"SQLite-Read",
-			&database.Config{
-				Driver:            database.DBSQLite3,
-				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
-			},
-
-			readHelper,
-			testhelpers.CloseDatabase,
-			nil,
-		},
-		{
-			"Postgres-Write",
-			testhelpers.PostgresTestDatabase,
-			writeAudit,
-			nil,
-			nil,
-		},
-		{
-			"Postgres-Read",
-			testhelpers.PostgresTestDatabase,
-			readHelper,
-			nil,
-			nil,
-		},
-	}
-
-	for _, tests := range testCases {
-		test := tests
-		t.Run(test.name, func(t *testing.T) {
-			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
-				t.Skip("database not configured skipping test")
-			}
-
-			dbConn, err := testhelpers.ConnectToDatabase(test.config)
-			if err != nil {
-				t.Fatal(err)
-			}
-
-			path := filepath.Join("..", "..", "migrations")
-			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
-			if err != nil {
-				t.Fatalf("failed to run migrations %v", err)
-			}
-
-			if test.runner != nil {
-				test.runner(t)
-			}
-
-			if test.closer != nil {
-				err = test.closer(dbConn)
-				if err != nil {
-					t.Log(err)
-				}
-			}
-		})
-	}
+	testhelpers.Run(t,
+		testhelpers.TestCase{Name: "Write", Runner: writeAudit},
+		testhelpers.TestCase{Name: "Read", Runner: readHelper},
+		testhelpers.TestCase{Name: "Verify", Runner: verifyHelper},
+	)
 }
 
 func writeAudit(t *testing.T) {
@@ -138,3 +68,96 @@ func readHelper(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func verifyHelper(t *testing.T) {
+	t.Helper()
+
+	result, err := Verify(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Breaks) != 0 {
+		t.Fatalf("expected unbroken chain, received %+v", result.Breaks)
+	}
+	if result.Checked == 0 {
+		t.Fatal("expected chained events to be checked")
+	}
+
+	id, hash, err := GetLatestHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != result.LastID || hash != result.LastHash {
+		t.Error("latest hash should match the end of the chain")
+	}
+
+	markID, _, cutoff, err := ArchiveMark(time.Now().Add(time.Hour))
+	if err != nil && err != sql.ErrNoRows {
+		t.Fatal(err)
+	}
+	if err == nil && (markID >= id || cutoff.After(time.Now())) {
+		t.Errorf("expected archive mark to leave the latest event, received %v", markID)
+	}
+	prevHash, err := GetHash(id - 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = repository.Exec(context.Background(), database.DB.SQL, "UPDATE audit_event SET message = 'altered' WHERE id = ?", result.LastID-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err = Verify(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Breaks) != 1 || result.Breaks[0].ID != id-1 {
+		t.Errorf("expected altered event to break the chain, received %+v", result.Breaks)
+	}
+
+	_, err = repository.Exec(context.Background(), database.DB.SQL, "DELETE FROM audit_event WHERE id = ?", id-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err = Verify(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Breaks) != 1 || result.Breaks[0].ID != id {
+		t.Errorf("expected removed event to break the chain, received %+v", result.Breaks)
+	}
+	_, err = repository.Exec(context.Background(), database.DB.SQL, "DELETE FROM audit_event WHERE id < ?", id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err = Verify(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Breaks) != 1 || result.Breaks[0].ID != id {
+		t.Errorf("expected removed oldest events to break the chain, received %+v", result.Breaks)
+	}
+	archived := []ArchivedEvent{{ID: id - 1, Hash: prevHash}}
+	result, err = Verify(archived)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Breaks) != 0 {
+		t.Errorf("expected archived events not to break the chain, received %+v", result.Breaks)
+	}
+	if result.ChainStart != prevHash {
+		t.Error("expected chain start to be set once events are archived")
+	}
+
+	_, err = repository.Exec(context.Background(), database.DB.SQL, "DELETE FROM audit_event")
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err = Verify(archived)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Breaks) != 1 {
+		t.Errorf("expected an empty table after an archive to break the chain, received %+v", result.Breaks)
+	}
+}
@@ -0,0 +1,61 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+
+	modelMySQL "github.com/yurulab/gocryptotrader/database/models/mysql"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+type mysqlStore struct{}
+
+// lastHash locks the most recent event until tx completes so concurrent
+// writers can't chain to the same event
+func (mysqlStore) lastHash(ctx context.Context, tx *sql.Tx) (string, error) {
+	last, err := modelMySQL.AuditEvents(qm.OrderBy("id desc"), qm.For("update")).One(ctx, tx)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return last.Hash, nil
+}
+
+func (mysqlStore) insert(ctx context.Context, exec boil.ContextExecutor, e *Entry) error {
+	event := modelMySQL.AuditEvent{
+		Type:       e.Type,
+		Identifier: e.Identifier,
+		Message:    e.Message,
+		CreatedAt:  e.CreatedAt,
+		PrevHash:   e.PrevHash,
+		Hash:       e.Hash,
+	}
+	if err := event.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+	e.ID = event.ID
+	return nil
+}
+
+func (mysqlStore) query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Entry, error) {
+	events, err := modelMySQL.AuditEvents(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Entry, len(events))
+	for i := range events {
+		resp[i] = Entry{
+			ID:         events[i].ID,
+			Type:       events[i].Type,
+			Identifier: events[i].Identifier,
+			Message:    events[i].Message,
+			CreatedAt:  events[i].CreatedAt.UTC(),
+			PrevHash:   events[i].PrevHash,
+			Hash:       events[i].Hash,
+		}
+	}
+	return resp, nil
+}
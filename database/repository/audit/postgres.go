@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+
+	modelPSQL "github.com/yurulab/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+type postgresStore struct{}
+
+// lastHash locks the table against inserts, a row lock would not stop a
+// writer which started before the newest event was committed from chaining
+// to the event before it
+func (postgresStore) lastHash(ctx context.Context, tx *sql.Tx) (string, error) {
+	_, err := tx.ExecContext(ctx, "LOCK TABLE audit_event IN SHARE ROW EXCLUSIVE MODE")
+	if err != nil {
+		return "", err
+	}
+	last, err := modelPSQL.AuditEvents(qm.OrderBy("id desc")).One(ctx, tx)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return last.Hash, nil
+}
+
+func (postgresStore) insert(ctx context.Context, exec boil.ContextExecutor, e *Entry) error {
+	event := modelPSQL.AuditEvent{
+		Type:       e.Type,
+		Identifier: e.Identifier,
+		Message:    e.Message,
+		CreatedAt:  e.CreatedAt,
+		PrevHash:   e.PrevHash,
+		Hash:       e.Hash,
+	}
+	if err := event.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+	e.ID = event.ID
+	return nil
+}
+
+func (postgresStore) query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Entry, error) {
+	events, err := modelPSQL.AuditEvents(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Entry, len(events))
+	for i := range events {
+		resp[i] = Entry{
+			ID:         events[i].ID,
+			Type:       events[i].Type,
+			Identifier: events[i].Identifier,
+			Message:    events[i].Message,
+			CreatedAt:  events[i].CreatedAt.UTC(),
+			PrevHash:   events[i].PrevHash,
+			Hash:       events[i].Hash,
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+
+	modelSQLite "github.com/yurulab/gocryptotrader/database/models/sqlite3"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+type sqliteStore struct{}
+
+// lastHash does not lock as SQLite serialises writers and the driver only
+// opens a single connection
+func (sqliteStore) lastHash(ctx context.Context, tx *sql.Tx) (string, error) {
+	last, err := modelSQLite.AuditEvents(qm.OrderBy("id desc")).One(ctx, tx)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return last.Hash, nil
+}
+
+func (sqliteStore) insert(ctx context.Context, exec boil.ContextExecutor, e *Entry) error {
+	event := modelSQLite.AuditEvent{
+		Type:       e.Type,
+		Identifier: e.Identifier,
+		Message:    e.Message,
+		CreatedAt:  repository.FormatSQLiteTime(e.CreatedAt),
+		PrevHash:   e.PrevHash,
+		Hash:       e.Hash,
+	}
+	if err := event.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+	e.ID = event.ID
+	return nil
+}
+
+func (sqliteStore) query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Entry, error) {
+	events, err := modelSQLite.AuditEvents(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Entry, len(events))
+	for i := range events {
+		createdAt, err := repository.ParseSQLiteTime(events[i].CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Entry{
+			ID:         events[i].ID,
+			Type:       events[i].Type,
+			Identifier: events[i].Identifier,
+			Message:    events[i].Message,
+			CreatedAt:  createdAt,
+			PrevHash:   events[i].PrevHash,
+			Hash:       events[i].Hash,
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,197 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// verifyBatchSize is the number of events read at a time while verifying
+const verifyBatchSize = 1000
+
+// Break describes an audit event which does not verify against the chain
+type Break struct {
+	ID     int64
+	Reason string
+}
+
+// ArchivedEvent identifies the last audit event removed by an archive, the
+// events remaining after it chain from its hash
+type ArchivedEvent struct {
+	ID   int64
+	Hash string
+}
+
+// VerifyResult holds the outcome of walking the audit event hash chain
+type VerifyResult struct {
+	// Checked is the number of chained events verified
+	Checked int64
+	// Unhashed is the number of events recorded before hash chaining
+	Unhashed int64
+	LastID   int64
+	LastHash string
+	// ChainStart is the previous hash of the earliest remaining chained
+	// event, it is only set when older events have been archived
+	ChainStart string
+	Breaks     []Break
+}
+
+// Verify walks every audit event in insertion order, checking that each event
+// matches its hash and that the hashes form an unbroken chain. The chain must
+// start at the first event ever recorded unless it follows one of the supplied
+// archived events, which must come from a trusted record of past archives
+func Verify(archived []ArchivedEvent) (*VerifyResult, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+
+	var result VerifyResult
+	var expectedPrev string
+	var chained bool
+	var lastID int64
+	for {
+		events, err := getEventsAfter(lastID, verifyBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		for i := range events {
+			e := &events[i]
+			lastID = e.ID
+			if e.Hash == "" {
+				if !chained {
+					result.Unhashed++
+					continue
+				}
+				result.Breaks = append(result.Breaks, Break{
+					ID:     e.ID,
+					Reason: "event has no hash",
+				})
+				continue
+			}
+			if !chained && e.PrevHash != "" {
+				if !followsArchive(e, archived) {
+					result.Breaks = append(result.Breaks, Break{
+						ID:     e.ID,
+						Reason: "chain does not start at the first event or an archived event, events may have been removed",
+					})
+				}
+				result.ChainStart = e.PrevHash
+				expectedPrev = e.PrevHash
+			}
+			chained = true
+
+			if e.PrevHash != expectedPrev {
+				result.Breaks = append(result.Breaks, Break{
+					ID:     e.ID,
+					Reason: "previous hash does not match the preceding event, events may have been removed or reordered",
+				})
+			}
+			if Hash(e.PrevHash, e.Type, e.Identifier, e.Message, e.CreatedAt) != e.Hash {
+				result.Breaks = append(result.Breaks, Break{
+					ID:     e.ID,
+					Reason: "event content does not match its hash",
+				})
+			}
+			expectedPrev = e.Hash
+			result.Checked++
+			result.LastID = e.ID
+			result.LastHash = e.Hash
+		}
+		if len(events) < verifyBatchSize {
+			break
+		}
+	}
+
+	if lastID == 0 && len(archived) > 0 {
+		// Archives always leave the latest event behind, so an empty table
+		// means the events were removed
+		result.Breaks = append(result.Breaks, Break{
+			ID:     archived[len(archived)-1].ID,
+			Reason: "no events remain after archived events, events may have been removed",
+		})
+	}
+	return &result, nil
+}
+
+// followsArchive returns whether e chains directly from an archived event
+func followsArchive(e *Entry, archived []ArchivedEvent) bool {
+	for i := range archived {
+		if archived[i].Hash == e.PrevHash && archived[i].ID < e.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// ArchiveMark returns the id and hash of the newest audit event created before
+// before, along with the cutoff which archives up to and including it. The
+// latest event is never included so the head of the chain always remains.
+// sql.ErrNoRows is returned when there is nothing to archive
+func ArchiveMark(before time.Time) (int64, string, time.Time, error) {
+	if database.DB.SQL == nil {
+		return 0, "", time.Time{}, database.ErrDatabaseSupportDisabled
+	}
+
+	ctx := context.Background()
+	latest, err := queryOne(ctx, database.DB.SQL, qm.OrderBy("id desc"))
+	if err != nil {
+		return 0, "", time.Time{}, err
+	}
+	if latest.CreatedAt.Before(before) {
+		before = latest.CreatedAt
+	}
+
+	mark, err := queryOne(ctx, database.DB.SQL,
+		qm.Where("created_at < ?", repository.Args(before)...),
+		qm.OrderBy("id desc"))
+	if err != nil {
+		return 0, "", time.Time{}, err
+	}
+	return mark.ID, mark.Hash, before, nil
+}
+
+// GetLatestHash returns the id and hash of the most recent audit event
+func GetLatestHash() (int64, string, error) {
+	if database.DB.SQL == nil {
+		return 0, "", database.ErrDatabaseSupportDisabled
+	}
+
+	latest, err := queryOne(context.Background(), database.DB.SQL, qm.OrderBy("id desc"))
+	if err != nil {
+		return 0, "", err
+	}
+	return latest.ID, latest.Hash, nil
+}
+
+// GetHash returns the stored hash of an audit event
+func GetHash(id int64) (string, error) {
+	if database.DB.SQL == nil {
+		return "", database.ErrDatabaseSupportDisabled
+	}
+
+	e, err := queryOne(context.Background(), database.DB.SQL, qm.Where("id = ?", id))
+	if err != nil {
+		return "", err
+	}
+	return e.Hash, nil
+}
+
+// getEventsAfter returns up to limit events with an id greater than id
+func getEventsAfter(id int64, limit int) ([]Entry, error) {
+	s, err := getStore()
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.query(context.Background(), database.DB.SQL,
+		qm.Where("id > ?", id),
+		qm.OrderBy("id"),
+		qm.Limit(limit))
+	if err != nil {
+		return nil, fmt.Errorf("audit event: %v", err)
+	}
+	return events, nil
+}
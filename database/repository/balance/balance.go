@@ -0,0 +1,154 @@
+package balance
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Snapshot is the balance of a single currency held on an exchange account at
+// a point in time
+type Snapshot struct {
+	Exchange     string
+	Account      string
+	Currency     string
+	Total        float64
+	Hold         float64
+	FiatCurrency string
+	// FiatValue is the value of Total in FiatCurrency, only set when Priced
+	FiatValue float64
+	Priced    bool
+	Timestamp time.Time
+}
+
+// EquityPoint is the summed fiat value of every balance in a snapshot
+type EquityPoint struct {
+	Timestamp    time.Time
+	FiatCurrency string
+	Value        float64
+	// Unpriced is the number of balances which could not be valued and are
+	// excluded from Value
+	Unpriced int64
+}
+
+// store reads and writes balance snapshots through the generated models of a
+// single driver
+type store interface {
+	insert(ctx context.Context, exec boil.ContextExecutor, s *Snapshot) error
+	query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Snapshot, error)
+}
+
+// stores are the balance snapshot stores keyed by dialect
+var stores = map[string]store{
+	database.DBSQLite3:    sqliteStore{},
+	database.DBPostgreSQL: postgresStore{},
+	database.DBMySQL:      mysqlStore{},
+}
+
+// getStore returns the balance snapshot store of the enabled driver
+func getStore() (store, error) {
+	s, ok := stores[repository.GetSQLDialect()]
+	if !ok {
+		return nil, repository.ErrDialectNotSupported
+	}
+	return s, nil
+}
+
+// Insert stores a set of balance snapshots in the database
+func Insert(snapshots []Snapshot) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+
+	s, err := getStore()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	return repository.Transaction(ctx, func(tx *sql.Tx) error {
+		for i := range snapshots {
+			if err := s.insert(ctx, tx, &snapshots[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Get returns balance snapshots recorded between start and end in time order,
+// limited to an exchange and currency if supplied. A limit of zero returns
+// every matching snapshot
+func Get(exchange, currency string, start, end time.Time, limit int) ([]Snapshot, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+
+	s, err := getStore()
+	if err != nil {
+		return nil, err
+	}
+
+	mods := []qm.QueryMod{qm.Where("created_at BETWEEN ? AND ?", repository.Args(start, end)...)}
+	if exchange != "" {
+		mods = append(mods, qm.Where("lower(exchange) = lower(?)", exchange))
+	}
+	if currency != "" {
+		mods = append(mods, qm.Where("upper(currency) = upper(?)", currency))
+	}
+	mods = append(mods, qm.OrderBy("created_at, id"))
+	resp, err := s.query(context.Background(), database.DB.SQL, append(mods, repository.Limit(limit)...)...)
+	if err != nil {
+		return nil, fmt.Errorf("balance snapshot: %v", err)
+	}
+	return resp, nil
+}
+
+// GetEquityCurve returns the total fiat value of every snapshot taken between
+// start and end in time order, limited to an exchange if one is supplied
+func GetEquityCurve(exchange string, start, end time.Time) ([]EquityPoint, error) {
+	snapshots, err := Get(exchange, "", start, end, 0)
+	if err != nil {
+		return nil, err
+	}
+	return equityCurve(snapshots), nil
+}
+
+// equityCurve sums snapshots taken at the same time and in the same fiat
+// currency into equity points
+func equityCurve(snapshots []Snapshot) []EquityPoint {
+	type key struct {
+		timestamp int64
+		fiat      string
+	}
+	points := make(map[key]int)
+	var resp []EquityPoint
+	for i := range snapshots {
+		k := key{snapshots[i].Timestamp.Unix(), snapshots[i].FiatCurrency}
+		x, ok := points[k]
+		if !ok {
+			resp = append(resp, EquityPoint{
+				Timestamp:    snapshots[i].Timestamp,
+				FiatCurrency: snapshots[i].FiatCurrency,
+			})
+			x = len(resp) - 1
+			points[k] = x
+		}
+		if snapshots[i].Priced {
+			resp[x].Value += snapshots[i].FiatValue
+		} else {
+			resp[x].Unpriced++
+		}
+	}
+	sort.SliceStable(resp, func(i, j int) bool {
+		return resp[i].Timestamp.Before(resp[j].Timestamp)
+	})
+	return resp
+}
@@ -0,0 +1,93 @@
+package balance
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/database/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestBalanceSnapshots(t *testing.T) {
+	testhelpers.Run(t,
+		testhelpers.TestCase{Name: "Snapshot", Runner: snapshotHelper},
+	)
+}
+
+func snapshotHelper(t *testing.T) {
+	t.Helper()
+
+	now := time.Now().Truncate(time.Second)
+	for x := 0; x < 3; x++ {
+		ts := now.Add(time.Duration(x-3) * time.Minute)
+		err := Insert([]Snapshot{
+			{Exchange: "test-0", Account: "main", Currency: "BTC", Total: 1, FiatCurrency: "USD", FiatValue: 100 * float64(x+1), Priced: true, Timestamp: ts},
+			{Exchange: "test-0", Account: "main", Currency: "USD", Total: 50, Hold: 10, FiatCurrency: "USD", FiatValue: 50, Priced: true, Timestamp: ts},
+			{Exchange: "test-1", Account: "main", Currency: "XYZ", Total: 5, FiatCurrency: "USD", Timestamp: ts},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	snapshots, err := Get("TEST-0", "btc", now.Add(-time.Hour), now, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 3 {
+		t.Fatalf("expected 3 snapshots, received %v", len(snapshots))
+	}
+	if !snapshots[0].Timestamp.Before(snapshots[2].Timestamp) ||
+		snapshots[2].FiatValue != 300 || !snapshots[2].Priced {
+		t.Errorf("unexpected snapshot history %+v", snapshots)
+	}
+
+	snapshots, err = Get("", "", now.Add(-time.Hour), now, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 2 {
+		t.Errorf("expected limit of 2 snapshots, received %v", len(snapshots))
+	}
+
+	curve, err := GetEquityCurve("", now.Add(-time.Hour), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(curve) != 3 {
+		t.Fatalf("expected 3 equity points, received %v", len(curve))
+	}
+	if curve[0].Value != 150 || curve[2].Value != 350 || curve[2].Unpriced != 1 {
+		t.Errorf("unexpected equity curve %+v", curve)
+	}
+
+	curve, err = GetEquityCurve("test-1", now.Add(-time.Hour), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(curve) != 3 || curve[0].Value != 0 || curve[0].Unpriced != 1 {
+		t.Errorf("unexpected exchange equity curve %+v", curve)
+	}
+}
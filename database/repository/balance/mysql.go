@@ -0,0 +1,49 @@
+package balance
+
+import (
+	"context"
+	"time"
+
+	modelMySQL "github.com/yurulab/gocryptotrader/database/models/mysql"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+type mysqlStore struct{}
+
+func (mysqlStore) insert(ctx context.Context, exec boil.ContextExecutor, s *Snapshot) error {
+	snapshot := modelMySQL.BalanceSnapshot{
+		Exchange:     s.Exchange,
+		Account:      s.Account,
+		Currency:     s.Currency,
+		Total:        s.Total,
+		Hold:         s.Hold,
+		FiatCurrency: s.FiatCurrency,
+		FiatValue:    null.NewFloat64(s.FiatValue, s.Priced),
+		CreatedAt:    s.Timestamp.UTC().Truncate(time.Second),
+	}
+	return snapshot.Insert(ctx, exec, boil.Infer())
+}
+
+func (mysqlStore) query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Snapshot, error) {
+	snapshots, err := modelMySQL.BalanceSnapshots(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Snapshot, len(snapshots))
+	for i := range snapshots {
+		resp[i] = Snapshot{
+			Exchange:     snapshots[i].Exchange,
+			Account:      snapshots[i].Account,
+			Currency:     snapshots[i].Currency,
+			Total:        snapshots[i].Total,
+			Hold:         snapshots[i].Hold,
+			FiatCurrency: snapshots[i].FiatCurrency,
+			FiatValue:    snapshots[i].FiatValue.Float64,
+			Priced:       snapshots[i].FiatValue.Valid,
+			Timestamp:    snapshots[i].CreatedAt.UTC(),
+		}
+	}
+	return resp, nil
+}
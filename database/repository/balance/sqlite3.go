@@ -0,0 +1,55 @@
+package balance
+
+import (
+	"context"
+	"time"
+
+	modelSQLite "github.com/yurulab/gocryptotrader/database/models/sqlite3"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+type sqliteStore struct{}
+
+func (sqliteStore) insert(ctx context.Context, exec boil.ContextExecutor, s *Snapshot) error {
+	snapshot := modelSQLite.BalanceSnapshot{
+		Exchange:     s.Exchange,
+		Account:      s.Account,
+		Currency:     s.Currency,
+		Total:        s.Total,
+		Hold:         s.Hold,
+		FiatCurrency: s.FiatCurrency,
+		FiatValue:    null.NewFloat64(s.FiatValue, s.Priced),
+		CreatedAt:    repository.FormatSQLiteTime(s.Timestamp),
+	}
+	return snapshot.Insert(ctx, exec, boil.Infer())
+}
+
+func (sqliteStore) query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Snapshot, error) {
+	snapshots, err := modelSQLite.BalanceSnapshots(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Snapshot, len(snapshots))
+	for i := range snapshots {
+		var createdAt time.Time
+		createdAt, err = repository.ParseSQLiteTime(snapshots[i].CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Snapshot{
+			Exchange:     snapshots[i].Exchange,
+			Account:      snapshots[i].Account,
+			Currency:     snapshots[i].Currency,
+			Total:        snapshots[i].Total,
+			Hold:         snapshots[i].Hold,
+			FiatCurrency: snapshots[i].FiatCurrency,
+			FiatValue:    snapshots[i].FiatValue.Float64,
+			Priced:       snapshots[i].FiatValue.Valid,
+			Timestamp:    createdAt,
+		}
+	}
+	return resp, nil
+}
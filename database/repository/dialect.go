@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/database"
+)
+
+// Dialect describes how a database driver binds query arguments. Entity
+// repositories go through the generated models, the dialect is used by the
+// query mods passed to them and by the helpers which work across every table
+type Dialect interface {
+	// Name returns the dialect name as used by goose
+	Name() string
+	// Rebind rewrites the ? bind parameters in query into the form the
+	// driver expects
+	Rebind(query string) string
+	// Time returns t in the form stored in timestamp columns
+	Time(t time.Time) interface{}
+}
+
+// dialects are the supported dialects keyed by name
+var dialects = map[string]Dialect{
+	database.DBSQLite3:    sqliteDialect{},
+	database.DBPostgreSQL: postgresDialect{},
+	database.DBMySQL:      mysqlDialect{},
+}
+
+// GetDialect returns the dialect of the enabled driver
+func GetDialect() (Dialect, error) {
+	if database.DB.Config == nil {
+		return nil, ErrDialectNotSupported
+	}
+	d, ok := dialects[GetSQLDialect()]
+	if !ok {
+		return nil, ErrDialectNotSupported
+	}
+	return d, nil
+}
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) Name() string {
+	return database.DBSQLite3
+}
+
+func (sqliteDialect) Rebind(query string) string {
+	return query
+}
+
+// Time formats t as written by CURRENT_TIMESTAMP so that stored timestamps
+// compare correctly as text
+func (sqliteDialect) Time(t time.Time) interface{} {
+	return FormatSQLiteTime(t)
+}
+
+type postgresDialect struct{}
+
+func (postgresDialect) Name() string {
+	return database.DBPostgreSQL
+}
+
+// Rebind numbers each ? bind parameter as $1, $2 and so on, leaving any ?
+// within quoted strings or identifiers as is
+func (postgresDialect) Rebind(query string) string {
+	var b strings.Builder
+	var quote byte
+	n := 0
+	for i := range query {
+		switch c := query[i]; {
+		case quote != 0:
+			// A doubled quote escapes itself, which closes and reopens
+			// the quoted section
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '?':
+			n++
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(n))
+			continue
+		}
+		b.WriteByte(query[i])
+	}
+	return b.String()
+}
+
+func (postgresDialect) Time(t time.Time) interface{} {
+	return t.UTC()
+}
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) Name() string {
+	return database.DBMySQL
+}
+
+func (mysqlDialect) Rebind(query string) string {
+	return query
+}
+
+func (mysqlDialect) Time(t time.Time) interface{} {
+	return t.UTC()
+}
+
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/database"
+)
+
+func TestGetDialect(t *testing.T) {
+	database.DB.Config = &database.Config{Driver: database.DBPostgreSQL}
+	d, err := GetDialect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Name() != database.DBPostgreSQL {
+		t.Errorf("unexpected dialect %s", d.Name())
+	}
+
+	database.DB.Config = &database.Config{Driver: "oracle"}
+	if _, err = GetDialect(); err != ErrDialectNotSupported {
+		t.Errorf("expected %v, received %v", ErrDialectNotSupported, err)
+	}
+}
+
+func TestRebind(t *testing.T) {
+	query := "SELECT id FROM fills WHERE exchange = ? AND tid = ?"
+	if q := (postgresDialect{}).Rebind(query); q != "SELECT id FROM fills WHERE exchange = $1 AND tid = $2" {
+		t.Errorf("unexpected postgres query %s", q)
+	}
+	if q := (sqliteDialect{}).Rebind(query); q != query {
+		t.Errorf("unexpected sqlite query %s", q)
+	}
+	if q := (mysqlDialect{}).Rebind(query); q != query {
+		t.Errorf("unexpected mysql query %s", q)
+	}
+
+	query = `SELECT id FROM script WHERE script_name = 'why?' AND "col?" = ? AND script_path = 'it''s?' AND script_data = ?`
+	expected := `SELECT id FROM script WHERE script_name = 'why?' AND "col?" = $1 AND script_path = 'it''s?' AND script_data = $2`
+	if q := (postgresDialect{}).Rebind(query); q != expected {
+		t.Errorf("unexpected postgres query %s", q)
+	}
+}
+
+func TestTime(t *testing.T) {
+	ts := time.Date(2020, 4, 21, 10, 0, 0, 0, time.FixedZone("", 3600))
+	if v := (sqliteDialect{}).Time(ts); v != "2020-04-21 09:00:00" {
+		t.Errorf("unexpected sqlite time %v", v)
+	}
+	for _, d := range []Dialect{postgresDialect{}, mysqlDialect{}} {
+		v, ok := d.Time(ts).(time.Time)
+		if !ok || !v.Equal(ts) || v.Location() != time.UTC {
+			t.Errorf("unexpected %s time %v", d.Name(), v)
+		}
+	}
+}
+
+func TestLimit(t *testing.T) {
+	if l := Limit(0); len(l) != 0 {
+		t.Errorf("expected no limit, received %v", l)
+	}
+	if l := Limit(10); len(l) != 1 {
+		t.Errorf("unexpected limit %v", l)
+	}
+}
+
+func TestArgs(t *testing.T) {
+	ts := time.Date(2020, 4, 21, 9, 0, 0, 0, time.UTC)
+	database.DB.Config = &database.Config{Driver: database.DBSQLite3}
+	args := Args("binance", ts)
+	if args[0] != "binance" || args[1] != "2020-04-21 09:00:00" {
+		t.Errorf("unexpected sqlite args %v", args)
+	}
+	database.DB.Config = &database.Config{Driver: database.DBPostgreSQL}
+	args = Args("binance", ts)
+	if args[0] != "binance" || args[1] != ts {
+		t.Errorf("unexpected postgres args %v", args)
+	}
+}
+
+func TestScanTime(t *testing.T) {
+	expected := time.Date(2020, 4, 21, 9, 0, 0, 0, time.UTC)
+	for _, src := range []interface{}{
+		expected.In(time.FixedZone("", 3600)),
+		"2020-04-21 09:00:00",
+		[]byte("2020-04-21T09:00:00Z"),
+	} {
+		var ts time.Time
+		if err := ScanTime(&ts).Scan(src); err != nil {
+			t.Fatal(err)
+		}
+		if !ts.Equal(expected) || ts.Location() != time.UTC {
+			t.Errorf("unexpected time %v scanning %v", ts, src)
+		}
+	}
+
+	ts := time.Now()
+	if err := ScanTime(&ts).Scan(nil); err != nil || !ts.IsZero() {
+		t.Errorf("expected zero time for null, received %v %v", ts, err)
+	}
+	if err := ScanTime(&ts).Scan(int64(1)); err == nil {
+		t.Error("expected error scanning an integer")
+	}
+}
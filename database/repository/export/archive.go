@@ -0,0 +1,185 @@
+package export
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/common/file/archive"
+	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/yurulab/gocryptotrader/log"
+)
+
+// archiveTimeFormat is the cutoff time format used in archive file names
+const archiveTimeFormat = "20060102T150405Z"
+
+// Archive moves every row of table older than before, along with any child
+// rows referencing them, into a compressed archive of JSON lines files in dir.
+// It returns the number of table rows archived and the path of the archive,
+// no archive is written when there are no rows to archive
+func Archive(table string, before time.Time, dir string) (int64, string, error) {
+	if database.DB.SQL == nil {
+		return 0, "", database.ErrDatabaseSupportDisabled
+	}
+
+	t, err := GetTable(table)
+	if err != nil {
+		return 0, "", err
+	}
+
+	tmp, err := ioutil.TempDir("", "gct-archive")
+	if err != nil {
+		return 0, "", err
+	}
+	defer func() {
+		if rErr := os.RemoveAll(tmp); rErr != nil {
+			log.Errorf(log.DatabaseMgr, "Failed to remove temporary archive directory %s: %v", tmp, rErr)
+		}
+	}()
+
+	name := t.Name + "_" + before.UTC().Format(archiveTimeFormat)
+	src := filepath.Join(tmp, name)
+	if err = os.Mkdir(src, 0770); err != nil {
+		return 0, "", err
+	}
+
+	ctx := context.Background()
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, "", err
+	}
+
+	var dest string
+	count, err := archiveRows(ctx, tx, t, before, src)
+	if err == nil && count > 0 {
+		if err = os.MkdirAll(dir, 0770); err == nil {
+			dest = filepath.Join(dir, name+".zip")
+			err = archive.Zip(src, dest)
+		}
+		if err == nil {
+			_, err = deleteRows(ctx, tx, t, before)
+		}
+	}
+	if err != nil || count == 0 {
+		if rErr := tx.Rollback(); rErr != nil {
+			log.Errorf(log.DatabaseMgr, "Archive Transaction rollback failed: %v", rErr)
+		}
+		if err != nil && dest != "" {
+			removeArchive(dest)
+		}
+		return 0, "", err
+	}
+
+	if err = tx.Commit(); err != nil {
+		removeArchive(dest)
+		return 0, "", err
+	}
+	return count, dest, nil
+}
+
+// Delete removes every row of table older than before, along with any child
+// rows referencing them, without archiving them
+func Delete(table string, before time.Time) (int64, error) {
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
+	}
+
+	t, err := GetTable(table)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx := context.Background()
+	var count int64
+	err = repository.Transaction(ctx, func(tx *sql.Tx) error {
+		count, err = deleteRows(ctx, tx, t, before)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// archiveRows writes the rows of t older than before, and their child rows,
+// to a JSON lines file per table in dir
+func archiveRows(ctx context.Context, tx *sql.Tx, t *Table, before time.Time, dir string) (int64, error) {
+	count, err := writeFile(ctx, tx, filepath.Join(dir, t.Name+"."+FormatJSONLines),
+		fmt.Sprintf("SELECT * FROM %s WHERE %s < ? ORDER BY %s",
+			t.Name,
+			t.TimeColumn,
+			t.TimeColumn),
+		before)
+	if err != nil || count == 0 {
+		return count, err
+	}
+
+	for i := range t.Children {
+		_, err = writeFile(ctx, tx, filepath.Join(dir, t.Children[i].Name+"."+FormatJSONLines),
+			fmt.Sprintf("SELECT * FROM %s WHERE %s", t.Children[i].Name, childCondition(t, &t.Children[i])),
+			before)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return count, nil
+}
+
+// deleteRows removes the rows of t older than before, removing child rows
+// first so foreign key constraints are not violated
+func deleteRows(ctx context.Context, tx *sql.Tx, t *Table, before time.Time) (int64, error) {
+	for i := range t.Children {
+		_, err := repository.Exec(ctx, tx,
+			fmt.Sprintf("DELETE FROM %s WHERE %s", t.Children[i].Name, childCondition(t, &t.Children[i])),
+			before)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	result, err := repository.Exec(ctx, tx,
+		fmt.Sprintf("DELETE FROM %s WHERE %s < ?", t.Name, t.TimeColumn),
+		before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// childCondition returns the condition matching child rows which reference
+// rows of t older than the first query argument
+func childCondition(t *Table, c *Child) string {
+	return fmt.Sprintf("%s IN (SELECT id FROM %s WHERE %s < ?)",
+		c.ForeignKey,
+		t.Name,
+		t.TimeColumn)
+}
+
+// writeFile writes the rows returned by query to a JSON lines file at path
+func writeFile(ctx context.Context, tx *sql.Tx, path, query string, args ...interface{}) (int64, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+	rw, err := newRowWriter(FormatJSONLines, f)
+	if err != nil {
+		f.Close()
+		return 0, err
+	}
+	count, err := writeQuery(ctx, tx, rw, query, args...)
+	if cErr := f.Close(); err == nil {
+		err = cErr
+	}
+	return count, err
+}
+
+func removeArchive(path string) {
+	if err := os.Remove(path); err != nil {
+		log.Errorf(log.DatabaseMgr, "Failed to remove archive, manual deletion required: %v", err)
+	}
+}
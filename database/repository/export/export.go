@@ -0,0 +1,229 @@
+package export
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/yurulab/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+)
+
+const (
+	// FormatCSV writes rows as comma separated values with a header row
+	FormatCSV = "csv"
+	// FormatJSONLines writes each row as a JSON object on its own line
+	FormatJSONLines = "jsonl"
+	// FormatParquet writes rows as an uncompressed Parquet file
+	FormatParquet = "parquet"
+)
+
+// Formats is the list of supported export formats
+var Formats = []string{FormatCSV, FormatJSONLines, FormatParquet}
+
+// rowWriter writes scanned rows in an export format
+type rowWriter interface {
+	// WriteHeader is passed the column names and their database types
+	WriteHeader(columns, types []string) error
+	WriteRow(values []interface{}) error
+	Flush() error
+}
+
+// Export writes every row of table with a time column value between start
+// and end to w in the requested format, returning the number of rows written
+func Export(table string, start, end time.Time, format string, w io.Writer) (int64, error) {
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
+	}
+
+	t, err := GetTable(table)
+	if err != nil {
+		return 0, err
+	}
+	rw, err := newRowWriter(format, w)
+	if err != nil {
+		return 0, err
+	}
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE %s BETWEEN ? AND ? ORDER BY %s",
+		t.Name,
+		t.TimeColumn,
+		t.TimeColumn)
+	return writeQuery(context.Background(), database.DB.SQL, rw, query, start, end)
+}
+
+// ToFile exports table to a temporary file alongside path which is renamed
+// over path once every row has been written, so a failed export never leaves
+// a partial file behind
+func ToFile(table string, start, end time.Time, format, path string) (int64, error) {
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return 0, err
+	}
+	count, err := Export(table, start, end, format, f)
+	if cErr := f.Close(); err == nil {
+		err = cErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		if rErr := os.Remove(f.Name()); rErr != nil {
+			log.Errorf(log.DatabaseMgr, "Failed to remove temporary export file %s: %v", f.Name(), rErr)
+		}
+		return 0, err
+	}
+	return count, nil
+}
+
+// newRowWriter returns a row writer for the supplied format
+func newRowWriter(format string, w io.Writer) (rowWriter, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case FormatJSONLines:
+		return &jsonLinesWriter{e: json.NewEncoder(w)}, nil
+	case FormatParquet:
+		return newParquetWriter(w), nil
+	}
+	return nil, fmt.Errorf("unsupported export format %q, supported formats: %v", format, Formats)
+}
+
+// writeQuery runs query, written with ? bind parameters, and writes every
+// returned row to rw
+func writeQuery(ctx context.Context, exec boil.ContextExecutor, rw rowWriter, query string, args ...interface{}) (int64, error) {
+	rows, err := repository.Query(ctx, exec, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	count, err := writeRows(rows, rw)
+	if err != nil {
+		return count, err
+	}
+	return count, rw.Flush()
+}
+
+// writeRows writes the column names followed by every row
+func writeRows(rows *sql.Rows, rw rowWriter) (int64, error) {
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return 0, err
+	}
+	columns := make([]string, len(columnTypes))
+	types := make([]string, len(columnTypes))
+	for i := range columnTypes {
+		columns[i] = columnTypes[i].Name()
+		types[i] = columnTypes[i].DatabaseTypeName()
+	}
+	if err = rw.WriteHeader(columns, types); err != nil {
+		return 0, err
+	}
+
+	values := make([]interface{}, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	var count int64
+	for rows.Next() {
+		if err = rows.Scan(dest...); err != nil {
+			return count, err
+		}
+		row := make([]interface{}, len(values))
+		for i := range values {
+			row[i] = normaliseValue(values[i])
+		}
+		if err = rw.WriteRow(row); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, rows.Err()
+}
+
+// normaliseValue converts a scanned column value into a dialect independent
+// representation, times are written in UTC as RFC3339 and binary data which is
+// not valid UTF-8 is base64 encoded
+func normaliseValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case time.Time:
+		return val.UTC().Format(time.RFC3339Nano)
+	case []byte:
+		if utf8.Valid(val) {
+			return string(val)
+		}
+		return base64.StdEncoding.EncodeToString(val)
+	}
+	return v
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) WriteHeader(columns, _ []string) error {
+	return c.w.Write(columns)
+}
+
+func (c *csvWriter) WriteRow(values []interface{}) error {
+	record := make([]string, len(values))
+	for i := range values {
+		record[i] = formatValue(values[i])
+	}
+	return c.w.Write(record)
+}
+
+// formatValue returns a normalised value as text, null values are empty
+func formatValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+func (c *csvWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type jsonLinesWriter struct {
+	e       *json.Encoder
+	columns []string
+}
+
+func (j *jsonLinesWriter) WriteHeader(columns, _ []string) error {
+	j.columns = columns
+	return nil
+}
+
+func (j *jsonLinesWriter) WriteRow(values []interface{}) error {
+	row := make(map[string]interface{}, len(values))
+	for i := range values {
+		row[j.columns[i]] = values[i]
+	}
+	return j.e.Encode(row)
+}
+
+func (j *jsonLinesWriter) Flush() error {
+	return nil
+}
@@ -0,0 +1,273 @@
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/database/repository/balance"
+	withdrawDB "github.com/yurulab/gocryptotrader/database/repository/withdraw"
+	"github.com/yurulab/gocryptotrader/database/testhelpers"
+	"github.com/yurulab/gocryptotrader/portfolio/banking"
+	"github.com/yurulab/gocryptotrader/portfolio/withdraw"
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestGetTable(t *testing.T) {
+	tbl, err := GetTable("FILLS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tbl.TimeColumn != "traded_at" {
+		t.Errorf("unexpected time column %s", tbl.TimeColumn)
+	}
+	if _, err = GetTable("nonce"); err == nil {
+		t.Error("expected error for unsupported table")
+	}
+}
+
+func TestNewRowWriter(t *testing.T) {
+	for _, f := range Formats {
+		if _, err := newRowWriter(f, ioutil.Discard); err != nil {
+			t.Error(err)
+		}
+	}
+	if _, err := newRowWriter("xml", ioutil.Discard); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
+
+func TestNormaliseValue(t *testing.T) {
+	ts := time.Date(2020, 4, 1, 10, 0, 0, 0, time.FixedZone("", 3600))
+	if v := normaliseValue(ts); v != "2020-04-01T09:00:00Z" {
+		t.Errorf("unexpected time value %v", v)
+	}
+	if v := normaliseValue([]byte("text")); v != "text" {
+		t.Errorf("unexpected text value %v", v)
+	}
+	if v := normaliseValue([]byte{0xff, 0xfe}); v != "//4=" {
+		t.Errorf("unexpected binary value %v", v)
+	}
+	if v := normaliseValue(int64(5)); v != int64(5) {
+		t.Errorf("unexpected int value %v", v)
+	}
+}
+
+func TestExport(t *testing.T) {
+	testhelpers.Run(t,
+		testhelpers.TestCase{Name: "Export", Runner: exportHelper},
+		testhelpers.TestCase{Name: "Archive", Runner: archiveHelper},
+	)
+}
+
+func exportHelper(t *testing.T) {
+	t.Helper()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for x := 0; x < 3; x++ {
+		err := balance.Insert([]balance.Snapshot{
+			{
+				Exchange:     "export",
+				Account:      "main",
+				Currency:     "BTC",
+				Total:        float64(x + 1),
+				FiatCurrency: "USD",
+				Timestamp:    now.Add(time.Duration(x-3) * time.Hour),
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var buf bytes.Buffer
+	count, err := Export("balance_snapshot", now.Add(-time.Hour*2-time.Minute), now, FormatCSV, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Fatalf("expected 2 rows, received %v", count)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header and 2 rows, received %v lines", len(lines))
+	}
+	if !strings.Contains(lines[0], "fiat_value") {
+		t.Errorf("unexpected header %s", lines[0])
+	}
+	if !strings.Contains(lines[1], ",,") {
+		t.Errorf("expected null fiat value to be written as an empty field: %s", lines[1])
+	}
+
+	buf.Reset()
+	count, err = Export("balance_snapshot", now.Add(-time.Hour*4), now, FormatJSONLines, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Fatalf("expected 3 rows, received %v", count)
+	}
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var row map[string]interface{}
+		if err = json.Unmarshal(scanner.Bytes(), &row); err != nil {
+			t.Fatal(err)
+		}
+		if row["exchange"] != "export" || row["fiat_value"] != nil {
+			t.Errorf("unexpected row %v", row)
+		}
+		if _, err = time.Parse(time.RFC3339Nano, row["created_at"].(string)); err != nil {
+			t.Error(err)
+		}
+	}
+
+	buf.Reset()
+	count, err = Export("balance_snapshot", now.Add(-time.Hour*4), now, FormatParquet, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Fatalf("expected 3 rows, received %v", count)
+	}
+	columns, rows := readParquet(t, buf.Bytes())
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 parquet rows, received %v", len(rows))
+	}
+	for i := range columns {
+		switch columns[i] {
+		case "exchange":
+			if rows[0][i] != "export" {
+				t.Errorf("unexpected exchange %v", rows[0][i])
+			}
+		case "id":
+			if _, ok := rows[0][i].(int64); !ok {
+				t.Errorf("expected integer id, received %T", rows[0][i])
+			}
+		}
+	}
+
+	path := filepath.Join(testhelpers.TempDir, "export.parquet")
+	count, err = ToFile("balance_snapshot", now.Add(-time.Hour*4), now, FormatParquet, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Fatalf("expected 3 rows, received %v", count)
+	}
+	if file, rErr := ioutil.ReadFile(path); rErr != nil {
+		t.Error(rErr)
+	} else if _, rows = readParquet(t, file); len(rows) != 3 {
+		t.Errorf("expected 3 parquet rows, received %v", len(rows))
+	}
+	failed := filepath.Join(testhelpers.TempDir, "failed.parquet")
+	if _, err = ToFile("balance_snapshot", now, now, "xml", failed); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+	if matches, _ := filepath.Glob(failed + "*"); len(matches) != 0 {
+		t.Errorf("expected failed export to leave no files, found %v", matches)
+	}
+
+	if _, err = Export("balance_snapshot", now, now, "xml", &buf); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
+
+func archiveHelper(t *testing.T) {
+	t.Helper()
+
+	for x := 0; x < 2; x++ {
+		resp := &withdraw.Response{
+			Exchange: &withdraw.ExchangeResponse{
+				Name:   "archive",
+				ID:     fmt.Sprintf("archive-%v", x),
+				Status: "complete",
+			},
+			RequestDetails: &withdraw.Request{
+				Exchange: "archive",
+				Amount:   1,
+			},
+		}
+		if x == 0 {
+			resp.RequestDetails.Currency = currency.AUD
+			resp.RequestDetails.Type = withdraw.Fiat
+			resp.RequestDetails.Fiat = &withdraw.FiatRequest{Bank: &banking.Account{}}
+		} else {
+			resp.RequestDetails.Currency = currency.BTC
+			resp.RequestDetails.Type = withdraw.Crypto
+			resp.RequestDetails.Crypto = &withdraw.CryptoRequest{Address: "addr"}
+		}
+		withdrawDB.Event(resp)
+	}
+
+	dir := filepath.Join(testhelpers.TempDir, "archive")
+	count, _, err := Archive("withdrawal_history", time.Now().Add(-time.Hour), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Fatalf("expected no rows to be archived, received %v", count)
+	}
+
+	count, file, err := Archive("withdrawal_history", time.Now().Add(time.Minute), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count < 2 {
+		t.Fatalf("expected at least 2 rows to be archived, received %v", count)
+	}
+
+	z, err := zip.OpenReader(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer z.Close()
+	var files []string
+	for i := range z.File {
+		if !z.File[i].FileInfo().IsDir() {
+			files = append(files, filepath.Base(z.File[i].Name))
+		}
+	}
+	if len(files) != 3 {
+		t.Errorf("expected a file per table, received %v", files)
+	}
+
+	for _, table := range []string{"withdrawal_history", "withdrawal_fiat", "withdrawal_crypto"} {
+		var remaining int64
+		err = database.DB.SQL.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&remaining)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if remaining != 0 {
+			t.Errorf("expected %s to be empty, %v rows remain", table, remaining)
+		}
+	}
+}
@@ -0,0 +1,451 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// parquetRowGroupSize is the number of rows buffered before they are written
+// out as a row group
+const parquetRowGroupSize = 10000
+
+var parquetMagic = []byte("PAR1")
+
+// Parquet enum values as defined by the format's thrift specification
+const (
+	parquetBoolean   int32 = 0
+	parquetInt64     int32 = 2
+	parquetDouble    int32 = 5
+	parquetByteArray int32 = 6
+
+	parquetOptional     int32 = 1
+	parquetUTF8         int32 = 0
+	parquetPlain        int32 = 0
+	parquetRLE          int32 = 3
+	parquetDataPage     int32 = 0
+	parquetUncompressed int32 = 0
+)
+
+// parquetWriter writes rows as an uncompressed Parquet file with every column
+// optional and PLAIN encoded. Column types are taken from the database column
+// types, columns other than integers, floats or booleans are written as UTF-8
+// strings
+type parquetWriter struct {
+	w         io.Writer
+	offset    int64
+	groupSize int
+	columns   []string
+	types     []int32
+	rows      [][]interface{}
+	numRows   int64
+	groups    []parquetRowGroup
+}
+
+type parquetRowGroup struct {
+	numRows int64
+	size    int64
+	columns []parquetColumnChunk
+}
+
+type parquetColumnChunk struct {
+	numValues int64
+	size      int64
+	offset    int64
+}
+
+func newParquetWriter(w io.Writer) *parquetWriter {
+	return &parquetWriter{w: w, groupSize: parquetRowGroupSize}
+}
+
+func (p *parquetWriter) WriteHeader(columns, types []string) error {
+	p.columns = columns
+	p.types = make([]int32, len(columns))
+	for i := range columns {
+		p.types[i] = parquetByteArray
+		if i < len(types) {
+			p.types[i] = parquetType(types[i])
+		}
+	}
+	return p.write(parquetMagic)
+}
+
+// parquetType returns the parquet type used for a database column type
+func parquetType(dbType string) int32 {
+	dbType = strings.ToUpper(dbType)
+	switch {
+	case strings.Contains(dbType, "BOOL"):
+		return parquetBoolean
+	case strings.Contains(dbType, "INT") && !strings.HasPrefix(dbType, "INTERVAL"):
+		return parquetInt64
+	case strings.Contains(dbType, "REAL"),
+		strings.Contains(dbType, "FLOAT"),
+		strings.Contains(dbType, "DOUBLE"),
+		strings.Contains(dbType, "NUMERIC"),
+		strings.Contains(dbType, "DECIMAL"):
+		return parquetDouble
+	}
+	return parquetByteArray
+}
+
+func (p *parquetWriter) WriteRow(values []interface{}) error {
+	p.rows = append(p.rows, values)
+	if len(p.rows) < p.groupSize {
+		return nil
+	}
+	return p.writeRowGroup()
+}
+
+// Flush writes any buffered rows followed by the file footer, no rows can be
+// written afterwards
+func (p *parquetWriter) Flush() error {
+	if len(p.rows) > 0 {
+		if err := p.writeRowGroup(); err != nil {
+			return err
+		}
+	}
+	footer := p.fileMetaData()
+	length := make([]byte, 4)
+	binary.LittleEndian.PutUint32(length, uint32(len(footer)))
+	for _, b := range [][]byte{footer, length, parquetMagic} {
+		if err := p.write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *parquetWriter) write(b []byte) error {
+	n, err := p.w.Write(b)
+	p.offset += int64(n)
+	return err
+}
+
+// writeRowGroup writes the buffered rows as a row group with a single data
+// page per column. Every column is encoded before any are written so a value
+// which cannot be converted does not leave a partial row group
+func (p *parquetWriter) writeRowGroup() error {
+	pages := make([][]byte, len(p.columns))
+	for i := range p.columns {
+		var err error
+		if pages[i], err = p.encodeColumn(i); err != nil {
+			return err
+		}
+	}
+
+	group := parquetRowGroup{numRows: int64(len(p.rows))}
+	for _, data := range pages {
+		header := parquetPageHeader(len(p.rows), len(data))
+		chunk := parquetColumnChunk{
+			numValues: int64(len(p.rows)),
+			size:      int64(len(header) + len(data)),
+			offset:    p.offset,
+		}
+		if err := p.write(header); err != nil {
+			return err
+		}
+		if err := p.write(data); err != nil {
+			return err
+		}
+		group.size += chunk.size
+		group.columns = append(group.columns, chunk)
+	}
+	p.groups = append(p.groups, group)
+	p.numRows += group.numRows
+	p.rows = p.rows[:0]
+	return nil
+}
+
+// encodeColumn returns the page data for a column of the buffered rows, the
+// definition levels marking null values followed by the PLAIN encoded non null
+// values
+func (p *parquetWriter) encodeColumn(col int) ([]byte, error) {
+	var buf bytes.Buffer
+	levels := make([]bool, len(p.rows))
+	var values []interface{}
+	for i := range p.rows {
+		if p.rows[i][col] == nil {
+			continue
+		}
+		levels[i] = true
+		values = append(values, p.rows[i][col])
+	}
+	encoded := parquetBitPacked(levels)
+	length := make([]byte, 4)
+	binary.LittleEndian.PutUint32(length, uint32(len(encoded)))
+	buf.Write(length)
+	buf.Write(encoded)
+
+	switch p.types[col] {
+	case parquetBoolean:
+		bools := make([]bool, len(values))
+		for i := range values {
+			v, ok := toBool(values[i])
+			if !ok {
+				return nil, p.typeError(col, values[i])
+			}
+			bools[i] = v
+		}
+		buf.Write(parquetPackBits(bools))
+	case parquetInt64:
+		for i := range values {
+			v, ok := toInt64(values[i])
+			if !ok {
+				return nil, p.typeError(col, values[i])
+			}
+			_ = binary.Write(&buf, binary.LittleEndian, v)
+		}
+	case parquetDouble:
+		for i := range values {
+			v, ok := toFloat64(values[i])
+			if !ok {
+				return nil, p.typeError(col, values[i])
+			}
+			_ = binary.Write(&buf, binary.LittleEndian, math.Float64bits(v))
+		}
+	default:
+		for i := range values {
+			s := formatValue(values[i])
+			_ = binary.Write(&buf, binary.LittleEndian, uint32(len(s)))
+			buf.WriteString(s)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func (p *parquetWriter) typeError(col int, v interface{}) error {
+	return fmt.Errorf("column %s value %v of type %T cannot be converted to its parquet type",
+		p.columns[col],
+		v,
+		v)
+}
+
+// toBool converts a normalised value to a boolean, drivers without a boolean
+// type return integers or text
+func toBool(v interface{}) (bool, bool) {
+	switch val := v.(type) {
+	case bool:
+		return val, true
+	case int64:
+		return val != 0, true
+	case string:
+		b, err := strconv.ParseBool(val)
+		return b, err == nil
+	}
+	return false, false
+}
+
+// toInt64 converts a normalised value to an integer, floats are only accepted
+// when they hold a whole number
+func toInt64(v interface{}) (int64, bool) {
+	switch val := v.(type) {
+	case int64:
+		return val, true
+	case float64:
+		return int64(val), val == math.Trunc(val) && !math.IsInf(val, 0)
+	case bool:
+		if val {
+			return 1, true
+		}
+		return 0, true
+	case string:
+		i, err := strconv.ParseInt(val, 10, 64)
+		return i, err == nil
+	}
+	return 0, false
+}
+
+// toFloat64 converts a normalised value to a float, decimal columns are
+// returned as text by some drivers
+func toFloat64(v interface{}) (float64, bool) {
+	switch val := v.(type) {
+	case float64:
+		return val, true
+	case int64:
+		return float64(val), true
+	case string:
+		f, err := strconv.ParseFloat(val, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// fileMetaData returns the thrift compact encoded FileMetaData footer
+func (p *parquetWriter) fileMetaData() []byte {
+	t := newThriftWriter()
+	t.i32(1, 1)
+	t.listBegin(2, thriftStruct, len(p.columns)+1)
+	t.elemBegin()
+	t.binary(4, "schema")
+	t.i32(5, int32(len(p.columns)))
+	t.structEnd()
+	for i := range p.columns {
+		t.elemBegin()
+		t.i32(1, p.types[i])
+		t.i32(3, parquetOptional)
+		t.binary(4, p.columns[i])
+		if p.types[i] == parquetByteArray {
+			t.i32(6, parquetUTF8)
+		}
+		t.structEnd()
+	}
+	t.i64(3, p.numRows)
+	t.listBegin(4, thriftStruct, len(p.groups))
+	for i := range p.groups {
+		t.elemBegin()
+		t.listBegin(1, thriftStruct, len(p.groups[i].columns))
+		for j, c := range p.groups[i].columns {
+			t.elemBegin()
+			t.i64(2, c.offset)
+			t.structBegin(3)
+			t.i32(1, p.types[j])
+			t.listBegin(2, thriftI32, 2)
+			t.varint(zigzag(int64(parquetPlain)))
+			t.varint(zigzag(int64(parquetRLE)))
+			t.listBegin(3, thriftBinary, 1)
+			t.varint(uint64(len(p.columns[j])))
+			t.buf.WriteString(p.columns[j])
+			t.i32(4, parquetUncompressed)
+			t.i64(5, c.numValues)
+			t.i64(6, c.size)
+			t.i64(7, c.size)
+			t.i64(9, c.offset)
+			t.structEnd()
+			t.structEnd()
+		}
+		t.i64(2, p.groups[i].size)
+		t.i64(3, p.groups[i].numRows)
+		t.structEnd()
+	}
+	t.binary(6, "gocryptotrader")
+	t.structEnd()
+	return t.buf.Bytes()
+}
+
+// parquetPageHeader returns the thrift compact encoded PageHeader for an
+// uncompressed data page
+func parquetPageHeader(numValues, size int) []byte {
+	t := newThriftWriter()
+	t.i32(1, parquetDataPage)
+	t.i32(2, int32(size))
+	t.i32(3, int32(size))
+	t.structBegin(5)
+	t.i32(1, int32(numValues))
+	t.i32(2, parquetPlain)
+	t.i32(3, parquetRLE)
+	t.i32(4, parquetRLE)
+	t.structEnd()
+	t.structEnd()
+	return t.buf.Bytes()
+}
+
+// parquetBitPacked encodes levels with a bit width of one as a single bit
+// packed run of the RLE/bit-packing hybrid encoding
+func parquetBitPacked(levels []bool) []byte {
+	groups := (len(levels) + 7) / 8
+	t := newThriftWriter()
+	t.varint(uint64(groups)<<1 | 1)
+	t.buf.Write(parquetPackBits(levels))
+	return t.buf.Bytes()
+}
+
+// parquetPackBits packs values into bytes least significant bit first
+func parquetPackBits(values []bool) []byte {
+	resp := make([]byte, (len(values)+7)/8)
+	for i := range values {
+		if values[i] {
+			resp[i/8] |= 1 << uint(i%8)
+		}
+	}
+	return resp
+}
+
+// Thrift compact protocol field types
+const (
+	thriftI32    byte = 5
+	thriftI64    byte = 6
+	thriftBinary byte = 8
+	thriftList   byte = 9
+	thriftStruct byte = 12
+)
+
+// thriftWriter encodes structs with the thrift compact protocol, it starts
+// within a struct which is completed by structEnd
+type thriftWriter struct {
+	buf  bytes.Buffer
+	last []int16
+}
+
+func newThriftWriter() *thriftWriter {
+	return &thriftWriter{last: []int16{0}}
+}
+
+func (t *thriftWriter) field(id int16, typ byte) {
+	last := t.last[len(t.last)-1]
+	if delta := id - last; delta > 0 && delta <= 15 {
+		t.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		t.buf.WriteByte(typ)
+		t.varint(zigzag(int64(id)))
+	}
+	t.last[len(t.last)-1] = id
+}
+
+func (t *thriftWriter) i32(id int16, v int32) {
+	t.field(id, thriftI32)
+	t.varint(zigzag(int64(v)))
+}
+
+func (t *thriftWriter) i64(id int16, v int64) {
+	t.field(id, thriftI64)
+	t.varint(zigzag(v))
+}
+
+func (t *thriftWriter) binary(id int16, s string) {
+	t.field(id, thriftBinary)
+	t.varint(uint64(len(s)))
+	t.buf.WriteString(s)
+}
+
+// listBegin writes a list field header, the elements are written after it
+func (t *thriftWriter) listBegin(id int16, elem byte, size int) {
+	t.field(id, thriftList)
+	if size < 15 {
+		t.buf.WriteByte(byte(size)<<4 | elem)
+		return
+	}
+	t.buf.WriteByte(0xf0 | elem)
+	t.varint(uint64(size))
+}
+
+// structBegin starts a struct field
+func (t *thriftWriter) structBegin(id int16) {
+	t.field(id, thriftStruct)
+	t.elemBegin()
+}
+
+// elemBegin starts a struct list element
+func (t *thriftWriter) elemBegin() {
+	t.last = append(t.last, 0)
+}
+
+func (t *thriftWriter) structEnd() {
+	t.buf.WriteByte(0)
+	t.last = t.last[:len(t.last)-1]
+}
+
+func (t *thriftWriter) varint(v uint64) {
+	for v >= 0x80 {
+		t.buf.WriteByte(byte(v) | 0x80)
+		v >>= 7
+	}
+	t.buf.WriteByte(byte(v))
+}
+
+func zigzag(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
@@ -0,0 +1,290 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// thriftReader decodes thrift compact protocol structs into maps keyed by
+// field id so that written files can be checked against the format
+type thriftReader struct {
+	b   []byte
+	pos int
+}
+
+func (r *thriftReader) byte() byte {
+	b := r.b[r.pos]
+	r.pos++
+	return b
+}
+
+func (r *thriftReader) varint() uint64 {
+	var v uint64
+	for shift := uint(0); ; shift += 7 {
+		b := r.byte()
+		v |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return v
+		}
+	}
+}
+
+func (r *thriftReader) int() int64 {
+	v := r.varint()
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+func (r *thriftReader) value(typ byte) interface{} {
+	switch typ {
+	case 1:
+		return true
+	case 2:
+		return false
+	case thriftI32, thriftI64:
+		return r.int()
+	case thriftBinary:
+		n := int(r.varint())
+		s := string(r.b[r.pos : r.pos+n])
+		r.pos += n
+		return s
+	case thriftList:
+		h := r.byte()
+		size := int(h >> 4)
+		if size == 15 {
+			size = int(r.varint())
+		}
+		resp := make([]interface{}, size)
+		for i := range resp {
+			resp[i] = r.value(h & 0x0f)
+		}
+		return resp
+	case thriftStruct:
+		return r.readStruct()
+	}
+	panic("unsupported thrift type")
+}
+
+func (r *thriftReader) readStruct() map[int16]interface{} {
+	resp := make(map[int16]interface{})
+	var last int16
+	for {
+		b := r.byte()
+		if b == 0 {
+			return resp
+		}
+		id := last + int16(b>>4)
+		if b>>4 == 0 {
+			id = int16(r.int())
+		}
+		last = id
+		resp[id] = r.value(b & 0x0f)
+	}
+}
+
+// readParquet decodes a file written by parquetWriter, returning the column
+// names and every row
+func readParquet(t *testing.T, file []byte) ([]string, [][]interface{}) {
+	t.Helper()
+	if !bytes.HasPrefix(file, parquetMagic) || !bytes.HasSuffix(file, parquetMagic) {
+		t.Fatal("missing parquet magic")
+	}
+	length := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	r := thriftReader{b: file[len(file)-8-length : len(file)-8]}
+	meta := r.readStruct()
+	if r.pos != length {
+		t.Fatalf("footer length %d does not match decoded length %d", length, r.pos)
+	}
+
+	schema := meta[2].([]interface{})
+	if root := schema[0].(map[int16]interface{}); root[5].(int64) != int64(len(schema)-1) {
+		t.Fatalf("unexpected schema root %v", root)
+	}
+	var columns []string
+	types := make([]int64, len(schema)-1)
+	for i := range types {
+		e := schema[i+1].(map[int16]interface{})
+		columns = append(columns, e[4].(string))
+		types[i] = e[1].(int64)
+		if e[3].(int64) != int64(parquetOptional) {
+			t.Errorf("column %s is not optional", columns[i])
+		}
+	}
+
+	var rows [][]interface{}
+	for _, g := range meta[4].([]interface{}) {
+		group := g.(map[int16]interface{})
+		numRows := int(group[3].(int64))
+		groupRows := make([][]interface{}, numRows)
+		for i := range groupRows {
+			groupRows[i] = make([]interface{}, len(columns))
+		}
+		for c, cc := range group[1].([]interface{}) {
+			md := cc.(map[int16]interface{})[3].(map[int16]interface{})
+			if md[1].(int64) != types[c] || md[5].(int64) != int64(numRows) {
+				t.Fatalf("unexpected column metadata %v", md)
+			}
+			offset := int(md[9].(int64))
+			pr := thriftReader{b: file[offset:]}
+			header := pr.readStruct()
+			if header[2].(int64) != header[3].(int64) ||
+				int64(pr.pos)+header[3].(int64) != md[7].(int64) {
+				t.Fatalf("unexpected page header %v", header)
+			}
+			page := pr.b[pr.pos : pr.pos+int(header[3].(int64))]
+			levelsLength := int(binary.LittleEndian.Uint32(page))
+			lr := thriftReader{b: page[4 : 4+levelsLength]}
+			if run := lr.varint(); run&1 != 1 || int(run>>1) != (numRows+7)/8 {
+				t.Fatalf("unexpected definition level run header %d", run)
+			}
+			levels := lr.b[lr.pos:]
+			data := page[4+levelsLength:]
+			var n int
+			for i := 0; i < numRows; i++ {
+				if levels[i/8]&(1<<uint(i%8)) == 0 {
+					continue
+				}
+				switch int32(types[c]) {
+				case parquetBoolean:
+					groupRows[i][c] = data[n/8]&(1<<uint(n%8)) != 0
+					n++
+				case parquetInt64:
+					groupRows[i][c] = int64(binary.LittleEndian.Uint64(data))
+					data = data[8:]
+				case parquetDouble:
+					groupRows[i][c] = math.Float64frombits(binary.LittleEndian.Uint64(data))
+					data = data[8:]
+				default:
+					l := int(binary.LittleEndian.Uint32(data))
+					groupRows[i][c] = string(data[4 : 4+l])
+					data = data[4+l:]
+				}
+			}
+		}
+		rows = append(rows, groupRows...)
+	}
+	if meta[3].(int64) != int64(len(rows)) {
+		t.Fatalf("expected %d rows, received %d", meta[3], len(rows))
+	}
+	return columns, rows
+}
+
+func TestParquetWriter(t *testing.T) {
+	var buf bytes.Buffer
+	p := newParquetWriter(&buf)
+	p.groupSize = 2
+	columns := []string{"id", "price", "name", "enabled", "empty"}
+	if err := p.WriteHeader(columns, []string{"INTEGER", "DOUBLE PRECISION", "TEXT", "BOOLEAN", ""}); err != nil {
+		t.Fatal(err)
+	}
+	rows := [][]interface{}{
+		{int64(1), float64(1.5), "a", true, nil},
+		{float64(2), int64(2), nil, int64(0), nil},
+		{"-3", "-0.25", "long name with spaces", nil, nil},
+		{nil, int64(4), "", "true", nil},
+		{int64(5), nil, int64(5), false, nil},
+	}
+	for i := range rows {
+		if err := p.WriteRow(rows[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := p.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	readColumns, readRows := readParquet(t, buf.Bytes())
+	if !reflect.DeepEqual(readColumns, columns) {
+		t.Errorf("unexpected columns %v", readColumns)
+	}
+	expected := [][]interface{}{
+		{int64(1), float64(1.5), "a", true, nil},
+		{int64(2), float64(2), nil, false, nil},
+		{int64(-3), float64(-0.25), "long name with spaces", nil, nil},
+		{nil, float64(4), "", true, nil},
+		{int64(5), nil, "5", false, nil},
+	}
+	if !reflect.DeepEqual(readRows, expected) {
+		t.Errorf("unexpected rows %v", readRows)
+	}
+	if !reflect.DeepEqual(p.types, []int32{parquetInt64, parquetDouble, parquetByteArray, parquetBoolean, parquetByteArray}) {
+		t.Errorf("unexpected column types %v", p.types)
+	}
+
+	// A value which cannot be converted fails before the row group is written
+	buf.Reset()
+	p = newParquetWriter(&buf)
+	p.groupSize = 1
+	if err := p.WriteHeader([]string{"name", "id"}, []string{"TEXT", "BIGINT"}); err != nil {
+		t.Fatal(err)
+	}
+	written := buf.Len()
+	if err := p.WriteRow([]interface{}{"one", float64(1.5)}); err == nil {
+		t.Error("expected error when a value cannot be converted to the column type")
+	}
+	if buf.Len() != written {
+		t.Error("expected no partial row group to be written")
+	}
+}
+
+func TestParquetType(t *testing.T) {
+	for dbType, expected := range map[string]int32{
+		"INTEGER":   parquetInt64,
+		"int8":      parquetInt64,
+		"BIGINT":    parquetInt64,
+		"TINYINT":   parquetInt64,
+		"REAL":      parquetDouble,
+		"FLOAT8":    parquetDouble,
+		"DOUBLE":    parquetDouble,
+		"NUMERIC":   parquetDouble,
+		"DECIMAL":   parquetDouble,
+		"BOOL":      parquetBoolean,
+		"boolean":   parquetBoolean,
+		"TEXT":      parquetByteArray,
+		"VARCHAR":   parquetByteArray,
+		"TIMESTAMP": parquetByteArray,
+		"INTERVAL":  parquetByteArray,
+		"":          parquetByteArray,
+	} {
+		if typ := parquetType(dbType); typ != expected {
+			t.Errorf("expected %s to be parquet type %d, received %d", dbType, expected, typ)
+		}
+	}
+}
+
+func TestParquetWriterEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	p := newParquetWriter(&buf)
+	if err := p.WriteHeader([]string{"id", "name"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	columns, rows := readParquet(t, buf.Bytes())
+	if len(columns) != 2 || len(rows) != 0 {
+		t.Errorf("unexpected columns %v and rows %v", columns, rows)
+	}
+}
+
+func TestThriftWriterLongList(t *testing.T) {
+	w := newThriftWriter()
+	w.listBegin(1, thriftI32, 20)
+	for i := 0; i < 20; i++ {
+		w.varint(zigzag(int64(i - 10)))
+	}
+	w.i64(20, math.MinInt64)
+	w.structEnd()
+
+	r := thriftReader{b: w.buf.Bytes()}
+	s := r.readStruct()
+	list := s[1].([]interface{})
+	if len(list) != 20 || list[0].(int64) != -10 || list[19].(int64) != 9 {
+		t.Errorf("unexpected list %v", list)
+	}
+	if s[20].(int64) != math.MinInt64 {
+		t.Errorf("unexpected long field id value %v", s[20])
+	}
+}
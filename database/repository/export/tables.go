@@ -0,0 +1,73 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Table describes a database table which can be exported by date range and
+// have retention policies applied to it
+type Table struct {
+	Name string
+	// TimeColumn is the column rows are filtered and aged by
+	TimeColumn string
+	// Children are tables holding rows which reference this table, these
+	// are archived and removed alongside their parent rows
+	Children []Child
+}
+
+// Child describes a table which references rows of a parent table
+type Child struct {
+	Name string
+	// ForeignKey is the column referencing the parent id
+	ForeignKey string
+}
+
+// Tables is the list of tables supported by export and retention
+var Tables = []Table{
+	{Name: "audit_event", TimeColumn: "created_at"},
+	{
+		Name:       "script",
+		TimeColumn: "created_at",
+		Children: []Child{
+			{Name: "script_execution", ForeignKey: "script_id"},
+		},
+	},
+	{Name: "script_execution", TimeColumn: "execution_time"},
+	{
+		Name:       "withdrawal_history",
+		TimeColumn: "created_at",
+		Children: []Child{
+			{Name: "withdrawal_fiat", ForeignKey: "withdrawal_history_id"},
+			{Name: "withdrawal_crypto", ForeignKey: "withdrawal_history_id"},
+		},
+	},
+	{Name: "request_journal", TimeColumn: "created_at"},
+	{Name: "balance_snapshot", TimeColumn: "created_at"},
+	{Name: "fills", TimeColumn: "traded_at"},
+	{Name: "funding_history", TimeColumn: "transferred_at"},
+	{Name: "orderbook_snapshot", TimeColumn: "created_at"},
+	{Name: "ticker_history", TimeColumn: "created_at"},
+	{Name: "ticker_history_minute", TimeColumn: "started_at"},
+}
+
+// GetTable returns the table definition for the supplied name
+func GetTable(name string) (*Table, error) {
+	for i := range Tables {
+		if strings.EqualFold(Tables[i].Name, name) {
+			return &Tables[i], nil
+		}
+	}
+	return nil, fmt.Errorf("table %s is not supported, supported tables: %s",
+		name,
+		strings.Join(TableNames(), ", "))
+}
+
+// TableNames returns the names of all supported tables
+func TableNames() []string {
+	names := make([]string, len(Tables))
+	for i := range Tables {
+		names[i] = Tables[i].Name
+	}
+	return names
+}
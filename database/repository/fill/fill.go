@@ -0,0 +1,165 @@
+package fill
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Fill is a single trade executed against one of our orders. Exchanges which
+// do not report the trades of an order are recorded as a single order level
+// fill using the order ID as the trade ID
+type Fill struct {
+	Exchange    string
+	Asset       string
+	Pair        string
+	OrderID     string
+	TID         string
+	Side        string
+	Type        string
+	Price       float64
+	Amount      float64
+	Fee         float64
+	FeeCurrency string
+	IsMaker     bool
+	Timestamp   time.Time
+}
+
+// isOrderLevel returns whether the fill covers the whole of its order and so
+// grows as the order executes
+func (f *Fill) isOrderLevel() bool {
+	return f.TID == f.OrderID
+}
+
+// store reads and writes fills through the generated models of a single
+// driver
+type store interface {
+	insert(ctx context.Context, exec boil.ContextExecutor, f *Fill) error
+	// update writes the price, amount and fee of the stored fill with the
+	// same exchange, asset, pair and trade ID as f
+	update(ctx context.Context, exec boil.ContextExecutor, f *Fill) error
+	query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Fill, error)
+}
+
+// stores are the fill stores keyed by dialect
+var stores = map[string]store{
+	database.DBSQLite3:    sqliteStore{},
+	database.DBPostgreSQL: postgresStore{},
+	database.DBMySQL:      mysqlStore{},
+}
+
+// getStore returns the fill store of the enabled driver
+func getStore() (store, error) {
+	s, ok := stores[repository.GetSQLDialect()]
+	if !ok {
+		return nil, repository.ErrDialectNotSupported
+	}
+	return s, nil
+}
+
+// Upsert stores fills in the database, skipping any already stored for the
+// exchange, asset, pair and trade ID, and returns the number of fills inserted
+// and updated. Order level fills already stored have their price, amount and
+// fee updated as the order executes
+func Upsert(fills []Fill) (inserted, updated int, err error) {
+	if database.DB.SQL == nil {
+		return 0, 0, database.ErrDatabaseSupportDisabled
+	}
+
+	s, err := getStore()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ctx := context.Background()
+	err = repository.Transaction(ctx, func(tx *sql.Tx) error {
+		for i := range fills {
+			existing, err := s.query(ctx, tx,
+				qm.Where("exchange = ? AND asset = ? AND pair = ? AND tid = ?",
+					fills[i].Exchange,
+					fills[i].Asset,
+					fills[i].Pair,
+					fills[i].TID),
+				qm.Limit(1))
+			if err != nil {
+				return err
+			}
+			if len(existing) == 0 {
+				if err = s.insert(ctx, tx, &fills[i]); err != nil {
+					return err
+				}
+				inserted++
+				continue
+			}
+			if !fills[i].isOrderLevel() ||
+				(existing[0].Price == fills[i].Price &&
+					existing[0].Amount == fills[i].Amount &&
+					existing[0].Fee == fills[i].Fee) {
+				continue
+			}
+			if err = s.update(ctx, tx, &fills[i]); err != nil {
+				return err
+			}
+			updated++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return inserted, updated, nil
+}
+
+// Get returns fills traded between start and end in time order, limited to an
+// exchange if one is supplied. A limit of zero returns every matching fill
+func Get(exchange string, start, end time.Time, limit int) ([]Fill, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+
+	s, err := getStore()
+	if err != nil {
+		return nil, err
+	}
+
+	mods := []qm.QueryMod{qm.Where("traded_at BETWEEN ? AND ?", repository.Args(start, end)...)}
+	if exchange != "" {
+		mods = append(mods, qm.Where("lower(exchange) = lower(?)", exchange))
+	}
+	mods = append(mods, qm.OrderBy("traded_at, id"))
+	resp, err := s.query(context.Background(), database.DB.SQL, append(mods, repository.Limit(limit)...)...)
+	if err != nil {
+		return nil, fmt.Errorf("fill: %v", err)
+	}
+	return resp, nil
+}
+
+// GetLatestTime returns the time of the most recent fill stored for the
+// exchange, returning sql.ErrNoRows if there are none
+func GetLatestTime(exchange string) (time.Time, error) {
+	if database.DB.SQL == nil {
+		return time.Time{}, database.ErrDatabaseSupportDisabled
+	}
+
+	s, err := getStore()
+	if err != nil {
+		return time.Time{}, err
+	}
+	latest, err := s.query(context.Background(), database.DB.SQL,
+		qm.Where("lower(exchange) = lower(?)", exchange),
+		qm.OrderBy("traded_at desc"),
+		qm.Limit(1))
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(latest) == 0 {
+		return time.Time{}, sql.ErrNoRows
+	}
+	return latest[0].Timestamp, nil
+}
@@ -0,0 +1,116 @@
+package fill
+
+import (
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/database/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestFills(t *testing.T) {
+	testhelpers.Run(t,
+		testhelpers.TestCase{Name: "Fill", Runner: fillHelper},
+	)
+}
+
+func fillHelper(t *testing.T) {
+	t.Helper()
+
+	if _, err := GetLatestTime("test"); err != sql.ErrNoRows {
+		t.Errorf("expected no rows, received %v", err)
+	}
+
+	now := time.Now().Truncate(time.Second)
+	fills := []Fill{
+		{Exchange: "test", Asset: "spot", Pair: "BTCUSD", OrderID: "1", TID: "a", Side: "BUY", Type: "LIMIT", Price: 100, Amount: 1, Fee: 0.1, FeeCurrency: "USD", Timestamp: now.Add(-time.Minute * 2)},
+		{Exchange: "test", Asset: "spot", Pair: "BTCUSD", OrderID: "1", TID: "b", Side: "BUY", Type: "LIMIT", Price: 101, Amount: 2, Fee: 0.2, FeeCurrency: "USD", IsMaker: true, Timestamp: now.Add(-time.Minute)},
+		{Exchange: "test", Asset: "spot", Pair: "BTCUSD", OrderID: "1", TID: "a", Side: "BUY", Type: "LIMIT", Price: 100, Amount: 1, Timestamp: now.Add(-time.Minute * 2)},
+	}
+	inserted, updated, err := Upsert(fills)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inserted != 2 || updated != 0 {
+		t.Errorf("expected duplicate trade to be skipped, inserted %v updated %v", inserted, updated)
+	}
+
+	inserted, updated, err = Upsert(fills[:2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inserted != 0 || updated != 0 {
+		t.Errorf("expected stored trades to be skipped, inserted %v updated %v", inserted, updated)
+	}
+
+	stored, err := Get("TEST", now.Add(-time.Hour), now, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 2 {
+		t.Fatalf("expected 2 fills, received %v", len(stored))
+	}
+	if stored[1].TID != "b" || !stored[1].IsMaker || stored[1].FeeCurrency != "USD" || stored[1].Fee != 0.2 {
+		t.Errorf("unexpected fill %+v", stored[1])
+	}
+
+	// Trade IDs may only be unique per pair, and order level fills grow as the
+	// order executes
+	other := fills[0]
+	other.Pair = "ETHUSD"
+	partial := Fill{Exchange: "test", Asset: "spot", Pair: "BTCUSD", OrderID: "2", TID: "2", Side: "SELL", Type: "LIMIT", Price: 100, Amount: 1, Fee: 0.1, Timestamp: now.Add(-time.Minute * 3)}
+	inserted, updated, err = Upsert([]Fill{other, partial})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inserted != 2 || updated != 0 {
+		t.Errorf("expected trade on another pair to be stored, inserted %v updated %v", inserted, updated)
+	}
+	partial.Amount = 3
+	partial.Fee = 0.3
+	inserted, updated, err = Upsert([]Fill{partial})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inserted != 0 || updated != 1 {
+		t.Errorf("expected order level fill to be updated, inserted %v updated %v", inserted, updated)
+	}
+	stored, err = Get("test", now.Add(-time.Hour), now, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 1 || stored[0].TID != "2" || stored[0].Amount != 3 || stored[0].Fee != 0.3 {
+		t.Errorf("unexpected order level fill %+v", stored)
+	}
+
+	latest, err := GetLatestTime("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !latest.Equal(now.Add(-time.Minute)) {
+		t.Errorf("expected latest fill time %v, received %v", now.Add(-time.Minute), latest)
+	}
+}
@@ -0,0 +1,69 @@
+package fill
+
+import (
+	"context"
+	"time"
+
+	modelPSQL "github.com/yurulab/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+type postgresStore struct{}
+
+func (postgresStore) insert(ctx context.Context, exec boil.ContextExecutor, f *Fill) error {
+	fill := modelPSQL.Fill{
+		Exchange:    f.Exchange,
+		Asset:       f.Asset,
+		Pair:        f.Pair,
+		OrderID:     f.OrderID,
+		Tid:         f.TID,
+		Side:        f.Side,
+		Type:        f.Type,
+		Price:       f.Price,
+		Amount:      f.Amount,
+		Fee:         f.Fee,
+		FeeCurrency: f.FeeCurrency,
+		IsMaker:     f.IsMaker,
+		TradedAt:    f.Timestamp.UTC(),
+		CreatedAt:   time.Now().UTC(),
+	}
+	return fill.Insert(ctx, exec, boil.Infer())
+}
+
+func (postgresStore) update(ctx context.Context, exec boil.ContextExecutor, f *Fill) error {
+	_, err := modelPSQL.Fills(
+		qm.Where("exchange = ? AND asset = ? AND pair = ? AND tid = ?", f.Exchange, f.Asset, f.Pair, f.TID)).UpdateAll(ctx, exec,
+		modelPSQL.M{
+			modelPSQL.FillColumns.Price:  f.Price,
+			modelPSQL.FillColumns.Amount: f.Amount,
+			modelPSQL.FillColumns.Fee:    f.Fee,
+		})
+	return err
+}
+
+func (postgresStore) query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Fill, error) {
+	fills, err := modelPSQL.Fills(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Fill, len(fills))
+	for i := range fills {
+		resp[i] = Fill{
+			Exchange:    fills[i].Exchange,
+			Asset:       fills[i].Asset,
+			Pair:        fills[i].Pair,
+			OrderID:     fills[i].OrderID,
+			TID:         fills[i].Tid,
+			Side:        fills[i].Side,
+			Type:        fills[i].Type,
+			Price:       fills[i].Price,
+			Amount:      fills[i].Amount,
+			Fee:         fills[i].Fee,
+			FeeCurrency: fills[i].FeeCurrency,
+			IsMaker:     fills[i].IsMaker,
+			Timestamp:   fills[i].TradedAt.UTC(),
+		}
+	}
+	return resp, nil
+}
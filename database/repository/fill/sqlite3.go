@@ -0,0 +1,75 @@
+package fill
+
+import (
+	"context"
+	"time"
+
+	modelSQLite "github.com/yurulab/gocryptotrader/database/models/sqlite3"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+type sqliteStore struct{}
+
+func (sqliteStore) insert(ctx context.Context, exec boil.ContextExecutor, f *Fill) error {
+	fill := modelSQLite.Fill{
+		Exchange:    f.Exchange,
+		Asset:       f.Asset,
+		Pair:        f.Pair,
+		OrderID:     f.OrderID,
+		Tid:         f.TID,
+		Side:        f.Side,
+		Type:        f.Type,
+		Price:       f.Price,
+		Amount:      f.Amount,
+		Fee:         f.Fee,
+		FeeCurrency: f.FeeCurrency,
+		IsMaker:     f.IsMaker,
+		TradedAt:    repository.FormatSQLiteTime(f.Timestamp),
+		CreatedAt:   repository.FormatSQLiteTime(time.Now()),
+	}
+	return fill.Insert(ctx, exec, boil.Infer())
+}
+
+func (sqliteStore) update(ctx context.Context, exec boil.ContextExecutor, f *Fill) error {
+	_, err := modelSQLite.Fills(
+		qm.Where("exchange = ? AND asset = ? AND pair = ? AND tid = ?", f.Exchange, f.Asset, f.Pair, f.TID)).UpdateAll(ctx, exec,
+		modelSQLite.M{
+			modelSQLite.FillColumns.Price:  f.Price,
+			modelSQLite.FillColumns.Amount: f.Amount,
+			modelSQLite.FillColumns.Fee:    f.Fee,
+		})
+	return err
+}
+
+func (sqliteStore) query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Fill, error) {
+	fills, err := modelSQLite.Fills(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Fill, len(fills))
+	for i := range fills {
+		var tradedAt time.Time
+		tradedAt, err = repository.ParseSQLiteTime(fills[i].TradedAt)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Fill{
+			Exchange:    fills[i].Exchange,
+			Asset:       fills[i].Asset,
+			Pair:        fills[i].Pair,
+			OrderID:     fills[i].OrderID,
+			TID:         fills[i].Tid,
+			Side:        fills[i].Side,
+			Type:        fills[i].Type,
+			Price:       fills[i].Price,
+			Amount:      fills[i].Amount,
+			Fee:         fills[i].Fee,
+			FeeCurrency: fills[i].FeeCurrency,
+			IsMaker:     fills[i].IsMaker,
+			Timestamp:   tradedAt,
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,234 @@
+package funding
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// vars related to the funding history
+var (
+	// WithdrawalMatchBefore is how long before a withdrawal is reported by an
+	// exchange a bot initiated withdrawal may have been submitted to match it
+	WithdrawalMatchBefore = time.Hour * 24
+	// WithdrawalMatchAfter allows for clock differences between the bot and
+	// the exchange when matching withdrawals
+	WithdrawalMatchAfter = time.Hour
+)
+
+// Transfer is a deposit or withdrawal reported by an exchange
+type Transfer struct {
+	Exchange          string
+	TransferID        string
+	TransferType      string
+	Status            string
+	Currency          string
+	Amount            float64
+	Fee               float64
+	Description       string
+	CryptoTxID        string
+	CryptoToAddress   string
+	CryptoFromAddress string
+	BankTo            string
+	BankFrom          string
+	// WithdrawalID is the ID of the bot initiated withdrawal the transfer was
+	// matched to, if any
+	WithdrawalID string
+	Timestamp    time.Time
+}
+
+// IsWithdrawal returns whether the transfer moved funds off the exchange
+func (t *Transfer) IsWithdrawal() bool {
+	return strings.Contains(strings.ToLower(t.TransferType), "withdraw")
+}
+
+// store reads and writes transfers, and the withdrawals they are matched to,
+// through the generated models of a single driver
+type store interface {
+	insert(ctx context.Context, exec boil.ContextExecutor, t *Transfer) error
+	// update writes the status, transaction ID, fee and withdrawal of the
+	// stored transfer with the same exchange and transfer ID as t
+	update(ctx context.Context, exec boil.ContextExecutor, t *Transfer) error
+	query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Transfer, error)
+	// withdrawal returns the id and status of the first withdrawal matching
+	// mods, returning sql.ErrNoRows if there is none
+	withdrawal(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) (id, status string, err error)
+	setWithdrawalStatus(ctx context.Context, exec boil.ContextExecutor, id, status string) error
+}
+
+// stores are the funding history stores keyed by dialect
+var stores = map[string]store{
+	database.DBSQLite3:    sqliteStore{},
+	database.DBPostgreSQL: postgresStore{},
+	database.DBMySQL:      mysqlStore{},
+}
+
+// getStore returns the funding history store of the enabled driver
+func getStore() (store, error) {
+	s, ok := stores[repository.GetSQLDialect()]
+	if !ok {
+		return nil, repository.ErrDialectNotSupported
+	}
+	return s, nil
+}
+
+// Upsert stores new transfers and updates the status, fee and transaction
+// details of transfers already stored, returning the number of transfers
+// inserted and updated. Withdrawals are linked to a matching bot initiated
+// withdrawal, whose status is kept in line with the exchange
+func Upsert(transfers []Transfer) (inserted, updated int, err error) {
+	if database.DB.SQL == nil {
+		return 0, 0, database.ErrDatabaseSupportDisabled
+	}
+
+	s, err := getStore()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ctx := context.Background()
+	err = repository.Transaction(ctx, func(tx *sql.Tx) error {
+		for i := range transfers {
+			isNew, changed, err := upsertTransfer(ctx, tx, s, &transfers[i])
+			if err != nil {
+				return err
+			}
+			switch {
+			case isNew:
+				inserted++
+			case changed:
+				updated++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return inserted, updated, nil
+}
+
+// updateTransferFields applies the fields of a transfer which may change as it
+// is processed by the exchange, returning whether any changed
+func updateTransferFields(status, txID *string, fee *float64, t *Transfer) bool {
+	changed := false
+	if t.Status != "" && *status != t.Status {
+		*status = t.Status
+		changed = true
+	}
+	if t.CryptoTxID != "" && *txID != t.CryptoTxID {
+		*txID = t.CryptoTxID
+		changed = true
+	}
+	if t.Fee != 0 && *fee != t.Fee {
+		*fee = t.Fee
+		changed = true
+	}
+	return changed
+}
+
+// matchWithdrawal returns the id and status of the bot initiated withdrawal
+// for a transfer, matching on the exchange ID or otherwise on currency, amount
+// and time for withdrawals not yet linked to a transfer
+func matchWithdrawal(ctx context.Context, tx *sql.Tx, s store, t *Transfer) (id, status string, err error) {
+	id, status, err = s.withdrawal(ctx, tx,
+		qm.Where("lower(exchange) = lower(?) AND exchange_id = ?", t.Exchange, t.TransferID))
+	if err != sql.ErrNoRows {
+		return id, status, err
+	}
+	return s.withdrawal(ctx, tx,
+		qm.Where("lower(exchange) = lower(?)", t.Exchange),
+		qm.Where("upper(currency) = upper(?)", t.Currency),
+		qm.Where("(amount = ? OR amount = ?)", t.Amount, t.Amount+t.Fee),
+		qm.Where("created_at BETWEEN ? AND ?",
+			repository.Args(t.Timestamp.Add(-WithdrawalMatchBefore), t.Timestamp.Add(WithdrawalMatchAfter))...),
+		qm.Where("id NOT IN (SELECT withdrawal_history_id FROM funding_history WHERE withdrawal_history_id IS NOT NULL)"),
+		qm.OrderBy("created_at desc"))
+}
+
+func upsertTransfer(ctx context.Context, tx *sql.Tx, s store, t *Transfer) (isNew, changed bool, err error) {
+	existing, err := s.query(ctx, tx,
+		qm.Where("exchange = ? AND transfer_id = ?", t.Exchange, t.TransferID),
+		qm.Limit(1))
+	if err != nil {
+		return false, false, err
+	}
+	stored := *t
+	if len(existing) == 0 {
+		isNew = true
+		stored.WithdrawalID = ""
+	} else {
+		stored = existing[0]
+		changed = updateTransferFields(&stored.Status, &stored.CryptoTxID, &stored.Fee, t)
+	}
+
+	var withdrawalStatus string
+	var linked bool
+	if t.IsWithdrawal() {
+		if stored.WithdrawalID != "" {
+			_, withdrawalStatus, err = s.withdrawal(ctx, tx, qm.Where("id = ?", stored.WithdrawalID))
+		} else {
+			var matchedID string
+			matchedID, withdrawalStatus, err = matchWithdrawal(ctx, tx, s, t)
+			if err == nil {
+				stored.WithdrawalID = matchedID
+				changed = true
+			}
+		}
+		switch {
+		case err == nil:
+			linked = true
+		case err != sql.ErrNoRows:
+			return false, false, err
+		}
+	}
+
+	if isNew {
+		err = s.insert(ctx, tx, &stored)
+	} else if changed {
+		err = s.update(ctx, tx, &stored)
+	}
+	if err != nil {
+		return false, false, err
+	}
+	if linked && stored.Status != "" && withdrawalStatus != stored.Status {
+		err = s.setWithdrawalStatus(ctx, tx, stored.WithdrawalID, stored.Status)
+		if err != nil {
+			return false, false, err
+		}
+	}
+	t.WithdrawalID = stored.WithdrawalID
+	return isNew, changed, nil
+}
+
+// Get returns transfers made between start and end in time order, limited to
+// an exchange if one is supplied. A limit of zero returns every matching
+// transfer
+func Get(exchange string, start, end time.Time, limit int) ([]Transfer, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+
+	s, err := getStore()
+	if err != nil {
+		return nil, err
+	}
+
+	mods := []qm.QueryMod{qm.Where("transferred_at BETWEEN ? AND ?", repository.Args(start, end)...)}
+	if exchange != "" {
+		mods = append(mods, qm.Where("lower(exchange) = lower(?)", exchange))
+	}
+	mods = append(mods, qm.OrderBy("transferred_at, id"))
+	resp, err := s.query(context.Background(), database.DB.SQL, append(mods, repository.Limit(limit)...)...)
+	if err != nil {
+		return nil, fmt.Errorf("funding history: %v", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,123 @@
+package funding
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/database/testhelpers"
+	withdrawrepo "github.com/yurulab/gocryptotrader/database/repository/withdraw"
+	"github.com/yurulab/gocryptotrader/portfolio/withdraw"
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestFundingHistory(t *testing.T) {
+	testhelpers.Run(t,
+		testhelpers.TestCase{Name: "Funding", Runner: fundingHelper},
+	)
+}
+
+func fundingHelper(t *testing.T) {
+	t.Helper()
+
+	byID := newWithdrawal("wd-1", 1)
+	withdrawrepo.Event(byID)
+	byAmount := newWithdrawal("", 2)
+	withdrawrepo.Event(byAmount)
+
+	now := time.Now().Truncate(time.Second)
+	transfers := []Transfer{
+		{Exchange: "test", TransferID: "dep-1", TransferType: "deposit", Status: "completed", Currency: "BTC", Amount: 3, CryptoTxID: "tx-1", Timestamp: now.Add(-time.Minute)},
+		{Exchange: "test", TransferID: "wd-1", TransferType: "withdrawal", Status: "pending", Currency: "BTC", Amount: 1, Timestamp: now},
+		{Exchange: "test", TransferID: "wd-2", TransferType: "Withdraw", Status: "pending", Currency: "btc", Amount: 1.9, Fee: 0.1, Timestamp: now},
+	}
+	inserted, updated, err := Upsert(transfers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inserted != 3 || updated != 0 {
+		t.Errorf("expected 3 inserted transfers, received %v inserted %v updated", inserted, updated)
+	}
+	if transfers[0].WithdrawalID != "" ||
+		transfers[1].WithdrawalID != byID.ID.String() ||
+		transfers[2].WithdrawalID != byAmount.ID.String() {
+		t.Errorf("unexpected withdrawal links %+v", transfers)
+	}
+
+	transfers[1].Status = "completed"
+	transfers[1].CryptoTxID = "tx-2"
+	inserted, updated, err = Upsert(transfers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inserted != 0 || updated != 1 {
+		t.Errorf("expected 1 updated transfer, received %v inserted %v updated", inserted, updated)
+	}
+
+	stored, err := Get("TEST", now.Add(-time.Hour), now, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 3 {
+		t.Fatalf("expected 3 transfers, received %v", len(stored))
+	}
+	if stored[0].TransferID != "dep-1" || stored[0].CryptoTxID != "tx-1" {
+		t.Errorf("unexpected transfer %+v", stored[0])
+	}
+	for i := range stored {
+		if stored[i].TransferID == "wd-1" &&
+			(stored[i].Status != "completed" || stored[i].CryptoTxID != "tx-2" ||
+				stored[i].WithdrawalID != byID.ID.String()) {
+			t.Errorf("transfer status should be updated %+v", stored[i])
+		}
+	}
+
+	w, err := withdrawrepo.GetEventByUUID(byID.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Exchange.Status != "completed" {
+		t.Errorf("linked withdrawal status should be updated, received %v", w.Exchange.Status)
+	}
+}
+
+func newWithdrawal(exchangeID string, amount float64) *withdraw.Response {
+	return &withdraw.Response{
+		Exchange: &withdraw.ExchangeResponse{
+			Name:   "test",
+			ID:     exchangeID,
+			Status: "submitted",
+		},
+		RequestDetails: &withdraw.Request{
+			Exchange: "test",
+			Currency: currency.BTC,
+			Amount:   amount,
+			Type:     withdraw.Crypto,
+			Crypto: &withdraw.CryptoRequest{
+				Address: "address",
+			},
+		},
+	}
+}
@@ -0,0 +1,95 @@
+package funding
+
+import (
+	"context"
+	"time"
+
+	modelMySQL "github.com/yurulab/gocryptotrader/database/models/mysql"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+type mysqlStore struct{}
+
+func (mysqlStore) insert(ctx context.Context, exec boil.ContextExecutor, t *Transfer) error {
+	now := time.Now().UTC()
+	transfer := modelMySQL.FundingHistory{
+		Exchange:            t.Exchange,
+		TransferID:          t.TransferID,
+		TransferType:        t.TransferType,
+		Status:              t.Status,
+		Currency:            t.Currency,
+		Amount:              t.Amount,
+		Fee:                 t.Fee,
+		Description:         t.Description,
+		CryptoTXID:          t.CryptoTxID,
+		CryptoToAddress:     t.CryptoToAddress,
+		CryptoFromAddress:   t.CryptoFromAddress,
+		BankTo:              t.BankTo,
+		BankFrom:            t.BankFrom,
+		WithdrawalHistoryID: null.NewString(t.WithdrawalID, t.WithdrawalID != ""),
+		TransferredAt:       t.Timestamp.UTC(),
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+	return transfer.Insert(ctx, exec, boil.Infer())
+}
+
+func (mysqlStore) update(ctx context.Context, exec boil.ContextExecutor, t *Transfer) error {
+	_, err := modelMySQL.FundingHistories(
+		qm.Where("exchange = ? AND transfer_id = ?", t.Exchange, t.TransferID)).UpdateAll(ctx, exec,
+		modelMySQL.M{
+			modelMySQL.FundingHistoryColumns.Status:              t.Status,
+			modelMySQL.FundingHistoryColumns.CryptoTXID:          t.CryptoTxID,
+			modelMySQL.FundingHistoryColumns.Fee:                 t.Fee,
+			modelMySQL.FundingHistoryColumns.WithdrawalHistoryID: null.NewString(t.WithdrawalID, t.WithdrawalID != ""),
+			modelMySQL.FundingHistoryColumns.UpdatedAt:           time.Now().UTC(),
+		})
+	return err
+}
+
+func (mysqlStore) query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Transfer, error) {
+	transfers, err := modelMySQL.FundingHistories(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Transfer, len(transfers))
+	for i := range transfers {
+		resp[i] = Transfer{
+			Exchange:          transfers[i].Exchange,
+			TransferID:        transfers[i].TransferID,
+			TransferType:      transfers[i].TransferType,
+			Status:            transfers[i].Status,
+			Currency:          transfers[i].Currency,
+			Amount:            transfers[i].Amount,
+			Fee:               transfers[i].Fee,
+			Description:       transfers[i].Description,
+			CryptoTxID:        transfers[i].CryptoTXID,
+			CryptoToAddress:   transfers[i].CryptoToAddress,
+			CryptoFromAddress: transfers[i].CryptoFromAddress,
+			BankTo:            transfers[i].BankTo,
+			BankFrom:          transfers[i].BankFrom,
+			WithdrawalID:      transfers[i].WithdrawalHistoryID.String,
+			Timestamp:         transfers[i].TransferredAt.UTC(),
+		}
+	}
+	return resp, nil
+}
+
+func (mysqlStore) withdrawal(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) (id, status string, err error) {
+	w, err := modelMySQL.WithdrawalHistories(mods...).One(ctx, exec)
+	if err != nil {
+		return "", "", err
+	}
+	return w.ID, w.Status, nil
+}
+
+func (mysqlStore) setWithdrawalStatus(ctx context.Context, exec boil.ContextExecutor, id, status string) error {
+	_, err := modelMySQL.WithdrawalHistories(qm.Where("id = ?", id)).UpdateAll(ctx, exec,
+		modelMySQL.M{
+			modelMySQL.WithdrawalHistoryColumns.Status:    status,
+			modelMySQL.WithdrawalHistoryColumns.UpdatedAt: time.Now().UTC(),
+		})
+	return err
+}
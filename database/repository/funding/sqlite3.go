@@ -0,0 +1,101 @@
+package funding
+
+import (
+	"context"
+	"time"
+
+	modelSQLite "github.com/yurulab/gocryptotrader/database/models/sqlite3"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+type sqliteStore struct{}
+
+func (sqliteStore) insert(ctx context.Context, exec boil.ContextExecutor, t *Transfer) error {
+	now := repository.FormatSQLiteTime(time.Now())
+	transfer := modelSQLite.FundingHistory{
+		Exchange:            t.Exchange,
+		TransferID:          t.TransferID,
+		TransferType:        t.TransferType,
+		Status:              t.Status,
+		Currency:            t.Currency,
+		Amount:              t.Amount,
+		Fee:                 t.Fee,
+		Description:         t.Description,
+		CryptoTXID:          t.CryptoTxID,
+		CryptoToAddress:     t.CryptoToAddress,
+		CryptoFromAddress:   t.CryptoFromAddress,
+		BankTo:              t.BankTo,
+		BankFrom:            t.BankFrom,
+		WithdrawalHistoryID: null.NewString(t.WithdrawalID, t.WithdrawalID != ""),
+		TransferredAt:       repository.FormatSQLiteTime(t.Timestamp),
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+	return transfer.Insert(ctx, exec, boil.Infer())
+}
+
+func (sqliteStore) update(ctx context.Context, exec boil.ContextExecutor, t *Transfer) error {
+	_, err := modelSQLite.FundingHistories(
+		qm.Where("exchange = ? AND transfer_id = ?", t.Exchange, t.TransferID)).UpdateAll(ctx, exec,
+		modelSQLite.M{
+			modelSQLite.FundingHistoryColumns.Status:              t.Status,
+			modelSQLite.FundingHistoryColumns.CryptoTXID:          t.CryptoTxID,
+			modelSQLite.FundingHistoryColumns.Fee:                 t.Fee,
+			modelSQLite.FundingHistoryColumns.WithdrawalHistoryID: null.NewString(t.WithdrawalID, t.WithdrawalID != ""),
+			modelSQLite.FundingHistoryColumns.UpdatedAt:           repository.FormatSQLiteTime(time.Now()),
+		})
+	return err
+}
+
+func (sqliteStore) query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Transfer, error) {
+	transfers, err := modelSQLite.FundingHistories(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Transfer, len(transfers))
+	for i := range transfers {
+		var transferredAt time.Time
+		transferredAt, err = repository.ParseSQLiteTime(transfers[i].TransferredAt)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Transfer{
+			Exchange:          transfers[i].Exchange,
+			TransferID:        transfers[i].TransferID,
+			TransferType:      transfers[i].TransferType,
+			Status:            transfers[i].Status,
+			Currency:          transfers[i].Currency,
+			Amount:            transfers[i].Amount,
+			Fee:               transfers[i].Fee,
+			Description:       transfers[i].Description,
+			CryptoTxID:        transfers[i].CryptoTXID,
+			CryptoToAddress:   transfers[i].CryptoToAddress,
+			CryptoFromAddress: transfers[i].CryptoFromAddress,
+			BankTo:            transfers[i].BankTo,
+			BankFrom:          transfers[i].BankFrom,
+			WithdrawalID:      transfers[i].WithdrawalHistoryID.String,
+			Timestamp:         transferredAt,
+		}
+	}
+	return resp, nil
+}
+
+func (sqliteStore) withdrawal(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) (id, status string, err error) {
+	w, err := modelSQLite.WithdrawalHistories(mods...).One(ctx, exec)
+	if err != nil {
+		return "", "", err
+	}
+	return w.ID, w.Status, nil
+}
+
+func (sqliteStore) setWithdrawalStatus(ctx context.Context, exec boil.ContextExecutor, id, status string) error {
+	_, err := modelSQLite.WithdrawalHistories(qm.Where("id = ?", id)).UpdateAll(ctx, exec,
+		modelSQLite.M{
+			modelSQLite.WithdrawalHistoryColumns.Status:    status,
+			modelSQLite.WithdrawalHistoryColumns.UpdatedAt: repository.FormatSQLiteTime(time.Now()),
+		})
+	return err
+}
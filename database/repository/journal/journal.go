@@ -0,0 +1,98 @@
+package journal
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Entry is a stored request journal entry
+type Entry struct {
+	ID int64
+	request.JournalEntry
+}
+
+// store reads and writes journal entries through the generated models of a
+// single driver
+type store interface {
+	insert(ctx context.Context, exec boil.ContextExecutor, e *request.JournalEntry) error
+	query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Entry, error)
+}
+
+// stores are the request journal stores keyed by dialect
+var stores = map[string]store{
+	database.DBSQLite3:    sqliteStore{},
+	database.DBPostgreSQL: postgresStore{},
+	database.DBMySQL:      mysqlStore{},
+}
+
+// getStore returns the request journal store of the enabled driver
+func getStore() (store, error) {
+	s, ok := stores[repository.GetSQLDialect()]
+	if !ok {
+		return nil, repository.ErrDialectNotSupported
+	}
+	return s, nil
+}
+
+// latencyMS returns a latency in the milliseconds it is stored as
+func latencyMS(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// latency returns a latency stored in milliseconds
+func latency(ms float64) time.Duration {
+	return time.Duration(ms * float64(time.Millisecond))
+}
+
+// Insert stores a request journal entry in the database
+func Insert(e *request.JournalEntry) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+
+	s, err := getStore()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	return repository.Transaction(ctx, func(tx *sql.Tx) error {
+		return s.insert(ctx, tx, e)
+	})
+}
+
+// Get returns journal entries recorded between start and end, limited to an
+// exchange if one is supplied
+func Get(exchange string, start, end time.Time, order string, limit int) ([]Entry, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+
+	s, err := getStore()
+	if err != nil {
+		return nil, err
+	}
+
+	mods := []qm.QueryMod{qm.Where("created_at BETWEEN ? AND ?", repository.Args(start, end)...)}
+	if exchange != "" {
+		mods = append(mods, qm.Where("lower(exchange) = lower(?)", exchange))
+	}
+	orderBy := "id"
+	if order == "desc" {
+		orderBy += " desc"
+	}
+	mods = append(mods, qm.OrderBy(orderBy))
+	resp, err := s.query(context.Background(), database.DB.SQL, append(mods, repository.Limit(limit)...)...)
+	if err != nil {
+		return nil, fmt.Errorf("request journal: %v", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,85 @@
+package journal
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/database/testhelpers"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestJournal(t *testing.T) {
+	testhelpers.Run(t,
+		testhelpers.TestCase{Name: "Write", Runner: writeJournal},
+		testhelpers.TestCase{Name: "Read", Runner: readHelper},
+	)
+}
+
+func writeJournal(t *testing.T) {
+	t.Helper()
+	var wg sync.WaitGroup
+
+	for x := 0; x < 20; x++ {
+		wg.Add(1)
+
+		go func(x int) {
+			defer wg.Done()
+			entry := &request.JournalEntry{
+				Exchange:   fmt.Sprintf("test-%v", x%2),
+				Method:     http.MethodPost,
+				Path:       "https://api.test.com/v1/order",
+				Query:      "signature=",
+				Body:       `{"price":1}`,
+				StatusCode: http.StatusOK,
+				Latency:    time.Millisecond * time.Duration(x),
+				Timestamp:  time.Now(),
+			}
+			if x == 0 {
+				entry.Error = "timeout"
+			}
+			if err := Insert(entry); err != nil {
+				t.Error(err)
+			}
+		}(x)
+	}
+
+	wg.Wait()
+}
+
+func readHelper(t *testing.T) {
+	t.Helper()
+
+	entries, err := Get("TEST-1", time.Now().Add(-time.Hour), time.Now().Add(time.Minute), "desc", 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 10 {
+		t.Errorf("expected 10 entries for exchange, received %v", len(entries))
+	}
+}
@@ -0,0 +1,53 @@
+package journal
+
+import (
+	"context"
+
+	modelPSQL "github.com/yurulab/gocryptotrader/database/models/postgres"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+type postgresStore struct{}
+
+func (postgresStore) insert(ctx context.Context, exec boil.ContextExecutor, e *request.JournalEntry) error {
+	entry := modelPSQL.RequestJournal{
+		Exchange:   e.Exchange,
+		Method:     e.Method,
+		Path:       e.Path,
+		Query:      e.Query,
+		Body:       e.Body,
+		StatusCode: e.StatusCode,
+		LatencyMS:  latencyMS(e.Latency),
+		Error:      null.NewString(e.Error, e.Error != ""),
+		CreatedAt:  e.Timestamp.UTC(),
+	}
+	return entry.Insert(ctx, exec, boil.Infer())
+}
+
+func (postgresStore) query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Entry, error) {
+	entries, err := modelPSQL.RequestJournals(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Entry, len(entries))
+	for i := range entries {
+		resp[i] = Entry{
+			ID: entries[i].ID,
+			JournalEntry: request.JournalEntry{
+				Exchange:   entries[i].Exchange,
+				Method:     entries[i].Method,
+				Path:       entries[i].Path,
+				Query:      entries[i].Query,
+				Body:       entries[i].Body,
+				StatusCode: entries[i].StatusCode,
+				Latency:    latency(entries[i].LatencyMS),
+				Error:      entries[i].Error.String,
+				Timestamp:  entries[i].CreatedAt.UTC(),
+			},
+		}
+	}
+	return resp, nil
+}
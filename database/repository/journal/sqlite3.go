@@ -0,0 +1,60 @@
+package journal
+
+import (
+	"context"
+	"time"
+
+	modelSQLite "github.com/yurulab/gocryptotrader/database/models/sqlite3"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+type sqliteStore struct{}
+
+func (sqliteStore) insert(ctx context.Context, exec boil.ContextExecutor, e *request.JournalEntry) error {
+	entry := modelSQLite.RequestJournal{
+		Exchange:   e.Exchange,
+		Method:     e.Method,
+		Path:       e.Path,
+		Query:      e.Query,
+		Body:       e.Body,
+		StatusCode: int64(e.StatusCode),
+		LatencyMS:  latencyMS(e.Latency),
+		Error:      null.NewString(e.Error, e.Error != ""),
+		CreatedAt:  repository.FormatSQLiteTime(e.Timestamp),
+	}
+	return entry.Insert(ctx, exec, boil.Infer())
+}
+
+func (sqliteStore) query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Entry, error) {
+	entries, err := modelSQLite.RequestJournals(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Entry, len(entries))
+	for i := range entries {
+		var createdAt time.Time
+		createdAt, err = repository.ParseSQLiteTime(entries[i].CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Entry{
+			ID: entries[i].ID,
+			JournalEntry: request.JournalEntry{
+				Exchange:   entries[i].Exchange,
+				Method:     entries[i].Method,
+				Path:       entries[i].Path,
+				Query:      entries[i].Query,
+				Body:       entries[i].Body,
+				StatusCode: int(entries[i].StatusCode),
+				Latency:    latency(entries[i].LatencyMS),
+				Error:      entries[i].Error.String,
+				Timestamp:  createdAt,
+			},
+		}
+	}
+	return resp, nil
+}
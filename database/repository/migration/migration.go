@@ -0,0 +1,173 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"path/filepath"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/yurulab/gocryptotrader/log"
+	"github.com/thrasher-corp/goose"
+)
+
+func init() {
+	goose.SetLogger(gooseLogger{})
+}
+
+// Migration is a schema migration along with when it was applied
+type Migration struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// Status is the schema version of the database compared against the
+// migrations available for its dialect
+type Status struct {
+	Driver     string
+	Version    int64
+	Latest     int64
+	Migrations []Migration
+}
+
+// VersionError is returned when the database schema version does not match
+// the latest available migration
+type VersionError struct {
+	Version int64
+	Latest  int64
+}
+
+func (e *VersionError) Error() string {
+	if e.Version > e.Latest {
+		return fmt.Sprintf("database schema version %d is newer than the latest migration %d, upgrade GoCryptoTrader or set the migration directory",
+			e.Version,
+			e.Latest)
+	}
+	return fmt.Sprintf("database schema version %d is behind the latest migration %d, start with -migrate or run dbmigrate to apply pending migrations",
+		e.Version,
+		e.Latest)
+}
+
+// Pending returns the migrations which have not been applied
+func (s *Status) Pending() []Migration {
+	var resp []Migration
+	for i := range s.Migrations {
+		if s.Migrations[i].Version > s.Version {
+			resp = append(resp, s.Migrations[i])
+		}
+	}
+	return resp
+}
+
+// Check returns a *VersionError if the schema version is not the latest
+// migration
+func (s *Status) Check() error {
+	if s.Version != s.Latest {
+		return &VersionError{Version: s.Version, Latest: s.Latest}
+	}
+	return nil
+}
+
+// GetStatus returns the schema version of the database along with every
+// migration in dir for the enabled driver
+func GetStatus(dir string) (*Status, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+
+	d, err := repository.GetDialect()
+	if err != nil {
+		return nil, err
+	}
+	migrations, err := goose.CollectMigrations(dir, d.Name(), 0, math.MaxInt64)
+	if err != nil {
+		return nil, err
+	}
+	if err = goose.SetDialect(d.Name()); err != nil {
+		return nil, err
+	}
+	version, err := goose.EnsureDBVersion(database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &Status{
+		Driver:  d.Name(),
+		Version: version,
+	}
+	ctx := context.Background()
+	for i := range migrations {
+		m := Migration{
+			Version: migrations[i].Version,
+			Name:    filepath.Base(filepath.Dir(migrations[i].Source)),
+		}
+		err = repository.QueryRow(ctx, database.DB.SQL,
+			"SELECT tstamp, is_applied FROM "+goose.TableName()+" WHERE version_id = ? ORDER BY id DESC LIMIT 1",
+			m.Version).Scan(repository.ScanTime(&m.AppliedAt), &m.Applied)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+		if !m.Applied {
+			m.AppliedAt = time.Time{}
+		}
+		resp.Migrations = append(resp.Migrations, m)
+		resp.Latest = m.Version
+	}
+	return resp, nil
+}
+
+// Up applies every pending migration in dir, returning the migrations
+// applied
+func Up(dir string) ([]Migration, error) {
+	status, err := GetStatus(dir)
+	if err != nil {
+		return nil, err
+	}
+	pending := status.Pending()
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	if err = goose.Up(database.DB.SQL, dir, status.Driver); err != nil {
+		return nil, err
+	}
+	if status, err = GetStatus(dir); err != nil {
+		return nil, err
+	}
+	var resp []Migration
+	for i := range status.Migrations {
+		if status.Migrations[i].Version >= pending[0].Version &&
+			status.Migrations[i].Version <= status.Version {
+			resp = append(resp, status.Migrations[i])
+		}
+	}
+	return resp, nil
+}
+
+// gooseLogger writes migration output to the database manager log
+type gooseLogger struct{}
+
+func (gooseLogger) Fatal(v ...interface{}) {
+	log.Errorln(log.DatabaseMgr, v...)
+}
+
+func (gooseLogger) Fatalf(format string, v ...interface{}) {
+	log.Errorf(log.DatabaseMgr, format, v...)
+}
+
+func (gooseLogger) Print(v ...interface{}) {
+	log.Infoln(log.DatabaseMgr, v...)
+}
+
+func (gooseLogger) Println(v ...interface{}) {
+	log.Infoln(log.DatabaseMgr, v...)
+}
+
+func (gooseLogger) Printf(format string, v ...interface{}) {
+	log.Infof(log.DatabaseMgr, format, v...)
+}
@@ -0,0 +1,111 @@
+package migration
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/database/drivers"
+	"github.com/yurulab/gocryptotrader/database/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestVersionError(t *testing.T) {
+	s := Status{Version: 1, Latest: 2}
+	if err := s.Check(); err == nil {
+		t.Error("expected error for outdated schema")
+	}
+	s.Version = 3
+	if _, ok := s.Check().(*VersionError); !ok {
+		t.Error("expected version error for newer schema")
+	}
+	s.Version = 2
+	if err := s.Check(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	dbConn, err := testhelpers.ConnectToDatabase(&database.Config{
+		Driver:            database.DBSQLite3,
+		ConnectionDetails: drivers.ConnectionDetails{Database: "./migrationdb"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = testhelpers.CloseDatabase(dbConn); err != nil {
+			t.Log(err)
+		}
+	}()
+
+	dir := filepath.Join("..", "..", "migrations")
+	if _, err = GetStatus(filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected error for missing migration directory")
+	}
+
+	status, err := GetStatus(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Version != 0 || len(status.Migrations) == 0 {
+		t.Fatalf("expected unmigrated database, received version %v with %v migrations",
+			status.Version,
+			len(status.Migrations))
+	}
+	if len(status.Pending()) != len(status.Migrations) {
+		t.Errorf("expected every migration to be pending, received %v", len(status.Pending()))
+	}
+	if _, ok := status.Check().(*VersionError); !ok {
+		t.Error("expected version error for unmigrated database")
+	}
+
+	applied, err := Up(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != len(status.Migrations) {
+		t.Errorf("expected %v migrations to be applied, received %v", len(status.Migrations), len(applied))
+	}
+
+	status, err = GetStatus(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = status.Check(); err != nil {
+		t.Error(err)
+	}
+	for i := range status.Migrations {
+		if !status.Migrations[i].Applied || status.Migrations[i].AppliedAt.IsZero() {
+			t.Errorf("expected %s to be applied", status.Migrations[i].Name)
+		}
+	}
+
+	applied, err = Up(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != 0 {
+		t.Errorf("expected no migrations to be applied, received %v", len(applied))
+	}
+}
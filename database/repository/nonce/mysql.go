@@ -0,0 +1,28 @@
+package nonce
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	modelMySQL "github.com/yurulab/gocryptotrader/database/models/mysql"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+type mysqlStore struct{}
+
+func (mysqlStore) lease(ctx context.Context, tx *sql.Tx, exchange string, last, min, n int64) (int64, error) {
+	now := time.Now().UTC()
+	_, err := tx.ExecContext(ctx,
+		"INSERT INTO nonce (exchange, value, updated_at) VALUES (?, ?, ?)"+
+			" ON DUPLICATE KEY UPDATE value = GREATEST(value + 1, ?) + ? - 1, updated_at = ?",
+		exchange, last, now, min, n, now)
+	if err != nil {
+		return 0, err
+	}
+	stored, err := modelMySQL.Nonces(qm.Where("exchange = ?", exchange)).One(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+	return stored.Value, nil
+}
@@ -0,0 +1,69 @@
+package nonce
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/yurulab/gocryptotrader/exchanges/nonce"
+)
+
+// store leases nonces through the generated models of a single driver
+type store interface {
+	// lease stores last for a new exchange, otherwise continues on from the
+	// stored nonce unless min is higher, and returns the stored value
+	lease(ctx context.Context, tx *sql.Tx, exchange string, last, min, n int64) (int64, error)
+}
+
+// stores are the nonce stores keyed by dialect
+var stores = map[string]store{
+	database.DBSQLite3:    sqliteStore{},
+	database.DBPostgreSQL: postgresStore{},
+	database.DBMySQL:      mysqlStore{},
+}
+
+// getStore returns the nonce store of the enabled driver
+func getStore() (store, error) {
+	s, ok := stores[repository.GetSQLDialect()]
+	if !ok {
+		return nil, repository.ErrDialectNotSupported
+	}
+	return s, nil
+}
+
+// Store is a nonce.Store persisting nonces to the database
+type Store struct{}
+
+// Lease implements nonce.Store
+func (Store) Lease(key string, min, n int64) (int64, error) {
+	return Lease(key, min, n)
+}
+
+// Lease atomically reserves n consecutive nonces for the exchange, none of
+// which are lower than min, and returns the first of them
+func Lease(exchange string, min, n int64) (int64, error) {
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
+	}
+	if err := nonce.CheckLease(exchange, n); err != nil {
+		return 0, err
+	}
+
+	_, last := nonce.LeaseRange(0, min, n)
+
+	var end int64
+	ctx := context.Background()
+	err := repository.Transaction(ctx, func(tx *sql.Tx) error {
+		s, err := getStore()
+		if err != nil {
+			return err
+		}
+		end, err = s.lease(ctx, tx, exchange, last, min, n)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return end - n + 1, nil
+}
@@ -0,0 +1,85 @@
+package nonce
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/database/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestLease(t *testing.T) {
+	testhelpers.Run(t,
+		testhelpers.TestCase{Name: "Lease", Runner: leaseHelper},
+	)
+}
+
+func leaseHelper(t *testing.T) {
+	t.Helper()
+
+	start, err := Lease("test", 100, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if start != 100 {
+		t.Errorf("expected first lease to start at min 100, received %v", start)
+	}
+
+	var wg sync.WaitGroup
+	var m sync.Mutex
+	seen := make(map[int64]bool)
+	for x := 0; x < 20; x++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s, err := Lease("test", 0, 5)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			m.Lock()
+			defer m.Unlock()
+			for i := s; i < s+5; i++ {
+				if i < 110 || seen[i] {
+					t.Errorf("nonce %v leased twice or below previous lease", i)
+				}
+				seen[i] = true
+			}
+		}()
+	}
+	wg.Wait()
+
+	start, err = Lease("test", 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if start != 210 {
+		t.Errorf("expected lease to continue from 210, received %v", start)
+	}
+
+	if _, err = (Store{}).Lease("test", 0, 0); err == nil {
+		t.Error("expected error leasing zero nonces")
+	}
+}
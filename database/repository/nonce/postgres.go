@@ -0,0 +1,28 @@
+package nonce
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	modelPSQL "github.com/yurulab/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+type postgresStore struct{}
+
+func (postgresStore) lease(ctx context.Context, tx *sql.Tx, exchange string, last, min, n int64) (int64, error) {
+	now := time.Now().UTC()
+	_, err := tx.ExecContext(ctx,
+		"INSERT INTO nonce (exchange, value, updated_at) VALUES ($1, $2, $3)"+
+			" ON CONFLICT (exchange) DO UPDATE SET value = GREATEST(nonce.value + 1, $4) + $5 - 1, updated_at = $3",
+		exchange, last, now, min, n)
+	if err != nil {
+		return 0, err
+	}
+	stored, err := modelPSQL.Nonces(qm.Where("exchange = ?", exchange)).One(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+	return stored.Value, nil
+}
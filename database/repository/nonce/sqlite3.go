@@ -0,0 +1,29 @@
+package nonce
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	modelSQLite "github.com/yurulab/gocryptotrader/database/models/sqlite3"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+type sqliteStore struct{}
+
+func (sqliteStore) lease(ctx context.Context, tx *sql.Tx, exchange string, last, min, n int64) (int64, error) {
+	now := repository.FormatSQLiteTime(time.Now())
+	_, err := tx.ExecContext(ctx,
+		"INSERT INTO nonce (exchange, value, updated_at) VALUES (?, ?, ?)"+
+			" ON CONFLICT (exchange) DO UPDATE SET value = MAX(nonce.value + 1, ?) + ? - 1, updated_at = ?",
+		exchange, last, now, min, n, now)
+	if err != nil {
+		return 0, err
+	}
+	stored, err := modelSQLite.Nonces(qm.Where("exchange = ?", exchange)).One(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+	return stored.Value, nil
+}
@@ -0,0 +1,157 @@
+package orderbook
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// snapshot is an orderbook in the form it is stored as a single row, the
+// book's last updated time is stored as created_at
+type snapshot struct {
+	Exchange     string
+	Asset        string
+	Pair         string
+	LastUpdateID int64
+	Bids         string
+	Asks         string
+	CreatedAt    time.Time
+}
+
+// store reads and writes orderbook snapshots through the generated models of a
+// single driver
+type store interface {
+	insert(ctx context.Context, exec boil.ContextExecutor, s *snapshot) error
+	query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]snapshot, error)
+}
+
+// stores are the orderbook snapshot stores keyed by dialect
+var stores = map[string]store{
+	database.DBSQLite3:    sqliteStore{},
+	database.DBPostgreSQL: postgresStore{},
+	database.DBMySQL:      mysqlStore{},
+}
+
+// getStore returns the orderbook snapshot store of the enabled driver
+func getStore() (store, error) {
+	s, ok := stores[repository.GetSQLDialect()]
+	if !ok {
+		return nil, repository.ErrDialectNotSupported
+	}
+	return s, nil
+}
+
+// formatPair returns the pair in the form snapshots are stored under
+func formatPair(p currency.Pair) string {
+	return p.Format(currency.DashDelimiter, true).String()
+}
+
+// newSnapshot returns the snapshot stored for b
+func newSnapshot(b *orderbook.Base) (*snapshot, error) {
+	bids, err := json.Marshal(b.Bids)
+	if err != nil {
+		return nil, err
+	}
+	asks, err := json.Marshal(b.Asks)
+	if err != nil {
+		return nil, err
+	}
+	return &snapshot{
+		Exchange:     strings.ToLower(b.ExchangeName),
+		Asset:        strings.ToLower(b.AssetType.String()),
+		Pair:         formatPair(b.Pair),
+		LastUpdateID: b.LastUpdateID,
+		Bids:         string(bids),
+		Asks:         string(asks),
+		CreatedAt:    b.LastUpdated,
+	}, nil
+}
+
+// book returns the orderbook a snapshot was stored for
+func (s *snapshot) book() (orderbook.Base, error) {
+	b := orderbook.Base{
+		ExchangeName: s.Exchange,
+		AssetType:    asset.Item(s.Asset),
+		LastUpdateID: s.LastUpdateID,
+		LastUpdated:  s.CreatedAt,
+	}
+	var err error
+	if b.Pair, err = currency.NewPairDelimiter(s.Pair, currency.DashDelimiter); err != nil {
+		return b, err
+	}
+	if err = json.Unmarshal([]byte(s.Bids), &b.Bids); err != nil {
+		return b, err
+	}
+	return b, json.Unmarshal([]byte(s.Asks), &b.Asks)
+}
+
+// Insert stores orderbook snapshots in the database
+func Insert(books []orderbook.Base) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+
+	s, err := getStore()
+	if err != nil {
+		return err
+	}
+	snapshots := make([]*snapshot, len(books))
+	for i := range books {
+		if snapshots[i], err = newSnapshot(&books[i]); err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	return repository.Transaction(ctx, func(tx *sql.Tx) error {
+		for i := range snapshots {
+			if err := s.insert(ctx, tx, snapshots[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Get returns the recorded snapshots of a book last updated between start
+// and end in the order they were recorded
+func Get(exchange string, a asset.Item, p currency.Pair, start, end time.Time, limit int) ([]orderbook.Base, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+
+	s, err := getStore()
+	if err != nil {
+		return nil, err
+	}
+	mods := append([]qm.QueryMod{
+		qm.Where("exchange = ? AND asset = ? AND pair = ?",
+			strings.ToLower(exchange),
+			strings.ToLower(a.String()),
+			formatPair(p)),
+		qm.Where("created_at BETWEEN ? AND ?", repository.Args(start, end)...),
+		qm.OrderBy("created_at, id"),
+	}, repository.Limit(limit)...)
+	snapshots, err := s.query(context.Background(), database.DB.SQL, mods...)
+	if err != nil {
+		return nil, fmt.Errorf("orderbook snapshot: %v", err)
+	}
+	resp := make([]orderbook.Base, len(snapshots))
+	for i := range snapshots {
+		if resp[i], err = snapshots[i].book(); err != nil {
+			return nil, fmt.Errorf("orderbook snapshot: %v", err)
+		}
+	}
+	return resp, nil
+}
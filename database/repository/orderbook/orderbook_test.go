@@ -0,0 +1,91 @@
+package orderbook
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/database/testhelpers"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestOrderbookSnapshots(t *testing.T) {
+	testhelpers.Run(t,
+		testhelpers.TestCase{Name: "Snapshot", Runner: snapshotHelper},
+	)
+}
+
+func snapshotHelper(t *testing.T) {
+	t.Helper()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	p := currency.NewPairWithDelimiter("BTC", "USD", "/")
+	var books []orderbook.Base
+	for x := 0; x < 3; x++ {
+		books = append(books, orderbook.Base{
+			ExchangeName: "Test",
+			AssetType:    asset.Spot,
+			Pair:         p,
+			Bids:         []orderbook.Item{{Price: 100 - float64(x), Amount: 1, ID: int64(x)}},
+			Asks:         []orderbook.Item{{Price: 101 + float64(x), Amount: 2}},
+			LastUpdateID: int64(x + 1),
+			LastUpdated:  now.Add(time.Duration(x-3) * time.Minute),
+		})
+	}
+	if err := Insert(books); err != nil {
+		t.Fatal(err)
+	}
+
+	stored, err := Get("test", asset.Spot, currency.NewPair(currency.BTC, currency.USD), now.Add(-time.Minute*2-time.Second*30), now, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 2 {
+		t.Fatalf("expected 2 snapshots, received %v", len(stored))
+	}
+	if stored[0].LastUpdateID != 2 || stored[1].LastUpdateID != 3 {
+		t.Errorf("expected snapshots in recorded order, received %+v", stored)
+	}
+	if !stored[0].Pair.Equal(p) || stored[0].AssetType != asset.Spot || stored[0].ExchangeName != "test" {
+		t.Errorf("unexpected book %+v", stored[0])
+	}
+	if !stored[0].LastUpdated.Equal(books[1].LastUpdated) {
+		t.Errorf("expected last updated %v, received %v", books[1].LastUpdated, stored[0].LastUpdated)
+	}
+	if len(stored[0].Bids) != 1 || stored[0].Bids[0] != books[1].Bids[0] ||
+		len(stored[0].Asks) != 1 || stored[0].Asks[0] != books[1].Asks[0] {
+		t.Errorf("unexpected levels %+v %+v", stored[0].Bids, stored[0].Asks)
+	}
+
+	stored, err = Get("test", asset.Spot, p, now.Add(-time.Hour), now, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 1 || stored[0].LastUpdateID != 1 {
+		t.Errorf("expected earliest snapshot, received %+v", stored)
+	}
+}
@@ -0,0 +1,44 @@
+package orderbook
+
+import (
+	"context"
+
+	modelPSQL "github.com/yurulab/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+type postgresStore struct{}
+
+func (postgresStore) insert(ctx context.Context, exec boil.ContextExecutor, s *snapshot) error {
+	row := modelPSQL.OrderbookSnapshot{
+		Exchange:     s.Exchange,
+		Asset:        s.Asset,
+		Pair:         s.Pair,
+		LastUpdateID: s.LastUpdateID,
+		Bids:         s.Bids,
+		Asks:         s.Asks,
+		CreatedAt:    s.CreatedAt.UTC(),
+	}
+	return row.Insert(ctx, exec, boil.Infer())
+}
+
+func (postgresStore) query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]snapshot, error) {
+	rows, err := modelPSQL.OrderbookSnapshots(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]snapshot, len(rows))
+	for i := range rows {
+		resp[i] = snapshot{
+			Exchange:     rows[i].Exchange,
+			Asset:        rows[i].Asset,
+			Pair:         rows[i].Pair,
+			LastUpdateID: rows[i].LastUpdateID,
+			Bids:         rows[i].Bids,
+			Asks:         rows[i].Asks,
+			CreatedAt:    rows[i].CreatedAt.UTC(),
+		}
+	}
+	return resp, nil
+}
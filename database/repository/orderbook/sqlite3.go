@@ -0,0 +1,51 @@
+package orderbook
+
+import (
+	"context"
+	"time"
+
+	modelSQLite "github.com/yurulab/gocryptotrader/database/models/sqlite3"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+type sqliteStore struct{}
+
+func (sqliteStore) insert(ctx context.Context, exec boil.ContextExecutor, s *snapshot) error {
+	row := modelSQLite.OrderbookSnapshot{
+		Exchange:     s.Exchange,
+		Asset:        s.Asset,
+		Pair:         s.Pair,
+		LastUpdateID: s.LastUpdateID,
+		Bids:         s.Bids,
+		Asks:         s.Asks,
+		CreatedAt:    repository.FormatSQLiteTime(s.CreatedAt),
+	}
+	return row.Insert(ctx, exec, boil.Infer())
+}
+
+func (sqliteStore) query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]snapshot, error) {
+	rows, err := modelSQLite.OrderbookSnapshots(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]snapshot, len(rows))
+	for i := range rows {
+		var createdAt time.Time
+		createdAt, err = repository.ParseSQLiteTime(rows[i].CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = snapshot{
+			Exchange:     rows[i].Exchange,
+			Asset:        rows[i].Asset,
+			Pair:         rows[i].Pair,
+			LastUpdateID: rows[i].LastUpdateID,
+			Bids:         rows[i].Bids,
+			Asks:         rows[i].Asks,
+			CreatedAt:    createdAt,
+		}
+	}
+	return resp, nil
+}
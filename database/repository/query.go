@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Scanner reads the columns of a query result row, it is implemented by
+// *sql.Rows and *sql.Row
+type Scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// Transaction runs fn in a database transaction, which is committed if fn
+// succeeds and rolled back otherwise
+func Transaction(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err = fn(tx); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			log.Errorf(log.DatabaseMgr, "Transaction rollback failed: %v\n", rErr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// Exec executes a query written with ? bind parameters
+func Exec(ctx context.Context, exec boil.ContextExecutor, query string, queryArgs ...interface{}) (sql.Result, error) {
+	d, err := GetDialect()
+	if err != nil {
+		return nil, err
+	}
+	query, queryArgs = prepare(d, query, queryArgs)
+	return exec.ExecContext(ctx, query, queryArgs...)
+}
+
+// Query runs a query written with ? bind parameters, the caller must close
+// the returned rows
+func Query(ctx context.Context, exec boil.ContextExecutor, query string, queryArgs ...interface{}) (*sql.Rows, error) {
+	d, err := GetDialect()
+	if err != nil {
+		return nil, err
+	}
+	query, queryArgs = prepare(d, query, queryArgs)
+	return exec.QueryContext(ctx, query, queryArgs...)
+}
+
+// QueryRow runs a query written with ? bind parameters which returns at most
+// one row, scanning it returns sql.ErrNoRows if there is none
+func QueryRow(ctx context.Context, exec boil.ContextExecutor, query string, queryArgs ...interface{}) Scanner {
+	d, err := GetDialect()
+	if err != nil {
+		return errScanner{err}
+	}
+	query, queryArgs = prepare(d, query, queryArgs)
+	return exec.QueryRowContext(ctx, query, queryArgs...)
+}
+
+// Args converts query arguments into the form stored by the enabled driver,
+// for use in the query mods passed to the generated models
+func Args(values ...interface{}) []interface{} {
+	d, err := GetDialect()
+	if err != nil {
+		return values
+	}
+	return args(d, values)
+}
+
+// Limit returns the query mods limiting a query to limit rows, a limit of
+// zero or less returns every row
+func Limit(limit int) []qm.QueryMod {
+	if limit <= 0 {
+		return nil
+	}
+	return []qm.QueryMod{qm.Limit(limit)}
+}
+
+// prepare rewrites query and its arguments for the dialect, writing them to
+// boil.DebugWriter in debug mode
+func prepare(d Dialect, query string, values []interface{}) (string, []interface{}) {
+	query = d.Rebind(query)
+	queryArgs := args(d, values)
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, queryArgs...)
+	}
+	return query, queryArgs
+}
+
+// args converts query arguments into the form stored by the dialect
+func args(d Dialect, values []interface{}) []interface{} {
+	resp := make([]interface{}, len(values))
+	for i := range values {
+		if t, ok := values[i].(time.Time); ok {
+			resp[i] = d.Time(t)
+			continue
+		}
+		resp[i] = values[i]
+	}
+	return resp
+}
+
+type errScanner struct {
+	err error
+}
+
+func (e errScanner) Scan(...interface{}) error {
+	return e.err
+}
+
+// ScanTime returns a scan destination for a timestamp column which accepts
+// the value returned by any supported driver
+func ScanTime(t *time.Time) sql.Scanner {
+	return timeScanner{t}
+}
+
+type timeScanner struct {
+	t *time.Time
+}
+
+func (s timeScanner) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case time.Time:
+		*s.t = v.UTC()
+		return nil
+	case nil:
+		*s.t = time.Time{}
+		return nil
+	case string:
+		return s.parse(v)
+	case []byte:
+		return s.parse(string(v))
+	}
+	return fmt.Errorf("unable to scan %T into a timestamp", src)
+}
+
+func (s timeScanner) parse(v string) error {
+	t, err := ParseSQLiteTime(v)
+	if err != nil {
+		return err
+	}
+	*s.t = t.UTC()
+	return nil
+}
@@ -1,9 +1,18 @@
 package repository
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/common"
 	"github.com/yurulab/gocryptotrader/database"
 )
 
+// ErrDialectNotSupported is returned when the enabled driver has no SQL
+// dialect
+var ErrDialectNotSupported = errors.New("database driver has no supported SQL dialect")
+
 // GetSQLDialect returns current SQL Dialect based on enabled driver
 func GetSQLDialect() string {
 	switch database.DB.Config.Driver {
@@ -11,6 +20,33 @@ func GetSQLDialect() string {
 		return database.DBSQLite3
 	case "psql", "postgres", "postgresql":
 		return database.DBPostgreSQL
+	case "mysql", "mariadb":
+		return database.DBMySQL
 	}
 	return "invalid driver"
 }
+
+// sqliteTimeLayouts are the layouts timestamps have been written to SQLite in
+var sqliteTimeLayouts = []string{
+	common.SimpleTimeFormat,
+	time.RFC3339Nano,
+	// written by time.Time.String
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+}
+
+// FormatSQLiteTime formats t as stored in SQLite timestamp columns, which
+// compare as text
+func FormatSQLiteTime(t time.Time) string {
+	return t.UTC().Format(common.SimpleTimeFormat)
+}
+
+// ParseSQLiteTime parses a timestamp column value as returned by the SQLite
+// driver, which depends on how the value was written
+func ParseSQLiteTime(s string) (time.Time, error) {
+	for _, layout := range sqliteTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unable to parse timestamp %q", s)
+}
@@ -0,0 +1,57 @@
+package script
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+	modelMySQL "github.com/yurulab/gocryptotrader/database/models/mysql"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+type mysqlStore struct{}
+
+func (mysqlStore) getID(ctx context.Context, exec boil.ContextExecutor, scriptID string) (string, error) {
+	s, err := modelMySQL.Scripts(qm.Where("script_id = ?", scriptID)).One(ctx, exec)
+	if err != nil {
+		return "", err
+	}
+	return s.ID, nil
+}
+
+func (mysqlStore) insertScript(ctx context.Context, exec boil.ContextExecutor, s *script) error {
+	row := modelMySQL.Script{
+		ID:             s.id,
+		ScriptID:       s.scriptID,
+		ScriptName:     s.name,
+		ScriptPath:     s.path,
+		ScriptData:     s.data,
+		LastExecutedAt: null.TimeFrom(s.executedAt.UTC()),
+	}
+	return row.Insert(ctx, exec, boil.Infer())
+}
+
+func (mysqlStore) setLastExecuted(ctx context.Context, exec boil.ContextExecutor, id string, executedAt time.Time) error {
+	_, err := modelMySQL.Scripts(qm.Where("id = ?", id)).UpdateAll(ctx, exec,
+		modelMySQL.M{modelMySQL.ScriptColumns.LastExecutedAt: executedAt.UTC()})
+	return err
+}
+
+// insertExecution generates the execution id as MySQL databases migrated
+// before the column had a default may still be without one
+func (mysqlStore) insertExecution(ctx context.Context, exec boil.ContextExecutor, e *execution) error {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	row := modelMySQL.ScriptExecution{
+		ID:              id.String(),
+		ScriptID:        null.StringFrom(e.scriptID),
+		ExecutionType:   e.executionType,
+		ExecutionStatus: e.status,
+		ExecutionTime:   e.executedAt.UTC(),
+	}
+	return row.Insert(ctx, exec, boil.Infer())
+}
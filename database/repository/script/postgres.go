@@ -0,0 +1,50 @@
+package script
+
+import (
+	"context"
+	"time"
+
+	modelPSQL "github.com/yurulab/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+type postgresStore struct{}
+
+func (postgresStore) getID(ctx context.Context, exec boil.ContextExecutor, scriptID string) (string, error) {
+	s, err := modelPSQL.Scripts(qm.Where("script_id = ?", scriptID)).One(ctx, exec)
+	if err != nil {
+		return "", err
+	}
+	return s.ID, nil
+}
+
+func (postgresStore) insertScript(ctx context.Context, exec boil.ContextExecutor, s *script) error {
+	row := modelPSQL.Script{
+		ID:             s.id,
+		ScriptID:       s.scriptID,
+		ScriptName:     s.name,
+		ScriptPath:     s.path,
+		ScriptData:     s.data,
+		LastExecutedAt: null.TimeFrom(s.executedAt.UTC()),
+	}
+	return row.Insert(ctx, exec, boil.Infer())
+}
+
+func (postgresStore) setLastExecuted(ctx context.Context, exec boil.ContextExecutor, id string, executedAt time.Time) error {
+	_, err := modelPSQL.Scripts(qm.Where("id = ?", id)).UpdateAll(ctx, exec,
+		modelPSQL.M{modelPSQL.ScriptColumns.LastExecutedAt: executedAt.UTC()})
+	return err
+}
+
+// insertExecution leaves the execution id to the column default
+func (postgresStore) insertExecution(ctx context.Context, exec boil.ContextExecutor, e *execution) error {
+	row := modelPSQL.ScriptExecution{
+		ScriptID:        null.StringFrom(e.scriptID),
+		ExecutionType:   e.executionType,
+		ExecutionStatus: e.status,
+		ExecutionTime:   e.executedAt.UTC(),
+	}
+	return row.Insert(ctx, exec, boil.Infer())
+}
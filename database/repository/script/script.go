@@ -2,125 +2,105 @@ package script
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/yurulab/gocryptotrader/database"
-	modelPSQL "github.com/yurulab/gocryptotrader/database/models/postgres"
-	modelSQLite "github.com/yurulab/gocryptotrader/database/models/sqlite3"
 	"github.com/yurulab/gocryptotrader/database/repository"
 	"github.com/yurulab/gocryptotrader/log"
 	"github.com/thrasher-corp/sqlboiler/boil"
 	"github.com/volatiletech/null"
 )
 
+// script is a stored script, recorded the first time it is executed
+type script struct {
+	id         string
+	scriptID   string
+	name       string
+	path       string
+	data       null.Bytes
+	executedAt time.Time
+}
+
+// execution is a single execution of a stored script
+type execution struct {
+	scriptID      string
+	executionType string
+	status        string
+	executedAt    time.Time
+}
+
+// store reads and writes scripts and their executions through the generated
+// models of a single driver
+type store interface {
+	// getID returns the id of the stored script with the script ID, returning
+	// sql.ErrNoRows if it has not been stored
+	getID(ctx context.Context, exec boil.ContextExecutor, scriptID string) (string, error)
+	insertScript(ctx context.Context, exec boil.ContextExecutor, s *script) error
+	setLastExecuted(ctx context.Context, exec boil.ContextExecutor, id string, executedAt time.Time) error
+	insertExecution(ctx context.Context, exec boil.ContextExecutor, e *execution) error
+}
+
+// stores are the script stores keyed by dialect
+var stores = map[string]store{
+	database.DBSQLite3:    sqliteStore{},
+	database.DBPostgreSQL: postgresStore{},
+	database.DBMySQL:      mysqlStore{},
+}
+
+// getStore returns the script store of the enabled driver
+func getStore() (store, error) {
+	s, ok := stores[repository.GetSQLDialect()]
+	if !ok {
+		return nil, repository.ErrDialectNotSupported
+	}
+	return s, nil
+}
+
 // Event inserts a new script event into database with execution details (script name time status hash of script)
-func Event(id, name, path string, data null.Bytes, executionType, status string, time time.Time) {
+func Event(id, name, path string, data null.Bytes, executionType, status string, executionTime time.Time) {
 	if database.DB.SQL == nil {
 		return
 	}
 
 	ctx := context.Background()
-	ctx = boil.SkipTimestamps(ctx)
-	tx, err := database.DB.SQL.BeginTx(ctx, nil)
-	if err != nil {
-		log.Errorf(log.DatabaseMgr, "Event transaction begin failed: %v", err)
-		return
-	}
-
-	if repository.GetSQLDialect() == database.DBSQLite3 {
-		query := modelSQLite.ScriptWhere.ScriptID.EQ(id)
-		f, errQry := modelSQLite.Scripts(query).Exists(ctx, tx)
-		if errQry != nil {
-			log.Errorf(log.DatabaseMgr, "Query failed: %v", errQry)
-			err = tx.Rollback()
-			if err != nil {
-				log.Errorf(log.DatabaseMgr, "Event Transaction rollback failed: %v", err)
-			}
-			return
-		}
-		var tempEvent = modelSQLite.Script{}
-		if !f {
-			newUUID, errUUID := uuid.NewV4()
-			if errUUID != nil {
-				log.Errorf(log.DatabaseMgr, "Failed to generate UUID: %v", errUUID)
-				_ = tx.Rollback()
-				return
-			}
-
-			tempEvent.ID = newUUID.String()
-			tempEvent.ScriptID = id
-			tempEvent.ScriptName = name
-			tempEvent.ScriptPath = path
-			tempEvent.ScriptData = data
-			err = tempEvent.Insert(ctx, tx, boil.Infer())
-			if err != nil {
-				log.Errorf(log.DatabaseMgr, "Event insert failed: %v", err)
-				err = tx.Rollback()
-				if err != nil {
-					log.Errorf(log.DatabaseMgr, "Event Transaction rollback failed: %v", err)
-				}
-				return
-			}
-		} else {
-			tempEvent.ID = id
-		}
-
-		tempScriptExecution := &modelSQLite.ScriptExecution{
-			ScriptID:        id,
-			ExecutionTime:   time.UTC().String(),
-			ExecutionStatus: status,
-			ExecutionType:   executionType,
-		}
-		err = tempEvent.AddScriptExecutions(ctx, tx, true, tempScriptExecution)
+	err := repository.Transaction(ctx, func(tx *sql.Tx) error {
+		s, err := getStore()
 		if err != nil {
-			log.Errorf(log.DatabaseMgr, "Event insert failed: %v", err)
-			err = tx.Rollback()
-			if err != nil {
-				log.Errorf(log.DatabaseMgr, "Event Transaction rollback failed: %v", err)
-			}
-			return
+			return err
 		}
-	} else {
-		var tempEvent = modelPSQL.Script{
-			ScriptID:   id,
-			ScriptName: name,
-			ScriptPath: path,
-			ScriptData: data,
-		}
-		err = tempEvent.Upsert(ctx, tx, true, []string{"script_id"}, boil.Whitelist("last_executed_at"), boil.Infer())
-		if err != nil {
-			log.Errorf(log.DatabaseMgr, "Event insert failed: %v", err)
-			err = tx.Rollback()
+		scriptUUID, err := s.getID(ctx, tx, id)
+		switch {
+		case err == sql.ErrNoRows:
+			var newUUID uuid.UUID
+			newUUID, err = uuid.NewV4()
 			if err != nil {
-				log.Errorf(log.DatabaseMgr, "Event Transaction rollback failed: %v", err)
+				return err
 			}
-			return
+			scriptUUID = newUUID.String()
+			err = s.insertScript(ctx, tx, &script{
+				id:         scriptUUID,
+				scriptID:   id,
+				name:       name,
+				path:       path,
+				data:       data,
+				executedAt: executionTime,
+			})
+		case err == nil:
+			err = s.setLastExecuted(ctx, tx, scriptUUID, executionTime)
 		}
-
-		tempScriptExecution := &modelPSQL.ScriptExecution{
-			ExecutionTime:   time.UTC(),
-			ExecutionStatus: status,
-			ExecutionType:   executionType,
-		}
-
-		err = tempEvent.AddScriptExecutions(ctx, tx, true, tempScriptExecution)
 		if err != nil {
-			log.Errorf(log.DatabaseMgr, "Event insert failed: %v", err)
-			err = tx.Rollback()
-			if err != nil {
-				log.Errorf(log.DatabaseMgr, "Event Transaction rollback failed: %v", err)
-			}
-			return
+			return err
 		}
-	}
-
-	err = tx.Commit()
+		return s.insertExecution(ctx, tx, &execution{
+			scriptID:      scriptUUID,
+			executionType: executionType,
+			status:        status,
+			executedAt:    executionTime,
+		})
+	})
 	if err != nil {
-		log.Errorf(log.DatabaseMgr, "Event Transaction commit failed: %v", err)
-		err = tx.Rollback()
-		if err != nil {
-			log.Errorf(log.DatabaseMgr, "Event Transaction rollback failed: %v", err)
-		}
+		log.Errorf(log.DatabaseMgr, "Event insert failed: %v", err)
 	}
 }
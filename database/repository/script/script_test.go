@@ -4,22 +4,18 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
-	"path/filepath"
 	"sync"
 	"testing"
 	"time"
 
-	"github.com/yurulab/gocryptotrader/database"
-	"github.com/yurulab/gocryptotrader/database/drivers"
-	"github.com/yurulab/gocryptotrader/database/repository"
 	"github.com/yurulab/gocryptotrader/database/testhelpers"
-	"github.com/thrasher-corp/goose"
 	"github.com/volatiletech/null"
 )
 
 func TestMain(m *testing.M) {
 	var err error
 	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
 	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
 	if err != nil {
 		fmt.Printf("failed to create temp file: %v", err)
@@ -37,65 +33,13 @@ func TestMain(m *testing.M) {
 }
 
 func TestScript(t *testing.T) {
-	testCases := []struct {
-		name   string
-		config *database.Config
-		runner func()
-		closer func(dbConn *database.Instance) error
-		output interface{}
-	}{
-		{
-			"SQLite-Write",
-			&database.Config{
-				Driver:            database.DBSQLite3,
-				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
-			},
-			writeScript,
-			testhelpers.CloseDatabase,
-			nil,
-		},
-		{
-			"Postgres-Write",
-			testhelpers.PostgresTestDatabase,
-			writeScript,
-			nil,
-			nil,
-		},
-	}
-
-	for _, tests := range testCases {
-		test := tests
-		t.Run(test.name, func(t *testing.T) {
-			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
-				t.Skip("database not configured skipping test")
-			}
-
-			dbConn, err := testhelpers.ConnectToDatabase(test.config)
-			if err != nil {
-				t.Fatal(err)
-			}
-
-			path := filepath.Join("..", "..", "migrations")
-			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
-			if err != nil {
-				t.Fatalf("failed to run migrations %v", err)
-			}
-
-			if test.runner != nil {
-				test.runner()
-			}
-
-			if test.closer != nil {
-				err = test.closer(dbConn)
-				if err != nil {
-					t.Log(err)
-				}
-			}
-		})
-	}
+	testhelpers.Run(t,
+		testhelpers.TestCase{Name: "Write", Runner: writeScript},
+	)
 }
 
-func writeScript() {
+func writeScript(t *testing.T) {
+	t.Helper()
 	var wg sync.WaitGroup
 	for x := 0; x < 20; x++ {
 		wg.Add(1)
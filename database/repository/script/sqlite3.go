@@ -0,0 +1,49 @@
+package script
+
+import (
+	"context"
+	"time"
+
+	modelSQLite "github.com/yurulab/gocryptotrader/database/models/sqlite3"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+type sqliteStore struct{}
+
+func (sqliteStore) getID(ctx context.Context, exec boil.ContextExecutor, scriptID string) (string, error) {
+	s, err := modelSQLite.Scripts(qm.Where("script_id = ?", scriptID)).One(ctx, exec)
+	if err != nil {
+		return "", err
+	}
+	return s.ID, nil
+}
+
+func (sqliteStore) insertScript(ctx context.Context, exec boil.ContextExecutor, s *script) error {
+	row := modelSQLite.Script{
+		ID:             s.id,
+		ScriptID:       s.scriptID,
+		ScriptName:     s.name,
+		ScriptPath:     s.path,
+		ScriptData:     s.data,
+		LastExecutedAt: repository.FormatSQLiteTime(s.executedAt),
+	}
+	return row.Insert(ctx, exec, boil.Infer())
+}
+
+func (sqliteStore) setLastExecuted(ctx context.Context, exec boil.ContextExecutor, id string, executedAt time.Time) error {
+	_, err := modelSQLite.Scripts(qm.Where("id = ?", id)).UpdateAll(ctx, exec,
+		modelSQLite.M{modelSQLite.ScriptColumns.LastExecutedAt: repository.FormatSQLiteTime(executedAt)})
+	return err
+}
+
+func (sqliteStore) insertExecution(ctx context.Context, exec boil.ContextExecutor, e *execution) error {
+	row := modelSQLite.ScriptExecution{
+		ScriptID:        e.scriptID,
+		ExecutionType:   e.executionType,
+		ExecutionStatus: e.status,
+		ExecutionTime:   repository.FormatSQLiteTime(e.executedAt),
+	}
+	return row.Insert(ctx, exec, boil.Infer())
+}
@@ -0,0 +1,113 @@
+package ticker
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	modelMySQL "github.com/yurulab/gocryptotrader/database/models/mysql"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+type mysqlStore struct{}
+
+func (mysqlStore) insert(ctx context.Context, exec boil.ContextExecutor, p *ticker.Price) error {
+	history := modelMySQL.TickerHistory{
+		Exchange:    strings.ToLower(p.ExchangeName),
+		Asset:       strings.ToLower(p.AssetType.String()),
+		Pair:        formatPair(p.Pair),
+		Last:        p.Last,
+		High:        p.High,
+		Low:         p.Low,
+		Bid:         p.Bid,
+		Ask:         p.Ask,
+		Volume:      p.Volume,
+		QuoteVolume: p.QuoteVolume,
+		CreatedAt:   p.LastUpdated.UTC(),
+	}
+	return history.Insert(ctx, exec, boil.Infer())
+}
+
+func (mysqlStore) queryTicks(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Tick, error) {
+	history, err := modelMySQL.TickerHistories(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Tick, len(history))
+	for i := range history {
+		resp[i], err = newTick(history[i].Exchange,
+			history[i].Asset,
+			history[i].Pair,
+			history[i].Last,
+			history[i].Bid,
+			history[i].Ask,
+			history[i].Volume,
+			history[i].CreatedAt.UTC())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (mysqlStore) queryMinutes(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Tick, error) {
+	minutes, err := modelMySQL.TickerHistoryMinutes(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Tick, len(minutes))
+	for i := range minutes {
+		var p currency.Pair
+		p, err = parsePair(minutes[i].Pair)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Tick{
+			Exchange: minutes[i].Exchange,
+			Asset:    asset.Item(minutes[i].Asset),
+			Pair:     p,
+			Time:     minutes[i].StartedAt.UTC(),
+			Period:   time.Minute,
+			Open:     minutes[i].Open,
+			High:     minutes[i].High,
+			Low:      minutes[i].Low,
+			Close:    minutes[i].Close,
+			Bid:      minutes[i].Bid,
+			Ask:      minutes[i].Ask,
+			Volume:   minutes[i].Volume,
+			Ticks:    minutes[i].Ticks,
+		}
+	}
+	return resp, nil
+}
+
+func (mysqlStore) upsertMinute(ctx context.Context, exec boil.ContextExecutor, t *Tick) error {
+	_, err := exec.ExecContext(ctx,
+		"INSERT INTO ticker_history_minute"+
+			" (exchange, asset, pair, open, high, low, close, bid, ask, volume, ticks, started_at)"+
+			" VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"+
+			" ON DUPLICATE KEY UPDATE"+
+			" high = GREATEST(high, VALUES(high)), low = LEAST(low, VALUES(low)), ticks = ticks + VALUES(ticks)",
+		t.Exchange,
+		t.Asset.String(),
+		formatPair(t.Pair),
+		t.Open,
+		t.High,
+		t.Low,
+		t.Close,
+		t.Bid,
+		t.Ask,
+		t.Volume,
+		t.Ticks,
+		t.Time.UTC())
+	return err
+}
+
+func (mysqlStore) deleteTicks(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) error {
+	_, err := modelMySQL.TickerHistories(mods...).DeleteAll(ctx, exec)
+	return err
+}
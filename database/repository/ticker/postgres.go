@@ -0,0 +1,115 @@
+package ticker
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	modelPSQL "github.com/yurulab/gocryptotrader/database/models/postgres"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+type postgresStore struct{}
+
+func (postgresStore) insert(ctx context.Context, exec boil.ContextExecutor, p *ticker.Price) error {
+	history := modelPSQL.TickerHistory{
+		Exchange:    strings.ToLower(p.ExchangeName),
+		Asset:       strings.ToLower(p.AssetType.String()),
+		Pair:        formatPair(p.Pair),
+		Last:        p.Last,
+		High:        p.High,
+		Low:         p.Low,
+		Bid:         p.Bid,
+		Ask:         p.Ask,
+		Volume:      p.Volume,
+		QuoteVolume: p.QuoteVolume,
+		CreatedAt:   p.LastUpdated.UTC(),
+	}
+	return history.Insert(ctx, exec, boil.Infer())
+}
+
+func (postgresStore) queryTicks(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Tick, error) {
+	history, err := modelPSQL.TickerHistories(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Tick, len(history))
+	for i := range history {
+		resp[i], err = newTick(history[i].Exchange,
+			history[i].Asset,
+			history[i].Pair,
+			history[i].Last,
+			history[i].Bid,
+			history[i].Ask,
+			history[i].Volume,
+			history[i].CreatedAt.UTC())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (postgresStore) queryMinutes(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Tick, error) {
+	minutes, err := modelPSQL.TickerHistoryMinutes(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Tick, len(minutes))
+	for i := range minutes {
+		var p currency.Pair
+		p, err = parsePair(minutes[i].Pair)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Tick{
+			Exchange: minutes[i].Exchange,
+			Asset:    asset.Item(minutes[i].Asset),
+			Pair:     p,
+			Time:     minutes[i].StartedAt.UTC(),
+			Period:   time.Minute,
+			Open:     minutes[i].Open,
+			High:     minutes[i].High,
+			Low:      minutes[i].Low,
+			Close:    minutes[i].Close,
+			Bid:      minutes[i].Bid,
+			Ask:      minutes[i].Ask,
+			Volume:   minutes[i].Volume,
+			Ticks:    minutes[i].Ticks,
+		}
+	}
+	return resp, nil
+}
+
+func (postgresStore) upsertMinute(ctx context.Context, exec boil.ContextExecutor, t *Tick) error {
+	_, err := exec.ExecContext(ctx,
+		"INSERT INTO ticker_history_minute"+
+			" (exchange, asset, pair, open, high, low, close, bid, ask, volume, ticks, started_at)"+
+			" VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)"+
+			" ON CONFLICT (exchange, asset, pair, started_at) DO UPDATE SET"+
+			" high = GREATEST(ticker_history_minute.high, EXCLUDED.high),"+
+			" low = LEAST(ticker_history_minute.low, EXCLUDED.low),"+
+			" ticks = ticker_history_minute.ticks + EXCLUDED.ticks",
+		t.Exchange,
+		t.Asset.String(),
+		formatPair(t.Pair),
+		t.Open,
+		t.High,
+		t.Low,
+		t.Close,
+		t.Bid,
+		t.Ask,
+		t.Volume,
+		t.Ticks,
+		t.Time.UTC())
+	return err
+}
+
+func (postgresStore) deleteTicks(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) error {
+	_, err := modelPSQL.TickerHistories(mods...).DeleteAll(ctx, exec)
+	return err
+}
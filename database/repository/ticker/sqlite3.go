@@ -0,0 +1,124 @@
+package ticker
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	modelSQLite "github.com/yurulab/gocryptotrader/database/models/sqlite3"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+type sqliteStore struct{}
+
+func (sqliteStore) insert(ctx context.Context, exec boil.ContextExecutor, p *ticker.Price) error {
+	history := modelSQLite.TickerHistory{
+		Exchange:    strings.ToLower(p.ExchangeName),
+		Asset:       strings.ToLower(p.AssetType.String()),
+		Pair:        formatPair(p.Pair),
+		Last:        p.Last,
+		High:        p.High,
+		Low:         p.Low,
+		Bid:         p.Bid,
+		Ask:         p.Ask,
+		Volume:      p.Volume,
+		QuoteVolume: p.QuoteVolume,
+		CreatedAt:   repository.FormatSQLiteTime(p.LastUpdated),
+	}
+	return history.Insert(ctx, exec, boil.Infer())
+}
+
+func (sqliteStore) queryTicks(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Tick, error) {
+	history, err := modelSQLite.TickerHistories(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Tick, len(history))
+	for i := range history {
+		var createdAt time.Time
+		createdAt, err = repository.ParseSQLiteTime(history[i].CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		resp[i], err = newTick(history[i].Exchange,
+			history[i].Asset,
+			history[i].Pair,
+			history[i].Last,
+			history[i].Bid,
+			history[i].Ask,
+			history[i].Volume,
+			createdAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (sqliteStore) queryMinutes(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Tick, error) {
+	minutes, err := modelSQLite.TickerHistoryMinutes(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Tick, len(minutes))
+	for i := range minutes {
+		var startedAt time.Time
+		startedAt, err = repository.ParseSQLiteTime(minutes[i].StartedAt)
+		if err != nil {
+			return nil, err
+		}
+		var p currency.Pair
+		p, err = parsePair(minutes[i].Pair)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Tick{
+			Exchange: minutes[i].Exchange,
+			Asset:    asset.Item(minutes[i].Asset),
+			Pair:     p,
+			Time:     startedAt,
+			Period:   time.Minute,
+			Open:     minutes[i].Open,
+			High:     minutes[i].High,
+			Low:      minutes[i].Low,
+			Close:    minutes[i].Close,
+			Bid:      minutes[i].Bid,
+			Ask:      minutes[i].Ask,
+			Volume:   minutes[i].Volume,
+			Ticks:    minutes[i].Ticks,
+		}
+	}
+	return resp, nil
+}
+
+func (sqliteStore) upsertMinute(ctx context.Context, exec boil.ContextExecutor, t *Tick) error {
+	_, err := exec.ExecContext(ctx,
+		"INSERT INTO ticker_history_minute"+
+			" (exchange, asset, pair, open, high, low, close, bid, ask, volume, ticks, started_at)"+
+			" VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"+
+			" ON CONFLICT (exchange, asset, pair, started_at) DO UPDATE SET"+
+			" high = MAX(high, excluded.high), low = MIN(low, excluded.low), ticks = ticks + excluded.ticks",
+		t.Exchange,
+		t.Asset.String(),
+		formatPair(t.Pair),
+		t.Open,
+		t.High,
+		t.Low,
+		t.Close,
+		t.Bid,
+		t.Ask,
+		t.Volume,
+		t.Ticks,
+		repository.FormatSQLiteTime(t.Time))
+	return err
+}
+
+func (sqliteStore) deleteTicks(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) error {
+	_, err := modelSQLite.TickerHistories(mods...).DeleteAll(ctx, exec)
+	return err
+}
@@ -0,0 +1,277 @@
+package ticker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Tick is the price of a pair over a period of ticker history. A single
+// stored ticker has a period of zero, an open, high, low and close equal to
+// its last price and one tick. Minute aggregates have the closing bid, ask
+// and volume of the minute
+type Tick struct {
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	Time     time.Time
+	Period   time.Duration
+	Open     float64
+	High     float64
+	Low      float64
+	Close    float64
+	Bid      float64
+	Ask      float64
+	Volume   float64
+	Ticks    int64
+}
+
+// store reads and writes ticker history through the generated models of a
+// single driver
+type store interface {
+	insert(ctx context.Context, exec boil.ContextExecutor, p *ticker.Price) error
+	queryTicks(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Tick, error)
+	queryMinutes(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Tick, error)
+	// upsertMinute stores a minute aggregate, widening the high and low and
+	// adding to the ticks of one already stored for the same minute
+	upsertMinute(ctx context.Context, exec boil.ContextExecutor, t *Tick) error
+	deleteTicks(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) error
+}
+
+// stores are the ticker history stores keyed by dialect
+var stores = map[string]store{
+	database.DBSQLite3:    sqliteStore{},
+	database.DBPostgreSQL: postgresStore{},
+	database.DBMySQL:      mysqlStore{},
+}
+
+// getStore returns the ticker history store of the enabled driver
+func getStore() (store, error) {
+	s, ok := stores[repository.GetSQLDialect()]
+	if !ok {
+		return nil, repository.ErrDialectNotSupported
+	}
+	return s, nil
+}
+
+// formatPair returns the pair in the form ticker history is stored under
+func formatPair(p currency.Pair) string {
+	return p.Format(currency.DashDelimiter, true).String()
+}
+
+// parsePair returns the pair of a stored ticker
+func parsePair(pair string) (currency.Pair, error) {
+	return currency.NewPairDelimiter(pair, currency.DashDelimiter)
+}
+
+// newTick returns a stored ticker as a tick
+func newTick(exchange, a, pair string, last, bid, ask, volume float64, createdAt time.Time) (Tick, error) {
+	p, err := parsePair(pair)
+	if err != nil {
+		return Tick{}, err
+	}
+	return Tick{
+		Exchange: exchange,
+		Asset:    asset.Item(a),
+		Pair:     p,
+		Time:     createdAt,
+		Open:     last,
+		High:     last,
+		Low:      last,
+		Close:    last,
+		Bid:      bid,
+		Ask:      ask,
+		Volume:   volume,
+		Ticks:    1,
+	}, nil
+}
+
+// Insert stores tickers in the ticker history
+func Insert(prices []ticker.Price) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+
+	s, err := getStore()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	return repository.Transaction(ctx, func(tx *sql.Tx) error {
+		for i := range prices {
+			if err := s.insert(ctx, tx, &prices[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DownsampleWindow is the period of tickers downsampled in each transaction,
+// keeping the number of tickers held in memory at once bounded
+var DownsampleWindow = time.Hour
+
+// Downsample replaces the tickers stored before the minute before falls in
+// with an aggregate per pair per minute, returning the number of tickers
+// replaced. Tickers are downsampled a DownsampleWindow at a time, oldest
+// first, each window in its own transaction. Tickers stored late for a minute
+// which has already been aggregated widen its high and low and are counted in
+// its ticks
+func Downsample(before time.Time) (int, error) {
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
+	}
+
+	s, err := getStore()
+	if err != nil {
+		return 0, err
+	}
+	before = before.UTC().Truncate(time.Minute)
+
+	var count int
+	ctx := context.Background()
+	for {
+		var oldest []Tick
+		oldest, err = s.queryTicks(ctx, database.DB.SQL,
+			qm.Where("created_at < ?", repository.Args(before)...),
+			qm.OrderBy("created_at, id"),
+			qm.Limit(1))
+		if err != nil {
+			return count, fmt.Errorf("ticker history: %v", err)
+		}
+		if len(oldest) == 0 {
+			return count, nil
+		}
+
+		start := oldest[0].Time.UTC().Truncate(time.Minute)
+		end := start.Add(DownsampleWindow).Truncate(time.Minute)
+		if !end.After(start) {
+			end = start.Add(time.Minute)
+		}
+		if end.After(before) {
+			end = before
+		}
+
+		var n int
+		n, err = downsampleWindow(ctx, s, start, end)
+		if err != nil {
+			return count, err
+		}
+		count += n
+	}
+}
+
+// downsampleWindow replaces the tickers stored from start until end with
+// minute aggregates in a single transaction, returning the number of tickers
+// replaced
+func downsampleWindow(ctx context.Context, s store, start, end time.Time) (int, error) {
+	var count int
+	err := repository.Transaction(ctx, func(tx *sql.Tx) error {
+		where := qm.Where("created_at >= ? AND created_at < ?", repository.Args(start, end)...)
+		ticks, err := s.queryTicks(ctx, tx,
+			where,
+			qm.OrderBy("exchange, asset, pair, created_at, id"))
+		if err != nil {
+			return fmt.Errorf("ticker history: %v", err)
+		}
+		count = len(ticks)
+
+		minutes := aggregate(ticks)
+		for i := range minutes {
+			if err = s.upsertMinute(ctx, tx, &minutes[i]); err != nil {
+				return err
+			}
+		}
+		return s.deleteTicks(ctx, tx, where)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// aggregate returns a minute aggregate per pair per minute of ticks, which
+// must be ordered by pair then time
+func aggregate(ticks []Tick) []Tick {
+	var minutes []Tick
+	for i := range ticks {
+		t := ticks[i]
+		t.Time = t.Time.UTC().Truncate(time.Minute)
+		last := len(minutes) - 1
+		if last < 0 ||
+			minutes[last].Exchange != t.Exchange ||
+			minutes[last].Asset != t.Asset ||
+			!minutes[last].Pair.Equal(t.Pair) ||
+			!minutes[last].Time.Equal(t.Time) {
+			t.Period = time.Minute
+			minutes = append(minutes, t)
+			continue
+		}
+		m := &minutes[last]
+		if t.Close > m.High {
+			m.High = t.Close
+		}
+		if t.Close < m.Low {
+			m.Low = t.Close
+		}
+		m.Close, m.Bid, m.Ask, m.Volume = t.Close, t.Bid, t.Ask, t.Volume
+		m.Ticks++
+	}
+	return minutes
+}
+
+// GetHistory returns the ticker history of a pair between start and end in
+// time order, which is made up of minute aggregates once tickers have been
+// downsampled
+func GetHistory(exchange string, a asset.Item, p currency.Pair, start, end time.Time, limit int) ([]Tick, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+
+	s, err := getStore()
+	if err != nil {
+		return nil, err
+	}
+
+	where := qm.Where("exchange = ? AND asset = ? AND pair = ?",
+		strings.ToLower(exchange),
+		strings.ToLower(a.String()),
+		formatPair(p))
+	ctx := context.Background()
+	resp, err := s.queryMinutes(ctx, database.DB.SQL, append([]qm.QueryMod{where,
+		qm.Where("started_at BETWEEN ? AND ?", repository.Args(start, end)...),
+		qm.OrderBy("started_at")},
+		repository.Limit(limit)...)...)
+	if err != nil {
+		return nil, fmt.Errorf("ticker history minute: %v", err)
+	}
+
+	ticks, err := s.queryTicks(ctx, database.DB.SQL, append([]qm.QueryMod{where,
+		qm.Where("created_at BETWEEN ? AND ?", repository.Args(start, end)...),
+		qm.OrderBy("created_at, id")},
+		repository.Limit(limit)...)...)
+	if err != nil {
+		return nil, fmt.Errorf("ticker history: %v", err)
+	}
+	resp = append(resp, ticks...)
+
+	sort.SliceStable(resp, func(i, j int) bool {
+		return resp[i].Time.Before(resp[j].Time)
+	})
+	if limit > 0 && len(resp) > limit {
+		resp = resp[:limit]
+	}
+	return resp, nil
+}
@@ -0,0 +1,126 @@
+package ticker
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/database/testhelpers"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/ticker"
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestTickerHistory(t *testing.T) {
+	testhelpers.Run(t,
+		testhelpers.TestCase{Name: "Downsample", Runner: downsampleHelper},
+	)
+}
+
+func downsampleHelper(t *testing.T) {
+	t.Helper()
+
+	// Downsample a minute per transaction so multiple windows are covered
+	oldWindow := DownsampleWindow
+	DownsampleWindow = time.Minute
+	defer func() { DownsampleWindow = oldWindow }()
+
+	now := time.Now().UTC().Truncate(time.Minute)
+	p := currency.NewPairWithDelimiter("BTC", "USD", "/")
+	price := func(last float64, at time.Time) ticker.Price {
+		return ticker.Price{
+			ExchangeName: "Test",
+			AssetType:    asset.Spot,
+			Pair:         p,
+			Last:         last,
+			Bid:          last - 1,
+			Ask:          last + 1,
+			Volume:       last * 10,
+			LastUpdated:  at,
+		}
+	}
+	prices := []ticker.Price{
+		price(100, now.Add(-time.Minute*3+time.Second*5)),
+		price(105, now.Add(-time.Minute*3+time.Second*20)),
+		price(95, now.Add(-time.Minute*3+time.Second*40)),
+		price(101, now.Add(-time.Minute*3+time.Second*50)),
+		price(110, now.Add(-time.Minute*2+time.Second*10)),
+		price(120, now.Add(time.Second*10)),
+	}
+	if err := Insert(prices); err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := Downsample(now.Add(-time.Minute + time.Second*30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 5 {
+		t.Errorf("expected 5 tickers to be downsampled, received %v", count)
+	}
+
+	history, err := GetHistory("test", asset.Spot, currency.NewPair(currency.BTC, currency.USD), now.Add(-time.Hour), now.Add(time.Minute), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 3 {
+		t.Fatalf("expected 2 minutes and a ticker, received %+v", history)
+	}
+	m := history[0]
+	if !m.Time.Equal(now.Add(-time.Minute*3)) || m.Period != time.Minute || m.Ticks != 4 {
+		t.Errorf("unexpected minute %+v", m)
+	}
+	if m.Open != 100 || m.High != 105 || m.Low != 95 || m.Close != 101 ||
+		m.Bid != 100 || m.Ask != 102 || m.Volume != 1010 {
+		t.Errorf("unexpected minute prices %+v", m)
+	}
+	if !m.Pair.Equal(p) || m.Asset != asset.Spot || m.Exchange != "test" {
+		t.Errorf("unexpected minute pair %+v", m)
+	}
+	if history[1].Ticks != 1 || history[1].Close != 110 {
+		t.Errorf("unexpected minute %+v", history[1])
+	}
+	if history[2].Period != 0 || history[2].Close != 120 || !history[2].Time.Equal(prices[5].LastUpdated) {
+		t.Errorf("unexpected ticker %+v", history[2])
+	}
+
+	// A late ticker for an aggregated minute is merged into it
+	if err = Insert([]ticker.Price{price(90, now.Add(-time.Minute*3+time.Second*55))}); err != nil {
+		t.Fatal(err)
+	}
+	if count, err = Downsample(now); err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("expected late ticker to be downsampled, received %v", count)
+	}
+	history, err = GetHistory("test", asset.Spot, p, now.Add(-time.Hour), now, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || history[0].Low != 90 || history[0].High != 105 || history[0].Ticks != 5 || history[0].Close != 101 {
+		t.Errorf("unexpected merged minute %+v", history)
+	}
+}
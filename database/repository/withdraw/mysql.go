@@ -0,0 +1,114 @@
+package withdraw
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/yurulab/gocryptotrader/currency"
+	modelMySQL "github.com/yurulab/gocryptotrader/database/models/mysql"
+	"github.com/yurulab/gocryptotrader/portfolio/banking"
+	"github.com/yurulab/gocryptotrader/portfolio/withdraw"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+type mysqlStore struct{}
+
+func (mysqlStore) insert(ctx context.Context, exec boil.ContextExecutor, id string, res *withdraw.Response) error {
+	history := modelMySQL.WithdrawalHistory{
+		ID:           id,
+		Exchange:     res.Exchange.Name,
+		ExchangeID:   res.Exchange.ID,
+		Status:       res.Exchange.Status,
+		Currency:     res.RequestDetails.Currency.String(),
+		Amount:       res.RequestDetails.Amount,
+		Description:  null.NewString(res.RequestDetails.Description, res.RequestDetails.Description != ""),
+		WithdrawType: int(res.RequestDetails.Type),
+	}
+	err := history.Insert(ctx, exec, boil.Infer())
+	if err != nil {
+		return err
+	}
+
+	switch res.RequestDetails.Type {
+	case withdraw.Fiat:
+		bank := res.RequestDetails.Fiat.Bank
+		return history.AddWithdrawalFiats(ctx, exec, true, &modelMySQL.WithdrawalFiat{
+			BankName:          bank.BankName,
+			BankAddress:       bank.BankAddress,
+			BankAccountName:   bank.AccountName,
+			BankAccountNumber: bank.AccountNumber,
+			BSB:               bank.BSBNumber,
+			SwiftCode:         bank.SWIFTCode,
+			Iban:              bank.IBAN,
+			BankCode:          bank.BankCode,
+		})
+	case withdraw.Crypto:
+		c := res.RequestDetails.Crypto
+		return history.AddWithdrawalCryptos(ctx, exec, true, &modelMySQL.WithdrawalCrypto{
+			Address:    c.Address,
+			AddressTag: null.NewString(c.AddressTag, c.AddressTag != ""),
+			Fee:        c.FeeAmount,
+		})
+	}
+	return nil
+}
+
+func (mysqlStore) query(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]*withdraw.Response, error) {
+	histories, err := modelMySQL.WithdrawalHistories(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]*withdraw.Response, len(histories))
+	for i, h := range histories {
+		r := &withdraw.Response{
+			Exchange: &withdraw.ExchangeResponse{
+				Name:   h.Exchange,
+				ID:     h.ExchangeID,
+				Status: h.Status,
+			},
+			RequestDetails: &withdraw.Request{
+				Currency:    currency.NewCode(h.Currency),
+				Description: h.Description.String,
+				Amount:      h.Amount,
+				Type:        withdraw.RequestType(h.WithdrawType),
+			},
+		}
+		r.ID, _ = uuid.FromString(h.ID)
+		r.CreatedAt = h.CreatedAt.UTC()
+		r.UpdatedAt = h.UpdatedAt.UTC()
+
+		switch r.RequestDetails.Type {
+		case withdraw.Crypto:
+			var c *modelMySQL.WithdrawalCrypto
+			if c, err = h.WithdrawalCryptos().One(ctx, exec); err != nil {
+				return nil, err
+			}
+			r.RequestDetails.Crypto = &withdraw.CryptoRequest{
+				Address:    c.Address,
+				AddressTag: c.AddressTag.String,
+				FeeAmount:  c.Fee,
+			}
+		case withdraw.Fiat:
+			var f *modelMySQL.WithdrawalFiat
+			if f, err = h.WithdrawalFiats().One(ctx, exec); err != nil {
+				return nil, err
+			}
+			r.RequestDetails.Fiat = &withdraw.FiatRequest{
+				Bank: &banking.Account{
+					BankName:      f.BankName,
+					BankAddress:   f.BankAddress,
+					AccountName:   f.BankAccountName,
+					AccountNumber: f.BankAccountNumber,
+					BSBNumber:     f.BSB,
+					SWIFTCode:     f.SwiftCode,
+					IBAN:          f.Iban,
+					BankCode:      f.BankCode,
+				},
+			}
+		}
+		resp[i] = r
+	}
+	return resp, nil
+}
@@ -6,24 +6,20 @@ import (
 	"io/ioutil"
 	"math/rand"
 	"os"
-	"path/filepath"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/yurulab/gocryptotrader/currency"
-	"github.com/yurulab/gocryptotrader/database"
-	"github.com/yurulab/gocryptotrader/database/drivers"
-	"github.com/yurulab/gocryptotrader/database/repository"
 	"github.com/yurulab/gocryptotrader/database/testhelpers"
 	"github.com/yurulab/gocryptotrader/portfolio/banking"
 	"github.com/yurulab/gocryptotrader/portfolio/withdraw"
-	"github.com/thrasher-corp/goose"
 )
 
 func TestMain(m *testing.M) {
 	var err error
 	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
 	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
 	if err != nil {
 		fmt.Printf("failed to create temp file: %v", err)
@@ -41,62 +37,9 @@ func TestMain(m *testing.M) {
 }
 
 func TestWithdraw(t *testing.T) {
-	testCases := []struct {
-		name   string
-		config *database.Config
-		runner func(t *testing.T)
-		closer func(dbConn *database.Instance) error
-		output interface{}
-	}{
-		{
-			"SQLite-Write",
-			&database.Config{
-				Driver:            database.DBSQLite3,
-				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
-			},
-			withdrawHelper,
-			testhelpers.CloseDatabase,
-			nil,
-		},
-		{
-			"Postgres-Write",
-			testhelpers.PostgresTestDatabase,
-			withdrawHelper,
-			nil,
-			nil,
-		},
-	}
-
-	for _, tests := range testCases {
-		test := tests
-		t.Run(test.name, func(t *testing.T) {
-			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
-				t.Skip("database not configured skipping test")
-			}
-
-			dbConn, err := testhelpers.ConnectToDatabase(test.config)
-			if err != nil {
-				t.Fatal(err)
-			}
-
-			path := filepath.Join("..", "..", "migrations")
-			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
-			if err != nil {
-				t.Fatalf("failed to run migrations %v", err)
-			}
-
-			if test.runner != nil {
-				test.runner(t)
-			}
-
-			if test.closer != nil {
-				err = test.closer(dbConn)
-				if err != nil {
-					t.Log(err)
-				}
-			}
-		})
-	}
+	testhelpers.Run(t,
+		testhelpers.TestCase{Name: "Write", Runner: withdrawHelper},
+	)
 }
 
 func withdrawHelper(t *testing.T) {
@@ -2,12 +2,18 @@ package testhelpers
 
 import (
 	"os"
+	"path/filepath"
 	"reflect"
+	"strconv"
+	"testing"
 
 	"github.com/yurulab/gocryptotrader/database"
 	"github.com/yurulab/gocryptotrader/database/drivers"
+	mysqlConn "github.com/yurulab/gocryptotrader/database/drivers/mysql"
 	psqlConn "github.com/yurulab/gocryptotrader/database/drivers/postgres"
 	sqliteConn "github.com/yurulab/gocryptotrader/database/drivers/sqlite3"
+	"github.com/yurulab/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/goose"
 )
 
 var (
@@ -15,6 +21,8 @@ var (
 	TempDir string
 	// PostgresTestDatabase postgresql database config details
 	PostgresTestDatabase *database.Config
+	// MySQLTestDatabase mysql database config details
+	MySQLTestDatabase *database.Config
 )
 
 // GetConnectionDetails returns connection details for CI or test db instances
@@ -65,6 +73,30 @@ func GetConnectionDetails() *database.Config {
 	}
 }
 
+// GetMySQLConnectionDetails returns connection details for a MySQL test
+// instance read from the GCT_MYSQL_HOST, GCT_MYSQL_PORT, GCT_MYSQL_USER,
+// GCT_MYSQL_PASSWORD and GCT_MYSQL_DATABASE environment variables, tests are
+// skipped when GCT_MYSQL_HOST is unset
+func GetMySQLConnectionDetails() *database.Config {
+	cfg := &database.Config{
+		Enabled: true,
+		Driver:  database.DBMySQL,
+	}
+	host, exists := os.LookupEnv("GCT_MYSQL_HOST")
+	if !exists {
+		return cfg
+	}
+	cfg.Host = host
+	cfg.Port = 3306
+	if p, err := strconv.ParseUint(os.Getenv("GCT_MYSQL_PORT"), 10, 16); err == nil {
+		cfg.Port = uint16(p)
+	}
+	cfg.Username = os.Getenv("GCT_MYSQL_USER")
+	cfg.Password = os.Getenv("GCT_MYSQL_PASSWORD")
+	cfg.Database = os.Getenv("GCT_MYSQL_DATABASE")
+	return cfg
+}
+
 // ConnectToDatabase opens connection to database and returns pointer to instance of database.DB
 func ConnectToDatabase(conn *database.Config) (dbConn *database.Instance, err error) {
 	database.DB.Config = conn
@@ -74,6 +106,11 @@ func ConnectToDatabase(conn *database.Config) (dbConn *database.Instance, err er
 		if err != nil {
 			return nil, err
 		}
+	} else if conn.Driver == database.DBMySQL {
+		dbConn, err = mysqlConn.Connect()
+		if err != nil {
+			return nil, err
+		}
 	} else if conn.Driver == database.DBSQLite3 || conn.Driver == database.DBSQLite {
 		database.DB.DataPath = TempDir
 		dbConn, err = sqliteConn.Connect()
@@ -98,3 +135,62 @@ func CloseDatabase(conn *database.Instance) (err error) {
 func CheckValidConfig(config *drivers.ConnectionDetails) bool {
 	return !reflect.DeepEqual(drivers.ConnectionDetails{}, *config)
 }
+
+// TestCase is a repository test run against every configured database
+type TestCase struct {
+	Name   string
+	Runner func(t *testing.T)
+}
+
+// Run connects to SQLite and to the Postgres and MySQL test databases when
+// configured, migrates each of them and runs cases in order as subtests named
+// after the database. Tests calling Run must live two directories below the
+// database package so the migrations can be found
+func Run(t *testing.T, cases ...TestCase) {
+	t.Helper()
+	databases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			"SQLite",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+		{"Postgres", PostgresTestDatabase},
+		{"MySQL", MySQLTestDatabase},
+	}
+
+	for i := range databases {
+		db := databases[i]
+		if db.config == nil || !CheckValidConfig(&db.config.ConnectionDetails) {
+			for j := range cases {
+				t.Run(db.name+"-"+cases[j].Name, func(t *testing.T) {
+					t.Skip("database not configured skipping test")
+				})
+			}
+			continue
+		}
+
+		dbConn, err := ConnectToDatabase(db.config)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		path := filepath.Join("..", "..", "migrations")
+		err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
+		if err != nil {
+			t.Fatalf("failed to run migrations %v", err)
+		}
+
+		for j := range cases {
+			t.Run(db.name+"-"+cases[j].Name, cases[j].Runner)
+		}
+
+		if err = CloseDatabase(dbConn); err != nil {
+			t.Log(err)
+		}
+	}
+}
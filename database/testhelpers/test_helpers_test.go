@@ -13,6 +13,7 @@ import (
 func TestMain(m *testing.M) {
 	var err error
 	PostgresTestDatabase = GetConnectionDetails()
+	MySQLTestDatabase = GetMySQLConnectionDetails()
 	TempDir, err = ioutil.TempDir("", "gct-temp")
 	if err != nil {
 		fmt.Printf("failed to create temp file: %v", err)
@@ -61,6 +62,11 @@ func TestDatabaseConnect(t *testing.T) {
 			config: PostgresTestDatabase,
 			output: nil,
 		},
+		{
+			name:   "MySQL",
+			config: MySQLTestDatabase,
+			output: nil,
+		},
 	}
 
 	for _, tests := range testCases {
@@ -257,6 +257,7 @@ func (d *Dispatcher) publish(id uuid.UUID, data interface{}) error {
 	select {
 	case d.jobs <- newJob:
 	default:
+		jobsDropped.Inc()
 		return fmt.Errorf("dispatcher jobs at limit [%d] current worker count [%d]. Spawn more workers via --dispatchworkers=x"+
 			", or increase the jobs limit via --dispatchjobslimit=x",
 			len(d.jobs),
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/gofrs/uuid"
@@ -250,6 +251,25 @@ func TestSubscribe(t *testing.T) {
 	}
 }
 
+func TestMetrics(t *testing.T) {
+	if queueCap() != cap(dispatcher.jobs) {
+		t.Errorf("expected queue capacity %d, received %d", cap(dispatcher.jobs), queueCap())
+	}
+	if queueLen() > queueCap() {
+		t.Errorf("queue length %d exceeds capacity %d", queueLen(), queueCap())
+	}
+	if workerCount() != atomic.LoadInt32(&dispatcher.count) {
+		t.Errorf("expected %d workers, received %d", dispatcher.count, workerCount())
+	}
+
+	d := dispatcher
+	dispatcher = nil
+	if queueLen() != 0 || queueCap() != 0 || workerCount() != 0 {
+		t.Error("expected zero values without a dispatcher")
+	}
+	dispatcher = d
+}
+
 func TestPublish(t *testing.T) {
 	itemID, err := mux.GetID()
 	if err != nil {
@@ -0,0 +1,54 @@
+package dispatch
+
+import (
+	"sync/atomic"
+
+	"github.com/yurulab/gocryptotrader/metrics"
+)
+
+var (
+	jobsQueued = metrics.NewGaugeFunc("gct_dispatch_jobs_queued",
+		"Jobs waiting in the dispatch queue.",
+		func() float64 { return float64(queueLen()) })
+	jobsCapacity = metrics.NewGaugeFunc("gct_dispatch_jobs_capacity",
+		"Capacity of the dispatch job queue.",
+		func() float64 { return float64(queueCap()) })
+	workers = metrics.NewGaugeFunc("gct_dispatch_workers",
+		"Running dispatch worker routines.",
+		func() float64 { return float64(workerCount()) })
+	jobsDropped = metrics.NewCounterVec("gct_dispatch_jobs_dropped_total",
+		"Jobs dropped because the dispatch queue was full.")
+)
+
+func init() {
+	metrics.MustRegister(jobsQueued, jobsCapacity, workers, jobsDropped)
+}
+
+// queueLen returns the number of queued jobs. The mutex guards against the
+// job channel being replaced by start
+func queueLen() int {
+	mtx.Lock()
+	defer mtx.Unlock()
+	if dispatcher == nil {
+		return 0
+	}
+	return len(dispatcher.jobs)
+}
+
+func queueCap() int {
+	mtx.Lock()
+	defer mtx.Unlock()
+	if dispatcher == nil {
+		return 0
+	}
+	return cap(dispatcher.jobs)
+}
+
+func workerCount() int32 {
+	mtx.Lock()
+	defer mtx.Unlock()
+	if dispatcher == nil {
+		return 0
+	}
+	return atomic.LoadInt32(&dispatcher.count)
+}
@@ -0,0 +1,214 @@
+package engine
+
+import (
+	"bufio"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/common/crypto"
+	"github.com/yurulab/gocryptotrader/database/repository/audit"
+	"github.com/yurulab/gocryptotrader/log"
+)
+
+// AuditCheckpoint is a signed record of the head of the audit event hash chain.
+// As the chain can be rewritten by anyone with database access, checkpoints
+// held outside the database prove the chain up to EventID was not altered.
+// Archived checkpoints record the last event removed by an archive, which the
+// remaining chain must start from
+type AuditCheckpoint struct {
+	Timestamp time.Time `json:"timestamp"`
+	EventID   int64     `json:"eventID"`
+	Hash      string    `json:"hash"`
+	Archived  bool      `json:"archived,omitempty"`
+	Signature string    `json:"signature"`
+}
+
+// sign returns the HMAC-SHA256 signature of the checkpoint
+func (c *AuditCheckpoint) sign(key string) string {
+	payload := fmt.Sprintf("%d:%s:%d", c.EventID, c.Hash, c.Timestamp.UnixNano())
+	if c.Archived {
+		payload += ":archived"
+	}
+	return crypto.HexEncodeToString(crypto.GetHMAC(crypto.HashSHA256,
+		[]byte(payload),
+		[]byte(key)))
+}
+
+// auditCheckpointer periodically appends signed audit checkpoints to a file
+type auditCheckpointer struct {
+	path     string
+	key      string
+	interval time.Duration
+	lastID   int64
+}
+
+func newAuditCheckpointer(path, key string, interval time.Duration) *auditCheckpointer {
+	return &auditCheckpointer{
+		path:     path,
+		key:      key,
+		interval: interval,
+	}
+}
+
+// run exports a checkpoint every interval until shutdown is closed
+func (a *auditCheckpointer) run(shutdown <-chan struct{}) {
+	t := time.NewTicker(a.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-shutdown:
+			return
+		case <-t.C:
+			if err := a.checkpoint(); err != nil {
+				log.Errorf(log.DatabaseMgr, "Audit checkpoint failed: %v\n", err)
+			}
+		}
+	}
+}
+
+// checkpoint appends a checkpoint of the latest audit event if it has changed
+// since the last checkpoint
+func (a *auditCheckpointer) checkpoint() error {
+	id, hash, err := audit.GetLatestHash()
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if id == a.lastID {
+		return nil
+	}
+
+	err = a.write(&AuditCheckpoint{
+		Timestamp: time.Now().UTC(),
+		EventID:   id,
+		Hash:      hash,
+	})
+	if err != nil {
+		return err
+	}
+	a.lastID = id
+	log.Debugf(log.DatabaseMgr, "Audit checkpoint exported at event %d\n", id)
+	return nil
+}
+
+// archived appends a checkpoint recording that every audit event up to and
+// including id is about to be archived
+func (a *auditCheckpointer) archived(id int64, hash string) error {
+	return a.write(&AuditCheckpoint{
+		Timestamp: time.Now().UTC(),
+		EventID:   id,
+		Hash:      hash,
+		Archived:  true,
+	})
+}
+
+// write signs and appends a checkpoint to the checkpoint file
+func (a *auditCheckpointer) write(c *AuditCheckpoint) error {
+	c.Signature = c.sign(a.key)
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(a.path), 0770); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(a.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(payload, '\n'))
+	if cErr := f.Close(); err == nil {
+		err = cErr
+	}
+	return err
+}
+
+// verifyAuditCheckpoints checks the signature of every checkpoint in the file
+// and that the checkpointed events still carry the same hash. It returns the
+// number of checkpoints verified, the archived events recorded by validly
+// signed checkpoints and any failures
+func verifyAuditCheckpoints(path, key string) (int64, []audit.ArchivedEvent, []audit.Break, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	defer f.Close()
+
+	type line struct {
+		number int
+		AuditCheckpoint
+	}
+	var checkpoints []line
+	var archived []audit.ArchivedEvent
+	var breaks []audit.Break
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var c AuditCheckpoint
+		if err = json.Unmarshal(scanner.Bytes(), &c); err != nil {
+			return 0, nil, nil, fmt.Errorf("checkpoint line %d: %v", n, err)
+		}
+		if c.sign(key) != c.Signature {
+			breaks = append(breaks, audit.Break{
+				ID:     c.EventID,
+				Reason: fmt.Sprintf("checkpoint on line %d has an invalid signature", n),
+			})
+			continue
+		}
+		if c.Archived {
+			archived = append(archived, audit.ArchivedEvent{ID: c.EventID, Hash: c.Hash})
+		}
+		checkpoints = append(checkpoints, line{number: n, AuditCheckpoint: c})
+	}
+	if err = scanner.Err(); err != nil {
+		return 0, nil, nil, err
+	}
+
+	var verified int64
+	for i := range checkpoints {
+		c := &checkpoints[i]
+		hash, err := audit.GetHash(c.EventID)
+		switch {
+		case err == sql.ErrNoRows:
+			if isAuditEventArchived(c.EventID, archived) {
+				verified++
+				continue
+			}
+			breaks = append(breaks, audit.Break{
+				ID:     c.EventID,
+				Reason: fmt.Sprintf("checkpointed event from line %d no longer exists", c.number),
+			})
+			continue
+		case err != nil:
+			return verified, archived, breaks, err
+		case hash != c.Hash:
+			breaks = append(breaks, audit.Break{
+				ID:     c.EventID,
+				Reason: fmt.Sprintf("event hash differs from checkpoint on line %d", c.number),
+			})
+			continue
+		}
+		verified++
+	}
+	return verified, archived, breaks, nil
+}
+
+// isAuditEventArchived returns whether a missing audit event was removed by
+// an archive recorded in a signed checkpoint
+func isAuditEventArchived(id int64, archived []audit.ArchivedEvent) bool {
+	for i := range archived {
+		if id <= archived[i].ID {
+			return true
+		}
+	}
+	return false
+}
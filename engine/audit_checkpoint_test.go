@@ -0,0 +1,100 @@
+package engine
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/database/repository/audit"
+)
+
+func TestAuditCheckpointSign(t *testing.T) {
+	t.Parallel()
+	c := AuditCheckpoint{
+		Timestamp: time.Unix(1584000000, 0),
+		EventID:   10,
+		Hash:      "abc",
+	}
+	sig := c.sign("key")
+	if sig == "" || sig != c.sign("key") {
+		t.Error("signature should be deterministic")
+	}
+	if sig == c.sign("other") {
+		t.Error("signature should depend on the key")
+	}
+	c.EventID = 11
+	if sig == c.sign("key") {
+		t.Error("signature should depend on the checkpoint")
+	}
+	sig = c.sign("key")
+	c.Archived = true
+	if sig == c.sign("key") {
+		t.Error("signature should depend on whether events were archived")
+	}
+}
+
+func TestVerifyAuditCheckpoints(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "gct-audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "checkpoints.log")
+
+	if _, _, _, err = verifyAuditCheckpoints(path, "key"); !os.IsNotExist(err) {
+		t.Errorf("expected not exist error, received %v", err)
+	}
+
+	c := AuditCheckpoint{Timestamp: time.Now(), EventID: 1, Hash: "abc"}
+	c.Signature = c.sign("wrongkey")
+	payload, err := json.Marshal(&c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(path, append(payload, '\n'), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	verified, _, breaks, err := verifyAuditCheckpoints(path, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verified != 0 || len(breaks) != 1 || breaks[0].ID != 1 {
+		t.Errorf("expected invalid signature break, received %v %+v", verified, breaks)
+	}
+
+	c.Signature = c.sign("key")
+	if payload, err = json.Marshal(&c); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(path, append(payload, '\n'), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err = verifyAuditCheckpoints(path, "key"); err != database.ErrDatabaseSupportDisabled {
+		t.Errorf("expected %v, received %v", database.ErrDatabaseSupportDisabled, err)
+	}
+}
+
+func TestIsAuditEventArchived(t *testing.T) {
+	t.Parallel()
+	archived := []audit.ArchivedEvent{{ID: 10, Hash: "a"}, {ID: 20, Hash: "b"}}
+	if !isAuditEventArchived(20, archived) || !isAuditEventArchived(5, archived) {
+		t.Error("events up to the last archived event should be archived")
+	}
+	if isAuditEventArchived(21, archived) || isAuditEventArchived(1, nil) {
+		t.Error("events after the last archived event should not be archived")
+	}
+}
+
+func TestAuditCheckpointerCheckpoint(t *testing.T) {
+	t.Parallel()
+	a := newAuditCheckpointer(filepath.Join(os.TempDir(), "gct-audit-none.log"), "key", time.Hour)
+	if err := a.checkpoint(); err != database.ErrDatabaseSupportDisabled {
+		t.Errorf("expected %v, received %v", database.ErrDatabaseSupportDisabled, err)
+	}
+}
@@ -0,0 +1,213 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/database/repository/balance"
+	exchange "github.com/yurulab/gocryptotrader/exchanges"
+	"github.com/yurulab/gocryptotrader/exchanges/account"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/ticker"
+	"github.com/yurulab/gocryptotrader/log"
+)
+
+// vars related to balance snapshots
+var (
+	// BalanceSnapshotTimeout is how long an exchange is given to return its
+	// account balances for a snapshot
+	BalanceSnapshotTimeout = time.Second * 30
+)
+
+// balanceSnapshotter records exchange account balances to the database every
+// interval
+type balanceSnapshotter struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	interval time.Duration
+}
+
+func (b *balanceSnapshotter) Started() bool {
+	return atomic.LoadInt32(&b.started) == 1
+}
+
+func (b *balanceSnapshotter) Start() (err error) {
+	if !atomic.CompareAndSwapInt32(&b.started, 0, 1) {
+		return errors.New("balance snapshots already started")
+	}
+
+	defer func() {
+		if err != nil {
+			atomic.CompareAndSwapInt32(&b.started, 1, 0)
+		}
+	}()
+
+	if !Bot.Config.BalanceSnapshots.Enabled {
+		return errors.New("balance snapshots are not enabled in the config")
+	}
+	if !Bot.DatabaseManager.Started() {
+		return errors.New("balance snapshots require the database manager to be started")
+	}
+
+	log.Debugln(log.PortfolioMgr, "Balance snapshots starting...")
+	b.interval = Bot.Config.BalanceSnapshots.Interval
+	b.shutdown = make(chan struct{})
+	go b.run()
+	return nil
+}
+
+func (b *balanceSnapshotter) Stop() error {
+	if atomic.LoadInt32(&b.started) == 0 {
+		return errors.New("balance snapshots not started")
+	}
+
+	if !atomic.CompareAndSwapInt32(&b.stopped, 0, 1) {
+		return errors.New("balance snapshots are already stopped")
+	}
+
+	log.Debugln(log.PortfolioMgr, "Balance snapshots shutting down...")
+	close(b.shutdown)
+	return nil
+}
+
+func (b *balanceSnapshotter) run() {
+	log.Debugf(log.PortfolioMgr,
+		"Balance snapshots started. Recording balance snapshots every %v.\n",
+		b.interval)
+	Bot.ServicesWG.Add(1)
+	tick := time.NewTicker(b.interval)
+	defer func() {
+		tick.Stop()
+		atomic.CompareAndSwapInt32(&b.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&b.started, 1, 0)
+		Bot.ServicesWG.Done()
+		log.Debugln(log.PortfolioMgr, "Balance snapshots shutdown.")
+	}()
+
+	for {
+		b.record(fetchSnapshotHoldings(),
+			Bot.Config.Currency.FiatDisplayCurrency,
+			time.Now())
+		select {
+		case <-b.shutdown:
+			return
+		case <-tick.C:
+		}
+	}
+}
+
+// fetchSnapshotHoldings returns the account balances of every enabled
+// exchange with authenticated API support. Each exchange is given at most
+// BalanceSnapshotTimeout so one slow exchange does not hold up the others
+func fetchSnapshotHoldings() []account.Holdings {
+	var holdings []account.Holdings
+	exchs := GetExchanges()
+	for x := range exchs {
+		if !exchs[x].IsEnabled() ||
+			!exchs[x].GetAuthenticatedAPISupport(exchange.RestAuthentication) ||
+			isExchangeSuspended(exchs[x]) {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), BalanceSnapshotTimeout)
+		h, err := exchs[x].FetchAccountInfo(ctx)
+		cancel()
+		if err != nil {
+			log.Errorf(log.PortfolioMgr,
+				"Balance snapshots: Unable to get account info for %s: %v\n",
+				exchs[x].GetName(),
+				err)
+			continue
+		}
+		holdings = append(holdings, h)
+	}
+	return holdings
+}
+
+// record stores a snapshot of the holdings
+func (b *balanceSnapshotter) record(holdings []account.Holdings, fiat currency.Code, now time.Time) {
+	snapshots := buildBalanceSnapshots(holdings, fiat, now)
+	if len(snapshots) == 0 {
+		return
+	}
+	if err := balance.Insert(snapshots); err != nil {
+		log.Errorf(log.PortfolioMgr, "Failed to record balance snapshot: %v\n", err)
+		return
+	}
+	log.Debugf(log.PortfolioMgr,
+		"Recorded %d balances to balance snapshot.\n",
+		len(snapshots))
+}
+
+// buildBalanceSnapshots flattens holdings into one snapshot per exchange,
+// account and currency, valued in the fiat currency where possible
+func buildBalanceSnapshots(holdings []account.Holdings, fiat currency.Code, now time.Time) []balance.Snapshot {
+	var snapshots []balance.Snapshot
+	for x := range holdings {
+		for y := range holdings[x].Accounts {
+			for z := range holdings[x].Accounts[y].Currencies {
+				c := holdings[x].Accounts[y].Currencies[z]
+				if c.TotalValue == 0 && c.Hold == 0 {
+					continue
+				}
+				value, priced := valueInFiat(holdings[x].Exchange,
+					c.CurrencyName,
+					c.TotalValue,
+					fiat)
+				snapshots = append(snapshots, balance.Snapshot{
+					Exchange:     holdings[x].Exchange,
+					Account:      holdings[x].Accounts[y].ID,
+					Currency:     c.CurrencyName.Upper().String(),
+					Total:        c.TotalValue,
+					Hold:         c.Hold,
+					FiatCurrency: fiat.Upper().String(),
+					FiatValue:    value,
+					Priced:       priced,
+					Timestamp:    now,
+				})
+			}
+		}
+	}
+	return snapshots
+}
+
+// valueInFiat returns the value of an amount of a currency in the fiat
+// currency. Fiat currencies are converted with forex rates, cryptocurrencies
+// are priced from the exchange's last spot price against the fiat currency, or
+// against USD or USDT before converting to the fiat currency
+func valueInFiat(exch string, code currency.Code, amount float64, fiat currency.Code) (float64, bool) {
+	if code.Match(fiat) {
+		return amount, true
+	}
+	if code.IsFiatCurrency() {
+		v, err := currency.ConvertCurrency(amount, code, fiat)
+		if err != nil {
+			return 0, false
+		}
+		return v, true
+	}
+
+	for _, quote := range []currency.Code{fiat, currency.USD, currency.USDT} {
+		t, err := ticker.GetTicker(exch, currency.NewPair(code, quote), asset.Spot)
+		if err != nil || t.Last == 0 {
+			continue
+		}
+		v := amount * t.Last
+		if quote.Match(fiat) {
+			return v, true
+		}
+		// USDT is treated as being worth one USD
+		if currency.USD.Match(fiat) {
+			return v, true
+		}
+		v, err = currency.ConvertCurrency(v, currency.USD, fiat)
+		if err != nil {
+			continue
+		}
+		return v, true
+	}
+	return 0, false
+}
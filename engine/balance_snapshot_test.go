@@ -0,0 +1,103 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/exchanges/account"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/ticker"
+)
+
+func TestBuildBalanceSnapshots(t *testing.T) {
+	err := ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: "snapshottest",
+		Pair:         currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.Spot,
+		Last:         1000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: "snapshottest",
+		Pair:         currency.NewPair(currency.LTC, currency.USDT),
+		AssetType:    asset.Spot,
+		Last:         50,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	holdings := []account.Holdings{{
+		Exchange: "snapshottest",
+		Accounts: []account.SubAccount{{
+			ID: "main",
+			Currencies: []account.Balance{
+				{CurrencyName: currency.BTC, TotalValue: 2, Hold: 1},
+				{CurrencyName: currency.LTC, TotalValue: 3},
+				{CurrencyName: currency.USD, TotalValue: 100},
+				{CurrencyName: currency.XRP, TotalValue: 10},
+				{CurrencyName: currency.ETH},
+			},
+		}},
+	}}
+
+	now := time.Now()
+	snapshots := buildBalanceSnapshots(holdings, currency.USD, now)
+	if len(snapshots) != 4 {
+		t.Fatalf("expected 4 snapshots excluding empty balances, received %v", len(snapshots))
+	}
+
+	expected := []struct {
+		value  float64
+		priced bool
+	}{{2000, true}, {150, true}, {100, true}, {0, false}}
+	for i := range expected {
+		if snapshots[i].FiatValue != expected[i].value ||
+			snapshots[i].Priced != expected[i].priced {
+			t.Errorf("%s expected value %v priced %v, received %v %v",
+				snapshots[i].Currency,
+				expected[i].value,
+				expected[i].priced,
+				snapshots[i].FiatValue,
+				snapshots[i].Priced)
+		}
+		if snapshots[i].Exchange != "snapshottest" ||
+			snapshots[i].Account != "main" ||
+			snapshots[i].FiatCurrency != "USD" ||
+			!snapshots[i].Timestamp.Equal(now) {
+			t.Errorf("unexpected snapshot %+v", snapshots[i])
+		}
+	}
+	if snapshots[0].Hold != 1 {
+		t.Error("snapshot should record held balance")
+	}
+}
+
+func TestBalanceSnapshotsStart(t *testing.T) {
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+	oldConfig := Bot.Config
+	defer func() { Bot.Config = oldConfig }()
+	Bot.Config = &config.Config{}
+
+	var b balanceSnapshotter
+	if err := b.Start(); err == nil || b.Started() {
+		t.Error("balance snapshots should not start when disabled")
+	}
+	if err := b.Stop(); err == nil {
+		t.Error("expected error stopping unstarted balance snapshots")
+	}
+
+	Bot.Config.BalanceSnapshots = config.BalanceSnapshotConfig{
+		Enabled:  true,
+		Interval: time.Hour,
+	}
+	if err := b.Start(); err == nil || b.Started() {
+		t.Error("balance snapshots should not start without the database manager")
+	}
+}
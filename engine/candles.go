@@ -0,0 +1,255 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/exchanges/kline"
+	"github.com/yurulab/gocryptotrader/exchanges/stream"
+	"github.com/yurulab/gocryptotrader/log"
+)
+
+// vars related to the candle manager
+var (
+	// CandleCloseDelay is how often the candle manager checks for candle
+	// periods that have elapsed without any trades
+	CandleCloseDelay = time.Second
+	// DefaultCandleIntervals are the intervals candles are built for when
+	// none are supplied
+	DefaultCandleIntervals = "1m"
+)
+
+// candleManager builds live candles from websocket trades and forwards
+// exchange native candles to the kline service
+type candleManager struct {
+	started   int32
+	stopped   int32
+	shutdown  chan struct{}
+	intervals []kline.Interval
+	builders  map[string]*kline.Builder
+	native    map[string]bool
+	m         sync.Mutex
+}
+
+func (c *candleManager) Started() bool {
+	return atomic.LoadInt32(&c.started) == 1
+}
+
+func (c *candleManager) Start() (err error) {
+	if atomic.AddInt32(&c.started, 1) != 1 {
+		return errors.New("candle manager already started")
+	}
+
+	defer func() {
+		if err != nil {
+			atomic.CompareAndSwapInt32(&c.started, 1, 0)
+		}
+	}()
+
+	log.Debugln(log.CandleMgr, "Candle manager starting...")
+	intervals := Bot.Settings.CandleIntervals
+	if intervals == "" {
+		intervals = DefaultCandleIntervals
+	}
+	c.intervals, err = parseCandleIntervals(intervals)
+	if err != nil {
+		return err
+	}
+
+	c.m.Lock()
+	c.builders = make(map[string]*kline.Builder)
+	c.native = make(map[string]bool)
+	c.m.Unlock()
+
+	c.shutdown = make(chan struct{})
+	go c.run()
+	return nil
+}
+
+func (c *candleManager) Stop() error {
+	if atomic.LoadInt32(&c.started) == 0 {
+		return errors.New("candle manager not started")
+	}
+
+	if atomic.AddInt32(&c.stopped, 1) != 1 {
+		return errors.New("candle manager is already stopped")
+	}
+
+	log.Debugln(log.CandleMgr, "Candle manager shutting down...")
+	close(c.shutdown)
+	return nil
+}
+
+func (c *candleManager) run() {
+	log.Debugf(log.CandleMgr, "Candle manager started. Intervals: %v\n", c.intervals)
+	Bot.ServicesWG.Add(1)
+	tick := time.NewTicker(CandleCloseDelay)
+	defer func() {
+		tick.Stop()
+		atomic.CompareAndSwapInt32(&c.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&c.started, 1, 0)
+		Bot.ServicesWG.Done()
+		log.Debugln(log.CandleMgr, "Candle manager shutdown.")
+	}()
+
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case t := <-tick.C:
+			c.closeElapsed(t)
+		}
+	}
+}
+
+// ProcessTrade folds a websocket trade into a candle for each configured
+// interval and publishes the resulting candles
+func (c *candleManager) ProcessTrade(t *stream.TradeData) error {
+	if t == nil {
+		return errors.New("candle manager: trade is nil")
+	}
+
+	ts := t.Timestamp
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+
+	c.m.Lock()
+	var candles []kline.Live
+	var errs []string
+	for i := range c.intervals {
+		key := candleKey(t.Exchange, t.CurrencyPair.String(), t.AssetType.String(), c.intervals[i])
+		if c.native[key] {
+			continue
+		}
+		b, ok := c.builders[key]
+		if !ok {
+			var err error
+			b, err = kline.NewBuilder(t.Exchange, t.CurrencyPair, t.AssetType, c.intervals[i])
+			if err != nil {
+				c.m.Unlock()
+				return err
+			}
+			c.builders[key] = b
+		}
+		// Some exchanges sign trade amounts by side
+		l, err := b.AddTrade(t.Price, math.Abs(t.Amount), ts)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		candles = append(candles, l...)
+	}
+	c.m.Unlock()
+
+	publishCandles(candles)
+	if errs != nil {
+		return fmt.Errorf("candle manager: %s %s %s trade not processed: %s",
+			t.Exchange,
+			t.CurrencyPair,
+			t.AssetType,
+			strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// ProcessKline forwards an exchange native candle to the kline service. Trades
+// are no longer aggregated for an interval once native candles are received
+// for it
+func (c *candleManager) ProcessKline(k *stream.KlineData) error {
+	if k == nil {
+		return errors.New("candle manager: kline is nil")
+	}
+
+	interval, err := klineDataInterval(k)
+	if err != nil {
+		return err
+	}
+
+	key := candleKey(k.Exchange, k.Pair.String(), k.AssetType.String(), interval)
+	c.m.Lock()
+	c.native[key] = true
+	delete(c.builders, key)
+	c.m.Unlock()
+
+	return kline.ProcessLive(&kline.Live{
+		Exchange: k.Exchange,
+		Pair:     k.Pair,
+		Asset:    k.AssetType,
+		Interval: interval,
+		Candle: kline.Candle{
+			Time:   k.StartTime,
+			Open:   k.OpenPrice,
+			High:   k.HighPrice,
+			Low:    k.LowPrice,
+			Close:  k.ClosePrice,
+			Volume: k.Volume,
+		},
+		Closed: !k.CloseTime.IsZero() && !k.Timestamp.Before(k.CloseTime),
+	})
+}
+
+// closeElapsed closes and publishes candles whose period has elapsed
+func (c *candleManager) closeElapsed(t time.Time) {
+	c.m.Lock()
+	var candles []kline.Live
+	for _, b := range c.builders {
+		candles = append(candles, b.Close(t)...)
+	}
+	c.m.Unlock()
+	publishCandles(candles)
+}
+
+func publishCandles(candles []kline.Live) {
+	for i := range candles {
+		err := kline.ProcessLive(&candles[i])
+		if err != nil {
+			log.Errorf(log.CandleMgr, "Candle manager unable to publish candle: %v\n", err)
+		}
+	}
+}
+
+func candleKey(exchange, pair, a string, i kline.Interval) string {
+	return strings.ToLower(exchange) + "|" +
+		strings.ToUpper(pair) + "|" +
+		strings.ToLower(a) + "|" +
+		i.String()
+}
+
+// klineDataInterval determines the interval of an exchange native candle from
+// its interval string, falling back to its start and close times
+func klineDataInterval(k *stream.KlineData) (kline.Interval, error) {
+	d, err := time.ParseDuration(k.Interval)
+	if err == nil && d > 0 {
+		return kline.Interval(d), nil
+	}
+	if !k.StartTime.IsZero() && k.CloseTime.After(k.StartTime) {
+		return kline.Interval(k.CloseTime.Sub(k.StartTime).Round(time.Second)), nil
+	}
+	return 0, fmt.Errorf("candle manager: %s %s %s unable to determine kline interval %q",
+		k.Exchange,
+		k.Pair,
+		k.AssetType,
+		k.Interval)
+}
+
+// parseCandleIntervals parses a comma separated list of durations
+func parseCandleIntervals(intervals string) ([]kline.Interval, error) {
+	var resp []kline.Interval
+	for _, s := range strings.Split(intervals, ",") {
+		d, err := time.ParseDuration(strings.TrimSpace(s))
+		if err != nil {
+			return nil, fmt.Errorf("invalid candle interval %q: %v", s, err)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("invalid candle interval %q", s)
+		}
+		resp = append(resp, kline.Interval(d))
+	}
+	return resp, nil
+}
@@ -0,0 +1,220 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/kline"
+	"github.com/yurulab/gocryptotrader/exchanges/stream"
+)
+
+func newTestCandleManager(t *testing.T, intervals string) *candleManager {
+	t.Helper()
+	i, err := parseCandleIntervals(intervals)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &candleManager{
+		intervals: i,
+		builders:  make(map[string]*kline.Builder),
+		native:    make(map[string]bool),
+	}
+}
+
+func TestCandleManagerStartStop(t *testing.T) {
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+
+	var c candleManager
+	if c.Started() {
+		t.Error("candle manager should not be started")
+	}
+
+	if err := c.Stop(); err == nil {
+		t.Error("expected error stopping an unstarted candle manager")
+	}
+
+	if err := c.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !c.Started() {
+		t.Error("candle manager should be started")
+	}
+
+	if err := c.Start(); err == nil {
+		t.Error("expected error starting a started candle manager")
+	}
+
+	if err := c.Stop(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParseCandleIntervals(t *testing.T) {
+	t.Parallel()
+	i, err := parseCandleIntervals("1m, 5m,1h")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(i) != 3 || i[0] != kline.OneMin || i[1] != kline.FiveMin || i[2] != kline.OneHour {
+		t.Errorf("unexpected intervals %v", i)
+	}
+
+	_, err = parseCandleIntervals("1m,meow")
+	if err == nil {
+		t.Error("expected error parsing invalid interval")
+	}
+
+	_, err = parseCandleIntervals("-1m")
+	if err == nil {
+		t.Error("expected error parsing negative interval")
+	}
+}
+
+func TestKlineDataInterval(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	i, err := klineDataInterval(&stream.KlineData{Interval: "5m"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i != kline.FiveMin {
+		t.Errorf("expected %v received %v", kline.FiveMin, i)
+	}
+
+	i, err = klineDataInterval(&stream.KlineData{
+		Interval:  "1min",
+		StartTime: start,
+		CloseTime: start.Add(time.Minute - time.Millisecond),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i != kline.OneMin {
+		t.Errorf("expected %v received %v", kline.OneMin, i)
+	}
+
+	_, err = klineDataInterval(&stream.KlineData{Interval: "1min"})
+	if err == nil {
+		t.Error("expected error when interval cannot be determined")
+	}
+}
+
+func TestCandleManagerProcessTrade(t *testing.T) {
+	t.Parallel()
+	c := newTestCandleManager(t, "1m")
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	start := time.Now().Truncate(time.Minute)
+
+	if err := c.ProcessTrade(nil); err == nil {
+		t.Error("expected error processing nil trade")
+	}
+
+	trade := stream.TradeData{
+		Exchange:     "candletrades",
+		CurrencyPair: p,
+		AssetType:    asset.Spot,
+		Timestamp:    start.Add(time.Second),
+		Price:        100,
+		Amount:       -2,
+	}
+	if err := c.ProcessTrade(&trade); err != nil {
+		t.Fatal(err)
+	}
+
+	trade.Timestamp = start.Add(2 * time.Second)
+	trade.Price = 110
+	trade.Amount = 1
+	if err := c.ProcessTrade(&trade); err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := kline.GetLiveCandle("candletrades", p, asset.Spot, kline.OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Closed {
+		t.Error("candle should not be closed")
+	}
+	if l.Candle.Open != 100 || l.Candle.High != 110 || l.Candle.Low != 100 ||
+		l.Candle.Close != 110 || l.Candle.Volume != 3 {
+		t.Errorf("unexpected candle %+v", l.Candle)
+	}
+
+	trade.Price = 0
+	if err := c.ProcessTrade(&trade); err == nil {
+		t.Error("expected error processing trade without a price")
+	}
+
+	c.closeElapsed(start.Add(time.Minute))
+	l, err = kline.GetLiveCandle("candletrades", p, asset.Spot, kline.OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !l.Closed || !l.Candle.Time.Equal(start) {
+		t.Errorf("expected closed candle starting %v received %+v", start, l)
+	}
+}
+
+func TestCandleManagerProcessKline(t *testing.T) {
+	t.Parallel()
+	c := newTestCandleManager(t, "1m")
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	start := time.Now().Truncate(time.Minute)
+
+	if err := c.ProcessKline(nil); err == nil {
+		t.Error("expected error processing nil kline")
+	}
+
+	k := stream.KlineData{
+		Exchange:   "candlekline",
+		Pair:       p,
+		AssetType:  asset.Spot,
+		Timestamp:  start.Add(time.Minute),
+		StartTime:  start,
+		CloseTime:  start.Add(time.Minute),
+		Interval:   "1m",
+		OpenPrice:  1,
+		ClosePrice: 2,
+		HighPrice:  3,
+		LowPrice:   0.5,
+		Volume:     10,
+	}
+	if err := c.ProcessKline(&k); err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := kline.GetLiveCandle("candlekline", p, asset.Spot, kline.OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !l.Closed || l.Candle.Close != 2 || l.Candle.Volume != 10 {
+		t.Errorf("unexpected candle %+v", l)
+	}
+
+	// Trades are ignored once native candles are received for the interval
+	err = c.ProcessTrade(&stream.TradeData{
+		Exchange:     "candlekline",
+		CurrencyPair: p,
+		AssetType:    asset.Spot,
+		Timestamp:    start.Add(time.Minute + time.Second),
+		Price:        1337,
+		Amount:       1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l, err = kline.GetLiveCandle("candlekline", p, asset.Spot, kline.OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Candle.Close != 2 {
+		t.Errorf("trade should not update a native candle %+v", l)
+	}
+}
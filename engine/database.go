@@ -3,24 +3,36 @@ package engine
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sync/atomic"
 	"time"
 
 	"github.com/yurulab/gocryptotrader/database"
+	dbmysql "github.com/yurulab/gocryptotrader/database/drivers/mysql"
 	dbpsql "github.com/yurulab/gocryptotrader/database/drivers/postgres"
 	dbsqlite3 "github.com/yurulab/gocryptotrader/database/drivers/sqlite3"
+	"github.com/yurulab/gocryptotrader/database/repository/migration"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
 	"github.com/yurulab/gocryptotrader/log"
 	"github.com/thrasher-corp/sqlboiler/boil"
 )
 
 var (
 	dbConn *database.Instance
+
+	// DefaultMigrationDir is the folder database migrations are read from,
+	// relative to the binary or config file
+	DefaultMigrationDir = filepath.Join("database", "migrations")
 )
 
 type databaseManager struct {
-	started  int32
-	stopped  int32
-	shutdown chan struct{}
+	started     int32
+	stopped     int32
+	shutdown    chan struct{}
+	journal     *requestJournal
+	checkpoints *auditCheckpointer
+	retention   *retentionManager
 }
 
 func (a *databaseManager) Started() bool {
@@ -57,16 +69,56 @@ func (a *databaseManager) Start() (err error) {
 				Bot.Config.Database.Database,
 				Bot.Config.Database.Driver)
 			dbConn, err = dbsqlite3.Connect()
+		} else if Bot.Config.Database.Driver == database.DBMySQL {
+			log.Debugf(log.DatabaseMgr,
+				"Attempting to establish database connection to host %s/%s utilising %s driver\n",
+				Bot.Config.Database.Host,
+				Bot.Config.Database.Database,
+				Bot.Config.Database.Driver)
+			dbConn, err = dbmysql.Connect()
 		}
 		if err != nil {
 			return fmt.Errorf("database failed to connect: %v Some features that utilise a database will be unavailable", err)
 		}
 		dbConn.Connected = true
 
-		DBLogger := database.Logger{}
+		if err = checkSchema(Bot.Settings.MigrationDir,
+			Bot.Settings.MigrateDatabase,
+			Bot.Settings.AllowUnverifiedSchema); err != nil {
+			if cErr := dbConn.SQL.Close(); cErr != nil {
+				log.Errorf(log.DatabaseMgr, "Failed to close database: %v", cErr)
+			}
+			return err
+		}
+
 		if Bot.Config.Database.Verbose {
 			boil.DebugMode = true
-			boil.DebugWriter = DBLogger
+			boil.DebugWriter = database.Logger{}
+		}
+
+		if Bot.Config.Database.RequestJournal {
+			a.journal = newRequestJournal()
+			go a.journal.run(a.shutdown)
+			request.SetJournal(a.journal)
+			log.Debugln(log.DatabaseMgr, "Request journal enabled.")
+		}
+
+		if cfg := Bot.Config.Database.AuditCheckpoint; cfg.Enabled {
+			a.checkpoints = newAuditCheckpointer(cfg.Path, cfg.Key, cfg.Interval)
+			go a.checkpoints.run(a.shutdown)
+			log.Debugf(log.DatabaseMgr,
+				"Audit checkpoints exported to %s every %v.\n",
+				cfg.Path,
+				cfg.Interval)
+		}
+
+		if cfg := &Bot.Config.Database.Retention; cfg.Enabled {
+			a.retention = newRetentionManager(cfg, a.checkpoints)
+			go a.retention.run(a.shutdown)
+			log.Debugf(log.DatabaseMgr,
+				"Retention policies applied every %v, archiving to %s.\n",
+				cfg.Interval,
+				cfg.ArchivePath)
 		}
 
 		go a.run()
@@ -76,6 +128,72 @@ func (a *databaseManager) Start() (err error) {
 	return errors.New("database support disabled")
 }
 
+// defaultMigrationDir returns the migrations folder alongside the binary or,
+// failing that, the config file. The working directory is used when neither
+// exists so the engine can still be run from the repository root
+func defaultMigrationDir(configFile string) string {
+	var dirs []string
+	if exe, err := os.Executable(); err == nil {
+		dirs = append(dirs, filepath.Join(filepath.Dir(exe), DefaultMigrationDir))
+	}
+	if configFile != "" {
+		dirs = append(dirs, filepath.Join(filepath.Dir(configFile), DefaultMigrationDir))
+	}
+	for i := range dirs {
+		if _, err := os.Stat(dirs[i]); err == nil {
+			return dirs[i]
+		}
+	}
+	return DefaultMigrationDir
+}
+
+// checkSchema compares the database schema version against the latest
+// migration in dir, applying pending migrations first if migrate is set. A
+// schema version which cannot be verified stops the engine unless
+// allowUnverified is set and no migrations were requested
+func checkSchema(dir string, migrate, allowUnverified bool) error {
+	if _, err := os.Stat(dir); err != nil {
+		return schemaUnverified(
+			fmt.Errorf("unable to read database migrations from %s: %v", dir, err),
+			migrate,
+			allowUnverified)
+	}
+
+	status, err := migration.GetStatus(dir)
+	if err != nil {
+		return schemaUnverified(
+			fmt.Errorf("unable to verify database schema version: %v", err),
+			migrate,
+			allowUnverified)
+	}
+
+	if pending := status.Pending(); len(pending) > 0 && migrate {
+		log.Infof(log.DatabaseMgr, "Applying %d pending database migrations.\n", len(pending))
+		if _, err = migration.Up(dir); err != nil {
+			return fmt.Errorf("database migration failed: %v", err)
+		}
+		if status, err = migration.GetStatus(dir); err != nil {
+			return fmt.Errorf("unable to verify database schema version: %v", err)
+		}
+	}
+
+	if err = status.Check(); err != nil {
+		return err
+	}
+	log.Debugf(log.DatabaseMgr, "Database schema version %d is up to date.\n", status.Version)
+	return nil
+}
+
+// schemaUnverified returns err, logging it as a warning instead when an
+// unverified schema is allowed and no migrations were requested
+func schemaUnverified(err error, migrate, allowUnverified bool) error {
+	if migrate || !allowUnverified {
+		return err
+	}
+	log.Warnf(log.DatabaseMgr, "Skipping database schema version check: %v\n", err)
+	return nil
+}
+
 func (a *databaseManager) Stop() error {
 	if atomic.LoadInt32(&a.started) == 0 {
 		return errors.New("database manager not started")
@@ -85,6 +203,21 @@ func (a *databaseManager) Stop() error {
 		return errors.New("database manager is already stopping")
 	}
 
+	if a.journal != nil {
+		request.SetJournal(nil)
+		a.journal.flush()
+		a.journal = nil
+	}
+
+	if a.checkpoints != nil {
+		if err := a.checkpoints.checkpoint(); err != nil {
+			log.Errorf(log.DatabaseMgr, "Audit checkpoint failed: %v\n", err)
+		}
+		a.checkpoints = nil
+	}
+
+	a.retention = nil
+
 	err := dbConn.SQL.Close()
 	if err != nil {
 		log.Errorf(log.DatabaseMgr, "Failed to close database: %v", err)
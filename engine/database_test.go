@@ -0,0 +1,42 @@
+package engine
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckSchemaMissingMigrationDir(t *testing.T) {
+	t.Parallel()
+	dir := filepath.Join(os.TempDir(), "gct-missing-migrations")
+	if err := checkSchema(dir, false, false); err == nil {
+		t.Error("expected missing migration directory to stop the engine")
+	}
+	if err := checkSchema(dir, true, true); err == nil {
+		t.Error("expected missing migration directory to stop the engine when migrating")
+	}
+	if err := checkSchema(dir, false, true); err != nil {
+		t.Errorf("expected unverified schema to be allowed, received %v", err)
+	}
+}
+
+func TestDefaultMigrationDir(t *testing.T) {
+	t.Parallel()
+	if dir := defaultMigrationDir(""); dir != DefaultMigrationDir {
+		t.Errorf("expected working directory fallback, received %s", dir)
+	}
+
+	cfgDir, err := ioutil.TempDir("", "gct-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(cfgDir)
+	migrations := filepath.Join(cfgDir, DefaultMigrationDir)
+	if err = os.MkdirAll(migrations, 0770); err != nil {
+		t.Fatal(err)
+	}
+	if dir := defaultMigrationDir(filepath.Join(cfgDir, "config.json")); dir != migrations {
+		t.Errorf("expected migrations alongside the config file, received %s", dir)
+	}
+}
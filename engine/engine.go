@@ -15,7 +15,9 @@ import (
 	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/currency"
 	"github.com/yurulab/gocryptotrader/currency/coinmarketcap"
+	"github.com/yurulab/gocryptotrader/database/repository/migration"
 	"github.com/yurulab/gocryptotrader/dispatch"
+	"github.com/yurulab/gocryptotrader/exchanges/nonce"
 	"github.com/yurulab/gocryptotrader/exchanges/request"
 	gctscript "github.com/yurulab/gocryptotrader/gctscript/vm"
 	gctlog "github.com/yurulab/gocryptotrader/log"
@@ -37,8 +39,14 @@ type Engine struct {
 	OrderManager                orderManager
 	PortfolioManager            portfolioManager
 	CommsManager                commsManager
+	CandleManager               candleManager
+	OrderbookRecorder           orderbookRecorder
+	TickerHistory               tickerHistory
+	BalanceSnapshots            balanceSnapshotter
+	FundingSync                 fundingSyncer
 	exchangeManager             exchangeManager
 	DepositAddressManager       *DepositAddressManager
+	nonceStore                  nonce.Store
 	Settings                    Settings
 	Uptime                      time.Time
 	ServicesWG                  sync.WaitGroup
@@ -118,6 +126,12 @@ func ValidateSettings(b *Engine, s *Settings) {
 	b.Settings.EnableAllPairs = s.EnableAllPairs
 	b.Settings.EnableCoinmarketcapAnalysis = s.EnableCoinmarketcapAnalysis
 	b.Settings.EnableDatabaseManager = s.EnableDatabaseManager
+	b.Settings.MigrateDatabase = s.MigrateDatabase
+	b.Settings.AllowUnverifiedSchema = s.AllowUnverifiedSchema
+	b.Settings.MigrationDir = s.MigrationDir
+	if b.Settings.MigrationDir == "" {
+		b.Settings.MigrationDir = defaultMigrationDir(b.Settings.ConfigFile)
+	}
 	b.Settings.EnableGCTScriptManager = s.EnableGCTScriptManager
 	b.Settings.MaxVirtualMachines = s.MaxVirtualMachines
 	b.Settings.EnableDispatcher = s.EnableDispatcher
@@ -155,6 +169,12 @@ func ValidateSettings(b *Engine, s *Settings) {
 		b.Settings.EnableDeprecatedRPC = b.Config.RemoteControl.DeprecatedRPC.Enabled
 	}
 
+	if flagSet["metrics"] {
+		b.Settings.EnableMetrics = s.EnableMetrics
+	} else {
+		b.Settings.EnableMetrics = b.Config.RemoteControl.Metrics.Enabled
+	}
+
 	if flagSet["gctscriptmanager"] {
 		gctscript.GCTScriptConfig.Enabled = s.EnableGCTScriptManager
 	}
@@ -185,6 +205,7 @@ func ValidateSettings(b *Engine, s *Settings) {
 	b.Settings.EnableTickerSyncing = s.EnableTickerSyncing
 	b.Settings.EnableOrderbookSyncing = s.EnableOrderbookSyncing
 	b.Settings.EnableTradeSyncing = s.EnableTradeSyncing
+	b.Settings.EnableDerivativeSyncing = s.EnableDerivativeSyncing
 	b.Settings.SyncWorkers = s.SyncWorkers
 	b.Settings.SyncTimeout = s.SyncTimeout
 	b.Settings.SyncContinuously = s.SyncContinuously
@@ -198,6 +219,8 @@ func ValidateSettings(b *Engine, s *Settings) {
 	b.Settings.DisableExchangeAutoPairUpdates = s.DisableExchangeAutoPairUpdates
 	b.Settings.ExchangePurgeCredentials = s.ExchangePurgeCredentials
 	b.Settings.EnableWebsocketRoutine = s.EnableWebsocketRoutine
+	b.Settings.EnableCandleManager = s.EnableCandleManager
+	b.Settings.CandleIntervals = s.CandleIntervals
 
 	// Checks if the flag values are different from the defaults
 	b.Settings.MaxHTTPRequestJobsLimit = s.MaxHTTPRequestJobsLimit
@@ -254,6 +277,7 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Enable gRPC Proxy: %v", s.EnableGRPCProxy)
 	gctlog.Debugf(gctlog.Global, "\t Enable websocket RPC: %v", s.EnableWebsocketRPC)
 	gctlog.Debugf(gctlog.Global, "\t Enable deprecated RPC: %v", s.EnableDeprecatedRPC)
+	gctlog.Debugf(gctlog.Global, "\t Enable metrics exporter: %v", s.EnableMetrics)
 	gctlog.Debugf(gctlog.Global, "\t Enable comms relayer: %v", s.EnableCommsRelayer)
 	gctlog.Debugf(gctlog.Global, "\t Enable event manager: %v", s.EnableEventManager)
 	gctlog.Debugf(gctlog.Global, "\t Event manager sleep delay: %v", s.EventManagerDelay)
@@ -261,8 +285,13 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Enable exchange sync manager: %v", s.EnableExchangeSyncManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable deposit address manager: %v\n", s.EnableDepositAddressManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable websocket routine: %v\n", s.EnableWebsocketRoutine)
+	gctlog.Debugf(gctlog.Global, "\t Enable candle manager: %v", s.EnableCandleManager)
+	gctlog.Debugf(gctlog.Global, "\t Candle manager intervals: %s", s.CandleIntervals)
 	gctlog.Debugf(gctlog.Global, "\t Enable NTP client: %v", s.EnableNTPClient)
 	gctlog.Debugf(gctlog.Global, "\t Enable Database manager: %v", s.EnableDatabaseManager)
+	gctlog.Debugf(gctlog.Global, "\t Migrate database: %v", s.MigrateDatabase)
+	gctlog.Debugf(gctlog.Global, "\t Database migration directory: %s", s.MigrationDir)
+	gctlog.Debugf(gctlog.Global, "\t Allow unverified database schema: %v", s.AllowUnverifiedSchema)
 	gctlog.Debugf(gctlog.Global, "\t Enable dispatcher: %v", s.EnableDispatcher)
 	gctlog.Debugf(gctlog.Global, "\t Dispatch package max worker amount: %d", s.DispatchMaxWorkerAmount)
 	gctlog.Debugf(gctlog.Global, "\t Dispatch package jobs limit: %d", s.DispatchJobsLimit)
@@ -272,6 +301,7 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Enable ticker syncing: %v\n", s.EnableTickerSyncing)
 	gctlog.Debugf(gctlog.Global, "\t Enable orderbook syncing: %v\n", s.EnableOrderbookSyncing)
 	gctlog.Debugf(gctlog.Global, "\t Enable trade syncing: %v\n", s.EnableTradeSyncing)
+	gctlog.Debugf(gctlog.Global, "\t Enable derivative syncing: %v\n", s.EnableDerivativeSyncing)
 	gctlog.Debugf(gctlog.Global, "\t Exchange sync timeout: %v\n", s.SyncTimeout)
 	gctlog.Debugf(gctlog.Global, "- FOREX SETTINGS:")
 	gctlog.Debugf(gctlog.Global, "\t Enable currency conveter: %v", s.EnableCurrencyConverter)
@@ -310,6 +340,9 @@ func (e *Engine) Start() error {
 
 	if e.Settings.EnableDatabaseManager {
 		if err := e.DatabaseManager.Start(); err != nil {
+			if _, ok := err.(*migration.VersionError); ok {
+				return err
+			}
 			gctlog.Errorf(gctlog.Global, "Database manager unable to start: %v", err)
 		}
 	}
@@ -358,6 +391,8 @@ func (e *Engine) Start() error {
 		e.Config.PurgeExchangeAPICredentials()
 	}
 
+	e.nonceStore = setupNonceStore()
+
 	gctlog.Debugln(gctlog.Global, "Setting up exchanges..")
 	SetupExchanges()
 	if Bot.exchangeManager.Len() == 0 {
@@ -403,6 +438,10 @@ func (e *Engine) Start() error {
 		StartWebsocketHandler()
 	}
 
+	if e.Settings.EnableMetrics {
+		go StartMetricsServer()
+	}
+
 	if e.Settings.EnablePortfolioManager {
 		if err = e.PortfolioManager.Start(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Fund manager unable to start: %v", err)
@@ -425,6 +464,7 @@ func (e *Engine) Start() error {
 			SyncTicker:       e.Settings.EnableTickerSyncing,
 			SyncOrderbook:    e.Settings.EnableOrderbookSyncing,
 			SyncTrades:       e.Settings.EnableTradeSyncing,
+			SyncDerivatives:  e.Settings.EnableDerivativeSyncing,
 			SyncContinuously: e.Settings.SyncContinuously,
 			NumWorkers:       e.Settings.SyncWorkers,
 			Verbose:          e.Settings.Verbose,
@@ -443,6 +483,36 @@ func (e *Engine) Start() error {
 		go EventManger()
 	}
 
+	if e.Settings.EnableCandleManager {
+		if err = e.CandleManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Candle manager unable to start: %v", err)
+		}
+	}
+
+	if e.Config.TickerHistory.Enabled {
+		if err = e.TickerHistory.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Ticker history unable to start: %v", err)
+		}
+	}
+
+	if e.Config.BalanceSnapshots.Enabled {
+		if err = e.BalanceSnapshots.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Balance snapshots unable to start: %v", err)
+		}
+	}
+
+	if e.Config.FundingSync.Enabled {
+		if err = e.FundingSync.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Funding sync unable to start: %v", err)
+		}
+	}
+
+	if e.Config.OrderbookRecorder.Enabled {
+		if err = e.OrderbookRecorder.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Orderbook recorder unable to start: %v", err)
+		}
+	}
+
 	if e.Settings.EnableWebsocketRoutine {
 		go WebsocketRoutine()
 	}
@@ -471,6 +541,31 @@ func (e *Engine) Stop() {
 			gctlog.Errorf(gctlog.Global, "GCTScript manager unable to stop. Error: %v", err)
 		}
 	}
+	if e.CandleManager.Started() {
+		if err := e.CandleManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Candle manager unable to stop. Error: %v", err)
+		}
+	}
+	if e.TickerHistory.Started() {
+		if err := e.TickerHistory.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Ticker history unable to stop. Error: %v", err)
+		}
+	}
+	if e.BalanceSnapshots.Started() {
+		if err := e.BalanceSnapshots.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Balance snapshots unable to stop. Error: %v", err)
+		}
+	}
+	if e.FundingSync.Started() {
+		if err := e.FundingSync.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Funding sync unable to stop. Error: %v", err)
+		}
+	}
+	if e.OrderbookRecorder.Started() {
+		if err := e.OrderbookRecorder.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Orderbook recorder unable to stop. Error: %v", err)
+		}
+	}
 	if e.OrderManager.Started() {
 		if err := e.OrderManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to stop. Error: %v", err)
@@ -22,6 +22,7 @@ type Settings struct {
 	EnableGRPCProxy             bool
 	EnableWebsocketRPC          bool
 	EnableDeprecatedRPC         bool
+	EnableMetrics               bool
 	EnableCommsRelayer          bool
 	EnableExchangeSyncManager   bool
 	EnableDepositAddressManager bool
@@ -29,19 +30,23 @@ type Settings struct {
 	EnableOrderManager          bool
 	EnableConnectivityMonitor   bool
 	EnableDatabaseManager       bool
+	MigrateDatabase             bool
+	AllowUnverifiedSchema       bool
 	EnableGCTScriptManager      bool
 	EnableNTPClient             bool
 	EnableWebsocketRoutine      bool
+	EnableCandleManager         bool
 	EventManagerDelay           time.Duration
 	Verbose                     bool
 
 	// Exchange syncer settings
-	EnableTickerSyncing    bool
-	EnableOrderbookSyncing bool
-	EnableTradeSyncing     bool
-	SyncWorkers            int
-	SyncContinuously       bool
-	SyncTimeout            time.Duration
+	EnableTickerSyncing     bool
+	EnableOrderbookSyncing  bool
+	EnableTradeSyncing      bool
+	EnableDerivativeSyncing bool
+	SyncWorkers             int
+	SyncContinuously        bool
+	SyncTimeout             time.Duration
 
 	// Forex settings
 	EnableCurrencyConverter bool
@@ -76,6 +81,9 @@ type Settings struct {
 	DispatchMaxWorkerAmount int
 	DispatchJobsLimit       int
 
+	// Candle manager settings
+	CandleIntervals string
+
 	// GCTscript settings
 	MaxVirtualMachines uint
 
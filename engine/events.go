@@ -10,6 +10,7 @@ import (
 	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/currency"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/kline"
 	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
 	"github.com/yurulab/gocryptotrader/exchanges/ticker"
 	"github.com/yurulab/gocryptotrader/log"
@@ -19,8 +20,9 @@ import (
 
 // Event const vars
 const (
-	ItemPrice     = "PRICE"
-	ItemOrderbook = "ORDERBOOK"
+	ItemPrice       = "PRICE"
+	ItemOrderbook   = "ORDERBOOK"
+	ItemCandleClose = "CANDLE_CLOSE"
 
 	ConditionGreaterThan        = ">"
 	ConditionGreaterThanOrEqual = ">="
@@ -52,6 +54,8 @@ type EventConditionParams struct {
 	CheckBids        bool
 	CheckBidsAndAsks bool
 	OrderbookAmount  float64
+
+	CandleInterval kline.Interval
 }
 
 // Event struct holds the event variables
@@ -164,6 +168,21 @@ func (e *Event) processTicker() bool {
 	return e.processCondition(t.Last, e.Condition.Price)
 }
 
+func (e *Event) processCandle() bool {
+	l, err := kline.GetLiveCandle(e.Exchange, e.Pair, e.Asset, e.Condition.CandleInterval)
+	if err != nil {
+		if Bot.Settings.Verbose {
+			log.Debugf(log.EventMgr, "Events: failed to get live candle. Err: %s\n", err)
+		}
+		return false
+	}
+
+	if !l.Closed {
+		return false
+	}
+	return e.processCondition(l.Candle.Close, e.Condition.Price)
+}
+
 func (e *Event) processCondition(actual, threshold float64) bool {
 	switch e.Condition.Condition {
 	case ConditionGreaterThan:
@@ -227,8 +246,11 @@ func (e *Event) processOrderbook() bool {
 // CheckEventCondition will check the event structure to see if there is a condition
 // met
 func (e *Event) CheckEventCondition() bool {
-	if e.Item == ItemPrice {
+	switch e.Item {
+	case ItemPrice:
 		return e.processTicker()
+	case ItemCandleClose:
+		return e.processCandle()
 	}
 	return e.processOrderbook()
 }
@@ -263,6 +285,12 @@ func IsValidEvent(exchange, item string, condition EventConditionParams, action
 		}
 	}
 
+	if item == ItemCandleClose {
+		if condition.Price <= 0 || condition.CandleInterval <= 0 {
+			return errInvalidCondition
+		}
+	}
+
 	if strings.Contains(action, ",") {
 		a := strings.Split(action, ",")
 
@@ -340,7 +368,7 @@ func IsValidAction(action string) bool {
 func IsValidItem(item string) bool {
 	item = strings.ToUpper(item)
 	switch item {
-	case ItemPrice, ItemOrderbook:
+	case ItemPrice, ItemOrderbook, ItemCandleClose:
 		return true
 	}
 	return false
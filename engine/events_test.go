@@ -2,9 +2,11 @@ package engine
 
 import (
 	"testing"
+	"time"
 
 	"github.com/yurulab/gocryptotrader/currency"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/kline"
 	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
 	"github.com/yurulab/gocryptotrader/exchanges/ticker"
 )
@@ -115,7 +117,7 @@ func TestString(t *testing.T) {
 		Action: "SMS,ALL",
 	}
 
-	if r := e.String(); r != "If the BTCUSD [SPOT] PRICE on Bitstamp meets the following {> 1 false false 0} then SMS,ALL." {
+	if r := e.String(); r != "If the BTCUSD [SPOT] PRICE on Bitstamp meets the following {> 1 false false 0 0s} then SMS,ALL." {
 		t.Error("unexpected result")
 	}
 }
@@ -159,6 +161,54 @@ func TestProcessTicker(t *testing.T) {
 	}
 }
 
+func TestProcessCandle(t *testing.T) {
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+	Bot.Settings.Verbose = true
+
+	e := Event{
+		Exchange: "candleevents",
+		Item:     ItemCandleClose,
+		Pair:     currency.NewPair(currency.BTC, currency.USD),
+		Asset:    asset.Spot,
+		Condition: EventConditionParams{
+			Condition:      ConditionGreaterThan,
+			Price:          1,
+			CandleInterval: kline.OneMin,
+		},
+	}
+	if r := e.processCandle(); r {
+		t.Error("unexpected result")
+	}
+
+	l := kline.Live{
+		Exchange: e.Exchange,
+		Pair:     e.Pair,
+		Asset:    e.Asset,
+		Interval: kline.OneMin,
+		Candle: kline.Candle{
+			Time:  time.Now().Truncate(time.Minute),
+			Close: 1337,
+		},
+	}
+	if err := kline.ProcessLive(&l); err != nil {
+		t.Fatal(err)
+	}
+	// candle has not closed yet
+	if r := e.processCandle(); r {
+		t.Error("unexpected result")
+	}
+
+	l.Closed = true
+	if err := kline.ProcessLive(&l); err != nil {
+		t.Fatal(err)
+	}
+	if r := e.CheckEventCondition(); !r {
+		t.Error("unexpected result")
+	}
+}
+
 func TestProcessCondition(t *testing.T) {
 	t.Parallel()
 	var e Event
@@ -286,6 +336,17 @@ func TestIsValidEvent(t *testing.T) {
 	if err := IsValidEvent(testExchange, ItemOrderbook, c, "SMS,test"); err != nil {
 		t.Error("unexpected result:", err)
 	}
+
+	// candle close event without an interval
+	c.Price = 1337
+	if err := IsValidEvent(testExchange, ItemCandleClose, c, ActionTest); err != errInvalidCondition {
+		t.Error("unexpected result:", err)
+	}
+
+	c.CandleInterval = kline.OneMin
+	if err := IsValidEvent(testExchange, ItemCandleClose, c, ActionTest); err != nil {
+		t.Error("unexpected result:", err)
+	}
 }
 
 func TestIsValidExchange(t *testing.T) {
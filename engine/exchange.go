@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"sync"
@@ -34,6 +35,7 @@ import (
 	"github.com/yurulab/gocryptotrader/exchanges/okcoin"
 	"github.com/yurulab/gocryptotrader/exchanges/okex"
 	"github.com/yurulab/gocryptotrader/exchanges/poloniex"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
 	"github.com/yurulab/gocryptotrader/exchanges/yobit"
 	"github.com/yurulab/gocryptotrader/exchanges/zb"
 	"github.com/yurulab/gocryptotrader/log"
@@ -129,15 +131,33 @@ func (e *exchangeManager) unloadExchange(exchangeName string) error {
 		return err
 	}
 
+	exch := e.getExchangeByName(exchangeName)
 	err = e.removeExchange(exchangeName)
 	if err != nil {
 		return err
 	}
 
+	// Abort any requests still in flight or waiting on the rate limiter
+	if b := exch.GetBase(); b != nil && b.Requester != nil {
+		if err = b.Requester.Shutdown(); err != nil {
+			log.Warnf(log.ExchangeSys, "%s %v\n", exchangeName, err)
+		}
+	}
+
 	exchCfg.Enabled = false
 	return nil
 }
 
+// isExchangeSuspended returns true when the exchange circuit breaker is open
+// and its requests are being rejected
+func isExchangeSuspended(exch exchange.IBotExchange) bool {
+	b := exch.GetBase()
+	if b == nil || b.Requester == nil {
+		return false
+	}
+	return b.Requester.GetBreakerStatus().State == request.BreakerOpen
+}
+
 // GetExchangeByName returns an exchange given an exchange name
 func GetExchangeByName(exchName string) exchange.IBotExchange {
 	return Bot.exchangeManager.getExchangeByName(exchName)
@@ -327,11 +347,22 @@ func LoadExchange(name string, useWG bool, wg *sync.WaitGroup) error {
 	}
 
 	Bot.exchangeManager.add(exch)
+	setExchangeNonceStore(exch)
+
+	if exchCfg.SyncServerTime {
+		if _, ok := exch.(exchange.ServerTimeFetcher); ok {
+			syncServerTime(exch)
+		} else {
+			log.Warnf(log.ExchangeSys,
+				"%s does not support server time sync, using the NTP clock offset.\n",
+				exch.GetName())
+		}
+	}
 
 	base := exch.GetBase()
 	if base.API.AuthenticatedSupport ||
 		base.API.AuthenticatedWebsocketSupport {
-		err = exch.ValidateCredentials()
+		err = exch.ValidateCredentials(context.Background())
 		if err != nil {
 			log.Warnf(log.ExchangeSys,
 				"%s: Cannot validate credentials, authenticated support has been disabled, Error: %s\n",
@@ -1,9 +1,12 @@
 package engine
 
 import (
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/yurulab/gocryptotrader/exchanges/bitfinex"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
 )
 
 func CleanupTest(t *testing.T) {
@@ -204,3 +207,23 @@ func TestDryRunParamInteraction(t *testing.T) {
 		t.Error("dryrun should be false and verbose should be true")
 	}
 }
+
+func TestIsExchangeSuspended(t *testing.T) {
+	b := new(bitfinex.Bitfinex)
+	b.SetDefaults()
+	if isExchangeSuspended(b) {
+		t.Error("expected exchange not to be suspended")
+	}
+
+	c := request.NewCircuitBreaker(1, time.Minute)
+	b.Requester = request.New(b.Name, new(http.Client),
+		request.WithCircuitBreaker(c))
+	c.Failure()
+	if !isExchangeSuspended(b) {
+		t.Error("expected exchange to be suspended with an open breaker")
+	}
+
+	if isExchangeSuspended(new(FakePassingExchange)) {
+		t.Error("expected exchange without a requester not to be suspended")
+	}
+}
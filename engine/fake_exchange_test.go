@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -64,30 +65,30 @@ func addPassingFakeExchange(baseExchangeName string) error {
 	return nil
 }
 
-func (h *FakePassingExchange) Setup(_ *config.ExchangeConfig) error { return nil }
-func (h *FakePassingExchange) Start(_ *sync.WaitGroup)              {}
-func (h *FakePassingExchange) SetDefaults()                         {}
-func (h *FakePassingExchange) GetName() string                      { return fakePassExchange }
-func (h *FakePassingExchange) IsEnabled() bool                      { return true }
-func (h *FakePassingExchange) SetEnabled(bool)                      {}
-func (h *FakePassingExchange) ValidateCredentials() error           { return nil }
+func (h *FakePassingExchange) Setup(_ *config.ExchangeConfig) error        { return nil }
+func (h *FakePassingExchange) Start(_ *sync.WaitGroup)                     {}
+func (h *FakePassingExchange) SetDefaults()                                {}
+func (h *FakePassingExchange) GetName() string                             { return fakePassExchange }
+func (h *FakePassingExchange) IsEnabled() bool                             { return true }
+func (h *FakePassingExchange) SetEnabled(bool)                             {}
+func (h *FakePassingExchange) ValidateCredentials(_ context.Context) error { return nil }
 
-func (h *FakePassingExchange) FetchTicker(_ currency.Pair, _ asset.Item) (*ticker.Price, error) {
+func (h *FakePassingExchange) FetchTicker(_ context.Context, _ currency.Pair, _ asset.Item) (*ticker.Price, error) {
 	return nil, nil
 }
-func (h *FakePassingExchange) UpdateTicker(_ currency.Pair, _ asset.Item) (*ticker.Price, error) {
+func (h *FakePassingExchange) UpdateTicker(_ context.Context, _ currency.Pair, _ asset.Item) (*ticker.Price, error) {
 	return nil, nil
 }
-func (h *FakePassingExchange) FetchOrderbook(_ currency.Pair, _ asset.Item) (*orderbook.Base, error) {
+func (h *FakePassingExchange) FetchOrderbook(_ context.Context, _ currency.Pair, _ asset.Item) (*orderbook.Base, error) {
 	return nil, nil
 }
-func (h *FakePassingExchange) UpdateOrderbook(_ currency.Pair, _ asset.Item) (*orderbook.Base, error) {
+func (h *FakePassingExchange) UpdateOrderbook(_ context.Context, _ currency.Pair, _ asset.Item) (*orderbook.Base, error) {
 	return nil, nil
 }
-func (h *FakePassingExchange) FetchTradablePairs(_ asset.Item) ([]string, error) {
+func (h *FakePassingExchange) FetchTradablePairs(_ context.Context, _ asset.Item) ([]string, error) {
 	return nil, nil
 }
-func (h *FakePassingExchange) UpdateTradablePairs(_ bool) error { return nil }
+func (h *FakePassingExchange) UpdateTradablePairs(_ context.Context, _ bool) error { return nil }
 
 func (h *FakePassingExchange) GetEnabledPairs(_ asset.Item) (currency.Pairs, error) {
 	return currency.Pairs{}, nil
@@ -95,11 +96,11 @@ func (h *FakePassingExchange) GetEnabledPairs(_ asset.Item) (currency.Pairs, err
 func (h *FakePassingExchange) GetAvailablePairs(_ asset.Item) (currency.Pairs, error) {
 	return currency.Pairs{}, nil
 }
-func (h *FakePassingExchange) FetchAccountInfo() (account.Holdings, error) {
+func (h *FakePassingExchange) FetchAccountInfo(_ context.Context) (account.Holdings, error) {
 	return account.Holdings{}, nil
 }
 
-func (h *FakePassingExchange) UpdateAccountInfo() (account.Holdings, error) {
+func (h *FakePassingExchange) UpdateAccountInfo(_ context.Context) (account.Holdings, error) {
 	return account.Holdings{}, nil
 }
 func (h *FakePassingExchange) GetAuthenticatedAPISupport(_ uint8) bool { return true }
@@ -107,41 +108,54 @@ func (h *FakePassingExchange) SetPairs(_ currency.Pairs, _ asset.Item, _ bool) e
 	return nil
 }
 func (h *FakePassingExchange) GetAssetTypes() asset.Items { return asset.Items{asset.Spot} }
-func (h *FakePassingExchange) GetExchangeHistory(_ currency.Pair, _ asset.Item, _, _ time.Time) ([]exchange.TradeHistory, error) {
-	return nil, nil
+func (h *FakePassingExchange) GetExchangeHistory(_ context.Context, _ currency.Pair, _ asset.Item, _, end time.Time) ([]exchange.TradeHistory, error) {
+	return []exchange.TradeHistory{
+		{
+			Timestamp: end,
+			TID:       "1337",
+			Price:     1337,
+			Amount:    1,
+			Exchange:  fakePassExchange,
+			Side:      order.Buy.String(),
+		},
+	}, nil
 }
 func (h *FakePassingExchange) SupportsAutoPairUpdates() bool        { return true }
 func (h *FakePassingExchange) SupportsRESTTickerBatchUpdates() bool { return true }
-func (h *FakePassingExchange) GetFeeByType(_ *exchange.FeeBuilder) (float64, error) {
+func (h *FakePassingExchange) GetFeeByType(_ context.Context, _ *exchange.FeeBuilder) (float64, error) {
 	return 0, nil
 }
-func (h *FakePassingExchange) GetLastPairsUpdateTime() int64                      { return 0 }
-func (h *FakePassingExchange) GetWithdrawPermissions() uint32                     { return 0 }
-func (h *FakePassingExchange) FormatWithdrawPermissions() string                  { return "" }
-func (h *FakePassingExchange) SupportsWithdrawPermissions(_ uint32) bool          { return true }
-func (h *FakePassingExchange) GetFundingHistory() ([]exchange.FundHistory, error) { return nil, nil }
-func (h *FakePassingExchange) SubmitOrder(_ *order.Submit) (order.SubmitResponse, error) {
+func (h *FakePassingExchange) GetLastPairsUpdateTime() int64             { return 0 }
+func (h *FakePassingExchange) GetWithdrawPermissions() uint32            { return 0 }
+func (h *FakePassingExchange) FormatWithdrawPermissions() string         { return "" }
+func (h *FakePassingExchange) SupportsWithdrawPermissions(_ uint32) bool { return true }
+func (h *FakePassingExchange) GetFundingHistory(_ context.Context) ([]exchange.FundHistory, error) {
+	return nil, nil
+}
+func (h *FakePassingExchange) SubmitOrder(_ context.Context, _ *order.Submit) (order.SubmitResponse, error) {
 	return order.SubmitResponse{
 		IsOrderPlaced: true,
 		FullyMatched:  true,
 		OrderID:       "FakePassingExchangeOrder",
 	}, nil
 }
-func (h *FakePassingExchange) ModifyOrder(_ *order.Modify) (string, error) { return "", nil }
-func (h *FakePassingExchange) CancelOrder(_ *order.Cancel) error           { return nil }
-func (h *FakePassingExchange) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (h *FakePassingExchange) ModifyOrder(_ context.Context, _ *order.Modify) (string, error) {
+	return "", nil
+}
+func (h *FakePassingExchange) CancelOrder(_ context.Context, _ *order.Cancel) error { return nil }
+func (h *FakePassingExchange) CancelAllOrders(_ context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	return order.CancelAllResponse{}, nil
 }
-func (h *FakePassingExchange) GetOrderInfo(_ string) (order.Detail, error) {
+func (h *FakePassingExchange) GetOrderInfo(_ context.Context, _ string) (order.Detail, error) {
 	return order.Detail{}, nil
 }
-func (h *FakePassingExchange) GetDepositAddress(_ currency.Code, _ string) (string, error) {
+func (h *FakePassingExchange) GetDepositAddress(_ context.Context, _ currency.Code, _ string) (string, error) {
 	return "", nil
 }
-func (h *FakePassingExchange) GetOrderHistory(_ *order.GetOrdersRequest) ([]order.Detail, error) {
+func (h *FakePassingExchange) GetOrderHistory(_ context.Context, _ *order.GetOrdersRequest) ([]order.Detail, error) {
 	return nil, nil
 }
-func (h *FakePassingExchange) GetActiveOrders(_ *order.GetOrdersRequest) ([]order.Detail, error) {
+func (h *FakePassingExchange) GetActiveOrders(_ context.Context, _ *order.GetOrdersRequest) ([]order.Detail, error) {
 	pair, err := currency.NewPairFromString("BTCUSD")
 	if err != nil {
 		return nil, err
@@ -182,20 +196,20 @@ func (h *FakePassingExchange) GetSubscriptions() ([]stream.ChannelSubscription,
 func (h *FakePassingExchange) GetDefaultConfig() (*config.ExchangeConfig, error) { return nil, nil }
 func (h *FakePassingExchange) GetBase() *exchange.Base                           { return nil }
 func (h *FakePassingExchange) SupportsAsset(_ asset.Item) bool                   { return true }
-func (h *FakePassingExchange) GetHistoricCandles(_ currency.Pair, _ asset.Item, _, _ time.Time, _ kline.Interval) (kline.Item, error) {
+func (h *FakePassingExchange) GetHistoricCandles(_ context.Context, _ currency.Pair, _ asset.Item, _, _ time.Time, _ kline.Interval) (kline.Item, error) {
 	return kline.Item{}, nil
 }
-func (h *FakePassingExchange) GetHistoricCandlesExtended(_ currency.Pair, _ asset.Item, _, _ time.Time, _ kline.Interval) (kline.Item, error) {
+func (h *FakePassingExchange) GetHistoricCandlesExtended(_ context.Context, _ currency.Pair, _ asset.Item, _, _ time.Time, _ kline.Interval) (kline.Item, error) {
 	return kline.Item{}, nil
 }
 func (h *FakePassingExchange) DisableRateLimiter() error { return nil }
 func (h *FakePassingExchange) EnableRateLimiter() error  { return nil }
-func (h *FakePassingExchange) WithdrawCryptocurrencyFunds(_ *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (h *FakePassingExchange) WithdrawCryptocurrencyFunds(_ context.Context, _ *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, nil
 }
-func (h *FakePassingExchange) WithdrawFiatFunds(_ *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (h *FakePassingExchange) WithdrawFiatFunds(_ context.Context, _ *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, nil
 }
-func (h *FakePassingExchange) WithdrawFiatFundsToInternationalBank(_ *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (h *FakePassingExchange) WithdrawFiatFundsToInternationalBank(_ context.Context, _ *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, nil
 }
@@ -0,0 +1,349 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/database/repository/fill"
+	exchange "github.com/yurulab/gocryptotrader/exchanges"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/order"
+	"github.com/yurulab/gocryptotrader/log"
+)
+
+// vars related to the fill importer
+var (
+	// FillImportPageWindow is the time range requested from an exchange's
+	// order history at a time
+	FillImportPageWindow = time.Hour * 24 * 7
+
+	// fillAmountTolerance is the difference between an order's executed
+	// amount and its fills which is put down to exchange rounding
+	fillAmountTolerance = 1e-8
+
+	errOrderManagerNotStarted = errors.New("order manager not started")
+)
+
+// fillImporter periodically pages through the order history of authenticated
+// exchanges and stores any new fills
+type fillImporter struct {
+	interval time.Duration
+	lookback time.Duration
+}
+
+// setupFillImporter returns a fill importer if the fill import is enabled and
+// the database manager is running
+func setupFillImporter() *fillImporter {
+	if !Bot.Config.FillImport.Enabled {
+		return nil
+	}
+	if !Bot.DatabaseManager.Started() {
+		log.Warnln(log.OrderMgr,
+			"Fill import requires the database manager, fill import disabled.")
+		return nil
+	}
+	log.Debugf(log.OrderMgr,
+		"Importing exchange fills every %v.\n",
+		Bot.Config.FillImport.Interval)
+	return &fillImporter{
+		interval: Bot.Config.FillImport.Interval,
+		lookback: Bot.Config.FillImport.Lookback,
+	}
+}
+
+// run imports fills from every authenticated exchange every interval until
+// shutdown is closed
+func (f *fillImporter) run(shutdown <-chan struct{}) {
+	t := time.NewTicker(f.interval)
+	defer t.Stop()
+	for {
+		f.importAll()
+		select {
+		case <-shutdown:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (f *fillImporter) importAll() {
+	exchs := GetExchanges()
+	for x := range exchs {
+		if !exchs[x].GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+			continue
+		}
+		if isExchangeSuspended(exchs[x]) {
+			continue
+		}
+		inserted, updated, err := f.importExchange(exchs[x], time.Now())
+		if err != nil {
+			log.Errorf(log.OrderMgr,
+				"Fill import: Unable to import fills for %s: %v\n",
+				exchs[x].GetName(),
+				err)
+			continue
+		}
+		if inserted > 0 || updated > 0 {
+			log.Debugf(log.OrderMgr,
+				"Fill import: Imported %d new and updated %d fills for %s.\n",
+				inserted,
+				updated,
+				exchs[x].GetName())
+		}
+	}
+}
+
+// assetPairs are the enabled pairs of an asset type
+type assetPairs struct {
+	asset asset.Item
+	pairs currency.Pairs
+}
+
+// importExchange pages through the exchange's order history since the most
+// recent stored fill, or the lookback period if there are none, and stores any
+// fills not seen before. Order history requests cannot be filtered by asset
+// type, so the history of every enabled pair is requested once and each order
+// attributed to its asset type
+func (f *fillImporter) importExchange(exch exchange.IBotExchange, now time.Time) (inserted, updated int, err error) {
+	name := exch.GetName()
+	start := now.Add(-f.lookback)
+	latest, err := fill.GetLatestTime(name)
+	switch {
+	case err == nil:
+		if latest.After(start) {
+			start = latest
+		}
+	case err != sql.ErrNoRows:
+		return 0, 0, err
+	}
+
+	var enabled []assetPairs
+	var pairs currency.Pairs
+	assets := exch.GetAssetTypes()
+	for x := range assets {
+		var p currency.Pairs
+		p, err = exch.GetEnabledPairs(assets[x])
+		if err != nil {
+			return 0, 0, err
+		}
+		if len(p) == 0 {
+			continue
+		}
+		enabled = append(enabled, assetPairs{asset: assets[x], pairs: p})
+		for y := range p {
+			if !pairs.Contains(p[y], true) {
+				pairs = append(pairs, p[y])
+			}
+		}
+	}
+	if len(pairs) == 0 {
+		return 0, 0, nil
+	}
+
+	var fills []fill.Fill
+	for pageStart := start; pageStart.Before(now); pageStart = pageStart.Add(FillImportPageWindow) {
+		pageEnd := pageStart.Add(FillImportPageWindow)
+		if pageEnd.After(now) {
+			pageEnd = now
+		}
+		var orders []order.Detail
+		orders, err = exch.GetOrderHistory(context.Background(), &order.GetOrdersRequest{
+			Type:       order.AnyType,
+			Side:       order.AnySide,
+			StartTicks: pageStart,
+			EndTicks:   pageEnd,
+			Pairs:      pairs,
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+		fills = append(fills, fillsFromOrders(name, enabled, orders)...)
+	}
+	if len(fills) == 0 {
+		return 0, 0, nil
+	}
+	return fill.Upsert(fills)
+}
+
+// orderAsset returns the asset type of an order, taken from the order when the
+// exchange sets it or otherwise from the first asset type its pair is enabled
+// for
+func orderAsset(o *order.Detail, enabled []assetPairs) asset.Item {
+	if o.AssetType != "" {
+		return o.AssetType
+	}
+	for x := range enabled {
+		if enabled[x].pairs.Contains(o.Pair, false) {
+			return enabled[x].asset
+		}
+	}
+	return enabled[0].asset
+}
+
+// fillsFromOrders returns the fills of the orders. Orders without trade
+// details which have executed are recorded as a single order level fill using
+// the order ID as the trade ID, which is updated as the order executes
+func fillsFromOrders(exch string, enabled []assetPairs, orders []order.Detail) []fill.Fill {
+	var fills []fill.Fill
+	for x := range orders {
+		o := &orders[x]
+		if o.ID == "" {
+			continue
+		}
+		a := orderAsset(o, enabled)
+		if len(o.Trades) == 0 {
+			if o.ExecutedAmount <= 0 {
+				continue
+			}
+			ts := o.CloseTime
+			if ts.IsZero() {
+				ts = o.LastUpdated
+			}
+			if ts.IsZero() {
+				ts = o.Date
+			}
+			fills = append(fills, fill.Fill{
+				Exchange:  exch,
+				Asset:     a.String(),
+				Pair:      o.Pair.String(),
+				OrderID:   o.ID,
+				TID:       o.ID,
+				Side:      o.Side.String(),
+				Type:      o.Type.String(),
+				Price:     o.Price,
+				Amount:    o.ExecutedAmount,
+				Fee:       o.Fee,
+				Timestamp: ts,
+			})
+			continue
+		}
+		for y := range o.Trades {
+			t := &o.Trades[y]
+			if t.TID == "" {
+				continue
+			}
+			side := t.Side
+			if side == "" {
+				side = o.Side
+			}
+			ts := t.Timestamp
+			if ts.IsZero() {
+				ts = o.Date
+			}
+			fills = append(fills, fill.Fill{
+				Exchange:    exch,
+				Asset:       a.String(),
+				Pair:        o.Pair.String(),
+				OrderID:     o.ID,
+				TID:         t.TID,
+				Side:        side.String(),
+				Type:        o.Type.String(),
+				Price:       t.Price,
+				Amount:      t.Amount,
+				Fee:         t.Fee,
+				FeeCurrency: t.FeeAsset,
+				IsMaker:     t.IsMaker,
+				Timestamp:   ts,
+			})
+		}
+	}
+	return fills
+}
+
+// ReconciledOrder is an order known to the order manager whose fills do not
+// match its executed amount
+type ReconciledOrder struct {
+	OrderID        string
+	Pair           string
+	ExecutedAmount float64
+	FilledAmount   float64
+}
+
+// FillReconciliation compares imported fills with the orders tracked by the
+// order manager
+type FillReconciliation struct {
+	Exchange string
+	// Matched is the number of tracked orders whose fills cover their
+	// executed amount
+	Matched int
+	// Missing are tracked orders which have executed but have no fills
+	Missing []ReconciledOrder
+	// Mismatched are tracked orders whose fills do not cover their executed
+	// amount
+	Mismatched []ReconciledOrder
+	// Unknown are fills for orders the order manager is not tracking
+	Unknown []fill.Fill
+}
+
+// ReconcileFills compares the fills imported for an exchange between start and
+// end with the orders tracked by the order manager
+func ReconcileFills(exch string, start, end time.Time) (*FillReconciliation, error) {
+	if !Bot.OrderManager.Started() {
+		return nil, errOrderManagerNotStarted
+	}
+	fills, err := fill.Get(exch, start, end, 0)
+	if err != nil {
+		return nil, err
+	}
+	orders, err := Bot.OrderManager.orderStore.GetByExchange(exch)
+	if err != nil && err != ErrExchangeNotFound {
+		return nil, err
+	}
+
+	var tracked []*order.Detail
+	for x := range orders {
+		if !orders[x].Date.IsZero() &&
+			(orders[x].Date.Before(start) || orders[x].Date.After(end)) {
+			continue
+		}
+		tracked = append(tracked, orders[x])
+	}
+	r := reconcileFills(tracked, fills)
+	r.Exchange = exch
+	return r, nil
+}
+
+func reconcileFills(orders []*order.Detail, fills []fill.Fill) *FillReconciliation {
+	filled := make(map[string]float64)
+	for x := range fills {
+		filled[fills[x].OrderID] += fills[x].Amount
+	}
+
+	r := &FillReconciliation{}
+	known := make(map[string]bool)
+	for x := range orders {
+		known[orders[x].ID] = true
+		amount, ok := filled[orders[x].ID]
+		if !ok {
+			if orders[x].ExecutedAmount > 0 {
+				r.Missing = append(r.Missing, ReconciledOrder{
+					OrderID:        orders[x].ID,
+					Pair:           orders[x].Pair.String(),
+					ExecutedAmount: orders[x].ExecutedAmount,
+				})
+			}
+			continue
+		}
+		if orders[x].ExecutedAmount-amount > fillAmountTolerance*math.Max(1, orders[x].ExecutedAmount) {
+			r.Mismatched = append(r.Mismatched, ReconciledOrder{
+				OrderID:        orders[x].ID,
+				Pair:           orders[x].Pair.String(),
+				ExecutedAmount: orders[x].ExecutedAmount,
+				FilledAmount:   amount,
+			})
+			continue
+		}
+		r.Matched++
+	}
+
+	for x := range fills {
+		if !known[fills[x].OrderID] {
+			r.Unknown = append(r.Unknown, fills[x])
+		}
+	}
+	return r
+}
@@ -0,0 +1,90 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/database/repository/fill"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/order"
+)
+
+func TestFillsFromOrders(t *testing.T) {
+	now := time.Now()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	orders := []order.Detail{
+		{
+			ID:   "1",
+			Pair: p,
+			Side: order.Buy,
+			Type: order.Limit,
+			Date: now,
+			Trades: []order.TradeHistory{
+				{TID: "a", Price: 100, Amount: 1, Fee: 0.1, FeeAsset: "USD", Timestamp: now},
+				{TID: "b", Price: 101, Amount: 2, IsMaker: true, Side: order.Sell},
+				{Price: 102, Amount: 3},
+			},
+		},
+		{ID: "2", Pair: p, Side: order.Sell, Type: order.Market, Price: 99, ExecutedAmount: 0.5, Fee: 0.2, CloseTime: now},
+		{ID: "3", Pair: p, Amount: 1},
+		{ExecutedAmount: 1},
+		{ID: "4", Pair: currency.NewPair(currency.BTC, currency.USDT), ExecutedAmount: 1},
+		{ID: "5", Pair: p, AssetType: asset.Margin, ExecutedAmount: 1},
+	}
+	enabled := []assetPairs{
+		{asset: asset.Spot, pairs: currency.Pairs{p}},
+		{asset: asset.Futures, pairs: currency.Pairs{currency.NewPair(currency.BTC, currency.USDT)}},
+	}
+
+	fills := fillsFromOrders("test", enabled, orders)
+	if len(fills) != 5 {
+		t.Fatalf("expected 5 fills, received %v", len(fills))
+	}
+	if fills[3].Asset != asset.Futures.String() || fills[4].Asset != asset.Margin.String() {
+		t.Errorf("orders should be attributed to their asset type %+v", fills[3:])
+	}
+	if fills[0].TID != "a" || fills[0].FeeCurrency != "USD" ||
+		fills[0].Side != order.Buy.String() || fills[0].OrderID != "1" ||
+		fills[0].Exchange != "test" || fills[0].Asset != "spot" {
+		t.Errorf("unexpected fill %+v", fills[0])
+	}
+	if fills[1].Side != order.Sell.String() || !fills[1].IsMaker ||
+		!fills[1].Timestamp.Equal(now) {
+		t.Errorf("trade side should be kept and missing time taken from order %+v", fills[1])
+	}
+	if fills[2].TID != "2" || fills[2].Amount != 0.5 || fills[2].Fee != 0.2 ||
+		!fills[2].Timestamp.Equal(now) {
+		t.Errorf("executed order without trades should be a single fill %+v", fills[2])
+	}
+}
+
+func TestReconcileFills(t *testing.T) {
+	orders := []*order.Detail{
+		{ID: "matched", ExecutedAmount: 3},
+		{ID: "missing", ExecutedAmount: 1},
+		{ID: "mismatched", ExecutedAmount: 2},
+		{ID: "unfilled", Amount: 1},
+	}
+	fills := []fill.Fill{
+		{OrderID: "matched", TID: "1", Amount: 1},
+		{OrderID: "matched", TID: "2", Amount: 2},
+		{OrderID: "mismatched", TID: "3", Amount: 1},
+		{OrderID: "external", TID: "4", Amount: 5},
+	}
+
+	r := reconcileFills(orders, fills)
+	if r.Matched != 1 {
+		t.Errorf("expected 1 matched order, received %v", r.Matched)
+	}
+	if len(r.Missing) != 1 || r.Missing[0].OrderID != "missing" {
+		t.Errorf("unexpected missing orders %+v", r.Missing)
+	}
+	if len(r.Mismatched) != 1 || r.Mismatched[0].OrderID != "mismatched" ||
+		r.Mismatched[0].FilledAmount != 1 {
+		t.Errorf("unexpected mismatched orders %+v", r.Mismatched)
+	}
+	if len(r.Unknown) != 1 || r.Unknown[0].TID != "4" {
+		t.Errorf("unexpected unknown fills %+v", r.Unknown)
+	}
+}
@@ -0,0 +1,191 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/common"
+	"github.com/yurulab/gocryptotrader/database/repository/funding"
+	exchange "github.com/yurulab/gocryptotrader/exchanges"
+	"github.com/yurulab/gocryptotrader/log"
+)
+
+// vars related to the funding sync
+var (
+	// FundingSyncTimeout is how long an exchange is given to return its
+	// funding history
+	FundingSyncTimeout = time.Minute
+)
+
+// fundingSyncer records the deposit and withdrawal history reported by
+// exchanges to the database every interval
+type fundingSyncer struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	interval time.Duration
+}
+
+func (f *fundingSyncer) Started() bool {
+	return atomic.LoadInt32(&f.started) == 1
+}
+
+func (f *fundingSyncer) Start() (err error) {
+	if !atomic.CompareAndSwapInt32(&f.started, 0, 1) {
+		return errors.New("funding sync already started")
+	}
+
+	defer func() {
+		if err != nil {
+			atomic.CompareAndSwapInt32(&f.started, 1, 0)
+		}
+	}()
+
+	if !Bot.Config.FundingSync.Enabled {
+		return errors.New("funding sync is not enabled in the config")
+	}
+	if !Bot.DatabaseManager.Started() {
+		return errors.New("funding sync requires the database manager to be started")
+	}
+
+	log.Debugln(log.PortfolioMgr, "Funding sync starting...")
+	f.interval = Bot.Config.FundingSync.Interval
+	f.shutdown = make(chan struct{})
+	go f.run()
+	return nil
+}
+
+func (f *fundingSyncer) Stop() error {
+	if atomic.LoadInt32(&f.started) == 0 {
+		return errors.New("funding sync not started")
+	}
+
+	if !atomic.CompareAndSwapInt32(&f.stopped, 0, 1) {
+		return errors.New("funding sync is already stopped")
+	}
+
+	log.Debugln(log.PortfolioMgr, "Funding sync shutting down...")
+	close(f.shutdown)
+	return nil
+}
+
+func (f *fundingSyncer) run() {
+	log.Debugf(log.PortfolioMgr,
+		"Funding sync started. Syncing exchange funding history every %v.\n",
+		f.interval)
+	Bot.ServicesWG.Add(1)
+	tick := time.NewTicker(f.interval)
+	defer func() {
+		tick.Stop()
+		atomic.CompareAndSwapInt32(&f.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&f.started, 1, 0)
+		Bot.ServicesWG.Done()
+		log.Debugln(log.PortfolioMgr, "Funding sync shutdown.")
+	}()
+
+	for {
+		f.syncAll()
+		select {
+		case <-f.shutdown:
+			return
+		case <-tick.C:
+		}
+	}
+}
+
+// syncAll syncs the funding history of every enabled exchange with
+// authenticated API support
+func (f *fundingSyncer) syncAll() {
+	exchs := GetExchanges()
+	for x := range exchs {
+		if !exchs[x].IsEnabled() ||
+			!exchs[x].GetAuthenticatedAPISupport(exchange.RestAuthentication) ||
+			isExchangeSuspended(exchs[x]) {
+			continue
+		}
+		syncFundingHistory(exchs[x])
+	}
+}
+
+// syncFundingHistory stores the funding history reported by the exchange,
+// giving the exchange at most FundingSyncTimeout to respond
+func syncFundingHistory(exch exchange.IBotExchange) {
+	ctx, cancel := context.WithTimeout(context.Background(), FundingSyncTimeout)
+	history, err := exch.GetFundingHistory(ctx)
+	cancel()
+	switch err {
+	case nil:
+	case common.ErrFunctionNotSupported, common.ErrNotYetImplemented:
+		if Bot.Settings.Verbose {
+			log.Debugf(log.PortfolioMgr,
+				"Funding sync: %s does not support funding history, skipping.\n",
+				exch.GetName())
+		}
+		return
+	default:
+		log.Errorf(log.PortfolioMgr,
+			"Funding sync: Unable to get funding history for %s: %v\n",
+			exch.GetName(),
+			err)
+		return
+	}
+
+	transfers := transfersFromHistory(exch.GetName(), history)
+	if len(transfers) == 0 {
+		return
+	}
+	inserted, updated, err := funding.Upsert(transfers)
+	if err != nil {
+		log.Errorf(log.PortfolioMgr,
+			"Funding sync: Unable to store funding history for %s: %v\n",
+			exch.GetName(),
+			err)
+		return
+	}
+	if inserted > 0 || updated > 0 {
+		log.Debugf(log.PortfolioMgr,
+			"Funding sync: %s %d new and %d updated transfers.\n",
+			exch.GetName(),
+			inserted,
+			updated)
+	}
+}
+
+// transfersFromHistory converts exchange funding history to transfers. As
+// some exchanges do not report a transfer ID, those transfers are identified
+// by their type, currency, amount and time instead
+func transfersFromHistory(exch string, history []exchange.FundHistory) []funding.Transfer {
+	transfers := make([]funding.Transfer, len(history))
+	for x := range history {
+		h := &history[x]
+		id := h.TransferID
+		if id == "" {
+			id = fmt.Sprintf("%s:%s:%s:%d",
+				h.TransferType,
+				h.Currency,
+				strconv.FormatFloat(h.Amount, 'f', -1, 64),
+				h.Timestamp.Unix())
+		}
+		transfers[x] = funding.Transfer{
+			Exchange:          exch,
+			TransferID:        id,
+			TransferType:      h.TransferType,
+			Status:            h.Status,
+			Currency:          h.Currency,
+			Amount:            h.Amount,
+			Fee:               h.Fee,
+			Description:       h.Description,
+			CryptoTxID:        h.CryptoTxID,
+			CryptoToAddress:   h.CryptoToAddress,
+			CryptoFromAddress: h.CryptoFromAddress,
+			BankTo:            h.BankTo,
+			BankFrom:          h.BankFrom,
+			Timestamp:         h.Timestamp,
+		}
+	}
+	return transfers
+}
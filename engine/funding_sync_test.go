@@ -0,0 +1,72 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/config"
+	exchange "github.com/yurulab/gocryptotrader/exchanges"
+)
+
+func TestTransfersFromHistory(t *testing.T) {
+	now := time.Now()
+	history := []exchange.FundHistory{
+		{
+			ExchangeName: "",
+			TransferID:   "1",
+			TransferType: "deposit",
+			Status:       "pending",
+			Currency:     "BTC",
+			Amount:       1.5,
+			CryptoTxID:   "tx",
+			Timestamp:    now,
+		},
+		{
+			TransferType: "withdrawal",
+			Currency:     "ETH",
+			Amount:       0.25,
+			Fee:          0.01,
+			Timestamp:    time.Unix(1586000000, 0),
+		},
+	}
+
+	transfers := transfersFromHistory("test", history)
+	if len(transfers) != 2 {
+		t.Fatalf("expected 2 transfers, received %v", len(transfers))
+	}
+	if transfers[0].Exchange != "test" || transfers[0].TransferID != "1" ||
+		transfers[0].CryptoTxID != "tx" || !transfers[0].Timestamp.Equal(now) {
+		t.Errorf("unexpected transfer %+v", transfers[0])
+	}
+	if transfers[1].TransferID != "withdrawal:ETH:0.25:1586000000" {
+		t.Errorf("expected generated transfer ID, received %v", transfers[1].TransferID)
+	}
+	if !transfers[1].IsWithdrawal() || transfers[0].IsWithdrawal() {
+		t.Error("unexpected transfer direction")
+	}
+}
+
+func TestFundingSyncStart(t *testing.T) {
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+	oldConfig := Bot.Config
+	defer func() { Bot.Config = oldConfig }()
+	Bot.Config = &config.Config{}
+
+	var f fundingSyncer
+	if err := f.Start(); err == nil || f.Started() {
+		t.Error("funding sync should not start when disabled")
+	}
+	if err := f.Stop(); err == nil {
+		t.Error("expected error stopping unstarted funding sync")
+	}
+
+	Bot.Config.FundingSync = config.FundingSyncConfig{
+		Enabled:  true,
+		Interval: time.Hour,
+	}
+	if err := f.Start(); err == nil || f.Started() {
+		t.Error("funding sync should not start without the database manager")
+	}
+}
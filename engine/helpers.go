@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
@@ -54,7 +55,13 @@ func GetSubsystemsStatus() map[string]bool {
 	systems["gctscript"] = Bot.GctScriptManager.Started()
 	systems["deprecated_rpc"] = Bot.Settings.EnableDeprecatedRPC
 	systems["websocket_rpc"] = Bot.Settings.EnableWebsocketRPC
+	systems["metrics"] = Bot.Settings.EnableMetrics
 	systems["dispatch"] = dispatch.IsRunning()
+	systems["candles"] = Bot.CandleManager.Started()
+	systems["orderbook_recorder"] = Bot.OrderbookRecorder.Started()
+	systems["ticker_history"] = Bot.TickerHistory.Started()
+	systems["balance_snapshots"] = Bot.BalanceSnapshots.Started()
+	systems["funding_sync"] = Bot.FundingSync.Started()
 	return systems
 }
 
@@ -83,6 +90,10 @@ func GetRPCEndpoints() map[string]RPCEndpoint {
 		Started:    Bot.Settings.EnableWebsocketRPC,
 		ListenAddr: "ws://" + Bot.Config.RemoteControl.WebsocketRPC.ListenAddress,
 	}
+	endpoints["metrics"] = RPCEndpoint{
+		Started:    Bot.Settings.EnableMetrics,
+		ListenAddr: "http://" + Bot.Config.RemoteControl.Metrics.ListenAddress + "/metrics",
+	}
 	return endpoints
 }
 
@@ -129,6 +140,31 @@ func SetSubsystem(subsys string, enable bool) error {
 			return dispatch.Start(Bot.Settings.DispatchMaxWorkerAmount, Bot.Settings.DispatchJobsLimit)
 		}
 		return dispatch.Stop()
+	case "candles":
+		if enable {
+			return Bot.CandleManager.Start()
+		}
+		return Bot.CandleManager.Stop()
+	case "orderbook_recorder":
+		if enable {
+			return Bot.OrderbookRecorder.Start()
+		}
+		return Bot.OrderbookRecorder.Stop()
+	case "ticker_history":
+		if enable {
+			return Bot.TickerHistory.Start()
+		}
+		return Bot.TickerHistory.Stop()
+	case "balance_snapshots":
+		if enable {
+			return Bot.BalanceSnapshots.Start()
+		}
+		return Bot.BalanceSnapshots.Stop()
+	case "funding_sync":
+		if enable {
+			return Bot.FundingSync.Start()
+		}
+		return Bot.FundingSync.Stop()
 	case "gctscript":
 		if enable {
 			vm.GCTScriptConfig.Enabled = true
@@ -432,7 +468,7 @@ func GetSpecificOrderbook(p currency.Pair, exchangeName string, assetType asset.
 	if exch == nil {
 		return nil, ErrExchangeNotFound
 	}
-	return exch.FetchOrderbook(p, assetType)
+	return exch.FetchOrderbook(context.Background(), p, assetType)
 }
 
 // GetSpecificTicker returns a specific ticker given the currency,
@@ -442,7 +478,7 @@ func GetSpecificTicker(p currency.Pair, exchangeName string, assetType asset.Ite
 	if exch == nil {
 		return nil, ErrExchangeNotFound
 	}
-	return exch.FetchTicker(p, assetType)
+	return exch.FetchTicker(context.Background(), p, assetType)
 }
 
 // GetCollatedExchangeAccountInfoByCoin collates individual exchange account
@@ -646,7 +682,7 @@ func GetExchangeCryptocurrencyDepositAddress(exchName, accountID string, item cu
 	if exch == nil {
 		return "", ErrExchangeNotFound
 	}
-	return exch.GetDepositAddress(item, accountID)
+	return exch.GetDepositAddress(context.Background(), item, accountID)
 }
 
 // GetExchangeCryptocurrencyDepositAddresses obtains an exchanges deposit cryptocurrency list
@@ -671,7 +707,7 @@ func GetExchangeCryptocurrencyDepositAddresses() map[string]map[string]string {
 		cryptoAddr := make(map[string]string)
 		for y := range cryptoCurrencies {
 			cryptocurrency := cryptoCurrencies[y]
-			depositAddr, err := exchanges[x].GetDepositAddress(currency.NewCode(cryptocurrency), "")
+			depositAddr, err := exchanges[x].GetDepositAddress(context.Background(), currency.NewCode(cryptocurrency), "")
 			if err != nil {
 				log.Errorf(log.Global, "%s failed to get cryptocurrency deposit addresses. Err: %s\n", exchName, err)
 				continue
@@ -720,7 +756,7 @@ func GetAllActiveTickers() []EnabledExchangeCurrencies {
 				continue
 			}
 			for z := range currencies {
-				tp, err := exchanges[x].FetchTicker(currencies[z], assets[y])
+				tp, err := exchanges[x].FetchTicker(context.Background(), currencies[z], assets[y])
 				if err != nil {
 					log.Errorf(log.ExchangeSys, "Exchange %s failed to retrieve %s ticker. Err: %s\n", exchName,
 						currencies[z].String(),
@@ -749,7 +785,7 @@ func GetAllEnabledExchangeAccountInfo() AllEnabledExchangeAccounts {
 				}
 				continue
 			}
-			accountInfo, err := exchanges[x].FetchAccountInfo()
+			accountInfo, err := exchanges[x].FetchAccountInfo(context.Background())
 			if err != nil {
 				log.Errorf(log.ExchangeSys,
 					"Error encountered retrieving exchange account info for %s. Error %s\n",
@@ -0,0 +1,44 @@
+package engine
+
+import (
+	"github.com/yurulab/gocryptotrader/config"
+	noncerepo "github.com/yurulab/gocryptotrader/database/repository/nonce"
+	exchange "github.com/yurulab/gocryptotrader/exchanges"
+	"github.com/yurulab/gocryptotrader/exchanges/nonce"
+	"github.com/yurulab/gocryptotrader/log"
+)
+
+// setupNonceStore returns the configured nonce store, or nil if nonces should
+// only be kept in memory
+func setupNonceStore() nonce.Store {
+	cfg := Bot.Config.NonceStore
+	if !cfg.Enabled {
+		return nil
+	}
+
+	switch cfg.Backend {
+	case config.NonceStoreDatabase:
+		if !Bot.DatabaseManager.Started() {
+			log.Warnln(log.ExchangeSys,
+				"Nonce store requires the database manager, using in memory nonces.")
+			return nil
+		}
+		log.Debugln(log.ExchangeSys, "Persisting nonces to the database.")
+		return noncerepo.Store{}
+	default:
+		log.Debugf(log.ExchangeSys, "Persisting nonces to %s.\n", cfg.Path)
+		return nonce.NewFileStore(cfg.Path)
+	}
+}
+
+// setExchangeNonceStore plugs the nonce store into the exchange requester
+func setExchangeNonceStore(exch exchange.IBotExchange) {
+	if Bot.nonceStore == nil {
+		return
+	}
+	base := exch.GetBase()
+	if base.Requester == nil {
+		return
+	}
+	base.Requester.SetNonceStore(Bot.nonceStore, Bot.Config.NonceStore.LeaseSize)
+}
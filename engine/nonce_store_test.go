@@ -0,0 +1,63 @@
+package engine
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/bitfinex"
+	"github.com/yurulab/gocryptotrader/exchanges/nonce"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
+)
+
+func TestSetupNonceStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	oldBot := Bot
+	defer func() { Bot = oldBot }()
+	Bot = &Engine{Config: &config.Config{}}
+
+	if setupNonceStore() != nil {
+		t.Error("disabled nonce store should be nil")
+	}
+
+	Bot.Config.NonceStore = config.NonceStoreConfig{
+		Enabled: true,
+		Backend: config.NonceStoreDatabase,
+	}
+	if setupNonceStore() != nil {
+		t.Error("database nonce store should be nil without the database manager")
+	}
+
+	Bot.Config.NonceStore = config.NonceStoreConfig{
+		Enabled:   true,
+		Backend:   config.NonceStoreFile,
+		Path:      filepath.Join(dir, "nonce.json"),
+		LeaseSize: 5,
+	}
+	Bot.nonceStore = setupNonceStore()
+	if _, ok := Bot.nonceStore.(*nonce.FileStore); !ok {
+		t.Fatal("expected file nonce store")
+	}
+
+	b := new(bitfinex.Bitfinex)
+	b.SetDefaults()
+	b.Requester = request.New(b.Name, new(http.Client))
+	setExchangeNonceStore(b)
+	n1 := b.Requester.GetNonce(false)
+
+	c := new(bitfinex.Bitfinex)
+	c.SetDefaults()
+	c.Requester = request.New(c.Name, new(http.Client))
+	setExchangeNonceStore(c)
+	if n2 := c.Requester.GetNonce(false); n2 != n1+5 {
+		t.Errorf("expected %v, received %v", n1+5, n2)
+	}
+}
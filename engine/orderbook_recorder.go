@@ -0,0 +1,242 @@
+package engine
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/config"
+	orderbookdb "github.com/yurulab/gocryptotrader/database/repository/orderbook"
+	"github.com/yurulab/gocryptotrader/dispatch"
+	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
+	"github.com/yurulab/gocryptotrader/exchanges/orderbook/recorder"
+	"github.com/yurulab/gocryptotrader/log"
+)
+
+// OrderbookRecorderFlushDelay is how often recorded orderbook snapshots are
+// written to their output
+var OrderbookRecorderFlushDelay = time.Second
+
+// orderbookRecorder persists snapshots of the configured orderbooks either
+// every interval or on every update for research and replay
+type orderbookRecorder struct {
+	started    int32
+	stopped    int32
+	shutdown   chan struct{}
+	cfg        config.OrderbookRecorderConfig
+	writer     *recorder.Writer
+	buffer     []orderbook.Base
+	recorded   map[string]time.Time
+	subscribed map[string]bool
+	wg         sync.WaitGroup
+	m          sync.Mutex
+}
+
+func (o *orderbookRecorder) Started() bool {
+	return atomic.LoadInt32(&o.started) == 1
+}
+
+func (o *orderbookRecorder) Start() (err error) {
+	if !atomic.CompareAndSwapInt32(&o.started, 0, 1) {
+		return errors.New("orderbook recorder already started")
+	}
+
+	defer func() {
+		if err != nil {
+			atomic.CompareAndSwapInt32(&o.started, 1, 0)
+		}
+	}()
+
+	if !Bot.Config.OrderbookRecorder.Enabled {
+		return errors.New("orderbook recorder is not enabled in the config")
+	}
+
+	log.Debugln(log.OrderBook, "Orderbook recorder starting...")
+	o.cfg = Bot.Config.OrderbookRecorder
+	o.writer = nil
+	if o.cfg.Output == config.OrderbookRecordFile {
+		o.writer = recorder.NewWriter(o.cfg.Path)
+	}
+	o.buffer = nil
+	o.recorded = make(map[string]time.Time)
+	o.subscribed = make(map[string]bool)
+	o.shutdown = make(chan struct{})
+	go o.run()
+	return nil
+}
+
+func (o *orderbookRecorder) Stop() error {
+	if atomic.LoadInt32(&o.started) == 0 {
+		return errors.New("orderbook recorder not started")
+	}
+
+	if !atomic.CompareAndSwapInt32(&o.stopped, 0, 1) {
+		return errors.New("orderbook recorder is already stopped")
+	}
+
+	log.Debugln(log.OrderBook, "Orderbook recorder shutting down...")
+	close(o.shutdown)
+	return nil
+}
+
+func (o *orderbookRecorder) run() {
+	log.Debugf(log.OrderBook, "Orderbook recorder started. Mode: %s Output: %s Pairs: %d\n",
+		o.cfg.Mode,
+		o.cfg.Output,
+		len(o.cfg.Pairs))
+	Bot.ServicesWG.Add(1)
+	flush := time.NewTicker(OrderbookRecorderFlushDelay)
+	var record <-chan time.Time
+	if o.cfg.Mode == config.OrderbookRecordInterval {
+		t := time.NewTicker(o.cfg.Interval)
+		defer t.Stop()
+		record = t.C
+	} else {
+		o.subscribe()
+	}
+	defer func() {
+		flush.Stop()
+		o.wg.Wait()
+		o.flush()
+		if o.writer != nil {
+			if err := o.writer.Close(); err != nil {
+				log.Errorf(log.OrderBook, "Orderbook recorder failed to close snapshot files: %v\n", err)
+			}
+		}
+		atomic.CompareAndSwapInt32(&o.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&o.started, 1, 0)
+		Bot.ServicesWG.Done()
+		log.Debugln(log.OrderBook, "Orderbook recorder shutdown.")
+	}()
+
+	for {
+		select {
+		case <-o.shutdown:
+			return
+		case <-record:
+			o.snapshot()
+		case <-flush.C:
+			if o.cfg.Mode == config.OrderbookRecordUpdate {
+				o.subscribe()
+			}
+			o.flush()
+		}
+	}
+}
+
+func recordKey(p *config.OrderbookRecordPair) string {
+	return strings.ToLower(p.Exchange) + "/" + p.Asset.String() + "/" + p.Pair.Upper().String()
+}
+
+// snapshot records every configured orderbook which has been updated since
+// it was last recorded
+func (o *orderbookRecorder) snapshot() {
+	for i := range o.cfg.Pairs {
+		p := &o.cfg.Pairs[i]
+		b, err := orderbook.Get(p.Exchange, p.Pair, p.Asset)
+		if err != nil {
+			continue
+		}
+		key := recordKey(p)
+		o.m.Lock()
+		if o.recorded[key].Equal(b.LastUpdated) {
+			o.m.Unlock()
+			continue
+		}
+		o.recorded[key] = b.LastUpdated
+		o.m.Unlock()
+		o.add(b)
+	}
+}
+
+// subscribe subscribes to updates of every configured orderbook which is not
+// yet subscribed to. Orderbooks are only available to subscribe to once they
+// have been fetched, so this is retried until each one succeeds
+func (o *orderbookRecorder) subscribe() {
+	for i := range o.cfg.Pairs {
+		p := &o.cfg.Pairs[i]
+		key := recordKey(p)
+		o.m.Lock()
+		if o.subscribed[key] {
+			o.m.Unlock()
+			continue
+		}
+		pipe, err := orderbook.SubscribeOrderbook(p.Exchange, p.Pair, p.Asset)
+		if err != nil {
+			o.m.Unlock()
+			continue
+		}
+		o.subscribed[key] = true
+		o.m.Unlock()
+		o.wg.Add(1)
+		go o.watch(key, pipe)
+	}
+}
+
+// watch records every update received on pipe until shutdown
+func (o *orderbookRecorder) watch(key string, pipe dispatch.Pipe) {
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			log.Errorf(log.OrderBook, "Orderbook recorder failed to release %s subscription: %v\n", key, err)
+		}
+		o.wg.Done()
+	}()
+
+	for {
+		select {
+		case <-o.shutdown:
+			return
+		case data, ok := <-pipe.C:
+			if !ok {
+				o.m.Lock()
+				delete(o.subscribed, key)
+				o.m.Unlock()
+				return
+			}
+			b := (*data.(*interface{})).(orderbook.Base)
+			o.add(&b)
+		}
+	}
+}
+
+// add buffers a copy of b to be written on the next flush
+func (o *orderbookRecorder) add(b *orderbook.Base) {
+	snapshot := *b
+	snapshot.Bids = append([]orderbook.Item(nil), b.Bids...)
+	snapshot.Asks = append([]orderbook.Item(nil), b.Asks...)
+	o.m.Lock()
+	o.buffer = append(o.buffer, snapshot)
+	o.m.Unlock()
+}
+
+// flush writes buffered snapshots to the configured output
+func (o *orderbookRecorder) flush() {
+	o.m.Lock()
+	books := o.buffer
+	o.buffer = nil
+	o.m.Unlock()
+	if len(books) == 0 {
+		return
+	}
+
+	if o.writer == nil {
+		if err := orderbookdb.Insert(books); err != nil {
+			log.Errorf(log.OrderBook, "Orderbook recorder failed to store %d snapshots: %v\n", len(books), err)
+		}
+		return
+	}
+	for i := range books {
+		if err := o.writer.Write(&books[i]); err != nil {
+			log.Errorf(log.OrderBook, "Orderbook recorder failed to write %s %s %s snapshot: %v\n",
+				books[i].ExchangeName,
+				books[i].AssetType,
+				books[i].Pair,
+				err)
+		}
+	}
+	if err := o.writer.Flush(); err != nil {
+		log.Errorf(log.OrderBook, "Orderbook recorder failed to flush snapshot files: %v\n", err)
+	}
+}
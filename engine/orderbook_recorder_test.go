@@ -0,0 +1,130 @@
+package engine
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/dispatch"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
+	"github.com/yurulab/gocryptotrader/exchanges/orderbook/recorder"
+)
+
+func TestOrderbookRecorderStartStop(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-orderbooks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+	oldConfig := Bot.Config
+	defer func() { Bot.Config = oldConfig }()
+	Bot.Config = &config.Config{}
+
+	var o orderbookRecorder
+	if err = o.Start(); err == nil || o.Started() {
+		t.Error("orderbook recorder should not start when disabled")
+	}
+	if err = o.Stop(); err == nil {
+		t.Error("expected error stopping an unstarted orderbook recorder")
+	}
+
+	Bot.Config.OrderbookRecorder = config.OrderbookRecorderConfig{
+		Enabled: true,
+		Mode:    config.OrderbookRecordUpdate,
+		Output:  config.OrderbookRecordFile,
+		Path:    dir,
+	}
+	if err = o.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if !o.Started() {
+		t.Error("orderbook recorder should be started")
+	}
+	if err = o.Start(); err == nil {
+		t.Error("expected error starting a started orderbook recorder")
+	}
+	if err = o.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; o.Started(); i++ {
+		if i == 100 {
+			t.Fatal("orderbook recorder failed to shut down")
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+}
+
+func TestOrderbookRecorderSnapshot(t *testing.T) {
+	if !dispatch.IsRunning() {
+		if err := dispatch.Start(1, dispatch.DefaultJobsLimit); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dir, err := ioutil.TempDir("", "gct-orderbooks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	p := currency.NewPair(currency.BTC, currency.USD)
+	o := orderbookRecorder{
+		cfg: config.OrderbookRecorderConfig{
+			Mode:   config.OrderbookRecordInterval,
+			Output: config.OrderbookRecordFile,
+			Path:   dir,
+			Pairs: []config.OrderbookRecordPair{
+				{Exchange: "recordertest", Asset: asset.Spot, Pair: p},
+				{Exchange: "recordertest", Asset: asset.Futures, Pair: p},
+			},
+		},
+		writer:   recorder.NewWriter(dir),
+		recorded: make(map[string]time.Time),
+	}
+
+	book := orderbook.Base{
+		ExchangeName: "recordertest",
+		AssetType:    asset.Spot,
+		Pair:         p,
+		Bids:         []orderbook.Item{{Price: 99, Amount: 1}},
+		Asks:         []orderbook.Item{{Price: 101, Amount: 1}},
+		LastUpdateID: 1337,
+	}
+	if err = book.Process(); err != nil {
+		t.Fatal(err)
+	}
+
+	o.snapshot()
+	o.snapshot()
+	if len(o.buffer) != 1 {
+		t.Fatalf("expected unchanged orderbook to be recorded once, received %v", len(o.buffer))
+	}
+
+	o.flush()
+	if len(o.buffer) != 0 {
+		t.Error("expected buffer to be emptied on flush")
+	}
+	if err = o.writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	stored, err := orderbook.Get("recordertest", p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	read, err := recorder.Read(dir, "recordertest", asset.Spot, p, stored.LastUpdated, stored.LastUpdated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read) != 1 || read[0].LastUpdateID != 1337 || read[0].Bids[0].Price != 99 {
+		t.Errorf("unexpected recorded snapshots %+v", read)
+	}
+}
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync/atomic"
@@ -10,6 +11,7 @@ import (
 	"github.com/yurulab/gocryptotrader/common"
 	"github.com/yurulab/gocryptotrader/communications/base"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
 	"github.com/yurulab/gocryptotrader/log"
 )
 
@@ -139,6 +141,10 @@ func (o *orderManager) Start() error {
 	o.shutdown = make(chan struct{})
 	o.orderStore.Orders = make(map[string][]*order.Detail)
 	go o.run()
+	o.fills = setupFillImporter()
+	if o.fills != nil {
+		go o.fills.run(o.shutdown)
+	}
 	return nil
 }
 
@@ -259,7 +265,9 @@ func (o *orderManager) Cancel(cancel *order.Cancel) error {
 		return errors.New("order asset type not supported by exchange")
 	}
 
-	err := exch.CancelOrder(cancel)
+	// Cancels reduce exposure so they are sent even while requests to the
+	// exchange are suspended by its circuit breaker
+	err := exch.CancelOrder(request.BypassBreaker(context.Background()), cancel)
 	if err != nil {
 		return fmt.Errorf("%v - Failed to cancel order: %v", cancel.Exchange, err)
 	}
@@ -311,7 +319,7 @@ func (o *orderManager) Submit(newOrder *order.Submit) (*orderSubmitResponse, err
 	if exch == nil {
 		return nil, ErrExchangeNotFound
 	}
-	result, err := exch.SubmitOrder(newOrder)
+	result, err := exch.SubmitOrder(context.Background(), newOrder)
 	if err != nil {
 		return nil, err
 	}
@@ -394,6 +402,12 @@ func (o *orderManager) processOrders() {
 			authExchanges[x])
 
 		exch := GetExchangeByName(authExchanges[x])
+		if isExchangeSuspended(exch) {
+			log.Debugf(log.OrderMgr,
+				"Order manager: Skipping %v, circuit breaker is open.",
+				authExchanges[x])
+			continue
+		}
 		supportedAssets := exch.GetAssetTypes()
 		for y := range supportedAssets {
 			pairs, err := exch.GetEnabledPairs(supportedAssets[y])
@@ -421,7 +435,7 @@ func (o *orderManager) processOrders() {
 				Type:  order.AnyType,
 				Pairs: pairs,
 			}
-			result, err := exch.GetActiveOrders(&req)
+			result, err := exch.GetActiveOrders(context.Background(), &req)
 			if err != nil {
 				log.Warnf(log.OrderMgr,
 					"Order manager: Unable to get active orders for %s and asset type %s: %s",
@@ -28,6 +28,7 @@ type orderManager struct {
 	shutdown   chan struct{}
 	orderStore orderStore
 	cfg        orderManagerConfig
+	fills      *fillImporter
 }
 
 type orderSubmitResponse struct {
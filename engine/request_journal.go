@@ -0,0 +1,72 @@
+package engine
+
+import (
+	"github.com/yurulab/gocryptotrader/database/repository/journal"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
+	"github.com/yurulab/gocryptotrader/log"
+)
+
+// requestJournalBuffer is the number of journal entries held while waiting to
+// be written to the database
+const requestJournalBuffer = 1000
+
+// requestJournal writes authenticated exchange requests to the database off
+// the request path
+type requestJournal struct {
+	entries chan *request.JournalEntry
+}
+
+func newRequestJournal() *requestJournal {
+	return &requestJournal{
+		entries: make(chan *request.JournalEntry, requestJournalBuffer),
+	}
+}
+
+// Record implements the request.Journal interface, dropping the entry if the
+// buffer is full so that exchange requests are never held up by the database
+func (j *requestJournal) Record(e *request.JournalEntry) {
+	select {
+	case j.entries <- e:
+	default:
+		log.Warnf(log.DatabaseMgr,
+			"Request journal buffer full, dropping %s %s %s entry",
+			e.Exchange,
+			e.Method,
+			e.Path)
+	}
+}
+
+// run writes entries until shutdown is closed
+func (j *requestJournal) run(shutdown <-chan struct{}) {
+	for {
+		select {
+		case <-shutdown:
+			return
+		case e := <-j.entries:
+			j.write(e)
+		}
+	}
+}
+
+// flush writes all buffered entries
+func (j *requestJournal) flush() {
+	for {
+		select {
+		case e := <-j.entries:
+			j.write(e)
+		default:
+			return
+		}
+	}
+}
+
+func (j *requestJournal) write(e *request.JournalEntry) {
+	if err := journal.Insert(e); err != nil {
+		log.Errorf(log.DatabaseMgr,
+			"Request journal failed to store %s %s %s entry: %v",
+			e.Exchange,
+			e.Method,
+			e.Path,
+			err)
+	}
+}
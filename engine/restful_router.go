@@ -12,6 +12,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/yurulab/gocryptotrader/common"
 	"github.com/yurulab/gocryptotrader/log"
+	"github.com/yurulab/gocryptotrader/metrics"
 )
 
 // RESTLogger logs the requests internally
@@ -54,6 +55,32 @@ func StartWebsocketServer() {
 	}
 }
 
+// StartMetricsServer starts a server exposing the bot metrics to a Prometheus
+// scrape
+func StartMetricsServer() {
+	listenAddr := Bot.Config.RemoteControl.Metrics.ListenAddress
+	log.Debugf(log.RESTSys,
+		"Metrics exporter enabled. Listen URL: http://%s:%d/metrics\n",
+		common.ExtractHost(listenAddr), common.ExtractPort(listenAddr))
+	err := http.ListenAndServe(listenAddr, newMetricsRouter())
+	if err != nil {
+		log.Errorf(log.RESTSys, "Failed to start metrics server. Err: %s", err)
+	}
+}
+
+// newMetricsRouter returns a router serving the metrics endpoint. Unlike the
+// RPC routers it does not match on host, as scrapes are commonly addressed by
+// IP
+func newMetricsRouter() *mux.Router {
+	router := mux.NewRouter().StrictSlash(true)
+	router.
+		Methods(http.MethodGet).
+		Path("/metrics").
+		Name("Metrics").
+		Handler(RESTLogger(metrics.Handler(), "Metrics"))
+	return router
+}
+
 // newRouter takes in the exchange interfaces and returns a new multiplexor
 // router
 func newRouter(isREST bool) *mux.Router {
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
@@ -75,7 +76,7 @@ func GetAllActiveOrderbooks() []EnabledExchangeOrderbooks {
 				continue
 			}
 			for z := range currencies {
-				ob, err := exchanges[x].FetchOrderbook(currencies[z], assets[y])
+				ob, err := exchanges[x].FetchOrderbook(context.Background(), currencies[z], assets[y])
 				if err != nil {
 					log.Errorf(log.RESTSys,
 						"Exchange %s failed to retrieve %s orderbook. Err: %s\n", exchName,
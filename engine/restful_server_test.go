@@ -6,10 +6,16 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"regexp"
 	"runtime"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
 )
 
 func makeHTTPGetRequest(t *testing.T, response interface{}) *http.Response {
@@ -111,3 +117,58 @@ func TestProfilerEnabledShouldEnableProfileEndPoint(t *testing.T) {
 		t.Errorf("Response returned wrong status code expected %v got %v", http.StatusOK, status)
 	}
 }
+
+func TestMetricsScrape(t *testing.T) {
+	SetupTestHelpers(t)
+	orig := Bot.ExchangeCurrencyPairManager
+	defer func() { Bot.ExchangeCurrencyPairManager = orig }()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	Bot.ExchangeCurrencyPairManager = &ExchangeCurrencyPairSyncer{
+		Cfg: CurrencyPairSyncerConfig{SyncTicker: true},
+		CurrencyPairs: []CurrencyPairSyncAgent{{
+			Exchange:  "metrics-test",
+			Pair:      p,
+			AssetType: asset.Spot,
+			Ticker: SyncBase{
+				HaveData:    true,
+				LastUpdated: time.Now().Add(-time.Minute),
+			},
+		}},
+	}
+	observeSyncUpdate("metrics-test", p, asset.Spot, SyncItemTicker, nil)
+
+	s := httptest.NewServer(newMetricsRouter())
+	defer s.Close()
+	resp, err := http.Get(s.URL + "/metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status %d, received %d", http.StatusOK, resp.StatusCode)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{
+		"# TYPE gct_request_duration_seconds histogram\n",
+		"# TYPE gct_websocket_reconnects_total counter\n",
+		"# TYPE gct_dispatch_jobs_queued gauge\n",
+		`gct_syncer_updates_total{exchange="metrics-test",item="ticker",result="success"} 1` + "\n",
+		`gct_syncer_last_update_timestamp_seconds{exchange="metrics-test",pair="BTCUSD",asset="spot",item="ticker"} `,
+	} {
+		if !strings.Contains(string(body), expected) {
+			t.Errorf("scrape missing %q", expected)
+		}
+	}
+
+	lag := regexp.MustCompile(`(?m)^gct_syncer_max_lag_seconds (\S+)$`).FindSubmatch(body)
+	if lag == nil {
+		t.Fatal("scrape missing syncer lag")
+	}
+	if v, err := strconv.ParseFloat(string(lag[1]), 64); err != nil || v < 60 {
+		t.Errorf("expected syncer lag of at least 60 seconds, received %s", lag[1])
+	}
+}
@@ -0,0 +1,113 @@
+package engine
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/database"
+	"github.com/yurulab/gocryptotrader/database/repository/audit"
+	"github.com/yurulab/gocryptotrader/database/repository/export"
+	"github.com/yurulab/gocryptotrader/log"
+)
+
+// auditEventTable is the table holding the audit event hash chain
+const auditEventTable = "audit_event"
+
+// retentionManager periodically removes rows older than their table's
+// retention policy, archiving them first when configured
+type retentionManager struct {
+	archivePath string
+	interval    time.Duration
+	policies    []database.RetentionPolicy
+	// checkpoints records where the audit event chain was cut so the
+	// remaining chain still verifies
+	checkpoints *auditCheckpointer
+}
+
+func newRetentionManager(cfg *database.RetentionConfig, checkpoints *auditCheckpointer) *retentionManager {
+	r := &retentionManager{
+		archivePath: cfg.ArchivePath,
+		interval:    cfg.Interval,
+		checkpoints: checkpoints,
+	}
+	for i := range cfg.Policies {
+		t, err := export.GetTable(cfg.Policies[i].Table)
+		if err != nil {
+			log.Errorf(log.DatabaseMgr, "Retention policy ignored: %v\n", err)
+			continue
+		}
+		if t.Name == auditEventTable && checkpoints == nil {
+			log.Errorln(log.DatabaseMgr,
+				"Retention policy ignored: audit events can only be removed when audit checkpoints are enabled.")
+			continue
+		}
+		p := cfg.Policies[i]
+		p.Table = t.Name
+		r.policies = append(r.policies, p)
+	}
+	return r
+}
+
+// run applies retention policies on start and every interval until shutdown
+// is closed
+func (r *retentionManager) run(shutdown <-chan struct{}) {
+	r.apply(time.Now())
+	t := time.NewTicker(r.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-shutdown:
+			return
+		case <-t.C:
+			r.apply(time.Now())
+		}
+	}
+}
+
+// apply removes rows older than each policy's max age relative to now
+func (r *retentionManager) apply(now time.Time) {
+	for i := range r.policies {
+		p := &r.policies[i]
+		before := now.Add(-p.MaxAge)
+		if p.Table == auditEventTable {
+			var err error
+			before, err = r.markAuditArchive(before)
+			if err == sql.ErrNoRows {
+				continue
+			}
+			if err != nil {
+				log.Errorf(log.DatabaseMgr, "Failed to apply retention to %s: %v\n", p.Table, err)
+				continue
+			}
+		}
+		if p.Archive {
+			count, path, err := export.Archive(p.Table, before, r.archivePath)
+			if err != nil {
+				log.Errorf(log.DatabaseMgr, "Failed to archive %s: %v\n", p.Table, err)
+				continue
+			}
+			if count > 0 {
+				log.Debugf(log.DatabaseMgr, "Archived %d %s rows to %s.\n", count, p.Table, path)
+			}
+			continue
+		}
+		count, err := export.Delete(p.Table, before)
+		if err != nil {
+			log.Errorf(log.DatabaseMgr, "Failed to apply retention to %s: %v\n", p.Table, err)
+			continue
+		}
+		if count > 0 {
+			log.Debugf(log.DatabaseMgr, "Removed %d %s rows older than %v.\n", count, p.Table, p.MaxAge)
+		}
+	}
+}
+
+// markAuditArchive records a signed checkpoint of the last audit event to be
+// removed before any are removed, returning the cutoff which removes up to it
+func (r *retentionManager) markAuditArchive(before time.Time) (time.Time, error) {
+	id, hash, cutoff, err := audit.ArchiveMark(before)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cutoff, r.checkpoints.archived(id, hash)
+}
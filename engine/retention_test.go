@@ -0,0 +1,36 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/database"
+)
+
+func TestNewRetentionManager(t *testing.T) {
+	t.Parallel()
+	cfg := &database.RetentionConfig{
+		Interval:    time.Hour,
+		ArchivePath: "archive",
+		Policies: []database.RetentionPolicy{
+			{Table: "AUDIT_EVENT", MaxAge: time.Hour, Archive: true},
+			{Table: "nonce", MaxAge: time.Hour},
+			{Table: "request_journal", MaxAge: time.Minute},
+		},
+	}
+	r := newRetentionManager(cfg, nil)
+	if len(r.policies) != 1 || r.policies[0].Table != "request_journal" {
+		t.Fatalf("expected audit events to be kept without checkpoints, received %+v", r.policies)
+	}
+
+	r = newRetentionManager(cfg, newAuditCheckpointer("checkpoints.log", "key", time.Hour))
+	if len(r.policies) != 2 {
+		t.Fatalf("expected unsupported table to be ignored, received %+v", r.policies)
+	}
+	if r.policies[0].Table != "audit_event" || !r.policies[0].Archive {
+		t.Errorf("unexpected policy %+v", r.policies[0])
+	}
+	if r.interval != time.Hour || r.archivePath != "archive" {
+		t.Error("unexpected retention settings")
+	}
+}
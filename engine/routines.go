@@ -8,11 +8,13 @@ import (
 
 	"github.com/yurulab/gocryptotrader/common"
 	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/exchanges/derivative"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
 	"github.com/yurulab/gocryptotrader/exchanges/stats"
 	"github.com/yurulab/gocryptotrader/exchanges/stream"
 	"github.com/yurulab/gocryptotrader/exchanges/ticker"
+	"github.com/yurulab/gocryptotrader/exchanges/trade"
 	"github.com/yurulab/gocryptotrader/log"
 )
 
@@ -296,6 +298,34 @@ func WebsocketDataHandler(exchName string, data interface{}) error {
 				d.AssetType,
 				d)
 		}
+		if d.Exchange == "" {
+			d.Exchange = exchName
+		}
+		if Bot.Settings.EnableExchangeSyncManager && Bot.ExchangeCurrencyPairManager != nil {
+			Bot.ExchangeCurrencyPairManager.update(exchName,
+				d.CurrencyPair,
+				d.AssetType,
+				SyncItemTrade,
+				nil)
+		}
+		err := trade.Process(trade.Data{
+			Exchange:  d.Exchange,
+			TID:       d.TID,
+			Pair:      d.CurrencyPair,
+			AssetType: d.AssetType,
+			Side:      d.Side,
+			Price:     d.Price,
+			Amount:    d.Amount,
+			Timestamp: d.Timestamp,
+		})
+		if err != nil {
+			log.Errorf(log.WebsocketMgr, "%s websocket failed to process trade. Error: %s\n",
+				exchName,
+				err)
+		}
+		if Bot.CandleManager.Started() {
+			return Bot.CandleManager.ProcessTrade(&d)
+		}
 	case stream.FundingData:
 		if Bot.Settings.Verbose {
 			log.Infof(log.WebsocketMgr, "%s websocket %s %s funding updated %+v",
@@ -304,6 +334,18 @@ func WebsocketDataHandler(exchName string, data interface{}) error {
 				d.AssetType,
 				d)
 		}
+	case *derivative.Info:
+		if Bot.Settings.Verbose {
+			log.Infof(log.WebsocketMgr, "%s websocket %s %s derivative info updated %+v",
+				exchName,
+				FormatCurrency(d.Pair),
+				d.AssetType,
+				d)
+		}
+		if d.Exchange == "" {
+			d.Exchange = exchName
+		}
+		return derivative.ProcessInfo(d)
 	case *ticker.Price:
 		if Bot.Settings.EnableExchangeSyncManager && Bot.ExchangeCurrencyPairManager != nil {
 			Bot.ExchangeCurrencyPairManager.update(exchName,
@@ -314,6 +356,9 @@ func WebsocketDataHandler(exchName string, data interface{}) error {
 		}
 		err := ticker.ProcessTicker(d)
 		printTickerSummary(d, "websocket", err)
+		if err == nil {
+			Bot.TickerHistory.Process(d)
+		}
 	case stream.KlineData:
 		if Bot.Settings.Verbose {
 			log.Infof(log.WebsocketMgr, "%s websocket %s %s kline updated %+v",
@@ -322,6 +367,12 @@ func WebsocketDataHandler(exchName string, data interface{}) error {
 				d.AssetType,
 				d)
 		}
+		if Bot.CandleManager.Started() {
+			if d.Exchange == "" {
+				d.Exchange = exchName
+			}
+			return Bot.CandleManager.ProcessKline(&d)
+		}
 	case *orderbook.Base:
 		if Bot.Settings.EnableExchangeSyncManager && Bot.ExchangeCurrencyPairManager != nil {
 			Bot.ExchangeCurrencyPairManager.update(exchName,
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/derivative"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
 	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
@@ -41,6 +43,13 @@ func TestHandleData(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
+	err = WebsocketDataHandler(exchName, &derivative.Info{
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+		AssetType: asset.PerpetualSwap,
+	})
+	if err != nil {
+		t.Error(err)
+	}
 	err = WebsocketDataHandler(exchName, &ticker.Price{})
 	if err != nil {
 		t.Error(err)
@@ -24,15 +24,22 @@ import (
 	"github.com/yurulab/gocryptotrader/common/file/archive"
 	"github.com/yurulab/gocryptotrader/currency"
 	"github.com/yurulab/gocryptotrader/database"
-	"github.com/yurulab/gocryptotrader/database/models/postgres"
-	"github.com/yurulab/gocryptotrader/database/models/sqlite3"
 	"github.com/yurulab/gocryptotrader/database/repository/audit"
+	"github.com/yurulab/gocryptotrader/database/repository/balance"
+	"github.com/yurulab/gocryptotrader/database/repository/fill"
+	"github.com/yurulab/gocryptotrader/database/repository/funding"
+	"github.com/yurulab/gocryptotrader/database/repository/journal"
+	"github.com/yurulab/gocryptotrader/database/repository/migration"
+	tickerdb "github.com/yurulab/gocryptotrader/database/repository/ticker"
 	"github.com/yurulab/gocryptotrader/exchanges/account"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/derivative"
 	"github.com/yurulab/gocryptotrader/exchanges/kline"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
 	"github.com/yurulab/gocryptotrader/exchanges/ticker"
+	"github.com/yurulab/gocryptotrader/exchanges/trade"
 	"github.com/yurulab/gocryptotrader/gctrpc"
 	"github.com/yurulab/gocryptotrader/gctrpc/auth"
 	gctscript "github.com/yurulab/gocryptotrader/gctscript/vm"
@@ -305,6 +312,19 @@ func (s *RPCServer) GetExchangeInfo(_ context.Context, r *gctrpc.GenericExchange
 		BaseCurrencies: strings.Join(exchCfg.BaseCurrencies.Strings(), ","),
 	}
 
+	if exch := GetExchangeByName(r.Exchange); exch != nil {
+		if b := exch.GetBase(); b != nil && b.Requester != nil {
+			status := b.Requester.GetBreakerStatus()
+			resp.CircuitBreaker = &gctrpc.CircuitBreakerStatus{
+				State:               status.State.String(),
+				ConsecutiveFailures: int64(status.Failures),
+			}
+			if !status.OpenedAt.IsZero() {
+				resp.CircuitBreaker.OpenedAt = status.OpenedAt.Unix()
+			}
+		}
+	}
+
 	resp.SupportedAssets = make(map[string]*gctrpc.PairsSupported)
 	assets := exchCfg.CurrencyPairs.GetAssetTypes()
 	for i := range assets {
@@ -471,13 +491,13 @@ func (s *RPCServer) GetOrderbooks(_ context.Context, r *gctrpc.GetOrderbooksRequ
 }
 
 // GetAccountInfo returns an account balance for a specific exchange
-func (s *RPCServer) GetAccountInfo(_ context.Context, r *gctrpc.GetAccountInfoRequest) (*gctrpc.GetAccountInfoResponse, error) {
+func (s *RPCServer) GetAccountInfo(ctx context.Context, r *gctrpc.GetAccountInfoRequest) (*gctrpc.GetAccountInfoResponse, error) {
 	exch := GetExchangeByName(r.Exchange)
 	if exch == nil {
 		return nil, errExchangeNotLoaded
 	}
 
-	resp, err := exch.FetchAccountInfo()
+	resp, err := exch.FetchAccountInfo(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -510,7 +530,7 @@ func (s *RPCServer) GetAccountInfoStream(r *gctrpc.GetAccountInfoRequest, stream
 		return errExchangeNotLoaded
 	}
 
-	initAcc, err := exch.FetchAccountInfo()
+	initAcc, err := exch.FetchAccountInfo(stream.Context())
 	if err != nil {
 		return err
 	}
@@ -743,13 +763,13 @@ func (s *RPCServer) GetForexRates(_ context.Context, r *gctrpc.GetForexRatesRequ
 
 // GetOrders returns all open orders, filtered by exchange, currency pair or
 // asset type
-func (s *RPCServer) GetOrders(_ context.Context, r *gctrpc.GetOrdersRequest) (*gctrpc.GetOrdersResponse, error) {
+func (s *RPCServer) GetOrders(ctx context.Context, r *gctrpc.GetOrdersRequest) (*gctrpc.GetOrdersResponse, error) {
 	exch := GetExchangeByName(r.Exchange)
 	if exch == nil {
 		return nil, errExchangeNotLoaded
 	}
 
-	resp, err := exch.GetActiveOrders(&order.GetOrdersRequest{
+	resp, err := exch.GetActiveOrders(ctx, &order.GetOrdersRequest{
 		Pairs: []currency.Pair{
 			currency.NewPairWithDelimiter(r.Pair.Base,
 				r.Pair.Quote, r.Pair.Delimiter),
@@ -780,12 +800,12 @@ func (s *RPCServer) GetOrders(_ context.Context, r *gctrpc.GetOrdersRequest) (*g
 }
 
 // GetOrder returns order information based on exchange and order ID
-func (s *RPCServer) GetOrder(_ context.Context, r *gctrpc.GetOrderRequest) (*gctrpc.OrderDetails, error) {
+func (s *RPCServer) GetOrder(ctx context.Context, r *gctrpc.GetOrderRequest) (*gctrpc.OrderDetails, error) {
 	exch := GetExchangeByName(r.Exchange)
 	if exch == nil {
 		return nil, errExchangeNotLoaded
 	}
-	result, err := exch.GetOrderInfo(r.OrderId)
+	result, err := exch.GetOrderInfo(ctx, r.OrderId)
 	if err != nil {
 		return nil, fmt.Errorf("error whilst trying to retrieve info for order %s: %s", r.OrderId, err)
 	}
@@ -822,7 +842,7 @@ func (s *RPCServer) GetOrder(_ context.Context, r *gctrpc.GetOrderRequest) (*gct
 
 // SubmitOrder submits an order specified by exchange, currency pair and asset
 // type
-func (s *RPCServer) SubmitOrder(_ context.Context, r *gctrpc.SubmitOrderRequest) (*gctrpc.SubmitOrderResponse, error) {
+func (s *RPCServer) SubmitOrder(ctx context.Context, r *gctrpc.SubmitOrderRequest) (*gctrpc.SubmitOrderResponse, error) {
 	exch := GetExchangeByName(r.Exchange)
 	if exch == nil {
 		return nil, errExchangeNotLoaded
@@ -843,7 +863,7 @@ func (s *RPCServer) SubmitOrder(_ context.Context, r *gctrpc.SubmitOrderRequest)
 		Exchange: r.Exchange,
 	}
 
-	resp, err := exch.SubmitOrder(submission)
+	resp, err := exch.SubmitOrder(ctx, submission)
 	if err != nil {
 		return &gctrpc.SubmitOrderResponse{}, err
 	}
@@ -856,7 +876,7 @@ func (s *RPCServer) SubmitOrder(_ context.Context, r *gctrpc.SubmitOrderRequest)
 
 // SimulateOrder simulates an order specified by exchange, currency pair and asset
 // type
-func (s *RPCServer) SimulateOrder(_ context.Context, r *gctrpc.SimulateOrderRequest) (*gctrpc.SimulateOrderResponse, error) {
+func (s *RPCServer) SimulateOrder(ctx context.Context, r *gctrpc.SimulateOrderRequest) (*gctrpc.SimulateOrderResponse, error) {
 	exch := GetExchangeByName(r.Exchange)
 	if exch == nil {
 		return nil, errExchangeNotLoaded
@@ -867,7 +887,7 @@ func (s *RPCServer) SimulateOrder(_ context.Context, r *gctrpc.SimulateOrderRequ
 		return nil, err
 	}
 
-	o, err := exch.FetchOrderbook(p, asset.Spot)
+	o, err := exch.FetchOrderbook(ctx, p, asset.Spot)
 	if err != nil {
 		return nil, err
 	}
@@ -897,7 +917,7 @@ func (s *RPCServer) SimulateOrder(_ context.Context, r *gctrpc.SimulateOrderRequ
 
 // WhaleBomb finds the amount required to reach a specific price target for a given exchange, pair
 // and asset type
-func (s *RPCServer) WhaleBomb(_ context.Context, r *gctrpc.WhaleBombRequest) (*gctrpc.SimulateOrderResponse, error) {
+func (s *RPCServer) WhaleBomb(ctx context.Context, r *gctrpc.WhaleBombRequest) (*gctrpc.SimulateOrderResponse, error) {
 	exch := GetExchangeByName(r.Exchange)
 	if exch == nil {
 		return nil, errExchangeNotLoaded
@@ -908,7 +928,7 @@ func (s *RPCServer) WhaleBomb(_ context.Context, r *gctrpc.WhaleBombRequest) (*g
 		return nil, err
 	}
 
-	o, err := exch.FetchOrderbook(p, asset.Spot)
+	o, err := exch.FetchOrderbook(ctx, p, asset.Spot)
 	if err != nil {
 		return nil, err
 	}
@@ -938,7 +958,7 @@ func (s *RPCServer) WhaleBomb(_ context.Context, r *gctrpc.WhaleBombRequest) (*g
 
 // CancelOrder cancels an order specified by exchange, currency pair and asset
 // type
-func (s *RPCServer) CancelOrder(_ context.Context, r *gctrpc.CancelOrderRequest) (*gctrpc.GenericResponse, error) {
+func (s *RPCServer) CancelOrder(ctx context.Context, r *gctrpc.CancelOrderRequest) (*gctrpc.GenericResponse, error) {
 	exch := GetExchangeByName(r.Exchange)
 	if exch == nil {
 		return nil, errExchangeNotLoaded
@@ -949,7 +969,7 @@ func (s *RPCServer) CancelOrder(_ context.Context, r *gctrpc.CancelOrderRequest)
 		return nil, err
 	}
 
-	err = exch.CancelOrder(&order.Cancel{
+	err = exch.CancelOrder(request.BypassBreaker(ctx), &order.Cancel{
 		AccountID:     r.AccountId,
 		ID:            r.OrderId,
 		Side:          order.Side(r.Side),
@@ -981,6 +1001,7 @@ func (s *RPCServer) AddEvent(_ context.Context, r *gctrpc.AddEventRequest) (*gct
 		Condition:        r.ConditionParams.Condition,
 		OrderbookAmount:  r.ConditionParams.OrderbookAmount,
 		Price:            r.ConditionParams.Price,
+		CandleInterval:   kline.Interval(r.ConditionParams.CandleInterval),
 	}
 
 	p := currency.NewPairWithDelimiter(r.Pair.Base,
@@ -1549,6 +1570,452 @@ func (s *RPCServer) GetExchangeTickerStream(r *gctrpc.GetExchangeTickerStreamReq
 	}
 }
 
+// GetCandleStream streams the live candle for the specified exchange, pair,
+// asset type and interval
+func (s *RPCServer) GetCandleStream(r *gctrpc.GetCandleStreamRequest, stream gctrpc.GoCryptoTrader_GetCandleStreamServer) error {
+	if r.Exchange == "" {
+		return errors.New(errExchangeNameUnset)
+	}
+
+	if r.Pair.String() == "" {
+		return errors.New(errCurrencyPairUnset)
+	}
+
+	if r.AssetType == "" {
+		return errors.New(errAssetTypeUnset)
+	}
+
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return err
+	}
+
+	pipe, err := kline.SubscribeKline(r.Exchange,
+		p,
+		asset.Item(r.AssetType),
+		kline.Interval(r.TimeInterval))
+	if err != nil {
+		return err
+	}
+
+	defer pipe.Release()
+
+	for {
+		data, ok := <-pipe.C
+		if !ok {
+			return errors.New(errDispatchSystem)
+		}
+		l := (*data.(*interface{})).(kline.Live)
+
+		err := stream.Send(liveCandleToRPC(&l))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// GetExchangeCandleStream streams all live candles associated with an exchange
+func (s *RPCServer) GetExchangeCandleStream(r *gctrpc.GetExchangeCandleStreamRequest, stream gctrpc.GoCryptoTrader_GetExchangeCandleStreamServer) error {
+	if r.Exchange == "" {
+		return errors.New(errExchangeNameUnset)
+	}
+
+	pipe, err := kline.SubscribeToExchangeKlines(r.Exchange)
+	if err != nil {
+		return err
+	}
+
+	defer pipe.Release()
+
+	for {
+		data, ok := <-pipe.C
+		if !ok {
+			return errors.New(errDispatchSystem)
+		}
+		l := (*data.(*interface{})).(kline.Live)
+
+		err := stream.Send(liveCandleToRPC(&l))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// GetRecentTrades returns the recent trades stored for the specified exchange,
+// pair and asset type
+func (s *RPCServer) GetRecentTrades(_ context.Context, r *gctrpc.GetRecentTradesRequest) (*gctrpc.RecentTradesResponse, error) {
+	if r.Exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+
+	if r.Pair.String() == "" {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+
+	if r.AssetType == "" {
+		return nil, errors.New(errAssetTypeUnset)
+	}
+
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return nil, err
+	}
+
+	trades, err := trade.GetRecentTrades(r.Exchange, p, asset.Item(r.AssetType))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.RecentTradesResponse{
+		Exchange:  r.Exchange,
+		Pair:      r.Pair,
+		AssetType: r.AssetType,
+	}
+	for i := range trades {
+		resp.Trades = append(resp.Trades, tradeToRPC(&trades[i]))
+	}
+	return resp, nil
+}
+
+// GetTradeStream streams new trades for the specified exchange, pair and asset
+// type
+func (s *RPCServer) GetTradeStream(r *gctrpc.GetTradeStreamRequest, stream gctrpc.GoCryptoTrader_GetTradeStreamServer) error {
+	if r.Exchange == "" {
+		return errors.New(errExchangeNameUnset)
+	}
+
+	if r.Pair.String() == "" {
+		return errors.New(errCurrencyPairUnset)
+	}
+
+	if r.AssetType == "" {
+		return errors.New(errAssetTypeUnset)
+	}
+
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return err
+	}
+
+	pipe, err := trade.SubscribeTrades(r.Exchange, p, asset.Item(r.AssetType))
+	if err != nil {
+		return err
+	}
+
+	defer pipe.Release()
+
+	for {
+		data, ok := <-pipe.C
+		if !ok {
+			return errors.New(errDispatchSystem)
+		}
+		t := (*data.(*interface{})).(trade.Data)
+
+		err := stream.Send(tradeToRPC(&t))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// GetHistoricTrades returns the public trades executed on an exchange within
+// the supplied time range. When unset the end time defaults to now and the
+// start time to an hour before the end time
+func (s *RPCServer) GetHistoricTrades(ctx context.Context, r *gctrpc.GetHistoricTradesRequest) (*gctrpc.GetHistoricTradesResponse, error) {
+	if r.Exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+
+	if r.Pair.String() == "" {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+
+	if r.AssetType == "" {
+		return nil, errors.New(errAssetTypeUnset)
+	}
+
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("Exchange " + r.Exchange + " not found")
+	}
+
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return nil, err
+	}
+
+	end := time.Now()
+	if r.End != 0 {
+		end = time.Unix(r.End, 0)
+	}
+	start := end.Add(-time.Hour)
+	if r.Start != 0 {
+		start = time.Unix(r.Start, 0)
+	}
+
+	a := asset.Item(strings.ToLower(r.AssetType))
+	trades, err := exch.GetExchangeHistory(ctx, p, a, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetHistoricTradesResponse{
+		Exchange:  exch.GetName(),
+		Pair:      r.Pair,
+		AssetType: a.String(),
+		Start:     start.Unix(),
+		End:       end.Unix(),
+	}
+	for i := range trades {
+		resp.Trades = append(resp.Trades, &gctrpc.TradeResponse{
+			Exchange:  exch.GetName(),
+			Pair:      r.Pair,
+			AssetType: a.String(),
+			Id:        trades[i].TID,
+			Price:     trades[i].Price,
+			Amount:    trades[i].Amount,
+			Side:      trades[i].Side,
+			Timestamp: trades[i].Timestamp.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+// GetDerivativeInfo returns the funding, open interest and pricing data for a
+// derivative contract
+func (s *RPCServer) GetDerivativeInfo(ctx context.Context, r *gctrpc.GetDerivativeInfoRequest) (*gctrpc.DerivativeInfoResponse, error) {
+	if r.Exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+
+	if r.Pair.String() == "" {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+
+	if r.AssetType == "" {
+		return nil, errors.New(errAssetTypeUnset)
+	}
+
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("Exchange " + r.Exchange + " not found")
+	}
+
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := exch.FetchDerivativeInfo(ctx, p, asset.Item(strings.ToLower(r.AssetType)))
+	if err != nil {
+		return nil, err
+	}
+	return derivativeInfoToRPC(info), nil
+}
+
+// GetDerivativeInfoStream streams derivative information updates for the
+// specified exchange, pair and asset type. Updates are published by the
+// exchange syncer when derivative syncing is enabled
+func (s *RPCServer) GetDerivativeInfoStream(r *gctrpc.GetDerivativeInfoStreamRequest, stream gctrpc.GoCryptoTrader_GetDerivativeInfoStreamServer) error {
+	if r.Exchange == "" {
+		return errors.New(errExchangeNameUnset)
+	}
+
+	if r.Pair.String() == "" {
+		return errors.New(errCurrencyPairUnset)
+	}
+
+	if r.AssetType == "" {
+		return errors.New(errAssetTypeUnset)
+	}
+
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return errors.New("Exchange " + r.Exchange + " not found")
+	}
+
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return err
+	}
+
+	a := asset.Item(strings.ToLower(r.AssetType))
+	// Seed the service so there is an item to subscribe to
+	info, err := exch.FetchDerivativeInfo(stream.Context(), p, a)
+	if err != nil {
+		return err
+	}
+
+	pipe, err := derivative.SubscribeInfo(exch.GetName(), p, a)
+	if err != nil {
+		return err
+	}
+
+	defer pipe.Release()
+
+	err = stream.Send(derivativeInfoToRPC(info))
+	if err != nil {
+		return err
+	}
+
+	for {
+		data, ok := <-pipe.C
+		if !ok {
+			return errors.New(errDispatchSystem)
+		}
+		d := (*data.(*interface{})).(derivative.Info)
+
+		err := stream.Send(derivativeInfoToRPC(&d))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// GetExchangeDerivativeInfoStream streams all derivative information updates
+// for an exchange
+func (s *RPCServer) GetExchangeDerivativeInfoStream(r *gctrpc.GetExchangeDerivativeInfoStreamRequest, stream gctrpc.GoCryptoTrader_GetExchangeDerivativeInfoStreamServer) error {
+	if r.Exchange == "" {
+		return errors.New(errExchangeNameUnset)
+	}
+
+	pipe, err := derivative.SubscribeToExchangeInfo(r.Exchange)
+	if err != nil {
+		return err
+	}
+
+	defer pipe.Release()
+
+	for {
+		data, ok := <-pipe.C
+		if !ok {
+			return errors.New(errDispatchSystem)
+		}
+		d := (*data.(*interface{})).(derivative.Info)
+
+		err := stream.Send(derivativeInfoToRPC(&d))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// GetLiquidations returns the forced liquidations on an exchange within the
+// supplied time range. When unset the end time defaults to now and the start
+// time to an hour before the end time
+func (s *RPCServer) GetLiquidations(ctx context.Context, r *gctrpc.GetLiquidationsRequest) (*gctrpc.GetLiquidationsResponse, error) {
+	if r.Exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+
+	if r.Pair.String() == "" {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+
+	if r.AssetType == "" {
+		return nil, errors.New(errAssetTypeUnset)
+	}
+
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("Exchange " + r.Exchange + " not found")
+	}
+
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return nil, err
+	}
+
+	end := time.Now()
+	if r.End != 0 {
+		end = time.Unix(r.End, 0)
+	}
+	start := end.Add(-time.Hour)
+	if r.Start != 0 {
+		start = time.Unix(r.Start, 0)
+	}
+
+	a := asset.Item(strings.ToLower(r.AssetType))
+	liquidations, err := exch.GetLiquidations(ctx, p, a, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetLiquidationsResponse{
+		Exchange:  exch.GetName(),
+		Pair:      r.Pair,
+		AssetType: a.String(),
+		Start:     start.Unix(),
+		End:       end.Unix(),
+	}
+	for i := range liquidations {
+		resp.Liquidations = append(resp.Liquidations, &gctrpc.LiquidationResponse{
+			Id:        liquidations[i].ID,
+			Price:     liquidations[i].Price,
+			Amount:    liquidations[i].Amount,
+			Side:      liquidations[i].Side.String(),
+			Timestamp: liquidations[i].Timestamp.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+func derivativeInfoToRPC(d *derivative.Info) *gctrpc.DerivativeInfoResponse {
+	return &gctrpc.DerivativeInfoResponse{
+		Exchange: d.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Base:      d.Pair.Base.String(),
+			Quote:     d.Pair.Quote.String(),
+			Delimiter: d.Pair.Delimiter,
+		},
+		AssetType:            d.AssetType.String(),
+		MarkPrice:            d.MarkPrice,
+		IndexPrice:           d.IndexPrice,
+		FundingRate:          d.FundingRate,
+		PredictedFundingRate: d.PredictedFundingRate,
+		NextFundingTime:      d.NextFundingTime.Unix(),
+		OpenInterest:         d.OpenInterest,
+		LastUpdated:          d.LastUpdated.Unix(),
+	}
+}
+
+func tradeToRPC(t *trade.Data) *gctrpc.TradeResponse {
+	return &gctrpc.TradeResponse{
+		Exchange: t.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Base:      t.Pair.Base.String(),
+			Quote:     t.Pair.Quote.String(),
+			Delimiter: t.Pair.Delimiter},
+		AssetType: t.AssetType.String(),
+		Id:        t.TID,
+		Price:     t.Price,
+		Amount:    t.Amount,
+		Side:      t.Side.String(),
+		Timestamp: t.Timestamp.Unix(),
+	}
+}
+
+func liveCandleToRPC(l *kline.Live) *gctrpc.CandleStreamResponse {
+	return &gctrpc.CandleStreamResponse{
+		Exchange: l.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Base:      l.Pair.Base.String(),
+			Quote:     l.Pair.Quote.String(),
+			Delimiter: l.Pair.Delimiter},
+		AssetType: l.Asset.String(),
+		Interval:  l.Interval.String(),
+		Candle: &gctrpc.Candle{
+			Time:   l.Candle.Time.Unix(),
+			Low:    l.Candle.Low,
+			High:   l.Candle.High,
+			Open:   l.Candle.Open,
+			Close:  l.Candle.Close,
+			Volume: l.Candle.Volume,
+		},
+		Closed: l.Closed,
+	}
+}
+
 // GetAuditEvent returns matching audit events from database
 func (s *RPCServer) GetAuditEvent(_ context.Context, r *gctrpc.GetAuditEventRequest) (*gctrpc.GetAuditEventResponse, error) {
 	UTCStartTime, err := time.Parse(common.SimpleTimeFormat, r.StartDate)
@@ -1570,35 +2037,418 @@ func (s *RPCServer) GetAuditEvent(_ context.Context, r *gctrpc.GetAuditEventRequ
 
 	resp := gctrpc.GetAuditEventResponse{}
 
-	switch v := events.(type) {
-	case postgres.AuditEventSlice:
-		for x := range v {
-			tempEvent := &gctrpc.AuditEvent{
-				Type:       v[x].Type,
-				Identifier: v[x].Identifier,
-				Message:    v[x].Message,
-				Timestamp:  v[x].CreatedAt.In(loc).Format(common.SimpleTimeFormat),
-			}
+	for x := range events {
+		resp.Events = append(resp.Events, &gctrpc.AuditEvent{
+			Type:       events[x].Type,
+			Identifier: events[x].Identifier,
+			Message:    events[x].Message,
+			Timestamp:  events[x].CreatedAt.In(loc).Format(common.SimpleTimeFormat),
+			Hash:       events[x].Hash,
+		})
+	}
+
+	return &resp, nil
+}
+
+// GetRequestJournal returns matching authenticated exchange requests from the
+// database
+func (s *RPCServer) GetRequestJournal(_ context.Context, r *gctrpc.GetRequestJournalRequest) (*gctrpc.GetRequestJournalResponse, error) {
+	UTCStartTime, err := time.Parse(common.SimpleTimeFormat, r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	UTCEndTime, err := time.Parse(common.SimpleTimeFormat, r.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := time.FixedZone("", int(r.Offset))
+
+	entries, err := journal.Get(r.Exchange, UTCStartTime, UTCEndTime, r.OrderBy, int(r.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := gctrpc.GetRequestJournalResponse{}
+
+	for x := range entries {
+		resp.Entries = append(resp.Entries, &gctrpc.RequestJournalEntry{
+			Id:         entries[x].ID,
+			Exchange:   entries[x].Exchange,
+			Method:     entries[x].Method,
+			Path:       entries[x].Path,
+			Query:      entries[x].Query,
+			Body:       entries[x].Body,
+			StatusCode: int64(entries[x].StatusCode),
+			LatencyMs:  float64(entries[x].Latency) / float64(time.Millisecond),
+			Error:      entries[x].Error,
+			Timestamp:  entries[x].Timestamp.In(loc).Format(common.SimpleTimeFormat),
+		})
+	}
+
+	return &resp, nil
+}
 
-			resp.Events = append(resp.Events, tempEvent)
+// VerifyAuditEvents walks the audit event hash chain and checks it against the
+// exported audit checkpoints if enabled
+func (s *RPCServer) VerifyAuditEvents(_ context.Context, _ *gctrpc.VerifyAuditEventsRequest) (*gctrpc.VerifyAuditEventsResponse, error) {
+	var checkpointsVerified int64
+	var archived []audit.ArchivedEvent
+	var checkpointBreaks []audit.Break
+	if cfg := Bot.Config.Database.AuditCheckpoint; cfg.Enabled {
+		var err error
+		checkpointsVerified, archived, checkpointBreaks, err = verifyAuditCheckpoints(cfg.Path, cfg.Key)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
 		}
-	case sqlite3.AuditEventSlice:
-		for x := range v {
-			tempEvent := &gctrpc.AuditEvent{
-				Type:       v[x].Type,
-				Identifier: v[x].Identifier,
-				Message:    v[x].Message,
-				Timestamp:  v[x].CreatedAt,
-			}
-			resp.Events = append(resp.Events, tempEvent)
+	}
+
+	result, err := audit.Verify(archived)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := gctrpc.VerifyAuditEventsResponse{
+		Checked:             result.Checked,
+		Unhashed:            result.Unhashed,
+		LastId:              result.LastID,
+		LastHash:            result.LastHash,
+		ChainStart:          result.ChainStart,
+		CheckpointsVerified: checkpointsVerified,
+	}
+	breaks := append(result.Breaks, checkpointBreaks...)
+
+	for i := range breaks {
+		resp.Breaks = append(resp.Breaks, &gctrpc.AuditChainBreak{
+			Id:     breaks[i].ID,
+			Reason: breaks[i].Reason,
+		})
+	}
+	resp.Valid = len(resp.Breaks) == 0
+	return &resp, nil
+}
+
+// GetPortfolioHistory returns recorded balance snapshots between the supplied
+// dates
+func (s *RPCServer) GetPortfolioHistory(_ context.Context, r *gctrpc.GetPortfolioHistoryRequest) (*gctrpc.GetPortfolioHistoryResponse, error) {
+	UTCStartTime, err := time.Parse(common.SimpleTimeFormat, r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	UTCEndTime, err := time.Parse(common.SimpleTimeFormat, r.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := time.FixedZone("", int(r.Offset))
+
+	snapshots, err := balance.Get(r.Exchange, r.Currency, UTCStartTime, UTCEndTime, int(r.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := gctrpc.GetPortfolioHistoryResponse{}
+	for x := range snapshots {
+		resp.Snapshots = append(resp.Snapshots, &gctrpc.BalanceSnapshot{
+			Exchange:     snapshots[x].Exchange,
+			Account:      snapshots[x].Account,
+			Currency:     snapshots[x].Currency,
+			Total:        snapshots[x].Total,
+			Hold:         snapshots[x].Hold,
+			FiatCurrency: snapshots[x].FiatCurrency,
+			FiatValue:    snapshots[x].FiatValue,
+			Priced:       snapshots[x].Priced,
+			Timestamp:    snapshots[x].Timestamp.In(loc).Format(common.SimpleTimeFormat),
+		})
+	}
+	return &resp, nil
+}
+
+// GetEquityCurve returns the total fiat value of each balance snapshot between
+// the supplied dates
+func (s *RPCServer) GetEquityCurve(_ context.Context, r *gctrpc.GetEquityCurveRequest) (*gctrpc.GetEquityCurveResponse, error) {
+	UTCStartTime, err := time.Parse(common.SimpleTimeFormat, r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	UTCEndTime, err := time.Parse(common.SimpleTimeFormat, r.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := time.FixedZone("", int(r.Offset))
+
+	points, err := balance.GetEquityCurve(r.Exchange, UTCStartTime, UTCEndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := gctrpc.GetEquityCurveResponse{}
+	for x := range points {
+		resp.Points = append(resp.Points, &gctrpc.EquityPoint{
+			Timestamp:    points[x].Timestamp.In(loc).Format(common.SimpleTimeFormat),
+			FiatCurrency: points[x].FiatCurrency,
+			Value:        points[x].Value,
+			Unpriced:     points[x].Unpriced,
+		})
+	}
+	return &resp, nil
+}
+
+// GetFills returns imported exchange fills between the supplied dates
+func (s *RPCServer) GetFills(_ context.Context, r *gctrpc.GetFillsRequest) (*gctrpc.GetFillsResponse, error) {
+	UTCStartTime, err := time.Parse(common.SimpleTimeFormat, r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	UTCEndTime, err := time.Parse(common.SimpleTimeFormat, r.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := time.FixedZone("", int(r.Offset))
+
+	fills, err := fill.Get(r.Exchange, UTCStartTime, UTCEndTime, int(r.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := gctrpc.GetFillsResponse{}
+	for x := range fills {
+		resp.Fills = append(resp.Fills, fillToRPC(&fills[x], loc))
+	}
+	return &resp, nil
+}
+
+// ReconcileFills compares the fills imported for an exchange with the orders
+// tracked by the order manager
+func (s *RPCServer) ReconcileFills(_ context.Context, r *gctrpc.ReconcileFillsRequest) (*gctrpc.ReconcileFillsResponse, error) {
+	if r.Exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("Exchange " + r.Exchange + " not found")
+	}
+
+	UTCStartTime, err := time.Parse(common.SimpleTimeFormat, r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	UTCEndTime, err := time.Parse(common.SimpleTimeFormat, r.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := time.FixedZone("", int(r.Offset))
+
+	result, err := ReconcileFills(exch.GetName(), UTCStartTime, UTCEndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := gctrpc.ReconcileFillsResponse{
+		Exchange: result.Exchange,
+		Matched:  int64(result.Matched),
+	}
+	for x := range result.Missing {
+		resp.Missing = append(resp.Missing, &gctrpc.ReconciledOrder{
+			OrderId:        result.Missing[x].OrderID,
+			Pair:           result.Missing[x].Pair,
+			ExecutedAmount: result.Missing[x].ExecutedAmount,
+		})
+	}
+	for x := range result.Mismatched {
+		resp.Mismatched = append(resp.Mismatched, &gctrpc.ReconciledOrder{
+			OrderId:        result.Mismatched[x].OrderID,
+			Pair:           result.Mismatched[x].Pair,
+			ExecutedAmount: result.Mismatched[x].ExecutedAmount,
+			FilledAmount:   result.Mismatched[x].FilledAmount,
+		})
+	}
+	for x := range result.Unknown {
+		resp.Unknown = append(resp.Unknown, fillToRPC(&result.Unknown[x], loc))
+	}
+	return &resp, nil
+}
+
+func fillToRPC(f *fill.Fill, loc *time.Location) *gctrpc.Fill {
+	return &gctrpc.Fill{
+		Exchange:    f.Exchange,
+		Asset:       f.Asset,
+		Pair:        f.Pair,
+		OrderId:     f.OrderID,
+		Tid:         f.TID,
+		Side:        f.Side,
+		Type:        f.Type,
+		Price:       f.Price,
+		Amount:      f.Amount,
+		Fee:         f.Fee,
+		FeeCurrency: f.FeeCurrency,
+		IsMaker:     f.IsMaker,
+		Timestamp:   f.Timestamp.In(loc).Format(common.SimpleTimeFormat),
+	}
+}
+
+// GetFundingHistory returns the deposits and withdrawals synced from exchanges
+// between the supplied dates
+func (s *RPCServer) GetFundingHistory(_ context.Context, r *gctrpc.GetFundingHistoryRequest) (*gctrpc.GetFundingHistoryResponse, error) {
+	UTCStartTime, err := time.Parse(common.SimpleTimeFormat, r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	UTCEndTime, err := time.Parse(common.SimpleTimeFormat, r.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := time.FixedZone("", int(r.Offset))
+
+	transfers, err := funding.Get(r.Exchange, UTCStartTime, UTCEndTime, int(r.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := gctrpc.GetFundingHistoryResponse{}
+	for x := range transfers {
+		resp.Transfers = append(resp.Transfers, &gctrpc.FundingTransfer{
+			Exchange:          transfers[x].Exchange,
+			TransferId:        transfers[x].TransferID,
+			TransferType:      transfers[x].TransferType,
+			Status:            transfers[x].Status,
+			Currency:          transfers[x].Currency,
+			Amount:            transfers[x].Amount,
+			Fee:               transfers[x].Fee,
+			Description:       transfers[x].Description,
+			CryptoTxId:        transfers[x].CryptoTxID,
+			CryptoToAddress:   transfers[x].CryptoToAddress,
+			CryptoFromAddress: transfers[x].CryptoFromAddress,
+			BankTo:            transfers[x].BankTo,
+			BankFrom:          transfers[x].BankFrom,
+			WithdrawalId:      transfers[x].WithdrawalID,
+			Timestamp:         transfers[x].Timestamp.In(loc).Format(common.SimpleTimeFormat),
+		})
+	}
+	return &resp, nil
+}
+
+// GetDatabaseStatus returns the database schema version along with the
+// applied and pending migrations
+func (s *RPCServer) GetDatabaseStatus(_ context.Context, _ *gctrpc.GetDatabaseStatusRequest) (*gctrpc.GetDatabaseStatusResponse, error) {
+	if !Bot.DatabaseManager.Started() {
+		return nil, errors.New("database manager not started")
+	}
+
+	status, err := migration.GetStatus(Bot.Settings.MigrationDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gctrpc.GetDatabaseStatusResponse{
+		Driver:        status.Driver,
+		Version:       status.Version,
+		LatestVersion: status.Latest,
+		Pending:       int64(len(status.Pending())),
+		Migrations:    rpcMigrations(status.Migrations),
+	}, nil
+}
+
+// MigrateDatabase applies every pending database migration
+func (s *RPCServer) MigrateDatabase(_ context.Context, _ *gctrpc.MigrateDatabaseRequest) (*gctrpc.MigrateDatabaseResponse, error) {
+	if !Bot.DatabaseManager.Started() {
+		return nil, errors.New("database manager not started")
+	}
+
+	applied, err := migration.Up(Bot.Settings.MigrationDir)
+	if err != nil {
+		return nil, err
+	}
+	status, err := migration.GetStatus(Bot.Settings.MigrationDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gctrpc.MigrateDatabaseResponse{
+		Version: status.Version,
+		Applied: rpcMigrations(applied),
+	}, nil
+}
+
+func rpcMigrations(migrations []migration.Migration) []*gctrpc.DatabaseMigration {
+	resp := make([]*gctrpc.DatabaseMigration, len(migrations))
+	for i := range migrations {
+		resp[i] = &gctrpc.DatabaseMigration{
+			Version: migrations[i].Version,
+			Name:    migrations[i].Name,
+			Applied: migrations[i].Applied,
+		}
+		if migrations[i].Applied {
+			resp[i].AppliedAt = migrations[i].AppliedAt.Format(common.SimpleTimeFormat)
 		}
 	}
+	return resp
+}
+
+// GetTickerHistory returns the recorded ticker history of a currency pair,
+// tickers older than the tick retention are returned as minute aggregates
+func (s *RPCServer) GetTickerHistory(_ context.Context, r *gctrpc.GetTickerHistoryRequest) (*gctrpc.GetTickerHistoryResponse, error) {
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+
+	UTCStartTime, err := time.Parse(common.SimpleTimeFormat, r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	UTCEndTime, err := time.Parse(common.SimpleTimeFormat, r.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := time.FixedZone("", int(r.Offset))
 
+	history, err := tickerdb.GetHistory(r.Exchange,
+		asset.Item(strings.ToLower(r.AssetType)),
+		currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter),
+		UTCStartTime,
+		UTCEndTime,
+		int(r.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := gctrpc.GetTickerHistoryResponse{
+		Exchange:  r.Exchange,
+		Pair:      r.Pair,
+		AssetType: r.AssetType,
+	}
+	for x := range history {
+		resp.History = append(resp.History, &gctrpc.TickerHistoryItem{
+			Timestamp: history[x].Time.In(loc).Format(common.SimpleTimeFormat),
+			Period:    int64(history[x].Period.Seconds()),
+			Open:      history[x].Open,
+			High:      history[x].High,
+			Low:       history[x].Low,
+			Close:     history[x].Close,
+			Bid:       history[x].Bid,
+			Ask:       history[x].Ask,
+			Volume:    history[x].Volume,
+			Ticks:     history[x].Ticks,
+		})
+	}
 	return &resp, nil
 }
 
 // GetHistoricCandles returns historical candles for a given exchange
-func (s *RPCServer) GetHistoricCandles(_ context.Context, req *gctrpc.GetHistoricCandlesRequest) (*gctrpc.GetHistoricCandlesResponse, error) {
+func (s *RPCServer) GetHistoricCandles(ctx context.Context, req *gctrpc.GetHistoricCandlesRequest) (*gctrpc.GetHistoricCandlesResponse, error) {
 	if req.Exchange == "" {
 		return nil, errors.New(errExchangeNameUnset)
 	}
@@ -1607,15 +2457,15 @@ func (s *RPCServer) GetHistoricCandles(_ context.Context, req *gctrpc.GetHistori
 		return nil, errors.New(errCurrencyPairUnset)
 	}
 
-	exchange := GetExchangeByName(req.Exchange)
-	if exchange == nil {
+	exch := GetExchangeByName(req.Exchange)
+	if exch == nil {
 		return nil, errors.New("Exchange " + req.Exchange + " not found")
 	}
 
 	var candles kline.Item
 	var err error
 	if req.ExRequest {
-		candles, err = exchange.GetHistoricCandlesExtended(currency.Pair{
+		candles, err = exch.GetHistoricCandlesExtended(ctx, currency.Pair{
 			Delimiter: req.Pair.Delimiter,
 			Base:      currency.NewCode(req.Pair.Base),
 			Quote:     currency.NewCode(req.Pair.Quote),
@@ -1628,7 +2478,7 @@ func (s *RPCServer) GetHistoricCandles(_ context.Context, req *gctrpc.GetHistori
 			return nil, err
 		}
 	} else {
-		candles, err = exchange.GetHistoricCandles(currency.Pair{
+		candles, err = exch.GetHistoricCandles(ctx, currency.Pair{
 			Delimiter: req.Pair.Delimiter,
 			Base:      currency.NewCode(req.Pair.Base),
 			Quote:     currency.NewCode(req.Pair.Quote),
@@ -1642,7 +2492,7 @@ func (s *RPCServer) GetHistoricCandles(_ context.Context, req *gctrpc.GetHistori
 		}
 	}
 	resp := gctrpc.GetHistoricCandlesResponse{
-		Exchange: exchange.GetName(),
+		Exchange: exch.GetName(),
 		Interval: kline.Interval(req.TimeInterval).Short(),
 		Start:    req.Start,
 		End:      req.End,
@@ -2037,7 +2887,7 @@ func (s *RPCServer) SetAllExchangePairs(_ context.Context, r *gctrpc.SetExchange
 // UpdateExchangeSupportedPairs forces an update of the supported pairs which
 // will update the available pairs list and remove any assets that are disabled
 // by the exchange
-func (s *RPCServer) UpdateExchangeSupportedPairs(_ context.Context, r *gctrpc.UpdateExchangeSupportedPairsRequest) (*gctrpc.GenericResponse, error) {
+func (s *RPCServer) UpdateExchangeSupportedPairs(ctx context.Context, r *gctrpc.UpdateExchangeSupportedPairsRequest) (*gctrpc.GenericResponse, error) {
 	exch := GetExchangeByName(r.Exchange)
 	if exch == nil {
 		return nil, errExchangeNotLoaded
@@ -2053,7 +2903,7 @@ func (s *RPCServer) UpdateExchangeSupportedPairs(_ context.Context, r *gctrpc.Up
 			errors.New("cannot auto pair update for exchange, a manual update is needed")
 	}
 
-	err := exch.UpdateTradablePairs(false)
+	err := exch.UpdateTradablePairs(ctx, false)
 	if err != nil {
 		return nil, err
 	}
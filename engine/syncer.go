@@ -1,15 +1,22 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"sync/atomic"
 	"time"
 
+	"github.com/yurulab/gocryptotrader/common"
 	"github.com/yurulab/gocryptotrader/currency"
+	exchange "github.com/yurulab/gocryptotrader/exchanges"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/derivative"
+	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/exchanges/ticker"
+	"github.com/yurulab/gocryptotrader/exchanges/trade"
 	"github.com/yurulab/gocryptotrader/log"
+	"github.com/yurulab/gocryptotrader/metrics"
 )
 
 // const holds the sync item types
@@ -17,6 +24,7 @@ const (
 	SyncItemTicker = iota
 	SyncItemOrderbook
 	SyncItemTrade
+	SyncItemDerivative
 
 	DefaultSyncerWorkers = 15
 	DefaultSyncerTimeout = time.Second * 15
@@ -25,11 +33,30 @@ const (
 var (
 	createdCounter = 0
 	removedCounter = 0
+
+	syncerLastUpdate = metrics.NewGaugeVec("gct_syncer_last_update_timestamp_seconds",
+		"Unix time of the last sync of an exchange currency pair item.",
+		"exchange", "pair", "asset", "item")
+	syncerUpdates = metrics.NewCounterVec("gct_syncer_updates_total",
+		"Exchange currency pair sync updates by item and result.",
+		"exchange", "item", "result")
+	syncerMaxLag = metrics.NewGaugeFunc("gct_syncer_max_lag_seconds",
+		"Longest time since any synced exchange currency pair item was last updated.",
+		func() float64 {
+			if Bot == nil || Bot.ExchangeCurrencyPairManager == nil {
+				return 0
+			}
+			return Bot.ExchangeCurrencyPairManager.maxLag(time.Now()).Seconds()
+		})
 )
 
+func init() {
+	metrics.MustRegister(syncerLastUpdate, syncerUpdates, syncerMaxLag)
+}
+
 // NewCurrencyPairSyncer starts a new CurrencyPairSyncer
 func NewCurrencyPairSyncer(c CurrencyPairSyncerConfig) (*ExchangeCurrencyPairSyncer, error) {
-	if !c.SyncOrderbook && !c.SyncTicker && !c.SyncTrades {
+	if !c.SyncOrderbook && !c.SyncTicker && !c.SyncTrades && !c.SyncDerivatives {
 		return nil, errors.New("no sync items enabled")
 	}
 
@@ -46,6 +73,7 @@ func NewCurrencyPairSyncer(c CurrencyPairSyncerConfig) (*ExchangeCurrencyPairSyn
 			SyncTicker:       c.SyncTicker,
 			SyncOrderbook:    c.SyncOrderbook,
 			SyncTrades:       c.SyncTrades,
+			SyncDerivatives:  c.SyncDerivatives,
 			SyncContinuously: c.SyncContinuously,
 			SyncTimeout:      c.SyncTimeout,
 			NumWorkers:       c.NumWorkers,
@@ -53,12 +81,13 @@ func NewCurrencyPairSyncer(c CurrencyPairSyncerConfig) (*ExchangeCurrencyPairSyn
 	}
 
 	s.tickerBatchLastRequested = make(map[string]time.Time)
+	s.ctx, s.cancel = context.WithCancel(context.Background())
 
 	log.Debugf(log.SyncMgr,
 		"Exchange currency pair syncer config: continuous: %v ticker: %v"+
-			" orderbook: %v trades: %v workers: %v verbose: %v timeout: %v\n",
+			" orderbook: %v trades: %v derivatives: %v workers: %v verbose: %v timeout: %v\n",
 		s.Cfg.SyncContinuously, s.Cfg.SyncTicker, s.Cfg.SyncOrderbook,
-		s.Cfg.SyncTrades, s.Cfg.NumWorkers, s.Cfg.Verbose, s.Cfg.SyncTimeout)
+		s.Cfg.SyncTrades, s.Cfg.SyncDerivatives, s.Cfg.NumWorkers, s.Cfg.Verbose, s.Cfg.SyncTimeout)
 	return &s, nil
 }
 
@@ -134,6 +163,19 @@ func (e *ExchangeCurrencyPairSyncer) add(c *CurrencyPairSyncAgent) {
 		}
 	}
 
+	if e.Cfg.SyncDerivatives && derivative.IsDerivative(c.AssetType) {
+		if e.Cfg.Verbose {
+			log.Debugf(log.SyncMgr,
+				"%s: Added derivative sync item %v %v: using REST: %v\n",
+				c.Exchange, FormatCurrency(c.Pair).String(), c.AssetType,
+				c.Derivative.IsUsingREST)
+		}
+		if atomic.LoadInt32(&e.initSyncCompleted) != 1 {
+			e.initSyncWG.Add(1)
+			createdCounter++
+		}
+	}
+
 	c.Created = time.Now()
 	e.CurrencyPairs = append(e.CurrencyPairs, *c)
 }
@@ -167,6 +209,8 @@ func (e *ExchangeCurrencyPairSyncer) isProcessing(exchangeName string, p currenc
 				return e.CurrencyPairs[x].Orderbook.IsProcessing
 			case SyncItemTrade:
 				return e.CurrencyPairs[x].Trade.IsProcessing
+			case SyncItemDerivative:
+				return e.CurrencyPairs[x].Derivative.IsProcessing
 			}
 		}
 	}
@@ -189,6 +233,8 @@ func (e *ExchangeCurrencyPairSyncer) setProcessing(exchangeName string, p curren
 				e.CurrencyPairs[x].Orderbook.IsProcessing = processing
 			case SyncItemTrade:
 				e.CurrencyPairs[x].Trade.IsProcessing = processing
+			case SyncItemDerivative:
+				e.CurrencyPairs[x].Derivative.IsProcessing = processing
 			}
 		}
 	}
@@ -214,11 +260,18 @@ func (e *ExchangeCurrencyPairSyncer) update(exchangeName string, p currency.Pair
 		if !e.Cfg.SyncTrades {
 			return
 		}
+
+	case SyncItemDerivative:
+		if !e.Cfg.SyncDerivatives {
+			return
+		}
 	default:
 		log.Warnf(log.SyncMgr, "ExchangeCurrencyPairSyncer: unknown sync item %v\n", syncType)
 		return
 	}
 
+	observeSyncUpdate(exchangeName, p, a, syncType, err)
+
 	e.mux.Lock()
 	defer e.mux.Unlock()
 
@@ -280,9 +333,82 @@ func (e *ExchangeCurrencyPairSyncer) update(exchangeName string, p currency.Pair
 						createdCounter)
 					e.initSyncWG.Done()
 				}
+
+			case SyncItemDerivative:
+				origHadData := e.CurrencyPairs[x].Derivative.HaveData
+				e.CurrencyPairs[x].Derivative.LastUpdated = time.Now()
+				if err != nil {
+					e.CurrencyPairs[x].Derivative.NumErrors++
+				}
+				e.CurrencyPairs[x].Derivative.HaveData = true
+				e.CurrencyPairs[x].Derivative.IsProcessing = false
+				if atomic.LoadInt32(&e.initSyncCompleted) != 1 && !origHadData {
+					removedCounter++
+					log.Debugf(log.SyncMgr, "%s derivative sync complete %v %v [%d/%d].\n",
+						exchangeName,
+						FormatCurrency(p).String(),
+						a,
+						removedCounter,
+						createdCounter)
+					e.initSyncWG.Done()
+				}
+			}
+		}
+	}
+}
+
+// maxLag returns the longest time since an enabled sync item with data was
+// last updated
+func (e *ExchangeCurrencyPairSyncer) maxLag(now time.Time) time.Duration {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	var lag time.Duration
+	for x := range e.CurrencyPairs {
+		items := []struct {
+			enabled bool
+			base    SyncBase
+		}{
+			{e.Cfg.SyncTicker, e.CurrencyPairs[x].Ticker},
+			{e.Cfg.SyncOrderbook, e.CurrencyPairs[x].Orderbook},
+			{e.Cfg.SyncTrades, e.CurrencyPairs[x].Trade},
+			{e.Cfg.SyncDerivatives, e.CurrencyPairs[x].Derivative},
+		}
+		for i := range items {
+			if !items[i].enabled || !items[i].base.HaveData {
+				continue
+			}
+			if l := now.Sub(items[i].base.LastUpdated); l > lag {
+				lag = l
 			}
 		}
 	}
+	return lag
+}
+
+// observeSyncUpdate records a sync update for the metrics exporter
+func observeSyncUpdate(exchangeName string, p currency.Pair, a asset.Item, syncType int, err error) {
+	item := syncItemName(syncType)
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	syncerUpdates.Inc(exchangeName, item, result)
+	syncerLastUpdate.Set(float64(time.Now().UnixNano())/float64(time.Second),
+		exchangeName, p.String(), a.String(), item)
+}
+
+func syncItemName(syncType int) string {
+	switch syncType {
+	case SyncItemTicker:
+		return "ticker"
+	case SyncItemOrderbook:
+		return "orderbook"
+	case SyncItemTrade:
+		return "trade"
+	case SyncItemDerivative:
+		return "derivative"
+	}
+	return "unknown"
 }
 
 func (e *ExchangeCurrencyPairSyncer) worker() {
@@ -295,6 +421,10 @@ func (e *ExchangeCurrencyPairSyncer) worker() {
 	for atomic.LoadInt32(&e.shutdown) != 1 {
 		exchanges := GetExchanges()
 		for x := range exchanges {
+			if isExchangeSuspended(exchanges[x]) {
+				time.Sleep(time.Millisecond * 50)
+				continue
+			}
 			exchangeName := exchanges[x].GetName()
 			assetTypes := exchanges[x].GetAssetTypes()
 			supportsREST := exchanges[x].SupportsREST()
@@ -366,6 +496,12 @@ func (e *ExchangeCurrencyPairSyncer) worker() {
 							}
 						}
 
+						if e.Cfg.SyncDerivatives {
+							// Derivative info is polled over REST, websocket
+							// feeds which carry it update it in between
+							c.Derivative = SyncBase{IsUsingREST: true}
+						}
+
 						e.add(&c)
 					}
 
@@ -422,20 +558,21 @@ func (e *ExchangeCurrencyPairSyncer) worker() {
 											if e.Cfg.Verbose {
 												log.Debugf(log.SyncMgr, "%s Init'ing REST ticker batching\n", exchangeName)
 											}
-											result, err = exchanges[x].UpdateTicker(c.Pair, c.AssetType)
+											result, err = exchanges[x].UpdateTicker(e.ctx, c.Pair, c.AssetType)
 											e.tickerBatchLastRequested[exchangeName] = time.Now()
 											e.mux.Unlock()
 										} else {
 											if e.Cfg.Verbose {
 												log.Debugf(log.SyncMgr, "%s Using recent batching cache\n", exchangeName)
 											}
-											result, err = exchanges[x].FetchTicker(c.Pair, c.AssetType)
+											result, err = exchanges[x].FetchTicker(e.ctx, c.Pair, c.AssetType)
 										}
 									} else {
-										result, err = exchanges[x].UpdateTicker(c.Pair, c.AssetType)
+										result, err = exchanges[x].UpdateTicker(e.ctx, c.Pair, c.AssetType)
 									}
 									printTickerSummary(result, "REST", err)
 									if err == nil {
+										Bot.TickerHistory.Process(result)
 										if Bot.Config.RemoteControl.WebsocketRPC.Enabled {
 											relayWebsocketEvent(result, "ticker_update", c.AssetType.String(), exchangeName)
 										}
@@ -472,7 +609,7 @@ func (e *ExchangeCurrencyPairSyncer) worker() {
 								}
 
 								e.setProcessing(c.Exchange, c.Pair, c.AssetType, SyncItemOrderbook, true)
-								result, err := exchanges[x].UpdateOrderbook(c.Pair, c.AssetType)
+								result, err := exchanges[x].UpdateOrderbook(e.ctx, c.Pair, c.AssetType)
 								printOrderbookSummary(result, "REST", err)
 								if err == nil {
 									if Bot.Config.RemoteControl.WebsocketRPC.Enabled {
@@ -484,12 +621,49 @@ func (e *ExchangeCurrencyPairSyncer) worker() {
 								time.Sleep(time.Millisecond * 50)
 							}
 						}
-						if e.Cfg.SyncTrades {
-							if !e.isProcessing(exchangeName, c.Pair, c.AssetType, SyncItemTrade) {
-								if c.Trade.LastUpdated.IsZero() || time.Since(c.Trade.LastUpdated) > e.Cfg.SyncTimeout {
+					}
+
+					if e.Cfg.SyncTrades {
+						if !e.isProcessing(exchangeName, c.Pair, c.AssetType, SyncItemTrade) {
+							if c.Trade.LastUpdated.IsZero() || time.Since(c.Trade.LastUpdated) > e.Cfg.SyncTimeout {
+								// Websocket trades update the tape directly, only
+								// fall back to REST once the feed has gone quiet
+								if !c.Trade.IsUsingWebsocket || time.Since(c.Created) > e.Cfg.SyncTimeout {
 									e.setProcessing(c.Exchange, c.Pair, c.AssetType, SyncItemTrade, true)
-									e.update(c.Exchange, c.Pair, c.AssetType, SyncItemTrade, nil)
+									err := fetchRecentTrades(e.ctx, exchanges[x], c.Pair, c.AssetType, c.Trade.LastUpdated)
+									if err != nil {
+										log.Errorf(log.SyncMgr, "%s %s %s: Failed to get REST trades. Error: %s\n",
+											c.Exchange,
+											FormatCurrency(c.Pair).String(),
+											strings.ToUpper(c.AssetType.String()),
+											err)
+									}
+									e.update(c.Exchange, c.Pair, c.AssetType, SyncItemTrade, err)
+								}
+							}
+						}
+					}
+
+					if e.Cfg.SyncDerivatives && derivative.IsDerivative(c.AssetType) {
+						if !e.isProcessing(exchangeName, c.Pair, c.AssetType, SyncItemDerivative) {
+							if c.Derivative.LastUpdated.IsZero() || time.Since(c.Derivative.LastUpdated) > e.Cfg.SyncTimeout {
+								e.setProcessing(c.Exchange, c.Pair, c.AssetType, SyncItemDerivative, true)
+								result, err := exchanges[x].UpdateDerivativeInfo(e.ctx, c.Pair, c.AssetType)
+								switch {
+								case err == common.ErrFunctionNotSupported:
+									// Exchanges without derivative data
+									// support are not retried as errors
+									err = nil
+								case err != nil:
+									log.Errorf(log.SyncMgr, "%s %s %s: Failed to get REST derivative info. Error: %s\n",
+										c.Exchange,
+										FormatCurrency(c.Pair).String(),
+										strings.ToUpper(c.AssetType.String()),
+										err)
+								case Bot.Config.RemoteControl.WebsocketRPC.Enabled:
+									relayWebsocketEvent(result, "derivative_update", c.AssetType.String(), exchangeName)
 								}
+								e.update(c.Exchange, c.Pair, c.AssetType, SyncItemDerivative, err)
 							}
 						}
 					}
@@ -597,6 +771,10 @@ func (e *ExchangeCurrencyPairSyncer) Start() {
 					}
 				}
 
+				if e.Cfg.SyncDerivatives {
+					c.Derivative = SyncBase{IsUsingREST: true}
+				}
+
 				e.add(&c)
 			}
 		}
@@ -638,6 +816,44 @@ func (e *ExchangeCurrencyPairSyncer) Start() {
 func (e *ExchangeCurrencyPairSyncer) Stop() {
 	stopped := atomic.CompareAndSwapInt32(&e.shutdown, 0, 1)
 	if stopped {
+		e.cancel()
 		log.Debugln(log.SyncMgr, "Exchange CurrencyPairSyncer stopped.")
 	}
 }
+
+// fetchRecentTrades retrieves trades since the last sync via REST and adds
+// them to the trade service. Exchanges without REST trade history support are
+// left to their websocket feeds
+func fetchRecentTrades(ctx context.Context, exch exchange.IBotExchange, p currency.Pair, a asset.Item, since time.Time) error {
+	end := time.Now()
+	if since.IsZero() {
+		since = end.Add(-time.Hour)
+	}
+
+	history, err := exch.GetExchangeHistory(ctx, p, a, since, end)
+	if err != nil {
+		if err == common.ErrNotYetImplemented || err == common.ErrFunctionNotSupported {
+			return nil
+		}
+		return err
+	}
+
+	trades := make([]trade.Data, 0, len(history))
+	for i := range history {
+		side, err := order.StringToOrderSide(history[i].Side)
+		if err != nil {
+			side = order.UnknownSide
+		}
+		trades = append(trades, trade.Data{
+			Exchange:  exch.GetName(),
+			TID:       history[i].TID,
+			Pair:      p,
+			AssetType: a,
+			Side:      side,
+			Price:     history[i].Price,
+			Amount:    history[i].Amount,
+			Timestamp: history[i].Timestamp,
+		})
+	}
+	return trade.ProcessHistory(trades...)
+}
@@ -1,10 +1,16 @@
 package engine
 
 import (
+	"context"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/order"
+	"github.com/yurulab/gocryptotrader/exchanges/trade"
 )
 
 func TestNewCurrencyPairSyncer(t *testing.T) {
@@ -43,3 +49,57 @@ func TestNewCurrencyPairSyncer(t *testing.T) {
 	time.Sleep(time.Second * 15)
 	Bot.ExchangeCurrencyPairManager.Stop()
 }
+
+func TestFetchRecentTrades(t *testing.T) {
+	SetupTestHelpers(t)
+	p := currency.NewPair(currency.BTC, currency.USD)
+
+	err := fetchRecentTrades(context.Background(), GetExchangeByName(fakePassExchange), p, asset.Spot, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	trades, err := trade.GetRecentTrades(fakePassExchange, p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 1 || trades[0].TID != "1337" || trades[0].Side != order.Buy {
+		t.Errorf("unexpected trades %+v", trades)
+	}
+}
+
+func TestSyncDerivatives(t *testing.T) {
+	e, err := NewCurrencyPairSyncer(CurrencyPairSyncerConfig{SyncDerivatives: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := currency.NewPair(currency.BTC, currency.USD)
+	// Only derivative asset types are waited on by the initial sync
+	e.add(&CurrencyPairSyncAgent{Exchange: "derivativetest", Pair: p, AssetType: asset.Spot})
+	e.add(&CurrencyPairSyncAgent{Exchange: "derivativetest", Pair: p, AssetType: asset.Futures})
+	atomic.StoreInt32(&e.initSyncStarted, 1)
+	e.update("derivativetest", p, asset.Futures, SyncItemDerivative, nil)
+
+	done := make(chan struct{})
+	go func() {
+		e.initSyncWG.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("initial sync should complete once the derivative is synced")
+	}
+
+	c, err := e.get("derivativetest", p, asset.Futures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Derivative.HaveData || c.Derivative.LastUpdated.IsZero() {
+		t.Errorf("expected derivative to be synced %+v", c.Derivative)
+	}
+	if syncItemName(SyncItemDerivative) != "derivative" {
+		t.Error("unexpected derivative sync item name")
+	}
+}
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -13,6 +14,7 @@ type CurrencyPairSyncerConfig struct {
 	SyncTicker       bool
 	SyncOrderbook    bool
 	SyncTrades       bool
+	SyncDerivatives  bool
 	SyncContinuously bool
 	SyncTimeout      time.Duration
 	NumWorkers       int
@@ -37,6 +39,10 @@ type ExchangeCurrencyPairSyncer struct {
 	initSyncStarted   int32
 	initSyncStartTime time.Time
 	shutdown          int32
+
+	// ctx is cancelled on Stop to abort in-flight exchange requests
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 // SyncBase stores information
@@ -51,11 +57,12 @@ type SyncBase struct {
 
 // CurrencyPairSyncAgent stores the sync agent info
 type CurrencyPairSyncAgent struct {
-	Created   time.Time
-	Exchange  string
-	AssetType asset.Item
-	Pair      currency.Pair
-	Ticker    SyncBase
-	Orderbook SyncBase
-	Trade     SyncBase
+	Created    time.Time
+	Exchange   string
+	AssetType  asset.Item
+	Pair       currency.Pair
+	Ticker     SyncBase
+	Orderbook  SyncBase
+	Trade      SyncBase
+	Derivative SyncBase
 }
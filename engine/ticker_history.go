@@ -0,0 +1,149 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	tickerdb "github.com/yurulab/gocryptotrader/database/repository/ticker"
+	"github.com/yurulab/gocryptotrader/exchanges/ticker"
+	"github.com/yurulab/gocryptotrader/log"
+)
+
+// vars related to the ticker history
+var (
+	// TickerHistoryFlushDelay is how often received tickers are written to
+	// the database
+	TickerHistoryFlushDelay = time.Second
+	// TickerHistoryDownsampleDelay is how often tickers older than the tick
+	// retention are downsampled to minute aggregates
+	TickerHistoryDownsampleDelay = time.Minute
+)
+
+// tickerHistory records every ticker fetched by the syncer or received over
+// websocket to the database, downsampling them to minute aggregates once they
+// are older than the tick retention
+type tickerHistory struct {
+	started   int32
+	stopped   int32
+	shutdown  chan struct{}
+	retention time.Duration
+	buffer    []ticker.Price
+	m         sync.Mutex
+}
+
+func (t *tickerHistory) Started() bool {
+	return atomic.LoadInt32(&t.started) == 1
+}
+
+func (t *tickerHistory) Start() (err error) {
+	if !atomic.CompareAndSwapInt32(&t.started, 0, 1) {
+		return errors.New("ticker history already started")
+	}
+
+	defer func() {
+		if err != nil {
+			atomic.CompareAndSwapInt32(&t.started, 1, 0)
+		}
+	}()
+
+	if !Bot.Config.TickerHistory.Enabled {
+		return errors.New("ticker history is not enabled in the config")
+	}
+	if !Bot.DatabaseManager.Started() {
+		return errors.New("ticker history requires the database manager to be started")
+	}
+
+	log.Debugln(log.Ticker, "Ticker history starting...")
+	t.retention = Bot.Config.TickerHistory.TickRetention
+	t.m.Lock()
+	t.buffer = nil
+	t.m.Unlock()
+	t.shutdown = make(chan struct{})
+	go t.run()
+	return nil
+}
+
+func (t *tickerHistory) Stop() error {
+	if atomic.LoadInt32(&t.started) == 0 {
+		return errors.New("ticker history not started")
+	}
+
+	if !atomic.CompareAndSwapInt32(&t.stopped, 0, 1) {
+		return errors.New("ticker history is already stopped")
+	}
+
+	log.Debugln(log.Ticker, "Ticker history shutting down...")
+	// Flush now as the engine stops the database manager before run returns
+	t.flush()
+	close(t.shutdown)
+	return nil
+}
+
+func (t *tickerHistory) run() {
+	log.Debugf(log.Ticker, "Ticker history started. Tick retention: %v\n", t.retention)
+	Bot.ServicesWG.Add(1)
+	flush := time.NewTicker(TickerHistoryFlushDelay)
+	downsample := time.NewTicker(TickerHistoryDownsampleDelay)
+	defer func() {
+		flush.Stop()
+		downsample.Stop()
+		t.flush()
+		atomic.CompareAndSwapInt32(&t.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&t.started, 1, 0)
+		Bot.ServicesWG.Done()
+		log.Debugln(log.Ticker, "Ticker history shutdown.")
+	}()
+
+	t.downsample(time.Now())
+	for {
+		select {
+		case <-t.shutdown:
+			return
+		case <-flush.C:
+			t.flush()
+		case now := <-downsample.C:
+			t.downsample(now)
+		}
+	}
+}
+
+// Process buffers a copy of a fetched or received ticker to be written on the
+// next flush, it does nothing unless the ticker history is started
+func (t *tickerHistory) Process(p *ticker.Price) {
+	if p == nil || !t.Started() {
+		return
+	}
+	t.m.Lock()
+	t.buffer = append(t.buffer, *p)
+	t.m.Unlock()
+}
+
+// flush writes buffered tickers to the database
+func (t *tickerHistory) flush() {
+	t.m.Lock()
+	prices := t.buffer
+	t.buffer = nil
+	t.m.Unlock()
+	if len(prices) == 0 {
+		return
+	}
+
+	if err := tickerdb.Insert(prices); err != nil {
+		log.Errorf(log.Ticker, "Ticker history failed to store %d tickers: %v\n", len(prices), err)
+	}
+}
+
+// downsample replaces tickers older than the tick retention relative to now
+// with minute aggregates
+func (t *tickerHistory) downsample(now time.Time) {
+	count, err := tickerdb.Downsample(now.Add(-t.retention))
+	if err != nil {
+		log.Errorf(log.Ticker, "Ticker history failed to downsample: %v\n", err)
+		return
+	}
+	if count > 0 {
+		log.Debugf(log.Ticker, "Ticker history downsampled %d tickers.\n", count)
+	}
+}
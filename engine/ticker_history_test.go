@@ -0,0 +1,62 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/ticker"
+)
+
+func TestTickerHistoryStart(t *testing.T) {
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+	oldConfig := Bot.Config
+	defer func() { Bot.Config = oldConfig }()
+	Bot.Config = &config.Config{}
+
+	var h tickerHistory
+	if err := h.Start(); err == nil || h.Started() {
+		t.Error("ticker history should not start when disabled")
+	}
+	if err := h.Stop(); err == nil {
+		t.Error("expected error stopping unstarted ticker history")
+	}
+
+	Bot.Config.TickerHistory = config.TickerHistoryConfig{
+		Enabled:       true,
+		TickRetention: time.Hour,
+	}
+	if err := h.Start(); err == nil || h.Started() {
+		t.Error("ticker history should not start without the database manager")
+	}
+}
+
+func TestTickerHistoryProcess(t *testing.T) {
+	t.Parallel()
+
+	p := &ticker.Price{
+		ExchangeName: "test",
+		AssetType:    asset.Spot,
+		Pair:         currency.NewPair(currency.BTC, currency.USD),
+		Last:         1337,
+		LastUpdated:  time.Now(),
+	}
+
+	var h tickerHistory
+	h.Process(p)
+	if len(h.buffer) != 0 {
+		t.Error("stopped ticker history should not buffer tickers")
+	}
+
+	h.started = 1
+	h.Process(p)
+	h.Process(nil)
+	p.Last = 1
+	if len(h.buffer) != 1 || h.buffer[0].Last != 1337 {
+		t.Errorf("expected a copy of the ticker to be buffered, received %+v", h.buffer)
+	}
+}
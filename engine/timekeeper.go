@@ -7,6 +7,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	exchange "github.com/yurulab/gocryptotrader/exchanges"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
 	"github.com/yurulab/gocryptotrader/log"
 	ntpclient "github.com/yurulab/gocryptotrader/ntpclient"
 )
@@ -71,6 +73,10 @@ func (n *ntpManager) Start() (err error) {
 			}
 		}
 	}
+	if Bot.Config.NTPClient.CompensateClockSkew && !n.inititalCheck {
+		// Apply the clock offset before exchanges send signed requests
+		n.processTime()
+	}
 	n.shutdown = make(chan struct{})
 	go n.run()
 	log.Debugln(log.TimeMgr, "NTP manager started.")
@@ -106,19 +112,30 @@ func (n *ntpManager) run() {
 			return
 		case <-t.C:
 			n.processTime()
+			syncServerTimes()
 		}
 	}
 }
 
-func (n *ntpManager) FetchNTPTime() time.Time {
-	return ntpclient.NTPClient(Bot.Config.NTPClient.Pool)
+func (n *ntpManager) FetchNTPTime() (time.Time, error) {
+	return ntpclient.FetchTime(Bot.Config.NTPClient.Pool)
 }
 
 func (n *ntpManager) processTime() error {
-	NTPTime := n.FetchNTPTime()
+	NTPTime, err := n.FetchNTPTime()
+	if err != nil {
+		log.Warnf(log.TimeMgr, "NTP manager: %v, using current system time\n", err)
+		return nil
+	}
 
 	currentTime := time.Now()
 	NTPcurrentTimeDifference := NTPTime.Sub(currentTime)
+	if Bot.Config.NTPClient.CompensateClockSkew {
+		request.SetClockOffset(NTPcurrentTimeDifference)
+		log.Debugf(log.TimeMgr, "NTP manager: Request clock offset set to %v\n", NTPcurrentTimeDifference)
+		return nil
+	}
+
 	configNTPTime := *Bot.Config.NTPClient.AllowedDifference
 	configNTPNegativeTime := (*Bot.Config.NTPClient.AllowedNegativeDifference - (*Bot.Config.NTPClient.AllowedNegativeDifference * 2))
 	if NTPcurrentTimeDifference > configNTPTime || NTPcurrentTimeDifference < configNTPNegativeTime {
@@ -137,3 +154,39 @@ func (n *ntpManager) processTime() error {
 	}
 	return nil
 }
+
+// syncServerTimes updates the request clock offset of loaded exchanges which
+// are configured to sync with their server time
+func syncServerTimes() {
+	exchs := GetExchanges()
+	for x := range exchs {
+		syncServerTime(exchs[x])
+	}
+}
+
+// syncServerTime sets the request clock offset of an exchange from its server
+// time if configured
+func syncServerTime(exch exchange.IBotExchange) {
+	base := exch.GetBase()
+	if base.Config == nil || !base.Config.SyncServerTime || base.Requester == nil {
+		return
+	}
+
+	fetcher, ok := exch.(exchange.ServerTimeFetcher)
+	if !ok {
+		return
+	}
+
+	offset, err := base.Requester.SyncServerTime(fetcher.FetchServerTime)
+	if err != nil {
+		log.Errorf(log.TimeMgr,
+			"%s unable to sync server time: %v\n",
+			base.Name,
+			err)
+		return
+	}
+	log.Debugf(log.TimeMgr,
+		"%s request clock offset set to %v from server time\n",
+		base.Name,
+		offset)
+}
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -57,7 +58,7 @@ func SubmitWithdrawal(exchName string, req *withdraw.Request) (*withdraw.Respons
 		resp.Exchange.ID = withdraw.DryRunID.String()
 	} else {
 		if req.Type == withdraw.Fiat {
-			ret, err = exch.WithdrawFiatFunds(req)
+			ret, err = exch.WithdrawFiatFunds(context.Background(), req)
 			if err != nil {
 				resp.Exchange.ID = StatusError
 				resp.Exchange.Status = err.Error()
@@ -66,7 +67,7 @@ func SubmitWithdrawal(exchName string, req *withdraw.Request) (*withdraw.Respons
 				resp.Exchange.ID = ret.ID
 			}
 		} else if req.Type == withdraw.Crypto {
-			ret, err = exch.WithdrawCryptocurrencyFunds(req)
+			ret, err = exch.WithdrawCryptocurrencyFunds(context.Background(), req)
 			if err != nil {
 				resp.Exchange.ID = StatusError
 				resp.Exchange.Status = err.Error()
@@ -53,12 +53,12 @@ type Alphapoint struct {
 
 // GetTicker returns current ticker information from Alphapoint for a selected
 // currency pair ie "BTCUSD"
-func (a *Alphapoint) GetTicker(currencyPair string) (Ticker, error) {
+func (a *Alphapoint) GetTicker(ctx context.Context, currencyPair string) (Ticker, error) {
 	req := make(map[string]interface{})
 	req["productPair"] = currencyPair
 	response := Ticker{}
 
-	err := a.SendHTTPRequest(http.MethodPost, alphapointTicker, req, &response)
+	err := a.SendHTTPRequest(ctx, http.MethodPost, alphapointTicker, req, &response)
 	if err != nil {
 		return response, err
 	}
@@ -74,14 +74,14 @@ func (a *Alphapoint) GetTicker(currencyPair string) (Ticker, error) {
 // AlphaPoint Exchange. To begin from the most recent trade, set startIndex to
 // 0 (default: 0)
 // Count: specifies the number of trades to return (default: 10)
-func (a *Alphapoint) GetTrades(currencyPair string, startIndex, count int) (Trades, error) {
+func (a *Alphapoint) GetTrades(ctx context.Context, currencyPair string, startIndex, count int) (Trades, error) {
 	req := make(map[string]interface{})
 	req["ins"] = currencyPair
 	req["startIndex"] = startIndex
 	req["Count"] = count
 	response := Trades{}
 
-	err := a.SendHTTPRequest(http.MethodPost, alphapointTrades, req, &response)
+	err := a.SendHTTPRequest(ctx, http.MethodPost, alphapointTrades, req, &response)
 	if err != nil {
 		return response, err
 	}
@@ -95,14 +95,14 @@ func (a *Alphapoint) GetTrades(currencyPair string, startIndex, count int) (Trad
 // CurrencyPair - instrument code (ex: “BTCUSD”)
 // StartDate - specifies the starting time in epoch time, type is long
 // EndDate - specifies the end time in epoch time, type is long
-func (a *Alphapoint) GetTradesByDate(currencyPair string, startDate, endDate int64) (Trades, error) {
+func (a *Alphapoint) GetTradesByDate(ctx context.Context, currencyPair string, startDate, endDate int64) (Trades, error) {
 	req := make(map[string]interface{})
 	req["ins"] = currencyPair
 	req["startDate"] = startDate
 	req["endDate"] = endDate
 	response := Trades{}
 
-	err := a.SendHTTPRequest(http.MethodPost, alphapointTradesByDate, req, &response)
+	err := a.SendHTTPRequest(ctx, http.MethodPost, alphapointTradesByDate, req, &response)
 	if err != nil {
 		return response, err
 	}
@@ -114,12 +114,12 @@ func (a *Alphapoint) GetTradesByDate(currencyPair string, startDate, endDate int
 
 // GetOrderbook fetches the current orderbook for a given currency pair
 // CurrencyPair - trade pair (ex: “BTCUSD”)
-func (a *Alphapoint) GetOrderbook(currencyPair string) (Orderbook, error) {
+func (a *Alphapoint) GetOrderbook(ctx context.Context, currencyPair string) (Orderbook, error) {
 	req := make(map[string]interface{})
 	req["productPair"] = currencyPair
 	response := Orderbook{}
 
-	err := a.SendHTTPRequest(http.MethodPost, alphapointOrderbook, req, &response)
+	err := a.SendHTTPRequest(ctx, http.MethodPost, alphapointOrderbook, req, &response)
 	if err != nil {
 		return response, err
 	}
@@ -130,10 +130,10 @@ func (a *Alphapoint) GetOrderbook(currencyPair string) (Orderbook, error) {
 }
 
 // GetProductPairs gets the currency pairs currently traded on alphapoint
-func (a *Alphapoint) GetProductPairs() (ProductPairs, error) {
+func (a *Alphapoint) GetProductPairs(ctx context.Context) (ProductPairs, error) {
 	response := ProductPairs{}
 
-	err := a.SendHTTPRequest(http.MethodPost, alphapointProductPairs, nil, &response)
+	err := a.SendHTTPRequest(ctx, http.MethodPost, alphapointProductPairs, nil, &response)
 	if err != nil {
 		return response, err
 	}
@@ -144,10 +144,10 @@ func (a *Alphapoint) GetProductPairs() (ProductPairs, error) {
 }
 
 // GetProducts gets the currency products currently supported on alphapoint
-func (a *Alphapoint) GetProducts() (Products, error) {
+func (a *Alphapoint) GetProducts(ctx context.Context) (Products, error) {
 	response := Products{}
 
-	err := a.SendHTTPRequest(http.MethodPost, alphapointProducts, nil, &response)
+	err := a.SendHTTPRequest(ctx, http.MethodPost, alphapointProducts, nil, &response)
 	if err != nil {
 		return response, err
 	}
@@ -163,7 +163,7 @@ func (a *Alphapoint) GetProducts() (Products, error) {
 // Email - Email address
 // Phone - Phone number (ex: “+12223334444”)
 // Password - Minimum 8 characters
-func (a *Alphapoint) CreateAccount(firstName, lastName, email, phone, password string) error {
+func (a *Alphapoint) CreateAccount(ctx context.Context, firstName, lastName, email, phone, password string) error {
 	if len(password) < 8 {
 		return errors.New(
 			"alphapoint Error - Create account - Password must be 8 characters or more",
@@ -178,7 +178,7 @@ func (a *Alphapoint) CreateAccount(firstName, lastName, email, phone, password s
 	req["password"] = password
 	response := Response{}
 
-	err := a.SendAuthenticatedHTTPRequest(http.MethodPost, alphapointCreateAccount, req, &response)
+	err := a.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, alphapointCreateAccount, req, &response)
 	if err != nil {
 		return fmt.Errorf("unable to create account. Reason: %s", err)
 	}
@@ -189,10 +189,10 @@ func (a *Alphapoint) CreateAccount(firstName, lastName, email, phone, password s
 }
 
 // GetUserInfo returns current account user information
-func (a *Alphapoint) GetUserInfo() (UserInfo, error) {
+func (a *Alphapoint) GetUserInfo(ctx context.Context) (UserInfo, error) {
 	response := UserInfo{}
 
-	err := a.SendAuthenticatedHTTPRequest(http.MethodPost, alphapointUserInfo, map[string]interface{}{}, &response)
+	err := a.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, alphapointUserInfo, map[string]interface{}{}, &response)
 	if err != nil {
 		return UserInfo{}, err
 	}
@@ -211,7 +211,7 @@ func (a *Alphapoint) GetUserInfo() (UserInfo, error) {
 // Cell2FAValue - Cell phone number, required for Authentication
 // Use2FAForWithdraw - “true” or “false” set to true for using 2FA for
 // withdrawals
-func (a *Alphapoint) SetUserInfo(firstName, lastName, cell2FACountryCode, cell2FAValue string, useAuthy2FA, use2FAForWithdraw bool) (UserInfoSet, error) {
+func (a *Alphapoint) SetUserInfo(ctx context.Context, firstName, lastName, cell2FACountryCode, cell2FAValue string, useAuthy2FA, use2FAForWithdraw bool) (UserInfoSet, error) {
 	response := UserInfoSet{}
 
 	var userInfoKVPs = []UserInfoKVP{
@@ -244,7 +244,7 @@ func (a *Alphapoint) SetUserInfo(firstName, lastName, cell2FACountryCode, cell2F
 	req := make(map[string]interface{})
 	req["userInfoKVP"] = userInfoKVPs
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		http.MethodPost,
 		alphapointUserInfo,
 		req,
@@ -260,10 +260,10 @@ func (a *Alphapoint) SetUserInfo(firstName, lastName, cell2FACountryCode, cell2F
 }
 
 // GetAccountInformation returns account info
-func (a *Alphapoint) GetAccountInformation() (AccountInfo, error) {
+func (a *Alphapoint) GetAccountInformation(ctx context.Context) (AccountInfo, error) {
 	response := AccountInfo{}
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		http.MethodPost,
 		alphapointAccountInfo,
 		map[string]interface{}{},
@@ -282,14 +282,14 @@ func (a *Alphapoint) GetAccountInformation() (AccountInfo, error) {
 // CurrencyPair - Instrument code (ex: “BTCUSD”)
 // StartIndex - Starting index, if less than 0 then start from the beginning
 // Count - Returns last trade, (Default: 30)
-func (a *Alphapoint) GetAccountTrades(currencyPair string, startIndex, count int) (Trades, error) {
+func (a *Alphapoint) GetAccountTrades(ctx context.Context, currencyPair string, startIndex, count int) (Trades, error) {
 	req := make(map[string]interface{})
 	req["ins"] = currencyPair
 	req["startIndex"] = startIndex
 	req["count"] = count
 	response := Trades{}
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		http.MethodPost,
 		alphapointAccountTrades,
 		req,
@@ -305,10 +305,10 @@ func (a *Alphapoint) GetAccountTrades(currencyPair string, startIndex, count int
 }
 
 // GetDepositAddresses generates a deposit address
-func (a *Alphapoint) GetDepositAddresses() ([]DepositAddresses, error) {
+func (a *Alphapoint) GetDepositAddresses(ctx context.Context) ([]DepositAddresses, error) {
 	response := Response{}
 
-	err := a.SendAuthenticatedHTTPRequest(http.MethodPost, alphapointDepositAddresses,
+	err := a.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, alphapointDepositAddresses,
 		map[string]interface{}{}, &response,
 	)
 	if err != nil {
@@ -325,7 +325,7 @@ func (a *Alphapoint) GetDepositAddresses() ([]DepositAddresses, error) {
 // product - Currency name (ex: “BTC”)
 // amount - Amount (ex: “.011”)
 // address - Withdraw address
-func (a *Alphapoint) WithdrawCoins(symbol, product, address string, amount float64) error {
+func (a *Alphapoint) WithdrawCoins(ctx context.Context, symbol, product, address string, amount float64) error {
 	req := make(map[string]interface{})
 	req["ins"] = symbol
 	req["product"] = product
@@ -333,7 +333,7 @@ func (a *Alphapoint) WithdrawCoins(symbol, product, address string, amount float
 	req["sendToAddress"] = address
 
 	response := Response{}
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		http.MethodPost,
 		alphapointWithdraw,
 		req,
@@ -362,7 +362,7 @@ func (a *Alphapoint) convertOrderTypeToOrderTypeNumber(orderType string) (orderT
 // orderType - “1” for market orders, “0” for limit orders
 // quantity - Quantity
 // price - Price in USD
-func (a *Alphapoint) CreateOrder(symbol, side, orderType string, quantity, price float64) (int64, error) {
+func (a *Alphapoint) CreateOrder(ctx context.Context, symbol, side, orderType string, quantity, price float64) (int64, error) {
 	orderTypeNumber := a.convertOrderTypeToOrderTypeNumber(orderType)
 	req := make(map[string]interface{})
 	req["ins"] = symbol
@@ -372,7 +372,7 @@ func (a *Alphapoint) CreateOrder(symbol, side, orderType string, quantity, price
 	req["px"] = strconv.FormatFloat(price, 'f', -1, 64)
 	response := Response{}
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		http.MethodPost,
 		alphapointCreateOrder,
 		req,
@@ -395,14 +395,14 @@ func (a *Alphapoint) CreateOrder(symbol, side, orderType string, quantity, price
 // book. A buy order will be modified to the highest bid and a sell order will
 // be modified to the lowest ask price. “1” means "Execute now", which will
 // convert a limit order into a market order.
-func (a *Alphapoint) ModifyExistingOrder(symbol string, orderID, action int64) (int64, error) {
+func (a *Alphapoint) ModifyExistingOrder(ctx context.Context, symbol string, orderID, action int64) (int64, error) {
 	req := make(map[string]interface{})
 	req["ins"] = symbol
 	req["serverOrderId"] = orderID
 	req["modifyAction"] = action
 	response := Response{}
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		http.MethodPost,
 		alphapointModifyOrder,
 		req,
@@ -420,13 +420,13 @@ func (a *Alphapoint) ModifyExistingOrder(symbol string, orderID, action int64) (
 // CancelExistingOrder cancels an order that has not been executed.
 // symbol - Instrument code (ex: “BTCUSD”)
 // OrderId - Order id (ex: 1000)
-func (a *Alphapoint) CancelExistingOrder(orderID int64, omsid string) (int64, error) {
+func (a *Alphapoint) CancelExistingOrder(ctx context.Context, orderID int64, omsid string) (int64, error) {
 	req := make(map[string]interface{})
 	req["OrderId"] = orderID
 	req["OMSId"] = omsid
 	response := Response{}
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		http.MethodPost,
 		alphapointCancelOrder,
 		req,
@@ -443,12 +443,12 @@ func (a *Alphapoint) CancelExistingOrder(orderID int64, omsid string) (int64, er
 
 // CancelAllExistingOrders cancels all open orders by symbol
 // symbol - Instrument code (ex: “BTCUSD”)
-func (a *Alphapoint) CancelAllExistingOrders(omsid string) error {
+func (a *Alphapoint) CancelAllExistingOrders(ctx context.Context, omsid string) error {
 	req := make(map[string]interface{})
 	req["OMSId"] = omsid
 	response := Response{}
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		http.MethodPost,
 		alphapointCancelAllOrders,
 		req,
@@ -464,10 +464,10 @@ func (a *Alphapoint) CancelAllExistingOrders(omsid string) error {
 }
 
 // GetOrders returns all current open orders
-func (a *Alphapoint) GetOrders() ([]OpenOrders, error) {
+func (a *Alphapoint) GetOrders(ctx context.Context) ([]OpenOrders, error) {
 	response := OrderInfo{}
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		http.MethodPost,
 		alphapointOpenOrders,
 		map[string]interface{}{},
@@ -487,7 +487,7 @@ func (a *Alphapoint) GetOrders() ([]OpenOrders, error) {
 // side - “buy” or “sell”
 // quantity - Quantity
 // price - Price in USD
-func (a *Alphapoint) GetOrderFee(symbol, side string, quantity, price float64) (float64, error) {
+func (a *Alphapoint) GetOrderFee(ctx context.Context, symbol, side string, quantity, price float64) (float64, error) {
 	req := make(map[string]interface{})
 	req["ins"] = symbol
 	req["side"] = side
@@ -495,7 +495,7 @@ func (a *Alphapoint) GetOrderFee(symbol, side string, quantity, price float64) (
 	req["px"] = strconv.FormatFloat(price, 'f', -1, 64)
 	response := Response{}
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		http.MethodPost,
 		alphapointOrderFee,
 		req,
@@ -511,7 +511,7 @@ func (a *Alphapoint) GetOrderFee(symbol, side string, quantity, price float64) (
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (a *Alphapoint) SendHTTPRequest(method, path string, data map[string]interface{}, result interface{}) error {
+func (a *Alphapoint) SendHTTPRequest(ctx context.Context, method, path string, data map[string]interface{}, result interface{}) error {
 	headers := make(map[string]string)
 	headers["Content-Type"] = "application/json"
 	path = fmt.Sprintf("%s/ajax/v%s/%s", a.API.Endpoints.URL, alphapointAPIVersion, path)
@@ -521,7 +521,7 @@ func (a *Alphapoint) SendHTTPRequest(method, path string, data map[string]interf
 		return errors.New("unable to JSON request")
 	}
 
-	return a.SendPayload(context.Background(), &request.Item{
+	return a.SendPayload(ctx, &request.Item{
 		Method:        method,
 		Path:          path,
 		Headers:       headers,
@@ -533,7 +533,7 @@ func (a *Alphapoint) SendHTTPRequest(method, path string, data map[string]interf
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated request
-func (a *Alphapoint) SendAuthenticatedHTTPRequest(method, path string, data map[string]interface{}, result interface{}) error {
+func (a *Alphapoint) SendAuthenticatedHTTPRequest(ctx context.Context, method, path string, data map[string]interface{}, result interface{}) error {
 	if !a.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, a.Name)
 	}
@@ -555,7 +555,7 @@ func (a *Alphapoint) SendAuthenticatedHTTPRequest(method, path string, data map[
 		return errors.New("unable to JSON request")
 	}
 
-	return a.SendPayload(context.Background(), &request.Item{
+	return a.SendPayload(ctx, &request.Item{
 		Method:        method,
 		Path:          path,
 		Headers:       headers,
@@ -22,10 +22,12 @@ import (
 )
 
 const (
-	apiURL = "https://api.binance.com"
+	apiURL        = "https://api.binance.com"
+	futuresAPIURL = "https://fapi.binance.com"
 
 	// Public endpoints
 	exchangeInfo      = "/api/v3/exchangeInfo"
+	serverTime        = "/api/v3/time"
 	orderBookDepth    = "/api/v3/depth"
 	recentTrades      = "/api/v3/trades"
 	historicalTrades  = "/api/v3/historicalTrades"
@@ -56,6 +58,12 @@ const (
 	dustLog           = "/wapi/v3/userAssetDribbletLog.html"
 	tradeFee          = "/wapi/v3/tradeFee.html"
 	assetDetail       = "/wapi/v3/assetDetail.html"
+
+	// USDT margined futures public endpoints
+	premiumIndex    = "/fapi/v1/premiumIndex"
+	openInterest    = "/fapi/v1/openInterest"
+	allForceOrders  = "/fapi/v1/allForceOrders"
+	forceOrderLimit = 1000
 )
 
 // Binance is the overarching type across the Bithumb package
@@ -68,11 +76,24 @@ type Binance struct {
 
 // GetExchangeInfo returns exchange information. Check binance_types for more
 // information
-func (b *Binance) GetExchangeInfo() (ExchangeInfo, error) {
+func (b *Binance) GetExchangeInfo(ctx context.Context) (ExchangeInfo, error) {
 	var resp ExchangeInfo
 	path := b.API.Endpoints.URL + exchangeInfo
 
-	return resp, b.SendHTTPRequest(path, limitDefault, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, limitDefault, &resp)
+}
+
+// GetServerTime returns the current server time
+func (b *Binance) GetServerTime(ctx context.Context) (time.Time, error) {
+	var resp struct {
+		ServerTime int64 `json:"serverTime"`
+	}
+	path := b.API.Endpoints.URL + serverTime
+
+	if err := b.SendHTTPRequest(ctx, path, limitDefault, &resp); err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, resp.ServerTime*int64(time.Millisecond)), nil
 }
 
 // GetOrderBook returns full orderbook information
@@ -80,7 +101,7 @@ func (b *Binance) GetExchangeInfo() (ExchangeInfo, error) {
 // OrderBookDataRequestParams contains the following members
 // symbol: string of currency pair
 // limit: returned limit amount
-func (b *Binance) GetOrderBook(obd OrderBookDataRequestParams) (OrderBook, error) {
+func (b *Binance) GetOrderBook(ctx context.Context, obd OrderBookDataRequestParams) (OrderBook, error) {
 	var orderbook OrderBook
 	if err := b.CheckLimit(obd.Limit); err != nil {
 		return orderbook, err
@@ -92,7 +113,7 @@ func (b *Binance) GetOrderBook(obd OrderBookDataRequestParams) (OrderBook, error
 
 	var resp OrderBookData
 	path := common.EncodeURLValues(b.API.Endpoints.URL+orderBookDepth, params)
-	if err := b.SendHTTPRequest(path, orderbookLimit(obd.Limit), &resp); err != nil {
+	if err := b.SendHTTPRequest(ctx, path, orderbookLimit(obd.Limit), &resp); err != nil {
 		return orderbook, err
 	}
 
@@ -136,7 +157,7 @@ func (b *Binance) GetOrderBook(obd OrderBookDataRequestParams) (OrderBook, error
 
 // GetRecentTrades returns recent trade activity
 // limit: Up to 500 results returned
-func (b *Binance) GetRecentTrades(rtr RecentTradeRequestParams) ([]RecentTrade, error) {
+func (b *Binance) GetRecentTrades(ctx context.Context, rtr RecentTradeRequestParams) ([]RecentTrade, error) {
 	var resp []RecentTrade
 
 	params := url.Values{}
@@ -145,7 +166,7 @@ func (b *Binance) GetRecentTrades(rtr RecentTradeRequestParams) ([]RecentTrade,
 
 	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URL, recentTrades, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, limitDefault, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, limitDefault, &resp)
 }
 
 // GetHistoricalTrades returns historical trade activity
@@ -164,7 +185,7 @@ func (b *Binance) GetHistoricalTrades(symbol string, limit int, fromID int64) ([
 //
 // symbol: string of currency pair
 // limit: Optional. Default 500; max 1000.
-func (b *Binance) GetAggregatedTrades(symbol string, limit int) ([]AggregatedTrade, error) {
+func (b *Binance) GetAggregatedTrades(ctx context.Context, symbol string, limit int) ([]AggregatedTrade, error) {
 	var resp []AggregatedTrade
 
 	if err := b.CheckLimit(limit); err != nil {
@@ -178,7 +199,7 @@ func (b *Binance) GetAggregatedTrades(symbol string, limit int) ([]AggregatedTra
 	}
 
 	path := b.API.Endpoints.URL + aggregatedTrades + "?" + params.Encode()
-	return resp, b.SendHTTPRequest(path, limitDefault, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, limitDefault, &resp)
 }
 
 // GetSpotKline returns kline data
@@ -189,7 +210,7 @@ func (b *Binance) GetAggregatedTrades(symbol string, limit int) ([]AggregatedTra
 // interval: the interval time for the data
 // startTime: startTime filter for kline data
 // endTime: endTime filter for the kline data
-func (b *Binance) GetSpotKline(arg KlinesRequestParams) ([]CandleStick, error) {
+func (b *Binance) GetSpotKline(ctx context.Context, arg KlinesRequestParams) ([]CandleStick, error) {
 	var resp interface{}
 	var klineData []CandleStick
 
@@ -208,7 +229,7 @@ func (b *Binance) GetSpotKline(arg KlinesRequestParams) ([]CandleStick, error) {
 
 	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URL, candleStick, params.Encode())
 
-	if err := b.SendHTTPRequest(path, limitDefault, &resp); err != nil {
+	if err := b.SendHTTPRequest(ctx, path, limitDefault, &resp); err != nil {
 		return klineData, err
 	}
 
@@ -258,66 +279,122 @@ func (b *Binance) GetSpotKline(arg KlinesRequestParams) ([]CandleStick, error) {
 // GetAveragePrice returns current average price for a symbol.
 //
 // symbol: string of currency pair
-func (b *Binance) GetAveragePrice(symbol string) (AveragePrice, error) {
+func (b *Binance) GetAveragePrice(ctx context.Context, symbol string) (AveragePrice, error) {
 	resp := AveragePrice{}
 	params := url.Values{}
 	params.Set("symbol", strings.ToUpper(symbol))
 
 	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URL, averagePrice, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, limitDefault, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, limitDefault, &resp)
+}
+
+// GetPremiumIndex returns the mark price, index price and funding rate for a
+// USDT margined perpetual contract
+//
+// symbol: string of currency pair
+func (b *Binance) GetPremiumIndex(ctx context.Context, symbol string) (PremiumIndex, error) {
+	resp := PremiumIndex{}
+	params := url.Values{}
+	params.Set("symbol", strings.ToUpper(symbol))
+
+	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URLSecondary, premiumIndex, params.Encode())
+
+	return resp, b.SendHTTPRequest(ctx, path, limitDefault, &resp)
+}
+
+// GetOpenInterest returns the present open interest for a USDT margined
+// perpetual contract
+//
+// symbol: string of currency pair
+func (b *Binance) GetOpenInterest(ctx context.Context, symbol string) (OpenInterest, error) {
+	resp := OpenInterest{}
+	params := url.Values{}
+	params.Set("symbol", strings.ToUpper(symbol))
+
+	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URLSecondary, openInterest, params.Encode())
+
+	return resp, b.SendHTTPRequest(ctx, path, limitDefault, &resp)
+}
+
+// GetForceOrders returns liquidation orders for a USDT margined perpetual
+// contract
+//
+// symbol: string of currency pair
+// startTime, endTime: Optional. Only the last 7 days of data is available
+// limit: Optional. Default 100; max 1000.
+func (b *Binance) GetForceOrders(ctx context.Context, symbol string, startTime, endTime time.Time, limit int) ([]ForceOrder, error) {
+	var resp []ForceOrder
+	params := url.Values{}
+	params.Set("symbol", strings.ToUpper(symbol))
+	if !startTime.IsZero() {
+		params.Set("startTime", strconv.FormatInt(convert.UnixMillis(startTime), 10))
+	}
+	if !endTime.IsZero() {
+		params.Set("endTime", strconv.FormatInt(convert.UnixMillis(endTime), 10))
+	}
+	if limit > 0 {
+		if limit > forceOrderLimit {
+			return nil, fmt.Errorf("limit %d exceeds maximum of %d", limit, forceOrderLimit)
+		}
+		params.Set("limit", strconv.Itoa(limit))
+	}
+
+	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URLSecondary, allForceOrders, params.Encode())
+
+	return resp, b.SendHTTPRequest(ctx, path, limitDefault, &resp)
 }
 
 // GetPriceChangeStats returns price change statistics for the last 24 hours
 //
 // symbol: string of currency pair
-func (b *Binance) GetPriceChangeStats(symbol string) (PriceChangeStats, error) {
+func (b *Binance) GetPriceChangeStats(ctx context.Context, symbol string) (PriceChangeStats, error) {
 	resp := PriceChangeStats{}
 	params := url.Values{}
 	params.Set("symbol", strings.ToUpper(symbol))
 
 	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URL, priceChange, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, limitDefault, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, limitDefault, &resp)
 }
 
 // GetTickers returns the ticker data for the last 24 hrs
-func (b *Binance) GetTickers() ([]PriceChangeStats, error) {
+func (b *Binance) GetTickers(ctx context.Context) ([]PriceChangeStats, error) {
 	var resp []PriceChangeStats
 	path := b.API.Endpoints.URL + priceChange
-	return resp, b.SendHTTPRequest(path, limitPriceChangeAll, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, limitPriceChangeAll, &resp)
 }
 
 // GetLatestSpotPrice returns latest spot price of symbol
 //
 // symbol: string of currency pair
-func (b *Binance) GetLatestSpotPrice(symbol string) (SymbolPrice, error) {
+func (b *Binance) GetLatestSpotPrice(ctx context.Context, symbol string) (SymbolPrice, error) {
 	resp := SymbolPrice{}
 	params := url.Values{}
 	params.Set("symbol", strings.ToUpper(symbol))
 
 	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URL, symbolPrice, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, symbolPriceLimit(symbol), &resp)
+	return resp, b.SendHTTPRequest(ctx, path, symbolPriceLimit(symbol), &resp)
 }
 
 // GetBestPrice returns the latest best price for symbol
 //
 // symbol: string of currency pair
-func (b *Binance) GetBestPrice(symbol string) (BestPrice, error) {
+func (b *Binance) GetBestPrice(ctx context.Context, symbol string) (BestPrice, error) {
 	resp := BestPrice{}
 	params := url.Values{}
 	params.Set("symbol", strings.ToUpper(symbol))
 
 	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URL, bestPrice, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, bestPriceLimit(symbol), &resp)
+	return resp, b.SendHTTPRequest(ctx, path, bestPriceLimit(symbol), &resp)
 }
 
 // NewOrder sends a new order to Binance
-func (b *Binance) NewOrder(o *NewOrderRequest) (NewOrderResponse, error) {
+func (b *Binance) NewOrder(ctx context.Context, o *NewOrderRequest) (NewOrderResponse, error) {
 	var resp NewOrderResponse
-	if err := b.newOrder(newOrder, o, &resp); err != nil {
+	if err := b.newOrder(ctx, newOrder, o, &resp); err != nil {
 		return resp, err
 	}
 
@@ -329,12 +406,12 @@ func (b *Binance) NewOrder(o *NewOrderRequest) (NewOrderResponse, error) {
 }
 
 // NewOrderTest sends a new test order to Binance
-func (b *Binance) NewOrderTest(o *NewOrderRequest) error {
+func (b *Binance) NewOrderTest(ctx context.Context, o *NewOrderRequest) error {
 	var resp NewOrderResponse
-	return b.newOrder(newOrderTest, o, &resp)
+	return b.newOrder(ctx, newOrderTest, o, &resp)
 }
 
-func (b *Binance) newOrder(api string, o *NewOrderRequest, resp *NewOrderResponse) error {
+func (b *Binance) newOrder(ctx context.Context, api string, o *NewOrderRequest, resp *NewOrderResponse) error {
 	path := b.API.Endpoints.URL + api
 
 	params := url.Values{}
@@ -369,11 +446,11 @@ func (b *Binance) newOrder(api string, o *NewOrderRequest, resp *NewOrderRespons
 		params.Set("newOrderRespType", o.NewOrderRespType)
 	}
 
-	return b.SendAuthHTTPRequest(http.MethodPost, path, params, limitOrder, resp)
+	return b.SendAuthHTTPRequest(ctx, http.MethodPost, path, params, limitOrder, resp)
 }
 
 // CancelExistingOrder sends a cancel order to Binance
-func (b *Binance) CancelExistingOrder(symbol string, orderID int64, origClientOrderID string) (CancelOrderResponse, error) {
+func (b *Binance) CancelExistingOrder(ctx context.Context, symbol string, orderID int64, origClientOrderID string) (CancelOrderResponse, error) {
 	var resp CancelOrderResponse
 
 	path := b.API.Endpoints.URL + cancelOrder
@@ -389,13 +466,13 @@ func (b *Binance) CancelExistingOrder(symbol string, orderID int64, origClientOr
 		params.Set("origClientOrderId", origClientOrderID)
 	}
 
-	return resp, b.SendAuthHTTPRequest(http.MethodDelete, path, params, limitOrder, &resp)
+	return resp, b.SendAuthHTTPRequest(ctx, http.MethodDelete, path, params, limitOrder, &resp)
 }
 
 // OpenOrders Current open orders. Get all open orders on a symbol.
 // Careful when accessing this with no symbol: The number of requests counted against the rate limiter
 // is significantly higher
-func (b *Binance) OpenOrders(symbol string) ([]QueryOrderData, error) {
+func (b *Binance) OpenOrders(ctx context.Context, symbol string) ([]QueryOrderData, error) {
 	var resp []QueryOrderData
 
 	path := b.API.Endpoints.URL + openOrders
@@ -406,7 +483,7 @@ func (b *Binance) OpenOrders(symbol string) ([]QueryOrderData, error) {
 		params.Set("symbol", strings.ToUpper(symbol))
 	}
 
-	if err := b.SendAuthHTTPRequest(http.MethodGet, path, params, openOrdersLimit(symbol), &resp); err != nil {
+	if err := b.SendAuthHTTPRequest(ctx, http.MethodGet, path, params, openOrdersLimit(symbol), &resp); err != nil {
 		return resp, err
 	}
 
@@ -416,7 +493,7 @@ func (b *Binance) OpenOrders(symbol string) ([]QueryOrderData, error) {
 // AllOrders Get all account orders; active, canceled, or filled.
 // orderId optional param
 // limit optional param, default 500; max 500
-func (b *Binance) AllOrders(symbol, orderID, limit string) ([]QueryOrderData, error) {
+func (b *Binance) AllOrders(ctx context.Context, symbol, orderID, limit string) ([]QueryOrderData, error) {
 	var resp []QueryOrderData
 
 	path := b.API.Endpoints.URL + allOrders
@@ -429,7 +506,7 @@ func (b *Binance) AllOrders(symbol, orderID, limit string) ([]QueryOrderData, er
 	if limit != "" {
 		params.Set("limit", limit)
 	}
-	if err := b.SendAuthHTTPRequest(http.MethodGet, path, params, limitOrdersAll, &resp); err != nil {
+	if err := b.SendAuthHTTPRequest(ctx, http.MethodGet, path, params, limitOrdersAll, &resp); err != nil {
 		return resp, err
 	}
 
@@ -437,7 +514,7 @@ func (b *Binance) AllOrders(symbol, orderID, limit string) ([]QueryOrderData, er
 }
 
 // QueryOrder returns information on a past order
-func (b *Binance) QueryOrder(symbol, origClientOrderID string, orderID int64) (QueryOrderData, error) {
+func (b *Binance) QueryOrder(ctx context.Context, symbol, origClientOrderID string, orderID int64) (QueryOrderData, error) {
 	var resp QueryOrderData
 
 	path := b.API.Endpoints.URL + queryOrder
@@ -451,7 +528,7 @@ func (b *Binance) QueryOrder(symbol, origClientOrderID string, orderID int64) (Q
 		params.Set("orderId", strconv.FormatInt(orderID, 10))
 	}
 
-	if err := b.SendAuthHTTPRequest(http.MethodGet, path, params, limitOrder, &resp); err != nil {
+	if err := b.SendAuthHTTPRequest(ctx, http.MethodGet, path, params, limitOrder, &resp); err != nil {
 		return resp, err
 	}
 
@@ -462,7 +539,7 @@ func (b *Binance) QueryOrder(symbol, origClientOrderID string, orderID int64) (Q
 }
 
 // GetAccount returns binance user accounts
-func (b *Binance) GetAccount() (*Account, error) {
+func (b *Binance) GetAccount(ctx context.Context) (*Account, error) {
 	type response struct {
 		Response
 		Account
@@ -473,7 +550,7 @@ func (b *Binance) GetAccount() (*Account, error) {
 	path := b.API.Endpoints.URL + accountInfo
 	params := url.Values{}
 
-	if err := b.SendAuthHTTPRequest(http.MethodGet, path, params, request.Unset, &resp); err != nil {
+	if err := b.SendAuthHTTPRequest(ctx, http.MethodGet, path, params, request.Unset, &resp); err != nil {
 		return &resp.Account, err
 	}
 
@@ -485,8 +562,8 @@ func (b *Binance) GetAccount() (*Account, error) {
 }
 
 // SendHTTPRequest sends an unauthenticated request
-func (b *Binance) SendHTTPRequest(path string, f request.EndpointLimit, result interface{}) error {
-	return b.SendPayload(context.Background(), &request.Item{
+func (b *Binance) SendHTTPRequest(ctx context.Context, path string, f request.EndpointLimit, result interface{}) error {
+	return b.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -497,7 +574,7 @@ func (b *Binance) SendHTTPRequest(path string, f request.EndpointLimit, result i
 }
 
 // SendAuthHTTPRequest sends an authenticated HTTP request
-func (b *Binance) SendAuthHTTPRequest(method, path string, params url.Values, f request.EndpointLimit, result interface{}) error {
+func (b *Binance) SendAuthHTTPRequest(ctx context.Context, method, path string, params url.Values, f request.EndpointLimit, result interface{}) error {
 	if !b.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, b.Name)
 	}
@@ -507,7 +584,7 @@ func (b *Binance) SendAuthHTTPRequest(method, path string, params url.Values, f
 	}
 	recvWindow := 5 * time.Second
 	params.Set("recvWindow", strconv.FormatInt(convert.RecvWindow(recvWindow), 10))
-	params.Set("timestamp", strconv.FormatInt(time.Now().Unix()*1000, 10))
+	params.Set("timestamp", strconv.FormatInt(b.Now().UnixNano()/int64(time.Millisecond), 10))
 
 	signature := params.Encode()
 	hmacSigned := crypto.GetHMAC(crypto.HashSHA256, []byte(signature), []byte(b.API.Credentials.Secret))
@@ -530,7 +607,7 @@ func (b *Binance) SendAuthHTTPRequest(method, path string, params url.Values, f
 		Message string `json:"msg"`
 	}{}
 
-	ctx, cancel := context.WithTimeout(context.Background(), recvWindow)
+	ctx, cancel := context.WithTimeout(ctx, recvWindow)
 	defer cancel()
 	err := b.SendPayload(ctx, &request.Item{
 		Method:        method,
@@ -572,12 +649,12 @@ func (b *Binance) SetValues() {
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *Binance) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *Binance) GetFee(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		multiplier, err := b.getMultiplier(feeBuilder.IsMaker)
+		multiplier, err := b.getMultiplier(ctx, feeBuilder.IsMaker)
 		if err != nil {
 			return 0, err
 		}
@@ -599,9 +676,9 @@ func getOfflineTradeFee(price, amount float64) float64 {
 }
 
 // getMultiplier retrieves account based taker/maker fees
-func (b *Binance) getMultiplier(isMaker bool) (float64, error) {
+func (b *Binance) getMultiplier(ctx context.Context, isMaker bool) (float64, error) {
 	var multiplier float64
-	account, err := b.GetAccount()
+	account, err := b.GetAccount(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -624,7 +701,7 @@ func getCryptocurrencyWithdrawalFee(c currency.Code) float64 {
 }
 
 // WithdrawCrypto sends cryptocurrency to the address of your choosing
-func (b *Binance) WithdrawCrypto(asset, address, addressTag, name, amount string) (string, error) {
+func (b *Binance) WithdrawCrypto(ctx context.Context, asset, address, addressTag, name, amount string) (string, error) {
 	var resp WithdrawResponse
 	path := b.API.Endpoints.URL + withdrawEndpoint
 
@@ -639,7 +716,7 @@ func (b *Binance) WithdrawCrypto(asset, address, addressTag, name, amount string
 		params.Set("addressTag", addressTag)
 	}
 
-	if err := b.SendAuthHTTPRequest(http.MethodPost, path, params, request.Unset, &resp); err != nil {
+	if err := b.SendAuthHTTPRequest(ctx, http.MethodPost, path, params, request.Unset, &resp); err != nil {
 		return "", err
 	}
 
@@ -651,7 +728,7 @@ func (b *Binance) WithdrawCrypto(asset, address, addressTag, name, amount string
 }
 
 // GetDepositAddressForCurrency retrieves the wallet address for a given currency
-func (b *Binance) GetDepositAddressForCurrency(currency string) (string, error) {
+func (b *Binance) GetDepositAddressForCurrency(ctx context.Context, currency string) (string, error) {
 	path := b.API.Endpoints.URL + depositAddress
 
 	resp := struct {
@@ -665,16 +742,16 @@ func (b *Binance) GetDepositAddressForCurrency(currency string) (string, error)
 	params.Set("status", "true")
 
 	return resp.Address,
-		b.SendAuthHTTPRequest(http.MethodGet, path, params, request.Unset, &resp)
+		b.SendAuthHTTPRequest(ctx, http.MethodGet, path, params, request.Unset, &resp)
 }
 
 // GetWsAuthStreamKey will retrieve a key to use for authorised WS streaming
-func (b *Binance) GetWsAuthStreamKey() (string, error) {
+func (b *Binance) GetWsAuthStreamKey(ctx context.Context) (string, error) {
 	var resp UserAccountStream
 	path := b.API.Endpoints.URL + userAccountStream
 	headers := make(map[string]string)
 	headers["X-MBX-APIKEY"] = b.API.Credentials.Key
-	err := b.SendPayload(context.Background(), &request.Item{
+	err := b.SendPayload(ctx, &request.Item{
 		Method:        http.MethodPost,
 		Path:          path,
 		Headers:       headers,
@@ -692,10 +769,10 @@ func (b *Binance) GetWsAuthStreamKey() (string, error) {
 }
 
 // MaintainWsAuthStreamKey will keep the key alive
-func (b *Binance) MaintainWsAuthStreamKey() error {
+func (b *Binance) MaintainWsAuthStreamKey(ctx context.Context) error {
 	var err error
 	if listenKey == "" {
-		listenKey, err = b.GetWsAuthStreamKey()
+		listenKey, err = b.GetWsAuthStreamKey(ctx)
 		return err
 	}
 	path := b.API.Endpoints.URL + userAccountStream
@@ -704,7 +781,7 @@ func (b *Binance) MaintainWsAuthStreamKey() error {
 	path = common.EncodeURLValues(path, params)
 	headers := make(map[string]string)
 	headers["X-MBX-APIKEY"] = b.API.Credentials.Key
-	return b.SendPayload(context.Background(), &request.Item{
+	return b.SendPayload(ctx, &request.Item{
 		Method:        http.MethodPut,
 		Path:          path,
 		Headers:       headers,
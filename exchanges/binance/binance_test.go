@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -38,16 +39,27 @@ func setFeeBuilder() *exchange.FeeBuilder {
 
 func TestGetExchangeInfo(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetExchangeInfo()
+	_, err := b.GetExchangeInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
 }
 
+func TestGetServerTime(t *testing.T) {
+	t.Parallel()
+	st, err := b.GetServerTime(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.IsZero() {
+		t.Error("expected server time to be set")
+	}
+}
+
 func TestFetchTradablePairs(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.FetchTradablePairs(asset.Spot)
+	_, err := b.FetchTradablePairs(context.Background(), asset.Spot)
 	if err != nil {
 		t.Error("Binance FetchTradablePairs(asset asets.AssetType) error", err)
 	}
@@ -56,7 +68,7 @@ func TestFetchTradablePairs(t *testing.T) {
 func TestGetOrderBook(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOrderBook(OrderBookDataRequestParams{
+	_, err := b.GetOrderBook(context.Background(), OrderBookDataRequestParams{
 		Symbol: "BTCUSDT",
 		Limit:  10,
 	})
@@ -69,7 +81,7 @@ func TestGetOrderBook(t *testing.T) {
 func TestGetRecentTrades(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetRecentTrades(RecentTradeRequestParams{
+	_, err := b.GetRecentTrades(context.Background(), RecentTradeRequestParams{
 		Symbol: "BTCUSDT",
 		Limit:  15,
 	})
@@ -93,7 +105,7 @@ func TestGetHistoricalTrades(t *testing.T) {
 
 func TestGetAggregatedTrades(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetAggregatedTrades("BTCUSDT", 5)
+	_, err := b.GetAggregatedTrades(context.Background(), "BTCUSDT", 5)
 	if err != nil {
 		t.Error("Binance GetAggregatedTrades() error", err)
 	}
@@ -101,7 +113,7 @@ func TestGetAggregatedTrades(t *testing.T) {
 
 func TestGetSpotKline(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetSpotKline(KlinesRequestParams{
+	_, err := b.GetSpotKline(context.Background(), KlinesRequestParams{
 		Symbol:    "BTCUSDT",
 		Interval:  kline.FiveMin.Short(),
 		Limit:     24,
@@ -116,7 +128,7 @@ func TestGetSpotKline(t *testing.T) {
 func TestGetAveragePrice(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetAveragePrice("BTCUSDT")
+	_, err := b.GetAveragePrice(context.Background(), "BTCUSDT")
 	if err != nil {
 		t.Error("Binance GetAveragePrice() error", err)
 	}
@@ -125,7 +137,7 @@ func TestGetAveragePrice(t *testing.T) {
 func TestGetPriceChangeStats(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetPriceChangeStats("BTCUSDT")
+	_, err := b.GetPriceChangeStats(context.Background(), "BTCUSDT")
 	if err != nil {
 		t.Error("Binance GetPriceChangeStats() error", err)
 	}
@@ -134,7 +146,7 @@ func TestGetPriceChangeStats(t *testing.T) {
 func TestGetTickers(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetTickers()
+	_, err := b.GetTickers(context.Background())
 	if err != nil {
 		t.Error("Binance TestGetTickers error", err)
 	}
@@ -143,7 +155,7 @@ func TestGetTickers(t *testing.T) {
 func TestGetLatestSpotPrice(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetLatestSpotPrice("BTCUSDT")
+	_, err := b.GetLatestSpotPrice(context.Background(), "BTCUSDT")
 	if err != nil {
 		t.Error("Binance GetLatestSpotPrice() error", err)
 	}
@@ -152,16 +164,89 @@ func TestGetLatestSpotPrice(t *testing.T) {
 func TestGetBestPrice(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetBestPrice("BTCUSDT")
+	_, err := b.GetBestPrice(context.Background(), "BTCUSDT")
 	if err != nil {
 		t.Error("Binance GetBestPrice() error", err)
 	}
 }
 
+func TestGetPremiumIndex(t *testing.T) {
+	t.Parallel()
+
+	_, err := b.GetPremiumIndex(context.Background(), "BTCUSDT")
+	if err != nil {
+		t.Error("Binance GetPremiumIndex() error", err)
+	}
+}
+
+func TestGetOpenInterest(t *testing.T) {
+	t.Parallel()
+
+	_, err := b.GetOpenInterest(context.Background(), "BTCUSDT")
+	if err != nil {
+		t.Error("Binance GetOpenInterest() error", err)
+	}
+}
+
+func TestGetForceOrders(t *testing.T) {
+	t.Parallel()
+
+	_, err := b.GetForceOrders(context.Background(), "BTCUSDT", time.Time{}, time.Time{}, forceOrderLimit+1)
+	if err == nil {
+		t.Error("Binance GetForceOrders() expecting an error when limit is exceeded")
+	}
+
+	start := time.Unix(1597363200, 0)
+	if !mockTests {
+		start = time.Now().Add(-time.Hour)
+	}
+	_, err = b.GetForceOrders(context.Background(), "BTCUSDT", start, start.Add(time.Hour), forceOrderLimit)
+	if err != nil {
+		t.Error("Binance GetForceOrders() error", err)
+	}
+}
+
+func TestUpdateDerivativeInfo(t *testing.T) {
+	t.Parallel()
+
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := b.UpdateDerivativeInfo(context.Background(), p, asset.Spot)
+	if err == nil {
+		t.Error("Binance UpdateDerivativeInfo() expecting an error for spot")
+	}
+
+	info, err := b.UpdateDerivativeInfo(context.Background(), p, asset.PerpetualSwap)
+	if err != nil {
+		t.Fatal("Binance UpdateDerivativeInfo() error", err)
+	}
+	if mockTests && info.FundingRate != 0.00038246 {
+		t.Errorf("Binance UpdateDerivativeInfo() unexpected funding rate %v", info.FundingRate)
+	}
+}
+
+func TestGetLiquidations(t *testing.T) {
+	t.Parallel()
+
+	start := time.Unix(1597363200, 0)
+	if !mockTests {
+		start = time.Now().Add(-time.Hour)
+	}
+	liquidations, err := b.GetLiquidations(context.Background(), currency.NewPair(currency.BTC, currency.USDT),
+		asset.PerpetualSwap,
+		start,
+		start.Add(time.Hour))
+	if err != nil {
+		t.Fatal("Binance GetLiquidations() error", err)
+	}
+	if mockTests && (len(liquidations) != 2 || liquidations[0].Side != order.Sell) {
+		t.Error("Binance GetLiquidations() unexpected result")
+	}
+}
+
 func TestQueryOrder(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.QueryOrder("BTCUSDT", "", 1337)
+	_, err := b.QueryOrder(context.Background(), "BTCUSDT", "", 1337)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("QueryOrder() error", err)
@@ -175,7 +260,7 @@ func TestQueryOrder(t *testing.T) {
 func TestOpenOrders(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.OpenOrders("BTCUSDT")
+	_, err := b.OpenOrders(context.Background(), "BTCUSDT")
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("OpenOrders() error", err)
@@ -189,7 +274,7 @@ func TestOpenOrders(t *testing.T) {
 func TestAllOrders(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.AllOrders("BTCUSDT", "", "")
+	_, err := b.AllOrders(context.Background(), "BTCUSDT", "", "")
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("AllOrders() error", err)
@@ -205,7 +290,7 @@ func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	t.Parallel()
 
 	var feeBuilder = setFeeBuilder()
-	b.GetFeeByType(feeBuilder)
+	b.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() || mockTests {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -224,7 +309,7 @@ func TestGetFee(t *testing.T) {
 
 	if areTestAPIKeysSet() || mockTests {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.1) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.1) || err != nil {
 			t.Error(err)
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		}
@@ -233,7 +318,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(100000) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(100000) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(100000), resp)
 			t.Error(err)
 		}
@@ -241,7 +326,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.1) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.1) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.1), resp)
 			t.Error(err)
 		}
@@ -249,7 +334,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -258,7 +343,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.0005) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.0005) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.0005), resp)
 		t.Error(err)
 	}
@@ -266,7 +351,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -275,7 +360,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.FiatCurrency = currency.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -284,7 +369,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -306,7 +391,7 @@ func TestGetActiveOrders(t *testing.T) {
 	var getOrdersRequest = order.GetOrdersRequest{
 		Type: order.AnyType,
 	}
-	_, err := b.GetActiveOrders(&getOrdersRequest)
+	_, err := b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if err == nil {
 		t.Error("Expected: 'At least one currency is required to fetch order history'. received nil")
 	}
@@ -315,7 +400,7 @@ func TestGetActiveOrders(t *testing.T) {
 		currency.NewPair(currency.LTC, currency.BTC),
 	}
 
-	_, err = b.GetActiveOrders(&getOrdersRequest)
+	_, err = b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("GetActiveOrders() error", err)
@@ -333,7 +418,7 @@ func TestGetOrderHistory(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := b.GetOrderHistory(&getOrdersRequest)
+	_, err := b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if err == nil {
 		t.Error("Expected: 'At least one currency is required to fetch order history'. received nil")
 	}
@@ -342,7 +427,7 @@ func TestGetOrderHistory(t *testing.T) {
 		currency.NewPair(currency.LTC,
 			currency.BTC)}
 
-	_, err = b.GetOrderHistory(&getOrdersRequest)
+	_, err = b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("GetOrderHistory() error", err)
@@ -365,7 +450,7 @@ func TestNewOrderTest(t *testing.T) {
 		TimeInForce: BinanceRequestParamsTimeGTC,
 	}
 
-	err := b.NewOrderTest(req)
+	err := b.NewOrderTest(context.Background(), req)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("NewOrderTest() error", err)
@@ -383,7 +468,7 @@ func TestNewOrderTest(t *testing.T) {
 		QuoteOrderQty: 10,
 	}
 
-	err = b.NewOrderTest(req)
+	err = b.NewOrderTest(context.Background(), req)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("NewOrderTest() error", err)
@@ -417,7 +502,7 @@ func TestSubmitOrder(t *testing.T) {
 		ClientID: "meowOrder",
 	}
 
-	_, err := b.SubmitOrder(orderSubmission)
+	_, err := b.SubmitOrder(context.Background(), orderSubmission)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("SubmitOrder() error", err)
@@ -442,7 +527,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		AssetType:     asset.Spot,
 	}
 
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("CancelExchangeOrder() error", err)
@@ -466,7 +551,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		Pair:          currency.NewPair(currency.LTC, currency.BTC),
 	}
 
-	_, err := b.CancelAllOrders(orderCancellation)
+	_, err := b.CancelAllOrders(context.Background(), orderCancellation)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("CancelAllExchangeOrders() error", err)
@@ -480,7 +565,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 func TestGetAccountInfo(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.UpdateAccountInfo()
+	_, err := b.UpdateAccountInfo(context.Background())
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("GetAccountInfo() error", err)
@@ -494,7 +579,7 @@ func TestGetAccountInfo(t *testing.T) {
 func TestModifyOrder(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.ModifyOrder(&order.Modify{})
+	_, err := b.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() error cannot be nil")
 	}
@@ -515,7 +600,7 @@ func TestWithdraw(t *testing.T) {
 		},
 	}
 
-	_, err := b.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := b.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("Withdraw() error", err)
@@ -529,7 +614,7 @@ func TestWithdraw(t *testing.T) {
 func TestWithdrawFiat(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.WithdrawFiatFunds(&withdraw.Request{})
+	_, err := b.WithdrawFiatFunds(context.Background(), &withdraw.Request{})
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -538,7 +623,7 @@ func TestWithdrawFiat(t *testing.T) {
 func TestWithdrawInternationalBank(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.WithdrawFiatFundsToInternationalBank(&withdraw.Request{})
+	_, err := b.WithdrawFiatFundsToInternationalBank(context.Background(), &withdraw.Request{})
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -547,7 +632,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetDepositAddress(currency.BTC, "")
+	_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("GetDepositAddress() error", err)
@@ -858,7 +943,7 @@ func TestWsOCO(t *testing.T) {
 }
 
 func TestGetWsAuthStreamKey(t *testing.T) {
-	key, err := b.GetWsAuthStreamKey()
+	key, err := b.GetWsAuthStreamKey(context.Background())
 	switch {
 	case mockTests && err != nil,
 		!mockTests && areTestAPIKeysSet() && err != nil:
@@ -873,7 +958,7 @@ func TestGetWsAuthStreamKey(t *testing.T) {
 }
 
 func TestMaintainWsAuthStreamKey(t *testing.T) {
-	err := b.MaintainWsAuthStreamKey()
+	err := b.MaintainWsAuthStreamKey(context.Background())
 	switch {
 	case mockTests && err != nil,
 		!mockTests && areTestAPIKeysSet() && err != nil:
@@ -911,12 +996,12 @@ func TestGetHistoricCandles(t *testing.T) {
 	}
 	startTime := time.Unix(1546300800, 0)
 	end := time.Unix(1577836799, 0)
-	_, err = b.GetHistoricCandles(currencyPair, asset.Spot, startTime, end, kline.OneDay)
+	_, err = b.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, startTime, end, kline.OneDay)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = b.GetHistoricCandles(currencyPair, asset.Spot, startTime, end, kline.Interval(time.Hour*7))
+	_, err = b.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, startTime, end, kline.Interval(time.Hour*7))
 	if err == nil {
 		t.Fatal("unexpected result")
 	}
@@ -929,11 +1014,11 @@ func TestGetHistoricCandlesExtended(t *testing.T) {
 	}
 	startTime := time.Unix(1546300800, 0)
 	end := time.Unix(1577836799, 0)
-	_, err = b.GetHistoricCandlesExtended(currencyPair, asset.Spot, startTime, end, kline.OneDay)
+	_, err = b.GetHistoricCandlesExtended(context.Background(), currencyPair, asset.Spot, startTime, end, kline.OneDay)
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = b.GetHistoricCandlesExtended(currencyPair, asset.Spot, startTime, end, kline.Interval(time.Hour*7))
+	_, err = b.GetHistoricCandlesExtended(context.Background(), currencyPair, asset.Spot, startTime, end, kline.Interval(time.Hour*7))
 	if err == nil {
 		t.Fatal("unexpected result")
 	}
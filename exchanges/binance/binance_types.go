@@ -233,6 +233,39 @@ type AveragePrice struct {
 	Price float64 `json:"price,string"`
 }
 
+// PremiumIndex holds the mark price, index price and funding data for a USDT
+// margined perpetual contract
+type PremiumIndex struct {
+	Symbol          string  `json:"symbol"`
+	MarkPrice       float64 `json:"markPrice,string"`
+	IndexPrice      float64 `json:"indexPrice,string"`
+	LastFundingRate float64 `json:"lastFundingRate,string"`
+	NextFundingTime int64   `json:"nextFundingTime"`
+	InterestRate    float64 `json:"interestRate,string"`
+	Time            int64   `json:"time"`
+}
+
+// OpenInterest holds the open interest for a USDT margined perpetual contract
+type OpenInterest struct {
+	Symbol       string  `json:"symbol"`
+	OpenInterest float64 `json:"openInterest,string"`
+	Time         int64   `json:"time"`
+}
+
+// ForceOrder holds a liquidation order for a USDT margined perpetual contract
+type ForceOrder struct {
+	Symbol       string  `json:"symbol"`
+	Price        float64 `json:"price,string"`
+	OrigQty      float64 `json:"origQty,string"`
+	ExecutedQty  float64 `json:"executedQty,string"`
+	AveragePrice float64 `json:"averagePrice,string"`
+	Status       string  `json:"status"`
+	TimeInForce  string  `json:"timeInForce"`
+	Type         string  `json:"type"`
+	Side         string  `json:"side"`
+	Time         int64   `json:"time"`
+}
+
 // PriceChangeStats contains statistics for the last 24 hours trade
 type PriceChangeStats struct {
 	Symbol             string  `json:"symbol"`
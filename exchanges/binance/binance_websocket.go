@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -36,7 +37,7 @@ func (b *Binance) WsConnect() error {
 	var dialer websocket.Dialer
 	var err error
 	if b.Websocket.CanUseAuthenticatedEndpoints() {
-		listenKey, err = b.GetWsAuthStreamKey()
+		listenKey, err = b.GetWsAuthStreamKey(context.Background())
 		if err != nil {
 			b.Websocket.SetCanUseAuthenticatedEndpoints(false)
 			log.Errorf(log.ExchangeSys,
@@ -104,7 +105,7 @@ func (b *Binance) KeepAuthKeyAlive() {
 			ticks.Stop()
 			return
 		case <-ticks.C:
-			err := b.MaintainWsAuthStreamKey()
+			err := b.MaintainWsAuthStreamKey(context.Background())
 			if err != nil {
 				b.Websocket.DataHandler <- err
 				log.Warnf(log.ExchangeSys,
@@ -292,6 +293,7 @@ func (b *Binance) wsHandleData(respRaw []byte) error {
 					}
 
 					b.Websocket.DataHandler <- stream.TradeData{
+						TID:          strconv.FormatInt(trade.TradeID, 10),
 						CurrencyPair: pair,
 						Timestamp:    time.Unix(0, trade.TimeStamp*int64(time.Millisecond)),
 						Price:        price,
@@ -407,7 +409,7 @@ func (b *Binance) SeedLocalCache(p currency.Pair) error {
 		return err
 	}
 
-	ob, err := b.GetOrderBook(OrderBookDataRequestParams{
+	ob, err := b.GetOrderBook(context.Background(), OrderBookDataRequestParams{
 		Symbol: fPair.String(),
 		Limit:  1000,
 	})
@@ -1,7 +1,9 @@
 package binance
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"sync"
@@ -13,6 +15,7 @@ import (
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
 	"github.com/yurulab/gocryptotrader/exchanges/account"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/derivative"
 	"github.com/yurulab/gocryptotrader/exchanges/kline"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
@@ -38,7 +41,7 @@ func (b *Binance) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if b.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = b.UpdateTradablePairs(true)
+		err = b.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -147,6 +150,8 @@ func (b *Binance) SetDefaults() {
 
 	b.API.Endpoints.URLDefault = apiURL
 	b.API.Endpoints.URL = b.API.Endpoints.URLDefault
+	b.API.Endpoints.URLSecondaryDefault = futuresAPIURL
+	b.API.Endpoints.URLSecondary = b.API.Endpoints.URLSecondaryDefault
 	b.Websocket = stream.New()
 	b.API.Endpoints.WebsocketURL = binanceDefaultWebsocketURL
 	b.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
@@ -266,7 +271,7 @@ func (b *Binance) Run() {
 	if !b.GetEnabledFeatures().AutoPairUpdates && !forceUpdate {
 		return
 	}
-	err = b.UpdateTradablePairs(forceUpdate)
+	err = b.UpdateTradablePairs(context.Background(), forceUpdate)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s failed to update tradable pairs. Err: %s",
@@ -276,8 +281,8 @@ func (b *Binance) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (b *Binance) FetchTradablePairs(a asset.Item) ([]string, error) {
-	info, err := b.GetExchangeInfo()
+func (b *Binance) FetchTradablePairs(ctx context.Context, a asset.Item) ([]string, error) {
+	info, err := b.GetExchangeInfo(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -306,10 +311,10 @@ func (b *Binance) FetchTradablePairs(a asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (b *Binance) UpdateTradablePairs(forceUpdate bool) error {
+func (b *Binance) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
 	assetTypes := b.GetAssetTypes()
 	for i := range assetTypes {
-		p, err := b.FetchTradablePairs(assetTypes[i])
+		p, err := b.FetchTradablePairs(ctx, assetTypes[i])
 		if err != nil {
 			return err
 		}
@@ -328,8 +333,8 @@ func (b *Binance) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Binance) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
-	tick, err := b.GetTickers()
+func (b *Binance) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+	tick, err := b.GetTickers(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -372,32 +377,37 @@ func (b *Binance) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.P
 	return ticker.GetTicker(b.Name, p, assetType)
 }
 
+// FetchServerTime returns the current exchange server time
+func (b *Binance) FetchServerTime(ctx context.Context) (time.Time, error) {
+	return b.GetServerTime(ctx)
+}
+
 // FetchTicker returns the ticker for a currency pair
-func (b *Binance) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *Binance) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (b *Binance) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Binance) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(p, assetType)
+		return b.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Binance) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Binance) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	fpair, err := b.FormatExchangeCurrency(p, assetType)
 	if err != nil {
 		return nil, err
 	}
 
-	orderbookNew, err := b.GetOrderBook(OrderBookDataRequestParams{
+	orderbookNew, err := b.GetOrderBook(ctx, OrderBookDataRequestParams{
 		Symbol: fpair.String(),
 		Limit:  1000})
 	if err != nil {
@@ -435,9 +445,9 @@ func (b *Binance) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*order
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // Bithumb exchange
-func (b *Binance) UpdateAccountInfo() (account.Holdings, error) {
+func (b *Binance) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var info account.Holdings
-	raw, err := b.GetAccount()
+	raw, err := b.GetAccount(ctx)
 	if err != nil {
 		return info, err
 	}
@@ -475,10 +485,10 @@ func (b *Binance) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (b *Binance) FetchAccountInfo() (account.Holdings, error) {
+func (b *Binance) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(b.Name)
 	if err != nil {
-		return b.UpdateAccountInfo()
+		return b.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -486,17 +496,100 @@ func (b *Binance) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *Binance) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *Binance) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data within the timeframe provided.
-func (b *Binance) GetExchangeHistory(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+func (b *Binance) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
+// UpdateDerivativeInfo updates and returns the funding, open interest and
+// pricing data for a USDT margined perpetual contract
+func (b *Binance) UpdateDerivativeInfo(ctx context.Context, p currency.Pair, assetType asset.Item) (*derivative.Info, error) {
+	if assetType != asset.PerpetualSwap {
+		return nil, fmt.Errorf("%s asset type %s is not supported", b.Name, assetType)
+	}
+	// USDT margined contracts share the spot symbol format
+	fPair, err := b.FormatExchangeCurrency(p, asset.Spot)
+	if err != nil {
+		return nil, err
+	}
+
+	premium, err := b.GetPremiumIndex(ctx, fPair.String())
+	if err != nil {
+		return nil, err
+	}
+	openInterest, err := b.GetOpenInterest(ctx, fPair.String())
+	if err != nil {
+		return nil, err
+	}
+
+	err = derivative.ProcessInfo(&derivative.Info{
+		Exchange:        b.Name,
+		Pair:            p,
+		AssetType:       assetType,
+		MarkPrice:       premium.MarkPrice,
+		IndexPrice:      premium.IndexPrice,
+		FundingRate:     premium.LastFundingRate,
+		NextFundingTime: time.Unix(0, premium.NextFundingTime*int64(time.Millisecond)),
+		OpenInterest:    openInterest.OpenInterest,
+		LastUpdated:     time.Unix(0, premium.Time*int64(time.Millisecond)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return derivative.GetInfo(b.Name, p, assetType)
+}
+
+// FetchDerivativeInfo returns the funding, open interest and pricing data for
+// a USDT margined perpetual contract
+func (b *Binance) FetchDerivativeInfo(ctx context.Context, p currency.Pair, assetType asset.Item) (*derivative.Info, error) {
+	info, err := derivative.GetInfo(b.Name, p, assetType)
+	if err != nil {
+		return b.UpdateDerivativeInfo(ctx, p, assetType)
+	}
+	return info, nil
+}
+
+// GetLiquidations returns forced liquidations for a USDT margined perpetual
+// contract within the timeframe provided
+func (b *Binance) GetLiquidations(ctx context.Context, p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]derivative.Liquidation, error) {
+	if assetType != asset.PerpetualSwap {
+		return nil, fmt.Errorf("%s asset type %s is not supported", b.Name, assetType)
+	}
+	fPair, err := b.FormatExchangeCurrency(p, asset.Spot)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := b.GetForceOrders(ctx, fPair.String(), timestampStart, timestampEnd, forceOrderLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]derivative.Liquidation, len(orders))
+	for i := range orders {
+		side, err := order.StringToOrderSide(orders[i].Side)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = derivative.Liquidation{
+			Exchange:  b.Name,
+			Pair:      p,
+			AssetType: assetType,
+			Side:      side,
+			Price:     orders[i].AveragePrice,
+			Amount:    orders[i].ExecutedQty,
+			Timestamp: time.Unix(0, orders[i].Time*int64(time.Millisecond)),
+		}
+	}
+	return resp, nil
+}
+
 // SubmitOrder submits a new order
-func (b *Binance) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (b *Binance) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
@@ -529,7 +622,7 @@ func (b *Binance) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		TimeInForce: BinanceRequestParamsTimeGTC,
 	}
 
-	response, err := b.NewOrder(&orderRequest)
+	response, err := b.NewOrder(ctx, &orderRequest)
 	if err != nil {
 		return submitOrderResponse, err
 	}
@@ -546,12 +639,12 @@ func (b *Binance) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *Binance) ModifyOrder(action *order.Modify) (string, error) {
+func (b *Binance) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *Binance) CancelOrder(order *order.Cancel) error {
+func (b *Binance) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	orderIDInt, err := strconv.ParseInt(order.ID, 10, 64)
 	if err != nil {
 		return err
@@ -562,24 +655,24 @@ func (b *Binance) CancelOrder(order *order.Cancel) error {
 		return err
 	}
 
-	_, err = b.CancelExistingOrder(fpair.String(),
+	_, err = b.CancelExistingOrder(ctx, fpair.String(),
 		orderIDInt,
 		order.AccountID)
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *Binance) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (b *Binance) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
-	openOrders, err := b.OpenOrders("")
+	openOrders, err := b.OpenOrders(ctx, "")
 	if err != nil {
 		return cancelAllOrdersResponse, err
 	}
 
 	for i := range openOrders {
-		_, err = b.CancelExistingOrder(openOrders[i].Symbol,
+		_, err = b.CancelExistingOrder(ctx, openOrders[i].Symbol,
 			openOrders[i].OrderID,
 			"")
 		if err != nil {
@@ -591,21 +684,21 @@ func (b *Binance) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, err
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *Binance) GetOrderInfo(orderID string) (order.Detail, error) {
+func (b *Binance) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Binance) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	return b.GetDepositAddressForCurrency(cryptocurrency.String())
+func (b *Binance) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	return b.GetDepositAddressForCurrency(ctx, cryptocurrency.String())
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Binance) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (b *Binance) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	amountStr := strconv.FormatFloat(withdrawRequest.Amount, 'f', -1, 64)
-	v, err := b.WithdrawCrypto(withdrawRequest.Currency.String(),
+	v, err := b.WithdrawCrypto(ctx, withdrawRequest.Currency.String(),
 		withdrawRequest.Crypto.Address,
 		withdrawRequest.Crypto.AddressTag,
 		withdrawRequest.Description, amountStr)
@@ -619,27 +712,27 @@ func (b *Binance) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request)
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *Binance) WithdrawFiatFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (b *Binance) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *Binance) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (b *Binance) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (b *Binance) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *Binance) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if (!b.AllowAuthenticatedRequest() || b.SkipAuthCheck) && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
 	}
-	return b.GetFee(feeBuilder)
+	return b.GetFee(ctx, feeBuilder)
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (b *Binance) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Binance) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	if len(req.Pairs) == 0 {
 		return nil, errors.New("at least one currency is required to fetch order history")
 	}
@@ -652,7 +745,7 @@ func (b *Binance) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail,
 			return nil, err
 		}
 
-		resp, err := b.OpenOrders(fpair.String())
+		resp, err := b.OpenOrders(ctx, fpair.String())
 		if err != nil {
 			return nil, err
 		}
@@ -689,7 +782,7 @@ func (b *Binance) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail,
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (b *Binance) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Binance) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	if len(req.Pairs) == 0 {
 		return nil, errors.New("at least one currency is required to fetch order history")
 	}
@@ -700,7 +793,7 @@ func (b *Binance) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail,
 		if err != nil {
 			return nil, err
 		}
-		resp, err := b.AllOrders(fpair.String(),
+		resp, err := b.AllOrders(ctx, fpair.String(),
 			"",
 			"1000")
 		if err != nil {
@@ -743,8 +836,8 @@ func (b *Binance) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail,
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (b *Binance) ValidateCredentials() error {
-	_, err := b.UpdateAccountInfo()
+func (b *Binance) ValidateCredentials(ctx context.Context) error {
+	_, err := b.UpdateAccountInfo(ctx)
 	return b.CheckTransientError(err)
 }
 
@@ -760,7 +853,7 @@ func (b *Binance) FormatExchangeKlineInterval(in kline.Interval) string {
 }
 
 // GetHistoricCandles returns candles between a time period for a set time interval
-func (b *Binance) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (b *Binance) GetHistoricCandles(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if !b.KlineIntervalEnabled(interval) {
 		return kline.Item{}, kline.ErrorKline{
 			Interval: interval,
@@ -790,7 +883,7 @@ func (b *Binance) GetHistoricCandles(pair currency.Pair, a asset.Item, start, en
 		Interval: interval,
 	}
 
-	candles, err := b.GetSpotKline(req)
+	candles, err := b.GetSpotKline(ctx, req)
 	if err != nil {
 		return kline.Item{}, err
 	}
@@ -811,7 +904,7 @@ func (b *Binance) GetHistoricCandles(pair currency.Pair, a asset.Item, start, en
 }
 
 // GetHistoricCandlesExtended returns candles between a time period for a set time interval
-func (b *Binance) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (b *Binance) GetHistoricCandlesExtended(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if !b.KlineIntervalEnabled(interval) {
 		return kline.Item{}, kline.ErrorKline{
 			Interval: interval,
@@ -840,7 +933,7 @@ func (b *Binance) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, s
 			Limit:     int(b.Features.Enabled.Kline.ResultLimit),
 		}
 
-		candles, err := b.GetSpotKline(req)
+		candles, err := b.GetSpotKline(ctx, req)
 		if err != nil {
 			return kline.Item{}, err
 		}
@@ -1,6 +1,9 @@
 package binance
 
 import (
+	"context"
+	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/yurulab/gocryptotrader/exchanges/request"
@@ -9,8 +12,8 @@ import (
 
 const (
 	// Binance limit rates
-	// Global dictates the max rate limit for general request items which is
-	// 1200 requests per minute
+	// Global dictates the max request weight for general request items which
+	// is 1200 per minute
 	binanceGlobalInterval    = time.Minute
 	binanceGlobalRequestRate = 1200
 	// Order related limits which are segregated from the global rate limits
@@ -35,57 +38,86 @@ const (
 	limitOrdersAll
 )
 
+// Binance reports the weight used by the IP in the current minute in the
+// X-MBX-USED-WEIGHT-1M response header, older endpoints omit the interval
+const (
+	headerUsedWeight1m = "X-MBX-USED-WEIGHT-1M"
+	headerUsedWeight   = "X-MBX-USED-WEIGHT"
+)
+
+// globalWeights are the request weights of endpoints counted against the
+// global rate limit, endpoints not listed have a weight of one
+var globalWeights = map[request.EndpointLimit]int{
+	limitHistoricalTrades:   5,
+	limitOrderbookDepth500:  5,
+	limitOrderbookDepth1000: 10,
+	limitOrderbookDepth5000: 50,
+	limitOrderbookTickerAll: 2,
+	limitPriceChangeAll:     40,
+	limitSymbolPriceAll:     2,
+}
+
 // RateLimit implements the request.Limiter interface
 type RateLimit struct {
-	GlobalRate *rate.Limiter
+	GlobalRate *request.AdaptiveLimit
 	Orders     *rate.Limiter
 }
 
 // Limit executes rate limiting functionality for Binance
-func (r *RateLimit) Limit(f request.EndpointLimit) error {
-	var limiter *rate.Limiter
+func (r *RateLimit) Limit(ctx context.Context, f request.EndpointLimit) error {
 	var tokens int
 	switch f {
-	case limitHistoricalTrades:
-		limiter, tokens = r.GlobalRate, 5
-	case limitOrderbookDepth500:
-		limiter, tokens = r.GlobalRate, 5
-	case limitOrderbookDepth1000:
-		limiter, tokens = r.GlobalRate, 10
-	case limitOrderbookDepth5000:
-		limiter, tokens = r.GlobalRate, 50
-	case limitOrderbookTickerAll:
-		limiter, tokens = r.GlobalRate, 2
-	case limitPriceChangeAll:
-		limiter, tokens = r.GlobalRate, 40
-	case limitSymbolPriceAll:
-		limiter, tokens = r.GlobalRate, 2
 	case limitOpenOrdersAll:
-		limiter, tokens = r.Orders, 40
+		tokens = 40
 	case limitOrder:
-		limiter, tokens = r.Orders, 1
+		tokens = 1
 	case limitOrdersAll:
-		limiter, tokens = r.Orders, 5
+		tokens = 5
 	default:
-		limiter, tokens = r.GlobalRate, 1
+		return r.GlobalRate.Limit(ctx, f)
 	}
 
-	var finalDelay time.Duration
+	reservations := make([]*rate.Reservation, tokens)
 	for i := 0; i < tokens; i++ {
 		// Consume tokens 1 at a time as this avoids needing burst capacity in the limiter,
 		// which would otherwise allow the rate limit to be exceeded over short periods
-		finalDelay = limiter.Reserve().Delay()
+		reservations[i] = r.Orders.Reserve()
 	}
-	time.Sleep(finalDelay)
-	return nil
+	return request.WaitForReservations(ctx, reservations...)
+}
+
+// UpdateFromHeaders implements the request.HeaderUpdater interface, keeping
+// the global weight budget in line with the weight reported by Binance
+func (r *RateLimit) UpdateFromHeaders(h http.Header, now time.Time) {
+	r.GlobalRate.UpdateFromHeaders(h, now)
 }
 
 // SetRateLimit returns the rate limit for the exchange
 func SetRateLimit() *RateLimit {
 	return &RateLimit{
-		GlobalRate: request.NewRateLimit(binanceGlobalInterval, binanceGlobalRequestRate),
-		Orders:     request.NewRateLimit(binanceOrderInterval, binanceOrderRequestRate),
+		GlobalRate: request.NewAdaptiveLimit(binanceGlobalInterval,
+			binanceGlobalRequestRate,
+			globalWeights,
+			parseUsedWeight),
+		Orders: request.NewRateLimit(binanceOrderInterval, binanceOrderRequestRate),
+	}
+}
+
+// parseUsedWeight reads the used request weight from the response headers.
+// Binance weight windows are aligned to the minute
+func parseUsedWeight(h http.Header, now time.Time) (request.Quota, bool) {
+	v := h.Get(headerUsedWeight1m)
+	if v == "" {
+		v = h.Get(headerUsedWeight)
+	}
+	used, err := strconv.Atoi(v)
+	if err != nil {
+		return request.Quota{}, false
 	}
+	return request.Quota{
+		Used:  used,
+		Reset: now.Truncate(binanceGlobalInterval).Add(binanceGlobalInterval).Sub(now),
+	}, true
 }
 
 func bestPriceLimit(symbol string) request.EndpointLimit {
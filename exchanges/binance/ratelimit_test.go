@@ -1,7 +1,11 @@
 package binance
 
 import (
+	"context"
+	"net/http"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/yurulab/gocryptotrader/exchanges/request"
 )
@@ -39,7 +43,7 @@ func TestRateLimit_Limit(t *testing.T) {
 			}
 
 			l := SetRateLimit()
-			if err := l.Limit(tt.Limit); err != nil {
+			if err := l.Limit(context.Background(), tt.Limit); err != nil {
 				t.Fatalf("error applying rate limit: %v", err)
 			}
 		})
@@ -59,9 +63,58 @@ func TestRateLimit_LimitStatic(t *testing.T) {
 			t.Parallel()
 
 			l := SetRateLimit()
-			if err := l.Limit(tt); err != nil {
+			if err := l.Limit(context.Background(), tt); err != nil {
 				t.Fatalf("error applying rate limit: %v", err)
 			}
 		})
 	}
 }
+
+func TestRateLimit_LimitContext(t *testing.T) {
+	t.Parallel()
+
+	l := SetRateLimit()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	if err := l.Limit(ctx, limitOpenOrdersAll); err == nil {
+		t.Fatal("expected error when the rate limit delay exceeds the context deadline")
+	}
+
+	// Cancelled reservations should hand their tokens back
+	if err := l.Limit(context.Background(), limitOrder); err != nil {
+		t.Fatalf("error applying rate limit: %v", err)
+	}
+
+	// Exhaust the global weight as reported by the exchange
+	h := http.Header{}
+	h.Set(headerUsedWeight1m, strconv.Itoa(binanceGlobalRequestRate))
+	l.UpdateFromHeaders(h, time.Now())
+	if err := l.Limit(ctx, limitDefault); err == nil {
+		t.Fatal("expected error when the used weight is exhausted")
+	}
+}
+
+func TestParseUsedWeight(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2020, 8, 14, 1, 2, 15, 0, time.UTC)
+	if _, ok := parseUsedWeight(http.Header{}, now); ok {
+		t.Error("expected no quota without weight headers")
+	}
+
+	h := http.Header{}
+	h.Set("x-mbx-used-weight", "40")
+	q, ok := parseUsedWeight(h, now)
+	if !ok || q.Used != 40 {
+		t.Errorf("expected used weight 40, received %v %v", q.Used, ok)
+	}
+
+	h.Set(headerUsedWeight1m, "55")
+	q, ok = parseUsedWeight(h, now)
+	if !ok || q.Used != 55 {
+		t.Errorf("expected used weight 55, received %v %v", q.Used, ok)
+	}
+	if q.Reset != time.Second*45 {
+		t.Errorf("expected reset in 45s, received %v", q.Reset)
+	}
+}
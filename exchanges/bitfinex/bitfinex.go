@@ -86,9 +86,9 @@ type Bitfinex struct {
 }
 
 // GetPlatformStatus returns the Bifinex platform status
-func (b *Bitfinex) GetPlatformStatus() (int, error) {
+func (b *Bitfinex) GetPlatformStatus(ctx context.Context) (int, error) {
 	var response []int
-	err := b.SendHTTPRequest(b.API.Endpoints.URL+
+	err := b.SendHTTPRequest(ctx, b.API.Endpoints.URL+
 		bitfinexAPIVersion2+
 		bitfinexPlatformStatus,
 		&response,
@@ -108,7 +108,7 @@ func (b *Bitfinex) GetPlatformStatus() (int, error) {
 }
 
 // GetTickerBatch returns all supported ticker information
-func (b *Bitfinex) GetTickerBatch() (map[string]Ticker, error) {
+func (b *Bitfinex) GetTickerBatch(ctx context.Context) (map[string]Ticker, error) {
 	var response [][]interface{}
 
 	path := b.API.Endpoints.URL +
@@ -116,7 +116,7 @@ func (b *Bitfinex) GetTickerBatch() (map[string]Ticker, error) {
 		bitfinexTickerBatch +
 		"?symbols=ALL"
 
-	err := b.SendHTTPRequest(path, &response, tickerBatch)
+	err := b.SendHTTPRequest(ctx, path, &response, tickerBatch)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +159,7 @@ func (b *Bitfinex) GetTickerBatch() (map[string]Ticker, error) {
 }
 
 // GetTicker returns ticker information for one symbol
-func (b *Bitfinex) GetTicker(symbol string) (Ticker, error) {
+func (b *Bitfinex) GetTicker(ctx context.Context, symbol string) (Ticker, error) {
 	var response []interface{}
 
 	path := b.API.Endpoints.URL +
@@ -167,7 +167,7 @@ func (b *Bitfinex) GetTicker(symbol string) (Ticker, error) {
 		bitfinexTicker +
 		symbol
 
-	err := b.SendHTTPRequest(path, &response, tickerFunction)
+	err := b.SendHTTPRequest(ctx, path, &response, tickerFunction)
 	if err != nil {
 		return Ticker{}, err
 	}
@@ -210,7 +210,7 @@ func (b *Bitfinex) GetTicker(symbol string) (Ticker, error) {
 // timestampStart is a millisecond timestamp
 // timestampEnd is a millisecond timestamp
 // reOrderResp reorders the returned data.
-func (b *Bitfinex) GetTrades(currencyPair string, limit, timestampStart, timestampEnd int64, reOrderResp bool) ([]Trade, error) {
+func (b *Bitfinex) GetTrades(ctx context.Context, currencyPair string, limit, timestampStart, timestampEnd int64, reOrderResp bool) ([]Trade, error) {
 	v := url.Values{}
 	if limit > 0 {
 		v.Set("limit", strconv.FormatInt(limit, 10))
@@ -237,7 +237,7 @@ func (b *Bitfinex) GetTrades(currencyPair string, limit, timestampStart, timesta
 		v.Encode()
 
 	var resp [][]interface{}
-	err := b.SendHTTPRequest(path, &resp, tradeRateLimit)
+	err := b.SendHTTPRequest(ctx, path, &resp, tradeRateLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -281,7 +281,7 @@ func (b *Bitfinex) GetTrades(currencyPair string, limit, timestampStart, timesta
 // precision - P0,P1,P2,P3,R0
 // Values can contain limit amounts for both the asks and bids - Example
 // "len" = 100
-func (b *Bitfinex) GetOrderbook(symbol, precision string, limit int64) (Orderbook, error) {
+func (b *Bitfinex) GetOrderbook(ctx context.Context, symbol, precision string, limit int64) (Orderbook, error) {
 	var u = url.Values{}
 	if limit > 0 {
 		u.Set("len", strconv.FormatInt(limit, 10))
@@ -296,7 +296,7 @@ func (b *Bitfinex) GetOrderbook(symbol, precision string, limit int64) (Orderboo
 		u.Encode()
 
 	var response [][]interface{}
-	err := b.SendHTTPRequest(path, &response, orderbookFunction)
+	err := b.SendHTTPRequest(ctx, path, &response, orderbookFunction)
 	if err != nil {
 		return Orderbook{}, err
 	}
@@ -365,10 +365,10 @@ func (b *Bitfinex) GetOrderbook(symbol, precision string, limit int64) (Orderboo
 }
 
 // GetStats returns various statistics about the requested pair
-func (b *Bitfinex) GetStats(symbol string) ([]Stat, error) {
+func (b *Bitfinex) GetStats(ctx context.Context, symbol string) ([]Stat, error) {
 	var response []Stat
 	path := b.API.Endpoints.URL + bitfinexAPIVersion + bitfinexStats + symbol
-	return response, b.SendHTTPRequest(path, &response, statsV1)
+	return response, b.SendHTTPRequest(ctx, path, &response, statsV1)
 }
 
 // GetFundingBook the entire margin funding book for both bids and asks sides
@@ -376,11 +376,11 @@ func (b *Bitfinex) GetStats(symbol string) ([]Stat, error) {
 // symbol - example "USD"
 // WARNING: Orderbook now has this support, will be deprecated once a full
 // conversion to full V2 API update is done.
-func (b *Bitfinex) GetFundingBook(symbol string) (FundingBook, error) {
+func (b *Bitfinex) GetFundingBook(ctx context.Context, symbol string) (FundingBook, error) {
 	response := FundingBook{}
 	path := b.API.Endpoints.URL + bitfinexAPIVersion + bitfinexLendbook + symbol
 
-	if err := b.SendHTTPRequest(path, &response, fundingbook); err != nil {
+	if err := b.SendHTTPRequest(ctx, path, &response, fundingbook); err != nil {
 		return response, err
 	}
 
@@ -391,21 +391,21 @@ func (b *Bitfinex) GetFundingBook(symbol string) (FundingBook, error) {
 // currency: total amount provided and Flash Return Rate (in % by 365 days)
 // over time
 // Symbol - example "USD"
-func (b *Bitfinex) GetLends(symbol string, values url.Values) ([]Lends, error) {
+func (b *Bitfinex) GetLends(ctx context.Context, symbol string, values url.Values) ([]Lends, error) {
 	var response []Lends
 	path := common.EncodeURLValues(b.API.Endpoints.URL+
 		bitfinexAPIVersion+
 		bitfinexLends+
 		symbol,
 		values)
-	return response, b.SendHTTPRequest(path, &response, lends)
+	return response, b.SendHTTPRequest(ctx, path, &response, lends)
 }
 
 // GetCandles returns candle chart data
 // timeFrame values: '1m', '5m', '15m', '30m', '1h', '3h', '6h', '12h', '1D',
 // '7D', '14D', '1M'
 // section values: last or hist
-func (b *Bitfinex) GetCandles(symbol, timeFrame string, start, end int64, limit uint32, historic bool) ([]Candle, error) {
+func (b *Bitfinex) GetCandles(ctx context.Context, symbol, timeFrame string, start, end int64, limit uint32, historic bool) ([]Candle, error) {
 	var fundingPeriod string
 	if symbol[0] == 'f' {
 		fundingPeriod = ":p30"
@@ -440,7 +440,7 @@ func (b *Bitfinex) GetCandles(symbol, timeFrame string, start, end int64, limit
 		}
 
 		var response [][]interface{}
-		err := b.SendHTTPRequest(path, &response, candle)
+		err := b.SendHTTPRequest(ctx, path, &response, candle)
 		if err != nil {
 			return nil, err
 		}
@@ -463,7 +463,7 @@ func (b *Bitfinex) GetCandles(symbol, timeFrame string, start, end int64, limit
 	path += "/last"
 
 	var response []interface{}
-	err := b.SendHTTPRequest(path, &response, candle)
+	err := b.SendHTTPRequest(ctx, path, &response, candle)
 	if err != nil {
 		return nil, err
 	}
@@ -507,7 +507,7 @@ func (b *Bitfinex) GetLiquidationFeed() error {
 // profit
 // Allowed time frames are 3h, 1w and 1M
 // Allowed symbols are trading pairs (e.g. tBTCUSD, tETHUSD and tGLOBAL:USD)
-func (b *Bitfinex) GetLeaderboard(key, timeframe, symbol string, sort, limit int, start, end string) ([]LeaderboardEntry, error) {
+func (b *Bitfinex) GetLeaderboard(ctx context.Context, key, timeframe, symbol string, sort, limit int, start, end string) ([]LeaderboardEntry, error) {
 	validLeaderboardKey := func(input string) bool {
 		switch input {
 		case LeaderboardUnrealisedProfitPeriodDelta,
@@ -543,7 +543,7 @@ func (b *Bitfinex) GetLeaderboard(key, timeframe, symbol string, sort, limit int
 	}
 	path = common.EncodeURLValues(path, vals)
 	var resp []interface{}
-	if err := b.SendHTTPRequest(path, &resp, leaderBoardReqRate); err != nil {
+	if err := b.SendHTTPRequest(ctx, path, &resp, leaderBoardReqRate); err != nil {
 		return nil, err
 	}
 
@@ -581,9 +581,9 @@ func (b *Bitfinex) GetForeignExchangeRate() error {
 }
 
 // GetAccountFees returns information about your account trading fees
-func (b *Bitfinex) GetAccountFees() ([]AccountInfo, error) {
+func (b *Bitfinex) GetAccountFees(ctx context.Context) ([]AccountInfo, error) {
 	var responses []AccountInfo
-	return responses, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return responses, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexAccountInfo,
 		nil,
 		&responses,
@@ -591,9 +591,9 @@ func (b *Bitfinex) GetAccountFees() ([]AccountInfo, error) {
 }
 
 // GetWithdrawalFees - Gets all fee rates for withdrawals
-func (b *Bitfinex) GetWithdrawalFees() (AccountFees, error) {
+func (b *Bitfinex) GetWithdrawalFees(ctx context.Context) (AccountFees, error) {
 	response := AccountFees{}
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexAccountFees,
 		nil,
 		&response,
@@ -602,10 +602,10 @@ func (b *Bitfinex) GetWithdrawalFees() (AccountFees, error) {
 
 // GetAccountSummary returns a 30-day summary of your trading volume and return
 // on margin funding
-func (b *Bitfinex) GetAccountSummary() (AccountSummary, error) {
+func (b *Bitfinex) GetAccountSummary(ctx context.Context) (AccountSummary, error) {
 	response := AccountSummary{}
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexAccountSummary,
 		nil,
 		&response,
@@ -617,7 +617,7 @@ func (b *Bitfinex) GetAccountSummary() (AccountSummary, error) {
 // “tethers", "ethereumc", "zcash", "monero", "iota", "bcash"
 // WalletName - accepted: “trading”, “exchange”, “deposit”
 // renew - Default is 0. If set to 1, will return a new unused deposit address
-func (b *Bitfinex) NewDeposit(method, walletName string, renew int) (DepositResponse, error) {
+func (b *Bitfinex) NewDeposit(ctx context.Context, method, walletName string, renew int) (DepositResponse, error) {
 	if !common.StringDataCompare(AcceptedWalletNames, walletName) {
 		return DepositResponse{},
 			fmt.Errorf("walletname: [%s] is not allowed, supported: %s",
@@ -631,7 +631,7 @@ func (b *Bitfinex) NewDeposit(method, walletName string, renew int) (DepositResp
 	req["wallet_name"] = walletName
 	req["renew"] = renew
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexDeposit,
 		req,
 		&response,
@@ -640,9 +640,9 @@ func (b *Bitfinex) NewDeposit(method, walletName string, renew int) (DepositResp
 
 // GetKeyPermissions checks the permissions of the key being used to generate
 // this request.
-func (b *Bitfinex) GetKeyPermissions() (KeyPermissions, error) {
+func (b *Bitfinex) GetKeyPermissions(ctx context.Context) (KeyPermissions, error) {
 	response := KeyPermissions{}
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexKeyPermissions,
 		nil,
 		&response,
@@ -650,9 +650,9 @@ func (b *Bitfinex) GetKeyPermissions() (KeyPermissions, error) {
 }
 
 // GetMarginInfo shows your trading wallet information for margin trading
-func (b *Bitfinex) GetMarginInfo() ([]MarginInfo, error) {
+func (b *Bitfinex) GetMarginInfo(ctx context.Context) ([]MarginInfo, error) {
 	var response []MarginInfo
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexMarginInfo,
 		nil,
 		&response,
@@ -660,9 +660,9 @@ func (b *Bitfinex) GetMarginInfo() ([]MarginInfo, error) {
 }
 
 // GetAccountBalance returns full wallet balance information
-func (b *Bitfinex) GetAccountBalance() ([]Balance, error) {
+func (b *Bitfinex) GetAccountBalance(ctx context.Context) ([]Balance, error) {
 	var response []Balance
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexBalances,
 		nil,
 		&response,
@@ -674,7 +674,7 @@ func (b *Bitfinex) GetAccountBalance() ([]Balance, error) {
 // Currency -  example "BTC"
 // WalletFrom - example "exchange"
 // WalletTo -  example "deposit"
-func (b *Bitfinex) WalletTransfer(amount float64, currency, walletFrom, walletTo string) (WalletTransfer, error) {
+func (b *Bitfinex) WalletTransfer(ctx context.Context, amount float64, currency, walletFrom, walletTo string) (WalletTransfer, error) {
 	var response []WalletTransfer
 	req := make(map[string]interface{})
 	req["amount"] = strconv.FormatFloat(amount, 'f', -1, 64)
@@ -682,7 +682,7 @@ func (b *Bitfinex) WalletTransfer(amount float64, currency, walletFrom, walletTo
 	req["walletfrom"] = walletFrom
 	req["walletto"] = walletTo
 
-	err := b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	err := b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexTransfer,
 		req,
 		&response,
@@ -699,7 +699,7 @@ func (b *Bitfinex) WalletTransfer(amount float64, currency, walletFrom, walletTo
 
 // WithdrawCryptocurrency requests a withdrawal from one of your wallets.
 // For FIAT, use WithdrawFIAT
-func (b *Bitfinex) WithdrawCryptocurrency(wallet, address, paymentID string, amount float64, c currency.Code) (Withdrawal, error) {
+func (b *Bitfinex) WithdrawCryptocurrency(ctx context.Context, wallet, address, paymentID string, amount float64, c currency.Code) (Withdrawal, error) {
 	var response []Withdrawal
 	req := make(map[string]interface{})
 	req["withdraw_type"] = b.ConvertSymbolToWithdrawalType(c)
@@ -710,7 +710,7 @@ func (b *Bitfinex) WithdrawCryptocurrency(wallet, address, paymentID string, amo
 		req["payment_id"] = paymentID
 	}
 
-	err := b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	err := b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexWithdrawal,
 		req,
 		&response,
@@ -727,7 +727,7 @@ func (b *Bitfinex) WithdrawCryptocurrency(wallet, address, paymentID string, amo
 }
 
 // WithdrawFIAT Sends an authenticated request to withdraw FIAT currency
-func (b *Bitfinex) WithdrawFIAT(withdrawalType, walletType string, withdrawRequest *withdraw.Request) (Withdrawal, error) {
+func (b *Bitfinex) WithdrawFIAT(ctx context.Context, withdrawalType, walletType string, withdrawRequest *withdraw.Request) (Withdrawal, error) {
 	var response []Withdrawal
 	req := make(map[string]interface{})
 
@@ -755,7 +755,7 @@ func (b *Bitfinex) WithdrawFIAT(withdrawalType, walletType string, withdrawReque
 		req["intermediary_bank_swift"] = withdrawRequest.Fiat.IntermediarySwiftCode
 	}
 
-	err := b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	err := b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexWithdrawal,
 		req,
 		&response,
@@ -773,7 +773,7 @@ func (b *Bitfinex) WithdrawFIAT(withdrawalType, walletType string, withdrawReque
 
 // NewOrder submits a new order and returns a order information
 // Major Upgrade needed on this function to include all query params
-func (b *Bitfinex) NewOrder(currencyPair, orderType string, amount, price float64, buy, hidden bool) (Order, error) {
+func (b *Bitfinex) NewOrder(ctx context.Context, currencyPair, orderType string, amount, price float64, buy, hidden bool) (Order, error) {
 	if !common.StringDataCompare(AcceptedOrderType, orderType) {
 		return Order{}, fmt.Errorf("order type %s not accepted", orderType)
 	}
@@ -790,7 +790,7 @@ func (b *Bitfinex) NewOrder(currencyPair, orderType string, amount, price float6
 		req["side"] = order.Buy.Lower()
 	}
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrderNew,
 		req,
 		&response,
@@ -798,12 +798,12 @@ func (b *Bitfinex) NewOrder(currencyPair, orderType string, amount, price float6
 }
 
 // NewOrderMulti allows several new orders at once
-func (b *Bitfinex) NewOrderMulti(orders []PlaceOrder) (OrderMultiResponse, error) {
+func (b *Bitfinex) NewOrderMulti(ctx context.Context, orders []PlaceOrder) (OrderMultiResponse, error) {
 	response := OrderMultiResponse{}
 	req := make(map[string]interface{})
 	req["orders"] = orders
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrderNewMulti,
 		req,
 		&response,
@@ -811,12 +811,12 @@ func (b *Bitfinex) NewOrderMulti(orders []PlaceOrder) (OrderMultiResponse, error
 }
 
 // CancelExistingOrder cancels a single order by OrderID
-func (b *Bitfinex) CancelExistingOrder(orderID int64) (Order, error) {
+func (b *Bitfinex) CancelExistingOrder(ctx context.Context, orderID int64) (Order, error) {
 	response := Order{}
 	req := make(map[string]interface{})
 	req["order_id"] = orderID
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrderCancel,
 		req,
 		&response,
@@ -824,12 +824,12 @@ func (b *Bitfinex) CancelExistingOrder(orderID int64) (Order, error) {
 }
 
 // CancelMultipleOrders cancels multiple orders
-func (b *Bitfinex) CancelMultipleOrders(orderIDs []int64) (string, error) {
+func (b *Bitfinex) CancelMultipleOrders(ctx context.Context, orderIDs []int64) (string, error) {
 	response := GenericResponse{}
 	req := make(map[string]interface{})
 	req["order_ids"] = orderIDs
 
-	return response.Result, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response.Result, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrderCancelMulti,
 		req,
 		nil,
@@ -837,10 +837,10 @@ func (b *Bitfinex) CancelMultipleOrders(orderIDs []int64) (string, error) {
 }
 
 // CancelAllExistingOrders cancels all active and open orders
-func (b *Bitfinex) CancelAllExistingOrders() (string, error) {
+func (b *Bitfinex) CancelAllExistingOrders(ctx context.Context) (string, error) {
 	response := GenericResponse{}
 
-	return response.Result, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response.Result, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrderCancelAll,
 		nil,
 		nil,
@@ -848,7 +848,7 @@ func (b *Bitfinex) CancelAllExistingOrders() (string, error) {
 }
 
 // ReplaceOrder replaces an older order with a new order
-func (b *Bitfinex) ReplaceOrder(orderID int64, symbol string, amount, price float64, buy bool, orderType string, hidden bool) (Order, error) {
+func (b *Bitfinex) ReplaceOrder(ctx context.Context, orderID int64, symbol string, amount, price float64, buy bool, orderType string, hidden bool) (Order, error) {
 	response := Order{}
 	req := make(map[string]interface{})
 	req["order_id"] = orderID
@@ -865,7 +865,7 @@ func (b *Bitfinex) ReplaceOrder(orderID int64, symbol string, amount, price floa
 		req["side"] = order.Sell.Lower()
 	}
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrderCancelReplace,
 		req,
 		&response,
@@ -873,12 +873,12 @@ func (b *Bitfinex) ReplaceOrder(orderID int64, symbol string, amount, price floa
 }
 
 // GetOrderStatus returns order status information
-func (b *Bitfinex) GetOrderStatus(orderID int64) (Order, error) {
+func (b *Bitfinex) GetOrderStatus(ctx context.Context, orderID int64) (Order, error) {
 	orderStatus := Order{}
 	req := make(map[string]interface{})
 	req["order_id"] = orderID
 
-	return orderStatus, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return orderStatus, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrderStatus,
 		req,
 		&orderStatus,
@@ -886,12 +886,12 @@ func (b *Bitfinex) GetOrderStatus(orderID int64) (Order, error) {
 }
 
 // GetInactiveOrders returns order status information
-func (b *Bitfinex) GetInactiveOrders() ([]Order, error) {
+func (b *Bitfinex) GetInactiveOrders(ctx context.Context) ([]Order, error) {
 	var response []Order
 	req := make(map[string]interface{})
 	req["limit"] = "100"
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexInactiveOrders,
 		req,
 		&response,
@@ -899,9 +899,9 @@ func (b *Bitfinex) GetInactiveOrders() ([]Order, error) {
 }
 
 // GetOpenOrders returns all active orders and statuses
-func (b *Bitfinex) GetOpenOrders() ([]Order, error) {
+func (b *Bitfinex) GetOpenOrders(ctx context.Context) ([]Order, error) {
 	var response []Order
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrders,
 		nil,
 		&response,
@@ -909,10 +909,10 @@ func (b *Bitfinex) GetOpenOrders() ([]Order, error) {
 }
 
 // GetActivePositions returns an array of active positions
-func (b *Bitfinex) GetActivePositions() ([]Position, error) {
+func (b *Bitfinex) GetActivePositions(ctx context.Context) ([]Position, error) {
 	var response []Position
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexPositions,
 		nil,
 		&response,
@@ -920,12 +920,12 @@ func (b *Bitfinex) GetActivePositions() ([]Position, error) {
 }
 
 // ClaimPosition allows positions to be claimed
-func (b *Bitfinex) ClaimPosition(positionID int) (Position, error) {
+func (b *Bitfinex) ClaimPosition(ctx context.Context, positionID int) (Position, error) {
 	response := Position{}
 	req := make(map[string]interface{})
 	req["position_id"] = positionID
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexClaimPosition,
 		nil,
 		nil,
@@ -933,7 +933,7 @@ func (b *Bitfinex) ClaimPosition(positionID int) (Position, error) {
 }
 
 // GetBalanceHistory returns balance history for the account
-func (b *Bitfinex) GetBalanceHistory(symbol string, timeSince, timeUntil time.Time, limit int, wallet string) ([]BalanceHistory, error) {
+func (b *Bitfinex) GetBalanceHistory(ctx context.Context, symbol string, timeSince, timeUntil time.Time, limit int, wallet string) ([]BalanceHistory, error) {
 	var response []BalanceHistory
 	req := make(map[string]interface{})
 	req["currency"] = symbol
@@ -951,7 +951,7 @@ func (b *Bitfinex) GetBalanceHistory(symbol string, timeSince, timeUntil time.Ti
 		req["wallet"] = wallet
 	}
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexHistory,
 		req,
 		&response,
@@ -959,7 +959,7 @@ func (b *Bitfinex) GetBalanceHistory(symbol string, timeSince, timeUntil time.Ti
 }
 
 // GetMovementHistory returns an array of past deposits and withdrawals
-func (b *Bitfinex) GetMovementHistory(symbol, method string, timeSince, timeUntil time.Time, limit int) ([]MovementHistory, error) {
+func (b *Bitfinex) GetMovementHistory(ctx context.Context, symbol, method string, timeSince, timeUntil time.Time, limit int) ([]MovementHistory, error) {
 	var response []MovementHistory
 	req := make(map[string]interface{})
 	req["currency"] = symbol
@@ -977,7 +977,7 @@ func (b *Bitfinex) GetMovementHistory(symbol, method string, timeSince, timeUnti
 		req["limit"] = limit
 	}
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexHistoryMovements,
 		req,
 		&response,
@@ -985,7 +985,7 @@ func (b *Bitfinex) GetMovementHistory(symbol, method string, timeSince, timeUnti
 }
 
 // GetTradeHistory returns past executed trades
-func (b *Bitfinex) GetTradeHistory(currencyPair string, timestamp, until time.Time, limit, reverse int) ([]TradeHistory, error) {
+func (b *Bitfinex) GetTradeHistory(ctx context.Context, currencyPair string, timestamp, until time.Time, limit, reverse int) ([]TradeHistory, error) {
 	var response []TradeHistory
 	req := make(map[string]interface{})
 	req["currency"] = currencyPair
@@ -1001,7 +1001,7 @@ func (b *Bitfinex) GetTradeHistory(currencyPair string, timestamp, until time.Ti
 		req["reverse"] = reverse
 	}
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexTradeHistory,
 		req,
 		&response,
@@ -1009,7 +1009,7 @@ func (b *Bitfinex) GetTradeHistory(currencyPair string, timestamp, until time.Ti
 }
 
 // NewOffer submits a new offer
-func (b *Bitfinex) NewOffer(symbol string, amount, rate float64, period int64, direction string) (Offer, error) {
+func (b *Bitfinex) NewOffer(ctx context.Context, symbol string, amount, rate float64, period int64, direction string) (Offer, error) {
 	response := Offer{}
 	req := make(map[string]interface{})
 	req["currency"] = symbol
@@ -1018,7 +1018,7 @@ func (b *Bitfinex) NewOffer(symbol string, amount, rate float64, period int64, d
 	req["period"] = period
 	req["direction"] = direction
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOfferNew,
 		req,
 		&response,
@@ -1026,12 +1026,12 @@ func (b *Bitfinex) NewOffer(symbol string, amount, rate float64, period int64, d
 }
 
 // CancelOffer cancels offer by offerID
-func (b *Bitfinex) CancelOffer(offerID int64) (Offer, error) {
+func (b *Bitfinex) CancelOffer(ctx context.Context, offerID int64) (Offer, error) {
 	response := Offer{}
 	req := make(map[string]interface{})
 	req["offer_id"] = offerID
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOfferCancel,
 		req,
 		&response,
@@ -1040,12 +1040,12 @@ func (b *Bitfinex) CancelOffer(offerID int64) (Offer, error) {
 
 // GetOfferStatus checks offer status whether it has been cancelled, execute or
 // is still active
-func (b *Bitfinex) GetOfferStatus(offerID int64) (Offer, error) {
+func (b *Bitfinex) GetOfferStatus(ctx context.Context, offerID int64) (Offer, error) {
 	response := Offer{}
 	req := make(map[string]interface{})
 	req["offer_id"] = offerID
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrderStatus,
 		req,
 		&response,
@@ -1053,10 +1053,10 @@ func (b *Bitfinex) GetOfferStatus(offerID int64) (Offer, error) {
 }
 
 // GetActiveCredits returns all available credits
-func (b *Bitfinex) GetActiveCredits() ([]Offer, error) {
+func (b *Bitfinex) GetActiveCredits(ctx context.Context) ([]Offer, error) {
 	var response []Offer
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexActiveCredits,
 		nil,
 		&response,
@@ -1064,10 +1064,10 @@ func (b *Bitfinex) GetActiveCredits() ([]Offer, error) {
 }
 
 // GetActiveOffers returns all current active offers
-func (b *Bitfinex) GetActiveOffers() ([]Offer, error) {
+func (b *Bitfinex) GetActiveOffers(ctx context.Context) ([]Offer, error) {
 	var response []Offer
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOffers,
 		nil,
 		&response,
@@ -1075,10 +1075,10 @@ func (b *Bitfinex) GetActiveOffers() ([]Offer, error) {
 }
 
 // GetActiveMarginFunding returns an array of active margin funds
-func (b *Bitfinex) GetActiveMarginFunding() ([]MarginFunds, error) {
+func (b *Bitfinex) GetActiveMarginFunding(ctx context.Context) ([]MarginFunds, error) {
 	var response []MarginFunds
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexMarginActiveFunds,
 		nil,
 		&response,
@@ -1087,10 +1087,10 @@ func (b *Bitfinex) GetActiveMarginFunding() ([]MarginFunds, error) {
 
 // GetUnusedMarginFunds returns an array of funding borrowed but not currently
 // used
-func (b *Bitfinex) GetUnusedMarginFunds() ([]MarginFunds, error) {
+func (b *Bitfinex) GetUnusedMarginFunds(ctx context.Context) ([]MarginFunds, error) {
 	var response []MarginFunds
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexMarginUnusedFunds,
 		nil,
 		&response,
@@ -1099,10 +1099,10 @@ func (b *Bitfinex) GetUnusedMarginFunds() ([]MarginFunds, error) {
 
 // GetMarginTotalTakenFunds returns an array of active funding used in a
 // position
-func (b *Bitfinex) GetMarginTotalTakenFunds() ([]MarginTotalTakenFunds, error) {
+func (b *Bitfinex) GetMarginTotalTakenFunds(ctx context.Context) ([]MarginTotalTakenFunds, error) {
 	var response []MarginTotalTakenFunds
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexMarginTotalFunds,
 		nil,
 		&response,
@@ -1110,12 +1110,12 @@ func (b *Bitfinex) GetMarginTotalTakenFunds() ([]MarginTotalTakenFunds, error) {
 }
 
 // CloseMarginFunding closes an unused or used taken fund
-func (b *Bitfinex) CloseMarginFunding(swapID int64) (Offer, error) {
+func (b *Bitfinex) CloseMarginFunding(ctx context.Context, swapID int64) (Offer, error) {
 	response := Offer{}
 	req := make(map[string]interface{})
 	req["swap_id"] = swapID
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexMarginClose,
 		req,
 		&response,
@@ -1123,8 +1123,8 @@ func (b *Bitfinex) CloseMarginFunding(swapID int64) (Offer, error) {
 }
 
 // SendHTTPRequest sends an unauthenticated request
-func (b *Bitfinex) SendHTTPRequest(path string, result interface{}, e request.EndpointLimit) error {
-	return b.SendPayload(context.Background(), &request.Item{
+func (b *Bitfinex) SendHTTPRequest(ctx context.Context, path string, result interface{}, e request.EndpointLimit) error {
+	return b.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -1136,7 +1136,7 @@ func (b *Bitfinex) SendHTTPRequest(path string, result interface{}, e request.En
 
 // SendAuthenticatedHTTPRequest sends an autheticated http request and json
 // unmarshals result to a supplied variable
-func (b *Bitfinex) SendAuthenticatedHTTPRequest(method, path string, params map[string]interface{}, result interface{}, endpoint request.EndpointLimit) error {
+func (b *Bitfinex) SendAuthenticatedHTTPRequest(ctx context.Context, method, path string, params map[string]interface{}, result interface{}, endpoint request.EndpointLimit) error {
 	if !b.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet,
 			b.Name)
@@ -1169,7 +1169,7 @@ func (b *Bitfinex) SendAuthenticatedHTTPRequest(method, path string, params map[
 	headers["X-BFX-PAYLOAD"] = PayloadBase64
 	headers["X-BFX-SIGNATURE"] = crypto.HexEncodeToString(hmac)
 
-	return b.SendPayload(context.Background(), &request.Item{
+	return b.SendPayload(ctx, &request.Item{
 		Method:        method,
 		Path:          b.API.Endpoints.URL + bitfinexAPIVersion + path,
 		Headers:       headers,
@@ -1183,12 +1183,12 @@ func (b *Bitfinex) SendAuthenticatedHTTPRequest(method, path string, params map[
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *Bitfinex) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *Bitfinex) GetFee(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		accountInfos, err := b.GetAccountFees()
+		accountInfos, err := b.GetAccountFees(ctx)
 		if err != nil {
 			return 0, err
 		}
@@ -1204,7 +1204,7 @@ func (b *Bitfinex) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
 		//TODO: fee is charged when < $1000USD is transferred, need to infer value in some way
 		fee = 0
 	case exchange.CryptocurrencyWithdrawalFee:
-		acc, err := b.GetWithdrawalFees()
+		acc, err := b.GetWithdrawalFees(ctx)
 		if err != nil {
 			return 0, err
 		}
@@ -1321,8 +1321,8 @@ func (b *Bitfinex) ConvertSymbolToWithdrawalType(c currency.Code) string {
 }
 
 // ConvertSymbolToDepositMethod returns a converted currency deposit method
-func (b *Bitfinex) ConvertSymbolToDepositMethod(c currency.Code) (string, error) {
-	if err := b.PopulateAcceptableMethods(); err != nil {
+func (b *Bitfinex) ConvertSymbolToDepositMethod(ctx context.Context, c currency.Code) (string, error) {
+	if err := b.PopulateAcceptableMethods(ctx); err != nil {
 		return "", err
 	}
 	method, ok := AcceptableMethods[c.String()]
@@ -1336,10 +1336,10 @@ func (b *Bitfinex) ConvertSymbolToDepositMethod(c currency.Code) (string, error)
 
 // PopulateAcceptableMethods retrieves all accepted currency strings and
 // populates a map to check
-func (b *Bitfinex) PopulateAcceptableMethods() error {
+func (b *Bitfinex) PopulateAcceptableMethods(ctx context.Context) error {
 	if len(AcceptableMethods) == 0 {
 		var response [][][2]string
-		err := b.SendHTTPRequest(b.API.Endpoints.URL+
+		err := b.SendHTTPRequest(ctx, b.API.Endpoints.URL+
 			bitfinexAPIVersion2+
 			bitfinexDepositMethod,
 			&response,
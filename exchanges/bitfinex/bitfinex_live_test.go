@@ -0,0 +1,48 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package bitfinex
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	b.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Bitfinex load config error", err)
+	}
+	bfxConfig, err := cfg.GetExchangeConfig("Bitfinex")
+	if err != nil {
+		log.Fatal("Bitfinex Setup() init error")
+	}
+	b.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = b.Setup(bfxConfig)
+	if err != nil {
+		log.Fatal("Bitfinex setup error", err)
+	}
+	b.API.Credentials.Key = apiKey
+	b.API.Credentials.Secret = apiSecret
+	if !b.Enabled || b.API.AuthenticatedSupport ||
+		b.Verbose || b.Websocket.IsEnabled() || len(b.BaseCurrencies) < 1 {
+		log.Fatal("Bitfinex Setup values not set correctly")
+	}
+
+	if areTestAPIKeysSet() {
+		b.API.AuthenticatedSupport = true
+		b.API.AuthenticatedWebsocketSupport = true
+	}
+	b.WebsocketSubdChannels = make(map[int]WebsocketChanInfo)
+	log.Printf(sharedtestvalues.LiveTesting, b.Name, b.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
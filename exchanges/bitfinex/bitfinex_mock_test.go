@@ -0,0 +1,62 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package bitfinex
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/bitfinex/bitfinex.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	b.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Bitfinex load config error", err)
+	}
+	bfxConfig, err := cfg.GetExchangeConfig("Bitfinex")
+	if err != nil {
+		log.Fatal("Bitfinex Setup() init error")
+	}
+	b.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = b.Setup(bfxConfig)
+	if err != nil {
+		log.Fatal("Bitfinex setup error", err)
+	}
+	b.API.Credentials.Key = apiKey
+	b.API.Credentials.Secret = apiSecret
+	if !b.Enabled || b.API.AuthenticatedSupport ||
+		b.Verbose || b.Websocket.IsEnabled() || len(b.BaseCurrencies) < 1 {
+		log.Fatal("Bitfinex Setup values not set correctly")
+	}
+
+	if areTestAPIKeysSet() {
+		b.API.AuthenticatedSupport = true
+		b.API.AuthenticatedWebsocketSupport = true
+	}
+	b.WebsocketSubdChannels = make(map[int]WebsocketChanInfo)
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+
+	b.HTTPClient = newClient
+	b.API.Endpoints.URL = serverDetails
+	log.Printf(sharedtestvalues.MockTesting, b.Name, b.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
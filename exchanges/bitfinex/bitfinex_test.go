@@ -1,14 +1,13 @@
 package bitfinex
 
 import (
+	"context"
 	"log"
 	"net/http"
-	"os"
 	"testing"
 	"time"
 
 	"github.com/gorilla/websocket"
-	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/core"
 	"github.com/yurulab/gocryptotrader/currency"
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
@@ -30,37 +29,6 @@ const (
 var b Bitfinex
 var wsAuthExecuted bool
 
-func TestMain(m *testing.M) {
-	b.SetDefaults()
-	cfg := config.GetConfig()
-	err := cfg.LoadConfig("../../testdata/configtest.json", true)
-	if err != nil {
-		log.Fatal("Bitfinex load config error", err)
-	}
-	bfxConfig, err := cfg.GetExchangeConfig("Bitfinex")
-	if err != nil {
-		log.Fatal("Bitfinex Setup() init error")
-	}
-	b.Websocket = sharedtestvalues.NewTestWebsocket()
-	err = b.Setup(bfxConfig)
-	if err != nil {
-		log.Fatal("Bitfinex setup error", err)
-	}
-	b.API.Credentials.Key = apiKey
-	b.API.Credentials.Secret = apiSecret
-	if !b.Enabled || b.API.AuthenticatedSupport ||
-		b.Verbose || b.Websocket.IsEnabled() || len(b.BaseCurrencies) < 1 {
-		log.Fatal("Bitfinex Setup values not set correctly")
-	}
-
-	if areTestAPIKeysSet() {
-		b.API.AuthenticatedSupport = true
-		b.API.AuthenticatedWebsocketSupport = true
-	}
-	b.WebsocketSubdChannels = make(map[int]WebsocketChanInfo)
-	os.Exit(m.Run())
-}
-
 func TestAppendOptionalDelimiter(t *testing.T) {
 	t.Parallel()
 	curr1, err := currency.NewPairFromString("BTCUSD")
@@ -86,7 +54,7 @@ func TestAppendOptionalDelimiter(t *testing.T) {
 
 func TestGetPlatformStatus(t *testing.T) {
 	t.Parallel()
-	result, err := b.GetPlatformStatus()
+	result, err := b.GetPlatformStatus(context.Background())
 	if err != nil {
 		t.Errorf("TestGetPlatformStatus error: %s", err)
 	}
@@ -98,7 +66,7 @@ func TestGetPlatformStatus(t *testing.T) {
 
 func TestGetTickerBatch(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTickerBatch()
+	_, err := b.GetTickerBatch(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -106,12 +74,12 @@ func TestGetTickerBatch(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTicker("tBTCUSD")
+	_, err := b.GetTicker(context.Background(), "tBTCUSD")
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = b.GetTicker("fUSD")
+	_, err = b.GetTicker(context.Background(), "fUSD")
 	if err != nil {
 		t.Error(err)
 	}
@@ -120,7 +88,7 @@ func TestGetTicker(t *testing.T) {
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetTrades("tBTCUSD", 5, 0, 0, false)
+	_, err := b.GetTrades(context.Background(), "tBTCUSD", 5, 0, 0, false)
 	if err != nil {
 		t.Error(err)
 	}
@@ -128,22 +96,22 @@ func TestGetTrades(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrderbook("tBTCUSD", "R0", 1)
+	_, err := b.GetOrderbook(context.Background(), "tBTCUSD", "R0", 1)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = b.GetOrderbook("fUSD", "R0", 1)
+	_, err = b.GetOrderbook(context.Background(), "fUSD", "R0", 1)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = b.GetOrderbook("tBTCUSD", "P0", 1)
+	_, err = b.GetOrderbook(context.Background(), "tBTCUSD", "P0", 1)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = b.GetOrderbook("fUSD", "P0", 1)
+	_, err = b.GetOrderbook(context.Background(), "fUSD", "P0", 1)
 	if err != nil {
 		t.Error(err)
 	}
@@ -151,7 +119,7 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetStats(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetStats("btcusd")
+	_, err := b.GetStats(context.Background(), "btcusd")
 	if err != nil {
 		t.Error(err)
 	}
@@ -159,7 +127,7 @@ func TestGetStats(t *testing.T) {
 
 func TestGetFundingBook(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetFundingBook("usd")
+	_, err := b.GetFundingBook(context.Background(), "usd")
 	if err != nil {
 		t.Error(err)
 	}
@@ -167,7 +135,7 @@ func TestGetFundingBook(t *testing.T) {
 
 func TestGetLends(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetLends("usd", nil)
+	_, err := b.GetLends(context.Background(), "usd", nil)
 	if err != nil {
 		t.Error(err)
 	}
@@ -175,7 +143,7 @@ func TestGetLends(t *testing.T) {
 
 func TestGetCandles(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetCandles("fUSD", "1m", 0, 0, 10, true)
+	_, err := b.GetCandles(context.Background(), "fUSD", "1m", 0, 0, 10, true)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -184,12 +152,12 @@ func TestGetCandles(t *testing.T) {
 func TestGetLeaderboard(t *testing.T) {
 	t.Parallel()
 	// Test invalid key
-	_, err := b.GetLeaderboard("", "", "", 0, 0, "", "")
+	_, err := b.GetLeaderboard(context.Background(), "", "", "", 0, 0, "", "")
 	if err == nil {
 		t.Error("an error should have been thrown for an invalid key")
 	}
 	// Test default
-	_, err = b.GetLeaderboard(LeaderboardUnrealisedProfitInception,
+	_, err = b.GetLeaderboard(context.Background(), LeaderboardUnrealisedProfitInception,
 		"1M",
 		"tGLOBAL:USD",
 		0,
@@ -201,7 +169,7 @@ func TestGetLeaderboard(t *testing.T) {
 	}
 	// Test params
 	var result []LeaderboardEntry
-	result, err = b.GetLeaderboard(LeaderboardUnrealisedProfitInception,
+	result, err = b.GetLeaderboard(context.Background(), LeaderboardUnrealisedProfitInception,
 		"1M",
 		"tGLOBAL:USD",
 		-1,
@@ -222,7 +190,7 @@ func TestGetAccountFees(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.UpdateAccountInfo()
+	_, err := b.UpdateAccountInfo(context.Background())
 	if err != nil {
 		t.Error("GetAccountInfo error", err)
 	}
@@ -234,7 +202,7 @@ func TestGetWithdrawalFee(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetWithdrawalFees()
+	_, err := b.GetWithdrawalFees(context.Background())
 	if err != nil {
 		t.Error("GetAccountInfo error", err)
 	}
@@ -246,7 +214,7 @@ func TestGetAccountSummary(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetAccountSummary()
+	_, err := b.GetAccountSummary(context.Background())
 	if err == nil {
 		t.Error("GetAccountSummary() Expected error")
 	}
@@ -257,17 +225,17 @@ func TestNewDeposit(t *testing.T) {
 		t.SkipNow()
 	}
 	t.Parallel()
-	_, err := b.NewDeposit("blabla", "testwallet", 0)
+	_, err := b.NewDeposit(context.Background(), "blabla", "testwallet", 0)
 	if err == nil {
 		t.Error("NewDeposit() Expected error")
 	}
 
-	_, err = b.NewDeposit("bitcoin", "testwallet", 0)
+	_, err = b.NewDeposit(context.Background(), "bitcoin", "testwallet", 0)
 	if err == nil {
 		t.Error("NewDeposit() Expected error")
 	}
 
-	_, err = b.NewDeposit("bitcoin", "exchange", 0)
+	_, err = b.NewDeposit(context.Background(), "bitcoin", "exchange", 0)
 	if err != nil {
 		t.Error(err)
 	}
@@ -279,7 +247,7 @@ func TestGetKeyPermissions(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetKeyPermissions()
+	_, err := b.GetKeyPermissions(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -291,7 +259,7 @@ func TestGetMarginInfo(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetMarginInfo()
+	_, err := b.GetMarginInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -303,7 +271,7 @@ func TestGetAccountBalance(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetAccountBalance()
+	_, err := b.GetAccountBalance(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -315,7 +283,7 @@ func TestGetAccountInfo(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.FetchAccountInfo()
+	_, err := b.FetchAccountInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -327,7 +295,7 @@ func TestWalletTransfer(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.WalletTransfer(0.01, "btc", "bla", "bla")
+	_, err := b.WalletTransfer(context.Background(), 0.01, "btc", "bla", "bla")
 	if err == nil {
 		t.Error("error cannot be nil")
 	}
@@ -339,7 +307,7 @@ func TestNewOrder(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.NewOrder("BTCUSD",
+	_, err := b.NewOrder(context.Background(), "BTCUSD",
 		order.Limit.Lower(),
 		-1,
 		2,
@@ -356,7 +324,7 @@ func TestUpdateTicker(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	_, err = b.UpdateTicker(pair, asset.Spot)
+	_, err = b.UpdateTicker(context.Background(), pair, asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
@@ -379,7 +347,7 @@ func TestNewOrderMulti(t *testing.T) {
 		},
 	}
 
-	_, err := b.NewOrderMulti(newOrder)
+	_, err := b.NewOrderMulti(context.Background(), newOrder)
 	if err == nil {
 		t.Error("NewOrderMulti() Expected error")
 	}
@@ -391,7 +359,7 @@ func TestCancelOrder(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.CancelExistingOrder(1337)
+	_, err := b.CancelExistingOrder(context.Background(), 1337)
 	if err == nil {
 		t.Error("CancelExistingOrder() Expected error")
 	}
@@ -403,7 +371,7 @@ func TestCancelMultipleOrders(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.CancelMultipleOrders([]int64{1337, 1336})
+	_, err := b.CancelMultipleOrders(context.Background(), []int64{1337, 1336})
 	if err == nil {
 		t.Error("CancelMultipleOrders() Expected error")
 	}
@@ -415,7 +383,7 @@ func TestCancelAllOrders(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.CancelAllExistingOrders()
+	_, err := b.CancelAllExistingOrders(context.Background())
 	if err == nil {
 		t.Error("CancelAllExistingOrders() Expected error")
 	}
@@ -427,7 +395,7 @@ func TestReplaceOrder(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.ReplaceOrder(1337, "BTCUSD",
+	_, err := b.ReplaceOrder(context.Background(), 1337, "BTCUSD",
 		1, 1, true, order.Limit.Lower(), false)
 	if err == nil {
 		t.Error("ReplaceOrder() Expected error")
@@ -440,7 +408,7 @@ func TestGetOrderStatus(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetOrderStatus(1337)
+	_, err := b.GetOrderStatus(context.Background(), 1337)
 	if err == nil {
 		t.Error("GetOrderStatus() Expected error")
 	}
@@ -452,7 +420,7 @@ func TestGetOpenOrders(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetOpenOrders()
+	_, err := b.GetOpenOrders(context.Background())
 	if err == nil {
 		t.Error("GetOpenOrders() Expectederror")
 	}
@@ -464,7 +432,7 @@ func TestGetActivePositions(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetActivePositions()
+	_, err := b.GetActivePositions(context.Background())
 	if err == nil {
 		t.Error("GetActivePositions() Expected error")
 	}
@@ -476,7 +444,7 @@ func TestClaimPosition(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.ClaimPosition(1337)
+	_, err := b.ClaimPosition(context.Background(), 1337)
 	if err == nil {
 		t.Error("ClaimPosition() Expected error")
 	}
@@ -488,7 +456,7 @@ func TestGetBalanceHistory(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetBalanceHistory("USD", time.Time{}, time.Time{}, 1, "deposit")
+	_, err := b.GetBalanceHistory(context.Background(), "USD", time.Time{}, time.Time{}, 1, "deposit")
 	if err == nil {
 		t.Error("GetBalanceHistory() Expected error")
 	}
@@ -500,7 +468,7 @@ func TestGetMovementHistory(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetMovementHistory("USD", "bitcoin", time.Time{}, time.Time{}, 1)
+	_, err := b.GetMovementHistory(context.Background(), "USD", "bitcoin", time.Time{}, time.Time{}, 1)
 	if err == nil {
 		t.Error("GetMovementHistory() Expected error")
 	}
@@ -512,7 +480,7 @@ func TestGetTradeHistory(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetTradeHistory("BTCUSD", time.Time{}, time.Time{}, 1, 0)
+	_, err := b.GetTradeHistory(context.Background(), "BTCUSD", time.Time{}, time.Time{}, 1, 0)
 	if err == nil {
 		t.Error("GetTradeHistory() Expected error")
 	}
@@ -524,7 +492,7 @@ func TestNewOffer(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.NewOffer("BTC", 1, 1, 1, "loan")
+	_, err := b.NewOffer(context.Background(), "BTC", 1, 1, 1, "loan")
 	if err == nil {
 		t.Error("NewOffer() Expected error")
 	}
@@ -536,7 +504,7 @@ func TestCancelOffer(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.CancelOffer(1337)
+	_, err := b.CancelOffer(context.Background(), 1337)
 	if err == nil {
 		t.Error("CancelOffer() Expected error")
 	}
@@ -548,7 +516,7 @@ func TestGetOfferStatus(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetOfferStatus(1337)
+	_, err := b.GetOfferStatus(context.Background(), 1337)
 	if err == nil {
 		t.Error("NewOffer() Expected error")
 	}
@@ -560,7 +528,7 @@ func TestGetActiveCredits(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetActiveCredits()
+	_, err := b.GetActiveCredits(context.Background())
 	if err == nil {
 		t.Error("GetActiveCredits() Expected error")
 	}
@@ -572,7 +540,7 @@ func TestGetActiveOffers(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetActiveOffers()
+	_, err := b.GetActiveOffers(context.Background())
 	if err == nil {
 		t.Error("GetActiveOffers() Expected error")
 	}
@@ -584,7 +552,7 @@ func TestGetActiveMarginFunding(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetActiveMarginFunding()
+	_, err := b.GetActiveMarginFunding(context.Background())
 	if err == nil {
 		t.Error("GetActiveMarginFunding() Expected error")
 	}
@@ -596,7 +564,7 @@ func TestGetUnusedMarginFunds(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetUnusedMarginFunds()
+	_, err := b.GetUnusedMarginFunds(context.Background())
 	if err == nil {
 		t.Error("GetUnusedMarginFunds() Expected error")
 	}
@@ -608,7 +576,7 @@ func TestGetMarginTotalTakenFunds(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetMarginTotalTakenFunds()
+	_, err := b.GetMarginTotalTakenFunds(context.Background())
 	if err == nil {
 		t.Error("GetMarginTotalTakenFunds() Expected error")
 	}
@@ -620,7 +588,7 @@ func TestCloseMarginFunding(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.CloseMarginFunding(1337)
+	_, err := b.CloseMarginFunding(context.Background(), 1337)
 	if err == nil {
 		t.Error("CloseMarginFunding() Expected error")
 	}
@@ -638,7 +606,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	b.GetFeeByType(feeBuilder)
+	b.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -656,7 +624,7 @@ func TestGetFee(t *testing.T) {
 
 	if areTestAPIKeysSet() {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.002) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.002) || err != nil {
 			t.Error(err)
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.002), resp)
 		}
@@ -665,7 +633,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(2000) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(2000) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(2000), resp)
 			t.Error(err)
 		}
@@ -673,7 +641,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.001), resp)
 			t.Error(err)
 		}
@@ -681,7 +649,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -689,7 +657,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyWithdrawalFee Basic
 		feeBuilder = setFeeBuilder()
 		feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.0004) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.0004) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.0004), resp)
 			t.Error(err)
 		}
@@ -698,7 +666,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -707,7 +675,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.FiatCurrency = currency.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.001), resp)
 		t.Error(err)
 	}
@@ -716,7 +684,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.001), resp)
 		t.Error(err)
 	}
@@ -737,7 +705,7 @@ func TestGetActiveOrders(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := b.GetActiveOrders(&getOrdersRequest)
+	_, err := b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -751,7 +719,7 @@ func TestGetOrderHistory(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := b.GetOrderHistory(&getOrdersRequest)
+	_, err := b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -783,7 +751,7 @@ func TestSubmitOrder(t *testing.T) {
 		Amount:    20,
 		ClientID:  "meowOrder",
 	}
-	response, err := b.SubmitOrder(orderSubmission)
+	response, err := b.SubmitOrder(context.Background(), orderSubmission)
 
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not place order: %v", err)
@@ -810,7 +778,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -833,7 +801,7 @@ func TestCancelAllExchangeOrdera(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	resp, err := b.CancelAllOrders(orderCancellation)
+	resp, err := b.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -852,7 +820,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := b.ModifyOrder(&order.Modify{})
+	_, err := b.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -873,7 +841,7 @@ func TestWithdraw(t *testing.T) {
 		},
 	}
 
-	_, err := b.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := b.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -898,7 +866,7 @@ func TestWithdrawFiat(t *testing.T) {
 		},
 	}
 
-	_, err := b.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -931,7 +899,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 		},
 	}
 
-	_, err := b.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -943,12 +911,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
 	if areTestAPIKeysSet() {
-		_, err := b.GetDepositAddress(currency.BTC, "deposit")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "deposit")
 		if err != nil {
 			t.Error("GetDepositAddress() error", err)
 		}
 	} else {
-		_, err := b.GetDepositAddress(currency.BTC, "deposit")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "deposit")
 		if err == nil {
 			t.Error("GetDepositAddress() error cannot be nil")
 		}
@@ -1114,7 +1082,7 @@ func TestWsCancelOffer(t *testing.T) {
 }
 
 func TestConvertSymbolToDepositMethod(t *testing.T) {
-	s, err := b.ConvertSymbolToDepositMethod(currency.BTC)
+	s, err := b.ConvertSymbolToDepositMethod(context.Background(), currency.BTC)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -1122,14 +1090,14 @@ func TestConvertSymbolToDepositMethod(t *testing.T) {
 		t.Errorf("expected bitcoin but received %s", s)
 	}
 
-	_, err = b.ConvertSymbolToDepositMethod(currency.NewCode("CATS!"))
+	_, err = b.ConvertSymbolToDepositMethod(context.Background(), currency.NewCode("CATS!"))
 	if err == nil {
 		log.Fatal("error cannot be nil")
 	}
 }
 
 func TestUpdateTradablePairs(t *testing.T) {
-	err := b.UpdateTradablePairs(false)
+	err := b.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		t.Error(err)
 	}
@@ -1241,12 +1209,12 @@ func TestGetHistoricCandles(t *testing.T) {
 		t.Fatal(err)
 	}
 	startTime := time.Now().Add(-time.Hour * 24)
-	_, err = b.GetHistoricCandles(currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin)
+	_, err = b.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = b.GetHistoricCandles(currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin*1337)
+	_, err = b.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin*1337)
 	if err == nil {
 		t.Fatal(err)
 	}
@@ -1258,12 +1226,12 @@ func TestGetHistoricCandlesExtended(t *testing.T) {
 		t.Fatal(err)
 	}
 	startTime := time.Now().Add(-time.Hour * 24)
-	_, err = b.GetHistoricCandlesExtended(currencyPair, asset.Spot, startTime, time.Now(), kline.OneHour)
+	_, err = b.GetHistoricCandlesExtended(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.OneHour)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = b.GetHistoricCandlesExtended(currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin*1337)
+	_, err = b.GetHistoricCandlesExtended(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin*1337)
 	if err == nil {
 		t.Fatal(err)
 	}
@@ -369,6 +369,7 @@ func (b *Bitfinex) wsHandleData(respRaw []byte) error {
 				}
 
 				b.Websocket.DataHandler <- stream.TradeData{
+					TID:          strconv.FormatInt(trades[i].ID, 10),
 					CurrencyPair: pair,
 					Timestamp:    time.Unix(0, trades[i].Timestamp*int64(time.Millisecond)),
 					Price:        trades[i].Price,
@@ -1061,7 +1062,7 @@ func (b *Bitfinex) WsAddSubscriptionChannel(chanID int, channel, pair string) {
 func (b *Bitfinex) WsNewOrder(data *WsNewOrderRequest) (string, error) {
 	data.CustomID = b.Websocket.AuthConn.GenerateMessageID(false)
 	request := makeRequestInterface(wsOrderNew, data)
-	resp, err := b.Websocket.AuthConn.SendMessageReturnResponse(data.CustomID, request)
+	resp, err := b.Websocket.AuthConn.SendRateLimitedMessageReturnResponse(submitOrder, data.CustomID, request)
 	if err != nil {
 		return "", err
 	}
@@ -1092,7 +1093,7 @@ func (b *Bitfinex) WsNewOrder(data *WsNewOrderRequest) (string, error) {
 // WsModifyOrder authenticated modify order request
 func (b *Bitfinex) WsModifyOrder(data *WsUpdateOrderRequest) error {
 	request := makeRequestInterface(wsOrderUpdate, data)
-	resp, err := b.Websocket.AuthConn.SendMessageReturnResponse(data.OrderID, request)
+	resp, err := b.Websocket.AuthConn.SendRateLimitedMessageReturnResponse(updateOrder, data.OrderID, request)
 	if err != nil {
 		return err
 	}
@@ -1121,7 +1122,7 @@ func (b *Bitfinex) WsCancelMultiOrders(orderIDs []int64) error {
 		OrderID: orderIDs,
 	}
 	request := makeRequestInterface(wsCancelMultipleOrders, cancel)
-	return b.Websocket.AuthConn.SendJSONMessage(request)
+	return b.Websocket.AuthConn.SendRateLimitedJSONMessage(cancelBatch, request)
 }
 
 // WsCancelOrder authenticated cancel order request
@@ -1130,7 +1131,7 @@ func (b *Bitfinex) WsCancelOrder(orderID int64) error {
 		OrderID: orderID,
 	}
 	request := makeRequestInterface(wsOrderCancel, cancel)
-	resp, err := b.Websocket.AuthConn.SendMessageReturnResponse(orderID, request)
+	resp, err := b.Websocket.AuthConn.SendRateLimitedMessageReturnResponse(cancelOrder, orderID, request)
 	if err != nil {
 		return err
 	}
@@ -1156,13 +1157,13 @@ func (b *Bitfinex) WsCancelOrder(orderID int64) error {
 func (b *Bitfinex) WsCancelAllOrders() error {
 	cancelAll := WsCancelAllOrdersRequest{All: 1}
 	request := makeRequestInterface(wsCancelMultipleOrders, cancelAll)
-	return b.Websocket.AuthConn.SendJSONMessage(request)
+	return b.Websocket.AuthConn.SendRateLimitedJSONMessage(cancelBatch, request)
 }
 
 // WsNewOffer authenticated new offer request
 func (b *Bitfinex) WsNewOffer(data *WsNewOfferRequest) error {
 	request := makeRequestInterface(wsFundingOrderNew, data)
-	return b.Websocket.AuthConn.SendJSONMessage(request)
+	return b.Websocket.AuthConn.SendRateLimitedJSONMessage(submitFundingOffer, request)
 }
 
 // WsCancelOffer authenticated cancel offer request
@@ -1171,7 +1172,7 @@ func (b *Bitfinex) WsCancelOffer(orderID int64) error {
 		OrderID: orderID,
 	}
 	request := makeRequestInterface(wsFundingOrderCancel, cancel)
-	resp, err := b.Websocket.AuthConn.SendMessageReturnResponse(orderID, request)
+	resp, err := b.Websocket.AuthConn.SendRateLimitedMessageReturnResponse(cancelFundingOffer, orderID, request)
 	if err != nil {
 		return err
 	}
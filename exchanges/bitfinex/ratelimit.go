@@ -1,7 +1,10 @@
 package bitfinex
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"net/http"
 	"time"
 
 	"github.com/yurulab/gocryptotrader/exchanges/request"
@@ -9,6 +12,10 @@ import (
 )
 
 const (
+	// rateLimitBlock is how long Bitfinex blocks an IP address once it has
+	// exceeded the rate limit
+	rateLimitBlock = time.Minute
+
 	// Bitfinex rate limits - Public
 	requestLimitInterval      = time.Minute
 	platformStatusReqRate     = 15
@@ -251,164 +258,185 @@ type RateLimit struct {
 	StatsV1           *rate.Limiter
 	Fundingbook       *rate.Limiter
 	Lends             *rate.Limiter
+	// Rejected pauses every endpoint once Bitfinex reports the rate limit
+	// has been exceeded
+	Rejected *request.Cooldown
 }
 
 // Limit limits outbound requests
-func (r *RateLimit) Limit(f request.EndpointLimit) error {
+func (r *RateLimit) Limit(ctx context.Context, f request.EndpointLimit) error {
+	if err := r.Rejected.Wait(ctx); err != nil {
+		return err
+	}
 	switch f {
 	case platformStatus:
-		time.Sleep(r.PlatformStatus.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.PlatformStatus.Reserve())
 	case tickerBatch:
-		time.Sleep(r.TickerBatch.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.TickerBatch.Reserve())
 	case tickerFunction:
-		time.Sleep(r.Ticker.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.Ticker.Reserve())
 	case tradeRateLimit:
-		time.Sleep(r.Trade.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.Trade.Reserve())
 	case orderbookFunction:
-		time.Sleep(r.Orderbook.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.Orderbook.Reserve())
 	case stats:
-		time.Sleep(r.Stats.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.Stats.Reserve())
 	case candle:
-		time.Sleep(r.Candle.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.Candle.Reserve())
 	case configs:
-		time.Sleep(r.Configs.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.Configs.Reserve())
 	case status:
-		time.Sleep(r.Stats.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.Stats.Reserve())
 	case liquid:
-		time.Sleep(r.Liquid.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.Liquid.Reserve())
 	case leaderBoard:
-		time.Sleep(r.LeaderBoard.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.LeaderBoard.Reserve())
 	case marketAveragePrice:
-		time.Sleep(r.MarketAveragePrice.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.MarketAveragePrice.Reserve())
 	case fx:
-		time.Sleep(r.Fx.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.Fx.Reserve())
 	case accountWalletBalance:
-		time.Sleep(r.AccountWalletBalance.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.AccountWalletBalance.Reserve())
 	case accountWalletHistory:
-		time.Sleep(r.AccountWalletHistory.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.AccountWalletHistory.Reserve())
 	case retrieveOrder:
-		time.Sleep(r.RetrieveOrder.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.RetrieveOrder.Reserve())
 	case submitOrder:
-		time.Sleep(r.SubmitOrder.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SubmitOrder.Reserve())
 	case updateOrder:
-		time.Sleep(r.UpdateOrder.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.UpdateOrder.Reserve())
 	case cancelOrder:
-		time.Sleep(r.CancelOrder.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.CancelOrder.Reserve())
 	case orderBatch:
-		time.Sleep(r.OrderBatch.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.OrderBatch.Reserve())
 	case cancelBatch:
-		time.Sleep(r.CancelBatch.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.CancelBatch.Reserve())
 	case orderHistory:
-		time.Sleep(r.OrderHistory.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.OrderHistory.Reserve())
 	case getOrderTrades:
-		time.Sleep(r.GetOrderTrades.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetOrderTrades.Reserve())
 	case getTrades:
-		time.Sleep(r.GetTrades.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetTrades.Reserve())
 	case getLedgers:
-		time.Sleep(r.GetLedgers.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetLedgers.Reserve())
 	case getAccountMarginInfo:
-		time.Sleep(r.GetAccountMarginInfo.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetAccountMarginInfo.Reserve())
 	case getActivePositions:
-		time.Sleep(r.GetActivePositions.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetActivePositions.Reserve())
 	case claimPosition:
-		time.Sleep(r.ClaimPosition.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.ClaimPosition.Reserve())
 	case getPositionHistory:
-		time.Sleep(r.GetPositionHistory.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetPositionHistory.Reserve())
 	case getPositionAudit:
-		time.Sleep(r.GetPositionAudit.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetPositionAudit.Reserve())
 	case updateCollateralOnPosition:
-		time.Sleep(r.UpdateCollateralOnPosition.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.UpdateCollateralOnPosition.Reserve())
 	case getActiveFundingOffers:
-		time.Sleep(r.GetActiveFundingOffers.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetActiveFundingOffers.Reserve())
 	case submitFundingOffer:
-		time.Sleep(r.SubmitFundingOffer.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SubmitFundingOffer.Reserve())
 	case cancelFundingOffer:
-		time.Sleep(r.CancelFundingOffer.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.CancelFundingOffer.Reserve())
 	case cancelAllFundingOffer:
-		time.Sleep(r.CancelAllFundingOffer.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.CancelAllFundingOffer.Reserve())
 	case closeFunding:
-		time.Sleep(r.CloseFunding.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.CloseFunding.Reserve())
 	case fundingAutoRenew:
-		time.Sleep(r.FundingAutoRenew.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.FundingAutoRenew.Reserve())
 	case keepFunding:
-		time.Sleep(r.KeepFunding.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.KeepFunding.Reserve())
 	case getOffersHistory:
-		time.Sleep(r.GetOffersHistory.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetOffersHistory.Reserve())
 	case getFundingLoans:
-		time.Sleep(r.GetFundingLoans.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetFundingLoans.Reserve())
 	case getFundingLoanHistory:
-		time.Sleep(r.GetFundingLoanHistory.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetFundingLoanHistory.Reserve())
 	case getFundingCredits:
-		time.Sleep(r.GetFundingCredits.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetFundingCredits.Reserve())
 	case getFundingCreditsHistory:
-		time.Sleep(r.GetFundingCreditsHistory.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetFundingCreditsHistory.Reserve())
 	case getFundingTrades:
-		time.Sleep(r.GetFundingTrades.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetFundingTrades.Reserve())
 	case getFundingInfo:
-		time.Sleep(r.GetFundingInfo.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetFundingInfo.Reserve())
 	case getUserInfo:
-		time.Sleep(r.GetUserInfo.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetUserInfo.Reserve())
 	case transferBetweenWallets:
-		time.Sleep(r.TransferBetweenWallets.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.TransferBetweenWallets.Reserve())
 	case getDepositAddress:
-		time.Sleep(r.GetDepositAddress.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetDepositAddress.Reserve())
 	case withdrawal:
-		time.Sleep(r.Withdrawal.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.Withdrawal.Reserve())
 	case getMovements:
-		time.Sleep(r.GetMovements.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetMovements.Reserve())
 	case getAlertList:
-		time.Sleep(r.GetAlertList.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetAlertList.Reserve())
 	case setPriceAlert:
-		time.Sleep(r.SetPriceAlert.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SetPriceAlert.Reserve())
 	case deletePriceAlert:
-		time.Sleep(r.DeletePriceAlert.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.DeletePriceAlert.Reserve())
 	case getBalanceForOrdersOffers:
-		time.Sleep(r.GetBalanceForOrdersOffers.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetBalanceForOrdersOffers.Reserve())
 	case userSettingsWrite:
-		time.Sleep(r.UserSettingsWrite.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.UserSettingsWrite.Reserve())
 	case userSettingsRead:
-		time.Sleep(r.UserSettingsRead.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.UserSettingsRead.Reserve())
 	case userSettingsDelete:
-		time.Sleep(r.UserSettingsDelete.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.UserSettingsDelete.Reserve())
 
 		//  Bitfinex V1 API
 	case getAccountFees:
-		time.Sleep(r.GetAccountFees.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetAccountFees.Reserve())
 	case getWithdrawalFees:
-		time.Sleep(r.GetWithdrawalFees.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetWithdrawalFees.Reserve())
 	case getAccountSummary:
-		time.Sleep(r.GetAccountSummary.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetAccountSummary.Reserve())
 	case newDepositAddress:
-		time.Sleep(r.NewDepositAddress.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.NewDepositAddress.Reserve())
 	case getKeyPermissions:
-		time.Sleep(r.GetKeyPermissions.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetKeyPermissions.Reserve())
 	case getMarginInfo:
-		time.Sleep(r.GetMarginInfo.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetMarginInfo.Reserve())
 	case getAccountBalance:
-		time.Sleep(r.GetAccountBalance.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.GetAccountBalance.Reserve())
 	case walletTransfer:
-		time.Sleep(r.WalletTransfer.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.WalletTransfer.Reserve())
 	case withdrawV1:
-		time.Sleep(r.WithdrawV1.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.WithdrawV1.Reserve())
 	case orderV1:
-		time.Sleep(r.OrderV1.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.OrderV1.Reserve())
 	case orderMulti:
-		time.Sleep(r.OrderMulti.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.OrderMulti.Reserve())
 	case statsV1:
-		time.Sleep(r.Stats.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.Stats.Reserve())
 	case fundingbook:
-		time.Sleep(r.Fundingbook.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.Fundingbook.Reserve())
 	case lends:
-		time.Sleep(r.Lends.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.Lends.Reserve())
 	default:
 		return errors.New("endpoint rate limit functionality not found")
 	}
-	return nil
+}
+
+// UpdateFromResponse implements the request.ResponseUpdater interface,
+// pausing requests while Bitfinex blocks the IP address for exceeding the rate
+// limit
+func (r *RateLimit) UpdateFromResponse(status int, body []byte, now time.Time) {
+	r.Rejected.UpdateFromResponse(status, body, now)
+}
+
+// parseRejection detects rate limit errors, returned with a 429 status or in
+// the body as error code 11010 by the v2 API and ERR_RATE_LIMIT by the v1 API
+func parseRejection(status int, body []byte) (time.Duration, bool) {
+	return 0, status == http.StatusTooManyRequests ||
+		bytes.Contains(body, []byte("ratelimit: error")) ||
+		bytes.Contains(body, []byte("ERR_RATE_LIMIT"))
 }
 
 // SetRateLimit returns the rate limit for the exchange
 func SetRateLimit() *RateLimit {
 	return &RateLimit{
+		Rejected: request.NewCooldown(rateLimitBlock, parseRejection),
 		PlatformStatus:       request.NewRateLimit(requestLimitInterval, platformStatusReqRate),
 		TickerBatch:          request.NewRateLimit(requestLimitInterval, tickerBatchReqRate),
 		Ticker:               request.NewRateLimit(requestLimitInterval, tickerReqRate),
@@ -0,0 +1,21 @@
+package bitfinex
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestParseRejection(t *testing.T) {
+	t.Parallel()
+	if _, ok := parseRejection(http.StatusOK, []byte(`[0,"ok"]`)); ok {
+		t.Error("expected successful response not to be a rejection")
+	}
+	for _, body := range []string{`["error",11010,"ratelimit: error"]`, `{"error":"ERR_RATE_LIMIT"}`} {
+		if _, ok := parseRejection(http.StatusOK, []byte(body)); !ok {
+			t.Errorf("expected %s to be a rejection", body)
+		}
+	}
+	if _, ok := parseRejection(http.StatusTooManyRequests, nil); !ok {
+		t.Error("expected 429 status to be a rejection")
+	}
+}
@@ -74,91 +74,91 @@ type Bitflyer struct {
 
 // GetLatestBlockCA returns the latest block information from bitflyer chain
 // analysis system
-func (b *Bitflyer) GetLatestBlockCA() (ChainAnalysisBlock, error) {
+func (b *Bitflyer) GetLatestBlockCA(ctx context.Context) (ChainAnalysisBlock, error) {
 	var resp ChainAnalysisBlock
 	path := b.API.Endpoints.URLSecondary + latestBlock
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetBlockCA returns block information by blockhash from bitflyer chain
 // analysis system
-func (b *Bitflyer) GetBlockCA(blockhash string) (ChainAnalysisBlock, error) {
+func (b *Bitflyer) GetBlockCA(ctx context.Context, blockhash string) (ChainAnalysisBlock, error) {
 	var resp ChainAnalysisBlock
 	path := b.API.Endpoints.URLSecondary + blockByBlockHash + blockhash
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetBlockbyHeightCA returns the block information by height from bitflyer chain
 // analysis system
-func (b *Bitflyer) GetBlockbyHeightCA(height int64) (ChainAnalysisBlock, error) {
+func (b *Bitflyer) GetBlockbyHeightCA(ctx context.Context, height int64) (ChainAnalysisBlock, error) {
 	var resp ChainAnalysisBlock
 	path := b.API.Endpoints.URLSecondary +
 		blockByBlockHeight +
 		strconv.FormatInt(height, 10)
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetTransactionByHashCA returns transaction information by txHash from
 // bitflyer chain analysis system
-func (b *Bitflyer) GetTransactionByHashCA(txHash string) (ChainAnalysisTransaction, error) {
+func (b *Bitflyer) GetTransactionByHashCA(ctx context.Context, txHash string) (ChainAnalysisTransaction, error) {
 	var resp ChainAnalysisTransaction
 	path := b.API.Endpoints.URLSecondary + transaction + txHash
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetAddressInfoCA returns balance information for address by addressln string
 // from bitflyer chain analysis system
-func (b *Bitflyer) GetAddressInfoCA(addressln string) (ChainAnalysisAddress, error) {
+func (b *Bitflyer) GetAddressInfoCA(ctx context.Context, addressln string) (ChainAnalysisAddress, error) {
 	var resp ChainAnalysisAddress
 	path := b.API.Endpoints.URLSecondary + address + addressln
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetMarkets returns market information
-func (b *Bitflyer) GetMarkets() ([]MarketInfo, error) {
+func (b *Bitflyer) GetMarkets(ctx context.Context) ([]MarketInfo, error) {
 	var resp []MarketInfo
 	path := b.API.Endpoints.URL + pubGetMarkets
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetOrderBook returns market orderbook depth
-func (b *Bitflyer) GetOrderBook(symbol string) (Orderbook, error) {
+func (b *Bitflyer) GetOrderBook(ctx context.Context, symbol string) (Orderbook, error) {
 	var resp Orderbook
 	v := url.Values{}
 	v.Set("product_code", symbol)
 	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URL, pubGetBoard, v.Encode())
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetTicker returns ticker information
-func (b *Bitflyer) GetTicker(symbol string) (Ticker, error) {
+func (b *Bitflyer) GetTicker(ctx context.Context, symbol string) (Ticker, error) {
 	var resp Ticker
 	v := url.Values{}
 	v.Set("product_code", symbol)
 	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URL, pubGetTicker, v.Encode())
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetExecutionHistory returns past trades that were executed on the market
-func (b *Bitflyer) GetExecutionHistory(symbol string) ([]ExecutedTrade, error) {
+func (b *Bitflyer) GetExecutionHistory(ctx context.Context, symbol string) ([]ExecutedTrade, error) {
 	var resp []ExecutedTrade
 	v := url.Values{}
 	v.Set("product_code", symbol)
 	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URL, pubGetExecutionHistory, v.Encode())
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetExchangeStatus returns exchange status information
-func (b *Bitflyer) GetExchangeStatus() (string, error) {
+func (b *Bitflyer) GetExchangeStatus(ctx context.Context) (string, error) {
 	resp := make(map[string]string)
 
 	path := b.API.Endpoints.URL + pubGetHealth
 
-	err := b.SendHTTPRequest(path, &resp)
+	err := b.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return "", err
 	}
@@ -179,12 +179,12 @@ func (b *Bitflyer) GetExchangeStatus() (string, error) {
 
 // GetChats returns trollbox chat log
 // Note: returns vary from instant to infinty
-func (b *Bitflyer) GetChats(fromDate string) ([]ChatLog, error) {
+func (b *Bitflyer) GetChats(ctx context.Context, fromDate string) ([]ChatLog, error) {
 	var resp []ChatLog
 	v := url.Values{}
 	v.Set("from_date", fromDate)
 	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URL, pubGetChats, v.Encode())
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetPermissions returns current permissions for associated with your API
@@ -304,8 +304,8 @@ func (b *Bitflyer) GetTradingCommission() {
 }
 
 // SendHTTPRequest sends an unauthenticated request
-func (b *Bitflyer) SendHTTPRequest(path string, result interface{}) error {
-	return b.SendPayload(context.Background(), &request.Item{
+func (b *Bitflyer) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return b.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -0,0 +1,41 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package bitflyer
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	b.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Bitflyer load config error", err)
+	}
+	bitflyerConfig, err := cfg.GetExchangeConfig("Bitflyer")
+	if err != nil {
+		log.Fatal("bitflyer Setup() init error")
+	}
+
+	bitflyerConfig.API.AuthenticatedSupport = true
+	bitflyerConfig.API.Credentials.Key = apiKey
+	bitflyerConfig.API.Credentials.Secret = apiSecret
+	b.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = b.Setup(bitflyerConfig)
+	if err != nil {
+		log.Fatal("Bitflyer setup error", err)
+	}
+
+	log.Printf(sharedtestvalues.LiveTesting, b.Name, b.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
@@ -0,0 +1,56 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package bitflyer
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/bitflyer/bitflyer.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	b.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Bitflyer load config error", err)
+	}
+	bitflyerConfig, err := cfg.GetExchangeConfig("Bitflyer")
+	if err != nil {
+		log.Fatal("bitflyer Setup() init error")
+	}
+
+	bitflyerConfig.API.AuthenticatedSupport = true
+	bitflyerConfig.API.Credentials.Key = apiKey
+	bitflyerConfig.API.Credentials.Secret = apiSecret
+	b.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = b.Setup(bitflyerConfig)
+	if err != nil {
+		log.Fatal("Bitflyer setup error", err)
+	}
+
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+
+	b.HTTPClient = newClient
+	b.API.Endpoints.URL = serverDetails + "/v1"
+	b.API.Endpoints.URLSecondary = serverDetails + "/v1/"
+	log.Printf(sharedtestvalues.MockTesting, b.Name, b.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
@@ -1,12 +1,10 @@
 package bitflyer
 
 import (
-	"log"
-	"os"
+	"context"
 	"testing"
 
 	"github.com/yurulab/gocryptotrader/common"
-	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/core"
 	"github.com/yurulab/gocryptotrader/currency"
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
@@ -24,33 +22,9 @@ const (
 
 var b Bitflyer
 
-func TestMain(m *testing.M) {
-	b.SetDefaults()
-	cfg := config.GetConfig()
-	err := cfg.LoadConfig("../../testdata/configtest.json", true)
-	if err != nil {
-		log.Fatal("Bitflyer load config error", err)
-	}
-	bitflyerConfig, err := cfg.GetExchangeConfig("Bitflyer")
-	if err != nil {
-		log.Fatal("bitflyer Setup() init error")
-	}
-
-	bitflyerConfig.API.AuthenticatedSupport = true
-	bitflyerConfig.API.Credentials.Key = apiKey
-	bitflyerConfig.API.Credentials.Secret = apiSecret
-
-	err = b.Setup(bitflyerConfig)
-	if err != nil {
-		log.Fatal("Bitflyer setup error", err)
-	}
-
-	os.Exit(m.Run())
-}
-
 func TestGetLatestBlockCA(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetLatestBlockCA()
+	_, err := b.GetLatestBlockCA(context.Background())
 	if err != nil {
 		t.Error("Bitflyer - GetLatestBlockCA() error:", err)
 	}
@@ -58,7 +32,7 @@ func TestGetLatestBlockCA(t *testing.T) {
 
 func TestGetBlockCA(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetBlockCA("000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f")
+	_, err := b.GetBlockCA(context.Background(), "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f")
 	if err != nil {
 		t.Error("Bitflyer - GetBlockCA() error:", err)
 	}
@@ -66,7 +40,7 @@ func TestGetBlockCA(t *testing.T) {
 
 func TestGetBlockbyHeightCA(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetBlockbyHeightCA(0)
+	_, err := b.GetBlockbyHeightCA(context.Background(), 0)
 	if err != nil {
 		t.Error("Bitflyer - GetBlockbyHeightCA() error:", err)
 	}
@@ -74,7 +48,7 @@ func TestGetBlockbyHeightCA(t *testing.T) {
 
 func TestGetTransactionByHashCA(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTransactionByHashCA("0562d1f063cd4127053d838b165630445af5e480ceb24e1fd9ecea52903cb772")
+	_, err := b.GetTransactionByHashCA(context.Background(), "0562d1f063cd4127053d838b165630445af5e480ceb24e1fd9ecea52903cb772")
 	if err != nil {
 		t.Error("Bitflyer - GetTransactionByHashCA() error:", err)
 	}
@@ -82,7 +56,7 @@ func TestGetTransactionByHashCA(t *testing.T) {
 
 func TestGetAddressInfoCA(t *testing.T) {
 	t.Parallel()
-	v, err := b.GetAddressInfoCA(core.BitcoinDonationAddress)
+	v, err := b.GetAddressInfoCA(context.Background(), core.BitcoinDonationAddress)
 	if err != nil {
 		t.Error("Bitflyer - GetAddressInfoCA() error:", err)
 	}
@@ -93,7 +67,7 @@ func TestGetAddressInfoCA(t *testing.T) {
 
 func TestGetMarkets(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarkets()
+	_, err := b.GetMarkets(context.Background())
 	if err != nil {
 		t.Error("Bitflyer - GetMarkets() error:", err)
 	}
@@ -101,7 +75,7 @@ func TestGetMarkets(t *testing.T) {
 
 func TestGetOrderBook(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrderBook("BTC_JPY")
+	_, err := b.GetOrderBook(context.Background(), "BTC_JPY")
 	if err != nil {
 		t.Error("Bitflyer - GetOrderBook() error:", err)
 	}
@@ -109,7 +83,7 @@ func TestGetOrderBook(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTicker("BTC_JPY")
+	_, err := b.GetTicker(context.Background(), "BTC_JPY")
 	if err != nil {
 		t.Error("Bitflyer - GetTicker() error:", err)
 	}
@@ -117,7 +91,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetExecutionHistory(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetExecutionHistory("BTC_JPY")
+	_, err := b.GetExecutionHistory(context.Background(), "BTC_JPY")
 	if err != nil {
 		t.Error("Bitflyer - GetExecutionHistory() error:", err)
 	}
@@ -125,7 +99,7 @@ func TestGetExecutionHistory(t *testing.T) {
 
 func TestGetExchangeStatus(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetExchangeStatus()
+	_, err := b.GetExchangeStatus(context.Background())
 	if err != nil {
 		t.Error("Bitflyer - GetExchangeStatus() error:", err)
 	}
@@ -159,7 +133,7 @@ func TestFetchTicker(t *testing.T) {
 		}
 	}
 
-	_, err = b.FetchTicker(p, asset.Spot)
+	_, err = b.FetchTicker(context.Background(), p, asset.Spot)
 	if err != nil {
 		t.Error("Bitflyer - FetchTicker() error", err)
 	}
@@ -179,7 +153,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	b.GetFeeByType(feeBuilder)
+	b.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -278,7 +252,7 @@ func TestGetActiveOrders(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := b.GetActiveOrders(&getOrdersRequest)
+	_, err := b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -292,7 +266,7 @@ func TestGetOrderHistory(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := b.GetOrderHistory(&getOrdersRequest)
+	_, err := b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected '%v', received '%v'", common.ErrNotYetImplemented, err)
 	}
@@ -321,7 +295,7 @@ func TestSubmitOrder(t *testing.T) {
 		Amount:   1,
 		ClientID: "meowOrder",
 	}
-	_, err := b.SubmitOrder(orderSubmission)
+	_, err := b.SubmitOrder(context.Background(), orderSubmission)
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected 'Not Yet Implemented', received %v", err)
 	}
@@ -341,7 +315,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected 'Not Yet Implemented', received %v", err)
@@ -362,7 +336,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	_, err := b.CancelAllOrders(orderCancellation)
+	_, err := b.CancelAllOrders(context.Background(), orderCancellation)
 
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected 'Not Yet Implemented', received %v", err)
@@ -384,7 +358,7 @@ func TestWithdraw(t *testing.T) {
 		},
 	}
 
-	_, err := b.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := b.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected 'Not Yet Implemented', received %v", err)
 	}
@@ -395,7 +369,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := b.ModifyOrder(&order.Modify{})
+	_, err := b.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -409,7 +383,7 @@ func TestWithdrawFiat(t *testing.T) {
 
 	var withdrawFiatRequest = withdraw.Request{}
 
-	_, err := b.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrNotYetImplemented, err)
 	}
@@ -423,8 +397,68 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 	var withdrawFiatRequest = withdraw.Request{}
 
-	_, err := b.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrNotYetImplemented, err)
 	}
 }
+
+func TestWsResponse(t *testing.T) {
+	pressXToJSON := []byte(`{"jsonrpc":"2.0","id":1337,"result":true}`)
+	err := b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+	pressXToJSON = []byte(`{"jsonrpc":"2.0","id":1338,"error":{"code":-32600,"message":"Invalid Request"}}`)
+	err = b.wsHandleData(pressXToJSON)
+	if err == nil {
+		t.Error("expected error for unmatched error response")
+	}
+}
+
+func TestWsOrderbook(t *testing.T) {
+	pressXToJSON := []byte(`{"jsonrpc":"2.0","method":"channelMessage","params":{"channel":"lightning_board_snapshot_BTC_JPY","message":{"mid_price":1003450,"bids":[{"price":1003400,"size":0.25},{"price":1003300,"size":1.1}],"asks":[{"price":1003500,"size":0.04},{"price":1003600,"size":0.3}]}}}`)
+	err := b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pressXToJSON = []byte(`{"jsonrpc":"2.0","method":"channelMessage","params":{"channel":"lightning_board_BTC_JPY","message":{"mid_price":1003450,"bids":[{"price":1003400,"size":0}],"asks":[{"price":1003550,"size":0.12}]}}}`)
+	for i := int64(0); i < b.WebsocketOrderbookBufferLimit; i++ {
+		err = b.wsHandleData(pressXToJSON)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	p := currency.NewPairWithDelimiter("BTC", "JPY", "_")
+	ob := b.Websocket.Orderbook.GetOrderbook(p, asset.Spot)
+	if ob == nil {
+		t.Fatal("expected orderbook to be loaded")
+	}
+	if len(ob.Bids) != 1 || len(ob.Asks) != 3 {
+		t.Errorf("unexpected orderbook state %+v", ob)
+	}
+}
+
+func TestWsTicker(t *testing.T) {
+	pressXToJSON := []byte(`{"jsonrpc":"2.0","method":"channelMessage","params":{"channel":"lightning_ticker_BTC_JPY","message":{"product_code":"BTC_JPY","state":"RUNNING","timestamp":"2020-07-08T01:23:45.6789012Z","tick_id":4573812,"best_bid":1003400,"best_ask":1003500,"best_bid_size":0.25,"best_ask_size":0.04,"total_bid_depth":1291.44,"total_ask_depth":1107.53,"market_bid_size":0,"market_ask_size":0,"ltp":1003450,"volume":12345.67,"volume_by_product":2345.67}}}`)
+	err := b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWsExecutions(t *testing.T) {
+	pressXToJSON := []byte(`{"jsonrpc":"2.0","method":"channelMessage","params":{"channel":"lightning_executions_BTC_JPY","message":[{"id":1786723410,"side":"BUY","price":1003500,"size":0.01,"exec_date":"2020-07-08T01:23:45.7012345Z","buy_child_order_acceptance_id":"JRF20200708-012345-111111","sell_child_order_acceptance_id":"JRF20200708-012340-222222"},{"id":1786723411,"side":"SELL","price":1003400,"size":0.02,"exec_date":"2020-07-08T01:23:45.8Z","buy_child_order_acceptance_id":"JRF20200708-012341-333333","sell_child_order_acceptance_id":"JRF20200708-012345-444444"}]}}`)
+	err := b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWsChildOrderEvents(t *testing.T) {
+	pressXToJSON := []byte(`{"jsonrpc":"2.0","method":"channelMessage","params":{"channel":"child_order_events","message":[{"product_code":"BTC_JPY","child_order_id":"JOR20200708-012345-555555","child_order_acceptance_id":"JRF20200708-012345-666666","event_date":"2020-07-08T01:23:45.123Z","event_type":"ORDER","child_order_type":"LIMIT","side":"BUY","price":1003000,"size":0.01,"expire_date":"2020-08-07T01:23:45"},{"product_code":"BTC_JPY","child_order_id":"JOR20200708-012345-555555","child_order_acceptance_id":"JRF20200708-012345-666666","event_date":"2020-07-08T01:24:00.5Z","event_type":"EXECUTION","exec_id":1786723499,"side":"BUY","price":1003000,"size":0.005,"commission":0,"sfd":0},{"product_code":"BTC_JPY","child_order_id":"JOR20200708-012345-555555","child_order_acceptance_id":"JRF20200708-012345-666666","event_date":"2020-07-08T01:25:00Z","event_type":"CANCEL","price":1003000,"size":0.005}]}}`)
+	err := b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+}
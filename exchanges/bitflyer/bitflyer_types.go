@@ -1,5 +1,7 @@
 package bitflyer
 
+import "encoding/json"
+
 // ChainAnalysisBlock holds block information from the bitcoin network
 type ChainAnalysisBlock struct {
 	BlockHash     string   `json:"block_hash"`
@@ -289,3 +291,64 @@ type NewOrder struct {
 	MinuteToExpire float64 `json:"minute_to_expire"`
 	TimeInForce    string  `json:"time_in_force"`
 }
+
+// WsRequest defines a JSON-RPC request sent over the realtime API
+type WsRequest struct {
+	Version string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
+	ID      int64       `json:"id,omitempty"`
+}
+
+// WsChannelParams defines the parameters for a subscribe or unsubscribe
+// request
+type WsChannelParams struct {
+	Channel string `json:"channel"`
+}
+
+// WsAuthParams defines the parameters for an auth request
+type WsAuthParams struct {
+	APIKey    string `json:"api_key"`
+	Timestamp int64  `json:"timestamp"`
+	Nonce     string `json:"nonce"`
+	Signature string `json:"signature"`
+}
+
+// WsResponse defines a generic JSON-RPC response or channel message from the
+// realtime API
+type WsResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      int64           `json:"id"`
+	Method  string          `json:"method"`
+	Result  json.RawMessage `json:"result"`
+	Error   *WsError        `json:"error"`
+	Params  struct {
+		Channel string          `json:"channel"`
+		Message json.RawMessage `json:"message"`
+	} `json:"params"`
+}
+
+// WsError defines a JSON-RPC error
+type WsError struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+}
+
+// WsChildOrderEvent defines an event on one of the authenticated account's
+// child orders
+type WsChildOrderEvent struct {
+	ProductCode            string  `json:"product_code"`
+	ChildOrderID           string  `json:"child_order_id"`
+	ChildOrderAcceptanceID string  `json:"child_order_acceptance_id"`
+	EventDate              string  `json:"event_date"`
+	EventType              string  `json:"event_type"`
+	ChildOrderType         string  `json:"child_order_type"`
+	Side                   string  `json:"side"`
+	Price                  float64 `json:"price"`
+	Size                   float64 `json:"size"`
+	ExpireDate             string  `json:"expire_date"`
+	ExecID                 int64   `json:"exec_id"`
+	Commission             float64 `json:"commission"`
+	SFD                    float64 `json:"sfd"`
+	Reason                 string  `json:"reason"`
+}
@@ -0,0 +1,478 @@
+package bitflyer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/yurulab/gocryptotrader/common"
+	"github.com/yurulab/gocryptotrader/common/crypto"
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/order"
+	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
+	"github.com/yurulab/gocryptotrader/exchanges/stream"
+	"github.com/yurulab/gocryptotrader/exchanges/stream/buffer"
+	"github.com/yurulab/gocryptotrader/exchanges/ticker"
+	"github.com/yurulab/gocryptotrader/log"
+)
+
+const (
+	bitflyerWSURL         = "wss://ws.lightstream.bitflyer.com/json-rpc"
+	bitflyerJSONRPC       = "2.0"
+	bitflyerChannelMethod = "channelMessage"
+
+	// Public channel prefixes, the product code is appended
+	wsBoardSnapshot = "lightning_board_snapshot_"
+	wsBoard         = "lightning_board_"
+	wsTicker        = "lightning_ticker_"
+	wsExecutions    = "lightning_executions_"
+
+	// Private channels
+	wsChildOrderEvents = "child_order_events"
+
+	// Child order event types
+	wsEventOrder        = "ORDER"
+	wsEventOrderFailed  = "ORDER_FAILED"
+	wsEventCancel       = "CANCEL"
+	wsEventCancelFailed = "CANCEL_FAILED"
+	wsEventExecution    = "EXECUTION"
+	wsEventExpire       = "EXPIRE"
+)
+
+// WsConnect connects to a websocket feed
+func (b *Bitflyer) WsConnect() error {
+	if !b.Websocket.IsEnabled() || !b.IsEnabled() {
+		return errors.New(stream.WebsocketNotEnabled)
+	}
+	var dialer websocket.Dialer
+	err := b.Websocket.Conn.Dial(&dialer, http.Header{})
+	if err != nil {
+		return err
+	}
+	if b.Verbose {
+		log.Debugf(log.ExchangeSys, "%s Connected to Websocket.\n", b.Name)
+	}
+	go b.wsReadData()
+
+	if b.Websocket.CanUseAuthenticatedEndpoints() {
+		err = b.wsAuth()
+		if err != nil {
+			b.Websocket.DataHandler <- err
+			b.Websocket.SetCanUseAuthenticatedEndpoints(false)
+		}
+	}
+
+	subs, err := b.generateDefaultSubscriptions()
+	if err != nil {
+		return err
+	}
+	return b.Websocket.SubscribeToChannels(subs)
+}
+
+// wsReadData receives and passes on websocket messages for processing
+func (b *Bitflyer) wsReadData() {
+	b.Websocket.Wg.Add(1)
+	defer b.Websocket.Wg.Done()
+
+	for {
+		resp := b.Websocket.Conn.ReadMessage()
+		if resp.Raw == nil {
+			return
+		}
+		err := b.wsHandleData(resp.Raw)
+		if err != nil {
+			b.Websocket.DataHandler <- err
+		}
+	}
+}
+
+// wsSendRequest sends a JSON-RPC request and returns an error if the request
+// is rejected
+func (b *Bitflyer) wsSendRequest(method string, params interface{}) error {
+	id := b.Websocket.Conn.GenerateMessageID(false)
+	resp, err := b.Websocket.Conn.SendMessageReturnResponse(id, WsRequest{
+		Version: bitflyerJSONRPC,
+		Method:  method,
+		Params:  params,
+		ID:      id,
+	})
+	if err != nil {
+		return err
+	}
+	var result WsResponse
+	err = json.Unmarshal(resp, &result)
+	if err != nil {
+		return err
+	}
+	if result.Error != nil {
+		return fmt.Errorf("%s websocket %s error: %d %s",
+			b.Name,
+			method,
+			result.Error.Code,
+			result.Error.Message)
+	}
+	var success bool
+	err = json.Unmarshal(result.Result, &success)
+	if err != nil {
+		return err
+	}
+	if !success {
+		return fmt.Errorf("%s websocket %s request rejected", b.Name, method)
+	}
+	return nil
+}
+
+// wsAuth authenticates the websocket connection for private channels
+func (b *Bitflyer) wsAuth() error {
+	nonce, err := crypto.GetRandomSalt(nil, 16)
+	if err != nil {
+		return err
+	}
+	params := WsAuthParams{
+		APIKey:    b.API.Credentials.Key,
+		Timestamp: time.Now().UnixNano() / int64(time.Millisecond),
+		Nonce:     crypto.HexEncodeToString(nonce),
+	}
+	hmac := crypto.GetHMAC(crypto.HashSHA256,
+		[]byte(strconv.FormatInt(params.Timestamp, 10)+params.Nonce),
+		[]byte(b.API.Credentials.Secret))
+	params.Signature = crypto.HexEncodeToString(hmac)
+	return b.wsSendRequest("auth", params)
+}
+
+func (b *Bitflyer) wsHandleData(respRaw []byte) error {
+	var resp WsResponse
+	err := json.Unmarshal(respRaw, &resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.ID != 0 {
+		if !b.Websocket.Match.IncomingWithData(resp.ID, respRaw) && resp.Error != nil {
+			return fmt.Errorf("%s websocket error: %d %s",
+				b.Name,
+				resp.Error.Code,
+				resp.Error.Message)
+		}
+		return nil
+	}
+
+	if resp.Method != bitflyerChannelMethod {
+		b.Websocket.DataHandler <- stream.UnhandledMessageWarning{Message: b.Name + stream.UnhandledMessage + string(respRaw)}
+		return nil
+	}
+
+	channel := resp.Params.Channel
+	switch {
+	case channel == wsChildOrderEvents:
+		var events []WsChildOrderEvent
+		err = json.Unmarshal(resp.Params.Message, &events)
+		if err != nil {
+			return err
+		}
+		for i := range events {
+			b.wsProcessChildOrderEvent(&events[i])
+		}
+	case strings.HasPrefix(channel, wsBoardSnapshot):
+		p, err := currency.NewPairDelimiter(strings.TrimPrefix(channel, wsBoardSnapshot),
+			currency.UnderscoreDelimiter)
+		if err != nil {
+			return err
+		}
+		var ob Orderbook
+		err = json.Unmarshal(resp.Params.Message, &ob)
+		if err != nil {
+			return err
+		}
+		bids, asks := wsOrderbookItems(&ob)
+		return b.Websocket.Orderbook.LoadSnapshot(&orderbook.Base{
+			Pair:         p,
+			Bids:         bids,
+			Asks:         asks,
+			LastUpdated:  time.Now(),
+			AssetType:    asset.Spot,
+			ExchangeName: b.Name,
+		})
+	case strings.HasPrefix(channel, wsBoard):
+		p, err := currency.NewPairDelimiter(strings.TrimPrefix(channel, wsBoard),
+			currency.UnderscoreDelimiter)
+		if err != nil {
+			return err
+		}
+		if b.Websocket.Orderbook.GetOrderbook(p, asset.Spot) == nil {
+			// Updates received before the snapshot are discarded
+			return nil
+		}
+		var ob Orderbook
+		err = json.Unmarshal(resp.Params.Message, &ob)
+		if err != nil {
+			return err
+		}
+		bids, asks := wsOrderbookItems(&ob)
+		if len(bids) == 0 && len(asks) == 0 {
+			return nil
+		}
+		return b.Websocket.Orderbook.Update(&buffer.Update{
+			UpdateTime: time.Now(),
+			Asset:      asset.Spot,
+			Bids:       bids,
+			Asks:       asks,
+			Pair:       p,
+		})
+	case strings.HasPrefix(channel, wsTicker):
+		var tick Ticker
+		err = json.Unmarshal(resp.Params.Message, &tick)
+		if err != nil {
+			return err
+		}
+		p, err := currency.NewPairDelimiter(tick.ProductCode, currency.UnderscoreDelimiter)
+		if err != nil {
+			return err
+		}
+		tickTime, err := time.Parse(time.RFC3339Nano, tick.TimeStamp)
+		if err != nil {
+			return err
+		}
+		b.Websocket.DataHandler <- &ticker.Price{
+			ExchangeName: b.Name,
+			Last:         tick.Last,
+			Bid:          tick.BestBid,
+			Ask:          tick.BestAsk,
+			Volume:       tick.VolumeByProduct,
+			LastUpdated:  tickTime,
+			AssetType:    asset.Spot,
+			Pair:         p,
+		}
+	case strings.HasPrefix(channel, wsExecutions):
+		p, err := currency.NewPairDelimiter(strings.TrimPrefix(channel, wsExecutions),
+			currency.UnderscoreDelimiter)
+		if err != nil {
+			return err
+		}
+		var trades []ExecutedTrade
+		err = json.Unmarshal(resp.Params.Message, &trades)
+		if err != nil {
+			return err
+		}
+		for i := range trades {
+			side, err := order.StringToOrderSide(trades[i].Side)
+			if err != nil {
+				b.Websocket.DataHandler <- order.ClassificationError{
+					Exchange: b.Name,
+					Err:      err,
+				}
+			}
+			tradeTime, err := time.Parse(time.RFC3339Nano, trades[i].ExecDate)
+			if err != nil {
+				return err
+			}
+			b.Websocket.DataHandler <- stream.TradeData{
+				TID:          strconv.FormatInt(trades[i].ID, 10),
+				Timestamp:    tradeTime,
+				CurrencyPair: p,
+				AssetType:    asset.Spot,
+				Exchange:     b.Name,
+				EventType:    order.UnknownType,
+				Price:        trades[i].Price,
+				Amount:       trades[i].Size,
+				Side:         side,
+			}
+		}
+	default:
+		b.Websocket.DataHandler <- stream.UnhandledMessageWarning{Message: b.Name + stream.UnhandledMessage + string(respRaw)}
+	}
+	return nil
+}
+
+func wsOrderbookItems(ob *Orderbook) (bids, asks []orderbook.Item) {
+	for i := range ob.Bids {
+		bids = append(bids, orderbook.Item{
+			Price:  ob.Bids[i].Price,
+			Amount: ob.Bids[i].Size,
+		})
+	}
+	for i := range ob.Asks {
+		asks = append(asks, orderbook.Item{
+			Price:  ob.Asks[i].Price,
+			Amount: ob.Asks[i].Size,
+		})
+	}
+	return bids, asks
+}
+
+func (b *Bitflyer) wsProcessChildOrderEvent(event *WsChildOrderEvent) {
+	orderID := event.ChildOrderAcceptanceID
+	var status order.Status
+	switch event.EventType {
+	case wsEventOrder:
+		status = order.New
+	case wsEventOrderFailed:
+		status = order.Rejected
+	case wsEventCancel:
+		status = order.Cancelled
+	case wsEventExecution:
+		status = order.PartiallyFilled
+	case wsEventExpire:
+		status = order.Expired
+	case wsEventCancelFailed:
+		b.Websocket.DataHandler <- fmt.Errorf("%s order %s cancellation failed",
+			b.Name,
+			orderID)
+		return
+	default:
+		status = order.UnknownStatus
+		b.Websocket.DataHandler <- order.ClassificationError{
+			Exchange: b.Name,
+			OrderID:  orderID,
+			Err:      fmt.Errorf("unknown child order event %s", event.EventType),
+		}
+	}
+
+	eventTime, err := time.Parse(time.RFC3339Nano, event.EventDate)
+	if err != nil {
+		b.Websocket.DataHandler <- order.ClassificationError{
+			Exchange: b.Name,
+			OrderID:  orderID,
+			Err:      err,
+		}
+	}
+
+	p, err := currency.NewPairDelimiter(event.ProductCode, currency.UnderscoreDelimiter)
+	if err != nil {
+		b.Websocket.DataHandler <- order.ClassificationError{
+			Exchange: b.Name,
+			OrderID:  orderID,
+			Err:      err,
+		}
+	}
+
+	detail := &order.Detail{
+		Exchange:    b.Name,
+		ID:          orderID,
+		Status:      status,
+		AssetType:   asset.Spot,
+		LastUpdated: eventTime,
+		Pair:        p,
+	}
+
+	if event.EventType == wsEventExecution {
+		detail.Trades = []order.TradeHistory{{
+			Price:     event.Price,
+			Amount:    event.Size,
+			Fee:       event.Commission,
+			Exchange:  b.Name,
+			TID:       strconv.FormatInt(event.ExecID, 10),
+			Timestamp: eventTime,
+		}}
+	}
+
+	if event.EventType == wsEventOrder {
+		detail.Price = event.Price
+		detail.Amount = event.Size
+		detail.RemainingAmount = event.Size
+		detail.Date = eventTime
+		detail.Type, err = order.StringToOrderType(event.ChildOrderType)
+		if err != nil {
+			b.Websocket.DataHandler <- order.ClassificationError{
+				Exchange: b.Name,
+				OrderID:  orderID,
+				Err:      err,
+			}
+		}
+		detail.Side, err = order.StringToOrderSide(event.Side)
+		if err != nil {
+			b.Websocket.DataHandler <- order.ClassificationError{
+				Exchange: b.Name,
+				OrderID:  orderID,
+				Err:      err,
+			}
+		}
+	}
+	b.Websocket.DataHandler <- detail
+}
+
+func (b *Bitflyer) generateDefaultSubscriptions() ([]stream.ChannelSubscription, error) {
+	var channels = []string{wsBoardSnapshot, wsBoard, wsTicker, wsExecutions}
+	enabledCurrencies, err := b.GetEnabledPairs(asset.Spot)
+	if err != nil {
+		return nil, err
+	}
+	var subscriptions []stream.ChannelSubscription
+	for i := range channels {
+		for j := range enabledCurrencies {
+			subscriptions = append(subscriptions, stream.ChannelSubscription{
+				Channel:  channels[i],
+				Currency: enabledCurrencies[j],
+				Asset:    asset.Spot,
+			})
+		}
+	}
+	if b.Websocket.CanUseAuthenticatedEndpoints() {
+		subscriptions = append(subscriptions, stream.ChannelSubscription{
+			Channel: wsChildOrderEvents,
+		})
+	}
+	return subscriptions, nil
+}
+
+// wsChannelName returns the realtime API channel name for a subscription
+func (b *Bitflyer) wsChannelName(sub *stream.ChannelSubscription) (string, error) {
+	if sub.Currency.IsEmpty() {
+		return sub.Channel, nil
+	}
+	fPair, err := b.FormatExchangeCurrency(sub.Currency, sub.Asset)
+	if err != nil {
+		return "", err
+	}
+	return sub.Channel + fPair.String(), nil
+}
+
+// Subscribe sends a websocket message to receive data from the channel
+func (b *Bitflyer) Subscribe(channelsToSubscribe []stream.ChannelSubscription) error {
+	var errs common.Errors
+	for i := range channelsToSubscribe {
+		channel, err := b.wsChannelName(&channelsToSubscribe[i])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		err = b.wsSendRequest("subscribe", WsChannelParams{Channel: channel})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		b.Websocket.AddSuccessfulSubscriptions(channelsToSubscribe[i])
+	}
+	if errs != nil {
+		return errs
+	}
+	return nil
+}
+
+// Unsubscribe sends a websocket message to stop receiving data from the channel
+func (b *Bitflyer) Unsubscribe(channelsToUnsubscribe []stream.ChannelSubscription) error {
+	var errs common.Errors
+	for i := range channelsToUnsubscribe {
+		channel, err := b.wsChannelName(&channelsToUnsubscribe[i])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		err = b.wsSendRequest("unsubscribe", WsChannelParams{Channel: channel})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		b.Websocket.RemoveSuccessfulUnsubscriptions(channelsToUnsubscribe[i])
+	}
+	if errs != nil {
+		return errs
+	}
+	return nil
+}
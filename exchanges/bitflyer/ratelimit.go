@@ -1,6 +1,7 @@
 package bitflyer
 
 import (
+	"context"
 	"time"
 
 	"github.com/yurulab/gocryptotrader/exchanges/request"
@@ -29,24 +30,22 @@ type RateLimit struct {
 }
 
 // Limit limits outbound requests
-func (r *RateLimit) Limit(f request.EndpointLimit) error {
+func (r *RateLimit) Limit(ctx context.Context, f request.EndpointLimit) error {
 	switch f {
 	case request.Auth:
-		time.Sleep(r.Auth.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.Auth.Reserve())
 	case orders:
-		res := r.Auth.Reserve()
-		time.Sleep(r.Order.Reserve().Delay())
-		time.Sleep(res.Delay())
+		return request.WaitForReservations(ctx,
+			r.Auth.Reserve(),
+			r.Order.Reserve())
 	case lowVolume:
-		authShell := r.Auth.Reserve()
-		orderShell := r.Order.Reserve()
-		time.Sleep(r.LowVolume.Reserve().Delay())
-		time.Sleep(orderShell.Delay())
-		time.Sleep(authShell.Delay())
+		return request.WaitForReservations(ctx,
+			r.Auth.Reserve(),
+			r.Order.Reserve(),
+			r.LowVolume.Reserve())
 	default:
-		time.Sleep(r.UnAuth.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.UnAuth.Reserve())
 	}
-	return nil
 }
 
 // SetRateLimit returns the rate limit for the exchange
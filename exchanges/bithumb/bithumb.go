@@ -50,8 +50,8 @@ type Bithumb struct {
 }
 
 // GetTradablePairs returns a list of tradable currencies
-func (b *Bithumb) GetTradablePairs() ([]string, error) {
-	result, err := b.GetAllTickers()
+func (b *Bithumb) GetTradablePairs(ctx context.Context) ([]string, error) {
+	result, err := b.GetAllTickers(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -66,12 +66,12 @@ func (b *Bithumb) GetTradablePairs() ([]string, error) {
 // GetTicker returns ticker information
 //
 // symbol e.g. "btc"
-func (b *Bithumb) GetTicker(symbol string) (Ticker, error) {
+func (b *Bithumb) GetTicker(ctx context.Context, symbol string) (Ticker, error) {
 	var response TickerResponse
 	path := b.API.Endpoints.URL +
 		publicTicker +
 		strings.ToUpper(symbol)
-	err := b.SendHTTPRequest(path, &response)
+	err := b.SendHTTPRequest(ctx, path, &response)
 	if err != nil {
 		return response.Data, err
 	}
@@ -84,10 +84,10 @@ func (b *Bithumb) GetTicker(symbol string) (Ticker, error) {
 }
 
 // GetAllTickers returns all ticker information
-func (b *Bithumb) GetAllTickers() (map[string]Ticker, error) {
+func (b *Bithumb) GetAllTickers(ctx context.Context) (map[string]Ticker, error) {
 	var response TickersResponse
 	path := b.API.Endpoints.URL + publicTicker + "all"
-	err := b.SendHTTPRequest(path, &response)
+	err := b.SendHTTPRequest(ctx, path, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -114,11 +114,11 @@ func (b *Bithumb) GetAllTickers() (map[string]Ticker, error) {
 // GetOrderBook returns current orderbook
 //
 // symbol e.g. "btc"
-func (b *Bithumb) GetOrderBook(symbol string) (Orderbook, error) {
+func (b *Bithumb) GetOrderBook(ctx context.Context, symbol string) (Orderbook, error) {
 	response := Orderbook{}
 	path := b.API.Endpoints.URL + publicOrderBook + strings.ToUpper(symbol)
 
-	err := b.SendHTTPRequest(path, &response)
+	err := b.SendHTTPRequest(ctx, path, &response)
 	if err != nil {
 		return response, err
 	}
@@ -133,13 +133,13 @@ func (b *Bithumb) GetOrderBook(symbol string) (Orderbook, error) {
 // GetTransactionHistory returns recent transactions
 //
 // symbol e.g. "btc"
-func (b *Bithumb) GetTransactionHistory(symbol string) (TransactionHistory, error) {
+func (b *Bithumb) GetTransactionHistory(ctx context.Context, symbol string) (TransactionHistory, error) {
 	response := TransactionHistory{}
 	path := b.API.Endpoints.URL +
 		publicTransactionHistory +
 		strings.ToUpper(symbol)
 
-	err := b.SendHTTPRequest(path, &response)
+	err := b.SendHTTPRequest(ctx, path, &response)
 	if err != nil {
 		return response, err
 	}
@@ -152,7 +152,7 @@ func (b *Bithumb) GetTransactionHistory(symbol string) (TransactionHistory, erro
 }
 
 // GetAccountInformation returns account information by singular currency
-func (b *Bithumb) GetAccountInformation(currency string) (Account, error) {
+func (b *Bithumb) GetAccountInformation(ctx context.Context, currency string) (Account, error) {
 	response := Account{}
 
 	val := url.Values{}
@@ -161,11 +161,11 @@ func (b *Bithumb) GetAccountInformation(currency string) (Account, error) {
 	}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(privateAccInfo, val, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, privateAccInfo, val, &response)
 }
 
 // GetAccountBalance returns customer wallet information
-func (b *Bithumb) GetAccountBalance(c string) (FullBalance, error) {
+func (b *Bithumb) GetAccountBalance(ctx context.Context, c string) (FullBalance, error) {
 	var response Balance
 	var fullBalance = FullBalance{
 		make(map[string]float64),
@@ -180,7 +180,7 @@ func (b *Bithumb) GetAccountBalance(c string) (FullBalance, error) {
 		vals.Set("currency", c)
 	}
 
-	err := b.SendAuthenticatedHTTPRequest(privateAccBalance, vals, &response)
+	err := b.SendAuthenticatedHTTPRequest(ctx, privateAccBalance, vals, &response)
 	if err != nil {
 		return fullBalance, err
 	}
@@ -228,12 +228,12 @@ func (b *Bithumb) GetAccountBalance(c string) (FullBalance, error) {
 // GetWalletAddress returns customer wallet address
 //
 // currency e.g. btc, ltc or "", will default to btc without currency specified
-func (b *Bithumb) GetWalletAddress(currency string) (WalletAddressRes, error) {
+func (b *Bithumb) GetWalletAddress(ctx context.Context, currency string) (WalletAddressRes, error) {
 	response := WalletAddressRes{}
 	params := url.Values{}
 	params.Set("currency", strings.ToUpper(currency))
 
-	err := b.SendAuthenticatedHTTPRequest(privateWalletAdd, params, &response)
+	err := b.SendAuthenticatedHTTPRequest(ctx, privateWalletAdd, params, &response)
 	if err != nil {
 		return response, err
 	}
@@ -248,11 +248,11 @@ func (b *Bithumb) GetWalletAddress(currency string) (WalletAddressRes, error) {
 }
 
 // GetLastTransaction returns customer last transaction
-func (b *Bithumb) GetLastTransaction() (LastTransactionTicker, error) {
+func (b *Bithumb) GetLastTransaction(ctx context.Context) (LastTransactionTicker, error) {
 	response := LastTransactionTicker{}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(privateTicker, nil, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, privateTicker, nil, &response)
 }
 
 // GetOrders returns order list
@@ -262,7 +262,7 @@ func (b *Bithumb) GetLastTransaction() (LastTransactionTicker, error) {
 // count: Value : 1 ~1000 (default : 100)
 // after: YYYY-MM-DD hh:mm:ss's UNIX Timestamp
 // (2014-11-28 16:40:01 = 1417160401000)
-func (b *Bithumb) GetOrders(orderID, transactionType, count, after, currency string) (Orders, error) {
+func (b *Bithumb) GetOrders(ctx context.Context, orderID, transactionType, count, after, currency string) (Orders, error) {
 	response := Orders{}
 
 	params := url.Values{}
@@ -287,15 +287,15 @@ func (b *Bithumb) GetOrders(orderID, transactionType, count, after, currency str
 	}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(privateOrders, params, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, privateOrders, params, &response)
 }
 
 // GetUserTransactions returns customer transactions
-func (b *Bithumb) GetUserTransactions() (UserTransactions, error) {
+func (b *Bithumb) GetUserTransactions(ctx context.Context) (UserTransactions, error) {
 	response := UserTransactions{}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(privateUserTrans, nil, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, privateUserTrans, nil, &response)
 }
 
 // PlaceTrade executes a trade order
@@ -305,7 +305,7 @@ func (b *Bithumb) GetUserTransactions() (UserTransactions, error) {
 // transactionType: Transaction type(bid : purchase, ask : sales)
 // units: Order quantity
 // price: Transaction amount per currency
-func (b *Bithumb) PlaceTrade(orderCurrency, transactionType string, units float64, price int64) (OrderPlace, error) {
+func (b *Bithumb) PlaceTrade(ctx context.Context, orderCurrency, transactionType string, units float64, price int64) (OrderPlace, error) {
 	response := OrderPlace{}
 
 	params := url.Values{}
@@ -316,11 +316,11 @@ func (b *Bithumb) PlaceTrade(orderCurrency, transactionType string, units float6
 	params.Set("price", strconv.FormatInt(price, 10))
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(privatePlaceTrade, params, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, privatePlaceTrade, params, &response)
 }
 
 // ModifyTrade modifies an order already on the exchange books
-func (b *Bithumb) ModifyTrade(orderID, orderCurrency, transactionType string, units float64, price int64) (OrderPlace, error) {
+func (b *Bithumb) ModifyTrade(ctx context.Context, orderID, orderCurrency, transactionType string, units float64, price int64) (OrderPlace, error) {
 	response := OrderPlace{}
 
 	params := url.Values{}
@@ -332,7 +332,7 @@ func (b *Bithumb) ModifyTrade(orderID, orderCurrency, transactionType string, un
 	params.Set("order_id", orderID)
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(privatePlaceTrade, params, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, privatePlaceTrade, params, &response)
 }
 
 // GetOrderDetails returns specific order details
@@ -341,7 +341,7 @@ func (b *Bithumb) ModifyTrade(orderID, orderCurrency, transactionType string, un
 // transactionType: Transaction type(bid : purchase, ask : sales)
 // currency: BTC, ETH, DASH, LTC, ETC, XRP, BCH, XMR, ZEC, QTUM, BTG, EOS
 // (default value: BTC)
-func (b *Bithumb) GetOrderDetails(orderID, transactionType, currency string) (OrderDetails, error) {
+func (b *Bithumb) GetOrderDetails(ctx context.Context, orderID, transactionType, currency string) (OrderDetails, error) {
 	response := OrderDetails{}
 
 	params := url.Values{}
@@ -350,7 +350,7 @@ func (b *Bithumb) GetOrderDetails(orderID, transactionType, currency string) (Or
 	params.Set("currency", strings.ToUpper(currency))
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(privateOrderDetail, params, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, privateOrderDetail, params, &response)
 }
 
 // CancelTrade cancels a customer purchase/sales transaction
@@ -358,7 +358,7 @@ func (b *Bithumb) GetOrderDetails(orderID, transactionType, currency string) (Or
 // orderID: Order number registered for purchase/sales
 // currency: BTC, ETH, DASH, LTC, ETC, XRP, BCH, XMR, ZEC, QTUM, BTG, EOS
 // (default value: BTC)
-func (b *Bithumb) CancelTrade(transactionType, orderID, currency string) (ActionStatus, error) {
+func (b *Bithumb) CancelTrade(ctx context.Context, transactionType, orderID, currency string) (ActionStatus, error) {
 	response := ActionStatus{}
 
 	params := url.Values{}
@@ -367,7 +367,7 @@ func (b *Bithumb) CancelTrade(transactionType, orderID, currency string) (Action
 	params.Set("currency", strings.ToUpper(currency))
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(privateCancelTrade, nil, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, privateCancelTrade, nil, &response)
 }
 
 // WithdrawCrypto withdraws a customer currency to an address
@@ -378,7 +378,7 @@ func (b *Bithumb) CancelTrade(transactionType, orderID, currency string) (Action
 // currency: BTC, ETH, DASH, LTC, ETC, XRP, BCH, XMR, ZEC, QTUM
 // (default value: BTC)
 // units: Quantity to withdraw currency
-func (b *Bithumb) WithdrawCrypto(address, destination, currency string, units float64) (ActionStatus, error) {
+func (b *Bithumb) WithdrawCrypto(ctx context.Context, address, destination, currency string, units float64) (ActionStatus, error) {
 	response := ActionStatus{}
 
 	params := url.Values{}
@@ -390,16 +390,16 @@ func (b *Bithumb) WithdrawCrypto(address, destination, currency string, units fl
 	params.Set("units", strconv.FormatFloat(units, 'f', -1, 64))
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(privateBTCWithdraw, params, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, privateBTCWithdraw, params, &response)
 }
 
 // RequestKRWDepositDetails returns Bithumb banking details for deposit
 // information
-func (b *Bithumb) RequestKRWDepositDetails() (KRWDeposit, error) {
+func (b *Bithumb) RequestKRWDepositDetails(ctx context.Context) (KRWDeposit, error) {
 	response := KRWDeposit{}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(privateKRWDeposit, nil, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, privateKRWDeposit, nil, &response)
 }
 
 // RequestKRWWithdraw allows a customer KRW withdrawal request
@@ -407,7 +407,7 @@ func (b *Bithumb) RequestKRWDepositDetails() (KRWDeposit, error) {
 // bank: Bankcode with bank name e.g. (bankcode)_(bankname)
 // account: Withdrawing bank account number
 // price: 	Withdrawing amount
-func (b *Bithumb) RequestKRWWithdraw(bank, account string, price int64) (ActionStatus, error) {
+func (b *Bithumb) RequestKRWWithdraw(ctx context.Context, bank, account string, price int64) (ActionStatus, error) {
 	response := ActionStatus{}
 
 	params := url.Values{}
@@ -416,7 +416,7 @@ func (b *Bithumb) RequestKRWWithdraw(bank, account string, price int64) (ActionS
 	params.Set("price", strconv.FormatInt(price, 10))
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(privateKRWWithdraw, params, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, privateKRWWithdraw, params, &response)
 }
 
 // MarketBuyOrder initiates a buy order through available order books
@@ -424,7 +424,7 @@ func (b *Bithumb) RequestKRWWithdraw(bank, account string, price int64) (ActionS
 // currency: BTC, ETH, DASH, LTC, ETC, XRP, BCH, XMR, ZEC, QTUM, BTG, EOS
 // (default value: BTC)
 // units: Order quantity
-func (b *Bithumb) MarketBuyOrder(currency string, units float64) (MarketBuy, error) {
+func (b *Bithumb) MarketBuyOrder(ctx context.Context, currency string, units float64) (MarketBuy, error) {
 	response := MarketBuy{}
 
 	params := url.Values{}
@@ -432,7 +432,7 @@ func (b *Bithumb) MarketBuyOrder(currency string, units float64) (MarketBuy, err
 	params.Set("units", strconv.FormatFloat(units, 'f', -1, 64))
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(privateMarketBuy, params, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, privateMarketBuy, params, &response)
 }
 
 // MarketSellOrder initiates a sell order through available order books
@@ -440,7 +440,7 @@ func (b *Bithumb) MarketBuyOrder(currency string, units float64) (MarketBuy, err
 // currency: BTC, ETH, DASH, LTC, ETC, XRP, BCH, XMR, ZEC, QTUM, BTG, EOS
 // (default value: BTC)
 // units: Order quantity
-func (b *Bithumb) MarketSellOrder(currency string, units float64) (MarketSell, error) {
+func (b *Bithumb) MarketSellOrder(ctx context.Context, currency string, units float64) (MarketSell, error) {
 	response := MarketSell{}
 
 	params := url.Values{}
@@ -448,12 +448,12 @@ func (b *Bithumb) MarketSellOrder(currency string, units float64) (MarketSell, e
 	params.Set("units", strconv.FormatFloat(units, 'f', -1, 64))
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(privateMarketSell, params, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, privateMarketSell, params, &response)
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (b *Bithumb) SendHTTPRequest(path string, result interface{}) error {
-	return b.SendPayload(context.Background(), &request.Item{
+func (b *Bithumb) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return b.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -464,7 +464,7 @@ func (b *Bithumb) SendHTTPRequest(path string, result interface{}) error {
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated HTTP request to bithumb
-func (b *Bithumb) SendAuthenticatedHTTPRequest(path string, params url.Values, result interface{}) error {
+func (b *Bithumb) SendAuthenticatedHTTPRequest(ctx context.Context, path string, params url.Values, result interface{}) error {
 	if !b.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, b.Name)
 	}
@@ -496,7 +496,7 @@ func (b *Bithumb) SendAuthenticatedHTTPRequest(path string, params url.Values, r
 		Message string `json:"message"`
 	}{}
 
-	err := b.SendPayload(context.Background(), &request.Item{
+	err := b.SendPayload(ctx, &request.Item{
 		Method:        http.MethodPost,
 		Path:          b.API.Endpoints.URL + path,
 		Headers:       headers,
@@ -602,8 +602,8 @@ var errCode = map[string]string{
 }
 
 // GetCandleStick returns candle stick data for requested pair
-func (b *Bithumb) GetCandleStick(symbol, interval string) (resp OHLCVResponse, err error) {
+func (b *Bithumb) GetCandleStick(ctx context.Context, symbol, interval string) (resp OHLCVResponse, err error) {
 	path := b.API.Endpoints.URL + publicCandleStick + symbol + "/" + interval
-	err = b.SendHTTPRequest(path, &resp)
+	err = b.SendHTTPRequest(ctx, path, &resp)
 	return
 }
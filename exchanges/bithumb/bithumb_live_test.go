@@ -0,0 +1,41 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package bithumb
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	b.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Bithumb load config error", err)
+	}
+	bitConfig, err := cfg.GetExchangeConfig("Bithumb")
+	if err != nil {
+		log.Fatal("Bithumb Setup() init error")
+	}
+
+	bitConfig.API.AuthenticatedSupport = true
+	bitConfig.API.Credentials.Key = apiKey
+	bitConfig.API.Credentials.Secret = apiSecret
+
+	err = b.Setup(bitConfig)
+	if err != nil {
+		log.Fatal("Bithumb setup error", err)
+	}
+
+	log.Printf(sharedtestvalues.LiveTesting, b.Name, b.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
@@ -0,0 +1,55 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package bithumb
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/bithumb/bithumb.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	b.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Bithumb load config error", err)
+	}
+	bitConfig, err := cfg.GetExchangeConfig("Bithumb")
+	if err != nil {
+		log.Fatal("Bithumb Setup() init error")
+	}
+
+	bitConfig.API.AuthenticatedSupport = true
+	bitConfig.API.Credentials.Key = apiKey
+	bitConfig.API.Credentials.Secret = apiSecret
+
+	err = b.Setup(bitConfig)
+	if err != nil {
+		log.Fatal("Bithumb setup error", err)
+	}
+
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+
+	b.HTTPClient = newClient
+	b.API.Endpoints.URL = serverDetails
+	log.Printf(sharedtestvalues.MockTesting, b.Name, b.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
@@ -1,13 +1,11 @@
 package bithumb
 
 import (
-	"log"
-	"os"
+	"context"
 	"testing"
 	"time"
 
 	"github.com/yurulab/gocryptotrader/common"
-	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/core"
 	"github.com/yurulab/gocryptotrader/currency"
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
@@ -28,33 +26,9 @@ const (
 
 var b Bithumb
 
-func TestMain(m *testing.M) {
-	b.SetDefaults()
-	cfg := config.GetConfig()
-	err := cfg.LoadConfig("../../testdata/configtest.json", true)
-	if err != nil {
-		log.Fatal("Bithumb load config error", err)
-	}
-	bitConfig, err := cfg.GetExchangeConfig("Bithumb")
-	if err != nil {
-		log.Fatal("Bithumb Setup() init error")
-	}
-
-	bitConfig.API.AuthenticatedSupport = true
-	bitConfig.API.Credentials.Key = apiKey
-	bitConfig.API.Credentials.Secret = apiSecret
-
-	err = b.Setup(bitConfig)
-	if err != nil {
-		log.Fatal("Bithumb setup error", err)
-	}
-
-	os.Exit(m.Run())
-}
-
 func TestGetTradablePairs(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTradablePairs()
+	_, err := b.GetTradablePairs(context.Background())
 	if err != nil {
 		t.Error("Bithumb GetTradablePairs() error", err)
 	}
@@ -62,7 +36,7 @@ func TestGetTradablePairs(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTicker(testCurrency)
+	_, err := b.GetTicker(context.Background(), testCurrency)
 	if err != nil {
 		t.Error("Bithumb GetTicker() error", err)
 	}
@@ -70,7 +44,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetAllTickers(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetAllTickers()
+	_, err := b.GetAllTickers(context.Background())
 	if err != nil {
 		t.Error("Bithumb GetAllTickers() error", err)
 	}
@@ -78,7 +52,7 @@ func TestGetAllTickers(t *testing.T) {
 
 func TestGetOrderBook(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrderBook(testCurrency)
+	_, err := b.GetOrderBook(context.Background(), testCurrency)
 	if err != nil {
 		t.Error("Bithumb GetOrderBook() error", err)
 	}
@@ -86,7 +60,7 @@ func TestGetOrderBook(t *testing.T) {
 
 func TestGetTransactionHistory(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTransactionHistory(testCurrency)
+	_, err := b.GetTransactionHistory(context.Background(), testCurrency)
 	if err != nil {
 		t.Error("Bithumb GetTransactionHistory() error", err)
 	}
@@ -98,7 +72,7 @@ func TestGetAccountBalance(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := b.GetAccountBalance(testCurrency)
+	_, err := b.GetAccountBalance(context.Background(), testCurrency)
 	if err == nil {
 		t.Error("Bithumb GetAccountBalance() Expected error")
 	}
@@ -110,7 +84,7 @@ func TestGetWalletAddress(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := b.GetWalletAddress("")
+	_, err := b.GetWalletAddress(context.Background(), "")
 	if err == nil {
 		t.Error("Bithumb GetWalletAddress() Expected error")
 	}
@@ -118,7 +92,7 @@ func TestGetWalletAddress(t *testing.T) {
 
 func TestGetLastTransaction(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetLastTransaction()
+	_, err := b.GetLastTransaction(context.Background())
 	if err == nil {
 		t.Error("Bithumb GetLastTransaction() Expected error")
 	}
@@ -126,7 +100,7 @@ func TestGetLastTransaction(t *testing.T) {
 
 func TestGetOrders(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrders("1337", order.Bid.Lower(), "100", "", testCurrency)
+	_, err := b.GetOrders(context.Background(), "1337", order.Bid.Lower(), "100", "", testCurrency)
 	if err == nil {
 		t.Error("Bithumb GetOrders() Expected error")
 	}
@@ -134,7 +108,7 @@ func TestGetOrders(t *testing.T) {
 
 func TestGetUserTransactions(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetUserTransactions()
+	_, err := b.GetUserTransactions(context.Background())
 	if err == nil {
 		t.Error("Bithumb GetUserTransactions() Expected error")
 	}
@@ -142,7 +116,7 @@ func TestGetUserTransactions(t *testing.T) {
 
 func TestPlaceTrade(t *testing.T) {
 	t.Parallel()
-	_, err := b.PlaceTrade(testCurrency, order.Bid.Lower(), 0, 0)
+	_, err := b.PlaceTrade(context.Background(), testCurrency, order.Bid.Lower(), 0, 0)
 	if err == nil {
 		t.Error("Bithumb PlaceTrade() Expected error")
 	}
@@ -150,7 +124,7 @@ func TestPlaceTrade(t *testing.T) {
 
 func TestGetOrderDetails(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrderDetails("1337", order.Bid.Lower(), testCurrency)
+	_, err := b.GetOrderDetails(context.Background(), "1337", order.Bid.Lower(), testCurrency)
 	if err == nil {
 		t.Error("Bithumb GetOrderDetails() Expected error")
 	}
@@ -158,7 +132,7 @@ func TestGetOrderDetails(t *testing.T) {
 
 func TestCancelTrade(t *testing.T) {
 	t.Parallel()
-	_, err := b.CancelTrade("", "", "")
+	_, err := b.CancelTrade(context.Background(), "", "", "")
 	if err == nil {
 		t.Error("Bithumb CancelTrade() Expected error")
 	}
@@ -166,7 +140,7 @@ func TestCancelTrade(t *testing.T) {
 
 func TestWithdrawCrypto(t *testing.T) {
 	t.Parallel()
-	_, err := b.WithdrawCrypto("LQxiDhKU7idKiWQhx4ALKYkBx8xKEQVxJR", "", "ltc", 0)
+	_, err := b.WithdrawCrypto(context.Background(), "LQxiDhKU7idKiWQhx4ALKYkBx8xKEQVxJR", "", "ltc", 0)
 	if err == nil {
 		t.Error("Bithumb WithdrawCrypto() Expected error")
 	}
@@ -177,7 +151,7 @@ func TestRequestKRWDepositDetails(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := b.RequestKRWDepositDetails()
+	_, err := b.RequestKRWDepositDetails(context.Background())
 	if err == nil {
 		t.Error("Bithumb RequestKRWDepositDetails() Expected error")
 	}
@@ -185,7 +159,7 @@ func TestRequestKRWDepositDetails(t *testing.T) {
 
 func TestRequestKRWWithdraw(t *testing.T) {
 	t.Parallel()
-	_, err := b.RequestKRWWithdraw("102_bank", "1337", 1000)
+	_, err := b.RequestKRWWithdraw(context.Background(), "102_bank", "1337", 1000)
 	if err == nil {
 		t.Error("Bithumb RequestKRWWithdraw() Expected error")
 	}
@@ -193,7 +167,7 @@ func TestRequestKRWWithdraw(t *testing.T) {
 
 func TestMarketBuyOrder(t *testing.T) {
 	t.Parallel()
-	_, err := b.MarketBuyOrder(testCurrency, 0)
+	_, err := b.MarketBuyOrder(context.Background(), testCurrency, 0)
 	if err == nil {
 		t.Error("Bithumb MarketBuyOrder() Expected error")
 	}
@@ -201,7 +175,7 @@ func TestMarketBuyOrder(t *testing.T) {
 
 func TestMarketSellOrder(t *testing.T) {
 	t.Parallel()
-	_, err := b.MarketSellOrder(testCurrency, 0)
+	_, err := b.MarketSellOrder(context.Background(), testCurrency, 0)
 	if err == nil {
 		t.Error("Bithumb MarketSellOrder() Expected error")
 	}
@@ -209,13 +183,13 @@ func TestMarketSellOrder(t *testing.T) {
 
 func TestUpdateTicker(t *testing.T) {
 	cp := currency.NewPair(currency.QTUM, currency.KRW)
-	_, err := b.UpdateTicker(cp, asset.Spot)
+	_, err := b.UpdateTicker(context.Background(), cp, asset.Spot)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	cp = currency.NewPair(currency.DASH, currency.KRW)
-	_, err = b.UpdateTicker(cp, asset.Spot)
+	_, err = b.UpdateTicker(context.Background(), cp, asset.Spot)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -233,7 +207,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	b.GetFeeByType(feeBuilder)
+	b.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -329,7 +303,7 @@ func TestGetActiveOrders(t *testing.T) {
 		Side: order.Sell,
 	}
 
-	_, err := b.GetActiveOrders(&getOrdersRequest)
+	_, err := b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -343,7 +317,7 @@ func TestGetOrderHistory(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := b.GetOrderHistory(&getOrdersRequest)
+	_, err := b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -374,7 +348,7 @@ func TestSubmitOrder(t *testing.T) {
 		Amount:   1,
 		ClientID: "meowOrder",
 	}
-	response, err := b.SubmitOrder(orderSubmission)
+	response, err := b.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -396,7 +370,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -419,7 +393,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	resp, err := b.CancelAllOrders(orderCancellation)
+	resp, err := b.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -436,12 +410,12 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 func TestGetAccountInfo(t *testing.T) {
 	t.Parallel()
 	if areTestAPIKeysSet() {
-		_, err := b.UpdateAccountInfo()
+		_, err := b.UpdateAccountInfo(context.Background())
 		if err != nil {
 			t.Error("Bithumb GetAccountInfo() error", err)
 		}
 	} else {
-		_, err := b.UpdateAccountInfo()
+		_, err := b.UpdateAccountInfo(context.Background())
 		if err == nil {
 			t.Error("Bithumb GetAccountInfo() Expected error")
 		}
@@ -454,7 +428,7 @@ func TestModifyOrder(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = b.ModifyOrder(&order.Modify{
+	_, err = b.ModifyOrder(context.Background(), &order.Modify{
 		ID:     "1337",
 		Price:  100,
 		Amount: 1000,
@@ -480,7 +454,7 @@ func TestWithdraw(t *testing.T) {
 		},
 	}
 
-	_, err := b.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := b.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -507,7 +481,7 @@ func TestWithdrawFiat(t *testing.T) {
 		Description: "WITHDRAW IT ALL",
 	}
 
-	_, err := b.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -523,7 +497,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.Request{}
-	_, err := b.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -532,12 +506,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
 	if areTestAPIKeysSet() {
-		_, err := b.GetDepositAddress(currency.BTC, "")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err != nil {
 			t.Error("GetDepositAddress() error", err)
 		}
 	} else {
-		_, err := b.GetDepositAddress(currency.BTC, "")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err == nil {
 			t.Error("GetDepositAddress() error cannot be nil")
 		}
@@ -545,7 +519,7 @@ func TestGetDepositAddress(t *testing.T) {
 }
 
 func TestGetCandleStick(t *testing.T) {
-	_, err := b.GetCandleStick("BTC_KRW", "1m")
+	_, err := b.GetCandleStick(context.Background(), "BTC_KRW", "1m")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -557,7 +531,7 @@ func TestGetHistoricCandles(t *testing.T) {
 		t.Fatal(err)
 	}
 	startTime := time.Now().Add(-time.Hour * 24)
-	_, err = b.GetHistoricCandles(currencyPair, asset.Spot, startTime, time.Now(), kline.OneDay)
+	_, err = b.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.OneDay)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -569,7 +543,7 @@ func TestGetHistoricCandlesExtended(t *testing.T) {
 		t.Fatal(err)
 	}
 	startTime := time.Now().Add(-time.Hour * 24)
-	_, err = b.GetHistoricCandlesExtended(currencyPair, asset.Spot, startTime, time.Now(), kline.OneDay)
+	_, err = b.GetHistoricCandlesExtended(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.OneDay)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -103,104 +103,104 @@ const (
 )
 
 // GetAnnouncement returns the general announcements from Bitmex
-func (b *Bitmex) GetAnnouncement() ([]Announcement, error) {
+func (b *Bitmex) GetAnnouncement(ctx context.Context) ([]Announcement, error) {
 	var announcement []Announcement
 
-	return announcement, b.SendHTTPRequest(bitmexEndpointAnnouncement,
+	return announcement, b.SendHTTPRequest(ctx, bitmexEndpointAnnouncement,
 		nil,
 		&announcement)
 }
 
 // GetUrgentAnnouncement returns an urgent announcement for your account
-func (b *Bitmex) GetUrgentAnnouncement() ([]Announcement, error) {
+func (b *Bitmex) GetUrgentAnnouncement(ctx context.Context) ([]Announcement, error) {
 	var announcement []Announcement
 
-	return announcement, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return announcement, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointAnnouncementUrgent,
 		nil,
 		&announcement)
 }
 
 // GetAPIKeys returns the APIkeys from bitmex
-func (b *Bitmex) GetAPIKeys() ([]APIKey, error) {
+func (b *Bitmex) GetAPIKeys(ctx context.Context) ([]APIKey, error) {
 	var keys []APIKey
 
-	return keys, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return keys, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointAPIkeys,
 		nil,
 		&keys)
 }
 
 // RemoveAPIKey removes an Apikey from the bitmex trading engine
-func (b *Bitmex) RemoveAPIKey(params APIKeyParams) (bool, error) {
+func (b *Bitmex) RemoveAPIKey(ctx context.Context, params APIKeyParams) (bool, error) {
 	var keyDeleted bool
 
-	return keyDeleted, b.SendAuthenticatedHTTPRequest(http.MethodDelete,
+	return keyDeleted, b.SendAuthenticatedHTTPRequest(ctx, http.MethodDelete,
 		bitmexEndpointAPIkeys,
 		&params,
 		&keyDeleted)
 }
 
 // DisableAPIKey disables an Apikey from the bitmex trading engine
-func (b *Bitmex) DisableAPIKey(params APIKeyParams) (APIKey, error) {
+func (b *Bitmex) DisableAPIKey(ctx context.Context, params APIKeyParams) (APIKey, error) {
 	var keyInfo APIKey
 
-	return keyInfo, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return keyInfo, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointDisableAPIkey,
 		&params,
 		&keyInfo)
 }
 
 // EnableAPIKey enables an Apikey from the bitmex trading engine
-func (b *Bitmex) EnableAPIKey(params APIKeyParams) (APIKey, error) {
+func (b *Bitmex) EnableAPIKey(ctx context.Context, params APIKeyParams) (APIKey, error) {
 	var keyInfo APIKey
 
-	return keyInfo, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return keyInfo, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointEnableAPIkey,
 		&params,
 		&keyInfo)
 }
 
 // GetTrollboxMessages returns messages from the bitmex trollbox
-func (b *Bitmex) GetTrollboxMessages(params ChatGetParams) ([]Chat, error) {
+func (b *Bitmex) GetTrollboxMessages(ctx context.Context, params ChatGetParams) ([]Chat, error) {
 	var messages []Chat
 
-	return messages, b.SendHTTPRequest(bitmexEndpointTrollbox, &params, &messages)
+	return messages, b.SendHTTPRequest(ctx, bitmexEndpointTrollbox, &params, &messages)
 }
 
 // SendTrollboxMessage sends a message to the bitmex trollbox
-func (b *Bitmex) SendTrollboxMessage(params ChatSendParams) ([]Chat, error) {
+func (b *Bitmex) SendTrollboxMessage(ctx context.Context, params ChatSendParams) ([]Chat, error) {
 	var messages []Chat
 
-	return messages, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return messages, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointTrollboxSend,
 		&params,
 		&messages)
 }
 
 // GetTrollboxChannels the channels from the the bitmex trollbox
-func (b *Bitmex) GetTrollboxChannels() ([]ChatChannel, error) {
+func (b *Bitmex) GetTrollboxChannels(ctx context.Context) ([]ChatChannel, error) {
 	var channels []ChatChannel
 
-	return channels, b.SendHTTPRequest(bitmexEndpointTrollboxChannels,
+	return channels, b.SendHTTPRequest(ctx, bitmexEndpointTrollboxChannels,
 		nil,
 		&channels)
 }
 
 // GetTrollboxConnectedUsers the channels from the the bitmex trollbox
-func (b *Bitmex) GetTrollboxConnectedUsers() (ConnectedUsers, error) {
+func (b *Bitmex) GetTrollboxConnectedUsers(ctx context.Context) (ConnectedUsers, error) {
 	var users ConnectedUsers
 
-	return users, b.SendHTTPRequest(bitmexEndpointTrollboxConnected, nil, &users)
+	return users, b.SendHTTPRequest(ctx, bitmexEndpointTrollboxConnected, nil, &users)
 }
 
 // GetAccountExecutions returns all raw transactions, which includes order
 // opening and cancelation, and order status changes. It can be quite noisy.
 // More focused information is available at /execution/tradeHistory.
-func (b *Bitmex) GetAccountExecutions(params *GenericRequestParams) ([]Execution, error) {
+func (b *Bitmex) GetAccountExecutions(ctx context.Context, params *GenericRequestParams) ([]Execution, error) {
 	var executionList []Execution
 
-	return executionList, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return executionList, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointExecution,
 		params,
 		&executionList)
@@ -208,141 +208,141 @@ func (b *Bitmex) GetAccountExecutions(params *GenericRequestParams) ([]Execution
 
 // GetAccountExecutionTradeHistory returns all balance-affecting executions.
 // This includes each trade, insurance charge, and settlement.
-func (b *Bitmex) GetAccountExecutionTradeHistory(params *GenericRequestParams) ([]Execution, error) {
+func (b *Bitmex) GetAccountExecutionTradeHistory(ctx context.Context, params *GenericRequestParams) ([]Execution, error) {
 	var tradeHistory []Execution
 
-	return tradeHistory, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return tradeHistory, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointExecutionTradeHistory,
 		params,
 		&tradeHistory)
 }
 
 // GetFullFundingHistory returns funding history
-func (b *Bitmex) GetFullFundingHistory() ([]Funding, error) {
+func (b *Bitmex) GetFullFundingHistory(ctx context.Context) ([]Funding, error) {
 	var fundingHistory []Funding
 
-	return fundingHistory, b.SendHTTPRequest(bitmexEndpointFundingHistory,
+	return fundingHistory, b.SendHTTPRequest(ctx, bitmexEndpointFundingHistory,
 		nil,
 		&fundingHistory)
 }
 
 // GetInstruments returns instrument data
-func (b *Bitmex) GetInstruments(params *GenericRequestParams) ([]Instrument, error) {
+func (b *Bitmex) GetInstruments(ctx context.Context, params *GenericRequestParams) ([]Instrument, error) {
 	var instruments []Instrument
 
-	return instruments, b.SendHTTPRequest(bitmexEndpointInstruments,
+	return instruments, b.SendHTTPRequest(ctx, bitmexEndpointInstruments,
 		params,
 		&instruments)
 }
 
 // GetActiveInstruments returns active instruments
-func (b *Bitmex) GetActiveInstruments(params *GenericRequestParams) ([]Instrument, error) {
+func (b *Bitmex) GetActiveInstruments(ctx context.Context, params *GenericRequestParams) ([]Instrument, error) {
 	var activeInstruments []Instrument
 
-	return activeInstruments, b.SendHTTPRequest(bitmexEndpointActiveInstruments,
+	return activeInstruments, b.SendHTTPRequest(ctx, bitmexEndpointActiveInstruments,
 		params,
 		&activeInstruments)
 }
 
 // GetActiveAndIndexInstruments returns all active instruments and all indices
-func (b *Bitmex) GetActiveAndIndexInstruments() ([]Instrument, error) {
+func (b *Bitmex) GetActiveAndIndexInstruments(ctx context.Context) ([]Instrument, error) {
 	var activeAndIndices []Instrument
 
 	return activeAndIndices,
-		b.SendHTTPRequest(bitmexEndpointActiveAndIndexInstruments,
+		b.SendHTTPRequest(ctx, bitmexEndpointActiveAndIndexInstruments,
 			nil,
 			&activeAndIndices)
 }
 
 // GetActiveIntervals returns funding history
-func (b *Bitmex) GetActiveIntervals() (InstrumentInterval, error) {
+func (b *Bitmex) GetActiveIntervals(ctx context.Context) (InstrumentInterval, error) {
 	var interval InstrumentInterval
 
-	return interval, b.SendHTTPRequest(bitmexEndpointActiveIntervals,
+	return interval, b.SendHTTPRequest(ctx, bitmexEndpointActiveIntervals,
 		nil,
 		&interval)
 }
 
 // GetCompositeIndex returns composite index
-func (b *Bitmex) GetCompositeIndex(params *GenericRequestParams) ([]IndexComposite, error) {
+func (b *Bitmex) GetCompositeIndex(ctx context.Context, params *GenericRequestParams) ([]IndexComposite, error) {
 	var compositeIndices []IndexComposite
 
-	return compositeIndices, b.SendHTTPRequest(bitmexEndpointCompositeIndex,
+	return compositeIndices, b.SendHTTPRequest(ctx, bitmexEndpointCompositeIndex,
 		params,
 		&compositeIndices)
 }
 
 // GetIndices returns all price indices
-func (b *Bitmex) GetIndices() ([]Instrument, error) {
+func (b *Bitmex) GetIndices(ctx context.Context) ([]Instrument, error) {
 	var indices []Instrument
 
-	return indices, b.SendHTTPRequest(bitmexEndpointIndices, nil, &indices)
+	return indices, b.SendHTTPRequest(ctx, bitmexEndpointIndices, nil, &indices)
 }
 
 // GetInsuranceFundHistory returns insurance fund history
-func (b *Bitmex) GetInsuranceFundHistory(params *GenericRequestParams) ([]Insurance, error) {
+func (b *Bitmex) GetInsuranceFundHistory(ctx context.Context, params *GenericRequestParams) ([]Insurance, error) {
 	var history []Insurance
 
-	return history, b.SendHTTPRequest(bitmexEndpointIndices, params, &history)
+	return history, b.SendHTTPRequest(ctx, bitmexEndpointIndices, params, &history)
 }
 
 // GetLeaderboard returns leaderboard information
-func (b *Bitmex) GetLeaderboard(params LeaderboardGetParams) ([]Leaderboard, error) {
+func (b *Bitmex) GetLeaderboard(ctx context.Context, params LeaderboardGetParams) ([]Leaderboard, error) {
 	var leader []Leaderboard
 
-	return leader, b.SendHTTPRequest(bitmexEndpointLeader, params, &leader)
+	return leader, b.SendHTTPRequest(ctx, bitmexEndpointLeader, params, &leader)
 }
 
 // GetAliasOnLeaderboard returns your alias on the leaderboard
-func (b *Bitmex) GetAliasOnLeaderboard() (Alias, error) {
+func (b *Bitmex) GetAliasOnLeaderboard(ctx context.Context) (Alias, error) {
 	var alias Alias
 
-	return alias, b.SendHTTPRequest(bitmexEndpointAlias, nil, &alias)
+	return alias, b.SendHTTPRequest(ctx, bitmexEndpointAlias, nil, &alias)
 }
 
 // GetLiquidationOrders returns liquidation orders
-func (b *Bitmex) GetLiquidationOrders(params *GenericRequestParams) ([]Liquidation, error) {
+func (b *Bitmex) GetLiquidationOrders(ctx context.Context, params *GenericRequestParams) ([]Liquidation, error) {
 	var orders []Liquidation
 
-	return orders, b.SendHTTPRequest(bitmexEndpointLiquidation,
+	return orders, b.SendHTTPRequest(ctx, bitmexEndpointLiquidation,
 		params,
 		&orders)
 }
 
 // GetCurrentNotifications returns your current notifications
-func (b *Bitmex) GetCurrentNotifications() ([]Notification, error) {
+func (b *Bitmex) GetCurrentNotifications(ctx context.Context) ([]Notification, error) {
 	var notifications []Notification
 
-	return notifications, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return notifications, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointNotifications,
 		nil,
 		&notifications)
 }
 
 // GetOrders returns all the orders, open and closed
-func (b *Bitmex) GetOrders(params *OrdersRequest) ([]Order, error) {
+func (b *Bitmex) GetOrders(ctx context.Context, params *OrdersRequest) ([]Order, error) {
 	var orders []Order
-	return orders, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return orders, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointOrder,
 		params,
 		&orders)
 }
 
 // AmendOrder amends the quantity or price of an open order
-func (b *Bitmex) AmendOrder(params *OrderAmendParams) (Order, error) {
+func (b *Bitmex) AmendOrder(ctx context.Context, params *OrderAmendParams) (Order, error) {
 	var order Order
 
-	return order, b.SendAuthenticatedHTTPRequest(http.MethodPut,
+	return order, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPut,
 		bitmexEndpointOrder,
 		params,
 		&order)
 }
 
 // CreateOrder creates a new order
-func (b *Bitmex) CreateOrder(params *OrderNewParams) (Order, error) {
+func (b *Bitmex) CreateOrder(ctx context.Context, params *OrderNewParams) (Order, error) {
 	var orderInfo Order
 
-	return orderInfo, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return orderInfo, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointOrder,
 		params,
 		&orderInfo)
@@ -350,223 +350,223 @@ func (b *Bitmex) CreateOrder(params *OrderNewParams) (Order, error) {
 
 // CancelOrders cancels one or a batch of orders on the exchange and returns
 // a cancelled order list
-func (b *Bitmex) CancelOrders(params *OrderCancelParams) ([]Order, error) {
+func (b *Bitmex) CancelOrders(ctx context.Context, params *OrderCancelParams) ([]Order, error) {
 	var cancelledOrders []Order
 
-	return cancelledOrders, b.SendAuthenticatedHTTPRequest(http.MethodDelete,
+	return cancelledOrders, b.SendAuthenticatedHTTPRequest(ctx, http.MethodDelete,
 		bitmexEndpointOrder,
 		params,
 		&cancelledOrders)
 }
 
 // CancelAllExistingOrders cancels all open orders on the exchange
-func (b *Bitmex) CancelAllExistingOrders(params OrderCancelAllParams) ([]Order, error) {
+func (b *Bitmex) CancelAllExistingOrders(ctx context.Context, params OrderCancelAllParams) ([]Order, error) {
 	var cancelledOrders []Order
 
-	return cancelledOrders, b.SendAuthenticatedHTTPRequest(http.MethodDelete,
+	return cancelledOrders, b.SendAuthenticatedHTTPRequest(ctx, http.MethodDelete,
 		bitmexEndpointCancelAllOrders,
 		params,
 		&cancelledOrders)
 }
 
 // AmendBulkOrders amends multiple orders for the same symbol
-func (b *Bitmex) AmendBulkOrders(params OrderAmendBulkParams) ([]Order, error) {
+func (b *Bitmex) AmendBulkOrders(ctx context.Context, params OrderAmendBulkParams) ([]Order, error) {
 	var amendedOrders []Order
 
-	return amendedOrders, b.SendAuthenticatedHTTPRequest(http.MethodPut,
+	return amendedOrders, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPut,
 		bitmexEndpointBulk,
 		params,
 		&amendedOrders)
 }
 
 // CreateBulkOrders creates multiple orders for the same symbol
-func (b *Bitmex) CreateBulkOrders(params OrderNewBulkParams) ([]Order, error) {
+func (b *Bitmex) CreateBulkOrders(ctx context.Context, params OrderNewBulkParams) ([]Order, error) {
 	var orders []Order
 
-	return orders, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return orders, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointBulk,
 		params,
 		&orders)
 }
 
 // CancelAllOrdersAfterTime closes all positions after a certain time period
-func (b *Bitmex) CancelAllOrdersAfterTime(params OrderCancelAllAfterParams) ([]Order, error) {
+func (b *Bitmex) CancelAllOrdersAfterTime(ctx context.Context, params OrderCancelAllAfterParams) ([]Order, error) {
 	var cancelledOrder []Order
 
-	return cancelledOrder, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return cancelledOrder, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointCancelOrderAfter,
 		params,
 		&cancelledOrder)
 }
 
 // ClosePosition closes a position WARNING deprecated use /order endpoint
-func (b *Bitmex) ClosePosition(params OrderClosePositionParams) ([]Order, error) {
+func (b *Bitmex) ClosePosition(ctx context.Context, params OrderClosePositionParams) ([]Order, error) {
 	var closedPositions []Order
 
-	return closedPositions, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return closedPositions, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointOrder,
 		params,
 		&closedPositions)
 }
 
 // GetOrderbook returns layer two orderbook data
-func (b *Bitmex) GetOrderbook(params OrderBookGetL2Params) ([]OrderBookL2, error) {
+func (b *Bitmex) GetOrderbook(ctx context.Context, params OrderBookGetL2Params) ([]OrderBookL2, error) {
 	var orderBooks []OrderBookL2
 
-	return orderBooks, b.SendHTTPRequest(bitmexEndpointOrderbookL2,
+	return orderBooks, b.SendHTTPRequest(ctx, bitmexEndpointOrderbookL2,
 		params,
 		&orderBooks)
 }
 
 // GetPositions returns positions
-func (b *Bitmex) GetPositions(params PositionGetParams) ([]Position, error) {
+func (b *Bitmex) GetPositions(ctx context.Context, params PositionGetParams) ([]Position, error) {
 	var positions []Position
 
-	return positions, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return positions, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointPosition,
 		params,
 		&positions)
 }
 
 // IsolatePosition enables isolated margin or cross margin per-position
-func (b *Bitmex) IsolatePosition(params PositionIsolateMarginParams) (Position, error) {
+func (b *Bitmex) IsolatePosition(ctx context.Context, params PositionIsolateMarginParams) (Position, error) {
 	var position Position
 
-	return position, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return position, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointIsolatePosition,
 		params,
 		&position)
 }
 
 // LeveragePosition chooses leverage for a position
-func (b *Bitmex) LeveragePosition(params PositionUpdateLeverageParams) (Position, error) {
+func (b *Bitmex) LeveragePosition(ctx context.Context, params PositionUpdateLeverageParams) (Position, error) {
 	var position Position
 
-	return position, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return position, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointLeveragePosition,
 		params,
 		&position)
 }
 
 // UpdateRiskLimit updates risk limit on a position
-func (b *Bitmex) UpdateRiskLimit(params PositionUpdateRiskLimitParams) (Position, error) {
+func (b *Bitmex) UpdateRiskLimit(ctx context.Context, params PositionUpdateRiskLimitParams) (Position, error) {
 	var position Position
 
-	return position, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return position, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointAdjustRiskLimit,
 		params,
 		&position)
 }
 
 // TransferMargin transfers equity in or out of a position
-func (b *Bitmex) TransferMargin(params PositionTransferIsolatedMarginParams) (Position, error) {
+func (b *Bitmex) TransferMargin(ctx context.Context, params PositionTransferIsolatedMarginParams) (Position, error) {
 	var position Position
 
-	return position, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return position, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointTransferMargin,
 		params,
 		&position)
 }
 
 // GetQuotes returns quotations
-func (b *Bitmex) GetQuotes(params *GenericRequestParams) ([]Quote, error) {
+func (b *Bitmex) GetQuotes(ctx context.Context, params *GenericRequestParams) ([]Quote, error) {
 	var quotations []Quote
 
-	return quotations, b.SendHTTPRequest(bitmexEndpointQuote,
+	return quotations, b.SendHTTPRequest(ctx, bitmexEndpointQuote,
 		params,
 		&quotations)
 }
 
 // GetQuotesByBuckets returns previous quotes in time buckets
-func (b *Bitmex) GetQuotesByBuckets(params *QuoteGetBucketedParams) ([]Quote, error) {
+func (b *Bitmex) GetQuotesByBuckets(ctx context.Context, params *QuoteGetBucketedParams) ([]Quote, error) {
 	var quotations []Quote
 
-	return quotations, b.SendHTTPRequest(bitmexEndpointQuoteBucketed,
+	return quotations, b.SendHTTPRequest(ctx, bitmexEndpointQuoteBucketed,
 		params,
 		&quotations)
 }
 
 // GetSettlementHistory returns settlement history
-func (b *Bitmex) GetSettlementHistory(params *GenericRequestParams) ([]Settlement, error) {
+func (b *Bitmex) GetSettlementHistory(ctx context.Context, params *GenericRequestParams) ([]Settlement, error) {
 	var history []Settlement
 
-	return history, b.SendHTTPRequest(bitmexEndpointSettlement,
+	return history, b.SendHTTPRequest(ctx, bitmexEndpointSettlement,
 		params,
 		&history)
 }
 
 // GetStats returns exchange wide per series turnover and volume statistics
-func (b *Bitmex) GetStats() ([]Stats, error) {
+func (b *Bitmex) GetStats(ctx context.Context) ([]Stats, error) {
 	var stats []Stats
 
-	return stats, b.SendHTTPRequest(bitmexEndpointStats, nil, &stats)
+	return stats, b.SendHTTPRequest(ctx, bitmexEndpointStats, nil, &stats)
 }
 
 // GetStatsHistorical historic stats
-func (b *Bitmex) GetStatsHistorical() ([]StatsHistory, error) {
+func (b *Bitmex) GetStatsHistorical(ctx context.Context) ([]StatsHistory, error) {
 	var history []StatsHistory
 
-	return history, b.SendHTTPRequest(bitmexEndpointStatsHistory, nil, &history)
+	return history, b.SendHTTPRequest(ctx, bitmexEndpointStatsHistory, nil, &history)
 }
 
 // GetStatSummary returns the stats summary in USD terms
-func (b *Bitmex) GetStatSummary() ([]StatsUSD, error) {
+func (b *Bitmex) GetStatSummary(ctx context.Context) ([]StatsUSD, error) {
 	var summary []StatsUSD
 
-	return summary, b.SendHTTPRequest(bitmexEndpointStatsSummary, nil, &summary)
+	return summary, b.SendHTTPRequest(ctx, bitmexEndpointStatsSummary, nil, &summary)
 }
 
 // GetTrade returns executed trades on the desk
-func (b *Bitmex) GetTrade(params *GenericRequestParams) ([]Trade, error) {
+func (b *Bitmex) GetTrade(ctx context.Context, params *GenericRequestParams) ([]Trade, error) {
 	var trade []Trade
 
-	return trade, b.SendHTTPRequest(bitmexEndpointTrade, params, &trade)
+	return trade, b.SendHTTPRequest(ctx, bitmexEndpointTrade, params, &trade)
 }
 
 // GetPreviousTrades previous trade history in time buckets
-func (b *Bitmex) GetPreviousTrades(params *TradeGetBucketedParams) ([]Trade, error) {
+func (b *Bitmex) GetPreviousTrades(ctx context.Context, params *TradeGetBucketedParams) ([]Trade, error) {
 	var trade []Trade
 
-	return trade, b.SendHTTPRequest(bitmexEndpointTradeBucketed,
+	return trade, b.SendHTTPRequest(ctx, bitmexEndpointTradeBucketed,
 		params,
 		&trade)
 }
 
 // GetUserInfo returns your user information
-func (b *Bitmex) GetUserInfo() (User, error) {
+func (b *Bitmex) GetUserInfo(ctx context.Context) (User, error) {
 	var userInfo User
 
-	return userInfo, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return userInfo, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointUser,
 		nil,
 		&userInfo)
 }
 
 // UpdateUserInfo updates user information
-func (b *Bitmex) UpdateUserInfo(params *UserUpdateParams) (User, error) {
+func (b *Bitmex) UpdateUserInfo(ctx context.Context, params *UserUpdateParams) (User, error) {
 	var userInfo User
 
-	return userInfo, b.SendAuthenticatedHTTPRequest(http.MethodPut,
+	return userInfo, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPut,
 		bitmexEndpointUser,
 		params,
 		&userInfo)
 }
 
 // GetAffiliateStatus returns your affiliate status
-func (b *Bitmex) GetAffiliateStatus() (AffiliateStatus, error) {
+func (b *Bitmex) GetAffiliateStatus(ctx context.Context) (AffiliateStatus, error) {
 	var status AffiliateStatus
 
-	return status, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return status, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointUserAffiliate,
 		nil,
 		&status)
 }
 
 // CancelWithdraw cancels a current withdrawal
-func (b *Bitmex) CancelWithdraw(token string) (TransactionInfo, error) {
+func (b *Bitmex) CancelWithdraw(ctx context.Context, token string) (TransactionInfo, error) {
 	var info TransactionInfo
 
-	return info, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return info, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointUserCancelWithdraw,
 		UserTokenParams{Token: token},
 		&info)
@@ -574,56 +574,56 @@ func (b *Bitmex) CancelWithdraw(token string) (TransactionInfo, error) {
 
 // CheckReferalCode checks a code, will return a percentage eg 0.1 for 10% or
 // if err a 404
-func (b *Bitmex) CheckReferalCode(referralCode string) (float64, error) {
+func (b *Bitmex) CheckReferalCode(ctx context.Context, referralCode string) (float64, error) {
 	var percentage float64
 
-	return percentage, b.SendHTTPRequest(bitmexEndpointUserCheckReferralCode,
+	return percentage, b.SendHTTPRequest(ctx, bitmexEndpointUserCheckReferralCode,
 		UserCheckReferralCodeParams{ReferralCode: referralCode},
 		&percentage)
 }
 
 // GetUserCommision returns your account's commission status.
-func (b *Bitmex) GetUserCommision() (UserCommission, error) {
+func (b *Bitmex) GetUserCommision(ctx context.Context) (UserCommission, error) {
 	var commissionInfo UserCommission
 
-	return commissionInfo, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return commissionInfo, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointUserCommision,
 		nil,
 		&commissionInfo)
 }
 
 // ConfirmEmail confirms email address with a token
-func (b *Bitmex) ConfirmEmail(token string) (ConfirmEmail, error) {
+func (b *Bitmex) ConfirmEmail(ctx context.Context, token string) (ConfirmEmail, error) {
 	var confirmation ConfirmEmail
 
-	return confirmation, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return confirmation, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointUserConfirmEmail,
 		UserTokenParams{Token: token},
 		&confirmation)
 }
 
 // ConfirmTwoFactorAuth confirms 2FA for this account.
-func (b *Bitmex) ConfirmTwoFactorAuth(token, typ string) (bool, error) {
+func (b *Bitmex) ConfirmTwoFactorAuth(ctx context.Context, token, typ string) (bool, error) {
 	var working bool
 
-	return working, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return working, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointUserConfirmTFA,
 		UserConfirmTFAParams{Token: token, Type: typ},
 		&working)
 }
 
 // ConfirmWithdrawal confirms a withdrawal
-func (b *Bitmex) ConfirmWithdrawal(token string) (TransactionInfo, error) {
+func (b *Bitmex) ConfirmWithdrawal(ctx context.Context, token string) (TransactionInfo, error) {
 	var info TransactionInfo
 
-	return info, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return info, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointUserCancelWithdraw,
 		UserTokenParams{Token: token},
 		&info)
 }
 
 // GetCryptoDepositAddress returns a deposit address for a cryptocurency
-func (b *Bitmex) GetCryptoDepositAddress(cryptoCurrency string) (string, error) {
+func (b *Bitmex) GetCryptoDepositAddress(ctx context.Context, cryptoCurrency string) (string, error) {
 	var address string
 
 	if !strings.EqualFold(cryptoCurrency, currency.XBT.String()) {
@@ -632,85 +632,85 @@ func (b *Bitmex) GetCryptoDepositAddress(cryptoCurrency string) (string, error)
 				cryptoCurrency)
 	}
 
-	return address, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return address, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointUserDepositAddress,
 		UserCurrencyParams{Currency: "XBt"},
 		&address)
 }
 
 // DisableTFA dsiables 2 factor authentication for your account
-func (b *Bitmex) DisableTFA(token, typ string) (bool, error) {
+func (b *Bitmex) DisableTFA(ctx context.Context, token, typ string) (bool, error) {
 	var disabled bool
 
-	return disabled, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return disabled, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointUserDisableTFA,
 		UserConfirmTFAParams{Token: token, Type: typ},
 		&disabled)
 }
 
 // UserLogOut logs you out of BitMEX
-func (b *Bitmex) UserLogOut() error {
-	return b.SendAuthenticatedHTTPRequest(http.MethodPost,
+func (b *Bitmex) UserLogOut(ctx context.Context) error {
+	return b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointUserLogout,
 		nil,
 		nil)
 }
 
 // UserLogOutAll logs you out of all systems for BitMEX
-func (b *Bitmex) UserLogOutAll() (int64, error) {
+func (b *Bitmex) UserLogOutAll(ctx context.Context) (int64, error) {
 	var status int64
 
-	return status, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return status, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointUserLogoutAll,
 		nil,
 		&status)
 }
 
 // GetUserMargin returns user margin information
-func (b *Bitmex) GetUserMargin(currency string) (UserMargin, error) {
+func (b *Bitmex) GetUserMargin(ctx context.Context, currency string) (UserMargin, error) {
 	var info UserMargin
 
-	return info, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return info, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointUserMargin,
 		UserCurrencyParams{Currency: currency},
 		&info)
 }
 
 // GetAllUserMargin returns user margin information
-func (b *Bitmex) GetAllUserMargin() ([]UserMargin, error) {
+func (b *Bitmex) GetAllUserMargin(ctx context.Context) ([]UserMargin, error) {
 	var info []UserMargin
 
-	return info, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return info, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointUserMargin,
 		UserCurrencyParams{Currency: "all"},
 		&info)
 }
 
 // GetMinimumWithdrawalFee returns minimum withdrawal fee information
-func (b *Bitmex) GetMinimumWithdrawalFee(currency string) (MinWithdrawalFee, error) {
+func (b *Bitmex) GetMinimumWithdrawalFee(ctx context.Context, currency string) (MinWithdrawalFee, error) {
 	var fee MinWithdrawalFee
 
-	return fee, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return fee, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointUserMinWithdrawalFee,
 		UserCurrencyParams{Currency: currency},
 		&fee)
 }
 
 // GetUserPreferences returns user preferences
-func (b *Bitmex) GetUserPreferences(params UserPreferencesParams) (User, error) {
+func (b *Bitmex) GetUserPreferences(ctx context.Context, params UserPreferencesParams) (User, error) {
 	var userInfo User
 
-	return userInfo, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return userInfo, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointUserPreferences,
 		params,
 		&userInfo)
 }
 
 // EnableTFA enables 2 factor authentication
-func (b *Bitmex) EnableTFA(typ string) (bool, error) {
+func (b *Bitmex) EnableTFA(ctx context.Context, typ string) (bool, error) {
 	var enabled bool
 
-	return enabled, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return enabled, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointUserRequestTFA,
 		UserConfirmTFAParams{Type: typ},
 		&enabled)
@@ -719,47 +719,47 @@ func (b *Bitmex) EnableTFA(typ string) (bool, error) {
 // UserRequestWithdrawal This will send a confirmation email to the email
 // address on record, unless requested via an API Key with the withdraw
 // permission.
-func (b *Bitmex) UserRequestWithdrawal(params UserRequestWithdrawalParams) (TransactionInfo, error) {
+func (b *Bitmex) UserRequestWithdrawal(ctx context.Context, params UserRequestWithdrawalParams) (TransactionInfo, error) {
 	var info TransactionInfo
 
-	return info, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return info, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitmexEndpointUserRequestWithdraw,
 		params,
 		&info)
 }
 
 // GetWalletInfo returns user wallet information
-func (b *Bitmex) GetWalletInfo(currency string) (WalletInfo, error) {
+func (b *Bitmex) GetWalletInfo(ctx context.Context, currency string) (WalletInfo, error) {
 	var info WalletInfo
 
-	return info, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return info, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointUserWallet,
 		UserCurrencyParams{Currency: currency},
 		&info)
 }
 
 // GetWalletHistory returns user wallet history transaction data
-func (b *Bitmex) GetWalletHistory(currency string) ([]TransactionInfo, error) {
+func (b *Bitmex) GetWalletHistory(ctx context.Context, currency string) ([]TransactionInfo, error) {
 	var info []TransactionInfo
 
-	return info, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return info, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointUserWalletHistory,
 		UserCurrencyParams{Currency: currency},
 		&info)
 }
 
 // GetWalletSummary returns user wallet summary
-func (b *Bitmex) GetWalletSummary(currency string) ([]TransactionInfo, error) {
+func (b *Bitmex) GetWalletSummary(ctx context.Context, currency string) ([]TransactionInfo, error) {
 	var info []TransactionInfo
 
-	return info, b.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return info, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		bitmexEndpointUserWalletSummary,
 		UserCurrencyParams{Currency: currency},
 		&info)
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (b *Bitmex) SendHTTPRequest(path string, params Parameter, result interface{}) error {
+func (b *Bitmex) SendHTTPRequest(ctx context.Context, path string, params Parameter, result interface{}) error {
 	var respCheck interface{}
 	path = b.API.Endpoints.URL + path
 	if params != nil {
@@ -768,13 +768,14 @@ func (b *Bitmex) SendHTTPRequest(path string, params Parameter, result interface
 			if err != nil {
 				return err
 			}
-			err = b.SendPayload(context.Background(), &request.Item{
+			err = b.SendPayload(ctx, &request.Item{
 				Method:        http.MethodGet,
 				Path:          encodedPath,
 				Result:        &respCheck,
 				Verbose:       b.Verbose,
 				HTTPDebugging: b.HTTPDebugging,
 				HTTPRecording: b.HTTPRecording,
+				Endpoint:      request.UnAuth,
 			})
 			if err != nil {
 				return err
@@ -782,13 +783,14 @@ func (b *Bitmex) SendHTTPRequest(path string, params Parameter, result interface
 			return b.CaptureError(respCheck, result)
 		}
 	}
-	err := b.SendPayload(context.Background(), &request.Item{
+	err := b.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        &respCheck,
 		Verbose:       b.Verbose,
 		HTTPDebugging: b.HTTPDebugging,
 		HTTPRecording: b.HTTPRecording,
+		Endpoint:      request.UnAuth,
 	})
 	if err != nil {
 		return err
@@ -797,7 +799,7 @@ func (b *Bitmex) SendHTTPRequest(path string, params Parameter, result interface
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated HTTP request to bitmex
-func (b *Bitmex) SendAuthenticatedHTTPRequest(verb, path string, params Parameter, result interface{}) error {
+func (b *Bitmex) SendAuthenticatedHTTPRequest(ctx context.Context, verb, path string, params Parameter, result interface{}) error {
 	if !b.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet,
 			b.Name)
@@ -834,7 +836,7 @@ func (b *Bitmex) SendAuthenticatedHTTPRequest(verb, path string, params Paramete
 
 	var respCheck interface{}
 
-	ctx, cancel := context.WithDeadline(context.Background(), expires)
+	ctx, cancel := context.WithDeadline(ctx, expires)
 	defer cancel()
 	err := b.SendPayload(ctx, &request.Item{
 		Method:        verb,
@@ -0,0 +1,41 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package bitmex
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	b.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Bitmex load config error", err)
+	}
+	bitmexConfig, err := cfg.GetExchangeConfig("Bitmex")
+	if err != nil {
+		log.Fatal("Bitmex Setup() init error")
+	}
+
+	bitmexConfig.API.AuthenticatedSupport = true
+	bitmexConfig.API.AuthenticatedWebsocketSupport = true
+	bitmexConfig.API.Credentials.Key = apiKey
+	bitmexConfig.API.Credentials.Secret = apiSecret
+	b.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = b.Setup(bitmexConfig)
+	if err != nil {
+		log.Fatal("Bitmex setup error", err)
+	}
+	log.Printf(sharedtestvalues.LiveTesting, b.Name, b.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
@@ -0,0 +1,56 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package bitmex
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/bitmex/bitmex.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	b.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Bitmex load config error", err)
+	}
+	bitmexConfig, err := cfg.GetExchangeConfig("Bitmex")
+	if err != nil {
+		log.Fatal("Bitmex Setup() init error")
+	}
+
+	bitmexConfig.API.AuthenticatedSupport = true
+	bitmexConfig.API.AuthenticatedWebsocketSupport = true
+	bitmexConfig.API.Credentials.Key = apiKey
+	bitmexConfig.API.Credentials.Secret = apiSecret
+	b.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = b.Setup(bitmexConfig)
+	if err != nil {
+		log.Fatal("Bitmex setup error", err)
+	}
+
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+
+	b.HTTPClient = newClient
+	b.API.Endpoints.URL = serverDetails + "/api/v1"
+	log.Printf(sharedtestvalues.MockTesting, b.Name, b.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
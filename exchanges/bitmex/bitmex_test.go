@@ -1,19 +1,18 @@
 package bitmex
 
 import (
-	"log"
+	"context"
 	"net/http"
-	"os"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/yurulab/gocryptotrader/common"
-	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/core"
 	"github.com/yurulab/gocryptotrader/currency"
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/yurulab/gocryptotrader/exchanges/stream"
@@ -29,30 +28,6 @@ const (
 
 var b Bitmex
 
-func TestMain(m *testing.M) {
-	b.SetDefaults()
-	cfg := config.GetConfig()
-	err := cfg.LoadConfig("../../testdata/configtest.json", true)
-	if err != nil {
-		log.Fatal("Bitmex load config error", err)
-	}
-	bitmexConfig, err := cfg.GetExchangeConfig("Bitmex")
-	if err != nil {
-		log.Fatal("Bitmex Setup() init error")
-	}
-
-	bitmexConfig.API.AuthenticatedSupport = true
-	bitmexConfig.API.AuthenticatedWebsocketSupport = true
-	bitmexConfig.API.Credentials.Key = apiKey
-	bitmexConfig.API.Credentials.Secret = apiSecret
-	b.Websocket = sharedtestvalues.NewTestWebsocket()
-	err = b.Setup(bitmexConfig)
-	if err != nil {
-		log.Fatal("Bitmex setup error", err)
-	}
-	os.Exit(m.Run())
-}
-
 func TestStart(t *testing.T) {
 	var testWg sync.WaitGroup
 	b.Start(&testWg)
@@ -60,49 +35,49 @@ func TestStart(t *testing.T) {
 }
 
 func TestGetUrgentAnnouncement(t *testing.T) {
-	_, err := b.GetUrgentAnnouncement()
+	_, err := b.GetUrgentAnnouncement(context.Background())
 	if err == nil {
 		t.Error("GetUrgentAnnouncement() Expected error")
 	}
 }
 
 func TestGetAPIKeys(t *testing.T) {
-	_, err := b.GetAPIKeys()
+	_, err := b.GetAPIKeys(context.Background())
 	if err == nil {
 		t.Error("GetAPIKeys() Expected error")
 	}
 }
 
 func TestRemoveAPIKey(t *testing.T) {
-	_, err := b.RemoveAPIKey(APIKeyParams{APIKeyID: "1337"})
+	_, err := b.RemoveAPIKey(context.Background(), APIKeyParams{APIKeyID: "1337"})
 	if err == nil {
 		t.Error("RemoveAPIKey() Expected error")
 	}
 }
 
 func TestDisableAPIKey(t *testing.T) {
-	_, err := b.DisableAPIKey(APIKeyParams{APIKeyID: "1337"})
+	_, err := b.DisableAPIKey(context.Background(), APIKeyParams{APIKeyID: "1337"})
 	if err == nil {
 		t.Error("DisableAPIKey() Expected error")
 	}
 }
 
 func TestEnableAPIKey(t *testing.T) {
-	_, err := b.EnableAPIKey(APIKeyParams{APIKeyID: "1337"})
+	_, err := b.EnableAPIKey(context.Background(), APIKeyParams{APIKeyID: "1337"})
 	if err == nil {
 		t.Error("EnableAPIKey() Expected error")
 	}
 }
 
 func TestGetTrollboxMessages(t *testing.T) {
-	_, err := b.GetTrollboxMessages(ChatGetParams{Count: 5})
+	_, err := b.GetTrollboxMessages(context.Background(), ChatGetParams{Count: 5})
 	if err != nil {
 		t.Error("GetTrollboxMessages() error", err)
 	}
 }
 
 func TestSendTrollboxMessage(t *testing.T) {
-	_, err := b.SendTrollboxMessage(ChatSendParams{
+	_, err := b.SendTrollboxMessage(context.Background(), ChatSendParams{
 		ChannelID: 1337,
 		Message:   "Hello,World!"})
 	if err == nil {
@@ -111,126 +86,147 @@ func TestSendTrollboxMessage(t *testing.T) {
 }
 
 func TestGetTrollboxChannels(t *testing.T) {
-	_, err := b.GetTrollboxChannels()
+	_, err := b.GetTrollboxChannels(context.Background())
 	if err != nil {
 		t.Error("GetTrollboxChannels() error", err)
 	}
 }
 
 func TestGetTrollboxConnectedUsers(t *testing.T) {
-	_, err := b.GetTrollboxConnectedUsers()
+	_, err := b.GetTrollboxConnectedUsers(context.Background())
 	if err == nil {
 		t.Error("GetTrollboxConnectedUsers() Expected error")
 	}
 }
 
 func TestGetAccountExecutions(t *testing.T) {
-	_, err := b.GetAccountExecutions(&GenericRequestParams{})
+	_, err := b.GetAccountExecutions(context.Background(), &GenericRequestParams{})
 	if err == nil {
 		t.Error("GetAccountExecutions() Expected error")
 	}
 }
 
 func TestGetAccountExecutionTradeHistory(t *testing.T) {
-	_, err := b.GetAccountExecutionTradeHistory(&GenericRequestParams{})
+	_, err := b.GetAccountExecutionTradeHistory(context.Background(), &GenericRequestParams{})
 	if err == nil {
 		t.Error("GetAccountExecutionTradeHistory() Expected error")
 	}
 }
 
 func TestGetFundingHistory(t *testing.T) {
-	_, err := b.GetFundingHistory()
+	_, err := b.GetFundingHistory(context.Background())
 	if err == nil {
 		t.Error("GetFundingHistory() Expected error")
 	}
 }
 
 func TestGetInstruments(t *testing.T) {
-	_, err := b.GetInstruments(&GenericRequestParams{})
+	_, err := b.GetInstruments(context.Background(), &GenericRequestParams{})
 	if err != nil {
 		t.Error("GetInstruments() error", err)
 	}
 }
 
 func TestGetActiveInstruments(t *testing.T) {
-	_, err := b.GetActiveInstruments(&GenericRequestParams{})
+	_, err := b.GetActiveInstruments(context.Background(), &GenericRequestParams{})
 	if err != nil {
 		t.Error("GetActiveInstruments() error", err)
 	}
 }
 
 func TestGetActiveAndIndexInstruments(t *testing.T) {
-	_, err := b.GetActiveAndIndexInstruments()
+	_, err := b.GetActiveAndIndexInstruments(context.Background())
 	if err != nil {
 		t.Error("GetActiveAndIndexInstruments() error", err)
 	}
 }
 
 func TestGetActiveIntervals(t *testing.T) {
-	_, err := b.GetActiveIntervals()
+	_, err := b.GetActiveIntervals(context.Background())
 	if err == nil {
 		t.Error("GetActiveIntervals() Expected error")
 	}
 }
 
 func TestGetCompositeIndex(t *testing.T) {
-	_, err := b.GetCompositeIndex(&GenericRequestParams{})
+	_, err := b.GetCompositeIndex(context.Background(), &GenericRequestParams{})
 	if err == nil {
 		t.Error("GetCompositeIndex() Expected error")
 	}
 }
 
 func TestGetIndices(t *testing.T) {
-	_, err := b.GetIndices()
+	_, err := b.GetIndices(context.Background())
 	if err != nil {
 		t.Error("GetIndices() error", err)
 	}
 }
 
 func TestGetInsuranceFundHistory(t *testing.T) {
-	_, err := b.GetInsuranceFundHistory(&GenericRequestParams{})
+	_, err := b.GetInsuranceFundHistory(context.Background(), &GenericRequestParams{})
 	if err != nil {
 		t.Error("GetInsuranceFundHistory() error", err)
 	}
 }
 
 func TestGetLeaderboard(t *testing.T) {
-	_, err := b.GetLeaderboard(LeaderboardGetParams{})
+	_, err := b.GetLeaderboard(context.Background(), LeaderboardGetParams{})
 	if err != nil {
 		t.Error("GetLeaderboard() error", err)
 	}
 }
 
 func TestGetAliasOnLeaderboard(t *testing.T) {
-	_, err := b.GetAliasOnLeaderboard()
+	_, err := b.GetAliasOnLeaderboard(context.Background())
 	if err == nil {
 		t.Error("GetAliasOnLeaderboard() Expected error")
 	}
 }
 
 func TestGetLiquidationOrders(t *testing.T) {
-	_, err := b.GetLiquidationOrders(&GenericRequestParams{})
+	_, err := b.GetLiquidationOrders(context.Background(), &GenericRequestParams{})
 	if err != nil {
 		t.Error("GetLiquidationOrders() error", err)
 	}
 }
 
+func TestUpdateDerivativeInfo(t *testing.T) {
+	cp := currency.NewPair(currency.XBT, currency.USD)
+	_, err := b.UpdateDerivativeInfo(context.Background(), cp, asset.Spot)
+	if err == nil {
+		t.Error("UpdateDerivativeInfo() Expected error")
+	}
+
+	_, err = b.UpdateDerivativeInfo(context.Background(), cp, asset.PerpetualContract)
+	if err != nil {
+		t.Error("UpdateDerivativeInfo() error", err)
+	}
+}
+
+func TestGetLiquidations(t *testing.T) {
+	cp := currency.NewPair(currency.XBT, currency.USD)
+	_, err := b.GetLiquidations(context.Background(), cp, asset.PerpetualContract, time.Now().Add(-time.Hour), time.Now())
+	if err != nil {
+		t.Error("GetLiquidations() error", err)
+	}
+}
+
 func TestGetCurrentNotifications(t *testing.T) {
-	_, err := b.GetCurrentNotifications()
+	_, err := b.GetCurrentNotifications(context.Background())
 	if err == nil {
 		t.Error("GetCurrentNotifications() Expected error")
 	}
 }
 
 func TestAmendOrder(t *testing.T) {
-	_, err := b.AmendOrder(&OrderAmendParams{})
+	_, err := b.AmendOrder(context.Background(), &OrderAmendParams{})
 	if err == nil {
 		t.Error("AmendOrder() Expected error")
 	}
 }
 
 func TestCreateOrder(t *testing.T) {
-	_, err := b.CreateOrder(&OrderNewParams{Symbol: "XBTM15",
+	_, err := b.CreateOrder(context.Background(), &OrderNewParams{Symbol: "XBTM15",
 		Price:         219.0,
 		ClientOrderID: "mm_bitmex_1a/oemUeQ4CAJZgP3fjHsA",
 		OrderQuantity: 98})
@@ -240,126 +236,126 @@ func TestCreateOrder(t *testing.T) {
 }
 
 func TestCancelOrders(t *testing.T) {
-	_, err := b.CancelOrders(&OrderCancelParams{})
+	_, err := b.CancelOrders(context.Background(), &OrderCancelParams{})
 	if err == nil {
 		t.Error("CancelOrders() Expected error")
 	}
 }
 
 func TestCancelAllOrders(t *testing.T) {
-	_, err := b.CancelAllExistingOrders(OrderCancelAllParams{})
+	_, err := b.CancelAllExistingOrders(context.Background(), OrderCancelAllParams{})
 	if err == nil {
 		t.Error("CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAllResponse, error)", err)
 	}
 }
 
 func TestAmendBulkOrders(t *testing.T) {
-	_, err := b.AmendBulkOrders(OrderAmendBulkParams{})
+	_, err := b.AmendBulkOrders(context.Background(), OrderAmendBulkParams{})
 	if err == nil {
 		t.Error("AmendBulkOrders() Expected error")
 	}
 }
 
 func TestCreateBulkOrders(t *testing.T) {
-	_, err := b.CreateBulkOrders(OrderNewBulkParams{})
+	_, err := b.CreateBulkOrders(context.Background(), OrderNewBulkParams{})
 	if err == nil {
 		t.Error("CreateBulkOrders() Expected error")
 	}
 }
 
 func TestCancelAllOrdersAfterTime(t *testing.T) {
-	_, err := b.CancelAllOrdersAfterTime(OrderCancelAllAfterParams{})
+	_, err := b.CancelAllOrdersAfterTime(context.Background(), OrderCancelAllAfterParams{})
 	if err == nil {
 		t.Error("CancelAllOrdersAfterTime() Expected error")
 	}
 }
 
 func TestClosePosition(t *testing.T) {
-	_, err := b.ClosePosition(OrderClosePositionParams{})
+	_, err := b.ClosePosition(context.Background(), OrderClosePositionParams{})
 	if err == nil {
 		t.Error("ClosePosition() Expected error")
 	}
 }
 
 func TestGetOrderbook(t *testing.T) {
-	_, err := b.GetOrderbook(OrderBookGetL2Params{Symbol: "XBT"})
+	_, err := b.GetOrderbook(context.Background(), OrderBookGetL2Params{Symbol: "XBT"})
 	if err != nil {
 		t.Error("GetOrderbook() error", err)
 	}
 }
 
 func TestGetPositions(t *testing.T) {
-	_, err := b.GetPositions(PositionGetParams{})
+	_, err := b.GetPositions(context.Background(), PositionGetParams{})
 	if err == nil {
 		t.Error("GetPositions() Expected error")
 	}
 }
 
 func TestIsolatePosition(t *testing.T) {
-	_, err := b.IsolatePosition(PositionIsolateMarginParams{Symbol: "XBT"})
+	_, err := b.IsolatePosition(context.Background(), PositionIsolateMarginParams{Symbol: "XBT"})
 	if err == nil {
 		t.Error("IsolatePosition() Expected error")
 	}
 }
 
 func TestLeveragePosition(t *testing.T) {
-	_, err := b.LeveragePosition(PositionUpdateLeverageParams{})
+	_, err := b.LeveragePosition(context.Background(), PositionUpdateLeverageParams{})
 	if err == nil {
 		t.Error("LeveragePosition() Expected error")
 	}
 }
 
 func TestUpdateRiskLimit(t *testing.T) {
-	_, err := b.UpdateRiskLimit(PositionUpdateRiskLimitParams{})
+	_, err := b.UpdateRiskLimit(context.Background(), PositionUpdateRiskLimitParams{})
 	if err == nil {
 		t.Error("UpdateRiskLimit() Expected error")
 	}
 }
 
 func TestTransferMargin(t *testing.T) {
-	_, err := b.TransferMargin(PositionTransferIsolatedMarginParams{})
+	_, err := b.TransferMargin(context.Background(), PositionTransferIsolatedMarginParams{})
 	if err == nil {
 		t.Error("TransferMargin() Expected error")
 	}
 }
 
 func TestGetQuotesByBuckets(t *testing.T) {
-	_, err := b.GetQuotesByBuckets(&QuoteGetBucketedParams{})
+	_, err := b.GetQuotesByBuckets(context.Background(), &QuoteGetBucketedParams{})
 	if err == nil {
 		t.Error("GetQuotesByBuckets() Expected error")
 	}
 }
 
 func TestGetSettlementHistory(t *testing.T) {
-	_, err := b.GetSettlementHistory(&GenericRequestParams{})
+	_, err := b.GetSettlementHistory(context.Background(), &GenericRequestParams{})
 	if err != nil {
 		t.Error("GetSettlementHistory() error", err)
 	}
 }
 
 func TestGetStats(t *testing.T) {
-	_, err := b.GetStats()
+	_, err := b.GetStats(context.Background())
 	if err != nil {
 		t.Error("GetStats() error", err)
 	}
 }
 
 func TestGetStatsHistorical(t *testing.T) {
-	_, err := b.GetStatsHistorical()
+	_, err := b.GetStatsHistorical(context.Background())
 	if err != nil {
 		t.Error("GetStatsHistorical() error", err)
 	}
 }
 
 func TestGetStatSummary(t *testing.T) {
-	_, err := b.GetStatSummary()
+	_, err := b.GetStatSummary(context.Background())
 	if err != nil {
 		t.Error("GetStatSummary() error", err)
 	}
 }
 
 func TestGetTrade(t *testing.T) {
-	_, err := b.GetTrade(&GenericRequestParams{
+	_, err := b.GetTrade(context.Background(), &GenericRequestParams{
 		Symbol:    "ETHUSD",
 		StartTime: time.Now().Format(time.RFC3339),
 		Reverse:   true})
@@ -369,7 +365,7 @@ func TestGetTrade(t *testing.T) {
 }
 
 func TestGetPreviousTrades(t *testing.T) {
-	_, err := b.GetPreviousTrades(&TradeGetBucketedParams{
+	_, err := b.GetPreviousTrades(context.Background(), &TradeGetBucketedParams{
 		Symbol:  "XBTBTC",
 		Start:   int32(time.Now().Add(-time.Hour * 24).Unix()),
 		Columns: "open,high,low,close,volume",
@@ -391,7 +387,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	b.GetFeeByType(feeBuilder)
+	b.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -485,7 +481,7 @@ func TestGetActiveOrders(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := b.GetActiveOrders(&getOrdersRequest)
+	_, err := b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -500,7 +496,7 @@ func TestGetOrderHistory(t *testing.T) {
 			currency.BTC)},
 	}
 
-	_, err := b.GetOrderHistory(&getOrdersRequest)
+	_, err := b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -530,7 +526,7 @@ func TestSubmitOrder(t *testing.T) {
 		Amount:   1,
 		ClientID: "meowOrder",
 	}
-	response, err := b.SubmitOrder(orderSubmission)
+	response, err := b.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -551,7 +547,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -573,7 +569,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	resp, err := b.CancelAllOrders(orderCancellation)
+	resp, err := b.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -589,12 +585,12 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 
 func TestGetAccountInfo(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := b.UpdateAccountInfo()
+		_, err := b.UpdateAccountInfo(context.Background())
 		if err != nil {
 			t.Error("GetAccountInfo() error", err)
 		}
 	} else {
-		_, err := b.UpdateAccountInfo()
+		_, err := b.UpdateAccountInfo(context.Background())
 		if err == nil {
 			t.Error("GetAccountInfo() error")
 		}
@@ -605,7 +601,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := b.ModifyOrder(&order.Modify{ID: "1337"})
+	_, err := b.ModifyOrder(context.Background(), &order.Modify{ID: "1337"})
 	if err == nil {
 		t.Error("ModifyOrder() error")
 	}
@@ -626,7 +622,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := b.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := b.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -641,7 +637,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.Request{}
-	_, err := b.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -653,7 +649,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.Request{}
-	_, err := b.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -661,12 +657,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := b.GetDepositAddress(currency.BTC, "")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err != nil {
 			t.Error("GetDepositAddress() error", err)
 		}
 	} else {
-		_, err := b.GetDepositAddress(currency.BTC, "")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err == nil {
 			t.Error("GetDepositAddress() error cannot be nil")
 		}
@@ -702,7 +698,7 @@ func TestWsAuth(t *testing.T) {
 }
 
 func TestUpdateTradablePairs(t *testing.T) {
-	err := b.UpdateTradablePairs(true)
+	err := b.UpdateTradablePairs(context.Background(), true)
 	if err != nil {
 		t.Fatal(err)
 	}
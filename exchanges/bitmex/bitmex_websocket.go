@@ -242,6 +242,7 @@ func (b *Bitmex) wsHandleData(respRaw []byte) error {
 				}
 
 				b.Websocket.DataHandler <- stream.TradeData{
+					TID:          trades.Data[i].TrdMatchID,
 					Timestamp:    trades.Data[i].Timestamp,
 					Price:        trades.Data[i].Price,
 					Amount:       float64(trades.Data[i].Size),
@@ -1,7 +1,9 @@
 package bitmex
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"math"
 	"strings"
 	"sync"
@@ -13,6 +15,7 @@ import (
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
 	"github.com/yurulab/gocryptotrader/exchanges/account"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/derivative"
 	"github.com/yurulab/gocryptotrader/exchanges/kline"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
@@ -38,7 +41,7 @@ func (b *Bitmex) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if b.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = b.UpdateTradablePairs(true)
+		err = b.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -188,15 +191,15 @@ func (b *Bitmex) Run() {
 		return
 	}
 
-	err := b.UpdateTradablePairs(false)
+	err := b.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys, "%s failed to update tradable pairs. Err: %s", b.Name, err)
 	}
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (b *Bitmex) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	marketInfo, err := b.GetActiveAndIndexInstruments()
+func (b *Bitmex) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	marketInfo, err := b.GetActiveAndIndexInstruments(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -211,8 +214,8 @@ func (b *Bitmex) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (b *Bitmex) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := b.FetchTradablePairs(asset.Spot)
+func (b *Bitmex) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := b.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -259,8 +262,8 @@ func (b *Bitmex) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bitmex) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
-	tick, err := b.GetActiveAndIndexInstruments()
+func (b *Bitmex) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+	tick, err := b.GetActiveAndIndexInstruments(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -295,25 +298,115 @@ func (b *Bitmex) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pr
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (b *Bitmex) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *Bitmex) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
+// UpdateDerivativeInfo updates and returns the funding, open interest and
+// pricing data for a derivative contract
+func (b *Bitmex) UpdateDerivativeInfo(ctx context.Context, p currency.Pair, assetType asset.Item) (*derivative.Info, error) {
+	if !derivative.IsDerivative(assetType) {
+		return nil, fmt.Errorf("%s asset type %s is not a derivative", b.Name, assetType)
+	}
+	fPair, err := b.FormatExchangeCurrency(p, assetType)
+	if err != nil {
+		return nil, err
+	}
+
+	instruments, err := b.GetActiveInstruments(ctx, &GenericRequestParams{
+		Symbol: fPair.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(instruments) == 0 {
+		return nil, fmt.Errorf("%s instrument %s not found", b.Name, fPair)
+	}
+
+	err = derivative.ProcessInfo(&derivative.Info{
+		Exchange:             b.Name,
+		Pair:                 p,
+		AssetType:            assetType,
+		MarkPrice:            instruments[0].MarkPrice,
+		IndexPrice:           instruments[0].IndicativeSettlePrice,
+		FundingRate:          instruments[0].FundingRate,
+		PredictedFundingRate: instruments[0].IndicativeFundingRate,
+		NextFundingTime:      instruments[0].FundingTimestamp,
+		OpenInterest:         float64(instruments[0].OpenInterest),
+		LastUpdated:          instruments[0].Timestamp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return derivative.GetInfo(b.Name, p, assetType)
+}
+
+// FetchDerivativeInfo returns the funding, open interest and pricing data for
+// a derivative contract
+func (b *Bitmex) FetchDerivativeInfo(ctx context.Context, p currency.Pair, assetType asset.Item) (*derivative.Info, error) {
+	info, err := derivative.GetInfo(b.Name, p, assetType)
+	if err != nil {
+		return b.UpdateDerivativeInfo(ctx, p, assetType)
+	}
+	return info, nil
+}
+
+// GetLiquidations returns forced liquidations for a derivative contract.
+// Bitmex only exposes liquidation orders that are currently active so these
+// are returned with the time they were retrieved
+func (b *Bitmex) GetLiquidations(ctx context.Context, p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]derivative.Liquidation, error) {
+	if !derivative.IsDerivative(assetType) {
+		return nil, fmt.Errorf("%s asset type %s is not a derivative", b.Name, assetType)
+	}
+	fPair, err := b.FormatExchangeCurrency(p, assetType)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := b.GetLiquidationOrders(ctx, &GenericRequestParams{
+		Symbol: fPair.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	resp := make([]derivative.Liquidation, len(orders))
+	for i := range orders {
+		side, err := order.StringToOrderSide(orders[i].Side)
+		if err != nil {
+			side = order.UnknownSide
+		}
+		resp[i] = derivative.Liquidation{
+			Exchange:  b.Name,
+			ID:        orders[i].OrderID,
+			Pair:      p,
+			AssetType: assetType,
+			Side:      side,
+			Price:     orders[i].Price,
+			Amount:    float64(orders[i].LeavesQty),
+			Timestamp: now,
+		}
+	}
+	return resp, nil
+}
+
 // FetchOrderbook returns orderbook base on the currency pair
-func (b *Bitmex) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Bitmex) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(p, assetType)
+		return b.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bitmex) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Bitmex) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	if assetType == asset.Index {
 		return nil, common.ErrFunctionNotSupported
 	}
@@ -323,7 +416,7 @@ func (b *Bitmex) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderb
 		return nil, err
 	}
 
-	orderbookNew, err := b.GetOrderbook(OrderBookGetL2Params{
+	orderbookNew, err := b.GetOrderbook(ctx, OrderBookGetL2Params{
 		Symbol: fpair.String(),
 		Depth:  500})
 	if err != nil {
@@ -359,10 +452,10 @@ func (b *Bitmex) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderb
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // Bitmex exchange
-func (b *Bitmex) UpdateAccountInfo() (account.Holdings, error) {
+func (b *Bitmex) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var info account.Holdings
 
-	bal, err := b.GetAllUserMargin()
+	bal, err := b.GetAllUserMargin(ctx)
 	if err != nil {
 		return info, err
 	}
@@ -390,10 +483,10 @@ func (b *Bitmex) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (b *Bitmex) FetchAccountInfo() (account.Holdings, error) {
+func (b *Bitmex) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(b.Name)
 	if err != nil {
-		return b.UpdateAccountInfo()
+		return b.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -401,17 +494,17 @@ func (b *Bitmex) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *Bitmex) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *Bitmex) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // GetExchangeHistory returns historic trade data within the timeframe provided.
-func (b *Bitmex) GetExchangeHistory(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+func (b *Bitmex) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *Bitmex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (b *Bitmex) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
@@ -433,7 +526,7 @@ func (b *Bitmex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		orderNewParams.Price = s.Price
 	}
 
-	response, err := b.CreateOrder(&orderNewParams)
+	response, err := b.CreateOrder(ctx, &orderNewParams)
 	if err != nil {
 		return submitOrderResponse, err
 	}
@@ -450,7 +543,7 @@ func (b *Bitmex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *Bitmex) ModifyOrder(action *order.Modify) (string, error) {
+func (b *Bitmex) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	var params OrderAmendParams
 
 	if math.Mod(action.Amount, 1) != 0 {
@@ -461,7 +554,7 @@ func (b *Bitmex) ModifyOrder(action *order.Modify) (string, error) {
 	params.OrderQty = int32(action.Amount)
 	params.Price = action.Price
 
-	order, err := b.AmendOrder(&params)
+	order, err := b.AmendOrder(ctx, &params)
 	if err != nil {
 		return "", err
 	}
@@ -470,21 +563,21 @@ func (b *Bitmex) ModifyOrder(action *order.Modify) (string, error) {
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *Bitmex) CancelOrder(order *order.Cancel) error {
+func (b *Bitmex) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	var params = OrderCancelParams{
 		OrderID: order.ID,
 	}
-	_, err := b.CancelOrders(&params)
+	_, err := b.CancelOrders(ctx, &params)
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *Bitmex) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (b *Bitmex) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
 	var emptyParams OrderCancelAllParams
-	orders, err := b.CancelAllExistingOrders(emptyParams)
+	orders, err := b.CancelAllExistingOrders(ctx, emptyParams)
 	if err != nil {
 		return cancelAllOrdersResponse, err
 	}
@@ -499,19 +592,19 @@ func (b *Bitmex) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, erro
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *Bitmex) GetOrderInfo(orderID string) (order.Detail, error) {
+func (b *Bitmex) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Bitmex) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	return b.GetCryptoDepositAddress(cryptocurrency.String())
+func (b *Bitmex) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	return b.GetCryptoDepositAddress(ctx, cryptocurrency.String())
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Bitmex) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (b *Bitmex) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	var request = UserRequestWithdrawalParams{
 		Address:  withdrawRequest.Crypto.Address,
 		Amount:   withdrawRequest.Amount,
@@ -522,7 +615,7 @@ func (b *Bitmex) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request)
 		request.Fee = withdrawRequest.Crypto.FeeAmount
 	}
 
-	resp, err := b.UserRequestWithdrawal(request)
+	resp, err := b.UserRequestWithdrawal(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -535,18 +628,18 @@ func (b *Bitmex) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request)
 
 // WithdrawFiatFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Bitmex) WithdrawFiatFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (b *Bitmex) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Bitmex) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (b *Bitmex) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (b *Bitmex) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *Bitmex) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !b.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
@@ -556,12 +649,12 @@ func (b *Bitmex) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error)
 
 // GetActiveOrders retrieves any orders that are active/open
 // This function is not concurrency safe due to orderSide/orderType maps
-func (b *Bitmex) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Bitmex) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var orders []order.Detail
 	params := OrdersRequest{}
 	params.Filter = "{\"open\":true}"
 
-	resp, err := b.GetOrders(&params)
+	resp, err := b.GetOrders(ctx, &params)
 	if err != nil {
 		return nil, err
 	}
@@ -604,10 +697,10 @@ func (b *Bitmex) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
 // This function is not concurrency safe due to orderSide/orderType maps
-func (b *Bitmex) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Bitmex) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var orders []order.Detail
 	params := OrdersRequest{}
-	resp, err := b.GetOrders(&params)
+	resp, err := b.GetOrders(ctx, &params)
 	if err != nil {
 		return nil, err
 	}
@@ -654,17 +747,17 @@ func (b *Bitmex) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (b *Bitmex) ValidateCredentials() error {
-	_, err := b.UpdateAccountInfo()
+func (b *Bitmex) ValidateCredentials(ctx context.Context) error {
+	_, err := b.UpdateAccountInfo(ctx)
 	return b.CheckTransientError(err)
 }
 
 // GetHistoricCandles returns candles between a time period for a set time interval
-func (b *Bitmex) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (b *Bitmex) GetHistoricCandles(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	return kline.Item{}, common.ErrFunctionNotSupported
 }
 
 // GetHistoricCandlesExtended returns candles between a time period for a set time interval
-func (b *Bitmex) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (b *Bitmex) GetHistoricCandlesExtended(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	return kline.Item{}, common.ErrFunctionNotSupported
 }
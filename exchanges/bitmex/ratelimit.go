@@ -4,36 +4,25 @@ import (
 	"time"
 
 	"github.com/yurulab/gocryptotrader/exchanges/request"
-	"golang.org/x/time/rate"
 )
 
-// Bitmex rate limits
+// Bitmex rate limits, unauthenticated requests are allowed half the rate of
+// authenticated requests so they carry twice the weight against the shared
+// budget
 const (
 	bitmexRateInterval = time.Minute
-	bitmexUnauthRate   = 30
 	bitmexAuthRate     = 60
+	bitmexUnauthWeight = 2
 )
 
-// RateLimit implements the request.Limiter interface
-type RateLimit struct {
-	Auth   *rate.Limiter
-	UnAuth *rate.Limiter
-}
-
-// Limit limits outbound calls
-func (r *RateLimit) Limit(f request.EndpointLimit) error {
-	if f == request.Auth {
-		time.Sleep(r.Auth.Reserve().Delay())
-		return nil
-	}
-	time.Sleep(r.UnAuth.Reserve().Delay())
-	return nil
-}
-
-// SetRateLimit returns the rate limit for the exchange
-func SetRateLimit() *RateLimit {
-	return &RateLimit{
-		Auth:   request.NewRateLimit(bitmexRateInterval, bitmexAuthRate),
-		UnAuth: request.NewRateLimit(bitmexRateInterval, bitmexUnauthRate),
-	}
+// SetRateLimit returns the rate limit for the exchange. Bitmex reports the
+// remaining budget in the X-RateLimit-* response headers which are used to
+// keep the limiter in step with the exchange
+func SetRateLimit() *request.AdaptiveLimit {
+	return request.NewAdaptiveLimit(bitmexRateInterval,
+		bitmexAuthRate,
+		map[request.EndpointLimit]int{
+			request.UnAuth: bitmexUnauthWeight,
+		},
+		request.ParseRateLimitHeaders)
 }
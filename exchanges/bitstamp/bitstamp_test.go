@@ -1,6 +1,7 @@
 package bitstamp
 
 import (
+	"context"
 	"net/url"
 	"testing"
 	"time"
@@ -43,7 +44,7 @@ func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	t.Parallel()
 
 	var feeBuilder = setFeeBuilder()
-	b.GetFeeByType(feeBuilder)
+	b.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v",
@@ -65,7 +66,7 @@ func TestGetFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
 
 	// CryptocurrencyTradeFee Basic
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || (areTestAPIKeysSet() && err != nil) {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || (areTestAPIKeysSet() && err != nil) {
 		t.Error(err)
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0),
@@ -76,7 +77,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.Amount = 1000
 	feeBuilder.PurchasePrice = 1000
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || (areTestAPIKeysSet() && err != nil) {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || (areTestAPIKeysSet() && err != nil) {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0),
 			resp)
@@ -86,7 +87,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee IsMaker
 	feeBuilder = setFeeBuilder()
 	feeBuilder.IsMaker = true
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || (areTestAPIKeysSet() && err != nil) {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || (areTestAPIKeysSet() && err != nil) {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0),
 			resp)
@@ -96,7 +97,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee Negative purchase price
 	feeBuilder = setFeeBuilder()
 	feeBuilder.PurchasePrice = -1000
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || (areTestAPIKeysSet() && err != nil) {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || (areTestAPIKeysSet() && err != nil) {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0),
 			resp)
@@ -106,7 +107,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0),
 			resp)
@@ -116,7 +117,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0),
 			resp)
@@ -127,7 +128,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.FiatCurrency = currency.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(7.5) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(7.5) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(7.5),
 			resp)
@@ -138,7 +139,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(15) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(15) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(15),
 			resp)
@@ -174,7 +175,7 @@ func TestCalculateTradingFee(t *testing.T) {
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetTicker(currency.BTC.String()+currency.USD.String(), false)
+	_, err := b.GetTicker(context.Background(), currency.BTC.String()+currency.USD.String(), false)
 	if err != nil {
 		t.Error("GetTicker() error", err)
 	}
@@ -183,7 +184,7 @@ func TestGetTicker(t *testing.T) {
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOrderbook(currency.BTC.String() + currency.USD.String())
+	_, err := b.GetOrderbook(context.Background(), currency.BTC.String()+currency.USD.String())
 	if err != nil {
 		t.Error("GetOrderbook() error", err)
 	}
@@ -192,7 +193,7 @@ func TestGetOrderbook(t *testing.T) {
 func TestGetTradingPairs(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetTradingPairs()
+	_, err := b.GetTradingPairs(context.Background())
 	if err != nil {
 		t.Error("GetTradingPairs() error", err)
 	}
@@ -204,16 +205,42 @@ func TestGetTransactions(t *testing.T) {
 	value := url.Values{}
 	value.Set("time", "hour")
 
-	_, err := b.GetTransactions(currency.BTC.String()+currency.USD.String(), value)
+	_, err := b.GetTransactions(context.Background(), currency.BTC.String()+currency.USD.String(), value)
 	if err != nil {
 		t.Error("GetTransactions() error", err)
 	}
 }
 
+func TestGetExchangeHistory(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	end := time.Now()
+	start := end.Add(-time.Minute * 30)
+	_, err := b.GetExchangeHistory(context.Background(), p, asset.Spot, end, start)
+	if err == nil {
+		t.Error("expected error when start is after end")
+	}
+
+	resp, err := b.GetExchangeHistory(context.Background(), p, asset.Spot, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range resp {
+		if resp[i].Timestamp.Before(start) || resp[i].Timestamp.After(end) {
+			t.Errorf("trade %s outside of requested range", resp[i].TID)
+		}
+	}
+
+	_, err = b.GetExchangeHistory(context.Background(), p, asset.Spot, end.Add(-time.Hour*25), end)
+	if err != exchange.ErrTradeHistoryRangeNotCovered {
+		t.Errorf("expected %v, received %v", exchange.ErrTradeHistoryRangeNotCovered, err)
+	}
+}
+
 func TestGetEURUSDConversionRate(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetEURUSDConversionRate()
+	_, err := b.GetEURUSDConversionRate(context.Background())
 	if err != nil {
 		t.Error("GetEURUSDConversionRate() error", err)
 	}
@@ -222,7 +249,7 @@ func TestGetEURUSDConversionRate(t *testing.T) {
 func TestGetBalance(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetBalance()
+	_, err := b.GetBalance(context.Background())
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Error("GetBalance() error", err)
@@ -236,7 +263,7 @@ func TestGetBalance(t *testing.T) {
 func TestGetUserTransactions(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetUserTransactions("btcusd")
+	_, err := b.GetUserTransactions(context.Background(), "btcusd")
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Error("GetUserTransactions() error", err)
@@ -250,7 +277,7 @@ func TestGetUserTransactions(t *testing.T) {
 func TestGetOpenOrders(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOpenOrders("btcusd")
+	_, err := b.GetOpenOrders(context.Background(), "btcusd")
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Error("GetOpenOrders() error", err)
@@ -264,7 +291,7 @@ func TestGetOpenOrders(t *testing.T) {
 func TestGetOrderStatus(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOrderStatus(1337)
+	_, err := b.GetOrderStatus(context.Background(), 1337)
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Error("GetOrderStatus() error", err)
@@ -278,7 +305,7 @@ func TestGetOrderStatus(t *testing.T) {
 func TestGetWithdrawalRequests(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetWithdrawalRequests(0)
+	_, err := b.GetWithdrawalRequests(context.Background(), 0)
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Error("GetWithdrawalRequests() error", err)
@@ -292,7 +319,7 @@ func TestGetWithdrawalRequests(t *testing.T) {
 func TestGetUnconfirmedBitcoinDeposits(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetUnconfirmedBitcoinDeposits()
+	_, err := b.GetUnconfirmedBitcoinDeposits(context.Background())
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Error("GetUnconfirmedBitcoinDeposits() error", err)
@@ -310,7 +337,7 @@ func TestTransferAccountBalance(t *testing.T) {
 		t.Skip()
 	}
 
-	err := b.TransferAccountBalance(0.01, "btc", "testAccount", true)
+	err := b.TransferAccountBalance(context.Background(), 0.01, "btc", "testAccount", true)
 	if !mockTests && err != nil {
 		t.Error("TransferAccountBalance() error", err)
 	}
@@ -340,7 +367,7 @@ func TestGetActiveOrders(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := b.GetActiveOrders(&getOrdersRequest)
+	_, err := b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Errorf("Could not get open orders: %s", err)
@@ -358,7 +385,7 @@ func TestGetOrderHistory(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := b.GetOrderHistory(&getOrdersRequest)
+	_, err := b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Errorf("Could not get order history: %s", err)
@@ -390,7 +417,7 @@ func TestSubmitOrder(t *testing.T) {
 		Amount:   1,
 		ClientID: "meowOrder",
 	}
-	response, err := b.SubmitOrder(orderSubmission)
+	response, err := b.SubmitOrder(context.Background(), orderSubmission)
 	switch {
 	case areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) && !mockTests:
 		t.Errorf("Order failed to be placed: %v", err)
@@ -411,7 +438,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	orderCancellation := &order.Cancel{
 		ID: "1234",
 	}
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 	switch {
 	case !areTestAPIKeysSet() && err == nil && !mockTests:
 		t.Error("Expecting an error when no keys are set")
@@ -429,7 +456,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	resp, err := b.CancelAllOrders(&order.Cancel{})
+	resp, err := b.CancelAllOrders(context.Background(), &order.Cancel{})
 	switch {
 	case !areTestAPIKeysSet() && err == nil && !mockTests:
 		t.Error("Expecting an error when no keys are set")
@@ -447,7 +474,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 func TestModifyOrder(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.ModifyOrder(&order.Modify{})
+	_, err := b.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -469,7 +496,7 @@ func TestWithdraw(t *testing.T) {
 		},
 	}
 
-	_, err := b.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := b.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	switch {
 	case !areTestAPIKeysSet() && err == nil && !mockTests:
 		t.Error("Expecting an error when no keys are set")
@@ -509,7 +536,7 @@ func TestWithdrawFiat(t *testing.T) {
 		Description: "WITHDRAW IT ALL",
 	}
 
-	_, err := b.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	switch {
 	case !areTestAPIKeysSet() && err == nil && !mockTests:
 		t.Error("Expecting an error when no keys are set")
@@ -555,7 +582,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 		Description: "WITHDRAW IT ALL",
 	}
 
-	_, err := b.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	switch {
 	case !areTestAPIKeysSet() && err == nil && !mockTests:
 		t.Error("Expecting an error when no keys are set")
@@ -569,7 +596,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetDepositAddress(currency.BTC, "")
+	_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
 	switch {
 	case areTestAPIKeysSet() && customerID != "" && err != nil && !mockTests:
 		t.Error("GetDepositAddress error", err)
@@ -671,7 +698,7 @@ func TestWsRequestReconnect(t *testing.T) {
 func TestBitstamp_OHLC(t *testing.T) {
 	start := time.Unix(1546300800, 0)
 	end := time.Unix(1577836799, 0)
-	_, err := b.OHLC("btcusd", start, end, "60", "10")
+	_, err := b.OHLC(context.Background(), "btcusd", start, end, "60", "10")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -685,7 +712,7 @@ func TestBitstamp_GetHistoricCandles(t *testing.T) {
 	start := time.Unix(1546300800, 0)
 	end := time.Unix(1577836799, 0)
 
-	_, err = b.GetHistoricCandles(currencyPair, asset.Spot, start, end, kline.OneDay)
+	_, err = b.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, start, end, kline.OneDay)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -698,7 +725,7 @@ func TestBitstamp_GetHistoricCandlesExtended(t *testing.T) {
 	}
 	start := time.Unix(1546300800, 0)
 	end := time.Unix(1577836799, 0)
-	_, err = b.GetHistoricCandlesExtended(currencyPair, asset.Spot, start, end, kline.OneDay)
+	_, err = b.GetHistoricCandlesExtended(context.Background(), currencyPair, asset.Spot, start, end, kline.OneDay)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1,6 +1,7 @@
 package bitstamp
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -123,6 +124,7 @@ func (b *Bitstamp) wsHandleData(respRaw []byte) error {
 			return err
 		}
 		b.Websocket.DataHandler <- stream.TradeData{
+			TID:          strconv.Itoa(wsTradeTemp.Data.ID),
 			Timestamp:    time.Unix(wsTradeTemp.Data.Timestamp, 0),
 			CurrencyPair: p,
 			AssetType:    a,
@@ -255,7 +257,7 @@ func (b *Bitstamp) seedOrderBook() error {
 	}
 
 	for x := range p {
-		orderbookSeed, err := b.GetOrderbook(p[x].String())
+		orderbookSeed, err := b.GetOrderbook(context.Background(), p[x].String())
 		if err != nil {
 			return err
 		}
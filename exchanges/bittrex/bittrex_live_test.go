@@ -0,0 +1,45 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package bittrex
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	b.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal(err)
+	}
+	bConfig, err := cfg.GetExchangeConfig("Bittrex")
+	if err != nil {
+		log.Fatal(err)
+	}
+	bConfig.API.Credentials.Key = apiKey
+	bConfig.API.Credentials.Secret = apiSecret
+	bConfig.API.AuthenticatedSupport = true
+	b.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = b.Setup(bConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if !b.IsEnabled() || !b.API.AuthenticatedSupport ||
+		b.Verbose || len(b.BaseCurrencies) < 1 {
+		log.Fatal("Bittrex Setup values not set correctly")
+	}
+
+	log.Printf(sharedtestvalues.LiveTesting, b.Name, b.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
@@ -0,0 +1,59 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package bittrex
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/bittrex/bittrex.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	b.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal(err)
+	}
+	bConfig, err := cfg.GetExchangeConfig("Bittrex")
+	if err != nil {
+		log.Fatal(err)
+	}
+	bConfig.API.Credentials.Key = apiKey
+	bConfig.API.Credentials.Secret = apiSecret
+	bConfig.API.AuthenticatedSupport = true
+	b.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = b.Setup(bConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if !b.IsEnabled() || !b.API.AuthenticatedSupport ||
+		b.Verbose || len(b.BaseCurrencies) < 1 {
+		log.Fatal("Bittrex Setup values not set correctly")
+	}
+
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+
+	b.HTTPClient = newClient
+	b.API.Endpoints.URL = serverDetails + "/api/v1.1"
+	log.Printf(sharedtestvalues.MockTesting, b.Name, b.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
@@ -1,15 +1,14 @@
 package bittrex
 
 import (
-	"log"
-	"os"
+	"context"
 	"testing"
 
 	"github.com/yurulab/gocryptotrader/common"
-	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/core"
 	"github.com/yurulab/gocryptotrader/currency"
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/portfolio/withdraw"
 )
@@ -25,37 +24,9 @@ const (
 
 var b Bittrex
 
-func TestMain(m *testing.M) {
-	b.SetDefaults()
-	cfg := config.GetConfig()
-	err := cfg.LoadConfig("../../testdata/configtest.json", true)
-	if err != nil {
-		log.Fatal(err)
-	}
-	bConfig, err := cfg.GetExchangeConfig("Bittrex")
-	if err != nil {
-		log.Fatal(err)
-	}
-	bConfig.API.Credentials.Key = apiKey
-	bConfig.API.Credentials.Secret = apiSecret
-	bConfig.API.AuthenticatedSupport = true
-
-	err = b.Setup(bConfig)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	if !b.IsEnabled() || !b.API.AuthenticatedSupport ||
-		b.Verbose || len(b.BaseCurrencies) < 1 {
-		log.Fatal("Bittrex Setup values not set correctly")
-	}
-
-	os.Exit(m.Run())
-}
-
 func TestGetMarkets(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarkets()
+	_, err := b.GetMarkets(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -63,7 +34,7 @@ func TestGetMarkets(t *testing.T) {
 
 func TestGetCurrencies(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetCurrencies()
+	_, err := b.GetCurrencies(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -71,7 +42,7 @@ func TestGetCurrencies(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTicker(currPair)
+	_, err := b.GetTicker(context.Background(), currPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -79,7 +50,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetMarketSummaries(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarketSummaries()
+	_, err := b.GetMarketSummaries(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -87,7 +58,7 @@ func TestGetMarketSummaries(t *testing.T) {
 
 func TestGetMarketSummary(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarketSummary(currPair)
+	_, err := b.GetMarketSummary(context.Background(), currPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -96,7 +67,7 @@ func TestGetMarketSummary(t *testing.T) {
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOrderbook(currPair)
+	_, err := b.GetOrderbook(context.Background(), currPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -105,7 +76,7 @@ func TestGetOrderbook(t *testing.T) {
 func TestGetMarketHistory(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetMarketHistory(currPair)
+	_, err := b.GetMarketHistory(context.Background(), currPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -114,7 +85,7 @@ func TestGetMarketHistory(t *testing.T) {
 func TestPlaceBuyLimit(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.PlaceBuyLimit(currPair, 1, 1)
+	_, err := b.PlaceBuyLimit(context.Background(), currPair, 1, 1)
 	if err == nil {
 		t.Error("Expected error")
 	}
@@ -123,7 +94,7 @@ func TestPlaceBuyLimit(t *testing.T) {
 func TestPlaceSellLimit(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.PlaceSellLimit(currPair, 1, 1)
+	_, err := b.PlaceSellLimit(context.Background(), currPair, 1, 1)
 	if err == nil {
 		t.Error("Expected error")
 	}
@@ -132,13 +103,13 @@ func TestPlaceSellLimit(t *testing.T) {
 func TestGetOpenOrders(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOpenOrders("")
+	_, err := b.GetOpenOrders(context.Background(), "")
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expected error")
 	}
-	_, err = b.GetOpenOrders(currPair)
+	_, err = b.GetOpenOrders(context.Background(), currPair)
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -149,7 +120,7 @@ func TestGetOpenOrders(t *testing.T) {
 func TestCancelExistingOrder(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.CancelExistingOrder("invalid-order")
+	_, err := b.CancelExistingOrder(context.Background(), "invalid-order")
 	if err == nil {
 		t.Error("Expected error")
 	}
@@ -158,7 +129,7 @@ func TestCancelExistingOrder(t *testing.T) {
 func TestGetAccountBalances(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetAccountBalances()
+	_, err := b.GetAccountBalances(context.Background())
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -169,7 +140,7 @@ func TestGetAccountBalances(t *testing.T) {
 func TestGetAccountBalanceByCurrency(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetAccountBalanceByCurrency(curr)
+	_, err := b.GetAccountBalanceByCurrency(context.Background(), curr)
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -180,13 +151,13 @@ func TestGetAccountBalanceByCurrency(t *testing.T) {
 func TestGetOrder(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOrder("0cb4c4e4-bdc7-4e13-8c13-430e587d2cc1")
+	_, err := b.GetOrder(context.Background(), "0cb4c4e4-bdc7-4e13-8c13-430e587d2cc1")
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expected error")
 	}
-	_, err = b.GetOrder("")
+	_, err = b.GetOrder(context.Background(), "")
 	if areTestAPIKeysSet() && err == nil {
 		t.Error("Expected error")
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -197,13 +168,13 @@ func TestGetOrder(t *testing.T) {
 func TestGetOrderHistoryForCurrency(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOrderHistoryForCurrency("")
+	_, err := b.GetOrderHistoryForCurrency(context.Background(), "")
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expected error")
 	}
-	_, err = b.GetOrderHistoryForCurrency(currPair)
+	_, err = b.GetOrderHistoryForCurrency(context.Background(), currPair)
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -214,13 +185,13 @@ func TestGetOrderHistoryForCurrency(t *testing.T) {
 func TestGetwithdrawalHistory(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetWithdrawalHistory("")
+	_, err := b.GetWithdrawalHistory(context.Background(), "")
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expected error")
 	}
-	_, err = b.GetWithdrawalHistory(curr)
+	_, err = b.GetWithdrawalHistory(context.Background(), curr)
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -230,13 +201,13 @@ func TestGetwithdrawalHistory(t *testing.T) {
 
 func TestGetDepositHistory(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetDepositHistory("")
+	_, err := b.GetDepositHistory(context.Background(), "")
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expected error")
 	}
-	_, err = b.GetDepositHistory(currPair)
+	_, err = b.GetDepositHistory(context.Background(), currPair)
 	if err == nil {
 		t.Error("Expected error")
 	}
@@ -254,7 +225,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	b.GetFeeByType(feeBuilder)
+	b.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -269,7 +240,7 @@ func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 func TestGetFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
 	// CryptocurrencyTradeFee Basic
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.0025) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.0025) || err != nil {
 		t.Error(err)
 		t.Errorf("Expected: %f, Received: %f", float64(0.0025), resp)
 	}
@@ -278,7 +249,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.Amount = 1000
 	feeBuilder.PurchasePrice = 1000
-	if resp, err := b.GetFee(feeBuilder); resp != float64(2500) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(2500) || err != nil {
 		t.Errorf("Expected: %f, Received: %f", float64(2500), resp)
 		t.Error(err)
 	}
@@ -286,7 +257,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee IsMaker
 	feeBuilder = setFeeBuilder()
 	feeBuilder.IsMaker = true
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.0025) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.0025) || err != nil {
 		t.Errorf("Expected: %f, Received: %f", float64(0.0025), resp)
 		t.Error(err)
 	}
@@ -294,7 +265,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee Negative purchase price
 	feeBuilder = setFeeBuilder()
 	feeBuilder.PurchasePrice = -1000
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -302,7 +273,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.0005) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.0005) || err != nil {
 		t.Errorf("Expected: %f, Received: %f", float64(0.0005), resp)
 		t.Error(err)
 	}
@@ -310,7 +281,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -319,7 +290,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.FiatCurrency = currency.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -328,7 +299,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -355,7 +326,7 @@ func TestGetActiveOrders(t *testing.T) {
 
 	getOrdersRequest.Pairs[0].Delimiter = "-"
 
-	_, err = b.GetActiveOrders(&getOrdersRequest)
+	_, err = b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -368,7 +339,7 @@ func TestGetOrderHistory(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := b.GetOrderHistory(&getOrdersRequest)
+	_, err := b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -399,7 +370,7 @@ func TestSubmitOrder(t *testing.T) {
 		Amount:   1,
 		ClientID: "meowOrder",
 	}
-	response, err := b.SubmitOrder(orderSubmission)
+	response, err := b.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -420,7 +391,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -442,7 +413,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	resp, err := b.CancelAllOrders(orderCancellation)
+	resp, err := b.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -460,7 +431,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := b.ModifyOrder(&order.Modify{})
+	_, err := b.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("Expected error")
 	}
@@ -480,7 +451,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := b.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := b.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -496,7 +467,7 @@ func TestWithdrawFiat(t *testing.T) {
 
 	var withdrawFiatRequest = withdraw.Request{}
 
-	_, err := b.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -509,7 +480,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 	var withdrawFiatRequest = withdraw.Request{}
 
-	_, err := b.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -517,12 +488,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := b.GetDepositAddress(currency.BTC, "")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err != nil {
 			t.Error(err)
 		}
 	} else {
-		_, err := b.GetDepositAddress(currency.BTC, "")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err == nil {
 			t.Error("error cannot be nil")
 		}
@@ -546,3 +517,97 @@ func TestParseTime(t *testing.T) {
 		t.Error("invalid time values")
 	}
 }
+
+func TestWsHubResponse(t *testing.T) {
+	pressXToJSON := []byte(`{"R":true,"I":"1337"}`)
+	err := b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+	pressXToJSON = []byte(`{"E":"There was an error invoking Hub method 'c2.SubscribeToExchangeDeltas'.","I":"1338"}`)
+	err = b.wsHandleData(pressXToJSON)
+	if err == nil {
+		t.Error("expected error for unmatched hub error response")
+	}
+	pressXToJSON = []byte(`{"C":"d-5B0F8D3A-B,0|Bu4n,0|Bu4o,1","S":1,"M":[]}`)
+	err = b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWsOrderbook(t *testing.T) {
+	pressXToJSON := "RcpBCoAgEAXQu/z1NKgh5CyrbUFpm8JtlxDvnknS9vESFggOP4duDBMIK0QrpQgn5ErYIIotYYe4wqwzVdRsKg7OObY5Evz/+/bL+r5h3dC8+y475gc="
+	var state WsExchangeState
+	err := wsDecode(pressXToJSON, &state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = b.wsLoadSnapshot(&state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := currency.NewPairFromString(currPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ob := b.Websocket.Orderbook.GetOrderbook(p, asset.Spot)
+	if ob == nil {
+		t.Fatal("expected orderbook to be loaded")
+	}
+	if ob.LastUpdateID != 1000 || len(ob.Bids) != 2 || len(ob.Asks) != 2 {
+		t.Errorf("unexpected orderbook snapshot %+v", ob)
+	}
+
+	update := []byte(`{"C":"d-5B0F8D3A-B,0|Bu4n,0|Bu4o,2","M":[{"H":"C2","M":"uE","A":["ZY47DsJADETvMrVZ2ZssYJchQqIABNkUAaXlEtHePYbwKahs672xZsIRhr5r86rJOxBOMGEWwg12n5AHvwlXmDJz8PUC40IL4R/htKCwSWUkdN90/DgS4luRp/F4GfsDrN7GStPaf5+zl2n6Af8Znw4lac2q0Umsylhm"]}]}`)
+	for i := int64(0); i < b.WebsocketOrderbookBufferLimit; i++ {
+		err = b.wsHandleData(update)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	ob = b.Websocket.Orderbook.GetOrderbook(p, asset.Spot)
+	if ob.LastUpdateID != 1001 {
+		t.Errorf("expected update ID 1001, received %v", ob.LastUpdateID)
+	}
+	for i := range ob.Bids {
+		if ob.Bids[i].Price == 9000.1 {
+			t.Error("expected bid at 9000.1 to be removed")
+		}
+	}
+	if ob.Asks[0].Price != 9001.2 || ob.Asks[0].Amount != 0.1 {
+		t.Errorf("expected ask at 9001.2 to be amended, received %+v", ob.Asks[0])
+	}
+}
+
+func TestWsSummaryDeltas(t *testing.T) {
+	pressXToJSON := []byte(`{"C":"d-5B0F8D3A-B,0|Bu4n,0|Bu4o,3","M":[{"H":"C2","M":"uS","A":["RY69DsIwDITf5WYTOYmTNt4olWAAhERgQcxdYEeq+u44dMDTyfruZ8YZmggj9DHjBMXtOtbNUHcgHKAlMDsmHKF9WeXdDD642BFeBjB7FwhvqGQfoiSXM6FCfSrCpQVIss/wYy3B2rZ/395AQwgTNEoTF9tS/Nr1aTFeuiw9t1ueyxc="]}]}`)
+	err := b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWsOrderDelta(t *testing.T) {
+	pressXToJSON := []byte(`{"C":"d-5B0F8D3A-B,0|Bu4n,0|Bu4o,4","M":[{"H":"C2","M":"uO","A":["TY9JT8MwEIX/y5xtNF4b+9jSQ1iSIhKJnpDjRQKiIpaIQ9X/zhipFad5843f0/MRfsAD5ibYogIXxU5cRyu5CyLwVXRRZpuwCAEMOvBCMhj2NBm8gz/CSO5JBlNE1Nwkl7kOMvJmwkxhKtlAGVlWdwteonaqEVJpBn21JlFkVkHzyUTLdVpl3hSHPIhJRpV0NsWSdUtPx8frga+HDa39QPtde98Oz+txT+ABPF4ZBh91KgZP4B0iMjhUoOmyo8pNJbvxfKu/ME6jcxLxj2zAH5Z5ZvAC/vtzyUSodAnzF8nbi3q7qBvq0fXdliq8nr3Lv1hBsafTLw=="]}]}`)
+	err := b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWsBalanceDelta(t *testing.T) {
+	pressXToJSON := []byte(`{"C":"d-5B0F8D3A-B,0|Bu4n,0|Bu4o,5","M":[{"H":"C2","M":"uB","A":["LYu7DsIwEAT/ZetzdH4l+EroqUDUfsSiiBAKSkOUf+cKutHs7I4rxBMaZMcdghqKy7azSfOpmVCnYvLYo5lD89UVm7knEB4Q63yI40SoejvfLmqL2sFFQobwMCl8FQhvyGtbFsIK6Xn5zIRN05gCp+TYMmv0/G/H8QM="]}]}`)
+	err := b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWsGenerateURL(t *testing.T) {
+	t.Parallel()
+	u := b.wsGenerateURL(bittrexWSURL, "/connect", "abc+123")
+	if u != bittrexWSURL+"/connect?clientProtocol=1.5&connectionData=%5B%7B%22name%22%3A%22c2%22%7D%5D&connectionToken=abc%2B123&transport=webSockets" {
+		t.Errorf("unexpected connection URL %s", u)
+	}
+}
@@ -224,3 +224,147 @@ type DepositHistory struct {
 		CryptoAddress string  `json:"CryptoAddress"`
 	} `json:"result"`
 }
+
+// WsNegotiate holds the SignalR negotiation response used to open a websocket
+// connection
+type WsNegotiate struct {
+	URL                     string  `json:"Url"`
+	ConnectionToken         string  `json:"ConnectionToken"`
+	ConnectionID            string  `json:"ConnectionId"`
+	KeepAliveTimeout        float64 `json:"KeepAliveTimeout"`
+	DisconnectTimeout       float64 `json:"DisconnectTimeout"`
+	ConnectionTimeout       float64 `json:"ConnectionTimeout"`
+	TryWebSockets           bool    `json:"TryWebSockets"`
+	ProtocolVersion         string  `json:"ProtocolVersion"`
+	TransportConnectTimeout float64 `json:"TransportConnectTimeout"`
+	LongPollDelay           float64 `json:"LongPollDelay"`
+}
+
+// WsHubInvocation defines an outbound SignalR hub method call
+type WsHubInvocation struct {
+	Hub       string        `json:"H"`
+	Method    string        `json:"M"`
+	Arguments []interface{} `json:"A"`
+	ID        string        `json:"I"`
+}
+
+// WsHubMessage defines a SignalR persistent connection message which contains
+// either a response to an invocation or a batch of hub pushes
+type WsHubMessage struct {
+	Cursor   string             `json:"C"`
+	Init     int64              `json:"S"`
+	Messages []WsHubPushMessage `json:"M"`
+	Result   json.RawMessage    `json:"R"`
+	ID       string             `json:"I"`
+	Error    string             `json:"E"`
+}
+
+// WsHubPushMessage defines a hub method pushed from the server
+type WsHubPushMessage struct {
+	Hub       string   `json:"H"`
+	Method    string   `json:"M"`
+	Arguments []string `json:"A"`
+}
+
+// WsOrderbookEntry defines a price level in an orderbook snapshot or delta
+type WsOrderbookEntry struct {
+	Type     int64   `json:"TY"`
+	Rate     float64 `json:"R"`
+	Quantity float64 `json:"Q"`
+}
+
+// WsExchangeState defines the response of a QueryExchangeState invocation
+type WsExchangeState struct {
+	MarketName string             `json:"M"`
+	Nonce      int64              `json:"N"`
+	Buys       []WsOrderbookEntry `json:"Z"`
+	Sells      []WsOrderbookEntry `json:"S"`
+}
+
+// WsExchangeDelta defines orderbook and fill changes for a market
+type WsExchangeDelta struct {
+	MarketName string             `json:"M"`
+	Nonce      int64              `json:"N"`
+	Buys       []WsOrderbookEntry `json:"Z"`
+	Sells      []WsOrderbookEntry `json:"S"`
+	Fills      []WsFill           `json:"f"`
+}
+
+// WsFill defines a public trade
+type WsFill struct {
+	FillID    int64   `json:"FI"`
+	OrderType string  `json:"OT"`
+	Rate      float64 `json:"R"`
+	Quantity  float64 `json:"Q"`
+	TimeStamp int64   `json:"T"`
+}
+
+// WsSummaryDeltas defines a batch of market summary changes
+type WsSummaryDeltas struct {
+	Nonce  int64             `json:"N"`
+	Deltas []WsMarketSummary `json:"D"`
+}
+
+// WsMarketSummary defines ticker data for a market
+type WsMarketSummary struct {
+	MarketName     string  `json:"M"`
+	High           float64 `json:"H"`
+	Low            float64 `json:"L"`
+	Volume         float64 `json:"V"`
+	Last           float64 `json:"l"`
+	BaseVolume     float64 `json:"m"`
+	TimeStamp      int64   `json:"T"`
+	Bid            float64 `json:"B"`
+	Ask            float64 `json:"A"`
+	OpenBuyOrders  int64   `json:"G"`
+	OpenSellOrders int64   `json:"g"`
+	PrevDay        float64 `json:"PD"`
+	Created        int64   `json:"x"`
+}
+
+// WsOrderDelta defines a change to one of the authenticated account's orders
+type WsOrderDelta struct {
+	AccountUUID string `json:"w"`
+	Nonce       int64  `json:"N"`
+	Type        int64  `json:"TY"`
+	Order       struct {
+		UUID              string  `json:"U"`
+		ID                int64   `json:"I"`
+		OrderUUID         string  `json:"OU"`
+		Exchange          string  `json:"E"`
+		OrderType         string  `json:"OT"`
+		Quantity          float64 `json:"Q"`
+		QuantityRemaining float64 `json:"q"`
+		Limit             float64 `json:"X"`
+		CommissionPaid    float64 `json:"n"`
+		Price             float64 `json:"P"`
+		PricePerUnit      float64 `json:"PU"`
+		Opened            int64   `json:"Y"`
+		Closed            int64   `json:"C"`
+		IsOpen            bool    `json:"i"`
+		CancelInitiated   bool    `json:"CI"`
+		ImmediateOrCancel bool    `json:"K"`
+		IsConditional     bool    `json:"k"`
+		Condition         string  `json:"J"`
+		ConditionTarget   float64 `json:"j"`
+		Updated           int64   `json:"u"`
+	} `json:"o"`
+}
+
+// WsBalanceDelta defines a change to one of the authenticated account's
+// balances
+type WsBalanceDelta struct {
+	Nonce   int64 `json:"N"`
+	Balance struct {
+		UUID          string  `json:"U"`
+		AccountID     int64   `json:"W"`
+		Currency      string  `json:"c"`
+		Balance       float64 `json:"b"`
+		Available     float64 `json:"a"`
+		Pending       float64 `json:"z"`
+		CryptoAddress string  `json:"p"`
+		Requested     bool    `json:"r"`
+		Updated       int64   `json:"u"`
+		AutoSell      bool    `json:"h"`
+	} `json:"d"`
+}
@@ -0,0 +1,601 @@
+package bittrex
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/yurulab/gocryptotrader/common"
+	"github.com/yurulab/gocryptotrader/common/crypto"
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/order"
+	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
+	"github.com/yurulab/gocryptotrader/exchanges/stream"
+	"github.com/yurulab/gocryptotrader/exchanges/stream/buffer"
+	"github.com/yurulab/gocryptotrader/exchanges/ticker"
+	"github.com/yurulab/gocryptotrader/log"
+)
+
+const (
+	bittrexWSURL          = "wss://socket.bittrex.com/signalr"
+	bittrexWSHub          = "c2"
+	bittrexClientProtocol = "1.5"
+
+	// Hub invocations
+	wsGetAuthContext          = "GetAuthContext"
+	wsAuthenticate            = "Authenticate"
+	wsQueryExchangeState      = "QueryExchangeState"
+	wsSubscribeExchangeDeltas = "SubscribeToExchangeDeltas"
+	wsSubscribeSummaryDeltas  = "SubscribeToSummaryDeltas"
+
+	// Hub pushes
+	wsExchangeDelta = "uE"
+	wsSummaryDelta  = "uS"
+	wsOrderDelta    = "uO"
+	wsBalanceDelta  = "uB"
+
+	// Orderbook delta types
+	wsOrderbookRemove = 1
+
+	// Order delta types
+	wsOrderOpen    = 0
+	wsOrderPartial = 1
+	wsOrderFill    = 2
+	wsOrderCancel  = 3
+)
+
+// WsConnect negotiates a SignalR connection token and connects to the
+// websocket feed
+func (b *Bittrex) WsConnect() error {
+	if !b.Websocket.IsEnabled() || !b.IsEnabled() {
+		return errors.New(stream.WebsocketNotEnabled)
+	}
+	token, err := b.wsNegotiate()
+	if err != nil {
+		return err
+	}
+	b.Websocket.Conn.SetURL(b.wsGenerateURL(b.Websocket.GetWebsocketURL(),
+		"/connect",
+		token))
+
+	var dialer websocket.Dialer
+	err = b.Websocket.Conn.Dial(&dialer, http.Header{})
+	if err != nil {
+		return err
+	}
+	if b.Verbose {
+		log.Debugf(log.ExchangeSys, "%s Connected to Websocket.\n", b.Name)
+	}
+	go b.wsReadData()
+
+	err = b.wsStart(token)
+	if err != nil {
+		return err
+	}
+
+	if b.Websocket.CanUseAuthenticatedEndpoints() {
+		err = b.wsAuth()
+		if err != nil {
+			b.Websocket.DataHandler <- err
+			b.Websocket.SetCanUseAuthenticatedEndpoints(false)
+		}
+	}
+
+	subs, err := b.generateDefaultSubscriptions()
+	if err != nil {
+		return err
+	}
+	return b.Websocket.SubscribeToChannels(subs)
+}
+
+// wsGenerateURL converts the websocket URL into a SignalR transport endpoint
+func (b *Bittrex) wsGenerateURL(base, endpoint, token string) string {
+	connectionData, _ := json.Marshal([]map[string]string{{"name": bittrexWSHub}})
+	params := url.Values{}
+	params.Set("transport", "webSockets")
+	params.Set("clientProtocol", bittrexClientProtocol)
+	params.Set("connectionData", string(connectionData))
+	if token != "" {
+		params.Set("connectionToken", token)
+	}
+	return base + endpoint + "?" + params.Encode()
+}
+
+// wsHTTPURL returns the http(s) equivalent of the running websocket URL for
+// SignalR negotiation and start requests
+func (b *Bittrex) wsHTTPURL() string {
+	u := b.Websocket.GetWebsocketURL()
+	if strings.HasPrefix(u, "wss://") {
+		return "https://" + strings.TrimPrefix(u, "wss://")
+	}
+	return "http://" + strings.TrimPrefix(u, "ws://")
+}
+
+// wsNegotiate retrieves a connection token for the SignalR websocket
+func (b *Bittrex) wsNegotiate() (string, error) {
+	var resp WsNegotiate
+	err := b.SendPayload(context.Background(), &request.Item{
+		Method:        http.MethodGet,
+		Path:          b.wsGenerateURL(b.wsHTTPURL(), "/negotiate", ""),
+		Result:        &resp,
+		Verbose:       b.Verbose,
+		HTTPDebugging: b.HTTPDebugging,
+		HTTPRecording: b.HTTPRecording,
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp.ConnectionToken == "" {
+		return "", fmt.Errorf("%s websocket negotiation returned no connection token", b.Name)
+	}
+	return resp.ConnectionToken, nil
+}
+
+// wsStart notifies the SignalR server that the transport is ready to receive
+// hub messages
+func (b *Bittrex) wsStart(token string) error {
+	var resp struct {
+		Response string `json:"Response"`
+	}
+	err := b.SendPayload(context.Background(), &request.Item{
+		Method:        http.MethodGet,
+		Path:          b.wsGenerateURL(b.wsHTTPURL(), "/start", token),
+		Result:        &resp,
+		Verbose:       b.Verbose,
+		HTTPDebugging: b.HTTPDebugging,
+		HTTPRecording: b.HTTPRecording,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Response != "started" {
+		return fmt.Errorf("%s websocket start returned unexpected response %s",
+			b.Name,
+			resp.Response)
+	}
+	return nil
+}
+
+// wsReadData receives and passes on websocket messages for processing
+func (b *Bittrex) wsReadData() {
+	b.Websocket.Wg.Add(1)
+	defer b.Websocket.Wg.Done()
+
+	for {
+		resp := b.Websocket.Conn.ReadMessage()
+		if resp.Raw == nil {
+			return
+		}
+		err := b.wsHandleData(resp.Raw)
+		if err != nil {
+			b.Websocket.DataHandler <- err
+		}
+	}
+}
+
+// wsInvoke calls a hub method and returns the method's result
+func (b *Bittrex) wsInvoke(method string, args ...interface{}) (json.RawMessage, error) {
+	if args == nil {
+		args = []interface{}{}
+	}
+	id := strconv.FormatInt(b.Websocket.Conn.GenerateMessageID(false), 10)
+	resp, err := b.Websocket.Conn.SendMessageReturnResponse(id, WsHubInvocation{
+		Hub:       bittrexWSHub,
+		Method:    method,
+		Arguments: args,
+		ID:        id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var result WsHubMessage
+	err = json.Unmarshal(resp, &result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Error != "" {
+		return nil, fmt.Errorf("%s websocket %s error: %s", b.Name, method, result.Error)
+	}
+	return result.Result, nil
+}
+
+// wsAuth authenticates the websocket connection so that order and balance
+// deltas are pushed
+func (b *Bittrex) wsAuth() error {
+	resp, err := b.wsInvoke(wsGetAuthContext, b.API.Credentials.Key)
+	if err != nil {
+		return err
+	}
+	var challenge string
+	err = json.Unmarshal(resp, &challenge)
+	if err != nil {
+		return err
+	}
+	hmac := crypto.GetHMAC(crypto.HashSHA512,
+		[]byte(challenge),
+		[]byte(b.API.Credentials.Secret))
+	resp, err = b.wsInvoke(wsAuthenticate,
+		b.API.Credentials.Key,
+		crypto.HexEncodeToString(hmac))
+	if err != nil {
+		return err
+	}
+	var authenticated bool
+	err = json.Unmarshal(resp, &authenticated)
+	if err != nil {
+		return err
+	}
+	if !authenticated {
+		return fmt.Errorf("%s websocket authentication failed", b.Name)
+	}
+	return nil
+}
+
+func (b *Bittrex) wsHandleData(respRaw []byte) error {
+	var msg WsHubMessage
+	err := json.Unmarshal(respRaw, &msg)
+	if err != nil {
+		return err
+	}
+
+	if msg.ID != "" {
+		if !b.Websocket.Match.IncomingWithData(msg.ID, respRaw) && msg.Error != "" {
+			return fmt.Errorf("%s websocket error: %s", b.Name, msg.Error)
+		}
+		return nil
+	}
+
+	for i := range msg.Messages {
+		for j := range msg.Messages[i].Arguments {
+			switch msg.Messages[i].Method {
+			case wsExchangeDelta:
+				var delta WsExchangeDelta
+				err = wsDecode(msg.Messages[i].Arguments[j], &delta)
+				if err != nil {
+					return err
+				}
+				err = b.wsProcessExchangeDelta(&delta)
+				if err != nil {
+					return err
+				}
+			case wsSummaryDelta:
+				var summary WsSummaryDeltas
+				err = wsDecode(msg.Messages[i].Arguments[j], &summary)
+				if err != nil {
+					return err
+				}
+				err = b.wsProcessSummaryDeltas(&summary)
+				if err != nil {
+					return err
+				}
+			case wsOrderDelta:
+				var delta WsOrderDelta
+				err = wsDecode(msg.Messages[i].Arguments[j], &delta)
+				if err != nil {
+					return err
+				}
+				b.wsProcessOrderDelta(&delta)
+			case wsBalanceDelta:
+				var delta WsBalanceDelta
+				err = wsDecode(msg.Messages[i].Arguments[j], &delta)
+				if err != nil {
+					return err
+				}
+				b.Websocket.DataHandler <- delta
+			default:
+				b.Websocket.DataHandler <- stream.UnhandledMessageWarning{Message: b.Name + stream.UnhandledMessage + string(respRaw)}
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// wsDecode decodes a base64 encoded, deflate compressed hub payload
+func wsDecode(data string, result interface{}) error {
+	compressed, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return err
+	}
+	reader := flate.NewReader(bytes.NewReader(compressed))
+	decompressed, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	err = reader.Close()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(decompressed, result)
+}
+
+func (b *Bittrex) wsProcessExchangeDelta(delta *WsExchangeDelta) error {
+	p, err := currency.NewPairDelimiter(delta.MarketName, currency.DashDelimiter)
+	if err != nil {
+		return err
+	}
+
+	for i := range delta.Fills {
+		side, err := order.StringToOrderSide(delta.Fills[i].OrderType)
+		if err != nil {
+			b.Websocket.DataHandler <- order.ClassificationError{
+				Exchange: b.Name,
+				Err:      err,
+			}
+		}
+		b.Websocket.DataHandler <- stream.TradeData{
+			TID:          strconv.FormatInt(delta.Fills[i].FillID, 10),
+			Timestamp:    time.Unix(0, delta.Fills[i].TimeStamp*int64(time.Millisecond)),
+			CurrencyPair: p,
+			AssetType:    asset.Spot,
+			Exchange:     b.Name,
+			EventType:    order.UnknownType,
+			Price:        delta.Fills[i].Rate,
+			Amount:       delta.Fills[i].Quantity,
+			Side:         side,
+		}
+	}
+
+	if len(delta.Buys) == 0 && len(delta.Sells) == 0 {
+		return nil
+	}
+
+	ob := b.Websocket.Orderbook.GetOrderbook(p, asset.Spot)
+	if ob == nil || delta.Nonce <= ob.LastUpdateID {
+		// Deltas received before the snapshot is loaded, or already
+		// reflected in it, are discarded
+		return nil
+	}
+
+	return b.Websocket.Orderbook.Update(&buffer.Update{
+		UpdateID:   delta.Nonce,
+		UpdateTime: time.Now(),
+		Asset:      asset.Spot,
+		Bids:       wsOrderbookItems(delta.Buys),
+		Asks:       wsOrderbookItems(delta.Sells),
+		Pair:       p,
+	})
+}
+
+// wsOrderbookItems converts bittrex orderbook entries, treating removals as
+// zero amount updates
+func wsOrderbookItems(entries []WsOrderbookEntry) []orderbook.Item {
+	items := make([]orderbook.Item, len(entries))
+	for i := range entries {
+		items[i].Price = entries[i].Rate
+		if entries[i].Type != wsOrderbookRemove {
+			items[i].Amount = entries[i].Quantity
+		}
+	}
+	return items
+}
+
+func (b *Bittrex) wsProcessSummaryDeltas(summary *WsSummaryDeltas) error {
+	enabledPairs, err := b.GetEnabledPairs(asset.Spot)
+	if err != nil {
+		return err
+	}
+	for i := range summary.Deltas {
+		p, err := currency.NewPairDelimiter(summary.Deltas[i].MarketName,
+			currency.DashDelimiter)
+		if err != nil {
+			return err
+		}
+		if !enabledPairs.Contains(p, true) {
+			continue
+		}
+		b.Websocket.DataHandler <- &ticker.Price{
+			ExchangeName: b.Name,
+			Last:         summary.Deltas[i].Last,
+			High:         summary.Deltas[i].High,
+			Low:          summary.Deltas[i].Low,
+			Bid:          summary.Deltas[i].Bid,
+			Ask:          summary.Deltas[i].Ask,
+			Volume:       summary.Deltas[i].BaseVolume,
+			QuoteVolume:  summary.Deltas[i].Volume,
+			Close:        summary.Deltas[i].PrevDay,
+			LastUpdated:  time.Unix(0, summary.Deltas[i].TimeStamp*int64(time.Millisecond)),
+			AssetType:    asset.Spot,
+			Pair:         p,
+		}
+	}
+	return nil
+}
+
+func (b *Bittrex) wsProcessOrderDelta(delta *WsOrderDelta) {
+	orderID := delta.Order.OrderUUID
+	var oType order.Type
+	var oSide order.Side
+	orderTypeSide := strings.Split(delta.Order.OrderType, "_")
+	if len(orderTypeSide) != 2 {
+		b.Websocket.DataHandler <- order.ClassificationError{
+			Exchange: b.Name,
+			OrderID:  orderID,
+			Err:      fmt.Errorf("unable to parse order type %s", delta.Order.OrderType),
+		}
+	} else {
+		var err error
+		oType, err = order.StringToOrderType(orderTypeSide[0])
+		if err != nil {
+			b.Websocket.DataHandler <- order.ClassificationError{
+				Exchange: b.Name,
+				OrderID:  orderID,
+				Err:      err,
+			}
+		}
+		oSide, err = order.StringToOrderSide(orderTypeSide[1])
+		if err != nil {
+			b.Websocket.DataHandler <- order.ClassificationError{
+				Exchange: b.Name,
+				OrderID:  orderID,
+				Err:      err,
+			}
+		}
+	}
+
+	var oStatus order.Status
+	switch delta.Type {
+	case wsOrderOpen:
+		oStatus = order.Active
+	case wsOrderPartial:
+		oStatus = order.PartiallyFilled
+	case wsOrderFill:
+		oStatus = order.Filled
+	case wsOrderCancel:
+		oStatus = order.Cancelled
+	default:
+		oStatus = order.UnknownStatus
+		b.Websocket.DataHandler <- order.ClassificationError{
+			Exchange: b.Name,
+			OrderID:  orderID,
+			Err:      fmt.Errorf("unknown order delta type %d", delta.Type),
+		}
+	}
+
+	p, err := currency.NewPairDelimiter(delta.Order.Exchange, currency.DashDelimiter)
+	if err != nil {
+		b.Websocket.DataHandler <- order.ClassificationError{
+			Exchange: b.Name,
+			OrderID:  orderID,
+			Err:      err,
+		}
+	}
+
+	detail := &order.Detail{
+		ImmediateOrCancel: delta.Order.ImmediateOrCancel,
+		Price:             delta.Order.Limit,
+		Amount:            delta.Order.Quantity,
+		ExecutedAmount:    delta.Order.Quantity - delta.Order.QuantityRemaining,
+		RemainingAmount:   delta.Order.QuantityRemaining,
+		Fee:               delta.Order.CommissionPaid,
+		Exchange:          b.Name,
+		ID:                orderID,
+		AccountID:         delta.AccountUUID,
+		Type:              oType,
+		Side:              oSide,
+		Status:            oStatus,
+		AssetType:         asset.Spot,
+		Date:              time.Unix(0, delta.Order.Opened*int64(time.Millisecond)),
+		LastUpdated:       time.Unix(0, delta.Order.Updated*int64(time.Millisecond)),
+		Pair:              p,
+	}
+	if delta.Order.Closed > 0 {
+		detail.CloseTime = time.Unix(0, delta.Order.Closed*int64(time.Millisecond))
+	}
+	b.Websocket.DataHandler <- detail
+}
+
+func (b *Bittrex) generateDefaultSubscriptions() ([]stream.ChannelSubscription, error) {
+	enabledCurrencies, err := b.GetEnabledPairs(asset.Spot)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions := []stream.ChannelSubscription{{
+		Channel: wsSubscribeSummaryDeltas,
+		Asset:   asset.Spot,
+	}}
+	for i := range enabledCurrencies {
+		subscriptions = append(subscriptions, stream.ChannelSubscription{
+			Channel:  wsSubscribeExchangeDeltas,
+			Currency: enabledCurrencies[i],
+			Asset:    asset.Spot,
+		})
+	}
+	return subscriptions, nil
+}
+
+// Subscribe invokes the hub subscription methods and seeds the orderbook for
+// each subscribed market
+func (b *Bittrex) Subscribe(channelsToSubscribe []stream.ChannelSubscription) error {
+	var errs common.Errors
+	for i := range channelsToSubscribe {
+		var args []interface{}
+		if channelsToSubscribe[i].Channel == wsSubscribeExchangeDeltas {
+			fPair, err := b.FormatExchangeCurrency(channelsToSubscribe[i].Currency,
+				channelsToSubscribe[i].Asset)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			args = append(args, fPair.String())
+		}
+		resp, err := b.wsInvoke(channelsToSubscribe[i].Channel, args...)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		var subscribed bool
+		err = json.Unmarshal(resp, &subscribed)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !subscribed {
+			errs = append(errs, fmt.Errorf("%s websocket subscription to %s %v rejected",
+				b.Name,
+				channelsToSubscribe[i].Channel,
+				channelsToSubscribe[i].Currency))
+			continue
+		}
+		b.Websocket.AddSuccessfulSubscriptions(channelsToSubscribe[i])
+		if channelsToSubscribe[i].Channel == wsSubscribeExchangeDeltas {
+			err = b.wsSeedOrderbook(args[0].(string))
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if errs != nil {
+		return errs
+	}
+	return nil
+}
+
+// wsSeedOrderbook queries the orderbook snapshot for a market and loads it
+// into the websocket orderbook buffer
+func (b *Bittrex) wsSeedOrderbook(marketName string) error {
+	resp, err := b.wsInvoke(wsQueryExchangeState, marketName)
+	if err != nil {
+		return err
+	}
+	var compressed string
+	err = json.Unmarshal(resp, &compressed)
+	if err != nil {
+		return err
+	}
+	var state WsExchangeState
+	err = wsDecode(compressed, &state)
+	if err != nil {
+		return err
+	}
+	return b.wsLoadSnapshot(&state)
+}
+
+func (b *Bittrex) wsLoadSnapshot(state *WsExchangeState) error {
+	p, err := currency.NewPairDelimiter(state.MarketName, currency.DashDelimiter)
+	if err != nil {
+		return err
+	}
+	return b.Websocket.Orderbook.LoadSnapshot(&orderbook.Base{
+		Pair:         p,
+		Bids:         wsOrderbookItems(state.Buys),
+		Asks:         wsOrderbookItems(state.Sells),
+		LastUpdated:  time.Now(),
+		LastUpdateID: state.Nonce,
+		AssetType:    asset.Spot,
+		ExchangeName: b.Name,
+	})
+}
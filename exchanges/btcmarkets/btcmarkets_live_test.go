@@ -0,0 +1,47 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package btcmarkets
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	b.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal(err)
+	}
+	bConfig, err := cfg.GetExchangeConfig("BTC Markets")
+	if err != nil {
+		log.Fatal(err)
+	}
+	bConfig.API.Credentials.Key = apiKey
+	bConfig.API.Credentials.Secret = apiSecret
+	bConfig.API.AuthenticatedSupport = true
+	b.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = b.Setup(bConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = b.ValidateCredentials(context.Background())
+	if err != nil {
+		fmt.Println("API credentials are invalid:", err)
+		b.API.AuthenticatedSupport = false
+		b.API.AuthenticatedWebsocketSupport = false
+	}
+	log.Printf(sharedtestvalues.LiveTesting, b.Name, b.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
@@ -1,19 +1,15 @@
 package btcmarkets
 
 import (
+	"context"
 	"errors"
-	"fmt"
-	"log"
-	"os"
 	"testing"
 	"time"
 
-	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/currency"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
 	"github.com/yurulab/gocryptotrader/exchanges/kline"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
-	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
 )
 
 var b BTCMarkets
@@ -30,41 +26,13 @@ const (
 	bid                     = "bid"
 )
 
-func TestMain(m *testing.M) {
-	b.SetDefaults()
-	cfg := config.GetConfig()
-	err := cfg.LoadConfig("../../testdata/configtest.json", true)
-	if err != nil {
-		log.Fatal(err)
-	}
-	bConfig, err := cfg.GetExchangeConfig("BTC Markets")
-	if err != nil {
-		log.Fatal(err)
-	}
-	bConfig.API.Credentials.Key = apiKey
-	bConfig.API.Credentials.Secret = apiSecret
-	bConfig.API.AuthenticatedSupport = true
-	b.Websocket = sharedtestvalues.NewTestWebsocket()
-	err = b.Setup(bConfig)
-	if err != nil {
-		log.Fatal(err)
-	}
-	err = b.ValidateCredentials()
-	if err != nil {
-		fmt.Println("API credentials are invalid:", err)
-		b.API.AuthenticatedSupport = false
-		b.API.AuthenticatedWebsocketSupport = false
-	}
-	os.Exit(m.Run())
-}
-
 func areTestAPIKeysSet() bool {
 	return b.AllowAuthenticatedRequest()
 }
 
 func TestGetMarkets(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarkets()
+	_, err := b.GetMarkets(context.Background())
 	if err != nil {
 		t.Error("GetTicker() error", err)
 	}
@@ -72,7 +40,7 @@ func TestGetMarkets(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTicker(BTCAUD)
+	_, err := b.GetTicker(context.Background(), BTCAUD)
 	if err != nil {
 		t.Error("GetOrderbook() error", err)
 	}
@@ -80,15 +48,33 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTrades(BTCAUD, 0, 0, 5)
+	_, err := b.GetTrades(context.Background(), BTCAUD, 0, 0, 5)
 	if err != nil {
 		t.Error("GetTrades() error", err)
 	}
 }
 
+func TestGetExchangeHistory(t *testing.T) {
+	t.Parallel()
+	p, err := currency.NewPairFromString(BTCAUD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	end := time.Now()
+	_, err = b.GetExchangeHistory(context.Background(), p, asset.Spot, end, end.Add(-time.Hour))
+	if err == nil {
+		t.Error("expected error when start is after end")
+	}
+
+	_, err = b.GetExchangeHistory(context.Background(), p, asset.Spot, end.Add(-time.Hour), end)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrderbook(BTCAUD, 2)
+	_, err := b.GetOrderbook(context.Background(), BTCAUD, 2)
 	if err != nil {
 		t.Error("GetTrades() error", err)
 	}
@@ -96,7 +82,7 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetMarketCandles(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarketCandles(BTCAUD, "1h", time.Now().UTC().Add(-time.Hour*24), time.Now().UTC(), -1, -1, -1)
+	_, err := b.GetMarketCandles(context.Background(), BTCAUD, "1h", time.Now().UTC().Add(-time.Hour*24), time.Now().UTC(), -1, -1, -1)
 	if err != nil {
 		t.Error(err)
 	}
@@ -108,7 +94,7 @@ func TestGetTickers(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = b.GetTickers(temp)
+	_, err = b.GetTickers(context.Background(), temp)
 	if err != nil {
 		t.Error(err)
 	}
@@ -117,7 +103,7 @@ func TestGetTickers(t *testing.T) {
 func TestGetMultipleOrderbooks(t *testing.T) {
 	t.Parallel()
 	temp := []string{BTCAUD, LTCAUD, ETHAUD}
-	_, err := b.GetMultipleOrderbooks(temp)
+	_, err := b.GetMultipleOrderbooks(context.Background(), temp)
 	if err != nil {
 		t.Error(err)
 	}
@@ -125,7 +111,7 @@ func TestGetMultipleOrderbooks(t *testing.T) {
 
 func TestGetServerTime(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetServerTime()
+	_, err := b.GetServerTime(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -136,7 +122,7 @@ func TestGetAccountBalance(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetAccountBalance()
+	_, err := b.GetAccountBalance(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -147,7 +133,7 @@ func TestGetTradingFees(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetTradingFees()
+	_, err := b.GetTradingFees(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -158,15 +144,15 @@ func TestGetTradeHistory(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetTradeHistory(ETHAUD, "", -1, -1, -1)
+	_, err := b.GetTradeHistory(context.Background(), ETHAUD, "", -1, -1, -1)
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = b.GetTradeHistory(BTCAUD, "", -1, -1, 1)
+	_, err = b.GetTradeHistory(context.Background(), BTCAUD, "", -1, -1, 1)
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = b.GetTradeHistory(fakePair, "", -1, -1, -1)
+	_, err = b.GetTradeHistory(context.Background(), fakePair, "", -1, -1, -1)
 	if err == nil {
 		t.Error("expected an error due to invalid trading pair")
 	}
@@ -177,7 +163,7 @@ func TestGetTradeByID(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetTradeByID("4712043732")
+	_, err := b.GetTradeByID(context.Background(), "4712043732")
 	if err != nil {
 		t.Error(err)
 	}
@@ -188,15 +174,15 @@ func TestNewOrder(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
-	_, err := b.NewOrder(BTCAUD, 100, 1, limit, bid, 0, 0, "", true, "", "")
+	_, err := b.NewOrder(context.Background(), BTCAUD, 100, 1, limit, bid, 0, 0, "", true, "", "")
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = b.NewOrder(BTCAUD, 100, 1, "invalid", bid, 0, 0, "", true, "", "")
+	_, err = b.NewOrder(context.Background(), BTCAUD, 100, 1, "invalid", bid, 0, 0, "", true, "", "")
 	if err == nil {
 		t.Error("expected an error due to invalid ordertype")
 	}
-	_, err = b.NewOrder(BTCAUD, 100, 1, limit, "invalid", 0, 0, "", true, "", "")
+	_, err = b.NewOrder(context.Background(), BTCAUD, 100, 1, limit, "invalid", 0, 0, "", true, "", "")
 	if err == nil {
 		t.Error("expected an error due to invalid orderside")
 	}
@@ -207,11 +193,11 @@ func TestGetOrders(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetOrders("", -1, -1, 2, false)
+	_, err := b.GetOrders(context.Background(), "", -1, -1, 2, false)
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = b.GetOrders(LTCAUD, -1, -1, -1, true)
+	_, err = b.GetOrders(context.Background(), LTCAUD, -1, -1, -1, true)
 	if err != nil {
 		t.Error(err)
 	}
@@ -223,12 +209,12 @@ func TestCancelOpenOrders(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 	temp := []string{BTCAUD, LTCAUD}
-	_, err := b.CancelAllOpenOrdersByPairs(temp)
+	_, err := b.CancelAllOpenOrdersByPairs(context.Background(), temp)
 	if err != nil {
 		t.Error(err)
 	}
 	temp = []string{BTCAUD, fakePair}
-	_, err = b.CancelAllOpenOrdersByPairs(temp)
+	_, err = b.CancelAllOpenOrdersByPairs(context.Background(), temp)
 	if err == nil {
 		t.Error("expected an error due to invalid marketID")
 	}
@@ -239,11 +225,11 @@ func TestFetchOrder(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.FetchOrder("4477045999")
+	_, err := b.FetchOrder(context.Background(), "4477045999")
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = b.FetchOrder("696969")
+	_, err = b.FetchOrder(context.Background(), "696969")
 	if err == nil {
 		t.Error(err)
 	}
@@ -254,7 +240,7 @@ func TestRemoveOrder(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
-	_, err := b.RemoveOrder("")
+	_, err := b.RemoveOrder(context.Background(), "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -265,7 +251,7 @@ func TestListWithdrawals(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.ListWithdrawals(-1, -1, -1)
+	_, err := b.ListWithdrawals(context.Background(), -1, -1, -1)
 	if err != nil {
 		t.Error(err)
 	}
@@ -276,7 +262,7 @@ func TestGetWithdrawal(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetWithdrawal("4477381751")
+	_, err := b.GetWithdrawal(context.Background(), "4477381751")
 	if err != nil {
 		t.Error(err)
 	}
@@ -287,7 +273,7 @@ func TestListDeposits(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.ListDeposits(-1, -1, -1)
+	_, err := b.ListDeposits(context.Background(), -1, -1, -1)
 	if err != nil {
 		t.Error(err)
 	}
@@ -298,7 +284,7 @@ func TestGetDeposit(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetDeposit("4476769607")
+	_, err := b.GetDeposit(context.Background(), "4476769607")
 	if err != nil {
 		t.Error(err)
 	}
@@ -309,7 +295,7 @@ func TestListTransfers(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.ListTransfers(-1, -1, -1)
+	_, err := b.ListTransfers(context.Background(), -1, -1, -1)
 	if err != nil {
 		t.Error(err)
 	}
@@ -320,11 +306,11 @@ func TestGetTransfer(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetTransfer("4476769607")
+	_, err := b.GetTransfer(context.Background(), "4476769607")
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = b.GetTransfer("6969696")
+	_, err = b.GetTransfer(context.Background(), "6969696")
 	if err == nil {
 		t.Error("expected an error due to invalid transferID")
 	}
@@ -335,11 +321,11 @@ func TestFetchDepositAddress(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.FetchDepositAddress("LTC", -1, -1, -1)
+	_, err := b.FetchDepositAddress(context.Background(), "LTC", -1, -1, -1)
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = b.FetchDepositAddress(fakePair, -1, -1, -1)
+	_, err = b.FetchDepositAddress(context.Background(), fakePair, -1, -1, -1)
 	if err != nil {
 		t.Error("expected an error due to invalid assetID")
 	}
@@ -347,7 +333,7 @@ func TestFetchDepositAddress(t *testing.T) {
 
 func TestGetWithdrawalFees(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetWithdrawalFees()
+	_, err := b.GetWithdrawalFees(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -358,7 +344,7 @@ func TestListAssets(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.ListAssets()
+	_, err := b.ListAssets(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -369,7 +355,7 @@ func TestGetTransactions(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetTransactions("", -1, -1, -1)
+	_, err := b.GetTransactions(context.Background(), "", -1, -1, -1)
 	if err != nil {
 		t.Error(err)
 	}
@@ -380,7 +366,7 @@ func TestCreateNewReport(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.CreateNewReport("TransactionReport", "json")
+	_, err := b.CreateNewReport(context.Background(), "TransactionReport", "json")
 	if err != nil {
 		t.Error(err)
 	}
@@ -391,7 +377,7 @@ func TestGetReport(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetReport("1kv38epne5v7lek9f18m60idg6")
+	_, err := b.GetReport(context.Background(), "1kv38epne5v7lek9f18m60idg6")
 	if err != nil {
 		t.Error(err)
 	}
@@ -402,7 +388,7 @@ func TestRequestWithdaw(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
-	_, err := b.RequestWithdraw("BTC", 1, "sdjflajdslfjld", "", "", "", "")
+	_, err := b.RequestWithdraw(context.Background(), "BTC", 1, "sdjflajdslfjld", "", "", "", "")
 	if err == nil {
 		t.Error("expected an error due to invalid toAddress")
 	}
@@ -421,7 +407,7 @@ func TestBatchPlaceCancelOrders(t *testing.T) {
 		OrderType: order.Limit.String(),
 		Side:      bid,
 	}
-	_, err := b.BatchPlaceCancelOrders(nil, append(temp, o))
+	_, err := b.BatchPlaceCancelOrders(context.Background(), nil, append(temp, o))
 	if err != nil {
 		t.Error(err)
 	}
@@ -433,7 +419,7 @@ func TestGetBatchTrades(t *testing.T) {
 		t.Skip("API keys required but not set, skipping test")
 	}
 	temp := []string{"4477045999", "4477381751", "4476769607"}
-	_, err := b.GetBatchTrades(temp)
+	_, err := b.GetBatchTrades(context.Background(), temp)
 	if err != nil {
 		t.Error(err)
 	}
@@ -445,7 +431,7 @@ func TestCancelBatchOrders(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 	temp := []string{"4477045999", "4477381751", "4477381751"}
-	_, err := b.CancelBatchOrders(temp)
+	_, err := b.CancelBatchOrders(context.Background(), temp)
 	if err != nil {
 		t.Error(err)
 	}
@@ -456,7 +442,7 @@ func TestFetchAccountInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.FetchAccountInfo()
+	_, err := b.FetchAccountInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -468,7 +454,7 @@ func TestGetOrderHistory(t *testing.T) {
 		t.Skip("API keys required but not set, skipping test")
 	}
 
-	_, err := b.GetOrderHistory(&order.GetOrdersRequest{
+	_, err := b.GetOrderHistory(context.Background(), &order.GetOrdersRequest{
 		Side: order.Buy,
 	})
 	if err != nil {
@@ -479,7 +465,7 @@ func TestGetOrderHistory(t *testing.T) {
 func TestUpdateOrderbook(t *testing.T) {
 	t.Parallel()
 	cp := currency.NewPairWithDelimiter(currency.BTC.String(), currency.AUD.String(), "-")
-	_, err := b.UpdateOrderbook(cp, asset.Spot)
+	_, err := b.UpdateOrderbook(context.Background(), cp, asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
@@ -488,7 +474,7 @@ func TestUpdateOrderbook(t *testing.T) {
 func TestUpdateTicker(t *testing.T) {
 	t.Parallel()
 	cp := currency.NewPairWithDelimiter(currency.BTC.String(), currency.AUD.String(), "-")
-	_, err := b.UpdateTicker(cp, asset.Spot)
+	_, err := b.UpdateTicker(context.Background(), cp, asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
@@ -500,7 +486,7 @@ func TestGetActiveOrders(t *testing.T) {
 		t.Skip("API keys required but not set, skipping test")
 	}
 
-	_, err := b.GetActiveOrders(&order.GetOrdersRequest{})
+	_, err := b.GetActiveOrders(context.Background(), &order.GetOrdersRequest{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -723,11 +709,11 @@ func TestBTCMarkets_GetHistoricCandles(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = b.GetHistoricCandles(p, asset.Spot, time.Now().Add(-time.Hour*24).UTC(), time.Now().UTC(), kline.OneHour)
+	_, err = b.GetHistoricCandles(context.Background(), p, asset.Spot, time.Now().Add(-time.Hour*24).UTC(), time.Now().UTC(), kline.OneHour)
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = b.GetHistoricCandles(p, asset.Spot, time.Now().Add(-time.Hour*24).UTC(), time.Now().UTC(), kline.FifteenMin)
+	_, err = b.GetHistoricCandles(context.Background(), p, asset.Spot, time.Now().Add(-time.Hour*24).UTC(), time.Now().UTC(), kline.FifteenMin)
 	if err != nil {
 		if !errors.As(err, &kline.ErrorKline{}) {
 			t.Fatal(err)
@@ -742,7 +728,7 @@ func TestBTCMarkets_GetHistoricCandlesExtended(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = b.GetHistoricCandlesExtended(p, asset.Spot, start, end, kline.OneDay)
+	_, err = b.GetHistoricCandlesExtended(context.Background(), p, asset.Spot, start, end, kline.OneDay)
 	if err != nil {
 		t.Fatal(err)
 	}
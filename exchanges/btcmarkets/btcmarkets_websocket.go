@@ -159,6 +159,7 @@ func (b *BTCMarkets) wsHandleData(respRaw []byte) error {
 		}
 
 		b.Websocket.DataHandler <- stream.TradeData{
+			TID:          strconv.FormatInt(trade.TradeID, 10),
 			Timestamp:    trade.Timestamp,
 			CurrencyPair: p,
 			AssetType:    asset.Spot,
@@ -1,6 +1,7 @@
 package btcmarkets
 
 import (
+	"context"
 	"time"
 
 	"github.com/yurulab/gocryptotrader/exchanges/request"
@@ -35,22 +36,21 @@ type RateLimit struct {
 }
 
 // Limit limits the outbound requests
-func (r *RateLimit) Limit(f request.EndpointLimit) error {
+func (r *RateLimit) Limit(ctx context.Context, f request.EndpointLimit) error {
 	switch f {
 	case request.Auth:
-		time.Sleep(r.Auth.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.Auth.Reserve())
 	case orderFunc:
-		time.Sleep(r.OrderPlacement.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.OrderPlacement.Reserve())
 	case batchFunc:
-		time.Sleep(r.BatchOrders.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.BatchOrders.Reserve())
 	case withdrawFunc:
-		time.Sleep(r.WithdrawRequest.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.WithdrawRequest.Reserve())
 	case newReportFunc:
-		time.Sleep(r.CreateNewReport.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.CreateNewReport.Reserve())
 	default:
-		time.Sleep(r.UnAuth.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.UnAuth.Reserve())
 	}
-	return nil
 }
 
 // SetRateLimit returns the rate limit for the exchange
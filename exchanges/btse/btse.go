@@ -47,42 +47,42 @@ const (
 )
 
 // GetMarketsSummary stores market summary data
-func (b *BTSE) GetMarketsSummary() (*HighLevelMarketData, error) {
+func (b *BTSE) GetMarketsSummary(ctx context.Context) (*HighLevelMarketData, error) {
 	var m HighLevelMarketData
-	return &m, b.SendHTTPRequest(http.MethodGet, btseMarketOverview, &m, true)
+	return &m, b.SendHTTPRequest(ctx, http.MethodGet, btseMarketOverview, &m, true)
 }
 
 // GetSpotMarkets returns a list of spot markets available on BTSE
-func (b *BTSE) GetSpotMarkets() ([]SpotMarket, error) {
+func (b *BTSE) GetSpotMarkets(ctx context.Context) ([]SpotMarket, error) {
 	var m []SpotMarket
-	return m, b.SendHTTPRequest(http.MethodGet, btseMarkets, &m, true)
+	return m, b.SendHTTPRequest(ctx, http.MethodGet, btseMarkets, &m, true)
 }
 
 // GetFuturesMarkets returns a list of futures markets available on BTSE
-func (b *BTSE) GetFuturesMarkets() ([]FuturesMarket, error) {
+func (b *BTSE) GetFuturesMarkets(ctx context.Context) ([]FuturesMarket, error) {
 	var m []FuturesMarket
-	return m, b.SendHTTPRequest(http.MethodGet, btseMarketOverview, &m, false)
+	return m, b.SendHTTPRequest(ctx, http.MethodGet, btseMarketOverview, &m, false)
 }
 
 // FetchOrderBook gets orderbook data for a given pair
-func (b *BTSE) FetchOrderBook(symbol string) (*Orderbook, error) {
+func (b *BTSE) FetchOrderBook(ctx context.Context, symbol string) (*Orderbook, error) {
 	var o Orderbook
 	endpoint := fmt.Sprintf("%s/%s", btseOrderbook, symbol)
-	return &o, b.SendHTTPRequest(http.MethodGet, endpoint, &o, true)
+	return &o, b.SendHTTPRequest(ctx, http.MethodGet, endpoint, &o, true)
 }
 
 // GetTrades returns a list of trades for the specified symbol
-func (b *BTSE) GetTrades(symbol string) ([]Trade, error) {
+func (b *BTSE) GetTrades(ctx context.Context, symbol string) ([]Trade, error) {
 	var t []Trade
 	endpoint := fmt.Sprintf("%s/%s", btseTrades, symbol)
-	return t, b.SendHTTPRequest(http.MethodGet, endpoint, &t, true)
+	return t, b.SendHTTPRequest(ctx, http.MethodGet, endpoint, &t, true)
 }
 
 // GetTicker returns the ticker for a specified symbol
-func (b *BTSE) GetTicker(symbol string) (*Ticker, error) {
+func (b *BTSE) GetTicker(ctx context.Context, symbol string) (*Ticker, error) {
 	var t Ticker
 	endpoint := fmt.Sprintf("%s/%s", btseTicker, symbol)
-	err := b.SendHTTPRequest(http.MethodGet, endpoint, &t, true)
+	err := b.SendHTTPRequest(ctx, http.MethodGet, endpoint, &t, true)
 	if err != nil {
 		return nil, err
 	}
@@ -90,26 +90,26 @@ func (b *BTSE) GetTicker(symbol string) (*Ticker, error) {
 }
 
 // GetMarketStatistics gets market statistics for a specificed market
-func (b *BTSE) GetMarketStatistics(symbol string) (*MarketStatistics, error) {
+func (b *BTSE) GetMarketStatistics(ctx context.Context, symbol string) (*MarketStatistics, error) {
 	var m MarketStatistics
 	endpoint := fmt.Sprintf("%s/%s", btseStats, symbol)
-	return &m, b.SendHTTPRequest(http.MethodGet, endpoint, &m, true)
+	return &m, b.SendHTTPRequest(ctx, http.MethodGet, endpoint, &m, true)
 }
 
 // GetServerTime returns the exchanges server time
-func (b *BTSE) GetServerTime() (*ServerTime, error) {
+func (b *BTSE) GetServerTime(ctx context.Context) (*ServerTime, error) {
 	var s ServerTime
-	return &s, b.SendHTTPRequest(http.MethodGet, btseTime, &s, true)
+	return &s, b.SendHTTPRequest(ctx, http.MethodGet, btseTime, &s, true)
 }
 
 // GetAccountBalance returns the users account balance
-func (b *BTSE) GetAccountBalance() ([]CurrencyBalance, error) {
+func (b *BTSE) GetAccountBalance(ctx context.Context) ([]CurrencyBalance, error) {
 	var a []CurrencyBalance
-	return a, b.SendAuthenticatedHTTPRequest(http.MethodGet, btseAccount, nil, &a, true)
+	return a, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, btseAccount, nil, &a, true)
 }
 
 // CreateOrder creates an order
-func (b *BTSE) CreateOrder(amount, price float64, side, orderType, symbol, timeInForce, tag string) (*string, error) {
+func (b *BTSE) CreateOrder(ctx context.Context, amount, price float64, side, orderType, symbol, timeInForce, tag string) (*string, error) {
 	req := make(map[string]interface{})
 	req["amount"] = amount
 	req["price"] = price
@@ -134,30 +134,30 @@ func (b *BTSE) CreateOrder(amount, price float64, side, orderType, symbol, timeI
 	}
 
 	var r orderResp
-	return &r.ID, b.SendAuthenticatedHTTPRequest(http.MethodPost, btseOrder, req, &r, true)
+	return &r.ID, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, btseOrder, req, &r, true)
 }
 
 // GetOrders returns all pending orders
-func (b *BTSE) GetOrders(symbol string) ([]OpenOrder, error) {
+func (b *BTSE) GetOrders(ctx context.Context, symbol string) ([]OpenOrder, error) {
 	req := make(map[string]interface{})
 	if symbol != "" {
 		req["symbol"] = symbol
 	}
 	var o []OpenOrder
-	return o, b.SendAuthenticatedHTTPRequest(http.MethodGet, btsePendingOrders, req, &o, true)
+	return o, b.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, btsePendingOrders, req, &o, true)
 }
 
 // CancelExistingOrder cancels an order
-func (b *BTSE) CancelExistingOrder(orderID, symbol string) (*CancelOrder, error) {
+func (b *BTSE) CancelExistingOrder(ctx context.Context, orderID, symbol string) (*CancelOrder, error) {
 	var c CancelOrder
 	req := make(map[string]interface{})
 	req["order_id"] = orderID
 	req["symbol"] = symbol
-	return &c, b.SendAuthenticatedHTTPRequest(http.MethodPost, btseDeleteOrder, req, &c, true)
+	return &c, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, btseDeleteOrder, req, &c, true)
 }
 
 // GetFills gets all filled orders
-func (b *BTSE) GetFills(orderID, symbol, before, after, limit, username string) ([]FilledOrder, error) {
+func (b *BTSE) GetFills(ctx context.Context, orderID, symbol, before, after, limit, username string) ([]FilledOrder, error) {
 	if orderID != "" && symbol != "" {
 		return nil, errors.New("orderID and symbol cannot co-exist in the same query")
 	} else if orderID == "" && symbol == "" {
@@ -189,16 +189,16 @@ func (b *BTSE) GetFills(orderID, symbol, before, after, limit, username string)
 	}
 
 	var o []FilledOrder
-	return o, b.SendAuthenticatedHTTPRequest(http.MethodPost, btseFills, req, &o, true)
+	return o, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, btseFills, req, &o, true)
 }
 
 // SendHTTPRequest sends an HTTP request to the desired endpoint
-func (b *BTSE) SendHTTPRequest(method, endpoint string, result interface{}, spotEndpoint bool) error {
+func (b *BTSE) SendHTTPRequest(ctx context.Context, method, endpoint string, result interface{}, spotEndpoint bool) error {
 	p := btseSPOTAPIPath
 	if !spotEndpoint {
 		p = btseFuturesAPIPath
 	}
-	return b.SendPayload(context.Background(), &request.Item{
+	return b.SendPayload(ctx, &request.Item{
 		Method:        method,
 		Path:          b.API.Endpoints.URL + p + endpoint,
 		Result:        result,
@@ -209,7 +209,7 @@ func (b *BTSE) SendHTTPRequest(method, endpoint string, result interface{}, spot
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated HTTP request to the desired endpoint
-func (b *BTSE) SendAuthenticatedHTTPRequest(method, endpoint string, req map[string]interface{}, result interface{}, spotEndpoint bool) error {
+func (b *BTSE) SendAuthenticatedHTTPRequest(ctx context.Context, method, endpoint string, req map[string]interface{}, result interface{}, spotEndpoint bool) error {
 	if !b.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet,
 			b.Name)
@@ -223,7 +223,7 @@ func (b *BTSE) SendAuthenticatedHTTPRequest(method, endpoint string, req map[str
 	path := p + endpoint
 	headers := make(map[string]string)
 	headers["btse-api"] = b.API.Credentials.Key
-	nonce := strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 10)
+	nonce := strconv.FormatInt(b.Now().UnixNano()/int64(time.Millisecond), 10)
 	headers["btse-nonce"] = nonce
 	var body io.Reader
 	var hmac []byte
@@ -254,7 +254,7 @@ func (b *BTSE) SendAuthenticatedHTTPRequest(method, endpoint string, req map[str
 			b.Name, method, path, string(payload))
 	}
 
-	return b.SendPayload(context.Background(), &request.Item{
+	return b.SendPayload(ctx, &request.Item{
 		Method:        method,
 		Path:          b.API.Endpoints.URL + path,
 		Headers:       headers,
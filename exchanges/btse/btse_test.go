@@ -1,17 +1,14 @@
 package btse
 
 import (
-	"log"
-	"os"
+	"context"
 	"strings"
 	"testing"
 
-	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/core"
 	"github.com/yurulab/gocryptotrader/currency"
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
-	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
 )
 
 // Please supply your own keys here to do better tests
@@ -24,36 +21,13 @@ const (
 
 var b BTSE
 
-func TestMain(m *testing.M) {
-	b.SetDefaults()
-	cfg := config.GetConfig()
-	err := cfg.LoadConfig("../../testdata/configtest.json", true)
-	if err != nil {
-		log.Fatal(err)
-	}
-	btseConfig, err := cfg.GetExchangeConfig("BTSE")
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	btseConfig.API.AuthenticatedSupport = true
-	btseConfig.API.Credentials.Key = apiKey
-	btseConfig.API.Credentials.Secret = apiSecret
-	b.Websocket = sharedtestvalues.NewTestWebsocket()
-	err = b.Setup(btseConfig)
-	if err != nil {
-		log.Fatal(err)
-	}
-	os.Exit(m.Run())
-}
-
 func areTestAPIKeysSet() bool {
 	return b.ValidateAPICredentials()
 }
 
 func TestGetMarketsSummary(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarketsSummary()
+	_, err := b.GetMarketsSummary(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -61,7 +35,7 @@ func TestGetMarketsSummary(t *testing.T) {
 
 func TestGetSpotMarkets(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetSpotMarkets()
+	_, err := b.GetSpotMarkets(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -69,7 +43,7 @@ func TestGetSpotMarkets(t *testing.T) {
 
 func TestGetFuturesMarkets(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetFuturesMarkets()
+	_, err := b.GetFuturesMarkets(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -77,7 +51,7 @@ func TestGetFuturesMarkets(t *testing.T) {
 
 func TestFetchOrderBook(t *testing.T) {
 	t.Parallel()
-	_, err := b.FetchOrderBook(testPair)
+	_, err := b.FetchOrderBook(context.Background(), testPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -85,7 +59,7 @@ func TestFetchOrderBook(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTrades(testPair)
+	_, err := b.GetTrades(context.Background(), testPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -93,7 +67,7 @@ func TestGetTrades(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTicker(testPair)
+	_, err := b.GetTicker(context.Background(), testPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -101,7 +75,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetMarketStatistics(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarketStatistics(testPair)
+	_, err := b.GetMarketStatistics(context.Background(), testPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -109,7 +83,7 @@ func TestGetMarketStatistics(t *testing.T) {
 
 func TestGetServerTime(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetServerTime()
+	_, err := b.GetServerTime(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -120,7 +94,7 @@ func TestGetAccount(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys not set, skipping test")
 	}
-	_, err := b.GetAccountBalance()
+	_, err := b.GetAccountBalance(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -131,7 +105,7 @@ func TestGetFills(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys not set, skipping test")
 	}
-	_, err := b.GetFills("", testPair, "", "", "", "")
+	_, err := b.GetFills(context.Background(), "", testPair, "", "", "", "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -142,7 +116,7 @@ func TestCreateOrder(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
-	_, err := b.CreateOrder(0.1,
+	_, err := b.CreateOrder(context.Background(), 0.1,
 		10000,
 		order.Sell.String(),
 		order.Limit.String(),
@@ -159,7 +133,7 @@ func TestGetOrders(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys not set, skipping test")
 	}
-	_, err := b.GetOrders("")
+	_, err := b.GetOrders(context.Background(), "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -174,7 +148,7 @@ func TestGetActiveOrders(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := b.GetActiveOrders(&getOrdersRequest)
+	_, err := b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if err != nil {
 		t.Error(err)
 	}
@@ -188,7 +162,7 @@ func TestGetOrderHistory(t *testing.T) {
 	var getOrdersRequest = order.GetOrdersRequest{
 		Type: order.AnyType,
 	}
-	_, err := b.GetOrderHistory(&getOrdersRequest)
+	_, err := b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if err != nil {
 		t.Error(err)
 	}
@@ -213,7 +187,7 @@ func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 		PurchasePrice: 1000,
 	}
 
-	b.GetFeeByType(feeBuilder)
+	b.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -313,7 +287,7 @@ func TestSubmitOrder(t *testing.T) {
 		Amount:   0.1,
 		ClientID: "meowOrder",
 	}
-	response, err := b.SubmitOrder(orderSubmission)
+	response, err := b.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -335,7 +309,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		AccountID:     "1",
 		Pair:          currencyPair,
 	}
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 	if err != nil {
 		t.Error(err)
 	}
@@ -355,7 +329,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		AccountID:     "1",
 		Pair:          currencyPair,
 	}
-	resp, err := b.CancelAllOrders(orderCancellation)
+	resp, err := b.CancelAllOrders(context.Background(), orderCancellation)
 
 	if err != nil {
 		t.Errorf("Could not cancel orders: %v", err)
@@ -421,7 +395,7 @@ func TestStatusToStandardStatus(t *testing.T) {
 func TestFetchTradablePairs(t *testing.T) {
 	assets := b.GetAssetTypes()
 	for i := range assets {
-		data, err := b.FetchTradablePairs(assets[i])
+		data, err := b.FetchTradablePairs(context.Background(), assets[i])
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -212,6 +212,7 @@ func (b *BTSE) wsHandleData(respRaw []byte) error {
 				return err
 			}
 			b.Websocket.DataHandler <- stream.TradeData{
+				TID:          strconv.FormatInt(tradeHistory.Data[x].ID, 10),
 				Timestamp:    time.Unix(0, tradeHistory.Data[x].TransactionTime*int64(time.Millisecond)),
 				CurrencyPair: p,
 				AssetType:    a,
@@ -1,6 +1,7 @@
 package btse
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -39,7 +40,7 @@ func (b *BTSE) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if b.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = b.UpdateTradablePairs(true)
+		err = b.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -187,7 +188,7 @@ func (b *BTSE) Run() {
 		return
 	}
 
-	err := b.UpdateTradablePairs(false)
+	err := b.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s Failed to update tradable pairs. Error: %s", b.Name, err)
@@ -195,10 +196,10 @@ func (b *BTSE) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (b *BTSE) FetchTradablePairs(a asset.Item) ([]string, error) {
+func (b *BTSE) FetchTradablePairs(ctx context.Context, a asset.Item) ([]string, error) {
 	var currencies []string
 	if a == asset.Spot {
-		m, err := b.GetSpotMarkets()
+		m, err := b.GetSpotMarkets(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -210,7 +211,7 @@ func (b *BTSE) FetchTradablePairs(a asset.Item) ([]string, error) {
 			currencies = append(currencies, m[x].Symbol)
 		}
 	} else if a == asset.Futures {
-		m, err := b.GetFuturesMarkets()
+		m, err := b.GetFuturesMarkets(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -228,10 +229,10 @@ func (b *BTSE) FetchTradablePairs(a asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (b *BTSE) UpdateTradablePairs(forceUpdate bool) error {
+func (b *BTSE) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
 	a := b.GetAssetTypes()
 	for i := range a {
-		pairs, err := b.FetchTradablePairs(a[i])
+		pairs, err := b.FetchTradablePairs(ctx, a[i])
 		if err != nil {
 			return err
 		}
@@ -250,7 +251,7 @@ func (b *BTSE) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *BTSE) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *BTSE) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	if assetType == asset.Futures {
 		// Futures REST implementation needs to be done before this can be
 		// removed
@@ -262,12 +263,12 @@ func (b *BTSE) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pric
 		return nil, err
 	}
 
-	t, err := b.GetTicker(fpair.String())
+	t, err := b.GetTicker(ctx, fpair.String())
 	if err != nil {
 		return nil, err
 	}
 
-	s, err := b.GetMarketStatistics(fpair.String())
+	s, err := b.GetMarketStatistics(ctx, fpair.String())
 	if err != nil {
 		return nil, err
 	}
@@ -290,25 +291,25 @@ func (b *BTSE) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pric
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (b *BTSE) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *BTSE) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (b *BTSE) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *BTSE) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(p, assetType)
+		return b.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *BTSE) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *BTSE) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	if assetType == asset.Futures {
 		// Futures REST implementation needs to be done before this can be
 		// removed
@@ -319,7 +320,7 @@ func (b *BTSE) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderboo
 	if err != nil {
 		return nil, err
 	}
-	a, err := b.FetchOrderBook(fpair.String())
+	a, err := b.FetchOrderBook(ctx, fpair.String())
 	if err != nil {
 		return nil, err
 	}
@@ -347,9 +348,9 @@ func (b *BTSE) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderboo
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // BTSE exchange
-func (b *BTSE) UpdateAccountInfo() (account.Holdings, error) {
+func (b *BTSE) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var a account.Holdings
-	balance, err := b.GetAccountBalance()
+	balance, err := b.GetAccountBalance(ctx)
 	if err != nil {
 		return a, err
 	}
@@ -380,10 +381,10 @@ func (b *BTSE) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (b *BTSE) FetchAccountInfo() (account.Holdings, error) {
+func (b *BTSE) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(b.Name)
 	if err != nil {
-		return b.UpdateAccountInfo()
+		return b.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -391,17 +392,17 @@ func (b *BTSE) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *BTSE) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *BTSE) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data within the timeframe provided.
-func (b *BTSE) GetExchangeHistory(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+func (b *BTSE) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *BTSE) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (b *BTSE) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var resp order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return resp, err
@@ -412,7 +413,7 @@ func (b *BTSE) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		return resp, err
 	}
 
-	r, err := b.CreateOrder(s.Amount,
+	r, err := b.CreateOrder(ctx, s.Amount,
 		s.Price,
 		s.Side.String(),
 		s.Type.String(),
@@ -435,19 +436,19 @@ func (b *BTSE) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *BTSE) ModifyOrder(action *order.Modify) (string, error) {
+func (b *BTSE) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *BTSE) CancelOrder(order *order.Cancel) error {
+func (b *BTSE) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	fpair, err := b.FormatExchangeCurrency(order.Pair,
 		order.AssetType)
 	if err != nil {
 		return err
 	}
 
-	r, err := b.CancelExistingOrder(order.ID, fpair.String())
+	r, err := b.CancelExistingOrder(ctx, order.ID, fpair.String())
 	if err != nil {
 		return err
 	}
@@ -465,9 +466,9 @@ func (b *BTSE) CancelOrder(order *order.Cancel) error {
 // CancelAllOrders cancels all orders associated with a currency pair
 // If product ID is sent, all orders of that specified market will be cancelled
 // If not specified, all orders of all markets will be cancelled
-func (b *BTSE) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
+func (b *BTSE) CancelAllOrders(ctx context.Context, orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
 	var resp order.CancelAllResponse
-	markets, err := b.GetSpotMarkets()
+	markets, err := b.GetSpotMarkets(ctx)
 	if err != nil {
 		return resp, err
 	}
@@ -491,13 +492,13 @@ func (b *BTSE) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAll
 		if fair.String() != checkPair {
 			continue
 		} else {
-			orders, err := b.GetOrders(checkPair)
+			orders, err := b.GetOrders(ctx, checkPair)
 			if err != nil {
 				return resp, err
 			}
 			for y := range orders {
 				success := "Order Cancelled"
-				_, err = b.CancelExistingOrder(orders[y].Order.ID, checkPair)
+				_, err = b.CancelExistingOrder(ctx, orders[y].Order.ID, checkPair)
 				if err != nil {
 					success = "Order Cancellation Failed"
 				}
@@ -509,8 +510,8 @@ func (b *BTSE) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAll
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *BTSE) GetOrderInfo(orderID string) (order.Detail, error) {
-	o, err := b.GetOrders("")
+func (b *BTSE) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
+	o, err := b.GetOrders(ctx, "")
 	if err != nil {
 		return order.Detail{}, err
 	}
@@ -556,7 +557,7 @@ func (b *BTSE) GetOrderInfo(orderID string) (order.Detail, error) {
 		od.Price = o[i].Price
 		od.Status = order.Status(o[i].Status)
 
-		fills, err := b.GetFills(orderID, "", "", "", "", "")
+		fills, err := b.GetFills(ctx, orderID, "", "", "", "", "")
 		if err != nil {
 			return od,
 				fmt.Errorf("unable to get order fills for orderID %s",
@@ -584,31 +585,31 @@ func (b *BTSE) GetOrderInfo(orderID string) (order.Detail, error) {
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *BTSE) GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error) {
+func (b *BTSE) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *BTSE) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (b *BTSE) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *BTSE) WithdrawFiatFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (b *BTSE) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *BTSE) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (b *BTSE) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (b *BTSE) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	resp, err := b.GetOrders("")
+func (b *BTSE) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	resp, err := b.GetOrders(ctx, "")
 	if err != nil {
 		return nil, err
 	}
@@ -654,7 +655,7 @@ func (b *BTSE) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, err
 			Status:   order.Status(resp[i].Status),
 		}
 
-		fills, err := b.GetFills(resp[i].ID, "", "", "", "", "")
+		fills, err := b.GetFills(ctx, resp[i].ID, "", "", "", "", "")
 		if err != nil {
 			log.Errorf(log.ExchangeSys,
 				"%s: Unable to get order fills for orderID %s",
@@ -692,12 +693,12 @@ func (b *BTSE) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, err
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (b *BTSE) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *BTSE) GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (b *BTSE) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *BTSE) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !b.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
@@ -707,17 +708,17 @@ func (b *BTSE) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (b *BTSE) ValidateCredentials() error {
-	_, err := b.UpdateAccountInfo()
+func (b *BTSE) ValidateCredentials(ctx context.Context) error {
+	_, err := b.UpdateAccountInfo(ctx)
 	return b.CheckTransientError(err)
 }
 
 // GetHistoricCandles returns candles between a time period for a set time interval
-func (b *BTSE) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (b *BTSE) GetHistoricCandles(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	return kline.Item{}, common.ErrFunctionNotSupported
 }
 
 // GetHistoricCandlesExtended returns candles between a time period for a set time interval
-func (b *BTSE) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (b *BTSE) GetHistoricCandlesExtended(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	return kline.Item{}, common.ErrFunctionNotSupported
 }
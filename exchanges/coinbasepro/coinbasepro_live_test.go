@@ -0,0 +1,41 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package coinbasepro
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	c.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("coinbasepro load config error", err)
+	}
+	gdxConfig, err := cfg.GetExchangeConfig("CoinbasePro")
+	if err != nil {
+		log.Fatal("coinbasepro Setup() init error")
+	}
+	gdxConfig.API.Credentials.Key = apiKey
+	gdxConfig.API.Credentials.Secret = apiSecret
+	gdxConfig.API.Credentials.ClientID = clientID
+	gdxConfig.API.AuthenticatedSupport = true
+	gdxConfig.API.AuthenticatedWebsocketSupport = true
+	c.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = c.Setup(gdxConfig)
+	if err != nil {
+		log.Fatal("CoinbasePro setup error", err)
+	}
+	log.Printf(sharedtestvalues.LiveTesting, c.Name, c.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
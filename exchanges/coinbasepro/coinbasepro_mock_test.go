@@ -0,0 +1,56 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package coinbasepro
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/coinbasepro/coinbasepro.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	c.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("coinbasepro load config error", err)
+	}
+	gdxConfig, err := cfg.GetExchangeConfig("CoinbasePro")
+	if err != nil {
+		log.Fatal("coinbasepro Setup() init error")
+	}
+	gdxConfig.API.Credentials.Key = apiKey
+	gdxConfig.API.Credentials.Secret = apiSecret
+	gdxConfig.API.Credentials.ClientID = clientID
+	gdxConfig.API.AuthenticatedSupport = true
+	gdxConfig.API.AuthenticatedWebsocketSupport = true
+	c.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = c.Setup(gdxConfig)
+	if err != nil {
+		log.Fatal("CoinbasePro setup error", err)
+	}
+
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+
+	c.HTTPClient = newClient
+	c.API.Endpoints.URL = serverDetails + "/"
+	log.Printf(sharedtestvalues.MockTesting, c.Name, c.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
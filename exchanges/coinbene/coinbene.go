@@ -76,27 +76,27 @@ const (
 )
 
 // GetAllPairs gets all pairs on the exchange
-func (c *Coinbene) GetAllPairs() ([]PairData, error) {
+func (c *Coinbene) GetAllPairs(ctx context.Context) ([]PairData, error) {
 	resp := struct {
 		Data []PairData `json:"data"`
 	}{}
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneGetAllPairs
-	return resp.Data, c.SendHTTPRequest(path, spotPairs, &resp)
+	return resp.Data, c.SendHTTPRequest(ctx, path, spotPairs, &resp)
 }
 
 // GetPairInfo gets info about a single pair
-func (c *Coinbene) GetPairInfo(symbol string) (PairData, error) {
+func (c *Coinbene) GetPairInfo(ctx context.Context, symbol string) (PairData, error) {
 	resp := struct {
 		Data PairData `json:"data"`
 	}{}
 	params := url.Values{}
 	params.Set("symbol", symbol)
 	path := common.EncodeURLValues(c.API.Endpoints.URL+coinbeneAPIVersion+coinbenePairInfo, params)
-	return resp.Data, c.SendHTTPRequest(path, spotPairInfo, &resp)
+	return resp.Data, c.SendHTTPRequest(ctx, path, spotPairInfo, &resp)
 }
 
 // GetOrderbook gets and stores orderbook data for given pair
-func (c *Coinbene) GetOrderbook(symbol string, size int64) (Orderbook, error) {
+func (c *Coinbene) GetOrderbook(ctx context.Context, symbol string, size int64) (Orderbook, error) {
 	resp := struct {
 		Data struct {
 			Asks [][]string `json:"asks"`
@@ -109,7 +109,7 @@ func (c *Coinbene) GetOrderbook(symbol string, size int64) (Orderbook, error) {
 	params.Set("symbol", symbol)
 	params.Set("depth", strconv.FormatInt(size, 10))
 	path := common.EncodeURLValues(c.API.Endpoints.URL+coinbeneAPIVersion+coinbeneGetOrderBook, params)
-	err := c.SendHTTPRequest(path, spotOrderbook, &resp)
+	err := c.SendHTTPRequest(ctx, path, spotOrderbook, &resp)
 	if err != nil {
 		return Orderbook{}, err
 	}
@@ -148,28 +148,28 @@ func (c *Coinbene) GetOrderbook(symbol string, size int64) (Orderbook, error) {
 }
 
 // GetTicker gets and stores ticker data for a currency pair
-func (c *Coinbene) GetTicker(symbol string) (TickerData, error) {
+func (c *Coinbene) GetTicker(ctx context.Context, symbol string) (TickerData, error) {
 	resp := struct {
 		TickerData TickerData `json:"data"`
 	}{}
 	params := url.Values{}
 	params.Set("symbol", symbol)
 	path := common.EncodeURLValues(c.API.Endpoints.URL+coinbeneAPIVersion+coinbeneGetTicker, params)
-	return resp.TickerData, c.SendHTTPRequest(path, spotSpecificTicker, &resp)
+	return resp.TickerData, c.SendHTTPRequest(ctx, path, spotSpecificTicker, &resp)
 }
 
 // GetTickers gets and all spot tickers supported by the exchange
-func (c *Coinbene) GetTickers() ([]TickerData, error) {
+func (c *Coinbene) GetTickers(ctx context.Context) ([]TickerData, error) {
 	resp := struct {
 		TickerData []TickerData `json:"data"`
 	}{}
 
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneGetTickersSpot
-	return resp.TickerData, c.SendHTTPRequest(path, spotTickerList, &resp)
+	return resp.TickerData, c.SendHTTPRequest(ctx, path, spotTickerList, &resp)
 }
 
 // GetTrades gets recent trades from the exchange
-func (c *Coinbene) GetTrades(symbol string) (Trades, error) {
+func (c *Coinbene) GetTrades(ctx context.Context, symbol string) (Trades, error) {
 	resp := struct {
 		Data [][]string `json:"data"`
 	}{}
@@ -177,7 +177,7 @@ func (c *Coinbene) GetTrades(symbol string) (Trades, error) {
 	params := url.Values{}
 	params.Set("symbol", symbol)
 	path := common.EncodeURLValues(c.API.Endpoints.URL+coinbeneAPIVersion+coinbeneGetTrades, params)
-	err := c.SendHTTPRequest(path, spotMarketTrades, &resp)
+	err := c.SendHTTPRequest(ctx, path, spotMarketTrades, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -208,12 +208,12 @@ func (c *Coinbene) GetTrades(symbol string) (Trades, error) {
 }
 
 // GetAccountBalances gets user balanace info
-func (c *Coinbene) GetAccountBalances() ([]UserBalanceData, error) {
+func (c *Coinbene) GetAccountBalances(ctx context.Context) ([]UserBalanceData, error) {
 	resp := struct {
 		Data []UserBalanceData `json:"data"`
 	}{}
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneGetUserBalance
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneGetUserBalance,
 		false,
@@ -227,14 +227,14 @@ func (c *Coinbene) GetAccountBalances() ([]UserBalanceData, error) {
 }
 
 // GetAccountAssetBalance gets user balanace info
-func (c *Coinbene) GetAccountAssetBalance(symbol string) (UserBalanceData, error) {
+func (c *Coinbene) GetAccountAssetBalance(ctx context.Context, symbol string) (UserBalanceData, error) {
 	v := url.Values{}
 	v.Set("asset", symbol)
 	resp := struct {
 		Data UserBalanceData `json:"data"`
 	}{}
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneAccountBalanceOne
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneAccountBalanceOne,
 		false,
@@ -248,7 +248,7 @@ func (c *Coinbene) GetAccountAssetBalance(symbol string) (UserBalanceData, error
 }
 
 // PlaceSpotOrder creates an order
-func (c *Coinbene) PlaceSpotOrder(price, quantity float64, symbol, direction,
+func (c *Coinbene) PlaceSpotOrder(ctx context.Context, price, quantity float64, symbol, direction,
 	orderType, clientID string, notional int) (OrderPlacementResponse, error) {
 	var resp OrderPlacementResponse
 	params := url.Values{}
@@ -289,7 +289,7 @@ func (c *Coinbene) PlaceSpotOrder(price, quantity float64, symbol, direction,
 		params.Set("notional", strconv.Itoa(notional))
 	}
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbenePlaceOrder
-	err := c.SendAuthHTTPRequest(http.MethodPost,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodPost,
 		path,
 		coinbenePlaceOrder,
 		false,
@@ -303,7 +303,7 @@ func (c *Coinbene) PlaceSpotOrder(price, quantity float64, symbol, direction,
 }
 
 // PlaceSpotOrders sets a batchful order request
-func (c *Coinbene) PlaceSpotOrders(orders []PlaceOrderRequest) ([]OrderPlacementResponse, error) {
+func (c *Coinbene) PlaceSpotOrders(ctx context.Context, orders []PlaceOrderRequest) ([]OrderPlacementResponse, error) {
 	if len(orders) == 0 {
 		return nil, errors.New("orders is nil")
 	}
@@ -359,7 +359,7 @@ func (c *Coinbene) PlaceSpotOrders(orders []PlaceOrderRequest) ([]OrderPlacement
 		Data []OrderPlacementResponse `json:"data"`
 	}{}
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneBatchPlaceOrder
-	err := c.SendAuthHTTPRequest(http.MethodPost,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodPost,
 		path,
 		coinbeneBatchPlaceOrder,
 		false,
@@ -373,7 +373,7 @@ func (c *Coinbene) PlaceSpotOrders(orders []PlaceOrderRequest) ([]OrderPlacement
 }
 
 // FetchOpenSpotOrders finds open orders
-func (c *Coinbene) FetchOpenSpotOrders(symbol string) (OrdersInfo, error) {
+func (c *Coinbene) FetchOpenSpotOrders(ctx context.Context, symbol string) (OrdersInfo, error) {
 	params := url.Values{}
 	params.Set("symbol", symbol)
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneOpenOrders
@@ -383,7 +383,7 @@ func (c *Coinbene) FetchOpenSpotOrders(symbol string) (OrdersInfo, error) {
 			Data OrdersInfo `json:"data"`
 		}{}
 		params.Set("pageNum", strconv.FormatInt(i, 10))
-		err := c.SendAuthHTTPRequest(http.MethodGet,
+		err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 			path,
 			coinbeneOpenOrders,
 			false,
@@ -405,7 +405,7 @@ func (c *Coinbene) FetchOpenSpotOrders(symbol string) (OrdersInfo, error) {
 }
 
 // FetchClosedOrders finds open orders
-func (c *Coinbene) FetchClosedOrders(symbol, latestID string) (OrdersInfo, error) {
+func (c *Coinbene) FetchClosedOrders(ctx context.Context, symbol, latestID string) (OrdersInfo, error) {
 	params := url.Values{}
 	params.Set("symbol", symbol)
 	params.Set("latestOrderId", latestID)
@@ -416,7 +416,7 @@ func (c *Coinbene) FetchClosedOrders(symbol, latestID string) (OrdersInfo, error
 			Data OrdersInfo `json:"data"`
 		}{}
 		params.Set("pageNum", strconv.FormatInt(i, 10))
-		err := c.SendAuthHTTPRequest(http.MethodGet,
+		err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 			path,
 			coinbeneClosedOrders,
 			false,
@@ -437,14 +437,14 @@ func (c *Coinbene) FetchClosedOrders(symbol, latestID string) (OrdersInfo, error
 }
 
 // FetchSpotOrderInfo gets order info
-func (c *Coinbene) FetchSpotOrderInfo(orderID string) (OrderInfo, error) {
+func (c *Coinbene) FetchSpotOrderInfo(ctx context.Context, orderID string) (OrderInfo, error) {
 	resp := struct {
 		Data OrderInfo `json:"data"`
 	}{}
 	params := url.Values{}
 	params.Set("orderId", orderID)
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneOrderInfo
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneOrderInfo,
 		false,
@@ -462,14 +462,14 @@ func (c *Coinbene) FetchSpotOrderInfo(orderID string) (OrderInfo, error) {
 }
 
 // GetSpotOrderFills returns a list of fills related to an order ID
-func (c *Coinbene) GetSpotOrderFills(orderID string) ([]OrderFills, error) {
+func (c *Coinbene) GetSpotOrderFills(ctx context.Context, orderID string) ([]OrderFills, error) {
 	resp := struct {
 		Data []OrderFills `json:"data"`
 	}{}
 	params := url.Values{}
 	params.Set("orderId", orderID)
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneTradeFills
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneTradeFills,
 		false,
@@ -483,14 +483,14 @@ func (c *Coinbene) GetSpotOrderFills(orderID string) ([]OrderFills, error) {
 }
 
 // CancelSpotOrder removes a given order
-func (c *Coinbene) CancelSpotOrder(orderID string) (string, error) {
+func (c *Coinbene) CancelSpotOrder(ctx context.Context, orderID string) (string, error) {
 	resp := struct {
 		Data string `json:"data"`
 	}{}
 	req := make(map[string]interface{})
 	req["orderId"] = orderID
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneCancelOrder
-	err := c.SendAuthHTTPRequest(http.MethodPost,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodPost,
 		path,
 		coinbeneCancelOrder,
 		false,
@@ -504,7 +504,7 @@ func (c *Coinbene) CancelSpotOrder(orderID string) (string, error) {
 }
 
 // CancelSpotOrders cancels a batch of orders
-func (c *Coinbene) CancelSpotOrders(orderIDs []string) ([]OrderCancellationResponse, error) {
+func (c *Coinbene) CancelSpotOrders(ctx context.Context, orderIDs []string) ([]OrderCancellationResponse, error) {
 	req := make(map[string]interface{})
 	req["orderIds"] = orderIDs
 	type resp struct {
@@ -513,7 +513,7 @@ func (c *Coinbene) CancelSpotOrders(orderIDs []string) ([]OrderCancellationRespo
 
 	var r resp
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneBatchCancel
-	err := c.SendAuthHTTPRequest(http.MethodPost,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodPost,
 		path,
 		coinbeneBatchCancel,
 		false,
@@ -527,13 +527,13 @@ func (c *Coinbene) CancelSpotOrders(orderIDs []string) ([]OrderCancellationRespo
 }
 
 // GetSwapTickers returns a map of swap tickers
-func (c *Coinbene) GetSwapTickers() (SwapTickers, error) {
+func (c *Coinbene) GetSwapTickers(ctx context.Context) (SwapTickers, error) {
 	type resp struct {
 		Data SwapTickers `json:"data"`
 	}
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneGetTickers
-	err := c.SendHTTPRequest(path, contractTickers, &r)
+	err := c.SendHTTPRequest(ctx, path, contractTickers, &r)
 	if err != nil {
 		return nil, err
 	}
@@ -541,8 +541,8 @@ func (c *Coinbene) GetSwapTickers() (SwapTickers, error) {
 }
 
 // GetSwapTicker returns a specific swap ticker
-func (c *Coinbene) GetSwapTicker(symbol string) (SwapTicker, error) {
-	tickers, err := c.GetSwapTickers()
+func (c *Coinbene) GetSwapTicker(ctx context.Context, symbol string) (SwapTicker, error) {
+	tickers, err := c.GetSwapTickers(ctx)
 	if err != nil {
 		return SwapTicker{}, err
 	}
@@ -555,7 +555,7 @@ func (c *Coinbene) GetSwapTicker(symbol string) (SwapTicker, error) {
 }
 
 // GetSwapOrderbook returns an orderbook for the specified currency
-func (c *Coinbene) GetSwapOrderbook(symbol string, size int64) (Orderbook, error) {
+func (c *Coinbene) GetSwapOrderbook(ctx context.Context, symbol string, size int64) (Orderbook, error) {
 	var s Orderbook
 	if symbol == "" {
 		return s, fmt.Errorf("a symbol must be specified")
@@ -578,7 +578,7 @@ func (c *Coinbene) GetSwapOrderbook(symbol string, size int64) (Orderbook, error
 
 	var r resp
 	path := common.EncodeURLValues(coinbeneSwapAPIURL+coinbeneAPIVersion+coinbeneGetOrderBook, v)
-	err := c.SendHTTPRequest(path, contractOrderbook, &r)
+	err := c.SendHTTPRequest(ctx, path, contractOrderbook, &r)
 	if err != nil {
 		return s, err
 	}
@@ -622,7 +622,7 @@ func (c *Coinbene) GetSwapOrderbook(symbol string, size int64) (Orderbook, error
 }
 
 // GetKlines data returns the kline data for a specific symbol
-func (c *Coinbene) GetKlines(pair string, start, end time.Time, period string) (resp CandleResponse, err error) {
+func (c *Coinbene) GetKlines(ctx context.Context, pair string, start, end time.Time, period string) (resp CandleResponse, err error) {
 	v := url.Values{}
 	v.Add("symbol", pair)
 	if !start.IsZero() {
@@ -634,7 +634,7 @@ func (c *Coinbene) GetKlines(pair string, start, end time.Time, period string) (
 	v.Add("period", period)
 
 	path := common.EncodeURLValues(coinbeneAPIURL+coinbeneAPIVersion+coinbeneSpotKlines, v)
-	if err = c.SendHTTPRequest(path, contractKline, &resp); err != nil {
+	if err = c.SendHTTPRequest(ctx, path, contractKline, &resp); err != nil {
 		return
 	}
 
@@ -646,7 +646,7 @@ func (c *Coinbene) GetKlines(pair string, start, end time.Time, period string) (
 }
 
 // GetSwapKlines data returns the kline data for a specific symbol
-func (c *Coinbene) GetSwapKlines(symbol string, start, end time.Time, resolution string) (resp CandleResponse, err error) {
+func (c *Coinbene) GetSwapKlines(ctx context.Context, symbol string, start, end time.Time, resolution string) (resp CandleResponse, err error) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	if !start.IsZero() {
@@ -658,7 +658,7 @@ func (c *Coinbene) GetSwapKlines(symbol string, start, end time.Time, resolution
 	v.Set("resolution", resolution)
 
 	path := common.EncodeURLValues(coinbeneSwapAPIURL+coinbeneAPIVersion+coinbeneGetKlines, v)
-	if err = c.SendHTTPRequest(path, contractKline, &resp); err != nil {
+	if err = c.SendHTTPRequest(ctx, path, contractKline, &resp); err != nil {
 		return
 	}
 
@@ -666,7 +666,7 @@ func (c *Coinbene) GetSwapKlines(symbol string, start, end time.Time, resolution
 }
 
 // GetSwapTrades returns a list of trades for a swap symbol
-func (c *Coinbene) GetSwapTrades(symbol string, limit int) (SwapTrades, error) {
+func (c *Coinbene) GetSwapTrades(ctx context.Context, symbol string, limit int) (SwapTrades, error) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	if limit != 0 {
@@ -677,7 +677,7 @@ func (c *Coinbene) GetSwapTrades(symbol string, limit int) (SwapTrades, error) {
 	}
 	var r resp
 	path := common.EncodeURLValues(coinbeneSwapAPIURL+coinbeneAPIVersion+coinbeneGetTrades, v)
-	if err := c.SendHTTPRequest(path, contractTrades, &r); err != nil {
+	if err := c.SendHTTPRequest(ctx, path, contractTrades, &r); err != nil {
 		return nil, err
 	}
 
@@ -710,13 +710,13 @@ func (c *Coinbene) GetSwapTrades(symbol string, limit int) (SwapTrades, error) {
 }
 
 // GetSwapAccountInfo returns a users swap account balance info
-func (c *Coinbene) GetSwapAccountInfo() (SwapAccountInfo, error) {
+func (c *Coinbene) GetSwapAccountInfo(ctx context.Context) (SwapAccountInfo, error) {
 	type resp struct {
 		Data SwapAccountInfo `json:"data"`
 	}
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneAccountInfo
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneAccountInfo,
 		true,
@@ -730,7 +730,7 @@ func (c *Coinbene) GetSwapAccountInfo() (SwapAccountInfo, error) {
 }
 
 // GetSwapPositions returns a list of open swap positions
-func (c *Coinbene) GetSwapPositions(symbol string) (SwapPositions, error) {
+func (c *Coinbene) GetSwapPositions(ctx context.Context, symbol string) (SwapPositions, error) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	type resp struct {
@@ -738,7 +738,7 @@ func (c *Coinbene) GetSwapPositions(symbol string) (SwapPositions, error) {
 	}
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneListSwapPositions
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneListSwapPositions,
 		true,
@@ -752,7 +752,7 @@ func (c *Coinbene) GetSwapPositions(symbol string) (SwapPositions, error) {
 }
 
 // PlaceSwapOrder places a swap order
-func (c *Coinbene) PlaceSwapOrder(symbol, direction, orderType, marginMode,
+func (c *Coinbene) PlaceSwapOrder(ctx context.Context, symbol, direction, orderType, marginMode,
 	clientID string, price, quantity float64, leverage int) (SwapPlaceOrderResponse, error) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
@@ -793,7 +793,7 @@ func (c *Coinbene) PlaceSwapOrder(symbol, direction, orderType, marginMode,
 	}
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbenePlaceOrder
-	err := c.SendAuthHTTPRequest(http.MethodPost,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodPost,
 		path,
 		coinbenePlaceOrder,
 		true,
@@ -807,7 +807,7 @@ func (c *Coinbene) PlaceSwapOrder(symbol, direction, orderType, marginMode,
 }
 
 // CancelSwapOrder cancels a swap order
-func (c *Coinbene) CancelSwapOrder(orderID string) (string, error) {
+func (c *Coinbene) CancelSwapOrder(ctx context.Context, orderID string) (string, error) {
 	params := make(map[string]interface{})
 	params["orderId"] = orderID
 	type resp struct {
@@ -815,7 +815,7 @@ func (c *Coinbene) CancelSwapOrder(orderID string) (string, error) {
 	}
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneCancelOrder
-	err := c.SendAuthHTTPRequest(http.MethodPost,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodPost,
 		path,
 		coinbeneCancelOrder,
 		true,
@@ -829,7 +829,7 @@ func (c *Coinbene) CancelSwapOrder(orderID string) (string, error) {
 }
 
 // GetSwapOpenOrders gets a list of open swap orders
-func (c *Coinbene) GetSwapOpenOrders(symbol string, pageNum, pageSize int) (SwapOrders, error) {
+func (c *Coinbene) GetSwapOpenOrders(ctx context.Context, symbol string, pageNum, pageSize int) (SwapOrders, error) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	if pageNum != 0 {
@@ -843,7 +843,7 @@ func (c *Coinbene) GetSwapOpenOrders(symbol string, pageNum, pageSize int) (Swap
 	}
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneOpenOrders
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneOpenOrders,
 		true,
@@ -857,7 +857,7 @@ func (c *Coinbene) GetSwapOpenOrders(symbol string, pageNum, pageSize int) (Swap
 }
 
 // GetSwapOpenOrdersByPage gets a list of open orders by page
-func (c *Coinbene) GetSwapOpenOrdersByPage(symbol string, latestOrderID int64) (SwapOrders, error) {
+func (c *Coinbene) GetSwapOpenOrdersByPage(ctx context.Context, symbol string, latestOrderID int64) (SwapOrders, error) {
 	v := url.Values{}
 	if symbol != "" {
 		v.Set("symbol", symbol)
@@ -870,7 +870,7 @@ func (c *Coinbene) GetSwapOpenOrdersByPage(symbol string, latestOrderID int64) (
 	}
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneOpenOrdersByPage
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneOpenOrdersByPage,
 		true,
@@ -884,7 +884,7 @@ func (c *Coinbene) GetSwapOpenOrdersByPage(symbol string, latestOrderID int64) (
 }
 
 // GetSwapOrderInfo gets order info for a specific order
-func (c *Coinbene) GetSwapOrderInfo(orderID string) (SwapOrder, error) {
+func (c *Coinbene) GetSwapOrderInfo(ctx context.Context, orderID string) (SwapOrder, error) {
 	v := url.Values{}
 	v.Set("orderId", orderID)
 	type resp struct {
@@ -892,7 +892,7 @@ func (c *Coinbene) GetSwapOrderInfo(orderID string) (SwapOrder, error) {
 	}
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneOrderInfo
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneOrderInfo,
 		true,
@@ -906,7 +906,7 @@ func (c *Coinbene) GetSwapOrderInfo(orderID string) (SwapOrder, error) {
 }
 
 // GetSwapOrderHistory returns the swap order history for a given symbol
-func (c *Coinbene) GetSwapOrderHistory(beginTime, endTime, symbol string, pageNum,
+func (c *Coinbene) GetSwapOrderHistory(ctx context.Context, beginTime, endTime, symbol string, pageNum,
 	pageSize int, direction, orderType string) (SwapOrders, error) {
 	v := url.Values{}
 	if beginTime != "" {
@@ -935,7 +935,7 @@ func (c *Coinbene) GetSwapOrderHistory(beginTime, endTime, symbol string, pageNu
 
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneClosedOrders
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneClosedOrders,
 		true,
@@ -949,7 +949,7 @@ func (c *Coinbene) GetSwapOrderHistory(beginTime, endTime, symbol string, pageNu
 }
 
 // GetSwapOrderHistoryByOrderID returns a list of historic orders based on user params
-func (c *Coinbene) GetSwapOrderHistoryByOrderID(beginTime, endTime, symbol, status string,
+func (c *Coinbene) GetSwapOrderHistoryByOrderID(ctx context.Context, beginTime, endTime, symbol, status string,
 	latestOrderID int64) (SwapOrders, error) {
 	v := url.Values{}
 	if beginTime != "" {
@@ -973,7 +973,7 @@ func (c *Coinbene) GetSwapOrderHistoryByOrderID(beginTime, endTime, symbol, stat
 
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneClosedOrdersByPage
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneClosedOrdersByPage,
 		true,
@@ -987,7 +987,7 @@ func (c *Coinbene) GetSwapOrderHistoryByOrderID(beginTime, endTime, symbol, stat
 }
 
 // CancelSwapOrders cancels multiple swap order IDs
-func (c *Coinbene) CancelSwapOrders(orderIDs []string) ([]OrderCancellationResponse, error) {
+func (c *Coinbene) CancelSwapOrders(ctx context.Context, orderIDs []string) ([]OrderCancellationResponse, error) {
 	if len(orderIDs) > 10 {
 		return nil, errors.New("only 10 orderIDs are allowed at a time")
 	}
@@ -999,7 +999,7 @@ func (c *Coinbene) CancelSwapOrders(orderIDs []string) ([]OrderCancellationRespo
 
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneBatchCancel
-	err := c.SendAuthHTTPRequest(http.MethodPost,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodPost,
 		path,
 		coinbeneBatchCancel,
 		true,
@@ -1013,7 +1013,7 @@ func (c *Coinbene) CancelSwapOrders(orderIDs []string) ([]OrderCancellationRespo
 }
 
 // GetSwapOrderFills returns a list of swap order fills
-func (c *Coinbene) GetSwapOrderFills(symbol, orderID string, lastTradeID int64) (SwapOrderFills, error) {
+func (c *Coinbene) GetSwapOrderFills(ctx context.Context, symbol, orderID string, lastTradeID int64) (SwapOrderFills, error) {
 	v := url.Values{}
 	if symbol != "" {
 		v.Set("symbol", symbol)
@@ -1030,7 +1030,7 @@ func (c *Coinbene) GetSwapOrderFills(symbol, orderID string, lastTradeID int64)
 
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneOrderFills
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneOrderFills,
 		true,
@@ -1044,7 +1044,7 @@ func (c *Coinbene) GetSwapOrderFills(symbol, orderID string, lastTradeID int64)
 }
 
 // GetSwapFundingRates returns a list of funding rates
-func (c *Coinbene) GetSwapFundingRates(pageNum, pageSize int) ([]SwapFundingRate, error) {
+func (c *Coinbene) GetSwapFundingRates(ctx context.Context, pageNum, pageSize int) ([]SwapFundingRate, error) {
 	v := url.Values{}
 	if pageNum != 0 {
 		v.Set("pageNum", strconv.Itoa(pageNum))
@@ -1058,7 +1058,7 @@ func (c *Coinbene) GetSwapFundingRates(pageNum, pageSize int) ([]SwapFundingRate
 
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbenePositionFeeRate
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbenePositionFeeRate,
 		true,
@@ -1072,14 +1072,14 @@ func (c *Coinbene) GetSwapFundingRates(pageNum, pageSize int) ([]SwapFundingRate
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (c *Coinbene) SendHTTPRequest(path string, f request.EndpointLimit, result interface{}) error {
+func (c *Coinbene) SendHTTPRequest(ctx context.Context, path string, f request.EndpointLimit, result interface{}) error {
 	var resp json.RawMessage
 	errCap := struct {
 		Code    int    `json:"code"`
 		Message string `json:"message"`
 	}{}
 
-	if err := c.SendPayload(context.Background(), &request.Item{
+	if err := c.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        &resp,
@@ -1100,7 +1100,7 @@ func (c *Coinbene) SendHTTPRequest(path string, f request.EndpointLimit, result
 }
 
 // SendAuthHTTPRequest sends an authenticated HTTP request
-func (c *Coinbene) SendAuthHTTPRequest(method, path, epPath string, isSwap bool,
+func (c *Coinbene) SendAuthHTTPRequest(ctx context.Context, method, path, epPath string, isSwap bool,
 	params, result interface{}, f request.EndpointLimit) error {
 	if !c.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet,
@@ -1111,7 +1111,7 @@ func (c *Coinbene) SendAuthHTTPRequest(method, path, epPath string, isSwap bool,
 	if isSwap {
 		authPath = coinbeneSwapAuthPath
 	}
-	now := time.Now()
+	now := c.Now()
 	timestamp := now.UTC().Format("2006-01-02T15:04:05.999Z")
 	var finalBody io.Reader
 	var preSign string
@@ -1160,7 +1160,7 @@ func (c *Coinbene) SendAuthHTTPRequest(method, path, epPath string, isSwap bool,
 	}{}
 
 	// Expiry of timestamp doesn't appear to be documented, so making a reasonable assumption
-	ctx, cancel := context.WithDeadline(context.Background(), now.Add(15*time.Second))
+	ctx, cancel := context.WithDeadline(ctx, now.Add(15*time.Second))
 	defer cancel()
 	if err := c.SendPayload(ctx, &request.Item{
 		Method:        method,
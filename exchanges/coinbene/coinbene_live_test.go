@@ -0,0 +1,40 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package coinbene
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	c.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal(err)
+	}
+	coinbeneConfig, err := cfg.GetExchangeConfig("Coinbene")
+	if err != nil {
+		log.Fatal(err)
+	}
+	coinbeneConfig.API.AuthenticatedWebsocketSupport = true
+	coinbeneConfig.API.AuthenticatedSupport = true
+	coinbeneConfig.API.Credentials.Secret = testAPISecret
+	coinbeneConfig.API.Credentials.Key = testAPIKey
+	c.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = c.Setup(coinbeneConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf(sharedtestvalues.LiveTesting, c.Name, c.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
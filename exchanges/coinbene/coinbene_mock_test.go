@@ -0,0 +1,55 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package coinbene
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/coinbene/coinbene.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	c.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal(err)
+	}
+	coinbeneConfig, err := cfg.GetExchangeConfig("Coinbene")
+	if err != nil {
+		log.Fatal(err)
+	}
+	coinbeneConfig.API.AuthenticatedWebsocketSupport = true
+	coinbeneConfig.API.AuthenticatedSupport = true
+	coinbeneConfig.API.Credentials.Secret = testAPISecret
+	coinbeneConfig.API.Credentials.Key = testAPIKey
+	c.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = c.Setup(coinbeneConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+
+	c.HTTPClient = newClient
+	c.API.Endpoints.URL = serverDetails + "/api/exchange/"
+	log.Printf(sharedtestvalues.MockTesting, c.Name, c.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
@@ -1,17 +1,14 @@
 package coinbene
 
 import (
-	"log"
-	"os"
+	"context"
 	"testing"
 	"time"
 
-	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/currency"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
 	"github.com/yurulab/gocryptotrader/exchanges/kline"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
-	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
 )
 
 // Please supply your own keys here for due diligence testing
@@ -25,36 +22,13 @@ const (
 
 var c Coinbene
 
-func TestMain(m *testing.M) {
-	c.SetDefaults()
-	cfg := config.GetConfig()
-	err := cfg.LoadConfig("../../testdata/configtest.json", true)
-	if err != nil {
-		log.Fatal(err)
-	}
-	coinbeneConfig, err := cfg.GetExchangeConfig("Coinbene")
-	if err != nil {
-		log.Fatal(err)
-	}
-	coinbeneConfig.API.AuthenticatedWebsocketSupport = true
-	coinbeneConfig.API.AuthenticatedSupport = true
-	coinbeneConfig.API.Credentials.Secret = testAPISecret
-	coinbeneConfig.API.Credentials.Key = testAPIKey
-	c.Websocket = sharedtestvalues.NewTestWebsocket()
-	err = c.Setup(coinbeneConfig)
-	if err != nil {
-		log.Fatal(err)
-	}
-	os.Exit(m.Run())
-}
-
 func areTestAPIKeysSet() bool {
 	return c.AllowAuthenticatedRequest()
 }
 
 func TestGetAllPairs(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetAllPairs()
+	_, err := c.GetAllPairs(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -62,7 +36,7 @@ func TestGetAllPairs(t *testing.T) {
 
 func TestGetPairInfo(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetPairInfo(spotTestPair)
+	_, err := c.GetPairInfo(context.Background(), spotTestPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -70,7 +44,7 @@ func TestGetPairInfo(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetOrderbook(spotTestPair, 100)
+	_, err := c.GetOrderbook(context.Background(), spotTestPair, 100)
 	if err != nil {
 		t.Error(err)
 	}
@@ -78,7 +52,7 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetTicker(spotTestPair)
+	_, err := c.GetTicker(context.Background(), spotTestPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -86,7 +60,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetTrades(spotTestPair)
+	_, err := c.GetTrades(context.Background(), spotTestPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -97,7 +71,7 @@ func TestGetAcounntBalances(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.GetAccountBalances()
+	_, err := c.GetAccountBalances(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -108,7 +82,7 @@ func TestGetAccountAssetBalance(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.GetAccountAssetBalance(currency.BTC.String())
+	_, err := c.GetAccountAssetBalance(context.Background(), currency.BTC.String())
 	if err != nil {
 		t.Error(err)
 	}
@@ -119,7 +93,7 @@ func TestPlaceOrder(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
-	_, err := c.PlaceSpotOrder(
+	_, err := c.PlaceSpotOrder(context.Background(),
 		1,
 		1,
 		spotTestPair,
@@ -139,7 +113,7 @@ func TestPlaceOrders(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.PlaceSpotOrders(
+	_, err := c.PlaceSpotOrders(context.Background(),
 		[]PlaceOrderRequest{
 			{
 				1,
@@ -161,7 +135,7 @@ func TestFetchOpenOrders(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.FetchOpenSpotOrders(spotTestPair)
+	_, err := c.FetchOpenSpotOrders(context.Background(), spotTestPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -172,7 +146,7 @@ func TestFetchClosedOrders(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.FetchClosedOrders(spotTestPair, "")
+	_, err := c.FetchClosedOrders(context.Background(), spotTestPair, "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -183,7 +157,7 @@ func TestFetchOrderInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.FetchSpotOrderInfo("adfjashjgsag")
+	_, err := c.FetchSpotOrderInfo(context.Background(), "adfjashjgsag")
 	if err != nil {
 		t.Error(err)
 	}
@@ -194,7 +168,7 @@ func TestGetSpotOrderFills(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.GetSpotOrderFills("1912131427156307968")
+	_, err := c.GetSpotOrderFills(context.Background(), "1912131427156307968")
 	if err != nil {
 		t.Error(err)
 	}
@@ -205,7 +179,7 @@ func TestCancelSpotOrder(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
-	_, err := c.CancelSpotOrder("adfjashjgsag")
+	_, err := c.CancelSpotOrder(context.Background(), "adfjashjgsag")
 	if err != nil {
 		t.Error(err)
 	}
@@ -217,7 +191,7 @@ func TestCancelSpotOrders(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.CancelSpotOrders([]string{"578639816552972288", "578639902896914432"})
+	_, err := c.CancelSpotOrders(context.Background(), []string{"578639816552972288", "578639902896914432"})
 	if err != nil {
 		t.Error(err)
 	}
@@ -226,11 +200,11 @@ func TestCancelSpotOrders(t *testing.T) {
 func TestUpdateTicker(t *testing.T) {
 	t.Parallel()
 	cp := currency.NewPairWithDelimiter("BTC", "USDT", "/")
-	_, err := c.UpdateTicker(cp, asset.Spot)
+	_, err := c.UpdateTicker(context.Background(), cp, asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = c.UpdateTicker(cp, asset.PerpetualSwap)
+	_, err = c.UpdateTicker(context.Background(), cp, asset.PerpetualSwap)
 	if err != nil {
 		t.Error(err)
 	}
@@ -241,7 +215,7 @@ func TestGetAccountInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.UpdateAccountInfo()
+	_, err := c.UpdateAccountInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -250,11 +224,11 @@ func TestGetAccountInfo(t *testing.T) {
 func TestUpdateOrderbook(t *testing.T) {
 	t.Parallel()
 	cp := currency.NewPairWithDelimiter("BTC", "USDT", "/")
-	_, err := c.UpdateOrderbook(cp, asset.Spot)
+	_, err := c.UpdateOrderbook(context.Background(), cp, asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = c.UpdateOrderbook(cp, asset.PerpetualSwap)
+	_, err = c.UpdateOrderbook(context.Background(), cp, asset.PerpetualSwap)
 	if err != nil {
 		t.Error(err)
 	}
@@ -262,7 +236,7 @@ func TestUpdateOrderbook(t *testing.T) {
 
 func TestGetSwapTickers(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetSwapTickers()
+	_, err := c.GetSwapTickers(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -270,7 +244,7 @@ func TestGetSwapTickers(t *testing.T) {
 
 func TestGetSwapTicker(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetSwapTicker(swapTestPair)
+	_, err := c.GetSwapTicker(context.Background(), swapTestPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -278,7 +252,7 @@ func TestGetSwapTicker(t *testing.T) {
 
 func TestGetSwapOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetSwapOrderbook(swapTestPair, 100)
+	_, err := c.GetSwapOrderbook(context.Background(), swapTestPair, 100)
 	if err != nil {
 		t.Error(err)
 	}
@@ -290,7 +264,7 @@ func TestGetKlines(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = c.GetKlines(p.String(),
+	_, err = c.GetKlines(context.Background(), p.String(),
 		time.Now().Add(-time.Hour*1), time.Now(), "1")
 	if err != nil {
 		t.Fatal(err)
@@ -303,7 +277,7 @@ func TestGetSwapKlines(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = c.GetSwapKlines(p.String(),
+	_, err = c.GetSwapKlines(context.Background(), p.String(),
 		time.Now().Add(-time.Hour*1), time.Now(), "1")
 	if err != nil {
 		t.Error(err)
@@ -312,7 +286,7 @@ func TestGetSwapKlines(t *testing.T) {
 
 func TestGetSwapTrades(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetSwapTrades(swapTestPair, 10)
+	_, err := c.GetSwapTrades(context.Background(), swapTestPair, 10)
 	if err != nil {
 		t.Error(err)
 	}
@@ -323,7 +297,7 @@ func TestGetSwapAccountInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.GetSwapAccountInfo()
+	_, err := c.GetSwapAccountInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -334,7 +308,7 @@ func TestGetSwapPositions(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.GetSwapPositions(swapTestPair)
+	_, err := c.GetSwapPositions(context.Background(), swapTestPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -346,7 +320,7 @@ func TestPlaceSwapOrder(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.PlaceSwapOrder(swapTestPair,
+	_, err := c.PlaceSwapOrder(context.Background(), swapTestPair,
 		order.Buy.Lower(),
 		"limit",
 		"fixed",
@@ -365,7 +339,7 @@ func TestCancelSwapOrder(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.CancelSwapOrder("1337")
+	_, err := c.CancelSwapOrder(context.Background(), "1337")
 	if err != nil {
 		t.Error(err)
 	}
@@ -377,7 +351,7 @@ func TestGetOpenSwapOrders(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.GetSwapOpenOrders(swapTestPair, 0, 0)
+	_, err := c.GetSwapOpenOrders(context.Background(), swapTestPair, 0, 0)
 	if err != nil {
 		t.Error(err)
 	}
@@ -389,7 +363,7 @@ func TestGetSwapOpenOrdersByPage(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.GetSwapOpenOrdersByPage(swapTestPair, 0)
+	_, err := c.GetSwapOpenOrdersByPage(context.Background(), swapTestPair, 0)
 	if err != nil {
 		t.Error(err)
 	}
@@ -401,7 +375,7 @@ func TestGetSwapOrderInfo(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.GetSwapOrderInfo("1337")
+	_, err := c.GetSwapOrderInfo(context.Background(), "1337")
 	if err != nil {
 		t.Error(err)
 	}
@@ -413,7 +387,7 @@ func TestGetSwapOrderHistory(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.GetSwapOrderHistory("", "", swapTestPair, 1, 10, "", "")
+	_, err := c.GetSwapOrderHistory(context.Background(), "", "", swapTestPair, 1, 10, "", "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -425,7 +399,7 @@ func TestGetSwapOrderHistoryByOrderID(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.GetSwapOrderHistoryByOrderID("", "", swapTestPair, "", 0)
+	_, err := c.GetSwapOrderHistoryByOrderID(context.Background(), "", "", swapTestPair, "", 0)
 	if err != nil {
 		t.Error(err)
 	}
@@ -437,7 +411,7 @@ func TestCancelSwapOrders(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.CancelSwapOrders([]string{"578639816552972288", "578639902896914432"})
+	_, err := c.CancelSwapOrders(context.Background(), []string{"578639816552972288", "578639902896914432"})
 	if err != nil {
 		t.Error(err)
 	}
@@ -449,7 +423,7 @@ func TestGetSwapOrderFills(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.GetSwapOrderFills(swapTestPair, "5807143157122003", 580714315825905664)
+	_, err := c.GetSwapOrderFills(context.Background(), swapTestPair, "5807143157122003", 580714315825905664)
 	if err != nil {
 		t.Error(err)
 	}
@@ -461,7 +435,7 @@ func TestGetSwapFundingRates(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.GetSwapFundingRates(1, 2)
+	_, err := c.GetSwapFundingRates(context.Background(), 1, 2)
 	if err != nil {
 		t.Error(err)
 	}
@@ -703,7 +677,7 @@ func TestGetHistoricCandles(t *testing.T) {
 		t.Fatal(err)
 	}
 	startTime := time.Now().Add(-time.Hour * 24)
-	_, err = c.GetHistoricCandles(currencyPair, asset.Spot, startTime, time.Now(), kline.OneHour)
+	_, err = c.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.OneHour)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -712,7 +686,7 @@ func TestGetHistoricCandles(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = c.GetHistoricCandles(currencyPairSwap, asset.PerpetualSwap, startTime, time.Now(), kline.OneHour)
+	_, err = c.GetHistoricCandles(context.Background(), currencyPairSwap, asset.PerpetualSwap, startTime, time.Now(), kline.OneHour)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -724,7 +698,7 @@ func TestGetHistoricCandlesExtended(t *testing.T) {
 		t.Fatal(err)
 	}
 	startTime := time.Now().Add(-time.Hour * 24)
-	_, err = c.GetHistoricCandlesExtended(currencyPair, asset.Spot, startTime, time.Now(), kline.OneHour)
+	_, err = c.GetHistoricCandlesExtended(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.OneHour)
 	if err != nil {
 		t.Fatal(err)
 	}
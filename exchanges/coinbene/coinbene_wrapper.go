@@ -1,6 +1,7 @@
 package coinbene
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -39,7 +40,7 @@ func (c *Coinbene) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if c.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = c.UpdateTradablePairs(true)
+		err = c.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -218,7 +219,7 @@ func (c *Coinbene) Run() {
 		return
 	}
 
-	err := c.UpdateTradablePairs(false)
+	err := c.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s Failed to update tradable pairs. Error: %s",
@@ -228,7 +229,7 @@ func (c *Coinbene) Run() {
 }
 
 // FetchTradablePairs returns a list of exchange tradable pairs
-func (c *Coinbene) FetchTradablePairs(a asset.Item) ([]string, error) {
+func (c *Coinbene) FetchTradablePairs(ctx context.Context, a asset.Item) ([]string, error) {
 	if !c.SupportsAsset(a) {
 		return nil, fmt.Errorf("%s does not support asset type %s", c.Name, a)
 	}
@@ -236,7 +237,7 @@ func (c *Coinbene) FetchTradablePairs(a asset.Item) ([]string, error) {
 	var currencies []string
 	switch a {
 	case asset.Spot:
-		pairs, err := c.GetAllPairs()
+		pairs, err := c.GetAllPairs(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -250,7 +251,7 @@ func (c *Coinbene) FetchTradablePairs(a asset.Item) ([]string, error) {
 			return nil, err
 		}
 
-		tickers, err := c.GetSwapTickers()
+		tickers, err := c.GetSwapTickers(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -269,10 +270,10 @@ func (c *Coinbene) FetchTradablePairs(a asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them
-func (c *Coinbene) UpdateTradablePairs(forceUpdate bool) error {
+func (c *Coinbene) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
 	assets := c.GetAssetTypes()
 	for x := range assets {
-		pairs, err := c.FetchTradablePairs(assets[x])
+		pairs, err := c.FetchTradablePairs(ctx, assets[x])
 		if err != nil {
 			return err
 		}
@@ -291,7 +292,7 @@ func (c *Coinbene) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (c *Coinbene) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (c *Coinbene) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	if !c.SupportsAsset(assetType) {
 		return nil,
 			fmt.Errorf("%s does not support asset type %s", c.Name, assetType)
@@ -304,7 +305,7 @@ func (c *Coinbene) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.
 
 	switch assetType {
 	case asset.Spot:
-		tickers, err := c.GetTickers()
+		tickers, err := c.GetTickers(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -335,7 +336,7 @@ func (c *Coinbene) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.
 			}
 		}
 	case asset.PerpetualSwap:
-		tickers, err := c.GetSwapTickers()
+		tickers, err := c.GetSwapTickers(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -374,7 +375,7 @@ func (c *Coinbene) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (c *Coinbene) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (c *Coinbene) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	if !c.SupportsAsset(assetType) {
 		return nil,
 			fmt.Errorf("%s does not support asset type %s", c.Name, assetType)
@@ -382,13 +383,13 @@ func (c *Coinbene) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.P
 
 	tickerNew, err := ticker.GetTicker(c.Name, p, assetType)
 	if err != nil {
-		return c.UpdateTicker(p, assetType)
+		return c.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (c *Coinbene) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (c *Coinbene) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	if !c.SupportsAsset(assetType) {
 		return nil,
 			fmt.Errorf("%s does not support asset type %s", c.Name, assetType)
@@ -396,13 +397,13 @@ func (c *Coinbene) FetchOrderbook(p currency.Pair, assetType asset.Item) (*order
 
 	ob, err := orderbook.Get(c.Name, p, assetType)
 	if err != nil {
-		return c.UpdateOrderbook(p, assetType)
+		return c.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (c *Coinbene) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (c *Coinbene) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	resp := new(orderbook.Base)
 	if !c.SupportsAsset(assetType) {
 		return nil,
@@ -417,11 +418,11 @@ func (c *Coinbene) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orde
 	var tempResp Orderbook
 	switch assetType {
 	case asset.Spot:
-		tempResp, err = c.GetOrderbook(fpair.String(),
+		tempResp, err = c.GetOrderbook(ctx, fpair.String(),
 			100, // TO-DO: Update this once we support configurable orderbook depth
 		)
 	case asset.PerpetualSwap:
-		tempResp, err = c.GetSwapOrderbook(fpair.String(),
+		tempResp, err = c.GetSwapOrderbook(ctx, fpair.String(),
 			100, // TO-DO: Update this once we support configurable orderbook depth
 		)
 	}
@@ -460,9 +461,9 @@ func (c *Coinbene) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orde
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // Coinbene exchange
-func (c *Coinbene) UpdateAccountInfo() (account.Holdings, error) {
+func (c *Coinbene) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var info account.Holdings
-	balance, err := c.GetAccountBalances()
+	balance, err := c.GetAccountBalances(ctx)
 	if err != nil {
 		return info, err
 	}
@@ -490,10 +491,10 @@ func (c *Coinbene) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (c *Coinbene) FetchAccountInfo() (account.Holdings, error) {
+func (c *Coinbene) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(c.Name)
 	if err != nil {
-		return c.UpdateAccountInfo()
+		return c.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -501,17 +502,17 @@ func (c *Coinbene) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (c *Coinbene) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (c *Coinbene) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data within the timeframe provided.
-func (c *Coinbene) GetExchangeHistory(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+func (c *Coinbene) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // SubmitOrder submits a new order
-func (c *Coinbene) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (c *Coinbene) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var resp order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return resp, err
@@ -528,7 +529,7 @@ func (c *Coinbene) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		return resp, err
 	}
 
-	tempResp, err := c.PlaceSpotOrder(s.Price,
+	tempResp, err := c.PlaceSpotOrder(ctx, s.Price,
 		s.Amount,
 		fpair.String(),
 		s.Side.String(),
@@ -545,18 +546,18 @@ func (c *Coinbene) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (c *Coinbene) ModifyOrder(action *order.Modify) (string, error) {
+func (c *Coinbene) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (c *Coinbene) CancelOrder(order *order.Cancel) error {
-	_, err := c.CancelSpotOrder(order.ID)
+func (c *Coinbene) CancelOrder(ctx context.Context, order *order.Cancel) error {
+	_, err := c.CancelSpotOrder(ctx, order.ID)
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (c *Coinbene) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
+func (c *Coinbene) CancelAllOrders(ctx context.Context, orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
 	var resp order.CancelAllResponse
 	fpair, err := c.FormatExchangeCurrency(orderCancellation.Pair,
 		orderCancellation.AssetType)
@@ -564,14 +565,14 @@ func (c *Coinbene) CancelAllOrders(orderCancellation *order.Cancel) (order.Cance
 		return resp, err
 	}
 
-	orders, err := c.FetchOpenSpotOrders(fpair.String())
+	orders, err := c.FetchOpenSpotOrders(ctx, fpair.String())
 	if err != nil {
 		return resp, err
 	}
 
 	tempMap := make(map[string]string)
 	for x := range orders {
-		_, err := c.CancelSpotOrder(orders[x].OrderID)
+		_, err := c.CancelSpotOrder(ctx, orders[x].OrderID)
 		if err != nil {
 			tempMap[orders[x].OrderID] = "Failed"
 		} else {
@@ -583,9 +584,9 @@ func (c *Coinbene) CancelAllOrders(orderCancellation *order.Cancel) (order.Cance
 }
 
 // GetOrderInfo returns information on a current open order
-func (c *Coinbene) GetOrderInfo(orderID string) (order.Detail, error) {
+func (c *Coinbene) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var resp order.Detail
-	tempResp, err := c.FetchSpotOrderInfo(orderID)
+	tempResp, err := c.FetchSpotOrderInfo(ctx, orderID)
 	if err != nil {
 		return resp, err
 	}
@@ -602,32 +603,32 @@ func (c *Coinbene) GetOrderInfo(orderID string) (order.Detail, error) {
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (c *Coinbene) GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error) {
+func (c *Coinbene) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (c *Coinbene) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (c *Coinbene) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (c *Coinbene) WithdrawFiatFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (c *Coinbene) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a withdrawal is
 // submitted
-func (c *Coinbene) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (c *Coinbene) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (c *Coinbene) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+func (c *Coinbene) GetActiveOrders(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
 	if len(getOrdersRequest.Pairs) == 0 {
-		allPairs, err := c.GetAllPairs()
+		allPairs, err := c.GetAllPairs(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -649,7 +650,7 @@ func (c *Coinbene) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]
 		}
 
 		var tempData OrdersInfo
-		tempData, err = c.FetchOpenSpotOrders(fpair.String())
+		tempData, err = c.FetchOpenSpotOrders(ctx, fpair.String())
 		if err != nil {
 			return nil, err
 		}
@@ -677,9 +678,9 @@ func (c *Coinbene) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (c *Coinbene) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+func (c *Coinbene) GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
 	if len(getOrdersRequest.Pairs) == 0 {
-		allPairs, err := c.GetAllPairs()
+		allPairs, err := c.GetAllPairs(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -702,7 +703,7 @@ func (c *Coinbene) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]
 			return nil, err
 		}
 
-		tempData, err = c.FetchClosedOrders(fpair.String(), "")
+		tempData, err = c.FetchClosedOrders(ctx, fpair.String(), "")
 		if err != nil {
 			return nil, err
 		}
@@ -729,13 +730,13 @@ func (c *Coinbene) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]
 }
 
 // GetFeeByType returns an estimate of fee based on the type of transaction
-func (c *Coinbene) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (c *Coinbene) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	fpair, err := c.FormatExchangeCurrency(feeBuilder.Pair, asset.Spot)
 	if err != nil {
 		return 0, err
 	}
 
-	tempData, err := c.GetPairInfo(fpair.String())
+	tempData, err := c.GetPairInfo(ctx, fpair.String())
 	if err != nil {
 		return 0, err
 	}
@@ -753,8 +754,8 @@ func (c *Coinbene) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (c *Coinbene) ValidateCredentials() error {
-	_, err := c.UpdateAccountInfo()
+func (c *Coinbene) ValidateCredentials(ctx context.Context) error {
+	_, err := c.UpdateAccountInfo(ctx)
 	return c.CheckTransientError(err)
 }
 
@@ -775,7 +776,7 @@ func (c *Coinbene) FormatExchangeKlineInterval(in kline.Interval) string {
 }
 
 // GetHistoricCandles returns candles between a time period for a set time interval
-func (c *Coinbene) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (c *Coinbene) GetHistoricCandles(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if !c.KlineIntervalEnabled(interval) {
 		return kline.Item{}, kline.ErrorKline{
 			Interval: interval,
@@ -789,11 +790,11 @@ func (c *Coinbene) GetHistoricCandles(pair currency.Pair, a asset.Item, start, e
 
 	var candles CandleResponse
 	if a == asset.PerpetualSwap {
-		candles, err = c.GetSwapKlines(formattedPair.String(),
+		candles, err = c.GetSwapKlines(ctx, formattedPair.String(),
 			start, end,
 			c.FormatExchangeKlineInterval(interval))
 	} else {
-		candles, err = c.GetKlines(formattedPair.String(),
+		candles, err = c.GetKlines(ctx, formattedPair.String(),
 			start, end,
 			c.FormatExchangeKlineInterval(interval))
 	}
@@ -867,6 +868,6 @@ func (c *Coinbene) GetHistoricCandles(pair currency.Pair, a asset.Item, start, e
 }
 
 // GetHistoricCandlesExtended returns candles between a time period for a set time interval
-func (c *Coinbene) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
-	return c.GetHistoricCandles(pair, a, start, end, interval)
+func (c *Coinbene) GetHistoricCandlesExtended(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	return c.GetHistoricCandles(ctx, pair, a, start, end, interval)
 }
@@ -1,6 +1,7 @@
 package coinbene
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -126,80 +127,79 @@ type RateLimit struct {
 }
 
 // Limit limits outbound requests
-func (r *RateLimit) Limit(f request.EndpointLimit) error {
+func (r *RateLimit) Limit(ctx context.Context, f request.EndpointLimit) error {
 	switch f {
 	case contractOrderbook:
-		time.Sleep(r.ContractOrderbook.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.ContractOrderbook.Reserve())
 	case contractTickers:
-		time.Sleep(r.ContractTickers.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.ContractTickers.Reserve())
 	case contractKline:
-		time.Sleep(r.ContractKline.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.ContractKline.Reserve())
 	case contractTrades:
-		time.Sleep(r.ContractTrades.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.ContractTrades.Reserve())
 	case contractAccountInfo:
-		time.Sleep(r.ContractAccountInfo.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.ContractAccountInfo.Reserve())
 	case contractPositionInfo:
-		time.Sleep(r.ContractPositionInfo.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.ContractPositionInfo.Reserve())
 	case contractPlaceOrder:
-		time.Sleep(r.ContractPlaceOrder.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.ContractPlaceOrder.Reserve())
 	case contractCancelOrder:
-		time.Sleep(r.ContractCancelOrder.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.ContractCancelOrder.Reserve())
 	case contractGetOpenOrders:
-		time.Sleep(r.ContractGetOpenOrders.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.ContractGetOpenOrders.Reserve())
 	case contractOpenOrdersByPage:
-		time.Sleep(r.ContractOpenOrdersByPage.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.ContractOpenOrdersByPage.Reserve())
 	case contractGetOrderInfo:
-		time.Sleep(r.ContractGetOrderInfo.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.ContractGetOrderInfo.Reserve())
 	case contractGetClosedOrders:
-		time.Sleep(r.ContractGetClosedOrders.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.ContractGetClosedOrders.Reserve())
 	case contractGetClosedOrdersbyPage:
-		time.Sleep(r.ContractGetClosedOrdersbyPage.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.ContractGetClosedOrdersbyPage.Reserve())
 	case contractCancelMultipleOrders:
-		time.Sleep(r.ContractCancelMultipleOrders.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.ContractCancelMultipleOrders.Reserve())
 	case contractGetOrderFills:
-		time.Sleep(r.ContractGetOrderFills.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.ContractGetOrderFills.Reserve())
 	case contractGetFundingRates:
-		time.Sleep(r.ContractGetFundingRates.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.ContractGetFundingRates.Reserve())
 	case spotPairs:
-		time.Sleep(r.SpotPairs.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SpotPairs.Reserve())
 	case spotPairInfo:
-		time.Sleep(r.SpotPairInfo.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SpotPairInfo.Reserve())
 	case spotOrderbook:
-		time.Sleep(r.SpotOrderbook.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SpotOrderbook.Reserve())
 	case spotTickerList:
-		time.Sleep(r.SpotTickerList.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SpotTickerList.Reserve())
 	case spotSpecificTicker:
-		time.Sleep(r.SpotSpecificTicker.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SpotSpecificTicker.Reserve())
 	case spotMarketTrades:
-		time.Sleep(r.SpotMarketTrades.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SpotMarketTrades.Reserve())
 	// case spotKline: // Not implemented yet
-	// 	time.Sleep(r.SpotKline.Reserve().Delay())
+	// 	return request.WaitForReservations(ctx, r.SpotKline.Reserve())
 	// case spotExchangeRate:
-	// 	time.Sleep(r.SpotExchangeRate.Reserve().Delay())
+	// 	return request.WaitForReservations(ctx, r.SpotExchangeRate.Reserve())
 	case spotAccountInfo:
-		time.Sleep(r.SpotAccountInfo.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SpotAccountInfo.Reserve())
 	case spotAccountAssetInfo:
-		time.Sleep(r.SpotAccountAssetInfo.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SpotAccountAssetInfo.Reserve())
 	case spotPlaceOrder:
-		time.Sleep(r.SpotPlaceOrder.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SpotPlaceOrder.Reserve())
 	case spotBatchOrder:
-		time.Sleep(r.SpotBatchOrder.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SpotBatchOrder.Reserve())
 	case spotQueryOpenOrders:
-		time.Sleep(r.SpotQueryOpenOrders.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SpotQueryOpenOrders.Reserve())
 	case spotQueryClosedOrders:
-		time.Sleep(r.SpotQueryClosedOrders.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SpotQueryClosedOrders.Reserve())
 	case spotQuerySpecficOrder:
-		time.Sleep(r.SpotQuerySpecficOrder.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SpotQuerySpecficOrder.Reserve())
 	case spotQueryTradeFills:
-		time.Sleep(r.SpotQueryTradeFills.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SpotQueryTradeFills.Reserve())
 	case spotCancelOrder:
-		time.Sleep(r.SpotCancelOrder.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SpotCancelOrder.Reserve())
 	case spotCancelOrdersBatch:
-		time.Sleep(r.SpotCancelOrdersBatch.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SpotCancelOrdersBatch.Reserve())
 	default:
 		return errors.New("rate limit error endpoint functionality not set")
 	}
-	return nil
 }
 
 // SetRateLimit returns the rate limit for the exchange
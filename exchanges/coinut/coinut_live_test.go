@@ -0,0 +1,45 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package coinut
+
+import (
+	"context"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	c.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Coinut load config error", err)
+	}
+	coinutCfg, err := cfg.GetExchangeConfig("COINUT")
+	if err != nil {
+		log.Fatal("Coinut Setup() init error")
+	}
+	coinutCfg.API.AuthenticatedSupport = true
+	coinutCfg.API.AuthenticatedWebsocketSupport = true
+	coinutCfg.API.Credentials.Key = apiKey
+	coinutCfg.API.Credentials.ClientID = clientID
+	c.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = c.Setup(coinutCfg)
+	if err != nil {
+		log.Fatal("Coinut setup error", err)
+	}
+	err = c.SeedInstruments(context.Background())
+	if err != nil {
+		log.Fatal("Coinut setup error ", err)
+	}
+	log.Printf(sharedtestvalues.LiveTesting, c.Name, c.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
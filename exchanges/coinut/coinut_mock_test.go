@@ -0,0 +1,60 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package coinut
+
+import (
+	"context"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/coinut/coinut.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	c.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Coinut load config error", err)
+	}
+	coinutCfg, err := cfg.GetExchangeConfig("COINUT")
+	if err != nil {
+		log.Fatal("Coinut Setup() init error")
+	}
+	coinutCfg.API.AuthenticatedSupport = true
+	coinutCfg.API.AuthenticatedWebsocketSupport = true
+	coinutCfg.API.Credentials.Key = apiKey
+	coinutCfg.API.Credentials.ClientID = clientID
+	c.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = c.Setup(coinutCfg)
+	if err != nil {
+		log.Fatal("Coinut setup error", err)
+	}
+
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+
+	c.HTTPClient = newClient
+	c.API.Endpoints.URL = serverDetails
+	err = c.SeedInstruments(context.Background())
+	if err != nil {
+		log.Fatal("Coinut setup error ", err)
+	}
+	log.Printf(sharedtestvalues.MockTesting, c.Name, c.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
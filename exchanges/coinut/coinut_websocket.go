@@ -17,6 +17,7 @@ import (
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
 	"github.com/yurulab/gocryptotrader/exchanges/stream"
 	"github.com/yurulab/gocryptotrader/exchanges/stream/buffer"
 	"github.com/yurulab/gocryptotrader/exchanges/ticker"
@@ -320,6 +321,7 @@ func (c *COINUT) wsHandleData(respRaw []byte) error {
 		}
 
 		c.Websocket.DataHandler <- stream.TradeData{
+			TID:          strconv.FormatInt(tradeUpdate.TransID, 10),
 			Timestamp:    time.Unix(tradeUpdate.Timestamp, 0),
 			CurrencyPair: p,
 			AssetType:    asset.Spot,
@@ -692,7 +694,8 @@ func (c *COINUT) wsGetAccountBalance() (*UserBalance, error) {
 		Request: "user_balance",
 		Nonce:   getNonce(),
 	}
-	resp, err := c.Websocket.Conn.SendMessageReturnResponse(accBalance.Nonce,
+	resp, err := c.Websocket.Conn.SendRateLimitedMessageReturnResponse(request.Auth,
+		accBalance.Nonce,
 		accBalance)
 	if err != nil {
 		return nil, err
@@ -729,7 +732,8 @@ func (c *COINUT) wsSubmitOrder(o *WsSubmitOrderParameters) (*order.Detail, error
 	if o.OrderID > 0 {
 		orderSubmissionRequest.OrderID = o.OrderID
 	}
-	resp, err := c.Websocket.Conn.SendMessageReturnResponse(orderSubmissionRequest.Nonce,
+	resp, err := c.Websocket.Conn.SendRateLimitedMessageReturnResponse(request.Auth,
+		orderSubmissionRequest.Nonce,
 		orderSubmissionRequest)
 	if err != nil {
 		return nil, err
@@ -774,7 +778,8 @@ func (c *COINUT) wsSubmitOrders(orders []WsSubmitOrderParameters) ([]order.Detai
 
 	orderRequest.Nonce = getNonce()
 	orderRequest.Request = "new_orders"
-	resp, err := c.Websocket.Conn.SendMessageReturnResponse(orderRequest.Nonce,
+	resp, err := c.Websocket.Conn.SendRateLimitedMessageReturnResponse(request.Auth,
+		orderRequest.Nonce,
 		orderRequest)
 	if err != nil {
 		errs = append(errs, err)
@@ -809,7 +814,8 @@ func (c *COINUT) wsGetOpenOrders(curr string) (*WsUserOpenOrdersResponse, error)
 	openOrdersRequest.Nonce = getNonce()
 	openOrdersRequest.InstrumentID = c.instrumentMap.LookupID(curr)
 
-	resp, err := c.Websocket.Conn.SendMessageReturnResponse(openOrdersRequest.Nonce,
+	resp, err := c.Websocket.Conn.SendRateLimitedMessageReturnResponse(request.Auth,
+		openOrdersRequest.Nonce,
 		openOrdersRequest)
 	if err != nil {
 		return response, err
@@ -843,7 +849,8 @@ func (c *COINUT) wsCancelOrder(cancellation *WsCancelOrderParameters) (*CancelOr
 	cancellationRequest.OrderID = cancellation.OrderID
 	cancellationRequest.Nonce = getNonce()
 
-	resp, err := c.Websocket.Conn.SendMessageReturnResponse(cancellationRequest.Nonce,
+	resp, err := c.Websocket.Conn.SendRateLimitedMessageReturnResponse(request.Auth,
+		cancellationRequest.Nonce,
 		cancellationRequest)
 	if err != nil {
 		return response, err
@@ -885,7 +892,8 @@ func (c *COINUT) wsCancelOrders(cancellations []WsCancelOrderParameters) (*Cance
 
 	cancelOrderRequest.Request = "cancel_orders"
 	cancelOrderRequest.Nonce = getNonce()
-	resp, err := c.Websocket.Conn.SendMessageReturnResponse(cancelOrderRequest.Nonce,
+	resp, err := c.Websocket.Conn.SendRateLimitedMessageReturnResponse(request.Auth,
+		cancelOrderRequest.Nonce,
 		cancelOrderRequest)
 	if err != nil {
 		return response, err
@@ -0,0 +1,194 @@
+package derivative
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/dispatch"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+)
+
+func init() {
+	service = new(Service)
+	service.Info = make(map[string]map[*currency.Item]map[*currency.Item]map[asset.Item]*Item)
+	service.Exchange = make(map[string]uuid.UUID)
+	service.mux = dispatch.GetNewMux()
+}
+
+// IsDerivative returns whether derivative data is supported for an asset type
+func IsDerivative(a asset.Item) bool {
+	return Assets.Contains(a)
+}
+
+// SubscribeInfo subscribes to the derivative information of a currency pair
+// and returns a communication channel to stream updates
+func SubscribeInfo(exchange string, p currency.Pair, a asset.Item) (dispatch.Pipe, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+
+	item, ok := service.Info[exchange][p.Base.Item][p.Quote.Item][a]
+	if !ok {
+		return dispatch.Pipe{}, fmt.Errorf("derivative info not found for %s %s %s",
+			exchange,
+			p,
+			a)
+	}
+	return service.mux.Subscribe(item.Main)
+}
+
+// SubscribeToExchangeInfo subscribes to all derivative information on an
+// exchange
+func SubscribeToExchangeInfo(exchange string) (dispatch.Pipe, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+	id, ok := service.Exchange[exchange]
+	if !ok {
+		return dispatch.Pipe{}, fmt.Errorf("%s exchange derivative info not found",
+			exchange)
+	}
+	return service.mux.Subscribe(id)
+}
+
+// GetInfo returns the stored derivative information for an exchange, currency
+// pair and asset type
+func GetInfo(exchange string, p currency.Pair, a asset.Item) (*Info, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+	item, ok := service.Info[exchange][p.Base.Item][p.Quote.Item][a]
+	if !ok {
+		return nil, fmt.Errorf("no derivative info for %s %s %s",
+			exchange,
+			p,
+			a)
+	}
+	info := item.Info
+	return &info, nil
+}
+
+// ProcessInfo validates and stores derivative information, publishing it to
+// any subscribers
+func ProcessInfo(info *Info) error {
+	if info == nil {
+		return errors.New(errInfoIsNil)
+	}
+
+	if info.Exchange == "" {
+		return errors.New(errExchangeNameUnset)
+	}
+
+	if info.Pair.IsEmpty() {
+		return fmt.Errorf("%s %s", info.Exchange, errPairNotSet)
+	}
+
+	if info.AssetType == "" {
+		return fmt.Errorf("%s %s %s",
+			info.Exchange,
+			info.Pair,
+			errAssetTypeNotSet)
+	}
+
+	if !IsDerivative(info.AssetType) {
+		return fmt.Errorf("%s %s %s %s",
+			info.Exchange,
+			info.Pair,
+			info.AssetType,
+			errNotDerivative)
+	}
+
+	if info.LastUpdated.IsZero() {
+		info.LastUpdated = time.Now()
+	}
+
+	return service.Update(info)
+}
+
+// Update merges the derivative information into the stored information and
+// publishes the result
+func (s *Service) Update(info *Info) error {
+	name := strings.ToLower(info.Exchange)
+	s.Lock()
+
+	item, ok := s.Info[name][info.Pair.Base.Item][info.Pair.Quote.Item][info.AssetType]
+	if !ok {
+		switch {
+		case s.Info[name] == nil:
+			s.Info[name] = make(map[*currency.Item]map[*currency.Item]map[asset.Item]*Item)
+			fallthrough
+		case s.Info[name][info.Pair.Base.Item] == nil:
+			s.Info[name][info.Pair.Base.Item] = make(map[*currency.Item]map[asset.Item]*Item)
+			fallthrough
+		case s.Info[name][info.Pair.Base.Item][info.Pair.Quote.Item] == nil:
+			s.Info[name][info.Pair.Base.Item][info.Pair.Quote.Item] = make(map[asset.Item]*Item)
+		}
+
+		var err error
+		item, err = s.newItem(name)
+		if err != nil {
+			s.Unlock()
+			return err
+		}
+		s.Info[name][info.Pair.Base.Item][info.Pair.Quote.Item][info.AssetType] = item
+	}
+
+	item.Info.merge(info)
+	merged := item.Info
+	ids := append(item.Assoc, item.Main)
+	s.Unlock()
+	return s.mux.Publish(ids, &merged)
+}
+
+// merge updates the stored fields with the fields set in update. Sources such
+// as websocket feeds only carry some of the fields, so unset zero values keep
+// the previously stored value
+func (i *Info) merge(update *Info) {
+	i.Exchange = update.Exchange
+	i.Pair = update.Pair
+	i.AssetType = update.AssetType
+	if update.MarkPrice != 0 {
+		i.MarkPrice = update.MarkPrice
+	}
+	if update.IndexPrice != 0 {
+		i.IndexPrice = update.IndexPrice
+	}
+	if update.FundingRate != 0 {
+		i.FundingRate = update.FundingRate
+	}
+	if update.PredictedFundingRate != 0 {
+		i.PredictedFundingRate = update.PredictedFundingRate
+	}
+	if !update.NextFundingTime.IsZero() {
+		i.NextFundingTime = update.NextFundingTime
+	}
+	if update.OpenInterest != 0 {
+		i.OpenInterest = update.OpenInterest
+	}
+	i.LastUpdated = update.LastUpdated
+}
+
+// newItem retrieves and sets dispatch mux publish IDs for new derivative
+// information
+func (s *Service) newItem(fmtName string) (*Item, error) {
+	exchangeID, ok := s.Exchange[fmtName]
+	if !ok {
+		var err error
+		exchangeID, err = s.mux.GetID()
+		if err != nil {
+			return nil, err
+		}
+		s.Exchange[fmtName] = exchangeID
+	}
+
+	singleID, err := s.mux.GetID()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Item{Main: singleID, Assoc: []uuid.UUID{exchangeID}}, nil
+}
@@ -0,0 +1,164 @@
+package derivative
+
+import (
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/dispatch"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+)
+
+func TestMain(m *testing.M) {
+	err := dispatch.Start(1, dispatch.DefaultJobsLimit)
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestIsDerivative(t *testing.T) {
+	t.Parallel()
+	if IsDerivative(asset.Spot) {
+		t.Error("spot should not be a derivative")
+	}
+	if !IsDerivative(asset.PerpetualSwap) {
+		t.Error("perpetual swap should be a derivative")
+	}
+}
+
+func TestProcessInfo(t *testing.T) {
+	p := currency.NewPair(currency.BTC, currency.USD)
+	err := ProcessInfo(nil)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	err = ProcessInfo(&Info{})
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	err = ProcessInfo(&Info{Exchange: "processtest"})
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	err = ProcessInfo(&Info{Exchange: "processtest", Pair: p})
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	err = ProcessInfo(&Info{Exchange: "processtest", Pair: p, AssetType: asset.Spot})
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	err = ProcessInfo(&Info{
+		Exchange:    "processtest",
+		Pair:        p,
+		AssetType:   asset.PerpetualContract,
+		MarkPrice:   1337,
+		FundingRate: 0.0001,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ProcessInfo(&Info{
+		Exchange:     "processtest",
+		Pair:         p,
+		AssetType:    asset.PerpetualContract,
+		MarkPrice:    1338,
+		OpenInterest: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := GetInfo("processtest", p, asset.PerpetualContract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.MarkPrice != 1338 || info.OpenInterest != 10 || info.LastUpdated.IsZero() {
+		t.Errorf("unexpected info %+v", info)
+	}
+	if info.FundingRate != 0.0001 {
+		t.Errorf("expected funding rate to be kept when unset in an update, received %v", info.FundingRate)
+	}
+
+	_, err = GetInfo("processtest", p, asset.Futures)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+}
+
+func TestSubscribeInfo(t *testing.T) {
+	p := currency.NewPair(currency.BTC, currency.USD)
+	_, err := SubscribeInfo("subscribetest", p, asset.Futures)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	_, err = SubscribeToExchangeInfo("subscribetest")
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	info := Info{
+		Exchange:  "subscribetest",
+		Pair:      p,
+		AssetType: asset.Futures,
+		MarkPrice: 1337,
+	}
+	err = ProcessInfo(&info)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pipe, err := SubscribeInfo("subscribetest", p, asset.Futures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exchPipe, err := SubscribeToExchangeInfo("subscribetest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := make(chan struct{})
+	go func() {
+		var pairReceived, exchReceived bool
+		for !pairReceived || !exchReceived {
+			select {
+			case data := <-pipe.C:
+				i := (*data.(*interface{})).(Info)
+				pairReceived = pairReceived || i.MarkPrice == 1337
+			case data := <-exchPipe.C:
+				i := (*data.(*interface{})).(Info)
+				exchReceived = exchReceived || i.MarkPrice == 1337
+			}
+		}
+		close(received)
+	}()
+
+	// Dispatch drops updates for routines that are not ready to receive so
+	// keep publishing until both pipes have been serviced
+	timeout := time.After(time.Second)
+	for {
+		err = ProcessInfo(&info)
+		if err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case <-received:
+			return
+		case <-timeout:
+			t.Fatal("derivative info not received by subscribers")
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
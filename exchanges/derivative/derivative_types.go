@@ -0,0 +1,75 @@
+package derivative
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/dispatch"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/order"
+)
+
+// const values for the derivative package
+const (
+	errExchangeNameUnset = "derivative exchange name not set"
+	errPairNotSet        = "derivative currency pair not set"
+	errAssetTypeNotSet   = "derivative asset type not set"
+	errNotDerivative     = "asset type is not a derivative"
+	errInfoIsNil         = "derivative info is nil"
+)
+
+// Vars for the derivative package
+var (
+	service *Service
+
+	// Assets are the asset types derivative data can be stored for
+	Assets = asset.Items{
+		asset.Futures,
+		asset.PerpetualContract,
+		asset.PerpetualSwap,
+	}
+)
+
+// Service holds derivative information for each individual exchange
+type Service struct {
+	Info     map[string]map[*currency.Item]map[*currency.Item]map[asset.Item]*Item
+	Exchange map[string]uuid.UUID
+	mux      *dispatch.Mux
+	sync.RWMutex
+}
+
+// Info defines the funding, open interest and pricing data for a derivative
+// contract
+type Info struct {
+	Exchange             string
+	Pair                 currency.Pair
+	AssetType            asset.Item
+	MarkPrice            float64
+	IndexPrice           float64
+	FundingRate          float64
+	PredictedFundingRate float64
+	NextFundingTime      time.Time
+	OpenInterest         float64
+	LastUpdated          time.Time
+}
+
+// Liquidation defines a forced liquidation order on a derivative contract
+type Liquidation struct {
+	Exchange  string
+	ID        string
+	Pair      currency.Pair
+	AssetType asset.Item
+	Side      order.Side
+	Price     float64
+	Amount    float64
+	Timestamp time.Time
+}
+
+// Item holds the derivative information for a currency pair and asset type
+type Item struct {
+	Info
+	Main  uuid.UUID
+	Assoc []uuid.UUID
+}
@@ -1,11 +1,13 @@
 package exchange
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +17,7 @@ import (
 	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/currency"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/derivative"
 	"github.com/yurulab/gocryptotrader/exchanges/kline"
 	"github.com/yurulab/gocryptotrader/exchanges/protocol"
 	"github.com/yurulab/gocryptotrader/exchanges/request"
@@ -98,6 +101,49 @@ func (e *Base) SetClientProxyAddress(addr string) error {
 	return nil
 }
 
+// SetClientOutbound spreads REST and websocket requests across multiple
+// proxies or local source addresses, overriding any single proxy address
+func (e *Base) SetClientOutbound(c *config.OutboundConfig) error {
+	if c == nil || (len(c.Proxies) == 0 && len(c.SourceAddresses) == 0) {
+		return nil
+	}
+
+	pool, err := request.NewOutboundPool(request.OutboundStrategy(c.Strategy),
+		c.Proxies,
+		c.SourceAddresses)
+	if err != nil {
+		return fmt.Errorf("exchange.go - setting outbound addresses error %s",
+			err)
+	}
+
+	e.checkAndInitRequester()
+	e.Requester.SetOutboundPool(pool)
+	if e.Websocket != nil {
+		e.Websocket.SetOutboundPool(pool)
+	}
+	return nil
+}
+
+// SetCircuitBreaker suspends REST requests after consecutive failures when the
+// circuit breaker is enabled, otherwise requests are never suspended
+func (e *Base) SetCircuitBreaker(c *config.CircuitBreakerConfig) {
+	e.checkAndInitRequester()
+	if c == nil || !c.Enabled {
+		e.Requester.SetCircuitBreaker(nil)
+		return
+	}
+	e.Requester.SetCircuitBreaker(request.NewCircuitBreaker(c.Threshold, c.Cooldown))
+}
+
+// Now returns the current time corrected for clock skew with the exchange. It
+// should be used for all authenticated request timestamps
+func (e *Base) Now() time.Time {
+	if e.Requester == nil {
+		return time.Now().Add(request.GetClockOffset())
+	}
+	return e.Requester.Now()
+}
+
 // SetFeatureDefaults sets the exchanges default feature
 // support set
 func (e *Base) SetFeatureDefaults() {
@@ -573,6 +619,12 @@ func (e *Base) SetupDefaults(exch *config.ExchangeConfig) error {
 	if err != nil {
 		return err
 	}
+
+	err = e.SetClientOutbound(exch.Outbound)
+	if err != nil {
+		return err
+	}
+	e.SetCircuitBreaker(exch.CircuitBreaker)
 	e.BaseCurrencies = exch.BaseCurrencies
 	return nil
 }
@@ -912,6 +964,27 @@ func (e *Base) CheckTransientError(err error) error {
 	return err
 }
 
+// FetchDerivativeInfo returns the stored funding, open interest and pricing
+// data for a derivative contract. Exchanges that support derivative data
+// override this
+func (e *Base) FetchDerivativeInfo(ctx context.Context, p currency.Pair, a asset.Item) (*derivative.Info, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// UpdateDerivativeInfo retrieves the funding, open interest and pricing data
+// for a derivative contract. Exchanges that support derivative data override
+// this
+func (e *Base) UpdateDerivativeInfo(ctx context.Context, p currency.Pair, a asset.Item) (*derivative.Info, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetLiquidations returns the forced liquidations of a derivative contract
+// within the timeframe provided. Exchanges that support liquidation data
+// override this
+func (e *Base) GetLiquidations(ctx context.Context, p currency.Pair, a asset.Item, startTime, endTime time.Time) ([]derivative.Liquidation, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
 // DisableRateLimiter disables the rate limiting system for the exchange
 func (e *Base) DisableRateLimiter() error {
 	return e.Requester.DisableRateLimiter()
@@ -1061,3 +1134,167 @@ func (e *Base) KlineIntervalEnabled(in kline.Interval) bool {
 func (e *Base) FormatExchangeKlineInterval(in kline.Interval) string {
 	return strconv.FormatFloat(in.Duration().Seconds(), 'f', 0, 64)
 }
+
+// CheckTradeHistoryRange validates the time range supplied to
+// GetExchangeHistory
+func CheckTradeHistoryRange(start, end time.Time) error {
+	if start.IsZero() || end.IsZero() {
+		return errors.New("trade history start and end times must be set")
+	}
+	if start.After(end) {
+		return errors.New("trade history start time cannot be after end time")
+	}
+	return nil
+}
+
+// FilterTradesByTime returns the trades that fall within the start and end
+// times inclusive, sorted oldest first. Exchanges that only return a fixed
+// window of trades use this to trim results to the requested range
+func FilterTradesByTime(trades []TradeHistory, start, end time.Time) []TradeHistory {
+	var resp []TradeHistory
+	for i := range trades {
+		if trades[i].Timestamp.Before(start) || trades[i].Timestamp.After(end) {
+			continue
+		}
+		resp = append(resp, trades[i])
+	}
+	sort.SliceStable(resp, func(i, j int) bool {
+		return resp[i].Timestamp.Before(resp[j].Timestamp)
+	})
+	return resp
+}
+
+// ErrTradeHistoryRangeNotCovered is returned by GetExchangeHistory when an
+// exchange's API cannot return trades as far back as the requested start time
+var ErrTradeHistoryRangeNotCovered = errors.New("exchange API cannot return trades as far back as the requested start time")
+
+// CheckTradeHistoryCoverage returns ErrTradeHistoryRangeNotCovered when no
+// trade in the oldest window of trades an exchange can return was executed
+// before start, as trades from start up to that window may have been dropped
+func CheckTradeHistoryCoverage(trades []TradeHistory, start time.Time) error {
+	for i := range trades {
+		if trades[i].Timestamp.Before(start) {
+			return nil
+		}
+	}
+	if len(trades) == 0 {
+		return nil
+	}
+	return ErrTradeHistoryRangeNotCovered
+}
+
+// MaxTradeIDPages is the most pages GetTradesByID requests while paging trades
+// forward, so an exchange which ignores the trade ID does not spend the rate
+// limit budget indefinitely
+var MaxTradeIDPages = 500
+
+// GetTradesByID returns the trades between start and end inclusive, sorted
+// oldest first, from an exchange which pages public trades forward from a
+// trade ID. fetch returns a page of trades executed after the supplied trade
+// ID, or the most recent trades when it is zero. When the most recent trades
+// do not reach back to start, the last trade ID before start is found by
+// binary search over trade IDs, which must increase with time, and trades are
+// paged forward from it until end is passed. Paging stops with an error if a
+// page does not advance the trade ID or after MaxTradeIDPages pages
+func GetTradesByID(fetch func(after int64) ([]TradeHistory, error), start, end time.Time) ([]TradeHistory, error) {
+	latest, err := fetch(0)
+	if err != nil {
+		return nil, err
+	}
+	if len(latest) == 0 || CheckTradeHistoryCoverage(latest, start) == nil {
+		return FilterTradesByTime(latest, start, end), nil
+	}
+
+	oldestID, newestID, err := tradeIDRange(latest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the largest trade ID whose following page begins before start
+	after, hi := int64(1), oldestID-1
+	for after < hi {
+		mid := after + (hi-after+1)/2
+		var page []TradeHistory
+		page, err = fetch(mid)
+		if err != nil {
+			return nil, err
+		}
+		if len(page) > 0 && CheckTradeHistoryCoverage(page, start) == nil {
+			after = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+
+	resp := latest
+	for pages := 0; after < newestID; pages++ {
+		if pages >= MaxTradeIDPages {
+			return nil, fmt.Errorf("%v, stopped paging after %d pages at trade ID %d",
+				ErrTradeHistoryRangeNotCovered,
+				pages,
+				after)
+		}
+		var page []TradeHistory
+		page, err = fetch(after)
+		if err != nil {
+			return nil, err
+		}
+		if len(page) == 0 {
+			return nil, fmt.Errorf("%v, no trades returned after trade ID %d",
+				ErrTradeHistoryRangeNotCovered,
+				after)
+		}
+		resp = append(resp, page...)
+
+		var newest int64
+		_, newest, err = tradeIDRange(page)
+		if err != nil {
+			return nil, err
+		}
+		var pastEnd bool
+		for i := range page {
+			if page[i].Timestamp.After(end) {
+				pastEnd = true
+				break
+			}
+		}
+		if pastEnd {
+			break
+		}
+		if newest <= after {
+			return nil, fmt.Errorf("%v, trade ID did not advance past %d",
+				ErrTradeHistoryRangeNotCovered,
+				after)
+		}
+		after = newest
+	}
+
+	seen := make(map[string]bool, len(resp))
+	unique := resp[:0:0]
+	for i := range resp {
+		if seen[resp[i].TID] {
+			continue
+		}
+		seen[resp[i].TID] = true
+		unique = append(unique, resp[i])
+	}
+	return FilterTradesByTime(unique, start, end), nil
+}
+
+// tradeIDRange returns the lowest and highest numeric trade IDs of trades
+func tradeIDRange(trades []TradeHistory) (lowest, highest int64, err error) {
+	for i := range trades {
+		var id int64
+		id, err = strconv.ParseInt(trades[i].TID, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("trade ID %q is not numeric: %v", trades[i].TID, err)
+		}
+		if i == 0 || id < lowest {
+			lowest = id
+		}
+		if id > highest {
+			highest = id
+		}
+	}
+	return lowest, highest, nil
+}
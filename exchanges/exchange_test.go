@@ -3,6 +3,7 @@ package exchange
 import (
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -134,6 +135,59 @@ func TestSetClientProxyAddress(t *testing.T) {
 	}
 }
 
+func TestSetClientOutbound(t *testing.T) {
+	t.Parallel()
+
+	newBase := Base{
+		Name:      "rawr",
+		Requester: request.New("rawr", &http.Client{}),
+		Websocket: stream.New(),
+	}
+
+	err := newBase.SetClientOutbound(nil)
+	if err != nil {
+		t.Error(err)
+	}
+	if newBase.Requester.GetOutboundPool() != nil {
+		t.Error("outbound pool should not be set without addresses")
+	}
+
+	err = newBase.SetClientOutbound(&config.OutboundConfig{
+		SourceAddresses: []string{"invalid"},
+	})
+	if err == nil {
+		t.Error("SetClientOutbound parsed invalid source address")
+	}
+
+	err = newBase.SetClientOutbound(&config.OutboundConfig{
+		Proxies:         []string{"http://www.valid.com"},
+		SourceAddresses: []string{"127.0.0.1"},
+		Strategy:        config.OutboundStrategyLeastUsed,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := newBase.Requester.GetOutboundPool()
+	if pool == nil || len(pool.Routes()) != 2 || pool.Strategy() != request.LeastUsed {
+		t.Error("outbound pool not set")
+	}
+}
+
+func TestNow(t *testing.T) {
+	t.Parallel()
+
+	var b Base
+	if d := time.Since(b.Now()); d < 0 || d > time.Second {
+		t.Errorf("unexpected time difference %v", d)
+	}
+
+	b.Requester = request.New("rawr", &http.Client{})
+	b.Requester.SetServerTimeOffset(time.Hour)
+	if d := time.Until(b.Now()); d < 59*time.Minute {
+		t.Errorf("expected server time offset to be applied, received %v", d)
+	}
+}
+
 func TestSetFeatureDefaults(t *testing.T) {
 	t.Parallel()
 
@@ -1839,3 +1893,145 @@ func Test_FormatExchangeKlineInterval(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckTradeHistoryRange(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	if err := CheckTradeHistoryRange(time.Time{}, now); err == nil {
+		t.Error("error cannot be nil")
+	}
+	if err := CheckTradeHistoryRange(now, now.Add(-time.Minute)); err == nil {
+		t.Error("error cannot be nil")
+	}
+	if err := CheckTradeHistoryRange(now.Add(-time.Minute), now); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFilterTradesByTime(t *testing.T) {
+	t.Parallel()
+	start := time.Unix(1590000000, 0)
+	trades := []TradeHistory{
+		{TID: "3", Timestamp: start.Add(time.Minute * 2)},
+		{TID: "2", Timestamp: start.Add(time.Minute)},
+		{TID: "1", Timestamp: start},
+		{TID: "0", Timestamp: start.Add(-time.Second)},
+	}
+	resp := FilterTradesByTime(trades, start, start.Add(time.Minute))
+	if len(resp) != 2 || resp[0].TID != "1" || resp[1].TID != "2" {
+		t.Errorf("unexpected trades %+v", resp)
+	}
+}
+
+func TestCheckTradeHistoryCoverage(t *testing.T) {
+	t.Parallel()
+	start := time.Unix(1590000000, 0)
+	if err := CheckTradeHistoryCoverage(nil, start); err != nil {
+		t.Error(err)
+	}
+	trades := []TradeHistory{{Timestamp: start}, {Timestamp: start.Add(-time.Second)}}
+	if err := CheckTradeHistoryCoverage(trades, start); err != nil {
+		t.Error(err)
+	}
+	if err := CheckTradeHistoryCoverage(trades[:1], start); err != ErrTradeHistoryRangeNotCovered {
+		t.Errorf("expected %v, received %v", ErrTradeHistoryRangeNotCovered, err)
+	}
+}
+
+func TestGetTradesByID(t *testing.T) {
+	t.Parallel()
+	const pageSize = 50
+	start := time.Unix(1590000000, 0)
+	var history []TradeHistory
+	for i := 1; i <= 1000; i++ {
+		history = append(history, TradeHistory{
+			TID:       strconv.Itoa(i),
+			Timestamp: start.Add(time.Duration(i/3) * time.Second),
+		})
+	}
+	var requests int
+	fetch := func(after int64) ([]TradeHistory, error) {
+		requests++
+		if after == 0 {
+			return history[len(history)-pageSize:], nil
+		}
+		if int(after) >= len(history) {
+			return nil, nil
+		}
+		end := int(after) + pageSize
+		if end > len(history) {
+			end = len(history)
+		}
+		return history[after:end], nil
+	}
+
+	from, to := start.Add(time.Second*100), start.Add(time.Second*200)
+	resp, err := GetTradesByID(fetch, from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 303 || resp[0].TID != "300" || resp[len(resp)-1].TID != "602" {
+		t.Errorf("expected trades 300 to 602, received %d trades from %s to %s",
+			len(resp), resp[0].TID, resp[len(resp)-1].TID)
+	}
+	if requests > 25 {
+		t.Errorf("expected the range to be found by binary search, made %d requests", requests)
+	}
+
+	resp, err = GetTradesByID(fetch, start.Add(time.Second*320), start.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 41 || resp[len(resp)-1].TID != "1000" {
+		t.Errorf("expected the most recent 41 trades, received %d", len(resp))
+	}
+
+	_, err = GetTradesByID(func(after int64) ([]TradeHistory, error) {
+		if after == 0 {
+			return history[len(history)-pageSize:], nil
+		}
+		return nil, nil
+	}, from, to)
+	if err == nil {
+		t.Error("expected error when older trades cannot be paged")
+	}
+
+	// An exchange returning the same page regardless of trade ID
+	requests = 0
+	_, err = GetTradesByID(func(after int64) ([]TradeHistory, error) {
+		requests++
+		if after == 0 {
+			return history[len(history)-pageSize:], nil
+		}
+		return history[:pageSize], nil
+	}, from, to)
+	if err == nil {
+		t.Error("expected error when the trade ID does not advance")
+	}
+	if requests > 25 {
+		t.Errorf("expected paging to stop, made %d requests", requests)
+	}
+
+	// An exchange paging one trade at a time is cut off at the page limit
+	requests = 0
+	_, err = GetTradesByID(func(after int64) ([]TradeHistory, error) {
+		requests++
+		if after == 0 {
+			return []TradeHistory{{TID: "1000000", Timestamp: start.Add(time.Hour)}}, nil
+		}
+		ts := from
+		if after <= 10 {
+			ts = from.Add(-time.Second)
+		}
+		return []TradeHistory{{
+			TID:       strconv.FormatInt(after+1, 10),
+			Timestamp: ts,
+		}}, nil
+	}, from, to)
+	if err == nil {
+		t.Error("expected error when the page limit is reached")
+	}
+	if requests > MaxTradeIDPages+25 {
+		t.Errorf("expected paging to stop at the page limit, made %d requests", requests)
+	}
+}
@@ -0,0 +1,41 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package exmo
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	e.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Exmo load config error", err)
+	}
+	exmoConf, err := cfg.GetExchangeConfig("EXMO")
+	if err != nil {
+		log.Fatal("Exmo Setup() init error")
+	}
+
+	err = e.Setup(exmoConf)
+	if err != nil {
+		log.Fatal("Exmo setup error", err)
+	}
+
+	e.API.AuthenticatedSupport = true
+	e.API.Credentials.Key = APIKey
+	e.API.Credentials.Secret = APISecret
+
+	log.Printf(sharedtestvalues.LiveTesting, e.Name, e.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
@@ -0,0 +1,55 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package exmo
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/exmo/exmo.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	e.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Exmo load config error", err)
+	}
+	exmoConf, err := cfg.GetExchangeConfig("EXMO")
+	if err != nil {
+		log.Fatal("Exmo Setup() init error")
+	}
+
+	err = e.Setup(exmoConf)
+	if err != nil {
+		log.Fatal("Exmo setup error", err)
+	}
+
+	e.API.AuthenticatedSupport = true
+	e.API.Credentials.Key = APIKey
+	e.API.Credentials.Secret = APISecret
+
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+
+	e.HTTPClient = newClient
+	e.API.Endpoints.URL = serverDetails
+	log.Printf(sharedtestvalues.MockTesting, e.Name, e.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
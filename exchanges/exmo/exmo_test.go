@@ -1,15 +1,15 @@
 package exmo
 
 import (
-	"log"
-	"os"
+	"context"
 	"testing"
+	"time"
 
 	"github.com/yurulab/gocryptotrader/common"
-	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/core"
 	"github.com/yurulab/gocryptotrader/currency"
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/portfolio/withdraw"
 )
@@ -24,41 +24,37 @@ var (
 	e EXMO
 )
 
-func TestMain(m *testing.M) {
-	e.SetDefaults()
-	cfg := config.GetConfig()
-	err := cfg.LoadConfig("../../testdata/configtest.json", true)
-	if err != nil {
-		log.Fatal("Exmo load config error", err)
-	}
-	exmoConf, err := cfg.GetExchangeConfig("EXMO")
-	if err != nil {
-		log.Fatal("Exmo Setup() init error")
-	}
-
-	err = e.Setup(exmoConf)
+func TestGetTrades(t *testing.T) {
+	t.Parallel()
+	_, err := e.GetTrades(context.Background(), "BTC_USD")
 	if err != nil {
-		log.Fatal("Exmo setup error", err)
+		t.Errorf("Err: %s", err)
 	}
-
-	e.API.AuthenticatedSupport = true
-	e.API.Credentials.Key = APIKey
-	e.API.Credentials.Secret = APISecret
-
-	os.Exit(m.Run())
 }
 
-func TestGetTrades(t *testing.T) {
+func TestGetExchangeHistory(t *testing.T) {
 	t.Parallel()
-	_, err := e.GetTrades("BTC_USD")
-	if err != nil {
+	p := currency.NewPairWithDelimiter("BTC", "USD", "_")
+	end := time.Now()
+	_, err := e.GetExchangeHistory(context.Background(), p, asset.Spot, end, end.Add(-time.Hour))
+	if err == nil {
+		t.Error("expected error when start is after end")
+	}
+
+	_, err = e.GetExchangeHistory(context.Background(), p, asset.Spot, end.Add(-time.Hour), end)
+	if err != nil && err != exchange.ErrTradeHistoryRangeNotCovered {
 		t.Errorf("Err: %s", err)
 	}
+
+	_, err = e.GetExchangeHistory(context.Background(), p, asset.Spot, end.AddDate(-1, 0, 0), end)
+	if err != exchange.ErrTradeHistoryRangeNotCovered {
+		t.Errorf("expected %v, received %v", exchange.ErrTradeHistoryRangeNotCovered, err)
+	}
 }
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := e.GetOrderbook("BTC_USD")
+	_, err := e.GetOrderbook(context.Background(), "BTC_USD")
 	if err != nil {
 		t.Errorf("Err: %s", err)
 	}
@@ -66,7 +62,7 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := e.GetTicker()
+	_, err := e.GetTicker(context.Background())
 	if err != nil {
 		t.Errorf("Err: %s", err)
 	}
@@ -74,7 +70,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetPairSettings(t *testing.T) {
 	t.Parallel()
-	_, err := e.GetPairSettings()
+	_, err := e.GetPairSettings(context.Background())
 	if err != nil {
 		t.Errorf("Err: %s", err)
 	}
@@ -82,7 +78,7 @@ func TestGetPairSettings(t *testing.T) {
 
 func TestGetCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := e.GetCurrency()
+	_, err := e.GetCurrency(context.Background())
 	if err != nil {
 		t.Errorf("Err: %s", err)
 	}
@@ -93,7 +89,7 @@ func TestGetUserInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := e.GetUserInfo()
+	_, err := e.GetUserInfo(context.Background())
 	if err != nil {
 		t.Errorf("Err: %s", err)
 	}
@@ -104,7 +100,7 @@ func TestGetRequiredAmount(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := e.GetRequiredAmount("BTC_USD", 100)
+	_, err := e.GetRequiredAmount(context.Background(), "BTC_USD", 100)
 	if err != nil {
 		t.Errorf("Err: %s", err)
 	}
@@ -124,7 +120,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	e.GetFeeByType(feeBuilder)
+	e.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -265,7 +261,7 @@ func TestGetActiveOrders(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := e.GetActiveOrders(&getOrdersRequest)
+	_, err := e.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -281,7 +277,7 @@ func TestGetOrderHistory(t *testing.T) {
 	currPair.Delimiter = "_"
 	getOrdersRequest.Pairs = []currency.Pair{currPair}
 
-	_, err := e.GetOrderHistory(&getOrdersRequest)
+	_, err := e.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -312,7 +308,7 @@ func TestSubmitOrder(t *testing.T) {
 		Amount:   1,
 		ClientID: "meowOrder",
 	}
-	response, err := e.SubmitOrder(orderSubmission)
+	response, err := e.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -333,7 +329,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	err := e.CancelOrder(orderCancellation)
+	err := e.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -355,7 +351,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	resp, err := e.CancelAllOrders(orderCancellation)
+	resp, err := e.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -373,7 +369,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := e.ModifyOrder(&order.Modify{})
+	_, err := e.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -393,7 +389,7 @@ func TestWithdraw(t *testing.T) {
 		},
 	}
 
-	_, err := e.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := e.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -408,7 +404,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.Request{}
-	_, err := e.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := e.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -420,7 +416,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.Request{}
-	_, err := e.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := e.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -428,12 +424,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := e.GetDepositAddress(currency.LTC, "")
+		_, err := e.GetDepositAddress(context.Background(), currency.LTC, "")
 		if err != nil {
 			t.Error("GetDepositAddress() error", err)
 		}
 	} else {
-		_, err := e.GetDepositAddress(currency.LTC, "")
+		_, err := e.GetDepositAddress(context.Background(), currency.LTC, "")
 		if err == nil {
 			t.Error("GetDepositAddress() error cannot be nil")
 		}
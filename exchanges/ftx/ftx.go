@@ -110,30 +110,30 @@ const (
 )
 
 // GetMarkets gets market data
-func (f *FTX) GetMarkets() ([]MarketData, error) {
+func (f *FTX) GetMarkets(ctx context.Context) ([]MarketData, error) {
 	resp := struct {
 		Data []MarketData `json:"result"`
 	}{}
-	return resp.Data, f.SendHTTPRequest(ftxAPIURL+getMarkets, &resp)
+	return resp.Data, f.SendHTTPRequest(ctx, f.API.Endpoints.URL+getMarkets, &resp)
 }
 
 // GetMarket gets market data for a provided asset type
-func (f *FTX) GetMarket(marketName string) (MarketData, error) {
+func (f *FTX) GetMarket(ctx context.Context, marketName string) (MarketData, error) {
 	resp := struct {
 		Data MarketData `json:"result"`
 	}{}
-	return resp.Data, f.SendHTTPRequest(ftxAPIURL+getMarket+marketName,
+	return resp.Data, f.SendHTTPRequest(ctx, f.API.Endpoints.URL+getMarket+marketName,
 		&resp)
 }
 
 // GetOrderbook gets orderbook for a given market with a given depth (default depth 20)
-func (f *FTX) GetOrderbook(marketName string, depth int64) (OrderbookData, error) {
+func (f *FTX) GetOrderbook(ctx context.Context, marketName string, depth int64) (OrderbookData, error) {
 	result := struct {
 		Data TempOBData `json:"result"`
 	}{}
 	strDepth := strconv.FormatInt(depth, 10)
 	var resp OrderbookData
-	err := f.SendHTTPRequest(fmt.Sprintf(ftxAPIURL+getOrderbook, marketName, strDepth), &result)
+	err := f.SendHTTPRequest(ctx, fmt.Sprintf(f.API.Endpoints.URL+getOrderbook, marketName, strDepth), &result)
 	if err != nil {
 		return resp, err
 	}
@@ -152,7 +152,7 @@ func (f *FTX) GetOrderbook(marketName string, depth int64) (OrderbookData, error
 }
 
 // GetTrades gets trades based on the conditions specified
-func (f *FTX) GetTrades(marketName string, startTime, endTime time.Time, limit int64) ([]TradeData, error) {
+func (f *FTX) GetTrades(ctx context.Context, marketName string, startTime, endTime time.Time, limit int64) ([]TradeData, error) {
 	strLimit := strconv.FormatInt(limit, 10)
 	params := url.Values{}
 	params.Set("limit", strLimit)
@@ -166,12 +166,12 @@ func (f *FTX) GetTrades(marketName string, startTime, endTime time.Time, limit i
 		params.Set("start_time", strconv.FormatInt(startTime.Unix(), 10))
 		params.Set("end_time", strconv.FormatInt(endTime.Unix(), 10))
 	}
-	return resp.Data, f.SendHTTPRequest(fmt.Sprintf(ftxAPIURL+getTrades, marketName)+params.Encode(),
+	return resp.Data, f.SendHTTPRequest(ctx, fmt.Sprintf(f.API.Endpoints.URL+getTrades, marketName)+params.Encode(),
 		&resp)
 }
 
 // GetHistoricalData gets historical OHLCV data for a given market pair
-func (f *FTX) GetHistoricalData(marketName, timeInterval, limit string, startTime, endTime time.Time) ([]OHLCVData, error) {
+func (f *FTX) GetHistoricalData(ctx context.Context, marketName, timeInterval, limit string, startTime, endTime time.Time) ([]OHLCVData, error) {
 	resp := struct {
 		Data []OHLCVData `json:"result"`
 	}{}
@@ -187,11 +187,11 @@ func (f *FTX) GetHistoricalData(marketName, timeInterval, limit string, startTim
 		params.Set("start_time", strconv.FormatInt(startTime.Unix(), 10))
 		params.Set("end_time", strconv.FormatInt(endTime.Unix(), 10))
 	}
-	return resp.Data, f.SendHTTPRequest(fmt.Sprintf(ftxAPIURL+getHistoricalData, marketName)+params.Encode(), &resp)
+	return resp.Data, f.SendHTTPRequest(ctx, fmt.Sprintf(f.API.Endpoints.URL+getHistoricalData, marketName)+params.Encode(), &resp)
 }
 
 // GetIndexHistoricalData gets historical OHLCV data for a given index
-func (f *FTX) GetIndexHistoricalData(indexName, timeInterval, limit string, startTime, endTime time.Time) ([]OHLCVData, error) {
+func (f *FTX) GetIndexHistoricalData(ctx context.Context, indexName, timeInterval, limit string, startTime, endTime time.Time) ([]OHLCVData, error) {
 	resp := struct {
 		Data []OHLCVData `json:"result"`
 	}{}
@@ -207,31 +207,31 @@ func (f *FTX) GetIndexHistoricalData(indexName, timeInterval, limit string, star
 		params.Set("start_time", strconv.FormatInt(startTime.Unix(), 10))
 		params.Set("end_time", strconv.FormatInt(endTime.Unix(), 10))
 	}
-	return resp.Data, f.SendHTTPRequest(fmt.Sprintf(ftxAPIURL+getIndexes, indexName)+params.Encode(), &resp)
+	return resp.Data, f.SendHTTPRequest(ctx, fmt.Sprintf(f.API.Endpoints.URL+getIndexes, indexName)+params.Encode(), &resp)
 }
 
 // GetFutures gets data on futures
-func (f *FTX) GetFutures() ([]FuturesData, error) {
+func (f *FTX) GetFutures(ctx context.Context) ([]FuturesData, error) {
 	resp := struct {
 		Data []FuturesData `json:"result"`
 	}{}
-	return resp.Data, f.SendHTTPRequest(ftxAPIURL+getFutures, &resp)
+	return resp.Data, f.SendHTTPRequest(ctx, f.API.Endpoints.URL+getFutures, &resp)
 }
 
 // GetFuture gets data on a given future
-func (f *FTX) GetFuture(futureName string) (FuturesData, error) {
+func (f *FTX) GetFuture(ctx context.Context, futureName string) (FuturesData, error) {
 	resp := struct {
 		Data FuturesData `json:"result"`
 	}{}
-	return resp.Data, f.SendHTTPRequest(ftxAPIURL+getFuture+futureName, &resp)
+	return resp.Data, f.SendHTTPRequest(ctx, f.API.Endpoints.URL+getFuture+futureName, &resp)
 }
 
 // GetFutureStats gets data on a given future's stats
-func (f *FTX) GetFutureStats(futureName string) (FutureStatsData, error) {
+func (f *FTX) GetFutureStats(ctx context.Context, futureName string) (FutureStatsData, error) {
 	resp := struct {
 		Data FutureStatsData `json:"result"`
 	}{}
-	return resp.Data, f.SendHTTPRequest(fmt.Sprintf(ftxAPIURL+getFutureStats, futureName), &resp)
+	return resp.Data, f.SendHTTPRequest(ctx, fmt.Sprintf(f.API.Endpoints.URL+getFutureStats, futureName), &resp)
 }
 
 // GetFundingRates gets data on funding rates
@@ -239,18 +239,18 @@ func (f *FTX) GetFutureStats(futureName string) (FutureStatsData, error) {
 // 	resp := struct {
 // 		Data []FundingRatesData `json:"result"`
 // 	}{}
-// 	return resp.Data, f.SendHTTPRequest(ftxAPIURL+getFundingRates, &resp)
+// 	return resp.Data, f.SendHTTPRequest(f.API.Endpoints.URL+getFundingRates, &resp)
 // }
 
 // GetIndexWeights gets index weights
-func (f *FTX) GetIndexWeights(index string) (IndexWeights, error) {
+func (f *FTX) GetIndexWeights(ctx context.Context, index string) (IndexWeights, error) {
 	var resp IndexWeights
-	return resp, f.SendHTTPRequest(ftxAPIURL+fmt.Sprintf(getIndexWeights, index), &resp)
+	return resp, f.SendHTTPRequest(ctx, f.API.Endpoints.URL+fmt.Sprintf(getIndexWeights, index), &resp)
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (f *FTX) SendHTTPRequest(path string, result interface{}) error {
-	return f.SendPayload(context.Background(), &request.Item{
+func (f *FTX) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return f.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -261,23 +261,23 @@ func (f *FTX) SendHTTPRequest(path string, result interface{}) error {
 }
 
 // GetSubaccount gets subaccount info
-func (f *FTX) GetSubaccount() ([]SubAccountData, error) {
+func (f *FTX) GetSubaccount(ctx context.Context) ([]SubAccountData, error) {
 	resp := struct {
 		Data []SubAccountData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getSubaccounts, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getSubaccounts, nil, &resp)
 }
 
 // GetSubaccountBalance gets subaccount balances
-func (f *FTX) GetSubaccountBalance(subaccountName string) ([]SubAccountBalanceData, error) {
+func (f *FTX) GetSubaccountBalance(ctx context.Context, subaccountName string) ([]SubAccountBalanceData, error) {
 	resp := struct {
 		Data []SubAccountBalanceData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, fmt.Sprintf(getSubaccountBalances, subaccountName), nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, fmt.Sprintf(getSubaccountBalances, subaccountName), nil, &resp)
 }
 
 // TransferSubaccountBalance transfer balances between subaccounts
-func (f *FTX) TransferSubaccountBalance(coin, source, destination string, size float64) (TransferedBalanceData, error) {
+func (f *FTX) TransferSubaccountBalance(ctx context.Context, coin, source, destination string, size float64) (TransferedBalanceData, error) {
 	req := make(map[string]interface{})
 	req["coin"] = coin
 	req["size"] = size
@@ -286,82 +286,82 @@ func (f *FTX) TransferSubaccountBalance(coin, source, destination string, size f
 	resp := struct {
 		Data TransferedBalanceData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodPost, transferSubaccountBalance, req, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodPost, transferSubaccountBalance, req, &resp)
 }
 
 // GetAccountInfo gets account info
-func (f *FTX) GetAccountInfo() (AccountInfoData, error) {
+func (f *FTX) GetAccountInfo(ctx context.Context) (AccountInfoData, error) {
 	resp := struct {
 		Data AccountInfoData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getAccountInfo, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getAccountInfo, nil, &resp)
 }
 
 // GetPositions gets the users positions
-func (f *FTX) GetPositions() ([]PositionData, error) {
+func (f *FTX) GetPositions(ctx context.Context) ([]PositionData, error) {
 	resp := struct {
 		Data []PositionData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getPositions, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getPositions, nil, &resp)
 }
 
 // ChangeAccountLeverage changes default leverage used by account
-func (f *FTX) ChangeAccountLeverage(leverage float64) error {
+func (f *FTX) ChangeAccountLeverage(ctx context.Context, leverage float64) error {
 	req := make(map[string]interface{})
 	req["leverage"] = leverage
-	return f.SendAuthHTTPRequest(http.MethodPost, setLeverage, req, nil)
+	return f.SendAuthHTTPRequest(ctx, http.MethodPost, setLeverage, req, nil)
 }
 
 // GetCoins gets coins' data in the account wallet
-func (f *FTX) GetCoins() ([]WalletCoinsData, error) {
+func (f *FTX) GetCoins(ctx context.Context) ([]WalletCoinsData, error) {
 	resp := struct {
 		Data []WalletCoinsData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getCoins, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getCoins, nil, &resp)
 }
 
 // GetBalances gets balances of the account
-func (f *FTX) GetBalances() ([]BalancesData, error) {
+func (f *FTX) GetBalances(ctx context.Context) ([]BalancesData, error) {
 	resp := struct {
 		Data []BalancesData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getBalances, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getBalances, nil, &resp)
 }
 
 // GetAllWalletBalances gets all wallets' balances
-func (f *FTX) GetAllWalletBalances() (AllWalletAccountData, error) {
+func (f *FTX) GetAllWalletBalances(ctx context.Context) (AllWalletAccountData, error) {
 	resp := struct {
 		Data AllWalletAccountData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getAllWalletBalances, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getAllWalletBalances, nil, &resp)
 }
 
 // FetchDepositAddress gets deposit address for a given coin
-func (f *FTX) FetchDepositAddress(coin string) (DepositData, error) {
+func (f *FTX) FetchDepositAddress(ctx context.Context, coin string) (DepositData, error) {
 	resp := struct {
 		Data DepositData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getDepositAddress+coin, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getDepositAddress+coin, nil, &resp)
 }
 
 // FetchDepositHistory gets deposit history
-func (f *FTX) FetchDepositHistory() ([]TransactionData, error) {
+func (f *FTX) FetchDepositHistory(ctx context.Context) ([]TransactionData, error) {
 	resp := struct {
 		Data []TransactionData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getDepositHistory, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getDepositHistory, nil, &resp)
 }
 
 // FetchWithdrawalHistory gets withdrawal history
-func (f *FTX) FetchWithdrawalHistory() ([]TransactionData, error) {
+func (f *FTX) FetchWithdrawalHistory(ctx context.Context) ([]TransactionData, error) {
 	resp := struct {
 		Data []TransactionData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getWithdrawalHistory, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getWithdrawalHistory, nil, &resp)
 }
 
 // Withdraw sends a withdrawal request
-func (f *FTX) Withdraw(coin, address, tag, password, code string, size float64) (TransactionData, error) {
+func (f *FTX) Withdraw(ctx context.Context, coin, address, tag, password, code string, size float64) (TransactionData, error) {
 	req := make(map[string]interface{})
 	req["coin"] = coin
 	req["address"] = address
@@ -378,11 +378,11 @@ func (f *FTX) Withdraw(coin, address, tag, password, code string, size float64)
 	resp := struct {
 		Data TransactionData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodPost, withdrawRequest, req, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodPost, withdrawRequest, req, &resp)
 }
 
 // GetOpenOrders gets open orders
-func (f *FTX) GetOpenOrders(marketName string) ([]OrderData, error) {
+func (f *FTX) GetOpenOrders(ctx context.Context, marketName string) ([]OrderData, error) {
 	params := url.Values{}
 	if marketName != "" {
 		params.Set("market", marketName)
@@ -390,11 +390,11 @@ func (f *FTX) GetOpenOrders(marketName string) ([]OrderData, error) {
 	resp := struct {
 		Data []OrderData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getOpenOrders+params.Encode(), nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getOpenOrders+params.Encode(), nil, &resp)
 }
 
 // FetchOrderHistory gets order history
-func (f *FTX) FetchOrderHistory(marketName string, startTime, endTime time.Time, limit string) ([]OrderData, error) {
+func (f *FTX) FetchOrderHistory(ctx context.Context, marketName string, startTime, endTime time.Time, limit string) ([]OrderData, error) {
 	resp := struct {
 		Data []OrderData `json:"result"`
 	}{}
@@ -412,11 +412,11 @@ func (f *FTX) FetchOrderHistory(marketName string, startTime, endTime time.Time,
 	if limit != "" {
 		params.Set("limit", limit)
 	}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getOrderHistory+params.Encode(), nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getOrderHistory+params.Encode(), nil, &resp)
 }
 
 // GetOpenTriggerOrders gets trigger orders that are currently open
-func (f *FTX) GetOpenTriggerOrders(marketName, orderType string) ([]TriggerOrderData, error) {
+func (f *FTX) GetOpenTriggerOrders(ctx context.Context, marketName, orderType string) ([]TriggerOrderData, error) {
 	params := url.Values{}
 	if marketName != "" {
 		params.Set("market", marketName)
@@ -427,19 +427,19 @@ func (f *FTX) GetOpenTriggerOrders(marketName, orderType string) ([]TriggerOrder
 	resp := struct {
 		Data []TriggerOrderData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getOpenTriggerOrders+params.Encode(), nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getOpenTriggerOrders+params.Encode(), nil, &resp)
 }
 
 // GetTriggerOrderTriggers gets trigger orders that are currently open
-func (f *FTX) GetTriggerOrderTriggers(orderID string) ([]TriggerData, error) {
+func (f *FTX) GetTriggerOrderTriggers(ctx context.Context, orderID string) ([]TriggerData, error) {
 	resp := struct {
 		Data []TriggerData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, fmt.Sprintf(getTriggerOrderTriggers, orderID), nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, fmt.Sprintf(getTriggerOrderTriggers, orderID), nil, &resp)
 }
 
 // GetTriggerOrderHistory gets trigger orders that are currently open
-func (f *FTX) GetTriggerOrderHistory(marketName string, startTime, endTime time.Time, side, orderType, limit string) ([]TriggerOrderData, error) {
+func (f *FTX) GetTriggerOrderHistory(ctx context.Context, marketName string, startTime, endTime time.Time, side, orderType, limit string) ([]TriggerOrderData, error) {
 	resp := struct {
 		Data []TriggerOrderData `json:"result"`
 	}{}
@@ -463,11 +463,11 @@ func (f *FTX) GetTriggerOrderHistory(marketName string, startTime, endTime time.
 	if limit != "" {
 		params.Set("limit", limit)
 	}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getTriggerOrderHistory+params.Encode(), nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getTriggerOrderHistory+params.Encode(), nil, &resp)
 }
 
 // Order places an order
-func (f *FTX) Order(marketName, side, orderType, clientID string, reduceOnly, ioc, postOnly bool, price, size float64) (OrderData, error) {
+func (f *FTX) Order(ctx context.Context, marketName, side, orderType, clientID string, reduceOnly, ioc, postOnly bool, price, size float64) (OrderData, error) {
 	req := make(map[string]interface{})
 	req["market"] = marketName
 	req["side"] = side
@@ -489,11 +489,11 @@ func (f *FTX) Order(marketName, side, orderType, clientID string, reduceOnly, io
 	resp := struct {
 		Data OrderData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodPost, placeOrder, req, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodPost, placeOrder, req, &resp)
 }
 
 // TriggerOrder places an order
-func (f *FTX) TriggerOrder(marketName, side, orderType string, reduceOnly, retryUntilFilled bool, size, triggerPrice, orderPrice, trailValue float64) (TriggerOrderData, error) {
+func (f *FTX) TriggerOrder(ctx context.Context, marketName, side, orderType string, reduceOnly, retryUntilFilled bool, size, triggerPrice, orderPrice, trailValue float64) (TriggerOrderData, error) {
 	req := make(map[string]interface{})
 	req["market"] = marketName
 	req["side"] = side
@@ -519,11 +519,11 @@ func (f *FTX) TriggerOrder(marketName, side, orderType string, reduceOnly, retry
 	resp := struct {
 		Data TriggerOrderData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodPost, placeTriggerOrder, req, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodPost, placeTriggerOrder, req, &resp)
 }
 
 // ModifyPlacedOrder modifies a placed order
-func (f *FTX) ModifyPlacedOrder(orderID, clientID string, price, size float64) (OrderData, error) {
+func (f *FTX) ModifyPlacedOrder(ctx context.Context, orderID, clientID string, price, size float64) (OrderData, error) {
 	req := make(map[string]interface{})
 	req["price"] = price
 	req["size"] = size
@@ -533,11 +533,11 @@ func (f *FTX) ModifyPlacedOrder(orderID, clientID string, price, size float64) (
 	resp := struct {
 		Data OrderData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodPost, fmt.Sprintf(modifyOrder, orderID), req, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodPost, fmt.Sprintf(modifyOrder, orderID), req, &resp)
 }
 
 // ModifyOrderByClientID modifies a placed order via clientOrderID
-func (f *FTX) ModifyOrderByClientID(clientOrderID, clientID string, price, size float64) (OrderData, error) {
+func (f *FTX) ModifyOrderByClientID(ctx context.Context, clientOrderID, clientID string, price, size float64) (OrderData, error) {
 	req := make(map[string]interface{})
 	req["price"] = price
 	req["size"] = size
@@ -547,12 +547,12 @@ func (f *FTX) ModifyOrderByClientID(clientOrderID, clientID string, price, size
 	resp := struct {
 		Data OrderData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodPost, fmt.Sprintf(modifyOrderByClientID, clientOrderID), req, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodPost, fmt.Sprintf(modifyOrderByClientID, clientOrderID), req, &resp)
 }
 
 // ModifyTriggerOrder modifies an existing trigger order
 // Choices for ordertype include stop, trailingStop, takeProfit
-func (f *FTX) ModifyTriggerOrder(orderID, orderType string, size, triggerPrice, orderPrice, trailValue float64) (TriggerOrderData, error) {
+func (f *FTX) ModifyTriggerOrder(ctx context.Context, orderID, orderType string, size, triggerPrice, orderPrice, trailValue float64) (TriggerOrderData, error) {
 	req := make(map[string]interface{})
 	req["size"] = size
 	if orderType == order.Stop.Lower() || orderType == "" {
@@ -569,51 +569,51 @@ func (f *FTX) ModifyTriggerOrder(orderID, orderType string, size, triggerPrice,
 	resp := struct {
 		Data TriggerOrderData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodPost, fmt.Sprintf(modifyTriggerOrder, orderID), req, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodPost, fmt.Sprintf(modifyTriggerOrder, orderID), req, &resp)
 }
 
 // GetOrderStatus gets the order status of a given orderID
-func (f *FTX) GetOrderStatus(orderID string) (OrderData, error) {
+func (f *FTX) GetOrderStatus(ctx context.Context, orderID string) (OrderData, error) {
 	resp := struct {
 		Data OrderData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getOrderStatus+orderID, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getOrderStatus+orderID, nil, &resp)
 }
 
 // GetOrderStatusByClientID gets the order status of a given clientOrderID
-func (f *FTX) GetOrderStatusByClientID(clientOrderID string) (OrderData, error) {
+func (f *FTX) GetOrderStatusByClientID(ctx context.Context, clientOrderID string) (OrderData, error) {
 	resp := struct {
 		Data OrderData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getOrderStatusByClientID+clientOrderID, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getOrderStatusByClientID+clientOrderID, nil, &resp)
 }
 
 // DeleteOrder deletes an order
-func (f *FTX) DeleteOrder(orderID string) (string, error) {
+func (f *FTX) DeleteOrder(ctx context.Context, orderID string) (string, error) {
 	resp := struct {
 		Data string `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodDelete, deleteOrder+orderID, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodDelete, deleteOrder+orderID, nil, &resp)
 }
 
 // DeleteOrderByClientID deletes an order
-func (f *FTX) DeleteOrderByClientID(clientID string) (string, error) {
+func (f *FTX) DeleteOrderByClientID(ctx context.Context, clientID string) (string, error) {
 	resp := struct {
 		Data string `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodDelete, deleteOrderByClientID+clientID, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodDelete, deleteOrderByClientID+clientID, nil, &resp)
 }
 
 // DeleteTriggerOrder deletes an order
-func (f *FTX) DeleteTriggerOrder(orderID string) (string, error) {
+func (f *FTX) DeleteTriggerOrder(ctx context.Context, orderID string) (string, error) {
 	resp := struct {
 		Data string `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodDelete, cancelTriggerOrder+orderID, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodDelete, cancelTriggerOrder+orderID, nil, &resp)
 }
 
 // GetFills gets fills' data
-func (f *FTX) GetFills(market, limit string, startTime, endTime time.Time) ([]FillsData, error) {
+func (f *FTX) GetFills(ctx context.Context, market, limit string, startTime, endTime time.Time) ([]FillsData, error) {
 	resp := struct {
 		Data []FillsData `json:"result"`
 	}{}
@@ -631,11 +631,11 @@ func (f *FTX) GetFills(market, limit string, startTime, endTime time.Time) ([]Fi
 		params.Set("start_time", strconv.FormatInt(startTime.Unix(), 10))
 		params.Set("end_time", strconv.FormatInt(endTime.Unix(), 10))
 	}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getFills+params.Encode(), nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getFills+params.Encode(), nil, &resp)
 }
 
 // GetFundingPayments gets funding payments
-func (f *FTX) GetFundingPayments(startTime, endTime time.Time, future string) ([]FundingPaymentsData, error) {
+func (f *FTX) GetFundingPayments(ctx context.Context, startTime, endTime time.Time, future string) ([]FundingPaymentsData, error) {
 	resp := struct {
 		Data []FundingPaymentsData `json:"result"`
 	}{}
@@ -650,87 +650,87 @@ func (f *FTX) GetFundingPayments(startTime, endTime time.Time, future string) ([
 	if future != "" {
 		params.Set("future", future)
 	}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getFundingPayments+params.Encode(), nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getFundingPayments+params.Encode(), nil, &resp)
 }
 
 // ListLeveragedTokens lists leveraged tokens
-func (f *FTX) ListLeveragedTokens() ([]LeveragedTokensData, error) {
+func (f *FTX) ListLeveragedTokens(ctx context.Context) ([]LeveragedTokensData, error) {
 	resp := struct {
 		Data []LeveragedTokensData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getLeveragedTokens, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getLeveragedTokens, nil, &resp)
 }
 
 // GetTokenInfo gets token info
-func (f *FTX) GetTokenInfo(tokenName string) ([]LeveragedTokensData, error) {
+func (f *FTX) GetTokenInfo(ctx context.Context, tokenName string) ([]LeveragedTokensData, error) {
 	resp := struct {
 		Data []LeveragedTokensData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getTokenInfo+tokenName, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getTokenInfo+tokenName, nil, &resp)
 }
 
 // ListLTBalances gets leveraged tokens' balances
-func (f *FTX) ListLTBalances() ([]LTBalanceData, error) {
+func (f *FTX) ListLTBalances(ctx context.Context) ([]LTBalanceData, error) {
 	resp := struct {
 		Data []LTBalanceData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getLTBalances, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getLTBalances, nil, &resp)
 }
 
 // ListLTCreations lists the leveraged tokens' creation requests
-func (f *FTX) ListLTCreations() ([]LTCreationData, error) {
+func (f *FTX) ListLTCreations(ctx context.Context) ([]LTCreationData, error) {
 	resp := struct {
 		Data []LTCreationData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getLTCreations, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getLTCreations, nil, &resp)
 }
 
 // RequestLTCreation sends a request to create a leveraged token
-func (f *FTX) RequestLTCreation(tokenName string, size float64) (RequestTokenCreationData, error) {
+func (f *FTX) RequestLTCreation(ctx context.Context, tokenName string, size float64) (RequestTokenCreationData, error) {
 	req := make(map[string]interface{})
 	req["size"] = size
 	resp := struct {
 		Data RequestTokenCreationData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodPost, fmt.Sprintf(requestLTCreation, tokenName), req, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodPost, fmt.Sprintf(requestLTCreation, tokenName), req, &resp)
 }
 
 // ListLTRedemptions lists the leveraged tokens' redemption requests
-func (f *FTX) ListLTRedemptions() ([]LTRedemptionData, error) {
+func (f *FTX) ListLTRedemptions(ctx context.Context) ([]LTRedemptionData, error) {
 	resp := struct {
 		Data []LTRedemptionData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getLTRedemptions, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getLTRedemptions, nil, &resp)
 }
 
 // RequestLTRedemption sends a request to redeem a leveraged token
-func (f *FTX) RequestLTRedemption(tokenName string, size float64) (LTRedemptionRequestData, error) {
+func (f *FTX) RequestLTRedemption(ctx context.Context, tokenName string, size float64) (LTRedemptionRequestData, error) {
 	req := make(map[string]interface{})
 	req["size"] = size
 	resp := struct {
 		Data LTRedemptionRequestData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodPost, fmt.Sprintf(requestLTRedemption, tokenName), req, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodPost, fmt.Sprintf(requestLTRedemption, tokenName), req, &resp)
 }
 
 // GetQuoteRequests gets a list of quote requests
-func (f *FTX) GetQuoteRequests() ([]QuoteRequestData, error) {
+func (f *FTX) GetQuoteRequests(ctx context.Context) ([]QuoteRequestData, error) {
 	resp := struct {
 		Data []QuoteRequestData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getListQuotes, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getListQuotes, nil, &resp)
 }
 
 // GetYourQuoteRequests gets a list of your quote requests
-func (f *FTX) GetYourQuoteRequests() ([]PersonalQuotesData, error) {
+func (f *FTX) GetYourQuoteRequests(ctx context.Context) ([]PersonalQuotesData, error) {
 	resp := struct {
 		Data []PersonalQuotesData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getMyQuotesRequests, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getMyQuotesRequests, nil, &resp)
 }
 
 // CreateQuoteRequest sends a request to create a quote
-func (f *FTX) CreateQuoteRequest(underlying, optionType, side string, expiry int64, requestExpiry string, strike, size, limitPrice, counterParyID float64, hideLimitPrice bool) (CreateQuoteRequestData, error) {
+func (f *FTX) CreateQuoteRequest(ctx context.Context, underlying, optionType, side string, expiry int64, requestExpiry string, strike, size, limitPrice, counterParyID float64, hideLimitPrice bool) (CreateQuoteRequestData, error) {
 	req := make(map[string]interface{})
 	req["underlying"] = underlying
 	req["type"] = optionType
@@ -751,75 +751,75 @@ func (f *FTX) CreateQuoteRequest(underlying, optionType, side string, expiry int
 	resp := struct {
 		Data CreateQuoteRequestData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodPost, createQuoteRequest, req, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodPost, createQuoteRequest, req, &resp)
 }
 
 // DeleteQuote sends request to cancel a quote
-func (f *FTX) DeleteQuote(requestID string) (CancelQuoteRequestData, error) {
+func (f *FTX) DeleteQuote(ctx context.Context, requestID string) (CancelQuoteRequestData, error) {
 	resp := struct {
 		Data CancelQuoteRequestData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodDelete, deleteQuote+requestID, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodDelete, deleteQuote+requestID, nil, &resp)
 }
 
 // GetQuotesForYourQuote gets a list of quotes for your quote
-func (f *FTX) GetQuotesForYourQuote(requestID string) (QuoteForQuoteData, error) {
+func (f *FTX) GetQuotesForYourQuote(ctx context.Context, requestID string) (QuoteForQuoteData, error) {
 	var resp QuoteForQuoteData
-	return resp, f.SendAuthHTTPRequest(http.MethodGet, fmt.Sprintf(endpointQuote, requestID), nil, &resp)
+	return resp, f.SendAuthHTTPRequest(ctx, http.MethodGet, fmt.Sprintf(endpointQuote, requestID), nil, &resp)
 }
 
 // MakeQuote makes a quote for a quote
-func (f *FTX) MakeQuote(requestID, price string) ([]QuoteForQuoteData, error) {
+func (f *FTX) MakeQuote(ctx context.Context, requestID, price string) ([]QuoteForQuoteData, error) {
 	params := url.Values{}
 	params.Set("price", price)
 	resp := struct {
 		Data []QuoteForQuoteData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodPost, fmt.Sprintf(endpointQuote, requestID), nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodPost, fmt.Sprintf(endpointQuote, requestID), nil, &resp)
 }
 
 // MyQuotes gets a list of my quotes for quotes
-func (f *FTX) MyQuotes() ([]QuoteForQuoteData, error) {
+func (f *FTX) MyQuotes(ctx context.Context) ([]QuoteForQuoteData, error) {
 	resp := struct {
 		Data []QuoteForQuoteData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getMyQuotes, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getMyQuotes, nil, &resp)
 }
 
 // DeleteMyQuote deletes my quote for quotes
-func (f *FTX) DeleteMyQuote(quoteID string) ([]QuoteForQuoteData, error) {
+func (f *FTX) DeleteMyQuote(ctx context.Context, quoteID string) ([]QuoteForQuoteData, error) {
 	resp := struct {
 		Data []QuoteForQuoteData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodDelete, deleteMyQuote+quoteID, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodDelete, deleteMyQuote+quoteID, nil, &resp)
 }
 
 // AcceptQuote accepts the quote for quote
-func (f *FTX) AcceptQuote(quoteID string) ([]QuoteForQuoteData, error) {
+func (f *FTX) AcceptQuote(ctx context.Context, quoteID string) ([]QuoteForQuoteData, error) {
 	resp := struct {
 		Data []QuoteForQuoteData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodPost, fmt.Sprintf(acceptQuote, quoteID), nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodPost, fmt.Sprintf(acceptQuote, quoteID), nil, &resp)
 }
 
 // GetAccountOptionsInfo gets account's options' info
-func (f *FTX) GetAccountOptionsInfo() (AccountOptionsInfoData, error) {
+func (f *FTX) GetAccountOptionsInfo(ctx context.Context) (AccountOptionsInfoData, error) {
 	resp := struct {
 		Data AccountOptionsInfoData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getOptionsInfo, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getOptionsInfo, nil, &resp)
 }
 
 // GetOptionsPositions gets options' positions
-func (f *FTX) GetOptionsPositions() ([]OptionsPositionsData, error) {
+func (f *FTX) GetOptionsPositions(ctx context.Context) ([]OptionsPositionsData, error) {
 	resp := struct {
 		Data []OptionsPositionsData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getOptionsPositions, nil, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getOptionsPositions, nil, &resp)
 }
 
 // GetPublicOptionsTrades gets options' trades from public
-func (f *FTX) GetPublicOptionsTrades(startTime, endTime time.Time, limit string) ([]OptionsTradesData, error) {
+func (f *FTX) GetPublicOptionsTrades(ctx context.Context, startTime, endTime time.Time, limit string) ([]OptionsTradesData, error) {
 	resp := struct {
 		Data []OptionsTradesData `json:"result"`
 	}{}
@@ -834,11 +834,11 @@ func (f *FTX) GetPublicOptionsTrades(startTime, endTime time.Time, limit string)
 	if limit != "" {
 		req["limit"] = limit
 	}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getPublicOptionsTrades, req, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getPublicOptionsTrades, req, &resp)
 }
 
 // GetOptionsFills gets fills data for options
-func (f *FTX) GetOptionsFills(startTime, endTime time.Time, limit string) ([]OptionFillsData, error) {
+func (f *FTX) GetOptionsFills(ctx context.Context, startTime, endTime time.Time, limit string) ([]OptionFillsData, error) {
 	resp := struct {
 		Data []OptionFillsData `json:"result"`
 	}{}
@@ -853,11 +853,11 @@ func (f *FTX) GetOptionsFills(startTime, endTime time.Time, limit string) ([]Opt
 	if limit != "" {
 		req["limit"] = limit
 	}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getOptionsFills, req, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getOptionsFills, req, &resp)
 }
 
 // GetHistoricalOpenInterest gets historical OI data for options
-func (f *FTX) GetHistoricalOpenInterest(startTime, endTime time.Time, limit string) ([]OptionOpenInterest, error) {
+func (f *FTX) GetHistoricalOpenInterest(ctx context.Context, startTime, endTime time.Time, limit string) ([]OptionOpenInterest, error) {
 	resp := struct {
 		Data []OptionOpenInterest `json:"result"`
 	}{}
@@ -872,11 +872,11 @@ func (f *FTX) GetHistoricalOpenInterest(startTime, endTime time.Time, limit stri
 	if limit != "" {
 		req["limit"] = limit
 	}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getHistricalOpenInterest, req, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getHistricalOpenInterest, req, &resp)
 }
 
 // GetHistoricalVolume gets historical contract volume for options
-func (f *FTX) GetHistoricalVolume(startTime, endTime time.Time, limit string) ([]OptionVolume, error) {
+func (f *FTX) GetHistoricalVolume(ctx context.Context, startTime, endTime time.Time, limit string) ([]OptionVolume, error) {
 	resp := struct {
 		Data []OptionVolume `json:"result"`
 	}{}
@@ -891,19 +891,19 @@ func (f *FTX) GetHistoricalVolume(startTime, endTime time.Time, limit string) ([
 	if limit != "" {
 		req["limit"] = limit
 	}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getHistricalVolumes, req, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getHistricalVolumes, req, &resp)
 }
 
 // GetOpenInterest gets open interest for options
-func (f *FTX) GetOpenInterest() (OpenInterest, error) {
+func (f *FTX) GetOpenInterest(ctx context.Context) (OpenInterest, error) {
 	resp := struct {
 		Data OpenInterest `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getOpenInterest, "", &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getOpenInterest, "", &resp)
 }
 
 // GetFundingRates gets data on funding rates
-func (f *FTX) GetFundingRates(startTime, endTime time.Time, marketName string) ([]FundingRatesData, error) {
+func (f *FTX) GetFundingRates(ctx context.Context, startTime, endTime time.Time, marketName string) ([]FundingRatesData, error) {
 	resp := struct {
 		Data []FundingRatesData `json:"result"`
 	}{}
@@ -920,12 +920,12 @@ func (f *FTX) GetFundingRates(startTime, endTime time.Time, marketName string) (
 	if marketName != "" {
 		req["future"] = marketName
 	}
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getFundingRates, req, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getFundingRates, req, &resp)
 }
 
 // SendAuthHTTPRequest sends an authenticated request
-func (f *FTX) SendAuthHTTPRequest(method, path string, data, result interface{}) error {
-	ts := strconv.FormatInt(time.Now().UnixNano()/1000000, 10)
+func (f *FTX) SendAuthHTTPRequest(ctx context.Context, method, path string, data, result interface{}) error {
+	ts := strconv.FormatInt(f.Now().UnixNano()/1000000, 10)
 	var body io.Reader
 	var hmac, payload []byte
 	var err error
@@ -950,9 +950,9 @@ func (f *FTX) SendAuthHTTPRequest(method, path string, data, result interface{})
 	if f.SubAccount != "" {
 		headers["FTX-SUBACCOUNT"] = f.SubAccount
 	}
-	return f.SendPayload(context.Background(), &request.Item{
+	return f.SendPayload(ctx, &request.Item{
 		Method:        method,
-		Path:          ftxAPIURL + path,
+		Path:          f.API.Endpoints.URL + path,
 		Headers:       headers,
 		Body:          body,
 		Result:        result,
@@ -964,13 +964,13 @@ func (f *FTX) SendAuthHTTPRequest(method, path string, data, result interface{})
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (f *FTX) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (f *FTX) GetFee(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
 	switch feeBuilder.FeeType {
 	case exchange.OfflineTradeFee:
 		fee = getOfflineTradeFee(feeBuilder)
 	default:
-		feeData, err := f.GetAccountInfo()
+		feeData, err := f.GetAccountInfo(ctx)
 		if err != nil {
 			return 0, err
 		}
@@ -995,7 +995,7 @@ func getOfflineTradeFee(feeBuilder *exchange.FeeBuilder) float64 {
 	return 0.0007 * feeBuilder.PurchasePrice * feeBuilder.Amount
 }
 
-func (f *FTX) compatibleOrderVars(orderSide, orderStatus, orderType string, amount, filledAmount, avgFillPrice float64) (OrderVars, error) {
+func (f *FTX) compatibleOrderVars(ctx context.Context, orderSide, orderStatus, orderType string, amount, filledAmount, avgFillPrice float64) (OrderVars, error) {
 	var resp OrderVars
 	switch orderSide {
 	case order.Buy.Lower():
@@ -1027,7 +1027,7 @@ func (f *FTX) compatibleOrderVars(orderSide, orderStatus, orderType string, amou
 		resp.OrderType = order.Limit
 		feeBuilder.IsMaker = true
 	}
-	fee, err := f.GetFee(&feeBuilder)
+	fee, err := f.GetFee(ctx, &feeBuilder)
 	if err != nil {
 		return resp, err
 	}
@@ -1036,7 +1036,7 @@ func (f *FTX) compatibleOrderVars(orderSide, orderStatus, orderType string, amou
 }
 
 // RequestForQuotes requests for otc quotes
-func (f *FTX) RequestForQuotes(base, quote string, amount float64) (RequestQuoteData, error) {
+func (f *FTX) RequestForQuotes(ctx context.Context, base, quote string, amount float64) (RequestQuoteData, error) {
 	resp := struct {
 		Data RequestQuoteData `json:"result"`
 	}{}
@@ -1044,20 +1044,20 @@ func (f *FTX) RequestForQuotes(base, quote string, amount float64) (RequestQuote
 	req["fromCoin"] = base
 	req["toCoin"] = quote
 	req["size"] = amount
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodPost, requestOTCQuote, req, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodPost, requestOTCQuote, req, &resp)
 }
 
 // GetOTCQuoteStatus gets quote status of a quote
-func (f *FTX) GetOTCQuoteStatus(marketName, quoteID string) ([]QuoteStatusData, error) {
+func (f *FTX) GetOTCQuoteStatus(ctx context.Context, marketName, quoteID string) ([]QuoteStatusData, error) {
 	resp := struct {
 		Data []QuoteStatusData `json:"result"`
 	}{}
 	params := url.Values{}
 	params.Set("market", marketName)
-	return resp.Data, f.SendAuthHTTPRequest(http.MethodGet, getOTCQuoteStatus+quoteID, params, &resp)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, http.MethodGet, getOTCQuoteStatus+quoteID, params, &resp)
 }
 
 // AcceptOTCQuote requests for otc quotes
-func (f *FTX) AcceptOTCQuote(quoteID string) error {
-	return f.SendAuthHTTPRequest(http.MethodPost, fmt.Sprintf(acceptOTCQuote, quoteID), nil, nil)
+func (f *FTX) AcceptOTCQuote(ctx context.Context, quoteID string) error {
+	return f.SendAuthHTTPRequest(ctx, http.MethodPost, fmt.Sprintf(acceptOTCQuote, quoteID), nil, nil)
 }
@@ -0,0 +1,44 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package ftx
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	f.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exchCfg, err := cfg.GetExchangeConfig("FTX")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exchCfg.API.AuthenticatedSupport = true
+	exchCfg.API.AuthenticatedWebsocketSupport = true
+	exchCfg.API.Credentials.Key = apiKey
+	exchCfg.API.Credentials.Secret = apiSecret
+	f.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = f.Setup(exchCfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	f.Websocket.DataHandler = sharedtestvalues.GetWebsocketInterfaceChannelOverride()
+	f.Websocket.TrafficAlert = sharedtestvalues.GetWebsocketStructChannelOverride()
+	log.Printf(sharedtestvalues.LiveTesting, f.Name, f.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
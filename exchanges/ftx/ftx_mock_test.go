@@ -0,0 +1,58 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package ftx
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/ftx/ftx.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	f.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exchCfg, err := cfg.GetExchangeConfig("FTX")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exchCfg.API.AuthenticatedSupport = true
+	exchCfg.API.AuthenticatedWebsocketSupport = true
+	exchCfg.API.Credentials.Key = apiKey
+	exchCfg.API.Credentials.Secret = apiSecret
+	f.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = f.Setup(exchCfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	f.Websocket.DataHandler = sharedtestvalues.GetWebsocketInterfaceChannelOverride()
+	f.Websocket.TrafficAlert = sharedtestvalues.GetWebsocketStructChannelOverride()
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+
+	f.HTTPClient = newClient
+	f.API.Endpoints.URL = serverDetails + "/api"
+	log.Printf(sharedtestvalues.MockTesting, f.Name, f.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
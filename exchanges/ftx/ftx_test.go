@@ -1,21 +1,18 @@
 package ftx
 
 import (
-	"log"
-	"os"
+	"context"
 	"reflect"
 	"strings"
 	"testing"
 	"time"
 
-	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/core"
 	"github.com/yurulab/gocryptotrader/currency"
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
 	"github.com/yurulab/gocryptotrader/exchanges/kline"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
-	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/yurulab/gocryptotrader/portfolio/withdraw"
 )
 
@@ -32,33 +29,6 @@ const (
 
 var f FTX
 
-func TestMain(m *testing.M) {
-	f.SetDefaults()
-	cfg := config.GetConfig()
-	err := cfg.LoadConfig("../../testdata/configtest.json", true)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	exchCfg, err := cfg.GetExchangeConfig("FTX")
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	exchCfg.API.AuthenticatedSupport = true
-	exchCfg.API.AuthenticatedWebsocketSupport = true
-	exchCfg.API.Credentials.Key = apiKey
-	exchCfg.API.Credentials.Secret = apiSecret
-	f.Websocket = sharedtestvalues.NewTestWebsocket()
-	err = f.Setup(exchCfg)
-	if err != nil {
-		log.Fatal(err)
-	}
-	f.Websocket.DataHandler = sharedtestvalues.GetWebsocketInterfaceChannelOverride()
-	f.Websocket.TrafficAlert = sharedtestvalues.GetWebsocketStructChannelOverride()
-	os.Exit(m.Run())
-}
-
 func areTestAPIKeysSet() bool {
 	return f.ValidateAPICredentials()
 }
@@ -67,7 +37,7 @@ func areTestAPIKeysSet() bool {
 
 func TestGetMarkets(t *testing.T) {
 	t.Parallel()
-	_, err := f.GetMarkets()
+	_, err := f.GetMarkets(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -75,7 +45,7 @@ func TestGetMarkets(t *testing.T) {
 
 func TestGetMarket(t *testing.T) {
 	t.Parallel()
-	_, err := f.GetMarket(spotPair)
+	_, err := f.GetMarket(context.Background(), spotPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -83,7 +53,7 @@ func TestGetMarket(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := f.GetOrderbook(spotPair, 5)
+	_, err := f.GetOrderbook(context.Background(), spotPair, 5)
 	if err != nil {
 		t.Error(err)
 	}
@@ -91,15 +61,15 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := f.GetTrades(spotPair, time.Time{}, time.Time{}, 200)
+	_, err := f.GetTrades(context.Background(), spotPair, time.Time{}, time.Time{}, 200)
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = f.GetTrades(spotPair, time.Time{}, time.Time{}, 5)
+	_, err = f.GetTrades(context.Background(), spotPair, time.Time{}, time.Time{}, 5)
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = f.GetTrades(spotPair, time.Unix(1559901511, 0), time.Unix(1559881511, 0), 5)
+	_, err = f.GetTrades(context.Background(), spotPair, time.Unix(1559901511, 0), time.Unix(1559881511, 0), 5)
 	if err == nil {
 		t.Error(err)
 	}
@@ -107,15 +77,15 @@ func TestGetTrades(t *testing.T) {
 
 func TestGetHistoricalData(t *testing.T) {
 	t.Parallel()
-	_, err := f.GetHistoricalData(spotPair, "86400", "5", time.Time{}, time.Time{})
+	_, err := f.GetHistoricalData(context.Background(), spotPair, "86400", "5", time.Time{}, time.Time{})
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = f.GetHistoricalData(spotPair, "86400", "5", time.Unix(1559881511, 0), time.Unix(1559901511, 0))
+	_, err = f.GetHistoricalData(context.Background(), spotPair, "86400", "5", time.Unix(1559881511, 0), time.Unix(1559901511, 0))
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = f.GetHistoricalData(spotPair, "86400", "5", time.Unix(1559901511, 0), time.Unix(1559881511, 0))
+	_, err = f.GetHistoricalData(context.Background(), spotPair, "86400", "5", time.Unix(1559901511, 0), time.Unix(1559881511, 0))
 	if err == nil {
 		t.Error(err)
 	}
@@ -123,7 +93,7 @@ func TestGetHistoricalData(t *testing.T) {
 
 func TestGetFutures(t *testing.T) {
 	t.Parallel()
-	_, err := f.GetFutures()
+	_, err := f.GetFutures(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -131,7 +101,7 @@ func TestGetFutures(t *testing.T) {
 
 func TestGetFuture(t *testing.T) {
 	t.Parallel()
-	_, err := f.GetFuture(futuresPair)
+	_, err := f.GetFuture(context.Background(), futuresPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -139,7 +109,38 @@ func TestGetFuture(t *testing.T) {
 
 func TestGetFutureStats(t *testing.T) {
 	t.Parallel()
-	_, err := f.GetFutureStats("BTC-PERP")
+	_, err := f.GetFutureStats(context.Background(), "BTC-PERP")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetLiquidations(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPairWithDelimiter("BTC", "PERP", "-")
+	end := time.Now()
+	_, err := f.GetLiquidations(context.Background(), cp, asset.Spot, end.Add(-time.Hour), end)
+	if err == nil {
+		t.Error("expected error for spot asset")
+	}
+	_, err = f.GetLiquidations(context.Background(), cp, asset.Futures, end, end.Add(-time.Hour))
+	if err == nil {
+		t.Error("expected error when start is after end")
+	}
+	_, err = f.GetLiquidations(context.Background(), cp, asset.Futures, end.Add(-time.Hour), end)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpdateDerivativeInfo(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPairWithDelimiter("BTC", "PERP", "-")
+	_, err := f.UpdateDerivativeInfo(context.Background(), cp, asset.Spot)
+	if err == nil {
+		t.Error("expected error for spot asset")
+	}
+	_, err = f.UpdateDerivativeInfo(context.Background(), cp, asset.Futures)
 	if err != nil {
 		t.Error(err)
 	}
@@ -147,7 +148,7 @@ func TestGetFutureStats(t *testing.T) {
 
 func TestGetFundingRates(t *testing.T) {
 	t.Parallel()
-	_, err := f.GetFundingRates()
+	_, err := f.GetFundingRates(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -158,7 +159,7 @@ func TestGetAccountInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetAccountInfo()
+	_, err := f.GetAccountInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -169,7 +170,7 @@ func TestGetPositions(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetPositions()
+	_, err := f.GetPositions(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -180,7 +181,7 @@ func TestChangeAccountLeverage(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
-	err := f.ChangeAccountLeverage(50)
+	err := f.ChangeAccountLeverage(context.Background(), 50)
 	if err != nil {
 		t.Error(err)
 	}
@@ -191,7 +192,7 @@ func TestGetCoins(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetCoins()
+	_, err := f.GetCoins(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -202,7 +203,7 @@ func TestGetBalances(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetBalances()
+	_, err := f.GetBalances(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -213,7 +214,7 @@ func TestGetAllWalletBalances(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetAllWalletBalances()
+	_, err := f.GetAllWalletBalances(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -224,7 +225,7 @@ func TestFetchDepositAddress(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.FetchDepositAddress("TUSD")
+	_, err := f.FetchDepositAddress(context.Background(), "TUSD")
 	if err != nil {
 		t.Error(err)
 	}
@@ -235,7 +236,7 @@ func TestFetchDepositHistory(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.FetchDepositHistory()
+	_, err := f.FetchDepositHistory(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -246,7 +247,7 @@ func TestFetchWithdrawalHistory(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.FetchWithdrawalHistory()
+	_, err := f.FetchWithdrawalHistory(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -257,7 +258,7 @@ func TestWithdraw(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
-	_, err := f.Withdraw("BTC", core.BitcoinDonationAddress, "", "", "957378", 0.0009)
+	_, err := f.Withdraw(context.Background(), "BTC", core.BitcoinDonationAddress, "", "", "957378", 0.0009)
 	if err != nil {
 		t.Error(err)
 	}
@@ -268,7 +269,7 @@ func TestGetOpenOrders(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetOpenOrders(spotPair)
+	_, err := f.GetOpenOrders(context.Background(), spotPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -279,15 +280,15 @@ func TestFetchOrderHistory(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.FetchOrderHistory(spotPair, time.Time{}, time.Time{}, "2")
+	_, err := f.FetchOrderHistory(context.Background(), spotPair, time.Time{}, time.Time{}, "2")
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = f.FetchOrderHistory(spotPair, time.Unix(1559881511, 0), time.Unix(1559901511, 0), "2")
+	_, err = f.FetchOrderHistory(context.Background(), spotPair, time.Unix(1559881511, 0), time.Unix(1559901511, 0), "2")
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = f.FetchOrderHistory(spotPair, time.Unix(1559901511, 0), time.Unix(1559881511, 0), "2")
+	_, err = f.FetchOrderHistory(context.Background(), spotPair, time.Unix(1559901511, 0), time.Unix(1559881511, 0), "2")
 	if err == nil {
 		t.Error(err)
 	}
@@ -298,7 +299,7 @@ func TestGetOpenTriggerOrders(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetOpenTriggerOrders(spotPair, "")
+	_, err := f.GetOpenTriggerOrders(context.Background(), spotPair, "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -309,7 +310,7 @@ func TestGetTriggerOrderTriggers(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetTriggerOrderTriggers("1031")
+	_, err := f.GetTriggerOrderTriggers(context.Background(), "1031")
 	if err != nil {
 		t.Error(err)
 	}
@@ -320,15 +321,15 @@ func TestGetTriggerOrderHistory(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetTriggerOrderHistory(spotPair, time.Time{}, time.Time{}, order.Buy.Lower(), "stop", "1")
+	_, err := f.GetTriggerOrderHistory(context.Background(), spotPair, time.Time{}, time.Time{}, order.Buy.Lower(), "stop", "1")
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = f.GetTriggerOrderHistory(spotPair, time.Unix(1559881511, 0), time.Unix(1559901511, 0), order.Buy.Lower(), "stop", "1")
+	_, err = f.GetTriggerOrderHistory(context.Background(), spotPair, time.Unix(1559881511, 0), time.Unix(1559901511, 0), order.Buy.Lower(), "stop", "1")
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = f.GetTriggerOrderHistory(spotPair, time.Unix(1559901511, 0), time.Unix(1559881511, 0), order.Buy.Lower(), "stop", "1")
+	_, err = f.GetTriggerOrderHistory(context.Background(), spotPair, time.Unix(1559901511, 0), time.Unix(1559881511, 0), order.Buy.Lower(), "stop", "1")
 	if err == nil {
 		t.Error(err)
 	}
@@ -339,7 +340,7 @@ func TestOrder(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
-	_, err := f.Order(spotPair, order.Buy.Lower(), "limit", "", "", "", "", 0.0001, 500)
+	_, err := f.Order(context.Background(), spotPair, order.Buy.Lower(), "limit", "", "", "", "", 0.0001, 500)
 	if err != nil {
 		t.Error(err)
 	}
@@ -350,7 +351,7 @@ func TestTriggerOrder(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
-	_, err := f.TriggerOrder(spotPair, order.Buy.Lower(), order.Stop.Lower(), "", "", 500, 0.0004, 0.0001, 0)
+	_, err := f.TriggerOrder(context.Background(), spotPair, order.Buy.Lower(), order.Stop.Lower(), "", "", 500, 0.0004, 0.0001, 0)
 	if err != nil {
 		t.Error(err)
 	}
@@ -361,7 +362,7 @@ func TestDeleteOrder(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
-	_, err := f.DeleteOrder("1031")
+	_, err := f.DeleteOrder(context.Background(), "1031")
 	if err != nil {
 		t.Error(err)
 	}
@@ -372,7 +373,7 @@ func TestDeleteOrderByClientID(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
-	_, err := f.DeleteOrderByClientID("clientID123")
+	_, err := f.DeleteOrderByClientID(context.Background(), "clientID123")
 	if err != nil {
 		t.Error(err)
 	}
@@ -383,7 +384,7 @@ func TestDeleteTriggerOrder(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
-	_, err := f.DeleteTriggerOrder("1031")
+	_, err := f.DeleteTriggerOrder(context.Background(), "1031")
 	if err != nil {
 		t.Error(err)
 	}
@@ -394,15 +395,15 @@ func TestGetFills(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetFills(spotPair, "", time.Time{}, time.Time{})
+	_, err := f.GetFills(context.Background(), spotPair, "", time.Time{}, time.Time{})
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = f.GetFills(spotPair, "", time.Unix(1559881511, 0), time.Unix(1559901511, 0))
+	_, err = f.GetFills(context.Background(), spotPair, "", time.Unix(1559881511, 0), time.Unix(1559901511, 0))
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = f.GetFills(spotPair, "", time.Unix(1559901511, 0), time.Unix(1559881511, 0))
+	_, err = f.GetFills(context.Background(), spotPair, "", time.Unix(1559901511, 0), time.Unix(1559881511, 0))
 	if err == nil {
 		t.Error(err)
 	}
@@ -413,11 +414,11 @@ func TestGetFundingPayments(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetFundingPayments(time.Unix(1559881511, 0), time.Unix(1559901511, 0), "")
+	_, err := f.GetFundingPayments(context.Background(), time.Unix(1559881511, 0), time.Unix(1559901511, 0), "")
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = f.GetFundingPayments(time.Unix(1559901511, 0), time.Unix(1559881511, 0), "")
+	_, err = f.GetFundingPayments(context.Background(), time.Unix(1559901511, 0), time.Unix(1559881511, 0), "")
 	if err == nil {
 		t.Error(err)
 	}
@@ -428,7 +429,7 @@ func TestListLeveragedTokens(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.ListLeveragedTokens()
+	_, err := f.ListLeveragedTokens(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -439,7 +440,7 @@ func TestGetTokenInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetTokenInfo("")
+	_, err := f.GetTokenInfo(context.Background(), "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -450,7 +451,7 @@ func TestListLTBalances(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.ListLTBalances()
+	_, err := f.ListLTBalances(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -461,7 +462,7 @@ func TestListLTCreations(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.ListLTCreations()
+	_, err := f.ListLTCreations(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -472,7 +473,7 @@ func TestRequestLTCreation(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.RequestLTCreation(testToken, 1)
+	_, err := f.RequestLTCreation(context.Background(), testToken, 1)
 	if err != nil {
 		t.Error(err)
 	}
@@ -483,7 +484,7 @@ func TestListLTRedemptions(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.ListLTRedemptions()
+	_, err := f.ListLTRedemptions(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -494,7 +495,7 @@ func TestGetQuoteRequests(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetQuoteRequests()
+	_, err := f.GetQuoteRequests(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -505,7 +506,7 @@ func TestGetYourQuoteRequests(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetYourQuoteRequests()
+	_, err := f.GetYourQuoteRequests(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -516,7 +517,7 @@ func TestCreateQuoteRequest(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
-	_, err := f.CreateQuoteRequest(strings.ToUpper(currency.BTC.String()), "call", order.Buy.Lower(), 1593140400, "", 10, 10, 5, 0, false)
+	_, err := f.CreateQuoteRequest(context.Background(), strings.ToUpper(currency.BTC.String()), "call", order.Buy.Lower(), 1593140400, "", 10, 10, 5, 0, false)
 	if err != nil {
 		t.Error(err)
 	}
@@ -527,7 +528,7 @@ func TestDeleteQuote(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
-	_, err := f.DeleteQuote("1031")
+	_, err := f.DeleteQuote(context.Background(), "1031")
 	if err != nil {
 		t.Error(err)
 	}
@@ -538,7 +539,7 @@ func TestGetQuotesForYourQuote(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetQuotesForYourQuote("1031")
+	_, err := f.GetQuotesForYourQuote(context.Background(), "1031")
 	if err != nil {
 		t.Error(err)
 	}
@@ -549,7 +550,7 @@ func TestMakeQuote(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
-	_, err := f.MakeQuote("1031", "5")
+	_, err := f.MakeQuote(context.Background(), "1031", "5")
 	if err != nil {
 		t.Error(err)
 	}
@@ -560,7 +561,7 @@ func TestMyQuotes(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.MyQuotes()
+	_, err := f.MyQuotes(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -571,7 +572,7 @@ func TestDeleteMyQuote(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
-	_, err := f.DeleteMyQuote("1031")
+	_, err := f.DeleteMyQuote(context.Background(), "1031")
 	if err != nil {
 		t.Error(err)
 	}
@@ -582,7 +583,7 @@ func TestAcceptQuote(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
-	_, err := f.AcceptQuote("1031")
+	_, err := f.AcceptQuote(context.Background(), "1031")
 	if err != nil {
 		t.Error(err)
 	}
@@ -593,7 +594,7 @@ func TestGetAccountOptionsInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetAccountOptionsInfo()
+	_, err := f.GetAccountOptionsInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -604,7 +605,7 @@ func TestGetOptionsPositions(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetOptionsPositions()
+	_, err := f.GetOptionsPositions(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -615,15 +616,15 @@ func TestGetPublicOptionsTrades(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetPublicOptionsTrades(time.Time{}, time.Time{}, "5")
+	_, err := f.GetPublicOptionsTrades(context.Background(), time.Time{}, time.Time{}, "5")
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = f.GetPublicOptionsTrades(time.Unix(1559881511, 0), time.Unix(1559901511, 0), "5")
+	_, err = f.GetPublicOptionsTrades(context.Background(), time.Unix(1559881511, 0), time.Unix(1559901511, 0), "5")
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = f.GetPublicOptionsTrades(time.Unix(1559901511, 0), time.Unix(1559881511, 0), "5")
+	_, err = f.GetPublicOptionsTrades(context.Background(), time.Unix(1559901511, 0), time.Unix(1559881511, 0), "5")
 	if err == nil {
 		t.Error(err)
 	}
@@ -634,15 +635,15 @@ func TestGetOptionsFills(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.GetOptionsFills(time.Time{}, time.Time{}, "5")
+	_, err := f.GetOptionsFills(context.Background(), time.Time{}, time.Time{}, "5")
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = f.GetOptionsFills(time.Unix(1559881511, 0), time.Unix(1559901511, 0), "5")
+	_, err = f.GetOptionsFills(context.Background(), time.Unix(1559881511, 0), time.Unix(1559901511, 0), "5")
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = f.GetOptionsFills(time.Unix(1559901511, 0), time.Unix(1559881511, 0), "5")
+	_, err = f.GetOptionsFills(context.Background(), time.Unix(1559901511, 0), time.Unix(1559881511, 0), "5")
 	if err == nil {
 		t.Error(err)
 	}
@@ -651,7 +652,7 @@ func TestGetOptionsFills(t *testing.T) {
 func TestUpdateOrderbook(t *testing.T) {
 	t.Parallel()
 	cp := currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "/")
-	_, err := f.UpdateOrderbook(cp, asset.Spot)
+	_, err := f.UpdateOrderbook(context.Background(), cp, asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
@@ -660,7 +661,7 @@ func TestUpdateOrderbook(t *testing.T) {
 func TestUpdateTicker(t *testing.T) {
 	t.Parallel()
 	cp := currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "/")
-	_, err := f.UpdateTicker(cp, asset.Spot)
+	_, err := f.UpdateTicker(context.Background(), cp, asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
@@ -674,7 +675,7 @@ func TestGetActiveOrders(t *testing.T) {
 	var orderReq order.GetOrdersRequest
 	cp := currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "/")
 	orderReq.Pairs = append(orderReq.Pairs, cp)
-	_, err := f.GetActiveOrders(&orderReq)
+	_, err := f.GetActiveOrders(context.Background(), &orderReq)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -688,7 +689,7 @@ func TestGetOrderHistory(t *testing.T) {
 	var orderReq order.GetOrdersRequest
 	cp := currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "/")
 	orderReq.Pairs = append(orderReq.Pairs, cp)
-	_, err := f.GetOrderHistory(&orderReq)
+	_, err := f.GetOrderHistory(context.Background(), &orderReq)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -699,7 +700,7 @@ func TestUpdateAccountHoldings(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := f.UpdateAccountInfo()
+	_, err := f.UpdateAccountInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -710,7 +711,7 @@ func TestFetchAccountInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := f.FetchAccountInfo()
+	_, err := f.FetchAccountInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -725,7 +726,7 @@ func TestGetFee(t *testing.T) {
 	var a float64
 	var err error
 	if areTestAPIKeysSet() {
-		a, err = f.GetFee(&x)
+		a, err = f.GetFee(context.Background(), &x)
 		if err != nil {
 			t.Error(err)
 		}
@@ -735,7 +736,7 @@ func TestGetFee(t *testing.T) {
 	}
 	x.IsMaker = false
 	if areTestAPIKeysSet() {
-		a, err = f.GetFee(&x)
+		a, err = f.GetFee(context.Background(), &x)
 		if err != nil {
 			t.Error(err)
 		}
@@ -744,7 +745,7 @@ func TestGetFee(t *testing.T) {
 		}
 	}
 	x.FeeType = exchange.OfflineTradeFee
-	a, err = f.GetFee(&x)
+	a, err = f.GetFee(context.Background(), &x)
 	if err != nil {
 		t.Error(err)
 	}
@@ -752,7 +753,7 @@ func TestGetFee(t *testing.T) {
 		t.Errorf("incorrect offline taker fee value")
 	}
 	x.IsMaker = true
-	a, err = f.GetFee(&x)
+	a, err = f.GetFee(context.Background(), &x)
 	if err != nil {
 		t.Error(err)
 	}
@@ -783,7 +784,7 @@ func TestGetOrderStatus(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := f.GetOrderStatus("1031")
+	_, err := f.GetOrderStatus(context.Background(), "1031")
 	if err != nil {
 		t.Error(err)
 	}
@@ -794,7 +795,7 @@ func TestGetOrderStatusByClientID(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := f.GetOrderStatusByClientID("testID")
+	_, err := f.GetOrderStatusByClientID(context.Background(), "testID")
 	if err != nil {
 		t.Error(err)
 	}
@@ -805,7 +806,7 @@ func TestRequestLTRedemption(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := f.RequestLTRedemption("ETHBULL", 5)
+	_, err := f.RequestLTRedemption(context.Background(), "ETHBULL", 5)
 	if err != nil {
 		t.Error(err)
 	}
@@ -825,7 +826,7 @@ func TestWithdrawCryptocurrencyFunds(t *testing.T) {
 	request.Crypto = &cryptoData
 	request.OneTimePassword = 123456
 	request.TradePassword = "incorrectTradePassword"
-	_, err := f.WithdrawCryptocurrencyFunds(request)
+	_, err := f.WithdrawCryptocurrencyFunds(context.Background(), request)
 	if err != nil {
 		t.Error(err)
 	}
@@ -836,7 +837,7 @@ func TestGetDepositAddress(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := f.GetDepositAddress(currency.NewCode("FTT"), "")
+	_, err := f.GetDepositAddress(context.Background(), currency.NewCode("FTT"), "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -847,7 +848,7 @@ func TestGetFundingHistory(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := f.GetFundingHistory()
+	_, err := f.GetFundingHistory(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -861,7 +862,7 @@ func TestGetHistoricCandles(t *testing.T) {
 	}
 	start := time.Date(2019, 11, 12, 0, 0, 0, 0, time.UTC)
 	end := start.AddDate(0, 0, 5)
-	_, err = f.GetHistoricCandles(currencyPair, asset.Spot, start, end, kline.OneDay)
+	_, err = f.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, start, end, kline.OneDay)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -875,7 +876,7 @@ func TestGetHistoricCandlesExtended(t *testing.T) {
 	}
 	start := time.Date(2019, 11, 12, 0, 0, 0, 0, time.UTC)
 	end := start.AddDate(0, 0, 5)
-	_, err = f.GetHistoricCandlesExtended(currencyPair, asset.Spot, start, end, kline.OneMin)
+	_, err = f.GetHistoricCandlesExtended(context.Background(), currencyPair, asset.Spot, start, end, kline.OneMin)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1069,7 +1070,7 @@ func TestGetOTCQuoteStatus(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := f.GetOTCQuoteStatus(btcusd, "1")
+	_, err := f.GetOTCQuoteStatus(context.Background(), btcusd, "1")
 	if err != nil {
 		t.Error(err)
 	}
@@ -1080,7 +1081,7 @@ func TestRequestForQuotes(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
-	_, err := f.RequestForQuotes("BTC", "USD", 0.5)
+	_, err := f.RequestForQuotes(context.Background(), "BTC", "USD", 0.5)
 	if err != nil {
 		t.Error(err)
 	}
@@ -1091,7 +1092,7 @@ func TestAcceptOTCQuote(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
-	err := f.AcceptOTCQuote("1031")
+	err := f.AcceptOTCQuote(context.Background(), "1031")
 	if err != nil {
 		t.Error(err)
 	}
@@ -1107,7 +1108,7 @@ func TestGetExchangeHistory(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = f.GetExchangeHistory(p, a, time.Now().Add(-time.Minute*500), time.Now())
+	_, err = f.GetExchangeHistory(context.Background(), p, a, time.Now().Add(-time.Minute*500), time.Now())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1128,7 +1129,7 @@ func TestCompatibleOrderVars(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	a, err := f.compatibleOrderVars("buy", "closed", "limit", 0.5, 0.5, 9500)
+	a, err := f.compatibleOrderVars(context.Background(), "buy", "closed", "limit", 0.5, 0.5, 9500)
 	if err != nil {
 		t.Error(err)
 	}
@@ -1144,7 +1145,7 @@ func TestCompatibleOrderVars(t *testing.T) {
 
 func TestGetIndexWeights(t *testing.T) {
 	t.Parallel()
-	_, err := f.GetIndexWeights("SHIT")
+	_, err := f.GetIndexWeights(context.Background(), "SHIT")
 	if err != nil {
 		t.Error(err)
 	}
@@ -1155,7 +1156,7 @@ func TestModifyPlacedOrder(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
-	_, err := f.ModifyPlacedOrder("1234", "", -0.1, 0.1)
+	_, err := f.ModifyPlacedOrder(context.Background(), "1234", "", -0.1, 0.1)
 	if err != nil {
 		t.Error(err)
 	}
@@ -1166,7 +1167,7 @@ func TestModifyOrderByClientID(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
-	_, err := f.ModifyOrderByClientID("1234", "", -0.1, 0.1)
+	_, err := f.ModifyOrderByClientID(context.Background(), "1234", "", -0.1, 0.1)
 	if err != nil {
 		t.Error(err)
 	}
@@ -1177,7 +1178,7 @@ func TestModifyTriggerOrder(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
-	_, err := f.ModifyTriggerOrder("1234", "stop", -0.1, 0.1, 0.02, 0)
+	_, err := f.ModifyTriggerOrder(context.Background(), "1234", "stop", -0.1, 0.1, 0.02, 0)
 	if err != nil {
 		t.Error(err)
 	}
@@ -1,6 +1,7 @@
 package ftx
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -307,6 +308,7 @@ func (f *FTX) wsHandleData(respRaw []byte) error {
 					}
 				}
 				f.Websocket.DataHandler <- stream.TradeData{
+					TID:          strconv.FormatInt(resultData.TradeData[z].ID, 10),
 					Timestamp:    resultData.TradeData[z].Time,
 					CurrencyPair: p,
 					AssetType:    a,
@@ -351,7 +353,7 @@ func (f *FTX) wsHandleData(respRaw []byte) error {
 			resp.Pair = pair
 			resp.RemainingAmount = resultData.OrderData.Size - resultData.OrderData.FilledSize
 			var orderVars OrderVars
-			orderVars, err = f.compatibleOrderVars(resultData.OrderData.Side,
+			orderVars, err = f.compatibleOrderVars(context.Background(), resultData.OrderData.Side,
 				resultData.OrderData.Status,
 				resultData.OrderData.OrderType,
 				resultData.OrderData.FilledSize,
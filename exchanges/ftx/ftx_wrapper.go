@@ -1,7 +1,9 @@
 package ftx
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -13,6 +15,7 @@ import (
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
 	"github.com/yurulab/gocryptotrader/exchanges/account"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/derivative"
 	"github.com/yurulab/gocryptotrader/exchanges/kline"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
@@ -38,7 +41,7 @@ func (f *FTX) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if f.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = f.UpdateTradablePairs(true)
+		err = f.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -139,7 +142,7 @@ func (f *FTX) SetDefaults() {
 
 	f.Requester = request.New(f.Name,
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout),
-		request.WithLimiter(request.NewBasicRateLimit(ratePeriod, rateLimit)))
+		request.WithLimiter(SetRateLimit()))
 
 	f.API.Endpoints.URLDefault = ftxAPIURL
 	f.API.Endpoints.URL = f.API.Endpoints.URLDefault
@@ -208,7 +211,7 @@ func (f *FTX) Run() {
 		return
 	}
 
-	err := f.UpdateTradablePairs(false)
+	err := f.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s failed to update tradable pairs. Err: %s",
@@ -218,11 +221,11 @@ func (f *FTX) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (f *FTX) FetchTradablePairs(a asset.Item) ([]string, error) {
+func (f *FTX) FetchTradablePairs(ctx context.Context, a asset.Item) ([]string, error) {
 	if !f.SupportsAsset(a) {
 		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, f.Name)
 	}
-	markets, err := f.GetMarkets()
+	markets, err := f.GetMarkets(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -246,10 +249,10 @@ func (f *FTX) FetchTradablePairs(a asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (f *FTX) UpdateTradablePairs(forceUpdate bool) error {
+func (f *FTX) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
 	assets := f.GetAssetTypes()
 	for x := range assets {
-		pairs, err := f.FetchTradablePairs(assets[x])
+		pairs, err := f.FetchTradablePairs(ctx, assets[x])
 		if err != nil {
 			return err
 		}
@@ -266,7 +269,7 @@ func (f *FTX) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (f *FTX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (f *FTX) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	allPairs, err := f.GetEnabledPairs(assetType)
 	if err != nil {
 		return nil, err
@@ -276,7 +279,7 @@ func (f *FTX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price
 		allPairs = append(allPairs, p)
 	}
 
-	markets, err := f.GetMarkets()
+	markets, err := f.GetMarkets(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -311,31 +314,145 @@ func (f *FTX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (f *FTX) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (f *FTX) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(f.Name, p, assetType)
 	if err != nil {
-		return f.UpdateTicker(p, assetType)
+		return f.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
+// UpdateDerivativeInfo updates and returns the funding, open interest and
+// pricing data for a derivative contract
+func (f *FTX) UpdateDerivativeInfo(ctx context.Context, p currency.Pair, assetType asset.Item) (*derivative.Info, error) {
+	if assetType != asset.Futures {
+		return nil, fmt.Errorf("%s asset type %s is not a derivative", f.Name, assetType)
+	}
+	marketName, err := f.FormatExchangeCurrency(p, assetType)
+	if err != nil {
+		return nil, err
+	}
+
+	future, err := f.GetFuture(ctx, marketName.String())
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := f.GetFutureStats(ctx, marketName.String())
+	if err != nil {
+		return nil, err
+	}
+
+	err = derivative.ProcessInfo(&derivative.Info{
+		Exchange:        f.Name,
+		Pair:            p,
+		AssetType:       assetType,
+		MarkPrice:       future.Mark,
+		IndexPrice:      future.Index,
+		FundingRate:     stats.NextFundingRate,
+		NextFundingTime: stats.NextFundingTime,
+		OpenInterest:    stats.OpenInterest,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return derivative.GetInfo(f.Name, p, assetType)
+}
+
+// GetLiquidations returns the forced liquidations of a futures contract within
+// the timeframe provided, FTX flags liquidations in its public trades
+func (f *FTX) GetLiquidations(ctx context.Context, p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]derivative.Liquidation, error) {
+	if assetType != asset.Futures {
+		return nil, fmt.Errorf("%s asset type %s is not a derivative", f.Name, assetType)
+	}
+	err := exchange.CheckTradeHistoryRange(timestampStart, timestampEnd)
+	if err != nil {
+		return nil, err
+	}
+	marketName, err := f.FormatExchangeCurrency(p, assetType)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []derivative.Liquidation
+	seen := make(map[int64]bool)
+	end := timestampEnd
+	for {
+		// Trades are returned newest first, page backwards by moving the end
+		// time to the oldest trade received
+		var trades []TradeData
+		trades, err = f.GetTrades(ctx, marketName.String(), timestampStart, end, 100)
+		if err != nil {
+			return nil, err
+		}
+
+		var added int
+		for i := range trades {
+			if seen[trades[i].ID] {
+				continue
+			}
+			seen[trades[i].ID] = true
+			added++
+			if trades[i].Time.Before(end) {
+				end = trades[i].Time
+			}
+			if !trades[i].Liquidation {
+				continue
+			}
+			side, sideErr := order.StringToOrderSide(trades[i].Side)
+			if sideErr != nil {
+				side = order.UnknownSide
+			}
+			resp = append(resp, derivative.Liquidation{
+				Exchange:  f.Name,
+				ID:        strconv.FormatInt(trades[i].ID, 10),
+				Pair:      p,
+				AssetType: assetType,
+				Side:      side,
+				Price:     trades[i].Price,
+				Amount:    trades[i].Size,
+				Timestamp: trades[i].Time,
+			})
+		}
+
+		if len(trades) < 100 || added == 0 {
+			break
+		}
+	}
+
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Timestamp.Before(resp[j].Timestamp)
+	})
+	return resp, nil
+}
+
+// FetchDerivativeInfo returns the funding, open interest and pricing data for
+// a derivative contract
+func (f *FTX) FetchDerivativeInfo(ctx context.Context, p currency.Pair, assetType asset.Item) (*derivative.Info, error) {
+	info, err := derivative.GetInfo(f.Name, p, assetType)
+	if err != nil {
+		return f.UpdateDerivativeInfo(ctx, p, assetType)
+	}
+	return info, nil
+}
+
 // FetchOrderbook returns orderbook base on the currency pair
-func (f *FTX) FetchOrderbook(currency currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (f *FTX) FetchOrderbook(ctx context.Context, currency currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(f.Name, currency, assetType)
 	if err != nil {
-		return f.UpdateOrderbook(currency, assetType)
+		return f.UpdateOrderbook(ctx, currency, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (f *FTX) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (f *FTX) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
 	formattedPair, err := f.FormatExchangeCurrency(p, assetType)
 	if err != nil {
 		return nil, err
 	}
-	tempResp, err := f.GetOrderbook(formattedPair.String(), 0)
+	tempResp, err := f.GetOrderbook(ctx, formattedPair.String(), 0)
 	if err != nil {
 		return orderBook, err
 	}
@@ -360,9 +477,9 @@ func (f *FTX) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook
 }
 
 // UpdateAccountInfo retrieves balances for all enabled currencies
-func (f *FTX) UpdateAccountInfo() (account.Holdings, error) {
+func (f *FTX) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var resp account.Holdings
-	data, err := f.GetBalances()
+	data, err := f.GetBalances(ctx)
 	if err != nil {
 		return resp, err
 	}
@@ -388,10 +505,10 @@ func (f *FTX) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (f *FTX) FetchAccountInfo() (account.Holdings, error) {
+func (f *FTX) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(f.Name)
 	if err != nil {
-		return f.UpdateAccountInfo()
+		return f.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -399,9 +516,9 @@ func (f *FTX) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (f *FTX) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (f *FTX) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var resp []exchange.FundHistory
-	depositData, err := f.FetchDepositHistory()
+	depositData, err := f.FetchDepositHistory(ctx)
 	if err != nil {
 		return resp, err
 	}
@@ -417,7 +534,7 @@ func (f *FTX) GetFundingHistory() ([]exchange.FundHistory, error) {
 		tempData.TransferID = strconv.FormatInt(depositData[x].ID, 10)
 		resp = append(resp, tempData)
 	}
-	withdrawalData, err := f.FetchWithdrawalHistory()
+	withdrawalData, err := f.FetchWithdrawalHistory(ctx)
 	if err != nil {
 		return resp, err
 	}
@@ -437,13 +554,13 @@ func (f *FTX) GetFundingHistory() ([]exchange.FundHistory, error) {
 }
 
 // GetExchangeHistory returns historic trade data within the timeframe provided.
-func (f *FTX) GetExchangeHistory(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+func (f *FTX) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
 	marketName, err := f.FormatExchangeCurrency(p, assetType)
 	if err != nil {
 		return nil, err
 	}
 	var resp []exchange.TradeHistory
-	trades, err := f.GetTrades(marketName.String(),
+	trades, err := f.GetTrades(ctx, marketName.String(),
 		time.Unix(timestampStart.Unix(), 0),
 		time.Unix(timestampEnd.Unix(), 0),
 		100)
@@ -473,7 +590,7 @@ func (f *FTX) GetExchangeHistory(p currency.Pair, assetType asset.Item, timestam
 		if len(trades) != 100 {
 			break
 		}
-		trades, err = f.GetTrades(marketName.String(),
+		trades, err = f.GetTrades(ctx, marketName.String(),
 			time.Unix(timestampStart.Unix(), 0),
 			time.Unix(trades[len(trades)-1].Time.Unix(), 0),
 			100)
@@ -485,7 +602,7 @@ func (f *FTX) GetExchangeHistory(p currency.Pair, assetType asset.Item, timestam
 }
 
 // SubmitOrder submits a new order
-func (f *FTX) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (f *FTX) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var resp order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return resp, err
@@ -503,7 +620,7 @@ func (f *FTX) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		return resp, err
 	}
 
-	tempResp, err := f.Order(formattedPair.String(),
+	tempResp, err := f.Order(ctx, formattedPair.String(),
 		s.Side.String(),
 		s.Type.String(),
 		s.ClientOrderID,
@@ -522,9 +639,9 @@ func (f *FTX) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (f *FTX) ModifyOrder(action *order.Modify) (string, error) {
+func (f *FTX) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	if action.TriggerPrice != 0 {
-		a, err := f.ModifyTriggerOrder(action.ID,
+		a, err := f.ModifyTriggerOrder(ctx, action.ID,
 			action.Type.String(),
 			action.Amount,
 			action.TriggerPrice,
@@ -539,12 +656,12 @@ func (f *FTX) ModifyOrder(action *order.Modify) (string, error) {
 	var err error
 	switch action.ID {
 	case "":
-		o, err = f.ModifyOrderByClientID(action.ClientOrderID, action.ClientOrderID, action.Price, action.Amount)
+		o, err = f.ModifyOrderByClientID(ctx, action.ClientOrderID, action.ClientOrderID, action.Price, action.Amount)
 		if err != nil {
 			return "", err
 		}
 	default:
-		o, err = f.ModifyPlacedOrder(action.ID, action.ClientOrderID, action.Price, action.Amount)
+		o, err = f.ModifyPlacedOrder(ctx, action.ID, action.ClientOrderID, action.Price, action.Amount)
 		if err != nil {
 			return "", err
 		}
@@ -553,26 +670,26 @@ func (f *FTX) ModifyOrder(action *order.Modify) (string, error) {
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (f *FTX) CancelOrder(order *order.Cancel) error {
-	_, err := f.DeleteOrder(order.ID)
+func (f *FTX) CancelOrder(ctx context.Context, order *order.Cancel) error {
+	_, err := f.DeleteOrder(ctx, order.ID)
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (f *FTX) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
+func (f *FTX) CancelAllOrders(ctx context.Context, orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
 	var resp order.CancelAllResponse
 	formattedPair, err := f.FormatExchangeCurrency(orderCancellation.Pair, orderCancellation.AssetType)
 	if err != nil {
 		return resp, err
 	}
-	orders, err := f.GetOpenOrders(formattedPair.String())
+	orders, err := f.GetOpenOrders(ctx, formattedPair.String())
 	if err != nil {
 		return resp, err
 	}
 
 	tempMap := make(map[string]string)
 	for x := range orders {
-		_, err := f.DeleteOrder(strconv.FormatInt(orders[x].ID, 10))
+		_, err := f.DeleteOrder(ctx, strconv.FormatInt(orders[x].ID, 10))
 		if err != nil {
 			tempMap[strconv.FormatInt(orders[x].ID, 10)] = "Cancellation Failed"
 			continue
@@ -584,7 +701,7 @@ func (f *FTX) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAllR
 }
 
 // GetCompatible gets compatible variables for order vars
-func (s *OrderData) GetCompatible(f *FTX) (OrderVars, error) {
+func (s *OrderData) GetCompatible(ctx context.Context, f *FTX) (OrderVars, error) {
 	var resp OrderVars
 	switch s.Side {
 	case order.Buy.Lower():
@@ -616,7 +733,7 @@ func (s *OrderData) GetCompatible(f *FTX) (OrderVars, error) {
 		resp.OrderType = order.Limit
 		feeBuilder.IsMaker = true
 	}
-	fee, err := f.GetFee(&feeBuilder)
+	fee, err := f.GetFee(ctx, &feeBuilder)
 	if err != nil {
 		return resp, err
 	}
@@ -625,9 +742,9 @@ func (s *OrderData) GetCompatible(f *FTX) (OrderVars, error) {
 }
 
 // GetOrderInfo returns information on a current open order
-func (f *FTX) GetOrderInfo(orderID string) (order.Detail, error) {
+func (f *FTX) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var resp order.Detail
-	orderData, err := f.GetOrderStatus(orderID)
+	orderData, err := f.GetOrderStatus(ctx, orderID)
 	if err != nil {
 		return resp, err
 	}
@@ -649,7 +766,7 @@ func (f *FTX) GetOrderInfo(orderID string) (order.Detail, error) {
 	resp.AssetType = assetType
 	resp.Price = orderData.Price
 	resp.RemainingAmount = orderData.RemainingSize
-	orderVars, err := orderData.GetCompatible(f)
+	orderVars, err := orderData.GetCompatible(ctx, f)
 	if err != nil {
 		return resp, err
 	}
@@ -661,8 +778,8 @@ func (f *FTX) GetOrderInfo(orderID string) (order.Detail, error) {
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (f *FTX) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	a, err := f.FetchDepositAddress(cryptocurrency.String())
+func (f *FTX) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	a, err := f.FetchDepositAddress(ctx, cryptocurrency.String())
 	if err != nil {
 		return "", err
 	}
@@ -671,14 +788,14 @@ func (f *FTX) GetDepositAddress(cryptocurrency currency.Code, _ string) (string,
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (f *FTX) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (f *FTX) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	var address, addressTag string
 	if withdrawRequest.Crypto != nil {
 		address = withdrawRequest.Crypto.Address
 		addressTag = withdrawRequest.Crypto.AddressTag
 	}
 	resp := withdraw.ExchangeResponse{}
-	a, err := f.Withdraw(withdrawRequest.Currency.String(),
+	a, err := f.Withdraw(ctx, withdrawRequest.Currency.String(),
 		address,
 		addressTag,
 		withdrawRequest.TradePassword,
@@ -694,13 +811,13 @@ func (f *FTX) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (*w
 
 // WithdrawFiatFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (f *FTX) WithdrawFiatFunds(_ *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (f *FTX) WithdrawFiatFunds(ctx context.Context, _ *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (f *FTX) WithdrawFiatFundsToInternationalBank(_ *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (f *FTX) WithdrawFiatFundsToInternationalBank(ctx context.Context, _ *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
@@ -710,7 +827,7 @@ func (f *FTX) GetWebsocket() (*stream.Websocket, error) {
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (f *FTX) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+func (f *FTX) GetActiveOrders(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
 	var resp []order.Detail
 	for x := range getOrdersRequest.Pairs {
 		assetType, err := f.GetPairAssetType(getOrdersRequest.Pairs[x])
@@ -724,7 +841,7 @@ func (f *FTX) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]order
 		}
 
 		var tempResp order.Detail
-		orderData, err := f.GetOpenOrders(formattedPair.String())
+		orderData, err := f.GetOpenOrders(ctx, formattedPair.String())
 		if err != nil {
 			return resp, err
 		}
@@ -746,7 +863,7 @@ func (f *FTX) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]order
 			tempResp.Price = orderData[y].Price
 			tempResp.RemainingAmount = orderData[y].RemainingSize
 			var orderVars OrderVars
-			orderVars, err = f.compatibleOrderVars(orderData[y].Side,
+			orderVars, err = f.compatibleOrderVars(ctx, orderData[y].Side,
 				orderData[y].Status,
 				orderData[y].OrderType,
 				orderData[y].FilledSize,
@@ -762,7 +879,7 @@ func (f *FTX) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]order
 			resp = append(resp, tempResp)
 		}
 
-		triggerOrderData, err := f.GetOpenTriggerOrders(formattedPair.String(),
+		triggerOrderData, err := f.GetOpenTriggerOrders(ctx, formattedPair.String(),
 			getOrdersRequest.Type.String())
 		if err != nil {
 			return resp, err
@@ -783,7 +900,7 @@ func (f *FTX) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]order
 			tempResp.Price = triggerOrderData[z].AvgFillPrice
 			tempResp.RemainingAmount = triggerOrderData[z].Size - triggerOrderData[z].FilledSize
 			tempResp.TriggerPrice = triggerOrderData[z].TriggerPrice
-			orderVars, err := f.compatibleOrderVars(triggerOrderData[z].Side,
+			orderVars, err := f.compatibleOrderVars(ctx, triggerOrderData[z].Side,
 				triggerOrderData[z].Status,
 				triggerOrderData[z].OrderType,
 				triggerOrderData[z].FilledSize,
@@ -804,7 +921,7 @@ func (f *FTX) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]order
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (f *FTX) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+func (f *FTX) GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
 	var resp []order.Detail
 	for x := range getOrdersRequest.Pairs {
 		var tempResp order.Detail
@@ -819,7 +936,7 @@ func (f *FTX) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order
 			return nil, err
 		}
 
-		orderData, err := f.FetchOrderHistory(formattedPair.String(),
+		orderData, err := f.FetchOrderHistory(ctx, formattedPair.String(),
 			getOrdersRequest.StartTicks, getOrdersRequest.EndTicks, "")
 		if err != nil {
 			return resp, err
@@ -841,7 +958,7 @@ func (f *FTX) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order
 			tempResp.Price = orderData[y].Price
 			tempResp.RemainingAmount = orderData[y].RemainingSize
 			var orderVars OrderVars
-			orderVars, err = f.compatibleOrderVars(orderData[y].Side,
+			orderVars, err = f.compatibleOrderVars(ctx, orderData[y].Side,
 				orderData[y].Status,
 				orderData[y].OrderType,
 				orderData[y].FilledSize,
@@ -856,7 +973,7 @@ func (f *FTX) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order
 			tempResp.Fee = orderVars.Fee
 			resp = append(resp, tempResp)
 		}
-		triggerOrderData, err := f.GetTriggerOrderHistory(formattedPair.String(),
+		triggerOrderData, err := f.GetTriggerOrderHistory(ctx, formattedPair.String(),
 			getOrdersRequest.StartTicks,
 			getOrdersRequest.EndTicks,
 			strings.ToLower(getOrdersRequest.Side.String()),
@@ -881,7 +998,7 @@ func (f *FTX) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order
 			tempResp.Price = triggerOrderData[z].AvgFillPrice
 			tempResp.RemainingAmount = triggerOrderData[z].Size - triggerOrderData[z].FilledSize
 			tempResp.TriggerPrice = triggerOrderData[z].TriggerPrice
-			orderVars, err := f.compatibleOrderVars(triggerOrderData[z].Side,
+			orderVars, err := f.compatibleOrderVars(ctx, triggerOrderData[z].Side,
 				triggerOrderData[z].Status,
 				triggerOrderData[z].OrderType,
 				triggerOrderData[z].FilledSize,
@@ -901,8 +1018,8 @@ func (f *FTX) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order
 }
 
 // GetFeeByType returns an estimate of fee based on the type of transaction
-func (f *FTX) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
-	return f.GetFee(feeBuilder)
+func (f *FTX) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
+	return f.GetFee(ctx, feeBuilder)
 }
 
 // SubscribeToWebsocketChannels appends to ChannelsToSubscribe
@@ -924,13 +1041,13 @@ func (f *FTX) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (f *FTX) ValidateCredentials() error {
-	_, err := f.UpdateAccountInfo()
+func (f *FTX) ValidateCredentials(ctx context.Context) error {
+	_, err := f.UpdateAccountInfo(ctx)
 	return f.CheckTransientError(err)
 }
 
 // GetHistoricCandles returns candles between a time period for a set time interval
-func (f *FTX) GetHistoricCandles(p currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (f *FTX) GetHistoricCandles(ctx context.Context, p currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if !f.KlineIntervalEnabled(interval) {
 		return kline.Item{}, kline.ErrorKline{
 			Interval: interval,
@@ -942,7 +1059,7 @@ func (f *FTX) GetHistoricCandles(p currency.Pair, a asset.Item, start, end time.
 		return kline.Item{}, err
 	}
 
-	ohlcData, err := f.GetHistoricalData(formattedPair.String(),
+	ohlcData, err := f.GetHistoricalData(ctx, formattedPair.String(),
 		f.FormatExchangeKlineInterval(interval),
 		strconv.FormatInt(int64(f.Features.Enabled.Kline.ResultLimit), 10),
 		start, end)
@@ -971,7 +1088,7 @@ func (f *FTX) GetHistoricCandles(p currency.Pair, a asset.Item, start, end time.
 }
 
 // GetHistoricCandlesExtended returns candles between a time period for a set time interval
-func (f *FTX) GetHistoricCandlesExtended(p currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (f *FTX) GetHistoricCandlesExtended(ctx context.Context, p currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if !f.KlineIntervalEnabled(interval) {
 		return kline.Item{}, kline.ErrorKline{
 			Interval: interval,
@@ -993,7 +1110,7 @@ func (f *FTX) GetHistoricCandlesExtended(p currency.Pair, a asset.Item, start, e
 	}
 
 	for x := range dates {
-		ohlcData, err := f.GetHistoricalData(formattedPair.String(),
+		ohlcData, err := f.GetHistoricalData(ctx, formattedPair.String(),
 			f.FormatExchangeKlineInterval(interval),
 			strconv.FormatInt(int64(f.Features.Enabled.Kline.ResultLimit), 10),
 			dates[x].Start, dates[x].End)
@@ -0,0 +1,22 @@
+package ftx
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/exchanges/request"
+)
+
+// SetRateLimit returns the rate limit for the exchange. FTX shares a single
+// budget across endpoints and does not report what is left of it, rejecting
+// requests over the limit with a 429 status, so a rejection exhausts the
+// budget for the rest of the window
+func SetRateLimit() *request.AdaptiveLimit {
+	l := request.NewAdaptiveLimit(ratePeriod, rateLimit, nil, nil)
+	l.SetRejectionParser(parseRejection)
+	return l
+}
+
+func parseRejection(status int, _ []byte) (time.Duration, bool) {
+	return 0, status == http.StatusTooManyRequests
+}
@@ -37,6 +37,7 @@ const (
 	gateioTicker          = "ticker"
 	gateioTickers         = "tickers"
 	gateioOrderbook       = "orderBook"
+	gateioTrades          = "tradeHistory"
 
 	gateioGenerateAddress = "New address is being generated for you, please wait a moment and refresh this page. "
 )
@@ -47,10 +48,10 @@ type Gateio struct {
 }
 
 // GetSymbols returns all supported symbols
-func (g *Gateio) GetSymbols() ([]string, error) {
+func (g *Gateio) GetSymbols(ctx context.Context) ([]string, error) {
 	var result []string
 	urlPath := fmt.Sprintf("%s/%s/%s", g.API.Endpoints.URLSecondary, gateioAPIVersion, gateioSymbol)
-	err := g.SendHTTPRequest(urlPath, &result)
+	err := g.SendHTTPRequest(ctx, urlPath, &result)
 	if err != nil {
 		return nil, nil
 	}
@@ -59,7 +60,7 @@ func (g *Gateio) GetSymbols() ([]string, error) {
 
 // GetMarketInfo returns information about all trading pairs, including
 // transaction fee, minimum order quantity, price accuracy and so on
-func (g *Gateio) GetMarketInfo() (MarketInfoResponse, error) {
+func (g *Gateio) GetMarketInfo(ctx context.Context) (MarketInfoResponse, error) {
 	type response struct {
 		Result string        `json:"result"`
 		Pairs  []interface{} `json:"pairs"`
@@ -68,7 +69,7 @@ func (g *Gateio) GetMarketInfo() (MarketInfoResponse, error) {
 	urlPath := fmt.Sprintf("%s/%s/%s", g.API.Endpoints.URLSecondary, gateioAPIVersion, gateioMarketInfo)
 	var res response
 	var result MarketInfoResponse
-	err := g.SendHTTPRequest(urlPath, &res)
+	err := g.SendHTTPRequest(ctx, urlPath, &res)
 	if err != nil {
 		return result, err
 	}
@@ -93,8 +94,8 @@ func (g *Gateio) GetMarketInfo() (MarketInfoResponse, error) {
 // updated every 10 seconds
 //
 // symbol: string of currency pair
-func (g *Gateio) GetLatestSpotPrice(symbol string) (float64, error) {
-	res, err := g.GetTicker(symbol)
+func (g *Gateio) GetLatestSpotPrice(ctx context.Context, symbol string) (float64, error) {
+	res, err := g.GetTicker(ctx, symbol)
 	if err != nil {
 		return 0, err
 	}
@@ -104,28 +105,47 @@ func (g *Gateio) GetLatestSpotPrice(symbol string) (float64, error) {
 
 // GetTicker returns a ticker for the supplied symbol
 // updated every 10 seconds
-func (g *Gateio) GetTicker(symbol string) (TickerResponse, error) {
+func (g *Gateio) GetTicker(ctx context.Context, symbol string) (TickerResponse, error) {
 	urlPath := fmt.Sprintf("%s/%s/%s/%s", g.API.Endpoints.URLSecondary, gateioAPIVersion, gateioTicker, symbol)
 	var res TickerResponse
-	return res, g.SendHTTPRequest(urlPath, &res)
+	return res, g.SendHTTPRequest(ctx, urlPath, &res)
 }
 
 // GetTickers returns tickers for all symbols
-func (g *Gateio) GetTickers() (map[string]TickerResponse, error) {
+func (g *Gateio) GetTickers(ctx context.Context) (map[string]TickerResponse, error) {
 	urlPath := fmt.Sprintf("%s/%s/%s", g.API.Endpoints.URLSecondary, gateioAPIVersion, gateioTickers)
 	resp := make(map[string]TickerResponse)
-	err := g.SendHTTPRequest(urlPath, &resp)
+	err := g.SendHTTPRequest(ctx, urlPath, &resp)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
+// GetTrades returns the most recent public trades for a symbol. When a trade ID
+// is supplied trades executed after that trade are returned instead
+func (g *Gateio) GetTrades(ctx context.Context, symbol string, tradeID int64) ([]PublicTrade, error) {
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", g.API.Endpoints.URLSecondary, gateioAPIVersion, gateioTrades, symbol)
+	if tradeID > 0 {
+		urlPath += "/" + strconv.FormatInt(tradeID, 10)
+	}
+	var resp PublicTradeHistoryResponse
+	err := g.SendHTTPRequest(ctx, urlPath, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Result != "true" {
+		return nil, errors.New("result was not true")
+	}
+	return resp.Data, nil
+}
+
 // GetOrderbook returns the orderbook data for a suppled symbol
-func (g *Gateio) GetOrderbook(symbol string) (Orderbook, error) {
+func (g *Gateio) GetOrderbook(ctx context.Context, symbol string) (Orderbook, error) {
 	urlPath := fmt.Sprintf("%s/%s/%s/%s", g.API.Endpoints.URLSecondary, gateioAPIVersion, gateioOrderbook, symbol)
 	var resp OrderbookResponse
-	err := g.SendHTTPRequest(urlPath, &resp)
+	err := g.SendHTTPRequest(ctx, urlPath, &resp)
 	if err != nil {
 		return Orderbook{}, err
 	}
@@ -183,7 +203,7 @@ func (g *Gateio) GetOrderbook(symbol string) (Orderbook, error) {
 }
 
 // GetSpotKline returns kline data for the most recent time period
-func (g *Gateio) GetSpotKline(arg KlinesRequestParams) (kline.Item, error) {
+func (g *Gateio) GetSpotKline(ctx context.Context, arg KlinesRequestParams) (kline.Item, error) {
 	urlPath := fmt.Sprintf("%s/%s/%s/%s?group_sec=%s&range_hour=%d",
 		g.API.Endpoints.URLSecondary,
 		gateioAPIVersion,
@@ -193,7 +213,7 @@ func (g *Gateio) GetSpotKline(arg KlinesRequestParams) (kline.Item, error) {
 		arg.HourSize)
 
 	var rawKlines map[string]interface{}
-	err := g.SendHTTPRequest(urlPath, &rawKlines)
+	err := g.SendHTTPRequest(ctx, urlPath, &rawKlines)
 	if err != nil {
 		return kline.Item{}, err
 	}
@@ -254,15 +274,15 @@ func (g *Gateio) GetSpotKline(arg KlinesRequestParams) (kline.Item, error) {
 }
 
 // GetBalances obtains the users account balance
-func (g *Gateio) GetBalances() (BalancesResponse, error) {
+func (g *Gateio) GetBalances(ctx context.Context) (BalancesResponse, error) {
 	var result BalancesResponse
 
 	return result,
-		g.SendAuthenticatedHTTPRequest(http.MethodPost, gateioBalances, "", &result)
+		g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, gateioBalances, "", &result)
 }
 
 // SpotNewOrder places a new order
-func (g *Gateio) SpotNewOrder(arg SpotNewOrderRequestParams) (SpotNewOrderResponse, error) {
+func (g *Gateio) SpotNewOrder(ctx context.Context, arg SpotNewOrderRequestParams) (SpotNewOrderResponse, error) {
 	var result SpotNewOrderResponse
 
 	// Be sure to use the correct price precision before calling this
@@ -273,13 +293,13 @@ func (g *Gateio) SpotNewOrder(arg SpotNewOrderRequestParams) (SpotNewOrderRespon
 	)
 
 	urlPath := fmt.Sprintf("%s/%s", gateioOrder, arg.Type)
-	return result, g.SendAuthenticatedHTTPRequest(http.MethodPost, urlPath, params, &result)
+	return result, g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, urlPath, params, &result)
 }
 
 // CancelExistingOrder cancels an order given the supplied orderID and symbol
 // orderID order ID number
 // symbol trade pair (ltc_btc)
-func (g *Gateio) CancelExistingOrder(orderID int64, symbol string) (bool, error) {
+func (g *Gateio) CancelExistingOrder(ctx context.Context, orderID int64, symbol string) (bool, error) {
 	type response struct {
 		Result  bool   `json:"result"`
 		Code    int    `json:"code"`
@@ -292,7 +312,7 @@ func (g *Gateio) CancelExistingOrder(orderID int64, symbol string) (bool, error)
 		orderID,
 		symbol,
 	)
-	err := g.SendAuthenticatedHTTPRequest(http.MethodPost, gateioCancelOrder, params, &result)
+	err := g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, gateioCancelOrder, params, &result)
 	if err != nil {
 		return false, err
 	}
@@ -304,8 +324,8 @@ func (g *Gateio) CancelExistingOrder(orderID int64, symbol string) (bool, error)
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (g *Gateio) SendHTTPRequest(path string, result interface{}) error {
-	return g.SendPayload(context.Background(), &request.Item{
+func (g *Gateio) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return g.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -317,7 +337,7 @@ func (g *Gateio) SendHTTPRequest(path string, result interface{}) error {
 
 // CancelAllExistingOrders all orders for a given symbol and side
 // orderType (0: sell,1: buy,-1: unlimited)
-func (g *Gateio) CancelAllExistingOrders(orderType int64, symbol string) error {
+func (g *Gateio) CancelAllExistingOrders(ctx context.Context, orderType int64, symbol string) error {
 	type response struct {
 		Result  bool   `json:"result"`
 		Code    int    `json:"code"`
@@ -329,7 +349,7 @@ func (g *Gateio) CancelAllExistingOrders(orderType int64, symbol string) error {
 		orderType,
 		symbol,
 	)
-	err := g.SendAuthenticatedHTTPRequest(http.MethodPost, gateioCancelAllOrders, params, &result)
+	err := g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, gateioCancelAllOrders, params, &result)
 	if err != nil {
 		return err
 	}
@@ -342,7 +362,7 @@ func (g *Gateio) CancelAllExistingOrders(orderType int64, symbol string) error {
 }
 
 // GetOpenOrders retrieves all open orders with an optional symbol filter
-func (g *Gateio) GetOpenOrders(symbol string) (OpenOrdersResponse, error) {
+func (g *Gateio) GetOpenOrders(ctx context.Context, symbol string) (OpenOrdersResponse, error) {
 	var params string
 	var result OpenOrdersResponse
 
@@ -350,7 +370,7 @@ func (g *Gateio) GetOpenOrders(symbol string) (OpenOrdersResponse, error) {
 		params = fmt.Sprintf("currencyPair=%s", symbol)
 	}
 
-	err := g.SendAuthenticatedHTTPRequest(http.MethodPost, gateioOpenOrders, params, &result)
+	err := g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, gateioOpenOrders, params, &result)
 	if err != nil {
 		return result, err
 	}
@@ -363,12 +383,12 @@ func (g *Gateio) GetOpenOrders(symbol string) (OpenOrdersResponse, error) {
 }
 
 // GetTradeHistory retrieves all orders with an optional symbol filter
-func (g *Gateio) GetTradeHistory(symbol string) (TradHistoryResponse, error) {
+func (g *Gateio) GetTradeHistory(ctx context.Context, symbol string) (TradHistoryResponse, error) {
 	var params string
 	var result TradHistoryResponse
 	params = fmt.Sprintf("currencyPair=%s", symbol)
 
-	err := g.SendAuthenticatedHTTPRequest(http.MethodPost, gateioTradeHistory, params, &result)
+	err := g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, gateioTradeHistory, params, &result)
 	if err != nil {
 		return result, err
 	}
@@ -388,7 +408,7 @@ func (g *Gateio) GenerateSignature(message string) []byte {
 
 // SendAuthenticatedHTTPRequest sends authenticated requests to the Gateio API
 // To use this you must setup an APIKey and APISecret from the exchange
-func (g *Gateio) SendAuthenticatedHTTPRequest(method, endpoint, param string, result interface{}) error {
+func (g *Gateio) SendAuthenticatedHTTPRequest(ctx context.Context, method, endpoint, param string, result interface{}) error {
 	if !g.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet,
 			g.Name)
@@ -404,7 +424,7 @@ func (g *Gateio) SendAuthenticatedHTTPRequest(method, endpoint, param string, re
 	urlPath := fmt.Sprintf("%s/%s/%s", g.API.Endpoints.URL, gateioAPIVersion, endpoint)
 
 	var intermidiary json.RawMessage
-	err := g.SendPayload(context.Background(), &request.Item{
+	err := g.SendPayload(ctx, &request.Item{
 		Method:        method,
 		Path:          urlPath,
 		Headers:       headers,
@@ -438,10 +458,10 @@ func (g *Gateio) SendAuthenticatedHTTPRequest(method, endpoint, param string, re
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (g *Gateio) GetFee(feeBuilder *exchange.FeeBuilder) (fee float64, err error) {
+func (g *Gateio) GetFee(ctx context.Context, feeBuilder *exchange.FeeBuilder) (fee float64, err error) {
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		feePairs, err := g.GetMarketInfo()
+		feePairs, err := g.GetMarketInfo(ctx)
 		if err != nil {
 			return 0, err
 		}
@@ -493,7 +513,7 @@ func getCryptocurrencyWithdrawalFee(c currency.Code) float64 {
 }
 
 // WithdrawCrypto withdraws cryptocurrency to your selected wallet
-func (g *Gateio) WithdrawCrypto(currency, address string, amount float64) (*withdraw.ExchangeResponse, error) {
+func (g *Gateio) WithdrawCrypto(ctx context.Context, currency, address string, amount float64) (*withdraw.ExchangeResponse, error) {
 	type response struct {
 		Result  bool   `json:"result"`
 		Message string `json:"message"`
@@ -506,7 +526,7 @@ func (g *Gateio) WithdrawCrypto(currency, address string, amount float64) (*with
 		address,
 		amount,
 	)
-	err := g.SendAuthenticatedHTTPRequest(http.MethodPost, gateioWithdraw, params, &result)
+	err := g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, gateioWithdraw, params, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -520,7 +540,7 @@ func (g *Gateio) WithdrawCrypto(currency, address string, amount float64) (*with
 }
 
 // GetCryptoDepositAddress returns a deposit address for a cryptocurrency
-func (g *Gateio) GetCryptoDepositAddress(currency string) (string, error) {
+func (g *Gateio) GetCryptoDepositAddress(ctx context.Context, currency string) (string, error) {
 	type response struct {
 		Result  bool   `json:"result,string"`
 		Code    int    `json:"code"`
@@ -532,7 +552,7 @@ func (g *Gateio) GetCryptoDepositAddress(currency string) (string, error) {
 	params := fmt.Sprintf("currency=%s",
 		currency)
 
-	err := g.SendAuthenticatedHTTPRequest(http.MethodPost, gateioDepositAddress, params, &result)
+	err := g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, gateioDepositAddress, params, &result)
 	if err != nil {
 		return "", err
 	}
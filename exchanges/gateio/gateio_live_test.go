@@ -0,0 +1,41 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package gateio
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	g.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("GateIO load config error", err)
+	}
+	gConf, err := cfg.GetExchangeConfig("GateIO")
+	if err != nil {
+		log.Fatal("GateIO Setup() init error")
+	}
+	gConf.API.AuthenticatedSupport = true
+	gConf.API.AuthenticatedWebsocketSupport = true
+	gConf.API.Credentials.Key = apiKey
+	gConf.API.Credentials.Secret = apiSecret
+	g.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = g.Setup(gConf)
+	if err != nil {
+		log.Fatal("GateIO setup error", err)
+	}
+
+	log.Printf(sharedtestvalues.LiveTesting, g.Name, g.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
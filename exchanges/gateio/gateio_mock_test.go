@@ -0,0 +1,56 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package gateio
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/gateio/gateio.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	g.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("GateIO load config error", err)
+	}
+	gConf, err := cfg.GetExchangeConfig("GateIO")
+	if err != nil {
+		log.Fatal("GateIO Setup() init error")
+	}
+	gConf.API.AuthenticatedSupport = true
+	gConf.API.AuthenticatedWebsocketSupport = true
+	gConf.API.Credentials.Key = apiKey
+	gConf.API.Credentials.Secret = apiSecret
+	g.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = g.Setup(gConf)
+	if err != nil {
+		log.Fatal("GateIO setup error", err)
+	}
+
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+
+	g.HTTPClient = newClient
+	g.API.Endpoints.URL = serverDetails
+	g.API.Endpoints.URLSecondary = serverDetails
+	log.Printf(sharedtestvalues.MockTesting, g.Name, g.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
@@ -90,6 +90,24 @@ type OrderbookResponse struct {
 	Bids    [][]string
 }
 
+// PublicTradeHistoryResponse stores the public trade history response data
+type PublicTradeHistoryResponse struct {
+	Result  string        `json:"result"`
+	Elapsed string        `json:"elapsed"`
+	Data    []PublicTrade `json:"data"`
+}
+
+// PublicTrade stores a single public trade
+type PublicTrade struct {
+	TradeID   int64   `json:"tradeID,string"`
+	Date      string  `json:"date"`
+	Timestamp int64   `json:"timestamp,string"`
+	Type      string  `json:"type"`
+	Rate      float64 `json:"rate"`
+	Amount    float64 `json:"amount"`
+	Total     float64 `json:"total"`
+}
+
 // OrderbookItem stores an orderbook item
 type OrderbookItem struct {
 	Price  float64
@@ -197,6 +197,7 @@ func (g *Gateio) wsHandleData(respRaw []byte) error {
 				}
 			}
 			g.Websocket.DataHandler <- stream.TradeData{
+				TID:          strconv.FormatInt(trades[i].ID, 10),
 				Timestamp:    time.Now(),
 				CurrencyPair: p,
 				AssetType:    asset.Spot,
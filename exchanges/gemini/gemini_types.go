@@ -235,6 +235,7 @@ type WsMarketUpdateResponse struct {
 // Event defines orderbook and trade data
 type Event struct {
 	Type      string  `json:"type"`
+	TID       int64   `json:"tid"`
 	Reason    string  `json:"reason"`
 	Price     float64 `json:"price,string"`
 	Delta     float64 `json:"delta,string"`
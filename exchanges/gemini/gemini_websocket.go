@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -411,7 +412,12 @@ func (g *Gemini) wsProcessUpdate(result WsMarketUpdateResponse, pair currency.Pa
 						Err:      err,
 					}
 				}
+				var tid string
+				if result.Events[i].TID != 0 {
+					tid = strconv.FormatInt(result.Events[i].TID, 10)
+				}
 				g.Websocket.DataHandler <- stream.TradeData{
+					TID:          tid,
 					Timestamp:    time.Unix(0, result.TimestampMS*int64(time.Millisecond)),
 					CurrencyPair: pair,
 					AssetType:    asset.Spot,
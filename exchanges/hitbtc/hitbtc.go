@@ -28,6 +28,8 @@ const (
 	apiV2Orderbook = "api/2/public/orderbook"
 	apiV2Candles   = "api/2/public/candles"
 
+	tradesLimit = 1000
+
 	// Authenticated
 	apiV2Balance        = "api/2/trading/balance"
 	apiV2CryptoAddress  = "api/2/account/crypto/address"
@@ -53,7 +55,7 @@ type HitBTC struct {
 
 // GetCurrencies returns the actual list of available currencies, tokens, ICO
 // etc.
-func (h *HitBTC) GetCurrencies() (map[string]Currencies, error) {
+func (h *HitBTC) GetCurrencies(ctx context.Context) (map[string]Currencies, error) {
 	type Response struct {
 		Data []Currencies
 	}
@@ -61,7 +63,7 @@ func (h *HitBTC) GetCurrencies() (map[string]Currencies, error) {
 	path := fmt.Sprintf("%s/%s", h.API.Endpoints.URL, apiV2Currency)
 
 	ret := make(map[string]Currencies)
-	err := h.SendHTTPRequest(path, &resp.Data)
+	err := h.SendHTTPRequest(ctx, path, &resp.Data)
 	if err != nil {
 		return ret, err
 	}
@@ -74,14 +76,14 @@ func (h *HitBTC) GetCurrencies() (map[string]Currencies, error) {
 
 // GetCurrency returns the actual list of available currencies, tokens, ICO
 // etc.
-func (h *HitBTC) GetCurrency(currency string) (Currencies, error) {
+func (h *HitBTC) GetCurrency(ctx context.Context, currency string) (Currencies, error) {
 	type Response struct {
 		Data Currencies
 	}
 	resp := Response{}
 	path := fmt.Sprintf("%s/%s/%s", h.API.Endpoints.URL, apiV2Currency, currency)
 
-	return resp.Data, h.SendHTTPRequest(path, &resp.Data)
+	return resp.Data, h.SendHTTPRequest(ctx, path, &resp.Data)
 }
 
 // GetSymbols Return the actual list of currency symbols (currency pairs) traded
@@ -89,12 +91,12 @@ func (h *HitBTC) GetCurrency(currency string) (Currencies, error) {
 // currency, and the second currency is called the quote currency. The currency
 // pair indicates how much of the quote currency is needed to purchase one unit
 // of the base currency.
-func (h *HitBTC) GetSymbols(symbol string) ([]string, error) {
+func (h *HitBTC) GetSymbols(ctx context.Context, symbol string) ([]string, error) {
 	var resp []Symbol
 	path := fmt.Sprintf("%s/%s/%s", h.API.Endpoints.URL, apiV2Symbol, symbol)
 
 	ret := make([]string, 0, len(resp))
-	err := h.SendHTTPRequest(path, &resp)
+	err := h.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return ret, err
 	}
@@ -107,28 +109,28 @@ func (h *HitBTC) GetSymbols(symbol string) ([]string, error) {
 
 // GetSymbolsDetailed is the same as above but returns an array of symbols with
 // all their details.
-func (h *HitBTC) GetSymbolsDetailed() ([]Symbol, error) {
+func (h *HitBTC) GetSymbolsDetailed(ctx context.Context) ([]Symbol, error) {
 	var resp []Symbol
 	path := fmt.Sprintf("%s/%s", h.API.Endpoints.URL, apiV2Symbol)
-	return resp, h.SendHTTPRequest(path, &resp)
+	return resp, h.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetTicker returns ticker information
-func (h *HitBTC) GetTicker(symbol string) (TickerResponse, error) {
+func (h *HitBTC) GetTicker(ctx context.Context, symbol string) (TickerResponse, error) {
 	var resp TickerResponse
 	path := fmt.Sprintf("%s/%s/%s", h.API.Endpoints.URL, apiV2Ticker, symbol)
-	return resp, h.SendHTTPRequest(path, &resp)
+	return resp, h.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetTickers returns ticker information
-func (h *HitBTC) GetTickers() ([]TickerResponse, error) {
+func (h *HitBTC) GetTickers(ctx context.Context) ([]TickerResponse, error) {
 	var resp []TickerResponse
 	path := fmt.Sprintf("%s/%s/", h.API.Endpoints.URL, apiV2Ticker)
-	return resp, h.SendHTTPRequest(path, &resp)
+	return resp, h.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetTrades returns trades from hitbtc
-func (h *HitBTC) GetTrades(currencyPair, from, till, limit, offset, by, sort string) ([]TradeHistory, error) {
+func (h *HitBTC) GetTrades(ctx context.Context, currencyPair, from, till, limit, offset, by, sort string) ([]TradeHistory, error) {
 	// start   Number or Datetime
 	// end     Number or Datetime
 	// limit   Number
@@ -167,12 +169,12 @@ func (h *HitBTC) GetTrades(currencyPair, from, till, limit, offset, by, sort str
 		apiV2Trades,
 		currencyPair,
 		vals.Encode())
-	return resp, h.SendHTTPRequest(path, &resp)
+	return resp, h.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetOrderbook an order book is an electronic list of buy and sell orders for a
 // specific symbol, organized by price level.
-func (h *HitBTC) GetOrderbook(currencyPair string, limit int) (Orderbook, error) {
+func (h *HitBTC) GetOrderbook(ctx context.Context, currencyPair string, limit int) (Orderbook, error) {
 	// limit Limit of orderbook levels, default 100. Set 0 to view full orderbook levels
 	vals := url.Values{}
 
@@ -187,7 +189,7 @@ func (h *HitBTC) GetOrderbook(currencyPair string, limit int) (Orderbook, error)
 		currencyPair,
 		vals.Encode())
 
-	err := h.SendHTTPRequest(path, &resp)
+	err := h.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return Orderbook{}, err
 	}
@@ -200,7 +202,7 @@ func (h *HitBTC) GetOrderbook(currencyPair string, limit int) (Orderbook, error)
 
 // GetCandles returns candles which is used for OHLC a specific currency.
 // Note: Result contain candles only with non zero volume.
-func (h *HitBTC) GetCandles(currencyPair, limit, period string, start, end time.Time) ([]ChartData, error) {
+func (h *HitBTC) GetCandles(ctx context.Context, currencyPair, limit, period string, start, end time.Time) ([]ChartData, error) {
 	// limit   Limit of candles, default 100.
 	// period  One of: M1 (one minute), M3, M5, M15, M30, H1, H4, D1, D7, 1M (one month). Default is M30 (30 minutes).
 	vals := url.Values{}
@@ -226,16 +228,16 @@ func (h *HitBTC) GetCandles(currencyPair, limit, period string, start, end time.
 
 	var resp []ChartData
 	path := fmt.Sprintf("%s/%s/%s?%s", h.API.Endpoints.URL, apiV2Candles, currencyPair, vals.Encode())
-	return resp, h.SendHTTPRequest(path, &resp)
+	return resp, h.SendHTTPRequest(ctx, path, &resp)
 }
 
 // Authenticated Market Data
 // https://api.hitbtc.com/?python#market-data
 
 // GetBalances returns full balance for your account
-func (h *HitBTC) GetBalances() (map[string]Balance, error) {
+func (h *HitBTC) GetBalances(ctx context.Context) (map[string]Balance, error) {
 	var result []Balance
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet,
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		apiV2Balance,
 		url.Values{},
 		otherRequests,
@@ -254,11 +256,11 @@ func (h *HitBTC) GetBalances() (map[string]Balance, error) {
 }
 
 // GetDepositAddresses returns a deposit address for a specific currency
-func (h *HitBTC) GetDepositAddresses(currency string) (DepositCryptoAddresses, error) {
+func (h *HitBTC) GetDepositAddresses(ctx context.Context, currency string) (DepositCryptoAddresses, error) {
 	var resp DepositCryptoAddresses
 
 	return resp,
-		h.SendAuthenticatedHTTPRequest(http.MethodGet,
+		h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 			apiV2CryptoAddress+"/"+currency,
 			url.Values{},
 			otherRequests,
@@ -266,9 +268,9 @@ func (h *HitBTC) GetDepositAddresses(currency string) (DepositCryptoAddresses, e
 }
 
 // GenerateNewAddress generates a new deposit address for a currency
-func (h *HitBTC) GenerateNewAddress(currency string) (DepositCryptoAddresses, error) {
+func (h *HitBTC) GenerateNewAddress(ctx context.Context, currency string) (DepositCryptoAddresses, error) {
 	resp := DepositCryptoAddresses{}
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost,
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		apiV2CryptoAddress+"/"+currency,
 		url.Values{},
 		otherRequests,
@@ -278,9 +280,9 @@ func (h *HitBTC) GenerateNewAddress(currency string) (DepositCryptoAddresses, er
 }
 
 // GetActiveorders returns all your active orders
-func (h *HitBTC) GetActiveorders(currency string) ([]Order, error) {
+func (h *HitBTC) GetActiveorders(ctx context.Context, currency string) ([]Order, error) {
 	var resp []Order
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet,
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		orders+"?symbol="+currency,
 		url.Values{},
 		tradingRequests,
@@ -290,7 +292,7 @@ func (h *HitBTC) GetActiveorders(currency string) ([]Order, error) {
 }
 
 // GetTradeHistoryForCurrency returns your trade history
-func (h *HitBTC) GetTradeHistoryForCurrency(currency, start, end string) (AuthenticatedTradeHistoryResponse, error) {
+func (h *HitBTC) GetTradeHistoryForCurrency(ctx context.Context, currency, start, end string) (AuthenticatedTradeHistoryResponse, error) {
 	values := url.Values{}
 
 	if start != "" {
@@ -304,7 +306,7 @@ func (h *HitBTC) GetTradeHistoryForCurrency(currency, start, end string) (Authen
 	values.Set("currencyPair", currency)
 	result := AuthenticatedTradeHistoryResponse{}
 
-	return result, h.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return result, h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		apiV2TradeHistory,
 		values,
 		otherRequests,
@@ -312,7 +314,7 @@ func (h *HitBTC) GetTradeHistoryForCurrency(currency, start, end string) (Authen
 }
 
 // GetTradeHistoryForAllCurrencies returns your trade history
-func (h *HitBTC) GetTradeHistoryForAllCurrencies(start, end string) (AuthenticatedTradeHistoryAll, error) {
+func (h *HitBTC) GetTradeHistoryForAllCurrencies(ctx context.Context, start, end string) (AuthenticatedTradeHistoryAll, error) {
 	values := url.Values{}
 
 	if start != "" {
@@ -326,7 +328,7 @@ func (h *HitBTC) GetTradeHistoryForAllCurrencies(start, end string) (Authenticat
 	values.Set("currencyPair", "all")
 	result := AuthenticatedTradeHistoryAll{}
 
-	return result, h.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return result, h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		apiV2TradeHistory,
 		values,
 		otherRequests,
@@ -334,12 +336,12 @@ func (h *HitBTC) GetTradeHistoryForAllCurrencies(start, end string) (Authenticat
 }
 
 // GetOrders List of your order history.
-func (h *HitBTC) GetOrders(currency string) ([]OrderHistoryResponse, error) {
+func (h *HitBTC) GetOrders(ctx context.Context, currency string) ([]OrderHistoryResponse, error) {
 	values := url.Values{}
 	values.Set("symbol", currency)
 	var result []OrderHistoryResponse
 
-	return result, h.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return result, h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		apiV2OrderHistory,
 		values,
 		tradingRequests,
@@ -347,12 +349,12 @@ func (h *HitBTC) GetOrders(currency string) ([]OrderHistoryResponse, error) {
 }
 
 // GetOpenOrders List of your currently open orders.
-func (h *HitBTC) GetOpenOrders(currency string) ([]OrderHistoryResponse, error) {
+func (h *HitBTC) GetOpenOrders(ctx context.Context, currency string) ([]OrderHistoryResponse, error) {
 	values := url.Values{}
 	values.Set("symbol", currency)
 	var result []OrderHistoryResponse
 
-	return result, h.SendAuthenticatedHTTPRequest(http.MethodGet,
+	return result, h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		apiv2OpenOrders,
 		values,
 		tradingRequests,
@@ -360,7 +362,7 @@ func (h *HitBTC) GetOpenOrders(currency string) ([]OrderHistoryResponse, error)
 }
 
 // PlaceOrder places an order on the exchange
-func (h *HitBTC) PlaceOrder(currency string, rate, amount float64, orderType, side string) (OrderResponse, error) {
+func (h *HitBTC) PlaceOrder(ctx context.Context, currency string, rate, amount float64, orderType, side string) (OrderResponse, error) {
 	var result OrderResponse
 	values := url.Values{}
 
@@ -371,7 +373,7 @@ func (h *HitBTC) PlaceOrder(currency string, rate, amount float64, orderType, si
 	values.Set("price", strconv.FormatFloat(rate, 'f', -1, 64))
 	values.Set("type", orderType)
 
-	return result, h.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return result, h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		apiOrder,
 		values,
 		tradingRequests,
@@ -379,11 +381,11 @@ func (h *HitBTC) PlaceOrder(currency string, rate, amount float64, orderType, si
 }
 
 // CancelExistingOrder cancels a specific order by OrderID
-func (h *HitBTC) CancelExistingOrder(orderID int64) (bool, error) {
+func (h *HitBTC) CancelExistingOrder(ctx context.Context, orderID int64) (bool, error) {
 	result := GenericResponse{}
 	values := url.Values{}
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodDelete,
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodDelete,
 		apiOrder+"/"+strconv.FormatInt(orderID, 10),
 		values,
 		tradingRequests,
@@ -401,10 +403,10 @@ func (h *HitBTC) CancelExistingOrder(orderID int64) (bool, error) {
 }
 
 // CancelAllExistingOrders cancels all open orders
-func (h *HitBTC) CancelAllExistingOrders() ([]Order, error) {
+func (h *HitBTC) CancelAllExistingOrders(ctx context.Context) ([]Order, error) {
 	var result []Order
 	values := url.Values{}
-	return result, h.SendAuthenticatedHTTPRequest(http.MethodDelete,
+	return result, h.SendAuthenticatedHTTPRequest(ctx, http.MethodDelete,
 		apiOrder,
 		values,
 		tradingRequests,
@@ -412,7 +414,7 @@ func (h *HitBTC) CancelAllExistingOrders() ([]Order, error) {
 }
 
 // MoveOrder generates a new move order
-func (h *HitBTC) MoveOrder(orderID int64, rate, amount float64) (MoveOrderResponse, error) {
+func (h *HitBTC) MoveOrder(ctx context.Context, orderID int64, rate, amount float64) (MoveOrderResponse, error) {
 	result := MoveOrderResponse{}
 	values := url.Values{}
 	values.Set("orderNumber", strconv.FormatInt(orderID, 10))
@@ -422,7 +424,7 @@ func (h *HitBTC) MoveOrder(orderID int64, rate, amount float64) (MoveOrderRespon
 		values.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	}
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost,
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		orderMove,
 		values,
 		tradingRequests,
@@ -440,7 +442,7 @@ func (h *HitBTC) MoveOrder(orderID int64, rate, amount float64) (MoveOrderRespon
 }
 
 // Withdraw allows for the withdrawal to a specific address
-func (h *HitBTC) Withdraw(currency, address string, amount float64) (bool, error) {
+func (h *HitBTC) Withdraw(ctx context.Context, currency, address string, amount float64) (bool, error) {
 	result := Withdraw{}
 	values := url.Values{}
 
@@ -448,7 +450,7 @@ func (h *HitBTC) Withdraw(currency, address string, amount float64) (bool, error
 	values.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	values.Set("address", address)
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost,
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		apiV2CryptoWithdraw,
 		values,
 		otherRequests,
@@ -466,9 +468,9 @@ func (h *HitBTC) Withdraw(currency, address string, amount float64) (bool, error
 }
 
 // GetFeeInfo returns current fee information
-func (h *HitBTC) GetFeeInfo(currencyPair string) (Fee, error) {
+func (h *HitBTC) GetFeeInfo(ctx context.Context, currencyPair string) (Fee, error) {
 	result := Fee{}
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet,
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		apiV2FeeInfo+"/"+currencyPair,
 		url.Values{},
 		tradingRequests,
@@ -478,13 +480,13 @@ func (h *HitBTC) GetFeeInfo(currencyPair string) (Fee, error) {
 }
 
 // GetTradableBalances returns current tradable balances
-func (h *HitBTC) GetTradableBalances() (map[string]map[string]float64, error) {
+func (h *HitBTC) GetTradableBalances(ctx context.Context) (map[string]map[string]float64, error) {
 	type Response struct {
 		Data map[string]map[string]interface{}
 	}
 	result := Response{}
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost,
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		tradableBalances,
 		url.Values{},
 		tradingRequests,
@@ -507,7 +509,7 @@ func (h *HitBTC) GetTradableBalances() (map[string]map[string]float64, error) {
 }
 
 // TransferBalance transfers a balance
-func (h *HitBTC) TransferBalance(currency, from, to string, amount float64) (bool, error) {
+func (h *HitBTC) TransferBalance(ctx context.Context, currency, from, to string, amount float64) (bool, error) {
 	values := url.Values{}
 	result := GenericResponse{}
 
@@ -516,7 +518,7 @@ func (h *HitBTC) TransferBalance(currency, from, to string, amount float64) (boo
 	values.Set("fromAccount", from)
 	values.Set("toAccount", to)
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost,
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		transferBalance,
 		values,
 		otherRequests,
@@ -534,8 +536,8 @@ func (h *HitBTC) TransferBalance(currency, from, to string, amount float64) (boo
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (h *HitBTC) SendHTTPRequest(path string, result interface{}) error {
-	return h.SendPayload(context.Background(), &request.Item{
+func (h *HitBTC) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return h.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -547,7 +549,7 @@ func (h *HitBTC) SendHTTPRequest(path string, result interface{}) error {
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated http request
-func (h *HitBTC) SendAuthenticatedHTTPRequest(method, endpoint string, values url.Values, f request.EndpointLimit, result interface{}) error {
+func (h *HitBTC) SendAuthenticatedHTTPRequest(ctx context.Context, method, endpoint string, values url.Values, f request.EndpointLimit, result interface{}) error {
 	if !h.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet,
 			h.Name)
@@ -557,7 +559,7 @@ func (h *HitBTC) SendAuthenticatedHTTPRequest(method, endpoint string, values ur
 
 	path := fmt.Sprintf("%s/%s", h.API.Endpoints.URL, endpoint)
 
-	return h.SendPayload(context.Background(), &request.Item{
+	return h.SendPayload(ctx, &request.Item{
 		Method:        method,
 		Path:          path,
 		Headers:       headers,
@@ -572,12 +574,12 @@ func (h *HitBTC) SendAuthenticatedHTTPRequest(method, endpoint string, values ur
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (h *HitBTC) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (h *HitBTC) GetFee(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		feeInfo, err := h.GetFeeInfo(feeBuilder.Pair.Base.String() +
-			feeBuilder.Pair.Delimiter +
+		feeInfo, err := h.GetFeeInfo(ctx, feeBuilder.Pair.Base.String()+
+			feeBuilder.Pair.Delimiter+
 			feeBuilder.Pair.Quote.String())
 
 		if err != nil {
@@ -587,7 +589,7 @@ func (h *HitBTC) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
 			feeBuilder.Amount,
 			feeBuilder.IsMaker)
 	case exchange.CryptocurrencyWithdrawalFee:
-		currencyInfo, err := h.GetCurrency(feeBuilder.Pair.Base.String())
+		currencyInfo, err := h.GetCurrency(ctx, feeBuilder.Pair.Base.String())
 		if err != nil {
 			return 0, err
 		}
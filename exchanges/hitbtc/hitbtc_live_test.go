@@ -0,0 +1,40 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package hitbtc
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	h.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("HitBTC load config error", err)
+	}
+	hitbtcConfig, err := cfg.GetExchangeConfig("HitBTC")
+	if err != nil {
+		log.Fatal("HitBTC Setup() init error")
+	}
+	hitbtcConfig.API.AuthenticatedSupport = true
+	hitbtcConfig.API.AuthenticatedWebsocketSupport = true
+	hitbtcConfig.API.Credentials.Key = apiKey
+	hitbtcConfig.API.Credentials.Secret = apiSecret
+	h.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = h.Setup(hitbtcConfig)
+	if err != nil {
+		log.Fatal("HitBTC setup error", err)
+	}
+	log.Printf(sharedtestvalues.LiveTesting, h.Name, h.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
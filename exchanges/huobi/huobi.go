@@ -20,6 +20,7 @@ import (
 
 const (
 	huobiAPIURL      = "https://api.huobi.pro"
+	huobiFuturesURL  = "https://api.hbdm.com"
 	huobiAPIVersion  = "1"
 	huobiAPIVersion2 = "2"
 
@@ -56,6 +57,13 @@ const (
 	huobiWithdrawCreate        = "dw/withdraw/api/create"
 	huobiWithdrawCancel        = "dw/withdraw-virtual/%s/cancel"
 	huobiStatusError           = "error"
+
+	// Coin margined swap public endpoints
+	huobiSwapFundingRate       = "swap-api/v1/swap_funding_rate"
+	huobiSwapOpenInterest      = "swap-api/v1/swap_open_interest"
+	huobiSwapIndex             = "swap-api/v1/swap_index"
+	huobiSwapLiquidationOrders = "swap-api/v1/swap_liquidation_orders"
+	huobiSwapLiquidationLimit  = 50
 )
 
 // HUOBI is the overarching type across this package
@@ -66,7 +74,7 @@ type HUOBI struct {
 
 // GetSpotKline returns kline data
 // KlinesRequestParams contains symbol, period and size
-func (h *HUOBI) GetSpotKline(arg KlinesRequestParams) ([]KlineItem, error) {
+func (h *HUOBI) GetSpotKline(ctx context.Context, arg KlinesRequestParams) ([]KlineItem, error) {
 	vals := url.Values{}
 	vals.Set("symbol", arg.Symbol)
 	vals.Set("period", arg.Period)
@@ -83,7 +91,7 @@ func (h *HUOBI) GetSpotKline(arg KlinesRequestParams) ([]KlineItem, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URL, huobiMarketHistoryKline)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(urlPath, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(urlPath, vals), &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -91,14 +99,14 @@ func (h *HUOBI) GetSpotKline(arg KlinesRequestParams) ([]KlineItem, error) {
 }
 
 // GetTickers returns the ticker for the specified symbol
-func (h *HUOBI) GetTickers() (Tickers, error) {
+func (h *HUOBI) GetTickers(ctx context.Context) (Tickers, error) {
 	var result Tickers
 	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URL, huobiMarketTickers)
-	return result, h.SendHTTPRequest(urlPath, &result)
+	return result, h.SendHTTPRequest(ctx, urlPath, &result)
 }
 
 // GetMarketDetailMerged returns the ticker for the specified symbol
-func (h *HUOBI) GetMarketDetailMerged(symbol string) (DetailMerged, error) {
+func (h *HUOBI) GetMarketDetailMerged(ctx context.Context, symbol string) (DetailMerged, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 
@@ -110,7 +118,7 @@ func (h *HUOBI) GetMarketDetailMerged(symbol string) (DetailMerged, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URL, huobiMarketDetailMerged)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(urlPath, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(urlPath, vals), &result)
 	if result.ErrorMessage != "" {
 		return result.Tick, errors.New(result.ErrorMessage)
 	}
@@ -118,7 +126,7 @@ func (h *HUOBI) GetMarketDetailMerged(symbol string) (DetailMerged, error) {
 }
 
 // GetDepth returns the depth for the specified symbol
-func (h *HUOBI) GetDepth(obd OrderBookDataRequestParams) (Orderbook, error) {
+func (h *HUOBI) GetDepth(ctx context.Context, obd OrderBookDataRequestParams) (Orderbook, error) {
 	vals := url.Values{}
 	vals.Set("symbol", obd.Symbol)
 
@@ -134,7 +142,7 @@ func (h *HUOBI) GetDepth(obd OrderBookDataRequestParams) (Orderbook, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URL, huobiMarketDepth)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(urlPath, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(urlPath, vals), &result)
 	if result.ErrorMessage != "" {
 		return result.Depth, errors.New(result.ErrorMessage)
 	}
@@ -142,7 +150,7 @@ func (h *HUOBI) GetDepth(obd OrderBookDataRequestParams) (Orderbook, error) {
 }
 
 // GetTrades returns the trades for the specified symbol
-func (h *HUOBI) GetTrades(symbol string) ([]Trade, error) {
+func (h *HUOBI) GetTrades(ctx context.Context, symbol string) ([]Trade, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 
@@ -156,18 +164,118 @@ func (h *HUOBI) GetTrades(symbol string) ([]Trade, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URL, huobiMarketTrade)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(urlPath, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(urlPath, vals), &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
 	return result.Tick.Data, err
 }
 
+// GetSwapFundingRate returns the current and estimated funding rate for a
+// coin margined swap contract e.g. BTC-USD
+func (h *HUOBI) GetSwapFundingRate(ctx context.Context, contractCode string) (SwapFundingRate, error) {
+	vals := url.Values{}
+	vals.Set("contract_code", contractCode)
+
+	type response struct {
+		SwapResponse
+		Data SwapFundingRate `json:"data"`
+	}
+
+	var result response
+	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URLSecondary, huobiSwapFundingRate)
+
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(urlPath, vals), &result)
+	if result.ErrorMessage != "" {
+		return result.Data, errors.New(result.ErrorMessage)
+	}
+	return result.Data, err
+}
+
+// GetSwapOpenInterest returns the open interest for a coin margined swap
+// contract e.g. BTC-USD
+func (h *HUOBI) GetSwapOpenInterest(ctx context.Context, contractCode string) ([]SwapOpenInterest, error) {
+	vals := url.Values{}
+	vals.Set("contract_code", contractCode)
+
+	type response struct {
+		SwapResponse
+		Data []SwapOpenInterest `json:"data"`
+	}
+
+	var result response
+	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URLSecondary, huobiSwapOpenInterest)
+
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(urlPath, vals), &result)
+	if result.ErrorMessage != "" {
+		return nil, errors.New(result.ErrorMessage)
+	}
+	return result.Data, err
+}
+
+// GetSwapIndex returns the index price for a coin margined swap contract
+// e.g. BTC-USD
+func (h *HUOBI) GetSwapIndex(ctx context.Context, contractCode string) ([]SwapIndex, error) {
+	vals := url.Values{}
+	vals.Set("contract_code", contractCode)
+
+	type response struct {
+		SwapResponse
+		Data []SwapIndex `json:"data"`
+	}
+
+	var result response
+	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URLSecondary, huobiSwapIndex)
+
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(urlPath, vals), &result)
+	if result.ErrorMessage != "" {
+		return nil, errors.New(result.ErrorMessage)
+	}
+	return result.Data, err
+}
+
+// GetSwapLiquidationOrders returns a page of liquidation orders for a coin
+// margined swap contract e.g. BTC-USD
+//
+// createDate: number of days to look back, either 7 or 90
+// pageIndex: page to return starting from 1
+// pageSize: Optional. Default 20; max 50.
+func (h *HUOBI) GetSwapLiquidationOrders(ctx context.Context, contractCode string, createDate, pageIndex, pageSize int64) (SwapLiquidationOrders, error) {
+	if pageSize > huobiSwapLiquidationLimit {
+		return SwapLiquidationOrders{},
+			fmt.Errorf("page size %d exceeds maximum of %d", pageSize, huobiSwapLiquidationLimit)
+	}
+	vals := url.Values{}
+	vals.Set("contract_code", contractCode)
+	vals.Set("trade_type", "0")
+	vals.Set("create_date", strconv.FormatInt(createDate, 10))
+	if pageIndex > 0 {
+		vals.Set("page_index", strconv.FormatInt(pageIndex, 10))
+	}
+	if pageSize > 0 {
+		vals.Set("page_size", strconv.FormatInt(pageSize, 10))
+	}
+
+	type response struct {
+		SwapResponse
+		Data SwapLiquidationOrders `json:"data"`
+	}
+
+	var result response
+	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URLSecondary, huobiSwapLiquidationOrders)
+
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(urlPath, vals), &result)
+	if result.ErrorMessage != "" {
+		return result.Data, errors.New(result.ErrorMessage)
+	}
+	return result.Data, err
+}
+
 // GetLatestSpotPrice returns latest spot price of symbol
 //
 // symbol: string of currency pair
-func (h *HUOBI) GetLatestSpotPrice(symbol string) (float64, error) {
-	list, err := h.GetTradeHistory(symbol, "1")
+func (h *HUOBI) GetLatestSpotPrice(ctx context.Context, symbol string) (float64, error) {
+	list, err := h.GetTradeHistory(ctx, symbol, "1")
 
 	if err != nil {
 		return 0, err
@@ -180,7 +288,7 @@ func (h *HUOBI) GetLatestSpotPrice(symbol string) (float64, error) {
 }
 
 // GetTradeHistory returns the trades for the specified symbol
-func (h *HUOBI) GetTradeHistory(symbol, size string) ([]TradeHistory, error) {
+func (h *HUOBI) GetTradeHistory(ctx context.Context, symbol, size string) ([]TradeHistory, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 
@@ -196,7 +304,7 @@ func (h *HUOBI) GetTradeHistory(symbol, size string) ([]TradeHistory, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URL, huobiMarketTradeHistory)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(urlPath, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(urlPath, vals), &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -204,7 +312,7 @@ func (h *HUOBI) GetTradeHistory(symbol, size string) ([]TradeHistory, error) {
 }
 
 // GetMarketDetail returns the ticker for the specified symbol
-func (h *HUOBI) GetMarketDetail(symbol string) (Detail, error) {
+func (h *HUOBI) GetMarketDetail(ctx context.Context, symbol string) (Detail, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 
@@ -216,7 +324,7 @@ func (h *HUOBI) GetMarketDetail(symbol string) (Detail, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URL, huobiMarketDetail)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(urlPath, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(urlPath, vals), &result)
 	if result.ErrorMessage != "" {
 		return result.Tick, errors.New(result.ErrorMessage)
 	}
@@ -224,7 +332,7 @@ func (h *HUOBI) GetMarketDetail(symbol string) (Detail, error) {
 }
 
 // GetSymbols returns an array of symbols supported by Huobi
-func (h *HUOBI) GetSymbols() ([]Symbol, error) {
+func (h *HUOBI) GetSymbols(ctx context.Context) ([]Symbol, error) {
 	type response struct {
 		Response
 		Symbols []Symbol `json:"data"`
@@ -233,7 +341,7 @@ func (h *HUOBI) GetSymbols() ([]Symbol, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/v%s/%s", h.API.Endpoints.URL, huobiAPIVersion, huobiSymbols)
 
-	err := h.SendHTTPRequest(urlPath, &result)
+	err := h.SendHTTPRequest(ctx, urlPath, &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -241,7 +349,7 @@ func (h *HUOBI) GetSymbols() ([]Symbol, error) {
 }
 
 // GetCurrencies returns a list of currencies supported by Huobi
-func (h *HUOBI) GetCurrencies() ([]string, error) {
+func (h *HUOBI) GetCurrencies(ctx context.Context) ([]string, error) {
 	type response struct {
 		Response
 		Currencies []string `json:"data"`
@@ -250,7 +358,7 @@ func (h *HUOBI) GetCurrencies() ([]string, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/v%s/%s", h.API.Endpoints.URL, huobiAPIVersion, huobiCurrencies)
 
-	err := h.SendHTTPRequest(urlPath, &result)
+	err := h.SendHTTPRequest(ctx, urlPath, &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -258,7 +366,7 @@ func (h *HUOBI) GetCurrencies() ([]string, error) {
 }
 
 // GetTimestamp returns the Huobi server time
-func (h *HUOBI) GetTimestamp() (int64, error) {
+func (h *HUOBI) GetTimestamp(ctx context.Context) (int64, error) {
 	type response struct {
 		Response
 		Timestamp int64 `json:"data"`
@@ -267,7 +375,7 @@ func (h *HUOBI) GetTimestamp() (int64, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/v%s/%s", h.API.Endpoints.URL, huobiAPIVersion, huobiTimestamp)
 
-	err := h.SendHTTPRequest(urlPath, &result)
+	err := h.SendHTTPRequest(ctx, urlPath, &result)
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
 	}
@@ -275,32 +383,32 @@ func (h *HUOBI) GetTimestamp() (int64, error) {
 }
 
 // GetAccounts returns the Huobi user accounts
-func (h *HUOBI) GetAccounts() ([]Account, error) {
+func (h *HUOBI) GetAccounts(ctx context.Context) ([]Account, error) {
 	result := struct {
 		Accounts []Account `json:"data"`
 	}{}
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, huobiAccounts, url.Values{}, nil, &result, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, huobiAccounts, url.Values{}, nil, &result, false)
 	return result.Accounts, err
 }
 
 // GetAccountBalance returns the users Huobi account balance
-func (h *HUOBI) GetAccountBalance(accountID string) ([]AccountBalanceDetail, error) {
+func (h *HUOBI) GetAccountBalance(ctx context.Context, accountID string) ([]AccountBalanceDetail, error) {
 	result := struct {
 		AccountBalanceData AccountBalance `json:"data"`
 	}{}
 	endpoint := fmt.Sprintf(huobiAccountBalance, accountID)
 	v := url.Values{}
 	v.Set("account-id", accountID)
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, endpoint, v, nil, &result, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, endpoint, v, nil, &result, false)
 	return result.AccountBalanceData.AccountBalanceDetails, err
 }
 
 // GetAggregatedBalance returns the balances of all the sub-account aggregated.
-func (h *HUOBI) GetAggregatedBalance() ([]AggregatedBalance, error) {
+func (h *HUOBI) GetAggregatedBalance(ctx context.Context) ([]AggregatedBalance, error) {
 	result := struct {
 		AggregatedBalances []AggregatedBalance `json:"data"`
 	}{}
-	err := h.SendAuthenticatedHTTPRequest(
+	err := h.SendAuthenticatedHTTPRequest(ctx,
 		http.MethodGet,
 		huobiAggregatedBalance,
 		nil,
@@ -312,7 +420,7 @@ func (h *HUOBI) GetAggregatedBalance() ([]AggregatedBalance, error) {
 }
 
 // SpotNewOrder submits an order to Huobi
-func (h *HUOBI) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
+func (h *HUOBI) SpotNewOrder(ctx context.Context, arg SpotNewOrderRequestParams) (int64, error) {
 	data := struct {
 		AccountID int    `json:"account-id,string"`
 		Amount    string `json:"amount"`
@@ -339,7 +447,7 @@ func (h *HUOBI) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
 	result := struct {
 		OrderID int64 `json:"data,string"`
 	}{}
-	err := h.SendAuthenticatedHTTPRequest(
+	err := h.SendAuthenticatedHTTPRequest(ctx,
 		http.MethodPost,
 		huobiOrderPlace,
 		nil,
@@ -351,24 +459,24 @@ func (h *HUOBI) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
 }
 
 // CancelExistingOrder cancels an order on Huobi
-func (h *HUOBI) CancelExistingOrder(orderID int64) (int64, error) {
+func (h *HUOBI) CancelExistingOrder(ctx context.Context, orderID int64) (int64, error) {
 	resp := struct {
 		OrderID int64 `json:"data,string"`
 	}{}
 	endpoint := fmt.Sprintf(huobiOrderCancel, strconv.FormatInt(orderID, 10))
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost, endpoint, url.Values{}, nil, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, endpoint, url.Values{}, nil, &resp, false)
 	return resp.OrderID, err
 }
 
 // CancelOrderBatch cancels a batch of orders -- to-do
-func (h *HUOBI) CancelOrderBatch(_ []int64) ([]CancelOrderBatch, error) {
+func (h *HUOBI) CancelOrderBatch(ctx context.Context, _ []int64) ([]CancelOrderBatch, error) {
 	type response struct {
 		Response
 		Data []CancelOrderBatch `json:"data"`
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost, huobiOrderCancelBatch, url.Values{}, nil, &result, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, huobiOrderCancelBatch, url.Values{}, nil, &result, false)
 
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
@@ -377,7 +485,7 @@ func (h *HUOBI) CancelOrderBatch(_ []int64) ([]CancelOrderBatch, error) {
 }
 
 // CancelOpenOrdersBatch cancels a batch of orders -- to-do
-func (h *HUOBI) CancelOpenOrdersBatch(accountID, symbol string) (CancelOpenOrdersBatch, error) {
+func (h *HUOBI) CancelOpenOrdersBatch(ctx context.Context, accountID, symbol string) (CancelOpenOrdersBatch, error) {
 	params := url.Values{}
 
 	params.Set("account-id", accountID)
@@ -391,7 +499,7 @@ func (h *HUOBI) CancelOpenOrdersBatch(accountID, symbol string) (CancelOpenOrder
 		Symbol:    symbol,
 	}
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost, huobiBatchCancelOpenOrders, url.Values{}, data, &result, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, huobiBatchCancelOpenOrders, url.Values{}, data, &result, false)
 	if result.Data.FailedCount > 0 {
 		return result, fmt.Errorf("there were %v failed order cancellations", result.Data.FailedCount)
 	}
@@ -400,13 +508,13 @@ func (h *HUOBI) CancelOpenOrdersBatch(accountID, symbol string) (CancelOpenOrder
 }
 
 // GetOrder returns order information for the specified order
-func (h *HUOBI) GetOrder(orderID int64) (OrderInfo, error) {
+func (h *HUOBI) GetOrder(ctx context.Context, orderID int64) (OrderInfo, error) {
 	resp := struct {
 		Order OrderInfo `json:"data"`
 	}{}
 	urlVal := url.Values{}
 	urlVal.Set("clientOrderId", strconv.FormatInt(orderID, 10))
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet,
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		huobiGetOrder,
 		urlVal,
 		nil,
@@ -416,17 +524,17 @@ func (h *HUOBI) GetOrder(orderID int64) (OrderInfo, error) {
 }
 
 // GetOrderMatchResults returns matched order info for the specified order
-func (h *HUOBI) GetOrderMatchResults(orderID int64) ([]OrderMatchInfo, error) {
+func (h *HUOBI) GetOrderMatchResults(ctx context.Context, orderID int64) ([]OrderMatchInfo, error) {
 	resp := struct {
 		Orders []OrderMatchInfo `json:"data"`
 	}{}
 	endpoint := fmt.Sprintf(huobiGetOrderMatch, strconv.FormatInt(orderID, 10))
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, endpoint, url.Values{}, nil, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, endpoint, url.Values{}, nil, &resp, false)
 	return resp.Orders, err
 }
 
 // GetOrders returns a list of orders
-func (h *HUOBI) GetOrders(symbol, types, start, end, states, from, direct, size string) ([]OrderInfo, error) {
+func (h *HUOBI) GetOrders(ctx context.Context, symbol, types, start, end, states, from, direct, size string) ([]OrderInfo, error) {
 	resp := struct {
 		Orders []OrderInfo `json:"data"`
 	}{}
@@ -459,12 +567,12 @@ func (h *HUOBI) GetOrders(symbol, types, start, end, states, from, direct, size
 		vals.Set("size", size)
 	}
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, huobiGetOrders, vals, nil, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, huobiGetOrders, vals, nil, &resp, false)
 	return resp.Orders, err
 }
 
 // GetOpenOrders returns a list of orders
-func (h *HUOBI) GetOpenOrders(accountID, symbol, side string, size int64) ([]OrderInfo, error) {
+func (h *HUOBI) GetOpenOrders(ctx context.Context, accountID, symbol, side string, size int64) ([]OrderInfo, error) {
 	resp := struct {
 		Orders []OrderInfo `json:"data"`
 	}{}
@@ -477,12 +585,12 @@ func (h *HUOBI) GetOpenOrders(accountID, symbol, side string, size int64) ([]Ord
 	}
 	vals.Set("size", strconv.FormatInt(size, 10))
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, huobiGetOpenOrders, vals, nil, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, huobiGetOpenOrders, vals, nil, &resp, false)
 	return resp.Orders, err
 }
 
 // GetOrdersMatch returns a list of matched orders
-func (h *HUOBI) GetOrdersMatch(symbol, types, start, end, from, direct, size string) ([]OrderMatchInfo, error) {
+func (h *HUOBI) GetOrdersMatch(ctx context.Context, symbol, types, start, end, from, direct, size string) ([]OrderMatchInfo, error) {
 	resp := struct {
 		Orders []OrderMatchInfo `json:"data"`
 	}{}
@@ -514,12 +622,12 @@ func (h *HUOBI) GetOrdersMatch(symbol, types, start, end, from, direct, size str
 		vals.Set("size", size)
 	}
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, huobiGetOrdersMatch, vals, nil, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, huobiGetOrdersMatch, vals, nil, &resp, false)
 	return resp.Orders, err
 }
 
 // MarginTransfer transfers assets into or out of the margin account
-func (h *HUOBI) MarginTransfer(symbol, currency string, amount float64, in bool) (int64, error) {
+func (h *HUOBI) MarginTransfer(ctx context.Context, symbol, currency string, amount float64, in bool) (int64, error) {
 	data := struct {
 		Symbol   string `json:"symbol"`
 		Currency string `json:"currency"`
@@ -538,12 +646,12 @@ func (h *HUOBI) MarginTransfer(symbol, currency string, amount float64, in bool)
 	resp := struct {
 		TransferID int64 `json:"data"`
 	}{}
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost, path, nil, data, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, path, nil, data, &resp, false)
 	return resp.TransferID, err
 }
 
 // MarginOrder submits a margin order application
-func (h *HUOBI) MarginOrder(symbol, currency string, amount float64) (int64, error) {
+func (h *HUOBI) MarginOrder(ctx context.Context, symbol, currency string, amount float64) (int64, error) {
 	data := struct {
 		Symbol   string `json:"symbol"`
 		Currency string `json:"currency"`
@@ -557,12 +665,12 @@ func (h *HUOBI) MarginOrder(symbol, currency string, amount float64) (int64, err
 	resp := struct {
 		MarginOrderID int64 `json:"data"`
 	}{}
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost, huobiMarginOrders, nil, data, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, huobiMarginOrders, nil, data, &resp, false)
 	return resp.MarginOrderID, err
 }
 
 // MarginRepayment repays a margin amount for a margin ID
-func (h *HUOBI) MarginRepayment(orderID int64, amount float64) (int64, error) {
+func (h *HUOBI) MarginRepayment(ctx context.Context, orderID int64, amount float64) (int64, error) {
 	data := struct {
 		Amount string `json:"amount"`
 	}{
@@ -574,12 +682,12 @@ func (h *HUOBI) MarginRepayment(orderID int64, amount float64) (int64, error) {
 	}{}
 
 	endpoint := fmt.Sprintf(huobiMarginRepay, strconv.FormatInt(orderID, 10))
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost, endpoint, nil, data, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, endpoint, nil, data, &resp, false)
 	return resp.MarginOrderID, err
 }
 
 // GetMarginLoanOrders returns the margin loan orders
-func (h *HUOBI) GetMarginLoanOrders(symbol, currency, start, end, states, from, direct, size string) ([]MarginOrder, error) {
+func (h *HUOBI) GetMarginLoanOrders(ctx context.Context, symbol, currency, start, end, states, from, direct, size string) ([]MarginOrder, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 	vals.Set("currency", currency)
@@ -611,12 +719,12 @@ func (h *HUOBI) GetMarginLoanOrders(symbol, currency, start, end, states, from,
 	resp := struct {
 		MarginLoanOrders []MarginOrder `json:"data"`
 	}{}
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, huobiMarginLoanOrders, vals, nil, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, huobiMarginLoanOrders, vals, nil, &resp, false)
 	return resp.MarginLoanOrders, err
 }
 
 // GetMarginAccountBalance returns the margin account balances
-func (h *HUOBI) GetMarginAccountBalance(symbol string) ([]MarginAccountBalance, error) {
+func (h *HUOBI) GetMarginAccountBalance(ctx context.Context, symbol string) ([]MarginAccountBalance, error) {
 	resp := struct {
 		Balances []MarginAccountBalance `json:"data"`
 	}{}
@@ -624,12 +732,12 @@ func (h *HUOBI) GetMarginAccountBalance(symbol string) ([]MarginAccountBalance,
 	if symbol != "" {
 		vals.Set("symbol", symbol)
 	}
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, huobiMarginAccountBalance, vals, nil, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, huobiMarginAccountBalance, vals, nil, &resp, false)
 	return resp.Balances, err
 }
 
 // Withdraw withdraws the desired amount and currency
-func (h *HUOBI) Withdraw(c currency.Code, address, addrTag string, amount, fee float64) (int64, error) {
+func (h *HUOBI) Withdraw(ctx context.Context, c currency.Code, address, addrTag string, amount, fee float64) (int64, error) {
 	resp := struct {
 		WithdrawID int64 `json:"data"`
 	}{}
@@ -654,12 +762,12 @@ func (h *HUOBI) Withdraw(c currency.Code, address, addrTag string, amount, fee f
 		data.AddrTag = addrTag
 	}
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost, huobiWithdrawCreate, nil, data, &resp.WithdrawID, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, huobiWithdrawCreate, nil, data, &resp.WithdrawID, false)
 	return resp.WithdrawID, err
 }
 
 // CancelWithdraw cancels a withdraw request
-func (h *HUOBI) CancelWithdraw(withdrawID int64) (int64, error) {
+func (h *HUOBI) CancelWithdraw(ctx context.Context, withdrawID int64) (int64, error) {
 	resp := struct {
 		WithdrawID int64 `json:"data"`
 	}{}
@@ -667,12 +775,12 @@ func (h *HUOBI) CancelWithdraw(withdrawID int64) (int64, error) {
 	vals.Set("withdraw-id", strconv.FormatInt(withdrawID, 10))
 
 	endpoint := fmt.Sprintf(huobiWithdrawCancel, strconv.FormatInt(withdrawID, 10))
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost, endpoint, vals, nil, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, endpoint, vals, nil, &resp, false)
 	return resp.WithdrawID, err
 }
 
 // QueryDepositAddress returns the deposit address for a specified currency
-func (h *HUOBI) QueryDepositAddress(cryptocurrency string) (DepositAddress, error) {
+func (h *HUOBI) QueryDepositAddress(ctx context.Context, cryptocurrency string) (DepositAddress, error) {
 	resp := struct {
 		DepositAddress []DepositAddress `json:"data"`
 	}{}
@@ -680,7 +788,7 @@ func (h *HUOBI) QueryDepositAddress(cryptocurrency string) (DepositAddress, erro
 	vals := url.Values{}
 	vals.Set("currency", cryptocurrency)
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, huobiAccountDepositAddress, vals, nil, &resp, true)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, huobiAccountDepositAddress, vals, nil, &resp, true)
 	if err != nil {
 		return DepositAddress{}, err
 	}
@@ -691,7 +799,7 @@ func (h *HUOBI) QueryDepositAddress(cryptocurrency string) (DepositAddress, erro
 }
 
 // QueryWithdrawQuotas returns the users cryptocurrency withdraw quotas
-func (h *HUOBI) QueryWithdrawQuotas(cryptocurrency string) (WithdrawQuota, error) {
+func (h *HUOBI) QueryWithdrawQuotas(ctx context.Context, cryptocurrency string) (WithdrawQuota, error) {
 	resp := struct {
 		WithdrawQuota WithdrawQuota `json:"data"`
 	}{}
@@ -699,7 +807,7 @@ func (h *HUOBI) QueryWithdrawQuotas(cryptocurrency string) (WithdrawQuota, error
 	vals := url.Values{}
 	vals.Set("currency", cryptocurrency)
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, huobiAccountWithdrawQuota, vals, nil, &resp, true)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, huobiAccountWithdrawQuota, vals, nil, &resp, true)
 	if err != nil {
 		return WithdrawQuota{}, err
 	}
@@ -707,8 +815,8 @@ func (h *HUOBI) QueryWithdrawQuotas(cryptocurrency string) (WithdrawQuota, error
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (h *HUOBI) SendHTTPRequest(path string, result interface{}) error {
-	return h.SendPayload(context.Background(), &request.Item{
+func (h *HUOBI) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return h.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -719,7 +827,7 @@ func (h *HUOBI) SendHTTPRequest(path string, result interface{}) error {
 }
 
 // SendAuthenticatedHTTPRequest sends authenticated requests to the HUOBI API
-func (h *HUOBI) SendAuthenticatedHTTPRequest(method, endpoint string, values url.Values, data, result interface{}, isVersion2API bool) error {
+func (h *HUOBI) SendAuthenticatedHTTPRequest(ctx context.Context, method, endpoint string, values url.Values, data, result interface{}, isVersion2API bool) error {
 	if !h.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, h.Name)
 	}
@@ -728,7 +836,8 @@ func (h *HUOBI) SendAuthenticatedHTTPRequest(method, endpoint string, values url
 		values = url.Values{}
 	}
 
-	now := time.Now()
+	limit := authEndpointLimit(endpoint)
+	now := h.Now()
 	values.Set("AccessKeyId", h.API.Credentials.Key)
 	values.Set("SignatureMethod", "HmacSHA256")
 	values.Set("SignatureVersion", "2")
@@ -765,7 +874,7 @@ func (h *HUOBI) SendAuthenticatedHTTPRequest(method, endpoint string, values url
 	}
 
 	// Time difference between your timestamp and standard should be less than 1 minute.
-	ctx, cancel := context.WithDeadline(context.Background(), now.Add(time.Minute))
+	ctx, cancel := context.WithDeadline(ctx, now.Add(time.Minute))
 	defer cancel()
 	interim := json.RawMessage{}
 	err := h.SendPayload(ctx, &request.Item{
@@ -778,6 +887,7 @@ func (h *HUOBI) SendAuthenticatedHTTPRequest(method, endpoint string, values url
 		Verbose:       h.Verbose,
 		HTTPDebugging: h.HTTPDebugging,
 		HTTPRecording: h.HTTPRecording,
+		Endpoint:      limit,
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,40 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package huobi
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	h.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Huobi load config error", err)
+	}
+	hConfig, err := cfg.GetExchangeConfig("Huobi")
+	if err != nil {
+		log.Fatal("Huobi Setup() init error")
+	}
+	hConfig.API.AuthenticatedSupport = true
+	hConfig.API.AuthenticatedWebsocketSupport = true
+	hConfig.API.Credentials.Key = apiKey
+	hConfig.API.Credentials.Secret = apiSecret
+	h.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = h.Setup(hConfig)
+	if err != nil {
+		log.Fatal("Huobi setup error", err)
+	}
+	log.Printf(sharedtestvalues.LiveTesting, h.Name, h.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
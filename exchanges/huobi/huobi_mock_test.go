@@ -0,0 +1,56 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package huobi
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/huobi/huobi.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	h.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Huobi load config error", err)
+	}
+	hConfig, err := cfg.GetExchangeConfig("Huobi")
+	if err != nil {
+		log.Fatal("Huobi Setup() init error")
+	}
+	hConfig.API.AuthenticatedSupport = true
+	hConfig.API.AuthenticatedWebsocketSupport = true
+	hConfig.API.Credentials.Key = apiKey
+	hConfig.API.Credentials.Secret = apiSecret
+	h.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = h.Setup(hConfig)
+	if err != nil {
+		log.Fatal("Huobi setup error", err)
+	}
+
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+
+	h.HTTPClient = newClient
+	h.API.Endpoints.URL = serverDetails
+	h.API.Endpoints.URLSecondary = serverDetails
+	log.Printf(sharedtestvalues.MockTesting, h.Name, h.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
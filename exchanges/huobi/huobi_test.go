@@ -1,15 +1,13 @@
 package huobi
 
 import (
-	"log"
-	"os"
+	"context"
 	"strconv"
 	"testing"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/yurulab/gocryptotrader/common"
-	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/core"
 	"github.com/yurulab/gocryptotrader/currency"
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
@@ -32,29 +30,6 @@ const (
 var h HUOBI
 var wsSetupRan bool
 
-func TestMain(m *testing.M) {
-	h.SetDefaults()
-	cfg := config.GetConfig()
-	err := cfg.LoadConfig("../../testdata/configtest.json", true)
-	if err != nil {
-		log.Fatal("Huobi load config error", err)
-	}
-	hConfig, err := cfg.GetExchangeConfig("Huobi")
-	if err != nil {
-		log.Fatal("Huobi Setup() init error")
-	}
-	hConfig.API.AuthenticatedSupport = true
-	hConfig.API.AuthenticatedWebsocketSupport = true
-	hConfig.API.Credentials.Key = apiKey
-	hConfig.API.Credentials.Secret = apiSecret
-	h.Websocket = sharedtestvalues.NewTestWebsocket()
-	err = h.Setup(hConfig)
-	if err != nil {
-		log.Fatal("Huobi setup error", err)
-	}
-	os.Exit(m.Run())
-}
-
 func setupWsTests(t *testing.T) {
 	if wsSetupRan {
 		return
@@ -79,7 +54,7 @@ func setupWsTests(t *testing.T) {
 
 func TestGetSpotKline(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetSpotKline(KlinesRequestParams{
+	_, err := h.GetSpotKline(context.Background(), KlinesRequestParams{
 		Symbol: testSymbol,
 		Period: "1min",
 		Size:   0,
@@ -95,17 +70,17 @@ func TestGetHistoricCandles(t *testing.T) {
 		t.Fatal(err)
 	}
 	startTime := time.Now().Add(-time.Hour * 1)
-	_, err = h.GetHistoricCandles(currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin)
+	_, err = h.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = h.GetHistoricCandles(currencyPair, asset.Spot, startTime.AddDate(0, 0, -7), time.Now(), kline.OneDay)
+	_, err = h.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, startTime.AddDate(0, 0, -7), time.Now(), kline.OneDay)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = h.GetHistoricCandles(currencyPair, asset.Spot, startTime, time.Now(), kline.Interval(time.Hour*7))
+	_, err = h.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.Interval(time.Hour*7))
 	if err == nil {
 		t.Fatal("unexpected result")
 	}
@@ -117,12 +92,12 @@ func TestGetHistoricCandlesExtended(t *testing.T) {
 		t.Fatal(err)
 	}
 	startTime := time.Now().Add(-time.Hour * 1)
-	_, err = h.GetHistoricCandlesExtended(currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin)
+	_, err = h.GetHistoricCandlesExtended(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = h.GetHistoricCandlesExtended(currencyPair, asset.Spot, startTime, time.Now(), kline.Interval(time.Hour*7))
+	_, err = h.GetHistoricCandlesExtended(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.Interval(time.Hour*7))
 	if err == nil {
 		t.Fatal("unexpected result")
 	}
@@ -130,7 +105,7 @@ func TestGetHistoricCandlesExtended(t *testing.T) {
 
 func TestGetMarketDetailMerged(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetMarketDetailMerged(testSymbol)
+	_, err := h.GetMarketDetailMerged(context.Background(), testSymbol)
 	if err != nil {
 		t.Errorf("Huobi TestGetMarketDetailMerged: %s", err)
 	}
@@ -138,7 +113,7 @@ func TestGetMarketDetailMerged(t *testing.T) {
 
 func TestGetDepth(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetDepth(OrderBookDataRequestParams{
+	_, err := h.GetDepth(context.Background(), OrderBookDataRequestParams{
 		Symbol: testSymbol,
 		Type:   OrderBookDataRequestParamsTypeStep1,
 	})
@@ -150,15 +125,75 @@ func TestGetDepth(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetTrades(testSymbol)
+	_, err := h.GetTrades(context.Background(), testSymbol)
 	if err != nil {
 		t.Errorf("Huobi TestGetTrades: %s", err)
 	}
 }
 
+func TestGetSwapFundingRate(t *testing.T) {
+	t.Parallel()
+	_, err := h.GetSwapFundingRate(context.Background(), "BTC-USD")
+	if err != nil {
+		t.Errorf("Huobi TestGetSwapFundingRate: %s", err)
+	}
+}
+
+func TestGetSwapOpenInterest(t *testing.T) {
+	t.Parallel()
+	_, err := h.GetSwapOpenInterest(context.Background(), "BTC-USD")
+	if err != nil {
+		t.Errorf("Huobi TestGetSwapOpenInterest: %s", err)
+	}
+}
+
+func TestGetSwapIndex(t *testing.T) {
+	t.Parallel()
+	_, err := h.GetSwapIndex(context.Background(), "BTC-USD")
+	if err != nil {
+		t.Errorf("Huobi TestGetSwapIndex: %s", err)
+	}
+}
+
+func TestGetSwapLiquidationOrders(t *testing.T) {
+	t.Parallel()
+	_, err := h.GetSwapLiquidationOrders(context.Background(), "BTC-USD", 7, 1, huobiSwapLiquidationLimit+1)
+	if err == nil {
+		t.Error("Huobi TestGetSwapLiquidationOrders: expected error when page size is exceeded")
+	}
+	_, err = h.GetSwapLiquidationOrders(context.Background(), "BTC-USD", 7, 1, huobiSwapLiquidationLimit)
+	if err != nil {
+		t.Errorf("Huobi TestGetSwapLiquidationOrders: %s", err)
+	}
+}
+
+func TestUpdateDerivativeInfo(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	_, err := h.UpdateDerivativeInfo(context.Background(), p, asset.Spot)
+	if err == nil {
+		t.Error("Huobi TestUpdateDerivativeInfo: expected error for spot")
+	}
+	_, err = h.UpdateDerivativeInfo(context.Background(), p, asset.PerpetualSwap)
+	if err != nil {
+		t.Errorf("Huobi TestUpdateDerivativeInfo: %s", err)
+	}
+}
+
+func TestGetLiquidations(t *testing.T) {
+	t.Parallel()
+	_, err := h.GetLiquidations(context.Background(), currency.NewPair(currency.BTC, currency.USD),
+		asset.PerpetualSwap,
+		time.Now().Add(-time.Hour),
+		time.Now())
+	if err != nil {
+		t.Errorf("Huobi TestGetLiquidations: %s", err)
+	}
+}
+
 func TestGetLatestSpotPrice(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetLatestSpotPrice(testSymbol)
+	_, err := h.GetLatestSpotPrice(context.Background(), testSymbol)
 	if err != nil {
 		t.Errorf("Huobi GetLatestSpotPrice: %s", err)
 	}
@@ -166,7 +201,7 @@ func TestGetLatestSpotPrice(t *testing.T) {
 
 func TestGetTradeHistory(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetTradeHistory(testSymbol, "50")
+	_, err := h.GetTradeHistory(context.Background(), testSymbol, "50")
 	if err != nil {
 		t.Errorf("Huobi TestGetTradeHistory: %s", err)
 	}
@@ -174,7 +209,7 @@ func TestGetTradeHistory(t *testing.T) {
 
 func TestGetMarketDetail(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetMarketDetail(testSymbol)
+	_, err := h.GetMarketDetail(context.Background(), testSymbol)
 	if err != nil {
 		t.Errorf("Huobi TestGetTradeHistory: %s", err)
 	}
@@ -182,7 +217,7 @@ func TestGetMarketDetail(t *testing.T) {
 
 func TestGetSymbols(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetSymbols()
+	_, err := h.GetSymbols(context.Background())
 	if err != nil {
 		t.Errorf("Huobi TestGetSymbols: %s", err)
 	}
@@ -190,14 +225,14 @@ func TestGetSymbols(t *testing.T) {
 
 func TestGetCurrencies(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetCurrencies()
+	_, err := h.GetCurrencies(context.Background())
 	if err != nil {
 		t.Errorf("Huobi TestGetCurrencies: %s", err)
 	}
 }
 
 func TestGetTicker(t *testing.T) {
-	_, err := h.GetTickers()
+	_, err := h.GetTickers(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -205,7 +240,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetTimestamp(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetTimestamp()
+	_, err := h.GetTimestamp(context.Background())
 	if err != nil {
 		t.Errorf("Huobi TestGetTimestamp: %s", err)
 	}
@@ -217,7 +252,7 @@ func TestGetAccounts(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := h.GetAccounts()
+	_, err := h.GetAccounts(context.Background())
 	if err != nil {
 		t.Errorf("Huobi GetAccounts: %s", err)
 	}
@@ -229,13 +264,13 @@ func TestGetAccountBalance(t *testing.T) {
 		t.Skip()
 	}
 
-	result, err := h.GetAccounts()
+	result, err := h.GetAccounts(context.Background())
 	if err != nil {
 		t.Errorf("Huobi GetAccounts: %s", err)
 	}
 
 	userID := strconv.FormatInt(result[0].ID, 10)
-	_, err = h.GetAccountBalance(userID)
+	_, err = h.GetAccountBalance(context.Background(), userID)
 	if err != nil {
 		t.Errorf("Huobi GetAccountBalance: %s", err)
 	}
@@ -247,7 +282,7 @@ func TestGetAggregatedBalance(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := h.GetAggregatedBalance()
+	_, err := h.GetAggregatedBalance(context.Background())
 	if err != nil {
 		t.Errorf("Huobi GetAggregatedBalance: %s", err)
 	}
@@ -267,7 +302,7 @@ func TestSpotNewOrder(t *testing.T) {
 		Type:      SpotNewOrderRequestTypeBuyLimit,
 	}
 
-	_, err := h.SpotNewOrder(arg)
+	_, err := h.SpotNewOrder(context.Background(), arg)
 	if err != nil {
 		t.Errorf("Huobi SpotNewOrder: %s", err)
 	}
@@ -278,7 +313,7 @@ func TestCancelExistingOrder(t *testing.T) {
 	if !h.ValidateAPICredentials() || !canManipulateRealOrders {
 		t.Skip()
 	}
-	_, err := h.CancelExistingOrder(1337)
+	_, err := h.CancelExistingOrder(context.Background(), 1337)
 	if err == nil {
 		t.Error("Huobi TestCancelExistingOrder Expected error")
 	}
@@ -289,7 +324,7 @@ func TestGetOrder(t *testing.T) {
 	if !h.ValidateAPICredentials() || !canManipulateRealOrders {
 		t.Skip()
 	}
-	_, err := h.GetOrder(1337)
+	_, err := h.GetOrder(context.Background(), 1337)
 	if err != nil {
 		t.Error(err)
 	}
@@ -301,7 +336,7 @@ func TestGetMarginLoanOrders(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := h.GetMarginLoanOrders(testSymbol, "", "", "", "", "", "", "")
+	_, err := h.GetMarginLoanOrders(context.Background(), testSymbol, "", "", "", "", "", "", "")
 	if err != nil {
 		t.Errorf("Huobi TestGetMarginLoanOrders: %s", err)
 	}
@@ -313,7 +348,7 @@ func TestGetMarginAccountBalance(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := h.GetMarginAccountBalance(testSymbol)
+	_, err := h.GetMarginAccountBalance(context.Background(), testSymbol)
 	if err != nil {
 		t.Errorf("Huobi TestGetMarginAccountBalance: %s", err)
 	}
@@ -324,7 +359,7 @@ func TestCancelWithdraw(t *testing.T) {
 	if !h.ValidateAPICredentials() || !canManipulateRealOrders {
 		t.Skip()
 	}
-	_, err := h.CancelWithdraw(1337)
+	_, err := h.CancelWithdraw(context.Background(), 1337)
 	if err == nil {
 		t.Error("Huobi TestCancelWithdraw Expected error")
 	}
@@ -346,7 +381,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	h.GetFeeByType(feeBuilder)
+	h.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -448,7 +483,7 @@ func TestGetActiveOrders(t *testing.T) {
 		Pairs: []currency.Pair{currency.NewPair(currency.BTC, currency.USDT)},
 	}
 
-	_, err := h.GetActiveOrders(&getOrdersRequest)
+	_, err := h.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -462,7 +497,7 @@ func TestGetOrderHistory(t *testing.T) {
 		Pairs: []currency.Pair{currency.NewPair(currency.BTC, currency.USDT)},
 	}
 
-	_, err := h.GetOrderHistory(&getOrdersRequest)
+	_, err := h.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -485,7 +520,7 @@ func TestSubmitOrder(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	accounts, err := h.GetAccounts()
+	accounts, err := h.GetAccounts(context.Background())
 	if err != nil {
 		t.Fatalf("Failed to get accounts. Err: %s", err)
 	}
@@ -501,7 +536,7 @@ func TestSubmitOrder(t *testing.T) {
 		Amount:   1,
 		ClientID: strconv.FormatInt(accounts[0].ID, 10),
 	}
-	response, err := h.SubmitOrder(orderSubmission)
+	response, err := h.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -520,7 +555,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	err := h.CancelOrder(orderCancellation)
+	err := h.CancelOrder(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -543,7 +578,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	resp, err := h.CancelAllOrders(&orderCancellation)
+	resp, err := h.CancelAllOrders(context.Background(), &orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -559,12 +594,12 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 
 func TestGetAccountInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
-		_, err := h.UpdateAccountInfo()
+		_, err := h.UpdateAccountInfo(context.Background())
 		if err == nil {
 			t.Error("GetAccountInfo() Expected error")
 		}
 	} else {
-		_, err := h.UpdateAccountInfo()
+		_, err := h.UpdateAccountInfo(context.Background())
 		if err != nil {
 			t.Error("GetAccountInfo() error", err)
 		}
@@ -575,7 +610,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := h.ModifyOrder(&order.Modify{})
+	_, err := h.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -595,7 +630,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := h.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := h.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -610,7 +645,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.Request{}
-	_, err := h.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := h.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -622,14 +657,14 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.Request{}
-	_, err := h.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := h.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
 }
 
 func TestQueryDepositAddress(t *testing.T) {
-	_, err := h.QueryDepositAddress(currency.BTC.Lower().String())
+	_, err := h.QueryDepositAddress(context.Background(), currency.BTC.Lower().String())
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -639,7 +674,7 @@ func TestQueryDepositAddress(t *testing.T) {
 }
 
 func TestQueryWithdrawQuota(t *testing.T) {
-	_, err := h.QueryWithdrawQuotas(currency.BTC.Lower().String())
+	_, err := h.QueryWithdrawQuotas(context.Background(), currency.BTC.Lower().String())
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -15,6 +15,60 @@ type ResponseV2 struct {
 	Message string `json:"message"`
 }
 
+// SwapResponse stores the Huobi swap API response information
+type SwapResponse struct {
+	Status       string `json:"status"`
+	Timestamp    int64  `json:"ts"`
+	ErrorCode    int64  `json:"err_code"`
+	ErrorMessage string `json:"err_msg"`
+}
+
+// SwapFundingRate stores the funding rate of a coin margined swap contract
+type SwapFundingRate struct {
+	Symbol          string  `json:"symbol"`
+	ContractCode    string  `json:"contract_code"`
+	FeeAsset        string  `json:"fee_asset"`
+	FundingRate     float64 `json:"funding_rate,string"`
+	EstimatedRate   float64 `json:"estimated_rate,string"`
+	FundingTime     int64   `json:"funding_time,string"`
+	NextFundingTime int64   `json:"next_funding_time,string"`
+}
+
+// SwapOpenInterest stores the open interest of a coin margined swap contract
+type SwapOpenInterest struct {
+	Symbol       string  `json:"symbol"`
+	ContractCode string  `json:"contract_code"`
+	Volume       float64 `json:"volume"`
+	Amount       float64 `json:"amount"`
+}
+
+// SwapIndex stores the index price of a coin margined swap contract
+type SwapIndex struct {
+	ContractCode string  `json:"contract_code"`
+	IndexPrice   float64 `json:"index_price"`
+	Timestamp    int64   `json:"index_ts"`
+}
+
+// SwapLiquidationOrders stores a page of swap liquidation orders
+type SwapLiquidationOrders struct {
+	Orders      []SwapLiquidationOrder `json:"orders"`
+	TotalPage   int64                  `json:"total_page"`
+	CurrentPage int64                  `json:"current_page"`
+	TotalSize   int64                  `json:"total_size"`
+}
+
+// SwapLiquidationOrder stores a liquidation order of a coin margined swap
+// contract
+type SwapLiquidationOrder struct {
+	Symbol       string  `json:"symbol"`
+	ContractCode string  `json:"contract_code"`
+	Direction    string  `json:"direction"`
+	Offset       string  `json:"offset"`
+	Volume       float64 `json:"volume"`
+	Price        float64 `json:"price"`
+	CreatedAt    int64   `json:"created_at"`
+}
+
 // KlineItem stores a kline item
 type KlineItem struct {
 	ID     int64   `json:"id"`
@@ -18,6 +18,7 @@ import (
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
 	"github.com/yurulab/gocryptotrader/exchanges/stream"
 	"github.com/yurulab/gocryptotrader/exchanges/ticker"
 	"github.com/yurulab/gocryptotrader/log"
@@ -363,6 +364,7 @@ func (h *HUOBI) wsHandleData(respRaw []byte) error {
 				side = order.Sell
 			}
 			h.Websocket.DataHandler <- stream.TradeData{
+				TID:          strconv.FormatFloat(trade.Tick.Data[i].ID, 'f', -1, 64),
 				Exchange:     h.Name,
 				AssetType:    a,
 				CurrencyPair: p,
@@ -617,7 +619,7 @@ func (h *HUOBI) wsGetAccountsList() (*WsAuthenticatedAccountsListResponse, error
 		return nil, fmt.Errorf("%v not authenticated cannot get accounts list", h.Name)
 	}
 	timestamp := time.Now().UTC().Format(wsDateTimeFormatting)
-	request := WsAuthenticatedAccountsListRequest{
+	req := WsAuthenticatedAccountsListRequest{
 		Op:               requestOp,
 		AccessKeyID:      h.API.Credentials.Key,
 		SignatureMethod:  signatureMethod,
@@ -626,9 +628,9 @@ func (h *HUOBI) wsGetAccountsList() (*WsAuthenticatedAccountsListResponse, error
 		Topic:            wsAccountsList,
 	}
 	hmac := h.wsGenerateSignature(timestamp, wsAccountListEndpoint)
-	request.Signature = crypto.Base64Encode(hmac)
-	request.ClientID = h.Websocket.AuthConn.GenerateMessageID(true)
-	resp, err := h.Websocket.AuthConn.SendMessageReturnResponse(request.ClientID, request)
+	req.Signature = crypto.Base64Encode(hmac)
+	req.ClientID = h.Websocket.AuthConn.GenerateMessageID(true)
+	resp, err := h.Websocket.AuthConn.SendRateLimitedMessageReturnResponse(request.Unset, req.ClientID, req)
 	if err != nil {
 		return nil, err
 	}
@@ -656,7 +658,7 @@ func (h *HUOBI) wsGetOrdersList(accountID int64, pair currency.Pair) (*WsAuthent
 	}
 
 	timestamp := time.Now().UTC().Format(wsDateTimeFormatting)
-	request := WsAuthenticatedOrdersListRequest{
+	req := WsAuthenticatedOrdersListRequest{
 		Op:               requestOp,
 		AccessKeyID:      h.API.Credentials.Key,
 		SignatureMethod:  signatureMethod,
@@ -669,10 +671,10 @@ func (h *HUOBI) wsGetOrdersList(accountID int64, pair currency.Pair) (*WsAuthent
 	}
 
 	hmac := h.wsGenerateSignature(timestamp, wsOrdersListEndpoint)
-	request.Signature = crypto.Base64Encode(hmac)
-	request.ClientID = h.Websocket.AuthConn.GenerateMessageID(true)
+	req.Signature = crypto.Base64Encode(hmac)
+	req.ClientID = h.Websocket.AuthConn.GenerateMessageID(true)
 
-	resp, err := h.Websocket.AuthConn.SendMessageReturnResponse(request.ClientID, request)
+	resp, err := h.Websocket.AuthConn.SendRateLimitedMessageReturnResponse(huobiSpotTrade, req.ClientID, req)
 	if err != nil {
 		return nil, err
 	}
@@ -695,7 +697,7 @@ func (h *HUOBI) wsGetOrderDetails(orderID string) (*WsAuthenticatedOrderDetailRe
 		return nil, fmt.Errorf("%v not authenticated cannot get order details", h.Name)
 	}
 	timestamp := time.Now().UTC().Format(wsDateTimeFormatting)
-	request := WsAuthenticatedOrderDetailsRequest{
+	req := WsAuthenticatedOrderDetailsRequest{
 		Op:               requestOp,
 		AccessKeyID:      h.API.Credentials.Key,
 		SignatureMethod:  signatureMethod,
@@ -705,9 +707,9 @@ func (h *HUOBI) wsGetOrderDetails(orderID string) (*WsAuthenticatedOrderDetailRe
 		OrderID:          orderID,
 	}
 	hmac := h.wsGenerateSignature(timestamp, wsOrdersDetailEndpoint)
-	request.Signature = crypto.Base64Encode(hmac)
-	request.ClientID = h.Websocket.AuthConn.GenerateMessageID(true)
-	resp, err := h.Websocket.AuthConn.SendMessageReturnResponse(request.ClientID, request)
+	req.Signature = crypto.Base64Encode(hmac)
+	req.ClientID = h.Websocket.AuthConn.GenerateMessageID(true)
+	resp, err := h.Websocket.AuthConn.SendRateLimitedMessageReturnResponse(huobiSpotTrade, req.ClientID, req)
 	if err != nil {
 		return nil, err
 	}
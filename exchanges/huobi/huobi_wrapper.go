@@ -1,6 +1,7 @@
 package huobi
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -14,6 +15,7 @@ import (
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
 	"github.com/yurulab/gocryptotrader/exchanges/account"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/derivative"
 	"github.com/yurulab/gocryptotrader/exchanges/kline"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
@@ -39,7 +41,7 @@ func (h *HUOBI) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if h.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = h.UpdateTradablePairs(true)
+		err = h.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -129,6 +131,8 @@ func (h *HUOBI) SetDefaults() {
 
 	h.API.Endpoints.URLDefault = huobiAPIURL
 	h.API.Endpoints.URL = h.API.Endpoints.URLDefault
+	h.API.Endpoints.URLSecondaryDefault = huobiFuturesURL
+	h.API.Endpoints.URLSecondary = h.API.Endpoints.URLSecondaryDefault
 	h.API.Endpoints.WebsocketURL = wsMarketURL
 	h.Websocket = stream.New()
 	h.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
@@ -182,6 +186,7 @@ func (h *HUOBI) Setup(exch *config.ExchangeConfig) error {
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
 		URL:                  wsAccountsOrdersURL,
 		Authenticated:        true,
+		Limiter:              h.Requester,
 	})
 }
 
@@ -275,7 +280,7 @@ func (h *HUOBI) Run() {
 		return
 	}
 
-	err = h.UpdateTradablePairs(forceUpdate)
+	err = h.UpdateTradablePairs(context.Background(), forceUpdate)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s failed to update tradable pairs. Err: %s",
@@ -285,8 +290,8 @@ func (h *HUOBI) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (h *HUOBI) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	symbols, err := h.GetSymbols()
+func (h *HUOBI) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	symbols, err := h.GetSymbols(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -311,8 +316,8 @@ func (h *HUOBI) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (h *HUOBI) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := h.FetchTradablePairs(asset.Spot)
+func (h *HUOBI) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := h.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -325,8 +330,8 @@ func (h *HUOBI) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (h *HUOBI) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
-	tickers, err := h.GetTickers()
+func (h *HUOBI) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+	tickers, err := h.GetTickers(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -364,30 +369,30 @@ func (h *HUOBI) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pri
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (h *HUOBI) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (h *HUOBI) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(h.Name, p, assetType)
 	if err != nil {
-		return h.UpdateTicker(p, assetType)
+		return h.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (h *HUOBI) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (h *HUOBI) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(h.Name, p, assetType)
 	if err != nil {
-		return h.UpdateOrderbook(p, assetType)
+		return h.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (h *HUOBI) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (h *HUOBI) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	fpair, err := h.FormatExchangeCurrency(p, assetType)
 	if err != nil {
 		return nil, err
 	}
-	orderbookNew, err := h.GetDepth(OrderBookDataRequestParams{
+	orderbookNew, err := h.GetDepth(ctx, OrderBookDataRequestParams{
 		Symbol: fpair.String(),
 		Type:   OrderBookDataRequestParamsTypeStep0,
 	})
@@ -423,8 +428,8 @@ func (h *HUOBI) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbo
 }
 
 // GetAccountID returns the account ID for trades
-func (h *HUOBI) GetAccountID() ([]Account, error) {
-	acc, err := h.GetAccounts()
+func (h *HUOBI) GetAccountID(ctx context.Context) ([]Account, error) {
+	acc, err := h.GetAccounts(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -438,7 +443,7 @@ func (h *HUOBI) GetAccountID() ([]Account, error) {
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // HUOBI exchange - to-do
-func (h *HUOBI) UpdateAccountInfo() (account.Holdings, error) {
+func (h *HUOBI) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var info account.Holdings
 	info.Exchange = h.Name
 	if h.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
@@ -464,14 +469,14 @@ func (h *HUOBI) UpdateAccountInfo() (account.Holdings, error) {
 		acc.Currencies = currencyDetails
 		info.Accounts = append(info.Accounts, acc)
 	} else {
-		accounts, err := h.GetAccountID()
+		accounts, err := h.GetAccountID(ctx)
 		if err != nil {
 			return info, err
 		}
 		for i := range accounts {
 			var acc account.SubAccount
 			acc.ID = strconv.FormatInt(accounts[i].ID, 10)
-			balances, err := h.GetAccountBalance(acc.ID)
+			balances, err := h.GetAccountBalance(ctx, acc.ID)
 			if err != nil {
 				return info, err
 			}
@@ -528,10 +533,10 @@ func (h *HUOBI) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (h *HUOBI) FetchAccountInfo() (account.Holdings, error) {
+func (h *HUOBI) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(h.Name)
 	if err != nil {
-		return h.UpdateAccountInfo()
+		return h.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -539,17 +544,125 @@ func (h *HUOBI) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (h *HUOBI) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (h *HUOBI) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data within the timeframe provided.
-func (h *HUOBI) GetExchangeHistory(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+func (h *HUOBI) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
+// UpdateDerivativeInfo updates and returns the funding, open interest and
+// index data for a coin margined swap contract. Huobi does not publish a mark
+// price for swaps over REST so it is left unset
+func (h *HUOBI) UpdateDerivativeInfo(ctx context.Context, p currency.Pair, assetType asset.Item) (*derivative.Info, error) {
+	if assetType != asset.PerpetualSwap {
+		return nil, fmt.Errorf("%s asset type %s is not supported", h.Name, assetType)
+	}
+	contractCode := p.Format("-", true).String()
+
+	funding, err := h.GetSwapFundingRate(ctx, contractCode)
+	if err != nil {
+		return nil, err
+	}
+	openInterest, err := h.GetSwapOpenInterest(ctx, contractCode)
+	if err != nil {
+		return nil, err
+	}
+	index, err := h.GetSwapIndex(ctx, contractCode)
+	if err != nil {
+		return nil, err
+	}
+
+	info := derivative.Info{
+		Exchange:             h.Name,
+		Pair:                 p,
+		AssetType:            assetType,
+		FundingRate:          funding.FundingRate,
+		PredictedFundingRate: funding.EstimatedRate,
+		NextFundingTime:      time.Unix(0, funding.FundingTime*int64(time.Millisecond)),
+	}
+	for i := range openInterest {
+		if openInterest[i].ContractCode == contractCode {
+			info.OpenInterest = openInterest[i].Volume
+			break
+		}
+	}
+	for i := range index {
+		if index[i].ContractCode == contractCode {
+			info.IndexPrice = index[i].IndexPrice
+			info.LastUpdated = time.Unix(0, index[i].Timestamp*int64(time.Millisecond))
+			break
+		}
+	}
+
+	err = derivative.ProcessInfo(&info)
+	if err != nil {
+		return nil, err
+	}
+	return derivative.GetInfo(h.Name, p, assetType)
+}
+
+// FetchDerivativeInfo returns the funding, open interest and index data for a
+// coin margined swap contract
+func (h *HUOBI) FetchDerivativeInfo(ctx context.Context, p currency.Pair, assetType asset.Item) (*derivative.Info, error) {
+	info, err := derivative.GetInfo(h.Name, p, assetType)
+	if err != nil {
+		return h.UpdateDerivativeInfo(ctx, p, assetType)
+	}
+	return info, nil
+}
+
+// GetLiquidations returns forced liquidations for a coin margined swap
+// contract within the timeframe provided. Huobi only returns liquidations
+// from the last seven days
+func (h *HUOBI) GetLiquidations(ctx context.Context, p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]derivative.Liquidation, error) {
+	if assetType != asset.PerpetualSwap {
+		return nil, fmt.Errorf("%s asset type %s is not supported", h.Name, assetType)
+	}
+	contractCode := p.Format("-", true).String()
+
+	var resp []derivative.Liquidation
+	for page := int64(1); ; page++ {
+		orders, err := h.GetSwapLiquidationOrders(ctx, contractCode, 7, page, huobiSwapLiquidationLimit)
+		if err != nil {
+			return nil, err
+		}
+		var pastStart bool
+		for i := range orders.Orders {
+			ts := time.Unix(0, orders.Orders[i].CreatedAt*int64(time.Millisecond))
+			if ts.Before(timestampStart) {
+				// Orders are returned newest first
+				pastStart = true
+				break
+			}
+			if ts.After(timestampEnd) {
+				continue
+			}
+			side, err := order.StringToOrderSide(orders.Orders[i].Direction)
+			if err != nil {
+				return nil, err
+			}
+			resp = append(resp, derivative.Liquidation{
+				Exchange:  h.Name,
+				Pair:      p,
+				AssetType: assetType,
+				Side:      side,
+				Price:     orders.Orders[i].Price,
+				Amount:    orders.Orders[i].Volume,
+				Timestamp: ts,
+			})
+		}
+		if pastStart || page >= orders.TotalPage {
+			break
+		}
+	}
+	return resp, nil
+}
+
 // SubmitOrder submits a new order
-func (h *HUOBI) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (h *HUOBI) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
@@ -587,7 +700,7 @@ func (h *HUOBI) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 	}
 
 	params.Type = formattedType
-	response, err := h.SpotNewOrder(params)
+	response, err := h.SpotNewOrder(ctx, params)
 	if err != nil {
 		return submitOrderResponse, err
 	}
@@ -604,23 +717,23 @@ func (h *HUOBI) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (h *HUOBI) ModifyOrder(action *order.Modify) (string, error) {
+func (h *HUOBI) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (h *HUOBI) CancelOrder(order *order.Cancel) error {
+func (h *HUOBI) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	orderIDInt, err := strconv.ParseInt(order.ID, 10, 64)
 	if err != nil {
 		return err
 	}
 
-	_, err = h.CancelExistingOrder(orderIDInt)
+	_, err = h.CancelExistingOrder(ctx, orderIDInt)
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (h *HUOBI) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
+func (h *HUOBI) CancelAllOrders(ctx context.Context, orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
 	var cancelAllOrdersResponse order.CancelAllResponse
 	enabledPairs, err := h.GetEnabledPairs(asset.Spot)
 	if err != nil {
@@ -632,7 +745,7 @@ func (h *HUOBI) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAl
 			return cancelAllOrdersResponse, err
 		}
 
-		resp, err := h.CancelOpenOrdersBatch(orderCancellation.AccountID,
+		resp, err := h.CancelOpenOrdersBatch(ctx, orderCancellation.AccountID,
 			fpair.String())
 		if err != nil {
 			return cancelAllOrdersResponse, err
@@ -653,7 +766,7 @@ func (h *HUOBI) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAl
 }
 
 // GetOrderInfo returns information on a current open order
-func (h *HUOBI) GetOrderInfo(orderID string) (order.Detail, error) {
+func (h *HUOBI) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	var respData *OrderInfo
 	if h.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
@@ -667,7 +780,7 @@ func (h *HUOBI) GetOrderInfo(orderID string) (order.Detail, error) {
 		if err != nil {
 			return orderDetail, err
 		}
-		resp, err := h.GetOrder(oID)
+		resp, err := h.GetOrder(ctx, oID)
 		if err != nil {
 			return orderDetail, err
 		}
@@ -742,15 +855,15 @@ func (h *HUOBI) GetOrderInfo(orderID string) (order.Detail, error) {
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (h *HUOBI) GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error) {
-	resp, err := h.QueryDepositAddress(cryptocurrency.Lower().String())
+func (h *HUOBI) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error) {
+	resp, err := h.QueryDepositAddress(ctx, cryptocurrency.Lower().String())
 	return resp.Address, err
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (h *HUOBI) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
-	resp, err := h.Withdraw(withdrawRequest.Currency, withdrawRequest.Crypto.Address, withdrawRequest.Crypto.AddressTag, withdrawRequest.Amount, withdrawRequest.Crypto.FeeAmount)
+func (h *HUOBI) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	resp, err := h.Withdraw(ctx, withdrawRequest.Currency, withdrawRequest.Crypto.Address, withdrawRequest.Crypto.AddressTag, withdrawRequest.Amount, withdrawRequest.Crypto.FeeAmount)
 	if err != nil {
 		return nil, err
 	}
@@ -761,18 +874,18 @@ func (h *HUOBI) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (h *HUOBI) WithdrawFiatFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (h *HUOBI) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (h *HUOBI) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (h *HUOBI) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (h *HUOBI) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (h *HUOBI) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !h.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
@@ -781,7 +894,7 @@ func (h *HUOBI) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (h *HUOBI) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (h *HUOBI) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	if len(req.Pairs) == 0 {
 		return nil, errors.New("currency must be supplied")
 	}
@@ -853,7 +966,7 @@ func (h *HUOBI) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, er
 				return nil, err
 			}
 
-			resp, err := h.GetOpenOrders(h.API.Credentials.ClientID,
+			resp, err := h.GetOpenOrders(ctx, h.API.Credentials.ClientID,
 				p.String(),
 				side,
 				500)
@@ -888,7 +1001,7 @@ func (h *HUOBI) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, er
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (h *HUOBI) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (h *HUOBI) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	if len(req.Pairs) == 0 {
 		return nil, errors.New("currency must be supplied")
 	}
@@ -901,7 +1014,7 @@ func (h *HUOBI) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, er
 			return nil, err
 		}
 
-		resp, err := h.GetOrders(
+		resp, err := h.GetOrders(ctx,
 			p.String(),
 			"",
 			"",
@@ -962,8 +1075,8 @@ func (h *HUOBI) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (h *HUOBI) ValidateCredentials() error {
-	_, err := h.UpdateAccountInfo()
+func (h *HUOBI) ValidateCredentials(ctx context.Context) error {
+	_, err := h.UpdateAccountInfo(ctx)
 	return h.CheckTransientError(err)
 }
 
@@ -987,7 +1100,7 @@ func (h *HUOBI) FormatExchangeKlineInterval(in kline.Interval) string {
 }
 
 // GetHistoricCandles returns candles between a time period for a set time interval
-func (h *HUOBI) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (h *HUOBI) GetHistoricCandles(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if !h.KlineIntervalEnabled(interval) {
 		return kline.Item{}, kline.ErrorKline{
 			Interval: interval,
@@ -1004,7 +1117,7 @@ func (h *HUOBI) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end
 		Symbol: formattedPair.String(),
 	}
 
-	candles, err := h.GetSpotKline(klineParams)
+	candles, err := h.GetSpotKline(ctx, klineParams)
 	if err != nil {
 		return kline.Item{}, err
 	}
@@ -1036,6 +1149,6 @@ func (h *HUOBI) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end
 }
 
 // GetHistoricCandlesExtended returns candles between a time period for a set time interval
-func (h *HUOBI) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
-	return h.GetHistoricCandles(pair, a, start, end, interval)
+func (h *HUOBI) GetHistoricCandlesExtended(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	return h.GetHistoricCandles(ctx, pair, a, start, end, interval)
 }
@@ -1,6 +1,8 @@
 package huobi
 
 import (
+	"context"
+	"strings"
 	"time"
 
 	"github.com/yurulab/gocryptotrader/exchanges/request"
@@ -11,6 +13,9 @@ const (
 	// Huobi rate limits per API Key
 	huobiSpotRateInterval = time.Second * 1
 	huobiSpotRequestRate  = 7
+	// Order placement, cancellation and queries share a separate budget
+	huobiSpotTradeRateInterval = time.Second * 1
+	huobiSpotTradeRequestRate  = 10
 
 	huobiFuturesRateInterval    = time.Second * 3
 	huobiFuturesAuthRequestRate = 30
@@ -28,11 +33,13 @@ const (
 	huobiFuturesTransfer
 	huobiSwapAuth
 	huobiSwapUnauth
+	huobiSpotTrade
 )
 
 // RateLimit implements the request.Limiter interface
 type RateLimit struct {
 	Spot          *rate.Limiter
+	SpotTrade     *rate.Limiter
 	FuturesAuth   *rate.Limiter
 	FuturesUnauth *rate.Limiter
 	SwapAuth      *rate.Limiter
@@ -41,30 +48,41 @@ type RateLimit struct {
 }
 
 // Limit limits outbound requests
-func (r *RateLimit) Limit(f request.EndpointLimit) error {
+func (r *RateLimit) Limit(ctx context.Context, f request.EndpointLimit) error {
 	switch f {
 	// TODO: Add futures and swap functionality
+	case huobiSpotTrade:
+		return request.WaitForReservations(ctx, r.SpotTrade.Reserve())
 	case huobiFuturesAuth:
-		time.Sleep(r.FuturesAuth.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.FuturesAuth.Reserve())
 	case huobiFuturesUnAuth:
-		time.Sleep(r.FuturesUnauth.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.FuturesUnauth.Reserve())
 	case huobiFuturesTransfer:
-		time.Sleep(r.FuturesXfer.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.FuturesXfer.Reserve())
 	case huobiSwapAuth:
-		time.Sleep(r.SwapAuth.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SwapAuth.Reserve())
 	case huobiSwapUnauth:
-		time.Sleep(r.SwapUnauth.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.SwapUnauth.Reserve())
 	default:
 		// Spot calls
-		time.Sleep(r.Spot.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.Spot.Reserve())
 	}
-	return nil
+}
+
+// authEndpointLimit returns the rate limit budget of an authenticated spot
+// endpoint, order endpoints are limited separately from the rest
+func authEndpointLimit(endpoint string) request.EndpointLimit {
+	if strings.HasPrefix(endpoint, "order/") || strings.HasPrefix(endpoint, "orders/") {
+		return huobiSpotTrade
+	}
+	return request.Unset
 }
 
 // SetRateLimit returns the rate limit for the exchange
 func SetRateLimit() *RateLimit {
 	return &RateLimit{
 		Spot:          request.NewRateLimit(huobiSpotRateInterval, huobiSpotRequestRate),
+		SpotTrade:     request.NewRateLimit(huobiSpotTradeRateInterval, huobiSpotTradeRequestRate),
 		FuturesAuth:   request.NewRateLimit(huobiFuturesRateInterval, huobiFuturesAuthRequestRate),
 		FuturesUnauth: request.NewRateLimit(huobiFuturesRateInterval, huobiFuturesUnAuthRequestRate),
 		SwapAuth:      request.NewRateLimit(huobiSwapRateInterval, huobiSwapAuthRequestRate),
@@ -0,0 +1,26 @@
+package huobi
+
+import (
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/exchanges/request"
+)
+
+func TestAuthEndpointLimit(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		endpoint string
+		expected request.EndpointLimit
+	}{
+		{huobiOrderPlace, huobiSpotTrade},
+		{huobiGetOpenOrders, huobiSpotTrade},
+		{huobiGetOrdersMatch, huobiSpotTrade},
+		{huobiAccounts, request.Unset},
+	}
+	for i := range testCases {
+		if l := authEndpointLimit(testCases[i].endpoint); l != testCases[i].expected {
+			t.Errorf("%s: expected %v, received %v",
+				testCases[i].endpoint, testCases[i].expected, l)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package exchange
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/yurulab/gocryptotrader/currency"
 	"github.com/yurulab/gocryptotrader/exchanges/account"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/derivative"
 	"github.com/yurulab/gocryptotrader/exchanges/kline"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
@@ -25,40 +27,43 @@ type IBotExchange interface {
 	GetName() string
 	IsEnabled() bool
 	SetEnabled(bool)
-	ValidateCredentials() error
-	FetchTicker(p currency.Pair, a asset.Item) (*ticker.Price, error)
-	UpdateTicker(p currency.Pair, a asset.Item) (*ticker.Price, error)
-	FetchOrderbook(p currency.Pair, a asset.Item) (*orderbook.Base, error)
-	UpdateOrderbook(p currency.Pair, a asset.Item) (*orderbook.Base, error)
-	FetchTradablePairs(a asset.Item) ([]string, error)
-	UpdateTradablePairs(forceUpdate bool) error
+	ValidateCredentials(ctx context.Context) error
+	FetchTicker(ctx context.Context, p currency.Pair, a asset.Item) (*ticker.Price, error)
+	UpdateTicker(ctx context.Context, p currency.Pair, a asset.Item) (*ticker.Price, error)
+	FetchOrderbook(ctx context.Context, p currency.Pair, a asset.Item) (*orderbook.Base, error)
+	UpdateOrderbook(ctx context.Context, p currency.Pair, a asset.Item) (*orderbook.Base, error)
+	FetchTradablePairs(ctx context.Context, a asset.Item) ([]string, error)
+	UpdateTradablePairs(ctx context.Context, forceUpdate bool) error
 	GetEnabledPairs(a asset.Item) (currency.Pairs, error)
 	GetAvailablePairs(a asset.Item) (currency.Pairs, error)
-	FetchAccountInfo() (account.Holdings, error)
-	UpdateAccountInfo() (account.Holdings, error)
+	FetchAccountInfo(ctx context.Context) (account.Holdings, error)
+	UpdateAccountInfo(ctx context.Context) (account.Holdings, error)
 	GetAuthenticatedAPISupport(endpoint uint8) bool
 	SetPairs(pairs currency.Pairs, a asset.Item, enabled bool) error
 	GetAssetTypes() asset.Items
-	GetExchangeHistory(p currency.Pair, a asset.Item, startTime, endTime time.Time) ([]TradeHistory, error)
+	GetExchangeHistory(ctx context.Context, p currency.Pair, a asset.Item, startTime, endTime time.Time) ([]TradeHistory, error)
+	FetchDerivativeInfo(ctx context.Context, p currency.Pair, a asset.Item) (*derivative.Info, error)
+	UpdateDerivativeInfo(ctx context.Context, p currency.Pair, a asset.Item) (*derivative.Info, error)
+	GetLiquidations(ctx context.Context, p currency.Pair, a asset.Item, startTime, endTime time.Time) ([]derivative.Liquidation, error)
 	SupportsAutoPairUpdates() bool
 	SupportsRESTTickerBatchUpdates() bool
-	GetFeeByType(f *FeeBuilder) (float64, error)
+	GetFeeByType(ctx context.Context, f *FeeBuilder) (float64, error)
 	GetLastPairsUpdateTime() int64
 	GetWithdrawPermissions() uint32
 	FormatWithdrawPermissions() string
 	SupportsWithdrawPermissions(permissions uint32) bool
-	GetFundingHistory() ([]FundHistory, error)
-	SubmitOrder(s *order.Submit) (order.SubmitResponse, error)
-	ModifyOrder(action *order.Modify) (string, error)
-	CancelOrder(order *order.Cancel) error
-	CancelAllOrders(orders *order.Cancel) (order.CancelAllResponse, error)
-	GetOrderInfo(orderID string) (order.Detail, error)
-	GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error)
-	GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error)
-	GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error)
-	WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error)
-	WithdrawFiatFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error)
-	WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error)
+	GetFundingHistory(ctx context.Context) ([]FundHistory, error)
+	SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error)
+	ModifyOrder(ctx context.Context, action *order.Modify) (string, error)
+	CancelOrder(ctx context.Context, order *order.Cancel) error
+	CancelAllOrders(ctx context.Context, orders *order.Cancel) (order.CancelAllResponse, error)
+	GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error)
+	GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error)
+	GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error)
+	GetActiveOrders(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error)
+	WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error)
+	WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error)
+	WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error)
 	SetHTTPClientUserAgent(ua string)
 	GetHTTPClientUserAgent() string
 	SetClientProxyAddress(addr string) error
@@ -67,8 +72,8 @@ type IBotExchange interface {
 	GetDefaultConfig() (*config.ExchangeConfig, error)
 	GetBase() *Base
 	SupportsAsset(assetType asset.Item) bool
-	GetHistoricCandles(p currency.Pair, a asset.Item, timeStart, timeEnd time.Time, interval kline.Interval) (kline.Item, error)
-	GetHistoricCandlesExtended(p currency.Pair, a asset.Item, timeStart, timeEnd time.Time, interval kline.Interval) (kline.Item, error)
+	GetHistoricCandles(ctx context.Context, p currency.Pair, a asset.Item, timeStart, timeEnd time.Time, interval kline.Interval) (kline.Item, error)
+	GetHistoricCandlesExtended(ctx context.Context, p currency.Pair, a asset.Item, timeStart, timeEnd time.Time, interval kline.Interval) (kline.Item, error)
 	DisableRateLimiter() error
 	EnableRateLimiter() error
 
@@ -84,3 +89,9 @@ type IBotExchange interface {
 	FlushWebsocketChannels() error
 	AuthenticateWebsocket() error
 }
+
+// ServerTimeFetcher is implemented by exchanges which expose their server
+// time, allowing request timestamps to be corrected for clock skew
+type ServerTimeFetcher interface {
+	FetchServerTime() (time.Time, error)
+}
@@ -0,0 +1,41 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package itbit
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	i.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Itbit load config error", err)
+	}
+	itbitConfig, err := cfg.GetExchangeConfig("ITBIT")
+	if err != nil {
+		log.Fatal("Itbit Setup() init error")
+	}
+	itbitConfig.API.AuthenticatedSupport = true
+	itbitConfig.API.Credentials.Key = apiKey
+	itbitConfig.API.Credentials.Secret = apiSecret
+	itbitConfig.API.Credentials.ClientID = clientID
+
+	err = i.Setup(itbitConfig)
+	if err != nil {
+		log.Fatal("Itbit setup error", err)
+	}
+
+	log.Printf(sharedtestvalues.LiveTesting, i.Name, i.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
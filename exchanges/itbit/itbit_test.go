@@ -1,13 +1,11 @@
 package itbit
 
 import (
-	"log"
+	"context"
 	"net/url"
-	"os"
 	"testing"
 
 	"github.com/yurulab/gocryptotrader/common"
-	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/core"
 	"github.com/yurulab/gocryptotrader/currency"
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
@@ -25,33 +23,9 @@ const (
 	canManipulateRealOrders = false
 )
 
-func TestMain(m *testing.M) {
-	i.SetDefaults()
-	cfg := config.GetConfig()
-	err := cfg.LoadConfig("../../testdata/configtest.json", true)
-	if err != nil {
-		log.Fatal("Itbit load config error", err)
-	}
-	itbitConfig, err := cfg.GetExchangeConfig("ITBIT")
-	if err != nil {
-		log.Fatal("Itbit Setup() init error")
-	}
-	itbitConfig.API.AuthenticatedSupport = true
-	itbitConfig.API.Credentials.Key = apiKey
-	itbitConfig.API.Credentials.Secret = apiSecret
-	itbitConfig.API.Credentials.ClientID = clientID
-
-	err = i.Setup(itbitConfig)
-	if err != nil {
-		log.Fatal("Itbit setup error", err)
-	}
-
-	os.Exit(m.Run())
-}
-
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := i.GetTicker("XBTUSD")
+	_, err := i.GetTicker(context.Background(), "XBTUSD")
 	if err != nil {
 		t.Error("GetTicker() error", err)
 	}
@@ -59,7 +33,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := i.GetOrderbook("XBTSGD")
+	_, err := i.GetOrderbook(context.Background(), "XBTSGD")
 	if err != nil {
 		t.Error("GetOrderbook() error", err)
 	}
@@ -67,56 +41,56 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetTradeHistory(t *testing.T) {
 	t.Parallel()
-	_, err := i.GetTradeHistory("XBTUSD", "0")
+	_, err := i.GetTradeHistory(context.Background(), "XBTUSD", "0")
 	if err != nil {
 		t.Error("GetTradeHistory() error", err)
 	}
 }
 
 func TestGetWallets(t *testing.T) {
-	_, err := i.GetWallets(url.Values{})
+	_, err := i.GetWallets(context.Background(), url.Values{})
 	if err == nil {
 		t.Error("GetWallets() Expected error")
 	}
 }
 
 func TestCreateWallet(t *testing.T) {
-	_, err := i.CreateWallet("test")
+	_, err := i.CreateWallet(context.Background(), "test")
 	if err == nil {
 		t.Error("CreateWallet() Expected error")
 	}
 }
 
 func TestGetWallet(t *testing.T) {
-	_, err := i.GetWallet("1337")
+	_, err := i.GetWallet(context.Background(), "1337")
 	if err == nil {
 		t.Error("GetWallet() Expected error")
 	}
 }
 
 func TestGetWalletBalance(t *testing.T) {
-	_, err := i.GetWalletBalance("1337", "XRT")
+	_, err := i.GetWalletBalance(context.Background(), "1337", "XRT")
 	if err == nil {
 		t.Error("GetWalletBalance() Expected error")
 	}
 }
 
 func TestGetWalletTrades(t *testing.T) {
-	_, err := i.GetWalletTrades("1337", url.Values{})
+	_, err := i.GetWalletTrades(context.Background(), "1337", url.Values{})
 	if err == nil {
 		t.Error("GetWalletTrades() Expected error")
 	}
 }
 
 func TestGetFundingHistory(t *testing.T) {
-	_, err := i.GetFundingHistoryForWallet("1337", url.Values{})
+	_, err := i.GetFundingHistoryForWallet(context.Background(), "1337", url.Values{})
 	if err == nil {
 		t.Error("GetFundingHistory() Expected error")
 	}
 }
 
 func TestPlaceOrder(t *testing.T) {
-	_, err := i.PlaceOrder("1337", order.Buy.Lower(),
+	_, err := i.PlaceOrder(context.Background(), "1337", order.Buy.Lower(),
 		order.Limit.Lower(), "USD", 1, 0.2, "banjo",
 		"sauce")
 	if err == nil {
@@ -125,7 +99,7 @@ func TestPlaceOrder(t *testing.T) {
 }
 
 func TestGetOrder(t *testing.T) {
-	_, err := i.GetOrder("1337", url.Values{})
+	_, err := i.GetOrder(context.Background(), "1337", url.Values{})
 	if err == nil {
 		t.Error("GetOrder() Expected error")
 	}
@@ -133,21 +107,21 @@ func TestGetOrder(t *testing.T) {
 
 func TestCancelExistingOrder(t *testing.T) {
 	t.Skip()
-	err := i.CancelExistingOrder("1337", "1337order")
+	err := i.CancelExistingOrder(context.Background(), "1337", "1337order")
 	if err == nil {
 		t.Error("CancelOrder() Expected error")
 	}
 }
 
 func TestGetCryptoDepositAddress(t *testing.T) {
-	_, err := i.GetCryptoDepositAddress("1337", "AUD")
+	_, err := i.GetCryptoDepositAddress(context.Background(), "1337", "AUD")
 	if err == nil {
 		t.Error("GetCryptoDepositAddress() Expected error")
 	}
 }
 
 func TestWalletTransfer(t *testing.T) {
-	_, err := i.WalletTransfer("1337", "mywallet", "anotherwallet", 200, "USD")
+	_, err := i.WalletTransfer(context.Background(), "1337", "mywallet", "anotherwallet", 200, "USD")
 	if err == nil {
 		t.Error("WalletTransfer() Expected error")
 	}
@@ -169,7 +143,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	i.GetFeeByType(feeBuilder)
+	i.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -270,7 +244,7 @@ func TestGetActiveOrders(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := i.GetActiveOrders(&getOrdersRequest)
+	_, err := i.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -283,7 +257,7 @@ func TestGetOrderHistory(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := i.GetOrderHistory(&getOrdersRequest)
+	_, err := i.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -313,7 +287,7 @@ func TestSubmitOrder(t *testing.T) {
 		Amount:   1,
 		ClientID: "meowOrder",
 	}
-	response, err := i.SubmitOrder(orderSubmission)
+	response, err := i.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -334,7 +308,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	err := i.CancelOrder(orderCancellation)
+	err := i.CancelOrder(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -357,7 +331,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	resp, err := i.CancelAllOrders(orderCancellation)
+	resp, err := i.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -373,7 +347,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 
 func TestGetAccountInfo(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := i.UpdateAccountInfo()
+		_, err := i.UpdateAccountInfo(context.Background())
 		if err == nil {
 			t.Error("GetAccountInfo() Expected error")
 		}
@@ -384,7 +358,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := i.ModifyOrder(&order.Modify{})
+	_, err := i.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -404,7 +378,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := i.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := i.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected 'Not supported', received %v", err)
 	}
@@ -416,7 +390,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.Request{}
-	_, err := i.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := i.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -428,14 +402,14 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.Request{}
-	_, err := i.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := i.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
 }
 
 func TestGetDepositAddress(t *testing.T) {
-	_, err := i.GetDepositAddress(currency.BTC, "")
+	_, err := i.GetDepositAddress(context.Background(), currency.BTC, "")
 	if err == nil {
 		t.Error("GetDepositAddress() error cannot be nil")
 	}
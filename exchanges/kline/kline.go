@@ -81,29 +81,35 @@ func CreateKline(trades []order.TradeHistory, interval Interval, p currency.Pair
 			continue
 		}
 
-		var newCandle = Candle{
-			Open: timeIntervalCache[x][0].Price,
-			Time: candleStart[x],
-		}
-
+		var newCandle = Candle{Time: candleStart[x]}
 		for y := range timeIntervalCache[x] {
-			if y == len(timeIntervalCache[x])-1 {
-				newCandle.Close = timeIntervalCache[x][y].Price
-				closePriceOfLast = timeIntervalCache[x][y].Price
-			}
-			if newCandle.High < timeIntervalCache[x][y].Price {
-				newCandle.High = timeIntervalCache[x][y].Price
-			}
-			if newCandle.Low > timeIntervalCache[x][y].Price || newCandle.Low == 0 {
-				newCandle.Low = timeIntervalCache[x][y].Price
-			}
-			newCandle.Volume += timeIntervalCache[x][y].Amount
+			newCandle.update(timeIntervalCache[x][y].Price,
+				timeIntervalCache[x][y].Amount)
 		}
+		closePriceOfLast = newCandle.Close
 		candles.Candles = append(candles.Candles, newCandle)
 	}
 	return candles, nil
 }
 
+// update folds a trade into the candle, the first trade for a candle without
+// volume sets its open, high and low
+func (c *Candle) update(price, amount float64) {
+	if c.Volume == 0 {
+		c.Open = price
+		c.High = price
+		c.Low = price
+	}
+	if price > c.High {
+		c.High = price
+	}
+	if price < c.Low {
+		c.Low = price
+	}
+	c.Close = price
+	c.Volume += amount
+}
+
 // validatData checks for zero values on data and sorts before turning
 // converting into OHLC
 func validateData(trades []order.TradeHistory) error {
@@ -0,0 +1,253 @@
+package kline
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/dispatch"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+)
+
+func init() {
+	service = new(Service)
+	service.Candles = make(map[string]map[*currency.Item]map[*currency.Item]map[asset.Item]map[Interval]*liveCandle)
+	service.Exchange = make(map[string]uuid.UUID)
+	service.mux = dispatch.GetNewMux()
+}
+
+// SubscribeKline subscribes to a live candle and returns a communication
+// channel to stream new candle updates
+func SubscribeKline(exchange string, p currency.Pair, a asset.Item, i Interval) (dispatch.Pipe, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+
+	c, ok := service.Candles[exchange][p.Base.Item][p.Quote.Item][a][i]
+	if !ok {
+		return dispatch.Pipe{}, fmt.Errorf("live candle not found for %s %s %s %s",
+			exchange,
+			p,
+			a,
+			i)
+	}
+	return service.mux.Subscribe(c.Main)
+}
+
+// SubscribeToExchangeKlines subscribes to all live candles on an exchange
+func SubscribeToExchangeKlines(exchange string) (dispatch.Pipe, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+	id, ok := service.Exchange[exchange]
+	if !ok {
+		return dispatch.Pipe{}, fmt.Errorf("%s exchange live candles not found",
+			exchange)
+	}
+	return service.mux.Subscribe(id)
+}
+
+// GetLiveCandle returns the latest live candle for the supplied parameters
+func GetLiveCandle(exchange string, p currency.Pair, a asset.Item, i Interval) (*Live, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+	c, ok := service.Candles[exchange][p.Base.Item][p.Quote.Item][a][i]
+	if !ok {
+		return nil, fmt.Errorf("no live candle for %s %s %s %s",
+			exchange,
+			p,
+			a,
+			i)
+	}
+	l := c.Live
+	return &l, nil
+}
+
+// ProcessLive processes an incoming live candle, storing it and publishing it
+// to any subscribers
+func ProcessLive(l *Live) error {
+	if l == nil {
+		return errors.New(errLiveCandleIsNil)
+	}
+
+	if l.Exchange == "" {
+		return errors.New(errExchangeNameUnset)
+	}
+
+	if l.Pair.IsEmpty() {
+		return fmt.Errorf("%s %s", l.Exchange, errPairNotSet)
+	}
+
+	if l.Asset == "" {
+		return fmt.Errorf("%s %s %s", l.Exchange, l.Pair, errAssetTypeNotSet)
+	}
+
+	if l.Interval <= 0 {
+		return fmt.Errorf("%s %s %s %s",
+			l.Exchange,
+			l.Pair,
+			l.Asset,
+			errIntervalNotSet)
+	}
+
+	return service.Update(l)
+}
+
+// Update updates the stored live candle and publishes the change
+func (s *Service) Update(l *Live) error {
+	name := strings.ToLower(l.Exchange)
+	s.Lock()
+
+	c, ok := s.Candles[name][l.Pair.Base.Item][l.Pair.Quote.Item][l.Asset][l.Interval]
+	if !ok {
+		switch {
+		case s.Candles[name] == nil:
+			s.Candles[name] = make(map[*currency.Item]map[*currency.Item]map[asset.Item]map[Interval]*liveCandle)
+			fallthrough
+		case s.Candles[name][l.Pair.Base.Item] == nil:
+			s.Candles[name][l.Pair.Base.Item] = make(map[*currency.Item]map[asset.Item]map[Interval]*liveCandle)
+			fallthrough
+		case s.Candles[name][l.Pair.Base.Item][l.Pair.Quote.Item] == nil:
+			s.Candles[name][l.Pair.Base.Item][l.Pair.Quote.Item] = make(map[asset.Item]map[Interval]*liveCandle)
+			fallthrough
+		case s.Candles[name][l.Pair.Base.Item][l.Pair.Quote.Item][l.Asset] == nil:
+			s.Candles[name][l.Pair.Base.Item][l.Pair.Quote.Item][l.Asset] = make(map[Interval]*liveCandle)
+		}
+
+		var err error
+		c, err = s.newLiveCandle(name)
+		if err != nil {
+			s.Unlock()
+			return err
+		}
+		s.Candles[name][l.Pair.Base.Item][l.Pair.Quote.Item][l.Asset][l.Interval] = c
+	}
+
+	c.Live = *l
+	ids := append(c.Assoc, c.Main)
+	s.Unlock()
+	return s.mux.Publish(ids, l)
+}
+
+// newLiveCandle retrieves and sets dispatch mux publish IDs for a new live
+// candle
+func (s *Service) newLiveCandle(fmtName string) (*liveCandle, error) {
+	exchangeID, ok := s.Exchange[fmtName]
+	if !ok {
+		var err error
+		exchangeID, err = s.mux.GetID()
+		if err != nil {
+			return nil, err
+		}
+		s.Exchange[fmtName] = exchangeID
+	}
+
+	singleID, err := s.mux.GetID()
+	if err != nil {
+		return nil, err
+	}
+	return &liveCandle{Main: singleID, Assoc: []uuid.UUID{exchangeID}}, nil
+}
+
+// NewBuilder returns a builder which aggregates trades into candles for the
+// supplied interval
+func NewBuilder(exchange string, p currency.Pair, a asset.Item, i Interval) (*Builder, error) {
+	if exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+	if p.IsEmpty() {
+		return nil, errors.New(errPairNotSet)
+	}
+	if a == "" {
+		return nil, errors.New(errAssetTypeNotSet)
+	}
+	if i <= 0 {
+		return nil, errors.New(errIntervalNotSet)
+	}
+	return &Builder{
+		exchange: exchange,
+		pair:     p,
+		asset:    a,
+		interval: i,
+	}, nil
+}
+
+// AddTrade folds a trade into the candle for its period. Any candles whose
+// period has elapsed are returned closed ahead of the in-progress candle.
+// Periods without price action are carried forward from the previous close
+// in the same manner as CreateKline
+func (b *Builder) AddTrade(price, amount float64, ts time.Time) ([]Live, error) {
+	if ts.IsZero() || ts.Unix() == 0 {
+		return nil, errors.New("trade timestamp not set")
+	}
+	if price == 0 {
+		return nil, errors.New("trade price not set")
+	}
+	if amount == 0 {
+		return nil, errors.New("trade amount not set")
+	}
+
+	start := ts.Truncate(b.interval.Duration())
+	var candles []Live
+	if b.current != nil {
+		if start.Before(b.current.Time) {
+			return nil, fmt.Errorf("trade at %v precedes current candle period %v",
+				ts,
+				b.current.Time)
+		}
+		candles = b.closeUntil(start)
+	} else {
+		b.current = &Candle{Time: start}
+	}
+
+	b.current.update(price, amount)
+	return append(candles, b.live(false)), nil
+}
+
+// Close closes any candles whose period has elapsed by the supplied time
+func (b *Builder) Close(t time.Time) []Live {
+	if b.current == nil {
+		return nil
+	}
+	return b.closeUntil(t.Truncate(b.interval.Duration()))
+}
+
+// Current returns the in-progress candle, if any
+func (b *Builder) Current() (Live, bool) {
+	if b.current == nil {
+		return Live{}, false
+	}
+	return b.live(false), true
+}
+
+// closeUntil closes the current candle and any empty periods before start
+func (b *Builder) closeUntil(start time.Time) []Live {
+	var candles []Live
+	for b.current.Time.Before(start) {
+		candles = append(candles, b.live(true))
+		last := b.current.Close
+		b.current = &Candle{
+			Time:  b.current.Time.Add(b.interval.Duration()),
+			Open:  last,
+			High:  last,
+			Low:   last,
+			Close: last,
+		}
+	}
+	return candles
+}
+
+func (b *Builder) live(closed bool) Live {
+	return Live{
+		Exchange: b.exchange,
+		Pair:     b.pair,
+		Asset:    b.asset,
+		Interval: b.interval,
+		Candle:   *b.current,
+		Closed:   closed,
+	}
+}
@@ -1,17 +1,28 @@
 package kline
 
 import (
+	"log"
 	"math/rand"
+	"os"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/yurulab/gocryptotrader/common/crypto"
 	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/dispatch"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
 )
 
+func TestMain(m *testing.M) {
+	err := dispatch.Start(1, dispatch.DefaultJobsLimit)
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
 func TestValidateData(t *testing.T) {
 	err := validateData(nil)
 	if err == nil {
@@ -416,3 +427,246 @@ func TestItem_SortCandlesByTimestamp(t *testing.T) {
 		t.Fatal("expected kline.Candles to be in ascending order")
 	}
 }
+
+func TestProcessLive(t *testing.T) {
+	err := ProcessLive(nil)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	l := &Live{}
+	err = ProcessLive(l)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	l.Exchange = "livetest"
+	err = ProcessLive(l)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	l.Pair = currency.NewPair(currency.BTC, currency.USD)
+	err = ProcessLive(l)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	l.Asset = asset.Spot
+	err = ProcessLive(l)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	l.Interval = OneMin
+	l.Candle = Candle{Time: time.Now().Truncate(time.Minute), Open: 1, High: 2, Low: 1, Close: 2, Volume: 3}
+	err = ProcessLive(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = GetLiveCandle("livetest", l.Pair, asset.Spot, OneHour)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	c, err := GetLiveCandle("LiveTest", l.Pair, asset.Spot, OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Candle.Close != 2 || c.Closed {
+		t.Errorf("unexpected live candle %+v", c)
+	}
+}
+
+func TestSubscribeKline(t *testing.T) {
+	p := currency.NewPair(currency.BTC, currency.USD)
+	_, err := SubscribeKline("subscribetest", p, asset.Spot, OneMin)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	_, err = SubscribeToExchangeKlines("subscribetest")
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	err = ProcessLive(&Live{
+		Exchange: "subscribetest",
+		Pair:     p,
+		Asset:    asset.Spot,
+		Interval: OneMin,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pipe, err := SubscribeKline("subscribetest", p, asset.Spot, OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exchPipe, err := SubscribeToExchangeKlines("subscribetest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := make(chan struct{})
+	go func() {
+		var pairReceived, exchReceived bool
+		for !pairReceived || !exchReceived {
+			select {
+			case data := <-pipe.C:
+				l := (*data.(*interface{})).(Live)
+				pairReceived = pairReceived || (l.Candle.Close == 1337 && l.Closed)
+			case data := <-exchPipe.C:
+				l := (*data.(*interface{})).(Live)
+				exchReceived = exchReceived || (l.Candle.Close == 1337 && l.Closed)
+			}
+		}
+		close(received)
+	}()
+
+	// Dispatch drops updates for routines that are not ready to receive so
+	// keep publishing until both pipes have been serviced
+	timeout := time.After(time.Second)
+	for {
+		err = ProcessLive(&Live{
+			Exchange: "subscribetest",
+			Pair:     p,
+			Asset:    asset.Spot,
+			Interval: OneMin,
+			Candle:   Candle{Close: 1337},
+			Closed:   true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case <-received:
+		case <-timeout:
+			t.Fatal("timed out waiting for live candle")
+		case <-time.After(time.Millisecond * 10):
+			continue
+		}
+		break
+	}
+
+	err = pipe.Release()
+	if err != nil {
+		t.Error(err)
+	}
+	err = exchPipe.Release()
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBuilder(t *testing.T) {
+	p := currency.NewPair(currency.BTC, currency.USD)
+	_, err := NewBuilder("", p, asset.Spot, OneMin)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+	_, err = NewBuilder("test", currency.Pair{}, asset.Spot, OneMin)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+	_, err = NewBuilder("test", p, "", OneMin)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+	_, err = NewBuilder("test", p, asset.Spot, 0)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	b, err := NewBuilder("test", p, asset.Spot, OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := b.Current(); ok {
+		t.Error("builder should not have a current candle")
+	}
+	if closed := b.Close(time.Now()); closed != nil {
+		t.Error("expected no closed candles")
+	}
+
+	start := time.Date(2020, 7, 8, 10, 0, 0, 0, time.UTC)
+	_, err = b.AddTrade(0, 1, start)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+	_, err = b.AddTrade(1, 0, start)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+	_, err = b.AddTrade(1, 1, time.Time{})
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	trades := []struct {
+		price, amount float64
+		offset        time.Duration
+	}{
+		{100, 1, 5 * time.Second},
+		{105, 2, 10 * time.Second},
+		{95, 1, 20 * time.Second},
+		{101, 1, 59 * time.Second},
+	}
+	for i := range trades {
+		var candles []Live
+		candles, err = b.AddTrade(trades[i].price, trades[i].amount, start.Add(trades[i].offset))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(candles) != 1 || candles[0].Closed {
+			t.Fatalf("expected a single in-progress candle, received %+v", candles)
+		}
+	}
+
+	c, ok := b.Current()
+	if !ok {
+		t.Fatal("builder should have a current candle")
+	}
+	expected := Candle{Time: start, Open: 100, High: 105, Low: 95, Close: 101, Volume: 5}
+	if c.Candle != expected {
+		t.Errorf("expected %+v, received %+v", expected, c.Candle)
+	}
+
+	// Trade three periods on should close the first candle and carry forward
+	// an empty candle for the period without price action
+	candles, err := b.AddTrade(110, 1, start.Add(2*time.Minute+time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles) != 3 {
+		t.Fatalf("expected 3 candles, received %d", len(candles))
+	}
+	if !candles[0].Closed || candles[0].Candle != expected {
+		t.Errorf("unexpected closed candle %+v", candles[0])
+	}
+	empty := Candle{Time: start.Add(time.Minute), Open: 101, High: 101, Low: 101, Close: 101}
+	if !candles[1].Closed || candles[1].Candle != empty {
+		t.Errorf("unexpected empty candle %+v", candles[1])
+	}
+	if candles[2].Closed || candles[2].Candle.Open != 110 || candles[2].Candle.Low != 110 {
+		t.Errorf("unexpected in-progress candle %+v", candles[2])
+	}
+
+	_, err = b.AddTrade(100, 1, start)
+	if err == nil {
+		t.Error("expected error for trade preceding current candle")
+	}
+
+	closed := b.Close(start.Add(3 * time.Minute))
+	if len(closed) != 1 || !closed[0].Closed || closed[0].Candle.Close != 110 {
+		t.Errorf("unexpected closed candles %+v", closed)
+	}
+	c, ok = b.Current()
+	if !ok || c.Candle.Volume != 0 || c.Candle.Open != 110 {
+		t.Errorf("unexpected carried forward candle %+v", c)
+	}
+}
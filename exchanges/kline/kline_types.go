@@ -2,9 +2,12 @@ package kline
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/dispatch"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
 )
 
@@ -38,6 +41,17 @@ const (
 	ErrUnsupportedInterval = "%s interval unsupported by exchange"
 	// ErrRequestExceedsExchangeLimits locale for exceeding rate limits message
 	ErrRequestExceedsExchangeLimits = "requested data would exceed exchange limits please lower range or use GetHistoricCandlesEx"
+
+	errExchangeNameUnset = "live candle exchange name not set"
+	errPairNotSet        = "live candle currency pair not set"
+	errAssetTypeNotSet   = "live candle asset type not set"
+	errIntervalNotSet    = "live candle interval not set"
+	errLiveCandleIsNil   = "live candle is nil"
+)
+
+// Vars for the kline package
+var (
+	service *Service
 )
 
 // Item holds all the relevant information for internal kline elements
@@ -94,3 +108,40 @@ type DateRange struct {
 	Start time.Time
 	End   time.Time
 }
+
+// Service holds the latest live candle for each exchange, pair, asset and
+// interval and the dispatch IDs used to publish them
+type Service struct {
+	Candles  map[string]map[*currency.Item]map[*currency.Item]map[asset.Item]map[Interval]*liveCandle
+	Exchange map[string]uuid.UUID
+	mux      *dispatch.Mux
+	sync.RWMutex
+}
+
+// Live holds a candle that is being built from streamed data. Closed is set
+// once the candle period has elapsed and the candle will no longer change
+type Live struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Interval Interval
+	Candle   Candle
+	Closed   bool
+}
+
+// liveCandle links a live candle with its dispatch IDs
+type liveCandle struct {
+	Live
+	Main  uuid.UUID
+	Assoc []uuid.UUID
+}
+
+// Builder aggregates trades into candles for a single exchange, pair, asset
+// and interval
+type Builder struct {
+	exchange string
+	pair     currency.Pair
+	asset    asset.Item
+	interval Interval
+	current  *Candle
+}
@@ -67,7 +67,7 @@ type Kraken struct {
 }
 
 // GetServerTime returns current server time
-func (k *Kraken) GetServerTime() (TimeResponse, error) {
+func (k *Kraken) GetServerTime(ctx context.Context) (TimeResponse, error) {
 	path := fmt.Sprintf("%s/%s/public/%s", k.API.Endpoints.URL, krakenAPIVersion, krakenServerTime)
 
 	var response struct {
@@ -75,7 +75,7 @@ func (k *Kraken) GetServerTime() (TimeResponse, error) {
 		Result TimeResponse `json:"result"`
 	}
 
-	if err := k.SendHTTPRequest(path, &response); err != nil {
+	if err := k.SendHTTPRequest(ctx, path, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -84,8 +84,8 @@ func (k *Kraken) GetServerTime() (TimeResponse, error) {
 
 // SeedAssets seeds Kraken's asset list and stores it in the
 // asset translator
-func (k *Kraken) SeedAssets() error {
-	assets, err := k.GetAssets()
+func (k *Kraken) SeedAssets(ctx context.Context) error {
+	assets, err := k.GetAssets(ctx)
 	if err != nil {
 		return err
 	}
@@ -93,7 +93,7 @@ func (k *Kraken) SeedAssets() error {
 		assetTranslator.Seed(k, v.Altname)
 	}
 
-	assetPairs, err := k.GetAssetPairs()
+	assetPairs, err := k.GetAssetPairs(ctx)
 	if err != nil {
 		return err
 	}
@@ -104,7 +104,7 @@ func (k *Kraken) SeedAssets() error {
 }
 
 // GetAssets returns a full asset list
-func (k *Kraken) GetAssets() (map[string]*Asset, error) {
+func (k *Kraken) GetAssets(ctx context.Context) (map[string]*Asset, error) {
 	path := fmt.Sprintf("%s/%s/public/%s", k.API.Endpoints.URL, krakenAPIVersion, krakenAssets)
 
 	var response struct {
@@ -112,14 +112,14 @@ func (k *Kraken) GetAssets() (map[string]*Asset, error) {
 		Result map[string]*Asset `json:"result"`
 	}
 
-	if err := k.SendHTTPRequest(path, &response); err != nil {
+	if err := k.SendHTTPRequest(ctx, path, &response); err != nil {
 		return response.Result, err
 	}
 	return response.Result, GetError(response.Error)
 }
 
 // GetAssetPairs returns a full asset pair list
-func (k *Kraken) GetAssetPairs() (map[string]*AssetPairs, error) {
+func (k *Kraken) GetAssetPairs(ctx context.Context) (map[string]*AssetPairs, error) {
 	path := fmt.Sprintf("%s/%s/public/%s", k.API.Endpoints.URL, krakenAPIVersion, krakenAssetPairs)
 
 	var response struct {
@@ -127,14 +127,14 @@ func (k *Kraken) GetAssetPairs() (map[string]*AssetPairs, error) {
 		Result map[string]*AssetPairs `json:"result"`
 	}
 
-	if err := k.SendHTTPRequest(path, &response); err != nil {
+	if err := k.SendHTTPRequest(ctx, path, &response); err != nil {
 		return response.Result, err
 	}
 	return response.Result, GetError(response.Error)
 }
 
 // GetTicker returns ticker information from kraken
-func (k *Kraken) GetTicker(symbol string) (Ticker, error) {
+func (k *Kraken) GetTicker(ctx context.Context, symbol string) (Ticker, error) {
 	tick := Ticker{}
 	values := url.Values{}
 	values.Set("pair", symbol)
@@ -147,7 +147,7 @@ func (k *Kraken) GetTicker(symbol string) (Ticker, error) {
 	resp := Response{}
 	path := fmt.Sprintf("%s/%s/public/%s?%s", k.API.Endpoints.URL, krakenAPIVersion, krakenTicker, values.Encode())
 
-	err := k.SendHTTPRequest(path, &resp)
+	err := k.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return tick, err
 	}
@@ -173,7 +173,7 @@ func (k *Kraken) GetTicker(symbol string) (Ticker, error) {
 // GetTickers supports fetching multiple tickers from Kraken
 // pairList must be in the format pairs separated by commas
 // ("LTCUSD,ETCUSD")
-func (k *Kraken) GetTickers(pairList string) (map[string]Ticker, error) {
+func (k *Kraken) GetTickers(ctx context.Context, pairList string) (map[string]Ticker, error) {
 	values := url.Values{}
 	values.Set("pair", pairList)
 
@@ -185,7 +185,7 @@ func (k *Kraken) GetTickers(pairList string) (map[string]Ticker, error) {
 	resp := Response{}
 	path := fmt.Sprintf("%s/%s/public/%s?%s", k.API.Endpoints.URL, krakenAPIVersion, krakenTicker, values.Encode())
 
-	err := k.SendHTTPRequest(path, &resp)
+	err := k.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -213,7 +213,7 @@ func (k *Kraken) GetTickers(pairList string) (map[string]Ticker, error) {
 }
 
 // GetOHLC returns an array of open high low close values of a currency pair
-func (k *Kraken) GetOHLC(symbol, interval string) ([]OpenHighLowClose, error) {
+func (k *Kraken) GetOHLC(ctx context.Context, symbol, interval string) ([]OpenHighLowClose, error) {
 	values := url.Values{}
 	values.Set("pair", symbol)
 	values.Set("interval", interval)
@@ -227,7 +227,7 @@ func (k *Kraken) GetOHLC(symbol, interval string) ([]OpenHighLowClose, error) {
 
 	path := fmt.Sprintf("%s/%s/public/%s?%s", k.API.Endpoints.URL, krakenAPIVersion, krakenOHLC, values.Encode())
 
-	err := k.SendHTTPRequest(path, &result)
+	err := k.SendHTTPRequest(ctx, path, &result)
 	if err != nil {
 		return OHLC, err
 	}
@@ -269,7 +269,7 @@ func (k *Kraken) GetOHLC(symbol, interval string) ([]OpenHighLowClose, error) {
 }
 
 // GetDepth returns the orderbook for a particular currency
-func (k *Kraken) GetDepth(symbol string) (Orderbook, error) {
+func (k *Kraken) GetDepth(ctx context.Context, symbol string) (Orderbook, error) {
 	values := url.Values{}
 	values.Set("pair", symbol)
 
@@ -277,7 +277,7 @@ func (k *Kraken) GetDepth(symbol string) (Orderbook, error) {
 	var orderBook Orderbook
 
 	path := fmt.Sprintf("%s/%s/public/%s?%s", k.API.Endpoints.URL, krakenAPIVersion, krakenDepth, values.Encode())
-	err := k.SendHTTPRequest(path, &result)
+	err := k.SendHTTPRequest(ctx, path, &result)
 	if err != nil {
 		return orderBook, err
 	}
@@ -330,7 +330,7 @@ func (k *Kraken) GetDepth(symbol string) (Orderbook, error) {
 }
 
 // GetTrades returns current trades on Kraken
-func (k *Kraken) GetTrades(symbol string) ([]RecentTrades, error) {
+func (k *Kraken) GetTrades(ctx context.Context, symbol string) ([]RecentTrades, error) {
 	values := url.Values{}
 	values.Set("pair", symbol)
 
@@ -339,7 +339,7 @@ func (k *Kraken) GetTrades(symbol string) ([]RecentTrades, error) {
 
 	path := fmt.Sprintf("%s/%s/public/%s?%s", k.API.Endpoints.URL, krakenAPIVersion, krakenTrades, values.Encode())
 
-	err := k.SendHTTPRequest(path, &result)
+	err := k.SendHTTPRequest(ctx, path, &result)
 	if err != nil {
 		return recentTrades, err
 	}
@@ -371,7 +371,7 @@ func (k *Kraken) GetTrades(symbol string) ([]RecentTrades, error) {
 }
 
 // GetSpread returns the full spread on Kraken
-func (k *Kraken) GetSpread(symbol string) ([]Spread, error) {
+func (k *Kraken) GetSpread(ctx context.Context, symbol string) ([]Spread, error) {
 	values := url.Values{}
 	values.Set("pair", symbol)
 
@@ -380,7 +380,7 @@ func (k *Kraken) GetSpread(symbol string) ([]Spread, error) {
 
 	path := fmt.Sprintf("%s/%s/public/%s?%s", k.API.Endpoints.URL, krakenAPIVersion, krakenSpread, values.Encode())
 
-	err := k.SendHTTPRequest(path, &response)
+	err := k.SendHTTPRequest(ctx, path, &response)
 	if err != nil {
 		return peanutButter, err
 	}
@@ -406,13 +406,13 @@ func (k *Kraken) GetSpread(symbol string) ([]Spread, error) {
 }
 
 // GetBalance returns your balance associated with your keys
-func (k *Kraken) GetBalance() (map[string]float64, error) {
+func (k *Kraken) GetBalance(ctx context.Context) (map[string]float64, error) {
 	var response struct {
 		Error  []string          `json:"error"`
 		Result map[string]string `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenBalance, url.Values{}, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenBalance, url.Values{}, &response); err != nil {
 		return nil, err
 	}
 
@@ -428,7 +428,7 @@ func (k *Kraken) GetBalance() (map[string]float64, error) {
 }
 
 // GetWithdrawInfo gets withdrawal fees
-func (k *Kraken) GetWithdrawInfo(currency string, amount float64) (WithdrawInformation, error) {
+func (k *Kraken) GetWithdrawInfo(ctx context.Context, currency string, amount float64) (WithdrawInformation, error) {
 	var response struct {
 		Error  []string            `json:"error"`
 		Result WithdrawInformation `json:"result"`
@@ -438,7 +438,7 @@ func (k *Kraken) GetWithdrawInfo(currency string, amount float64) (WithdrawInfor
 	params.Set("key  ", "")
 	params.Set("amount ", fmt.Sprintf("%f", amount))
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenWithdrawInfo, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenWithdrawInfo, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -446,7 +446,7 @@ func (k *Kraken) GetWithdrawInfo(currency string, amount float64) (WithdrawInfor
 }
 
 // Withdraw withdraws funds
-func (k *Kraken) Withdraw(asset, key string, amount float64) (string, error) {
+func (k *Kraken) Withdraw(ctx context.Context, asset, key string, amount float64) (string, error) {
 	var response struct {
 		Error       []string `json:"error"`
 		ReferenceID string   `json:"refid"`
@@ -456,7 +456,7 @@ func (k *Kraken) Withdraw(asset, key string, amount float64) (string, error) {
 	params.Set("key", key)
 	params.Set("amount", fmt.Sprintf("%f", amount))
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenWithdraw, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenWithdraw, params, &response); err != nil {
 		return response.ReferenceID, err
 	}
 
@@ -464,7 +464,7 @@ func (k *Kraken) Withdraw(asset, key string, amount float64) (string, error) {
 }
 
 // GetDepositMethods gets withdrawal fees
-func (k *Kraken) GetDepositMethods(currency string) ([]DepositMethods, error) {
+func (k *Kraken) GetDepositMethods(ctx context.Context, currency string) ([]DepositMethods, error) {
 	var response struct {
 		Error  []string         `json:"error"`
 		Result []DepositMethods `json:"result"`
@@ -472,7 +472,7 @@ func (k *Kraken) GetDepositMethods(currency string) ([]DepositMethods, error) {
 	params := url.Values{}
 	params.Set("asset", currency)
 
-	err := k.SendAuthenticatedHTTPRequest(krakenDepositMethods, params, &response)
+	err := k.SendAuthenticatedHTTPRequest(ctx, krakenDepositMethods, params, &response)
 	if err != nil {
 		return response.Result, err
 	}
@@ -481,7 +481,7 @@ func (k *Kraken) GetDepositMethods(currency string) ([]DepositMethods, error) {
 }
 
 // GetTradeBalance returns full information about your trades on Kraken
-func (k *Kraken) GetTradeBalance(args ...TradeBalanceOptions) (TradeBalanceInfo, error) {
+func (k *Kraken) GetTradeBalance(ctx context.Context, args ...TradeBalanceOptions) (TradeBalanceInfo, error) {
 	params := url.Values{}
 
 	if args != nil {
@@ -499,7 +499,7 @@ func (k *Kraken) GetTradeBalance(args ...TradeBalanceOptions) (TradeBalanceInfo,
 		Result TradeBalanceInfo `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenTradeBalance, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenTradeBalance, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -507,7 +507,7 @@ func (k *Kraken) GetTradeBalance(args ...TradeBalanceOptions) (TradeBalanceInfo,
 }
 
 // GetOpenOrders returns all current open orders
-func (k *Kraken) GetOpenOrders(args OrderInfoOptions) (OpenOrders, error) {
+func (k *Kraken) GetOpenOrders(ctx context.Context, args OrderInfoOptions) (OpenOrders, error) {
 	params := url.Values{}
 
 	if args.Trades {
@@ -523,7 +523,7 @@ func (k *Kraken) GetOpenOrders(args OrderInfoOptions) (OpenOrders, error) {
 		Result OpenOrders `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenOpenOrders, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenOpenOrders, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -531,7 +531,7 @@ func (k *Kraken) GetOpenOrders(args OrderInfoOptions) (OpenOrders, error) {
 }
 
 // GetClosedOrders returns a list of closed orders
-func (k *Kraken) GetClosedOrders(args GetClosedOrdersOptions) (ClosedOrders, error) {
+func (k *Kraken) GetClosedOrders(ctx context.Context, args GetClosedOrdersOptions) (ClosedOrders, error) {
 	params := url.Values{}
 
 	if args.Trades {
@@ -563,7 +563,7 @@ func (k *Kraken) GetClosedOrders(args GetClosedOrdersOptions) (ClosedOrders, err
 		Result ClosedOrders `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenClosedOrders, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenClosedOrders, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -571,7 +571,7 @@ func (k *Kraken) GetClosedOrders(args GetClosedOrdersOptions) (ClosedOrders, err
 }
 
 // QueryOrdersInfo returns order information
-func (k *Kraken) QueryOrdersInfo(args OrderInfoOptions, txid string, txids ...string) (map[string]OrderInfo, error) {
+func (k *Kraken) QueryOrdersInfo(ctx context.Context, args OrderInfoOptions, txid string, txids ...string) (map[string]OrderInfo, error) {
 	params := url.Values{
 		"txid": {txid},
 	}
@@ -593,7 +593,7 @@ func (k *Kraken) QueryOrdersInfo(args OrderInfoOptions, txid string, txids ...st
 		Result map[string]OrderInfo `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenQueryOrders, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenQueryOrders, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -601,7 +601,7 @@ func (k *Kraken) QueryOrdersInfo(args OrderInfoOptions, txid string, txids ...st
 }
 
 // GetTradesHistory returns trade history information
-func (k *Kraken) GetTradesHistory(args ...GetTradesHistoryOptions) (TradesHistory, error) {
+func (k *Kraken) GetTradesHistory(ctx context.Context, args ...GetTradesHistoryOptions) (TradesHistory, error) {
 	params := url.Values{}
 
 	if args != nil {
@@ -631,7 +631,7 @@ func (k *Kraken) GetTradesHistory(args ...GetTradesHistoryOptions) (TradesHistor
 		Result TradesHistory `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenTradeHistory, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenTradeHistory, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -639,7 +639,7 @@ func (k *Kraken) GetTradesHistory(args ...GetTradesHistoryOptions) (TradesHistor
 }
 
 // QueryTrades returns information on a specific trade
-func (k *Kraken) QueryTrades(trades bool, txid string, txids ...string) (map[string]TradeInfo, error) {
+func (k *Kraken) QueryTrades(ctx context.Context, trades bool, txid string, txids ...string) (map[string]TradeInfo, error) {
 	params := url.Values{
 		"txid": {txid},
 	}
@@ -657,7 +657,7 @@ func (k *Kraken) QueryTrades(trades bool, txid string, txids ...string) (map[str
 		Result map[string]TradeInfo `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenQueryTrades, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenQueryTrades, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -665,7 +665,7 @@ func (k *Kraken) QueryTrades(trades bool, txid string, txids ...string) (map[str
 }
 
 // OpenPositions returns current open positions
-func (k *Kraken) OpenPositions(docalcs bool, txids ...string) (map[string]Position, error) {
+func (k *Kraken) OpenPositions(ctx context.Context, docalcs bool, txids ...string) (map[string]Position, error) {
 	params := url.Values{}
 
 	if txids != nil {
@@ -681,7 +681,7 @@ func (k *Kraken) OpenPositions(docalcs bool, txids ...string) (map[string]Positi
 		Result map[string]Position `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenOpenPositions, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenOpenPositions, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -689,7 +689,7 @@ func (k *Kraken) OpenPositions(docalcs bool, txids ...string) (map[string]Positi
 }
 
 // GetLedgers returns current ledgers
-func (k *Kraken) GetLedgers(args ...GetLedgersOptions) (Ledgers, error) {
+func (k *Kraken) GetLedgers(ctx context.Context, args ...GetLedgersOptions) (Ledgers, error) {
 	params := url.Values{}
 
 	if args != nil {
@@ -723,7 +723,7 @@ func (k *Kraken) GetLedgers(args ...GetLedgersOptions) (Ledgers, error) {
 		Result Ledgers  `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenLedgers, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenLedgers, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -731,7 +731,7 @@ func (k *Kraken) GetLedgers(args ...GetLedgersOptions) (Ledgers, error) {
 }
 
 // QueryLedgers queries an individual ledger by ID
-func (k *Kraken) QueryLedgers(id string, ids ...string) (map[string]LedgerInfo, error) {
+func (k *Kraken) QueryLedgers(ctx context.Context, id string, ids ...string) (map[string]LedgerInfo, error) {
 	params := url.Values{
 		"id": {id},
 	}
@@ -745,7 +745,7 @@ func (k *Kraken) QueryLedgers(id string, ids ...string) (map[string]LedgerInfo,
 		Result map[string]LedgerInfo `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenQueryLedgers, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenQueryLedgers, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -753,7 +753,7 @@ func (k *Kraken) QueryLedgers(id string, ids ...string) (map[string]LedgerInfo,
 }
 
 // GetTradeVolume returns your trade volume by currency
-func (k *Kraken) GetTradeVolume(feeinfo bool, symbol ...string) (TradeVolumeResponse, error) {
+func (k *Kraken) GetTradeVolume(ctx context.Context, feeinfo bool, symbol ...string) (TradeVolumeResponse, error) {
 	params := url.Values{}
 
 	if symbol != nil {
@@ -769,7 +769,7 @@ func (k *Kraken) GetTradeVolume(feeinfo bool, symbol ...string) (TradeVolumeResp
 		Result TradeVolumeResponse `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenTradeVolume, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenTradeVolume, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -777,7 +777,7 @@ func (k *Kraken) GetTradeVolume(feeinfo bool, symbol ...string) (TradeVolumeResp
 }
 
 // AddOrder adds a new order for Kraken exchange
-func (k *Kraken) AddOrder(symbol, side, orderType string, volume, price, price2, leverage float64, args *AddOrderOptions) (AddOrderResponse, error) {
+func (k *Kraken) AddOrder(ctx context.Context, symbol, side, orderType string, volume, price, price2, leverage float64, args *AddOrderOptions) (AddOrderResponse, error) {
 	params := url.Values{
 		"pair":      {symbol},
 		"type":      {strings.ToLower(side)},
@@ -830,7 +830,7 @@ func (k *Kraken) AddOrder(symbol, side, orderType string, volume, price, price2,
 		Result AddOrderResponse `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenOrderPlace, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenOrderPlace, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -838,7 +838,7 @@ func (k *Kraken) AddOrder(symbol, side, orderType string, volume, price, price2,
 }
 
 // CancelExistingOrder cancels order by orderID
-func (k *Kraken) CancelExistingOrder(txid string) (CancelOrderResponse, error) {
+func (k *Kraken) CancelExistingOrder(ctx context.Context, txid string) (CancelOrderResponse, error) {
 	values := url.Values{
 		"txid": {txid},
 	}
@@ -848,7 +848,7 @@ func (k *Kraken) CancelExistingOrder(txid string) (CancelOrderResponse, error) {
 		Result CancelOrderResponse `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenOrderCancel, values, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenOrderCancel, values, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -875,8 +875,8 @@ func GetError(apiErrors []string) error {
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP requests
-func (k *Kraken) SendHTTPRequest(path string, result interface{}) error {
-	return k.SendPayload(context.Background(), &request.Item{
+func (k *Kraken) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return k.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -887,7 +887,7 @@ func (k *Kraken) SendHTTPRequest(path string, result interface{}) error {
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated HTTP request
-func (k *Kraken) SendAuthenticatedHTTPRequest(method string, params url.Values, result interface{}) (err error) {
+func (k *Kraken) SendAuthenticatedHTTPRequest(ctx context.Context, method string, params url.Values, result interface{}) (err error) {
 	if !k.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet,
 			k.Name)
@@ -912,7 +912,7 @@ func (k *Kraken) SendAuthenticatedHTTPRequest(method string, params url.Values,
 	headers["API-Key"] = k.API.Credentials.Key
 	headers["API-Sign"] = signature
 
-	return k.SendPayload(context.Background(), &request.Item{
+	return k.SendPayload(ctx, &request.Item{
 		Method:        http.MethodPost,
 		Path:          k.API.Endpoints.URL + path,
 		Headers:       headers,
@@ -927,7 +927,7 @@ func (k *Kraken) SendAuthenticatedHTTPRequest(method string, params url.Values,
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (k *Kraken) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (k *Kraken) GetFee(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
 	c := feeBuilder.Pair.Base.String() +
 		feeBuilder.Pair.Delimiter +
@@ -935,7 +935,7 @@ func (k *Kraken) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		feePair, err := k.GetTradeVolume(true, c)
+		feePair, err := k.GetTradeVolume(ctx, true, c)
 		if err != nil {
 			return 0, err
 		}
@@ -953,7 +953,7 @@ func (k *Kraken) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
 	case exchange.CryptocurrencyWithdrawalFee:
 		fee = getWithdrawalFee(feeBuilder.Pair.Base)
 	case exchange.InternationalBankDepositFee:
-		depositMethods, err := k.GetDepositMethods(feeBuilder.FiatCurrency.String())
+		depositMethods, err := k.GetDepositMethods(ctx, feeBuilder.FiatCurrency.String())
 		if err != nil {
 			return 0, err
 		}
@@ -999,7 +999,7 @@ func calculateTradingFee(currency string, feePair map[string]TradeVolumeFee, pur
 }
 
 // GetCryptoDepositAddress returns a deposit address for a cryptocurrency
-func (k *Kraken) GetCryptoDepositAddress(method, code string) (string, error) {
+func (k *Kraken) GetCryptoDepositAddress(ctx context.Context, method, code string) (string, error) {
 	var resp = struct {
 		Error  []string         `json:"error"`
 		Result []DepositAddress `json:"result"`
@@ -1009,7 +1009,7 @@ func (k *Kraken) GetCryptoDepositAddress(method, code string) (string, error) {
 	values.Set("asset", code)
 	values.Set("method", method)
 
-	err := k.SendAuthenticatedHTTPRequest(krakenDepositAddresses, values, &resp)
+	err := k.SendAuthenticatedHTTPRequest(ctx, krakenDepositAddresses, values, &resp)
 	if err != nil {
 		return "", err
 	}
@@ -1022,7 +1022,7 @@ func (k *Kraken) GetCryptoDepositAddress(method, code string) (string, error) {
 }
 
 // WithdrawStatus gets the status of recent withdrawals
-func (k *Kraken) WithdrawStatus(c currency.Code, method string) ([]WithdrawStatusResponse, error) {
+func (k *Kraken) WithdrawStatus(ctx context.Context, c currency.Code, method string) ([]WithdrawStatusResponse, error) {
 	var response struct {
 		Error  []string                 `json:"error"`
 		Result []WithdrawStatusResponse `json:"result"`
@@ -1034,7 +1034,7 @@ func (k *Kraken) WithdrawStatus(c currency.Code, method string) ([]WithdrawStatu
 		params.Set("method", method)
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenWithdrawStatus, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenWithdrawStatus, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -1042,7 +1042,7 @@ func (k *Kraken) WithdrawStatus(c currency.Code, method string) ([]WithdrawStatu
 }
 
 // WithdrawCancel sends a withdrawal cancelation request
-func (k *Kraken) WithdrawCancel(c currency.Code, refID string) (bool, error) {
+func (k *Kraken) WithdrawCancel(ctx context.Context, c currency.Code, refID string) (bool, error) {
 	var response struct {
 		Error  []string `json:"error"`
 		Result bool     `json:"result"`
@@ -1052,7 +1052,7 @@ func (k *Kraken) WithdrawCancel(c currency.Code, refID string) (bool, error) {
 	params.Set("asset ", c.String())
 	params.Set("refid", refID)
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenWithdrawCancel, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenWithdrawCancel, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -1060,9 +1060,9 @@ func (k *Kraken) WithdrawCancel(c currency.Code, refID string) (bool, error) {
 }
 
 // GetWebsocketToken returns a websocket token
-func (k *Kraken) GetWebsocketToken() (string, error) {
+func (k *Kraken) GetWebsocketToken(ctx context.Context) (string, error) {
 	var response WsTokenResponse
-	if err := k.SendAuthenticatedHTTPRequest(krakenWebsocketToken, url.Values{}, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenWebsocketToken, url.Values{}, &response); err != nil {
 		return "", err
 	}
 	if len(response.Error) > 0 {
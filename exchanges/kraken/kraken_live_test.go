@@ -0,0 +1,40 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package kraken
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	k.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal(err)
+	}
+	krakenConfig, err := cfg.GetExchangeConfig("Kraken")
+	if err != nil {
+		log.Fatal(err)
+	}
+	krakenConfig.API.AuthenticatedSupport = true
+	krakenConfig.API.Credentials.Key = apiKey
+	krakenConfig.API.Credentials.Secret = apiSecret
+	krakenConfig.API.Endpoints.WebsocketURL = k.API.Endpoints.WebsocketURL
+	k.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = k.Setup(krakenConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf(sharedtestvalues.LiveTesting, k.Name, k.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
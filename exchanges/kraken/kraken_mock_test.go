@@ -0,0 +1,58 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package kraken
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/kraken/kraken.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	k.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal(err)
+	}
+	krakenConfig, err := cfg.GetExchangeConfig("Kraken")
+	if err != nil {
+		log.Fatal(err)
+	}
+	krakenConfig.API.AuthenticatedSupport = true
+	krakenConfig.API.Credentials.Key = apiKey
+	krakenConfig.API.Credentials.Secret = apiSecret
+	krakenConfig.API.Endpoints.WebsocketURL = k.API.Endpoints.WebsocketURL
+	k.Websocket = sharedtestvalues.NewTestWebsocket()
+
+	// Setup seeds the asset translator over REST, so the mock server has to
+	// be in place beforehand
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+	k.HTTPClient = newClient
+	krakenConfig.API.Endpoints.URL = serverDetails
+
+	err = k.Setup(krakenConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf(sharedtestvalues.MockTesting, k.Name, k.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
@@ -1,23 +1,20 @@
 package kraken
 
 import (
-	"log"
+	"context"
 	"net/http"
-	"os"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/yurulab/gocryptotrader/common/convert"
-	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/core"
 	"github.com/yurulab/gocryptotrader/currency"
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
 	"github.com/yurulab/gocryptotrader/exchanges/kline"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
-	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/yurulab/gocryptotrader/exchanges/stream"
 	"github.com/yurulab/gocryptotrader/portfolio/withdraw"
 )
@@ -32,34 +29,10 @@ const (
 	canManipulateRealOrders = false
 )
 
-// TestSetup setup func
-func TestMain(m *testing.M) {
-	k.SetDefaults()
-	cfg := config.GetConfig()
-	err := cfg.LoadConfig("../../testdata/configtest.json", true)
-	if err != nil {
-		log.Fatal(err)
-	}
-	krakenConfig, err := cfg.GetExchangeConfig("Kraken")
-	if err != nil {
-		log.Fatal(err)
-	}
-	krakenConfig.API.AuthenticatedSupport = true
-	krakenConfig.API.Credentials.Key = apiKey
-	krakenConfig.API.Credentials.Secret = apiSecret
-	krakenConfig.API.Endpoints.WebsocketURL = k.API.Endpoints.WebsocketURL
-	k.Websocket = sharedtestvalues.NewTestWebsocket()
-	err = k.Setup(krakenConfig)
-	if err != nil {
-		log.Fatal(err)
-	}
-	os.Exit(m.Run())
-}
-
 // TestGetServerTime API endpoint test
 func TestGetServerTime(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetServerTime()
+	_, err := k.GetServerTime(context.Background())
 	if err != nil {
 		t.Error("GetServerTime() error", err)
 	}
@@ -68,7 +41,7 @@ func TestGetServerTime(t *testing.T) {
 // TestGetAssets API endpoint test
 func TestGetAssets(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetAssets()
+	_, err := k.GetAssets(context.Background())
 	if err != nil {
 		t.Error("GetAssets() error", err)
 	}
@@ -135,7 +108,7 @@ func TestLookupCurrency(t *testing.T) {
 // TestGetAssetPairs API endpoint test
 func TestGetAssetPairs(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetAssetPairs()
+	_, err := k.GetAssetPairs(context.Background())
 	if err != nil {
 		t.Error("GetAssetPairs() error", err)
 	}
@@ -144,7 +117,7 @@ func TestGetAssetPairs(t *testing.T) {
 // TestGetTicker API endpoint test
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetTicker("BCHEUR")
+	_, err := k.GetTicker(context.Background(), "BCHEUR")
 	if err != nil {
 		t.Error("GetTicker() error", err)
 	}
@@ -153,7 +126,7 @@ func TestGetTicker(t *testing.T) {
 // TestGetTickers API endpoint test
 func TestGetTickers(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetTickers("LTCUSD,ETCUSD")
+	_, err := k.GetTickers(context.Background(), "LTCUSD,ETCUSD")
 	if err != nil {
 		t.Error("GetTickers() error", err)
 	}
@@ -162,7 +135,7 @@ func TestGetTickers(t *testing.T) {
 // TestGetOHLC API endpoint test
 func TestGetOHLC(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetOHLC("XXBTZUSD", "1440")
+	_, err := k.GetOHLC(context.Background(), "XXBTZUSD", "1440")
 	if err != nil {
 		t.Error("GetOHLC() error", err)
 	}
@@ -171,7 +144,7 @@ func TestGetOHLC(t *testing.T) {
 // TestGetDepth API endpoint test
 func TestGetDepth(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetDepth("BCHEUR")
+	_, err := k.GetDepth(context.Background(), "BCHEUR")
 	if err != nil {
 		t.Error("GetDepth() error", err)
 	}
@@ -180,7 +153,7 @@ func TestGetDepth(t *testing.T) {
 // TestGetTrades API endpoint test
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetTrades("BCHEUR")
+	_, err := k.GetTrades(context.Background(), "BCHEUR")
 	if err != nil {
 		t.Error("GetTrades() error", err)
 	}
@@ -189,7 +162,7 @@ func TestGetTrades(t *testing.T) {
 // TestGetSpread API endpoint test
 func TestGetSpread(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetSpread("BCHEUR")
+	_, err := k.GetSpread(context.Background(), "BCHEUR")
 	if err != nil {
 		t.Error("GetSpread() error", err)
 	}
@@ -198,7 +171,7 @@ func TestGetSpread(t *testing.T) {
 // TestGetBalance API endpoint test
 func TestGetBalance(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetBalance()
+	_, err := k.GetBalance(context.Background())
 	if err == nil {
 		t.Error("GetBalance() Expected error")
 	}
@@ -208,7 +181,7 @@ func TestGetBalance(t *testing.T) {
 func TestGetTradeBalance(t *testing.T) {
 	t.Parallel()
 	args := TradeBalanceOptions{Asset: "ZEUR"}
-	_, err := k.GetTradeBalance(args)
+	_, err := k.GetTradeBalance(context.Background(), args)
 	if err == nil {
 		t.Error("GetTradeBalance() Expected error")
 	}
@@ -218,7 +191,7 @@ func TestGetTradeBalance(t *testing.T) {
 func TestGetOpenOrders(t *testing.T) {
 	t.Parallel()
 	args := OrderInfoOptions{Trades: true}
-	_, err := k.GetOpenOrders(args)
+	_, err := k.GetOpenOrders(context.Background(), args)
 	if err == nil {
 		t.Error("GetOpenOrders() Expected error")
 	}
@@ -228,7 +201,7 @@ func TestGetOpenOrders(t *testing.T) {
 func TestGetClosedOrders(t *testing.T) {
 	t.Parallel()
 	args := GetClosedOrdersOptions{Trades: true, Start: "OE4KV4-4FVQ5-V7XGPU"}
-	_, err := k.GetClosedOrders(args)
+	_, err := k.GetClosedOrders(context.Background(), args)
 	if err == nil {
 		t.Error("GetClosedOrders() Expected error")
 	}
@@ -238,7 +211,7 @@ func TestGetClosedOrders(t *testing.T) {
 func TestQueryOrdersInfo(t *testing.T) {
 	t.Parallel()
 	args := OrderInfoOptions{Trades: true}
-	_, err := k.QueryOrdersInfo(args, "OR6ZFV-AA6TT-CKFFIW", "OAMUAJ-HLVKG-D3QJ5F")
+	_, err := k.QueryOrdersInfo(context.Background(), args, "OR6ZFV-AA6TT-CKFFIW", "OAMUAJ-HLVKG-D3QJ5F")
 	if err == nil {
 		t.Error("QueryOrdersInfo() Expected error")
 	}
@@ -248,7 +221,7 @@ func TestQueryOrdersInfo(t *testing.T) {
 func TestGetTradesHistory(t *testing.T) {
 	t.Parallel()
 	args := GetTradesHistoryOptions{Trades: true, Start: "TMZEDR-VBJN2-NGY6DX", End: "TVRXG2-R62VE-RWP3UW"}
-	_, err := k.GetTradesHistory(args)
+	_, err := k.GetTradesHistory(context.Background(), args)
 	if err == nil {
 		t.Error("GetTradesHistory() Expected error")
 	}
@@ -257,7 +230,7 @@ func TestGetTradesHistory(t *testing.T) {
 // TestQueryTrades API endpoint test
 func TestQueryTrades(t *testing.T) {
 	t.Parallel()
-	_, err := k.QueryTrades(true, "TMZEDR-VBJN2-NGY6DX", "TFLWIB-KTT7L-4TWR3L", "TDVRAH-2H6OS-SLSXRX")
+	_, err := k.QueryTrades(context.Background(), true, "TMZEDR-VBJN2-NGY6DX", "TFLWIB-KTT7L-4TWR3L", "TDVRAH-2H6OS-SLSXRX")
 	if err == nil {
 		t.Error("QueryTrades() Expected error")
 	}
@@ -266,7 +239,7 @@ func TestQueryTrades(t *testing.T) {
 // TestOpenPositions API endpoint test
 func TestOpenPositions(t *testing.T) {
 	t.Parallel()
-	_, err := k.OpenPositions(false)
+	_, err := k.OpenPositions(context.Background(), false)
 	if err == nil {
 		t.Error("OpenPositions() Expected error")
 	}
@@ -276,7 +249,7 @@ func TestOpenPositions(t *testing.T) {
 func TestGetLedgers(t *testing.T) {
 	t.Parallel()
 	args := GetLedgersOptions{Start: "LRUHXI-IWECY-K4JYGO", End: "L5NIY7-JZQJD-3J4M2V", Ofs: 15}
-	_, err := k.GetLedgers(args)
+	_, err := k.GetLedgers(context.Background(), args)
 	if err == nil {
 		t.Error("GetLedgers() Expected error")
 	}
@@ -285,7 +258,7 @@ func TestGetLedgers(t *testing.T) {
 // TestQueryLedgers API endpoint test
 func TestQueryLedgers(t *testing.T) {
 	t.Parallel()
-	_, err := k.QueryLedgers("LVTSFS-NHZVM-EXNZ5M")
+	_, err := k.QueryLedgers(context.Background(), "LVTSFS-NHZVM-EXNZ5M")
 	if err == nil {
 		t.Error("QueryLedgers() Expected error")
 	}
@@ -294,7 +267,7 @@ func TestQueryLedgers(t *testing.T) {
 // TestGetTradeVolume API endpoint test
 func TestGetTradeVolume(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetTradeVolume(true, "OAVY7T-MV5VK-KHDF5X")
+	_, err := k.GetTradeVolume(context.Background(), true, "OAVY7T-MV5VK-KHDF5X")
 	if err == nil {
 		t.Error("GetTradeVolume() Expected error")
 	}
@@ -304,7 +277,7 @@ func TestGetTradeVolume(t *testing.T) {
 func TestAddOrder(t *testing.T) {
 	t.Parallel()
 	args := AddOrderOptions{OrderFlags: "fcib"}
-	_, err := k.AddOrder("XXBTZUSD",
+	_, err := k.AddOrder(context.Background(), "XXBTZUSD",
 		order.Sell.Lower(), order.Limit.Lower(),
 		0.00000001, 0, 0, 0, &args)
 	if err == nil {
@@ -315,7 +288,7 @@ func TestAddOrder(t *testing.T) {
 // TestCancelExistingOrder API endpoint test
 func TestCancelExistingOrder(t *testing.T) {
 	t.Parallel()
-	_, err := k.CancelExistingOrder("OAVY7T-MV5VK-KHDF5X")
+	_, err := k.CancelExistingOrder(context.Background(), "OAVY7T-MV5VK-KHDF5X")
 	if err == nil {
 		t.Error("CancelExistingOrder() Expected error")
 	}
@@ -337,7 +310,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	k.GetFeeByType(feeBuilder)
+	k.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -354,7 +327,7 @@ func TestGetFee(t *testing.T) {
 
 	if areTestAPIKeysSet() {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := k.GetFee(feeBuilder); resp != float64(0.0026) || err != nil {
+		if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0.0026) || err != nil {
 			t.Error(err)
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.0026), resp)
 		}
@@ -363,7 +336,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := k.GetFee(feeBuilder); resp != float64(2600) || err != nil {
+		if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(2600) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(2600), resp)
 			t.Error(err)
 		}
@@ -371,7 +344,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := k.GetFee(feeBuilder); resp != float64(0.0016) || err != nil {
+		if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0.0016) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.0016), resp)
 			t.Error(err)
 		}
@@ -379,7 +352,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := k.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -387,7 +360,7 @@ func TestGetFee(t *testing.T) {
 		// InternationalBankDepositFee Basic
 		feeBuilder = setFeeBuilder()
 		feeBuilder.FeeType = exchange.InternationalBankDepositFee
-		if resp, err := k.GetFee(feeBuilder); resp != float64(5) || err != nil {
+		if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(5) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(5), resp)
 			t.Error(err)
 		}
@@ -397,7 +370,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
 	feeBuilder.Pair.Base = currency.XXBT
-	if resp, err := k.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(5), resp)
 		t.Error(err)
 	}
@@ -405,7 +378,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := k.GetFee(feeBuilder); resp != float64(0.0005) || err != nil {
+	if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0.0005) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.0005), resp)
 		t.Error(err)
 	}
@@ -414,7 +387,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.Pair.Base = currency.NewCode("hello")
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := k.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -423,7 +396,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.USD
-	if resp, err := k.GetFee(feeBuilder); resp != float64(5) || err != nil {
+	if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(5) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(5), resp)
 		t.Error(err)
 	}
@@ -444,7 +417,7 @@ func TestGetActiveOrders(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := k.GetActiveOrders(&getOrdersRequest)
+	_, err := k.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -458,7 +431,7 @@ func TestGetOrderHistory(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := k.GetOrderHistory(&getOrdersRequest)
+	_, err := k.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -472,7 +445,7 @@ func TestGetOrderInfo(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := k.GetOrderInfo("OZPTPJ-HVYHF-EDIGXS")
+	_, err := k.GetOrderInfo(context.Background(), "OZPTPJ-HVYHF-EDIGXS")
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting error")
 	}
@@ -508,7 +481,7 @@ func TestSubmitOrder(t *testing.T) {
 		Amount:   1,
 		ClientID: "meowOrder",
 	}
-	response, err := k.SubmitOrder(orderSubmission)
+	response, err := k.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -526,7 +499,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		ID: "OGEX6P-B5Q74-IGZ72R",
 	}
 
-	err := k.CancelOrder(orderCancellation)
+	err := k.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -541,7 +514,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	resp, err := k.CancelAllOrders(&order.Cancel{})
+	resp, err := k.CancelAllOrders(context.Background(), &order.Cancel{})
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -557,12 +530,12 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 // TestGetAccountInfo wrapper test
 func TestGetAccountInfo(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := k.UpdateAccountInfo()
+		_, err := k.UpdateAccountInfo(context.Background())
 		if err != nil {
 			t.Error("GetAccountInfo() error", err)
 		}
 	} else {
-		_, err := k.UpdateAccountInfo()
+		_, err := k.UpdateAccountInfo(context.Background())
 		if err == nil {
 			t.Error("GetAccountInfo() Expected error")
 		}
@@ -574,7 +547,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := k.ModifyOrder(&order.Modify{})
+	_, err := k.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -596,7 +569,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := k.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := k.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -618,7 +591,7 @@ func TestWithdrawFiat(t *testing.T) {
 		TradePassword: "someBank",
 	}
 
-	_, err := k.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := k.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -640,7 +613,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 		TradePassword: "someBank",
 	}
 
-	_, err := k.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := k.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -652,12 +625,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 // TestGetDepositAddress wrapper test
 func TestGetDepositAddress(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := k.GetDepositAddress(currency.BTC, "")
+		_, err := k.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err != nil {
 			t.Error("GetDepositAddress() error", err)
 		}
 	} else {
-		_, err := k.GetDepositAddress(currency.BTC, "")
+		_, err := k.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err == nil {
 			t.Error("GetDepositAddress() error can not be nil")
 		}
@@ -667,12 +640,12 @@ func TestGetDepositAddress(t *testing.T) {
 // TestWithdrawStatus wrapper test
 func TestWithdrawStatus(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := k.WithdrawStatus(currency.BTC, "")
+		_, err := k.WithdrawStatus(context.Background(), currency.BTC, "")
 		if err != nil {
 			t.Error("WithdrawStatus() error", err)
 		}
 	} else {
-		_, err := k.WithdrawStatus(currency.BTC, "")
+		_, err := k.WithdrawStatus(context.Background(), currency.BTC, "")
 		if err == nil {
 			t.Error("GetDepositAddress() error can not be nil")
 		}
@@ -681,7 +654,7 @@ func TestWithdrawStatus(t *testing.T) {
 
 // TestWithdrawCancel wrapper test
 func TestWithdrawCancel(t *testing.T) {
-	_, err := k.WithdrawCancel(currency.BTC, "")
+	_, err := k.WithdrawCancel(context.Background(), currency.BTC, "")
 	if areTestAPIKeysSet() && err == nil {
 		t.Error("WithdrawCancel() error cannot be nil")
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -708,7 +681,7 @@ func setupWsTests(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	token, err := k.GetWebsocketToken()
+	token, err := k.GetWebsocketToken(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -740,7 +713,7 @@ func TestGetWSToken(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required, skipping")
 	}
-	resp, err := k.GetWebsocketToken()
+	resp, err := k.GetWebsocketToken(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1414,12 +1387,12 @@ func TestGetHistoricCandles(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = k.GetHistoricCandles(currencyPair, asset.Spot, time.Now().AddDate(0, 0, -1), time.Now(), kline.OneMin)
+	_, err = k.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, time.Now().AddDate(0, 0, -1), time.Now(), kline.OneMin)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = k.GetHistoricCandles(currencyPair, asset.Spot, time.Now(), time.Now(), kline.Interval(time.Hour*7))
+	_, err = k.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, time.Now(), time.Now(), kline.Interval(time.Hour*7))
 	if err == nil {
 		t.Fatal("unexpected result")
 	}
@@ -1430,12 +1403,12 @@ func TestGetHistoricCandlesExtended(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = k.GetHistoricCandlesExtended(currencyPair, asset.Spot, time.Now().AddDate(0, -6, 0), time.Now(), kline.OneDay)
+	_, err = k.GetHistoricCandlesExtended(context.Background(), currencyPair, asset.Spot, time.Now().AddDate(0, -6, 0), time.Now(), kline.OneDay)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = k.GetHistoricCandlesExtended(currencyPair, asset.Spot, time.Now(), time.Now(), kline.Interval(time.Hour*7))
+	_, err = k.GetHistoricCandlesExtended(context.Background(), currencyPair, asset.Spot, time.Now(), time.Now(), kline.Interval(time.Hour*7))
 	if err == nil {
 		t.Fatal("unexpected result")
 	}
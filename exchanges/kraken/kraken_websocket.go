@@ -1,6 +1,7 @@
 package kraken
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -17,6 +18,7 @@ import (
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
 	"github.com/yurulab/gocryptotrader/exchanges/stream"
 	"github.com/yurulab/gocryptotrader/exchanges/stream/buffer"
 	"github.com/yurulab/gocryptotrader/exchanges/ticker"
@@ -82,7 +84,7 @@ func (k *Kraken) WsConnect() error {
 	go k.wsFunnelConnectionData(k.Websocket.Conn, comms)
 
 	if k.GetAuthenticatedAPISupport(exchange.WebsocketAuthentication) {
-		authToken, err = k.GetWebsocketToken()
+		authToken, err = k.GetWebsocketToken(context.Background())
 		if err != nil {
 			k.Websocket.SetCanUseAuthenticatedEndpoints(false)
 			log.Errorf(log.ExchangeSys,
@@ -982,12 +984,12 @@ channels:
 	return nil
 }
 
-func (k *Kraken) wsAddOrder(request *WsAddOrderRequest) (string, error) {
+func (k *Kraken) wsAddOrder(req *WsAddOrderRequest) (string, error) {
 	id := k.Websocket.AuthConn.GenerateMessageID(false)
-	request.UserReferenceID = strconv.FormatInt(id, 10)
-	request.Event = krakenWsAddOrder
-	request.Token = authToken
-	jsonResp, err := k.Websocket.AuthConn.SendMessageReturnResponse(id, request)
+	req.UserReferenceID = strconv.FormatInt(id, 10)
+	req.Event = krakenWsAddOrder
+	req.Token = authToken
+	jsonResp, err := k.Websocket.AuthConn.SendRateLimitedMessageReturnResponse(request.Auth, id, req)
 	if err != nil {
 		return "", err
 	}
@@ -1003,10 +1005,10 @@ func (k *Kraken) wsAddOrder(request *WsAddOrderRequest) (string, error) {
 }
 
 func (k *Kraken) wsCancelOrders(orderIDs []string) error {
-	request := WsCancelOrderRequest{
+	req := WsCancelOrderRequest{
 		Event:          krakenWsCancelOrder,
 		Token:          authToken,
 		TransactionIDs: orderIDs,
 	}
-	return k.Websocket.AuthConn.SendJSONMessage(request)
+	return k.Websocket.AuthConn.SendRateLimitedJSONMessage(request.Auth, req)
 }
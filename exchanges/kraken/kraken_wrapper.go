@@ -1,6 +1,7 @@
 package kraken
 
 import (
+	"context"
 	"errors"
 	"strconv"
 	"strings"
@@ -39,7 +40,7 @@ func (k *Kraken) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if k.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = k.UpdateTradablePairs(true)
+		err = k.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -142,7 +143,7 @@ func (k *Kraken) SetDefaults() {
 
 	k.Requester = request.New(k.Name,
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout),
-		request.WithLimiter(request.NewBasicRateLimit(krakenRateInterval, krakenRequestRate)))
+		request.WithLimiter(SetRateLimit()))
 
 	k.API.Endpoints.URLDefault = krakenAPIURL
 	k.API.Endpoints.URL = k.API.Endpoints.URLDefault
@@ -165,7 +166,7 @@ func (k *Kraken) Setup(exch *config.ExchangeConfig) error {
 		return err
 	}
 
-	err = k.SeedAssets()
+	err = k.SeedAssets(context.Background())
 	if err != nil {
 		return err
 	}
@@ -207,6 +208,7 @@ func (k *Kraken) Setup(exch *config.ExchangeConfig) error {
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
 		URL:                  krakenAuthWSURL,
 		Authenticated:        true,
+		Limiter:              k.Requester,
 	})
 }
 
@@ -282,7 +284,7 @@ func (k *Kraken) Run() {
 		return
 	}
 
-	err = k.UpdateTradablePairs(forceUpdate)
+	err = k.UpdateTradablePairs(context.Background(), forceUpdate)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s failed to update tradable pairs. Err: %s",
@@ -292,14 +294,14 @@ func (k *Kraken) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (k *Kraken) FetchTradablePairs(asset asset.Item) ([]string, error) {
+func (k *Kraken) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
 	if !assetTranslator.Seeded() {
-		if err := k.SeedAssets(); err != nil {
+		if err := k.SeedAssets(ctx); err != nil {
 			return nil, err
 		}
 	}
 
-	pairs, err := k.GetAssetPairs()
+	pairs, err := k.GetAssetPairs(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -339,8 +341,8 @@ func (k *Kraken) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (k *Kraken) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := k.FetchTradablePairs(asset.Spot)
+func (k *Kraken) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := k.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -353,7 +355,7 @@ func (k *Kraken) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (k *Kraken) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (k *Kraken) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	pairs, err := k.GetEnabledPairs(assetType)
 	if err != nil {
 		return nil, err
@@ -362,7 +364,7 @@ func (k *Kraken) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pr
 	if err != nil {
 		return nil, err
 	}
-	tickers, err := k.GetTickers(pairsCollated)
+	tickers, err := k.GetTickers(ctx, pairsCollated)
 	if err != nil {
 		return nil, err
 	}
@@ -402,32 +404,41 @@ func (k *Kraken) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pr
 	return ticker.GetTicker(k.Name, p, assetType)
 }
 
+// FetchServerTime returns the current exchange server time
+func (k *Kraken) FetchServerTime(ctx context.Context) (time.Time, error) {
+	t, err := k.GetServerTime(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(t.Unixtime, 0), nil
+}
+
 // FetchTicker returns the ticker for a currency pair
-func (k *Kraken) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (k *Kraken) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(k.Name, p, assetType)
 	if err != nil {
-		return k.UpdateTicker(p, assetType)
+		return k.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (k *Kraken) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (k *Kraken) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(k.Name, p, assetType)
 	if err != nil {
-		return k.UpdateOrderbook(p, assetType)
+		return k.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (k *Kraken) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (k *Kraken) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	fpair, err := k.FormatExchangeCurrency(p, assetType)
 	if err != nil {
 		return nil, err
 	}
 
-	orderbookNew, err := k.GetDepth(fpair.String())
+	orderbookNew, err := k.GetDepth(ctx, fpair.String())
 	if err != nil {
 		return nil, err
 	}
@@ -461,11 +472,11 @@ func (k *Kraken) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderb
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // Kraken exchange - to-do
-func (k *Kraken) UpdateAccountInfo() (account.Holdings, error) {
+func (k *Kraken) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var info account.Holdings
 	info.Exchange = k.Name
 
-	bal, err := k.GetBalance()
+	bal, err := k.GetBalance(ctx)
 	if err != nil {
 		return info, err
 	}
@@ -498,10 +509,10 @@ func (k *Kraken) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (k *Kraken) FetchAccountInfo() (account.Holdings, error) {
+func (k *Kraken) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(k.Name)
 	if err != nil {
-		return k.UpdateAccountInfo()
+		return k.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -509,17 +520,17 @@ func (k *Kraken) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (k *Kraken) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (k *Kraken) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data within the timeframe provided.
-func (k *Kraken) GetExchangeHistory(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+func (k *Kraken) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (k *Kraken) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (k *Kraken) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	err := s.Validate()
 	if err != nil {
@@ -542,7 +553,7 @@ func (k *Kraken) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		submitOrderResponse.IsOrderPlaced = true
 	} else {
 		var response AddOrderResponse
-		response, err = k.AddOrder(s.Pair.String(),
+		response, err = k.AddOrder(ctx, s.Pair.String(),
 			s.Side.String(),
 			s.Type.String(),
 			s.Amount,
@@ -566,28 +577,28 @@ func (k *Kraken) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (k *Kraken) ModifyOrder(action *order.Modify) (string, error) {
+func (k *Kraken) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (k *Kraken) CancelOrder(order *order.Cancel) error {
+func (k *Kraken) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	if k.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
 		return k.wsCancelOrders([]string{order.ID})
 	}
-	_, err := k.CancelExistingOrder(order.ID)
+	_, err := k.CancelExistingOrder(ctx, order.ID)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (k *Kraken) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (k *Kraken) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
 
 	var emptyOrderOptions OrderInfoOptions
-	openOrders, err := k.GetOpenOrders(emptyOrderOptions)
+	openOrders, err := k.GetOpenOrders(ctx, emptyOrderOptions)
 	if err != nil {
 		return cancelAllOrdersResponse, err
 	}
@@ -596,7 +607,7 @@ func (k *Kraken) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, erro
 		if k.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
 			err = k.wsCancelOrders([]string{orderID})
 		} else {
-			_, err = k.CancelExistingOrder(orderID)
+			_, err = k.CancelExistingOrder(ctx, orderID)
 		}
 		if err != nil {
 			cancelAllOrdersResponse.Status[orderID] = err.Error()
@@ -606,10 +617,10 @@ func (k *Kraken) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, erro
 }
 
 // GetOrderInfo returns information on a current open order
-func (k *Kraken) GetOrderInfo(orderID string) (order.Detail, error) {
+func (k *Kraken) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	var emptyOrderOptions OrderInfoOptions
-	openOrders, err := k.GetOpenOrders(emptyOrderOptions)
+	openOrders, err := k.GetOpenOrders(ctx, emptyOrderOptions)
 	if err != nil {
 		return orderDetail, err
 	}
@@ -672,8 +683,8 @@ func (k *Kraken) GetOrderInfo(orderID string) (order.Detail, error) {
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (k *Kraken) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	methods, err := k.GetDepositMethods(cryptocurrency.String())
+func (k *Kraken) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	methods, err := k.GetDepositMethods(ctx, cryptocurrency.String())
 	if err != nil {
 		return "", err
 	}
@@ -687,13 +698,13 @@ func (k *Kraken) GetDepositAddress(cryptocurrency currency.Code, _ string) (stri
 		return "", errors.New("method not found")
 	}
 
-	return k.GetCryptoDepositAddress(method, cryptocurrency.String())
+	return k.GetCryptoDepositAddress(ctx, method, cryptocurrency.String())
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal
 // Populate exchange.WithdrawRequest.TradePassword with withdrawal key name, as set up on your account
-func (k *Kraken) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
-	v, err := k.Withdraw(withdrawRequest.Currency.String(), withdrawRequest.TradePassword, withdrawRequest.Amount)
+func (k *Kraken) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	v, err := k.Withdraw(ctx, withdrawRequest.Currency.String(), withdrawRequest.TradePassword, withdrawRequest.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -704,8 +715,8 @@ func (k *Kraken) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request)
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (k *Kraken) WithdrawFiatFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
-	v, err := k.Withdraw(withdrawRequest.Currency.String(), withdrawRequest.TradePassword, withdrawRequest.Amount)
+func (k *Kraken) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	v, err := k.Withdraw(ctx, withdrawRequest.Currency.String(), withdrawRequest.TradePassword, withdrawRequest.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -716,8 +727,8 @@ func (k *Kraken) WithdrawFiatFunds(withdrawRequest *withdraw.Request) (*withdraw
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (k *Kraken) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
-	v, err := k.Withdraw(withdrawRequest.Currency.String(), withdrawRequest.TradePassword, withdrawRequest.Amount)
+func (k *Kraken) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	v, err := k.Withdraw(ctx, withdrawRequest.Currency.String(), withdrawRequest.TradePassword, withdrawRequest.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -727,17 +738,17 @@ func (k *Kraken) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (k *Kraken) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (k *Kraken) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !k.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
 	}
-	return k.GetFee(feeBuilder)
+	return k.GetFee(ctx, feeBuilder)
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (k *Kraken) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	resp, err := k.GetOpenOrders(OrderInfoOptions{})
+func (k *Kraken) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	resp, err := k.GetOpenOrders(ctx, OrderInfoOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -785,7 +796,7 @@ func (k *Kraken) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (k *Kraken) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+func (k *Kraken) GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
 	req := GetClosedOrdersOptions{}
 	if getOrdersRequest.StartTicks.Unix() > 0 {
 		req.Start = strconv.FormatInt(getOrdersRequest.StartTicks.Unix(), 10)
@@ -804,7 +815,7 @@ func (k *Kraken) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]or
 		return nil, err
 	}
 
-	resp, err := k.GetClosedOrders(req)
+	resp, err := k.GetClosedOrders(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -842,7 +853,7 @@ func (k *Kraken) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]or
 
 // AuthenticateWebsocket sends an authentication message to the websocket
 func (k *Kraken) AuthenticateWebsocket() error {
-	resp, err := k.GetWebsocketToken()
+	resp, err := k.GetWebsocketToken(context.Background())
 	if resp != "" {
 		authToken = resp
 	}
@@ -851,8 +862,8 @@ func (k *Kraken) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (k *Kraken) ValidateCredentials() error {
-	_, err := k.UpdateAccountInfo()
+func (k *Kraken) ValidateCredentials(ctx context.Context) error {
+	_, err := k.UpdateAccountInfo(ctx)
 	return k.CheckTransientError(err)
 }
 
@@ -862,7 +873,7 @@ func (k *Kraken) FormatExchangeKlineInterval(in kline.Interval) string {
 }
 
 // GetHistoricCandles returns candles between a time period for a set time interval
-func (k *Kraken) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (k *Kraken) GetHistoricCandles(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if !k.KlineIntervalEnabled(interval) {
 		return kline.Item{}, kline.ErrorKline{
 			Interval: interval,
@@ -881,7 +892,7 @@ func (k *Kraken) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end
 		return kline.Item{}, err
 	}
 
-	candles, err := k.GetOHLC(assetTranslator.LookupCurrency(formattedPair.Upper().String()), k.FormatExchangeKlineInterval(interval))
+	candles, err := k.GetOHLC(ctx, assetTranslator.LookupCurrency(formattedPair.Upper().String()), k.FormatExchangeKlineInterval(interval))
 	if err != nil {
 		return kline.Item{}, err
 	}
@@ -908,7 +919,7 @@ func (k *Kraken) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end
 }
 
 // GetHistoricCandlesExtended returns candles between a time period for a set time interval
-func (k *Kraken) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (k *Kraken) GetHistoricCandlesExtended(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if !k.KlineIntervalEnabled(interval) {
 		return kline.Item{}, kline.ErrorKline{
 			Interval: interval,
@@ -927,7 +938,7 @@ func (k *Kraken) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, st
 		return kline.Item{}, err
 	}
 
-	candles, err := k.GetOHLC(assetTranslator.LookupCurrency(formattedPair.Upper().String()), k.FormatExchangeKlineInterval(interval))
+	candles, err := k.GetOHLC(ctx, assetTranslator.LookupCurrency(formattedPair.Upper().String()), k.FormatExchangeKlineInterval(interval))
 	if err != nil {
 		return kline.Item{}, err
 	}
@@ -0,0 +1,29 @@
+package kraken
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/exchanges/request"
+)
+
+// krakenRejectionPause is how long requests are paused after Kraken reports
+// the rate limit has been exceeded. Kraken does not report its call counter,
+// this allows the counter of the lowest verification tier to decay by five
+const krakenRejectionPause = time.Second * 15
+
+// SetRateLimit returns the rate limit for the exchange. Kraken shares a call
+// counter across endpoints and only reports exceeding it through errors in the
+// response body, which exhaust the budget until the counter has decayed
+func SetRateLimit() *request.AdaptiveLimit {
+	l := request.NewAdaptiveLimit(krakenRateInterval, krakenRequestRate, nil, nil)
+	l.SetRejectionParser(parseRejection)
+	return l
+}
+
+// parseRejection detects the rate limit errors Kraken returns in the body of
+// a successful response
+func parseRejection(_ int, body []byte) (time.Duration, bool) {
+	return krakenRejectionPause, bytes.Contains(body, []byte("EAPI:Rate limit exceeded")) ||
+		bytes.Contains(body, []byte("EGeneral:Too many requests"))
+}
@@ -0,0 +1,20 @@
+package kraken
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestParseRejection(t *testing.T) {
+	t.Parallel()
+	if _, ok := parseRejection(http.StatusOK, []byte(`{"error":[],"result":{}}`)); ok {
+		t.Error("expected successful response not to be a rejection")
+	}
+	pause, ok := parseRejection(http.StatusOK, []byte(`{"error":["EAPI:Rate limit exceeded"]}`))
+	if !ok || pause != krakenRejectionPause {
+		t.Errorf("expected rate limit error to pause requests for %v, received %v", krakenRejectionPause, pause)
+	}
+	if _, ok = parseRejection(http.StatusOK, []byte(`{"error":["EGeneral:Too many requests"]}`)); !ok {
+		t.Error("expected too many requests error to be a rejection")
+	}
+}
@@ -41,11 +41,11 @@ type LakeBTC struct {
 }
 
 // GetTicker returns the current ticker from lakeBTC
-func (l *LakeBTC) GetTicker() (map[string]Ticker, error) {
+func (l *LakeBTC) GetTicker(ctx context.Context) (map[string]Ticker, error) {
 	response := make(map[string]TickerResponse)
 	path := fmt.Sprintf("%s/%s", l.API.Endpoints.URL, lakeBTCTicker)
 
-	err := l.SendHTTPRequest(path, &response)
+	err := l.SendHTTPRequest(ctx, path, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -97,14 +97,14 @@ func (l *LakeBTC) GetTicker() (map[string]Ticker, error) {
 }
 
 // GetOrderBook returns the order book from LakeBTC
-func (l *LakeBTC) GetOrderBook(currency string) (Orderbook, error) {
+func (l *LakeBTC) GetOrderBook(ctx context.Context, currency string) (Orderbook, error) {
 	type Response struct {
 		Bids [][]string `json:"bids"`
 		Asks [][]string `json:"asks"`
 	}
 	path := fmt.Sprintf("%s/%s?symbol=%s", l.API.Endpoints.URL, lakeBTCOrderbook, strings.ToLower(currency))
 	resp := Response{}
-	err := l.SendHTTPRequest(path, &resp)
+	err := l.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return Orderbook{}, err
 	}
@@ -141,7 +141,7 @@ func (l *LakeBTC) GetOrderBook(currency string) (Orderbook, error) {
 }
 
 // GetTradeHistory returns the trade history for a given currency pair
-func (l *LakeBTC) GetTradeHistory(currency string, start int64) ([]TradeHistory, error) {
+func (l *LakeBTC) GetTradeHistory(ctx context.Context, currency string, start int64) ([]TradeHistory, error) {
 	v := url.Values{}
 	v.Set("symbol", strings.ToLower(currency))
 	if start != 0 {
@@ -149,27 +149,27 @@ func (l *LakeBTC) GetTradeHistory(currency string, start int64) ([]TradeHistory,
 	}
 	path := fmt.Sprintf("%s/%s?%s", l.API.Endpoints.URL, lakeBTCTrades, v.Encode())
 	var resp []TradeHistory
-	return resp, l.SendHTTPRequest(path, &resp)
+	return resp, l.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetAccountInformation returns your current account information
-func (l *LakeBTC) GetAccountInformation() (AccountInfo, error) {
+func (l *LakeBTC) GetAccountInformation(ctx context.Context) (AccountInfo, error) {
 	resp := AccountInfo{}
-	return resp, l.SendAuthenticatedHTTPRequest(lakeBTCGetAccountInfo, "", &resp)
+	return resp, l.SendAuthenticatedHTTPRequest(ctx, lakeBTCGetAccountInfo, "", &resp)
 }
 
 // Trade executes an order on the exchange and returns trade inforamtion or an
 // error
-func (l *LakeBTC) Trade(isBuyOrder bool, amount, price float64, currency string) (Trade, error) {
+func (l *LakeBTC) Trade(ctx context.Context, isBuyOrder bool, amount, price float64, currency string) (Trade, error) {
 	resp := Trade{}
 	params := strconv.FormatFloat(price, 'f', -1, 64) + "," + strconv.FormatFloat(amount, 'f', -1, 64) + "," + currency
 
 	if isBuyOrder {
-		if err := l.SendAuthenticatedHTTPRequest(lakeBTCBuyOrder, params, &resp); err != nil {
+		if err := l.SendAuthenticatedHTTPRequest(ctx, lakeBTCBuyOrder, params, &resp); err != nil {
 			return resp, err
 		}
 	} else {
-		if err := l.SendAuthenticatedHTTPRequest(lakeBTCSellOrder, params, &resp); err != nil {
+		if err := l.SendAuthenticatedHTTPRequest(ctx, lakeBTCSellOrder, params, &resp); err != nil {
 			return resp, err
 		}
 	}
@@ -182,14 +182,14 @@ func (l *LakeBTC) Trade(isBuyOrder bool, amount, price float64, currency string)
 }
 
 // GetOpenOrders returns all open orders associated with your account
-func (l *LakeBTC) GetOpenOrders() ([]OpenOrders, error) {
+func (l *LakeBTC) GetOpenOrders(ctx context.Context) ([]OpenOrders, error) {
 	var orders []OpenOrders
 
-	return orders, l.SendAuthenticatedHTTPRequest(lakeBTCOpenOrders, "", &orders)
+	return orders, l.SendAuthenticatedHTTPRequest(ctx, lakeBTCOpenOrders, "", &orders)
 }
 
 // GetOrders returns your orders
-func (l *LakeBTC) GetOrders(orders []int64) ([]Orders, error) {
+func (l *LakeBTC) GetOrders(ctx context.Context, orders []int64) ([]Orders, error) {
 	var ordersStr []string
 	for _, x := range orders {
 		ordersStr = append(ordersStr, strconv.FormatInt(x, 10))
@@ -197,18 +197,18 @@ func (l *LakeBTC) GetOrders(orders []int64) ([]Orders, error) {
 
 	var resp []Orders
 	return resp,
-		l.SendAuthenticatedHTTPRequest(lakeBTCGetOrders, strings.Join(ordersStr, ","), &resp)
+		l.SendAuthenticatedHTTPRequest(ctx, lakeBTCGetOrders, strings.Join(ordersStr, ","), &resp)
 }
 
 // CancelExistingOrder cancels an order by ID number and returns an error
-func (l *LakeBTC) CancelExistingOrder(orderID int64) error {
+func (l *LakeBTC) CancelExistingOrder(ctx context.Context, orderID int64) error {
 	type Response struct {
 		Result bool `json:"Result"`
 	}
 
 	resp := Response{}
 	params := strconv.FormatInt(orderID, 10)
-	err := l.SendAuthenticatedHTTPRequest(lakeBTCCancelOrder, params, &resp)
+	err := l.SendAuthenticatedHTTPRequest(ctx, lakeBTCCancelOrder, params, &resp)
 	if err != nil {
 		return err
 	}
@@ -220,14 +220,14 @@ func (l *LakeBTC) CancelExistingOrder(orderID int64) error {
 }
 
 // CancelExistingOrders cancels an order by ID number and returns an error
-func (l *LakeBTC) CancelExistingOrders(orderIDs []string) error {
+func (l *LakeBTC) CancelExistingOrders(ctx context.Context, orderIDs []string) error {
 	type Response struct {
 		Result bool `json:"Result"`
 	}
 
 	resp := Response{}
 	params := strings.Join(orderIDs, ",")
-	err := l.SendAuthenticatedHTTPRequest(lakeBTCCancelOrder, params, &resp)
+	err := l.SendAuthenticatedHTTPRequest(ctx, lakeBTCCancelOrder, params, &resp)
 	if err != nil {
 		return err
 	}
@@ -239,30 +239,30 @@ func (l *LakeBTC) CancelExistingOrders(orderIDs []string) error {
 }
 
 // GetTrades returns trades associated with your account by timestamp
-func (l *LakeBTC) GetTrades(timestamp int64) ([]AuthenticatedTradeHistory, error) {
+func (l *LakeBTC) GetTrades(ctx context.Context, timestamp int64) ([]AuthenticatedTradeHistory, error) {
 	params := ""
 	if timestamp != 0 {
 		params = strconv.FormatInt(timestamp, 10)
 	}
 
 	var trades []AuthenticatedTradeHistory
-	return trades, l.SendAuthenticatedHTTPRequest(lakeBTCGetTrades, params, &trades)
+	return trades, l.SendAuthenticatedHTTPRequest(ctx, lakeBTCGetTrades, params, &trades)
 }
 
 // GetExternalAccounts returns your external accounts WARNING: Only for BTC!
-func (l *LakeBTC) GetExternalAccounts() ([]ExternalAccounts, error) {
+func (l *LakeBTC) GetExternalAccounts(ctx context.Context) ([]ExternalAccounts, error) {
 	var resp []ExternalAccounts
 
-	return resp, l.SendAuthenticatedHTTPRequest(lakeBTCGetExternalAccounts, "", &resp)
+	return resp, l.SendAuthenticatedHTTPRequest(ctx, lakeBTCGetExternalAccounts, "", &resp)
 }
 
 // CreateWithdraw allows your to withdraw to external account WARNING: Only for
 // BTC!
-func (l *LakeBTC) CreateWithdraw(amount float64, accountID string) (Withdraw, error) {
+func (l *LakeBTC) CreateWithdraw(ctx context.Context, amount float64, accountID string) (Withdraw, error) {
 	resp := Withdraw{}
 	params := strconv.FormatFloat(amount, 'f', -1, 64) + ",btc," + accountID
 
-	err := l.SendAuthenticatedHTTPRequest(lakeBTCCreateWithdraw, params, &resp)
+	err := l.SendAuthenticatedHTTPRequest(ctx, lakeBTCCreateWithdraw, params, &resp)
 	if err != nil {
 		return Withdraw{}, err
 	}
@@ -274,8 +274,8 @@ func (l *LakeBTC) CreateWithdraw(amount float64, accountID string) (Withdraw, er
 }
 
 // SendHTTPRequest sends an unauthenticated http request
-func (l *LakeBTC) SendHTTPRequest(path string, result interface{}) error {
-	return l.SendPayload(context.Background(), &request.Item{
+func (l *LakeBTC) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return l.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -286,7 +286,7 @@ func (l *LakeBTC) SendHTTPRequest(path string, result interface{}) error {
 }
 
 // SendAuthenticatedHTTPRequest sends an autheticated HTTP request to a LakeBTC
-func (l *LakeBTC) SendAuthenticatedHTTPRequest(method, params string, result interface{}) (err error) {
+func (l *LakeBTC) SendAuthenticatedHTTPRequest(ctx context.Context, method, params string, result interface{}) (err error) {
 	if !l.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, l.Name)
 	}
@@ -315,7 +315,7 @@ func (l *LakeBTC) SendAuthenticatedHTTPRequest(method, params string, result int
 	headers["Authorization"] = "Basic " + crypto.Base64Encode([]byte(l.API.Credentials.Key+":"+crypto.HexEncodeToString(hmac)))
 	headers["Content-Type"] = "application/json-rpc"
 
-	return l.SendPayload(context.Background(), &request.Item{
+	return l.SendPayload(ctx, &request.Item{
 		Method:        http.MethodPost,
 		Path:          l.API.Endpoints.URL,
 		Headers:       headers,
@@ -0,0 +1,40 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package lakebtc
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	l.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("LakeBTC load config error", err)
+	}
+	lakebtcConfig, err := cfg.GetExchangeConfig("LakeBTC")
+	if err != nil {
+		log.Fatal("LakeBTC Setup() init error", err)
+	}
+	lakebtcConfig.API.AuthenticatedSupport = true
+	lakebtcConfig.API.Credentials.Key = apiKey
+	lakebtcConfig.API.Credentials.Secret = apiSecret
+	lakebtcConfig.Features.Enabled.Websocket = true
+	l.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = l.Setup(lakebtcConfig)
+	if err != nil {
+		log.Fatal("LakeBTC setup error", err)
+	}
+	log.Printf(sharedtestvalues.LiveTesting, l.Name, l.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
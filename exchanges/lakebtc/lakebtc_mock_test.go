@@ -0,0 +1,55 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package lakebtc
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/lakebtc/lakebtc.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	l.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("LakeBTC load config error", err)
+	}
+	lakebtcConfig, err := cfg.GetExchangeConfig("LakeBTC")
+	if err != nil {
+		log.Fatal("LakeBTC Setup() init error", err)
+	}
+	lakebtcConfig.API.AuthenticatedSupport = true
+	lakebtcConfig.API.Credentials.Key = apiKey
+	lakebtcConfig.API.Credentials.Secret = apiSecret
+	lakebtcConfig.Features.Enabled.Websocket = true
+	l.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = l.Setup(lakebtcConfig)
+	if err != nil {
+		log.Fatal("LakeBTC setup error", err)
+	}
+
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+
+	l.HTTPClient = newClient
+	l.API.Endpoints.URL = serverDetails + "/api_v2"
+	log.Printf(sharedtestvalues.MockTesting, l.Name, l.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
@@ -15,19 +15,19 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	gctcrypto "github.com/yurulab/gocryptotrader/common/crypto"
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/exchanges/request"
-	"github.com/yurulab/gocryptotrader/exchanges/stream"
 )
 
 // Lbank is the overarching type across this package
 type Lbank struct {
 	exchange.Base
-	privateKey    *rsa.PrivateKey
-	WebsocketConn *stream.WebsocketConnection
+	privateKey     *rsa.PrivateKey
+	wsSubscribeKey string
 }
 
 const (
@@ -57,59 +57,65 @@ const (
 	lbankWithdrawalRecords       = "withdraws.do"
 	lbankWithdraw                = "withdraw.do"
 	lbankRevokeWithdraw          = "withdrawCancel.do"
+
+	// Authenticated websocket key endpoints
+	lbankWsAPIVersion     = "2"
+	lbankWsSubscribeKey   = "subscribe/get_key.do"
+	lbankWsRefreshKey     = "subscribe/refresh_key.do"
+	lbankWsKeyRefreshRate = 50 * time.Minute
 )
 
 // GetTicker returns a ticker for the specified symbol
 // symbol: eth_btc
-func (l *Lbank) GetTicker(symbol string) (TickerResponse, error) {
+func (l *Lbank) GetTicker(ctx context.Context, symbol string) (TickerResponse, error) {
 	var t TickerResponse
 	params := url.Values{}
 	params.Set("symbol", symbol)
 	path := fmt.Sprintf("%s/v%s/%s?%s", l.API.Endpoints.URL, lbankAPIVersion, lbankTicker, params.Encode())
-	return t, l.SendHTTPRequest(path, &t)
+	return t, l.SendHTTPRequest(ctx, path, &t)
 }
 
 // GetTickers returns all tickers
-func (l *Lbank) GetTickers() ([]TickerResponse, error) {
+func (l *Lbank) GetTickers(ctx context.Context) ([]TickerResponse, error) {
 	var t []TickerResponse
 	params := url.Values{}
 	params.Set("symbol", "all")
 	path := fmt.Sprintf("%s/v%s/%s?%s", l.API.Endpoints.URL, lbankAPIVersion, lbankTicker, params.Encode())
-	return t, l.SendHTTPRequest(path, &t)
+	return t, l.SendHTTPRequest(ctx, path, &t)
 }
 
 // GetCurrencyPairs returns a list of supported currency pairs by the exchange
-func (l *Lbank) GetCurrencyPairs() ([]string, error) {
+func (l *Lbank) GetCurrencyPairs(ctx context.Context) ([]string, error) {
 	path := fmt.Sprintf("%s/v%s/%s", l.API.Endpoints.URL, lbankAPIVersion,
 		lbankCurrencyPairs)
 	var result []string
-	return result, l.SendHTTPRequest(path, &result)
+	return result, l.SendHTTPRequest(ctx, path, &result)
 }
 
 // GetMarketDepths returns arrays of asks, bids and timestamp
-func (l *Lbank) GetMarketDepths(symbol, size, merge string) (MarketDepthResponse, error) {
+func (l *Lbank) GetMarketDepths(ctx context.Context, symbol, size, merge string) (MarketDepthResponse, error) {
 	var m MarketDepthResponse
 	params := url.Values{}
 	params.Set("symbol", symbol)
 	params.Set("size", size)
 	params.Set("merge", merge)
 	path := fmt.Sprintf("%s/v%s/%s?%s", l.API.Endpoints.URL, lbankAPIVersion, lbankMarketDepths, params.Encode())
-	return m, l.SendHTTPRequest(path, &m)
+	return m, l.SendHTTPRequest(ctx, path, &m)
 }
 
 // GetTrades returns an array of available trades regarding a particular exchange
-func (l *Lbank) GetTrades(symbol, size, time string) ([]TradeResponse, error) {
+func (l *Lbank) GetTrades(ctx context.Context, symbol, size, time string) ([]TradeResponse, error) {
 	var g []TradeResponse
 	params := url.Values{}
 	params.Set("symbol", symbol)
 	params.Set("size", size)
 	params.Set("time", time)
 	path := fmt.Sprintf("%s/v%s/%s?%s", l.API.Endpoints.URL, lbankAPIVersion, lbankTrades, params.Encode())
-	return g, l.SendHTTPRequest(path, &g)
+	return g, l.SendHTTPRequest(ctx, path, &g)
 }
 
 // GetKlines returns kline data
-func (l *Lbank) GetKlines(symbol, size, klineType, time string) ([]KlineResponse, error) {
+func (l *Lbank) GetKlines(ctx context.Context, symbol, size, klineType, time string) ([]KlineResponse, error) {
 	var klineTemp interface{}
 	var k []KlineResponse
 	params := url.Values{}
@@ -118,7 +124,7 @@ func (l *Lbank) GetKlines(symbol, size, klineType, time string) ([]KlineResponse
 	params.Set("type", klineType)
 	params.Set("time", time)
 	path := fmt.Sprintf("%s/v%s/%s?%s", l.API.Endpoints.URL, lbankAPIVersion, lbankKlines, params.Encode())
-	err := l.SendHTTPRequest(path, &klineTemp)
+	err := l.SendHTTPRequest(ctx, path, &klineTemp)
 	if err != nil {
 		return k, err
 	}
@@ -178,10 +184,10 @@ func (l *Lbank) GetKlines(symbol, size, klineType, time string) ([]KlineResponse
 }
 
 // GetUserInfo gets users account info
-func (l *Lbank) GetUserInfo() (InfoFinalResponse, error) {
+func (l *Lbank) GetUserInfo(ctx context.Context) (InfoFinalResponse, error) {
 	var resp InfoFinalResponse
 	path := fmt.Sprintf("%s/v%s/%s?", l.API.Endpoints.URL, lbankAPIVersion, lbankUserInfo)
-	err := l.SendAuthHTTPRequest(http.MethodPost, path, nil, &resp)
+	err := l.SendAuthHTTPRequest(ctx, http.MethodPost, path, nil, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -194,7 +200,7 @@ func (l *Lbank) GetUserInfo() (InfoFinalResponse, error) {
 }
 
 // CreateOrder creates an order
-func (l *Lbank) CreateOrder(pair, side string, amount, price float64) (CreateOrderResponse, error) {
+func (l *Lbank) CreateOrder(ctx context.Context, pair, side string, amount, price float64) (CreateOrderResponse, error) {
 	var resp CreateOrderResponse
 	if !strings.EqualFold(side, order.Buy.String()) &&
 		!strings.EqualFold(side, order.Sell.String()) {
@@ -213,7 +219,7 @@ func (l *Lbank) CreateOrder(pair, side string, amount, price float64) (CreateOrd
 	params.Set("price", strconv.FormatFloat(price, 'f', -1, 64))
 	params.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	path := fmt.Sprintf("%s/v%s/%s?", l.API.Endpoints.URL, lbankAPIVersion, lbankPlaceOrder)
-	err := l.SendAuthHTTPRequest(http.MethodPost, path, params, &resp)
+	err := l.SendAuthHTTPRequest(ctx, http.MethodPost, path, params, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -226,13 +232,13 @@ func (l *Lbank) CreateOrder(pair, side string, amount, price float64) (CreateOrd
 }
 
 // RemoveOrder cancels a given order
-func (l *Lbank) RemoveOrder(pair, orderID string) (RemoveOrderResponse, error) {
+func (l *Lbank) RemoveOrder(ctx context.Context, pair, orderID string) (RemoveOrderResponse, error) {
 	var resp RemoveOrderResponse
 	params := url.Values{}
 	params.Set("symbol", pair)
 	params.Set("order_id", orderID)
 	path := fmt.Sprintf("%s/v%s/%s", l.API.Endpoints.URL, lbankAPIVersion, lbankCancelOrder)
-	err := l.SendAuthHTTPRequest(http.MethodPost, path, params, &resp)
+	err := l.SendAuthHTTPRequest(ctx, http.MethodPost, path, params, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -246,14 +252,14 @@ func (l *Lbank) RemoveOrder(pair, orderID string) (RemoveOrderResponse, error) {
 
 // QueryOrder finds out information about orders (can pass up to 3 comma separated values to this)
 // Lbank returns an empty string as their []OrderResponse instead of returning an empty array, so when len(tempResp.Orders) > 2 its not empty and should be unmarshalled separately
-func (l *Lbank) QueryOrder(pair, orderIDs string) (QueryOrderFinalResponse, error) {
+func (l *Lbank) QueryOrder(ctx context.Context, pair, orderIDs string) (QueryOrderFinalResponse, error) {
 	var resp QueryOrderFinalResponse
 	var tempResp QueryOrderResponse
 	params := url.Values{}
 	params.Set("symbol", pair)
 	params.Set("order_id", orderIDs)
 	path := fmt.Sprintf("%s/v%s/%s?", l.API.Endpoints.URL, lbankAPIVersion, lbankQueryOrder)
-	err := l.SendAuthHTTPRequest(http.MethodPost, path, params, &tempResp)
+	err := l.SendAuthHTTPRequest(ctx, http.MethodPost, path, params, &tempResp)
 	if err != nil {
 		return resp, err
 	}
@@ -281,7 +287,7 @@ func (l *Lbank) QueryOrder(pair, orderIDs string) (QueryOrderFinalResponse, erro
 
 // QueryOrderHistory finds order info in the past 2 days
 // Lbank returns an empty string as their []OrderResponse instead of returning an empty array, so when len(tempResp.Orders) > 2 its not empty and should be unmarshalled separately
-func (l *Lbank) QueryOrderHistory(pair, pageNumber, pageLength string) (OrderHistoryFinalResponse, error) {
+func (l *Lbank) QueryOrderHistory(ctx context.Context, pair, pageNumber, pageLength string) (OrderHistoryFinalResponse, error) {
 	var resp OrderHistoryFinalResponse
 	var tempResp OrderHistoryResponse
 	params := url.Values{}
@@ -289,7 +295,7 @@ func (l *Lbank) QueryOrderHistory(pair, pageNumber, pageLength string) (OrderHis
 	params.Set("current_page", pageNumber)
 	params.Set("page_length", pageLength)
 	path := fmt.Sprintf("%s/v%s/%s?", l.API.Endpoints.URL, lbankAPIVersion, lbankQueryHistoryOrder)
-	err := l.SendAuthHTTPRequest(http.MethodPost, path, params, &tempResp)
+	err := l.SendAuthHTTPRequest(ctx, http.MethodPost, path, params, &tempResp)
 	if err != nil {
 		return resp, err
 	}
@@ -314,20 +320,20 @@ func (l *Lbank) QueryOrderHistory(pair, pageNumber, pageLength string) (OrderHis
 }
 
 // GetPairInfo finds information about all trading pairs
-func (l *Lbank) GetPairInfo() ([]PairInfoResponse, error) {
+func (l *Lbank) GetPairInfo(ctx context.Context) ([]PairInfoResponse, error) {
 	var resp []PairInfoResponse
 	path := fmt.Sprintf("%s/v%s/%s?", l.API.Endpoints.URL, lbankAPIVersion, lbankPairInfo)
-	return resp, l.SendHTTPRequest(path, &resp)
+	return resp, l.SendHTTPRequest(ctx, path, &resp)
 }
 
 // OrderTransactionDetails gets info about transactions
-func (l *Lbank) OrderTransactionDetails(symbol, orderID string) (TransactionHistoryResp, error) {
+func (l *Lbank) OrderTransactionDetails(ctx context.Context, symbol, orderID string) (TransactionHistoryResp, error) {
 	var resp TransactionHistoryResp
 	params := url.Values{}
 	params.Set("symbol", symbol)
 	params.Set("order_id", orderID)
 	path := fmt.Sprintf("%s/v%s/%s?", l.API.Endpoints.URL, lbankAPIVersion, lbankOrderTransactionDetails)
-	err := l.SendAuthHTTPRequest(http.MethodPost, path, params, &resp)
+	err := l.SendAuthHTTPRequest(ctx, http.MethodPost, path, params, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -340,7 +346,7 @@ func (l *Lbank) OrderTransactionDetails(symbol, orderID string) (TransactionHist
 }
 
 // TransactionHistory stores info about transactions
-func (l *Lbank) TransactionHistory(symbol, transactionType, startDate, endDate, from, direct, size string) (TransactionHistoryResp, error) {
+func (l *Lbank) TransactionHistory(ctx context.Context, symbol, transactionType, startDate, endDate, from, direct, size string) (TransactionHistoryResp, error) {
 	var resp TransactionHistoryResp
 	params := url.Values{}
 	params.Set("symbol", symbol)
@@ -351,7 +357,7 @@ func (l *Lbank) TransactionHistory(symbol, transactionType, startDate, endDate,
 	params.Set("direct", direct)
 	params.Set("size", size)
 	path := fmt.Sprintf("%s/v%s/%s?", l.API.Endpoints.URL, lbankAPIVersion, lbankPastTransactions)
-	err := l.SendAuthHTTPRequest(http.MethodPost, path, params, &resp)
+	err := l.SendAuthHTTPRequest(ctx, http.MethodPost, path, params, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -365,7 +371,7 @@ func (l *Lbank) TransactionHistory(symbol, transactionType, startDate, endDate,
 
 // GetOpenOrders gets opening orders
 // Lbank returns an empty string as their []OrderResponse instead of returning an empty array, so when len(tempResp.Orders) > 2 its not empty and should be unmarshalled separately
-func (l *Lbank) GetOpenOrders(pair, pageNumber, pageLength string) (OpenOrderFinalResponse, error) {
+func (l *Lbank) GetOpenOrders(ctx context.Context, pair, pageNumber, pageLength string) (OpenOrderFinalResponse, error) {
 	var resp OpenOrderFinalResponse
 	var tempResp OpenOrderResponse
 	params := url.Values{}
@@ -373,7 +379,7 @@ func (l *Lbank) GetOpenOrders(pair, pageNumber, pageLength string) (OpenOrderFin
 	params.Set("current_page", pageNumber)
 	params.Set("page_length", pageLength)
 	path := fmt.Sprintf("%s/v%s/%s", l.API.Endpoints.URL, lbankAPIVersion, lbankOpeningOrders)
-	err := l.SendAuthHTTPRequest(http.MethodPost, path, params, &tempResp)
+	err := l.SendAuthHTTPRequest(ctx, http.MethodPost, path, params, &tempResp)
 	if err != nil {
 		return resp, err
 	}
@@ -398,23 +404,23 @@ func (l *Lbank) GetOpenOrders(pair, pageNumber, pageLength string) (OpenOrderFin
 }
 
 // USD2RMBRate finds USD-CNY Rate
-func (l *Lbank) USD2RMBRate() (ExchangeRateResponse, error) {
+func (l *Lbank) USD2RMBRate(ctx context.Context) (ExchangeRateResponse, error) {
 	var resp ExchangeRateResponse
 	path := fmt.Sprintf("%s/v%s/%s", l.API.Endpoints.URL, lbankAPIVersion, lbankUSD2CNYRate)
-	return resp, l.SendHTTPRequest(path, &resp)
+	return resp, l.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetWithdrawConfig gets information about withdrawals
-func (l *Lbank) GetWithdrawConfig(assetCode string) ([]WithdrawConfigResponse, error) {
+func (l *Lbank) GetWithdrawConfig(ctx context.Context, assetCode string) ([]WithdrawConfigResponse, error) {
 	var resp []WithdrawConfigResponse
 	params := url.Values{}
 	params.Set("assetCode", assetCode)
 	path := fmt.Sprintf("%s/v%s/%s?%s", l.API.Endpoints.URL, lbankAPIVersion, lbankWithdrawConfig, params.Encode())
-	return resp, l.SendHTTPRequest(path, &resp)
+	return resp, l.SendHTTPRequest(ctx, path, &resp)
 }
 
 // Withdraw sends a withdrawal request
-func (l *Lbank) Withdraw(account, assetCode, amount, memo, mark, withdrawType string) (WithdrawResponse, error) {
+func (l *Lbank) Withdraw(ctx context.Context, account, assetCode, amount, memo, mark, withdrawType string) (WithdrawResponse, error) {
 	var resp WithdrawResponse
 	params := url.Values{}
 	params.Set("account", account)
@@ -431,7 +437,7 @@ func (l *Lbank) Withdraw(account, assetCode, amount, memo, mark, withdrawType st
 	}
 	path := fmt.Sprintf("%s/v%s/%s", l.API.Endpoints.URL, lbankAPIVersion,
 		lbankWithdraw)
-	err := l.SendAuthHTTPRequest(http.MethodPost, path, params, &resp)
+	err := l.SendAuthHTTPRequest(ctx, http.MethodPost, path, params, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -444,14 +450,14 @@ func (l *Lbank) Withdraw(account, assetCode, amount, memo, mark, withdrawType st
 }
 
 // RevokeWithdraw cancels the withdrawal given the withdrawalID
-func (l *Lbank) RevokeWithdraw(withdrawID string) (RevokeWithdrawResponse, error) {
+func (l *Lbank) RevokeWithdraw(ctx context.Context, withdrawID string) (RevokeWithdrawResponse, error) {
 	var resp RevokeWithdrawResponse
 	params := url.Values{}
 	if withdrawID != "" {
 		params.Set("withdrawId", withdrawID)
 	}
 	path := fmt.Sprintf("%s/v%s/%s?", l.API.Endpoints.URL, lbankAPIVersion, lbankRevokeWithdraw)
-	err := l.SendAuthHTTPRequest(http.MethodPost, path, params, &resp)
+	err := l.SendAuthHTTPRequest(ctx, http.MethodPost, path, params, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -464,7 +470,7 @@ func (l *Lbank) RevokeWithdraw(withdrawID string) (RevokeWithdrawResponse, error
 }
 
 // GetWithdrawalRecords gets withdrawal records
-func (l *Lbank) GetWithdrawalRecords(assetCode, status, pageNo, pageSize string) (WithdrawalResponse, error) {
+func (l *Lbank) GetWithdrawalRecords(ctx context.Context, assetCode, status, pageNo, pageSize string) (WithdrawalResponse, error) {
 	var resp WithdrawalResponse
 	params := url.Values{}
 	params.Set("assetCode", assetCode)
@@ -472,7 +478,7 @@ func (l *Lbank) GetWithdrawalRecords(assetCode, status, pageNo, pageSize string)
 	params.Set("pageNo", pageNo)
 	params.Set("pageSize", pageSize)
 	path := fmt.Sprintf("%s/v%s/%s", l.API.Endpoints.URL, lbankAPIVersion, lbankWithdrawalRecords)
-	err := l.SendAuthHTTPRequest(http.MethodPost, path, params, &resp)
+	err := l.SendAuthHTTPRequest(ctx, http.MethodPost, path, params, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -484,6 +490,43 @@ func (l *Lbank) GetWithdrawalRecords(assetCode, status, pageNo, pageSize string)
 	return resp, nil
 }
 
+// GetWebsocketSubscribeKey returns a key which is required to subscribe to
+// private websocket channels, the key expires after 60 minutes unless
+// refreshed
+func (l *Lbank) GetWebsocketSubscribeKey(ctx context.Context) (string, error) {
+	var resp WsSubscribeKeyResponse
+	path := fmt.Sprintf("%s/v%s/%s", l.API.Endpoints.URL, lbankWsAPIVersion, lbankWsSubscribeKey)
+	err := l.SendAuthHTTPRequest(ctx, http.MethodPost, path, nil, &resp)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.Error != 0 {
+		return "", ErrorCapture(resp.Error)
+	}
+
+	return resp.Key, nil
+}
+
+// RefreshWebsocketSubscribeKey extends the validity of a websocket subscribe
+// key by 60 minutes
+func (l *Lbank) RefreshWebsocketSubscribeKey(ctx context.Context, key string) error {
+	var resp ErrCapture
+	params := url.Values{}
+	params.Set("subscribeKey", key)
+	path := fmt.Sprintf("%s/v%s/%s", l.API.Endpoints.URL, lbankWsAPIVersion, lbankWsRefreshKey)
+	err := l.SendAuthHTTPRequest(ctx, http.MethodPost, path, params, &resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.Error != 0 {
+		return ErrorCapture(resp.Error)
+	}
+
+	return nil
+}
+
 // ErrorCapture captures errors
 func ErrorCapture(code int64) error {
 	msg, ok := errorCodes[code]
@@ -494,8 +537,8 @@ func ErrorCapture(code int64) error {
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (l *Lbank) SendHTTPRequest(path string, result interface{}) error {
-	return l.SendPayload(context.Background(), &request.Item{
+func (l *Lbank) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return l.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -545,7 +588,7 @@ func (l *Lbank) sign(data string) (string, error) {
 }
 
 // SendAuthHTTPRequest sends an authenticated request
-func (l *Lbank) SendAuthHTTPRequest(method, endpoint string, vals url.Values, result interface{}) error {
+func (l *Lbank) SendAuthHTTPRequest(ctx context.Context, method, endpoint string, vals url.Values, result interface{}) error {
 	if !l.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, l.Name)
 	}
@@ -565,7 +608,7 @@ func (l *Lbank) SendAuthHTTPRequest(method, endpoint string, vals url.Values, re
 	headers := make(map[string]string)
 	headers["Content-Type"] = "application/x-www-form-urlencoded"
 
-	return l.SendPayload(context.Background(), &request.Item{
+	return l.SendPayload(ctx, &request.Item{
 		Method:        method,
 		Path:          endpoint,
 		Headers:       headers,
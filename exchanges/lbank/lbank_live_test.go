@@ -0,0 +1,39 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package lbank
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	l.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal(err)
+	}
+	lbankConfig, err := cfg.GetExchangeConfig("Lbank")
+	if err != nil {
+		log.Fatal(err)
+	}
+	lbankConfig.API.AuthenticatedSupport = true
+	lbankConfig.API.Credentials.Key = testAPIKey
+	lbankConfig.API.Credentials.Secret = testAPISecret
+	l.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = l.Setup(lbankConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf(sharedtestvalues.LiveTesting, l.Name, l.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
@@ -0,0 +1,54 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package lbank
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/lbank/lbank.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	l.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal(err)
+	}
+	lbankConfig, err := cfg.GetExchangeConfig("Lbank")
+	if err != nil {
+		log.Fatal(err)
+	}
+	lbankConfig.API.AuthenticatedSupport = true
+	lbankConfig.API.Credentials.Key = testAPIKey
+	lbankConfig.API.Credentials.Secret = testAPISecret
+	l.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = l.Setup(lbankConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+
+	l.HTTPClient = newClient
+	l.API.Endpoints.URL = serverDetails
+	log.Printf(sharedtestvalues.MockTesting, l.Name, l.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
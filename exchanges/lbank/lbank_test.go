@@ -1,13 +1,11 @@
 package lbank
 
 import (
-	"log"
-	"os"
+	"context"
 	"strconv"
 	"testing"
 	"time"
 
-	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/currency"
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
@@ -25,34 +23,13 @@ const (
 
 var l Lbank
 
-func TestMain(m *testing.M) {
-	l.SetDefaults()
-	cfg := config.GetConfig()
-	err := cfg.LoadConfig("../../testdata/configtest.json", true)
-	if err != nil {
-		log.Fatal(err)
-	}
-	lbankConfig, err := cfg.GetExchangeConfig("Lbank")
-	if err != nil {
-		log.Fatal(err)
-	}
-	lbankConfig.API.AuthenticatedSupport = true
-	lbankConfig.API.Credentials.Key = testAPIKey
-	lbankConfig.API.Credentials.Secret = testAPISecret
-	err = l.Setup(lbankConfig)
-	if err != nil {
-		log.Fatal(err)
-	}
-	os.Exit(m.Run())
-}
-
 func areTestAPIKeysSet() bool {
 	return l.AllowAuthenticatedRequest()
 }
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetTicker(testCurrencyPair)
+	_, err := l.GetTicker(context.Background(), testCurrencyPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -60,7 +37,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetTickers(t *testing.T) {
 	t.Parallel()
-	tickers, err := l.GetTickers()
+	tickers, err := l.GetTickers(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -71,7 +48,7 @@ func TestGetTickers(t *testing.T) {
 
 func TestGetCurrencyPairs(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetCurrencyPairs()
+	_, err := l.GetCurrencyPairs(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -79,11 +56,11 @@ func TestGetCurrencyPairs(t *testing.T) {
 
 func TestGetMarketDepths(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetMarketDepths(testCurrencyPair, "600", "1")
+	_, err := l.GetMarketDepths(context.Background(), testCurrencyPair, "600", "1")
 	if err != nil {
 		t.Fatal(err)
 	}
-	a, _ := l.GetMarketDepths(testCurrencyPair, "4", "0")
+	a, _ := l.GetMarketDepths(context.Background(), testCurrencyPair, "4", "0")
 	if len(a.Asks) != 4 {
 		t.Errorf("asks length requested doesnt match the output")
 	}
@@ -91,12 +68,12 @@ func TestGetMarketDepths(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetTrades(testCurrencyPair, "600",
+	_, err := l.GetTrades(context.Background(), testCurrencyPair, "600",
 		strconv.FormatInt(time.Now().Unix(), 10))
 	if err != nil {
 		t.Error(err)
 	}
-	a, err := l.GetTrades(testCurrencyPair, "600", "0")
+	a, err := l.GetTrades(context.Background(), testCurrencyPair, "600", "0")
 	if len(a) != 600 && err != nil {
 		t.Error(err)
 	}
@@ -104,7 +81,7 @@ func TestGetTrades(t *testing.T) {
 
 func TestGetKlines(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetKlines(testCurrencyPair, "600", "minute1",
+	_, err := l.GetKlines(context.Background(), testCurrencyPair, "600", "minute1",
 		strconv.FormatInt(time.Now().Unix(), 10))
 	if err != nil {
 		t.Error(err)
@@ -118,7 +95,7 @@ func TestUpdateOrderbook(t *testing.T) {
 		Base:      currency.ETH,
 		Quote:     currency.BTC}
 
-	_, err := l.UpdateOrderbook(p.Lower(), asset.Spot)
+	_, err := l.UpdateOrderbook(context.Background(), p.Lower(), asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
@@ -129,7 +106,7 @@ func TestGetUserInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := l.GetUserInfo()
+	_, err := l.GetUserInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -141,19 +118,19 @@ func TestCreateOrder(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 	cp := currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "_")
-	_, err := l.CreateOrder(cp.Lower().String(), "what", 1231, 12314)
+	_, err := l.CreateOrder(context.Background(), cp.Lower().String(), "what", 1231, 12314)
 	if err == nil {
 		t.Error("CreateOrder error cannot be nil")
 	}
-	_, err = l.CreateOrder(cp.Lower().String(), order.Buy.Lower(), 0, 0)
+	_, err = l.CreateOrder(context.Background(), cp.Lower().String(), order.Buy.Lower(), 0, 0)
 	if err == nil {
 		t.Error("CreateOrder error cannot be nil")
 	}
-	_, err = l.CreateOrder(cp.Lower().String(), order.Sell.Lower(), 1231, 0)
+	_, err = l.CreateOrder(context.Background(), cp.Lower().String(), order.Sell.Lower(), 1231, 0)
 	if err == nil {
 		t.Error("CreateOrder error cannot be nil")
 	}
-	_, err = l.CreateOrder(cp.Lower().String(), order.Buy.Lower(), 58, 681)
+	_, err = l.CreateOrder(context.Background(), cp.Lower().String(), order.Buy.Lower(), 58, 681)
 	if err != nil {
 		t.Errorf("Unexpected error: %v", err)
 	}
@@ -165,7 +142,7 @@ func TestRemoveOrder(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 	cp := currency.NewPairWithDelimiter(currency.ETH.String(), currency.BTC.String(), "_")
-	_, err := l.RemoveOrder(cp.Lower().String(), "24f7ce27-af1d-4dca-a8c1-ef1cbeec1b23")
+	_, err := l.RemoveOrder(context.Background(), cp.Lower().String(), "24f7ce27-af1d-4dca-a8c1-ef1cbeec1b23")
 	if err != nil {
 		t.Error(err)
 	}
@@ -177,7 +154,7 @@ func TestQueryOrder(t *testing.T) {
 		t.Skip("API keys required but not set, skipping test")
 	}
 	cp := currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "_")
-	_, err := l.QueryOrder(cp.Lower().String(), "1")
+	_, err := l.QueryOrder(context.Background(), cp.Lower().String(), "1")
 	if err != nil {
 		t.Error(err)
 	}
@@ -189,7 +166,7 @@ func TestQueryOrderHistory(t *testing.T) {
 		t.Skip("API keys required but not set, skipping test")
 	}
 	cp := currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "_")
-	_, err := l.QueryOrderHistory(cp.Lower().String(), "1", "100")
+	_, err := l.QueryOrderHistory(context.Background(), cp.Lower().String(), "1", "100")
 	if err != nil {
 		t.Error(err)
 	}
@@ -197,7 +174,7 @@ func TestQueryOrderHistory(t *testing.T) {
 
 func TestGetPairInfo(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetPairInfo()
+	_, err := l.GetPairInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -208,7 +185,7 @@ func TestOrderTransactionDetails(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := l.OrderTransactionDetails(testCurrencyPair, "24f7ce27-af1d-4dca-a8c1-ef1cbeec1b23")
+	_, err := l.OrderTransactionDetails(context.Background(), testCurrencyPair, "24f7ce27-af1d-4dca-a8c1-ef1cbeec1b23")
 	if err != nil {
 		t.Error(err)
 	}
@@ -219,7 +196,7 @@ func TestTransactionHistory(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := l.TransactionHistory(testCurrencyPair, "", "", "", "", "", "")
+	_, err := l.TransactionHistory(context.Background(), testCurrencyPair, "", "", "", "", "", "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -231,7 +208,7 @@ func TestGetOpenOrders(t *testing.T) {
 		t.Skip("API keys required but not set, skipping test")
 	}
 	cp := currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "_")
-	_, err := l.GetOpenOrders(cp.Lower().String(), "1", "50")
+	_, err := l.GetOpenOrders(context.Background(), cp.Lower().String(), "1", "50")
 	if err != nil {
 		t.Error(err)
 	}
@@ -239,7 +216,7 @@ func TestGetOpenOrders(t *testing.T) {
 
 func TestUSD2RMBRate(t *testing.T) {
 	t.Parallel()
-	_, err := l.USD2RMBRate()
+	_, err := l.USD2RMBRate(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -247,7 +224,7 @@ func TestUSD2RMBRate(t *testing.T) {
 
 func TestGetWithdrawConfig(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetWithdrawConfig(currency.ETH.Lower().String())
+	_, err := l.GetWithdrawConfig(context.Background(), currency.ETH.Lower().String())
 	if err != nil {
 		t.Error(err)
 	}
@@ -258,7 +235,7 @@ func TestWithdraw(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
-	_, err := l.Withdraw("", "", "", "", "", "")
+	_, err := l.Withdraw(context.Background(), "", "", "", "", "", "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -269,7 +246,7 @@ func TestGetWithdrawRecords(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := l.GetWithdrawalRecords(currency.ETH.Lower().String(),
+	_, err := l.GetWithdrawalRecords(context.Background(), currency.ETH.Lower().String(),
 		"0", "1", "20")
 	if err != nil {
 		t.Error(err)
@@ -323,7 +300,7 @@ func TestSubmitOrder(t *testing.T) {
 		Amount:   1,
 		ClientID: "meowOrder",
 	}
-	response, err := l.SubmitOrder(orderSubmission)
+	response, err := l.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -340,7 +317,7 @@ func TestCancelOrder(t *testing.T) {
 	var a order.Cancel
 	a.Pair = cp
 	a.ID = "24f7ce27-af1d-4dca-a8c1-ef1cbeec1b23"
-	err := l.CancelOrder(&a)
+	err := l.CancelOrder(context.Background(), &a)
 	if err != nil {
 		t.Error(err)
 	}
@@ -351,7 +328,7 @@ func TestGetOrderInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := l.GetOrderInfo("9ead39f5-701a-400b-b635-d7349eb0f6b")
+	_, err := l.GetOrderInfo(context.Background(), "9ead39f5-701a-400b-b635-d7349eb0f6b")
 	if err != nil {
 		t.Error(err)
 	}
@@ -362,7 +339,7 @@ func TestGetAllOpenOrderID(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := l.getAllOpenOrderID()
+	_, err := l.getAllOpenOrderID(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -375,7 +352,7 @@ func TestGetFeeByType(t *testing.T) {
 	input.Amount = 2
 	input.FeeType = exchange.CryptocurrencyWithdrawalFee
 	input.Pair = cp
-	a, err := l.GetFeeByType(&input)
+	a, err := l.GetFeeByType(context.Background(), &input)
 	if err != nil {
 		t.Error(err)
 	}
@@ -389,7 +366,7 @@ func TestGetAccountInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := l.UpdateAccountInfo()
+	_, err := l.UpdateAccountInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -402,7 +379,7 @@ func TestGetOrderHistory(t *testing.T) {
 	}
 	var input order.GetOrdersRequest
 	input.Side = order.Buy
-	_, err := l.GetOrderHistory(&input)
+	_, err := l.GetOrderHistory(context.Background(), &input)
 	if err != nil {
 		t.Error(err)
 	}
@@ -414,12 +391,12 @@ func TestGetHistoricCandles(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = l.GetHistoricCandles(pair, asset.Spot, time.Now().Add(-24*time.Hour), time.Now(), kline.OneMin)
+	_, err = l.GetHistoricCandles(context.Background(), pair, asset.Spot, time.Now().Add(-24*time.Hour), time.Now(), kline.OneMin)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = l.GetHistoricCandles(pair, asset.Spot, time.Now().Add(-24*time.Hour), time.Now(), kline.OneHour)
+	_, err = l.GetHistoricCandles(context.Background(), pair, asset.Spot, time.Now().Add(-24*time.Hour), time.Now(), kline.OneHour)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -434,7 +411,7 @@ func TestGetHistoricCandlesExtended(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = l.GetHistoricCandlesExtended(pair, asset.Spot, startTime, end, kline.OneMin)
+	_, err = l.GetHistoricCandlesExtended(context.Background(), pair, asset.Spot, startTime, end, kline.OneMin)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -485,3 +462,55 @@ func Test_FormatExchangeKlineInterval(t *testing.T) {
 		})
 	}
 }
+
+func TestWsDepth(t *testing.T) {
+	pressXToJSON := []byte(`{"depth":{"asks":[[9100.5,0.25],[9101,1.2]],"bids":[[9100,0.5],[9099.5,2]]},"count":100,"type":"depth","pair":"btc_usdt","SERVER":"V2","TS":"2020-07-08T17:49:22.722"}`)
+	err := l.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+	p, err := currency.NewPairFromString(testCurrencyPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ob := l.Websocket.Orderbook.GetOrderbook(p, asset.Spot)
+	if ob == nil {
+		t.Fatal("expected orderbook to be loaded")
+	}
+	if len(ob.Bids) != 2 || len(ob.Asks) != 2 {
+		t.Errorf("expected 2 bids and 2 asks, received %d bids and %d asks",
+			len(ob.Bids), len(ob.Asks))
+	}
+}
+
+func TestWsTrade(t *testing.T) {
+	pressXToJSON := []byte(`{"trade":{"volume":6.3607,"amount":77148.9303,"price":12129,"direction":"sell","TS":"2020-07-08T17:49:22.722"},"type":"trade","pair":"btc_usdt","SERVER":"V2","TS":"2020-07-08T17:49:22.722"}`)
+	err := l.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWsTick(t *testing.T) {
+	pressXToJSON := []byte(`{"tick":{"to_cny":76643.5,"high":0.02719761,"vol":497529.7686,"low":0.02603071,"change":2.54,"usd":299.12,"to_usd":11083.66,"dir":"sell","turnover":13224.0186,"latest":0.02698749,"cny":2068.41},"type":"tick","pair":"btc_usdt","SERVER":"V2","TS":"2020-07-08T17:49:22.722"}`)
+	err := l.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWsOrderUpdate(t *testing.T) {
+	pressXToJSON := []byte(`{"orderUpdate":{"amount":"0.0","orderAmt":"0.0002","price":"9100.0","role":"maker","pair":"btc_usdt","type":"buy","uuid":"8a7b7f3c-5e41-4a2c-9b5e-5a3f3c2c1b11","accAmt":"0.0","orderStatus":0,"avgPrice":"0.0","updateTime":1594201762722,"customerID":"","remainAmt":"0.0002"},"pair":"btc_usdt","type":"orderUpdate","SERVER":"V2","TS":"2020-07-08T17:49:22.722"}`)
+	err := l.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWsError(t *testing.T) {
+	pressXToJSON := []byte(`{"SERVER":"V2","action":"subscribe","message":"Invalid order pairs:btc_usd","status":"error","TS":"2020-07-08T17:49:22.722"}`)
+	err := l.wsHandleData(pressXToJSON)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
@@ -233,6 +233,98 @@ type GetAllOpenIDResp struct {
 	OrderID      string
 }
 
+// WsSubscribeKeyResponse stores the key used to subscribe to private
+// websocket channels
+type WsSubscribeKeyResponse struct {
+	ErrCapture `json:",omitempty"`
+	Key        string `json:"data"`
+}
+
+// WsRequest defines a websocket subscription or pong request
+type WsRequest struct {
+	Action       string `json:"action"`
+	Subscribe    string `json:"subscribe,omitempty"`
+	Pair         string `json:"pair,omitempty"`
+	Depth        string `json:"depth,omitempty"`
+	SubscribeKey string `json:"subscribeKey,omitempty"`
+	Pong         string `json:"pong,omitempty"`
+}
+
+// WsResponse defines the fields common to every websocket message
+type WsResponse struct {
+	Action  string `json:"action"`
+	Ping    string `json:"ping"`
+	Type    string `json:"type"`
+	Pair    string `json:"pair"`
+	Server  string `json:"SERVER"`
+	TS      string `json:"TS"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// WsDepth defines an orderbook snapshot push
+type WsDepth struct {
+	Depth struct {
+		Asks [][2]float64 `json:"asks"`
+		Bids [][2]float64 `json:"bids"`
+	} `json:"depth"`
+	Count int64  `json:"count"`
+	Pair  string `json:"pair"`
+	TS    string `json:"TS"`
+}
+
+// WsTrade defines a public trade push
+type WsTrade struct {
+	Trade struct {
+		Volume    float64 `json:"volume"`
+		Amount    float64 `json:"amount"`
+		Price     float64 `json:"price"`
+		Direction string  `json:"direction"`
+		TS        string  `json:"TS"`
+	} `json:"trade"`
+	Pair string `json:"pair"`
+}
+
+// WsTick defines a ticker push
+type WsTick struct {
+	Tick struct {
+		ToCNY    float64 `json:"to_cny"`
+		High     float64 `json:"high"`
+		Volume   float64 `json:"vol"`
+		Low      float64 `json:"low"`
+		Change   float64 `json:"change"`
+		USD      float64 `json:"usd"`
+		ToUSD    float64 `json:"to_usd"`
+		Dir      string  `json:"dir"`
+		Turnover float64 `json:"turnover"`
+		Latest   float64 `json:"latest"`
+		CNY      float64 `json:"cny"`
+	} `json:"tick"`
+	Pair string `json:"pair"`
+	TS   string `json:"TS"`
+}
+
+// WsOrderUpdate defines a change to one of the authenticated account's
+// orders
+type WsOrderUpdate struct {
+	OrderUpdate struct {
+		Amount       float64 `json:"amount,string"`
+		OrderAmount  float64 `json:"orderAmt,string"`
+		Price        float64 `json:"price,string"`
+		Role         string  `json:"role"`
+		Pair         string  `json:"pair"`
+		Type         string  `json:"type"`
+		UUID         string  `json:"uuid"`
+		AccAmount    float64 `json:"accAmt,string"`
+		OrderStatus  int64   `json:"orderStatus"`
+		AveragePrice float64 `json:"avgPrice,string"`
+		UpdateTime   int64   `json:"updateTime"`
+		CustomerID   string  `json:"customerID"`
+		RemainAmount float64 `json:"remainAmt,string"`
+	} `json:"orderUpdate"`
+	Pair string `json:"pair"`
+}
+
 var errorCodes = map[int64]string{
 	10000: "Internal error",
 	10001: "The required parameters can not be empty",
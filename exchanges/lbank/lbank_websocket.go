@@ -0,0 +1,409 @@
+package lbank
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/yurulab/gocryptotrader/common"
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/order"
+	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
+	"github.com/yurulab/gocryptotrader/exchanges/stream"
+	"github.com/yurulab/gocryptotrader/exchanges/ticker"
+	"github.com/yurulab/gocryptotrader/log"
+)
+
+const (
+	lbankWSURL        = "wss://www.lbkex.net/ws/V2/"
+	lbankWSTimeLayout = "2006-01-02T15:04:05.999"
+	lbankWSDepth      = "100"
+
+	wsSubscribe   = "subscribe"
+	wsUnsubscribe = "unsubscribe"
+	wsPing        = "ping"
+	wsPong        = "pong"
+
+	wsDepth       = "depth"
+	wsTrade       = "trade"
+	wsTick        = "tick"
+	wsOrderUpdate = "orderUpdate"
+
+	// Order update statuses
+	wsOrderCancelled       = -1
+	wsOrderOnTrading       = 0
+	wsOrderPartiallyFilled = 1
+	wsOrderFilled          = 2
+	wsOrderCancelling      = 4
+)
+
+// lbankWSLocation is the timezone websocket timestamps are reported in
+var lbankWSLocation = time.FixedZone("CST", 8*60*60)
+
+// WsConnect connects to a websocket feed
+func (l *Lbank) WsConnect() error {
+	if !l.Websocket.IsEnabled() || !l.IsEnabled() {
+		return errors.New(stream.WebsocketNotEnabled)
+	}
+	var dialer websocket.Dialer
+	err := l.Websocket.Conn.Dial(&dialer, http.Header{})
+	if err != nil {
+		return err
+	}
+	if l.Verbose {
+		log.Debugf(log.ExchangeSys, "%s Connected to Websocket.\n", l.Name)
+	}
+	go l.wsReadData()
+
+	if l.Websocket.CanUseAuthenticatedEndpoints() {
+		err = l.wsAuth()
+		if err != nil {
+			l.Websocket.DataHandler <- err
+			l.Websocket.SetCanUseAuthenticatedEndpoints(false)
+		}
+	}
+
+	subs, err := l.generateDefaultSubscriptions()
+	if err != nil {
+		return err
+	}
+	return l.Websocket.SubscribeToChannels(subs)
+}
+
+// wsAuth retrieves a subscribe key for private channels and keeps it alive
+// for the lifetime of the connection
+func (l *Lbank) wsAuth() error {
+	key, err := l.GetWebsocketSubscribeKey(context.Background())
+	if err != nil {
+		return err
+	}
+	l.wsSubscribeKey = key
+	go l.wsRefreshSubscribeKey(key)
+	return nil
+}
+
+// wsRefreshSubscribeKey periodically extends the subscribe key so that
+// private channels are not dropped
+func (l *Lbank) wsRefreshSubscribeKey(key string) {
+	l.Websocket.Wg.Add(1)
+	defer l.Websocket.Wg.Done()
+
+	tick := time.NewTicker(lbankWsKeyRefreshRate)
+	defer tick.Stop()
+	for {
+		select {
+		case <-l.Websocket.ShutdownC:
+			return
+		case <-tick.C:
+			err := l.RefreshWebsocketSubscribeKey(context.Background(), key)
+			if err != nil {
+				l.Websocket.DataHandler <- err
+			}
+		}
+	}
+}
+
+// wsReadData receives and passes on websocket messages for processing
+func (l *Lbank) wsReadData() {
+	l.Websocket.Wg.Add(1)
+	defer l.Websocket.Wg.Done()
+
+	for {
+		resp := l.Websocket.Conn.ReadMessage()
+		if resp.Raw == nil {
+			return
+		}
+		err := l.wsHandleData(resp.Raw)
+		if err != nil {
+			l.Websocket.DataHandler <- err
+		}
+	}
+}
+
+func (l *Lbank) wsHandleData(respRaw []byte) error {
+	var resp WsResponse
+	err := json.Unmarshal(respRaw, &resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.Action == wsPing {
+		return l.Websocket.Conn.SendJSONMessage(WsRequest{
+			Action: wsPong,
+			Pong:   resp.Ping,
+		})
+	}
+
+	if resp.Status == "error" {
+		return fmt.Errorf("%s websocket error: %s", l.Name, resp.Message)
+	}
+
+	switch resp.Type {
+	case wsDepth:
+		var depth WsDepth
+		err = json.Unmarshal(respRaw, &depth)
+		if err != nil {
+			return err
+		}
+		p, err := currency.NewPairDelimiter(depth.Pair, currency.UnderscoreDelimiter)
+		if err != nil {
+			return err
+		}
+		updated, err := parseWsTime(depth.TS)
+		if err != nil {
+			return err
+		}
+		newOrderbook := orderbook.Base{
+			Pair:         p,
+			LastUpdated:  updated,
+			AssetType:    asset.Spot,
+			ExchangeName: l.Name,
+		}
+		for i := range depth.Depth.Bids {
+			newOrderbook.Bids = append(newOrderbook.Bids, orderbook.Item{
+				Price:  depth.Depth.Bids[i][0],
+				Amount: depth.Depth.Bids[i][1],
+			})
+		}
+		for i := range depth.Depth.Asks {
+			newOrderbook.Asks = append(newOrderbook.Asks, orderbook.Item{
+				Price:  depth.Depth.Asks[i][0],
+				Amount: depth.Depth.Asks[i][1],
+			})
+		}
+		// Depth pushes are full snapshots of the requested depth
+		return l.Websocket.Orderbook.LoadSnapshot(&newOrderbook)
+	case wsTrade:
+		var trade WsTrade
+		err = json.Unmarshal(respRaw, &trade)
+		if err != nil {
+			return err
+		}
+		p, err := currency.NewPairDelimiter(trade.Pair, currency.UnderscoreDelimiter)
+		if err != nil {
+			return err
+		}
+		tradeTime, err := parseWsTime(trade.Trade.TS)
+		if err != nil {
+			return err
+		}
+		side, err := order.StringToOrderSide(trade.Trade.Direction)
+		if err != nil {
+			l.Websocket.DataHandler <- order.ClassificationError{
+				Exchange: l.Name,
+				Err:      err,
+			}
+		}
+		l.Websocket.DataHandler <- stream.TradeData{
+			Timestamp:    tradeTime,
+			CurrencyPair: p,
+			AssetType:    asset.Spot,
+			Exchange:     l.Name,
+			EventType:    order.UnknownType,
+			Price:        trade.Trade.Price,
+			Amount:       trade.Trade.Volume,
+			Side:         side,
+		}
+	case wsTick:
+		var tick WsTick
+		err = json.Unmarshal(respRaw, &tick)
+		if err != nil {
+			return err
+		}
+		p, err := currency.NewPairDelimiter(tick.Pair, currency.UnderscoreDelimiter)
+		if err != nil {
+			return err
+		}
+		tickTime, err := parseWsTime(tick.TS)
+		if err != nil {
+			return err
+		}
+		l.Websocket.DataHandler <- &ticker.Price{
+			ExchangeName: l.Name,
+			Last:         tick.Tick.Latest,
+			High:         tick.Tick.High,
+			Low:          tick.Tick.Low,
+			Volume:       tick.Tick.Volume,
+			QuoteVolume:  tick.Tick.Turnover,
+			LastUpdated:  tickTime,
+			AssetType:    asset.Spot,
+			Pair:         p,
+		}
+	case wsOrderUpdate:
+		var update WsOrderUpdate
+		err = json.Unmarshal(respRaw, &update)
+		if err != nil {
+			return err
+		}
+		l.wsProcessOrderUpdate(&update)
+	default:
+		l.Websocket.DataHandler <- stream.UnhandledMessageWarning{Message: l.Name + stream.UnhandledMessage + string(respRaw)}
+	}
+	return nil
+}
+
+func (l *Lbank) wsProcessOrderUpdate(update *WsOrderUpdate) {
+	orderID := update.OrderUpdate.UUID
+	var status order.Status
+	switch update.OrderUpdate.OrderStatus {
+	case wsOrderCancelled:
+		status = order.Cancelled
+	case wsOrderOnTrading:
+		status = order.Active
+	case wsOrderPartiallyFilled:
+		status = order.PartiallyFilled
+	case wsOrderFilled:
+		status = order.Filled
+	case wsOrderCancelling:
+		status = order.PendingCancel
+	default:
+		status = order.UnknownStatus
+		l.Websocket.DataHandler <- order.ClassificationError{
+			Exchange: l.Name,
+			OrderID:  orderID,
+			Err:      fmt.Errorf("unknown order status %d", update.OrderUpdate.OrderStatus),
+		}
+	}
+
+	// Market orders are suffixed with _market e.g. buy_market
+	oType := order.Limit
+	sideString := update.OrderUpdate.Type
+	if len(sideString) > 7 && sideString[len(sideString)-7:] == "_market" {
+		oType = order.Market
+		sideString = sideString[:len(sideString)-7]
+	}
+	side, err := order.StringToOrderSide(sideString)
+	if err != nil {
+		l.Websocket.DataHandler <- order.ClassificationError{
+			Exchange: l.Name,
+			OrderID:  orderID,
+			Err:      err,
+		}
+	}
+
+	p, err := currency.NewPairDelimiter(update.OrderUpdate.Pair, currency.UnderscoreDelimiter)
+	if err != nil {
+		l.Websocket.DataHandler <- order.ClassificationError{
+			Exchange: l.Name,
+			OrderID:  orderID,
+			Err:      err,
+		}
+	}
+
+	l.Websocket.DataHandler <- &order.Detail{
+		Price:           update.OrderUpdate.Price,
+		Amount:          update.OrderUpdate.OrderAmount,
+		ExecutedAmount:  update.OrderUpdate.AccAmount,
+		RemainingAmount: update.OrderUpdate.RemainAmount,
+		Exchange:        l.Name,
+		ID:              orderID,
+		ClientOrderID:   update.OrderUpdate.CustomerID,
+		Type:            oType,
+		Side:            side,
+		Status:          status,
+		AssetType:       asset.Spot,
+		LastUpdated:     time.Unix(0, update.OrderUpdate.UpdateTime*int64(time.Millisecond)),
+		Pair:            p,
+	}
+}
+
+// parseWsTime parses websocket timestamps which are reported without a zone
+func parseWsTime(ts string) (time.Time, error) {
+	return time.ParseInLocation(lbankWSTimeLayout, ts, lbankWSLocation)
+}
+
+func (l *Lbank) generateDefaultSubscriptions() ([]stream.ChannelSubscription, error) {
+	var channels = []string{wsDepth, wsTrade, wsTick}
+	enabledCurrencies, err := l.GetEnabledPairs(asset.Spot)
+	if err != nil {
+		return nil, err
+	}
+	var subscriptions []stream.ChannelSubscription
+	for i := range channels {
+		for j := range enabledCurrencies {
+			subscriptions = append(subscriptions, stream.ChannelSubscription{
+				Channel:  channels[i],
+				Currency: enabledCurrencies[j],
+				Asset:    asset.Spot,
+			})
+		}
+	}
+	if l.Websocket.CanUseAuthenticatedEndpoints() {
+		subscriptions = append(subscriptions, stream.ChannelSubscription{
+			Channel: wsOrderUpdate,
+			Asset:   asset.Spot,
+		})
+	}
+	return subscriptions, nil
+}
+
+// wsGenerateRequest builds the subscription request for a channel
+func (l *Lbank) wsGenerateRequest(action string, sub *stream.ChannelSubscription) (WsRequest, error) {
+	req := WsRequest{
+		Action:    action,
+		Subscribe: sub.Channel,
+	}
+	switch sub.Channel {
+	case wsOrderUpdate:
+		req.Pair = "all"
+		req.SubscribeKey = l.wsSubscribeKey
+		return req, nil
+	case wsDepth:
+		req.Depth = lbankWSDepth
+	}
+	fPair, err := l.FormatExchangeCurrency(sub.Currency, sub.Asset)
+	if err != nil {
+		return req, err
+	}
+	req.Pair = fPair.String()
+	return req, nil
+}
+
+// Subscribe sends a websocket message to receive data from the channel
+func (l *Lbank) Subscribe(channelsToSubscribe []stream.ChannelSubscription) error {
+	var errs common.Errors
+	for i := range channelsToSubscribe {
+		req, err := l.wsGenerateRequest(wsSubscribe, &channelsToSubscribe[i])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		err = l.Websocket.Conn.SendJSONMessage(req)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		l.Websocket.AddSuccessfulSubscriptions(channelsToSubscribe[i])
+	}
+	if errs != nil {
+		return errs
+	}
+	return nil
+}
+
+// Unsubscribe sends a websocket message to stop receiving data from the channel
+func (l *Lbank) Unsubscribe(channelsToUnsubscribe []stream.ChannelSubscription) error {
+	var errs common.Errors
+	for i := range channelsToUnsubscribe {
+		req, err := l.wsGenerateRequest(wsUnsubscribe, &channelsToUnsubscribe[i])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		err = l.Websocket.Conn.SendJSONMessage(req)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		l.Websocket.RemoveSuccessfulUnsubscriptions(channelsToUnsubscribe[i])
+	}
+	if errs != nil {
+		return errs
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package lbank
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -18,6 +19,7 @@ import (
 	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
 	"github.com/yurulab/gocryptotrader/exchanges/protocol"
 	"github.com/yurulab/gocryptotrader/exchanges/request"
+	"github.com/yurulab/gocryptotrader/exchanges/stream"
 	"github.com/yurulab/gocryptotrader/exchanges/ticker"
 	"github.com/yurulab/gocryptotrader/log"
 	"github.com/yurulab/gocryptotrader/portfolio/withdraw"
@@ -37,7 +39,7 @@ func (l *Lbank) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if l.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = l.UpdateTradablePairs(true)
+		err = l.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -63,7 +65,8 @@ func (l *Lbank) SetDefaults() {
 
 	l.Features = exchange.Features{
 		Supports: exchange.FeaturesSupported{
-			REST: true,
+			REST:      true,
+			Websocket: true,
 			RESTCapabilities: protocol.Features{
 				TickerBatching:      true,
 				TickerFetching:      true,
@@ -82,6 +85,16 @@ func (l *Lbank) SetDefaults() {
 				TradeFee:            true,
 				CryptoWithdrawalFee: true,
 			},
+			WebsocketCapabilities: protocol.Features{
+				TickerFetching:         true,
+				TradeFetching:          true,
+				OrderbookFetching:      true,
+				Subscribe:              true,
+				Unsubscribe:            true,
+				AuthenticatedEndpoints: true,
+				GetOrders:              true,
+				GetOrder:               true,
+			},
 			WithdrawPermissions: exchange.AutoWithdrawCryptoWithAPIPermission |
 				exchange.NoFiatWithdrawals,
 		},
@@ -110,6 +123,11 @@ func (l *Lbank) SetDefaults() {
 
 	l.API.Endpoints.URLDefault = lbankAPIURL
 	l.API.Endpoints.URL = l.API.Endpoints.URLDefault
+	l.API.Endpoints.WebsocketURL = lbankWSURL
+	l.Websocket = stream.New()
+	l.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
+	l.WebsocketResponseCheckTimeout = exchange.DefaultWebsocketResponseCheckTimeout
+	l.WebsocketOrderbookBufferLimit = exchange.DefaultWebsocketOrderbookBufferLimit
 }
 
 // Setup sets exchange configuration profile
@@ -131,7 +149,30 @@ func (l *Lbank) Setup(exch *config.ExchangeConfig) error {
 			log.Errorf(log.ExchangeSys, "%s couldn't load private key, setting authenticated support to false", l.Name)
 		}
 	}
-	return nil
+
+	err = l.Websocket.Setup(&stream.WebsocketSetup{
+		Enabled:                          exch.Features.Enabled.Websocket,
+		Verbose:                          exch.Verbose,
+		AuthenticatedWebsocketAPISupport: exch.API.AuthenticatedWebsocketSupport,
+		WebsocketTimeout:                 exch.WebsocketTrafficTimeout,
+		DefaultURL:                       lbankWSURL,
+		ExchangeName:                     exch.Name,
+		RunningURL:                       exch.API.Endpoints.WebsocketURL,
+		Connector:                        l.WsConnect,
+		Subscriber:                       l.Subscribe,
+		UnSubscriber:                     l.Unsubscribe,
+		GenerateSubscriptions:            l.generateDefaultSubscriptions,
+		Features:                         &l.Features.Supports.WebsocketCapabilities,
+		OrderbookBufferLimit:             exch.WebsocketOrderbookBufferLimit,
+	})
+	if err != nil {
+		return err
+	}
+
+	return l.Websocket.SetupNewConnection(stream.ConnectionSetup{
+		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
+		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+	})
 }
 
 // Start starts the LakeBTC go routine
@@ -146,6 +187,11 @@ func (l *Lbank) Start(wg *sync.WaitGroup) {
 // Run implements the Lbank wrapper
 func (l *Lbank) Run() {
 	if l.Verbose {
+		log.Debugf(log.ExchangeSys,
+			"%s Websocket: %s (url: %s).\n",
+			l.Name,
+			common.IsEnabled(l.Websocket.IsEnabled()),
+			l.Websocket.GetWebsocketURL())
 		l.PrintEnabledPairs()
 	}
 
@@ -153,15 +199,15 @@ func (l *Lbank) Run() {
 		return
 	}
 
-	err := l.UpdateTradablePairs(false)
+	err := l.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys, "%s failed to update tradable pairs. Err: %s", l.Name, err)
 	}
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (l *Lbank) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	currencies, err := l.GetCurrencyPairs()
+func (l *Lbank) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	currencies, err := l.GetCurrencyPairs(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -170,8 +216,8 @@ func (l *Lbank) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (l *Lbank) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := l.FetchTradablePairs(asset.Spot)
+func (l *Lbank) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := l.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -184,8 +230,8 @@ func (l *Lbank) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (l *Lbank) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
-	tickerInfo, err := l.GetTickers()
+func (l *Lbank) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+	tickerInfo, err := l.GetTickers(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -217,7 +263,7 @@ func (l *Lbank) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pri
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (l *Lbank) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (l *Lbank) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	fpair, err := l.FormatExchangeCurrency(p, assetType)
 	if err != nil {
 		return nil, err
@@ -225,28 +271,28 @@ func (l *Lbank) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Pric
 
 	tickerNew, err := ticker.GetTicker(l.Name, fpair, assetType)
 	if err != nil {
-		return l.UpdateTicker(p, assetType)
+		return l.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (l *Lbank) FetchOrderbook(currency currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (l *Lbank) FetchOrderbook(ctx context.Context, currency currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(l.Name, currency, assetType)
 	if err != nil {
-		return l.UpdateOrderbook(currency, assetType)
+		return l.UpdateOrderbook(ctx, currency, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (l *Lbank) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (l *Lbank) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
 	fpair, err := l.FormatExchangeCurrency(p, assetType)
 	if err != nil {
 		return nil, err
 	}
-	a, err := l.GetMarketDepths(fpair.String(), "60", "1")
+	a, err := l.GetMarketDepths(ctx, fpair.String(), "60", "1")
 	if err != nil {
 		return orderBook, err
 	}
@@ -273,9 +319,9 @@ func (l *Lbank) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbo
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // Lbank exchange
-func (l *Lbank) UpdateAccountInfo() (account.Holdings, error) {
+func (l *Lbank) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var info account.Holdings
-	data, err := l.GetUserInfo()
+	data, err := l.GetUserInfo(ctx)
 	if err != nil {
 		return info, err
 	}
@@ -311,10 +357,10 @@ func (l *Lbank) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (l *Lbank) FetchAccountInfo() (account.Holdings, error) {
+func (l *Lbank) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(l.Name)
 	if err != nil {
-		return l.UpdateAccountInfo()
+		return l.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -322,17 +368,17 @@ func (l *Lbank) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (l *Lbank) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (l *Lbank) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data within the timeframe provided.
-func (l *Lbank) GetExchangeHistory(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+func (l *Lbank) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // SubmitOrder submits a new order
-func (l *Lbank) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (l *Lbank) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var resp order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return resp, err
@@ -349,7 +395,7 @@ func (l *Lbank) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		return resp, err
 	}
 
-	tempResp, err := l.CreateOrder(
+	tempResp, err := l.CreateOrder(ctx,
 		fpair.String(),
 		s.Side.String(),
 		s.Amount,
@@ -367,24 +413,24 @@ func (l *Lbank) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (l *Lbank) ModifyOrder(action *order.Modify) (string, error) {
+func (l *Lbank) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (l *Lbank) CancelOrder(order *order.Cancel) error {
+func (l *Lbank) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	fpair, err := l.FormatExchangeCurrency(order.Pair, order.AssetType)
 	if err != nil {
 		return err
 	}
-	_, err = l.RemoveOrder(fpair.String(), order.ID)
+	_, err = l.RemoveOrder(ctx, fpair.String(), order.ID)
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (l *Lbank) CancelAllOrders(orders *order.Cancel) (order.CancelAllResponse, error) {
+func (l *Lbank) CancelAllOrders(ctx context.Context, orders *order.Cancel) (order.CancelAllResponse, error) {
 	var resp order.CancelAllResponse
-	orderIDs, err := l.getAllOpenOrderID()
+	orderIDs, err := l.getAllOpenOrderID(ctx)
 	if err != nil {
 		return resp, nil
 	}
@@ -402,7 +448,7 @@ func (l *Lbank) CancelAllOrders(orders *order.Cancel) (order.CancelAllResponse,
 				tempSlice = append(tempSlice, orderIDs[key][y])
 				if y%3 == 0 {
 					input = strings.Join(tempSlice, ",")
-					CancelResponse, err2 := l.RemoveOrder(key, input)
+					CancelResponse, err2 := l.RemoveOrder(ctx, key, input)
 					if err2 != nil {
 						return resp, err2
 					}
@@ -420,7 +466,7 @@ func (l *Lbank) CancelAllOrders(orders *order.Cancel) (order.CancelAllResponse,
 				y++
 			}
 			input = strings.Join(tempSlice, ",")
-			CancelResponse, err2 := l.RemoveOrder(key, input)
+			CancelResponse, err2 := l.RemoveOrder(ctx, key, input)
 			if err2 != nil {
 				return resp, err2
 			}
@@ -439,9 +485,9 @@ func (l *Lbank) CancelAllOrders(orders *order.Cancel) (order.CancelAllResponse,
 }
 
 // GetOrderInfo returns information on a current open order
-func (l *Lbank) GetOrderInfo(orderID string) (order.Detail, error) {
+func (l *Lbank) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var resp order.Detail
-	orderIDs, err := l.getAllOpenOrderID()
+	orderIDs, err := l.getAllOpenOrderID(ctx)
 	if err != nil {
 		return resp, err
 	}
@@ -451,7 +497,7 @@ func (l *Lbank) GetOrderInfo(orderID string) (order.Detail, error) {
 			if val[i] != orderID {
 				continue
 			}
-			tempResp, err := l.QueryOrder(key, orderID)
+			tempResp, err := l.QueryOrder(ctx, key, orderID)
 			if err != nil {
 				return resp, err
 			}
@@ -485,7 +531,7 @@ func (l *Lbank) GetOrderInfo(orderID string) (order.Detail, error) {
 			resp.Amount = tempResp.Orders[0].Amount
 			resp.ExecutedAmount = tempResp.Orders[0].DealAmount
 			resp.RemainingAmount = tempResp.Orders[0].Amount - tempResp.Orders[0].DealAmount
-			resp.Fee, err = l.GetFeeByType(&exchange.FeeBuilder{
+			resp.Fee, err = l.GetFeeByType(ctx, &exchange.FeeBuilder{
 				FeeType:       exchange.CryptocurrencyTradeFee,
 				Amount:        tempResp.Orders[0].Amount,
 				PurchasePrice: tempResp.Orders[0].Price})
@@ -498,14 +544,14 @@ func (l *Lbank) GetOrderInfo(orderID string) (order.Detail, error) {
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (l *Lbank) GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error) {
+func (l *Lbank) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (l *Lbank) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
-	resp, err := l.Withdraw(withdrawRequest.Crypto.Address, withdrawRequest.Currency.String(),
+func (l *Lbank) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	resp, err := l.Withdraw(ctx, withdrawRequest.Crypto.Address, withdrawRequest.Currency.String(),
 		strconv.FormatFloat(withdrawRequest.Amount, 'f', -1, 64), "",
 		withdrawRequest.Description, "")
 	if err != nil {
@@ -518,28 +564,28 @@ func (l *Lbank) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (
 
 // WithdrawFiatFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (l *Lbank) WithdrawFiatFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (l *Lbank) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a withdrawal is
 // submitted
-func (l *Lbank) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (l *Lbank) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (l *Lbank) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+func (l *Lbank) GetActiveOrders(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
 	var finalResp []order.Detail
 	var resp order.Detail
-	tempData, err := l.getAllOpenOrderID()
+	tempData, err := l.getAllOpenOrderID(ctx)
 	if err != nil {
 		return finalResp, err
 	}
 
 	for key, val := range tempData {
 		for x := range val {
-			tempResp, err := l.QueryOrder(key, val[x])
+			tempResp, err := l.QueryOrder(ctx, key, val[x])
 			if err != nil {
 				return finalResp, err
 			}
@@ -574,7 +620,7 @@ func (l *Lbank) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]ord
 			resp.Date = time.Unix(tempResp.Orders[0].CreateTime, 9)
 			resp.ExecutedAmount = tempResp.Orders[0].DealAmount
 			resp.RemainingAmount = tempResp.Orders[0].Amount - tempResp.Orders[0].DealAmount
-			resp.Fee, err = l.GetFeeByType(&exchange.FeeBuilder{
+			resp.Fee, err = l.GetFeeByType(ctx, &exchange.FeeBuilder{
 				FeeType:       exchange.CryptocurrencyTradeFee,
 				Amount:        tempResp.Orders[0].Amount,
 				PurchasePrice: tempResp.Orders[0].Price})
@@ -601,7 +647,7 @@ func (l *Lbank) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]ord
 
 // GetOrderHistory retrieves account order information *
 // Can Limit response to specific order status
-func (l *Lbank) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+func (l *Lbank) GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
 	var finalResp []order.Detail
 	var resp order.Detail
 	var tempCurr currency.Pairs
@@ -621,12 +667,12 @@ func (l *Lbank) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]ord
 		}
 
 		b := int64(1)
-		tempResp, err := l.QueryOrderHistory(fpair.String(), strconv.FormatInt(b, 10), "200")
+		tempResp, err := l.QueryOrderHistory(ctx, fpair.String(), strconv.FormatInt(b, 10), "200")
 		if err != nil {
 			return finalResp, err
 		}
 		for len(tempResp.Orders) != 0 {
-			tempResp, err = l.QueryOrderHistory(fpair.String(), strconv.FormatInt(b, 10), "200")
+			tempResp, err = l.QueryOrderHistory(ctx, fpair.String(), strconv.FormatInt(b, 10), "200")
 			if err != nil {
 				return finalResp, err
 			}
@@ -662,7 +708,7 @@ func (l *Lbank) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]ord
 				resp.Date = time.Unix(tempResp.Orders[x].CreateTime, 9)
 				resp.ExecutedAmount = tempResp.Orders[x].DealAmount
 				resp.RemainingAmount = tempResp.Orders[x].Price - tempResp.Orders[x].DealAmount
-				resp.Fee, err = l.GetFeeByType(&exchange.FeeBuilder{
+				resp.Fee, err = l.GetFeeByType(ctx, &exchange.FeeBuilder{
 					FeeType:       exchange.CryptocurrencyTradeFee,
 					Amount:        tempResp.Orders[x].Amount,
 					PurchasePrice: tempResp.Orders[x].Price})
@@ -678,13 +724,13 @@ func (l *Lbank) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]ord
 }
 
 // GetFeeByType returns an estimate of fee based on the type of transaction *
-func (l *Lbank) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (l *Lbank) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var resp float64
 	if feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		return feeBuilder.Amount * feeBuilder.PurchasePrice * 0.002, nil
 	}
 	if feeBuilder.FeeType == exchange.CryptocurrencyWithdrawalFee {
-		withdrawalFee, err := l.GetWithdrawConfig(feeBuilder.Pair.Base.Lower().String())
+		withdrawalFee, err := l.GetWithdrawConfig(ctx, feeBuilder.Pair.Base.Lower().String())
 		if err != nil {
 			return resp, err
 		}
@@ -704,7 +750,7 @@ func (l *Lbank) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
 }
 
 // GetAllOpenOrderID returns all open orders by currency pairs
-func (l *Lbank) getAllOpenOrderID() (map[string][]string, error) {
+func (l *Lbank) getAllOpenOrderID(ctx context.Context) (map[string][]string, error) {
 	allPairs, err := l.GetEnabledPairs(asset.Spot)
 	if err != nil {
 		return nil, err
@@ -716,7 +762,7 @@ func (l *Lbank) getAllOpenOrderID() (map[string][]string, error) {
 			return nil, err
 		}
 		b := int64(1)
-		tempResp, err := l.GetOpenOrders(fpair.String(),
+		tempResp, err := l.GetOpenOrders(ctx, fpair.String(),
 			strconv.FormatInt(b, 10),
 			"200")
 		if err != nil {
@@ -724,7 +770,7 @@ func (l *Lbank) getAllOpenOrderID() (map[string][]string, error) {
 		}
 		tempData := len(tempResp.Orders)
 		for tempData != 0 {
-			tempResp, err = l.GetOpenOrders(fpair.String(),
+			tempResp, err = l.GetOpenOrders(ctx, fpair.String(),
 				strconv.FormatInt(b, 10),
 				"200")
 			if err != nil {
@@ -748,8 +794,8 @@ func (l *Lbank) getAllOpenOrderID() (map[string][]string, error) {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (l *Lbank) ValidateCredentials() error {
-	_, err := l.UpdateAccountInfo()
+func (l *Lbank) ValidateCredentials(ctx context.Context) error {
+	_, err := l.UpdateAccountInfo(ctx)
 	return l.CheckTransientError(err)
 }
 
@@ -771,7 +817,7 @@ func (l *Lbank) FormatExchangeKlineInterval(in kline.Interval) string {
 }
 
 // GetHistoricCandles returns candles between a time period for a set time interval
-func (l *Lbank) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (l *Lbank) GetHistoricCandles(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if !l.KlineIntervalEnabled(interval) {
 		return kline.Item{}, kline.ErrorKline{
 			Interval: interval,
@@ -783,7 +829,7 @@ func (l *Lbank) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end
 		return kline.Item{}, err
 	}
 
-	data, err := l.GetKlines(formattedPair.String(),
+	data, err := l.GetKlines(ctx, formattedPair.String(),
 		strconv.FormatInt(int64(l.Features.Enabled.Kline.ResultLimit), 10),
 		l.FormatExchangeKlineInterval(interval),
 		strconv.FormatInt(start.Unix(), 10))
@@ -814,7 +860,7 @@ func (l *Lbank) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end
 }
 
 // GetHistoricCandlesExtended returns candles between a time period for a set time interval
-func (l *Lbank) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (l *Lbank) GetHistoricCandlesExtended(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if !l.KlineIntervalEnabled(interval) {
 		return kline.Item{}, kline.ErrorKline{
 			Interval: interval,
@@ -834,7 +880,7 @@ func (l *Lbank) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, sta
 	}
 
 	for x := range dates {
-		data, err := l.GetKlines(formattedPair.String(),
+		data, err := l.GetKlines(ctx, formattedPair.String(),
 			strconv.FormatInt(int64(l.Features.Enabled.Kline.ResultLimit), 10),
 			l.FormatExchangeKlineInterval(interval),
 			strconv.FormatInt(dates[x].Start.UTC().Unix(), 10))
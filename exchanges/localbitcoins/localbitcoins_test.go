@@ -1,6 +1,7 @@
 package localbitcoins
 
 import (
+	"context"
 	"testing"
 
 	"github.com/yurulab/gocryptotrader/common"
@@ -24,7 +25,7 @@ var l LocalBitcoins
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
 
-	_, err := l.GetTicker()
+	_, err := l.GetTicker(context.Background())
 	if err != nil {
 		t.Errorf("GetTicker() returned: %s", err)
 	}
@@ -33,7 +34,7 @@ func TestGetTicker(t *testing.T) {
 func TestGetTradableCurrencies(t *testing.T) {
 	t.Parallel()
 
-	_, err := l.GetTradableCurrencies()
+	_, err := l.GetTradableCurrencies(context.Background())
 	if err != nil {
 		t.Errorf("GetTradableCurrencies() returned: %s", err)
 	}
@@ -41,7 +42,7 @@ func TestGetTradableCurrencies(t *testing.T) {
 
 func TestGetAccountInfo(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetAccountInformation("", true)
+	_, err := l.GetAccountInformation(context.Background(), "", true)
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Errorf("Could not get AccountInformation: %s", err)
@@ -54,7 +55,7 @@ func TestGetAccountInfo(t *testing.T) {
 
 func TestGetads(t *testing.T) {
 	t.Parallel()
-	_, err := l.Getads("")
+	_, err := l.Getads(context.Background(), "")
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Errorf("Could not get ads: %s", err)
@@ -69,7 +70,7 @@ func TestEditAd(t *testing.T) {
 	t.Parallel()
 
 	var edit AdEdit
-	err := l.EditAd(&edit, "1337")
+	err := l.EditAd(context.Background(), &edit, "1337")
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Errorf("Could not edit order: %s", err)
@@ -97,7 +98,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	t.Parallel()
 	var feeBuilder = setFeeBuilder()
-	l.GetFeeByType(feeBuilder)
+	l.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -206,7 +207,7 @@ func TestGetActiveOrders(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := l.GetActiveOrders(&getOrdersRequest)
+	_, err := l.GetActiveOrders(context.Background(), &getOrdersRequest)
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Errorf("Could not get active orders: %s", err)
@@ -224,7 +225,7 @@ func TestGetOrderHistory(t *testing.T) {
 		Type: order.AnyType,
 	}
 
-	_, err := l.GetOrderHistory(&getOrdersRequest)
+	_, err := l.GetOrderHistory(context.Background(), &getOrdersRequest)
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Errorf("Could not get order history: %s", err)
@@ -259,7 +260,7 @@ func TestSubmitOrder(t *testing.T) {
 		Amount:   1,
 		ClientID: "meowOrder",
 	}
-	response, err := l.SubmitOrder(orderSubmission)
+	response, err := l.SubmitOrder(context.Background(), orderSubmission)
 	switch {
 	case areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) && !mockTests:
 		t.Errorf("Order failed to be placed: %v", err)
@@ -283,7 +284,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		Pair:          currency.NewPair(currency.LTC, currency.BTC),
 	}
 
-	err := l.CancelOrder(orderCancellation)
+	err := l.CancelOrder(context.Background(), orderCancellation)
 	switch {
 	case !areTestAPIKeysSet() && err == nil && !mockTests:
 		t.Error("Expecting an error when no keys are set")
@@ -307,7 +308,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		Pair:          currency.NewPair(currency.LTC, currency.BTC),
 	}
 
-	resp, err := l.CancelAllOrders(orderCancellation)
+	resp, err := l.CancelAllOrders(context.Background(), orderCancellation)
 	switch {
 	case !areTestAPIKeysSet() && err == nil && !mockTests:
 		t.Error("Expecting an error when no keys are set")
@@ -325,7 +326,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 func TestModifyOrder(t *testing.T) {
 	t.Parallel()
 
-	_, err := l.ModifyOrder(&order.Modify{})
+	_, err := l.ModifyOrder(context.Background(), &order.Modify{})
 	if err != common.ErrFunctionNotSupported {
 		t.Error("ModifyOrder() error", err)
 	}
@@ -347,7 +348,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := l.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := l.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	switch {
 	case !areTestAPIKeysSet() && err == nil && !mockTests:
 		t.Error("Expecting an error when no keys are set")
@@ -362,7 +363,7 @@ func TestWithdrawFiat(t *testing.T) {
 	t.Parallel()
 
 	var withdrawFiatRequest = withdraw.Request{}
-	_, err := l.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := l.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'",
 			common.ErrFunctionNotSupported,
@@ -374,7 +375,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	t.Parallel()
 
 	var withdrawFiatRequest = withdraw.Request{}
-	_, err := l.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := l.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'",
 			common.ErrFunctionNotSupported,
@@ -385,7 +386,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
 
-	_, err := l.GetDepositAddress(currency.BTC, "")
+	_, err := l.GetDepositAddress(context.Background(), currency.BTC, "")
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Error("GetDepositAddress() error", err)
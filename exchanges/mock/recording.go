@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -46,17 +47,20 @@ func HTTPRecord(res *http.Response, service string, respContents []byte) error {
 	}
 	service = strings.ToLower(service)
 
-	fileout := filepath.Join(DefaultDirectory, service, service+".json")
-
-	contents, err := ioutil.ReadFile(fileout)
-	if err != nil {
-		return err
-	}
+	fileout := filepath.Join(getRecordDirectory(), service, service+".json")
 
 	var m VCRMock
-	err = json.Unmarshal(contents, &m)
-	if err != nil {
+	contents, err := ioutil.ReadFile(fileout)
+	switch {
+	case os.IsNotExist(err):
+		// First recording for this service, the file is created below
+	case err != nil:
 		return err
+	default:
+		err = json.Unmarshal(contents, &m)
+		if err != nil {
+			return err
+		}
 	}
 
 	if m.Routes == nil {
@@ -64,7 +68,7 @@ func HTTPRecord(res *http.Response, service string, respContents []byte) error {
 	}
 
 	var httpResponse HTTPResponse
-	cleanedContents, err := CheckResponsePayload(respContents)
+	cleanedContents, err := CheckResponsePayload(scrubSecrets(respContents))
 	if err != nil {
 		return err
 	}
@@ -84,7 +88,7 @@ func HTTPRecord(res *http.Response, service string, respContents []byte) error {
 		if bodyErr != nil {
 			return bodyErr
 		}
-		body = string(payload)
+		body = string(scrubSecrets(payload))
 	}
 
 	switch res.Request.Header.Get(contentType) {
@@ -117,7 +121,11 @@ func HTTPRecord(res *http.Response, service string, respContents []byte) error {
 		return err
 	}
 
-	httpResponse.QueryString, err = GetFilteredURLVals(res.Request.URL.Query())
+	query, err := url.ParseQuery(string(scrubSecrets([]byte(res.Request.URL.RawQuery))))
+	if err != nil {
+		return err
+	}
+	httpResponse.QueryString, err = GetFilteredURLVals(query)
 	if err != nil {
 		return err
 	}
@@ -243,6 +251,16 @@ func GetFilteredHeader(res *http.Response) (http.Header, error) {
 		}
 	}
 
+	for k, v := range res.Request.Header {
+		if isSensitiveHeader(k) {
+			res.Request.Header.Set(k, "")
+			continue
+		}
+		for i := range v {
+			v[i] = string(scrubSecrets([]byte(v[i])))
+		}
+	}
+
 	return res.Request.Header, nil
 }
 
@@ -254,11 +272,9 @@ func GetFilteredURLVals(vals url.Values) (string, error) {
 		return "", err
 	}
 
-	for key, val := range vals {
-		for i := range items.Variables {
-			if strings.EqualFold(items.Variables[i], val[0]) {
-				vals.Set(key, "")
-			}
+	for key := range vals {
+		if IsExcluded(key, items.Variables) {
+			vals.Set(key, "")
 		}
 	}
 	return vals.Encode(), nil
@@ -397,6 +413,71 @@ var excludedList Exclusion
 var m sync.Mutex
 var set bool
 var exclusionFile = DefaultDirectory + "exclusion.json"
+var recordDirectory = DefaultDirectory
+var secrets [][]byte
+
+// minSecretLength stops short values such as the default "Key" credential
+// placeholders from being scrubbed out of every recording
+const minSecretLength = 8
+
+// sensitiveHeaderParts are header name fragments which are always excluded
+// from recordings as they carry credentials or request signatures
+var sensitiveHeaderParts = []string{"key",
+	"sign",
+	"passphrase",
+	"token",
+	"auth"}
+
+// SetRecordDirectory sets the directory HTTP recordings and the exclusion list
+// are read from and written to, defaulting to DefaultDirectory
+func SetRecordDirectory(dir string) {
+	m.Lock()
+	defer m.Unlock()
+	recordDirectory = dir
+	exclusionFile = filepath.Join(dir, "exclusion.json")
+	set = false
+}
+
+func getRecordDirectory() string {
+	m.Lock()
+	defer m.Unlock()
+	return recordDirectory
+}
+
+// AddSecrets registers credential values which are scrubbed wherever they
+// appear in recorded requests and responses, regardless of the field name
+func AddSecrets(values ...string) {
+	m.Lock()
+	defer m.Unlock()
+	for i := range values {
+		if len(values[i]) < minSecretLength {
+			continue
+		}
+		secrets = append(secrets, []byte(values[i]))
+	}
+}
+
+// scrubSecrets removes all registered secret values from the data
+func scrubSecrets(data []byte) []byte {
+	m.Lock()
+	defer m.Unlock()
+	for i := range secrets {
+		data = bytes.Replace(data, secrets[i], nil, -1)
+	}
+	return data
+}
+
+// isSensitiveHeader returns whether the header name looks like it carries
+// credentials or a signature
+func isSensitiveHeader(name string) bool {
+	name = strings.ToLower(name)
+	for i := range sensitiveHeaderParts {
+		if strings.Contains(name, sensitiveHeaderParts[i]) {
+			return true
+		}
+	}
+	return false
+}
 
 var defaultExcludedHeaders = []string{"Key",
 	"X-Mbx-Apikey",
@@ -2,8 +2,11 @@ package mock
 
 import (
 	"encoding/json"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -207,3 +210,84 @@ func TestGetExcludedItems(t *testing.T) {
 		t.Error("Variable exclusion list not popoulated")
 	}
 }
+
+func TestHTTPRecord(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-mock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	SetRecordDirectory(dir)
+	defer SetRecordDirectory(DefaultDirectory)
+
+	const secret = "SuperSecretAPIKey123"
+	AddSecrets(secret, "Key")
+	defer func() {
+		m.Lock()
+		secrets = nil
+		m.Unlock()
+	}()
+
+	req, err := http.NewRequest(http.MethodGet,
+		"https://api.test.com/v1/balance?apikey="+secret+"&currency=btc",
+		nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-Test-Signature", "deadbeef")
+	req.Header.Set("X-Request-Owner", secret)
+	req.Header.Set("Accept", "application/json")
+	resp := &http.Response{Request: req}
+
+	err = HTTPRecord(resp, "TestExchange",
+		[]byte(`{"owner":"`+secret+`","balance":1.5,"Key":"value"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "testexchange", "testexchange.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), secret) {
+		t.Error("secret value recorded")
+	}
+	if strings.Contains(string(data), "deadbeef") {
+		t.Error("signature header recorded")
+	}
+
+	var v VCRMock
+	if err = json.Unmarshal(data, &v); err != nil {
+		t.Fatal(err)
+	}
+	r := v.Routes["/v1/balance"][http.MethodGet]
+	if len(r) != 1 {
+		t.Fatalf("expected 1 recorded response, received %v", len(r))
+	}
+	if r[0].Headers["Accept"][0] != "application/json" {
+		t.Error("non sensitive header should be kept")
+	}
+	if !strings.Contains(r[0].QueryString, "currency=btc") {
+		t.Error("non sensitive query value should be kept")
+	}
+	if !strings.Contains(string(r[0].Data), `"Key": "value"`) {
+		t.Error("short secrets should not be scrubbed")
+	}
+
+	if _, err = os.Stat(filepath.Join(dir, "exclusion.json")); err != nil {
+		t.Error("exclusion list should be created in the record directory")
+	}
+}
+
+func TestIsSensitiveHeader(t *testing.T) {
+	for _, h := range []string{"X-MBX-APIKEY", "Authorization", "OK-ACCESS-SIGN", "CB-ACCESS-PASSPHRASE", "X-Auth-Token"} {
+		if !isSensitiveHeader(h) {
+			t.Errorf("expected %s to be sensitive", h)
+		}
+	}
+	for _, h := range []string{"Content-Type", "Accept", "User-Agent"} {
+		if isSensitiveHeader(h) {
+			t.Errorf("expected %s to not be sensitive", h)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package nonce
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// vars related to nonce stores
+var (
+	// FileLockTimeout is how long a file store waits for the lock file before
+	// giving up
+	FileLockTimeout = time.Second * 5
+	// FileLockStale is the age after which a lock file is assumed to have been
+	// abandoned by a crashed process and is broken
+	FileLockStale = time.Second * 10
+
+	fileLockRetryDelay = time.Millisecond * 5
+
+	errInvalidLeaseSize = errors.New("nonce lease size must be greater than zero")
+	errNoStoreKey       = errors.New("nonce store key is empty")
+)
+
+// Store persists the highest nonce handed out per key so that nonces keep
+// increasing across restarts and between bot instances
+type Store interface {
+	// Lease atomically reserves n consecutive nonces for the key, none of
+	// which are lower than min, and returns the first of them
+	Lease(key string, min, n int64) (int64, error)
+}
+
+// LeaseRange returns the first and last nonce of the next lease given the
+// highest nonce handed out so far
+func LeaseRange(stored, min, n int64) (start, end int64) {
+	start = stored + 1
+	if min > start {
+		start = min
+	}
+	return start, start + n - 1
+}
+
+// CheckLease validates the arguments to a Store lease
+func CheckLease(key string, n int64) error {
+	if key == "" {
+		return errNoStoreKey
+	}
+	if n <= 0 {
+		return errInvalidLeaseSize
+	}
+	return nil
+}
+
+// FileStore is a Store backed by a JSON file, guarded between processes by a
+// lock file next to it
+type FileStore struct {
+	path string
+	m    sync.Mutex
+}
+
+// NewFileStore returns a Store persisting nonces to the supplied path
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// Lease implements Store
+func (f *FileStore) Lease(key string, min, n int64) (int64, error) {
+	if err := CheckLease(key, n); err != nil {
+		return 0, err
+	}
+
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	unlock, err := lockFile(f.path + ".lock")
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	values := make(map[string]int64)
+	data, err := ioutil.ReadFile(f.path)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return 0, err
+	case len(data) > 0:
+		if err = json.Unmarshal(data, &values); err != nil {
+			return 0, fmt.Errorf("unable to parse nonce store %s: %s", f.path, err)
+		}
+	}
+
+	start, end := LeaseRange(values[key], min, n)
+	values[key] = end
+
+	data, err = json.MarshalIndent(values, "", " ")
+	if err != nil {
+		return 0, err
+	}
+	if err = writeFileAtomic(f.path, data); err != nil {
+		return 0, err
+	}
+	return start, nil
+}
+
+// lockFile creates the lock file exclusively, waiting for other holders to
+// release it, and returns a function removing it
+func lockFile(path string) (func(), error) {
+	deadline := time.Now().Add(FileLockTimeout)
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(path) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+		if breakStaleLock(path) {
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for nonce store lock %s", path)
+		}
+		time.Sleep(fileLockRetryDelay)
+	}
+}
+
+// breakStaleLock removes the lock file if it is older than FileLockStale,
+// returning whether it did. Waiters take a second lock exclusively and check
+// the age again before removing, so a lock freshly created by a waiter which
+// broke it first is never removed by another
+func breakStaleLock(path string) bool {
+	if !isStale(path) {
+		return false
+	}
+	breaker := path + ".break"
+	f, err := os.OpenFile(breaker, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		// A breaker left behind by a process which crashed while breaking
+		// the lock is removed in turn once it is stale
+		if os.IsExist(err) && isStale(breaker) {
+			os.Remove(breaker)
+		}
+		return false
+	}
+	f.Close()
+	defer os.Remove(breaker)
+	if !isStale(path) {
+		return false
+	}
+	return os.Remove(path) == nil
+}
+
+// isStale returns whether the file exists and was last modified more than
+// FileLockStale ago
+func isStale(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && time.Since(info.ModTime()) > FileLockStale
+}
+
+// writeFileAtomic writes data to a temporary file and renames it over path so
+// readers never see a partial file
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+// Leaser hands out nonces from ranges leased from a Store, only going back to
+// the store once the current range is used up
+type Leaser struct {
+	store Store
+	key   string
+	size  int64
+	next  int64
+	end   int64
+	m     sync.Mutex
+}
+
+// NewLeaser returns a Leaser for the key leasing size nonces at a time, a
+// size of one or less leases every nonce individually. A size above one is
+// only safe when a single process uses the key, as the nonces of ranges held
+// by different processes interleave and exchanges requiring strictly
+// increasing nonces reject those sent after a higher one
+func NewLeaser(s Store, key string, size int64) *Leaser {
+	if size < 1 {
+		size = 1
+	}
+	return &Leaser{store: s, key: key, size: size}
+}
+
+// Next returns the next nonce, which is not lower than min when a new range
+// has to be leased
+func (l *Leaser) Next(min int64) (Value, error) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	if l.next == 0 || l.next > l.end {
+		start, err := l.store.Lease(l.key, min, l.size)
+		if err != nil {
+			return 0, err
+		}
+		l.next, l.end = start, start+l.size-1
+	}
+	v := l.next
+	l.next++
+	return Value(v), nil
+}
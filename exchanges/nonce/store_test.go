@@ -0,0 +1,206 @@
+package nonce
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLeaseRange(t *testing.T) {
+	start, end := LeaseRange(0, 100, 10)
+	if start != 100 || end != 109 {
+		t.Errorf("expected 100-109, received %v-%v", start, end)
+	}
+	start, end = LeaseRange(200, 100, 1)
+	if start != 201 || end != 201 {
+		t.Errorf("expected 201-201, received %v-%v", start, end)
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "nonce.json")
+
+	if _, err = NewFileStore(path).Lease("", 0, 1); err != errNoStoreKey {
+		t.Errorf("expected %v, received %v", errNoStoreKey, err)
+	}
+	if _, err = NewFileStore(path).Lease("test", 0, 0); err != errInvalidLeaseSize {
+		t.Errorf("expected %v, received %v", errInvalidLeaseSize, err)
+	}
+
+	start, err := NewFileStore(path).Lease("test", 1000, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if start != 1000 {
+		t.Errorf("expected 1000, received %v", start)
+	}
+
+	// Separate stores on the same file simulate separate bot instances
+	stores := []*FileStore{NewFileStore(path), NewFileStore(path)}
+	var wg sync.WaitGroup
+	var m sync.Mutex
+	seen := make(map[int64]bool)
+	for x := 0; x < 20; x++ {
+		wg.Add(1)
+		go func(s *FileStore) {
+			defer wg.Done()
+			v, err := s.Lease("test", 0, 1)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			m.Lock()
+			defer m.Unlock()
+			if v < 1010 || seen[v] {
+				t.Errorf("nonce %v leased twice or below previous lease", v)
+			}
+			seen[v] = true
+		}(stores[x%2])
+	}
+	wg.Wait()
+
+	start, err = NewFileStore(path).Lease("test", 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if start != 1030 {
+		t.Errorf("expected 1030, received %v", start)
+	}
+
+	start, err = NewFileStore(path).Lease("other", 5, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if start != 5 {
+		t.Errorf("expected 5, received %v", start)
+	}
+
+	if _, err = os.Stat(path + ".lock"); !os.IsNotExist(err) {
+		t.Error("expected lock file to be removed")
+	}
+}
+
+func TestFileStoreStaleLock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "nonce.json")
+
+	if err = ioutil.WriteFile(path+".lock", nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-FileLockStale * 2)
+	if err = os.Chtimes(path+".lock", old, old); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = NewFileStore(path).Lease("test", 1, 1); err != nil {
+		t.Error(err)
+	}
+
+	if err = ioutil.WriteFile(path+".lock", nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	timeout := FileLockTimeout
+	FileLockTimeout = time.Millisecond * 20
+	defer func() { FileLockTimeout = timeout }()
+	if _, err = NewFileStore(path).Lease("test", 1, 1); err == nil {
+		t.Error("expected lock timeout error")
+	}
+}
+
+func TestBreakStaleLock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	lock := filepath.Join(dir, "nonce.json.lock")
+	old := time.Now().Add(-FileLockStale * 2)
+
+	if breakStaleLock(lock) {
+		t.Error("expected missing lock not to be broken")
+	}
+	if err = ioutil.WriteFile(lock, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if breakStaleLock(lock) {
+		t.Error("expected fresh lock not to be broken")
+	}
+
+	// Another waiter is breaking the lock
+	if err = os.Chtimes(lock, old, old); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(lock+".break", nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if breakStaleLock(lock) {
+		t.Error("expected lock being broken by another waiter to be left")
+	}
+
+	// The other waiter crashed while breaking the lock
+	if err = os.Chtimes(lock+".break", old, old); err != nil {
+		t.Fatal(err)
+	}
+	if breakStaleLock(lock) {
+		t.Error("expected stale breaker to be removed first")
+	}
+	if !breakStaleLock(lock) {
+		t.Error("expected stale lock to be broken")
+	}
+	if _, err = os.Stat(lock); !os.IsNotExist(err) {
+		t.Error("expected stale lock to be removed")
+	}
+	if _, err = os.Stat(lock + ".break"); !os.IsNotExist(err) {
+		t.Error("expected breaker to be removed")
+	}
+}
+
+type testStore struct {
+	stored int64
+	calls  int
+	err    error
+}
+
+func (s *testStore) Lease(_ string, min, n int64) (int64, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	s.calls++
+	start, end := LeaseRange(s.stored, min, n)
+	s.stored = end
+	return start, nil
+}
+
+func TestLeaser(t *testing.T) {
+	s := &testStore{}
+	l := NewLeaser(s, "test", 5)
+	for i := int64(0); i < 7; i++ {
+		v, err := l.Next(100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != Value(100+i) {
+			t.Errorf("expected %v, received %v", 100+i, v)
+		}
+	}
+	if s.calls != 2 {
+		t.Errorf("expected 2 leases, received %v", s.calls)
+	}
+
+	s.err = errors.New("store down")
+	if _, err := NewLeaser(s, "test", 0).Next(1); err == nil {
+		t.Error("expected store error")
+	}
+}
@@ -0,0 +1,48 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package okcoin
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	o.SetDefaults()
+	o.ExchangeName = OKGroupExchange
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Okcoin load config error", err)
+	}
+	okcoinConfig, err := cfg.GetExchangeConfig(OKGroupExchange)
+	if err != nil {
+		log.Fatalf("%v Setup() init error", OKGroupExchange)
+	}
+	if okcoinConfig.Features.Enabled.Websocket {
+		websocketEnabled = true
+	}
+
+	okcoinConfig.API.AuthenticatedSupport = true
+	okcoinConfig.API.AuthenticatedWebsocketSupport = true
+	okcoinConfig.API.Credentials.Key = apiKey
+	okcoinConfig.API.Credentials.Secret = apiSecret
+	okcoinConfig.API.Credentials.ClientID = passphrase
+	okcoinConfig.API.Endpoints.WebsocketURL = o.API.Endpoints.WebsocketURL
+	o.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = o.Setup(okcoinConfig)
+	if err != nil {
+		log.Fatal("OKCoin setup error", err)
+	}
+	testSetupRan = true
+	log.Printf(sharedtestvalues.LiveTesting, o.Name, o.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
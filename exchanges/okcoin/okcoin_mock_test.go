@@ -0,0 +1,62 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package okcoin
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/okcoin international/okcoin international.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	o.SetDefaults()
+	o.ExchangeName = OKGroupExchange
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Okcoin load config error", err)
+	}
+	okcoinConfig, err := cfg.GetExchangeConfig(OKGroupExchange)
+	if err != nil {
+		log.Fatalf("%v Setup() init error", OKGroupExchange)
+	}
+	if okcoinConfig.Features.Enabled.Websocket {
+		websocketEnabled = true
+	}
+
+	okcoinConfig.API.AuthenticatedSupport = true
+	okcoinConfig.API.AuthenticatedWebsocketSupport = true
+	okcoinConfig.API.Credentials.Key = apiKey
+	okcoinConfig.API.Credentials.Secret = apiSecret
+	okcoinConfig.API.Credentials.ClientID = passphrase
+	okcoinConfig.API.Endpoints.WebsocketURL = o.API.Endpoints.WebsocketURL
+	o.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = o.Setup(okcoinConfig)
+	if err != nil {
+		log.Fatal("OKCoin setup error", err)
+	}
+	testSetupRan = true
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+
+	o.HTTPClient = newClient
+	o.API.Endpoints.URL = serverDetails + "/api/"
+	log.Printf(sharedtestvalues.MockTesting, o.Name, o.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
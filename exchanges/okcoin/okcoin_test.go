@@ -1,17 +1,15 @@
 package okcoin
 
 import (
+	"context"
 	"encoding/json"
-	"log"
 	"net/http"
-	"os"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/yurulab/gocryptotrader/common"
-	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/core"
 	"github.com/yurulab/gocryptotrader/currency"
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
@@ -19,7 +17,6 @@ import (
 	"github.com/yurulab/gocryptotrader/exchanges/kline"
 	"github.com/yurulab/gocryptotrader/exchanges/okgroup"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
-	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/yurulab/gocryptotrader/exchanges/stream"
 	"github.com/yurulab/gocryptotrader/portfolio/withdraw"
 )
@@ -45,38 +42,6 @@ func TestSetRealOrderDefaults(t *testing.T) {
 	}
 }
 
-// TestSetup Sets defaults for test environment
-func TestMain(m *testing.M) {
-	o.SetDefaults()
-	o.ExchangeName = OKGroupExchange
-	cfg := config.GetConfig()
-	err := cfg.LoadConfig("../../testdata/configtest.json", true)
-	if err != nil {
-		log.Fatal("Okcoin load config error", err)
-	}
-	okcoinConfig, err := cfg.GetExchangeConfig(OKGroupExchange)
-	if err != nil {
-		log.Fatalf("%v Setup() init error", OKGroupExchange)
-	}
-	if okcoinConfig.Features.Enabled.Websocket {
-		websocketEnabled = true
-	}
-
-	okcoinConfig.API.AuthenticatedSupport = true
-	okcoinConfig.API.AuthenticatedWebsocketSupport = true
-	okcoinConfig.API.Credentials.Key = apiKey
-	okcoinConfig.API.Credentials.Secret = apiSecret
-	okcoinConfig.API.Credentials.ClientID = passphrase
-	okcoinConfig.API.Endpoints.WebsocketURL = o.API.Endpoints.WebsocketURL
-	o.Websocket = sharedtestvalues.NewTestWebsocket()
-	err = o.Setup(okcoinConfig)
-	if err != nil {
-		log.Fatal("OKCoin setup error", err)
-	}
-	testSetupRan = true
-	os.Exit(m.Run())
-}
-
 func areTestAPIKeysSet() bool {
 	return o.ValidateAPICredentials()
 }
@@ -93,13 +58,13 @@ func testStandardErrorHandling(t *testing.T, err error) {
 
 // TestGetAccountCurrencies API endpoint test
 func TestGetAccountCurrencies(t *testing.T) {
-	_, err := o.GetAccountCurrencies()
+	_, err := o.GetAccountCurrencies(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountWalletInformation API endpoint test
 func TestGetAccountWalletInformation(t *testing.T) {
-	resp, err := o.GetAccountWalletInformation("")
+	resp, err := o.GetAccountWalletInformation(context.Background(), "")
 	if areTestAPIKeysSet() {
 		if err != nil {
 			t.Error(err)
@@ -114,7 +79,7 @@ func TestGetAccountWalletInformation(t *testing.T) {
 
 // TestGetAccountWalletInformationForCurrency API endpoint test
 func TestGetAccountWalletInformationForCurrency(t *testing.T) {
-	resp, err := o.GetAccountWalletInformation(currency.BTC.String())
+	resp, err := o.GetAccountWalletInformation(context.Background(), currency.BTC.String())
 	if areTestAPIKeysSet() {
 		if err != nil {
 			t.Error(err)
@@ -136,7 +101,7 @@ func TestTransferAccountFunds(t *testing.T) {
 		From:     6,
 		To:       1,
 	}
-	_, err := o.TransferAccountFunds(request)
+	_, err := o.TransferAccountFunds(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -151,13 +116,13 @@ func TestAccountWithdrawRequest(t *testing.T) {
 		ToAddress:   core.BitcoinDonationAddress,
 		Fee:         1,
 	}
-	_, err := o.AccountWithdraw(request)
+	_, err := o.AccountWithdraw(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountWithdrawalFee API endpoint test
 func TestGetAccountWithdrawalFee(t *testing.T) {
-	resp, err := o.GetAccountWithdrawalFee("")
+	resp, err := o.GetAccountWithdrawalFee(context.Background(), "")
 	if areTestAPIKeysSet() {
 		if err != nil {
 			t.Error(err)
@@ -172,7 +137,7 @@ func TestGetAccountWithdrawalFee(t *testing.T) {
 
 // TestGetWithdrawalFeeForCurrency API endpoint test
 func TestGetAccountWithdrawalFeeForCurrency(t *testing.T) {
-	resp, err := o.GetAccountWithdrawalFee(currency.BTC.String())
+	resp, err := o.GetAccountWithdrawalFee(context.Background(), currency.BTC.String())
 	if areTestAPIKeysSet() {
 		if err != nil {
 			t.Error(err)
@@ -187,49 +152,49 @@ func TestGetAccountWithdrawalFeeForCurrency(t *testing.T) {
 
 // TestGetAccountWithdrawalHistory API endpoint test
 func TestGetAccountWithdrawalHistory(t *testing.T) {
-	_, err := o.GetAccountWithdrawalHistory("")
+	_, err := o.GetAccountWithdrawalHistory(context.Background(), "")
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountWithdrawalHistoryForCurrency API endpoint test
 func TestGetAccountWithdrawalHistoryForCurrency(t *testing.T) {
-	_, err := o.GetAccountWithdrawalHistory(currency.BTC.String())
+	_, err := o.GetAccountWithdrawalHistory(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountBillDetails API endpoint test
 func TestGetAccountBillDetails(t *testing.T) {
-	_, err := o.GetAccountBillDetails(okgroup.GetAccountBillDetailsRequest{})
+	_, err := o.GetAccountBillDetails(context.Background(), okgroup.GetAccountBillDetailsRequest{})
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountDepositAddressForCurrency API endpoint test
 func TestGetAccountDepositAddressForCurrency(t *testing.T) {
-	_, err := o.GetAccountDepositAddressForCurrency(currency.BTC.String())
+	_, err := o.GetAccountDepositAddressForCurrency(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountDepositHistory API endpoint test
 func TestGetAccountDepositHistory(t *testing.T) {
-	_, err := o.GetAccountDepositHistory("")
+	_, err := o.GetAccountDepositHistory(context.Background(), "")
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountDepositHistoryForCurrency API endpoint test
 func TestGetAccountDepositHistoryForCurrency(t *testing.T) {
-	_, err := o.GetAccountDepositHistory(currency.BTC.String())
+	_, err := o.GetAccountDepositHistory(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSpotTradingAccounts API endpoint test
 func TestGetSpotTradingAccounts(t *testing.T) {
-	_, err := o.GetSpotTradingAccounts()
+	_, err := o.GetSpotTradingAccounts(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSpotTradingAccountsForCurrency API endpoint test
 func TestGetSpotTradingAccountsForCurrency(t *testing.T) {
-	_, err := o.GetSpotTradingAccountForCurrency(currency.BTC.String())
+	_, err := o.GetSpotTradingAccountForCurrency(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
@@ -239,7 +204,7 @@ func TestGetSpotBillDetailsForCurrency(t *testing.T) {
 		Currency: currency.BTC.String(),
 		Limit:    100,
 	}
-	_, err := o.GetSpotBillDetailsForCurrency(request)
+	_, err := o.GetSpotBillDetailsForCurrency(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -249,7 +214,7 @@ func TestGetSpotBillDetailsForCurrencyBadLimit(t *testing.T) {
 		Currency: currency.BTC.String(),
 		Limit:    -1,
 	}
-	_, err := o.GetSpotBillDetailsForCurrency(request)
+	_, err := o.GetSpotBillDetailsForCurrency(context.Background(), request)
 	if areTestAPIKeysSet() && err == nil {
 		t.Errorf("Expecting an error when invalid request sent")
 	}
@@ -266,7 +231,7 @@ func TestPlaceSpotOrderLimit(t *testing.T) {
 		Size:         "100",
 	}
 
-	_, err := o.PlaceSpotOrder(&request)
+	_, err := o.PlaceSpotOrder(context.Background(), &request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -281,7 +246,7 @@ func TestPlaceSpotOrderMarket(t *testing.T) {
 		Notional:     "100",
 	}
 
-	_, err := o.PlaceSpotOrder(&request)
+	_, err := o.PlaceSpotOrder(context.Background(), &request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -300,7 +265,7 @@ func TestPlaceMultipleSpotOrders(t *testing.T) {
 		ord,
 	}
 
-	_, errs := o.PlaceMultipleSpotOrders(request)
+	_, errs := o.PlaceMultipleSpotOrders(context.Background(), request)
 	if len(errs) > 0 {
 		testStandardErrorHandling(t, errs[0])
 	}
@@ -324,7 +289,7 @@ func TestPlaceMultipleSpotOrdersOverCurrencyLimits(t *testing.T) {
 		ord,
 	}
 
-	_, errs := o.PlaceMultipleSpotOrders(request)
+	_, errs := o.PlaceMultipleSpotOrders(context.Background(), request)
 	if errs[0].Error() != "maximum 4 orders for each pair" {
 		t.Error("Expecting an error when more than 4 orders for a pair supplied", errs[0])
 	}
@@ -356,7 +321,7 @@ func TestPlaceMultipleSpotOrdersOverPairLimits(t *testing.T) {
 		request = append(request, ord)
 	}
 
-	_, errs := o.PlaceMultipleSpotOrders(request)
+	_, errs := o.PlaceMultipleSpotOrders(context.Background(), request)
 	if errs[0].Error() != "up to 4 trading pairs" {
 		t.Error("Expecting an error when more than 4 trading pairs supplied", errs[0])
 	}
@@ -370,7 +335,7 @@ func TestCancelSpotOrder(t *testing.T) {
 		OrderID:      1234,
 	}
 
-	_, err := o.CancelSpotOrder(request)
+	_, err := o.CancelSpotOrder(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -382,7 +347,7 @@ func TestCancelMultipleSpotOrders(t *testing.T) {
 		OrderIDs:     []int64{1, 2, 3, 4},
 	}
 
-	cancellations, err := o.CancelMultipleSpotOrders(request)
+	cancellations, err := o.CancelMultipleSpotOrders(context.Background(), request)
 	testStandardErrorHandling(t, err)
 	for _, cancellationsPerCurrency := range cancellations {
 		for _, cancellation := range cancellationsPerCurrency {
@@ -401,7 +366,7 @@ func TestCancelMultipleSpotOrdersOverCurrencyLimits(t *testing.T) {
 		OrderIDs:     []int64{1, 2, 3, 4, 5},
 	}
 
-	_, err := o.CancelMultipleSpotOrders(request)
+	_, err := o.CancelMultipleSpotOrders(context.Background(), request)
 	if err.Error() != "maximum 4 order cancellations for each pair" {
 		t.Error("Expecting an error when more than 4 orders for a pair supplied", err)
 	}
@@ -413,14 +378,14 @@ func TestGetSpotOrders(t *testing.T) {
 		InstrumentID: spotCurrency,
 		Status:       "all",
 	}
-	_, err := o.GetSpotOrders(request)
+	_, err := o.GetSpotOrders(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSpotOpenOrders API endpoint test
 func TestGetSpotOpenOrders(t *testing.T) {
 	request := okgroup.GetSpotOpenOrdersRequest{}
-	_, err := o.GetSpotOpenOrders(request)
+	_, err := o.GetSpotOpenOrders(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -430,7 +395,7 @@ func TestGetSpotOrder(t *testing.T) {
 		OrderID:      "-1234",
 		InstrumentID: currency.NewPairWithDelimiter(currency.BTC.String(), currency.USD.String(), "-").Upper().String(),
 	}
-	_, err := o.GetSpotOrder(request)
+	_, err := o.GetSpotOrder(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -440,13 +405,13 @@ func TestGetSpotTransactionDetails(t *testing.T) {
 		OrderID:      1234,
 		InstrumentID: spotCurrency,
 	}
-	_, err := o.GetSpotTransactionDetails(request)
+	_, err := o.GetSpotTransactionDetails(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSpotTokenPairDetails API endpoint test
 func TestGetSpotTokenPairDetails(t *testing.T) {
-	_, err := o.GetSpotTokenPairDetails()
+	_, err := o.GetSpotTokenPairDetails(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -454,7 +419,7 @@ func TestGetSpotTokenPairDetails(t *testing.T) {
 
 // TestGetSpotAllTokenPairsInformation API endpoint test
 func TestGetSpotAllTokenPairsInformation(t *testing.T) {
-	_, err := o.GetSpotAllTokenPairsInformation()
+	_, err := o.GetSpotAllTokenPairsInformation(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -462,7 +427,7 @@ func TestGetSpotAllTokenPairsInformation(t *testing.T) {
 
 // TestGetSpotAllTokenPairsInformationForCurrency API endpoint test
 func TestGetSpotAllTokenPairsInformationForCurrency(t *testing.T) {
-	_, err := o.GetSpotAllTokenPairsInformationForCurrency(spotCurrency)
+	_, err := o.GetSpotAllTokenPairsInformationForCurrency(context.Background(), spotCurrency)
 	if err != nil {
 		t.Error(err)
 	}
@@ -473,7 +438,7 @@ func TestGetSpotFilledOrdersInformation(t *testing.T) {
 	request := okgroup.GetSpotFilledOrdersInformationRequest{
 		InstrumentID: spotCurrency,
 	}
-	_, err := o.GetSpotFilledOrdersInformation(request)
+	_, err := o.GetSpotFilledOrdersInformation(context.Background(), request)
 	if err != nil {
 		t.Error(err)
 	}
@@ -486,7 +451,7 @@ func TestGetSpotMarketData(t *testing.T) {
 		InstrumentID: spotCurrency,
 		Granularity:  "604800",
 	}
-	_, err := o.GetMarketData(request)
+	_, err := o.GetMarketData(context.Background(), request)
 	if err != nil {
 		t.Error(err)
 	}
@@ -494,13 +459,13 @@ func TestGetSpotMarketData(t *testing.T) {
 
 // TestGetMarginTradingAccounts API endpoint test
 func TestGetMarginTradingAccounts(t *testing.T) {
-	_, err := o.GetMarginTradingAccounts()
+	_, err := o.GetMarginTradingAccounts(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetMarginTradingAccountsForCurrency API endpoint test
 func TestGetMarginTradingAccountsForCurrency(t *testing.T) {
-	_, err := o.GetMarginTradingAccountsForCurrency(spotCurrency)
+	_, err := o.GetMarginTradingAccountsForCurrency(context.Background(), spotCurrency)
 	testStandardErrorHandling(t, err)
 }
 
@@ -510,19 +475,19 @@ func TestGetMarginBillDetails(t *testing.T) {
 		InstrumentID: spotCurrency,
 		Limit:        100,
 	}
-	_, err := o.GetMarginBillDetails(request)
+	_, err := o.GetMarginBillDetails(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetMarginAccountSettings API endpoint test
 func TestGetMarginAccountSettings(t *testing.T) {
-	_, err := o.GetMarginAccountSettings("")
+	_, err := o.GetMarginAccountSettings(context.Background(), "")
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetMarginAccountSettingsForCurrency API endpoint test
 func TestGetMarginAccountSettingsForCurrency(t *testing.T) {
-	_, err := o.GetMarginAccountSettings(spotCurrency)
+	_, err := o.GetMarginAccountSettings(context.Background(), spotCurrency)
 	testStandardErrorHandling(t, err)
 }
 
@@ -535,7 +500,7 @@ func TestOpenMarginLoan(t *testing.T) {
 		QuoteCurrency: currency.USD.String(),
 	}
 
-	_, err := o.OpenMarginLoan(request)
+	_, err := o.OpenMarginLoan(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -549,7 +514,7 @@ func TestRepayMarginLoan(t *testing.T) {
 		BorrowID:      1,
 	}
 
-	_, err := o.RepayMarginLoan(request)
+	_, err := o.RepayMarginLoan(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -565,7 +530,7 @@ func TestPlaceMarginOrderLimit(t *testing.T) {
 		Size:          "100",
 	}
 
-	_, err := o.PlaceMarginOrder(&request)
+	_, err := o.PlaceMarginOrder(context.Background(), &request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -581,7 +546,7 @@ func TestPlaceMarginOrderMarket(t *testing.T) {
 		Notional:      "100",
 	}
 
-	_, err := o.PlaceMarginOrder(&request)
+	_, err := o.PlaceMarginOrder(context.Background(), &request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -601,7 +566,7 @@ func TestPlaceMultipleMarginOrders(t *testing.T) {
 		ord,
 	}
 
-	_, errs := o.PlaceMultipleMarginOrders(request)
+	_, errs := o.PlaceMultipleMarginOrders(context.Background(), request)
 	if len(errs) > 0 {
 		testStandardErrorHandling(t, errs[0])
 	}
@@ -626,7 +591,7 @@ func TestPlaceMultipleMarginOrdersOverCurrencyLimits(t *testing.T) {
 		ord,
 	}
 
-	_, errs := o.PlaceMultipleMarginOrders(request)
+	_, errs := o.PlaceMultipleMarginOrders(context.Background(), request)
 	if errs[0].Error() != "maximum 4 orders for each pair" {
 		t.Error("Expecting an error when more than 4 orders for a pair supplied", errs[0])
 	}
@@ -659,7 +624,7 @@ func TestPlaceMultipleMarginOrdersOverPairLimits(t *testing.T) {
 		request = append(request, ord)
 	}
 
-	_, errs := o.PlaceMultipleMarginOrders(request)
+	_, errs := o.PlaceMultipleMarginOrders(context.Background(), request)
 	if errs[0].Error() != "up to 4 trading pairs" {
 		t.Error("Expecting an error when more than 4 trading pairs supplied", errs[0])
 	}
@@ -673,7 +638,7 @@ func TestCancelMarginOrder(t *testing.T) {
 		OrderID:      1234,
 	}
 
-	_, err := o.CancelMarginOrder(request)
+	_, err := o.CancelMarginOrder(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -685,7 +650,7 @@ func TestCancelMultipleMarginOrders(t *testing.T) {
 		OrderIDs:     []int64{1, 2, 3, 4},
 	}
 
-	_, errs := o.CancelMultipleMarginOrders(request)
+	_, errs := o.CancelMultipleMarginOrders(context.Background(), request)
 	if len(errs) > 0 {
 		testStandardErrorHandling(t, errs[0])
 	}
@@ -699,7 +664,7 @@ func TestCancelMultipleMarginOrdersOverCurrencyLimits(t *testing.T) {
 		OrderIDs:     []int64{1, 2, 3, 4, 5},
 	}
 
-	_, errs := o.CancelMultipleMarginOrders(request)
+	_, errs := o.CancelMultipleMarginOrders(context.Background(), request)
 	if errs[0].Error() != "maximum 4 order cancellations for each pair" {
 		t.Error("Expecting an error when more than 4 orders for a pair supplied", errs[0])
 	}
@@ -711,14 +676,14 @@ func TestGetMarginOrders(t *testing.T) {
 		InstrumentID: spotCurrency,
 		Status:       "all",
 	}
-	_, err := o.GetMarginOrders(request)
+	_, err := o.GetMarginOrders(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetMarginOpenOrders API endpoint test
 func TestGetMarginOpenOrders(t *testing.T) {
 	request := okgroup.GetSpotOpenOrdersRequest{}
-	_, err := o.GetMarginOpenOrders(request)
+	_, err := o.GetMarginOpenOrders(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -728,7 +693,7 @@ func TestGetMarginOrder(t *testing.T) {
 		OrderID:      "1234",
 		InstrumentID: currency.NewPairWithDelimiter(currency.BTC.String(), currency.USD.String(), "-").Upper().String(),
 	}
-	_, err := o.GetMarginOrder(request)
+	_, err := o.GetMarginOrder(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -738,7 +703,7 @@ func TestGetMarginTransactionDetails(t *testing.T) {
 		OrderID:      1234,
 		InstrumentID: spotCurrency,
 	}
-	_, err := o.GetMarginTransactionDetails(request)
+	_, err := o.GetMarginTransactionDetails(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -882,7 +847,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	o.GetFeeByType(feeBuilder)
+	o.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -897,7 +862,7 @@ func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 func TestGetFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
 	// CryptocurrencyTradeFee Basic
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0.0015) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0.0015) || err != nil {
 		t.Error(err)
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.0015), resp)
 	}
@@ -905,35 +870,35 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.Amount = 1000
 	feeBuilder.PurchasePrice = 1000
-	if resp, err := o.GetFee(feeBuilder); resp != float64(1500) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(1500) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(1500), resp)
 		t.Error(err)
 	}
 	// CryptocurrencyTradeFee IsMaker
 	feeBuilder = setFeeBuilder()
 	feeBuilder.IsMaker = true
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0.0005) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0.0005) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.0005), resp)
 		t.Error(err)
 	}
 	// CryptocurrencyTradeFee Negative purchase price
 	feeBuilder = setFeeBuilder()
 	feeBuilder.PurchasePrice = -1000
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
 	// InternationalBankDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -941,7 +906,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.USD
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -972,7 +937,7 @@ func TestSubmitOrder(t *testing.T) {
 		Amount:   1,
 		ClientID: "meowOrder",
 	}
-	response, err := o.SubmitOrder(orderSubmission)
+	response, err := o.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -991,7 +956,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	err := o.CancelOrder(&orderCancellation)
+	err := o.CancelOrder(context.Background(), &orderCancellation)
 	testStandardErrorHandling(t, err)
 }
 
@@ -1006,7 +971,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	resp, err := o.CancelAllOrders(&orderCancellation)
+	resp, err := o.CancelAllOrders(context.Background(), &orderCancellation)
 	testStandardErrorHandling(t, err)
 	if len(resp.Status) > 0 {
 		t.Errorf("%v orders failed to cancel", len(resp.Status))
@@ -1015,14 +980,14 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 
 // TestGetAccountInfo Wrapper test
 func TestGetAccountInfo(t *testing.T) {
-	_, err := o.UpdateAccountInfo()
+	_, err := o.UpdateAccountInfo(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestModifyOrder Wrapper test
 func TestModifyOrder(t *testing.T) {
 	TestSetRealOrderDefaults(t)
-	_, err := o.ModifyOrder(&order.Modify{})
+	_, err := o.ModifyOrder(context.Background(), &order.Modify{})
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -1043,7 +1008,7 @@ func TestWithdraw(t *testing.T) {
 		TradePassword: "Password",
 	}
 
-	_, err := o.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := o.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	testStandardErrorHandling(t, err)
 }
 
@@ -1051,7 +1016,7 @@ func TestWithdraw(t *testing.T) {
 func TestWithdrawFiat(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	var withdrawFiatRequest = withdraw.Request{}
-	_, err := o.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := o.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -1061,7 +1026,7 @@ func TestWithdrawFiat(t *testing.T) {
 func TestWithdrawInternationalBank(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	var withdrawFiatRequest = withdraw.Request{}
-	_, err := o.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := o.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -1070,19 +1035,19 @@ func TestWithdrawInternationalBank(t *testing.T) {
 // TestGetOrderbook logic test
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetOrderBook(okgroup.GetOrderBookRequest{InstrumentID: "BTC-USDT"},
+	_, err := o.GetOrderBook(context.Background(), okgroup.GetOrderBookRequest{InstrumentID: "BTC-USDT"},
 		asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = o.GetOrderBook(okgroup.GetOrderBookRequest{InstrumentID: "Payload"},
+	_, err = o.GetOrderBook(context.Background(), okgroup.GetOrderBookRequest{InstrumentID: "Payload"},
 		asset.Futures)
 	if err == nil {
 		t.Error("error cannot be nil")
 	}
 
-	_, err = o.GetOrderBook(okgroup.GetOrderBookRequest{InstrumentID: "BTC-USD-SWAP"},
+	_, err = o.GetOrderBook(context.Background(), okgroup.GetOrderBookRequest{InstrumentID: "BTC-USD-SWAP"},
 		asset.PerpetualSwap)
 	if err == nil {
 		t.Error("error cannot be nil")
@@ -1095,7 +1060,7 @@ func TestGetHistoricCandles(t *testing.T) {
 		t.Fatal(err)
 	}
 	startTime := time.Unix(1588636800, 0)
-	_, err = o.GetHistoricCandles(currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin)
+	_, err = o.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1107,12 +1072,12 @@ func TestGetHistoricCandlesExtended(t *testing.T) {
 		t.Fatal(err)
 	}
 	startTime := time.Unix(1588636800, 0)
-	_, err = o.GetHistoricCandlesExtended(currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin)
+	_, err = o.GetHistoricCandlesExtended(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = o.GetHistoricCandles(currencyPair, asset.Spot, startTime, time.Now(), kline.Interval(time.Hour*7))
+	_, err = o.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.Interval(time.Hour*7))
 	if err == nil {
 		t.Fatal("unexpected result")
 	}
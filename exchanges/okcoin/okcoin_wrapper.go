@@ -1,6 +1,7 @@
 package okcoin
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"time"
@@ -34,7 +35,7 @@ func (o *OKCoin) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if o.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = o.UpdateTradablePairs(true)
+		err = o.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -221,7 +222,7 @@ func (o *OKCoin) Run() {
 		return
 	}
 
-	err = o.UpdateTradablePairs(forceUpdate)
+	err = o.UpdateTradablePairs(context.Background(), forceUpdate)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s failed to update tradable pairs. Err: %s",
@@ -231,8 +232,8 @@ func (o *OKCoin) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (o *OKCoin) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	prods, err := o.GetSpotTokenPairDetails()
+func (o *OKCoin) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	prods, err := o.GetSpotTokenPairDetails(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -254,8 +255,8 @@ func (o *OKCoin) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (o *OKCoin) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := o.FetchTradablePairs(asset.Spot)
+func (o *OKCoin) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := o.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -267,9 +268,9 @@ func (o *OKCoin) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (o *OKCoin) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (o *OKCoin) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	if assetType == asset.Spot {
-		resp, err := o.GetSpotAllTokenPairsInformation()
+		resp, err := o.GetSpotAllTokenPairsInformation(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -306,16 +307,16 @@ func (o *OKCoin) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pr
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (o *OKCoin) FetchTicker(p currency.Pair, assetType asset.Item) (tickerData *ticker.Price, err error) {
+func (o *OKCoin) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (tickerData *ticker.Price, err error) {
 	tickerData, err = ticker.GetTicker(o.Name, p, assetType)
 	if err != nil {
-		return o.UpdateTicker(p, assetType)
+		return o.UpdateTicker(ctx, p, assetType)
 	}
 	return
 }
 
 // GetHistoricCandles returns candles between a time period for a set time interval
-func (o *OKCoin) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (o *OKCoin) GetHistoricCandles(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if !o.KlineIntervalEnabled(interval) {
 		return kline.Item{}, kline.ErrorKline{
 			Interval: interval,
@@ -335,7 +336,7 @@ func (o *OKCoin) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end
 		InstrumentID: formattedPair.String(),
 	}
 
-	candles, err := o.GetMarketData(req)
+	candles, err := o.GetMarketData(ctx, req)
 	if err != nil {
 		return kline.Item{}, err
 	}
@@ -388,7 +389,7 @@ func (o *OKCoin) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end
 }
 
 // GetHistoricCandlesExtended returns candles between a time period for a set time interval
-func (o *OKCoin) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (o *OKCoin) GetHistoricCandlesExtended(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if !o.KlineIntervalEnabled(interval) {
 		return kline.Item{}, kline.ErrorKline{
 			Interval: interval,
@@ -417,7 +418,7 @@ func (o *OKCoin) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, st
 			InstrumentID: formattedPair.String(),
 		}
 
-		candles, err := o.GetMarketData(req)
+		candles, err := o.GetMarketData(ctx, req)
 		if err != nil {
 			return kline.Item{}, err
 		}
@@ -0,0 +1,47 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package okex
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	o.SetDefaults()
+	o.ExchangeName = OKGroupExchange
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Okex load config error", err)
+	}
+
+	okexConfig, err := cfg.GetExchangeConfig(OKGroupExchange)
+	if err != nil {
+		log.Fatalf("%v Setup() init error", OKGroupExchange)
+	}
+	if okexConfig.Features.Enabled.Websocket {
+		websocketEnabled = true
+	}
+	okexConfig.API.AuthenticatedSupport = true
+	okexConfig.API.AuthenticatedWebsocketSupport = true
+	okexConfig.API.Credentials.Key = apiKey
+	okexConfig.API.Credentials.Secret = apiSecret
+	okexConfig.API.Credentials.ClientID = passphrase
+	okexConfig.API.Endpoints.WebsocketURL = o.API.Endpoints.WebsocketURL
+	o.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = o.Setup(okexConfig)
+	if err != nil {
+		log.Fatal("Okex setup error", err)
+	}
+	log.Printf(sharedtestvalues.LiveTesting, o.Name, o.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
@@ -1,11 +1,10 @@
 package okex
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
-	"os"
 	"strconv"
 	"strings"
 	"testing"
@@ -13,7 +12,6 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/yurulab/gocryptotrader/common"
-	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/core"
 	"github.com/yurulab/gocryptotrader/currency"
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
@@ -21,7 +19,6 @@ import (
 	"github.com/yurulab/gocryptotrader/exchanges/kline"
 	"github.com/yurulab/gocryptotrader/exchanges/okgroup"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
-	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/yurulab/gocryptotrader/exchanges/stream"
 	"github.com/yurulab/gocryptotrader/portfolio/withdraw"
 )
@@ -46,37 +43,6 @@ func TestSetRealOrderDefaults(t *testing.T) {
 	}
 }
 
-// TestSetup Sets defaults for test environment
-func TestMain(m *testing.M) {
-	o.SetDefaults()
-	o.ExchangeName = OKGroupExchange
-	cfg := config.GetConfig()
-	err := cfg.LoadConfig("../../testdata/configtest.json", true)
-	if err != nil {
-		log.Fatal("Okex load config error", err)
-	}
-
-	okexConfig, err := cfg.GetExchangeConfig(OKGroupExchange)
-	if err != nil {
-		log.Fatalf("%v Setup() init error", OKGroupExchange)
-	}
-	if okexConfig.Features.Enabled.Websocket {
-		websocketEnabled = true
-	}
-	okexConfig.API.AuthenticatedSupport = true
-	okexConfig.API.AuthenticatedWebsocketSupport = true
-	okexConfig.API.Credentials.Key = apiKey
-	okexConfig.API.Credentials.Secret = apiSecret
-	okexConfig.API.Credentials.ClientID = passphrase
-	okexConfig.API.Endpoints.WebsocketURL = o.API.Endpoints.WebsocketURL
-	o.Websocket = sharedtestvalues.NewTestWebsocket()
-	err = o.Setup(okexConfig)
-	if err != nil {
-		log.Fatal("Okex setup error", err)
-	}
-	os.Exit(m.Run())
-}
-
 func areTestAPIKeysSet() bool {
 	return o.ValidateAPICredentials()
 }
@@ -93,14 +59,14 @@ func testStandardErrorHandling(t *testing.T, err error) {
 // TestGetAccountCurrencies API endpoint test
 func TestGetAccountCurrencies(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAccountCurrencies()
+	_, err := o.GetAccountCurrencies(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountWalletInformation API endpoint test
 func TestGetAccountWalletInformation(t *testing.T) {
 	t.Parallel()
-	resp, err := o.GetAccountWalletInformation("")
+	resp, err := o.GetAccountWalletInformation(context.Background(), "")
 	if areTestAPIKeysSet() {
 		if err != nil {
 			t.Error(err)
@@ -116,7 +82,7 @@ func TestGetAccountWalletInformation(t *testing.T) {
 // TestGetAccountWalletInformationForCurrency API endpoint test
 func TestGetAccountWalletInformationForCurrency(t *testing.T) {
 	t.Parallel()
-	resp, err := o.GetAccountWalletInformation(currency.BTC.String())
+	resp, err := o.GetAccountWalletInformation(context.Background(), currency.BTC.String())
 	if areTestAPIKeysSet() {
 		if err != nil {
 			t.Error(err)
@@ -140,7 +106,7 @@ func TestTransferAccountFunds(t *testing.T) {
 		To:       -1,
 	}
 
-	_, err := o.TransferAccountFunds(request)
+	_, err := o.TransferAccountFunds(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -157,14 +123,14 @@ func TestAccountWithdrawRequest(t *testing.T) {
 		Fee:         1,
 	}
 
-	_, err := o.AccountWithdraw(request)
+	_, err := o.AccountWithdraw(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountWithdrawalFee API endpoint test
 func TestGetAccountWithdrawalFee(t *testing.T) {
 	t.Parallel()
-	resp, err := o.GetAccountWithdrawalFee("")
+	resp, err := o.GetAccountWithdrawalFee(context.Background(), "")
 	if areTestAPIKeysSet() {
 		if err != nil {
 			t.Error(err)
@@ -180,7 +146,7 @@ func TestGetAccountWithdrawalFee(t *testing.T) {
 // TestGetWithdrawalFeeForCurrency API endpoint test
 func TestGetAccountWithdrawalFeeForCurrency(t *testing.T) {
 	t.Parallel()
-	resp, err := o.GetAccountWithdrawalFee(currency.BTC.String())
+	resp, err := o.GetAccountWithdrawalFee(context.Background(), currency.BTC.String())
 	if areTestAPIKeysSet() {
 		if err != nil {
 			t.Error(err)
@@ -196,56 +162,56 @@ func TestGetAccountWithdrawalFeeForCurrency(t *testing.T) {
 // TestGetAccountWithdrawalHistory API endpoint test
 func TestGetAccountWithdrawalHistory(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAccountWithdrawalHistory("")
+	_, err := o.GetAccountWithdrawalHistory(context.Background(), "")
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountWithdrawalHistoryForCurrency API endpoint test
 func TestGetAccountWithdrawalHistoryForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAccountWithdrawalHistory(currency.BTC.String())
+	_, err := o.GetAccountWithdrawalHistory(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountBillDetails API endpoint test
 func TestGetAccountBillDetails(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAccountBillDetails(okgroup.GetAccountBillDetailsRequest{})
+	_, err := o.GetAccountBillDetails(context.Background(), okgroup.GetAccountBillDetailsRequest{})
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountDepositAddressForCurrency API endpoint test
 func TestGetAccountDepositAddressForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAccountDepositAddressForCurrency(currency.BTC.String())
+	_, err := o.GetAccountDepositAddressForCurrency(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountDepositHistory API endpoint test
 func TestGetAccountDepositHistory(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAccountDepositHistory("")
+	_, err := o.GetAccountDepositHistory(context.Background(), "")
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountDepositHistoryForCurrency API endpoint test
 func TestGetAccountDepositHistoryForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAccountDepositHistory(currency.BTC.String())
+	_, err := o.GetAccountDepositHistory(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSpotTradingAccounts API endpoint test
 func TestGetSpotTradingAccounts(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSpotTradingAccounts()
+	_, err := o.GetSpotTradingAccounts(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSpotTradingAccountsForCurrency API endpoint test
 func TestGetSpotTradingAccountsForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSpotTradingAccountForCurrency(currency.BTC.String())
+	_, err := o.GetSpotTradingAccountForCurrency(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
@@ -256,7 +222,7 @@ func TestGetSpotBillDetailsForCurrency(t *testing.T) {
 		Currency: currency.BTC.String(),
 		Limit:    100,
 	}
-	_, err := o.GetSpotBillDetailsForCurrency(request)
+	_, err := o.GetSpotBillDetailsForCurrency(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -267,7 +233,7 @@ func TestGetSpotBillDetailsForCurrencyBadLimit(t *testing.T) {
 		Currency: currency.BTC.String(),
 		Limit:    -1,
 	}
-	_, err := o.GetSpotBillDetailsForCurrency(request)
+	_, err := o.GetSpotBillDetailsForCurrency(context.Background(), request)
 	if areTestAPIKeysSet() && err == nil {
 		t.Errorf("Expecting an error when invalid request sent")
 	}
@@ -285,7 +251,7 @@ func TestPlaceSpotOrderLimit(t *testing.T) {
 		Size:         "0.001",
 	}
 
-	_, err := o.PlaceSpotOrder(&request)
+	_, err := o.PlaceSpotOrder(context.Background(), &request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -301,7 +267,7 @@ func TestPlaceSpotOrderMarket(t *testing.T) {
 		Notional:     "100",
 	}
 
-	_, err := o.PlaceSpotOrder(&request)
+	_, err := o.PlaceSpotOrder(context.Background(), &request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -321,7 +287,7 @@ func TestPlaceMultipleSpotOrders(t *testing.T) {
 		ord,
 	}
 
-	_, errs := o.PlaceMultipleSpotOrders(request)
+	_, errs := o.PlaceMultipleSpotOrders(context.Background(), request)
 	if len(errs) > 0 {
 		testStandardErrorHandling(t, errs[0])
 	}
@@ -346,7 +312,7 @@ func TestPlaceMultipleSpotOrdersOverCurrencyLimits(t *testing.T) {
 		ord,
 	}
 
-	_, errs := o.PlaceMultipleSpotOrders(request)
+	_, errs := o.PlaceMultipleSpotOrders(context.Background(), request)
 	if errs[0].Error() != "maximum 4 orders for each pair" {
 		t.Error("Expecting an error when more than 4 orders for a pair supplied", errs[0])
 	}
@@ -379,7 +345,7 @@ func TestPlaceMultipleSpotOrdersOverPairLimits(t *testing.T) {
 		request = append(request, ord)
 	}
 
-	_, errs := o.PlaceMultipleSpotOrders(request)
+	_, errs := o.PlaceMultipleSpotOrders(context.Background(), request)
 	if errs[0].Error() != "up to 4 trading pairs" {
 		t.Error("Expecting an error when more than 4 trading pairs supplied", errs[0])
 	}
@@ -394,7 +360,7 @@ func TestCancelSpotOrder(t *testing.T) {
 		OrderID:      1234,
 	}
 
-	_, err := o.CancelSpotOrder(request)
+	_, err := o.CancelSpotOrder(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -407,7 +373,7 @@ func TestCancelMultipleSpotOrders(t *testing.T) {
 		OrderIDs:     []int64{1, 2, 3, 4},
 	}
 
-	cancellations, err := o.CancelMultipleSpotOrders(request)
+	cancellations, err := o.CancelMultipleSpotOrders(context.Background(), request)
 	testStandardErrorHandling(t, err)
 	for _, cancellationsPerCurrency := range cancellations {
 		for _, cancellation := range cancellationsPerCurrency {
@@ -427,7 +393,7 @@ func TestCancelMultipleSpotOrdersOverCurrencyLimits(t *testing.T) {
 		OrderIDs:     []int64{1, 2, 3, 4, 5},
 	}
 
-	_, err := o.CancelMultipleSpotOrders(request)
+	_, err := o.CancelMultipleSpotOrders(context.Background(), request)
 	if err.Error() != "maximum 4 order cancellations for each pair" {
 		t.Error("Expecting an error when more than 4 orders for a pair supplied", err)
 	}
@@ -441,7 +407,7 @@ func TestGetSpotOrders(t *testing.T) {
 		Status:       "all",
 		Limit:        1,
 	}
-	_, err := o.GetSpotOrders(request)
+	_, err := o.GetSpotOrders(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -449,7 +415,7 @@ func TestGetSpotOrders(t *testing.T) {
 func TestGetSpotOpenOrders(t *testing.T) {
 	t.Parallel()
 	request := okgroup.GetSpotOpenOrdersRequest{}
-	_, err := o.GetSpotOpenOrders(request)
+	_, err := o.GetSpotOpenOrders(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -460,7 +426,7 @@ func TestGetSpotOrder(t *testing.T) {
 		OrderID:      "-1234",
 		InstrumentID: currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "-").Upper().String(),
 	}
-	_, err := o.GetSpotOrder(request)
+	_, err := o.GetSpotOrder(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -471,14 +437,14 @@ func TestGetSpotTransactionDetails(t *testing.T) {
 		OrderID:      1234,
 		InstrumentID: spotCurrency,
 	}
-	_, err := o.GetSpotTransactionDetails(request)
+	_, err := o.GetSpotTransactionDetails(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSpotTokenPairDetails API endpoint test
 func TestGetSpotTokenPairDetails(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSpotTokenPairDetails()
+	_, err := o.GetSpotTokenPairDetails(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -487,7 +453,7 @@ func TestGetSpotTokenPairDetails(t *testing.T) {
 // TestGetSpotAllTokenPairsInformation API endpoint test
 func TestGetSpotAllTokenPairsInformation(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSpotAllTokenPairsInformation()
+	_, err := o.GetSpotAllTokenPairsInformation(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -496,7 +462,7 @@ func TestGetSpotAllTokenPairsInformation(t *testing.T) {
 // TestGetSpotAllTokenPairsInformationForCurrency API endpoint test
 func TestGetSpotAllTokenPairsInformationForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSpotAllTokenPairsInformationForCurrency(spotCurrency)
+	_, err := o.GetSpotAllTokenPairsInformationForCurrency(context.Background(), spotCurrency)
 	if err != nil {
 		t.Error(err)
 	}
@@ -508,7 +474,7 @@ func TestGetSpotFilledOrdersInformation(t *testing.T) {
 	request := okgroup.GetSpotFilledOrdersInformationRequest{
 		InstrumentID: spotCurrency,
 	}
-	_, err := o.GetSpotFilledOrdersInformation(request)
+	_, err := o.GetSpotFilledOrdersInformation(context.Background(), request)
 	if err != nil {
 		t.Error(err)
 	}
@@ -522,7 +488,7 @@ func TestGetSpotMarketData(t *testing.T) {
 		InstrumentID: spotCurrency,
 		Granularity:  "604800",
 	}
-	_, err := o.GetMarketData(request)
+	_, err := o.GetMarketData(context.Background(), request)
 	if err != nil {
 		t.Error(err)
 	}
@@ -534,17 +500,17 @@ func TestGetHistoricCandles(t *testing.T) {
 		t.Fatal(err)
 	}
 	startTime := time.Unix(1588636800, 0)
-	_, err = o.GetHistoricCandles(currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin)
+	_, err = o.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = o.GetHistoricCandles(currencyPair, asset.Spot, startTime, time.Now(), kline.Interval(time.Hour*7))
+	_, err = o.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.Interval(time.Hour*7))
 	if err == nil {
 		t.Fatal("unexpected result")
 	}
 
-	_, err = o.GetHistoricCandles(currencyPair, asset.Margin, startTime, time.Now(), kline.Interval(time.Hour*7))
+	_, err = o.GetHistoricCandles(context.Background(), currencyPair, asset.Margin, startTime, time.Now(), kline.Interval(time.Hour*7))
 	if err == nil {
 		t.Fatal("unexpected result")
 	}
@@ -553,7 +519,7 @@ func TestGetHistoricCandles(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = o.GetHistoricCandles(swapPair, asset.PerpetualSwap, startTime, time.Now(), kline.OneDay)
+	_, err = o.GetHistoricCandles(context.Background(), swapPair, asset.PerpetualSwap, startTime, time.Now(), kline.OneDay)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -565,28 +531,57 @@ func TestGetHistoricCandlesExtended(t *testing.T) {
 		t.Fatal(err)
 	}
 	startTime := time.Unix(1588636800, 0)
-	_, err = o.GetHistoricCandlesExtended(currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin)
+	_, err = o.GetHistoricCandlesExtended(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.OneMin)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = o.GetHistoricCandles(currencyPair, asset.Spot, startTime, time.Now(), kline.Interval(time.Hour*7))
+	_, err = o.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.Interval(time.Hour*7))
 	if err == nil {
 		t.Fatal("unexpected result")
 	}
 }
 
+func TestUpdateDerivativeInfo(t *testing.T) {
+	t.Parallel()
+	swapPair, err := currency.NewPairFromString("BTC-USD_SWAP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = o.UpdateDerivativeInfo(context.Background(), swapPair, asset.PerpetualSwap)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = o.UpdateDerivativeInfo(context.Background(), swapPair, asset.Spot)
+	if err == nil {
+		t.Error("expected error for non derivative asset type")
+	}
+}
+
+func TestGetLiquidations(t *testing.T) {
+	t.Parallel()
+	swapPair, err := currency.NewPairFromString("BTC-USD_SWAP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = o.GetLiquidations(context.Background(), swapPair, asset.PerpetualSwap, time.Now().Add(-time.Hour*24), time.Now())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 // TestGetMarginTradingAccounts API endpoint test
 func TestGetMarginTradingAccounts(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetMarginTradingAccounts()
+	_, err := o.GetMarginTradingAccounts(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetMarginTradingAccountsForCurrency API endpoint test
 func TestGetMarginTradingAccountsForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetMarginTradingAccountsForCurrency(spotCurrency)
+	_, err := o.GetMarginTradingAccountsForCurrency(context.Background(), spotCurrency)
 	testStandardErrorHandling(t, err)
 }
 
@@ -598,21 +593,21 @@ func TestGetMarginBillDetails(t *testing.T) {
 		Limit:        100,
 	}
 
-	_, err := o.GetMarginBillDetails(request)
+	_, err := o.GetMarginBillDetails(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetMarginAccountSettings API endpoint test
 func TestGetMarginAccountSettings(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetMarginAccountSettings("")
+	_, err := o.GetMarginAccountSettings(context.Background(), "")
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetMarginAccountSettingsForCurrency API endpoint test
 func TestGetMarginAccountSettingsForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetMarginAccountSettings(spotCurrency)
+	_, err := o.GetMarginAccountSettings(context.Background(), spotCurrency)
 	testStandardErrorHandling(t, err)
 }
 
@@ -626,7 +621,7 @@ func TestOpenMarginLoan(t *testing.T) {
 		QuoteCurrency: currency.USDT.String(),
 	}
 
-	_, err := o.OpenMarginLoan(request)
+	_, err := o.OpenMarginLoan(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -641,7 +636,7 @@ func TestRepayMarginLoan(t *testing.T) {
 		BorrowID:      1,
 	}
 
-	_, err := o.RepayMarginLoan(request)
+	_, err := o.RepayMarginLoan(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -658,7 +653,7 @@ func TestPlaceMarginOrderLimit(t *testing.T) {
 		Size:         "100",
 	}
 
-	_, err := o.PlaceMarginOrder(&request)
+	_, err := o.PlaceMarginOrder(context.Background(), &request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -675,7 +670,7 @@ func TestPlaceMarginOrderMarket(t *testing.T) {
 		Notional:      "100",
 	}
 
-	_, err := o.PlaceMarginOrder(&request)
+	_, err := o.PlaceMarginOrder(context.Background(), &request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -696,7 +691,7 @@ func TestPlaceMultipleMarginOrders(t *testing.T) {
 		ord,
 	}
 
-	_, errs := o.PlaceMultipleMarginOrders(request)
+	_, errs := o.PlaceMultipleMarginOrders(context.Background(), request)
 	if len(errs) > 0 {
 		testStandardErrorHandling(t, errs[0])
 	}
@@ -722,7 +717,7 @@ func TestPlaceMultipleMarginOrdersOverCurrencyLimits(t *testing.T) {
 		ord,
 	}
 
-	_, errs := o.PlaceMultipleMarginOrders(request)
+	_, errs := o.PlaceMultipleMarginOrders(context.Background(), request)
 	if errs[0].Error() != "maximum 4 orders for each pair" {
 		t.Error("Expecting an error when more than 4 orders for a pair supplied", errs[0])
 	}
@@ -756,7 +751,7 @@ func TestPlaceMultipleMarginOrdersOverPairLimits(t *testing.T) {
 		request = append(request, ord)
 	}
 
-	_, errs := o.PlaceMultipleMarginOrders(request)
+	_, errs := o.PlaceMultipleMarginOrders(context.Background(), request)
 	if errs[0].Error() != "up to 4 trading pairs" {
 		t.Error("Expecting an error when more than 4 trading pairs supplied", errs[0])
 	}
@@ -771,7 +766,7 @@ func TestCancelMarginOrder(t *testing.T) {
 		OrderID:      1234,
 	}
 
-	_, err := o.CancelMarginOrder(request)
+	_, err := o.CancelMarginOrder(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -784,7 +779,7 @@ func TestCancelMultipleMarginOrders(t *testing.T) {
 		OrderIDs:     []int64{1, 2, 3, 4},
 	}
 
-	_, errs := o.CancelMultipleMarginOrders(request)
+	_, errs := o.CancelMultipleMarginOrders(context.Background(), request)
 	if len(errs) > 0 {
 		testStandardErrorHandling(t, errs[0])
 	}
@@ -799,7 +794,7 @@ func TestCancelMultipleMarginOrdersOverCurrencyLimits(t *testing.T) {
 		OrderIDs:     []int64{1, 2, 3, 4, 5},
 	}
 
-	_, errs := o.CancelMultipleMarginOrders(request)
+	_, errs := o.CancelMultipleMarginOrders(context.Background(), request)
 	if errs[0].Error() != "maximum 4 order cancellations for each pair" {
 		t.Error("Expecting an error when more than 4 orders for a pair supplied", errs[0])
 	}
@@ -812,7 +807,7 @@ func TestGetMarginOrders(t *testing.T) {
 		InstrumentID: spotCurrency,
 		Status:       "all",
 	}
-	_, err := o.GetMarginOrders(request)
+	_, err := o.GetMarginOrders(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -820,7 +815,7 @@ func TestGetMarginOrders(t *testing.T) {
 func TestGetMarginOpenOrders(t *testing.T) {
 	t.Parallel()
 	request := okgroup.GetSpotOpenOrdersRequest{}
-	_, err := o.GetMarginOpenOrders(request)
+	_, err := o.GetMarginOpenOrders(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -831,7 +826,7 @@ func TestGetMarginOrder(t *testing.T) {
 		OrderID:      "1234",
 		InstrumentID: currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "-").Upper().String(),
 	}
-	_, err := o.GetMarginOrder(request)
+	_, err := o.GetMarginOrder(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -842,7 +837,7 @@ func TestGetMarginTransactionDetails(t *testing.T) {
 		OrderID:      1234,
 		InstrumentID: spotCurrency,
 	}
-	_, err := o.GetMarginTransactionDetails(request)
+	_, err := o.GetMarginTransactionDetails(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -854,7 +849,7 @@ func getFutureInstrumentID() string {
 	if genericFutureInstrumentID != "" {
 		return genericFutureInstrumentID
 	}
-	resp, err := o.GetFuturesContractInformation()
+	resp, err := o.GetFuturesContractInformation(context.Background())
 	if err != nil {
 		// No error handling here because we're not testing this
 		return err.Error()
@@ -866,35 +861,35 @@ func getFutureInstrumentID() string {
 // TestGetFuturesPostions API endpoint test
 func TestGetFuturesPostions(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetFuturesPostions()
+	_, err := o.GetFuturesPostions(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetFuturesPostionsForCurrency API endpoint test
 func TestGetFuturesPostionsForCurrency(t *testing.T) {
 	currencyContract := getFutureInstrumentID()
-	_, err := o.GetFuturesPostionsForCurrency(currencyContract)
+	_, err := o.GetFuturesPostionsForCurrency(context.Background(), currencyContract)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetFuturesAccountOfAllCurrencies API endpoint test
 func TestGetFuturesAccountOfAllCurrencies(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetFuturesAccountOfAllCurrencies()
+	_, err := o.GetFuturesAccountOfAllCurrencies(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetFuturesAccountOfACurrency API endpoint test
 func TestGetFuturesAccountOfACurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetFuturesAccountOfACurrency(currency.BTC.String())
+	_, err := o.GetFuturesAccountOfACurrency(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetFuturesLeverage API endpoint test
 func TestGetFuturesLeverage(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetFuturesLeverage(currency.BTC.String())
+	_, err := o.GetFuturesLeverage(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
@@ -907,14 +902,14 @@ func TestSetFuturesLeverage(t *testing.T) {
 		Leverage:     10,
 		Direction:    "Long",
 	}
-	_, err := o.SetFuturesLeverage(request)
+	_, err := o.SetFuturesLeverage(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetFuturesBillDetails API endpoint test
 func TestGetFuturesBillDetails(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetFuturesBillDetails(okgroup.GetSpotBillDetailsForCurrencyRequest{
+	_, err := o.GetFuturesBillDetails(context.Background(), okgroup.GetSpotBillDetailsForCurrencyRequest{
 		Currency: currency.BTC.String(),
 	})
 	testStandardErrorHandling(t, err)
@@ -923,7 +918,7 @@ func TestGetFuturesBillDetails(t *testing.T) {
 // TestPlaceFuturesOrder API endpoint test
 func TestPlaceFuturesOrder(t *testing.T) {
 	TestSetRealOrderDefaults(t)
-	_, err := o.PlaceFuturesOrder(okgroup.PlaceFuturesOrderRequest{
+	_, err := o.PlaceFuturesOrder(context.Background(), okgroup.PlaceFuturesOrderRequest{
 		InstrumentID: getFutureInstrumentID(),
 		Leverage:     10,
 		Type:         1,
@@ -937,7 +932,7 @@ func TestPlaceFuturesOrder(t *testing.T) {
 // TestPlaceFuturesOrderBatch API endpoint test
 func TestPlaceFuturesOrderBatch(t *testing.T) {
 	TestSetRealOrderDefaults(t)
-	_, err := o.PlaceFuturesOrderBatch(okgroup.PlaceFuturesOrderBatchRequest{
+	_, err := o.PlaceFuturesOrderBatch(context.Background(), okgroup.PlaceFuturesOrderBatchRequest{
 		InstrumentID: getFutureInstrumentID(),
 		Leverage:     10,
 		OrdersData: []okgroup.PlaceFuturesOrderBatchRequestDetails{
@@ -956,7 +951,7 @@ func TestPlaceFuturesOrderBatch(t *testing.T) {
 // TestCancelFuturesOrder API endpoint test
 func TestCancelFuturesOrder(t *testing.T) {
 	TestSetRealOrderDefaults(t)
-	_, err := o.CancelFuturesOrder(okgroup.CancelFuturesOrderRequest{
+	_, err := o.CancelFuturesOrder(context.Background(), okgroup.CancelFuturesOrderRequest{
 		InstrumentID: getFutureInstrumentID(),
 		OrderID:      "1",
 	})
@@ -971,13 +966,13 @@ func TestCancelMultipleFuturesOrders(t *testing.T) {
 		OrderIDs:     []int64{1, 2, 3, 4},
 	}
 
-	_, err := o.CancelFuturesOrderBatch(request)
+	_, err := o.CancelFuturesOrderBatch(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetFuturesOrderList API endpoint test
 func TestGetFuturesOrderList(t *testing.T) {
-	_, err := o.GetFuturesOrderList(okgroup.GetFuturesOrdersListRequest{
+	_, err := o.GetFuturesOrderList(context.Background(), okgroup.GetFuturesOrdersListRequest{
 		InstrumentID: getFutureInstrumentID(),
 		Status:       6,
 	})
@@ -986,7 +981,7 @@ func TestGetFuturesOrderList(t *testing.T) {
 
 // TestGetFuturesOrderDetails API endpoint test
 func TestGetFuturesOrderDetails(t *testing.T) {
-	_, err := o.GetFuturesOrderDetails(okgroup.GetFuturesOrderDetailsRequest{
+	_, err := o.GetFuturesOrderDetails(context.Background(), okgroup.GetFuturesOrderDetailsRequest{
 		InstrumentID: getFutureInstrumentID(),
 		OrderID:      1,
 	})
@@ -995,7 +990,7 @@ func TestGetFuturesOrderDetails(t *testing.T) {
 
 // TestGetFuturesTransactionDetails API endpoint test
 func TestGetFuturesTransactionDetails(t *testing.T) {
-	_, err := o.GetFuturesTransactionDetails(okgroup.GetFuturesTransactionDetailsRequest{
+	_, err := o.GetFuturesTransactionDetails(context.Background(), okgroup.GetFuturesTransactionDetailsRequest{
 		InstrumentID: getFutureInstrumentID(),
 		OrderID:      1,
 	})
@@ -1005,7 +1000,7 @@ func TestGetFuturesTransactionDetails(t *testing.T) {
 // TestGetFuturesContractInformation API endpoint test
 func TestGetFuturesContractInformation(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetFuturesContractInformation()
+	_, err := o.GetFuturesContractInformation(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1014,7 +1009,7 @@ func TestGetFuturesContractInformation(t *testing.T) {
 // TestGetAllFuturesTokenInfo API endpoint test
 func TestGetAllFuturesTokenInfo(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAllFuturesTokenInfo()
+	_, err := o.GetAllFuturesTokenInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1022,7 +1017,7 @@ func TestGetAllFuturesTokenInfo(t *testing.T) {
 
 // TestGetAllFuturesTokenInfo API endpoint test
 func TestGetFuturesTokenInfoForCurrency(t *testing.T) {
-	_, err := o.GetFuturesTokenInfoForCurrency(getFutureInstrumentID())
+	_, err := o.GetFuturesTokenInfoForCurrency(context.Background(), getFutureInstrumentID())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1030,7 +1025,7 @@ func TestGetFuturesTokenInfoForCurrency(t *testing.T) {
 
 // TestGetFuturesFilledOrder API endpoint test
 func TestGetFuturesFilledOrder(t *testing.T) {
-	_, err := o.GetFuturesFilledOrder(okgroup.GetFuturesFilledOrderRequest{
+	_, err := o.GetFuturesFilledOrder(context.Background(), okgroup.GetFuturesFilledOrderRequest{
 		InstrumentID: getFutureInstrumentID(),
 	})
 	testStandardErrorHandling(t, err)
@@ -1038,13 +1033,13 @@ func TestGetFuturesFilledOrder(t *testing.T) {
 
 // TestGetFuturesHoldAmount API endpoint test
 func TestGetFuturesHoldAmount(t *testing.T) {
-	_, err := o.GetFuturesHoldAmount(getFutureInstrumentID())
+	_, err := o.GetFuturesHoldAmount(context.Background(), getFutureInstrumentID())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetFuturesHoldAmount API endpoint test
 func TestGetFuturesIndices(t *testing.T) {
-	_, err := o.GetFuturesIndices(getFutureInstrumentID())
+	_, err := o.GetFuturesIndices(context.Background(), getFutureInstrumentID())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1053,7 +1048,7 @@ func TestGetFuturesIndices(t *testing.T) {
 // TestGetFuturesHoldAmount API endpoint test
 func TestGetFuturesExchangeRates(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetFuturesExchangeRates()
+	_, err := o.GetFuturesExchangeRates(context.Background())
 	if err != nil {
 		t.Errorf("Encountered error: %v", err)
 	}
@@ -1061,7 +1056,7 @@ func TestGetFuturesExchangeRates(t *testing.T) {
 
 // TestGetFuturesHoldAmount API endpoint test
 func TestGetFuturesEstimatedDeliveryPrice(t *testing.T) {
-	_, err := o.GetFuturesEstimatedDeliveryPrice(getFutureInstrumentID())
+	_, err := o.GetFuturesEstimatedDeliveryPrice(context.Background(), getFutureInstrumentID())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1069,7 +1064,7 @@ func TestGetFuturesEstimatedDeliveryPrice(t *testing.T) {
 
 // TestGetFuturesOpenInterests API endpoint test
 func TestGetFuturesOpenInterests(t *testing.T) {
-	_, err := o.GetFuturesOpenInterests(getFutureInstrumentID())
+	_, err := o.GetFuturesOpenInterests(context.Background(), getFutureInstrumentID())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1077,7 +1072,7 @@ func TestGetFuturesOpenInterests(t *testing.T) {
 
 // TestGetFuturesOpenInterests API endpoint test
 func TestGetFuturesCurrentPriceLimit(t *testing.T) {
-	_, err := o.GetFuturesCurrentPriceLimit(getFutureInstrumentID())
+	_, err := o.GetFuturesCurrentPriceLimit(context.Background(), getFutureInstrumentID())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1085,7 +1080,7 @@ func TestGetFuturesCurrentPriceLimit(t *testing.T) {
 
 // TestGetFuturesCurrentMarkPrice API endpoint test
 func TestGetFuturesCurrentMarkPrice(t *testing.T) {
-	_, err := o.GetFuturesCurrentMarkPrice(getFutureInstrumentID())
+	_, err := o.GetFuturesCurrentMarkPrice(context.Background(), getFutureInstrumentID())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1093,7 +1088,7 @@ func TestGetFuturesCurrentMarkPrice(t *testing.T) {
 
 // TestGetFuturesForceLiquidatedOrders API endpoint test
 func TestGetFuturesForceLiquidatedOrders(t *testing.T) {
-	_, err := o.GetFuturesForceLiquidatedOrders(okgroup.GetFuturesForceLiquidatedOrdersRequest{
+	_, err := o.GetFuturesForceLiquidatedOrders(context.Background(), okgroup.GetFuturesForceLiquidatedOrdersRequest{
 		InstrumentID: getFutureInstrumentID(),
 		Status:       "1",
 	})
@@ -1111,35 +1106,35 @@ func TestGetFuturesTagPrice(t *testing.T) {
 // TestGetSwapPostions API endpoint test
 func TestGetSwapPostions(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapPostions()
+	_, err := o.GetSwapPostions(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSwapPostionsForContract API endpoint test
 func TestGetSwapPostionsForContract(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapPostionsForContract(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapPostionsForContract(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSwapAccountOfAllCurrency API endpoint test
 func TestGetSwapAccountOfAllCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapAccountOfAllCurrency()
+	_, err := o.GetSwapAccountOfAllCurrency(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSwapAccountSettingsOfAContract API endpoint test
 func TestGetSwapAccountSettingsOfAContract(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapAccountSettingsOfAContract(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapAccountSettingsOfAContract(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	testStandardErrorHandling(t, err)
 }
 
 // TestSetSwapLeverageLevelOfAContract API endpoint test
 func TestSetSwapLeverageLevelOfAContract(t *testing.T) {
 	t.Parallel()
-	_, err := o.SetSwapLeverageLevelOfAContract(okgroup.SetSwapLeverageLevelOfAContractRequest{
+	_, err := o.SetSwapLeverageLevelOfAContract(context.Background(), okgroup.SetSwapLeverageLevelOfAContractRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		Leverage:     10,
 		Side:         1,
@@ -1151,7 +1146,7 @@ func TestSetSwapLeverageLevelOfAContract(t *testing.T) {
 // TestGetSwapAccountSettingsOfAContract API endpoint test
 func TestGetSwapBillDetails(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapBillDetails(okgroup.GetSpotBillDetailsForCurrencyRequest{
+	_, err := o.GetSwapBillDetails(context.Background(), okgroup.GetSpotBillDetailsForCurrencyRequest{
 		Currency: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		Limit:    100,
 	})
@@ -1162,7 +1157,7 @@ func TestGetSwapBillDetails(t *testing.T) {
 func TestPlaceSwapOrder(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	t.Parallel()
-	_, err := o.PlaceSwapOrder(okgroup.PlaceSwapOrderRequest{
+	_, err := o.PlaceSwapOrder(context.Background(), okgroup.PlaceSwapOrderRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		Size:         1,
 		Type:         1,
@@ -1175,7 +1170,7 @@ func TestPlaceSwapOrder(t *testing.T) {
 func TestPlaceMultipleSwapOrders(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	t.Parallel()
-	_, err := o.PlaceMultipleSwapOrders(okgroup.PlaceMultipleSwapOrdersRequest{
+	_, err := o.PlaceMultipleSwapOrders(context.Background(), okgroup.PlaceMultipleSwapOrdersRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		Leverage:     10,
 		OrdersData: []okgroup.PlaceMultipleSwapOrderData{
@@ -1200,7 +1195,7 @@ func TestPlaceMultipleSwapOrders(t *testing.T) {
 func TestCancelSwapOrder(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	t.Parallel()
-	_, err := o.CancelSwapOrder(okgroup.CancelSwapOrderRequest{
+	_, err := o.CancelSwapOrder(context.Background(), okgroup.CancelSwapOrderRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		OrderID:      "64-2a-26132f931-3",
 	})
@@ -1211,7 +1206,7 @@ func TestCancelSwapOrder(t *testing.T) {
 func TestCancelMultipleSwapOrders(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	t.Parallel()
-	_, err := o.CancelMultipleSwapOrders(okgroup.CancelMultipleSwapOrdersRequest{
+	_, err := o.CancelMultipleSwapOrders(context.Background(), okgroup.CancelMultipleSwapOrdersRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		OrderIDs:     []int64{1, 2, 3, 4},
 	})
@@ -1221,7 +1216,7 @@ func TestCancelMultipleSwapOrders(t *testing.T) {
 // TestGetSwapOrderList API endpoint test
 func TestGetSwapOrderList(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapOrderList(okgroup.GetSwapOrderListRequest{
+	_, err := o.GetSwapOrderList(context.Background(), okgroup.GetSwapOrderListRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		Status:       6,
 	})
@@ -1231,7 +1226,7 @@ func TestGetSwapOrderList(t *testing.T) {
 // TestGetSwapOrderDetails API endpoint test
 func TestGetSwapOrderDetails(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapOrderDetails(okgroup.GetSwapOrderDetailsRequest{
+	_, err := o.GetSwapOrderDetails(context.Background(), okgroup.GetSwapOrderDetailsRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		OrderID:      "64-2a-26132f931-3",
 	})
@@ -1241,7 +1236,7 @@ func TestGetSwapOrderDetails(t *testing.T) {
 // TestGetSwapTransactionDetails API endpoint test
 func TestGetSwapTransactionDetails(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapTransactionDetails(okgroup.GetSwapTransactionDetailsRequest{
+	_, err := o.GetSwapTransactionDetails(context.Background(), okgroup.GetSwapTransactionDetailsRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		OrderID:      "64-2a-26132f931-3",
 	})
@@ -1251,7 +1246,7 @@ func TestGetSwapTransactionDetails(t *testing.T) {
 // TestGetSwapContractInformation API endpoint test
 func TestGetSwapContractInformation(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapContractInformation()
+	_, err := o.GetSwapContractInformation(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1260,7 +1255,7 @@ func TestGetSwapContractInformation(t *testing.T) {
 // TestGetAllSwapTokensInformation API endpoint test
 func TestGetAllSwapTokensInformation(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAllSwapTokensInformation()
+	_, err := o.GetAllSwapTokensInformation(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1269,7 +1264,7 @@ func TestGetAllSwapTokensInformation(t *testing.T) {
 // TestGetSwapTokensInformationForCurrency API endpoint test
 func TestGetSwapTokensInformationForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapTokensInformationForCurrency(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapTokensInformationForCurrency(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	if err != nil {
 		t.Error(err)
 	}
@@ -1278,7 +1273,7 @@ func TestGetSwapTokensInformationForCurrency(t *testing.T) {
 // TestGetSwapFilledOrdersData API endpoint test
 func TestGetSwapFilledOrdersData(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapFilledOrdersData(&okgroup.GetSwapFilledOrdersDataRequest{
+	_, err := o.GetSwapFilledOrdersData(context.Background(), &okgroup.GetSwapFilledOrdersDataRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		Limit:        100,
 	})
@@ -1290,7 +1285,7 @@ func TestGetSwapFilledOrdersData(t *testing.T) {
 // TestGetSwapIndeces API endpoint test
 func TestGetSwapIndeces(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapIndices(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapIndices(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	if err != nil {
 		t.Error(err)
 	}
@@ -1299,7 +1294,7 @@ func TestGetSwapIndeces(t *testing.T) {
 // TestGetSwapExchangeRates API endpoint test
 func TestGetSwapExchangeRates(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapExchangeRates()
+	_, err := o.GetSwapExchangeRates(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1308,7 +1303,7 @@ func TestGetSwapExchangeRates(t *testing.T) {
 // TestGetSwapOpenInterest API endpoint test
 func TestGetSwapOpenInterest(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapOpenInterest(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapOpenInterest(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	if err != nil {
 		t.Error(err)
 	}
@@ -1317,7 +1312,7 @@ func TestGetSwapOpenInterest(t *testing.T) {
 // TestGetSwapCurrentPriceLimits API endpoint test
 func TestGetSwapCurrentPriceLimits(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapCurrentPriceLimits(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapCurrentPriceLimits(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	if err != nil {
 		t.Error(err)
 	}
@@ -1326,7 +1321,7 @@ func TestGetSwapCurrentPriceLimits(t *testing.T) {
 // TestGetSwapForceLiquidatedOrders API endpoint test
 func TestGetSwapForceLiquidatedOrders(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapForceLiquidatedOrders(okgroup.GetSwapForceLiquidatedOrdersRequest{
+	_, err := o.GetSwapForceLiquidatedOrders(context.Background(), okgroup.GetSwapForceLiquidatedOrdersRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		Status:       "0",
 	})
@@ -1338,14 +1333,14 @@ func TestGetSwapForceLiquidatedOrders(t *testing.T) {
 // TestGetSwapOnHoldAmountForOpenOrders API endpoint test
 func TestGetSwapOnHoldAmountForOpenOrders(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapOnHoldAmountForOpenOrders(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapOnHoldAmountForOpenOrders(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSwapNextSettlementTime API endpoint test
 func TestGetSwapNextSettlementTime(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapNextSettlementTime(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapNextSettlementTime(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	if err != nil {
 		t.Error(err)
 	}
@@ -1354,7 +1349,7 @@ func TestGetSwapNextSettlementTime(t *testing.T) {
 // TestGetSwapMarkPrice API endpoint test
 func TestGetSwapMarkPrice(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapMarkPrice(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapMarkPrice(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	if err != nil {
 		t.Error(err)
 	}
@@ -1363,7 +1358,7 @@ func TestGetSwapMarkPrice(t *testing.T) {
 // TestGetSwapFundingRateHistory API endpoint test
 func TestGetSwapFundingRateHistory(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapFundingRateHistory(okgroup.GetSwapFundingRateHistoryRequest{
+	_, err := o.GetSwapFundingRateHistory(context.Background(), okgroup.GetSwapFundingRateHistoryRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		Limit:        100,
 	})
@@ -1375,21 +1370,21 @@ func TestGetSwapFundingRateHistory(t *testing.T) {
 // TestGetETT API endpoint test
 func TestGetETT(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetETT()
+	_, err := o.GetETT(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetETTAccountInformationForCurrency API endpoint test
 func TestGetETTAccountInformationForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetETTBillsDetails(currency.BTC.String())
+	_, err := o.GetETTBillsDetails(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetETTBillsDetails API endpoint test
 func TestGetETTBillsDetails(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetETTBillsDetails(currency.BTC.String())
+	_, err := o.GetETTBillsDetails(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
@@ -1405,7 +1400,7 @@ func TestPlaceETTOrder(t *testing.T) {
 		ETT:           "OK06",
 	}
 
-	_, err := o.PlaceETTOrder(&request)
+	_, err := o.PlaceETTOrder(context.Background(), &request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -1413,7 +1408,7 @@ func TestPlaceETTOrder(t *testing.T) {
 func TestCancelETTOrder(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	t.Parallel()
-	_, err := o.CancelETTOrder("888845120785408")
+	_, err := o.CancelETTOrder(context.Background(), "888845120785408")
 	testStandardErrorHandling(t, err)
 }
 
@@ -1429,14 +1424,14 @@ func TestGetETTOrderList(t *testing.T) {
 		Status: 0,
 	}
 
-	_, err := o.GetETTOrderList(request)
+	_, err := o.GetETTOrderList(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetETTOrderDetails API endpoint test
 func TestGetETTOrderDetails(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetETTOrderDetails("888845020785408")
+	_, err := o.GetETTOrderDetails(context.Background(), "888845020785408")
 	testStandardErrorHandling(t, err)
 }
 
@@ -1444,7 +1439,7 @@ func TestGetETTOrderDetails(t *testing.T) {
 func TestGetETTConstituents(t *testing.T) {
 	t.Skip("ETT currently unavailable")
 	t.Parallel()
-	_, err := o.GetETTConstituents("OK06ETT")
+	_, err := o.GetETTConstituents(context.Background(), "OK06ETT")
 	if err != nil {
 		t.Error(err)
 	}
@@ -1454,7 +1449,7 @@ func TestGetETTConstituents(t *testing.T) {
 func TestGetETTSettlementPriceHistory(t *testing.T) {
 	t.Skip("ETT currently unavailable")
 	t.Parallel()
-	_, err := o.GetETTSettlementPriceHistory("OK06ETT")
+	_, err := o.GetETTSettlementPriceHistory(context.Background(), "OK06ETT")
 	if err != nil {
 		t.Error(err)
 	}
@@ -1594,7 +1589,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	o.GetFeeByType(feeBuilder)
+	o.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -1610,7 +1605,7 @@ func TestGetFee(t *testing.T) {
 	t.Parallel()
 	var feeBuilder = setFeeBuilder()
 	// CryptocurrencyTradeFee Basic
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0.0015) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0.0015) || err != nil {
 		t.Error(err)
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.0015), resp)
 	}
@@ -1619,7 +1614,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.Amount = 1000
 	feeBuilder.PurchasePrice = 1000
-	if resp, err := o.GetFee(feeBuilder); resp != float64(1500) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(1500) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(1500), resp)
 		t.Error(err)
 	}
@@ -1627,7 +1622,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee IsMaker
 	feeBuilder = setFeeBuilder()
 	feeBuilder.IsMaker = true
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0.0005) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0.0005) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.0005), resp)
 		t.Error(err)
 	}
@@ -1635,7 +1630,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee Negative purchase price
 	feeBuilder = setFeeBuilder()
 	feeBuilder.PurchasePrice = -1000
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -1643,7 +1638,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -1651,7 +1646,7 @@ func TestGetFee(t *testing.T) {
 	// InternationalBankDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -1660,7 +1655,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.USD
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -1693,7 +1688,7 @@ func TestSubmitOrder(t *testing.T) {
 		Amount:   1,
 		ClientID: "meowOrder",
 	}
-	response, err := o.SubmitOrder(orderSubmission)
+	response, err := o.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -1713,7 +1708,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	err := o.CancelOrder(&orderCancellation)
+	err := o.CancelOrder(context.Background(), &orderCancellation)
 	testStandardErrorHandling(t, err)
 }
 
@@ -1729,7 +1724,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	resp, err := o.CancelAllOrders(&orderCancellation)
+	resp, err := o.CancelAllOrders(context.Background(), &orderCancellation)
 	testStandardErrorHandling(t, err)
 
 	if len(resp.Status) > 0 {
@@ -1739,7 +1734,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 
 // TestGetAccountInfo Wrapper test
 func TestGetAccountInfo(t *testing.T) {
-	_, err := o.UpdateAccountInfo()
+	_, err := o.UpdateAccountInfo(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
@@ -1747,7 +1742,7 @@ func TestGetAccountInfo(t *testing.T) {
 func TestModifyOrder(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	t.Parallel()
-	_, err := o.ModifyOrder(&order.Modify{})
+	_, err := o.ModifyOrder(context.Background(), &order.Modify{})
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'",
 			common.ErrFunctionNotSupported,
@@ -1769,7 +1764,7 @@ func TestWithdraw(t *testing.T) {
 		Description:   "WITHDRAW IT ALL",
 		TradePassword: "Password",
 	}
-	_, err := o.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := o.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	testStandardErrorHandling(t, err)
 }
 
@@ -1778,7 +1773,7 @@ func TestWithdrawFiat(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	t.Parallel()
 	var withdrawFiatRequest = withdraw.Request{}
-	_, err := o.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := o.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'",
 			common.ErrFunctionNotSupported,
@@ -1791,7 +1786,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	t.Parallel()
 	var withdrawFiatRequest = withdraw.Request{}
-	_, err := o.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := o.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'",
 			common.ErrFunctionNotSupported,
@@ -1802,19 +1797,19 @@ func TestWithdrawInternationalBank(t *testing.T) {
 // TestGetOrderbook logic test
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetOrderBook(okgroup.GetOrderBookRequest{InstrumentID: "BTC-USDT"},
+	_, err := o.GetOrderBook(context.Background(), okgroup.GetOrderBookRequest{InstrumentID: "BTC-USDT"},
 		asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
 	contract := getFutureInstrumentID()
-	_, err = o.GetOrderBook(okgroup.GetOrderBookRequest{InstrumentID: contract},
+	_, err = o.GetOrderBook(context.Background(), okgroup.GetOrderBookRequest{InstrumentID: contract},
 		asset.Futures)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = o.GetOrderBook(okgroup.GetOrderBookRequest{InstrumentID: "BTC-USD-SWAP"},
+	_, err = o.GetOrderBook(context.Background(), okgroup.GetOrderBookRequest{InstrumentID: "BTC-USD-SWAP"},
 		asset.PerpetualSwap)
 	if err != nil {
 		t.Error(err)
@@ -1822,7 +1817,7 @@ func TestGetOrderbook(t *testing.T) {
 }
 
 func TestUpdateTradablePairs(t *testing.T) {
-	err := o.UpdateTradablePairs(true)
+	err := o.UpdateTradablePairs(context.Background(), true)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1,6 +1,7 @@
 package okex
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -13,8 +14,10 @@ import (
 	"github.com/yurulab/gocryptotrader/currency"
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/derivative"
 	"github.com/yurulab/gocryptotrader/exchanges/kline"
 	"github.com/yurulab/gocryptotrader/exchanges/okgroup"
+	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/exchanges/protocol"
 	"github.com/yurulab/gocryptotrader/exchanges/request"
 	"github.com/yurulab/gocryptotrader/exchanges/stream"
@@ -36,7 +39,7 @@ func (o *OKEX) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if o.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = o.UpdateTradablePairs(true)
+		err = o.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -282,7 +285,7 @@ func (o *OKEX) Run() {
 		return
 	}
 
-	err = o.UpdateTradablePairs(forceUpdate)
+	err = o.UpdateTradablePairs(context.Background(), forceUpdate)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s failed to update tradable pairs. Err: %s",
@@ -292,7 +295,7 @@ func (o *OKEX) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (o *OKEX) FetchTradablePairs(i asset.Item) ([]string, error) {
+func (o *OKEX) FetchTradablePairs(ctx context.Context, i asset.Item) ([]string, error) {
 	var pairs []string
 
 	format, err := o.GetPairFormat(i, false)
@@ -302,7 +305,7 @@ func (o *OKEX) FetchTradablePairs(i asset.Item) ([]string, error) {
 
 	switch i {
 	case asset.Spot:
-		prods, err := o.GetSpotTokenPairDetails()
+		prods, err := o.GetSpotTokenPairDetails(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -315,7 +318,7 @@ func (o *OKEX) FetchTradablePairs(i asset.Item) ([]string, error) {
 		}
 		return pairs, nil
 	case asset.Futures:
-		prods, err := o.GetFuturesContractInformation()
+		prods, err := o.GetFuturesContractInformation(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -327,7 +330,7 @@ func (o *OKEX) FetchTradablePairs(i asset.Item) ([]string, error) {
 		return pairs, nil
 
 	case asset.PerpetualSwap:
-		prods, err := o.GetSwapContractInformation()
+		prods, err := o.GetSwapContractInformation(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -351,7 +354,7 @@ func (o *OKEX) FetchTradablePairs(i asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (o *OKEX) UpdateTradablePairs(forceUpdate bool) error {
+func (o *OKEX) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
 	assets := o.CurrencyPairs.GetAssetTypes()
 	for x := range assets {
 		if assets[x] == asset.Index {
@@ -359,7 +362,7 @@ func (o *OKEX) UpdateTradablePairs(forceUpdate bool) error {
 			continue
 		}
 
-		pairs, err := o.FetchTradablePairs(assets[x])
+		pairs, err := o.FetchTradablePairs(ctx, assets[x])
 		if err != nil {
 			return err
 		}
@@ -416,11 +419,11 @@ func (o *OKEX) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (o *OKEX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (o *OKEX) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
 	switch assetType {
 	case asset.Spot:
-		resp, err := o.GetSpotAllTokenPairsInformation()
+		resp, err := o.GetSpotAllTokenPairsInformation(ctx)
 		if err != nil {
 			return tickerPrice, err
 		}
@@ -454,7 +457,7 @@ func (o *OKEX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pric
 		}
 
 	case asset.PerpetualSwap:
-		resp, err := o.GetAllSwapTokensInformation()
+		resp, err := o.GetAllSwapTokensInformation(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -490,7 +493,7 @@ func (o *OKEX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pric
 		}
 
 	case asset.Futures:
-		resp, err := o.GetAllFuturesTokenInfo()
+		resp, err := o.GetAllFuturesTokenInfo(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -530,19 +533,166 @@ func (o *OKEX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pric
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (o *OKEX) FetchTicker(p currency.Pair, assetType asset.Item) (tickerData *ticker.Price, err error) {
+func (o *OKEX) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (tickerData *ticker.Price, err error) {
 	if assetType == asset.Index {
 		return tickerData, errors.New("ticker fetching not supported for index")
 	}
 	tickerData, err = ticker.GetTicker(o.Name, p, assetType)
 	if err != nil {
-		return o.UpdateTicker(p, assetType)
+		return o.UpdateTicker(ctx, p, assetType)
 	}
 	return
 }
 
+// UpdateDerivativeInfo updates and returns the funding, open interest and
+// pricing data for a derivative contract
+func (o *OKEX) UpdateDerivativeInfo(ctx context.Context, p currency.Pair, assetType asset.Item) (*derivative.Info, error) {
+	fPair, err := o.FormatExchangeCurrency(p, assetType)
+	if err != nil {
+		return nil, err
+	}
+	instrumentID := fPair.String()
+
+	info := derivative.Info{
+		Exchange:  o.Name,
+		Pair:      p,
+		AssetType: assetType,
+	}
+	switch assetType {
+	case asset.PerpetualSwap:
+		mark, err := o.GetSwapMarkPrice(ctx, instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		index, err := o.GetSwapIndices(ctx, instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		openInterest, err := o.GetSwapOpenInterest(ctx, instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		funding, err := o.GetSwapNextSettlementTime(ctx, instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		info.MarkPrice = mark.MarkPrice
+		info.IndexPrice = index.Index
+		info.OpenInterest = openInterest.Amount
+		info.FundingRate = funding.FundingRate
+		info.PredictedFundingRate = funding.EstimatedRate
+		info.NextFundingTime = funding.FundingTime
+		info.LastUpdated = mark.Timestamp
+	case asset.Futures:
+		mark, err := o.GetFuturesCurrentMarkPrice(ctx, instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		index, err := o.GetFuturesIndices(ctx, instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		openInterest, err := o.GetFuturesOpenInterests(ctx, instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		info.MarkPrice = mark.MarkPrice
+		info.IndexPrice = index.Index
+		info.OpenInterest = openInterest.Amount
+		info.LastUpdated = mark.Timestamp
+	default:
+		return nil, fmt.Errorf("%s asset type %s is not a derivative", o.Name, assetType)
+	}
+
+	err = derivative.ProcessInfo(&info)
+	if err != nil {
+		return nil, err
+	}
+	return derivative.GetInfo(o.Name, p, assetType)
+}
+
+// FetchDerivativeInfo returns the funding, open interest and pricing data for
+// a derivative contract
+func (o *OKEX) FetchDerivativeInfo(ctx context.Context, p currency.Pair, assetType asset.Item) (*derivative.Info, error) {
+	info, err := derivative.GetInfo(o.Name, p, assetType)
+	if err != nil {
+		return o.UpdateDerivativeInfo(ctx, p, assetType)
+	}
+	return info, nil
+}
+
+// GetLiquidations returns forced liquidations for a derivative contract
+// within the timeframe provided. OKEX only returns the most recent filled
+// liquidations from the last seven days
+func (o *OKEX) GetLiquidations(ctx context.Context, p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]derivative.Liquidation, error) {
+	fPair, err := o.FormatExchangeCurrency(p, assetType)
+	if err != nil {
+		return nil, err
+	}
+
+	type liquidation struct {
+		size      int64
+		price     float64
+		createdAt string
+		orderType int64
+	}
+	var orders []liquidation
+	switch assetType {
+	case asset.PerpetualSwap:
+		resp, err := o.GetSwapForceLiquidatedOrders(ctx, okgroup.GetSwapForceLiquidatedOrdersRequest{
+			InstrumentID: fPair.String(),
+			Status:       "1",
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp {
+			orders = append(orders, liquidation{resp[i].Size, resp[i].Price, resp[i].CreatedAt, resp[i].Type})
+		}
+	case asset.Futures:
+		resp, err := o.GetFuturesForceLiquidatedOrders(ctx, okgroup.GetFuturesForceLiquidatedOrdersRequest{
+			InstrumentID: fPair.String(),
+			Status:       "1",
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp {
+			orders = append(orders, liquidation{resp[i].Size, resp[i].Price, resp[i].CreatedAt, resp[i].Type})
+		}
+	default:
+		return nil, fmt.Errorf("%s asset type %s is not a derivative", o.Name, assetType)
+	}
+
+	var resp []derivative.Liquidation
+	for i := range orders {
+		ts, err := time.Parse(time.RFC3339, orders[i].createdAt)
+		if err != nil {
+			return nil, err
+		}
+		if ts.Before(timestampStart) || ts.After(timestampEnd) {
+			continue
+		}
+		// Type 3 closes a long position and type 4 closes a short position
+		side := order.Sell
+		if orders[i].orderType == 4 {
+			side = order.Buy
+		}
+		resp = append(resp, derivative.Liquidation{
+			Exchange:  o.Name,
+			Pair:      p,
+			AssetType: assetType,
+			Side:      side,
+			Price:     orders[i].price,
+			Amount:    float64(orders[i].size),
+			Timestamp: ts,
+		})
+	}
+	return resp, nil
+}
+
 // GetHistoricCandles returns candles between a time period for a set time interval
-func (o *OKEX) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (o *OKEX) GetHistoricCandles(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if !o.KlineIntervalEnabled(interval) {
 		return kline.Item{}, kline.ErrorKline{
 			Interval: interval,
@@ -562,7 +712,7 @@ func (o *OKEX) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end t
 		InstrumentID: formattedPair.String(),
 	}
 
-	candles, err := o.GetMarketData(req)
+	candles, err := o.GetMarketData(ctx, req)
 	if err != nil {
 		return kline.Item{}, err
 	}
@@ -616,7 +766,7 @@ func (o *OKEX) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end t
 }
 
 // GetHistoricCandlesExtended returns candles between a time period for a set time interval
-func (o *OKEX) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (o *OKEX) GetHistoricCandlesExtended(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if !o.KlineIntervalEnabled(interval) {
 		return kline.Item{}, kline.ErrorKline{
 			Interval: interval,
@@ -644,7 +794,7 @@ func (o *OKEX) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, star
 			InstrumentID: formattedPair.String(),
 		}
 
-		candles, err := o.GetMarketData(req)
+		candles, err := o.GetMarketData(ctx, req)
 		if err != nil {
 			return kline.Item{}, err
 		}
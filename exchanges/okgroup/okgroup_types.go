@@ -1159,15 +1159,18 @@ type GetSwapOnHoldAmountForOpenOrdersResponse struct {
 
 // GetSwapNextSettlementTimeResponse response data for GetSwapNextSettlementTime
 type GetSwapNextSettlementTimeResponse struct {
-	InstrumentID string `json:"instrument_id"`
-	FundingTime  string `json:"funding_time"`
+	InstrumentID   string    `json:"instrument_id"`
+	FundingTime    time.Time `json:"funding_time"`
+	FundingRate    float64   `json:"funding_rate,string"`
+	EstimatedRate  float64   `json:"estimated_rate,string"`
+	SettlementTime time.Time `json:"settlement_time"`
 }
 
 // GetSwapMarkPriceResponse response data for GetSwapMarkPrice
 type GetSwapMarkPriceResponse struct {
-	InstrumentID string `json:"instrument_id"`
-	MarkPrice    string `json:"mark_price"`
-	Timstamp     string `json:"timstamp"`
+	InstrumentID string    `json:"instrument_id"`
+	MarkPrice    float64   `json:"mark_price,string"`
+	Timestamp    time.Time `json:"timestamp"`
 }
 
 // GetSwapFundingRateHistoryRequest request data for GetSwapFundingRateHistory
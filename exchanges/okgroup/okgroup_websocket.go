@@ -480,6 +480,7 @@ func (o *OKGroup) wsProcessTrades(respRaw []byte) error {
 		}
 
 		o.Websocket.DataHandler <- stream.TradeData{
+			TID:          response.Data[i].TradeID,
 			Amount:       amount,
 			AssetType:    o.GetAssetTypeFromTableName(response.Table),
 			CurrencyPair: c,
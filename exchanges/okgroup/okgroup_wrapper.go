@@ -1,6 +1,7 @@
 package okgroup
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -62,16 +63,16 @@ func (o *OKGroup) Setup(exch *config.ExchangeConfig) error {
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (o *OKGroup) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (o *OKGroup) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(o.Name, p, assetType)
 	if err != nil {
-		return o.UpdateOrderbook(p, assetType)
+		return o.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (o *OKGroup) UpdateOrderbook(p currency.Pair, a asset.Item) (*orderbook.Base, error) {
+func (o *OKGroup) UpdateOrderbook(ctx context.Context, p currency.Pair, a asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
 	if a == asset.Index {
 		return orderBook, errors.New("no orderbooks for index")
@@ -82,7 +83,7 @@ func (o *OKGroup) UpdateOrderbook(p currency.Pair, a asset.Item) (*orderbook.Bas
 		return nil, err
 	}
 
-	orderbookNew, err := o.GetOrderBook(GetOrderBookRequest{
+	orderbookNew, err := o.GetOrderBook(ctx, GetOrderBookRequest{
 		InstrumentID: fpair.String(),
 	}, a)
 	if err != nil {
@@ -166,8 +167,8 @@ func (o *OKGroup) UpdateOrderbook(p currency.Pair, a asset.Item) (*orderbook.Bas
 }
 
 // UpdateAccountInfo retrieves balances for all enabled currencies
-func (o *OKGroup) UpdateAccountInfo() (account.Holdings, error) {
-	currencies, err := o.GetSpotTradingAccounts()
+func (o *OKGroup) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
+	currencies, err := o.GetSpotTradingAccounts(ctx)
 	if err != nil {
 		return account.Holdings{}, err
 	}
@@ -204,10 +205,10 @@ func (o *OKGroup) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (o *OKGroup) FetchAccountInfo() (account.Holdings, error) {
+func (o *OKGroup) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(o.Name)
 	if err != nil {
-		return o.UpdateAccountInfo()
+		return o.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -215,8 +216,8 @@ func (o *OKGroup) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (o *OKGroup) GetFundingHistory() (resp []exchange.FundHistory, err error) {
-	accountDepositHistory, err := o.GetAccountDepositHistory("")
+func (o *OKGroup) GetFundingHistory(ctx context.Context) (resp []exchange.FundHistory, err error) {
+	accountDepositHistory, err := o.GetAccountDepositHistory(ctx, "")
 	if err != nil {
 		return
 	}
@@ -241,7 +242,7 @@ func (o *OKGroup) GetFundingHistory() (resp []exchange.FundHistory, err error) {
 			TransferType: "deposit",
 		})
 	}
-	accountWithdrawlHistory, err := o.GetAccountWithdrawalHistory("")
+	accountWithdrawlHistory, err := o.GetAccountWithdrawalHistory(ctx, "")
 	for i := range accountWithdrawlHistory {
 		resp = append(resp, exchange.FundHistory{
 			Amount:       accountWithdrawlHistory[i].Amount,
@@ -257,12 +258,12 @@ func (o *OKGroup) GetFundingHistory() (resp []exchange.FundHistory, err error) {
 }
 
 // GetExchangeHistory returns historic trade data within the timeframe provided.
-func (o *OKGroup) GetExchangeHistory(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+func (o *OKGroup) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (o *OKGroup) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (o *OKGroup) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	err := s.Validate()
 	if err != nil {
 		return order.SubmitResponse{}, err
@@ -284,7 +285,7 @@ func (o *OKGroup) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		request.Price = strconv.FormatFloat(s.Price, 'f', -1, 64)
 	}
 
-	orderResponse, err := o.PlaceSpotOrder(&request)
+	orderResponse, err := o.PlaceSpotOrder(ctx, &request)
 	if err != nil {
 		return order.SubmitResponse{}, err
 	}
@@ -301,12 +302,12 @@ func (o *OKGroup) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (o *OKGroup) ModifyOrder(action *order.Modify) (string, error) {
+func (o *OKGroup) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (o *OKGroup) CancelOrder(orderCancellation *order.Cancel) (err error) {
+func (o *OKGroup) CancelOrder(ctx context.Context, orderCancellation *order.Cancel) (err error) {
 	orderID, err := strconv.ParseInt(orderCancellation.ID, 10, 64)
 	if err != nil {
 		return
@@ -318,7 +319,7 @@ func (o *OKGroup) CancelOrder(orderCancellation *order.Cancel) (err error) {
 		return
 	}
 
-	orderCancellationResponse, err := o.CancelSpotOrder(CancelSpotOrderRequest{
+	orderCancellationResponse, err := o.CancelSpotOrder(ctx, CancelSpotOrderRequest{
 		InstrumentID: fpair.String(),
 		OrderID:      orderID,
 	})
@@ -332,7 +333,7 @@ func (o *OKGroup) CancelOrder(orderCancellation *order.Cancel) (err error) {
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (o *OKGroup) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
+func (o *OKGroup) CancelAllOrders(ctx context.Context, orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
 	orderIDs := strings.Split(orderCancellation.ID, ",")
 	resp := order.CancelAllResponse{}
 	resp.Status = make(map[string]string)
@@ -352,7 +353,7 @@ func (o *OKGroup) CancelAllOrders(orderCancellation *order.Cancel) (order.Cancel
 		return resp, err
 	}
 
-	cancelOrdersResponse, err := o.CancelMultipleSpotOrders(CancelMultipleSpotOrdersRequest{
+	cancelOrdersResponse, err := o.CancelMultipleSpotOrders(ctx, CancelMultipleSpotOrdersRequest{
 		InstrumentID: fpair.String(),
 		OrderIDs:     orderIDNumbers,
 	})
@@ -370,8 +371,8 @@ func (o *OKGroup) CancelAllOrders(orderCancellation *order.Cancel) (order.Cancel
 }
 
 // GetOrderInfo returns information on a current open order
-func (o *OKGroup) GetOrderInfo(orderID string) (resp order.Detail, err error) {
-	mOrder, err := o.GetSpotOrder(GetSpotOrderRequest{OrderID: orderID})
+func (o *OKGroup) GetOrderInfo(ctx context.Context, orderID string) (resp order.Detail, err error) {
+	mOrder, err := o.GetSpotOrder(ctx, GetSpotOrderRequest{OrderID: orderID})
 	if err != nil {
 		return
 	}
@@ -399,8 +400,8 @@ func (o *OKGroup) GetOrderInfo(orderID string) (resp order.Detail, err error) {
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (o *OKGroup) GetDepositAddress(p currency.Code, accountID string) (string, error) {
-	wallet, err := o.GetAccountDepositAddressForCurrency(p.Lower().String())
+func (o *OKGroup) GetDepositAddress(ctx context.Context, p currency.Code, accountID string) (string, error) {
+	wallet, err := o.GetAccountDepositAddressForCurrency(ctx, p.Lower().String())
 	if err != nil || len(wallet) == 0 {
 		return "", err
 	}
@@ -409,8 +410,8 @@ func (o *OKGroup) GetDepositAddress(p currency.Code, accountID string) (string,
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (o *OKGroup) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
-	withdrawal, err := o.AccountWithdraw(AccountWithdrawRequest{
+func (o *OKGroup) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	withdrawal, err := o.AccountWithdraw(ctx, AccountWithdrawRequest{
 		Amount:      withdrawRequest.Amount,
 		Currency:    withdrawRequest.Currency.Lower().String(),
 		Destination: 4, // 1, 2, 3 are all internal
@@ -435,24 +436,24 @@ func (o *OKGroup) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request)
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (o *OKGroup) WithdrawFiatFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (o *OKGroup) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (o *OKGroup) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (o *OKGroup) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (o *OKGroup) GetActiveOrders(req *order.GetOrdersRequest) (resp []order.Detail, err error) {
+func (o *OKGroup) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) (resp []order.Detail, err error) {
 	for x := range req.Pairs {
 		fpair, err := o.FormatExchangeCurrency(req.Pairs[x], asset.Spot)
 		if err != nil {
 			return nil, err
 		}
-		spotOpenOrders, err := o.GetSpotOpenOrders(GetSpotOpenOrdersRequest{
+		spotOpenOrders, err := o.GetSpotOpenOrders(ctx, GetSpotOpenOrdersRequest{
 			InstrumentID: fpair.String(),
 		})
 		if err != nil {
@@ -479,13 +480,13 @@ func (o *OKGroup) GetActiveOrders(req *order.GetOrdersRequest) (resp []order.Det
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (o *OKGroup) GetOrderHistory(req *order.GetOrdersRequest) (resp []order.Detail, err error) {
+func (o *OKGroup) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) (resp []order.Detail, err error) {
 	for x := range req.Pairs {
 		fpair, err := o.FormatExchangeCurrency(req.Pairs[x], asset.Spot)
 		if err != nil {
 			return nil, err
 		}
-		spotOpenOrders, err := o.GetSpotOrders(GetSpotOrdersRequest{
+		spotOpenOrders, err := o.GetSpotOrders(ctx, GetSpotOrdersRequest{
 			Status:       strings.Join([]string{"filled", "cancelled", "failure"}, "|"),
 			InstrumentID: fpair.String(),
 		})
@@ -512,12 +513,12 @@ func (o *OKGroup) GetOrderHistory(req *order.GetOrdersRequest) (resp []order.Det
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (o *OKGroup) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (o *OKGroup) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !o.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
 	}
-	return o.GetFee(feeBuilder)
+	return o.GetFee(ctx, feeBuilder)
 }
 
 // GetWithdrawCapabilities returns the types of withdrawal methods permitted by the exchange
@@ -532,7 +533,7 @@ func (o *OKGroup) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (o *OKGroup) ValidateCredentials() error {
-	_, err := o.UpdateAccountInfo()
+func (o *OKGroup) ValidateCredentials(ctx context.Context) error {
+	_, err := o.UpdateAccountInfo(ctx)
 	return o.CheckTransientError(err)
 }
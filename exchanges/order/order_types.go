@@ -171,6 +171,7 @@ type TradeHistory struct {
 	Price       float64
 	Amount      float64
 	Fee         float64
+	FeeAsset    string
 	Exchange    string
 	TID         string
 	Description string
@@ -62,6 +62,7 @@ func (s *Service) Update(b *Base) error {
 		book.b.Bids = b.Bids
 		book.b.Asks = b.Asks
 		book.b.LastUpdated = b.LastUpdated
+		book.b.LastUpdateID = b.LastUpdateID
 		ids := append(book.Assoc, book.Main)
 		s.Unlock()
 		return s.mux.Publish(ids, b)
@@ -0,0 +1,296 @@
+package recorder
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
+)
+
+const (
+	// FileExtension is the extension of orderbook snapshot files
+	FileExtension = ".jsonl.gz"
+	// ReplaySuffix is appended to the exchange name replayed snapshots are
+	// published under, keeping them apart from the live books
+	ReplaySuffix = "_replay"
+	// fileDateFormat names snapshot files by the UTC day they cover
+	fileDateFormat = "20060102"
+	// fileOpenedFormat suffixes snapshot files with the time they were
+	// opened, its fixed width keeps the files of a day sorted by name
+	fileOpenedFormat = "20060102T150405.000000000"
+)
+
+var errReplayStopped = errors.New("orderbook replay stopped")
+
+// Writer appends orderbook snapshots to gzip compressed JSON lines files. Each
+// book is written to a new file for every UTC day and every time the writer is
+// opened, so a file left incomplete by a crash is never appended to
+type Writer struct {
+	dir   string
+	files map[string]*file
+	m     sync.Mutex
+}
+
+type file struct {
+	day string
+	f   *os.File
+	gz  *gzip.Writer
+	enc *json.Encoder
+}
+
+// NewWriter returns a writer storing snapshot files beneath dir
+func NewWriter(dir string) *Writer {
+	return &Writer{
+		dir:   dir,
+		files: make(map[string]*file),
+	}
+}
+
+// FormatPair returns the pair in the form snapshots are stored under
+func FormatPair(p currency.Pair) currency.Pair {
+	return p.Format(currency.DashDelimiter, true)
+}
+
+// Path returns the snapshot file of a book for the UTC day of t, opened at
+// opened
+func Path(dir, exchange string, a asset.Item, p currency.Pair, t, opened time.Time) string {
+	return dayPrefix(dir, exchange, a, p, t) + "-" + opened.UTC().Format(fileOpenedFormat) + FileExtension
+}
+
+// Files returns the snapshot files of a book for the UTC day of t in the order
+// they were written
+func Files(dir, exchange string, a asset.Item, p currency.Pair, t time.Time) ([]string, error) {
+	prefix := dayPrefix(dir, exchange, a, p, t)
+	files, err := filepath.Glob(prefix + "-*" + FileExtension)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	// Files written before they were suffixed hold the earliest snapshots
+	if _, err = os.Stat(prefix + FileExtension); err == nil {
+		files = append([]string{prefix + FileExtension}, files...)
+	}
+	return files, nil
+}
+
+// dayPrefix returns the path snapshot files of a book for the UTC day of t
+// begin with
+func dayPrefix(dir, exchange string, a asset.Item, p currency.Pair, t time.Time) string {
+	return filepath.Join(dir,
+		strings.ToLower(exchange),
+		strings.ToLower(a.String()),
+		FormatPair(p).String(),
+		t.UTC().Format(fileDateFormat))
+}
+
+// Write appends a snapshot of b to its book's file for the day it was last
+// updated
+func (w *Writer) Write(b *orderbook.Base) error {
+	snapshot := *b
+	snapshot.Pair = FormatPair(b.Pair)
+	snapshot.LastUpdated = b.LastUpdated.UTC()
+	key := strings.ToLower(b.ExchangeName) + "/" + b.AssetType.String() + "/" + snapshot.Pair.String()
+	day := snapshot.LastUpdated.Format(fileDateFormat)
+
+	w.m.Lock()
+	defer w.m.Unlock()
+	f, ok := w.files[key]
+	if ok && f.day != day {
+		delete(w.files, key)
+		if err := f.close(); err != nil {
+			return err
+		}
+		ok = false
+	}
+	if !ok {
+		var err error
+		f, err = openFile(Path(w.dir, b.ExchangeName, b.AssetType, b.Pair, b.LastUpdated, time.Now()), day)
+		if err != nil {
+			return err
+		}
+		w.files[key] = f
+	}
+	return f.enc.Encode(&snapshot)
+}
+
+// Flush writes buffered snapshots through to their files
+func (w *Writer) Flush() error {
+	w.m.Lock()
+	defer w.m.Unlock()
+	for _, f := range w.files {
+		if err := f.gz.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close flushes and closes every open file
+func (w *Writer) Close() error {
+	w.m.Lock()
+	defer w.m.Unlock()
+	var errs []string
+	for key, f := range w.files {
+		if err := f.close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+		delete(w.files, key)
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// openFile creates a new snapshot file at path
+func openFile(path, day string) (*file, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0770); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0640)
+	if err != nil {
+		return nil, err
+	}
+	gz := gzip.NewWriter(f)
+	return &file{
+		day: day,
+		f:   f,
+		gz:  gz,
+		enc: json.NewEncoder(gz),
+	}, nil
+}
+
+func (f *file) close() error {
+	if err := f.gz.Close(); err != nil {
+		f.f.Close()
+		return err
+	}
+	return f.f.Close()
+}
+
+// Read returns the recorded snapshots of a book last updated between start
+// and end in the order they were recorded. A file cut short by an unclean
+// shutdown is read up to its last complete snapshot
+func Read(dir, exchange string, a asset.Item, p currency.Pair, start, end time.Time) ([]orderbook.Base, error) {
+	var resp []orderbook.Base
+	day := start.UTC().Truncate(time.Hour * 24)
+	for ; !day.After(end); day = day.Add(time.Hour * 24) {
+		files, err := Files(dir, exchange, a, p, day)
+		if err != nil {
+			return nil, err
+		}
+		for i := range files {
+			snapshots, err := readFile(files[i])
+			if err != nil {
+				return nil, err
+			}
+			for j := range snapshots {
+				if snapshots[j].LastUpdated.Before(start) || snapshots[j].LastUpdated.After(end) {
+					continue
+				}
+				resp = append(resp, snapshots[j])
+			}
+		}
+	}
+	return resp, nil
+}
+
+// readFile returns the snapshots stored in path, stopping at the first
+// incomplete or corrupt snapshot
+func readFile(path string) ([]orderbook.Base, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(bufio.NewReader(f))
+	if err != nil {
+		if err == io.EOF || isTruncated(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer gz.Close()
+
+	var resp []orderbook.Base
+	dec := json.NewDecoder(gz)
+	for {
+		var b orderbook.Base
+		err = dec.Decode(&b)
+		switch {
+		case err == nil:
+			resp = append(resp, b)
+			continue
+		case err == io.EOF, isTruncated(err):
+			return resp, nil
+		}
+		return nil, err
+	}
+}
+
+// isTruncated returns whether err was caused by compressed data which ends
+// early or was left incomplete by an unclean shutdown
+func isTruncated(err error) bool {
+	if _, ok := err.(flate.CorruptInputError); ok {
+		return true
+	}
+	return err == io.ErrUnexpectedEOF || err == gzip.ErrChecksum || err == gzip.ErrHeader
+}
+
+// ReplayName returns the exchange name snapshots of exchange are replayed
+// under
+func ReplayName(exchange string) string {
+	return exchange + ReplaySuffix
+}
+
+// Replay re-publishes snapshots through the orderbook service in order under
+// the exchange's ReplayName, so the live books used for trading are never
+// overwritten or recorded again. It waits between snapshots for the recorded
+// time divided by speed, a speed of zero or less publishes them without
+// waiting. Replay returns early if shutdown is closed
+func Replay(books []orderbook.Base, speed float64, shutdown <-chan struct{}) error {
+	for i := range books {
+		if i > 0 && speed > 0 {
+			wait := books[i].LastUpdated.Sub(books[i-1].LastUpdated)
+			if wait > 0 {
+				t := time.NewTimer(time.Duration(float64(wait) / speed))
+				select {
+				case <-shutdown:
+					t.Stop()
+					return errReplayStopped
+				case <-t.C:
+				}
+			}
+		}
+		select {
+		case <-shutdown:
+			return errReplayStopped
+		default:
+		}
+
+		b := books[i]
+		b.ExchangeName = ReplayName(books[i].ExchangeName)
+		b.Bids = append([]orderbook.Item(nil), books[i].Bids...)
+		b.Asks = append([]orderbook.Item(nil), books[i].Asks...)
+		if err := b.Process(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package recorder
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/dispatch"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
+)
+
+func TestMain(m *testing.M) {
+	err := dispatch.Start(1, dispatch.DefaultJobsLimit)
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func testBooks(exchange string, p currency.Pair, start time.Time, n int) []orderbook.Base {
+	var resp []orderbook.Base
+	for x := 0; x < n; x++ {
+		resp = append(resp, orderbook.Base{
+			ExchangeName: exchange,
+			AssetType:    asset.Spot,
+			Pair:         p,
+			Bids:         []orderbook.Item{{Price: 100 - float64(x), Amount: 1}},
+			Asks:         []orderbook.Item{{Price: 101 + float64(x), Amount: 2, ID: int64(x)}},
+			LastUpdateID: int64(x + 1),
+			LastUpdated:  start.Add(time.Duration(x) * time.Minute),
+		})
+	}
+	return resp
+}
+
+func TestWriteRead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-orderbooks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	p := currency.NewPair(currency.BTC, currency.USD)
+	start := time.Date(2020, 4, 28, 23, 58, 0, 0, time.UTC)
+	books := testBooks("Test", p, start, 4)
+	w := NewWriter(dir)
+	for i := range books {
+		if err = w.Write(&books[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := Files(dir, "test", asset.Spot, p, start.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Errorf("expected snapshots to roll over to the next day's file, received %v", files)
+	}
+
+	read, err := Read(dir, "Test", asset.Spot, currency.NewPairWithDelimiter("BTC", "USD", "/"), start, start.Add(time.Minute*3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read) != len(books) {
+		t.Fatalf("expected %v snapshots, received %v", len(books), len(read))
+	}
+	for i := range read {
+		if read[i].LastUpdateID != books[i].LastUpdateID ||
+			!read[i].LastUpdated.Equal(books[i].LastUpdated) ||
+			read[i].Pair.String() != "BTC-USD" ||
+			read[i].Asks[0] != books[i].Asks[0] ||
+			read[i].Bids[0] != books[i].Bids[0] {
+			t.Errorf("expected %+v, received %+v", books[i], read[i])
+		}
+	}
+
+	read, err = Read(dir, "Test", asset.Spot, p, start.Add(time.Minute), start.Add(time.Minute*2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read) != 2 || read[0].LastUpdateID != 2 {
+		t.Errorf("expected snapshots within range, received %+v", read)
+	}
+}
+
+func TestReadTruncated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-orderbooks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	p := currency.NewPair(currency.BTC, currency.USD)
+	start := time.Date(2020, 4, 28, 0, 0, 0, 0, time.UTC)
+	books := testBooks("test", p, start, 3)
+	w := NewWriter(dir)
+	for i := range books {
+		if err = w.Write(&books[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Read before closing as an unclean shutdown would leave the file
+	read, err := Read(dir, "test", asset.Spot, p, start, start.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read) != len(books) {
+		t.Errorf("expected %v snapshots, received %v", len(books), len(read))
+	}
+
+	// Restarting on the same day after a crash must not append to the
+	// incomplete file
+	more := testBooks("test", p, start.Add(time.Hour), 2)
+	restarted := NewWriter(dir)
+	for i := range more {
+		if err = restarted.Write(&more[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = restarted.Close(); err != nil {
+		t.Fatal(err)
+	}
+	read, err = Read(dir, "test", asset.Spot, p, start, start.Add(time.Hour*2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read) != len(books)+len(more) {
+		t.Errorf("expected %v snapshots after restart, received %v", len(books)+len(more), len(read))
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	read, err = Read(dir, "test", asset.Spot, p, start.Add(time.Hour*48), start.Add(time.Hour*49))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read) != 0 {
+		t.Errorf("expected no snapshots for missing file, received %v", len(read))
+	}
+}
+
+func TestReadCorrupt(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-orderbooks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	p := currency.NewPair(currency.BTC, currency.USD)
+	start := time.Date(2020, 4, 28, 0, 0, 0, 0, time.UTC)
+	books := testBooks("test", p, start, 3)
+	w := NewWriter(dir)
+	for i := range books {
+		if err = w.Write(&books[i]); err != nil {
+			t.Fatal(err)
+		}
+		if err = w.Flush(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := Files(dir, "test", asset.Spot, p, start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("expected one snapshot file, received %v", files)
+	}
+	data, err := ioutil.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Garble the end of the file, leaving the earlier flushed snapshots intact
+	for i := len(data) - 16; i < len(data); i++ {
+		data[i] ^= 0xff
+	}
+	if err = ioutil.WriteFile(files[0], data, 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	read, err := Read(dir, "test", asset.Spot, p, start, start.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read) == 0 || len(read) > len(books) {
+		t.Errorf("expected snapshots before the corruption, received %v", len(read))
+	}
+}
+
+func TestReplay(t *testing.T) {
+	p := currency.NewPair(currency.BTC, currency.USD)
+	books := testBooks("replaytest", p, time.Now().Add(-time.Hour), 3)
+	if err := Replay(books, 0, nil); err != nil {
+		t.Fatal(err)
+	}
+	b, err := orderbook.Get(ReplayName("replaytest"), p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.LastUpdateID != 3 || b.Bids[0].Price != 98 {
+		t.Errorf("expected last snapshot to be published, received %+v", b)
+	}
+	if _, err = orderbook.Get("replaytest", p, asset.Spot); err == nil {
+		t.Error("expected replay not to publish under the live exchange name")
+	}
+
+	shutdown := make(chan struct{})
+	close(shutdown)
+	if err = Replay(books, 1, shutdown); err != errReplayStopped {
+		t.Errorf("expected %v, received %v", errReplayStopped, err)
+	}
+}
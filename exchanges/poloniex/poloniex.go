@@ -48,6 +48,7 @@ const (
 	poloniexLendingHistory       = "returnLendingHistory"
 	poloniexAutoRenew            = "toggleAutoRenew"
 	poloniexMaxOrderbookDepth    = 100
+	poloniexTradeHistoryLimit    = 1000
 )
 
 // Poloniex is the overarching type across the poloniex package
@@ -56,7 +57,7 @@ type Poloniex struct {
 }
 
 // GetTicker returns current ticker information
-func (p *Poloniex) GetTicker() (map[string]Ticker, error) {
+func (p *Poloniex) GetTicker(ctx context.Context) (map[string]Ticker, error) {
 	type response struct {
 		Data map[string]Ticker
 	}
@@ -64,19 +65,19 @@ func (p *Poloniex) GetTicker() (map[string]Ticker, error) {
 	resp := response{}
 	path := fmt.Sprintf("%s/public?command=returnTicker", p.API.Endpoints.URL)
 
-	return resp.Data, p.SendHTTPRequest(path, &resp.Data)
+	return resp.Data, p.SendHTTPRequest(ctx, path, &resp.Data)
 }
 
 // GetVolume returns a list of currencies with associated volume
-func (p *Poloniex) GetVolume() (interface{}, error) {
+func (p *Poloniex) GetVolume(ctx context.Context) (interface{}, error) {
 	var resp interface{}
 	path := fmt.Sprintf("%s/public?command=return24hVolume", p.API.Endpoints.URL)
 
-	return resp, p.SendHTTPRequest(path, &resp)
+	return resp, p.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetOrderbook returns the full orderbook from poloniex
-func (p *Poloniex) GetOrderbook(currencyPair string, depth int) (OrderbookAll, error) {
+func (p *Poloniex) GetOrderbook(ctx context.Context, currencyPair string, depth int) (OrderbookAll, error) {
 	vals := url.Values{}
 
 	if depth != 0 {
@@ -88,7 +89,7 @@ func (p *Poloniex) GetOrderbook(currencyPair string, depth int) (OrderbookAll, e
 		vals.Set("currencyPair", currencyPair)
 		resp := OrderbookResponse{}
 		path := fmt.Sprintf("%s/public?command=returnOrderBook&%s", p.API.Endpoints.URL, vals.Encode())
-		err := p.SendHTTPRequest(path, &resp)
+		err := p.SendHTTPRequest(ctx, path, &resp)
 		if err != nil {
 			return oba, err
 		}
@@ -122,7 +123,7 @@ func (p *Poloniex) GetOrderbook(currencyPair string, depth int) (OrderbookAll, e
 		vals.Set("currencyPair", "all")
 		resp := OrderbookResponseAll{}
 		path := fmt.Sprintf("%s/public?command=returnOrderBook&%s", p.API.Endpoints.URL, vals.Encode())
-		err := p.SendHTTPRequest(path, &resp.Data)
+		err := p.SendHTTPRequest(ctx, path, &resp.Data)
 		if err != nil {
 			return oba, err
 		}
@@ -156,7 +157,7 @@ func (p *Poloniex) GetOrderbook(currencyPair string, depth int) (OrderbookAll, e
 }
 
 // GetTradeHistory returns trades history from poloniex
-func (p *Poloniex) GetTradeHistory(currencyPair, start, end string) ([]TradeHistory, error) {
+func (p *Poloniex) GetTradeHistory(ctx context.Context, currencyPair, start, end string) ([]TradeHistory, error) {
 	vals := url.Values{}
 	vals.Set("currencyPair", currencyPair)
 
@@ -171,11 +172,11 @@ func (p *Poloniex) GetTradeHistory(currencyPair, start, end string) ([]TradeHist
 	var resp []TradeHistory
 	path := fmt.Sprintf("%s/public?command=returnTradeHistory&%s", p.API.Endpoints.URL, vals.Encode())
 
-	return resp, p.SendHTTPRequest(path, &resp)
+	return resp, p.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetChartData returns chart data for a specific currency pair
-func (p *Poloniex) GetChartData(currencyPair string, start, end time.Time, period string) ([]ChartData, error) {
+func (p *Poloniex) GetChartData(ctx context.Context, currencyPair string, start, end time.Time, period string) ([]ChartData, error) {
 	vals := url.Values{}
 	vals.Set("currencyPair", currencyPair)
 
@@ -194,7 +195,7 @@ func (p *Poloniex) GetChartData(currencyPair string, start, end time.Time, perio
 	var temp json.RawMessage
 	var resp []ChartData
 	path := p.API.Endpoints.URL + "/public?command=returnChartData&" + vals.Encode()
-	err := p.SendHTTPRequest(path, &temp)
+	err := p.SendHTTPRequest(ctx, path, &temp)
 	if err != nil {
 		return nil, err
 	}
@@ -217,30 +218,30 @@ func (p *Poloniex) GetChartData(currencyPair string, start, end time.Time, perio
 }
 
 // GetCurrencies returns information about currencies
-func (p *Poloniex) GetCurrencies() (map[string]Currencies, error) {
+func (p *Poloniex) GetCurrencies(ctx context.Context) (map[string]Currencies, error) {
 	type Response struct {
 		Data map[string]Currencies
 	}
 	resp := Response{}
 	path := fmt.Sprintf("%s/public?command=returnCurrencies", p.API.Endpoints.URL)
 
-	return resp.Data, p.SendHTTPRequest(path, &resp.Data)
+	return resp.Data, p.SendHTTPRequest(ctx, path, &resp.Data)
 }
 
 // GetLoanOrders returns the list of loan offers and demands for a given
 // currency, specified by the "currency" GET parameter.
-func (p *Poloniex) GetLoanOrders(currency string) (LoanOrders, error) {
+func (p *Poloniex) GetLoanOrders(ctx context.Context, currency string) (LoanOrders, error) {
 	resp := LoanOrders{}
 	path := fmt.Sprintf("%s/public?command=returnLoanOrders&currency=%s", p.API.Endpoints.URL, currency)
 
-	return resp, p.SendHTTPRequest(path, &resp)
+	return resp, p.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetBalances returns balances for your account.
-func (p *Poloniex) GetBalances() (Balance, error) {
+func (p *Poloniex) GetBalances(ctx context.Context) (Balance, error) {
 	var result interface{}
 
-	err := p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexBalances, url.Values{}, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexBalances, url.Values{}, &result)
 	if err != nil {
 		return Balance{}, err
 	}
@@ -257,10 +258,10 @@ func (p *Poloniex) GetBalances() (Balance, error) {
 }
 
 // GetCompleteBalances returns complete balances from your account.
-func (p *Poloniex) GetCompleteBalances() (CompleteBalances, error) {
+func (p *Poloniex) GetCompleteBalances(ctx context.Context) (CompleteBalances, error) {
 	var result interface{}
 
-	err := p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexBalancesComplete, url.Values{}, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexBalancesComplete, url.Values{}, &result)
 	if err != nil {
 		return CompleteBalances{}, err
 	}
@@ -282,11 +283,11 @@ func (p *Poloniex) GetCompleteBalances() (CompleteBalances, error) {
 }
 
 // GetDepositAddresses returns deposit addresses for all enabled cryptos.
-func (p *Poloniex) GetDepositAddresses() (DepositAddresses, error) {
+func (p *Poloniex) GetDepositAddresses(ctx context.Context) (DepositAddresses, error) {
 	var result interface{}
 	addresses := DepositAddresses{}
 
-	err := p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexDepositAddresses, url.Values{}, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexDepositAddresses, url.Values{}, &result)
 	if err != nil {
 		return addresses, err
 	}
@@ -305,7 +306,7 @@ func (p *Poloniex) GetDepositAddresses() (DepositAddresses, error) {
 }
 
 // GenerateNewAddress generates a new address for a currency
-func (p *Poloniex) GenerateNewAddress(currency string) (string, error) {
+func (p *Poloniex) GenerateNewAddress(ctx context.Context, currency string) (string, error) {
 	type Response struct {
 		Success  int
 		Error    string
@@ -315,7 +316,7 @@ func (p *Poloniex) GenerateNewAddress(currency string) (string, error) {
 	values := url.Values{}
 	values.Set("currency", currency)
 
-	err := p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexGenerateNewAddress, values, &resp)
+	err := p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexGenerateNewAddress, values, &resp)
 	if err != nil {
 		return "", err
 	}
@@ -328,7 +329,7 @@ func (p *Poloniex) GenerateNewAddress(currency string) (string, error) {
 }
 
 // GetDepositsWithdrawals returns a list of deposits and withdrawals
-func (p *Poloniex) GetDepositsWithdrawals(start, end string) (DepositsWithdrawals, error) {
+func (p *Poloniex) GetDepositsWithdrawals(ctx context.Context, start, end string) (DepositsWithdrawals, error) {
 	resp := DepositsWithdrawals{}
 	values := url.Values{}
 
@@ -344,27 +345,27 @@ func (p *Poloniex) GetDepositsWithdrawals(start, end string) (DepositsWithdrawal
 		values.Set("end", strconv.FormatInt(time.Now().Unix(), 10))
 	}
 
-	return resp, p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexDepositsWithdrawals, values, &resp)
+	return resp, p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexDepositsWithdrawals, values, &resp)
 }
 
 // GetOpenOrders returns current unfilled opened orders
-func (p *Poloniex) GetOpenOrders(currency string) (OpenOrdersResponse, error) {
+func (p *Poloniex) GetOpenOrders(ctx context.Context, currency string) (OpenOrdersResponse, error) {
 	values := url.Values{}
 	values.Set("currencyPair", currency)
 	result := OpenOrdersResponse{}
-	return result, p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexOrders, values, &result.Data)
+	return result, p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexOrders, values, &result.Data)
 }
 
 // GetOpenOrdersForAllCurrencies returns all open orders
-func (p *Poloniex) GetOpenOrdersForAllCurrencies() (OpenOrdersResponseAll, error) {
+func (p *Poloniex) GetOpenOrdersForAllCurrencies(ctx context.Context) (OpenOrdersResponseAll, error) {
 	values := url.Values{}
 	values.Set("currencyPair", "all")
 	result := OpenOrdersResponseAll{}
-	return result, p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexOrders, values, &result.Data)
+	return result, p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexOrders, values, &result.Data)
 }
 
 // GetAuthenticatedTradeHistoryForCurrency returns account trade history
-func (p *Poloniex) GetAuthenticatedTradeHistoryForCurrency(currency string, start, end, limit int64) (AuthenticatedTradeHistoryResponse, error) {
+func (p *Poloniex) GetAuthenticatedTradeHistoryForCurrency(ctx context.Context, currency string, start, end, limit int64) (AuthenticatedTradeHistoryResponse, error) {
 	values := url.Values{}
 
 	if start > 0 {
@@ -381,11 +382,11 @@ func (p *Poloniex) GetAuthenticatedTradeHistoryForCurrency(currency string, star
 
 	values.Set("currencyPair", currency)
 	result := AuthenticatedTradeHistoryResponse{}
-	return result, p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexTradeHistory, values, &result.Data)
+	return result, p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexTradeHistory, values, &result.Data)
 }
 
 // GetAuthenticatedTradeHistory returns account trade history
-func (p *Poloniex) GetAuthenticatedTradeHistory(start, end, limit int64) (AuthenticatedTradeHistoryAll, error) {
+func (p *Poloniex) GetAuthenticatedTradeHistory(ctx context.Context, start, end, limit int64) (AuthenticatedTradeHistoryAll, error) {
 	values := url.Values{}
 
 	if start > 0 {
@@ -403,7 +404,7 @@ func (p *Poloniex) GetAuthenticatedTradeHistory(start, end, limit int64) (Authen
 	values.Set("currencyPair", "all")
 	var result json.RawMessage
 
-	err := p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexTradeHistory, values, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexTradeHistory, values, &result)
 	if err != nil {
 		return AuthenticatedTradeHistoryAll{}, err
 	}
@@ -419,7 +420,7 @@ func (p *Poloniex) GetAuthenticatedTradeHistory(start, end, limit int64) (Authen
 }
 
 // PlaceOrder places a new order on the exchange
-func (p *Poloniex) PlaceOrder(currency string, rate, amount float64, immediate, fillOrKill, buy bool) (OrderResponse, error) {
+func (p *Poloniex) PlaceOrder(ctx context.Context, currency string, rate, amount float64, immediate, fillOrKill, buy bool) (OrderResponse, error) {
 	result := OrderResponse{}
 	values := url.Values{}
 
@@ -442,16 +443,16 @@ func (p *Poloniex) PlaceOrder(currency string, rate, amount float64, immediate,
 		values.Set("fillOrKill", "1")
 	}
 
-	return result, p.SendAuthenticatedHTTPRequest(http.MethodPost, orderType, values, &result)
+	return result, p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, orderType, values, &result)
 }
 
 // CancelExistingOrder cancels and order by orderID
-func (p *Poloniex) CancelExistingOrder(orderID int64) error {
+func (p *Poloniex) CancelExistingOrder(ctx context.Context, orderID int64) error {
 	result := GenericResponse{}
 	values := url.Values{}
 	values.Set("orderNumber", strconv.FormatInt(orderID, 10))
 
-	err := p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexOrderCancel, values, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexOrderCancel, values, &result)
 	if err != nil {
 		return err
 	}
@@ -464,7 +465,7 @@ func (p *Poloniex) CancelExistingOrder(orderID int64) error {
 }
 
 // MoveOrder moves an order
-func (p *Poloniex) MoveOrder(orderID int64, rate, amount float64, postOnly, immediateOrCancel bool) (MoveOrderResponse, error) {
+func (p *Poloniex) MoveOrder(ctx context.Context, orderID int64, rate, amount float64, postOnly, immediateOrCancel bool) (MoveOrderResponse, error) {
 	result := MoveOrderResponse{}
 	values := url.Values{}
 
@@ -491,7 +492,7 @@ func (p *Poloniex) MoveOrder(orderID int64, rate, amount float64, postOnly, imme
 		values.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	}
 
-	err := p.SendAuthenticatedHTTPRequest(http.MethodPost,
+	err := p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		poloniexOrderMove,
 		values,
 		&result)
@@ -507,7 +508,7 @@ func (p *Poloniex) MoveOrder(orderID int64, rate, amount float64, postOnly, imme
 }
 
 // Withdraw withdraws a currency to a specific delegated address
-func (p *Poloniex) Withdraw(currency, address string, amount float64) (*Withdraw, error) {
+func (p *Poloniex) Withdraw(ctx context.Context, currency, address string, amount float64) (*Withdraw, error) {
 	result := &Withdraw{}
 	values := url.Values{}
 
@@ -515,7 +516,7 @@ func (p *Poloniex) Withdraw(currency, address string, amount float64) (*Withdraw
 	values.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	values.Set("address", address)
 
-	err := p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexWithdraw, values, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexWithdraw, values, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -528,20 +529,20 @@ func (p *Poloniex) Withdraw(currency, address string, amount float64) (*Withdraw
 }
 
 // GetFeeInfo returns fee information
-func (p *Poloniex) GetFeeInfo() (Fee, error) {
+func (p *Poloniex) GetFeeInfo(ctx context.Context) (Fee, error) {
 	result := Fee{}
 
-	return result, p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexFeeInfo, url.Values{}, &result)
+	return result, p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexFeeInfo, url.Values{}, &result)
 }
 
 // GetTradableBalances returns tradable balances
-func (p *Poloniex) GetTradableBalances() (map[string]map[string]float64, error) {
+func (p *Poloniex) GetTradableBalances(ctx context.Context) (map[string]map[string]float64, error) {
 	type Response struct {
 		Data map[string]map[string]interface{}
 	}
 	result := Response{}
 
-	err := p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexTradableBalances, url.Values{}, &result.Data)
+	err := p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexTradableBalances, url.Values{}, &result.Data)
 	if err != nil {
 		return nil, err
 	}
@@ -559,7 +560,7 @@ func (p *Poloniex) GetTradableBalances() (map[string]map[string]float64, error)
 }
 
 // TransferBalance transfers balances between your accounts
-func (p *Poloniex) TransferBalance(currency, from, to string, amount float64) (bool, error) {
+func (p *Poloniex) TransferBalance(ctx context.Context, currency, from, to string, amount float64) (bool, error) {
 	values := url.Values{}
 	result := GenericResponse{}
 
@@ -568,7 +569,7 @@ func (p *Poloniex) TransferBalance(currency, from, to string, amount float64) (b
 	values.Set("fromAccount", from)
 	values.Set("toAccount", to)
 
-	err := p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexTransferBalance, values, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexTransferBalance, values, &result)
 	if err != nil {
 		return false, err
 	}
@@ -581,13 +582,13 @@ func (p *Poloniex) TransferBalance(currency, from, to string, amount float64) (b
 }
 
 // GetMarginAccountSummary returns a summary on your margin accounts
-func (p *Poloniex) GetMarginAccountSummary() (Margin, error) {
+func (p *Poloniex) GetMarginAccountSummary(ctx context.Context) (Margin, error) {
 	result := Margin{}
-	return result, p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexMarginAccountSummary, url.Values{}, &result)
+	return result, p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexMarginAccountSummary, url.Values{}, &result)
 }
 
 // PlaceMarginOrder places a margin order
-func (p *Poloniex) PlaceMarginOrder(currency string, rate, amount, lendingRate float64, buy bool) (OrderResponse, error) {
+func (p *Poloniex) PlaceMarginOrder(ctx context.Context, currency string, rate, amount, lendingRate float64, buy bool) (OrderResponse, error) {
 	result := OrderResponse{}
 	values := url.Values{}
 
@@ -606,17 +607,17 @@ func (p *Poloniex) PlaceMarginOrder(currency string, rate, amount, lendingRate f
 		values.Set("lendingRate", strconv.FormatFloat(lendingRate, 'f', -1, 64))
 	}
 
-	return result, p.SendAuthenticatedHTTPRequest(http.MethodPost, orderType, values, &result)
+	return result, p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, orderType, values, &result)
 }
 
 // GetMarginPosition returns a position on a margin order
-func (p *Poloniex) GetMarginPosition(currency string) (interface{}, error) {
+func (p *Poloniex) GetMarginPosition(ctx context.Context, currency string) (interface{}, error) {
 	values := url.Values{}
 
 	if currency != "" && currency != "all" {
 		values.Set("currencyPair", currency)
 		result := MarginPosition{}
-		return result, p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexMarginPosition, values, &result)
+		return result, p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexMarginPosition, values, &result)
 	}
 	values.Set("currencyPair", "all")
 
@@ -624,16 +625,16 @@ func (p *Poloniex) GetMarginPosition(currency string) (interface{}, error) {
 		Data map[string]MarginPosition
 	}
 	result := Response{}
-	return result, p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexMarginPosition, values, &result.Data)
+	return result, p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexMarginPosition, values, &result.Data)
 }
 
 // CloseMarginPosition closes a current margin position
-func (p *Poloniex) CloseMarginPosition(currency string) (bool, error) {
+func (p *Poloniex) CloseMarginPosition(ctx context.Context, currency string) (bool, error) {
 	values := url.Values{}
 	values.Set("currencyPair", currency)
 	result := GenericResponse{}
 
-	err := p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexMarginPositionClose, values, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexMarginPositionClose, values, &result)
 	if err != nil {
 		return false, err
 	}
@@ -646,7 +647,7 @@ func (p *Poloniex) CloseMarginPosition(currency string) (bool, error) {
 }
 
 // CreateLoanOffer places a loan offer on the exchange
-func (p *Poloniex) CreateLoanOffer(currency string, amount, rate float64, duration int, autoRenew bool) (int64, error) {
+func (p *Poloniex) CreateLoanOffer(ctx context.Context, currency string, amount, rate float64, duration int, autoRenew bool) (int64, error) {
 	values := url.Values{}
 	values.Set("currency", currency)
 	values.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
@@ -668,7 +669,7 @@ func (p *Poloniex) CreateLoanOffer(currency string, amount, rate float64, durati
 
 	result := Response{}
 
-	err := p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexCreateLoanOffer, values, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexCreateLoanOffer, values, &result)
 	if err != nil {
 		return 0, err
 	}
@@ -681,12 +682,12 @@ func (p *Poloniex) CreateLoanOffer(currency string, amount, rate float64, durati
 }
 
 // CancelLoanOffer cancels a loan offer order
-func (p *Poloniex) CancelLoanOffer(orderNumber int64) (bool, error) {
+func (p *Poloniex) CancelLoanOffer(ctx context.Context, orderNumber int64) (bool, error) {
 	result := GenericResponse{}
 	values := url.Values{}
 	values.Set("orderID", strconv.FormatInt(orderNumber, 10))
 
-	err := p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexCancelLoanOffer, values, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexCancelLoanOffer, values, &result)
 	if err != nil {
 		return false, err
 	}
@@ -699,13 +700,13 @@ func (p *Poloniex) CancelLoanOffer(orderNumber int64) (bool, error) {
 }
 
 // GetOpenLoanOffers returns all open loan offers
-func (p *Poloniex) GetOpenLoanOffers() (map[string][]LoanOffer, error) {
+func (p *Poloniex) GetOpenLoanOffers(ctx context.Context) (map[string][]LoanOffer, error) {
 	type Response struct {
 		Data map[string][]LoanOffer
 	}
 	result := Response{}
 
-	err := p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexOpenLoanOffers, url.Values{}, &result.Data)
+	err := p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexOpenLoanOffers, url.Values{}, &result.Data)
 	if err != nil {
 		return nil, err
 	}
@@ -718,13 +719,13 @@ func (p *Poloniex) GetOpenLoanOffers() (map[string][]LoanOffer, error) {
 }
 
 // GetActiveLoans returns active loans
-func (p *Poloniex) GetActiveLoans() (ActiveLoans, error) {
+func (p *Poloniex) GetActiveLoans(ctx context.Context) (ActiveLoans, error) {
 	result := ActiveLoans{}
-	return result, p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexActiveLoans, url.Values{}, &result)
+	return result, p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, poloniexActiveLoans, url.Values{}, &result)
 }
 
 // GetLendingHistory returns lending history for the account
-func (p *Poloniex) GetLendingHistory(start, end string) ([]LendingHistory, error) {
+func (p *Poloniex) GetLendingHistory(ctx context.Context, start, end string) ([]LendingHistory, error) {
 	vals := url.Values{}
 
 	if start != "" {
@@ -736,19 +737,19 @@ func (p *Poloniex) GetLendingHistory(start, end string) ([]LendingHistory, error
 	}
 
 	var resp []LendingHistory
-	return resp, p.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return resp, p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		poloniexLendingHistory,
 		vals,
 		&resp)
 }
 
 // ToggleAutoRenew allows for the autorenew of a contract
-func (p *Poloniex) ToggleAutoRenew(orderNumber int64) (bool, error) {
+func (p *Poloniex) ToggleAutoRenew(ctx context.Context, orderNumber int64) (bool, error) {
 	values := url.Values{}
 	values.Set("orderNumber", strconv.FormatInt(orderNumber, 10))
 	result := GenericResponse{}
 
-	err := p.SendAuthenticatedHTTPRequest(http.MethodPost,
+	err := p.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		poloniexAutoRenew,
 		values,
 		&result)
@@ -764,8 +765,8 @@ func (p *Poloniex) ToggleAutoRenew(orderNumber int64) (bool, error) {
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (p *Poloniex) SendHTTPRequest(path string, result interface{}) error {
-	return p.SendPayload(context.Background(), &request.Item{
+func (p *Poloniex) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return p.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -776,7 +777,7 @@ func (p *Poloniex) SendHTTPRequest(path string, result interface{}) error {
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated HTTP request
-func (p *Poloniex) SendAuthenticatedHTTPRequest(method, endpoint string, values url.Values, result interface{}) error {
+func (p *Poloniex) SendAuthenticatedHTTPRequest(ctx context.Context, method, endpoint string, values url.Values, result interface{}) error {
 	if !p.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet,
 			p.Name)
@@ -796,7 +797,7 @@ func (p *Poloniex) SendAuthenticatedHTTPRequest(method, endpoint string, values
 
 	path := fmt.Sprintf("%s/%s", p.API.Endpoints.URL, poloniexAPITradingEndpoint)
 
-	return p.SendPayload(context.Background(), &request.Item{
+	return p.SendPayload(ctx, &request.Item{
 		Method:        method,
 		Path:          path,
 		Headers:       headers,
@@ -811,11 +812,11 @@ func (p *Poloniex) SendAuthenticatedHTTPRequest(method, endpoint string, values
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (p *Poloniex) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (p *Poloniex) GetFee(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		feeInfo, err := p.GetFeeInfo()
+		feeInfo, err := p.GetFeeInfo(ctx)
 		if err != nil {
 			return 0, err
 		}
@@ -1,6 +1,7 @@
 package poloniex
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -63,7 +64,7 @@ func (p *Poloniex) WsConnect() error {
 func (p *Poloniex) getCurrencyIDMap() error {
 	if currencyIDMap == nil {
 		currencyIDMap = make(map[float64]string)
-		resp, err := p.GetTicker()
+		resp, err := p.GetTicker(context.Background())
 		if err != nil {
 			return err
 		}
@@ -339,6 +340,7 @@ func (p *Poloniex) wsHandleData(respRaw []byte) error {
 						}
 
 						p.Websocket.DataHandler <- stream.TradeData{
+							TID:          strconv.FormatInt(trade.TradeID, 10),
 							Timestamp:    time.Unix(trade.Timestamp, 0),
 							CurrencyPair: pair,
 							Side:         side,
@@ -1,6 +1,7 @@
 package poloniex
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -38,7 +39,7 @@ func (p *Poloniex) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if p.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = p.UpdateTradablePairs(true)
+		err = p.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -220,7 +221,7 @@ func (p *Poloniex) Run() {
 		return
 	}
 
-	err = p.UpdateTradablePairs(forceUpdate)
+	err = p.UpdateTradablePairs(context.Background(), forceUpdate)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s failed to update tradable pairs. Err: %s",
@@ -230,8 +231,8 @@ func (p *Poloniex) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (p *Poloniex) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	resp, err := p.GetTicker()
+func (p *Poloniex) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	resp, err := p.GetTicker(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -246,8 +247,8 @@ func (p *Poloniex) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (p *Poloniex) UpdateTradablePairs(forceUpgrade bool) error {
-	pairs, err := p.FetchTradablePairs(asset.Spot)
+func (p *Poloniex) UpdateTradablePairs(ctx context.Context, forceUpgrade bool) error {
+	pairs, err := p.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -259,8 +260,8 @@ func (p *Poloniex) UpdateTradablePairs(forceUpgrade bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (p *Poloniex) UpdateTicker(currencyPair currency.Pair, assetType asset.Item) (*ticker.Price, error) {
-	tick, err := p.GetTicker()
+func (p *Poloniex) UpdateTicker(ctx context.Context, currencyPair currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+	tick, err := p.GetTicker(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -298,26 +299,26 @@ func (p *Poloniex) UpdateTicker(currencyPair currency.Pair, assetType asset.Item
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (p *Poloniex) FetchTicker(currencyPair currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (p *Poloniex) FetchTicker(ctx context.Context, currencyPair currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(p.Name, currencyPair, assetType)
 	if err != nil {
-		return p.UpdateTicker(currencyPair, assetType)
+		return p.UpdateTicker(ctx, currencyPair, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (p *Poloniex) FetchOrderbook(currencyPair currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (p *Poloniex) FetchOrderbook(ctx context.Context, currencyPair currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(p.Name, currencyPair, assetType)
 	if err != nil {
-		return p.UpdateOrderbook(currencyPair, assetType)
+		return p.UpdateOrderbook(ctx, currencyPair, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (p *Poloniex) UpdateOrderbook(currencyPair currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
-	orderbookNew, err := p.GetOrderbook("", poloniexMaxOrderbookDepth)
+func (p *Poloniex) UpdateOrderbook(ctx context.Context, currencyPair currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+	orderbookNew, err := p.GetOrderbook(ctx, "", poloniexMaxOrderbookDepth)
 	if err != nil {
 		return nil, err
 	}
@@ -364,10 +365,10 @@ func (p *Poloniex) UpdateOrderbook(currencyPair currency.Pair, assetType asset.I
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // Poloniex exchange
-func (p *Poloniex) UpdateAccountInfo() (account.Holdings, error) {
+func (p *Poloniex) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var response account.Holdings
 	response.Exchange = p.Name
-	accountBalance, err := p.GetBalances()
+	accountBalance, err := p.GetBalances(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -393,10 +394,10 @@ func (p *Poloniex) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (p *Poloniex) FetchAccountInfo() (account.Holdings, error) {
+func (p *Poloniex) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(p.Name)
 	if err != nil {
-		return p.UpdateAccountInfo()
+		return p.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -404,17 +405,69 @@ func (p *Poloniex) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (p *Poloniex) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (p *Poloniex) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data within the timeframe provided.
-func (p *Poloniex) GetExchangeHistory(currencyPair currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
-	return nil, common.ErrNotYetImplemented
+func (p *Poloniex) GetExchangeHistory(ctx context.Context, currencyPair currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]exchange.TradeHistory, error) {
+	err := exchange.CheckTradeHistoryRange(timestampStart, timestampEnd)
+	if err != nil {
+		return nil, err
+	}
+	fPair, err := p.FormatExchangeCurrency(currencyPair, assetType)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []exchange.TradeHistory
+	seen := make(map[int64]bool)
+	end := timestampEnd.Unix()
+	for {
+		// Poloniex returns at most tradeHistoryLimit trades newest first, so
+		// page backwards by moving the end time to the oldest trade received
+		var trades []TradeHistory
+		trades, err = p.GetTradeHistory(ctx, fPair.String(),
+			strconv.FormatInt(timestampStart.Unix(), 10),
+			strconv.FormatInt(end, 10))
+		if err != nil {
+			return resp, err
+		}
+
+		var added int
+		for i := range trades {
+			if seen[trades[i].TradeID] {
+				continue
+			}
+			seen[trades[i].TradeID] = true
+			var ts time.Time
+			ts, err = time.Parse("2006-01-02 15:04:05", trades[i].Date)
+			if err != nil {
+				return resp, err
+			}
+			resp = append(resp, exchange.TradeHistory{
+				Timestamp: ts,
+				TID:       strconv.FormatInt(trades[i].TradeID, 10),
+				Price:     trades[i].Rate,
+				Amount:    trades[i].Amount,
+				Exchange:  p.Name,
+				Side:      trades[i].Type,
+			})
+			if ts.Unix() < end {
+				end = ts.Unix()
+			}
+			added++
+		}
+
+		if len(trades) < poloniexTradeHistoryLimit || added == 0 {
+			break
+		}
+	}
+	return exchange.FilterTradesByTime(resp, timestampStart, timestampEnd), nil
 }
 
 // SubmitOrder submits a new order
-func (p *Poloniex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (p *Poloniex) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
@@ -422,7 +475,7 @@ func (p *Poloniex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 	fillOrKill := s.Type == order.Market
 	isBuyOrder := s.Side == order.Buy
-	response, err := p.PlaceOrder(s.Pair.String(),
+	response, err := p.PlaceOrder(ctx, s.Pair.String(),
 		s.Price,
 		s.Amount,
 		false,
@@ -444,13 +497,13 @@ func (p *Poloniex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (p *Poloniex) ModifyOrder(action *order.Modify) (string, error) {
+func (p *Poloniex) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	oID, err := strconv.ParseInt(action.ID, 10, 64)
 	if err != nil {
 		return "", err
 	}
 
-	resp, err := p.MoveOrder(oID,
+	resp, err := p.MoveOrder(ctx, oID,
 		action.Price,
 		action.Amount,
 		action.PostOnly,
@@ -463,28 +516,28 @@ func (p *Poloniex) ModifyOrder(action *order.Modify) (string, error) {
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (p *Poloniex) CancelOrder(order *order.Cancel) error {
+func (p *Poloniex) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	orderIDInt, err := strconv.ParseInt(order.ID, 10, 64)
 	if err != nil {
 		return err
 	}
 
-	return p.CancelExistingOrder(orderIDInt)
+	return p.CancelExistingOrder(ctx, orderIDInt)
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (p *Poloniex) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (p *Poloniex) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
-	openOrders, err := p.GetOpenOrdersForAllCurrencies()
+	openOrders, err := p.GetOpenOrdersForAllCurrencies(ctx)
 	if err != nil {
 		return cancelAllOrdersResponse, err
 	}
 
 	for key := range openOrders.Data {
 		for i := range openOrders.Data[key] {
-			err = p.CancelExistingOrder(openOrders.Data[key][i].OrderNumber)
+			err = p.CancelExistingOrder(ctx, openOrders.Data[key][i].OrderNumber)
 			if err != nil {
 				id := strconv.FormatInt(openOrders.Data[key][i].OrderNumber, 10)
 				cancelAllOrdersResponse.Status[id] = err.Error()
@@ -496,14 +549,14 @@ func (p *Poloniex) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, er
 }
 
 // GetOrderInfo returns information on a current open order
-func (p *Poloniex) GetOrderInfo(orderID string) (order.Detail, error) {
+func (p *Poloniex) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (p *Poloniex) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	a, err := p.GetDepositAddresses()
+func (p *Poloniex) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	a, err := p.GetDepositAddresses(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -519,8 +572,8 @@ func (p *Poloniex) GetDepositAddress(cryptocurrency currency.Code, _ string) (st
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (p *Poloniex) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
-	v, err := p.Withdraw(withdrawRequest.Currency.String(), withdrawRequest.Crypto.Address, withdrawRequest.Amount)
+func (p *Poloniex) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	v, err := p.Withdraw(ctx, withdrawRequest.Currency.String(), withdrawRequest.Crypto.Address, withdrawRequest.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -531,28 +584,28 @@ func (p *Poloniex) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (p *Poloniex) WithdrawFiatFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (p *Poloniex) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (p *Poloniex) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+func (p *Poloniex) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (p *Poloniex) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (p *Poloniex) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if (!p.AllowAuthenticatedRequest() || p.SkipAuthCheck) && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
 	}
-	return p.GetFee(feeBuilder)
+	return p.GetFee(ctx, feeBuilder)
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (p *Poloniex) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	resp, err := p.GetOpenOrdersForAllCurrencies()
+func (p *Poloniex) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	resp, err := p.GetOpenOrdersForAllCurrencies(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -602,8 +655,8 @@ func (p *Poloniex) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail,
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (p *Poloniex) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	resp, err := p.GetAuthenticatedTradeHistory(req.StartTicks.Unix(),
+func (p *Poloniex) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	resp, err := p.GetAuthenticatedTradeHistory(ctx, req.StartTicks.Unix(),
 		req.EndTicks.Unix(),
 		10000)
 	if err != nil {
@@ -656,13 +709,13 @@ func (p *Poloniex) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail,
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (p *Poloniex) ValidateCredentials() error {
-	_, err := p.UpdateAccountInfo()
+func (p *Poloniex) ValidateCredentials(ctx context.Context) error {
+	_, err := p.UpdateAccountInfo(ctx)
 	return p.CheckTransientError(err)
 }
 
 // GetHistoricCandles returns candles between a time period for a set time interval
-func (p *Poloniex) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+func (p *Poloniex) GetHistoricCandles(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if !p.KlineIntervalEnabled(interval) {
 		return kline.Item{}, kline.ErrorKline{
 			Interval: interval,
@@ -674,7 +727,7 @@ func (p *Poloniex) GetHistoricCandles(pair currency.Pair, a asset.Item, start, e
 		return kline.Item{}, err
 	}
 
-	candles, err := p.GetChartData(formattedPair.String(),
+	candles, err := p.GetChartData(ctx, formattedPair.String(),
 		start, end,
 		p.FormatExchangeKlineInterval(interval))
 	if err != nil {
@@ -704,6 +757,6 @@ func (p *Poloniex) GetHistoricCandles(pair currency.Pair, a asset.Item, start, e
 }
 
 // GetHistoricCandlesExtended returns candles between a time period for a set time interval
-func (p *Poloniex) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
-	return p.GetHistoricCandles(pair, a, start, end, interval)
+func (p *Poloniex) GetHistoricCandlesExtended(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	return p.GetHistoricCandles(ctx, pair, a, start, end, interval)
 }
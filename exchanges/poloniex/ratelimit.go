@@ -1,6 +1,7 @@
 package poloniex
 
 import (
+	"context"
 	"time"
 
 	"github.com/yurulab/gocryptotrader/exchanges/request"
@@ -20,13 +21,11 @@ type RateLimit struct {
 }
 
 // Limit limits outbound calls
-func (r *RateLimit) Limit(f request.EndpointLimit) error {
+func (r *RateLimit) Limit(ctx context.Context, f request.EndpointLimit) error {
 	if f == request.Auth {
-		time.Sleep(r.Auth.Reserve().Delay())
-		return nil
+		return request.WaitForReservations(ctx, r.Auth.Reserve())
 	}
-	time.Sleep(r.UnAuth.Reserve().Delay())
-	return nil
+	return request.WaitForReservations(ctx, r.UnAuth.Reserve())
 }
 
 // SetRateLimit returns the rate limit for the exchange
@@ -0,0 +1,334 @@
+package request
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Generic rate limit headers used by a number of exchanges
+const (
+	HeaderRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining"
+	HeaderRateLimitReset     = "X-RateLimit-Reset"
+)
+
+// DefaultAdaptiveThreshold is the fraction of the window budget that can be
+// consumed before requests are spread across the remainder of the window
+const DefaultAdaptiveThreshold = 0.8
+
+// Quota is the rate limit state reported by an exchange in its response
+// headers
+type Quota struct {
+	// Used is the weight consumed in the current window
+	Used int
+	// Limit is the total weight allowed per window, zero if not reported
+	Limit int
+	// Reset is the time until the current window resets, zero if not
+	// reported
+	Reset time.Duration
+}
+
+// HeaderParser extracts the quota reported by an exchange from its response
+// headers, returning false when no rate limit information is present
+type HeaderParser func(h http.Header, now time.Time) (Quota, bool)
+
+// HeaderUpdater is implemented by limiters that adjust their budget from the
+// rate limit state reported in response headers
+type HeaderUpdater interface {
+	UpdateFromHeaders(h http.Header, now time.Time)
+}
+
+// RejectionParser reports whether a response status and body show that a
+// request was rejected for exceeding the rate limit, along with how long
+// requests should be paused for. A pause of zero leaves it to the limiter
+type RejectionParser func(status int, body []byte) (time.Duration, bool)
+
+// ResponseUpdater is implemented by limiters that pause requests after a rate
+// limit rejection, for exchanges which only report their limits through the
+// response status or body
+type ResponseUpdater interface {
+	UpdateFromResponse(status int, body []byte, now time.Time)
+}
+
+// AdaptiveLimit implements the Limiter interface using a single weighted
+// budget per window that is shared across endpoints. The budget is kept in
+// line with the exchange by parsing response headers, and once the threshold
+// is reached the remaining budget is spread over the rest of the window so
+// that requests slow down well before the exchange starts rejecting them
+type AdaptiveLimit struct {
+	window    time.Duration
+	threshold float64
+	weights   map[EndpointLimit]int
+	parser    HeaderParser
+	rejection RejectionParser
+
+	m           sync.Mutex
+	limit       int
+	used        int
+	windowStart time.Time
+	next        time.Time
+}
+
+// NewAdaptiveLimit returns an AdaptiveLimit allowing limit weight per window.
+// Endpoints not found in weights consume a weight of one. A nil parser
+// disables header updates, leaving only Retry-After to be honoured
+func NewAdaptiveLimit(window time.Duration, limit int, weights map[EndpointLimit]int, parser HeaderParser) *AdaptiveLimit {
+	return &AdaptiveLimit{
+		window:      window,
+		threshold:   DefaultAdaptiveThreshold,
+		weights:     weights,
+		parser:      parser,
+		limit:       limit,
+		windowStart: time.Now(),
+	}
+}
+
+// SetRejectionParser sets the parser detecting rate limit rejections in the
+// response status and body, a rejection exhausts the budget for the pause
+// given or otherwise the rest of the window
+func (a *AdaptiveLimit) SetRejectionParser(p RejectionParser) {
+	a.m.Lock()
+	a.rejection = p
+	a.m.Unlock()
+}
+
+// Limit waits until the endpoint weight can be consumed from the budget or the
+// context is done. An error is returned straight away if the weight can never
+// fit within the limit of a single window
+func (a *AdaptiveLimit) Limit(ctx context.Context, e EndpointLimit) error {
+	weight := a.weight(e)
+	for {
+		a.m.Lock()
+		now := time.Now()
+		delay, err := a.reserve(now, weight)
+		a.m.Unlock()
+		if err != nil {
+			return err
+		}
+		if delay == 0 {
+			return ctx.Err()
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && deadline.Before(now.Add(delay)) {
+			return fmt.Errorf("rate limit delay of %s would exceed context deadline",
+				delay)
+		}
+
+		t := time.NewTimer(delay)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// UpdateFromHeaders adjusts the budget from the quota reported by the exchange.
+// A Retry-After header exhausts the budget until the time given
+func (a *AdaptiveLimit) UpdateFromHeaders(h http.Header, now time.Time) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	a.roll(now)
+
+	if after := parseRetryAfter(h, now); after > 0 {
+		a.windowStart = now.Add(after - a.window)
+		a.used = a.limit
+		return
+	}
+
+	if a.parser == nil {
+		return
+	}
+
+	q, ok := a.parser(h, now)
+	if !ok {
+		return
+	}
+
+	if q.Limit > 0 {
+		a.limit = q.Limit
+	}
+
+	if q.Reset > 0 {
+		start := now.Add(q.Reset - a.window)
+		if start.Sub(a.windowStart) > a.window/2 {
+			// The exchange has moved on to a new window, so its count is
+			// authoritative
+			a.windowStart = start
+			a.used = q.Used
+			a.next = time.Time{}
+			return
+		}
+	}
+
+	// Locally counted requests may still be in flight and not yet reflected
+	// by the exchange, while the exchange may be counting requests made from
+	// elsewhere on the same key or IP, so keep the larger of the two
+	if q.Used > a.used {
+		a.used = q.Used
+	}
+}
+
+// UpdateFromResponse exhausts the budget when the response shows the request
+// was rejected for exceeding the rate limit
+func (a *AdaptiveLimit) UpdateFromResponse(status int, body []byte, now time.Time) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	if a.rejection == nil {
+		return
+	}
+	pause, ok := a.rejection(status, body)
+	if !ok {
+		return
+	}
+	a.roll(now)
+	if pause > 0 {
+		a.windowStart = now.Add(pause - a.window)
+	}
+	a.used = a.limit
+}
+
+// Remaining returns the weight left in the current window
+func (a *AdaptiveLimit) Remaining() int {
+	a.m.Lock()
+	defer a.m.Unlock()
+	a.roll(time.Now())
+	if a.used >= a.limit {
+		return 0
+	}
+	return a.limit - a.used
+}
+
+// reserve consumes weight from the budget, returning zero on success or the
+// delay to wait before trying again
+func (a *AdaptiveLimit) reserve(now time.Time, weight int) (time.Duration, error) {
+	if weight > a.limit {
+		return 0, fmt.Errorf("endpoint weight %d exceeds rate limit of %d per window",
+			weight, a.limit)
+	}
+	a.roll(now)
+	end := a.windowStart.Add(a.window)
+	if a.used+weight > a.limit {
+		return end.Sub(now), nil
+	}
+
+	if float64(a.used+weight) > a.threshold*float64(a.limit) {
+		if now.Before(a.next) {
+			return a.next.Sub(now), nil
+		}
+		// Spread what is left of the budget evenly over the rest of the
+		// window
+		slots := (a.limit - a.used) / weight
+		a.next = now.Add(end.Sub(now) / time.Duration(slots))
+	}
+
+	a.used += weight
+	return 0, nil
+}
+
+// roll starts a new window once the current one has elapsed
+func (a *AdaptiveLimit) roll(now time.Time) {
+	if now.Before(a.windowStart.Add(a.window)) {
+		return
+	}
+	elapsed := now.Sub(a.windowStart)
+	a.windowStart = a.windowStart.Add(elapsed - elapsed%a.window)
+	a.used = 0
+	a.next = time.Time{}
+}
+
+func (a *AdaptiveLimit) weight(e EndpointLimit) int {
+	if w, ok := a.weights[e]; ok && w > 0 {
+		return w
+	}
+	return 1
+}
+
+// ParseRateLimitHeaders is a HeaderParser for exchanges using the common
+// X-RateLimit-Limit, X-RateLimit-Remaining and X-RateLimit-Reset headers. The
+// reset value is accepted as either a unix timestamp or a number of seconds
+func ParseRateLimitHeaders(h http.Header, now time.Time) (Quota, bool) {
+	limit, err := strconv.Atoi(h.Get(HeaderRateLimitLimit))
+	if err != nil {
+		return Quota{}, false
+	}
+	remaining, err := strconv.Atoi(h.Get(HeaderRateLimitRemaining))
+	if err != nil {
+		return Quota{}, false
+	}
+
+	q := Quota{Used: limit - remaining, Limit: limit}
+	if reset, err := strconv.ParseInt(h.Get(HeaderRateLimitReset), 10, 64); err == nil {
+		if reset > now.Unix()/2 {
+			q.Reset = time.Unix(reset, 0).Sub(now)
+		} else {
+			q.Reset = time.Duration(reset) * time.Second
+		}
+	}
+	return q, true
+}
+
+// Cooldown pauses requests after an exchange rejects one for exceeding its
+// rate limit. It is used alongside per endpoint limiters, which have no shared
+// budget to exhaust, for exchanges which only report their limits through the
+// response status or body
+type Cooldown struct {
+	pause  time.Duration
+	parser RejectionParser
+
+	m     sync.Mutex
+	until time.Time
+}
+
+// NewCooldown returns a Cooldown pausing requests after a rejection detected
+// by parser, for pause unless the parser gives a duration
+func NewCooldown(pause time.Duration, parser RejectionParser) *Cooldown {
+	return &Cooldown{pause: pause, parser: parser}
+}
+
+// Wait waits until any pause following a rejection has passed or the context
+// is done
+func (c *Cooldown) Wait(ctx context.Context) error {
+	c.m.Lock()
+	delay := time.Until(c.until)
+	c.m.Unlock()
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(time.Now().Add(delay)) {
+		return fmt.Errorf("rate limit cooldown of %s would exceed context deadline",
+			delay)
+	}
+
+	t := time.NewTimer(delay)
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		t.Stop()
+		return ctx.Err()
+	}
+}
+
+// UpdateFromResponse pauses requests when the response shows the request was
+// rejected for exceeding the rate limit
+func (c *Cooldown) UpdateFromResponse(status int, body []byte, now time.Time) {
+	pause, ok := c.parser(status, body)
+	if !ok {
+		return
+	}
+	if pause <= 0 {
+		pause = c.pause
+	}
+	c.m.Lock()
+	if until := now.Add(pause); until.After(c.until) {
+		c.until = until
+	}
+	c.m.Unlock()
+}
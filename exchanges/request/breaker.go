@@ -0,0 +1,165 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Circuit breaker defaults
+const (
+	DefaultBreakerThreshold = 5
+	DefaultBreakerCooldown  = 30 * time.Second
+)
+
+// Circuit breaker states
+const (
+	BreakerClosed BreakerState = iota
+	BreakerOpen
+	BreakerHalfOpen
+)
+
+// ErrBreakerOpen is returned when requests are rejected because the exchange
+// has failed too many consecutive requests
+var ErrBreakerOpen = errors.New("circuit breaker open, requests suspended")
+
+// breakerBypassKey marks a request context as exempt from the circuit breaker
+type breakerBypassKey struct{}
+
+// BypassBreaker returns a context whose requests are sent even while the
+// circuit breaker is open and are not counted by it. It is intended for
+// requests which reduce exposure, such as order cancellations
+func BypassBreaker(ctx context.Context) context.Context {
+	return context.WithValue(ctx, breakerBypassKey{}, true)
+}
+
+// breakerBypassed returns whether requests made with ctx skip the breaker
+func breakerBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(breakerBypassKey{}).(bool)
+	return bypass
+}
+
+// BreakerState is the state of a circuit breaker
+type BreakerState int32
+
+// String implements the stringer interface
+func (b BreakerState) String() string {
+	switch b {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// BreakerStatus is a snapshot of a circuit breaker
+type BreakerStatus struct {
+	State    BreakerState
+	Failures int
+	OpenedAt time.Time
+}
+
+// CircuitBreaker stops requests being sent to an exchange after a number of
+// consecutive failures. Once the cooldown has passed a single probe request is
+// let through, closing the breaker on success or reopening it on failure
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	m        sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewCircuitBreaker returns a CircuitBreaker that opens after threshold
+// consecutive failures and probes the exchange again after cooldown
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	if threshold <= 0 {
+		threshold = DefaultBreakerThreshold
+	}
+	if cooldown <= 0 {
+		cooldown = DefaultBreakerCooldown
+	}
+	return &CircuitBreaker{threshold: threshold, cooldown: cooldown}
+}
+
+// Allow returns ErrBreakerOpen if a request should not be sent
+func (c *CircuitBreaker) Allow() error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	switch c.currentState(time.Now()) {
+	case BreakerOpen:
+		return ErrBreakerOpen
+	case BreakerHalfOpen:
+		if c.probing {
+			return ErrBreakerOpen
+		}
+		c.state = BreakerHalfOpen
+		c.probing = true
+	}
+	return nil
+}
+
+// Success records a successful request, closing the breaker. It returns true
+// if the breaker was not already closed
+func (c *CircuitBreaker) Success() bool {
+	c.m.Lock()
+	defer c.m.Unlock()
+	closed := c.state != BreakerClosed
+	c.state = BreakerClosed
+	c.failures = 0
+	c.probing = false
+	return closed
+}
+
+// Failure records a failed request, opening the breaker once the threshold is
+// reached or immediately if a half-open probe fails. Failures of requests
+// still in flight when the breaker opened do not extend its cooldown. It
+// returns true if the breaker was closed and is now open
+func (c *CircuitBreaker) Failure() bool {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.failures++
+	opened := false
+	if c.state != BreakerOpen && (c.probing || c.failures >= c.threshold) {
+		opened = c.state == BreakerClosed
+		c.state = BreakerOpen
+		c.openedAt = time.Now()
+	}
+	c.probing = false
+	return opened
+}
+
+// Abandon releases a half-open probe whose outcome is unknown, such as a
+// request cancelled by its context, so that another probe can be sent
+func (c *CircuitBreaker) Abandon() {
+	c.m.Lock()
+	c.probing = false
+	c.m.Unlock()
+}
+
+// Status returns the current breaker status
+func (c *CircuitBreaker) Status() BreakerStatus {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return BreakerStatus{
+		State:    c.currentState(time.Now()),
+		Failures: c.failures,
+		OpenedAt: c.openedAt,
+	}
+}
+
+// currentState reports an open breaker as half-open once the cooldown has
+// elapsed
+func (c *CircuitBreaker) currentState(now time.Time) BreakerState {
+	if c.state == BreakerOpen && now.Sub(c.openedAt) >= c.cooldown {
+		return BreakerHalfOpen
+	}
+	return c.state
+}
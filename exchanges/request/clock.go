@@ -0,0 +1,65 @@
+package request
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+// clockOffset is the offset in nanoseconds added to local time by all
+// requesters without a server time offset
+var clockOffset int64
+
+var errServerTimeUnset = errors.New("server time is unset")
+
+// SetClockOffset sets the offset between local time and a reference clock,
+// such as NTP, used for request timestamps and nonces
+func SetClockOffset(d time.Duration) {
+	atomic.StoreInt64(&clockOffset, int64(d))
+}
+
+// GetClockOffset returns the global clock offset
+func GetClockOffset() time.Duration {
+	return time.Duration(atomic.LoadInt64(&clockOffset))
+}
+
+// SetServerTimeOffset sets the offset between local time and the exchange
+// server time, which takes precedence over the global clock offset
+func (r *Requester) SetServerTimeOffset(d time.Duration) {
+	atomic.StoreInt64(&r.serverTimeOffset, int64(d))
+	atomic.StoreInt32(&r.serverTimeSet, 1)
+}
+
+// GetServerTimeOffset returns the exchange server time offset and whether it
+// has been set
+func (r *Requester) GetServerTimeOffset() (time.Duration, bool) {
+	return time.Duration(atomic.LoadInt64(&r.serverTimeOffset)),
+		atomic.LoadInt32(&r.serverTimeSet) == 1
+}
+
+// SyncServerTime sets the server time offset from the supplied server time
+// fetcher, assuming the server time was taken half way through the call
+func (r *Requester) SyncServerTime(fetch func() (time.Time, error)) (time.Duration, error) {
+	start := time.Now()
+	serverTime, err := fetch()
+	if err != nil {
+		return 0, err
+	}
+	if serverTime.IsZero() {
+		return 0, errServerTimeUnset
+	}
+	end := time.Now()
+	offset := serverTime.Sub(start.Add(end.Sub(start) / 2))
+	r.SetServerTimeOffset(offset)
+	return offset, nil
+}
+
+// Now returns the local time adjusted by the exchange server time offset if
+// set, otherwise by the global clock offset. It should be used for all request
+// timestamps and nonces
+func (r *Requester) Now() time.Time {
+	if offset, ok := r.GetServerTimeOffset(); ok {
+		return time.Now().Add(offset)
+	}
+	return time.Now().Add(GetClockOffset())
+}
@@ -0,0 +1,62 @@
+package request
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClockOffset(t *testing.T) {
+	SetClockOffset(time.Hour)
+	defer SetClockOffset(0)
+
+	r := New("clock-test", new(http.Client))
+	if d := time.Until(r.Now()); d < 59*time.Minute || d > time.Hour {
+		t.Errorf("expected global clock offset to be applied, received %v", d)
+	}
+
+	n := r.GetNonce(false)
+	if d := time.Until(time.Unix(int64(n), 0)); d < 59*time.Minute {
+		t.Errorf("expected nonce to be offset, received %v", d)
+	}
+
+	r.SetServerTimeOffset(-time.Hour)
+	if d := time.Until(r.Now()); d > -59*time.Minute {
+		t.Errorf("expected server time offset to take precedence, received %v", d)
+	}
+}
+
+func TestSyncServerTime(t *testing.T) {
+	t.Parallel()
+	r := New("sync-test", new(http.Client))
+
+	_, err := r.SyncServerTime(func() (time.Time, error) {
+		return time.Time{}, errors.New("unavailable")
+	})
+	if err == nil {
+		t.Error("expected fetch error")
+	}
+	_, err = r.SyncServerTime(func() (time.Time, error) {
+		return time.Time{}, nil
+	})
+	if err != errServerTimeUnset {
+		t.Errorf("expected %v, received %v", errServerTimeUnset, err)
+	}
+	if _, ok := r.GetServerTimeOffset(); ok {
+		t.Error("server time offset should not be set on error")
+	}
+
+	offset, err := r.SyncServerTime(func() (time.Time, error) {
+		return time.Now().Add(-2 * time.Second), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if offset > -time.Second || offset < -3*time.Second {
+		t.Errorf("unexpected offset %v", offset)
+	}
+	if o, ok := r.GetServerTimeOffset(); !ok || o != offset {
+		t.Errorf("expected server time offset %v, received %v", offset, o)
+	}
+}
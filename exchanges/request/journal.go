@@ -0,0 +1,179 @@
+package request
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/log"
+)
+
+// redactedBody replaces request bodies that cannot be parsed for redaction
+const redactedBody = "[redacted]"
+
+// journalCredentials holds the credential and signature field names which are
+// always cleared from journal entries, in addition to the mock exclusion list
+var journalCredentials = []string{
+	"key",
+	"apikey",
+	"api_key",
+	"accesskey",
+	"access_key",
+	"accesskeyid",
+	"secret",
+	"secretkey",
+	"secret_key",
+	"sign",
+	"signature",
+	"passphrase",
+	"password",
+	"token",
+	"otp",
+	"tfa",
+}
+
+var (
+	journalExclusion     mock.Exclusion
+	journalExclusionOnce sync.Once
+)
+
+// getJournalExclusion returns the field names whose values are cleared from
+// journal entries. The mock exclusion list is loaded on first use so fields
+// redacted from recorded fixtures are also redacted from journals
+func getJournalExclusion() *mock.Exclusion {
+	journalExclusionOnce.Do(func() {
+		variables := append([]string(nil), journalCredentials...)
+		excluded, err := mock.GetExcludedItems()
+		if err != nil {
+			log.Errorf(log.RequestSys,
+				"Unable to load mock exclusion list, only credentials will be redacted from journal entries: %v\n",
+				err)
+		}
+		for i := range excluded.Variables {
+			if !mock.IsExcluded(excluded.Variables[i], variables) {
+				variables = append(variables, excluded.Variables[i])
+			}
+		}
+		journalExclusion = mock.Exclusion{
+			Headers:   excluded.Headers,
+			Variables: variables,
+		}
+	})
+	return &journalExclusion
+}
+
+var (
+	journal   Journal
+	journalMu sync.RWMutex
+)
+
+// SetJournal sets the journal which records all authenticated requests sent
+// by every Requester, a nil journal disables recording
+func SetJournal(j Journal) {
+	journalMu.Lock()
+	journal = j
+	journalMu.Unlock()
+}
+
+func getJournal() Journal {
+	journalMu.RLock()
+	defer journalMu.RUnlock()
+	return journal
+}
+
+// journalRequest records a single authenticated request attempt if a journal
+// is set
+func (r *Requester) journalRequest(req *http.Request, start time.Time, resp *http.Response, err error) {
+	j := getJournal()
+	if j == nil {
+		return
+	}
+
+	u := *req.URL
+	u.RawQuery = ""
+	entry := &JournalEntry{
+		Exchange:  r.Name,
+		Method:    req.Method,
+		Path:      u.String(),
+		Query:     RedactQuery(req.URL.RawQuery),
+		Timestamp: start,
+		Latency:   time.Since(start),
+	}
+
+	if req.GetBody != nil {
+		body, bErr := req.GetBody()
+		if bErr == nil {
+			contents, rErr := ioutil.ReadAll(body)
+			body.Close()
+			if rErr == nil {
+				entry.Body = RedactBody(contents)
+			}
+		}
+	}
+
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.StatusCode = resp.StatusCode
+	}
+
+	j.Record(entry)
+}
+
+// RedactQuery returns the URL encoded query with excluded parameter values
+// cleared
+func RedactQuery(query string) string {
+	if query == "" {
+		return ""
+	}
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return redactedBody
+	}
+	for k := range values {
+		if mock.IsExcluded(k, getJournalExclusion().Variables) {
+			values.Set(k, "")
+		}
+	}
+	return values.Encode()
+}
+
+// RedactBody returns a request body with excluded JSON fields or form values
+// cleared. Bodies which are neither are replaced entirely
+func RedactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err == nil {
+		switch d := data.(type) {
+		case map[string]interface{}:
+			if len(d) == 0 {
+				return string(body)
+			}
+		case []interface{}:
+		default:
+			return redactedBody
+		}
+		checked, err := mock.CheckJSON(data, getJournalExclusion())
+		if err != nil {
+			return redactedBody
+		}
+		redacted, err := json.Marshal(checked)
+		if err != nil {
+			return redactedBody
+		}
+		return string(redacted)
+	}
+
+	if !strings.Contains(string(body), "=") {
+		return redactedBody
+	}
+	return RedactQuery(string(body))
+}
@@ -1,7 +1,9 @@
 package request
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"sync/atomic"
 	"time"
 
@@ -22,9 +24,8 @@ type BasicLimit struct {
 }
 
 // Limit executes a single rate limit set by NewRateLimit
-func (b *BasicLimit) Limit(_ EndpointLimit) error {
-	time.Sleep(b.r.Reserve().Delay())
-	return nil
+func (b *BasicLimit) Limit(ctx context.Context, _ EndpointLimit) error {
+	return WaitForReservations(ctx, b.r.Reserve())
 }
 
 // EndpointLimit defines individual endpoint rate limits that are set when
@@ -35,7 +36,7 @@ type EndpointLimit int
 // wrapper for extended rate limiting configuration i.e. Shells of rate
 // limits with a global rate for sub rates.
 type Limiter interface {
-	Limit(EndpointLimit) error
+	Limit(context.Context, EndpointLimit) error
 }
 
 // NewRateLimit creates a new RateLimit based of time interval and how many
@@ -52,23 +53,70 @@ func NewRateLimit(interval time.Duration, actions int) *rate.Limiter {
 	return rate.NewLimiter(rate.Limit(rps), 1)
 }
 
+// WaitForReservations sleeps until the longest delay of the supplied
+// reservations has passed. If the context is cancelled, or its deadline would
+// be exceeded by the delay, the reservations are cancelled so their tokens are
+// returned to the limiters and the context error is returned
+func WaitForReservations(ctx context.Context, reservations ...*rate.Reservation) error {
+	var delay time.Duration
+	for i := range reservations {
+		if !reservations[i].OK() {
+			cancelReservations(reservations)
+			return errors.New("rate limit reservation exceeds limiter burst")
+		}
+		if d := reservations[i].Delay(); d > delay {
+			delay = d
+		}
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(time.Now().Add(delay)) {
+		cancelReservations(reservations)
+		return fmt.Errorf("rate limit delay of %s would exceed context deadline",
+			delay)
+	}
+
+	if delay == 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		cancelReservations(reservations)
+		return ctx.Err()
+	}
+}
+
+func cancelReservations(reservations []*rate.Reservation) {
+	for i := range reservations {
+		reservations[i].Cancel()
+	}
+}
+
 // NewBasicRateLimit returns an object that implements the limiter interface
 // for basic rate limit
 func NewBasicRateLimit(interval time.Duration, actions int) Limiter {
 	return &BasicLimit{NewRateLimit(interval, actions)}
 }
 
-// InitiateRateLimit sleeps for designated end point rate limits
-func (r *Requester) InitiateRateLimit(e EndpointLimit) error {
+// InitiateRateLimit sleeps for designated end point rate limits or until the
+// context is done
+func (r *Requester) InitiateRateLimit(ctx context.Context, e EndpointLimit) error {
 	if atomic.LoadInt32(&r.disableRateLimiter) == 1 {
-		return nil
+		return ctx.Err()
 	}
 
 	if r.limiter != nil {
-		return r.limiter.Limit(e)
+		start := time.Now()
+		err := r.limiter.Limit(ctx, e)
+		rateLimitWait.Observe(time.Since(start).Seconds(), r.Name)
+		return err
 	}
 
-	return nil
+	return ctx.Err()
 }
 
 // DisableRateLimiter disables the rate limiting system for the exchange
@@ -0,0 +1,53 @@
+package request
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/metrics"
+)
+
+// codeError is the code label value used for requests that failed before a
+// response was received
+const codeError = "error"
+
+var (
+	requestDuration = metrics.NewHistogramVec("gct_request_duration_seconds",
+		"Duration of HTTP requests sent to exchanges, excluding rate limit waits.",
+		nil,
+		"exchange")
+	requestsTotal = metrics.NewCounterVec("gct_requests_total",
+		"HTTP requests sent to exchanges by response status code.",
+		"exchange", "code")
+	rateLimitWait = metrics.NewHistogramVec("gct_request_rate_limit_wait_seconds",
+		"Time spent waiting on the exchange rate limiter, including websocket requests.",
+		nil,
+		"exchange")
+	breakerState = metrics.NewGaugeVec("gct_request_circuit_breaker_state",
+		"Exchange circuit breaker state: 0 closed, 1 open, 2 half-open.",
+		"exchange")
+)
+
+func init() {
+	metrics.MustRegister(requestDuration, requestsTotal, rateLimitWait, breakerState)
+}
+
+// observeRequest records the duration and outcome of a single HTTP request
+func (r *Requester) observeRequest(start time.Time, resp *http.Response, err error) {
+	requestDuration.Observe(time.Since(start).Seconds(), r.Name)
+	code := codeError
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	requestsTotal.Inc(r.Name, code)
+}
+
+// observeBreaker records the current circuit breaker state
+func (r *Requester) observeBreaker() {
+	breaker := r.getBreaker()
+	if breaker == nil {
+		return
+	}
+	breakerState.Set(float64(breaker.Status().State), r.Name)
+}
@@ -0,0 +1,34 @@
+package request
+
+import (
+	"github.com/yurulab/gocryptotrader/exchanges/nonce"
+	"github.com/yurulab/gocryptotrader/log"
+)
+
+// SetNonceStore persists nonces for the requester through the supplied store,
+// leasing leaseSize nonces at a time. A nil store reverts to in memory nonces
+func (r *Requester) SetNonceStore(s nonce.Store, leaseSize int64) {
+	r.timedLock.LockForDuration()
+	defer r.timedLock.UnlockIfLocked()
+	if s == nil {
+		r.nonceLeaser = nil
+		return
+	}
+	r.nonceLeaser = nonce.NewLeaser(s, r.Name, leaseSize)
+}
+
+// leaseNonce returns the next nonce from the nonce store, seeded from min, and
+// keeps the in memory nonce in step so a store failure falls back to
+// incrementing from the last value handed out
+func (r *Requester) leaseNonce(min int64) (nonce.Value, bool) {
+	v, err := r.nonceLeaser.Next(min)
+	if err != nil {
+		log.Errorf(log.RequestSys,
+			"%s nonce store lease failed, using local nonce: %v\n",
+			r.Name,
+			err)
+		return 0, false
+	}
+	r.Nonce.Set(int64(v))
+	return v, true
+}
@@ -20,3 +20,19 @@ func WithRetryPolicy(p RetryPolicy) RequesterOption {
 		r.retryPolicy = p
 	}
 }
+
+// WithCircuitBreaker configures the circuit breaker for a Requester, a nil
+// breaker disables it.
+func WithCircuitBreaker(c *CircuitBreaker) RequesterOption {
+	return func(r *Requester) {
+		r.breaker = c
+	}
+}
+
+// WithOutboundPool configures the proxies or source addresses requests are
+// routed through for a Requester.
+func WithOutboundPool(p *OutboundPool) RequesterOption {
+	return func(r *Requester) {
+		r.SetOutboundPool(p)
+	}
+}
@@ -0,0 +1,215 @@
+package request
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Outbound route selection strategies for unauthenticated requests
+const (
+	RoundRobin OutboundStrategy = "roundrobin"
+	LeastUsed  OutboundStrategy = "leastused"
+)
+
+var errNoOutboundRoutes = errors.New("no proxies or source addresses supplied")
+
+// OutboundStrategy determines how an outbound route is selected
+type OutboundStrategy string
+
+// OutboundPool spreads requests across a set of proxies or local source
+// addresses. Authenticated requests always use the first route so that only
+// a single address needs to be whitelisted with the exchange
+type OutboundPool struct {
+	strategy OutboundStrategy
+	routes   []*OutboundRoute
+	m        sync.Mutex
+	next     int
+}
+
+// OutboundRoute is a single proxy or local source address
+type OutboundRoute struct {
+	proxy     *url.URL
+	localAddr *net.TCPAddr
+	transport *http.Transport
+	inFlight  int64
+	uses      uint64
+}
+
+// NewOutboundPool returns a pool routing requests through the supplied proxy
+// URLs and local source IP addresses, in that order
+func NewOutboundPool(strategy OutboundStrategy, proxies, sourceAddresses []string) (*OutboundPool, error) {
+	switch OutboundStrategy(strings.ToLower(string(strategy))) {
+	case "", RoundRobin:
+		strategy = RoundRobin
+	case LeastUsed:
+		strategy = LeastUsed
+	default:
+		return nil, fmt.Errorf("unsupported outbound strategy %q", strategy)
+	}
+
+	if len(proxies) == 0 && len(sourceAddresses) == 0 {
+		return nil, errNoOutboundRoutes
+	}
+
+	p := &OutboundPool{strategy: strategy}
+	for i := range proxies {
+		proxy, err := url.ParseRequestURI(proxies[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy address %q: %v", proxies[i], err)
+		}
+		p.routes = append(p.routes, newOutboundRoute(proxy, nil))
+	}
+	for i := range sourceAddresses {
+		ip := net.ParseIP(sourceAddresses[i])
+		if ip == nil {
+			return nil, fmt.Errorf("invalid source address %q", sourceAddresses[i])
+		}
+		p.routes = append(p.routes, newOutboundRoute(nil, &net.TCPAddr{IP: ip}))
+	}
+	return p, nil
+}
+
+func newOutboundRoute(proxy *url.URL, localAddr *net.TCPAddr) *OutboundRoute {
+	route := &OutboundRoute{
+		proxy:     proxy,
+		localAddr: localAddr,
+	}
+	route.transport = route.newTransport(http.DefaultTransport.(*http.Transport))
+	return route
+}
+
+// newTransport returns a clone of base which dials from the route's source
+// address or sends requests through its proxy, keeping all other settings
+func (o *OutboundRoute) newTransport(base *http.Transport) *http.Transport {
+	t := base.Clone()
+	if o.localAddr != nil {
+		dialer := &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			LocalAddr: o.localAddr,
+		}
+		t.DialContext = dialer.DialContext
+	}
+	if o.proxy != nil {
+		t.Proxy = http.ProxyURL(o.proxy)
+	}
+	return t
+}
+
+// useTransport rebuilds the transport of every route from base, so that
+// requests keep the TLS, HTTP/2, timeout and other settings of the client
+// they are sent on behalf of
+func (p *OutboundPool) useTransport(base *http.Transport) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	for i := range p.routes {
+		p.routes[i].transport = p.routes[i].newTransport(base)
+	}
+}
+
+// Strategy returns the selection strategy used for unauthenticated requests
+func (p *OutboundPool) Strategy() OutboundStrategy {
+	return p.strategy
+}
+
+// Routes returns all routes in the pool
+func (p *OutboundPool) Routes() []*OutboundRoute {
+	return append([]*OutboundRoute(nil), p.routes...)
+}
+
+// Next selects a route and counts it as used. Authenticated connections always
+// receive the first route
+func (p *OutboundPool) Next(authenticated bool) *OutboundRoute {
+	p.m.Lock()
+	defer p.m.Unlock()
+	route := p.pick(authenticated)
+	route.uses++
+	return route
+}
+
+// acquire selects a route for a request, which must be released once the
+// request completes
+func (p *OutboundPool) acquire(authenticated bool) *OutboundRoute {
+	p.m.Lock()
+	defer p.m.Unlock()
+	route := p.pick(authenticated)
+	route.uses++
+	route.inFlight++
+	return route
+}
+
+func (p *OutboundPool) release(route *OutboundRoute) {
+	p.m.Lock()
+	route.inFlight--
+	p.m.Unlock()
+}
+
+// pick must be called with the lock held
+func (p *OutboundPool) pick(authenticated bool) *OutboundRoute {
+	if authenticated || len(p.routes) == 1 {
+		return p.routes[0]
+	}
+
+	if p.strategy == LeastUsed {
+		best := p.routes[0]
+		for _, r := range p.routes[1:] {
+			if r.inFlight < best.inFlight ||
+				(r.inFlight == best.inFlight && r.uses < best.uses) {
+				best = r
+			}
+		}
+		return best
+	}
+
+	route := p.routes[p.next]
+	p.next = (p.next + 1) % len(p.routes)
+	return route
+}
+
+// Proxy returns the proxy URL of the route, nil when a source address is used
+func (o *OutboundRoute) Proxy() *url.URL {
+	return o.proxy
+}
+
+// LocalAddr returns the local source address of the route, nil when a proxy
+// is used
+func (o *OutboundRoute) LocalAddr() *net.TCPAddr {
+	return o.localAddr
+}
+
+// String returns the proxy URL or source address
+func (o *OutboundRoute) String() string {
+	if o.proxy != nil {
+		return o.proxy.String()
+	}
+	return o.localAddr.IP.String()
+}
+
+// SetOutboundPool routes requests through the supplied pool, a nil pool
+// reverts to the HTTP client transport. Route transports are cloned from the
+// HTTP client transport, or the default transport if it is not an
+// *http.Transport, so the pool must be set after the HTTP client
+func (r *Requester) SetOutboundPool(p *OutboundPool) {
+	if p != nil {
+		var base *http.Transport
+		if r.HTTPClient != nil {
+			base, _ = r.HTTPClient.Transport.(*http.Transport)
+		}
+		if base == nil {
+			base = http.DefaultTransport.(*http.Transport)
+		}
+		p.useTransport(base)
+	}
+	r.outbound = p
+}
+
+// GetOutboundPool returns the outbound pool, nil if none is set
+func (r *Requester) GetOutboundPool() *OutboundPool {
+	return r.outbound
+}
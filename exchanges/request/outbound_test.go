@@ -0,0 +1,165 @@
+package request
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewOutboundPool(t *testing.T) {
+	t.Parallel()
+	if _, err := NewOutboundPool(RoundRobin, nil, nil); err != errNoOutboundRoutes {
+		t.Errorf("expected %v, received %v", errNoOutboundRoutes, err)
+	}
+	if _, err := NewOutboundPool("random", nil, []string{"127.0.0.1"}); err == nil {
+		t.Error("expected unsupported strategy error")
+	}
+	if _, err := NewOutboundPool(RoundRobin, []string{"not a url"}, nil); err == nil {
+		t.Error("expected invalid proxy error")
+	}
+	if _, err := NewOutboundPool(RoundRobin, nil, []string{"localhost"}); err == nil {
+		t.Error("expected invalid source address error")
+	}
+
+	p, err := NewOutboundPool("", []string{"http://127.0.0.1:8080"}, []string{"::1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Strategy() != RoundRobin {
+		t.Errorf("expected %s, received %s", RoundRobin, p.Strategy())
+	}
+	routes := p.Routes()
+	if len(routes) != 2 ||
+		routes[0].String() != "http://127.0.0.1:8080" ||
+		routes[0].LocalAddr() != nil ||
+		routes[1].String() != "::1" ||
+		routes[1].Proxy() != nil {
+		t.Errorf("unexpected routes %v", routes)
+	}
+}
+
+func TestOutboundPoolSelection(t *testing.T) {
+	t.Parallel()
+	p, err := NewOutboundPool(RoundRobin, nil, []string{"127.0.0.1", "127.0.0.2", "127.0.0.3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"127.0.0.1", "127.0.0.2", "127.0.0.3", "127.0.0.1"}
+	for i := range expected {
+		if r := p.Next(false).String(); r != expected[i] {
+			t.Errorf("round robin %d: expected %s, received %s", i, expected[i], r)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		if r := p.Next(true).String(); r != "127.0.0.1" {
+			t.Errorf("authenticated requests should be sticky, received %s", r)
+		}
+	}
+
+	p, err = NewOutboundPool(LeastUsed, nil, []string{"127.0.0.1", "127.0.0.2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := p.acquire(false)
+	second := p.acquire(false)
+	if first == second {
+		t.Error("least used should not select a route with requests in flight")
+	}
+	p.release(second)
+	if r := p.acquire(false); r != second {
+		t.Errorf("least used expected %s, received %s", second, r)
+	}
+}
+
+func TestOutboundTransport(t *testing.T) {
+	t.Parallel()
+	p, err := NewOutboundPool(RoundRobin, []string{"http://127.0.0.1:8080"}, []string{"127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tlsConfig := &tls.Config{ServerName: "exchange.invalid"}
+	New("outbound-transport-test", &http.Client{Transport: &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		TLSClientConfig:       tlsConfig,
+		ForceAttemptHTTP2:     true,
+		ExpectContinueTimeout: time.Second,
+	}}, WithOutboundPool(p))
+
+	routes := p.Routes()
+	for i := range routes {
+		tr := routes[i].transport
+		if tr.TLSClientConfig == nil ||
+			tr.TLSClientConfig.ServerName != tlsConfig.ServerName ||
+			!tr.ForceAttemptHTTP2 ||
+			tr.ExpectContinueTimeout != time.Second {
+			t.Errorf("%s: client transport settings not kept", routes[i])
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "http://exchange.invalid", nil)
+	proxy, err := routes[0].transport.Proxy(req)
+	if err != nil || proxy == nil || proxy.String() != "http://127.0.0.1:8080" {
+		t.Errorf("expected route proxy, received %v %v", proxy, err)
+	}
+	if routes[0].transport.DialContext != nil {
+		t.Error("proxy route should keep the client dialer")
+	}
+	if routes[1].transport.DialContext == nil {
+		t.Error("source address route should dial from its address")
+	}
+}
+
+func TestSendPayloadOutbound(t *testing.T) {
+	t.Parallel()
+	var hits [2]int32
+	var proxies []string
+	for i := range hits {
+		i := i
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&hits[i], 1)
+			io.WriteString(w, `{"response":true}`)
+		}))
+		defer proxy.Close()
+		proxies = append(proxies, proxy.URL)
+	}
+
+	p, err := NewOutboundPool(RoundRobin, proxies, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := New("outbound-test", new(http.Client),
+		WithLimiter(NewBasicRateLimit(time.Second, 100)),
+		WithOutboundPool(p))
+
+	for i := 0; i < 4; i++ {
+		err = r.SendPayload(context.Background(), &Item{
+			Method: http.MethodGet,
+			Path:   "http://exchange.invalid/ticker",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		err = r.SendPayload(context.Background(), &Item{
+			Method:      http.MethodGet,
+			Path:        "http://exchange.invalid/balance",
+			AuthRequest: true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if h := atomic.LoadInt32(&hits[0]); h != 4 {
+		t.Errorf("expected first proxy to receive 4 requests, received %v", h)
+	}
+	if h := atomic.LoadInt32(&hits[1]); h != 2 {
+		t.Errorf("expected second proxy to receive 2 requests, received %v", h)
+	}
+}
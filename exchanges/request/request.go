@@ -1,6 +1,7 @@
 package request
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -29,6 +30,7 @@ func New(name string, httpRequester *http.Client, opts ...RequesterOption) *Requ
 		retryPolicy: DefaultRetryPolicy,
 		maxRetries:  MaxRetryAttempts,
 		timedLock:   timedmutex.NewTimedMutex(DefaultMutexLockTimeout),
+		shutdown:    make(chan struct{}),
 	}
 
 	for _, o := range opts {
@@ -38,8 +40,17 @@ func New(name string, httpRequester *http.Client, opts ...RequesterOption) *Requ
 	return r
 }
 
-// SendPayload handles sending HTTP/HTTPS requests
+// SendPayload handles sending HTTP/HTTPS requests. The request is aborted,
+// including any rate limit wait, when the context is done or the requester is
+// shut down
 func (r *Requester) SendPayload(ctx context.Context, i *Item) error {
+	if atomic.LoadInt32(&r.isShutdown) == 1 {
+		return errRequesterShutdown
+	}
+
+	ctx, cancel := r.bindContext(ctx)
+	defer cancel()
+
 	if !i.NonceEnabled {
 		r.timedLock.LockForDuration()
 	}
@@ -131,14 +142,55 @@ func (r *Requester) doRequest(req *http.Request, p *Item) error {
 		}
 	}
 
+	// The breaker counts a single outcome for the request, from its final
+	// attempt, however many times it is retried
+	var lastResp *http.Response
+	var lastErr error
+	var attempted bool
+	if breaker := r.getBreaker(); breaker != nil && !breakerBypassed(req.Context()) {
+		if err := breaker.Allow(); err != nil {
+			return fmt.Errorf("%s %v", r.Name, err)
+		}
+		defer func() {
+			if !attempted {
+				breaker.Abandon()
+				return
+			}
+			r.recordOutcome(req.Context(), breaker, lastResp, lastErr)
+		}()
+	}
+
 	for attempt := 1; ; attempt++ {
 		// Initiate a rate limit reservation and sleep on requested endpoint
-		err := r.InitiateRateLimit(p.Endpoint)
+		err := r.InitiateRateLimit(req.Context(), p.Endpoint)
 		if err != nil {
 			return err
 		}
 
-		resp, err := r.HTTPClient.Do(req)
+		client := r.HTTPClient
+		var route *OutboundRoute
+		if r.outbound != nil {
+			route = r.outbound.acquire(p.AuthRequest)
+			routed := *r.HTTPClient
+			routed.Transport = route.transport
+			client = &routed
+		}
+
+		start := time.Now()
+		resp, err := client.Do(req)
+		if route != nil {
+			r.outbound.release(route)
+		}
+		r.observeRequest(start, resp, err)
+		if p.AuthRequest {
+			r.journalRequest(req, start, resp, err)
+		}
+		attempted, lastResp, lastErr = true, resp, err
+		if err == nil {
+			if err = r.updateLimiter(resp); err != nil {
+				return err
+			}
+		}
 		if retry, checkErr := r.retryPolicy(resp, err); checkErr != nil {
 			return checkErr
 		} else if retry {
@@ -168,7 +220,7 @@ func (r *Requester) doRequest(req *http.Request, p *Item) error {
 				delay = after
 			}
 
-			if d, ok := req.Context().Deadline(); ok && d.After(time.Now().Add(delay)) {
+			if d, ok := req.Context().Deadline(); ok && d.Before(time.Now().Add(delay)) {
 				if err != nil {
 					return fmt.Errorf("request.go error - deadline would be exceeded by retry, err: %v", err)
 				}
@@ -183,7 +235,13 @@ func (r *Requester) doRequest(req *http.Request, p *Item) error {
 					attempt)
 			}
 
-			time.Sleep(delay)
+			t := time.NewTimer(delay)
+			select {
+			case <-t.C:
+			case <-req.Context().Done():
+				t.Stop()
+				return req.Context().Err()
+			}
 			continue
 		}
 
@@ -237,15 +295,124 @@ func (r *Requester) doRequest(req *http.Request, p *Item) error {
 	}
 }
 
+// recordOutcome updates the circuit breaker with the result of a request,
+// logging when it opens or closes. Transport errors and server errors count as
+// failures, while requests abandoned by their context say nothing about the
+// health of the exchange
+func (r *Requester) recordOutcome(ctx context.Context, breaker *CircuitBreaker, resp *http.Response, err error) {
+	switch {
+	case err != nil && ctx.Err() != nil:
+		breaker.Abandon()
+	case err != nil, resp.StatusCode >= http.StatusInternalServerError:
+		if breaker.Failure() {
+			log.Warnf(log.RequestSys,
+				"%s circuit breaker opened after %d consecutive failures, requests suspended",
+				r.Name,
+				breaker.threshold)
+		}
+	default:
+		if breaker.Success() {
+			log.Infof(log.RequestSys,
+				"%s circuit breaker closed, requests resumed",
+				r.Name)
+		}
+	}
+	r.observeBreaker()
+}
+
+// SetCircuitBreaker sets the circuit breaker, a nil breaker disables it
+func (r *Requester) SetCircuitBreaker(c *CircuitBreaker) {
+	r.breakerMu.Lock()
+	r.breaker = c
+	r.breakerMu.Unlock()
+	r.observeBreaker()
+}
+
+// getBreaker returns the circuit breaker, which is nil if disabled
+func (r *Requester) getBreaker() *CircuitBreaker {
+	r.breakerMu.RLock()
+	defer r.breakerMu.RUnlock()
+	return r.breaker
+}
+
+// GetBreakerStatus returns the status of the requester circuit breaker
+func (r *Requester) GetBreakerStatus() BreakerStatus {
+	breaker := r.getBreaker()
+	if breaker == nil {
+		return BreakerStatus{State: BreakerClosed}
+	}
+	return breaker.Status()
+}
+
+// updateLimiter passes the response headers on to limiters that adjust their
+// budget from the rate limit state reported by the exchange. Limiters watching
+// for rate limit rejections are passed the status and body, which is buffered
+// so it can still be read afterwards
+func (r *Requester) updateLimiter(resp *http.Response) error {
+	if u, ok := r.limiter.(HeaderUpdater); ok {
+		u.UpdateFromHeaders(resp.Header, time.Now())
+	}
+	u, ok := r.limiter.(ResponseUpdater)
+	if !ok {
+		return nil
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if cErr := resp.Body.Close(); err == nil {
+		err = cErr
+	}
+	if err != nil {
+		return err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	u.UpdateFromResponse(resp.StatusCode, body, time.Now())
+	return nil
+}
+
+// bindContext returns a context that is done when either the supplied context
+// is done or the requester is shut down
+func (r *Requester) bindContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	if r.shutdown == nil {
+		return ctx, cancel
+	}
+	go func() {
+		select {
+		case <-r.shutdown:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// Shutdown aborts all in-flight requests, including those waiting on the rate
+// limiter, and rejects any new requests
+func (r *Requester) Shutdown() error {
+	if !atomic.CompareAndSwapInt32(&r.isShutdown, 0, 1) {
+		return errRequesterShutdown
+	}
+	close(r.shutdown)
+	return nil
+}
+
 // GetNonce returns a nonce for requests. This locks and enforces concurrent
 // nonce FIFO on the buffered job channel
 func (r *Requester) GetNonce(isNano bool) nonce.Value {
 	r.timedLock.LockForDuration()
+	if r.nonceLeaser != nil {
+		seed := r.Now().Unix()
+		if isNano {
+			seed = r.Now().UnixNano()
+		}
+		if v, ok := r.leaseNonce(seed); ok {
+			return v
+		}
+	}
 	if r.Nonce.Get() == 0 {
 		if isNano {
-			r.Nonce.Set(time.Now().UnixNano())
+			r.Nonce.Set(r.Now().UnixNano())
 		} else {
-			r.Nonce.Set(time.Now().Unix())
+			r.Nonce.Set(r.Now().Unix())
 		}
 		return r.Nonce.Get()
 	}
@@ -257,8 +424,13 @@ func (r *Requester) GetNonce(isNano bool) nonce.Value {
 // nonce FIFO on the buffered job channel this is for millisecond
 func (r *Requester) GetNonceMilli() nonce.Value {
 	r.timedLock.LockForDuration()
+	if r.nonceLeaser != nil {
+		if v, ok := r.leaseNonce(r.Now().UnixNano() / int64(time.Millisecond)); ok {
+			return v
+		}
+	}
 	if r.Nonce.Get() == 0 {
-		r.Nonce.Set(time.Now().UnixNano() / int64(time.Millisecond))
+		r.Nonce.Set(r.Now().UnixNano() / int64(time.Millisecond))
 		return r.Nonce.Get()
 	}
 	r.Nonce.Inc()
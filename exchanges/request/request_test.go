@@ -5,12 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"math"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -18,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/yurulab/gocryptotrader/exchanges/nonce"
 	"golang.org/x/time/rate"
 )
 
@@ -70,6 +73,17 @@ func TestMain(m *testing.M) {
 		io.WriteString(w, `{"response":false}`)
 	})
 
+	sm.HandleFunc("/server-error", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, `{"error":true}`)
+	})
+	sm.HandleFunc("/quota", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set(HeaderRateLimitLimit, "10")
+		w.Header().Set(HeaderRateLimitRemaining, "0")
+		w.Header().Set(HeaderRateLimitReset, "60")
+		io.WriteString(w, `{"response":true}`)
+	})
+
 	server := httptest.NewServer(sm)
 	testURL = server.URL
 	issues := m.Run()
@@ -170,20 +184,18 @@ type GlobalLimitTest struct {
 	UnAuth *rate.Limiter
 }
 
-func (g *GlobalLimitTest) Limit(e EndpointLimit) error {
+func (g *GlobalLimitTest) Limit(ctx context.Context, e EndpointLimit) error {
 	switch e {
 	case Auth:
 		if g.Auth == nil {
 			return errors.New("auth rate not set")
 		}
-		time.Sleep(g.Auth.Reserve().Delay())
-		return nil
+		return WaitForReservations(ctx, g.Auth.Reserve())
 	case UnAuth:
 		if g.UnAuth == nil {
 			return errors.New("unauth rate not set")
 		}
-		time.Sleep(g.UnAuth.Reserve().Delay())
-		return nil
+		return WaitForReservations(ctx, g.UnAuth.Reserve())
 	default:
 		return fmt.Errorf("cannot execute functionality: %d not found", e)
 	}
@@ -451,6 +463,58 @@ func TestGetNonceMillis(t *testing.T) {
 	}
 }
 
+type failingNonceStore struct{}
+
+func (failingNonceStore) Lease(string, int64, int64) (int64, error) {
+	return 0, errors.New("nonce store unavailable")
+}
+
+func TestGetNonceStore(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "gct-nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	store := nonce.NewFileStore(filepath.Join(dir, "nonce.json"))
+
+	r := New("test",
+		new(http.Client),
+		WithLimiter(&globalshell))
+	r.SetNonceStore(store, 10)
+	n1 := r.GetNonce(false)
+	r.timedLock.UnlockIfLocked()
+
+	// A restarted instance must continue above the leased range
+	r2 := New("test",
+		new(http.Client),
+		WithLimiter(&globalshell))
+	r2.SetNonceStore(store, 10)
+	n2 := r2.GetNonce(false)
+	r2.timedLock.UnlockIfLocked()
+	if n2 != n1+10 {
+		t.Errorf("expected %v, received %v", n1+10, n2)
+	}
+
+	n3 := r.GetNonce(false)
+	r.timedLock.UnlockIfLocked()
+	if n3 != n1+1 {
+		t.Errorf("expected %v, received %v", n1+1, n3)
+	}
+
+	r.SetNonceStore(failingNonceStore{}, 1)
+	n4 := r.GetNonceMilli()
+	r.timedLock.UnlockIfLocked()
+	if n4 != n3+1 {
+		t.Errorf("expected fallback nonce %v, received %v", n3+1, n4)
+	}
+
+	r.SetNonceStore(nil, 0)
+	if r.nonceLeaser != nil {
+		t.Error("expected nonce store to be removed")
+	}
+}
+
 func TestSetProxy(t *testing.T) {
 	t.Parallel()
 	r := New("test",
@@ -564,3 +628,616 @@ func TestEnableDisableRateLimit(t *testing.T) {
 		// Correct test
 	}
 }
+
+func TestWaitForReservations(t *testing.T) {
+	t.Parallel()
+	l := NewRateLimit(time.Minute, 1)
+	err := WaitForReservations(context.Background(), l.Reserve())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	tn := time.Now()
+	err = WaitForReservations(ctx, l.Reserve())
+	if err == nil {
+		t.Fatal("expected error when the delay exceeds the context deadline")
+	}
+	if time.Since(tn) > time.Millisecond*500 {
+		t.Fatal("deadline should be checked before waiting")
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(time.Millisecond * 100)
+		cancel()
+	}()
+	err = WaitForReservations(ctx, l.Reserve())
+	if err != context.Canceled {
+		t.Fatalf("expected %v but received %v", context.Canceled, err)
+	}
+
+	// Multiple reservations wait for the longest delay
+	fast := NewRateLimit(time.Millisecond*100, 1)
+	fast.Reserve()
+	tn = time.Now()
+	err = WaitForReservations(context.Background(), fast.Reserve(), fast.Reserve())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(tn) < time.Millisecond*150 {
+		t.Fatal("expected to wait for the longest reservation delay")
+	}
+}
+
+func TestSendPayloadContext(t *testing.T) {
+	t.Parallel()
+	r := New("test",
+		new(http.Client),
+		WithLimiter(NewBasicRateLimit(time.Minute, 1)))
+
+	var resp interface{}
+	err := r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   testURL,
+		Result: &resp,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(time.Millisecond * 100)
+		cancel()
+	}()
+	tn := time.Now()
+	err = r.SendPayload(ctx, &Item{
+		Method: http.MethodGet,
+		Path:   testURL,
+		Result: &resp,
+	})
+	if err != context.Canceled {
+		t.Fatalf("expected %v but received %v", context.Canceled, err)
+	}
+	if time.Since(tn) > time.Second {
+		t.Fatal("rate limit wait was not aborted")
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	t.Parallel()
+	r := New("test",
+		new(http.Client),
+		WithLimiter(NewBasicRateLimit(time.Minute, 1)))
+
+	var resp interface{}
+	err := r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   testURL,
+		Result: &resp,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs := make(chan error)
+	go func() {
+		errs <- r.SendPayload(context.Background(), &Item{
+			Method: http.MethodGet,
+			Path:   testURL,
+			Result: &resp,
+		})
+	}()
+
+	time.Sleep(time.Millisecond * 100)
+	err = r.Shutdown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err = <-errs:
+		if err != context.Canceled {
+			t.Fatalf("expected %v but received %v", context.Canceled, err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("in-flight request was not aborted on shutdown")
+	}
+
+	err = r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   testURL,
+		Result: &resp,
+	})
+	if err != errRequesterShutdown {
+		t.Fatalf("expected %v but received %v", errRequesterShutdown, err)
+	}
+
+	err = r.Shutdown()
+	if err == nil {
+		t.Fatal("expected error when shutting down twice")
+	}
+}
+
+func TestAdaptiveLimit(t *testing.T) {
+	t.Parallel()
+	a := NewAdaptiveLimit(time.Minute, 10, map[EndpointLimit]int{Auth: 4}, nil)
+	for i := 0; i < 2; i++ {
+		if err := a.Limit(context.Background(), Auth); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if r := a.Remaining(); r != 2 {
+		t.Fatalf("expected 2 remaining, received %d", r)
+	}
+
+	// Weight is shared across endpoints
+	if err := a.Limit(context.Background(), UnAuth); err != nil {
+		t.Fatal(err)
+	}
+	if r := a.Remaining(); r != 1 {
+		t.Fatalf("expected 1 remaining, received %d", r)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	if err := a.Limit(ctx, Auth); err == nil {
+		t.Fatal("expected error when weight exceeds the remaining budget")
+	}
+
+	// Past the threshold the remaining budget is spread over the window
+	a = NewAdaptiveLimit(time.Millisecond*200, 10, nil, nil)
+	tn := time.Now()
+	for i := 0; i < 10; i++ {
+		if err := a.Limit(context.Background(), Unset); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if time.Since(tn) < time.Millisecond*50 {
+		t.Error("expected requests past the threshold to be slowed")
+	}
+
+	// A weight larger than the whole window budget can never be satisfied
+	a = NewAdaptiveLimit(time.Minute, 3, map[EndpointLimit]int{Auth: 4}, nil)
+	done := make(chan error, 1)
+	go func() { done <- a.Limit(context.Background(), Auth) }()
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Error("expected error when weight exceeds the window limit")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Limit blocked on a weight that can never be reserved")
+	}
+}
+
+func TestAdaptiveLimitUpdateFromHeaders(t *testing.T) {
+	t.Parallel()
+	parser := func(h http.Header, _ time.Time) (Quota, bool) {
+		used, err := strconv.Atoi(h.Get("used"))
+		if err != nil {
+			return Quota{}, false
+		}
+		return Quota{Used: used, Reset: time.Second * 30}, true
+	}
+	a := NewAdaptiveLimit(time.Minute, 100, nil, parser)
+
+	now := time.Now()
+	a.UpdateFromHeaders(http.Header{}, now)
+	if r := a.Remaining(); r != 100 {
+		t.Fatalf("expected 100 remaining, received %d", r)
+	}
+
+	h := http.Header{}
+	h.Set("used", "60")
+	a.UpdateFromHeaders(h, now)
+	if r := a.Remaining(); r != 40 {
+		t.Fatalf("expected 40 remaining, received %d", r)
+	}
+
+	// A lower count within the same window may not yet include requests made
+	// locally so the higher count is kept
+	h.Set("used", "20")
+	a.UpdateFromHeaders(h, now)
+	if r := a.Remaining(); r != 40 {
+		t.Fatalf("expected 40 remaining, received %d", r)
+	}
+
+	h = http.Header{}
+	h.Set(headerRetryAfter, "30")
+	a.UpdateFromHeaders(h, now)
+	if r := a.Remaining(); r != 0 {
+		t.Fatalf("expected budget to be exhausted by Retry-After, received %d", r)
+	}
+}
+
+func TestAdaptiveLimitUpdateFromResponse(t *testing.T) {
+	t.Parallel()
+	a := NewAdaptiveLimit(time.Minute, 100, nil, nil)
+	now := time.Now()
+	a.UpdateFromResponse(http.StatusTooManyRequests, nil, now)
+	if r := a.Remaining(); r != 100 {
+		t.Fatalf("expected rejections to be ignored without a parser, received %d remaining", r)
+	}
+
+	a.SetRejectionParser(func(status int, body []byte) (time.Duration, bool) {
+		return 0, string(body) == "slow down"
+	})
+	a.UpdateFromResponse(http.StatusOK, []byte("ok"), now)
+	if r := a.Remaining(); r != 100 {
+		t.Fatalf("expected 100 remaining, received %d", r)
+	}
+	a.UpdateFromResponse(http.StatusOK, []byte("slow down"), now)
+	if r := a.Remaining(); r != 0 {
+		t.Fatalf("expected budget to be exhausted by a rejection, received %d", r)
+	}
+}
+
+func TestCooldown(t *testing.T) {
+	t.Parallel()
+	c := NewCooldown(time.Millisecond*50, func(status int, _ []byte) (time.Duration, bool) {
+		return 0, status == http.StatusTooManyRequests
+	})
+	if err := c.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	c.UpdateFromResponse(http.StatusOK, nil, time.Now())
+	tn := time.Now()
+	if err := c.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(tn) > time.Millisecond*25 {
+		t.Error("expected no pause without a rejection")
+	}
+
+	c.UpdateFromResponse(http.StatusTooManyRequests, nil, time.Now())
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+	if err := c.Wait(ctx); err == nil {
+		t.Error("expected error when the pause exceeds the context deadline")
+	}
+	tn = time.Now()
+	if err := c.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(tn) < time.Millisecond*25 {
+		t.Error("expected requests to be paused after a rejection")
+	}
+}
+
+func TestParseRateLimitHeaders(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	if _, ok := ParseRateLimitHeaders(http.Header{}, now); ok {
+		t.Error("expected no quota without headers")
+	}
+
+	h := http.Header{}
+	h.Set("x-ratelimit-limit", "60")
+	h.Set("x-ratelimit-remaining", "45")
+	h.Set("x-ratelimit-reset", strconv.FormatInt(now.Add(time.Minute).Unix(), 10))
+	q, ok := ParseRateLimitHeaders(h, now)
+	if !ok {
+		t.Fatal("expected quota to be parsed")
+	}
+	if q.Limit != 60 || q.Used != 15 {
+		t.Errorf("expected limit 60 used 15, received %d %d", q.Limit, q.Used)
+	}
+	if q.Reset <= 0 || q.Reset > time.Minute {
+		t.Errorf("unexpected reset from timestamp %v", q.Reset)
+	}
+
+	h.Set("x-ratelimit-reset", "10")
+	q, _ = ParseRateLimitHeaders(h, now)
+	if q.Reset != time.Second*10 {
+		t.Errorf("expected reset of 10s, received %v", q.Reset)
+	}
+}
+
+func TestSendPayloadUpdatesLimiter(t *testing.T) {
+	t.Parallel()
+	a := NewAdaptiveLimit(time.Minute, 100, nil, ParseRateLimitHeaders)
+	r := New("test", new(http.Client), WithLimiter(a))
+	err := r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   testURL + "/quota",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rem := a.Remaining(); rem != 0 {
+		t.Fatalf("expected reported quota to be applied, received %d remaining", rem)
+	}
+}
+
+func TestSendPayloadUpdatesLimiterFromResponse(t *testing.T) {
+	t.Parallel()
+	var rejected string
+	a := NewAdaptiveLimit(time.Minute, 100, nil, nil)
+	a.SetRejectionParser(func(_ int, body []byte) (time.Duration, bool) {
+		rejected = string(body)
+		return 0, true
+	})
+	r := New("test", new(http.Client), WithLimiter(a))
+	var resp struct {
+		Response bool `json:"response"`
+	}
+	err := r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   testURL + "/quota",
+		Result: &resp,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rejected != `{"response":true}` || !resp.Response {
+		t.Errorf("expected body to be passed to the limiter and still decoded, received %q", rejected)
+	}
+	if rem := a.Remaining(); rem != 0 {
+		t.Fatalf("expected rejection to be applied, received %d remaining", rem)
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	c := NewCircuitBreaker(2, time.Millisecond*50)
+	if err := c.Allow(); err != nil {
+		t.Fatal(err)
+	}
+	c.Failure()
+	if s := c.Status(); s.State != BreakerClosed || s.Failures != 1 {
+		t.Fatalf("expected closed breaker with 1 failure, received %s %d", s.State, s.Failures)
+	}
+	c.Failure()
+	if s := c.Status().State; s != BreakerOpen {
+		t.Fatalf("expected open breaker, received %s", s)
+	}
+	if err := c.Allow(); err != ErrBreakerOpen {
+		t.Fatalf("expected %v, received %v", ErrBreakerOpen, err)
+	}
+
+	// Failures from requests in flight when it opened do not extend the
+	// cooldown
+	openedAt := c.Status().OpenedAt
+	time.Sleep(time.Millisecond * 10)
+	if c.Failure() {
+		t.Fatal("expected breaker to already be open")
+	}
+	if s := c.Status(); !s.OpenedAt.Equal(openedAt) {
+		t.Fatalf("expected opened at %v, received %v", openedAt, s.OpenedAt)
+	}
+
+	time.Sleep(time.Millisecond * 50)
+	if s := c.Status().State; s != BreakerHalfOpen {
+		t.Fatalf("expected half-open breaker, received %s", s)
+	}
+	if err := c.Allow(); err != nil {
+		t.Fatalf("expected probe to be allowed, received %v", err)
+	}
+	if err := c.Allow(); err != ErrBreakerOpen {
+		t.Fatalf("expected only one probe, received %v", err)
+	}
+
+	// A failed probe reopens the breaker straight away
+	c.Failure()
+	if s := c.Status().State; s != BreakerOpen {
+		t.Fatalf("expected open breaker, received %s", s)
+	}
+
+	time.Sleep(time.Millisecond * 60)
+	if err := c.Allow(); err != nil {
+		t.Fatal(err)
+	}
+	c.Abandon()
+	if err := c.Allow(); err != nil {
+		t.Fatalf("expected abandoned probe to be released, received %v", err)
+	}
+	c.Success()
+	if s := c.Status(); s.State != BreakerClosed || s.Failures != 0 {
+		t.Fatalf("expected closed breaker after success, received %s %d", s.State, s.Failures)
+	}
+}
+
+func TestSendPayloadCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	r := New("test", new(http.Client),
+		WithCircuitBreaker(NewCircuitBreaker(2, time.Minute)),
+		WithRetryPolicy(func(*http.Response, error) (bool, error) { return false, nil }))
+	for i := 0; i < 2; i++ {
+		err := r.SendPayload(context.Background(), &Item{
+			Method: http.MethodGet,
+			Path:   testURL + "/server-error",
+		})
+		if err == nil {
+			t.Fatal("expected server error")
+		}
+	}
+	if s := r.GetBreakerStatus().State; s != BreakerOpen {
+		t.Fatalf("expected open breaker, received %s", s)
+	}
+	err := r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   testURL,
+	})
+	if err == nil || !strings.Contains(err.Error(), ErrBreakerOpen.Error()) {
+		t.Fatalf("expected %v, received %v", ErrBreakerOpen, err)
+	}
+
+	r = New("test", new(http.Client), WithCircuitBreaker(nil))
+	if s := r.GetBreakerStatus().State; s != BreakerClosed {
+		t.Fatalf("expected closed status without a breaker, received %s", s)
+	}
+}
+
+func TestSendPayloadCircuitBreakerRetries(t *testing.T) {
+	t.Parallel()
+	r := New("test", new(http.Client),
+		WithCircuitBreaker(NewCircuitBreaker(2, time.Minute)),
+		WithBackoff(func(int) time.Duration { return 0 }),
+		WithRetryPolicy(func(resp *http.Response, err error) (bool, error) {
+			return err == nil && resp.StatusCode >= http.StatusInternalServerError, nil
+		}))
+	err := r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   testURL + "/server-error",
+	})
+	if err == nil {
+		t.Fatal("expected server error")
+	}
+	if s := r.GetBreakerStatus(); s.State != BreakerClosed || s.Failures != 1 {
+		t.Fatalf("expected retried request to count as one failure, received %s %d", s.State, s.Failures)
+	}
+}
+
+func TestSendPayloadBypassBreaker(t *testing.T) {
+	t.Parallel()
+	r := New("test", new(http.Client),
+		WithCircuitBreaker(NewCircuitBreaker(1, time.Minute)),
+		WithRetryPolicy(func(*http.Response, error) (bool, error) { return false, nil }))
+	if s := r.GetBreakerStatus().State; s != BreakerClosed {
+		t.Fatalf("expected closed breaker, received %s", s)
+	}
+	err := r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   testURL + "/server-error",
+	})
+	if err == nil {
+		t.Fatal("expected server error")
+	}
+	if s := r.GetBreakerStatus().State; s != BreakerOpen {
+		t.Fatalf("expected open breaker, received %s", s)
+	}
+
+	err = r.SendPayload(BypassBreaker(context.Background()), &Item{
+		Method: http.MethodGet,
+		Path:   testURL,
+	})
+	if err != nil {
+		t.Fatalf("expected bypassed request to be sent, received %v", err)
+	}
+	if s := r.GetBreakerStatus().State; s != BreakerOpen {
+		t.Fatalf("expected bypassed request not to close the breaker, received %s", s)
+	}
+
+	if s := New("test", new(http.Client)).GetBreakerStatus().State; s != BreakerClosed {
+		t.Fatalf("expected no breaker by default, received %s", s)
+	}
+}
+
+func TestSendPayloadMetrics(t *testing.T) {
+	t.Parallel()
+	r := New("metrics-test", new(http.Client),
+		WithLimiter(NewBasicRateLimit(time.Second, 100)),
+		WithRetryPolicy(func(*http.Response, error) (bool, error) { return false, nil }))
+	err := r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   testURL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   testURL + "/server-error",
+	})
+	if err == nil {
+		t.Fatal("expected server error")
+	}
+
+	if v := requestsTotal.Value("metrics-test", "200"); v != 1 {
+		t.Errorf("expected 1 successful request, received %v", v)
+	}
+	if v := requestsTotal.Value("metrics-test", "500"); v != 1 {
+		t.Errorf("expected 1 failed request, received %v", v)
+	}
+	if c := requestDuration.Count("metrics-test"); c != 2 {
+		t.Errorf("expected 2 request durations, received %v", c)
+	}
+	if c := rateLimitWait.Count("metrics-test"); c != 2 {
+		t.Errorf("expected 2 rate limit waits, received %v", c)
+	}
+}
+
+type testJournal struct {
+	m       sync.Mutex
+	entries []*JournalEntry
+}
+
+func (j *testJournal) Record(e *JournalEntry) {
+	j.m.Lock()
+	j.entries = append(j.entries, e)
+	j.m.Unlock()
+}
+
+func TestSendPayloadJournal(t *testing.T) {
+	j := &testJournal{}
+	SetJournal(j)
+	defer SetJournal(nil)
+
+	r := New("journal-test", new(http.Client),
+		WithLimiter(NewBasicRateLimit(time.Second, 100)))
+	err := r.SendPayload(context.Background(), &Item{
+		Method:      http.MethodPost,
+		Path:        testURL + "?symbol=BTCUSD&signature=abc",
+		Body:        strings.NewReader(`{"amount":1,"apiKey":"secret"}`),
+		AuthRequest: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   testURL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	j.m.Lock()
+	defer j.m.Unlock()
+	if len(j.entries) != 1 {
+		t.Fatalf("expected 1 journal entry, received %v", len(j.entries))
+	}
+	e := j.entries[0]
+	if e.Exchange != "journal-test" ||
+		e.Method != http.MethodPost ||
+		e.Path != testURL ||
+		e.StatusCode != http.StatusOK ||
+		e.Error != "" ||
+		e.Timestamp.IsZero() {
+		t.Errorf("unexpected journal entry %+v", e)
+	}
+	if e.Query != "signature=&symbol=BTCUSD" {
+		t.Errorf("unexpected query %s", e.Query)
+	}
+	if e.Body != `{"amount":1,"apiKey":""}` {
+		t.Errorf("unexpected body %s", e.Body)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		body     string
+		expected string
+	}{
+		{"", ""},
+		{"{}", "{}"},
+		{`{"nonce":1,"signature":"abc","orders":[{"price":"1","token":"x"}]}`,
+			`{"nonce":1,"orders":[{"price":"1","token":""}],"signature":""}`},
+		{`[{"username":"bob"}]`, `[{"username":""}]`},
+		{`{"login":"bob","amount":1}`, `{"amount":1,"login":""}`},
+		{"key=abc&amount=1", "amount=1&key="},
+		{"plain text", redactedBody},
+		{`"quoted"`, redactedBody},
+	}
+	for i := range testCases {
+		if r := RedactBody([]byte(testCases[i].body)); r != testCases[i].expected {
+			t.Errorf("%s: expected %s, received %s",
+				testCases[i].body, testCases[i].expected, r)
+		}
+	}
+}
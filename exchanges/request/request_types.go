@@ -1,8 +1,10 @@
 package request
 
 import (
+	"errors"
 	"io"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/yurulab/gocryptotrader/common/timedmutex"
@@ -23,6 +25,8 @@ const (
 var (
 	MaxRequestJobs   = DefaultMaxRequestJobs
 	MaxRetryAttempts = DefaultMaxRetryAttempts
+
+	errRequesterShutdown = errors.New("requester has been shut down")
 )
 
 // Requester struct for the request client
@@ -38,6 +42,14 @@ type Requester struct {
 	backoff            Backoff
 	retryPolicy        RetryPolicy
 	timedLock          *timedmutex.TimedMutex
+	shutdown           chan struct{}
+	isShutdown         int32
+	breaker            *CircuitBreaker
+	breakerMu          sync.RWMutex
+	outbound           *OutboundPool
+	serverTimeOffset   int64
+	serverTimeSet      int32
+	nonceLeaser        *nonce.Leaser
 }
 
 // Item is a temp item for requests
@@ -56,6 +68,25 @@ type Item struct {
 	Endpoint      EndpointLimit
 }
 
+// JournalEntry is a redacted record of a single authenticated request attempt
+type JournalEntry struct {
+	Exchange   string
+	Method     string
+	Path       string
+	Query      string
+	Body       string
+	StatusCode int
+	Latency    time.Duration
+	Error      string
+	Timestamp  time.Time
+}
+
+// Journal records authenticated requests sent to exchanges. Record is called
+// on the request path so implementations must not block
+type Journal interface {
+	Record(*JournalEntry)
+}
+
 // Backoff determines how long to wait between request attempts.
 type Backoff func(n int) time.Duration
 
@@ -37,8 +37,11 @@ func RetryAfter(resp *http.Response, now time.Time) time.Duration {
 	if resp == nil {
 		return 0
 	}
+	return parseRetryAfter(resp.Header, now)
+}
 
-	after := resp.Header.Get(headerRetryAfter)
+func parseRetryAfter(h http.Header, now time.Time) time.Duration {
+	after := h.Get(headerRetryAfter)
 	if after == "" {
 		return 0
 	}
@@ -20,6 +20,9 @@ const (
 
 	MockTesting = "Mock testing framework in use for %s exchange @ %s on REST endpoints only"
 	LiveTesting = "Mock testing bypassed; live testing of REST endpoints in use for %s exchange @ %s"
+	// MockMissing is logged when mock tests are skipped as no fixture has
+	// been recorded, see cmd/mock_recorder
+	MockMissing = "Mock testing skipped, no fixture recorded at %s. Record one with cmd/mock_recorder or run live with -tags=mock_test_off"
 )
 
 // GetWebsocketInterfaceChannelOverride returns a new interface based channel
@@ -0,0 +1,19 @@
+package stream
+
+import "github.com/yurulab/gocryptotrader/metrics"
+
+var (
+	websocketConnected = metrics.NewGaugeVec("gct_websocket_connected",
+		"Whether the exchange websocket is connected.",
+		"exchange")
+	websocketDisconnects = metrics.NewCounterVec("gct_websocket_disconnects_total",
+		"Unexpected exchange websocket disconnections by reason.",
+		"exchange", "reason")
+	websocketReconnects = metrics.NewCounterVec("gct_websocket_reconnects_total",
+		"Exchange websocket reconnection attempts by result.",
+		"exchange", "result")
+)
+
+func init() {
+	metrics.MustRegister(websocketConnected, websocketDisconnects, websocketReconnects)
+}
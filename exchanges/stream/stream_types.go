@@ -1,6 +1,7 @@
 package stream
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/yurulab/gocryptotrader/currency"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
 )
 
 // Connection defines a streaming services connection
@@ -18,9 +20,12 @@ type Connection interface {
 	SetupPingHandler(PingHandler)
 	GenerateMessageID(highPrecision bool) int64
 	SendMessageReturnResponse(signature interface{}, request interface{}) ([]byte, error)
+	SendRateLimitedJSONMessage(e request.EndpointLimit, data interface{}) error
+	SendRateLimitedMessageReturnResponse(e request.EndpointLimit, signature, payload interface{}) ([]byte, error)
 	SendRawMessage(messageType int, message []byte) error
 	SetURL(string)
 	SetProxy(string)
+	SetOutboundPool(*request.OutboundPool)
 	GetURL() string
 	Shutdown() error
 }
@@ -46,6 +51,16 @@ type ConnectionSetup struct {
 	RateLimit            int64
 	URL                  string
 	Authenticated        bool
+	// Limiter is the rate limit budget shared with the exchange REST
+	// requester, consulted before sending rate limited messages
+	Limiter RateLimiter
+}
+
+// RateLimiter is a rate limit budget shared between transports, satisfied by
+// request.Requester so websocket order entry draws from the same allowance as
+// REST calls for the same exchange account
+type RateLimiter interface {
+	InitiateRateLimit(ctx context.Context, e request.EndpointLimit) error
 }
 
 // PingHandler container for ping handler settings
@@ -59,6 +74,8 @@ type PingHandler struct {
 
 // TradeData defines trade data
 type TradeData struct {
+	// TID is the exchange's trade ID, left empty when the feed has none
+	TID          string
 	Timestamp    time.Time
 	CurrencyPair currency.Pair
 	AssetType    asset.Item
@@ -11,6 +11,7 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
 	"github.com/yurulab/gocryptotrader/log"
 )
 
@@ -151,6 +152,8 @@ func (w *Websocket) SetupNewConnection(c ConnectionSetup) error {
 		ExchangeName:      w.exchangeName,
 		URL:               connectionURL,
 		ProxyURL:          w.GetProxyAddress(),
+		Outbound:          w.outbound,
+		Authenticated:     c.Authenticated,
 		Verbose:           w.verbose,
 		ResponseMaxLimit:  c.ResponseMaxLimit,
 		Traffic:           w.TrafficAlert,
@@ -159,6 +162,7 @@ func (w *Websocket) SetupNewConnection(c ConnectionSetup) error {
 		Wg:                w.Wg,
 		Match:             w.Match,
 		RateLimit:         c.RateLimit,
+		Limiter:           c.Limiter,
 	}
 
 	if c.Authenticated {
@@ -333,6 +337,7 @@ func (w *Websocket) connectionMonitor() {
 				log.Warnf(log.WebsocketMgr,
 					"%v websocket has been disconnected. Reason: %v",
 					w.exchangeName, err)
+				websocketDisconnects.Inc(w.exchangeName, "error")
 				w.setConnectedStatus(false)
 			} else {
 				// pass off non disconnect errors to datahandler to manage
@@ -342,7 +347,10 @@ func (w *Websocket) connectionMonitor() {
 			if !w.IsConnecting() && !w.IsConnected() {
 				err := w.Connect()
 				if err != nil {
+					websocketReconnects.Inc(w.exchangeName, "failure")
 					log.Error(log.WebsocketMgr, err)
+				} else {
+					websocketReconnects.Inc(w.exchangeName, "success")
 				}
 			}
 			if !timer.Stop() {
@@ -512,6 +520,7 @@ func (w *Websocket) trafficMonitor() error {
 						w.trafficTimeout)
 				}
 				trafficTimer.Stop()
+				websocketDisconnects.Inc(w.exchangeName, "traffic_timeout")
 				w.Wg.Done()
 				err := w.Shutdown()
 				if err != nil {
@@ -545,6 +554,11 @@ func (w *Websocket) setConnectedStatus(b bool) {
 	w.connectionMutex.Lock()
 	w.connected = b
 	w.connectionMutex.Unlock()
+	if b {
+		websocketConnected.Set(1, w.exchangeName)
+	} else {
+		websocketConnected.Set(0, w.exchangeName)
+	}
 }
 
 // IsConnected returns status of connection
@@ -769,6 +783,18 @@ func (w *Websocket) GetProxyAddress() string {
 	return w.proxyAddr
 }
 
+// SetOutboundPool sets the proxies or source addresses websocket connections
+// are dialled through, taking effect on the next connection attempt
+func (w *Websocket) SetOutboundPool(p *request.OutboundPool) {
+	w.outbound = p
+	if w.Conn != nil {
+		w.Conn.SetOutboundPool(p)
+	}
+	if w.AuthConn != nil {
+		w.AuthConn.SetOutboundPool(p)
+	}
+}
+
 // GetName returns exchange name
 func (w *Websocket) GetName() string {
 	return w.exchangeName
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"compress/flate"
 	"compress/gzip"
+	"context"
 	"crypto/rand"
 	"fmt"
 	"io/ioutil"
@@ -15,6 +16,7 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
 	"github.com/yurulab/gocryptotrader/log"
 )
 
@@ -45,9 +47,68 @@ func (w *WebsocketConnection) SendMessageReturnResponse(signature, request inter
 	}
 }
 
+// SendRateLimitedMessageReturnResponse waits on the rate limit budget shared
+// with the REST requester for the endpoint, then sends the message and waits
+// for its response
+func (w *WebsocketConnection) SendRateLimitedMessageReturnResponse(e request.EndpointLimit, signature, payload interface{}) ([]byte, error) {
+	err := w.waitForRateLimit(e)
+	if err != nil {
+		return nil, err
+	}
+	return w.SendMessageReturnResponse(signature, payload)
+}
+
+// SendRateLimitedJSONMessage waits on the rate limit budget shared with the
+// REST requester for the endpoint, then sends a JSON encoded message
+func (w *WebsocketConnection) SendRateLimitedJSONMessage(e request.EndpointLimit, data interface{}) error {
+	err := w.waitForRateLimit(e)
+	if err != nil {
+		return err
+	}
+	return w.SendJSONMessage(data)
+}
+
+// waitForRateLimit consumes the endpoint allowance from the shared rate limit
+// budget, giving up when the connection is shut down
+func (w *WebsocketConnection) waitForRateLimit(e request.EndpointLimit) error {
+	if w.Limiter == nil {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-w.ShutdownC:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	err := w.Limiter.InitiateRateLimit(ctx, e)
+	if err != nil {
+		return fmt.Errorf("%s websocket connection: %v", w.ExchangeName, err)
+	}
+	return nil
+}
+
 // Dial sets proxy urls and then connects to the websocket
 func (w *WebsocketConnection) Dial(dialer *websocket.Dialer, headers http.Header) error {
-	if w.ProxyURL != "" {
+	if w.Outbound != nil {
+		route := w.Outbound.Next(w.Authenticated)
+		if route.Proxy() != nil {
+			dialer.Proxy = http.ProxyURL(route.Proxy())
+		}
+		if route.LocalAddr() != nil {
+			d := &net.Dialer{LocalAddr: route.LocalAddr()}
+			dialer.NetDialContext = d.DialContext
+		}
+		if w.Verbose {
+			log.Debugf(log.WebsocketMgr,
+				"%v Websocket dialling %s via %s\n",
+				w.ExchangeName,
+				w.URL,
+				route)
+		}
+	} else if w.ProxyURL != "" {
 		proxy, err := url.Parse(w.ProxyURL)
 		if err != nil {
 			return err
@@ -303,6 +364,11 @@ func (w *WebsocketConnection) SetURL(url string) {
 	w.URL = url
 }
 
+// SetOutboundPool sets the pool the connection is dialled through
+func (w *WebsocketConnection) SetOutboundPool(p *request.OutboundPool) {
+	w.Outbound = p
+}
+
 // SetProxy sets connection proxy
 func (w *WebsocketConnection) SetProxy(proxy string) {
 	w.ProxyURL = proxy
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"compress/flate"
 	"compress/gzip"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -18,6 +19,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/yurulab/gocryptotrader/currency"
 	"github.com/yurulab/gocryptotrader/exchanges/protocol"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
 )
 
 const (
@@ -929,6 +931,24 @@ func TestSetupNewConnection(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	pool, err := request.NewOutboundPool(request.RoundRobin, nil, []string{"127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	web.SetOutboundPool(pool)
+	conn, ok := web.AuthConn.(*WebsocketConnection)
+	if !ok || conn.Outbound != pool || !conn.Authenticated {
+		t.Error("outbound pool not set on authenticated connection")
+	}
+	err = web.SetupNewConnection(ConnectionSetup{URL: "urlstring"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, ok = web.Conn.(*WebsocketConnection)
+	if !ok || conn.Outbound != pool || conn.Authenticated {
+		t.Error("outbound pool not set on new connection")
+	}
 }
 
 func TestWebsocketConnectionShutdown(t *testing.T) {
@@ -955,3 +975,52 @@ func TestWebsocketConnectionShutdown(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestSendRateLimitedJSONMessage(t *testing.T) {
+	t.Parallel()
+	r := request.New("test", new(http.Client),
+		request.WithLimiter(request.NewBasicRateLimit(time.Millisecond*200, 1)))
+	wc := WebsocketConnection{
+		ExchangeName: "test",
+		Limiter:      r,
+		ShutdownC:    make(chan struct{}),
+	}
+
+	// Consume the budget from the REST side
+	err := r.InitiateRateLimit(context.Background(), request.Auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tn := time.Now()
+	err = wc.SendRateLimitedJSONMessage(request.Auth, "test")
+	if err == nil {
+		t.Fatal("expected error sending to a disconnected websocket")
+	}
+	if time.Since(tn) < time.Millisecond*100 {
+		t.Error("expected websocket message to wait on the shared budget")
+	}
+
+	// Shutting down the connection should abort a pending wait
+	err = r.InitiateRateLimit(context.Background(), request.Auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	close(wc.ShutdownC)
+	_, err = wc.SendRateLimitedMessageReturnResponse(request.Auth, 1, "test")
+	if err == nil || !strings.Contains(err.Error(), context.Canceled.Error()) {
+		t.Errorf("expected context cancelled error, received %v", err)
+	}
+}
+
+func TestConnectedStatusMetric(t *testing.T) {
+	ws := Websocket{exchangeName: "metrics-test"}
+	ws.setConnectedStatus(true)
+	if v := websocketConnected.Value("metrics-test"); v != 1 {
+		t.Errorf("expected connected gauge 1, received %v", v)
+	}
+	ws.setConnectedStatus(false)
+	if v := websocketConnected.Value("metrics-test"); v != 0 {
+		t.Errorf("expected connected gauge 0, received %v", v)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/yurulab/gocryptotrader/exchanges/protocol"
+	"github.com/yurulab/gocryptotrader/exchanges/request"
 	"github.com/yurulab/gocryptotrader/exchanges/stream/buffer"
 )
 
@@ -35,6 +36,7 @@ type Websocket struct {
 	dataMonitorRunning           bool
 	trafficTimeout               time.Duration
 	proxyAddr                    string
+	outbound                     *request.OutboundPool
 	defaultURL                   string
 	defaultURLAuth               string
 	runningURL                   string
@@ -118,12 +120,17 @@ type WebsocketConnection struct {
 	writeControl sync.Mutex
 
 	RateLimit    int64
+	Limiter      RateLimiter
 	ExchangeName string
 	URL          string
 	ProxyURL     string
-	Wg           *sync.WaitGroup
-	Connection   *websocket.Conn
-	ShutdownC    chan struct{}
+	// Outbound takes precedence over ProxyURL when set, with authenticated
+	// connections staying on the first route of the pool
+	Outbound      *request.OutboundPool
+	Authenticated bool
+	Wg            *sync.WaitGroup
+	Connection    *websocket.Conn
+	ShutdownC     chan struct{}
 
 	Match             *Match
 	ResponseMaxLimit  time.Duration
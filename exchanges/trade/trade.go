@@ -0,0 +1,248 @@
+package trade
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/dispatch"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+)
+
+func init() {
+	service = new(Service)
+	service.Trades = make(map[string]map[*currency.Item]map[*currency.Item]map[asset.Item]*Tape)
+	service.Exchange = make(map[string]uuid.UUID)
+	service.mux = dispatch.GetNewMux()
+}
+
+// SubscribeTrades subscribes to the trades of a currency pair and returns a
+// communication channel to stream new trades
+func SubscribeTrades(exchange string, p currency.Pair, a asset.Item) (dispatch.Pipe, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+
+	tape, ok := service.Trades[exchange][p.Base.Item][p.Quote.Item][a]
+	if !ok {
+		return dispatch.Pipe{}, fmt.Errorf("trades not found for %s %s %s",
+			exchange,
+			p,
+			a)
+	}
+	return service.mux.Subscribe(tape.Main)
+}
+
+// SubscribeToExchangeTrades subscribes to all trades on an exchange
+func SubscribeToExchangeTrades(exchange string) (dispatch.Pipe, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+	id, ok := service.Exchange[exchange]
+	if !ok {
+		return dispatch.Pipe{}, fmt.Errorf("%s exchange trades not found",
+			exchange)
+	}
+	return service.mux.Subscribe(id)
+}
+
+// GetRecentTrades returns the stored recent trades for an exchange, currency
+// pair and asset type, oldest first
+func GetRecentTrades(exchange string, p currency.Pair, a asset.Item) ([]Data, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+	tape, ok := service.Trades[exchange][p.Base.Item][p.Quote.Item][a]
+	if !ok {
+		return nil, fmt.Errorf("no trades for %s %s %s",
+			exchange,
+			p,
+			a)
+	}
+	resp := make([]Data, len(tape.Trades))
+	copy(resp, tape.Trades)
+	return resp, nil
+}
+
+// Process validates and stores trades received from a live feed, publishing
+// each new trade to any subscribers. Trades are only deduplicated by their
+// exchange trade ID, as separate fills can share a timestamp, price, amount
+// and side
+func Process(trades ...Data) error {
+	return process(false, trades)
+}
+
+// ProcessHistory validates and stores trades fetched over REST, publishing
+// each new trade to any subscribers. Results are expected to overlap trades
+// already received, so trades without an ID on either side are matched by
+// their contents and trades no newer than those dropped from the window are
+// ignored
+func ProcessHistory(trades ...Data) error {
+	return process(true, trades)
+}
+
+func process(history bool, trades []Data) error {
+	for i := range trades {
+		if trades[i].Exchange == "" {
+			return errors.New(errExchangeNameUnset)
+		}
+
+		if trades[i].Pair.IsEmpty() {
+			return fmt.Errorf("%s %s", trades[i].Exchange, errPairNotSet)
+		}
+
+		if trades[i].AssetType == "" {
+			return fmt.Errorf("%s %s %s",
+				trades[i].Exchange,
+				trades[i].Pair,
+				errAssetTypeNotSet)
+		}
+
+		if trades[i].Price == 0 {
+			return fmt.Errorf("%s %s %s %s",
+				trades[i].Exchange,
+				trades[i].Pair,
+				trades[i].AssetType,
+				errPriceNotSet)
+		}
+
+		if trades[i].Amount == 0 {
+			return fmt.Errorf("%s %s %s %s",
+				trades[i].Exchange,
+				trades[i].Pair,
+				trades[i].AssetType,
+				errAmountNotSet)
+		}
+
+		if trades[i].Timestamp.IsZero() {
+			trades[i].Timestamp = time.Now()
+		}
+
+		err := service.update(&trades[i], history)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// update adds a trade to the rolling window and publishes it unless it has
+// already been stored
+func (s *Service) update(d *Data, history bool) error {
+	name := strings.ToLower(d.Exchange)
+	s.Lock()
+
+	tape, ok := s.Trades[name][d.Pair.Base.Item][d.Pair.Quote.Item][d.AssetType]
+	if !ok {
+		switch {
+		case s.Trades[name] == nil:
+			s.Trades[name] = make(map[*currency.Item]map[*currency.Item]map[asset.Item]*Tape)
+			fallthrough
+		case s.Trades[name][d.Pair.Base.Item] == nil:
+			s.Trades[name][d.Pair.Base.Item] = make(map[*currency.Item]map[asset.Item]*Tape)
+			fallthrough
+		case s.Trades[name][d.Pair.Base.Item][d.Pair.Quote.Item] == nil:
+			s.Trades[name][d.Pair.Base.Item][d.Pair.Quote.Item] = make(map[asset.Item]*Tape)
+		}
+
+		var err error
+		tape, err = s.newTape(name)
+		if err != nil {
+			s.Unlock()
+			return err
+		}
+		s.Trades[name][d.Pair.Base.Item][d.Pair.Quote.Item][d.AssetType] = tape
+	}
+
+	if tape.contains(d, history) {
+		s.Unlock()
+		return nil
+	}
+
+	tape.add(d)
+	ids := append(tape.Assoc, tape.Main)
+	s.Unlock()
+	return s.mux.Publish(ids, d)
+}
+
+// newTape retrieves and sets dispatch mux publish IDs for a new trade tape
+func (s *Service) newTape(fmtName string) (*Tape, error) {
+	exchangeID, ok := s.Exchange[fmtName]
+	if !ok {
+		var err error
+		exchangeID, err = s.mux.GetID()
+		if err != nil {
+			return nil, err
+		}
+		s.Exchange[fmtName] = exchangeID
+	}
+
+	singleID, err := s.mux.GetID()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tape{Main: singleID, Assoc: []uuid.UUID{exchangeID}}, nil
+}
+
+// contains returns whether the trade has already been processed. Trades are
+// matched by ID, trades from history without an ID on either side are matched
+// by their timestamp, price, amount and side as websocket feeds may omit the
+// IDs returned over REST. History no newer than the last trade dropped from
+// the window is treated as processed so overlapping REST results are not
+// added again once capped
+func (t *Tape) contains(d *Data, history bool) bool {
+	if !history {
+		if d.TID == "" {
+			return false
+		}
+		for i := range t.Trades {
+			if t.Trades[i].TID == d.TID {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !t.dropped.IsZero() && !d.Timestamp.After(t.dropped) {
+		return true
+	}
+	for i := range t.Trades {
+		if d.TID != "" && t.Trades[i].TID != "" {
+			if t.Trades[i].TID == d.TID {
+				return true
+			}
+			continue
+		}
+		if t.Trades[i].Timestamp.Equal(d.Timestamp) &&
+			t.Trades[i].Price == d.Price &&
+			t.Trades[i].Amount == d.Amount &&
+			t.Trades[i].Side == d.Side {
+			return true
+		}
+	}
+	return false
+}
+
+// add inserts a trade in timestamp order, dropping the oldest trades once
+// MaxTrades is exceeded
+func (t *Tape) add(d *Data) {
+	t.Trades = append(t.Trades, *d)
+	if l := len(t.Trades); l > 1 && d.Timestamp.Before(t.Trades[l-2].Timestamp) {
+		sort.SliceStable(t.Trades, func(i, j int) bool {
+			return t.Trades[i].Timestamp.Before(t.Trades[j].Timestamp)
+		})
+	}
+
+	if MaxTrades > 0 && len(t.Trades) > MaxTrades {
+		drop := len(t.Trades) - MaxTrades
+		if last := t.Trades[drop-1].Timestamp; last.After(t.dropped) {
+			t.dropped = last
+		}
+		t.Trades = append(t.Trades[:0], t.Trades[drop:]...)
+	}
+}
@@ -0,0 +1,310 @@
+package trade
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/dispatch"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/order"
+)
+
+func TestMain(m *testing.M) {
+	err := dispatch.Start(1, dispatch.DefaultJobsLimit)
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestProcess(t *testing.T) {
+	p := currency.NewPair(currency.BTC, currency.USD)
+	err := Process(Data{})
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	err = Process(Data{Exchange: "processtest"})
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	err = Process(Data{Exchange: "processtest", Pair: p})
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	err = Process(Data{Exchange: "processtest", Pair: p, AssetType: asset.Spot})
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	err = Process(Data{Exchange: "processtest", Pair: p, AssetType: asset.Spot, Price: 1})
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	err = Process(Data{
+		Exchange:  "processtest",
+		Pair:      p,
+		AssetType: asset.Spot,
+		Price:     1,
+		Amount:    1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	trades, err := GetRecentTrades("processtest", p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 1 || trades[0].Timestamp.IsZero() {
+		t.Errorf("unexpected trades %+v", trades)
+	}
+
+	_, err = GetRecentTrades("processtest", p, asset.Futures)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+}
+
+func TestRollingWindow(t *testing.T) {
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	start := time.Now().Add(-time.Hour)
+	old := MaxTrades
+	MaxTrades = 5
+	defer func() { MaxTrades = old }()
+
+	var trades []Data
+	for i := 0; i < 8; i++ {
+		trades = append(trades, Data{
+			Exchange:  "windowtest",
+			TID:       strconv.Itoa(i),
+			Pair:      p,
+			AssetType: asset.Spot,
+			Side:      order.Buy,
+			Price:     float64(i + 1),
+			Amount:    1,
+			Timestamp: start.Add(time.Duration(i) * time.Second),
+		})
+	}
+
+	// Process out of order and with duplicates as REST results overlap
+	err := ProcessHistory(trades[4:]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ProcessHistory(trades...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := GetRecentTrades("windowtest", p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 5 {
+		t.Fatalf("expected 5 trades received %d", len(resp))
+	}
+	for i := range resp {
+		if resp[i].TID != strconv.Itoa(i+3) {
+			t.Errorf("expected trade %d received %s", i+3, resp[i].TID)
+		}
+	}
+
+	// Trades dropped from the window are not added again by later polls
+	err = ProcessHistory(trades...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = GetRecentTrades("windowtest", p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 5 || resp[0].TID != "3" {
+		t.Errorf("expected dropped trades to be ignored, received %+v", resp)
+	}
+
+	// A REST trade without an ID matches the same trade already stored
+	rest := trades[7]
+	rest.TID = ""
+	err = ProcessHistory(rest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = GetRecentTrades("windowtest", p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 5 || resp[4].TID != "7" {
+		t.Errorf("expected REST trade to be deduplicated, received %+v", resp)
+	}
+
+	// REST trades without an ID are deduplicated by their contents
+	noID := Data{
+		Exchange:  "windowtest",
+		Pair:      p,
+		AssetType: asset.Spot,
+		Price:     1337,
+		Amount:    1,
+		Timestamp: start.Add(time.Minute),
+	}
+	err = ProcessHistory(noID, noID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = GetRecentTrades("windowtest", p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp[len(resp)-1].Price != 1337 || resp[len(resp)-2].Price == 1337 {
+		t.Errorf("unexpected trades %+v", resp)
+	}
+}
+
+func TestLiveTrades(t *testing.T) {
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	start := time.Now().Add(-time.Hour)
+	old := MaxTrades
+	MaxTrades = 5
+	defer func() { MaxTrades = old }()
+
+	fill := Data{
+		Exchange:  "livetest",
+		Pair:      p,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Price:     1,
+		Amount:    1,
+		Timestamp: start,
+	}
+
+	// Separate fills without an ID are all kept even when identical
+	err := Process(fill, fill)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := GetRecentTrades("livetest", p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("expected 2 trades received %d", len(resp))
+	}
+
+	// Fills with an ID are deduplicated on it
+	withID := fill
+	withID.TID = "1"
+	err = Process(withID, withID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = GetRecentTrades("livetest", p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 3 {
+		t.Fatalf("expected 3 trades received %d", len(resp))
+	}
+
+	// Fill the window so trades are dropped, then widen it as live trades at
+	// or before the dropped watermark are still added
+	for i := 0; i < 5; i++ {
+		newer := fill
+		newer.Timestamp = start.Add(time.Minute)
+		err = Process(newer)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	MaxTrades = 10
+	err = Process(fill)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = GetRecentTrades("livetest", p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 6 || !resp[0].Timestamp.Equal(start) {
+		t.Errorf("expected late live trade to be kept, received %+v", resp)
+	}
+}
+
+func TestSubscribeTrades(t *testing.T) {
+	p := currency.NewPair(currency.BTC, currency.USD)
+	_, err := SubscribeTrades("subscribetest", p, asset.Spot)
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	_, err = SubscribeToExchangeTrades("subscribetest")
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	newTrade := func(i int) Data {
+		return Data{
+			Exchange:  "subscribetest",
+			TID:       strconv.Itoa(i),
+			Pair:      p,
+			AssetType: asset.Spot,
+			Price:     1337,
+			Amount:    1,
+		}
+	}
+
+	err = Process(newTrade(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pipe, err := SubscribeTrades("subscribetest", p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exchPipe, err := SubscribeToExchangeTrades("subscribetest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := make(chan struct{})
+	go func() {
+		var pairReceived, exchReceived bool
+		for !pairReceived || !exchReceived {
+			select {
+			case data := <-pipe.C:
+				d := (*data.(*interface{})).(Data)
+				pairReceived = pairReceived || d.Price == 1337
+			case data := <-exchPipe.C:
+				d := (*data.(*interface{})).(Data)
+				exchReceived = exchReceived || d.Price == 1337
+			}
+		}
+		close(received)
+	}()
+
+	// Dispatch drops updates for routines that are not ready to receive so
+	// keep publishing new trades until both pipes have been serviced
+	timeout := time.After(time.Second)
+	for i := 1; ; i++ {
+		err = Process(newTrade(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case <-received:
+			return
+		case <-timeout:
+			t.Fatal("trade not received by subscribers")
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
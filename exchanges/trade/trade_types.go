@@ -0,0 +1,65 @@
+package trade
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/yurulab/gocryptotrader/currency"
+	"github.com/yurulab/gocryptotrader/dispatch"
+	"github.com/yurulab/gocryptotrader/exchanges/asset"
+	"github.com/yurulab/gocryptotrader/exchanges/order"
+)
+
+// const values for the trade package
+const (
+	errExchangeNameUnset = "trade exchange name not set"
+	errPairNotSet        = "trade currency pair not set"
+	errAssetTypeNotSet   = "trade asset type not set"
+	errPriceNotSet       = "trade price not set"
+	errAmountNotSet      = "trade amount not set"
+
+	// DefaultMaxTrades is the default amount of recent trades stored for each
+	// exchange, currency pair and asset type
+	DefaultMaxTrades = 100
+)
+
+// Vars for the trade package
+var (
+	service *Service
+
+	// MaxTrades is the amount of recent trades stored for each exchange,
+	// currency pair and asset type before the oldest trades are dropped
+	MaxTrades = DefaultMaxTrades
+)
+
+// Service holds the recent trade tape for each individual exchange
+type Service struct {
+	Trades   map[string]map[*currency.Item]map[*currency.Item]map[asset.Item]*Tape
+	Exchange map[string]uuid.UUID
+	mux      *dispatch.Mux
+	sync.RWMutex
+}
+
+// Data defines a single public trade
+type Data struct {
+	Exchange  string
+	TID       string
+	Pair      currency.Pair
+	AssetType asset.Item
+	Side      order.Side
+	Price     float64
+	Amount    float64
+	Timestamp time.Time
+}
+
+// Tape holds a rolling window of recent trades for a currency pair and asset
+// type
+type Tape struct {
+	Trades []Data
+	Main   uuid.UUID
+	Assoc  []uuid.UUID
+	// dropped is the high-water mark of trades dropped from the window,
+	// trades at or before it have already been processed
+	dropped time.Time
+}
@@ -0,0 +1,39 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package yobit
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	y.SetDefaults()
+	yobitConfig := config.GetConfig()
+	err := yobitConfig.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Yobit load config error", err)
+	}
+	conf, err := yobitConfig.GetExchangeConfig("Yobit")
+	if err != nil {
+		log.Fatal("Yobit init error", err)
+	}
+	conf.API.Credentials.Key = apiKey
+	conf.API.Credentials.Secret = apiSecret
+	conf.API.AuthenticatedSupport = true
+
+	err = y.Setup(conf)
+	if err != nil {
+		log.Fatal("Yobit setup error", err)
+	}
+	log.Printf(sharedtestvalues.LiveTesting, y.Name, y.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
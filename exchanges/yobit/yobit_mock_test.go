@@ -0,0 +1,55 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package yobit
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/yobit/yobit.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	y.SetDefaults()
+	yobitConfig := config.GetConfig()
+	err := yobitConfig.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("Yobit load config error", err)
+	}
+	conf, err := yobitConfig.GetExchangeConfig("Yobit")
+	if err != nil {
+		log.Fatal("Yobit init error", err)
+	}
+	conf.API.Credentials.Key = apiKey
+	conf.API.Credentials.Secret = apiSecret
+	conf.API.AuthenticatedSupport = true
+
+	err = y.Setup(conf)
+	if err != nil {
+		log.Fatal("Yobit setup error", err)
+	}
+
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+
+	y.HTTPClient = newClient
+	y.API.Endpoints.URL = serverDetails + "/api"
+	y.API.Endpoints.URLSecondary = serverDetails + "/tapi"
+	log.Printf(sharedtestvalues.MockTesting, y.Name, y.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
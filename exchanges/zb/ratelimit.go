@@ -1,6 +1,7 @@
 package zb
 
 import (
+	"context"
 	"time"
 
 	"github.com/yurulab/gocryptotrader/exchanges/request"
@@ -27,16 +28,15 @@ type RateLimit struct {
 }
 
 // Limit limits the outbound requests
-func (r *RateLimit) Limit(f request.EndpointLimit) error {
+func (r *RateLimit) Limit(ctx context.Context, f request.EndpointLimit) error {
 	switch f {
 	case request.Auth:
-		time.Sleep(r.Auth.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.Auth.Reserve())
 	case klineFunc:
-		time.Sleep(r.KlineData.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.KlineData.Reserve())
 	default:
-		time.Sleep(r.UnAuth.Reserve().Delay())
+		return request.WaitForReservations(ctx, r.UnAuth.Reserve())
 	}
-	return nil
 }
 
 // SetRateLimit returns the rate limit for the exchange
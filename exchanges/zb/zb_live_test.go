@@ -0,0 +1,40 @@
+//+build mock_test_off
+
+// This will build if build tag mock_test_off is parsed and will do live testing
+// using all tests in (exchange)_test.go
+package zb
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+var mockTests = false
+
+func TestMain(m *testing.M) {
+	z.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("ZB load config error", err)
+	}
+	zbConfig, err := cfg.GetExchangeConfig("ZB")
+	if err != nil {
+		log.Fatal("ZB Setup() init error", err)
+	}
+	zbConfig.API.AuthenticatedSupport = true
+	zbConfig.API.AuthenticatedWebsocketSupport = true
+	zbConfig.API.Credentials.Key = apiKey
+	zbConfig.API.Credentials.Secret = apiSecret
+	z.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = z.Setup(zbConfig)
+	if err != nil {
+		log.Fatal("ZB setup error", err)
+	}
+	log.Printf(sharedtestvalues.LiveTesting, z.Name, z.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
@@ -0,0 +1,56 @@
+//+build !mock_test_off
+
+// This will build if build tag mock_test_off is not parsed and will try to mock
+// all tests in _test.go
+package zb
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/yurulab/gocryptotrader/config"
+	"github.com/yurulab/gocryptotrader/exchanges/mock"
+	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const mockfile = "../../testdata/http_mock/zb/zb.json"
+
+var mockTests = true
+
+func TestMain(m *testing.M) {
+	if _, err := os.Stat(mockfile); err != nil {
+		log.Printf(sharedtestvalues.MockMissing, mockfile)
+		os.Exit(0)
+	}
+	z.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal("ZB load config error", err)
+	}
+	zbConfig, err := cfg.GetExchangeConfig("ZB")
+	if err != nil {
+		log.Fatal("ZB Setup() init error", err)
+	}
+	zbConfig.API.AuthenticatedSupport = true
+	zbConfig.API.AuthenticatedWebsocketSupport = true
+	zbConfig.API.Credentials.Key = apiKey
+	zbConfig.API.Credentials.Secret = apiSecret
+	z.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = z.Setup(zbConfig)
+	if err != nil {
+		log.Fatal("ZB setup error", err)
+	}
+
+	serverDetails, newClient, err := mock.NewVCRServer(mockfile)
+	if err != nil {
+		log.Fatalf("Mock server error %s", err)
+	}
+
+	z.HTTPClient = newClient
+	z.API.Endpoints.URL = serverDetails + "/data"
+	z.API.Endpoints.URLSecondary = serverDetails + "/api"
+	log.Printf(sharedtestvalues.MockTesting, z.Name, z.API.Endpoints.URL)
+	os.Exit(m.Run())
+}
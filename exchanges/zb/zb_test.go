@@ -1,25 +1,22 @@
 package zb
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
-	"os"
 	"strconv"
 	"testing"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/yurulab/gocryptotrader/common"
-	"github.com/yurulab/gocryptotrader/config"
 	"github.com/yurulab/gocryptotrader/core"
 	"github.com/yurulab/gocryptotrader/currency"
 	exchange "github.com/yurulab/gocryptotrader/exchanges"
 	"github.com/yurulab/gocryptotrader/exchanges/asset"
 	"github.com/yurulab/gocryptotrader/exchanges/kline"
 	"github.com/yurulab/gocryptotrader/exchanges/order"
-	"github.com/yurulab/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/yurulab/gocryptotrader/exchanges/stream"
 	"github.com/yurulab/gocryptotrader/portfolio/withdraw"
 )
@@ -34,29 +31,6 @@ const (
 var z ZB
 var wsSetupRan bool
 
-func TestMain(m *testing.M) {
-	z.SetDefaults()
-	cfg := config.GetConfig()
-	err := cfg.LoadConfig("../../testdata/configtest.json", true)
-	if err != nil {
-		log.Fatal("ZB load config error", err)
-	}
-	zbConfig, err := cfg.GetExchangeConfig("ZB")
-	if err != nil {
-		log.Fatal("ZB Setup() init error", err)
-	}
-	zbConfig.API.AuthenticatedSupport = true
-	zbConfig.API.AuthenticatedWebsocketSupport = true
-	zbConfig.API.Credentials.Key = apiKey
-	zbConfig.API.Credentials.Secret = apiSecret
-	z.Websocket = sharedtestvalues.NewTestWebsocket()
-	err = z.Setup(zbConfig)
-	if err != nil {
-		log.Fatal("ZB setup error", err)
-	}
-	os.Exit(m.Run())
-}
-
 func setupWsAuth(t *testing.T) {
 	if wsSetupRan {
 		return
@@ -86,7 +60,7 @@ func TestSpotNewOrder(t *testing.T) {
 		Amount: 0.01,
 		Price:  10246.1,
 	}
-	_, err := z.SpotNewOrder(arg)
+	_, err := z.SpotNewOrder(context.Background(), arg)
 	if err != nil {
 		t.Errorf("ZB SpotNewOrder: %s", err)
 	}
@@ -99,7 +73,7 @@ func TestCancelExistingOrder(t *testing.T) {
 		t.Skip()
 	}
 
-	err := z.CancelExistingOrder(20180629145864850, "btc_usdt")
+	err := z.CancelExistingOrder(context.Background(), 20180629145864850, "btc_usdt")
 	if err != nil {
 		t.Errorf("ZB CancelExistingOrder: %s", err)
 	}
@@ -107,7 +81,7 @@ func TestCancelExistingOrder(t *testing.T) {
 
 func TestGetLatestSpotPrice(t *testing.T) {
 	t.Parallel()
-	_, err := z.GetLatestSpotPrice("btc_usdt")
+	_, err := z.GetLatestSpotPrice(context.Background(), "btc_usdt")
 	if err != nil {
 		t.Errorf("ZB GetLatestSpotPrice: %s", err)
 	}
@@ -115,15 +89,38 @@ func TestGetLatestSpotPrice(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := z.GetTicker("btc_usdt")
+	_, err := z.GetTicker(context.Background(), "btc_usdt")
 	if err != nil {
 		t.Errorf("ZB GetTicker: %s", err)
 	}
 }
 
+func TestGetTrades(t *testing.T) {
+	t.Parallel()
+	_, err := z.GetTrades(context.Background(), "btc_usdt", 0)
+	if err != nil {
+		t.Errorf("ZB GetTrades: %s", err)
+	}
+}
+
+func TestGetExchangeHistory(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPairWithDelimiter("btc", "usdt", "_")
+	end := time.Now()
+	_, err := z.GetExchangeHistory(context.Background(), p, asset.Spot, end, end.Add(-time.Hour))
+	if err == nil {
+		t.Error("expected error when start is after end")
+	}
+
+	_, err = z.GetExchangeHistory(context.Background(), p, asset.Spot, end.Add(-time.Hour), end)
+	if err != nil {
+		t.Errorf("ZB GetExchangeHistory: %s", err)
+	}
+}
+
 func TestGetTickers(t *testing.T) {
 	t.Parallel()
-	_, err := z.GetTickers()
+	_, err := z.GetTickers(context.Background())
 	if err != nil {
 		t.Errorf("ZB GetTicker: %s", err)
 	}
@@ -131,7 +128,7 @@ func TestGetTickers(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := z.GetOrderbook("btc_usdt")
+	_, err := z.GetOrderbook(context.Background(), "btc_usdt")
 	if err != nil {
 		t.Errorf("ZB GetTicker: %s", err)
 	}
@@ -139,7 +136,7 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetMarkets(t *testing.T) {
 	t.Parallel()
-	_, err := z.GetMarkets()
+	_, err := z.GetMarkets(context.Background())
 	if err != nil {
 		t.Errorf("ZB GetMarkets: %s", err)
 	}
@@ -151,7 +148,7 @@ func TestGetSpotKline(t *testing.T) {
 		Type:   kline.OneMin.Short() + "in",
 		Size:   10,
 	}
-	_, err := z.GetSpotKline(arg)
+	_, err := z.GetSpotKline(context.Background(), arg)
 	if err != nil {
 		t.Errorf("ZB GetSpotKline: %s", err)
 	}
@@ -173,7 +170,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	z.GetFeeByType(feeBuilder)
+	z.GetFeeByType(context.Background(), feeBuilder)
 	if !z.ValidateAPICredentials() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -276,7 +273,7 @@ func TestGetActiveOrders(t *testing.T) {
 			currency.USDT)},
 	}
 
-	_, err := z.GetActiveOrders(&getOrdersRequest)
+	_, err := z.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if z.ValidateAPICredentials() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !z.ValidateAPICredentials() && err == nil {
@@ -292,7 +289,7 @@ func TestGetOrderHistory(t *testing.T) {
 			currency.BTC)},
 	}
 
-	_, err := z.GetOrderHistory(&getOrdersRequest)
+	_, err := z.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if z.ValidateAPICredentials() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !z.ValidateAPICredentials() && err == nil {
@@ -322,7 +319,7 @@ func TestSubmitOrder(t *testing.T) {
 		Amount:   1,
 		ClientID: "meowOrder",
 	}
-	response, err := z.SubmitOrder(orderSubmission)
+	response, err := z.SubmitOrder(context.Background(), orderSubmission)
 	if z.ValidateAPICredentials() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !z.ValidateAPICredentials() && err == nil {
@@ -343,7 +340,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	err := z.CancelOrder(orderCancellation)
+	err := z.CancelOrder(context.Background(), orderCancellation)
 	if !z.ValidateAPICredentials() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -365,7 +362,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		Pair:          currencyPair,
 	}
 
-	resp, err := z.CancelAllOrders(orderCancellation)
+	resp, err := z.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !z.ValidateAPICredentials() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -381,12 +378,12 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 
 func TestGetAccountInfo(t *testing.T) {
 	if z.ValidateAPICredentials() {
-		_, err := z.UpdateAccountInfo()
+		_, err := z.UpdateAccountInfo(context.Background())
 		if err != nil {
 			t.Error("GetAccountInfo() error", err)
 		}
 	} else {
-		_, err := z.UpdateAccountInfo()
+		_, err := z.UpdateAccountInfo(context.Background())
 		if err == nil {
 			t.Error("GetAccountInfo() Expected error")
 		}
@@ -397,7 +394,7 @@ func TestModifyOrder(t *testing.T) {
 	if z.ValidateAPICredentials() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := z.ModifyOrder(&order.Modify{})
+	_, err := z.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -418,7 +415,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := z.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := z.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !z.ValidateAPICredentials() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -433,7 +430,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.Request{}
-	_, err := z.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := z.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -445,7 +442,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.Request{}
-	_, err := z.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := z.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -453,13 +450,13 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	if z.ValidateAPICredentials() {
-		_, err := z.GetDepositAddress(currency.BTC, "")
+		_, err := z.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err != nil {
 			t.Error("GetDepositAddress() error PLEASE MAKE SURE YOU CREATE DEPOSIT ADDRESSES VIA ZB.COM",
 				err)
 		}
 	} else {
-		_, err := z.GetDepositAddress(currency.BTC, "")
+		_, err := z.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err == nil {
 			t.Error("GetDepositAddress() Expected error")
 		}
@@ -836,12 +833,12 @@ func TestGetHistoricCandles(t *testing.T) {
 		t.Fatal(err)
 	}
 	startTime := time.Now().Add(-time.Hour * 1)
-	_, err = z.GetHistoricCandles(currencyPair, asset.Spot, startTime, time.Now(), kline.OneHour)
+	_, err = z.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.OneHour)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = z.GetHistoricCandles(currencyPair, asset.Spot, startTime, time.Now(), kline.Interval(time.Hour*7))
+	_, err = z.GetHistoricCandles(context.Background(), currencyPair, asset.Spot, startTime, time.Now(), kline.Interval(time.Hour*7))
 	if err == nil {
 		t.Fatal("unexpected result")
 	}
@@ -854,7 +851,7 @@ func TestGetHistoricCandlesExtended(t *testing.T) {
 	}
 	start := time.Now().AddDate(0, -2, 0)
 	end := time.Now()
-	_, err = z.GetHistoricCandlesExtended(currencyPair, asset.Spot, start, end, kline.OneHour)
+	_, err = z.GetHistoricCandlesExtended(context.Background(), currencyPair, asset.Spot, start, end, kline.OneHour)
 	if err != nil {
 		t.Fatal(err)
 	}
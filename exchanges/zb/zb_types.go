@@ -67,6 +67,16 @@ type MarketResponseItem struct {
 	PriceScale  float64 `json:"priceScale"`
 }
 
+// TradeHistory holds a single public trade
+type TradeHistory struct {
+	Amount    float64 `json:"amount,string"`
+	Date      int64   `json:"date"`
+	Price     float64 `json:"price,string"`
+	TID       int64   `json:"tid"`
+	TradeType string  `json:"trade_type"`
+	Type      string  `json:"type"`
+}
+
 // TickerResponse holds the ticker response data
 type TickerResponse struct {
 	Date   string              `json:"date"`
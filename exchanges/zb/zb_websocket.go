@@ -235,7 +235,16 @@ func (z *ZB) wsHandleData(respRaw []byte) error {
 				}
 			}
 
+			var tid string
+			switch v := trades.Data[i].TID.(type) {
+			case string:
+				tid = v
+			case float64:
+				tid = strconv.FormatFloat(v, 'f', -1, 64)
+			}
+
 			z.Websocket.DataHandler <- stream.TradeData{
+				TID:          tid,
 				Timestamp:    time.Unix(trades.Data[i].Date, 0),
 				CurrencyPair: cPair,
 				AssetType:    asset.Spot,
@@ -810,6 +810,7 @@ type GetExchangeInfoResponse struct {
 	BaseCurrencies       string                     `protobuf:"bytes,8,opt,name=base_currencies,json=baseCurrencies,proto3" json:"base_currencies,omitempty"`
 	SupportedAssets      map[string]*PairsSupported `protobuf:"bytes,9,rep,name=supported_assets,json=supportedAssets,proto3" json:"supported_assets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	AuthenticatedApi     bool                       `protobuf:"varint,10,opt,name=authenticated_api,json=authenticatedApi,proto3" json:"authenticated_api,omitempty"`
+	CircuitBreaker       *CircuitBreakerStatus      `protobuf:"bytes,11,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -910,6 +911,68 @@ func (m *GetExchangeInfoResponse) GetAuthenticatedApi() bool {
 	return false
 }
 
+func (m *GetExchangeInfoResponse) GetCircuitBreaker() *CircuitBreakerStatus {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return nil
+}
+
+type CircuitBreakerStatus struct {
+	State                string   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	ConsecutiveFailures  int64    `protobuf:"varint,2,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	OpenedAt             int64    `protobuf:"varint,3,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CircuitBreakerStatus) Reset()         { *m = CircuitBreakerStatus{} }
+func (m *CircuitBreakerStatus) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerStatus) ProtoMessage()    {}
+func (*CircuitBreakerStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{20}
+}
+
+func (m *CircuitBreakerStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CircuitBreakerStatus.Unmarshal(m, b)
+}
+func (m *CircuitBreakerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CircuitBreakerStatus.Marshal(b, m, deterministic)
+}
+func (m *CircuitBreakerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerStatus.Merge(m, src)
+}
+func (m *CircuitBreakerStatus) XXX_Size() int {
+	return xxx_messageInfo_CircuitBreakerStatus.Size(m)
+}
+func (m *CircuitBreakerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerStatus proto.InternalMessageInfo
+
+func (m *CircuitBreakerStatus) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *CircuitBreakerStatus) GetConsecutiveFailures() int64 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *CircuitBreakerStatus) GetOpenedAt() int64 {
+	if m != nil {
+		return m.OpenedAt
+	}
+	return 0
+}
+
 type GetTickerRequest struct {
 	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
@@ -923,7 +986,7 @@ func (m *GetTickerRequest) Reset()         { *m = GetTickerRequest{} }
 func (m *GetTickerRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTickerRequest) ProtoMessage()    {}
 func (*GetTickerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{20}
+	return fileDescriptor_77a6da22d6a3feb1, []int{21}
 }
 
 func (m *GetTickerRequest) XXX_Unmarshal(b []byte) error {
@@ -978,7 +1041,7 @@ func (m *CurrencyPair) Reset()         { *m = CurrencyPair{} }
 func (m *CurrencyPair) String() string { return proto.CompactTextString(m) }
 func (*CurrencyPair) ProtoMessage()    {}
 func (*CurrencyPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{21}
+	return fileDescriptor_77a6da22d6a3feb1, []int{22}
 }
 
 func (m *CurrencyPair) XXX_Unmarshal(b []byte) error {
@@ -1040,7 +1103,7 @@ func (m *TickerResponse) Reset()         { *m = TickerResponse{} }
 func (m *TickerResponse) String() string { return proto.CompactTextString(m) }
 func (*TickerResponse) ProtoMessage()    {}
 func (*TickerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{22}
+	return fileDescriptor_77a6da22d6a3feb1, []int{23}
 }
 
 func (m *TickerResponse) XXX_Unmarshal(b []byte) error {
@@ -1141,7 +1204,7 @@ func (m *GetTickersRequest) Reset()         { *m = GetTickersRequest{} }
 func (m *GetTickersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTickersRequest) ProtoMessage()    {}
 func (*GetTickersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{23}
+	return fileDescriptor_77a6da22d6a3feb1, []int{24}
 }
 
 func (m *GetTickersRequest) XXX_Unmarshal(b []byte) error {
@@ -1174,7 +1237,7 @@ func (m *Tickers) Reset()         { *m = Tickers{} }
 func (m *Tickers) String() string { return proto.CompactTextString(m) }
 func (*Tickers) ProtoMessage()    {}
 func (*Tickers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{24}
+	return fileDescriptor_77a6da22d6a3feb1, []int{25}
 }
 
 func (m *Tickers) XXX_Unmarshal(b []byte) error {
@@ -1220,7 +1283,7 @@ func (m *GetTickersResponse) Reset()         { *m = GetTickersResponse{} }
 func (m *GetTickersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTickersResponse) ProtoMessage()    {}
 func (*GetTickersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{25}
+	return fileDescriptor_77a6da22d6a3feb1, []int{26}
 }
 
 func (m *GetTickersResponse) XXX_Unmarshal(b []byte) error {
@@ -1261,7 +1324,7 @@ func (m *GetOrderbookRequest) Reset()         { *m = GetOrderbookRequest{} }
 func (m *GetOrderbookRequest) String() string { return proto.CompactTextString(m) }
 func (*GetOrderbookRequest) ProtoMessage()    {}
 func (*GetOrderbookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{26}
+	return fileDescriptor_77a6da22d6a3feb1, []int{27}
 }
 
 func (m *GetOrderbookRequest) XXX_Unmarshal(b []byte) error {
@@ -1316,7 +1379,7 @@ func (m *OrderbookItem) Reset()         { *m = OrderbookItem{} }
 func (m *OrderbookItem) String() string { return proto.CompactTextString(m) }
 func (*OrderbookItem) ProtoMessage()    {}
 func (*OrderbookItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{27}
+	return fileDescriptor_77a6da22d6a3feb1, []int{28}
 }
 
 func (m *OrderbookItem) XXX_Unmarshal(b []byte) error {
@@ -1374,7 +1437,7 @@ func (m *OrderbookResponse) Reset()         { *m = OrderbookResponse{} }
 func (m *OrderbookResponse) String() string { return proto.CompactTextString(m) }
 func (*OrderbookResponse) ProtoMessage()    {}
 func (*OrderbookResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{28}
+	return fileDescriptor_77a6da22d6a3feb1, []int{29}
 }
 
 func (m *OrderbookResponse) XXX_Unmarshal(b []byte) error {
@@ -1447,7 +1510,7 @@ func (m *GetOrderbooksRequest) Reset()         { *m = GetOrderbooksRequest{} }
 func (m *GetOrderbooksRequest) String() string { return proto.CompactTextString(m) }
 func (*GetOrderbooksRequest) ProtoMessage()    {}
 func (*GetOrderbooksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{29}
+	return fileDescriptor_77a6da22d6a3feb1, []int{30}
 }
 
 func (m *GetOrderbooksRequest) XXX_Unmarshal(b []byte) error {
@@ -1480,7 +1543,7 @@ func (m *Orderbooks) Reset()         { *m = Orderbooks{} }
 func (m *Orderbooks) String() string { return proto.CompactTextString(m) }
 func (*Orderbooks) ProtoMessage()    {}
 func (*Orderbooks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{30}
+	return fileDescriptor_77a6da22d6a3feb1, []int{31}
 }
 
 func (m *Orderbooks) XXX_Unmarshal(b []byte) error {
@@ -1526,7 +1589,7 @@ func (m *GetOrderbooksResponse) Reset()         { *m = GetOrderbooksResponse{} }
 func (m *GetOrderbooksResponse) String() string { return proto.CompactTextString(m) }
 func (*GetOrderbooksResponse) ProtoMessage()    {}
 func (*GetOrderbooksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{31}
+	return fileDescriptor_77a6da22d6a3feb1, []int{32}
 }
 
 func (m *GetOrderbooksResponse) XXX_Unmarshal(b []byte) error {
@@ -1565,7 +1628,7 @@ func (m *GetAccountInfoRequest) Reset()         { *m = GetAccountInfoRequest{} }
 func (m *GetAccountInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAccountInfoRequest) ProtoMessage()    {}
 func (*GetAccountInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{32}
+	return fileDescriptor_77a6da22d6a3feb1, []int{33}
 }
 
 func (m *GetAccountInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -1605,7 +1668,7 @@ func (m *Account) Reset()         { *m = Account{} }
 func (m *Account) String() string { return proto.CompactTextString(m) }
 func (*Account) ProtoMessage()    {}
 func (*Account) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{33}
+	return fileDescriptor_77a6da22d6a3feb1, []int{34}
 }
 
 func (m *Account) XXX_Unmarshal(b []byte) error {
@@ -1653,7 +1716,7 @@ func (m *AccountCurrencyInfo) Reset()         { *m = AccountCurrencyInfo{} }
 func (m *AccountCurrencyInfo) String() string { return proto.CompactTextString(m) }
 func (*AccountCurrencyInfo) ProtoMessage()    {}
 func (*AccountCurrencyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{34}
+	return fileDescriptor_77a6da22d6a3feb1, []int{35}
 }
 
 func (m *AccountCurrencyInfo) XXX_Unmarshal(b []byte) error {
@@ -1707,7 +1770,7 @@ func (m *GetAccountInfoResponse) Reset()         { *m = GetAccountInfoResponse{}
 func (m *GetAccountInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAccountInfoResponse) ProtoMessage()    {}
 func (*GetAccountInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{35}
+	return fileDescriptor_77a6da22d6a3feb1, []int{36}
 }
 
 func (m *GetAccountInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -1752,7 +1815,7 @@ func (m *GetConfigRequest) Reset()         { *m = GetConfigRequest{} }
 func (m *GetConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*GetConfigRequest) ProtoMessage()    {}
 func (*GetConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{36}
+	return fileDescriptor_77a6da22d6a3feb1, []int{37}
 }
 
 func (m *GetConfigRequest) XXX_Unmarshal(b []byte) error {
@@ -1784,7 +1847,7 @@ func (m *GetConfigResponse) Reset()         { *m = GetConfigResponse{} }
 func (m *GetConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*GetConfigResponse) ProtoMessage()    {}
 func (*GetConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{37}
+	return fileDescriptor_77a6da22d6a3feb1, []int{38}
 }
 
 func (m *GetConfigResponse) XXX_Unmarshal(b []byte) error {
@@ -1826,7 +1889,7 @@ func (m *PortfolioAddress) Reset()         { *m = PortfolioAddress{} }
 func (m *PortfolioAddress) String() string { return proto.CompactTextString(m) }
 func (*PortfolioAddress) ProtoMessage()    {}
 func (*PortfolioAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{38}
+	return fileDescriptor_77a6da22d6a3feb1, []int{39}
 }
 
 func (m *PortfolioAddress) XXX_Unmarshal(b []byte) error {
@@ -1885,7 +1948,7 @@ func (m *GetPortfolioRequest) Reset()         { *m = GetPortfolioRequest{} }
 func (m *GetPortfolioRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPortfolioRequest) ProtoMessage()    {}
 func (*GetPortfolioRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{39}
+	return fileDescriptor_77a6da22d6a3feb1, []int{40}
 }
 
 func (m *GetPortfolioRequest) XXX_Unmarshal(b []byte) error {
@@ -1917,7 +1980,7 @@ func (m *GetPortfolioResponse) Reset()         { *m = GetPortfolioResponse{} }
 func (m *GetPortfolioResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPortfolioResponse) ProtoMessage()    {}
 func (*GetPortfolioResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{40}
+	return fileDescriptor_77a6da22d6a3feb1, []int{41}
 }
 
 func (m *GetPortfolioResponse) XXX_Unmarshal(b []byte) error {
@@ -1955,7 +2018,7 @@ func (m *GetPortfolioSummaryRequest) Reset()         { *m = GetPortfolioSummaryR
 func (m *GetPortfolioSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPortfolioSummaryRequest) ProtoMessage()    {}
 func (*GetPortfolioSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{41}
+	return fileDescriptor_77a6da22d6a3feb1, []int{42}
 }
 
 func (m *GetPortfolioSummaryRequest) XXX_Unmarshal(b []byte) error {
@@ -1990,7 +2053,7 @@ func (m *Coin) Reset()         { *m = Coin{} }
 func (m *Coin) String() string { return proto.CompactTextString(m) }
 func (*Coin) ProtoMessage()    {}
 func (*Coin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{42}
+	return fileDescriptor_77a6da22d6a3feb1, []int{43}
 }
 
 func (m *Coin) XXX_Unmarshal(b []byte) error {
@@ -2052,7 +2115,7 @@ func (m *OfflineCoinSummary) Reset()         { *m = OfflineCoinSummary{} }
 func (m *OfflineCoinSummary) String() string { return proto.CompactTextString(m) }
 func (*OfflineCoinSummary) ProtoMessage()    {}
 func (*OfflineCoinSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{43}
+	return fileDescriptor_77a6da22d6a3feb1, []int{44}
 }
 
 func (m *OfflineCoinSummary) XXX_Unmarshal(b []byte) error {
@@ -2106,7 +2169,7 @@ func (m *OnlineCoinSummary) Reset()         { *m = OnlineCoinSummary{} }
 func (m *OnlineCoinSummary) String() string { return proto.CompactTextString(m) }
 func (*OnlineCoinSummary) ProtoMessage()    {}
 func (*OnlineCoinSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{44}
+	return fileDescriptor_77a6da22d6a3feb1, []int{45}
 }
 
 func (m *OnlineCoinSummary) XXX_Unmarshal(b []byte) error {
@@ -2152,7 +2215,7 @@ func (m *OfflineCoins) Reset()         { *m = OfflineCoins{} }
 func (m *OfflineCoins) String() string { return proto.CompactTextString(m) }
 func (*OfflineCoins) ProtoMessage()    {}
 func (*OfflineCoins) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{45}
+	return fileDescriptor_77a6da22d6a3feb1, []int{46}
 }
 
 func (m *OfflineCoins) XXX_Unmarshal(b []byte) error {
@@ -2191,7 +2254,7 @@ func (m *OnlineCoins) Reset()         { *m = OnlineCoins{} }
 func (m *OnlineCoins) String() string { return proto.CompactTextString(m) }
 func (*OnlineCoins) ProtoMessage()    {}
 func (*OnlineCoins) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{46}
+	return fileDescriptor_77a6da22d6a3feb1, []int{47}
 }
 
 func (m *OnlineCoins) XXX_Unmarshal(b []byte) error {
@@ -2234,7 +2297,7 @@ func (m *GetPortfolioSummaryResponse) Reset()         { *m = GetPortfolioSummary
 func (m *GetPortfolioSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPortfolioSummaryResponse) ProtoMessage()    {}
 func (*GetPortfolioSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{47}
+	return fileDescriptor_77a6da22d6a3feb1, []int{48}
 }
 
 func (m *GetPortfolioSummaryResponse) XXX_Unmarshal(b []byte) error {
@@ -2306,7 +2369,7 @@ func (m *AddPortfolioAddressRequest) Reset()         { *m = AddPortfolioAddressR
 func (m *AddPortfolioAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*AddPortfolioAddressRequest) ProtoMessage()    {}
 func (*AddPortfolioAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{48}
+	return fileDescriptor_77a6da22d6a3feb1, []int{49}
 }
 
 func (m *AddPortfolioAddressRequest) XXX_Unmarshal(b []byte) error {
@@ -2382,7 +2445,7 @@ func (m *RemovePortfolioAddressRequest) Reset()         { *m = RemovePortfolioAd
 func (m *RemovePortfolioAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*RemovePortfolioAddressRequest) ProtoMessage()    {}
 func (*RemovePortfolioAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{49}
+	return fileDescriptor_77a6da22d6a3feb1, []int{50}
 }
 
 func (m *RemovePortfolioAddressRequest) XXX_Unmarshal(b []byte) error {
@@ -2434,7 +2497,7 @@ func (m *GetForexProvidersRequest) Reset()         { *m = GetForexProvidersReque
 func (m *GetForexProvidersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetForexProvidersRequest) ProtoMessage()    {}
 func (*GetForexProvidersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{50}
+	return fileDescriptor_77a6da22d6a3feb1, []int{51}
 }
 
 func (m *GetForexProvidersRequest) XXX_Unmarshal(b []byte) error {
@@ -2472,7 +2535,7 @@ func (m *ForexProvider) Reset()         { *m = ForexProvider{} }
 func (m *ForexProvider) String() string { return proto.CompactTextString(m) }
 func (*ForexProvider) ProtoMessage()    {}
 func (*ForexProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{51}
+	return fileDescriptor_77a6da22d6a3feb1, []int{52}
 }
 
 func (m *ForexProvider) XXX_Unmarshal(b []byte) error {
@@ -2553,7 +2616,7 @@ func (m *GetForexProvidersResponse) Reset()         { *m = GetForexProvidersResp
 func (m *GetForexProvidersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetForexProvidersResponse) ProtoMessage()    {}
 func (*GetForexProvidersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{52}
+	return fileDescriptor_77a6da22d6a3feb1, []int{53}
 }
 
 func (m *GetForexProvidersResponse) XXX_Unmarshal(b []byte) error {
@@ -2591,7 +2654,7 @@ func (m *GetForexRatesRequest) Reset()         { *m = GetForexRatesRequest{} }
 func (m *GetForexRatesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetForexRatesRequest) ProtoMessage()    {}
 func (*GetForexRatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{53}
+	return fileDescriptor_77a6da22d6a3feb1, []int{54}
 }
 
 func (m *GetForexRatesRequest) XXX_Unmarshal(b []byte) error {
@@ -2626,7 +2689,7 @@ func (m *ForexRatesConversion) Reset()         { *m = ForexRatesConversion{} }
 func (m *ForexRatesConversion) String() string { return proto.CompactTextString(m) }
 func (*ForexRatesConversion) ProtoMessage()    {}
 func (*ForexRatesConversion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{54}
+	return fileDescriptor_77a6da22d6a3feb1, []int{55}
 }
 
 func (m *ForexRatesConversion) XXX_Unmarshal(b []byte) error {
@@ -2686,7 +2749,7 @@ func (m *GetForexRatesResponse) Reset()         { *m = GetForexRatesResponse{} }
 func (m *GetForexRatesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetForexRatesResponse) ProtoMessage()    {}
 func (*GetForexRatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{55}
+	return fileDescriptor_77a6da22d6a3feb1, []int{56}
 }
 
 func (m *GetForexRatesResponse) XXX_Unmarshal(b []byte) error {
@@ -2738,7 +2801,7 @@ func (m *OrderDetails) Reset()         { *m = OrderDetails{} }
 func (m *OrderDetails) String() string { return proto.CompactTextString(m) }
 func (*OrderDetails) ProtoMessage()    {}
 func (*OrderDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{56}
+	return fileDescriptor_77a6da22d6a3feb1, []int{57}
 }
 
 func (m *OrderDetails) XXX_Unmarshal(b []byte) error {
@@ -2875,7 +2938,7 @@ func (m *TradeHistory) Reset()         { *m = TradeHistory{} }
 func (m *TradeHistory) String() string { return proto.CompactTextString(m) }
 func (*TradeHistory) ProtoMessage()    {}
 func (*TradeHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{57}
+	return fileDescriptor_77a6da22d6a3feb1, []int{58}
 }
 
 func (m *TradeHistory) XXX_Unmarshal(b []byte) error {
@@ -2965,7 +3028,7 @@ func (m *GetOrdersRequest) Reset()         { *m = GetOrdersRequest{} }
 func (m *GetOrdersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetOrdersRequest) ProtoMessage()    {}
 func (*GetOrdersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{58}
+	return fileDescriptor_77a6da22d6a3feb1, []int{59}
 }
 
 func (m *GetOrdersRequest) XXX_Unmarshal(b []byte) error {
@@ -3018,7 +3081,7 @@ func (m *GetOrdersResponse) Reset()         { *m = GetOrdersResponse{} }
 func (m *GetOrdersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetOrdersResponse) ProtoMessage()    {}
 func (*GetOrdersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{59}
+	return fileDescriptor_77a6da22d6a3feb1, []int{60}
 }
 
 func (m *GetOrdersResponse) XXX_Unmarshal(b []byte) error {
@@ -3058,7 +3121,7 @@ func (m *GetOrderRequest) Reset()         { *m = GetOrderRequest{} }
 func (m *GetOrderRequest) String() string { return proto.CompactTextString(m) }
 func (*GetOrderRequest) ProtoMessage()    {}
 func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{60}
+	return fileDescriptor_77a6da22d6a3feb1, []int{61}
 }
 
 func (m *GetOrderRequest) XXX_Unmarshal(b []byte) error {
@@ -3110,7 +3173,7 @@ func (m *SubmitOrderRequest) Reset()         { *m = SubmitOrderRequest{} }
 func (m *SubmitOrderRequest) String() string { return proto.CompactTextString(m) }
 func (*SubmitOrderRequest) ProtoMessage()    {}
 func (*SubmitOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{61}
+	return fileDescriptor_77a6da22d6a3feb1, []int{62}
 }
 
 func (m *SubmitOrderRequest) XXX_Unmarshal(b []byte) error {
@@ -3192,7 +3255,7 @@ func (m *SubmitOrderResponse) Reset()         { *m = SubmitOrderResponse{} }
 func (m *SubmitOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*SubmitOrderResponse) ProtoMessage()    {}
 func (*SubmitOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{62}
+	return fileDescriptor_77a6da22d6a3feb1, []int{63}
 }
 
 func (m *SubmitOrderResponse) XXX_Unmarshal(b []byte) error {
@@ -3241,7 +3304,7 @@ func (m *SimulateOrderRequest) Reset()         { *m = SimulateOrderRequest{} }
 func (m *SimulateOrderRequest) String() string { return proto.CompactTextString(m) }
 func (*SimulateOrderRequest) ProtoMessage()    {}
 func (*SimulateOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{63}
+	return fileDescriptor_77a6da22d6a3feb1, []int{64}
 }
 
 func (m *SimulateOrderRequest) XXX_Unmarshal(b []byte) error {
@@ -3306,7 +3369,7 @@ func (m *SimulateOrderResponse) Reset()         { *m = SimulateOrderResponse{} }
 func (m *SimulateOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*SimulateOrderResponse) ProtoMessage()    {}
 func (*SimulateOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{64}
+	return fileDescriptor_77a6da22d6a3feb1, []int{65}
 }
 
 func (m *SimulateOrderResponse) XXX_Unmarshal(b []byte) error {
@@ -3383,7 +3446,7 @@ func (m *WhaleBombRequest) Reset()         { *m = WhaleBombRequest{} }
 func (m *WhaleBombRequest) String() string { return proto.CompactTextString(m) }
 func (*WhaleBombRequest) ProtoMessage()    {}
 func (*WhaleBombRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{65}
+	return fileDescriptor_77a6da22d6a3feb1, []int{66}
 }
 
 func (m *WhaleBombRequest) XXX_Unmarshal(b []byte) error {
@@ -3449,7 +3512,7 @@ func (m *CancelOrderRequest) Reset()         { *m = CancelOrderRequest{} }
 func (m *CancelOrderRequest) String() string { return proto.CompactTextString(m) }
 func (*CancelOrderRequest) ProtoMessage()    {}
 func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{66}
+	return fileDescriptor_77a6da22d6a3feb1, []int{67}
 }
 
 func (m *CancelOrderRequest) XXX_Unmarshal(b []byte) error {
@@ -3530,7 +3593,7 @@ func (m *CancelAllOrdersRequest) Reset()         { *m = CancelAllOrdersRequest{}
 func (m *CancelAllOrdersRequest) String() string { return proto.CompactTextString(m) }
 func (*CancelAllOrdersRequest) ProtoMessage()    {}
 func (*CancelAllOrdersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{67}
+	return fileDescriptor_77a6da22d6a3feb1, []int{68}
 }
 
 func (m *CancelAllOrdersRequest) XXX_Unmarshal(b []byte) error {
@@ -3569,7 +3632,7 @@ func (m *CancelAllOrdersResponse) Reset()         { *m = CancelAllOrdersResponse
 func (m *CancelAllOrdersResponse) String() string { return proto.CompactTextString(m) }
 func (*CancelAllOrdersResponse) ProtoMessage()    {}
 func (*CancelAllOrdersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{68}
+	return fileDescriptor_77a6da22d6a3feb1, []int{69}
 }
 
 func (m *CancelAllOrdersResponse) XXX_Unmarshal(b []byte) error {
@@ -3609,7 +3672,7 @@ func (m *CancelAllOrdersResponse_Orders) Reset()         { *m = CancelAllOrdersR
 func (m *CancelAllOrdersResponse_Orders) String() string { return proto.CompactTextString(m) }
 func (*CancelAllOrdersResponse_Orders) ProtoMessage()    {}
 func (*CancelAllOrdersResponse_Orders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{68, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{69, 0}
 }
 
 func (m *CancelAllOrdersResponse_Orders) XXX_Unmarshal(b []byte) error {
@@ -3654,7 +3717,7 @@ func (m *GetEventsRequest) Reset()         { *m = GetEventsRequest{} }
 func (m *GetEventsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetEventsRequest) ProtoMessage()    {}
 func (*GetEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{69}
+	return fileDescriptor_77a6da22d6a3feb1, []int{70}
 }
 
 func (m *GetEventsRequest) XXX_Unmarshal(b []byte) error {
@@ -3681,6 +3744,7 @@ type ConditionParams struct {
 	CheckBids            bool     `protobuf:"varint,3,opt,name=check_bids,json=checkBids,proto3" json:"check_bids,omitempty"`
 	CheckBidsAndAsks     bool     `protobuf:"varint,4,opt,name=check_bids_and_asks,json=checkBidsAndAsks,proto3" json:"check_bids_and_asks,omitempty"`
 	OrderbookAmount      float64  `protobuf:"fixed64,5,opt,name=orderbook_amount,json=orderbookAmount,proto3" json:"orderbook_amount,omitempty"`
+	CandleInterval       int64    `protobuf:"varint,6,opt,name=candle_interval,json=candleInterval,proto3" json:"candle_interval,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3690,7 +3754,7 @@ func (m *ConditionParams) Reset()         { *m = ConditionParams{} }
 func (m *ConditionParams) String() string { return proto.CompactTextString(m) }
 func (*ConditionParams) ProtoMessage()    {}
 func (*ConditionParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{70}
+	return fileDescriptor_77a6da22d6a3feb1, []int{71}
 }
 
 func (m *ConditionParams) XXX_Unmarshal(b []byte) error {
@@ -3746,6 +3810,13 @@ func (m *ConditionParams) GetOrderbookAmount() float64 {
 	return 0
 }
 
+func (m *ConditionParams) GetCandleInterval() int64 {
+	if m != nil {
+		return m.CandleInterval
+	}
+	return 0
+}
+
 type GetEventsResponse struct {
 	Id                   int64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Exchange             string           `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
@@ -3763,7 +3834,7 @@ func (m *GetEventsResponse) Reset()         { *m = GetEventsResponse{} }
 func (m *GetEventsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetEventsResponse) ProtoMessage()    {}
 func (*GetEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{71}
+	return fileDescriptor_77a6da22d6a3feb1, []int{72}
 }
 
 func (m *GetEventsResponse) XXX_Unmarshal(b []byte) error {
@@ -3849,7 +3920,7 @@ func (m *AddEventRequest) Reset()         { *m = AddEventRequest{} }
 func (m *AddEventRequest) String() string { return proto.CompactTextString(m) }
 func (*AddEventRequest) ProtoMessage()    {}
 func (*AddEventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{72}
+	return fileDescriptor_77a6da22d6a3feb1, []int{73}
 }
 
 func (m *AddEventRequest) XXX_Unmarshal(b []byte) error {
@@ -3923,7 +3994,7 @@ func (m *AddEventResponse) Reset()         { *m = AddEventResponse{} }
 func (m *AddEventResponse) String() string { return proto.CompactTextString(m) }
 func (*AddEventResponse) ProtoMessage()    {}
 func (*AddEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{73}
+	return fileDescriptor_77a6da22d6a3feb1, []int{74}
 }
 
 func (m *AddEventResponse) XXX_Unmarshal(b []byte) error {
@@ -3962,7 +4033,7 @@ func (m *RemoveEventRequest) Reset()         { *m = RemoveEventRequest{} }
 func (m *RemoveEventRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveEventRequest) ProtoMessage()    {}
 func (*RemoveEventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{74}
+	return fileDescriptor_77a6da22d6a3feb1, []int{75}
 }
 
 func (m *RemoveEventRequest) XXX_Unmarshal(b []byte) error {
@@ -4003,7 +4074,7 @@ func (m *GetCryptocurrencyDepositAddressesRequest) Reset() {
 func (m *GetCryptocurrencyDepositAddressesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCryptocurrencyDepositAddressesRequest) ProtoMessage()    {}
 func (*GetCryptocurrencyDepositAddressesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{75}
+	return fileDescriptor_77a6da22d6a3feb1, []int{76}
 }
 
 func (m *GetCryptocurrencyDepositAddressesRequest) XXX_Unmarshal(b []byte) error {
@@ -4044,7 +4115,7 @@ func (m *GetCryptocurrencyDepositAddressesResponse) Reset() {
 func (m *GetCryptocurrencyDepositAddressesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCryptocurrencyDepositAddressesResponse) ProtoMessage()    {}
 func (*GetCryptocurrencyDepositAddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{76}
+	return fileDescriptor_77a6da22d6a3feb1, []int{77}
 }
 
 func (m *GetCryptocurrencyDepositAddressesResponse) XXX_Unmarshal(b []byte) error {
@@ -4086,7 +4157,7 @@ func (m *GetCryptocurrencyDepositAddressRequest) Reset() {
 func (m *GetCryptocurrencyDepositAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCryptocurrencyDepositAddressRequest) ProtoMessage()    {}
 func (*GetCryptocurrencyDepositAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{77}
+	return fileDescriptor_77a6da22d6a3feb1, []int{78}
 }
 
 func (m *GetCryptocurrencyDepositAddressRequest) XXX_Unmarshal(b []byte) error {
@@ -4134,7 +4205,7 @@ func (m *GetCryptocurrencyDepositAddressResponse) Reset() {
 func (m *GetCryptocurrencyDepositAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCryptocurrencyDepositAddressResponse) ProtoMessage()    {}
 func (*GetCryptocurrencyDepositAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{78}
+	return fileDescriptor_77a6da22d6a3feb1, []int{79}
 }
 
 func (m *GetCryptocurrencyDepositAddressResponse) XXX_Unmarshal(b []byte) error {
@@ -4177,7 +4248,7 @@ func (m *WithdrawFiatRequest) Reset()         { *m = WithdrawFiatRequest{} }
 func (m *WithdrawFiatRequest) String() string { return proto.CompactTextString(m) }
 func (*WithdrawFiatRequest) ProtoMessage()    {}
 func (*WithdrawFiatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{79}
+	return fileDescriptor_77a6da22d6a3feb1, []int{80}
 }
 
 func (m *WithdrawFiatRequest) XXX_Unmarshal(b []byte) error {
@@ -4250,7 +4321,7 @@ func (m *WithdrawCryptoRequest) Reset()         { *m = WithdrawCryptoRequest{} }
 func (m *WithdrawCryptoRequest) String() string { return proto.CompactTextString(m) }
 func (*WithdrawCryptoRequest) ProtoMessage()    {}
 func (*WithdrawCryptoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{80}
+	return fileDescriptor_77a6da22d6a3feb1, []int{81}
 }
 
 func (m *WithdrawCryptoRequest) XXX_Unmarshal(b []byte) error {
@@ -4332,7 +4403,7 @@ func (m *WithdrawResponse) Reset()         { *m = WithdrawResponse{} }
 func (m *WithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*WithdrawResponse) ProtoMessage()    {}
 func (*WithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{81}
+	return fileDescriptor_77a6da22d6a3feb1, []int{82}
 }
 
 func (m *WithdrawResponse) XXX_Unmarshal(b []byte) error {
@@ -4378,7 +4449,7 @@ func (m *WithdrawalEventByIDRequest) Reset()         { *m = WithdrawalEventByIDR
 func (m *WithdrawalEventByIDRequest) String() string { return proto.CompactTextString(m) }
 func (*WithdrawalEventByIDRequest) ProtoMessage()    {}
 func (*WithdrawalEventByIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{82}
+	return fileDescriptor_77a6da22d6a3feb1, []int{83}
 }
 
 func (m *WithdrawalEventByIDRequest) XXX_Unmarshal(b []byte) error {
@@ -4417,7 +4488,7 @@ func (m *WithdrawalEventByIDResponse) Reset()         { *m = WithdrawalEventByID
 func (m *WithdrawalEventByIDResponse) String() string { return proto.CompactTextString(m) }
 func (*WithdrawalEventByIDResponse) ProtoMessage()    {}
 func (*WithdrawalEventByIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{83}
+	return fileDescriptor_77a6da22d6a3feb1, []int{84}
 }
 
 func (m *WithdrawalEventByIDResponse) XXX_Unmarshal(b []byte) error {
@@ -4458,7 +4529,7 @@ func (m *WithdrawalEventsByExchangeRequest) Reset()         { *m = WithdrawalEve
 func (m *WithdrawalEventsByExchangeRequest) String() string { return proto.CompactTextString(m) }
 func (*WithdrawalEventsByExchangeRequest) ProtoMessage()    {}
 func (*WithdrawalEventsByExchangeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{84}
+	return fileDescriptor_77a6da22d6a3feb1, []int{85}
 }
 
 func (m *WithdrawalEventsByExchangeRequest) XXX_Unmarshal(b []byte) error {
@@ -4514,7 +4585,7 @@ func (m *WithdrawalEventsByDateRequest) Reset()         { *m = WithdrawalEventsB
 func (m *WithdrawalEventsByDateRequest) String() string { return proto.CompactTextString(m) }
 func (*WithdrawalEventsByDateRequest) ProtoMessage()    {}
 func (*WithdrawalEventsByDateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{85}
+	return fileDescriptor_77a6da22d6a3feb1, []int{86}
 }
 
 func (m *WithdrawalEventsByDateRequest) XXX_Unmarshal(b []byte) error {
@@ -4574,7 +4645,7 @@ func (m *WithdrawalEventsByExchangeResponse) Reset()         { *m = WithdrawalEv
 func (m *WithdrawalEventsByExchangeResponse) String() string { return proto.CompactTextString(m) }
 func (*WithdrawalEventsByExchangeResponse) ProtoMessage()    {}
 func (*WithdrawalEventsByExchangeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{86}
+	return fileDescriptor_77a6da22d6a3feb1, []int{87}
 }
 
 func (m *WithdrawalEventsByExchangeResponse) XXX_Unmarshal(b []byte) error {
@@ -4617,7 +4688,7 @@ func (m *WithdrawalEventResponse) Reset()         { *m = WithdrawalEventResponse
 func (m *WithdrawalEventResponse) String() string { return proto.CompactTextString(m) }
 func (*WithdrawalEventResponse) ProtoMessage()    {}
 func (*WithdrawalEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{87}
+	return fileDescriptor_77a6da22d6a3feb1, []int{88}
 }
 
 func (m *WithdrawalEventResponse) XXX_Unmarshal(b []byte) error {
@@ -4686,7 +4757,7 @@ func (m *WithdrawlExchangeEvent) Reset()         { *m = WithdrawlExchangeEvent{}
 func (m *WithdrawlExchangeEvent) String() string { return proto.CompactTextString(m) }
 func (*WithdrawlExchangeEvent) ProtoMessage()    {}
 func (*WithdrawlExchangeEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{88}
+	return fileDescriptor_77a6da22d6a3feb1, []int{89}
 }
 
 func (m *WithdrawlExchangeEvent) XXX_Unmarshal(b []byte) error {
@@ -4744,7 +4815,7 @@ func (m *WithdrawalRequestEvent) Reset()         { *m = WithdrawalRequestEvent{}
 func (m *WithdrawalRequestEvent) String() string { return proto.CompactTextString(m) }
 func (*WithdrawalRequestEvent) ProtoMessage()    {}
 func (*WithdrawalRequestEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{89}
+	return fileDescriptor_77a6da22d6a3feb1, []int{90}
 }
 
 func (m *WithdrawalRequestEvent) XXX_Unmarshal(b []byte) error {
@@ -4823,7 +4894,7 @@ func (m *FiatWithdrawalEvent) Reset()         { *m = FiatWithdrawalEvent{} }
 func (m *FiatWithdrawalEvent) String() string { return proto.CompactTextString(m) }
 func (*FiatWithdrawalEvent) ProtoMessage()    {}
 func (*FiatWithdrawalEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{90}
+	return fileDescriptor_77a6da22d6a3feb1, []int{91}
 }
 
 func (m *FiatWithdrawalEvent) XXX_Unmarshal(b []byte) error {
@@ -4899,7 +4970,7 @@ func (m *CryptoWithdrawalEvent) Reset()         { *m = CryptoWithdrawalEvent{} }
 func (m *CryptoWithdrawalEvent) String() string { return proto.CompactTextString(m) }
 func (*CryptoWithdrawalEvent) ProtoMessage()    {}
 func (*CryptoWithdrawalEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{91}
+	return fileDescriptor_77a6da22d6a3feb1, []int{92}
 }
 
 func (m *CryptoWithdrawalEvent) XXX_Unmarshal(b []byte) error {
@@ -4952,7 +5023,7 @@ func (m *GetLoggerDetailsRequest) Reset()         { *m = GetLoggerDetailsRequest
 func (m *GetLoggerDetailsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLoggerDetailsRequest) ProtoMessage()    {}
 func (*GetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{92}
+	return fileDescriptor_77a6da22d6a3feb1, []int{93}
 }
 
 func (m *GetLoggerDetailsRequest) XXX_Unmarshal(b []byte) error {
@@ -4994,7 +5065,7 @@ func (m *GetLoggerDetailsResponse) Reset()         { *m = GetLoggerDetailsRespon
 func (m *GetLoggerDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetLoggerDetailsResponse) ProtoMessage()    {}
 func (*GetLoggerDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{93}
+	return fileDescriptor_77a6da22d6a3feb1, []int{94}
 }
 
 func (m *GetLoggerDetailsResponse) XXX_Unmarshal(b []byte) error {
@@ -5055,7 +5126,7 @@ func (m *SetLoggerDetailsRequest) Reset()         { *m = SetLoggerDetailsRequest
 func (m *SetLoggerDetailsRequest) String() string { return proto.CompactTextString(m) }
 func (*SetLoggerDetailsRequest) ProtoMessage()    {}
 func (*SetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{94}
+	return fileDescriptor_77a6da22d6a3feb1, []int{95}
 }
 
 func (m *SetLoggerDetailsRequest) XXX_Unmarshal(b []byte) error {
@@ -5102,7 +5173,7 @@ func (m *GetExchangePairsRequest) Reset()         { *m = GetExchangePairsRequest
 func (m *GetExchangePairsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetExchangePairsRequest) ProtoMessage()    {}
 func (*GetExchangePairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{95}
+	return fileDescriptor_77a6da22d6a3feb1, []int{96}
 }
 
 func (m *GetExchangePairsRequest) XXX_Unmarshal(b []byte) error {
@@ -5148,7 +5219,7 @@ func (m *GetExchangePairsResponse) Reset()         { *m = GetExchangePairsRespon
 func (m *GetExchangePairsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetExchangePairsResponse) ProtoMessage()    {}
 func (*GetExchangePairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{96}
+	return fileDescriptor_77a6da22d6a3feb1, []int{97}
 }
 
 func (m *GetExchangePairsResponse) XXX_Unmarshal(b []byte) error {
@@ -5190,7 +5261,7 @@ func (m *SetExchangePairRequest) Reset()         { *m = SetExchangePairRequest{}
 func (m *SetExchangePairRequest) String() string { return proto.CompactTextString(m) }
 func (*SetExchangePairRequest) ProtoMessage()    {}
 func (*SetExchangePairRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{97}
+	return fileDescriptor_77a6da22d6a3feb1, []int{98}
 }
 
 func (m *SetExchangePairRequest) XXX_Unmarshal(b []byte) error {
@@ -5252,7 +5323,7 @@ func (m *GetOrderbookStreamRequest) Reset()         { *m = GetOrderbookStreamReq
 func (m *GetOrderbookStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*GetOrderbookStreamRequest) ProtoMessage()    {}
 func (*GetOrderbookStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{98}
+	return fileDescriptor_77a6da22d6a3feb1, []int{99}
 }
 
 func (m *GetOrderbookStreamRequest) XXX_Unmarshal(b []byte) error {
@@ -5305,7 +5376,7 @@ func (m *GetExchangeOrderbookStreamRequest) Reset()         { *m = GetExchangeOr
 func (m *GetExchangeOrderbookStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*GetExchangeOrderbookStreamRequest) ProtoMessage()    {}
 func (*GetExchangeOrderbookStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{99}
+	return fileDescriptor_77a6da22d6a3feb1, []int{100}
 }
 
 func (m *GetExchangeOrderbookStreamRequest) XXX_Unmarshal(b []byte) error {
@@ -5346,7 +5417,7 @@ func (m *GetTickerStreamRequest) Reset()         { *m = GetTickerStreamRequest{}
 func (m *GetTickerStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTickerStreamRequest) ProtoMessage()    {}
 func (*GetTickerStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{100}
+	return fileDescriptor_77a6da22d6a3feb1, []int{101}
 }
 
 func (m *GetTickerStreamRequest) XXX_Unmarshal(b []byte) error {
@@ -5399,7 +5470,7 @@ func (m *GetExchangeTickerStreamRequest) Reset()         { *m = GetExchangeTicke
 func (m *GetExchangeTickerStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*GetExchangeTickerStreamRequest) ProtoMessage()    {}
 func (*GetExchangeTickerStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{101}
+	return fileDescriptor_77a6da22d6a3feb1, []int{102}
 }
 
 func (m *GetExchangeTickerStreamRequest) XXX_Unmarshal(b []byte) error {
@@ -5427,112 +5498,3189 @@ func (m *GetExchangeTickerStreamRequest) GetExchange() string {
 	return ""
 }
 
-type GetAuditEventRequest struct {
-	StartDate            string   `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate              string   `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	OrderBy              string   `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset               int32    `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetCandleStreamRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	TimeInterval         int64         `protobuf:"varint,4,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GetAuditEventRequest) Reset()         { *m = GetAuditEventRequest{} }
-func (m *GetAuditEventRequest) String() string { return proto.CompactTextString(m) }
-func (*GetAuditEventRequest) ProtoMessage()    {}
-func (*GetAuditEventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{102}
+func (m *GetCandleStreamRequest) Reset()         { *m = GetCandleStreamRequest{} }
+func (m *GetCandleStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCandleStreamRequest) ProtoMessage()    {}
+func (*GetCandleStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{103}
 }
 
-func (m *GetAuditEventRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetAuditEventRequest.Unmarshal(m, b)
+func (m *GetCandleStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCandleStreamRequest.Unmarshal(m, b)
 }
-func (m *GetAuditEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetAuditEventRequest.Marshal(b, m, deterministic)
+func (m *GetCandleStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCandleStreamRequest.Marshal(b, m, deterministic)
 }
-func (m *GetAuditEventRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAuditEventRequest.Merge(m, src)
+func (m *GetCandleStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCandleStreamRequest.Merge(m, src)
 }
-func (m *GetAuditEventRequest) XXX_Size() int {
-	return xxx_messageInfo_GetAuditEventRequest.Size(m)
+func (m *GetCandleStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCandleStreamRequest.Size(m)
 }
-func (m *GetAuditEventRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAuditEventRequest.DiscardUnknown(m)
+func (m *GetCandleStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCandleStreamRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetAuditEventRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetCandleStreamRequest proto.InternalMessageInfo
 
-func (m *GetAuditEventRequest) GetStartDate() string {
+func (m *GetCandleStreamRequest) GetExchange() string {
 	if m != nil {
-		return m.StartDate
+		return m.Exchange
 	}
 	return ""
 }
 
-func (m *GetAuditEventRequest) GetEndDate() string {
+func (m *GetCandleStreamRequest) GetPair() *CurrencyPair {
 	if m != nil {
-		return m.EndDate
+		return m.Pair
 	}
-	return ""
+	return nil
 }
 
-func (m *GetAuditEventRequest) GetOrderBy() string {
+func (m *GetCandleStreamRequest) GetAssetType() string {
 	if m != nil {
-		return m.OrderBy
+		return m.AssetType
 	}
 	return ""
 }
 
-func (m *GetAuditEventRequest) GetLimit() int32 {
+func (m *GetCandleStreamRequest) GetTimeInterval() int64 {
 	if m != nil {
-		return m.Limit
+		return m.TimeInterval
 	}
 	return 0
 }
 
-func (m *GetAuditEventRequest) GetOffset() int32 {
-	if m != nil {
-		return m.Offset
-	}
-	return 0
-}
+type GetExchangeCandleStreamRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetExchangeCandleStreamRequest) Reset()         { *m = GetExchangeCandleStreamRequest{} }
+func (m *GetExchangeCandleStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExchangeCandleStreamRequest) ProtoMessage()    {}
+func (*GetExchangeCandleStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{104}
+}
+
+func (m *GetExchangeCandleStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangeCandleStreamRequest.Unmarshal(m, b)
+}
+func (m *GetExchangeCandleStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangeCandleStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExchangeCandleStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangeCandleStreamRequest.Merge(m, src)
+}
+func (m *GetExchangeCandleStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExchangeCandleStreamRequest.Size(m)
+}
+func (m *GetExchangeCandleStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangeCandleStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExchangeCandleStreamRequest proto.InternalMessageInfo
+
+func (m *GetExchangeCandleStreamRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+type CandleStreamResponse struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Interval             string        `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Candle               *Candle       `protobuf:"bytes,5,opt,name=candle,proto3" json:"candle,omitempty"`
+	Closed               bool          `protobuf:"varint,6,opt,name=closed,proto3" json:"closed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CandleStreamResponse) Reset()         { *m = CandleStreamResponse{} }
+func (m *CandleStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*CandleStreamResponse) ProtoMessage()    {}
+func (*CandleStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{105}
+}
+
+func (m *CandleStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandleStreamResponse.Unmarshal(m, b)
+}
+func (m *CandleStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandleStreamResponse.Marshal(b, m, deterministic)
+}
+func (m *CandleStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandleStreamResponse.Merge(m, src)
+}
+func (m *CandleStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_CandleStreamResponse.Size(m)
+}
+func (m *CandleStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandleStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandleStreamResponse proto.InternalMessageInfo
+
+func (m *CandleStreamResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *CandleStreamResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *CandleStreamResponse) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *CandleStreamResponse) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *CandleStreamResponse) GetCandle() *Candle {
+	if m != nil {
+		return m.Candle
+	}
+	return nil
+}
+
+func (m *CandleStreamResponse) GetClosed() bool {
+	if m != nil {
+		return m.Closed
+	}
+	return false
+}
+
+type GetRecentTradesRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetRecentTradesRequest) Reset()         { *m = GetRecentTradesRequest{} }
+func (m *GetRecentTradesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRecentTradesRequest) ProtoMessage()    {}
+func (*GetRecentTradesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{106}
+}
+
+func (m *GetRecentTradesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRecentTradesRequest.Unmarshal(m, b)
+}
+func (m *GetRecentTradesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRecentTradesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRecentTradesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRecentTradesRequest.Merge(m, src)
+}
+func (m *GetRecentTradesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRecentTradesRequest.Size(m)
+}
+func (m *GetRecentTradesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRecentTradesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRecentTradesRequest proto.InternalMessageInfo
+
+func (m *GetRecentTradesRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetRecentTradesRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetRecentTradesRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+type GetTradeStreamRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetTradeStreamRequest) Reset()         { *m = GetTradeStreamRequest{} }
+func (m *GetTradeStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTradeStreamRequest) ProtoMessage()    {}
+func (*GetTradeStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{107}
+}
+
+func (m *GetTradeStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTradeStreamRequest.Unmarshal(m, b)
+}
+func (m *GetTradeStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTradeStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTradeStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTradeStreamRequest.Merge(m, src)
+}
+func (m *GetTradeStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTradeStreamRequest.Size(m)
+}
+func (m *GetTradeStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTradeStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTradeStreamRequest proto.InternalMessageInfo
+
+func (m *GetTradeStreamRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetTradeStreamRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetTradeStreamRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+type TradeResponse struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Id                   string        `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Price                float64       `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               float64       `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Side                 string        `protobuf:"bytes,7,opt,name=side,proto3" json:"side,omitempty"`
+	Timestamp            int64         `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TradeResponse) Reset()         { *m = TradeResponse{} }
+func (m *TradeResponse) String() string { return proto.CompactTextString(m) }
+func (*TradeResponse) ProtoMessage()    {}
+func (*TradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{108}
+}
+
+func (m *TradeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradeResponse.Unmarshal(m, b)
+}
+func (m *TradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradeResponse.Marshal(b, m, deterministic)
+}
+func (m *TradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeResponse.Merge(m, src)
+}
+func (m *TradeResponse) XXX_Size() int {
+	return xxx_messageInfo_TradeResponse.Size(m)
+}
+func (m *TradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeResponse proto.InternalMessageInfo
+
+func (m *TradeResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *TradeResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *TradeResponse) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *TradeResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *TradeResponse) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *TradeResponse) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TradeResponse) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *TradeResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type RecentTradesResponse struct {
+	Exchange             string           `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair    `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string           `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Trades               []*TradeResponse `protobuf:"bytes,4,rep,name=trades,proto3" json:"trades,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RecentTradesResponse) Reset()         { *m = RecentTradesResponse{} }
+func (m *RecentTradesResponse) String() string { return proto.CompactTextString(m) }
+func (*RecentTradesResponse) ProtoMessage()    {}
+func (*RecentTradesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{109}
+}
+
+func (m *RecentTradesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecentTradesResponse.Unmarshal(m, b)
+}
+func (m *RecentTradesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecentTradesResponse.Marshal(b, m, deterministic)
+}
+func (m *RecentTradesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecentTradesResponse.Merge(m, src)
+}
+func (m *RecentTradesResponse) XXX_Size() int {
+	return xxx_messageInfo_RecentTradesResponse.Size(m)
+}
+func (m *RecentTradesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecentTradesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecentTradesResponse proto.InternalMessageInfo
+
+func (m *RecentTradesResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *RecentTradesResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *RecentTradesResponse) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *RecentTradesResponse) GetTrades() []*TradeResponse {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+type GetHistoricTradesRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start                int64         `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	End                  int64         `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetHistoricTradesRequest) Reset()         { *m = GetHistoricTradesRequest{} }
+func (m *GetHistoricTradesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHistoricTradesRequest) ProtoMessage()    {}
+func (*GetHistoricTradesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{110}
+}
+
+func (m *GetHistoricTradesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHistoricTradesRequest.Unmarshal(m, b)
+}
+func (m *GetHistoricTradesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHistoricTradesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetHistoricTradesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHistoricTradesRequest.Merge(m, src)
+}
+func (m *GetHistoricTradesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetHistoricTradesRequest.Size(m)
+}
+func (m *GetHistoricTradesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHistoricTradesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHistoricTradesRequest proto.InternalMessageInfo
+
+func (m *GetHistoricTradesRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetHistoricTradesRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetHistoricTradesRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *GetHistoricTradesRequest) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *GetHistoricTradesRequest) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+type GetHistoricTradesResponse struct {
+	Exchange             string           `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair    `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string           `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start                int64            `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	End                  int64            `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`
+	Trades               []*TradeResponse `protobuf:"bytes,6,rep,name=trades,proto3" json:"trades,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetHistoricTradesResponse) Reset()         { *m = GetHistoricTradesResponse{} }
+func (m *GetHistoricTradesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetHistoricTradesResponse) ProtoMessage()    {}
+func (*GetHistoricTradesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{111}
+}
+
+func (m *GetHistoricTradesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHistoricTradesResponse.Unmarshal(m, b)
+}
+func (m *GetHistoricTradesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHistoricTradesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetHistoricTradesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHistoricTradesResponse.Merge(m, src)
+}
+func (m *GetHistoricTradesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetHistoricTradesResponse.Size(m)
+}
+func (m *GetHistoricTradesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHistoricTradesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHistoricTradesResponse proto.InternalMessageInfo
+
+func (m *GetHistoricTradesResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetHistoricTradesResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetHistoricTradesResponse) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *GetHistoricTradesResponse) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *GetHistoricTradesResponse) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *GetHistoricTradesResponse) GetTrades() []*TradeResponse {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+type GetDerivativeInfoRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetDerivativeInfoRequest) Reset()         { *m = GetDerivativeInfoRequest{} }
+func (m *GetDerivativeInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDerivativeInfoRequest) ProtoMessage()    {}
+func (*GetDerivativeInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{112}
+}
+
+func (m *GetDerivativeInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDerivativeInfoRequest.Unmarshal(m, b)
+}
+func (m *GetDerivativeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDerivativeInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDerivativeInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDerivativeInfoRequest.Merge(m, src)
+}
+func (m *GetDerivativeInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDerivativeInfoRequest.Size(m)
+}
+func (m *GetDerivativeInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDerivativeInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDerivativeInfoRequest proto.InternalMessageInfo
+
+func (m *GetDerivativeInfoRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetDerivativeInfoRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetDerivativeInfoRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+type GetDerivativeInfoStreamRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetDerivativeInfoStreamRequest) Reset()         { *m = GetDerivativeInfoStreamRequest{} }
+func (m *GetDerivativeInfoStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDerivativeInfoStreamRequest) ProtoMessage()    {}
+func (*GetDerivativeInfoStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{113}
+}
+
+func (m *GetDerivativeInfoStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDerivativeInfoStreamRequest.Unmarshal(m, b)
+}
+func (m *GetDerivativeInfoStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDerivativeInfoStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDerivativeInfoStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDerivativeInfoStreamRequest.Merge(m, src)
+}
+func (m *GetDerivativeInfoStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDerivativeInfoStreamRequest.Size(m)
+}
+func (m *GetDerivativeInfoStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDerivativeInfoStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDerivativeInfoStreamRequest proto.InternalMessageInfo
+
+func (m *GetDerivativeInfoStreamRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetDerivativeInfoStreamRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetDerivativeInfoStreamRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+type GetExchangeDerivativeInfoStreamRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetExchangeDerivativeInfoStreamRequest) Reset() {
+	*m = GetExchangeDerivativeInfoStreamRequest{}
+}
+func (m *GetExchangeDerivativeInfoStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExchangeDerivativeInfoStreamRequest) ProtoMessage()    {}
+func (*GetExchangeDerivativeInfoStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{114}
+}
+
+func (m *GetExchangeDerivativeInfoStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangeDerivativeInfoStreamRequest.Unmarshal(m, b)
+}
+func (m *GetExchangeDerivativeInfoStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangeDerivativeInfoStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExchangeDerivativeInfoStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangeDerivativeInfoStreamRequest.Merge(m, src)
+}
+func (m *GetExchangeDerivativeInfoStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExchangeDerivativeInfoStreamRequest.Size(m)
+}
+func (m *GetExchangeDerivativeInfoStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangeDerivativeInfoStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExchangeDerivativeInfoStreamRequest proto.InternalMessageInfo
+
+func (m *GetExchangeDerivativeInfoStreamRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+type DerivativeInfoResponse struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	MarkPrice            float64       `protobuf:"fixed64,4,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
+	IndexPrice           float64       `protobuf:"fixed64,5,opt,name=index_price,json=indexPrice,proto3" json:"index_price,omitempty"`
+	FundingRate          float64       `protobuf:"fixed64,6,opt,name=funding_rate,json=fundingRate,proto3" json:"funding_rate,omitempty"`
+	PredictedFundingRate float64       `protobuf:"fixed64,7,opt,name=predicted_funding_rate,json=predictedFundingRate,proto3" json:"predicted_funding_rate,omitempty"`
+	NextFundingTime      int64         `protobuf:"varint,8,opt,name=next_funding_time,json=nextFundingTime,proto3" json:"next_funding_time,omitempty"`
+	OpenInterest         float64       `protobuf:"fixed64,9,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
+	LastUpdated          int64         `protobuf:"varint,10,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DerivativeInfoResponse) Reset()         { *m = DerivativeInfoResponse{} }
+func (m *DerivativeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*DerivativeInfoResponse) ProtoMessage()    {}
+func (*DerivativeInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{115}
+}
+
+func (m *DerivativeInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DerivativeInfoResponse.Unmarshal(m, b)
+}
+func (m *DerivativeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DerivativeInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *DerivativeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivativeInfoResponse.Merge(m, src)
+}
+func (m *DerivativeInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_DerivativeInfoResponse.Size(m)
+}
+func (m *DerivativeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivativeInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivativeInfoResponse proto.InternalMessageInfo
+
+func (m *DerivativeInfoResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *DerivativeInfoResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *DerivativeInfoResponse) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *DerivativeInfoResponse) GetMarkPrice() float64 {
+	if m != nil {
+		return m.MarkPrice
+	}
+	return 0
+}
+
+func (m *DerivativeInfoResponse) GetIndexPrice() float64 {
+	if m != nil {
+		return m.IndexPrice
+	}
+	return 0
+}
+
+func (m *DerivativeInfoResponse) GetFundingRate() float64 {
+	if m != nil {
+		return m.FundingRate
+	}
+	return 0
+}
+
+func (m *DerivativeInfoResponse) GetPredictedFundingRate() float64 {
+	if m != nil {
+		return m.PredictedFundingRate
+	}
+	return 0
+}
+
+func (m *DerivativeInfoResponse) GetNextFundingTime() int64 {
+	if m != nil {
+		return m.NextFundingTime
+	}
+	return 0
+}
+
+func (m *DerivativeInfoResponse) GetOpenInterest() float64 {
+	if m != nil {
+		return m.OpenInterest
+	}
+	return 0
+}
+
+func (m *DerivativeInfoResponse) GetLastUpdated() int64 {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return 0
+}
+
+type GetLiquidationsRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start                int64         `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	End                  int64         `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetLiquidationsRequest) Reset()         { *m = GetLiquidationsRequest{} }
+func (m *GetLiquidationsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLiquidationsRequest) ProtoMessage()    {}
+func (*GetLiquidationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{116}
+}
+
+func (m *GetLiquidationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLiquidationsRequest.Unmarshal(m, b)
+}
+func (m *GetLiquidationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLiquidationsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLiquidationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLiquidationsRequest.Merge(m, src)
+}
+func (m *GetLiquidationsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLiquidationsRequest.Size(m)
+}
+func (m *GetLiquidationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLiquidationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLiquidationsRequest proto.InternalMessageInfo
+
+func (m *GetLiquidationsRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetLiquidationsRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetLiquidationsRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *GetLiquidationsRequest) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *GetLiquidationsRequest) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+type LiquidationResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Price                float64  `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               float64  `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Side                 string   `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	Timestamp            int64    `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LiquidationResponse) Reset()         { *m = LiquidationResponse{} }
+func (m *LiquidationResponse) String() string { return proto.CompactTextString(m) }
+func (*LiquidationResponse) ProtoMessage()    {}
+func (*LiquidationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{117}
+}
+
+func (m *LiquidationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LiquidationResponse.Unmarshal(m, b)
+}
+func (m *LiquidationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LiquidationResponse.Marshal(b, m, deterministic)
+}
+func (m *LiquidationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationResponse.Merge(m, src)
+}
+func (m *LiquidationResponse) XXX_Size() int {
+	return xxx_messageInfo_LiquidationResponse.Size(m)
+}
+func (m *LiquidationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidationResponse proto.InternalMessageInfo
+
+func (m *LiquidationResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LiquidationResponse) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *LiquidationResponse) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *LiquidationResponse) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *LiquidationResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type GetLiquidationsResponse struct {
+	Exchange             string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start                int64                  `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	End                  int64                  `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`
+	Liquidations         []*LiquidationResponse `protobuf:"bytes,6,rep,name=liquidations,proto3" json:"liquidations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetLiquidationsResponse) Reset()         { *m = GetLiquidationsResponse{} }
+func (m *GetLiquidationsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLiquidationsResponse) ProtoMessage()    {}
+func (*GetLiquidationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{118}
+}
+
+func (m *GetLiquidationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLiquidationsResponse.Unmarshal(m, b)
+}
+func (m *GetLiquidationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLiquidationsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLiquidationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLiquidationsResponse.Merge(m, src)
+}
+func (m *GetLiquidationsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLiquidationsResponse.Size(m)
+}
+func (m *GetLiquidationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLiquidationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLiquidationsResponse proto.InternalMessageInfo
+
+func (m *GetLiquidationsResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetLiquidationsResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetLiquidationsResponse) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *GetLiquidationsResponse) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *GetLiquidationsResponse) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *GetLiquidationsResponse) GetLiquidations() []*LiquidationResponse {
+	if m != nil {
+		return m.Liquidations
+	}
+	return nil
+}
+
+type GetAuditEventRequest struct {
+	StartDate            string   `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string   `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	OrderBy              string   `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int32    `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAuditEventRequest) Reset()         { *m = GetAuditEventRequest{} }
+func (m *GetAuditEventRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAuditEventRequest) ProtoMessage()    {}
+func (*GetAuditEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{119}
+}
+
+func (m *GetAuditEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditEventRequest.Unmarshal(m, b)
+}
+func (m *GetAuditEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditEventRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAuditEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditEventRequest.Merge(m, src)
+}
+func (m *GetAuditEventRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAuditEventRequest.Size(m)
+}
+func (m *GetAuditEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuditEventRequest proto.InternalMessageInfo
+
+func (m *GetAuditEventRequest) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetAuditEventRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetAuditEventRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *GetAuditEventRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetAuditEventRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type GetAuditEventResponse struct {
+	Events               []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetAuditEventResponse) Reset()         { *m = GetAuditEventResponse{} }
+func (m *GetAuditEventResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAuditEventResponse) ProtoMessage()    {}
+func (*GetAuditEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{120}
+}
+
+func (m *GetAuditEventResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditEventResponse.Unmarshal(m, b)
+}
+func (m *GetAuditEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditEventResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAuditEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditEventResponse.Merge(m, src)
+}
+func (m *GetAuditEventResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAuditEventResponse.Size(m)
+}
+func (m *GetAuditEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditEventResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuditEventResponse proto.InternalMessageInfo
+
+func (m *GetAuditEventResponse) GetEvents() []*AuditEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type GetRequestJournalRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	StartDate            string   `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string   `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	OrderBy              string   `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Limit                int32    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int32    `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRequestJournalRequest) Reset()         { *m = GetRequestJournalRequest{} }
+func (m *GetRequestJournalRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRequestJournalRequest) ProtoMessage()    {}
+func (*GetRequestJournalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{121}
+}
+
+func (m *GetRequestJournalRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRequestJournalRequest.Unmarshal(m, b)
+}
+func (m *GetRequestJournalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRequestJournalRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRequestJournalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRequestJournalRequest.Merge(m, src)
+}
+func (m *GetRequestJournalRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRequestJournalRequest.Size(m)
+}
+func (m *GetRequestJournalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRequestJournalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRequestJournalRequest proto.InternalMessageInfo
+
+func (m *GetRequestJournalRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetRequestJournalRequest) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetRequestJournalRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetRequestJournalRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *GetRequestJournalRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetRequestJournalRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type RequestJournalEntry struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange             string   `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Method               string   `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Path                 string   `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Query                string   `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
+	Body                 string   `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	StatusCode           int64    `protobuf:"varint,7,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	LatencyMs            float64  `protobuf:"fixed64,8,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	Error                string   `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	Timestamp            string   `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestJournalEntry) Reset()         { *m = RequestJournalEntry{} }
+func (m *RequestJournalEntry) String() string { return proto.CompactTextString(m) }
+func (*RequestJournalEntry) ProtoMessage()    {}
+func (*RequestJournalEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{122}
+}
+
+func (m *RequestJournalEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestJournalEntry.Unmarshal(m, b)
+}
+func (m *RequestJournalEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestJournalEntry.Marshal(b, m, deterministic)
+}
+func (m *RequestJournalEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestJournalEntry.Merge(m, src)
+}
+func (m *RequestJournalEntry) XXX_Size() int {
+	return xxx_messageInfo_RequestJournalEntry.Size(m)
+}
+func (m *RequestJournalEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestJournalEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestJournalEntry proto.InternalMessageInfo
+
+func (m *RequestJournalEntry) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RequestJournalEntry) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *RequestJournalEntry) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *RequestJournalEntry) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *RequestJournalEntry) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *RequestJournalEntry) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *RequestJournalEntry) GetStatusCode() int64 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *RequestJournalEntry) GetLatencyMs() float64 {
+	if m != nil {
+		return m.LatencyMs
+	}
+	return 0
+}
+
+func (m *RequestJournalEntry) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *RequestJournalEntry) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+type GetRequestJournalResponse struct {
+	Entries              []*RequestJournalEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetRequestJournalResponse) Reset()         { *m = GetRequestJournalResponse{} }
+func (m *GetRequestJournalResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRequestJournalResponse) ProtoMessage()    {}
+func (*GetRequestJournalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{123}
+}
+
+func (m *GetRequestJournalResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRequestJournalResponse.Unmarshal(m, b)
+}
+func (m *GetRequestJournalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRequestJournalResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRequestJournalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRequestJournalResponse.Merge(m, src)
+}
+func (m *GetRequestJournalResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRequestJournalResponse.Size(m)
+}
+func (m *GetRequestJournalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRequestJournalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRequestJournalResponse proto.InternalMessageInfo
+
+func (m *GetRequestJournalResponse) GetEntries() []*RequestJournalEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type VerifyAuditEventsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyAuditEventsRequest) Reset()         { *m = VerifyAuditEventsRequest{} }
+func (m *VerifyAuditEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyAuditEventsRequest) ProtoMessage()    {}
+func (*VerifyAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{124}
+}
+
+func (m *VerifyAuditEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyAuditEventsRequest.Unmarshal(m, b)
+}
+func (m *VerifyAuditEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyAuditEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifyAuditEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyAuditEventsRequest.Merge(m, src)
+}
+func (m *VerifyAuditEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyAuditEventsRequest.Size(m)
+}
+func (m *VerifyAuditEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyAuditEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyAuditEventsRequest proto.InternalMessageInfo
+
+type AuditChainBreak struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditChainBreak) Reset()         { *m = AuditChainBreak{} }
+func (m *AuditChainBreak) String() string { return proto.CompactTextString(m) }
+func (*AuditChainBreak) ProtoMessage()    {}
+func (*AuditChainBreak) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{125}
+}
+
+func (m *AuditChainBreak) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditChainBreak.Unmarshal(m, b)
+}
+func (m *AuditChainBreak) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditChainBreak.Marshal(b, m, deterministic)
+}
+func (m *AuditChainBreak) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditChainBreak.Merge(m, src)
+}
+func (m *AuditChainBreak) XXX_Size() int {
+	return xxx_messageInfo_AuditChainBreak.Size(m)
+}
+func (m *AuditChainBreak) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditChainBreak.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditChainBreak proto.InternalMessageInfo
+
+func (m *AuditChainBreak) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuditChainBreak) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type VerifyAuditEventsResponse struct {
+	Valid                bool               `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Checked              int64              `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
+	Unhashed             int64              `protobuf:"varint,3,opt,name=unhashed,proto3" json:"unhashed,omitempty"`
+	LastId               int64              `protobuf:"varint,4,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	LastHash             string             `protobuf:"bytes,5,opt,name=last_hash,json=lastHash,proto3" json:"last_hash,omitempty"`
+	CheckpointsVerified  int64              `protobuf:"varint,6,opt,name=checkpoints_verified,json=checkpointsVerified,proto3" json:"checkpoints_verified,omitempty"`
+	Breaks               []*AuditChainBreak `protobuf:"bytes,7,rep,name=breaks,proto3" json:"breaks,omitempty"`
+	ChainStart           string             `protobuf:"bytes,8,opt,name=chain_start,json=chainStart,proto3" json:"chain_start,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *VerifyAuditEventsResponse) Reset()         { *m = VerifyAuditEventsResponse{} }
+func (m *VerifyAuditEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyAuditEventsResponse) ProtoMessage()    {}
+func (*VerifyAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{126}
+}
+
+func (m *VerifyAuditEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyAuditEventsResponse.Unmarshal(m, b)
+}
+func (m *VerifyAuditEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyAuditEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *VerifyAuditEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyAuditEventsResponse.Merge(m, src)
+}
+func (m *VerifyAuditEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyAuditEventsResponse.Size(m)
+}
+func (m *VerifyAuditEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyAuditEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyAuditEventsResponse proto.InternalMessageInfo
+
+func (m *VerifyAuditEventsResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *VerifyAuditEventsResponse) GetChecked() int64 {
+	if m != nil {
+		return m.Checked
+	}
+	return 0
+}
+
+func (m *VerifyAuditEventsResponse) GetUnhashed() int64 {
+	if m != nil {
+		return m.Unhashed
+	}
+	return 0
+}
+
+func (m *VerifyAuditEventsResponse) GetLastId() int64 {
+	if m != nil {
+		return m.LastId
+	}
+	return 0
+}
+
+func (m *VerifyAuditEventsResponse) GetLastHash() string {
+	if m != nil {
+		return m.LastHash
+	}
+	return ""
+}
+
+func (m *VerifyAuditEventsResponse) GetCheckpointsVerified() int64 {
+	if m != nil {
+		return m.CheckpointsVerified
+	}
+	return 0
+}
+
+func (m *VerifyAuditEventsResponse) GetBreaks() []*AuditChainBreak {
+	if m != nil {
+		return m.Breaks
+	}
+	return nil
+}
+
+func (m *VerifyAuditEventsResponse) GetChainStart() string {
+	if m != nil {
+		return m.ChainStart
+	}
+	return ""
+}
+
+type GetPortfolioHistoryRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Currency             string   `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	StartDate            string   `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string   `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Limit                int32    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int32    `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPortfolioHistoryRequest) Reset()         { *m = GetPortfolioHistoryRequest{} }
+func (m *GetPortfolioHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPortfolioHistoryRequest) ProtoMessage()    {}
+func (*GetPortfolioHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{127}
+}
+
+func (m *GetPortfolioHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPortfolioHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetPortfolioHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPortfolioHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPortfolioHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPortfolioHistoryRequest.Merge(m, src)
+}
+func (m *GetPortfolioHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPortfolioHistoryRequest.Size(m)
+}
+func (m *GetPortfolioHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPortfolioHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPortfolioHistoryRequest proto.InternalMessageInfo
+
+func (m *GetPortfolioHistoryRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetPortfolioHistoryRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *GetPortfolioHistoryRequest) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetPortfolioHistoryRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetPortfolioHistoryRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetPortfolioHistoryRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type BalanceSnapshot struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Currency             string   `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Total                float64  `protobuf:"fixed64,4,opt,name=total,proto3" json:"total,omitempty"`
+	Hold                 float64  `protobuf:"fixed64,5,opt,name=hold,proto3" json:"hold,omitempty"`
+	FiatCurrency         string   `protobuf:"bytes,6,opt,name=fiat_currency,json=fiatCurrency,proto3" json:"fiat_currency,omitempty"`
+	FiatValue            float64  `protobuf:"fixed64,7,opt,name=fiat_value,json=fiatValue,proto3" json:"fiat_value,omitempty"`
+	Priced               bool     `protobuf:"varint,8,opt,name=priced,proto3" json:"priced,omitempty"`
+	Timestamp            string   `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BalanceSnapshot) Reset()         { *m = BalanceSnapshot{} }
+func (m *BalanceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*BalanceSnapshot) ProtoMessage()    {}
+func (*BalanceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{128}
+}
+
+func (m *BalanceSnapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceSnapshot.Unmarshal(m, b)
+}
+func (m *BalanceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceSnapshot.Marshal(b, m, deterministic)
+}
+func (m *BalanceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceSnapshot.Merge(m, src)
+}
+func (m *BalanceSnapshot) XXX_Size() int {
+	return xxx_messageInfo_BalanceSnapshot.Size(m)
+}
+func (m *BalanceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceSnapshot proto.InternalMessageInfo
+
+func (m *BalanceSnapshot) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *BalanceSnapshot) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *BalanceSnapshot) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *BalanceSnapshot) GetTotal() float64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *BalanceSnapshot) GetHold() float64 {
+	if m != nil {
+		return m.Hold
+	}
+	return 0
+}
+
+func (m *BalanceSnapshot) GetFiatCurrency() string {
+	if m != nil {
+		return m.FiatCurrency
+	}
+	return ""
+}
+
+func (m *BalanceSnapshot) GetFiatValue() float64 {
+	if m != nil {
+		return m.FiatValue
+	}
+	return 0
+}
+
+func (m *BalanceSnapshot) GetPriced() bool {
+	if m != nil {
+		return m.Priced
+	}
+	return false
+}
+
+func (m *BalanceSnapshot) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+type GetPortfolioHistoryResponse struct {
+	Snapshots            []*BalanceSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetPortfolioHistoryResponse) Reset()         { *m = GetPortfolioHistoryResponse{} }
+func (m *GetPortfolioHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPortfolioHistoryResponse) ProtoMessage()    {}
+func (*GetPortfolioHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{129}
+}
+
+func (m *GetPortfolioHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPortfolioHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetPortfolioHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPortfolioHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPortfolioHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPortfolioHistoryResponse.Merge(m, src)
+}
+func (m *GetPortfolioHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPortfolioHistoryResponse.Size(m)
+}
+func (m *GetPortfolioHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPortfolioHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPortfolioHistoryResponse proto.InternalMessageInfo
+
+func (m *GetPortfolioHistoryResponse) GetSnapshots() []*BalanceSnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+type GetEquityCurveRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	StartDate            string   `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string   `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Offset               int32    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetEquityCurveRequest) Reset()         { *m = GetEquityCurveRequest{} }
+func (m *GetEquityCurveRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEquityCurveRequest) ProtoMessage()    {}
+func (*GetEquityCurveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{130}
+}
+
+func (m *GetEquityCurveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEquityCurveRequest.Unmarshal(m, b)
+}
+func (m *GetEquityCurveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEquityCurveRequest.Marshal(b, m, deterministic)
+}
+func (m *GetEquityCurveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEquityCurveRequest.Merge(m, src)
+}
+func (m *GetEquityCurveRequest) XXX_Size() int {
+	return xxx_messageInfo_GetEquityCurveRequest.Size(m)
+}
+func (m *GetEquityCurveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEquityCurveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEquityCurveRequest proto.InternalMessageInfo
+
+func (m *GetEquityCurveRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetEquityCurveRequest) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetEquityCurveRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetEquityCurveRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type EquityPoint struct {
+	Timestamp            string   `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	FiatCurrency         string   `protobuf:"bytes,2,opt,name=fiat_currency,json=fiatCurrency,proto3" json:"fiat_currency,omitempty"`
+	Value                float64  `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	Unpriced             int64    `protobuf:"varint,4,opt,name=unpriced,proto3" json:"unpriced,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EquityPoint) Reset()         { *m = EquityPoint{} }
+func (m *EquityPoint) String() string { return proto.CompactTextString(m) }
+func (*EquityPoint) ProtoMessage()    {}
+func (*EquityPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{131}
+}
+
+func (m *EquityPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EquityPoint.Unmarshal(m, b)
+}
+func (m *EquityPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EquityPoint.Marshal(b, m, deterministic)
+}
+func (m *EquityPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EquityPoint.Merge(m, src)
+}
+func (m *EquityPoint) XXX_Size() int {
+	return xxx_messageInfo_EquityPoint.Size(m)
+}
+func (m *EquityPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_EquityPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EquityPoint proto.InternalMessageInfo
+
+func (m *EquityPoint) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *EquityPoint) GetFiatCurrency() string {
+	if m != nil {
+		return m.FiatCurrency
+	}
+	return ""
+}
+
+func (m *EquityPoint) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *EquityPoint) GetUnpriced() int64 {
+	if m != nil {
+		return m.Unpriced
+	}
+	return 0
+}
+
+type GetEquityCurveResponse struct {
+	Points               []*EquityPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetEquityCurveResponse) Reset()         { *m = GetEquityCurveResponse{} }
+func (m *GetEquityCurveResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEquityCurveResponse) ProtoMessage()    {}
+func (*GetEquityCurveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{132}
+}
+
+func (m *GetEquityCurveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEquityCurveResponse.Unmarshal(m, b)
+}
+func (m *GetEquityCurveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEquityCurveResponse.Marshal(b, m, deterministic)
+}
+func (m *GetEquityCurveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEquityCurveResponse.Merge(m, src)
+}
+func (m *GetEquityCurveResponse) XXX_Size() int {
+	return xxx_messageInfo_GetEquityCurveResponse.Size(m)
+}
+func (m *GetEquityCurveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEquityCurveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEquityCurveResponse proto.InternalMessageInfo
+
+func (m *GetEquityCurveResponse) GetPoints() []*EquityPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+type GetFillsRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	StartDate            string   `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string   `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int32    `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFillsRequest) Reset()         { *m = GetFillsRequest{} }
+func (m *GetFillsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFillsRequest) ProtoMessage()    {}
+func (*GetFillsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{133}
+}
+
+func (m *GetFillsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFillsRequest.Unmarshal(m, b)
+}
+func (m *GetFillsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFillsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetFillsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFillsRequest.Merge(m, src)
+}
+func (m *GetFillsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFillsRequest.Size(m)
+}
+func (m *GetFillsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFillsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFillsRequest proto.InternalMessageInfo
+
+func (m *GetFillsRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetFillsRequest) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetFillsRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetFillsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetFillsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type Fill struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                string   `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                 string   `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	OrderId              string   `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Tid                  string   `protobuf:"bytes,5,opt,name=tid,proto3" json:"tid,omitempty"`
+	Side                 string   `protobuf:"bytes,6,opt,name=side,proto3" json:"side,omitempty"`
+	Type                 string   `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	Price                float64  `protobuf:"fixed64,8,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               float64  `protobuf:"fixed64,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee                  float64  `protobuf:"fixed64,10,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeCurrency          string   `protobuf:"bytes,11,opt,name=fee_currency,json=feeCurrency,proto3" json:"fee_currency,omitempty"`
+	IsMaker              bool     `protobuf:"varint,12,opt,name=is_maker,json=isMaker,proto3" json:"is_maker,omitempty"`
+	Timestamp            string   `protobuf:"bytes,13,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Fill) Reset()         { *m = Fill{} }
+func (m *Fill) String() string { return proto.CompactTextString(m) }
+func (*Fill) ProtoMessage()    {}
+func (*Fill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{134}
+}
+
+func (m *Fill) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Fill.Unmarshal(m, b)
+}
+func (m *Fill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Fill.Marshal(b, m, deterministic)
+}
+func (m *Fill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fill.Merge(m, src)
+}
+func (m *Fill) XXX_Size() int {
+	return xxx_messageInfo_Fill.Size(m)
+}
+func (m *Fill) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fill proto.InternalMessageInfo
+
+func (m *Fill) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *Fill) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *Fill) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *Fill) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *Fill) GetTid() string {
+	if m != nil {
+		return m.Tid
+	}
+	return ""
+}
+
+func (m *Fill) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *Fill) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Fill) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *Fill) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Fill) GetFee() float64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *Fill) GetFeeCurrency() string {
+	if m != nil {
+		return m.FeeCurrency
+	}
+	return ""
+}
+
+func (m *Fill) GetIsMaker() bool {
+	if m != nil {
+		return m.IsMaker
+	}
+	return false
+}
+
+func (m *Fill) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+type GetFillsResponse struct {
+	Fills                []*Fill  `protobuf:"bytes,1,rep,name=fills,proto3" json:"fills,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFillsResponse) Reset()         { *m = GetFillsResponse{} }
+func (m *GetFillsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFillsResponse) ProtoMessage()    {}
+func (*GetFillsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{135}
+}
+
+func (m *GetFillsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFillsResponse.Unmarshal(m, b)
+}
+func (m *GetFillsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFillsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetFillsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFillsResponse.Merge(m, src)
+}
+func (m *GetFillsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFillsResponse.Size(m)
+}
+func (m *GetFillsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFillsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFillsResponse proto.InternalMessageInfo
+
+func (m *GetFillsResponse) GetFills() []*Fill {
+	if m != nil {
+		return m.Fills
+	}
+	return nil
+}
+
+type ReconcileFillsRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	StartDate            string   `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string   `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Offset               int32    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReconcileFillsRequest) Reset()         { *m = ReconcileFillsRequest{} }
+func (m *ReconcileFillsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReconcileFillsRequest) ProtoMessage()    {}
+func (*ReconcileFillsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{136}
+}
+
+func (m *ReconcileFillsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconcileFillsRequest.Unmarshal(m, b)
+}
+func (m *ReconcileFillsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconcileFillsRequest.Marshal(b, m, deterministic)
+}
+func (m *ReconcileFillsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileFillsRequest.Merge(m, src)
+}
+func (m *ReconcileFillsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReconcileFillsRequest.Size(m)
+}
+func (m *ReconcileFillsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileFillsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileFillsRequest proto.InternalMessageInfo
+
+func (m *ReconcileFillsRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *ReconcileFillsRequest) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *ReconcileFillsRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *ReconcileFillsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ReconciledOrder struct {
+	OrderId              string   `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Pair                 string   `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	ExecutedAmount       float64  `protobuf:"fixed64,3,opt,name=executed_amount,json=executedAmount,proto3" json:"executed_amount,omitempty"`
+	FilledAmount         float64  `protobuf:"fixed64,4,opt,name=filled_amount,json=filledAmount,proto3" json:"filled_amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReconciledOrder) Reset()         { *m = ReconciledOrder{} }
+func (m *ReconciledOrder) String() string { return proto.CompactTextString(m) }
+func (*ReconciledOrder) ProtoMessage()    {}
+func (*ReconciledOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{137}
+}
+
+func (m *ReconciledOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconciledOrder.Unmarshal(m, b)
+}
+func (m *ReconciledOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconciledOrder.Marshal(b, m, deterministic)
+}
+func (m *ReconciledOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconciledOrder.Merge(m, src)
+}
+func (m *ReconciledOrder) XXX_Size() int {
+	return xxx_messageInfo_ReconciledOrder.Size(m)
+}
+func (m *ReconciledOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconciledOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconciledOrder proto.InternalMessageInfo
+
+func (m *ReconciledOrder) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *ReconciledOrder) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *ReconciledOrder) GetExecutedAmount() float64 {
+	if m != nil {
+		return m.ExecutedAmount
+	}
+	return 0
+}
+
+func (m *ReconciledOrder) GetFilledAmount() float64 {
+	if m != nil {
+		return m.FilledAmount
+	}
+	return 0
+}
+
+type GetFundingHistoryRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	StartDate            string   `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string   `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int32    `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFundingHistoryRequest) Reset()         { *m = GetFundingHistoryRequest{} }
+func (m *GetFundingHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFundingHistoryRequest) ProtoMessage()    {}
+func (*GetFundingHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{138}
+}
+
+func (m *GetFundingHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFundingHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetFundingHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFundingHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetFundingHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFundingHistoryRequest.Merge(m, src)
+}
+func (m *GetFundingHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFundingHistoryRequest.Size(m)
+}
+func (m *GetFundingHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFundingHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFundingHistoryRequest proto.InternalMessageInfo
+
+func (m *GetFundingHistoryRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetFundingHistoryRequest) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetFundingHistoryRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetFundingHistoryRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetFundingHistoryRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type FundingTransfer struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	TransferId           string   `protobuf:"bytes,2,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	TransferType         string   `protobuf:"bytes,3,opt,name=transfer_type,json=transferType,proto3" json:"transfer_type,omitempty"`
+	Status               string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Currency             string   `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount               float64  `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee                  float64  `protobuf:"fixed64,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	Description          string   `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	CryptoTxId           string   `protobuf:"bytes,9,opt,name=crypto_tx_id,json=cryptoTxId,proto3" json:"crypto_tx_id,omitempty"`
+	CryptoToAddress      string   `protobuf:"bytes,10,opt,name=crypto_to_address,json=cryptoToAddress,proto3" json:"crypto_to_address,omitempty"`
+	CryptoFromAddress    string   `protobuf:"bytes,11,opt,name=crypto_from_address,json=cryptoFromAddress,proto3" json:"crypto_from_address,omitempty"`
+	BankTo               string   `protobuf:"bytes,12,opt,name=bank_to,json=bankTo,proto3" json:"bank_to,omitempty"`
+	BankFrom             string   `protobuf:"bytes,13,opt,name=bank_from,json=bankFrom,proto3" json:"bank_from,omitempty"`
+	WithdrawalId         string   `protobuf:"bytes,14,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
+	Timestamp            string   `protobuf:"bytes,15,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FundingTransfer) Reset()         { *m = FundingTransfer{} }
+func (m *FundingTransfer) String() string { return proto.CompactTextString(m) }
+func (*FundingTransfer) ProtoMessage()    {}
+func (*FundingTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{139}
+}
+
+func (m *FundingTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundingTransfer.Unmarshal(m, b)
+}
+func (m *FundingTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundingTransfer.Marshal(b, m, deterministic)
+}
+func (m *FundingTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingTransfer.Merge(m, src)
+}
+func (m *FundingTransfer) XXX_Size() int {
+	return xxx_messageInfo_FundingTransfer.Size(m)
+}
+func (m *FundingTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingTransfer proto.InternalMessageInfo
+
+func (m *FundingTransfer) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *FundingTransfer) GetTransferId() string {
+	if m != nil {
+		return m.TransferId
+	}
+	return ""
+}
+
+func (m *FundingTransfer) GetTransferType() string {
+	if m != nil {
+		return m.TransferType
+	}
+	return ""
+}
+
+func (m *FundingTransfer) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *FundingTransfer) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *FundingTransfer) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *FundingTransfer) GetFee() float64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *FundingTransfer) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *FundingTransfer) GetCryptoTxId() string {
+	if m != nil {
+		return m.CryptoTxId
+	}
+	return ""
+}
+
+func (m *FundingTransfer) GetCryptoToAddress() string {
+	if m != nil {
+		return m.CryptoToAddress
+	}
+	return ""
+}
+
+func (m *FundingTransfer) GetCryptoFromAddress() string {
+	if m != nil {
+		return m.CryptoFromAddress
+	}
+	return ""
+}
+
+func (m *FundingTransfer) GetBankTo() string {
+	if m != nil {
+		return m.BankTo
+	}
+	return ""
+}
+
+func (m *FundingTransfer) GetBankFrom() string {
+	if m != nil {
+		return m.BankFrom
+	}
+	return ""
+}
+
+func (m *FundingTransfer) GetWithdrawalId() string {
+	if m != nil {
+		return m.WithdrawalId
+	}
+	return ""
+}
+
+func (m *FundingTransfer) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+type GetFundingHistoryResponse struct {
+	Transfers            []*FundingTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetFundingHistoryResponse) Reset()         { *m = GetFundingHistoryResponse{} }
+func (m *GetFundingHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFundingHistoryResponse) ProtoMessage()    {}
+func (*GetFundingHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{140}
+}
+
+func (m *GetFundingHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFundingHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetFundingHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFundingHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetFundingHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFundingHistoryResponse.Merge(m, src)
+}
+func (m *GetFundingHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFundingHistoryResponse.Size(m)
+}
+func (m *GetFundingHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFundingHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFundingHistoryResponse proto.InternalMessageInfo
+
+func (m *GetFundingHistoryResponse) GetTransfers() []*FundingTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+type DatabaseMigration struct {
+	Version              int64    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Applied              bool     `protobuf:"varint,3,opt,name=applied,proto3" json:"applied,omitempty"`
+	AppliedAt            string   `protobuf:"bytes,4,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatabaseMigration) Reset()         { *m = DatabaseMigration{} }
+func (m *DatabaseMigration) String() string { return proto.CompactTextString(m) }
+func (*DatabaseMigration) ProtoMessage()    {}
+func (*DatabaseMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{141}
+}
+
+func (m *DatabaseMigration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseMigration.Unmarshal(m, b)
+}
+func (m *DatabaseMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseMigration.Marshal(b, m, deterministic)
+}
+func (m *DatabaseMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseMigration.Merge(m, src)
+}
+func (m *DatabaseMigration) XXX_Size() int {
+	return xxx_messageInfo_DatabaseMigration.Size(m)
+}
+func (m *DatabaseMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseMigration proto.InternalMessageInfo
+
+func (m *DatabaseMigration) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *DatabaseMigration) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DatabaseMigration) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
+func (m *DatabaseMigration) GetAppliedAt() string {
+	if m != nil {
+		return m.AppliedAt
+	}
+	return ""
+}
+
+type GetDatabaseStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDatabaseStatusRequest) Reset()         { *m = GetDatabaseStatusRequest{} }
+func (m *GetDatabaseStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDatabaseStatusRequest) ProtoMessage()    {}
+func (*GetDatabaseStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{142}
+}
+
+func (m *GetDatabaseStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDatabaseStatusRequest.Unmarshal(m, b)
+}
+func (m *GetDatabaseStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDatabaseStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDatabaseStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDatabaseStatusRequest.Merge(m, src)
+}
+func (m *GetDatabaseStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDatabaseStatusRequest.Size(m)
+}
+func (m *GetDatabaseStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDatabaseStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDatabaseStatusRequest proto.InternalMessageInfo
+
+type GetDatabaseStatusResponse struct {
+	Driver               string               `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Version              int64                `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	LatestVersion        int64                `protobuf:"varint,3,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	Pending              int64                `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
+	Migrations           []*DatabaseMigration `protobuf:"bytes,5,rep,name=migrations,proto3" json:"migrations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetDatabaseStatusResponse) Reset()         { *m = GetDatabaseStatusResponse{} }
+func (m *GetDatabaseStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDatabaseStatusResponse) ProtoMessage()    {}
+func (*GetDatabaseStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{143}
+}
+
+func (m *GetDatabaseStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDatabaseStatusResponse.Unmarshal(m, b)
+}
+func (m *GetDatabaseStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDatabaseStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDatabaseStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDatabaseStatusResponse.Merge(m, src)
+}
+func (m *GetDatabaseStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDatabaseStatusResponse.Size(m)
+}
+func (m *GetDatabaseStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDatabaseStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDatabaseStatusResponse proto.InternalMessageInfo
+
+func (m *GetDatabaseStatusResponse) GetDriver() string {
+	if m != nil {
+		return m.Driver
+	}
+	return ""
+}
+
+func (m *GetDatabaseStatusResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GetDatabaseStatusResponse) GetLatestVersion() int64 {
+	if m != nil {
+		return m.LatestVersion
+	}
+	return 0
+}
+
+func (m *GetDatabaseStatusResponse) GetPending() int64 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+func (m *GetDatabaseStatusResponse) GetMigrations() []*DatabaseMigration {
+	if m != nil {
+		return m.Migrations
+	}
+	return nil
+}
+
+type MigrateDatabaseRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-type GetAuditEventResponse struct {
-	Events               []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+func (m *MigrateDatabaseRequest) Reset()         { *m = MigrateDatabaseRequest{} }
+func (m *MigrateDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*MigrateDatabaseRequest) ProtoMessage()    {}
+func (*MigrateDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{144}
+}
+
+func (m *MigrateDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateDatabaseRequest.Unmarshal(m, b)
+}
+func (m *MigrateDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateDatabaseRequest.Marshal(b, m, deterministic)
+}
+func (m *MigrateDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateDatabaseRequest.Merge(m, src)
+}
+func (m *MigrateDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_MigrateDatabaseRequest.Size(m)
+}
+func (m *MigrateDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateDatabaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateDatabaseRequest proto.InternalMessageInfo
+
+type MigrateDatabaseResponse struct {
+	Version              int64                `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Applied              []*DatabaseMigration `protobuf:"bytes,2,rep,name=applied,proto3" json:"applied,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *MigrateDatabaseResponse) Reset()         { *m = MigrateDatabaseResponse{} }
+func (m *MigrateDatabaseResponse) String() string { return proto.CompactTextString(m) }
+func (*MigrateDatabaseResponse) ProtoMessage()    {}
+func (*MigrateDatabaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{145}
+}
+
+func (m *MigrateDatabaseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateDatabaseResponse.Unmarshal(m, b)
+}
+func (m *MigrateDatabaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateDatabaseResponse.Marshal(b, m, deterministic)
+}
+func (m *MigrateDatabaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateDatabaseResponse.Merge(m, src)
+}
+func (m *MigrateDatabaseResponse) XXX_Size() int {
+	return xxx_messageInfo_MigrateDatabaseResponse.Size(m)
+}
+func (m *MigrateDatabaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateDatabaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateDatabaseResponse proto.InternalMessageInfo
+
+func (m *MigrateDatabaseResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *MigrateDatabaseResponse) GetApplied() []*DatabaseMigration {
+	if m != nil {
+		return m.Applied
+	}
+	return nil
+}
+
+type GetTickerHistoryRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	StartDate            string        `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string        `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Limit                int32         `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int32         `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GetAuditEventResponse) Reset()         { *m = GetAuditEventResponse{} }
-func (m *GetAuditEventResponse) String() string { return proto.CompactTextString(m) }
-func (*GetAuditEventResponse) ProtoMessage()    {}
-func (*GetAuditEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{103}
+func (m *GetTickerHistoryRequest) Reset()         { *m = GetTickerHistoryRequest{} }
+func (m *GetTickerHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTickerHistoryRequest) ProtoMessage()    {}
+func (*GetTickerHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{146}
 }
 
-func (m *GetAuditEventResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetAuditEventResponse.Unmarshal(m, b)
+func (m *GetTickerHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTickerHistoryRequest.Unmarshal(m, b)
 }
-func (m *GetAuditEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetAuditEventResponse.Marshal(b, m, deterministic)
+func (m *GetTickerHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTickerHistoryRequest.Marshal(b, m, deterministic)
 }
-func (m *GetAuditEventResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAuditEventResponse.Merge(m, src)
+func (m *GetTickerHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTickerHistoryRequest.Merge(m, src)
 }
-func (m *GetAuditEventResponse) XXX_Size() int {
-	return xxx_messageInfo_GetAuditEventResponse.Size(m)
+func (m *GetTickerHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTickerHistoryRequest.Size(m)
 }
-func (m *GetAuditEventResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAuditEventResponse.DiscardUnknown(m)
+func (m *GetTickerHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTickerHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetAuditEventResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetTickerHistoryRequest proto.InternalMessageInfo
 
-func (m *GetAuditEventResponse) GetEvents() []*AuditEvent {
+func (m *GetTickerHistoryRequest) GetExchange() string {
 	if m != nil {
-		return m.Events
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetTickerHistoryRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetTickerHistoryRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *GetTickerHistoryRequest) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetTickerHistoryRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetTickerHistoryRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetTickerHistoryRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type TickerHistoryItem struct {
+	Timestamp            string   `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Period               int64    `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	Open                 float64  `protobuf:"fixed64,3,opt,name=open,proto3" json:"open,omitempty"`
+	High                 float64  `protobuf:"fixed64,4,opt,name=high,proto3" json:"high,omitempty"`
+	Low                  float64  `protobuf:"fixed64,5,opt,name=low,proto3" json:"low,omitempty"`
+	Close                float64  `protobuf:"fixed64,6,opt,name=close,proto3" json:"close,omitempty"`
+	Bid                  float64  `protobuf:"fixed64,7,opt,name=bid,proto3" json:"bid,omitempty"`
+	Ask                  float64  `protobuf:"fixed64,8,opt,name=ask,proto3" json:"ask,omitempty"`
+	Volume               float64  `protobuf:"fixed64,9,opt,name=volume,proto3" json:"volume,omitempty"`
+	Ticks                int64    `protobuf:"varint,10,opt,name=ticks,proto3" json:"ticks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TickerHistoryItem) Reset()         { *m = TickerHistoryItem{} }
+func (m *TickerHistoryItem) String() string { return proto.CompactTextString(m) }
+func (*TickerHistoryItem) ProtoMessage()    {}
+func (*TickerHistoryItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{147}
+}
+
+func (m *TickerHistoryItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TickerHistoryItem.Unmarshal(m, b)
+}
+func (m *TickerHistoryItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TickerHistoryItem.Marshal(b, m, deterministic)
+}
+func (m *TickerHistoryItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickerHistoryItem.Merge(m, src)
+}
+func (m *TickerHistoryItem) XXX_Size() int {
+	return xxx_messageInfo_TickerHistoryItem.Size(m)
+}
+func (m *TickerHistoryItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickerHistoryItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickerHistoryItem proto.InternalMessageInfo
+
+func (m *TickerHistoryItem) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *TickerHistoryItem) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *TickerHistoryItem) GetOpen() float64 {
+	if m != nil {
+		return m.Open
+	}
+	return 0
+}
+
+func (m *TickerHistoryItem) GetHigh() float64 {
+	if m != nil {
+		return m.High
+	}
+	return 0
+}
+
+func (m *TickerHistoryItem) GetLow() float64 {
+	if m != nil {
+		return m.Low
+	}
+	return 0
+}
+
+func (m *TickerHistoryItem) GetClose() float64 {
+	if m != nil {
+		return m.Close
+	}
+	return 0
+}
+
+func (m *TickerHistoryItem) GetBid() float64 {
+	if m != nil {
+		return m.Bid
+	}
+	return 0
+}
+
+func (m *TickerHistoryItem) GetAsk() float64 {
+	if m != nil {
+		return m.Ask
+	}
+	return 0
+}
+
+func (m *TickerHistoryItem) GetVolume() float64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
+func (m *TickerHistoryItem) GetTicks() int64 {
+	if m != nil {
+		return m.Ticks
+	}
+	return 0
+}
+
+type GetTickerHistoryResponse struct {
+	Exchange             string               `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair        `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string               `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	History              []*TickerHistoryItem `protobuf:"bytes,4,rep,name=history,proto3" json:"history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetTickerHistoryResponse) Reset()         { *m = GetTickerHistoryResponse{} }
+func (m *GetTickerHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTickerHistoryResponse) ProtoMessage()    {}
+func (*GetTickerHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{148}
+}
+
+func (m *GetTickerHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTickerHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetTickerHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTickerHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTickerHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTickerHistoryResponse.Merge(m, src)
+}
+func (m *GetTickerHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTickerHistoryResponse.Size(m)
+}
+func (m *GetTickerHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTickerHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTickerHistoryResponse proto.InternalMessageInfo
+
+func (m *GetTickerHistoryResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetTickerHistoryResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetTickerHistoryResponse) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *GetTickerHistoryResponse) GetHistory() []*TickerHistoryItem {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+type ReconcileFillsResponse struct {
+	Exchange             string             `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Matched              int64              `protobuf:"varint,2,opt,name=matched,proto3" json:"matched,omitempty"`
+	Missing              []*ReconciledOrder `protobuf:"bytes,3,rep,name=missing,proto3" json:"missing,omitempty"`
+	Mismatched           []*ReconciledOrder `protobuf:"bytes,4,rep,name=mismatched,proto3" json:"mismatched,omitempty"`
+	Unknown              []*Fill            `protobuf:"bytes,5,rep,name=unknown,proto3" json:"unknown,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ReconcileFillsResponse) Reset()         { *m = ReconcileFillsResponse{} }
+func (m *ReconcileFillsResponse) String() string { return proto.CompactTextString(m) }
+func (*ReconcileFillsResponse) ProtoMessage()    {}
+func (*ReconcileFillsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{149}
+}
+
+func (m *ReconcileFillsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconcileFillsResponse.Unmarshal(m, b)
+}
+func (m *ReconcileFillsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconcileFillsResponse.Marshal(b, m, deterministic)
+}
+func (m *ReconcileFillsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileFillsResponse.Merge(m, src)
+}
+func (m *ReconcileFillsResponse) XXX_Size() int {
+	return xxx_messageInfo_ReconcileFillsResponse.Size(m)
+}
+func (m *ReconcileFillsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileFillsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileFillsResponse proto.InternalMessageInfo
+
+func (m *ReconcileFillsResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *ReconcileFillsResponse) GetMatched() int64 {
+	if m != nil {
+		return m.Matched
+	}
+	return 0
+}
+
+func (m *ReconcileFillsResponse) GetMissing() []*ReconciledOrder {
+	if m != nil {
+		return m.Missing
+	}
+	return nil
+}
+
+func (m *ReconcileFillsResponse) GetMismatched() []*ReconciledOrder {
+	if m != nil {
+		return m.Mismatched
+	}
+	return nil
+}
+
+func (m *ReconcileFillsResponse) GetUnknown() []*Fill {
+	if m != nil {
+		return m.Unknown
 	}
 	return nil
 }
@@ -5554,7 +8702,7 @@ func (m *GetHistoricCandlesRequest) Reset()         { *m = GetHistoricCandlesReq
 func (m *GetHistoricCandlesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetHistoricCandlesRequest) ProtoMessage()    {}
 func (*GetHistoricCandlesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{104}
+	return fileDescriptor_77a6da22d6a3feb1, []int{150}
 }
 
 func (m *GetHistoricCandlesRequest) XXX_Unmarshal(b []byte) error {
@@ -5640,7 +8788,7 @@ func (m *GetHistoricCandlesResponse) Reset()         { *m = GetHistoricCandlesRe
 func (m *GetHistoricCandlesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetHistoricCandlesResponse) ProtoMessage()    {}
 func (*GetHistoricCandlesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{105}
+	return fileDescriptor_77a6da22d6a3feb1, []int{151}
 }
 
 func (m *GetHistoricCandlesResponse) XXX_Unmarshal(b []byte) error {
@@ -5719,7 +8867,7 @@ func (m *Candle) Reset()         { *m = Candle{} }
 func (m *Candle) String() string { return proto.CompactTextString(m) }
 func (*Candle) ProtoMessage()    {}
 func (*Candle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{106}
+	return fileDescriptor_77a6da22d6a3feb1, []int{152}
 }
 
 func (m *Candle) XXX_Unmarshal(b []byte) error {
@@ -5787,6 +8935,7 @@ type AuditEvent struct {
 	Identifier           string   `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
 	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	Timestamp            string   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Hash                 string   `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5796,7 +8945,7 @@ func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
 func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
 func (*AuditEvent) ProtoMessage()    {}
 func (*AuditEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{107}
+	return fileDescriptor_77a6da22d6a3feb1, []int{153}
 }
 
 func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
@@ -5845,6 +8994,13 @@ func (m *AuditEvent) GetTimestamp() string {
 	return ""
 }
 
+func (m *AuditEvent) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
 type GCTScript struct {
 	UUID                 string   `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -5859,7 +9015,7 @@ func (m *GCTScript) Reset()         { *m = GCTScript{} }
 func (m *GCTScript) String() string { return proto.CompactTextString(m) }
 func (*GCTScript) ProtoMessage()    {}
 func (*GCTScript) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{108}
+	return fileDescriptor_77a6da22d6a3feb1, []int{154}
 }
 
 func (m *GCTScript) XXX_Unmarshal(b []byte) error {
@@ -5919,7 +9075,7 @@ func (m *GCTScriptExecuteRequest) Reset()         { *m = GCTScriptExecuteRequest
 func (m *GCTScriptExecuteRequest) String() string { return proto.CompactTextString(m) }
 func (*GCTScriptExecuteRequest) ProtoMessage()    {}
 func (*GCTScriptExecuteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{109}
+	return fileDescriptor_77a6da22d6a3feb1, []int{155}
 }
 
 func (m *GCTScriptExecuteRequest) XXX_Unmarshal(b []byte) error {
@@ -5958,7 +9114,7 @@ func (m *GCTScriptStopRequest) Reset()         { *m = GCTScriptStopRequest{} }
 func (m *GCTScriptStopRequest) String() string { return proto.CompactTextString(m) }
 func (*GCTScriptStopRequest) ProtoMessage()    {}
 func (*GCTScriptStopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{110}
+	return fileDescriptor_77a6da22d6a3feb1, []int{156}
 }
 
 func (m *GCTScriptStopRequest) XXX_Unmarshal(b []byte) error {
@@ -5996,7 +9152,7 @@ func (m *GCTScriptStopAllRequest) Reset()         { *m = GCTScriptStopAllRequest
 func (m *GCTScriptStopAllRequest) String() string { return proto.CompactTextString(m) }
 func (*GCTScriptStopAllRequest) ProtoMessage()    {}
 func (*GCTScriptStopAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{111}
+	return fileDescriptor_77a6da22d6a3feb1, []int{157}
 }
 
 func (m *GCTScriptStopAllRequest) XXX_Unmarshal(b []byte) error {
@@ -6027,7 +9183,7 @@ func (m *GCTScriptStatusRequest) Reset()         { *m = GCTScriptStatusRequest{}
 func (m *GCTScriptStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*GCTScriptStatusRequest) ProtoMessage()    {}
 func (*GCTScriptStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{112}
+	return fileDescriptor_77a6da22d6a3feb1, []int{158}
 }
 
 func (m *GCTScriptStatusRequest) XXX_Unmarshal(b []byte) error {
@@ -6058,7 +9214,7 @@ func (m *GCTScriptListAllRequest) Reset()         { *m = GCTScriptListAllRequest
 func (m *GCTScriptListAllRequest) String() string { return proto.CompactTextString(m) }
 func (*GCTScriptListAllRequest) ProtoMessage()    {}
 func (*GCTScriptListAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{113}
+	return fileDescriptor_77a6da22d6a3feb1, []int{159}
 }
 
 func (m *GCTScriptListAllRequest) XXX_Unmarshal(b []byte) error {
@@ -6094,7 +9250,7 @@ func (m *GCTScriptUploadRequest) Reset()         { *m = GCTScriptUploadRequest{}
 func (m *GCTScriptUploadRequest) String() string { return proto.CompactTextString(m) }
 func (*GCTScriptUploadRequest) ProtoMessage()    {}
 func (*GCTScriptUploadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{114}
+	return fileDescriptor_77a6da22d6a3feb1, []int{160}
 }
 
 func (m *GCTScriptUploadRequest) XXX_Unmarshal(b []byte) error {
@@ -6161,7 +9317,7 @@ func (m *GCTScriptReadScriptRequest) Reset()         { *m = GCTScriptReadScriptR
 func (m *GCTScriptReadScriptRequest) String() string { return proto.CompactTextString(m) }
 func (*GCTScriptReadScriptRequest) ProtoMessage()    {}
 func (*GCTScriptReadScriptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{115}
+	return fileDescriptor_77a6da22d6a3feb1, []int{161}
 }
 
 func (m *GCTScriptReadScriptRequest) XXX_Unmarshal(b []byte) error {
@@ -6200,7 +9356,7 @@ func (m *GCTScriptQueryRequest) Reset()         { *m = GCTScriptQueryRequest{} }
 func (m *GCTScriptQueryRequest) String() string { return proto.CompactTextString(m) }
 func (*GCTScriptQueryRequest) ProtoMessage()    {}
 func (*GCTScriptQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{116}
+	return fileDescriptor_77a6da22d6a3feb1, []int{162}
 }
 
 func (m *GCTScriptQueryRequest) XXX_Unmarshal(b []byte) error {
@@ -6240,7 +9396,7 @@ func (m *GCTScriptAutoLoadRequest) Reset()         { *m = GCTScriptAutoLoadReque
 func (m *GCTScriptAutoLoadRequest) String() string { return proto.CompactTextString(m) }
 func (*GCTScriptAutoLoadRequest) ProtoMessage()    {}
 func (*GCTScriptAutoLoadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{117}
+	return fileDescriptor_77a6da22d6a3feb1, []int{163}
 }
 
 func (m *GCTScriptAutoLoadRequest) XXX_Unmarshal(b []byte) error {
@@ -6287,7 +9443,7 @@ func (m *GCTScriptStatusResponse) Reset()         { *m = GCTScriptStatusResponse
 func (m *GCTScriptStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*GCTScriptStatusResponse) ProtoMessage()    {}
 func (*GCTScriptStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{118}
+	return fileDescriptor_77a6da22d6a3feb1, []int{164}
 }
 
 func (m *GCTScriptStatusResponse) XXX_Unmarshal(b []byte) error {
@@ -6335,7 +9491,7 @@ func (m *GCTScriptQueryResponse) Reset()         { *m = GCTScriptQueryResponse{}
 func (m *GCTScriptQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*GCTScriptQueryResponse) ProtoMessage()    {}
 func (*GCTScriptQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{119}
+	return fileDescriptor_77a6da22d6a3feb1, []int{165}
 }
 
 func (m *GCTScriptQueryResponse) XXX_Unmarshal(b []byte) error {
@@ -6389,7 +9545,7 @@ func (m *GenericResponse) Reset()         { *m = GenericResponse{} }
 func (m *GenericResponse) String() string { return proto.CompactTextString(m) }
 func (*GenericResponse) ProtoMessage()    {}
 func (*GenericResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{120}
+	return fileDescriptor_77a6da22d6a3feb1, []int{166}
 }
 
 func (m *GenericResponse) XXX_Unmarshal(b []byte) error {
@@ -6437,7 +9593,7 @@ func (m *SetExchangeAssetRequest) Reset()         { *m = SetExchangeAssetRequest
 func (m *SetExchangeAssetRequest) String() string { return proto.CompactTextString(m) }
 func (*SetExchangeAssetRequest) ProtoMessage()    {}
 func (*SetExchangeAssetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{121}
+	return fileDescriptor_77a6da22d6a3feb1, []int{167}
 }
 
 func (m *SetExchangeAssetRequest) XXX_Unmarshal(b []byte) error {
@@ -6491,7 +9647,7 @@ func (m *SetExchangeAllPairsRequest) Reset()         { *m = SetExchangeAllPairsR
 func (m *SetExchangeAllPairsRequest) String() string { return proto.CompactTextString(m) }
 func (*SetExchangeAllPairsRequest) ProtoMessage()    {}
 func (*SetExchangeAllPairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{122}
+	return fileDescriptor_77a6da22d6a3feb1, []int{168}
 }
 
 func (m *SetExchangeAllPairsRequest) XXX_Unmarshal(b []byte) error {
@@ -6537,7 +9693,7 @@ func (m *UpdateExchangeSupportedPairsRequest) Reset()         { *m = UpdateExcha
 func (m *UpdateExchangeSupportedPairsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateExchangeSupportedPairsRequest) ProtoMessage()    {}
 func (*UpdateExchangeSupportedPairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{123}
+	return fileDescriptor_77a6da22d6a3feb1, []int{169}
 }
 
 func (m *UpdateExchangeSupportedPairsRequest) XXX_Unmarshal(b []byte) error {
@@ -6576,7 +9732,7 @@ func (m *GetExchangeAssetsRequest) Reset()         { *m = GetExchangeAssetsReque
 func (m *GetExchangeAssetsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetExchangeAssetsRequest) ProtoMessage()    {}
 func (*GetExchangeAssetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{124}
+	return fileDescriptor_77a6da22d6a3feb1, []int{170}
 }
 
 func (m *GetExchangeAssetsRequest) XXX_Unmarshal(b []byte) error {
@@ -6615,7 +9771,7 @@ func (m *GetExchangeAssetsResponse) Reset()         { *m = GetExchangeAssetsResp
 func (m *GetExchangeAssetsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetExchangeAssetsResponse) ProtoMessage()    {}
 func (*GetExchangeAssetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{125}
+	return fileDescriptor_77a6da22d6a3feb1, []int{171}
 }
 
 func (m *GetExchangeAssetsResponse) XXX_Unmarshal(b []byte) error {
@@ -6654,7 +9810,7 @@ func (m *WebsocketGetInfoRequest) Reset()         { *m = WebsocketGetInfoRequest
 func (m *WebsocketGetInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*WebsocketGetInfoRequest) ProtoMessage()    {}
 func (*WebsocketGetInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{126}
+	return fileDescriptor_77a6da22d6a3feb1, []int{172}
 }
 
 func (m *WebsocketGetInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -6699,7 +9855,7 @@ func (m *WebsocketGetInfoResponse) Reset()         { *m = WebsocketGetInfoRespon
 func (m *WebsocketGetInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*WebsocketGetInfoResponse) ProtoMessage()    {}
 func (*WebsocketGetInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{127}
+	return fileDescriptor_77a6da22d6a3feb1, []int{173}
 }
 
 func (m *WebsocketGetInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -6781,7 +9937,7 @@ func (m *WebsocketSetEnabledRequest) Reset()         { *m = WebsocketSetEnabledR
 func (m *WebsocketSetEnabledRequest) String() string { return proto.CompactTextString(m) }
 func (*WebsocketSetEnabledRequest) ProtoMessage()    {}
 func (*WebsocketSetEnabledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{128}
+	return fileDescriptor_77a6da22d6a3feb1, []int{174}
 }
 
 func (m *WebsocketSetEnabledRequest) XXX_Unmarshal(b []byte) error {
@@ -6827,7 +9983,7 @@ func (m *WebsocketGetSubscriptionsRequest) Reset()         { *m = WebsocketGetSu
 func (m *WebsocketGetSubscriptionsRequest) String() string { return proto.CompactTextString(m) }
 func (*WebsocketGetSubscriptionsRequest) ProtoMessage()    {}
 func (*WebsocketGetSubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{129}
+	return fileDescriptor_77a6da22d6a3feb1, []int{175}
 }
 
 func (m *WebsocketGetSubscriptionsRequest) XXX_Unmarshal(b []byte) error {
@@ -6869,7 +10025,7 @@ func (m *WebsocketSubscription) Reset()         { *m = WebsocketSubscription{} }
 func (m *WebsocketSubscription) String() string { return proto.CompactTextString(m) }
 func (*WebsocketSubscription) ProtoMessage()    {}
 func (*WebsocketSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{130}
+	return fileDescriptor_77a6da22d6a3feb1, []int{176}
 }
 
 func (m *WebsocketSubscription) XXX_Unmarshal(b []byte) error {
@@ -6930,7 +10086,7 @@ func (m *WebsocketGetSubscriptionsResponse) Reset()         { *m = WebsocketGetS
 func (m *WebsocketGetSubscriptionsResponse) String() string { return proto.CompactTextString(m) }
 func (*WebsocketGetSubscriptionsResponse) ProtoMessage()    {}
 func (*WebsocketGetSubscriptionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{131}
+	return fileDescriptor_77a6da22d6a3feb1, []int{177}
 }
 
 func (m *WebsocketGetSubscriptionsResponse) XXX_Unmarshal(b []byte) error {
@@ -6977,7 +10133,7 @@ func (m *WebsocketSetProxyRequest) Reset()         { *m = WebsocketSetProxyReque
 func (m *WebsocketSetProxyRequest) String() string { return proto.CompactTextString(m) }
 func (*WebsocketSetProxyRequest) ProtoMessage()    {}
 func (*WebsocketSetProxyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{132}
+	return fileDescriptor_77a6da22d6a3feb1, []int{178}
 }
 
 func (m *WebsocketSetProxyRequest) XXX_Unmarshal(b []byte) error {
@@ -7024,7 +10180,7 @@ func (m *WebsocketSetURLRequest) Reset()         { *m = WebsocketSetURLRequest{}
 func (m *WebsocketSetURLRequest) String() string { return proto.CompactTextString(m) }
 func (*WebsocketSetURLRequest) ProtoMessage()    {}
 func (*WebsocketSetURLRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{133}
+	return fileDescriptor_77a6da22d6a3feb1, []int{179}
 }
 
 func (m *WebsocketSetURLRequest) XXX_Unmarshal(b []byte) error {
@@ -7087,6 +10243,7 @@ func init() {
 	proto.RegisterType((*PairsSupported)(nil), "gctrpc.PairsSupported")
 	proto.RegisterType((*GetExchangeInfoResponse)(nil), "gctrpc.GetExchangeInfoResponse")
 	proto.RegisterMapType((map[string]*PairsSupported)(nil), "gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry")
+	proto.RegisterType((*CircuitBreakerStatus)(nil), "gctrpc.CircuitBreakerStatus")
 	proto.RegisterType((*GetTickerRequest)(nil), "gctrpc.GetTickerRequest")
 	proto.RegisterType((*CurrencyPair)(nil), "gctrpc.CurrencyPair")
 	proto.RegisterType((*TickerResponse)(nil), "gctrpc.TickerResponse")
@@ -7176,8 +10333,53 @@ func init() {
 	proto.RegisterType((*GetExchangeOrderbookStreamRequest)(nil), "gctrpc.GetExchangeOrderbookStreamRequest")
 	proto.RegisterType((*GetTickerStreamRequest)(nil), "gctrpc.GetTickerStreamRequest")
 	proto.RegisterType((*GetExchangeTickerStreamRequest)(nil), "gctrpc.GetExchangeTickerStreamRequest")
+	proto.RegisterType((*GetCandleStreamRequest)(nil), "gctrpc.GetCandleStreamRequest")
+	proto.RegisterType((*GetExchangeCandleStreamRequest)(nil), "gctrpc.GetExchangeCandleStreamRequest")
+	proto.RegisterType((*CandleStreamResponse)(nil), "gctrpc.CandleStreamResponse")
+	proto.RegisterType((*GetRecentTradesRequest)(nil), "gctrpc.GetRecentTradesRequest")
+	proto.RegisterType((*GetTradeStreamRequest)(nil), "gctrpc.GetTradeStreamRequest")
+	proto.RegisterType((*TradeResponse)(nil), "gctrpc.TradeResponse")
+	proto.RegisterType((*RecentTradesResponse)(nil), "gctrpc.RecentTradesResponse")
+	proto.RegisterType((*GetHistoricTradesRequest)(nil), "gctrpc.GetHistoricTradesRequest")
+	proto.RegisterType((*GetHistoricTradesResponse)(nil), "gctrpc.GetHistoricTradesResponse")
+	proto.RegisterType((*GetDerivativeInfoRequest)(nil), "gctrpc.GetDerivativeInfoRequest")
+	proto.RegisterType((*GetDerivativeInfoStreamRequest)(nil), "gctrpc.GetDerivativeInfoStreamRequest")
+	proto.RegisterType((*GetExchangeDerivativeInfoStreamRequest)(nil), "gctrpc.GetExchangeDerivativeInfoStreamRequest")
+	proto.RegisterType((*DerivativeInfoResponse)(nil), "gctrpc.DerivativeInfoResponse")
+	proto.RegisterType((*GetLiquidationsRequest)(nil), "gctrpc.GetLiquidationsRequest")
+	proto.RegisterType((*LiquidationResponse)(nil), "gctrpc.LiquidationResponse")
+	proto.RegisterType((*GetLiquidationsResponse)(nil), "gctrpc.GetLiquidationsResponse")
 	proto.RegisterType((*GetAuditEventRequest)(nil), "gctrpc.GetAuditEventRequest")
 	proto.RegisterType((*GetAuditEventResponse)(nil), "gctrpc.GetAuditEventResponse")
+	proto.RegisterType((*GetRequestJournalRequest)(nil), "gctrpc.GetRequestJournalRequest")
+	proto.RegisterType((*RequestJournalEntry)(nil), "gctrpc.RequestJournalEntry")
+	proto.RegisterType((*GetRequestJournalResponse)(nil), "gctrpc.GetRequestJournalResponse")
+	proto.RegisterType((*VerifyAuditEventsRequest)(nil), "gctrpc.VerifyAuditEventsRequest")
+	proto.RegisterType((*AuditChainBreak)(nil), "gctrpc.AuditChainBreak")
+	proto.RegisterType((*VerifyAuditEventsResponse)(nil), "gctrpc.VerifyAuditEventsResponse")
+	proto.RegisterType((*GetPortfolioHistoryRequest)(nil), "gctrpc.GetPortfolioHistoryRequest")
+	proto.RegisterType((*BalanceSnapshot)(nil), "gctrpc.BalanceSnapshot")
+	proto.RegisterType((*GetPortfolioHistoryResponse)(nil), "gctrpc.GetPortfolioHistoryResponse")
+	proto.RegisterType((*GetEquityCurveRequest)(nil), "gctrpc.GetEquityCurveRequest")
+	proto.RegisterType((*EquityPoint)(nil), "gctrpc.EquityPoint")
+	proto.RegisterType((*GetEquityCurveResponse)(nil), "gctrpc.GetEquityCurveResponse")
+	proto.RegisterType((*GetFillsRequest)(nil), "gctrpc.GetFillsRequest")
+	proto.RegisterType((*Fill)(nil), "gctrpc.Fill")
+	proto.RegisterType((*GetFillsResponse)(nil), "gctrpc.GetFillsResponse")
+	proto.RegisterType((*ReconcileFillsRequest)(nil), "gctrpc.ReconcileFillsRequest")
+	proto.RegisterType((*ReconciledOrder)(nil), "gctrpc.ReconciledOrder")
+	proto.RegisterType((*GetFundingHistoryRequest)(nil), "gctrpc.GetFundingHistoryRequest")
+	proto.RegisterType((*FundingTransfer)(nil), "gctrpc.FundingTransfer")
+	proto.RegisterType((*GetFundingHistoryResponse)(nil), "gctrpc.GetFundingHistoryResponse")
+	proto.RegisterType((*DatabaseMigration)(nil), "gctrpc.DatabaseMigration")
+	proto.RegisterType((*GetDatabaseStatusRequest)(nil), "gctrpc.GetDatabaseStatusRequest")
+	proto.RegisterType((*GetDatabaseStatusResponse)(nil), "gctrpc.GetDatabaseStatusResponse")
+	proto.RegisterType((*MigrateDatabaseRequest)(nil), "gctrpc.MigrateDatabaseRequest")
+	proto.RegisterType((*MigrateDatabaseResponse)(nil), "gctrpc.MigrateDatabaseResponse")
+	proto.RegisterType((*GetTickerHistoryRequest)(nil), "gctrpc.GetTickerHistoryRequest")
+	proto.RegisterType((*TickerHistoryItem)(nil), "gctrpc.TickerHistoryItem")
+	proto.RegisterType((*GetTickerHistoryResponse)(nil), "gctrpc.GetTickerHistoryResponse")
+	proto.RegisterType((*ReconcileFillsResponse)(nil), "gctrpc.ReconcileFillsResponse")
 	proto.RegisterType((*GetHistoricCandlesRequest)(nil), "gctrpc.GetHistoricCandlesRequest")
 	proto.RegisterType((*GetHistoricCandlesResponse)(nil), "gctrpc.GetHistoricCandlesResponse")
 	proto.RegisterType((*Candle)(nil), "gctrpc.Candle")
@@ -7215,409 +10417,539 @@ func init() {
 }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 6423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7c, 0x4d, 0x8c, 0x1c, 0xc7,
-	0x75, 0x30, 0x7a, 0x66, 0xf6, 0x67, 0xde, 0xfe, 0xb2, 0xf6, 0x6f, 0x38, 0x5c, 0x72, 0xc9, 0x92,
-	0x45, 0x91, 0xfa, 0x59, 0x4a, 0x94, 0x64, 0xcb, 0xf2, 0xdf, 0xb7, 0x5c, 0x4a, 0x6b, 0xda, 0xb4,
-	0x44, 0xf7, 0x2e, 0x25, 0x40, 0xfe, 0xa0, 0x49, 0xcf, 0x74, 0xed, 0x6e, 0x9b, 0x3d, 0xdd, 0xa3,
-	0xee, 0x9e, 0x5d, 0xae, 0x8d, 0xc0, 0x86, 0x91, 0x04, 0x01, 0x1c, 0x24, 0x08, 0x0c, 0xc3, 0x09,
-	0x90, 0x53, 0x4e, 0x41, 0x2e, 0x06, 0x82, 0x1c, 0x82, 0x1c, 0x8c, 0x5c, 0x83, 0x00, 0xb9, 0x04,
-	0x08, 0x7c, 0xc9, 0x29, 0x41, 0x0e, 0x41, 0x92, 0x43, 0x80, 0x5c, 0x72, 0x0a, 0xea, 0xd5, 0x4f,
-	0x57, 0x75, 0xf7, 0xcc, 0xce, 0xca, 0xb4, 0x72, 0x21, 0xa7, 0x5f, 0xbd, 0x7a, 0xef, 0xd5, 0xab,
-	0x57, 0xaf, 0x5e, 0xbd, 0x7a, 0xb5, 0xd0, 0x4c, 0x06, 0xbd, 0xed, 0x41, 0x12, 0x67, 0x31, 0x99,
-	0x3e, 0xea, 0x65, 0xc9, 0xa0, 0xd7, 0xde, 0x3c, 0x8a, 0xe3, 0xa3, 0x90, 0xdd, 0xf1, 0x06, 0xc1,
-	0x1d, 0x2f, 0x8a, 0xe2, 0xcc, 0xcb, 0x82, 0x38, 0x4a, 0x05, 0x56, 0x7b, 0x4b, 0xb6, 0xe2, 0x57,
-	0x77, 0x78, 0x78, 0x27, 0x0b, 0xfa, 0x2c, 0xcd, 0xbc, 0xfe, 0x40, 0x20, 0xd0, 0x65, 0x58, 0xdc,
-	0x63, 0xd9, 0x83, 0xe8, 0x30, 0x76, 0xd9, 0x27, 0x43, 0x96, 0x66, 0xf4, 0x2f, 0x1b, 0xb0, 0xa4,
-	0x41, 0xe9, 0x20, 0x8e, 0x52, 0x46, 0xd6, 0x61, 0x7a, 0x38, 0xe0, 0x5d, 0x5b, 0xce, 0x75, 0xe7,
-	0x56, 0xd3, 0x95, 0x5f, 0xe4, 0x0e, 0xac, 0x78, 0x27, 0x5e, 0x10, 0x7a, 0xdd, 0x90, 0x75, 0xd8,
-	0xd3, 0xde, 0xb1, 0x17, 0x1d, 0xb1, 0xb4, 0x55, 0xbb, 0xee, 0xdc, 0xaa, 0xbb, 0x44, 0x37, 0xbd,
-	0xa3, 0x5a, 0xc8, 0x4b, 0x70, 0x89, 0x45, 0x1c, 0xe4, 0x1b, 0xe8, 0x75, 0x44, 0x5f, 0x96, 0x0d,
-	0x39, 0xf2, 0x1b, 0xb0, 0xee, 0xb3, 0x43, 0x6f, 0x18, 0x66, 0x9d, 0xc3, 0x38, 0x61, 0x4f, 0x3b,
-	0x83, 0x24, 0x3e, 0x09, 0x7c, 0x96, 0xb4, 0x1a, 0x28, 0xc5, 0xaa, 0x6c, 0x7d, 0x97, 0x37, 0x3e,
-	0x92, 0x6d, 0xe4, 0x2e, 0xac, 0xe9, 0x5e, 0x81, 0x97, 0x75, 0x7a, 0xc3, 0x24, 0x61, 0x51, 0xef,
-	0xac, 0x35, 0x85, 0x9d, 0x56, 0x54, 0xa7, 0xc0, 0xcb, 0x76, 0x65, 0x13, 0xf9, 0x10, 0x96, 0xd3,
-	0x61, 0x37, 0x3d, 0x4b, 0x33, 0xd6, 0xef, 0xa4, 0x99, 0x97, 0x0d, 0xd3, 0xd6, 0xf4, 0xf5, 0xfa,
-	0xad, 0xb9, 0xbb, 0x2f, 0x6f, 0x0b, 0x3d, 0x6f, 0x17, 0x54, 0xb2, 0xbd, 0xaf, 0xf0, 0xf7, 0x11,
-	0xfd, 0x9d, 0x28, 0x4b, 0xce, 0xdc, 0xa5, 0xd4, 0x86, 0x92, 0xf7, 0x60, 0x21, 0x19, 0xf4, 0x3a,
-	0x2c, 0xf2, 0x07, 0x71, 0x10, 0x65, 0x69, 0x6b, 0x06, 0xa9, 0xde, 0x1e, 0x45, 0xd5, 0x1d, 0xf4,
-	0xde, 0x51, 0xb8, 0x82, 0xe4, 0x7c, 0x62, 0x80, 0xda, 0xf7, 0x60, 0xb5, 0x8a, 0x31, 0x59, 0x86,
-	0xfa, 0x13, 0x76, 0x26, 0x67, 0x87, 0xff, 0x24, 0xab, 0x30, 0x75, 0xe2, 0x85, 0x43, 0x86, 0x93,
-	0x31, 0xeb, 0x8a, 0x8f, 0xb7, 0x6b, 0x6f, 0x39, 0xed, 0x03, 0xb8, 0x54, 0x62, 0x53, 0x41, 0xe0,
-	0xb6, 0x49, 0x60, 0xee, 0xee, 0x8a, 0x12, 0xd9, 0x7d, 0xb4, 0xab, 0xfa, 0x1a, 0x54, 0xe9, 0x0d,
-	0xd8, 0xda, 0x63, 0xd9, 0x6e, 0xdc, 0xef, 0x0f, 0xa3, 0xa0, 0x87, 0x46, 0xe8, 0xb2, 0xd0, 0x3b,
-	0x63, 0x49, 0xaa, 0x2c, 0xeb, 0x3d, 0x58, 0xad, 0x6a, 0x27, 0x2d, 0x98, 0x91, 0x73, 0x8f, 0xfc,
-	0x67, 0x5d, 0xf5, 0x49, 0x36, 0xa1, 0xd9, 0x8b, 0xa3, 0x88, 0xf5, 0x32, 0xe6, 0xcb, 0x81, 0xe4,
-	0x00, 0xfa, 0x3b, 0x35, 0xb8, 0x3e, 0x9a, 0xa7, 0x34, 0xdd, 0xef, 0xc1, 0x7a, 0xcf, 0x44, 0xe8,
-	0x24, 0x12, 0xa3, 0xe5, 0xe0, 0x54, 0xec, 0x1a, 0x53, 0x31, 0x96, 0xd2, 0x76, 0x65, 0xab, 0x98,
-	0xa4, 0xb5, 0x5e, 0x55, 0x5b, 0xfb, 0x10, 0xda, 0xa3, 0x3b, 0x55, 0xa8, 0xfc, 0xae, 0xad, 0xf2,
-	0x4d, 0x25, 0x5a, 0x15, 0x11, 0x53, 0xf7, 0x5f, 0x80, 0x8d, 0x3d, 0x16, 0xb1, 0x24, 0xe8, 0x69,
-	0xe3, 0x90, 0x3a, 0xe7, 0x1a, 0xd4, 0x36, 0x29, 0x59, 0xe5, 0x00, 0xba, 0x0e, 0xab, 0x7b, 0x2c,
-	0xd3, 0x9d, 0xf4, 0x4c, 0xfd, 0xc2, 0x81, 0x35, 0x6c, 0x48, 0xbb, 0xe9, 0x99, 0x68, 0x90, 0xea,
-	0xfc, 0x0d, 0xb8, 0xa4, 0xbb, 0xa7, 0x6a, 0xa9, 0x08, 0x4d, 0xbe, 0x6e, 0x68, 0xb2, 0xdc, 0x33,
-	0x5f, 0x30, 0xa9, 0xb9, 0x62, 0xf2, 0x75, 0x27, 0xc1, 0xed, 0x5d, 0x58, 0xab, 0x44, 0xbd, 0x88,
-	0x8d, 0xd3, 0x16, 0xac, 0xef, 0xb1, 0xcc, 0x30, 0x55, 0xc3, 0x08, 0xe7, 0x0c, 0x30, 0xb7, 0xbd,
-	0x34, 0xf3, 0x92, 0x2c, 0xb7, 0x3d, 0xf9, 0x49, 0x9e, 0x87, 0xc5, 0x30, 0x48, 0x33, 0x16, 0x75,
-	0x3c, 0xdf, 0x4f, 0x58, 0x2a, 0xdc, 0x5a, 0xd3, 0x5d, 0x10, 0xd0, 0x1d, 0x01, 0xa4, 0x7f, 0xed,
-	0x70, 0xe5, 0x17, 0x58, 0x49, 0x65, 0x3d, 0x84, 0x66, 0xbe, 0xf2, 0x85, 0x92, 0xb6, 0x0d, 0x25,
-	0x55, 0xf5, 0xd9, 0x2e, 0x2c, 0xff, 0x9c, 0x40, 0xfb, 0xdb, 0xb0, 0xf8, 0xac, 0x17, 0xed, 0x5b,
-	0xd0, 0x96, 0x86, 0xa3, 0xbc, 0xee, 0x7b, 0x5e, 0x9f, 0x29, 0xdb, 0x69, 0xc3, 0xac, 0x72, 0xd2,
-	0x92, 0x87, 0xfe, 0xa6, 0x77, 0x60, 0x65, 0x8f, 0x65, 0xda, 0x57, 0xab, 0x2e, 0x23, 0x97, 0x32,
-	0x7d, 0x03, 0x4d, 0xcd, 0xe8, 0x20, 0x75, 0xb4, 0x09, 0xcd, 0x7c, 0x27, 0x90, 0x06, 0xaa, 0x01,
-	0xf4, 0x2e, 0xda, 0xa1, 0xea, 0xf5, 0xfe, 0xc1, 0x23, 0x97, 0x89, 0x6e, 0x97, 0x61, 0x36, 0xce,
-	0x06, 0x9d, 0x5e, 0xec, 0x2b, 0xd9, 0x66, 0xe2, 0x6c, 0xb0, 0x1b, 0xfb, 0x4c, 0xce, 0xbd, 0xd1,
-	0x47, 0xcf, 0xfd, 0x9f, 0x8a, 0xb9, 0xb2, 0x9b, 0xa4, 0x1c, 0xdf, 0x80, 0xa6, 0x22, 0xa8, 0xe6,
-	0xea, 0x15, 0x63, 0xae, 0xaa, 0xfa, 0x6c, 0xbf, 0x2f, 0x38, 0xca, 0xa9, 0x9a, 0x95, 0x02, 0xa4,
-	0xed, 0x2f, 0xc1, 0x82, 0xd5, 0x74, 0x9e, 0xe9, 0x36, 0xcd, 0x39, 0x79, 0x03, 0xd6, 0xef, 0x07,
-	0xa9, 0xb9, 0x6d, 0x4e, 0x32, 0x1f, 0x1f, 0xc3, 0xe2, 0x23, 0x2f, 0x48, 0xd2, 0xfd, 0xe1, 0x60,
-	0x10, 0xa3, 0xfd, 0xbe, 0x00, 0x4b, 0xf9, 0xde, 0x3c, 0xe0, 0x6d, 0xb2, 0xd3, 0xa2, 0x06, 0x63,
-	0x0f, 0xf2, 0x1c, 0x2c, 0xa8, 0x3d, 0x59, 0xa0, 0x09, 0x91, 0xe6, 0x25, 0x10, 0x91, 0xe8, 0x8f,
-	0x1a, 0x96, 0xea, 0xac, 0xe8, 0x80, 0x40, 0x23, 0xf2, 0x74, 0x6c, 0x80, 0xbf, 0x4d, 0x43, 0xa8,
-	0xd9, 0x3e, 0xbd, 0x05, 0x33, 0x27, 0x2c, 0xe9, 0xc6, 0x29, 0xc3, 0x8d, 0x7f, 0xd6, 0x55, 0x9f,
-	0x5c, 0x90, 0x61, 0x1a, 0x44, 0x47, 0x9d, 0xd4, 0x8b, 0xfc, 0x6e, 0xfc, 0x14, 0xb7, 0xf9, 0x59,
-	0x77, 0x1e, 0x81, 0xfb, 0x02, 0x46, 0x6e, 0xc0, 0xfc, 0x71, 0x96, 0x0d, 0x3a, 0x3c, 0xfe, 0x88,
-	0x87, 0x99, 0xdc, 0xd5, 0xe7, 0x38, 0xec, 0x40, 0x80, 0xf8, 0xca, 0x45, 0x94, 0x61, 0xca, 0x12,
-	0xef, 0x88, 0x45, 0x59, 0x6b, 0x5a, 0xac, 0x5c, 0x0e, 0x7d, 0xac, 0x80, 0xe4, 0x2a, 0x00, 0xa2,
-	0x0d, 0x92, 0xf8, 0xe9, 0x59, 0x6b, 0x46, 0x98, 0x1e, 0x87, 0x3c, 0xe2, 0x00, 0xae, 0xbf, 0xae,
-	0x97, 0x32, 0x15, 0x3f, 0x04, 0x2c, 0x6d, 0xcd, 0x0a, 0xfd, 0x71, 0xf0, 0xae, 0x86, 0x92, 0x0e,
-	0x0f, 0x1e, 0xa4, 0xd6, 0x3b, 0x5e, 0x9a, 0xb2, 0x2c, 0x6d, 0x35, 0xd1, 0x80, 0xde, 0xa8, 0x30,
-	0xa0, 0x42, 0x10, 0x21, 0xfb, 0xed, 0x60, 0x37, 0x1d, 0x44, 0x58, 0x50, 0x1e, 0x34, 0x79, 0xc3,
-	0xec, 0x98, 0x45, 0x19, 0xdf, 0x02, 0x38, 0x93, 0x41, 0xd0, 0x02, 0xd4, 0xcd, 0xb2, 0xd5, 0xb0,
-	0x33, 0x08, 0xda, 0x1f, 0xf1, 0x08, 0xa1, 0x4c, 0xb5, 0xc2, 0x04, 0x5f, 0xb6, 0x7d, 0xc5, 0xba,
-	0x12, 0xd6, 0xb6, 0x23, 0xd3, 0x34, 0x4f, 0x61, 0x79, 0x8f, 0x65, 0x07, 0x41, 0xef, 0x09, 0x4b,
-	0x26, 0x30, 0x4a, 0x72, 0x0b, 0x1a, 0xdc, 0xa2, 0x24, 0x83, 0x55, 0xbd, 0x9d, 0xc9, 0xb0, 0x8b,
-	0x33, 0x72, 0x11, 0x83, 0xcf, 0x05, 0x6a, 0xae, 0x93, 0x9d, 0x0d, 0x84, 0x5d, 0x34, 0xdd, 0x26,
-	0x42, 0x0e, 0xce, 0x06, 0x8c, 0x7e, 0x00, 0xf3, 0x66, 0x27, 0xee, 0x34, 0x7c, 0x16, 0x06, 0xfd,
-	0x20, 0x63, 0x89, 0x72, 0x1a, 0x1a, 0xc0, 0xed, 0x91, 0x4f, 0x91, 0xb4, 0x63, 0xfc, 0xcd, 0xd7,
-	0xdb, 0x27, 0xc3, 0x38, 0x53, 0xb4, 0xc5, 0x07, 0xfd, 0x69, 0x0d, 0x16, 0xd5, 0x70, 0xa4, 0x31,
-	0x2b, 0x99, 0x9d, 0x73, 0x65, 0xbe, 0x01, 0xf3, 0xa1, 0x97, 0x66, 0x9d, 0xe1, 0xc0, 0xf7, 0x54,
-	0x7c, 0x52, 0x77, 0xe7, 0x38, 0xec, 0xb1, 0x00, 0x71, 0x8b, 0x56, 0xe1, 0x27, 0xae, 0x2d, 0xc9,
-	0x7d, 0xbe, 0x67, 0x0e, 0x86, 0x40, 0x83, 0xf7, 0x41, 0x6b, 0x77, 0x5c, 0xfc, 0xcd, 0x61, 0xc7,
-	0xc1, 0xd1, 0x31, 0x5a, 0xb7, 0xe3, 0xe2, 0x6f, 0x3e, 0x83, 0x61, 0x7c, 0x8a, 0xb6, 0xec, 0xb8,
-	0xfc, 0x27, 0x87, 0x74, 0x03, 0x1f, 0x4d, 0xd7, 0x71, 0xf9, 0x4f, 0x0e, 0xf1, 0xd2, 0x27, 0x68,
-	0xa8, 0x8e, 0xcb, 0x7f, 0xf2, 0xd0, 0xfd, 0x24, 0x0e, 0x87, 0x7d, 0xd6, 0x6a, 0x22, 0x50, 0x7e,
-	0x91, 0x2b, 0xd0, 0x1c, 0x24, 0x41, 0x8f, 0x75, 0xbc, 0xec, 0x18, 0x8d, 0xc9, 0x71, 0x67, 0x11,
-	0xb0, 0x93, 0x1d, 0xd3, 0x15, 0xb8, 0xa4, 0x27, 0x5a, 0x7b, 0xcf, 0x0f, 0x61, 0x46, 0x42, 0xc6,
-	0x4e, 0xfa, 0xab, 0x30, 0x93, 0x09, 0xb4, 0x56, 0x0d, 0x57, 0x81, 0x36, 0x2c, 0x5b, 0xd3, 0xae,
-	0x42, 0xa3, 0x5f, 0x03, 0x62, 0x72, 0x93, 0x13, 0x71, 0x3b, 0xa7, 0x23, 0xdc, 0xf1, 0x92, 0x4d,
-	0x27, 0xcd, 0x09, 0x7c, 0x0f, 0x37, 0xa3, 0xf7, 0x13, 0x9f, 0x3b, 0x92, 0xf8, 0xc9, 0x67, 0x6a,
-	0x9a, 0xdf, 0x82, 0x05, 0xcd, 0xf8, 0x41, 0xc6, 0xfa, 0x5c, 0xe1, 0x5e, 0x3f, 0x1e, 0x46, 0x19,
-	0xf2, 0x74, 0x5c, 0xf9, 0xc5, 0x2d, 0x10, 0xf5, 0x8b, 0x2c, 0x1d, 0x57, 0x7c, 0x90, 0x45, 0xa8,
-	0x05, 0xbe, 0x3c, 0x01, 0xd5, 0x02, 0x9f, 0xfe, 0x8f, 0x03, 0x97, 0x8c, 0x81, 0x5c, 0xd8, 0x28,
-	0x4b, 0x16, 0x57, 0xab, 0xb0, 0xb8, 0xdb, 0xd0, 0xe8, 0x06, 0x3e, 0x3f, 0x78, 0x71, 0xbd, 0xae,
-	0x29, 0x72, 0xd6, 0x38, 0x5c, 0x44, 0xe1, 0xa8, 0x5e, 0xfa, 0x24, 0x6d, 0x35, 0xc6, 0xa2, 0x72,
-	0x94, 0xd2, 0x7a, 0x98, 0x2a, 0xaf, 0x07, 0x5b, 0x97, 0xd3, 0x45, 0x5d, 0x8a, 0x70, 0x54, 0xd3,
-	0xd6, 0x96, 0xd7, 0x03, 0xc8, 0x81, 0x63, 0xa7, 0xf5, 0x8b, 0x00, 0xb1, 0xc6, 0x94, 0xf6, 0x77,
-	0xb9, 0x24, 0xb4, 0x36, 0x41, 0x03, 0x99, 0x7e, 0x13, 0x43, 0x0d, 0x93, 0xb9, 0x54, 0xfe, 0x5d,
-	0x8b, 0xa6, 0xb0, 0x45, 0x52, 0xa2, 0x99, 0x5a, 0xc4, 0x5e, 0x47, 0x62, 0x3b, 0xbd, 0x1e, 0x9f,
-	0x7a, 0xe3, 0x74, 0x3d, 0x76, 0x0f, 0xff, 0x00, 0x66, 0x64, 0x0f, 0x69, 0x16, 0x02, 0xa1, 0x16,
-	0xf8, 0xe4, 0x4b, 0x00, 0xc6, 0x3e, 0x24, 0xc6, 0x75, 0x45, 0xc9, 0x20, 0x3b, 0x29, 0x6b, 0x40,
-	0x76, 0x06, 0x3a, 0x3d, 0x84, 0x95, 0x0a, 0x14, 0x2e, 0x8a, 0x3e, 0x1b, 0x4b, 0x51, 0xd4, 0x37,
-	0xd9, 0x82, 0xb9, 0x2c, 0xce, 0xbc, 0xb0, 0x93, 0xef, 0x10, 0x8e, 0x0b, 0x08, 0xfa, 0x80, 0x43,
-	0xd0, 0x41, 0xc5, 0xa1, 0xb0, 0x5c, 0xee, 0xa0, 0xe2, 0xd0, 0xa7, 0x1e, 0x06, 0x5e, 0xd6, 0xa0,
-	0xa5, 0x0a, 0xc7, 0x4d, 0xd9, 0x4b, 0x30, 0xeb, 0x89, 0x2e, 0x6a, 0x60, 0x4b, 0x85, 0x81, 0xb9,
-	0x1a, 0x81, 0x12, 0xdc, 0x81, 0x76, 0xe3, 0xe8, 0x30, 0x38, 0x52, 0xd6, 0xf1, 0x02, 0x3a, 0x2b,
-	0x05, 0xcb, 0x63, 0x12, 0xdf, 0xcb, 0x3c, 0xe4, 0x36, 0xef, 0xe2, 0x6f, 0xfa, 0xdb, 0x0e, 0x2c,
-	0x3f, 0x8a, 0x93, 0xec, 0x30, 0x0e, 0x83, 0x58, 0xc6, 0xef, 0x3c, 0x1c, 0x51, 0xf1, 0xbd, 0x8c,
-	0x23, 0xe5, 0x27, 0xf7, 0x90, 0xbd, 0x38, 0x88, 0x84, 0xad, 0xd6, 0xa4, 0x82, 0xe2, 0x20, 0xe2,
-	0xa6, 0x4a, 0xae, 0xc3, 0x9c, 0xcf, 0xd2, 0x5e, 0x12, 0x0c, 0xf8, 0x99, 0x4c, 0xba, 0x05, 0x13,
-	0xc4, 0x09, 0x77, 0xbd, 0xd0, 0x8b, 0x7a, 0x4c, 0x7a, 0x76, 0xf5, 0x49, 0xd7, 0xd0, 0x5d, 0x69,
-	0x49, 0x8c, 0xe3, 0xb1, 0x0d, 0x96, 0x43, 0xf9, 0x3c, 0x34, 0x07, 0x0a, 0x28, 0xcd, 0xaf, 0xa5,
-	0xf7, 0xea, 0xc2, 0x70, 0xdc, 0x1c, 0x95, 0x6e, 0xf2, 0xe0, 0x3e, 0xa7, 0xb7, 0x3f, 0xec, 0xf7,
-	0xbd, 0xe4, 0x4c, 0x71, 0x8b, 0xa0, 0xb1, 0x1b, 0x07, 0x11, 0x57, 0x14, 0x1f, 0x94, 0x0a, 0xde,
-	0xf8, 0x6f, 0x53, 0xf4, 0x9a, 0x25, 0xba, 0xa9, 0xad, 0xba, 0xad, 0xad, 0x6b, 0x00, 0x03, 0x96,
-	0xf4, 0x58, 0x94, 0x79, 0x47, 0x6a, 0xc4, 0x06, 0x84, 0x1e, 0x03, 0x79, 0xff, 0xf0, 0x30, 0x0c,
-	0x22, 0xc6, 0xd9, 0x4a, 0x61, 0xc6, 0x68, 0x7f, 0xb4, 0x0c, 0x36, 0xa7, 0x7a, 0x89, 0xd3, 0xb7,
-	0xe0, 0xd2, 0xfb, 0x51, 0x05, 0x23, 0x45, 0xce, 0x19, 0x47, 0xae, 0x56, 0x22, 0xf7, 0x75, 0x98,
-	0x37, 0x04, 0x4f, 0xc9, 0x5b, 0xd0, 0x94, 0x32, 0xea, 0x83, 0x42, 0x5b, 0x7b, 0x83, 0xd2, 0x08,
-	0xdd, 0x1c, 0x99, 0xfe, 0x91, 0x03, 0x73, 0xb9, 0x64, 0x29, 0x79, 0x03, 0xa6, 0xb8, 0xba, 0x15,
-	0x95, 0x6b, 0x9a, 0x4a, 0x8e, 0xb3, 0x8d, 0xff, 0x8a, 0xb8, 0x50, 0x20, 0xb7, 0xf7, 0x01, 0x72,
-	0x60, 0x45, 0x58, 0x77, 0xc7, 0x0e, 0xeb, 0x2e, 0x97, 0xa9, 0x2a, 0xd1, 0x8c, 0xc8, 0xee, 0xef,
-	0x1a, 0x70, 0xa5, 0xd2, 0x58, 0xa4, 0x0d, 0xbe, 0x02, 0x73, 0x62, 0x2d, 0x70, 0x0f, 0xa0, 0x04,
-	0x9e, 0xcf, 0xf3, 0x13, 0x41, 0xe4, 0x02, 0xae, 0x0d, 0x6c, 0x27, 0xaf, 0xc1, 0x02, 0x0a, 0xdb,
-	0x89, 0x85, 0x42, 0xe4, 0xc2, 0xb6, 0x3b, 0xcc, 0x23, 0x8a, 0x54, 0x19, 0x19, 0xc0, 0x9a, 0xd5,
-	0xa5, 0x93, 0x0a, 0x11, 0xe4, 0x26, 0xf5, 0x65, 0x23, 0x94, 0x1e, 0x25, 0xa5, 0x50, 0x96, 0x24,
-	0x28, 0xdb, 0x84, 0xea, 0x56, 0x7a, 0xe5, 0x16, 0x72, 0x07, 0xe6, 0x25, 0x47, 0xd4, 0x8c, 0xdc,
-	0xe2, 0x6c, 0x19, 0xe7, 0x44, 0x47, 0x44, 0x20, 0x7d, 0x58, 0x35, 0x3b, 0x68, 0x09, 0xa7, 0xb0,
-	0xe3, 0x97, 0x26, 0x97, 0x30, 0x2a, 0x09, 0x48, 0x7a, 0xa5, 0x86, 0xf6, 0xff, 0x87, 0xd6, 0xa8,
-	0x01, 0x55, 0x4c, 0xfb, 0x8b, 0xf6, 0xb4, 0xaf, 0x56, 0x98, 0x64, 0x6a, 0x66, 0x01, 0x3f, 0x82,
-	0x8d, 0x11, 0xc2, 0x5c, 0x20, 0xad, 0x60, 0x58, 0xaa, 0x69, 0x4d, 0xff, 0xec, 0x40, 0x7b, 0xc7,
-	0xf7, 0x4b, 0xce, 0x29, 0x4f, 0x12, 0x7c, 0xc6, 0x2e, 0x97, 0xdc, 0x81, 0x95, 0xfc, 0x8c, 0x96,
-	0xe7, 0x1b, 0xc4, 0xe1, 0x91, 0xe8, 0xa6, 0x3c, 0xf7, 0x7c, 0x83, 0x1b, 0x47, 0xe8, 0x77, 0xd2,
-	0x2c, 0xe6, 0xc7, 0x45, 0x8c, 0x55, 0x66, 0xb9, 0x39, 0x84, 0xfe, 0xbe, 0x00, 0xd1, 0xa7, 0x70,
-	0xd5, 0x65, 0xfd, 0xf8, 0x84, 0x7d, 0xd6, 0xe3, 0xa4, 0x6d, 0x68, 0xed, 0x31, 0x3b, 0xed, 0xad,
-	0x63, 0xa5, 0xff, 0x70, 0x60, 0xc1, 0x4e, 0x88, 0x3f, 0xab, 0xe3, 0xf9, 0xcb, 0x40, 0x12, 0x96,
-	0x66, 0x9d, 0x41, 0x1c, 0x86, 0xfc, 0x94, 0xee, 0xb3, 0xd0, 0x3b, 0x93, 0xa9, 0xf8, 0x65, 0xde,
-	0xf2, 0x48, 0x34, 0xdc, 0xe7, 0x70, 0xb2, 0x01, 0x33, 0xde, 0x20, 0xe8, 0x70, 0x43, 0x12, 0x5a,
-	0x9e, 0xf6, 0x06, 0xc1, 0x37, 0xd9, 0x19, 0xa1, 0xb0, 0x20, 0x1b, 0x3a, 0x21, 0x3b, 0x61, 0x21,
-	0xaa, 0xb6, 0xee, 0xce, 0x89, 0xe6, 0x87, 0x1c, 0x44, 0x6e, 0xc3, 0xf2, 0x20, 0x09, 0xb8, 0x45,
-	0xe6, 0x39, 0xff, 0x19, 0x94, 0x66, 0x49, 0xc2, 0xd5, 0xe8, 0xe8, 0x77, 0xe0, 0x72, 0x85, 0x2e,
-	0xa4, 0xdb, 0xfa, 0x2a, 0x2c, 0xd9, 0x37, 0x07, 0xca, 0x75, 0xe9, 0x40, 0xd6, 0xea, 0xe8, 0x2e,
-	0x1e, 0x5a, 0x74, 0x64, 0x40, 0x8a, 0x38, 0xae, 0x97, 0xe9, 0x34, 0x17, 0xfd, 0x04, 0x56, 0x73,
-	0xe0, 0x6e, 0x1c, 0x9d, 0xb0, 0x24, 0xe5, 0x06, 0x48, 0xa0, 0x71, 0x98, 0xc4, 0x2a, 0xd1, 0x8a,
-	0xbf, 0x79, 0x28, 0x97, 0xc5, 0x72, 0x92, 0x6b, 0x59, 0xcc, 0x71, 0x12, 0x2f, 0x53, 0x1b, 0x17,
-	0xfe, 0xe6, 0xd6, 0x16, 0x20, 0x11, 0xd6, 0xc1, 0x36, 0x61, 0xbd, 0x73, 0x12, 0xc6, 0xb9, 0xd0,
-	0x0f, 0x30, 0xa2, 0x34, 0x45, 0x91, 0x63, 0xfc, 0x0a, 0xcc, 0x89, 0x31, 0xf2, 0x9e, 0x6a, 0x7c,
-	0x9b, 0xd6, 0xf8, 0x0a, 0x62, 0xba, 0x70, 0xa8, 0xa1, 0xf4, 0xe7, 0x75, 0x98, 0xc7, 0x20, 0xf6,
-	0x3e, 0xcb, 0xbc, 0x20, 0x1c, 0x1f, 0x5e, 0x8b, 0xb0, 0xb4, 0xa6, 0xc3, 0xd2, 0xe7, 0x60, 0xc1,
-	0xcc, 0x91, 0x9c, 0xa9, 0xf3, 0xad, 0x91, 0x21, 0x39, 0x23, 0xcf, 0xc3, 0x22, 0x9e, 0xb6, 0x73,
-	0x2c, 0x61, 0x33, 0x0b, 0x08, 0xd5, 0x68, 0xf6, 0xd9, 0x60, 0xaa, 0x70, 0x36, 0xe0, 0xcd, 0x18,
-	0x5f, 0x77, 0xd2, 0xc0, 0xd7, 0x47, 0x07, 0x84, 0xec, 0x07, 0xbe, 0xd1, 0x8c, 0xbd, 0x67, 0x8c,
-	0x66, 0xec, 0xcd, 0x8f, 0x45, 0x09, 0x13, 0x17, 0x00, 0x78, 0x8f, 0x35, 0x8b, 0x46, 0x37, 0xaf,
-	0x80, 0x07, 0x41, 0x1f, 0x6f, 0xb9, 0x64, 0x42, 0xbb, 0x29, 0x2c, 0x56, 0x7c, 0xe5, 0x27, 0x37,
-	0x30, 0x4f, 0x6e, 0xf9, 0x39, 0x6f, 0xce, 0x3a, 0xe7, 0x6d, 0xc1, 0x5c, 0x3c, 0x60, 0x51, 0x47,
-	0x9e, 0xba, 0xe7, 0x45, 0x40, 0xc1, 0x41, 0x1f, 0x88, 0x93, 0xf7, 0x32, 0xd4, 0x0f, 0x19, 0x6b,
-	0x2d, 0x88, 0x33, 0xfa, 0x21, 0xe3, 0x2b, 0x6b, 0x3a, 0x4b, 0x3c, 0x9f, 0xa5, 0xad, 0x45, 0x9c,
-	0x3d, 0xed, 0xbc, 0x0f, 0x38, 0xf4, 0xeb, 0x01, 0x77, 0x42, 0x67, 0xae, 0xc4, 0xa1, 0xff, 0xe4,
-	0xc0, 0xbc, 0xd9, 0x50, 0x1e, 0x9c, 0x53, 0x31, 0xb8, 0xe2, 0xd4, 0xe9, 0x41, 0xd5, 0xab, 0x07,
-	0xd5, 0xb0, 0x06, 0x65, 0x1a, 0xc5, 0x54, 0xc1, 0x28, 0xc6, 0x1f, 0xea, 0x0a, 0x13, 0x37, 0x53,
-	0x9c, 0x38, 0xa9, 0x8d, 0x59, 0xad, 0x0d, 0x99, 0x65, 0x42, 0x9b, 0x4c, 0x27, 0x39, 0xca, 0xdb,
-	0xfc, 0x6b, 0x45, 0xfe, 0xea, 0xec, 0x5c, 0x3f, 0xef, 0xec, 0x4c, 0x77, 0xf0, 0x20, 0xa1, 0x18,
-	0xcb, 0xe5, 0xf5, 0x32, 0x4c, 0xa3, 0xb0, 0x6a, 0x65, 0xad, 0x5a, 0x27, 0x3f, 0xb9, 0x68, 0x5c,
-	0x89, 0x43, 0xbf, 0x8e, 0x77, 0xa7, 0xd8, 0x34, 0x89, 0xe8, 0x97, 0x61, 0x56, 0xe8, 0x46, 0x4f,
-	0xcd, 0x0c, 0x7e, 0x3f, 0xf0, 0xe9, 0x2f, 0x1d, 0x20, 0xfb, 0xc3, 0x6e, 0x3f, 0x98, 0x9c, 0xda,
-	0xe4, 0x39, 0x0d, 0x02, 0x0d, 0x9c, 0x0d, 0xb1, 0x5c, 0xf1, 0x77, 0x61, 0x05, 0x35, 0x8a, 0x2b,
-	0x28, 0xb7, 0x8c, 0xa9, 0xea, 0xb4, 0xc6, 0xb4, 0x69, 0x47, 0x7c, 0x83, 0x0b, 0x03, 0x16, 0x65,
-	0x1d, 0x99, 0x9f, 0xe2, 0x1b, 0x1c, 0x02, 0x1e, 0xf8, 0x74, 0x1f, 0x56, 0xac, 0x91, 0x49, 0x4d,
-	0xdf, 0x80, 0x79, 0x21, 0xc0, 0x20, 0xf4, 0x7a, 0xfa, 0x02, 0x61, 0x0e, 0x61, 0x8f, 0x10, 0x34,
-	0x4e, 0x5f, 0xbf, 0xeb, 0xc0, 0xea, 0x7e, 0xd0, 0x1f, 0x86, 0x5e, 0xc6, 0x7e, 0x0d, 0x1a, 0xcb,
-	0x87, 0x5f, 0xb7, 0x86, 0xaf, 0x34, 0xd9, 0xc8, 0x35, 0x49, 0xff, 0xcb, 0x81, 0xb5, 0x82, 0x28,
-	0x3a, 0x8c, 0xb6, 0x8d, 0x69, 0x44, 0x3e, 0x45, 0x22, 0x19, 0x4c, 0x6b, 0x16, 0xd3, 0xe7, 0x60,
-	0xa1, 0x1f, 0x44, 0x41, 0x7f, 0xd8, 0xef, 0x98, 0x6b, 0x78, 0x5e, 0x02, 0x1f, 0xe1, 0x14, 0x70,
-	0x24, 0xef, 0xa9, 0x81, 0xd4, 0x90, 0x48, 0x02, 0x28, 0x90, 0x5e, 0x85, 0xd5, 0xfc, 0xa8, 0xd3,
-	0x39, 0xf2, 0x82, 0xa8, 0x13, 0xc6, 0x69, 0x2a, 0xe7, 0x98, 0xe4, 0x6d, 0x7b, 0x5e, 0x10, 0x3d,
-	0x8c, 0xd3, 0xd4, 0x70, 0x92, 0xd3, 0xa6, 0x93, 0xa4, 0x7f, 0xe0, 0xc0, 0xf2, 0x87, 0xc7, 0x5e,
-	0xc8, 0xee, 0xc5, 0xfd, 0xee, 0xb3, 0xd5, 0xfd, 0x0d, 0x98, 0x17, 0xa9, 0xca, 0xcc, 0x4b, 0x8e,
-	0x98, 0x9a, 0x81, 0x39, 0x84, 0x1d, 0x20, 0xa8, 0x72, 0x1a, 0xfe, 0xd3, 0x01, 0xb2, 0xcb, 0xa3,
-	0xbf, 0x70, 0x62, 0x7b, 0xe0, 0xae, 0x44, 0xa4, 0x1a, 0x72, 0x0b, 0x6b, 0x4a, 0xc8, 0x03, 0xdb,
-	0xfc, 0xea, 0x96, 0xf9, 0xe9, 0xd1, 0x34, 0x2e, 0x98, 0x4f, 0x2c, 0xed, 0x73, 0xcf, 0xc3, 0xe2,
-	0xa9, 0x17, 0x86, 0x2c, 0xd3, 0xd7, 0x8e, 0xf2, 0xf2, 0x42, 0x40, 0x55, 0xda, 0x42, 0x0d, 0x78,
-	0xc6, 0x18, 0xf0, 0x1b, 0xb0, 0x2e, 0xc6, 0xbb, 0x13, 0x86, 0x13, 0xbb, 0x4f, 0xfa, 0x27, 0x35,
-	0xd8, 0x28, 0x75, 0xd3, 0xf1, 0x93, 0x6d, 0xaf, 0x37, 0xf5, 0xb8, 0xaa, 0x3b, 0x6c, 0xcb, 0x4f,
-	0xd9, 0xab, 0xfd, 0x37, 0x0e, 0x4c, 0x0b, 0xd0, 0x58, 0xb5, 0x7f, 0xa4, 0x56, 0xbe, 0xb4, 0x2c,
-	0x71, 0x5a, 0xfc, 0xc2, 0x64, 0xcc, 0xc4, 0x7f, 0xe6, 0x9d, 0xb2, 0x70, 0x19, 0xf2, 0x3a, 0xf9,
-	0xab, 0xb0, 0x5c, 0x44, 0xb8, 0xd0, 0x75, 0x9c, 0xc8, 0x38, 0xbd, 0x73, 0xc2, 0x8c, 0x3b, 0xe4,
-	0x5f, 0x38, 0xb0, 0xb4, 0x1b, 0x47, 0x7e, 0xc0, 0x77, 0xd7, 0x47, 0x5e, 0xe2, 0xf5, 0x53, 0x59,
-	0xaa, 0x20, 0x40, 0xea, 0x4a, 0x42, 0x03, 0x46, 0x24, 0x7f, 0xaf, 0x02, 0xf4, 0x8e, 0x59, 0xef,
-	0x49, 0x47, 0x66, 0x63, 0x45, 0x7d, 0x03, 0x87, 0xdc, 0x0b, 0xfc, 0x94, 0xbc, 0x02, 0x2b, 0x79,
-	0x73, 0xc7, 0x8b, 0xfc, 0x8e, 0x4c, 0xc5, 0xe2, 0xcd, 0x8f, 0xc6, 0xdb, 0x89, 0xfc, 0x9d, 0xf4,
-	0x49, 0xca, 0x83, 0x66, 0x9d, 0x81, 0xec, 0x58, 0xbe, 0x7a, 0x49, 0xc3, 0x77, 0x10, 0x4c, 0xff,
-	0xdb, 0xc1, 0xad, 0x4e, 0x8d, 0x4a, 0xce, 0x76, 0x9e, 0x74, 0xc4, 0x5c, 0xb4, 0x35, 0x65, 0xb5,
-	0xc2, 0x94, 0x11, 0x68, 0x04, 0x19, 0xeb, 0xab, 0x1d, 0x84, 0xff, 0x26, 0xf7, 0x60, 0x59, 0x8f,
-	0xb8, 0x33, 0x40, 0xb5, 0xc8, 0xf5, 0xb0, 0x91, 0x1f, 0xaa, 0x2d, 0xad, 0xb9, 0x4b, 0xbd, 0x82,
-	0x1a, 0xd5, 0x3a, 0x9a, 0x9a, 0xc8, 0x23, 0xf7, 0x50, 0xdb, 0xd2, 0x11, 0x89, 0x2f, 0x21, 0x35,
-	0xeb, 0x0d, 0x33, 0xe6, 0xcb, 0x33, 0x83, 0xfe, 0xa6, 0xff, 0xea, 0xc0, 0xd2, 0x8e, 0xef, 0xe3,
-	0xb8, 0x27, 0xf1, 0x07, 0x6a, 0x94, 0xb5, 0x73, 0x46, 0x59, 0xff, 0x94, 0xa3, 0xfc, 0x95, 0xbd,
-	0xc5, 0x08, 0x25, 0x50, 0x0a, 0xcb, 0xf9, 0x38, 0xab, 0xa7, 0x97, 0x7e, 0x0e, 0x88, 0x38, 0xbf,
-	0x5a, 0xea, 0x28, 0x62, 0xbd, 0x0b, 0xb7, 0xf6, 0x58, 0xb6, 0x9b, 0x9c, 0x0d, 0xb2, 0x58, 0x05,
-	0xf0, 0xf7, 0xd9, 0x20, 0x4e, 0x03, 0xe5, 0x8b, 0xd8, 0x44, 0x6e, 0xe6, 0x6f, 0x1d, 0xb8, 0x3d,
-	0x01, 0x21, 0x29, 0xeb, 0xc7, 0xe5, 0x24, 0xdb, 0xff, 0x33, 0x0b, 0x75, 0x26, 0xa2, 0xb2, 0xad,
-	0x21, 0xb2, 0x96, 0x42, 0x93, 0x6c, 0x7f, 0x19, 0x16, 0xed, 0xc6, 0x0b, 0xf9, 0x84, 0x10, 0x6e,
-	0x9e, 0x23, 0xc4, 0x24, 0xc6, 0x75, 0x13, 0x16, 0x7b, 0x16, 0x09, 0xc9, 0xa8, 0x00, 0xa5, 0xbb,
-	0xf0, 0xc2, 0xb9, 0xdc, 0xa4, 0xda, 0x46, 0x66, 0x1c, 0xe8, 0xcf, 0x1d, 0x58, 0xf9, 0x30, 0xc8,
-	0x8e, 0xfd, 0xc4, 0x3b, 0x7d, 0x37, 0xf0, 0x26, 0xb2, 0x7e, 0xf3, 0x82, 0xa0, 0x56, 0xb8, 0x20,
-	0x18, 0x15, 0x0f, 0x15, 0x92, 0x17, 0x8d, 0x72, 0x92, 0xe6, 0x26, 0x2c, 0x75, 0xbd, 0xe8, 0x49,
-	0xc7, 0xd8, 0x68, 0x85, 0x59, 0x2f, 0x70, 0xb0, 0xba, 0x3d, 0xf0, 0xe9, 0x3f, 0x3a, 0xb0, 0xa6,
-	0x24, 0x16, 0x83, 0x9f, 0x44, 0x66, 0x43, 0x03, 0x35, 0x3b, 0xe7, 0xb2, 0x05, 0x73, 0xf2, 0x67,
-	0x27, 0xf3, 0x8e, 0xa4, 0xe3, 0x02, 0x09, 0x3a, 0xf0, 0x8e, 0xac, 0xe1, 0x36, 0x46, 0x0e, 0xd7,
-	0x8e, 0x7e, 0xe5, 0xe9, 0x65, 0x3a, 0x3f, 0xcb, 0x15, 0x14, 0x30, 0x53, 0xce, 0xde, 0xbc, 0x0d,
-	0xcb, 0x6a, 0x5c, 0x15, 0x6b, 0x53, 0x9c, 0xce, 0xf2, 0x28, 0xab, 0x66, 0x45, 0x59, 0x2f, 0x43,
-	0x5b, 0xf5, 0xf5, 0x42, 0x5c, 0xb7, 0xf7, 0xce, 0x1e, 0xdc, 0x2f, 0xaf, 0x5d, 0xa4, 0x42, 0x0f,
-	0xe0, 0x4a, 0x25, 0xb6, 0x64, 0xfa, 0x26, 0x4c, 0x31, 0x0e, 0x94, 0x21, 0xd8, 0x96, 0x5a, 0x60,
-	0x85, 0x3e, 0xfa, 0xb6, 0x4c, 0x60, 0x53, 0x06, 0x37, 0x0a, 0x18, 0xe9, 0xbd, 0xb3, 0x0b, 0xd4,
-	0xaa, 0x54, 0x1d, 0x45, 0xf1, 0xea, 0x1e, 0xe7, 0x64, 0xca, 0x15, 0x1f, 0xf4, 0x0c, 0xae, 0x96,
-	0xd9, 0xdc, 0xf7, 0xb2, 0x89, 0x58, 0xac, 0xc2, 0x14, 0xd6, 0x71, 0xa9, 0xb5, 0x8b, 0x1f, 0x7c,
-	0xb6, 0x58, 0xa4, 0x42, 0x37, 0xfe, 0x33, 0x67, 0xdd, 0x30, 0x59, 0x7f, 0x07, 0xe8, 0xb8, 0x11,
-	0x96, 0xd5, 0x57, 0xbf, 0x80, 0xfa, 0x7e, 0x5a, 0x83, 0x8d, 0x11, 0x28, 0x25, 0xcd, 0xbc, 0x6d,
-	0x0c, 0x51, 0xec, 0x31, 0xd7, 0x8a, 0x5c, 0x42, 0x25, 0x97, 0xa0, 0x94, 0xab, 0xe0, 0x2d, 0x98,
-	0x49, 0x84, 0xa6, 0xe4, 0x36, 0x73, 0xad, 0x2c, 0xa0, 0x54, 0xa5, 0xe8, 0xaa, 0xd0, 0xc9, 0x17,
-	0x01, 0x30, 0x75, 0xc0, 0xfc, 0x8e, 0x97, 0xc9, 0x9d, 0xb8, 0xbd, 0x2d, 0x2a, 0x89, 0xb7, 0x55,
-	0x25, 0xf1, 0xf6, 0x81, 0xaa, 0x24, 0x76, 0x9b, 0x12, 0x7b, 0x07, 0xbb, 0xca, 0xeb, 0x5f, 0xde,
-	0x75, 0xfa, 0xfc, 0xae, 0x12, 0x7b, 0x27, 0xa3, 0x07, 0xb0, 0x5e, 0x3d, 0xa6, 0xca, 0x04, 0x66,
-	0x51, 0x53, 0xf9, 0x82, 0xa9, 0x5b, 0x0b, 0xe6, 0xdf, 0x9c, 0x9c, 0xac, 0x3d, 0xde, 0xb1, 0xee,
-	0xed, 0xfc, 0x5c, 0xf3, 0xa8, 0x4c, 0x09, 0x81, 0x86, 0xde, 0xaa, 0xa7, 0x5c, 0xfc, 0x4d, 0xee,
-	0x40, 0xe3, 0x30, 0xd0, 0xfa, 0xd0, 0xf7, 0xb6, 0xdc, 0x0f, 0x17, 0x2d, 0x01, 0x11, 0xc9, 0x9b,
-	0x30, 0x2d, 0x36, 0x01, 0xf4, 0x1f, 0x73, 0x77, 0xaf, 0xea, 0x08, 0x01, 0xa1, 0xc5, 0x4e, 0x12,
-	0x99, 0xfe, 0x95, 0x03, 0x2b, 0x15, 0x44, 0xf9, 0x69, 0x1c, 0x5d, 0xae, 0xa1, 0xc5, 0x59, 0x0e,
-	0x78, 0x8f, 0x6b, 0xf2, 0x06, 0xcc, 0x2b, 0x57, 0x8c, 0xed, 0x42, 0x15, 0x73, 0x12, 0x86, 0x28,
-	0xcf, 0xc3, 0xa2, 0x46, 0x19, 0xf6, 0xbb, 0x4c, 0xd5, 0xb1, 0x2c, 0x28, 0x24, 0x04, 0x62, 0x39,
-	0x4a, 0xda, 0x95, 0xbe, 0x93, 0xff, 0xc4, 0x65, 0x78, 0x1a, 0x1c, 0xaa, 0x2a, 0x2d, 0xf1, 0x81,
-	0x51, 0x55, 0xd7, 0x53, 0x21, 0x0b, 0xfe, 0xa6, 0x3e, 0xac, 0x55, 0x8e, 0x6d, 0x4c, 0x12, 0xbd,
-	0xe0, 0xd0, 0x6b, 0x25, 0x87, 0x2e, 0x9d, 0x73, 0x3d, 0x4f, 0x2d, 0xbd, 0x86, 0x45, 0x6c, 0x0f,
-	0xe3, 0xa3, 0xa3, 0x3c, 0x75, 0x23, 0x8d, 0x7e, 0x1d, 0xa6, 0x43, 0x84, 0xab, 0x12, 0x77, 0xf1,
-	0x45, 0x23, 0xcc, 0xb5, 0x17, 0xba, 0xe4, 0x97, 0xcc, 0x41, 0x74, 0x18, 0xcb, 0x4c, 0x05, 0xfe,
-	0xe6, 0x43, 0xf6, 0x59, 0x77, 0x78, 0xa4, 0x6a, 0x52, 0xf1, 0x83, 0x63, 0x9e, 0x7a, 0x49, 0x24,
-	0x63, 0x7c, 0xfc, 0xcd, 0x31, 0x59, 0x92, 0xc4, 0x89, 0x0c, 0xe8, 0xc5, 0x07, 0xdd, 0x83, 0x8d,
-	0xfd, 0x8b, 0x89, 0x88, 0x4e, 0x0c, 0x33, 0xe9, 0xd2, 0xd9, 0xe1, 0x07, 0xfd, 0xa6, 0x55, 0xb0,
-	0x87, 0x45, 0x5d, 0x13, 0x7a, 0x4e, 0x0c, 0x2f, 0x15, 0x31, 0xfc, 0xa0, 0xbf, 0x74, 0x50, 0x0d,
-	0x05, 0x6a, 0xba, 0x26, 0xb8, 0x5c, 0x00, 0x27, 0x62, 0xb6, 0x37, 0x2b, 0x0a, 0xe0, 0xac, 0xbe,
-	0x93, 0x55, 0xc0, 0xfd, 0x5a, 0x8b, 0xda, 0x7e, 0xe6, 0xc0, 0xfa, 0xbe, 0x2d, 0xde, 0x33, 0xc8,
-	0x3a, 0xbe, 0x08, 0x53, 0xa2, 0x98, 0xb2, 0x6e, 0x67, 0x0d, 0xad, 0x10, 0x5f, 0xa0, 0xf0, 0x79,
-	0x15, 0xb7, 0x2f, 0xd2, 0x12, 0xe4, 0x17, 0xfd, 0xa1, 0x83, 0x77, 0x1b, 0x3a, 0x37, 0xb4, 0x9f,
-	0x25, 0xcc, 0xeb, 0x7f, 0xa6, 0xd5, 0x4d, 0x5f, 0x83, 0x1b, 0x66, 0xf1, 0xeb, 0x85, 0x25, 0xa1,
-	0xbf, 0x89, 0x35, 0x21, 0xa2, 0x62, 0xeb, 0xff, 0x40, 0xfe, 0x2f, 0xc3, 0x35, 0x43, 0xfe, 0x0b,
-	0x8a, 0x41, 0xff, 0xd8, 0xc1, 0xfb, 0x9f, 0x9d, 0xa1, 0x1f, 0x64, 0xd6, 0x21, 0xe9, 0x2a, 0x00,
-	0x46, 0x14, 0x1d, 0xbe, 0x79, 0xe9, 0xb2, 0x7a, 0x0e, 0xe1, 0x01, 0x0a, 0xb9, 0x0c, 0xb3, 0x2c,
-	0xf2, 0x45, 0xa3, 0x8c, 0x42, 0x59, 0xe4, 0xab, 0x26, 0x91, 0xea, 0xe8, 0x9e, 0x59, 0x29, 0xa4,
-	0x7b, 0x67, 0xd5, 0xb1, 0x08, 0x37, 0x8e, 0xf8, 0xf0, 0x90, 0x2f, 0x48, 0xb1, 0xa3, 0xc8, 0x2f,
-	0xba, 0x2b, 0x2a, 0x8c, 0x0c, 0xd1, 0xe4, 0x6a, 0x7c, 0x11, 0xa6, 0x31, 0xd0, 0x28, 0x95, 0x2a,
-	0x19, 0xb8, 0x12, 0x83, 0xfe, 0xbb, 0xb0, 0x30, 0x71, 0x91, 0x10, 0xf4, 0x76, 0xbd, 0xc8, 0x0f,
-	0x27, 0x3a, 0xce, 0x3d, 0xb3, 0x19, 0xca, 0x23, 0xb5, 0x06, 0x1e, 0x39, 0xed, 0x48, 0x4d, 0x94,
-	0x90, 0x61, 0xa4, 0xf6, 0x1c, 0x2c, 0x64, 0x41, 0x9f, 0x75, 0x82, 0x28, 0x63, 0xc9, 0x89, 0xa7,
-	0xae, 0x0d, 0xe7, 0x39, 0xf0, 0x81, 0x84, 0x71, 0x5e, 0xec, 0x69, 0x47, 0x85, 0x3d, 0xe2, 0xf4,
-	0xdf, 0x64, 0x4f, 0x55, 0xde, 0xe6, 0xef, 0x1d, 0x2c, 0x89, 0x29, 0x0d, 0x77, 0x82, 0x2a, 0xa5,
-	0xc9, 0xc7, 0xab, 0x07, 0x54, 0xaf, 0x18, 0x50, 0x23, 0x1f, 0x50, 0x1b, 0x66, 0xad, 0xb1, 0x34,
-	0x5d, 0xfd, 0x4d, 0x6e, 0xc2, 0x74, 0x0f, 0x85, 0x93, 0xb5, 0x05, 0x8b, 0x46, 0x2a, 0xcc, 0x0f,
-	0x99, 0x2b, 0x5b, 0xe9, 0x6f, 0x39, 0x30, 0x2d, 0x40, 0x18, 0x77, 0xe4, 0x77, 0x3f, 0xf8, 0x5b,
-	0x55, 0x8c, 0xd6, 0xf2, 0x8a, 0x51, 0x55, 0x57, 0x5a, 0x37, 0xea, 0x4a, 0x09, 0x34, 0xe2, 0x01,
-	0x8b, 0x54, 0xfd, 0x29, 0xff, 0xcd, 0x07, 0xd1, 0x0b, 0xe3, 0x94, 0xc9, 0xe3, 0x8e, 0xf8, 0x30,
-	0x6a, 0x49, 0xa7, 0xcd, 0x5a, 0x52, 0xfa, 0x14, 0x20, 0x37, 0x2e, 0x1d, 0x01, 0xc9, 0x70, 0x0d,
-	0x23, 0xa0, 0x6b, 0x00, 0x81, 0xcf, 0xa2, 0x2c, 0x38, 0x0c, 0x98, 0xaa, 0x49, 0x34, 0x20, 0x7c,
-	0x97, 0xef, 0xb3, 0x34, 0x55, 0x05, 0x3d, 0x4d, 0x57, 0x7d, 0x92, 0x4d, 0x68, 0xea, 0x37, 0x6b,
-	0xea, 0x56, 0x42, 0x03, 0x68, 0x17, 0x9a, 0x7b, 0xbb, 0x07, 0xfb, 0x18, 0x95, 0x71, 0xc6, 0x8f,
-	0x1f, 0x3f, 0xb8, 0xaf, 0x18, 0xf3, 0xdf, 0x3a, 0x76, 0xac, 0x19, 0xb1, 0x23, 0xe1, 0x73, 0x99,
-	0x1d, 0xab, 0xdc, 0x15, 0xff, 0xcd, 0xd7, 0x65, 0xc4, 0x9e, 0x66, 0x9d, 0x64, 0xa8, 0x0e, 0xad,
-	0x33, 0xfc, 0xdb, 0x1d, 0x46, 0xf4, 0x3e, 0x6c, 0x68, 0x1e, 0xef, 0x88, 0x4c, 0x92, 0x5a, 0x21,
-	0xb7, 0x61, 0x5a, 0x44, 0x84, 0xb2, 0x32, 0xf3, 0x92, 0xde, 0xef, 0x54, 0x07, 0x57, 0x22, 0xd0,
-	0x1d, 0x58, 0xd5, 0xc0, 0xfd, 0x2c, 0x1e, 0x7c, 0x0a, 0x12, 0x97, 0x0d, 0x41, 0x38, 0x89, 0x9d,
-	0x50, 0x05, 0xb4, 0xf8, 0xe6, 0x21, 0x6f, 0xe2, 0x91, 0xaf, 0x6a, 0x31, 0x3b, 0x3d, 0x0c, 0xd2,
-	0xcc, 0xe8, 0xf4, 0x67, 0x8e, 0xd1, 0xeb, 0xf1, 0x20, 0x8c, 0x3d, 0x5f, 0x49, 0xb5, 0x05, 0x73,
-	0x82, 0xa9, 0x19, 0x33, 0x82, 0x00, 0x61, 0x48, 0x98, 0x23, 0x60, 0x99, 0x5d, 0xcd, 0x44, 0xb8,
-	0xef, 0x65, 0x9e, 0x2e, 0xc0, 0xab, 0xe7, 0x05, 0x78, 0xdc, 0xe4, 0xbd, 0xa4, 0x77, 0x1c, 0x9c,
-	0x30, 0x5f, 0x6e, 0x75, 0xfa, 0x9b, 0xcf, 0x73, 0x7c, 0xc2, 0x92, 0xd3, 0x24, 0xc8, 0x84, 0xd5,
-	0xcd, 0xba, 0x39, 0x80, 0xee, 0x41, 0x3b, 0xd7, 0x07, 0xf3, 0x7c, 0xf5, 0xeb, 0xc2, 0x3a, 0xbc,
-	0x07, 0x6b, 0x1a, 0xf8, 0xed, 0x21, 0xd3, 0xf5, 0x70, 0x17, 0xa1, 0xf1, 0x0d, 0x68, 0x69, 0xe0,
-	0xce, 0x30, 0x8b, 0x1f, 0x1a, 0x8a, 0x5b, 0xb7, 0xc8, 0x34, 0x55, 0x9f, 0xc2, 0x81, 0x7e, 0x56,
-	0x9f, 0x4f, 0x3e, 0xb6, 0xe6, 0x54, 0x4c, 0x5c, 0xfe, 0xe8, 0x52, 0xbf, 0xaf, 0x32, 0xaf, 0xa3,
-	0x5f, 0x82, 0x19, 0x41, 0x54, 0x25, 0xca, 0x2b, 0x44, 0x55, 0x18, 0x34, 0x36, 0xa6, 0x58, 0x8e,
-	0xf7, 0x1c, 0xf2, 0xb9, 0x22, 0x6a, 0xe7, 0x28, 0xc2, 0x9a, 0xe3, 0xa6, 0x2c, 0xb2, 0xfc, 0x0a,
-	0x2c, 0xc9, 0x27, 0x45, 0xe7, 0x72, 0x52, 0xdd, 0x6b, 0x46, 0xf7, 0x1e, 0x86, 0xbf, 0x6a, 0xc3,
-	0xc6, 0x58, 0xef, 0x53, 0x47, 0xad, 0x46, 0x60, 0x55, 0xb7, 0x02, 0xab, 0x47, 0xd0, 0x36, 0x99,
-	0x84, 0xe1, 0xc4, 0xd1, 0x71, 0x4e, 0xb1, 0x66, 0x51, 0xdc, 0x81, 0xe7, 0x44, 0x8d, 0xb3, 0x22,
-	0xaa, 0x43, 0xcd, 0x49, 0x49, 0xd3, 0xcf, 0x5b, 0x11, 0xb6, 0x88, 0x72, 0x27, 0xe9, 0xf7, 0x3a,
-	0x6e, 0xe1, 0xc5, 0x7e, 0xb9, 0xea, 0x75, 0x40, 0x2e, 0xf2, 0xc3, 0xf8, 0x45, 0xdf, 0x84, 0x8d,
-	0x0f, 0x59, 0x37, 0x8d, 0x7b, 0x4f, 0x58, 0x66, 0xbf, 0xff, 0x1d, 0xcb, 0xeb, 0x27, 0x35, 0x68,
-	0x95, 0xfb, 0x4d, 0xb0, 0x7d, 0xe2, 0x33, 0x44, 0xa9, 0x11, 0xf5, 0x90, 0x53, 0x03, 0xcc, 0x6a,
-	0xa4, 0xba, 0x5d, 0x8d, 0xf4, 0x05, 0xd8, 0xb0, 0x9f, 0xbe, 0xe4, 0x54, 0x84, 0x03, 0x59, 0xb7,
-	0x9a, 0xf3, 0xd7, 0x4f, 0x9f, 0x83, 0x05, 0xab, 0x45, 0xba, 0x14, 0x1b, 0xc8, 0xbd, 0x58, 0x32,
-	0x8c, 0xa2, 0x20, 0x3a, 0xea, 0x0c, 0x13, 0xb5, 0x0d, 0x83, 0x04, 0x3d, 0x4e, 0x42, 0x1e, 0x75,
-	0xe0, 0xf3, 0x20, 0x7d, 0x19, 0x27, 0xf2, 0x79, 0xf3, 0x08, 0x54, 0x4f, 0x00, 0x1f, 0x41, 0x5b,
-	0x2b, 0x85, 0xdb, 0x95, 0x90, 0xfd, 0x57, 0x31, 0xa7, 0xaf, 0xc2, 0x75, 0x53, 0xcd, 0xfb, 0xc3,
-	0xae, 0x4e, 0x3c, 0x4c, 0x64, 0x13, 0xdf, 0x87, 0xb5, 0x5c, 0x22, 0xa3, 0x33, 0xd7, 0x34, 0x47,
-	0x89, 0x58, 0xa8, 0x4e, 0xd3, 0xf2, 0x73, 0x6c, 0x36, 0x44, 0xaf, 0xae, 0x7a, 0x61, 0x75, 0x19,
-	0x97, 0x3c, 0x4d, 0x57, 0x7e, 0xf1, 0xa0, 0xe4, 0xc6, 0x18, 0xe9, 0x27, 0xb0, 0x96, 0x5d, 0x58,
-	0x48, 0xcd, 0x4e, 0xd2, 0xcf, 0xe9, 0x2c, 0x48, 0xe5, 0xd8, 0x5c, 0xbb, 0x0f, 0x7d, 0x68, 0x98,
-	0xea, 0x3e, 0xcb, 0xf0, 0x51, 0xd7, 0x84, 0xae, 0x44, 0xbc, 0x08, 0x93, 0xae, 0x04, 0x3f, 0xe8,
-	0xbb, 0xb0, 0x6e, 0x52, 0x7b, 0xec, 0x3e, 0x9c, 0x84, 0xd6, 0x32, 0xd4, 0xb9, 0x5d, 0x09, 0x4a,
-	0xfc, 0xe7, 0xdd, 0x1f, 0x7f, 0x0d, 0x16, 0xf7, 0x62, 0x91, 0xea, 0xc0, 0x22, 0x9e, 0x84, 0xbc,
-	0x0f, 0x33, 0x72, 0x29, 0x91, 0xf5, 0xd2, 0xbb, 0x70, 0xe4, 0xd1, 0xde, 0x18, 0xf1, 0x5e, 0x9c,
-	0xae, 0xfc, 0xe8, 0x1f, 0xfe, 0xe5, 0x27, 0xb5, 0x05, 0x32, 0x77, 0xe7, 0xe4, 0xb5, 0x3b, 0x47,
-	0x2c, 0xc3, 0x14, 0xc4, 0x11, 0x2c, 0x58, 0xaf, 0x7a, 0xc9, 0xa6, 0xf5, 0x32, 0xb7, 0xf0, 0xd8,
-	0xb7, 0x7d, 0x75, 0xec, 0xbb, 0x5d, 0x7a, 0x19, 0x59, 0xac, 0x90, 0x4b, 0x92, 0x45, 0xfe, 0x60,
-	0x97, 0x1c, 0xc3, 0x92, 0x30, 0x76, 0x4d, 0x94, 0x6c, 0xe5, 0xc4, 0x2a, 0x1f, 0x24, 0x9b, 0x43,
-	0xb1, 0x76, 0x09, 0x7a, 0x05, 0xf9, 0xac, 0x91, 0x15, 0xce, 0x47, 0xac, 0x03, 0xcd, 0x8a, 0x7c,
-	0x17, 0x96, 0xe5, 0xa3, 0xc8, 0x67, 0xc1, 0x6a, 0x13, 0x59, 0xad, 0x93, 0x55, 0xce, 0xca, 0x17,
-	0x74, 0x73, 0x5e, 0x31, 0xd6, 0xf0, 0x98, 0x8f, 0x73, 0xc9, 0xb5, 0x91, 0xaf, 0x76, 0x05, 0xa7,
-	0xad, 0x73, 0x5e, 0xf5, 0xda, 0x83, 0x3b, 0x62, 0x1c, 0x57, 0x3f, 0xec, 0x25, 0x3f, 0x11, 0xc9,
-	0x95, 0xca, 0xa7, 0xe2, 0xe4, 0x85, 0xf3, 0xdf, 0xa7, 0x0b, 0x19, 0x6e, 0x4d, 0xfa, 0x90, 0x9d,
-	0x7e, 0x0e, 0x85, 0xb9, 0x46, 0x36, 0xa5, 0x30, 0xd6, 0xe3, 0x75, 0xf5, 0x3c, 0x9e, 0xf4, 0x60,
-	0xde, 0x7c, 0xb0, 0x4b, 0xae, 0x54, 0xe4, 0x72, 0x34, 0xf3, 0xcd, 0xea, 0x46, 0xc9, 0xb0, 0x85,
-	0x0c, 0x09, 0x59, 0x96, 0x0c, 0x75, 0x01, 0x2e, 0x89, 0x60, 0xa9, 0xf0, 0xd8, 0x95, 0xd0, 0xc2,
-	0xac, 0x55, 0xbc, 0x4c, 0x1e, 0x3d, 0xb3, 0xd7, 0x90, 0x53, 0x8b, 0xae, 0x18, 0x33, 0xab, 0xb8,
-	0xbd, 0xed, 0xbc, 0x48, 0x52, 0x9c, 0x5b, 0xf3, 0x2d, 0xe6, 0x44, 0xfc, 0xb6, 0xce, 0x79, 0xc8,
-	0x59, 0x9a, 0x5f, 0xc5, 0x13, 0xd7, 0x63, 0x8a, 0xef, 0xdb, 0x8c, 0x17, 0xc4, 0xbb, 0xb1, 0x3f,
-	0xd9, 0x38, 0xaf, 0x56, 0xbf, 0x40, 0x96, 0x8f, 0xa0, 0x69, 0x1b, 0xb9, 0xae, 0x12, 0x52, 0xe0,
-	0x1a, 0x67, 0x03, 0x92, 0x5a, 0x0f, 0xb4, 0x25, 0x53, 0xdb, 0x92, 0x2b, 0x9e, 0x48, 0x57, 0x8e,
-	0xd4, 0x7c, 0xf3, 0x3c, 0x72, 0xa4, 0x71, 0x36, 0x48, 0x49, 0x08, 0x8b, 0xc2, 0x21, 0x3c, 0x9b,
-	0xd9, 0xbc, 0x8a, 0xbc, 0x36, 0x28, 0xc9, 0x5d, 0x82, 0x39, 0x99, 0x1f, 0x42, 0x53, 0xe7, 0x96,
-	0x48, 0xcb, 0x10, 0xdc, 0x7a, 0xa1, 0xda, 0x1e, 0xf1, 0xfe, 0x50, 0x59, 0x25, 0x5d, 0x90, 0x23,
-	0x11, 0xaf, 0x09, 0x39, 0xe1, 0xef, 0x00, 0xe4, 0x0f, 0x12, 0xc9, 0xe5, 0x12, 0x65, 0xad, 0xad,
-	0x76, 0x55, 0x93, 0x24, 0xbf, 0x8e, 0xe4, 0x97, 0xc9, 0xa2, 0x45, 0x5e, 0xad, 0x2b, 0x9d, 0x4a,
-	0xb3, 0xd6, 0x55, 0xf1, 0x09, 0x63, 0x7b, 0xf4, 0xdb, 0x35, 0x35, 0x11, 0x54, 0x2d, 0x2a, 0x5d,
-	0xe3, 0xc1, 0x47, 0x20, 0xb6, 0x00, 0xe3, 0xd1, 0xdc, 0x66, 0x15, 0x97, 0xca, 0x2d, 0xa0, 0xfc,
-	0x02, 0xae, 0xb4, 0x05, 0xe4, 0x0f, 0xdd, 0xc8, 0x13, 0xfc, 0xfb, 0x31, 0xc6, 0x9b, 0x2f, 0x62,
-	0xd2, 0x2a, 0x3f, 0x80, 0x6b, 0x5f, 0x1b, 0xd5, 0x9c, 0x56, 0xdb, 0xb4, 0xbc, 0x62, 0xc0, 0x85,
-	0x74, 0x26, 0xd2, 0x71, 0x79, 0x2f, 0x91, 0xca, 0xfb, 0x55, 0x59, 0x5e, 0x47, 0x96, 0x6d, 0xd2,
-	0x2a, 0xb3, 0x4c, 0x91, 0xc1, 0xab, 0x8e, 0xb4, 0x35, 0xf1, 0xc8, 0xcc, 0xb2, 0x35, 0xeb, 0x2d,
-	0x5a, 0xfb, 0x72, 0x45, 0x8b, 0xe4, 0xb2, 0x86, 0x5c, 0x96, 0xc8, 0x82, 0xf6, 0xba, 0x48, 0x4b,
-	0x98, 0x83, 0x7e, 0x42, 0x60, 0x99, 0x43, 0xf1, 0x89, 0x98, 0xe5, 0x66, 0x4b, 0x0f, 0xc5, 0x4a,
-	0x6e, 0x56, 0x3f, 0x05, 0x23, 0x3f, 0xb0, 0x5f, 0x9c, 0xa9, 0x17, 0x30, 0x74, 0xec, 0x93, 0x15,
-	0xc1, 0xf2, 0xb9, 0x09, 0x9e, 0xb5, 0xd0, 0x2d, 0xe4, 0x7c, 0x99, 0x6c, 0x14, 0x39, 0xcb, 0x27,
-	0x32, 0xe4, 0x04, 0x56, 0x2a, 0x1e, 0x84, 0xe4, 0x02, 0x8c, 0x7e, 0x2d, 0x32, 0xda, 0x3b, 0x50,
-	0x64, 0xba, 0x49, 0x91, 0xa9, 0xe7, 0xfb, 0x9a, 0xa9, 0x8c, 0xd5, 0xf9, 0x3a, 0xf8, 0x01, 0xac,
-	0x57, 0xbf, 0xd1, 0x20, 0xcf, 0xeb, 0x3f, 0x8d, 0x31, 0xee, 0x0d, 0xc7, 0x68, 0xee, 0xcf, 0x23,
-	0xf7, 0x2d, 0xda, 0xe6, 0xdc, 0x13, 0xa4, 0x51, 0x25, 0xc0, 0x29, 0x16, 0x5a, 0xd9, 0xcf, 0x13,
-	0xc8, 0x75, 0x43, 0xa7, 0x95, 0xaf, 0x38, 0xda, 0x37, 0xc6, 0x60, 0xd8, 0xce, 0x91, 0xac, 0x49,
-	0x9d, 0x63, 0x4d, 0xbf, 0x7e, 0xe7, 0x20, 0x3d, 0x40, 0x5e, 0xfe, 0x6f, 0x79, 0x80, 0xd2, 0x8b,
-	0x06, 0xcb, 0x03, 0x94, 0x1f, 0x19, 0x94, 0x3c, 0x00, 0x32, 0xc3, 0x07, 0x07, 0xe4, 0x23, 0x5c,
-	0x19, 0xb2, 0xca, 0xaf, 0x55, 0x74, 0x24, 0x69, 0xd5, 0xca, 0xb0, 0xeb, 0xf8, 0x4a, 0x8e, 0x58,
-	0x14, 0x0f, 0x72, 0xed, 0xb9, 0x30, 0xab, 0xd0, 0xc9, 0x46, 0x91, 0x80, 0xa2, 0x5c, 0x59, 0x91,
-	0x4d, 0x37, 0x90, 0xe8, 0x25, 0x3a, 0x6f, 0x12, 0xe5, 0x34, 0xbb, 0x30, 0x67, 0x54, 0x1f, 0x13,
-	0xed, 0xc2, 0xcb, 0xc5, 0xd6, 0xed, 0x2b, 0x95, 0x6d, 0xb6, 0xa3, 0xa2, 0x4b, 0x9c, 0x41, 0x8a,
-	0x08, 0x9a, 0xc7, 0x77, 0x61, 0xc1, 0x2a, 0x00, 0xce, 0x95, 0x5f, 0x55, 0xa2, 0x9c, 0x2b, 0xbf,
-	0xb2, 0x6a, 0x58, 0x85, 0xab, 0x14, 0x95, 0x9f, 0x4a, 0x14, 0xcd, 0xeb, 0x63, 0x68, 0xea, 0xba,
-	0xdb, 0x5c, 0xff, 0xc5, 0x52, 0xdc, 0xf3, 0x78, 0x58, 0x73, 0x70, 0xca, 0x3b, 0x77, 0xe3, 0x7e,
-	0x57, 0xd0, 0x9f, 0x33, 0xaa, 0x68, 0x73, 0x7d, 0x95, 0x4b, 0x6b, 0x47, 0x2f, 0x16, 0x4b, 0x57,
-	0x3d, 0xec, 0xa8, 0xe5, 0x4f, 0x60, 0xa9, 0x50, 0xe0, 0x99, 0x07, 0x29, 0xd5, 0xe5, 0xac, 0x79,
-	0x90, 0x32, 0xa2, 0x32, 0xd4, 0x0e, 0x03, 0x05, 0x3f, 0x2f, 0x0c, 0x73, 0xbb, 0x12, 0xde, 0x5c,
-	0x54, 0x76, 0x58, 0x36, 0x6b, 0xd5, 0x79, 0x5a, 0x36, 0x6b, 0xd7, 0x4a, 0x96, 0xbc, 0xb9, 0xb8,
-	0x50, 0x21, 0x1f, 0xc0, 0xac, 0xaa, 0xbb, 0xcb, 0x0d, 0xb6, 0x50, 0x71, 0xd8, 0x6e, 0x95, 0x1b,
-	0x24, 0x55, 0xcb, 0x68, 0x3d, 0xdf, 0x47, 0xaa, 0x72, 0x12, 0x8c, 0x5a, 0xbd, 0x7c, 0x12, 0xca,
-	0x05, 0x7c, 0x13, 0x4e, 0x82, 0xf0, 0x58, 0x9a, 0xfe, 0x5f, 0x38, 0x78, 0xd1, 0x37, 0xbe, 0xae,
-	0x8e, 0xbc, 0x7a, 0x81, 0x12, 0x3c, 0x21, 0xcc, 0x6b, 0x17, 0x2e, 0xda, 0xa3, 0xb7, 0x50, 0x4c,
-	0x4a, 0xaf, 0xaa, 0x7d, 0x12, 0xbb, 0xf9, 0x02, 0x5d, 0x57, 0xf0, 0x71, 0xa1, 0xff, 0xdc, 0x11,
-	0x7f, 0x73, 0x6c, 0x0c, 0x5d, 0xb2, 0x3d, 0xa1, 0x00, 0x4a, 0xe0, 0x3b, 0x13, 0xe3, 0x4b, 0x71,
-	0x6f, 0xa2, 0xb8, 0xd7, 0xe9, 0x95, 0x31, 0xe2, 0x72, 0x61, 0x43, 0xb8, 0x64, 0xd6, 0xdf, 0xbd,
-	0x3b, 0x8c, 0x7c, 0xe3, 0x4c, 0x55, 0x51, 0x9a, 0x97, 0x9b, 0x49, 0xb1, 0x5a, 0x4c, 0x05, 0x2c,
-	0x14, 0x5d, 0xff, 0xa9, 0x6c, 0x3d, 0x0c, 0xbc, 0xec, 0x90, 0x53, 0xe5, 0xdc, 0x7e, 0xec, 0xe4,
-	0xa5, 0x5f, 0xf6, 0x30, 0x04, 0xe3, 0xab, 0x45, 0xda, 0x56, 0x85, 0xdd, 0x18, 0xd6, 0xaf, 0x23,
-	0xeb, 0x57, 0xe8, 0x2d, 0x93, 0xb5, 0xfc, 0x4f, 0x0c, 0x1d, 0x65, 0xb0, 0xa5, 0xf9, 0x91, 0x51,
-	0x7c, 0x68, 0x14, 0xa2, 0xe5, 0xdb, 0xff, 0xe8, 0x9a, 0xb6, 0x3c, 0xfe, 0x18, 0x53, 0xc9, 0x66,
-	0x87, 0x02, 0xa7, 0x1a, 0x11, 0xcd, 0xbb, 0x7b, 0x16, 0xf8, 0x5c, 0x88, 0x9f, 0x39, 0xa5, 0xda,
-	0x39, 0xa3, 0xaa, 0x8b, 0xdc, 0x1e, 0xc1, 0xa7, 0x5c, 0xdb, 0xd6, 0x7e, 0x71, 0x12, 0xd4, 0x0b,
-	0x48, 0xf6, 0x87, 0x56, 0x8d, 0x92, 0x59, 0xea, 0x96, 0x47, 0x29, 0x63, 0x4b, 0xe1, 0x2e, 0x24,
-	0x91, 0x3c, 0xfd, 0xd3, 0xcb, 0x95, 0x12, 0xf9, 0x5e, 0x26, 0x0f, 0xca, 0xcb, 0xc5, 0xb2, 0x17,
-	0x33, 0xe1, 0x52, 0x59, 0xa0, 0xd2, 0xbe, 0x3e, 0x1a, 0xa1, 0x2a, 0xf3, 0x72, 0xc4, 0x32, 0x51,
-	0xc1, 0xe2, 0x4b, 0x06, 0x27, 0xb0, 0xbc, 0x3f, 0x92, 0xe9, 0xfe, 0xa7, 0x66, 0x2a, 0xa3, 0x53,
-	0x8a, 0x4c, 0xd3, 0x02, 0x53, 0x3e, 0xd8, 0x13, 0x51, 0xe3, 0x6f, 0x16, 0xa8, 0x90, 0xad, 0xd1,
-	0xa5, 0x2b, 0x65, 0xbe, 0x95, 0xb5, 0x2d, 0x36, 0x5f, 0xe3, 0xa8, 0x8c, 0x55, 0x1f, 0x22, 0x4c,
-	0x58, 0x2a, 0x54, 0x9e, 0xe4, 0x5b, 0x5f, 0x75, 0x49, 0xca, 0x84, 0x99, 0x8f, 0xd4, 0x66, 0xc6,
-	0x79, 0x65, 0x98, 0x84, 0x28, 0x54, 0x70, 0x90, 0x1b, 0x55, 0x07, 0x3f, 0xab, 0x40, 0x62, 0xdc,
-	0x11, 0x54, 0xf2, 0x24, 0xeb, 0xa5, 0x73, 0xa1, 0x3a, 0x36, 0xfd, 0x9e, 0xb8, 0x71, 0x1f, 0x51,
-	0x40, 0x42, 0x6e, 0x57, 0x65, 0x1b, 0x2e, 0x2c, 0x86, 0x74, 0xc1, 0xe4, 0x5a, 0x31, 0x25, 0x51,
-	0x12, 0xe7, 0x18, 0xd3, 0x3f, 0x66, 0x19, 0x88, 0x95, 0x10, 0xa9, 0xa8, 0x0f, 0x19, 0x99, 0x3d,
-	0x28, 0xe6, 0x41, 0xe4, 0xf1, 0x5e, 0x71, 0xfa, 0xa1, 0xfd, 0xa7, 0xe6, 0x2c, 0x96, 0x37, 0x2b,
-	0x46, 0x7d, 0x11, 0xd6, 0xcf, 0x21, 0xeb, 0xab, 0xe4, 0x4a, 0x61, 0xbc, 0x05, 0x11, 0xc4, 0x09,
-	0xc0, 0xb8, 0x98, 0x37, 0x4f, 0x00, 0xa5, 0x9a, 0x16, 0xeb, 0x04, 0x50, 0x2e, 0x2b, 0x29, 0x9d,
-	0x00, 0x3c, 0x8e, 0x82, 0x4e, 0x83, 0x3c, 0x81, 0xe5, 0xe2, 0x05, 0xb9, 0xb1, 0x7c, 0xaa, 0xaf,
-	0xce, 0xcf, 0x4d, 0xfa, 0xc8, 0x73, 0x4d, 0x2f, 0x13, 0x29, 0xfd, 0x3b, 0xf2, 0x0d, 0x07, 0x79,
-	0x02, 0x4b, 0x85, 0x3b, 0x6b, 0x63, 0x0a, 0x2b, 0x2f, 0xb3, 0x47, 0xb3, 0xb2, 0x17, 0xa8, 0x66,
-	0x35, 0xc4, 0xde, 0x7c, 0xd1, 0x3c, 0x85, 0x95, 0x8a, 0x6b, 0x67, 0xe3, 0xdc, 0x3c, 0xf2, 0x4e,
-	0xba, 0x5d, 0x16, 0xca, 0xba, 0x7e, 0xb5, 0x73, 0x5b, 0x39, 0xef, 0x84, 0x09, 0xce, 0x03, 0x63,
-	0x98, 0xf2, 0x6f, 0xc9, 0x96, 0x29, 0x5a, 0x37, 0xfd, 0xed, 0xad, 0x91, 0xed, 0x95, 0xce, 0x57,
-	0xb3, 0x94, 0xb7, 0xb1, 0x21, 0x2c, 0xda, 0xa2, 0x1a, 0x69, 0x95, 0xaa, 0x1b, 0xf3, 0x73, 0x47,
-	0x68, 0xaf, 0x10, 0xcd, 0xee, 0x13, 0xa4, 0xcd, 0x60, 0xc1, 0xaa, 0x65, 0x30, 0x8c, 0xb3, 0xa2,
-	0x4a, 0x62, 0xc2, 0x14, 0xa1, 0x39, 0xa6, 0x78, 0xc0, 0xd5, 0x68, 0x9a, 0xa6, 0x2c, 0x99, 0x20,
-	0x5b, 0x95, 0x9c, 0xf2, 0xba, 0x88, 0x4f, 0xcd, 0x2c, 0x35, 0x98, 0xc9, 0x52, 0x8b, 0x0a, 0x66,
-	0x76, 0x11, 0xc6, 0xf9, 0xb3, 0x76, 0x0e, 0xd3, 0x53, 0xa3, 0x80, 0x40, 0x15, 0x23, 0x1c, 0xc4,
-	0x47, 0x47, 0x21, 0x33, 0xd2, 0x0c, 0x23, 0xaa, 0x15, 0x46, 0x8f, 0xf4, 0x06, 0x32, 0xbd, 0x42,
-	0xd7, 0x6d, 0xa6, 0xde, 0x30, 0x8b, 0xd5, 0xda, 0xf8, 0x3e, 0x6e, 0x28, 0x85, 0x5a, 0x2a, 0x6b,
-	0x43, 0xa9, 0x2e, 0x2b, 0x6b, 0xd3, 0x71, 0x28, 0x23, 0x76, 0x96, 0x63, 0x89, 0xd7, 0x93, 0x6c,
-	0xbe, 0x8b, 0x91, 0x82, 0x75, 0xe9, 0x6d, 0x45, 0x0a, 0x55, 0x05, 0x04, 0x13, 0xde, 0x07, 0x19,
-	0x7b, 0xa7, 0xb8, 0xe7, 0x4c, 0x61, 0x65, 0x9f, 0xf1, 0x29, 0xb3, 0x03, 0x04, 0x5a, 0xc5, 0xce,
-	0x2e, 0x25, 0x38, 0xd7, 0xf3, 0x88, 0x84, 0x59, 0xca, 0x32, 0x2f, 0x0c, 0xad, 0xe8, 0x80, 0xfc,
-	0xbe, 0x03, 0x9b, 0xe3, 0x2a, 0x0a, 0xc8, 0x4b, 0x8a, 0xf4, 0x04, 0x75, 0x07, 0xa3, 0xe5, 0x90,
-	0x87, 0x2d, 0x72, 0x9d, 0xcb, 0x21, 0x6a, 0xf1, 0xf5, 0xe5, 0x8c, 0xa2, 0x24, 0x04, 0x12, 0x89,
-	0x2c, 0xbb, 0xcc, 0x80, 0x54, 0xc5, 0x40, 0x56, 0xe5, 0x82, 0x95, 0xc8, 0xaa, 0xae, 0x51, 0x28,
-	0x25, 0xb2, 0x2c, 0xed, 0xa7, 0x7c, 0x55, 0x15, 0x4b, 0x0e, 0xf2, 0xa9, 0x1e, 0x51, 0xc4, 0x90,
-	0x07, 0x67, 0xa3, 0xaa, 0x15, 0xec, 0x39, 0x3f, 0x55, 0x58, 0xea, 0x0a, 0x35, 0x85, 0x95, 0x8a,
-	0x2b, 0x7d, 0xe3, 0xc0, 0x32, 0xf2, 0xbe, 0x7f, 0xc2, 0x39, 0xd7, 0x1c, 0xb9, 0xb9, 0x49, 0xea,
-	0x3f, 0x73, 0xe0, 0xf2, 0xc8, 0x8b, 0x73, 0x72, 0xab, 0x6a, 0x48, 0x55, 0x95, 0x01, 0xed, 0xdb,
-	0x13, 0x60, 0xda, 0x59, 0x4c, 0x72, 0xb5, 0xa8, 0x05, 0xeb, 0x2e, 0x9d, 0xf4, 0xe1, 0x52, 0xe9,
-	0x2e, 0x9d, 0x5c, 0xaf, 0x52, 0x86, 0x79, 0xcd, 0x3e, 0xe1, 0x1e, 0x6f, 0xaa, 0x02, 0x2f, 0xdb,
-	0xc9, 0x11, 0x2c, 0x15, 0x2e, 0xdb, 0xf3, 0xcd, 0xaf, 0xfa, 0x16, 0x7e, 0xc2, 0x6b, 0x65, 0x93,
-	0xd5, 0x30, 0x09, 0xbb, 0xd3, 0xf8, 0x24, 0xe5, 0xf5, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xfa,
-	0x35, 0xc5, 0x70, 0x58, 0x5f, 0x00, 0x00,
+	// 8508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0x5b, 0x6c, 0x24, 0xc9,
+	0x91, 0x18, 0xaa, 0xbb, 0xd9, 0x64, 0x07, 0xdf, 0xc5, 0x57, 0xb3, 0x66, 0x38, 0x24, 0x6b, 0x6e,
+	0x67, 0x67, 0xf6, 0xc1, 0xd1, 0x3e, 0xf4, 0x38, 0x49, 0x27, 0x99, 0xc3, 0xd9, 0x1d, 0x8d, 0x34,
+	0xab, 0x9d, 0x2b, 0x72, 0x67, 0x01, 0xc9, 0x50, 0xbb, 0xd8, 0x95, 0x24, 0x4b, 0x53, 0x5d, 0xd5,
+	0x5b, 0x55, 0xcd, 0x19, 0xea, 0xce, 0xd0, 0x41, 0xb0, 0xce, 0x07, 0xdf, 0xe1, 0x0c, 0x43, 0x10,
+	0x64, 0x03, 0x86, 0x61, 0x1c, 0x60, 0xd8, 0xb8, 0x9f, 0x03, 0x8c, 0xfb, 0xb0, 0x0d, 0xdb, 0xf0,
+	0xaf, 0x60, 0xc0, 0x3f, 0x06, 0x7c, 0xfe, 0xf1, 0x8f, 0xef, 0x70, 0x07, 0x9c, 0x1f, 0x1f, 0x06,
+	0xfc, 0xe3, 0x2f, 0x23, 0x23, 0x1f, 0x95, 0x59, 0x95, 0xd5, 0x6c, 0xee, 0x8e, 0x46, 0xfa, 0x99,
+	0xe9, 0x8a, 0x8c, 0xcc, 0x88, 0x8c, 0x8c, 0xcc, 0x8c, 0x8c, 0x8c, 0x0c, 0x42, 0x27, 0x1d, 0xf6,
+	0xf7, 0x86, 0x69, 0x92, 0x27, 0x76, 0xfb, 0xb4, 0x9f, 0xa7, 0xc3, 0xbe, 0x73, 0xfd, 0x34, 0x49,
+	0x4e, 0x23, 0x72, 0xd7, 0x1f, 0x86, 0x77, 0xfd, 0x38, 0x4e, 0x72, 0x3f, 0x0f, 0x93, 0x38, 0x63,
+	0x58, 0xce, 0x36, 0x2f, 0xc5, 0xaf, 0xe3, 0xd1, 0xc9, 0xdd, 0x3c, 0x1c, 0x90, 0x2c, 0xf7, 0x07,
+	0x43, 0x86, 0xe0, 0x2e, 0xc1, 0xc2, 0x03, 0x92, 0x3f, 0x8c, 0x4f, 0x12, 0x8f, 0x7c, 0x32, 0x22,
+	0x59, 0xee, 0xfe, 0x69, 0x0b, 0x16, 0x25, 0x28, 0x1b, 0x26, 0x71, 0x46, 0xec, 0x75, 0x68, 0x8f,
+	0x86, 0xb4, 0x6a, 0xd7, 0xda, 0xb1, 0x6e, 0x77, 0x3c, 0xfe, 0x65, 0xdf, 0x85, 0x15, 0xff, 0xdc,
+	0x0f, 0x23, 0xff, 0x38, 0x22, 0x3d, 0xf2, 0xbc, 0x7f, 0xe6, 0xc7, 0xa7, 0x24, 0xeb, 0x36, 0x76,
+	0xac, 0xdb, 0x4d, 0xcf, 0x96, 0x45, 0xef, 0x89, 0x12, 0xfb, 0x75, 0x58, 0x26, 0x31, 0x05, 0x05,
+	0x0a, 0x7a, 0x13, 0xd1, 0x97, 0x78, 0x41, 0x81, 0xfc, 0x2e, 0xac, 0x07, 0xe4, 0xc4, 0x1f, 0x45,
+	0x79, 0xef, 0x24, 0x49, 0xc9, 0xf3, 0xde, 0x30, 0x4d, 0xce, 0xc3, 0x80, 0xa4, 0xdd, 0x16, 0x72,
+	0xb1, 0xca, 0x4b, 0xdf, 0xa7, 0x85, 0x8f, 0x79, 0x99, 0xfd, 0x36, 0xac, 0xc9, 0x5a, 0xa1, 0x9f,
+	0xf7, 0xfa, 0xa3, 0x34, 0x25, 0x71, 0xff, 0xa2, 0x3b, 0x85, 0x95, 0x56, 0x44, 0xa5, 0xd0, 0xcf,
+	0x0f, 0x78, 0x91, 0xfd, 0x31, 0x2c, 0x65, 0xa3, 0xe3, 0xec, 0x22, 0xcb, 0xc9, 0xa0, 0x97, 0xe5,
+	0x7e, 0x3e, 0xca, 0xba, 0xed, 0x9d, 0xe6, 0xed, 0xd9, 0xb7, 0xdf, 0xd8, 0x63, 0x72, 0xde, 0x2b,
+	0x89, 0x64, 0xef, 0x50, 0xe0, 0x1f, 0x22, 0xfa, 0x7b, 0x71, 0x9e, 0x5e, 0x78, 0x8b, 0x99, 0x0e,
+	0xb5, 0xbf, 0x0d, 0xf3, 0xe9, 0xb0, 0xdf, 0x23, 0x71, 0x30, 0x4c, 0xc2, 0x38, 0xcf, 0xba, 0xd3,
+	0xd8, 0xea, 0x9d, 0xba, 0x56, 0xbd, 0x61, 0xff, 0x3d, 0x81, 0xcb, 0x9a, 0x9c, 0x4b, 0x15, 0x90,
+	0x73, 0x0f, 0x56, 0x4d, 0x84, 0xed, 0x25, 0x68, 0x3e, 0x25, 0x17, 0x7c, 0x74, 0xe8, 0x4f, 0x7b,
+	0x15, 0xa6, 0xce, 0xfd, 0x68, 0x44, 0x70, 0x30, 0x66, 0x3c, 0xf6, 0xf1, 0xe5, 0xc6, 0x97, 0x2c,
+	0xe7, 0x08, 0x96, 0x2b, 0x64, 0x0c, 0x0d, 0xdc, 0x51, 0x1b, 0x98, 0x7d, 0x7b, 0x45, 0xb0, 0xec,
+	0x3d, 0x3e, 0x10, 0x75, 0x95, 0x56, 0xdd, 0x5d, 0xd8, 0x7e, 0x40, 0xf2, 0x83, 0x64, 0x30, 0x18,
+	0xc5, 0x61, 0x1f, 0x95, 0xd0, 0x23, 0x91, 0x7f, 0x41, 0xd2, 0x4c, 0x68, 0xd6, 0xb7, 0x61, 0xd5,
+	0x54, 0x6e, 0x77, 0x61, 0x9a, 0x8f, 0x3d, 0xd2, 0x9f, 0xf1, 0xc4, 0xa7, 0x7d, 0x1d, 0x3a, 0xfd,
+	0x24, 0x8e, 0x49, 0x3f, 0x27, 0x01, 0xef, 0x48, 0x01, 0x70, 0x7f, 0xb7, 0x01, 0x3b, 0xf5, 0x34,
+	0xb9, 0xea, 0xfe, 0x00, 0xd6, 0xfb, 0x2a, 0x42, 0x2f, 0xe5, 0x18, 0x5d, 0x0b, 0x87, 0xe2, 0x40,
+	0x19, 0x8a, 0xb1, 0x2d, 0xed, 0x19, 0x4b, 0xd9, 0x20, 0xad, 0xf5, 0x4d, 0x65, 0xce, 0x09, 0x38,
+	0xf5, 0x95, 0x0c, 0x22, 0x7f, 0x5b, 0x17, 0xf9, 0x75, 0xc1, 0x9a, 0xa9, 0x11, 0x55, 0xf6, 0x5f,
+	0x84, 0x8d, 0x07, 0x24, 0x26, 0x69, 0xd8, 0x97, 0xca, 0xc1, 0x65, 0x4e, 0x25, 0x28, 0x75, 0x92,
+	0x93, 0x2a, 0x00, 0xee, 0x3a, 0xac, 0x3e, 0x20, 0xb9, 0xac, 0x24, 0x47, 0xea, 0xdf, 0x5b, 0xb0,
+	0x86, 0x05, 0xd9, 0x71, 0x76, 0xc1, 0x0a, 0xb8, 0x38, 0xff, 0x16, 0x2c, 0xcb, 0xea, 0x99, 0x98,
+	0x2a, 0x4c, 0x92, 0xef, 0x28, 0x92, 0xac, 0xd6, 0x2c, 0x26, 0x4c, 0xa6, 0xce, 0x98, 0xa5, 0xac,
+	0x04, 0x76, 0x0e, 0x60, 0xcd, 0x88, 0x7a, 0x15, 0x1d, 0x77, 0xbb, 0xb0, 0xfe, 0x80, 0xe4, 0x8a,
+	0xaa, 0x2a, 0x4a, 0x38, 0xab, 0x80, 0xa9, 0xee, 0x65, 0xb9, 0x9f, 0xe6, 0x85, 0xee, 0xf1, 0x4f,
+	0xfb, 0x15, 0x58, 0x88, 0xc2, 0x2c, 0x27, 0x71, 0xcf, 0x0f, 0x82, 0x94, 0x64, 0x6c, 0x59, 0xeb,
+	0x78, 0xf3, 0x0c, 0xba, 0xcf, 0x80, 0xee, 0xbf, 0xb1, 0x60, 0xa3, 0x42, 0x8a, 0x0b, 0xeb, 0x11,
+	0x74, 0x8a, 0x99, 0xcf, 0x84, 0xb4, 0xa7, 0x08, 0xc9, 0x54, 0x67, 0xaf, 0x34, 0xfd, 0x8b, 0x06,
+	0x9c, 0xdf, 0x84, 0x85, 0x17, 0x3d, 0x69, 0xbf, 0x04, 0x0e, 0x57, 0x1c, 0xb1, 0xea, 0x7e, 0xdb,
+	0x1f, 0x10, 0xa1, 0x3b, 0x0e, 0xcc, 0x88, 0x45, 0x9a, 0xd3, 0x90, 0xdf, 0xee, 0x5d, 0x58, 0x79,
+	0x40, 0x72, 0x51, 0x4b, 0x48, 0xb7, 0x7e, 0x2a, 0xbb, 0xef, 0xc2, 0xaa, 0x5e, 0x81, 0xcb, 0xe8,
+	0x3a, 0x74, 0x8a, 0x9d, 0x80, 0x2b, 0xa8, 0x04, 0xb8, 0x6f, 0xc3, 0x9a, 0x52, 0xeb, 0xc3, 0xa3,
+	0xc7, 0x1e, 0x61, 0xd5, 0x36, 0x61, 0x26, 0xc9, 0x87, 0xbd, 0x7e, 0x12, 0x08, 0xde, 0xa6, 0x93,
+	0x7c, 0x78, 0x90, 0x04, 0x84, 0x8f, 0xbd, 0x52, 0x47, 0x8e, 0xfd, 0x1f, 0xb1, 0xb1, 0xd2, 0x8b,
+	0x38, 0x1f, 0xdf, 0x84, 0x8e, 0x68, 0x50, 0x8c, 0xd5, 0x9b, 0xca, 0x58, 0x99, 0xea, 0xec, 0x7d,
+	0xc8, 0x28, 0xf2, 0xa1, 0x9a, 0xe1, 0x0c, 0x64, 0xce, 0x57, 0x60, 0x5e, 0x2b, 0xba, 0x4c, 0x75,
+	0x3b, 0xea, 0x98, 0xbc, 0x0b, 0xeb, 0xf7, 0xc3, 0x4c, 0xdd, 0x36, 0x27, 0x19, 0x8f, 0xef, 0xc1,
+	0xc2, 0x63, 0x3f, 0x4c, 0xb3, 0xc3, 0xd1, 0x70, 0x98, 0xa0, 0xfe, 0xbe, 0x0a, 0x8b, 0xc5, 0xde,
+	0x3c, 0xa4, 0x65, 0xbc, 0xd2, 0x82, 0x04, 0x63, 0x0d, 0xfb, 0x26, 0xcc, 0x8b, 0x3d, 0x99, 0xa1,
+	0x31, 0x96, 0xe6, 0x38, 0x10, 0x91, 0xdc, 0x9f, 0xb7, 0x34, 0xd1, 0x69, 0xd6, 0x81, 0x0d, 0xad,
+	0xd8, 0x97, 0xb6, 0x01, 0xfe, 0x56, 0x15, 0xa1, 0xa1, 0xaf, 0xe9, 0x5d, 0x98, 0x3e, 0x27, 0xe9,
+	0x71, 0x92, 0x11, 0xdc, 0xf8, 0x67, 0x3c, 0xf1, 0x49, 0x19, 0x19, 0x65, 0x61, 0x7c, 0xda, 0xcb,
+	0xfc, 0x38, 0x38, 0x4e, 0x9e, 0xe3, 0x36, 0x3f, 0xe3, 0xcd, 0x21, 0xf0, 0x90, 0xc1, 0xec, 0x5d,
+	0x98, 0x3b, 0xcb, 0xf3, 0x61, 0x8f, 0xda, 0x1f, 0xc9, 0x28, 0xe7, 0xbb, 0xfa, 0x2c, 0x85, 0x1d,
+	0x31, 0x10, 0x9d, 0xb9, 0x88, 0x32, 0xca, 0x48, 0xea, 0x9f, 0x92, 0x38, 0xef, 0xb6, 0xd9, 0xcc,
+	0xa5, 0xd0, 0x8f, 0x04, 0xd0, 0xde, 0x02, 0x40, 0xb4, 0x61, 0x9a, 0x3c, 0xbf, 0xe8, 0x4e, 0x33,
+	0xd5, 0xa3, 0x90, 0xc7, 0x14, 0x40, 0xe5, 0x77, 0xec, 0x67, 0x44, 0xd8, 0x0f, 0x21, 0xc9, 0xba,
+	0x33, 0x4c, 0x7e, 0x14, 0x7c, 0x20, 0xa1, 0x76, 0x8f, 0x1a, 0x0f, 0x5c, 0xea, 0x3d, 0x3f, 0xcb,
+	0x48, 0x9e, 0x75, 0x3b, 0xa8, 0x40, 0xef, 0x1a, 0x14, 0xa8, 0x64, 0x44, 0xf0, 0x7a, 0xfb, 0x58,
+	0x4d, 0x1a, 0x11, 0x1a, 0x94, 0x1a, 0x4d, 0xfe, 0x28, 0x3f, 0x23, 0x71, 0x4e, 0xb7, 0x00, 0x4a,
+	0x64, 0x18, 0x76, 0x01, 0x65, 0xb3, 0xa4, 0x15, 0xec, 0x0f, 0x43, 0xfb, 0x3d, 0x58, 0xec, 0x87,
+	0x69, 0x7f, 0x14, 0xe6, 0xbd, 0xe3, 0x94, 0xf8, 0x4f, 0x49, 0xda, 0x9d, 0x2d, 0xed, 0x26, 0xac,
+	0xf8, 0x1e, 0x2b, 0x65, 0x2b, 0xac, 0xb7, 0xd0, 0xd7, 0xa0, 0xce, 0x77, 0xa8, 0xa1, 0x51, 0x65,
+	0xce, 0xa0, 0xc9, 0x6f, 0xe8, 0x4b, 0xce, 0xba, 0x20, 0xa3, 0xab, 0xa3, 0xaa, 0xe1, 0xbf, 0x0d,
+	0xab, 0x26, 0x1e, 0xe8, 0x9c, 0xa0, 0x1b, 0x8a, 0x50, 0x24, 0xf6, 0x61, 0xbf, 0x05, 0xab, 0x7d,
+	0x2a, 0xac, 0xfe, 0x28, 0x0f, 0xcf, 0x49, 0xef, 0xc4, 0x0f, 0xa3, 0x51, 0x2a, 0x8d, 0xcc, 0x15,
+	0xa5, 0xec, 0x7d, 0x5e, 0x64, 0x5f, 0x83, 0x4e, 0x32, 0x24, 0x31, 0x95, 0x54, 0xce, 0xad, 0xcb,
+	0x19, 0x06, 0xd8, 0xcf, 0xdd, 0x67, 0xb0, 0xf4, 0x80, 0xe4, 0x47, 0x61, 0xff, 0x29, 0x49, 0x27,
+	0x98, 0x59, 0xf6, 0x6d, 0x68, 0xd1, 0x69, 0xc1, 0xbb, 0xb7, 0x2a, 0xa5, 0xc8, 0x6d, 0x47, 0xda,
+	0x4d, 0x0f, 0x31, 0xa8, 0x42, 0xe1, 0xf0, 0xf7, 0xf2, 0x8b, 0x21, 0x53, 0xee, 0x8e, 0xd7, 0x41,
+	0xc8, 0xd1, 0xc5, 0x90, 0xb8, 0x4f, 0x60, 0x4e, 0xad, 0x44, 0x57, 0xbe, 0x80, 0x44, 0xe1, 0x20,
+	0xcc, 0x49, 0x2a, 0x56, 0x3e, 0x09, 0xa0, 0x93, 0x8a, 0xea, 0x19, 0x9f, 0x8c, 0xf8, 0x9b, 0x0a,
+	0xe8, 0x93, 0x51, 0x92, 0x8b, 0xb6, 0xd9, 0x87, 0xfb, 0xd3, 0x06, 0x2c, 0x88, 0xee, 0xf0, 0x19,
+	0x29, 0x78, 0xb6, 0x2e, 0xe5, 0x79, 0x17, 0xe6, 0x22, 0x3f, 0xcb, 0x7b, 0xa3, 0x61, 0xe0, 0x0b,
+	0x23, 0xab, 0xe9, 0xcd, 0x52, 0xd8, 0x47, 0x0c, 0x44, 0xa7, 0xa5, 0xb0, 0xa1, 0x71, 0x81, 0xe0,
+	0xd4, 0xe7, 0xfa, 0x6a, 0x67, 0x6c, 0x68, 0xd1, 0x3a, 0x38, 0x65, 0x2d, 0x0f, 0x7f, 0x53, 0xd8,
+	0x59, 0x78, 0x7a, 0x86, 0x53, 0xd4, 0xf2, 0xf0, 0x37, 0xd5, 0x9f, 0x28, 0x79, 0x86, 0x13, 0xd2,
+	0xf2, 0xe8, 0x4f, 0x0a, 0x39, 0x0e, 0x03, 0x9c, 0x7f, 0x96, 0x47, 0x7f, 0x52, 0x88, 0x9f, 0x3d,
+	0xc5, 0xd9, 0x66, 0x79, 0xf4, 0x27, 0x3d, 0x7f, 0x9c, 0x27, 0xd1, 0x68, 0x40, 0xba, 0x1d, 0x04,
+	0xf2, 0x2f, 0x3a, 0xd0, 0xc3, 0x34, 0xec, 0x93, 0x9e, 0x9f, 0x9f, 0xe1, 0x8c, 0xb0, 0xbc, 0x19,
+	0x04, 0xec, 0xe7, 0x67, 0xee, 0x0a, 0x2c, 0xcb, 0x81, 0x96, 0x5b, 0xc0, 0xc7, 0x30, 0xcd, 0x21,
+	0x63, 0x07, 0xfd, 0x73, 0x30, 0x9d, 0x33, 0xb4, 0x6e, 0x63, 0xa7, 0xa9, 0xaa, 0xb5, 0x2e, 0x69,
+	0x4f, 0xa0, 0xb9, 0x5f, 0x07, 0x5b, 0xa5, 0xc6, 0x07, 0xe2, 0x4e, 0xd1, 0x0e, 0xdb, 0x53, 0x16,
+	0xf5, 0x76, 0xb2, 0xa2, 0x81, 0x1f, 0xe0, 0x8e, 0xfa, 0x61, 0x1a, 0xd0, 0xd5, 0x30, 0x79, 0xfa,
+	0x52, 0x55, 0xf3, 0x03, 0x98, 0x97, 0x84, 0x1f, 0xe6, 0x64, 0x40, 0x05, 0xee, 0x0f, 0x92, 0x51,
+	0x9c, 0x23, 0x4d, 0xcb, 0xe3, 0x5f, 0x54, 0x03, 0x51, 0xbe, 0x48, 0xd2, 0xf2, 0xd8, 0x87, 0xbd,
+	0x00, 0x8d, 0x30, 0xe0, 0x13, 0xad, 0x11, 0x06, 0xee, 0xff, 0xb3, 0x60, 0x59, 0xe9, 0xc8, 0x95,
+	0x95, 0xb2, 0xa2, 0x71, 0x0d, 0x83, 0xc6, 0xdd, 0x81, 0xd6, 0x71, 0x18, 0xd0, 0xd3, 0x23, 0x95,
+	0xeb, 0x9a, 0x68, 0x4e, 0xeb, 0x87, 0x87, 0x28, 0x14, 0xd5, 0xcf, 0x9e, 0x66, 0xdd, 0xd6, 0x58,
+	0x54, 0x8a, 0x52, 0x99, 0x0f, 0x53, 0xd5, 0xf9, 0xa0, 0xcb, 0xb2, 0x5d, 0x96, 0x25, 0xb3, 0xa9,
+	0x65, 0xdb, 0x52, 0xf3, 0xfa, 0x00, 0x05, 0x70, 0xec, 0xb0, 0xfe, 0x3a, 0x40, 0x22, 0x31, 0xb9,
+	0xfe, 0x6d, 0x56, 0x98, 0x96, 0x2a, 0xa8, 0x20, 0xbb, 0xdf, 0x42, 0x7b, 0x49, 0x25, 0xce, 0x85,
+	0xff, 0xb6, 0xd6, 0x26, 0xd3, 0x45, 0xbb, 0xd2, 0x66, 0xa6, 0x35, 0xf6, 0x0e, 0x36, 0xb6, 0xdf,
+	0xef, 0xd3, 0xa1, 0x57, 0x5c, 0x04, 0x63, 0x0d, 0x91, 0x27, 0x30, 0xcd, 0x6b, 0x70, 0xb5, 0x60,
+	0x08, 0x8d, 0x30, 0xb0, 0xbf, 0x02, 0xa0, 0x6c, 0xa6, 0xac, 0x5f, 0xd7, 0x04, 0x0f, 0xbc, 0x92,
+	0xd0, 0x06, 0x24, 0xa7, 0xa0, 0xbb, 0x27, 0xb0, 0x62, 0x40, 0xa1, 0xac, 0xc8, 0x03, 0x3e, 0x67,
+	0x45, 0x7c, 0xdb, 0xdb, 0x30, 0x9b, 0x27, 0xb9, 0x1f, 0xf5, 0x8a, 0xfd, 0xc9, 0xf2, 0x00, 0x41,
+	0x4f, 0x28, 0x04, 0x17, 0xa8, 0x24, 0x62, 0x9a, 0x4b, 0x17, 0xa8, 0x24, 0x0a, 0x5c, 0x1f, 0xad,
+	0x47, 0xad, 0xd3, 0x5c, 0x84, 0xe3, 0x86, 0xec, 0x75, 0x98, 0xf1, 0x59, 0x15, 0xd1, 0xb1, 0xc5,
+	0x52, 0xc7, 0x3c, 0x89, 0xe0, 0xda, 0xb8, 0x03, 0x1d, 0x24, 0xf1, 0x49, 0x78, 0x2a, 0xb4, 0xe3,
+	0x55, 0x58, 0x56, 0x60, 0x85, 0x61, 0x15, 0xf8, 0xb9, 0x8f, 0xd4, 0xe6, 0x3c, 0xfc, 0xed, 0xfe,
+	0xd8, 0x82, 0xa5, 0xc7, 0x49, 0x9a, 0x9f, 0x24, 0x51, 0x98, 0xf0, 0x43, 0x08, 0xb5, 0xa9, 0xc4,
+	0x21, 0x85, 0x1b, 0xc3, 0xfc, 0x93, 0xae, 0x90, 0xfd, 0x24, 0x8c, 0x99, 0xae, 0x36, 0xb8, 0x80,
+	0x92, 0x30, 0xa6, 0xaa, 0x6a, 0xef, 0xc0, 0x6c, 0x40, 0xb2, 0x7e, 0x1a, 0x0e, 0xe9, 0xc1, 0x92,
+	0x2f, 0x0b, 0x2a, 0x88, 0x36, 0x7c, 0xec, 0x47, 0x7e, 0xdc, 0x27, 0x7c, 0x65, 0x17, 0x9f, 0xee,
+	0x1a, 0x2e, 0x57, 0x92, 0x13, 0xe5, 0x8c, 0xaf, 0x83, 0x79, 0x57, 0xbe, 0x00, 0x9d, 0xa1, 0x00,
+	0x72, 0xf5, 0xeb, 0x4a, 0x4b, 0xa1, 0xd4, 0x1d, 0xaf, 0x40, 0x75, 0xaf, 0x83, 0xa3, 0xb6, 0x77,
+	0x38, 0x1a, 0x0c, 0xfc, 0xf4, 0x42, 0x50, 0x8b, 0xa1, 0x75, 0x90, 0x84, 0x31, 0x15, 0x14, 0xed,
+	0x94, 0xb0, 0x40, 0xe9, 0x6f, 0x95, 0xf5, 0x86, 0xc6, 0xba, 0x2a, 0xad, 0xa6, 0x2e, 0xad, 0x1b,
+	0x00, 0x43, 0x92, 0xf6, 0x49, 0x9c, 0xfb, 0xa7, 0xa2, 0xc7, 0x0a, 0xc4, 0x3d, 0x03, 0xfb, 0xc3,
+	0x93, 0x93, 0x28, 0x8c, 0x09, 0x25, 0xcb, 0x99, 0x19, 0x23, 0xfd, 0x7a, 0x1e, 0x74, 0x4a, 0xcd,
+	0x0a, 0xa5, 0x0f, 0x60, 0xf9, 0xc3, 0xd8, 0x40, 0x48, 0x34, 0x67, 0x8d, 0x6b, 0xae, 0x51, 0x69,
+	0xee, 0x1b, 0x30, 0xa7, 0x30, 0x9e, 0xd9, 0x5f, 0x82, 0x0e, 0xe7, 0x51, 0x9e, 0x76, 0x1c, 0xb9,
+	0x1a, 0x54, 0x7a, 0xe8, 0x15, 0xc8, 0xee, 0x3f, 0xb4, 0x60, 0xb6, 0xe0, 0x8c, 0x3a, 0xe9, 0xa6,
+	0xa8, 0xb8, 0x45, 0x2b, 0x37, 0x64, 0x2b, 0x05, 0xce, 0x1e, 0xfe, 0xcb, 0x8c, 0x5b, 0x86, 0xec,
+	0x1c, 0x02, 0x14, 0x40, 0x83, 0x51, 0x79, 0x57, 0x37, 0x2a, 0x37, 0xab, 0xad, 0x0a, 0xd6, 0x14,
+	0xbb, 0xf2, 0x3f, 0xb6, 0xe0, 0x9a, 0x51, 0x59, 0xb8, 0x0e, 0xbe, 0x09, 0xb3, 0x6c, 0x2e, 0xd0,
+	0x15, 0x40, 0x30, 0x3c, 0x57, 0x38, 0x59, 0xc2, 0xd8, 0x03, 0x9c, 0x1b, 0x58, 0x6e, 0xbf, 0x05,
+	0xf3, 0xf4, 0x2b, 0xeb, 0x25, 0x4c, 0x20, 0xdd, 0x86, 0xa1, 0xc2, 0x1c, 0xa2, 0x70, 0x91, 0xd9,
+	0x43, 0x58, 0xd3, 0xaa, 0xf4, 0x32, 0xc6, 0x02, 0xdf, 0xa4, 0xbe, 0xaa, 0x9c, 0x07, 0xea, 0xb8,
+	0xdc, 0x3b, 0x50, 0x1a, 0xe4, 0x65, 0x4c, 0x74, 0x2b, 0xfd, 0x6a, 0x89, 0x7d, 0x17, 0xe6, 0x38,
+	0x45, 0x94, 0x4c, 0xb7, 0x65, 0xe0, 0x71, 0x96, 0x55, 0x44, 0x04, 0x7b, 0x00, 0xab, 0x6a, 0x05,
+	0xc9, 0xe1, 0x14, 0x56, 0xfc, 0xca, 0xe4, 0x1c, 0xc6, 0x15, 0x06, 0xed, 0x7e, 0xa5, 0xc0, 0xf9,
+	0x9b, 0xd0, 0xad, 0xeb, 0x90, 0x61, 0xd8, 0x5f, 0xd3, 0x87, 0x7d, 0xd5, 0xa0, 0x92, 0x99, 0xea,
+	0xca, 0xfc, 0x0e, 0x6c, 0xd4, 0x30, 0x73, 0x05, 0xdf, 0xc8, 0x87, 0xb1, 0xa9, 0x6d, 0xf7, 0xcf,
+	0x2d, 0x70, 0xf6, 0x83, 0xa0, 0xb2, 0x38, 0x15, 0x9e, 0x8e, 0x97, 0xbc, 0xe4, 0x52, 0x6f, 0x7b,
+	0x71, 0xd0, 0x2c, 0x9c, 0x26, 0xec, 0x04, 0x6c, 0xcb, 0xa2, 0xc2, 0x81, 0xbe, 0x4b, 0x95, 0x23,
+	0x0a, 0x7a, 0x59, 0x9e, 0xd0, 0x33, 0x2f, 0xda, 0x2a, 0x33, 0x54, 0x1d, 0xa2, 0xe0, 0x90, 0x81,
+	0xdc, 0xe7, 0xb0, 0xe5, 0x91, 0x41, 0x72, 0x4e, 0x5e, 0x76, 0x3f, 0x5d, 0x07, 0xba, 0x0f, 0x88,
+	0xee, 0xbb, 0x97, 0xb6, 0xd2, 0xff, 0xb2, 0x60, 0x5e, 0x2b, 0x79, 0x61, 0x3e, 0x86, 0x37, 0xc0,
+	0x4e, 0x49, 0x96, 0xf7, 0x86, 0x49, 0x14, 0x51, 0x57, 0x43, 0x40, 0xbd, 0xa9, 0xfc, 0x3e, 0x61,
+	0x89, 0x96, 0x3c, 0x66, 0x05, 0xf7, 0x29, 0xdc, 0xde, 0x80, 0x69, 0x7f, 0x18, 0xf6, 0xa8, 0x22,
+	0x31, 0x29, 0xb7, 0xfd, 0x61, 0xf8, 0x2d, 0x72, 0x61, 0xbb, 0x30, 0xcf, 0x0b, 0x7a, 0x11, 0x39,
+	0x27, 0x11, 0x8a, 0xb6, 0xe9, 0xcd, 0xb2, 0xe2, 0x47, 0x14, 0x64, 0xdf, 0x81, 0xa5, 0x61, 0x1a,
+	0x52, 0x8d, 0x2c, 0x2e, 0x2e, 0xa6, 0x91, 0x9b, 0x45, 0x0e, 0x17, 0xbd, 0x73, 0xbf, 0x0b, 0x9b,
+	0x06, 0x59, 0xf0, 0x65, 0xeb, 0x6b, 0xb0, 0xa8, 0x5f, 0x7f, 0x88, 0xa5, 0x4b, 0x1a, 0xb2, 0x5a,
+	0x45, 0x6f, 0xe1, 0x44, 0x6b, 0x87, 0x1b, 0xa4, 0x88, 0xe3, 0xf9, 0xb9, 0xf4, 0xd5, 0xb9, 0x9f,
+	0xc0, 0x6a, 0x01, 0x3c, 0x48, 0xe2, 0x73, 0x92, 0x66, 0x54, 0x01, 0x6d, 0x68, 0x9d, 0xa4, 0x89,
+	0xf0, 0x16, 0xe3, 0x6f, 0x6a, 0xca, 0xe5, 0x09, 0x1f, 0xe4, 0x46, 0x9e, 0x50, 0x9c, 0xd4, 0xcf,
+	0xc5, 0xc6, 0x85, 0xbf, 0xa9, 0xb6, 0x85, 0xd8, 0x08, 0xe9, 0x61, 0x19, 0xd3, 0xde, 0x59, 0x0e,
+	0xa3, 0x54, 0xdc, 0x27, 0x68, 0x51, 0xaa, 0xac, 0xf0, 0x3e, 0xfe, 0x06, 0xcc, 0xb2, 0x3e, 0xd2,
+	0x9a, 0xa2, 0x7f, 0xd7, 0xb5, 0xfe, 0x95, 0xd8, 0xf4, 0xe0, 0x44, 0x42, 0xdd, 0x3f, 0x69, 0xc2,
+	0x1c, 0x1a, 0xb1, 0xf7, 0x49, 0xee, 0x87, 0xd1, 0x78, 0xf3, 0x9a, 0x99, 0xa5, 0x0d, 0x69, 0x96,
+	0xde, 0x84, 0x79, 0xd5, 0xd1, 0x73, 0x21, 0xce, 0xb7, 0x8a, 0x9b, 0xe7, 0x82, 0xfa, 0x94, 0xf0,
+	0xb4, 0x5d, 0x60, 0x31, 0x9d, 0x99, 0x47, 0xa8, 0x44, 0xd3, 0xcf, 0x06, 0x53, 0xa5, 0xb3, 0x01,
+	0x2d, 0x46, 0xfb, 0xba, 0x97, 0x85, 0x81, 0x3c, 0x3a, 0x20, 0xe4, 0x30, 0x0c, 0x94, 0x62, 0xac,
+	0x3d, 0xad, 0x14, 0x63, 0x6d, 0x7a, 0x2c, 0x4a, 0x09, 0xbb, 0xc5, 0xc0, 0xcb, 0xb8, 0x19, 0x54,
+	0xba, 0x39, 0x01, 0xa4, 0xfe, 0x2f, 0x7a, 0x72, 0xe3, 0x5e, 0xf9, 0x0e, 0xd3, 0xd8, 0x4c, 0x3a,
+	0x57, 0xd8, 0xc9, 0x0d, 0xd4, 0x93, 0x5b, 0x71, 0xce, 0x9b, 0xd5, 0xce, 0x79, 0xdb, 0x30, 0x9b,
+	0x0c, 0x49, 0xdc, 0xe3, 0xa7, 0xee, 0x39, 0x2c, 0x04, 0x0a, 0x7a, 0x82, 0x10, 0xba, 0xbc, 0x9e,
+	0x10, 0xd2, 0x9d, 0xc7, 0x02, 0xfa, 0xd3, 0x7e, 0x03, 0xda, 0x79, 0xea, 0x53, 0xef, 0xe9, 0xc2,
+	0x4e, 0x53, 0x5d, 0xbc, 0x8f, 0x28, 0xf4, 0x1b, 0x21, 0x5d, 0x84, 0x2e, 0x3c, 0x8e, 0xe3, 0xfe,
+	0x37, 0x0b, 0xe6, 0xd4, 0x82, 0x6a, 0xe7, 0x2c, 0x43, 0xe7, 0xca, 0x43, 0x27, 0x3b, 0xd5, 0x34,
+	0x77, 0xaa, 0xa5, 0x75, 0x4a, 0x55, 0x8a, 0xa9, 0x92, 0x52, 0x8c, 0x3f, 0xd4, 0x95, 0x06, 0x6e,
+	0xba, 0x3c, 0x70, 0x5c, 0x1a, 0x33, 0x52, 0x1a, 0xdc, 0xcb, 0x84, 0x3a, 0x99, 0x4d, 0x72, 0x94,
+	0xd7, 0xe9, 0x37, 0xca, 0xf4, 0xc5, 0xd9, 0xb9, 0x79, 0xd9, 0xd9, 0xd9, 0xdd, 0x87, 0x65, 0x85,
+	0x30, 0x9f, 0x5e, 0x6f, 0x40, 0x1b, 0x99, 0x15, 0x33, 0x6b, 0x55, 0x3b, 0xf9, 0xf1, 0x49, 0xe3,
+	0x71, 0x1c, 0xf7, 0x1b, 0x78, 0x01, 0x8c, 0x45, 0x93, 0xb0, 0x4e, 0x5d, 0xf1, 0x28, 0x1b, 0x39,
+	0x34, 0xd3, 0xf8, 0xfd, 0x30, 0x70, 0xff, 0xab, 0x05, 0xf6, 0xe1, 0xe8, 0x78, 0x10, 0x4e, 0xde,
+	0xda, 0xe4, 0x3e, 0x0d, 0x1b, 0x5a, 0x38, 0x1a, 0x6c, 0xba, 0xe2, 0xef, 0xd2, 0x0c, 0x6a, 0x95,
+	0x67, 0x50, 0xa1, 0x19, 0x53, 0x66, 0xb7, 0x46, 0x5b, 0xd5, 0x23, 0xba, 0xc1, 0x45, 0x21, 0x89,
+	0xf3, 0x1e, 0xf7, 0x4f, 0xd1, 0x0d, 0x0e, 0x01, 0x0f, 0x03, 0xf7, 0x10, 0x56, 0xb4, 0x9e, 0x71,
+	0x49, 0xef, 0xc2, 0x1c, 0x63, 0x60, 0x18, 0xf9, 0x7d, 0x79, 0x0b, 0x32, 0x8b, 0xb0, 0xc7, 0x08,
+	0x1a, 0x27, 0xaf, 0xdf, 0xb3, 0x60, 0xf5, 0x30, 0x1c, 0x8c, 0x22, 0x3f, 0x27, 0xbf, 0x00, 0x89,
+	0x15, 0xdd, 0x6f, 0x6a, 0xdd, 0x17, 0x92, 0x6c, 0x15, 0x92, 0x74, 0xff, 0x8f, 0x05, 0x6b, 0x25,
+	0x56, 0xa4, 0x19, 0xad, 0x2b, 0x53, 0x8d, 0x3f, 0x85, 0x23, 0x29, 0x44, 0x1b, 0x1a, 0xd1, 0x9b,
+	0x30, 0x3f, 0x08, 0xe3, 0x70, 0x30, 0x1a, 0xf4, 0xd4, 0x39, 0x3c, 0xc7, 0x81, 0x8f, 0x71, 0x08,
+	0x28, 0x92, 0xff, 0x5c, 0x41, 0x6a, 0x71, 0x24, 0xff, 0x79, 0x81, 0xf4, 0x39, 0x58, 0x2d, 0x8e,
+	0x3a, 0xbd, 0x53, 0x3f, 0x8c, 0x7b, 0x51, 0x92, 0x65, 0x7c, 0x8c, 0xed, 0xa2, 0xec, 0x81, 0x1f,
+	0xc6, 0x8f, 0x92, 0x2c, 0x53, 0x16, 0xc9, 0xb6, 0xba, 0x48, 0xba, 0x7f, 0xdf, 0x82, 0xa5, 0x8f,
+	0xcf, 0xfc, 0x88, 0xdc, 0x4b, 0x06, 0xc7, 0x2f, 0x56, 0xf6, 0xbb, 0x30, 0xc7, 0x5c, 0x95, 0xb9,
+	0x9f, 0x9e, 0x12, 0x31, 0x02, 0xb3, 0x08, 0x3b, 0x42, 0x90, 0x71, 0x18, 0xfe, 0xb7, 0x05, 0xf6,
+	0x01, 0xb5, 0xfe, 0xa2, 0x89, 0xf5, 0x81, 0x2e, 0x25, 0xcc, 0xd5, 0x50, 0x68, 0x58, 0x87, 0x43,
+	0x1e, 0xea, 0xea, 0xd7, 0xd4, 0xd4, 0x4f, 0xf6, 0xa6, 0x75, 0x45, 0x7f, 0x62, 0x65, 0x9f, 0x7b,
+	0x05, 0x16, 0x9e, 0xf9, 0x51, 0x44, 0x72, 0x79, 0x77, 0xca, 0x6f, 0x60, 0x18, 0x54, 0xb8, 0x2d,
+	0x44, 0x87, 0xa7, 0x95, 0x0e, 0xbf, 0x0b, 0xeb, 0xac, 0xbf, 0xfb, 0x51, 0x34, 0xf1, 0xf2, 0xe9,
+	0xfe, 0xe3, 0x06, 0x6c, 0x54, 0xaa, 0x49, 0xfb, 0x49, 0xd7, 0xd7, 0x5b, 0xb2, 0x5f, 0xe6, 0x0a,
+	0x7b, 0xfc, 0x93, 0xd7, 0x72, 0xfe, 0x83, 0x05, 0x6d, 0x06, 0x1a, 0x2b, 0xf6, 0xef, 0x88, 0x99,
+	0xcf, 0x35, 0x8b, 0x9d, 0x16, 0xbf, 0x38, 0x19, 0x31, 0xf6, 0x9f, 0x7a, 0x31, 0x3e, 0x9b, 0x14,
+	0x10, 0xe7, 0x6b, 0xb0, 0x54, 0x46, 0xb8, 0xd2, 0x9d, 0x22, 0xf3, 0x38, 0xbd, 0x77, 0x4e, 0x94,
+	0x8b, 0xf0, 0xbf, 0xb2, 0x60, 0xf1, 0x20, 0x89, 0x83, 0x90, 0xee, 0xae, 0x8f, 0xfd, 0xd4, 0x1f,
+	0x64, 0x3c, 0xde, 0x82, 0x81, 0x78, 0xcb, 0x05, 0xa0, 0xc6, 0xf9, 0xbb, 0x05, 0xd0, 0x3f, 0x23,
+	0xfd, 0xa7, 0x3d, 0xee, 0x8d, 0x65, 0x41, 0x1a, 0x14, 0x72, 0x8f, 0xfa, 0x5e, 0xdf, 0x84, 0x95,
+	0xa2, 0xb8, 0xe7, 0xc7, 0x41, 0x8f, 0xbb, 0x62, 0xf1, 0xfa, 0x4a, 0xe2, 0xed, 0xc7, 0xc1, 0x3e,
+	0xf5, 0xbf, 0xde, 0x81, 0x25, 0xe9, 0x81, 0xec, 0x69, 0x6b, 0xf5, 0xa2, 0x84, 0xef, 0x23, 0x98,
+	0x5e, 0xd0, 0xf5, 0xfd, 0x38, 0x88, 0x48, 0x2f, 0x8c, 0x73, 0x92, 0x9e, 0xfb, 0xc2, 0x0a, 0x5f,
+	0x60, 0xe0, 0x87, 0x1c, 0xea, 0xfe, 0x5f, 0x0b, 0x96, 0x95, 0xee, 0x73, 0xb5, 0x28, 0xbc, 0x93,
+	0xe8, 0xb4, 0xd6, 0xc6, 0xb6, 0x51, 0x1a, 0x5b, 0x1b, 0x5a, 0x21, 0x0d, 0xa0, 0xe0, 0x5b, 0x0d,
+	0xfd, 0x6d, 0xdf, 0x83, 0x25, 0x29, 0x9a, 0xde, 0x10, 0xe5, 0xc7, 0x27, 0xce, 0x46, 0x71, 0xfa,
+	0xd6, 0xc4, 0xeb, 0x2d, 0xf6, 0x4b, 0xf2, 0x16, 0x13, 0x6e, 0x6a, 0xa2, 0xa5, 0xbb, 0x8f, 0xc3,
+	0xc2, 0x57, 0x2c, 0xf6, 0xc5, 0xb8, 0x26, 0xfd, 0x11, 0xf5, 0x55, 0xb3, 0xc3, 0x85, 0xfc, 0x76,
+	0xff, 0xd2, 0x82, 0xc5, 0xfd, 0x20, 0xc0, 0x7e, 0x4f, 0xb2, 0x70, 0x88, 0x5e, 0x36, 0x2e, 0xe9,
+	0x65, 0xf3, 0x53, 0xf6, 0xf2, 0x33, 0x2f, 0x2b, 0x35, 0x42, 0x70, 0x5d, 0x58, 0x2a, 0xfa, 0x69,
+	0x1e, 0x5e, 0xf7, 0xd7, 0xc0, 0x66, 0x07, 0x5d, 0x4d, 0x1c, 0x65, 0xac, 0xf7, 0xe1, 0x36, 0x75,
+	0xc3, 0xa6, 0x17, 0xc3, 0x3c, 0x11, 0x96, 0xfe, 0x7d, 0x32, 0x4c, 0xb2, 0x50, 0x2c, 0x5a, 0x64,
+	0xa2, 0xf5, 0xe8, 0xe7, 0x16, 0xdc, 0x99, 0xa0, 0x21, 0xce, 0xeb, 0xf7, 0xaa, 0xde, 0xb8, 0xbf,
+	0xa1, 0x86, 0x25, 0x4d, 0xd4, 0xca, 0x9e, 0x84, 0xf0, 0xc8, 0x11, 0xd9, 0xa4, 0xf3, 0x55, 0x58,
+	0xd0, 0x0b, 0xaf, 0xb4, 0x78, 0x44, 0x70, 0xeb, 0x12, 0x26, 0x26, 0x51, 0xae, 0x5b, 0xb0, 0xd0,
+	0xd7, 0x9a, 0xe0, 0x84, 0x4a, 0x50, 0xf7, 0x00, 0x5e, 0xbd, 0x94, 0x1a, 0x17, 0x5b, 0xad, 0x6b,
+	0xc2, 0xfd, 0x13, 0x0b, 0x56, 0x3e, 0x0e, 0xf3, 0xb3, 0x20, 0xf5, 0x9f, 0xd1, 0x40, 0xbf, 0x49,
+	0x18, 0x54, 0x6f, 0x12, 0x1a, 0xa5, 0x9b, 0x84, 0x3a, 0xc3, 0xa9, 0xe4, 0xe5, 0x68, 0x55, 0xbd,
+	0x39, 0xb7, 0x68, 0x14, 0x41, 0xfc, 0xb4, 0xa7, 0xec, 0xc8, 0x4c, 0xad, 0xe7, 0x29, 0x58, 0x5c,
+	0x33, 0x04, 0xee, 0x7f, 0xb1, 0x60, 0x4d, 0x70, 0xcc, 0x3a, 0x3f, 0x09, 0xcf, 0x8a, 0x04, 0x1a,
+	0xba, 0x73, 0x66, 0x1b, 0x66, 0xf9, 0xcf, 0x5e, 0xee, 0x9f, 0xf2, 0x85, 0x0b, 0x38, 0xe8, 0xc8,
+	0x3f, 0xd5, 0xba, 0xdb, 0xaa, 0xed, 0xae, 0x6e, 0x26, 0xf3, 0x63, 0x4e, 0xbb, 0x38, 0xf4, 0x95,
+	0x04, 0x30, 0x5d, 0x75, 0xf3, 0x7c, 0x19, 0x96, 0x44, 0xbf, 0x0c, 0x73, 0x93, 0x1d, 0xe3, 0x0a,
+	0x73, 0xac, 0xa1, 0x99, 0x63, 0x6f, 0x80, 0x23, 0xea, 0xfa, 0x11, 0xce, 0xdb, 0x7b, 0x17, 0x0f,
+	0xef, 0x57, 0xe7, 0x2e, 0xb6, 0xe2, 0x1e, 0xc1, 0x35, 0x23, 0x36, 0x27, 0xfa, 0x79, 0x98, 0x22,
+	0x14, 0xc8, 0x6d, 0xb5, 0x6d, 0x31, 0xc1, 0x4a, 0x75, 0x04, 0xbe, 0xc7, 0xb0, 0x5d, 0x02, 0xbb,
+	0x25, 0x8c, 0xec, 0xde, 0xc5, 0x15, 0x22, 0x73, 0x4c, 0x67, 0x56, 0xbc, 0xe3, 0xc7, 0x31, 0x99,
+	0xf2, 0xd8, 0x87, 0x7b, 0x01, 0x5b, 0x55, 0x32, 0xf7, 0xfd, 0x7c, 0x22, 0x12, 0x2c, 0x70, 0x22,
+	0xcd, 0xc5, 0xdc, 0xc5, 0x0f, 0x3a, 0x5a, 0x24, 0x16, 0x36, 0x1e, 0xfd, 0x59, 0x90, 0x6e, 0xa9,
+	0xa4, 0xbf, 0x0b, 0xee, 0xb8, 0x1e, 0x56, 0xc5, 0xd7, 0xbc, 0x82, 0xf8, 0x7e, 0xda, 0x80, 0x8d,
+	0x1a, 0x94, 0x8a, 0x64, 0xbe, 0xac, 0x74, 0x91, 0xed, 0x31, 0x37, 0xca, 0x54, 0x22, 0xc1, 0x17,
+	0x6b, 0xa9, 0x10, 0xc1, 0x97, 0x60, 0x3a, 0x65, 0x92, 0xea, 0xb6, 0xcc, 0x55, 0xfd, 0x88, 0x8b,
+	0x92, 0x55, 0x15, 0xe8, 0xf4, 0xb6, 0x15, 0x7d, 0x0c, 0x2c, 0x5a, 0x84, 0xed, 0xc4, 0xce, 0x1e,
+	0x8b, 0x9b, 0xde, 0x13, 0x71, 0xd3, 0x7b, 0x47, 0x22, 0x6e, 0xda, 0xeb, 0x70, 0xec, 0x7d, 0xac,
+	0xca, 0xef, 0x89, 0x69, 0xd5, 0xf6, 0xe5, 0x55, 0x39, 0xf6, 0x7e, 0xee, 0x1e, 0xc1, 0xba, 0xb9,
+	0x4f, 0x46, 0x4f, 0x67, 0x59, 0x52, 0xc5, 0x84, 0x69, 0x6a, 0x13, 0xe6, 0xaf, 0x2d, 0x58, 0x37,
+	0xf7, 0x77, 0xec, 0xf2, 0x76, 0xb9, 0x53, 0xba, 0xce, 0xa5, 0x62, 0x43, 0x4b, 0x6e, 0xd5, 0x53,
+	0x1e, 0xfe, 0xb6, 0xef, 0x42, 0xeb, 0x24, 0x94, 0xf2, 0x90, 0x17, 0xbc, 0x74, 0x1d, 0x2e, 0x6b,
+	0x02, 0x22, 0xda, 0x9f, 0x87, 0x36, 0xdb, 0x04, 0x70, 0xfd, 0x98, 0x7d, 0x7b, 0x4b, 0x5a, 0x08,
+	0x08, 0x2d, 0x57, 0xe2, 0xc8, 0xee, 0xbf, 0xb2, 0x60, 0xc5, 0xd0, 0x28, 0x3d, 0xb6, 0xe3, 0x92,
+	0xab, 0x48, 0x71, 0x86, 0x02, 0x68, 0x68, 0x23, 0x3d, 0x86, 0x89, 0xa5, 0x18, 0xcb, 0x99, 0x28,
+	0x66, 0x39, 0x0c, 0x51, 0x5e, 0x81, 0x05, 0x89, 0x32, 0x1a, 0x1c, 0x13, 0x11, 0xf0, 0x32, 0x2f,
+	0x90, 0x10, 0x88, 0x71, 0x2b, 0xd9, 0x31, 0x5f, 0x3b, 0xe9, 0x4f, 0x9c, 0x86, 0xcf, 0xc2, 0x13,
+	0x11, 0x93, 0xc6, 0x3e, 0xd0, 0xaa, 0x3a, 0xf6, 0x85, 0xc9, 0x82, 0xbf, 0xdd, 0x00, 0xd6, 0x8c,
+	0x7d, 0x1b, 0xe3, 0x6d, 0x2f, 0x2d, 0xe8, 0x8d, 0xca, 0x82, 0xce, 0x17, 0xe7, 0x66, 0xe1, 0x83,
+	0x7a, 0x0b, 0x43, 0xf6, 0x1e, 0x25, 0xa7, 0xa7, 0x85, 0x8f, 0x87, 0x2b, 0xfd, 0x3a, 0xb4, 0x23,
+	0x84, 0x8b, 0x80, 0x7e, 0xf6, 0xe5, 0xc6, 0xd0, 0xad, 0x56, 0x29, 0x6e, 0xa3, 0xc3, 0xf8, 0x24,
+	0xe1, 0x2e, 0x0d, 0xfc, 0x4d, 0xbb, 0x1c, 0x90, 0xe3, 0xd1, 0xa9, 0x88, 0xc0, 0xc5, 0x0f, 0x8a,
+	0xf9, 0xcc, 0x4f, 0x63, 0x7e, 0x18, 0xc0, 0xdf, 0x14, 0x93, 0xa4, 0x69, 0x92, 0x72, 0xcb, 0x9f,
+	0x7d, 0xb8, 0x0f, 0x60, 0xe3, 0xf0, 0x6a, 0x2c, 0xe2, 0x22, 0x86, 0x2e, 0x77, 0xbe, 0xd8, 0xe1,
+	0x87, 0xfb, 0x2d, 0x2d, 0x3c, 0x11, 0x63, 0xcf, 0x26, 0x5c, 0x39, 0xd1, 0xbc, 0x14, 0x8d, 0xe1,
+	0x07, 0x75, 0x5b, 0x75, 0xab, 0xad, 0xc9, 0x08, 0xe8, 0x6a, 0xb8, 0x1f, 0xb3, 0xd9, 0x3e, 0x6f,
+	0x08, 0xf7, 0xd3, 0xea, 0x4e, 0x16, 0xef, 0xf7, 0x0b, 0x8d, 0xbd, 0xfb, 0x99, 0x05, 0xeb, 0x87,
+	0x3a, 0x7b, 0x2f, 0xc0, 0x3d, 0xf9, 0x1a, 0x4c, 0xb1, 0xd0, 0xd1, 0xe6, 0x4e, 0xb3, 0xd6, 0xc4,
+	0x67, 0x28, 0x74, 0x5c, 0xd9, 0x35, 0x0d, 0xd7, 0x04, 0xfe, 0xe5, 0xfe, 0x8e, 0x85, 0x97, 0x20,
+	0xd2, 0x89, 0x74, 0x98, 0xa7, 0xc4, 0x1f, 0xbc, 0xd4, 0x30, 0xa8, 0xaf, 0xc3, 0xae, 0x1a, 0xea,
+	0x7b, 0x65, 0x4e, 0xdc, 0xbf, 0x8d, 0xc1, 0x23, 0x2c, 0xb4, 0xeb, 0x97, 0xc0, 0xff, 0x57, 0xe1,
+	0x86, 0xc2, 0xff, 0x15, 0xd9, 0xa0, 0xd1, 0xd1, 0x94, 0xfb, 0x03, 0x3c, 0x3c, 0xbf, 0x7c, 0xee,
+	0xa9, 0xaf, 0x8f, 0x3a, 0xfe, 0x8b, 0xd3, 0x7c, 0x8b, 0xdd, 0x00, 0x50, 0xa0, 0x3c, 0xcb, 0xeb,
+	0x5d, 0xbc, 0x22, 0xaf, 0xee, 0x9f, 0x59, 0xb0, 0xaa, 0xd7, 0x99, 0x20, 0xb6, 0xe7, 0x85, 0x75,
+	0xd0, 0x81, 0x19, 0xad, 0x6f, 0x1d, 0x4f, 0x7e, 0xdb, 0xb7, 0xa0, 0xcd, 0xbc, 0x16, 0xdc, 0x02,
+	0x59, 0x50, 0xfc, 0x46, 0x41, 0x44, 0x3c, 0x5e, 0x4a, 0x67, 0x4f, 0x3f, 0x4a, 0x32, 0x12, 0xf0,
+	0xcb, 0x5c, 0xfe, 0xc5, 0x35, 0xcf, 0x23, 0x7d, 0x12, 0xe7, 0x78, 0xaf, 0x92, 0xbd, 0x54, 0xcd,
+	0xfb, 0x6d, 0xbc, 0xd8, 0x43, 0xc2, 0xbf, 0x04, 0xbd, 0xff, 0x0b, 0x0b, 0xe6, 0x91, 0xf6, 0xcb,
+	0x1d, 0x4f, 0x66, 0x95, 0xb5, 0xaa, 0xb7, 0x51, 0x53, 0xe6, 0xdb, 0xa8, 0xb6, 0xd1, 0xe9, 0xae,
+	0x38, 0x3f, 0xa9, 0xff, 0x4d, 0x3e, 0xd0, 0xe3, 0xb7, 0x7b, 0x05, 0xc0, 0xfd, 0x63, 0x0b, 0x56,
+	0xf5, 0x11, 0x7e, 0x99, 0xbd, 0x7d, 0x53, 0xde, 0xef, 0x95, 0xc2, 0x28, 0x35, 0xc9, 0xcb, 0x0b,
+	0xbe, 0x7f, 0xce, 0xf6, 0x50, 0x76, 0xbd, 0x17, 0xf6, 0x5f, 0xbe, 0x4e, 0x16, 0xa7, 0x22, 0xb6,
+	0x8e, 0xe8, 0xa7, 0x22, 0x16, 0xd7, 0x49, 0x7f, 0xba, 0x7f, 0xc6, 0x36, 0x9e, 0x32, 0xa7, 0x2f,
+	0x53, 0xb6, 0x13, 0xb2, 0xaa, 0x8c, 0x41, 0x7b, 0x92, 0x31, 0xf8, 0x21, 0x0e, 0xc1, 0x7d, 0x92,
+	0x86, 0xe7, 0x7e, 0x1e, 0x9e, 0x93, 0x09, 0x63, 0x38, 0x5f, 0xdc, 0xc4, 0xfc, 0xb1, 0x05, 0x37,
+	0x2a, 0x1c, 0xfc, 0x12, 0x16, 0x88, 0xfb, 0x70, 0x4b, 0xd9, 0x35, 0x3e, 0x25, 0x3b, 0xee, 0xdf,
+	0x6b, 0xc2, 0x7a, 0x59, 0x98, 0x2f, 0x53, 0x4b, 0xb6, 0x00, 0x06, 0x7e, 0xfa, 0x54, 0xbb, 0x09,
+	0xeb, 0x50, 0x08, 0xbb, 0x06, 0xdb, 0x86, 0xd9, 0x30, 0x0e, 0xc8, 0x73, 0x5e, 0xce, 0x16, 0x21,
+	0x40, 0x10, 0x43, 0xd8, 0x85, 0xb9, 0x93, 0x51, 0x1c, 0xd0, 0xb0, 0x17, 0x0c, 0xd0, 0x60, 0xeb,
+	0xd1, 0x2c, 0x87, 0x79, 0x7e, 0x4e, 0xe8, 0x93, 0xdb, 0x61, 0x4a, 0x82, 0xb0, 0x4f, 0x8d, 0x5b,
+	0x0d, 0x99, 0xc5, 0xe7, 0xaf, 0xca, 0xd2, 0xf7, 0x95, 0x5a, 0xaf, 0xc1, 0x72, 0x4c, 0x9e, 0xe7,
+	0xb2, 0x82, 0x12, 0x9c, 0xb0, 0x48, 0x0b, 0x38, 0x2e, 0x5e, 0xe1, 0xdf, 0x84, 0x79, 0x8c, 0x2c,
+	0xc0, 0x9d, 0x8f, 0x64, 0x39, 0x8f, 0xe8, 0x9f, 0xa3, 0xc0, 0x87, 0x1c, 0x56, 0x89, 0xc2, 0x86,
+	0x4a, 0x14, 0xb6, 0xfb, 0xcf, 0x98, 0xb5, 0xf2, 0x28, 0xfc, 0x64, 0x14, 0x06, 0xec, 0xcd, 0xf3,
+	0xaf, 0xe4, 0xea, 0xf2, 0x63, 0x0b, 0x56, 0x14, 0x26, 0x6b, 0x7d, 0x60, 0xe6, 0xcb, 0x95, 0x2b,
+	0xdc, 0xd8, 0xea, 0x9b, 0xc7, 0x54, 0x79, 0xf3, 0xf8, 0x2b, 0xf6, 0xf6, 0x4d, 0x97, 0xd7, 0xaf,
+	0xe2, 0x1a, 0xf7, 0x75, 0x98, 0x8b, 0x14, 0x26, 0xf9, 0x4a, 0x27, 0xdd, 0x08, 0x06, 0x59, 0x7a,
+	0x5a, 0x05, 0xf7, 0x1f, 0x59, 0x18, 0xf0, 0xb4, 0x3f, 0x0a, 0xc2, 0x5c, 0x73, 0xf6, 0x6f, 0x01,
+	0x20, 0xd1, 0x5e, 0x50, 0x3c, 0x32, 0xea, 0x20, 0x84, 0x3a, 0xda, 0xe8, 0xc5, 0x28, 0x89, 0x03,
+	0x56, 0xc8, 0xbd, 0xa9, 0x24, 0x0e, 0x44, 0x11, 0xbb, 0xdb, 0x3b, 0xbe, 0xd0, 0xee, 0x4c, 0xef,
+	0x5d, 0x98, 0x7d, 0x6a, 0x74, 0xdc, 0x92, 0x93, 0x93, 0x8c, 0xe4, 0xdc, 0x33, 0xc2, 0xbf, 0xdc,
+	0x03, 0x58, 0x2b, 0xb1, 0xc6, 0x87, 0xe0, 0x35, 0x68, 0xa3, 0xc3, 0xac, 0x12, 0x9b, 0xaf, 0xe0,
+	0x72, 0x0c, 0xf7, 0x5f, 0xb3, 0xad, 0x95, 0x77, 0xeb, 0x9b, 0xc9, 0x28, 0x8d, 0xa5, 0xb3, 0xe7,
+	0xb2, 0x53, 0x9c, 0x22, 0x80, 0xc6, 0x38, 0x01, 0x34, 0xeb, 0x05, 0xd0, 0xaa, 0x11, 0xc0, 0x94,
+	0x59, 0x00, 0x6d, 0x4d, 0x00, 0xbf, 0xdf, 0x80, 0x15, 0x9d, 0x71, 0x76, 0xb6, 0xbd, 0xca, 0x6d,
+	0xdc, 0x3a, 0xb4, 0x07, 0x24, 0x3f, 0x4b, 0x84, 0x6f, 0x93, 0x7f, 0xd1, 0x49, 0x31, 0xa4, 0x0f,
+	0x81, 0xf8, 0xa4, 0xa0, 0xbf, 0xd9, 0x93, 0x29, 0x92, 0x8a, 0xf8, 0x3d, 0xf6, 0x41, 0x31, 0x8f,
+	0x93, 0xe0, 0x42, 0xf8, 0x64, 0xe8, 0x6f, 0xba, 0x7c, 0x32, 0x2f, 0x1a, 0x7b, 0x54, 0x3a, 0x8d,
+	0xac, 0x00, 0x03, 0xd1, 0xa7, 0x9c, 0x54, 0x7a, 0x91, 0x9f, 0xe3, 0xa3, 0x94, 0x41, 0xc6, 0x63,
+	0x7d, 0x3a, 0x1c, 0xf2, 0x41, 0x56, 0x38, 0x38, 0x58, 0xdc, 0x15, 0xfb, 0xd0, 0x27, 0x25, 0x30,
+	0x89, 0x17, 0x93, 0xd2, 0x43, 0xcb, 0xa3, 0x3c, 0x90, 0xd2, 0xe3, 0x3a, 0x4d, 0xe2, 0x3c, 0x0d,
+	0xe5, 0x9d, 0x90, 0x9c, 0x03, 0x06, 0x01, 0x7a, 0x02, 0x97, 0xc6, 0x55, 0x3e, 0x21, 0x69, 0x78,
+	0x72, 0x51, 0x68, 0x8e, 0xbc, 0xf3, 0xfd, 0x75, 0x58, 0x44, 0xe8, 0xc1, 0x99, 0x1f, 0xc6, 0xf8,
+	0xf8, 0xae, 0x22, 0xf8, 0x75, 0x68, 0xa7, 0xc4, 0xcf, 0x92, 0x58, 0xf8, 0xe2, 0xd9, 0x97, 0xfb,
+	0x47, 0x0d, 0xd8, 0x34, 0xb4, 0xcb, 0x79, 0x65, 0xb7, 0x47, 0xa1, 0x88, 0x77, 0x61, 0x1f, 0xd4,
+	0x9b, 0x85, 0x17, 0xbc, 0xf2, 0x5d, 0x99, 0xf8, 0xa4, 0xc3, 0x3b, 0x8a, 0xcf, 0xfc, 0xec, 0x8c,
+	0x88, 0x77, 0x43, 0xf2, 0x9b, 0x06, 0x5d, 0xe2, 0xe2, 0xcf, 0x6d, 0xeb, 0xa6, 0xd7, 0xa6, 0x9f,
+	0x0f, 0x03, 0xea, 0xd8, 0xc3, 0x02, 0x8a, 0x27, 0x02, 0xb8, 0x28, 0xe0, 0x1b, 0x7e, 0x76, 0x86,
+	0xcf, 0x04, 0x69, 0xe3, 0xec, 0x7d, 0x74, 0xef, 0x9c, 0xb2, 0x1a, 0xf2, 0x63, 0x12, 0x7d, 0x26,
+	0x58, 0x94, 0x3d, 0xe1, 0x45, 0xf6, 0x5d, 0x68, 0xe3, 0x13, 0x49, 0x91, 0x95, 0x61, 0x43, 0x9b,
+	0x73, 0x85, 0x8c, 0x3c, 0x8e, 0x46, 0x55, 0xa4, 0x4f, 0xa1, 0x3d, 0xb6, 0x90, 0xb1, 0xe7, 0xa0,
+	0x80, 0xa0, 0x43, 0x0a, 0x71, 0xff, 0xad, 0xa5, 0x3f, 0x57, 0x10, 0x51, 0x6f, 0x9f, 0xf1, 0xfa,
+	0x49, 0x9f, 0xb7, 0xcd, 0x71, 0xf3, 0xb6, 0xa5, 0xcf, 0xdb, 0xab, 0x4d, 0xce, 0xbf, 0xdb, 0x80,
+	0xc5, 0x7b, 0x2c, 0xd8, 0xf8, 0x30, 0xf6, 0x87, 0xd9, 0x59, 0x72, 0xf9, 0xf5, 0x13, 0xf3, 0x89,
+	0xca, 0xeb, 0x27, 0xf6, 0xa9, 0xf5, 0xa6, 0x59, 0xea, 0xcd, 0x2a, 0x4c, 0x61, 0x04, 0x3e, 0xb7,
+	0x60, 0xd8, 0x87, 0x7c, 0x8b, 0x33, 0x55, 0xbc, 0xc5, 0xa1, 0xb6, 0x82, 0x9e, 0xc2, 0x83, 0xcd,
+	0xd7, 0xb9, 0x13, 0x35, 0x77, 0xc7, 0x16, 0x00, 0x22, 0x31, 0x47, 0x18, 0x33, 0x53, 0x3a, 0x14,
+	0xc2, 0xde, 0xf8, 0xac, 0x43, 0x1b, 0xb7, 0xd2, 0x00, 0x87, 0x6b, 0xc6, 0xe3, 0x5f, 0xfa, 0xc4,
+	0xec, 0x94, 0x27, 0xe6, 0x11, 0x5c, 0x33, 0x8e, 0xa3, 0x9c, 0x9a, 0x9d, 0x8c, 0x0b, 0x48, 0x4c,
+	0x4e, 0xa9, 0x3c, 0x25, 0x01, 0x7a, 0x05, 0x26, 0xb5, 0x05, 0xf0, 0x39, 0xfb, 0x27, 0xa3, 0x30,
+	0xbf, 0x38, 0x18, 0xa5, 0xe7, 0xe4, 0x17, 0xbb, 0x6a, 0x17, 0xe3, 0xdc, 0xd2, 0xc6, 0xf9, 0x47,
+	0x16, 0xcc, 0x32, 0x26, 0x1e, 0xd3, 0x19, 0xa1, 0xcb, 0xc2, 0x2a, 0xc9, 0xa2, 0x3a, 0x0a, 0x0d,
+	0xc3, 0x28, 0xc8, 0xeb, 0x63, 0x1e, 0x89, 0x89, 0x1f, 0x6c, 0x9a, 0x73, 0xf1, 0xb7, 0xc4, 0x34,
+	0x67, 0xdf, 0xee, 0x7b, 0xec, 0x99, 0xbe, 0x2a, 0x0b, 0x2e, 0xdd, 0xd7, 0xa1, 0xad, 0xe5, 0x4c,
+	0x90, 0x91, 0xfa, 0x0a, 0xcf, 0x1e, 0x47, 0x71, 0x7f, 0x6a, 0x61, 0xb4, 0xe2, 0xfb, 0x61, 0x14,
+	0x65, 0xbf, 0x58, 0x69, 0x5e, 0x6d, 0xa7, 0xff, 0x77, 0x0d, 0x68, 0x51, 0xa6, 0xae, 0xee, 0x7e,
+	0xb6, 0x6d, 0x25, 0xd8, 0xb3, 0xc3, 0x8d, 0x2b, 0x35, 0x6a, 0xab, 0xa5, 0x47, 0x6d, 0x2d, 0x41,
+	0x33, 0x97, 0xd7, 0xca, 0xf4, 0xa7, 0xb4, 0x0e, 0xdb, 0x8a, 0x75, 0x28, 0x6e, 0x6a, 0xb8, 0xbb,
+	0x21, 0xe7, 0x26, 0x19, 0xb3, 0x39, 0x67, 0xcc, 0x36, 0x67, 0xc7, 0x74, 0xfb, 0x0b, 0xc5, 0xed,
+	0x2f, 0x3d, 0x50, 0x10, 0x25, 0x24, 0x7a, 0x96, 0x5d, 0xa6, 0x9c, 0x90, 0x22, 0x20, 0x7a, 0x13,
+	0x66, 0xc2, 0xac, 0x37, 0xc0, 0x77, 0xe8, 0x73, 0x2c, 0x14, 0x3f, 0xcc, 0x3e, 0xa0, 0x9f, 0xba,
+	0xd6, 0xcd, 0x97, 0x67, 0xe0, 0x17, 0x30, 0x64, 0x89, 0x0f, 0x2b, 0x57, 0x0c, 0x17, 0xa6, 0x4e,
+	0x28, 0xa0, 0xfc, 0x74, 0x87, 0x62, 0x79, 0xac, 0x08, 0xe7, 0x98, 0x47, 0xfa, 0x49, 0xdc, 0x0f,
+	0x23, 0xf2, 0x12, 0xb4, 0xa2, 0x6e, 0x8e, 0xfd, 0xa1, 0x05, 0x8b, 0x92, 0x8f, 0x00, 0x7d, 0xc9,
+	0xda, 0x20, 0x5a, 0xfa, 0x20, 0xda, 0x8a, 0x99, 0x2d, 0xc6, 0xfc, 0x55, 0x58, 0x14, 0xb1, 0x3c,
+	0x3d, 0xed, 0x14, 0xb0, 0x20, 0xc0, 0xfb, 0x32, 0x94, 0x92, 0x76, 0xbe, 0x40, 0xe3, 0x51, 0x92,
+	0x0c, 0xc8, 0x90, 0xdc, 0x7f, 0xc2, 0xac, 0x46, 0x7e, 0x16, 0xbb, 0xc2, 0xce, 0xf4, 0xb2, 0x66,
+	0xcc, 0x5f, 0x37, 0x61, 0x51, 0x9c, 0x14, 0x53, 0x3f, 0xce, 0x4e, 0x48, 0x3a, 0x96, 0x2f, 0xfa,
+	0xbc, 0x93, 0xe3, 0x15, 0x81, 0x8e, 0x20, 0x40, 0x0f, 0x71, 0xfb, 0x90, 0x08, 0xca, 0x99, 0x64,
+	0x4e, 0x00, 0x45, 0x4c, 0x11, 0xbf, 0x4a, 0x6d, 0x69, 0xf1, 0xf2, 0xea, 0x0e, 0x36, 0x55, 0x1b,
+	0x1f, 0xd1, 0x36, 0xcd, 0x90, 0xe9, 0xda, 0xf8, 0x88, 0x99, 0xea, 0xcd, 0xea, 0x0e, 0xcc, 0xb1,
+	0xfb, 0xcc, 0x5e, 0xfe, 0x9c, 0x76, 0xa3, 0xc3, 0x8d, 0x0a, 0x84, 0x1d, 0x3d, 0x7f, 0x18, 0xd0,
+	0xd3, 0xb5, 0xc0, 0x48, 0x64, 0x3c, 0x25, 0x33, 0x25, 0x17, 0x39, 0x9a, 0x7c, 0x08, 0xba, 0x07,
+	0x2b, 0x1c, 0x97, 0x3e, 0xdb, 0x90, 0xd8, 0x6c, 0x62, 0xf2, 0x66, 0xde, 0x4f, 0x93, 0x81, 0xc0,
+	0xdf, 0xa0, 0x8f, 0x8d, 0xe2, 0xa7, 0xbd, 0x3c, 0xc1, 0xd9, 0xd9, 0xf1, 0xda, 0xf4, 0xf3, 0x28,
+	0x91, 0x97, 0xa8, 0xb4, 0x99, 0xee, 0x7c, 0x71, 0x89, 0x4a, 0x2b, 0x53, 0xc1, 0x3e, 0x93, 0x17,
+	0x97, 0x94, 0xe9, 0x05, 0x26, 0xd8, 0x02, 0xf8, 0xb0, 0xb4, 0xc1, 0x2e, 0x9a, 0x2d, 0xdf, 0xb2,
+	0x32, 0x16, 0xdb, 0xab, 0x18, 0xa3, 0xca, 0xf6, 0x5a, 0xd2, 0x10, 0xaf, 0xc0, 0x74, 0x7f, 0x00,
+	0xcb, 0xf7, 0xfd, 0xdc, 0x3f, 0xf6, 0x33, 0xf2, 0x41, 0x78, 0x9a, 0xfa, 0xe2, 0x39, 0x15, 0x7f,
+	0x31, 0xc2, 0x8d, 0xdc, 0x69, 0xe5, 0x9d, 0x8b, 0x72, 0x05, 0x2c, 0x9f, 0x14, 0xf9, 0xc3, 0x61,
+	0x14, 0x72, 0xb3, 0x74, 0xc6, 0x13, 0x9f, 0x78, 0xba, 0x65, 0x3f, 0xe9, 0x5d, 0x3f, 0x8f, 0x2c,
+	0xe7, 0x90, 0xfd, 0x9c, 0xbf, 0x66, 0x12, 0xe4, 0x79, 0x52, 0x0d, 0x6e, 0x75, 0xff, 0x9c, 0x39,
+	0x18, 0xcb, 0x85, 0x45, 0x6e, 0xb5, 0x20, 0x0d, 0xcf, 0x8b, 0x7b, 0x4e, 0xf6, 0xa5, 0x32, 0xde,
+	0xd0, 0x19, 0xa7, 0x99, 0x89, 0xfc, 0x9c, 0x64, 0x79, 0x4f, 0x20, 0x30, 0x13, 0x7a, 0x9e, 0x41,
+	0x9f, 0x70, 0xb4, 0x2e, 0x4c, 0x0f, 0x09, 0x0a, 0x8b, 0xef, 0xbd, 0xe2, 0x93, 0xc6, 0x2d, 0x0c,
+	0x84, 0x80, 0x32, 0xfe, 0xf2, 0x4f, 0x3e, 0xb1, 0xac, 0x88, 0xd0, 0x53, 0x90, 0x69, 0x72, 0x1d,
+	0x56, 0x40, 0x04, 0x9e, 0xe8, 0xe5, 0x19, 0x6c, 0x54, 0x4a, 0x8a, 0x40, 0xad, 0x9a, 0x31, 0x78,
+	0xa7, 0x90, 0x77, 0xe3, 0x32, 0x36, 0x04, 0xa6, 0xfb, 0x97, 0xcc, 0x95, 0xc1, 0xee, 0xb7, 0xae,
+	0xb0, 0x90, 0xbd, 0x48, 0x47, 0x9c, 0xb2, 0x22, 0xb6, 0xc6, 0xad, 0x88, 0x53, 0x35, 0x2b, 0x62,
+	0xdb, 0xbc, 0x22, 0x4e, 0x6b, 0x2b, 0xe2, 0xff, 0xb0, 0x60, 0x59, 0xeb, 0x25, 0xe6, 0x66, 0x18,
+	0x6f, 0xad, 0x51, 0x7b, 0x97, 0xa4, 0x61, 0x22, 0x8e, 0x5c, 0xfc, 0x8b, 0x6a, 0x7b, 0x32, 0x24,
+	0xb1, 0x78, 0xb1, 0x45, 0x7f, 0xcb, 0x04, 0x1d, 0xad, 0x6a, 0x82, 0x8e, 0xa9, 0x22, 0x41, 0xc7,
+	0x2a, 0x4c, 0xe1, 0x25, 0x93, 0x78, 0x1c, 0x81, 0x1f, 0x9f, 0x29, 0x6d, 0x07, 0x3d, 0x01, 0x84,
+	0xfd, 0xa7, 0x19, 0xf7, 0xeb, 0xb1, 0x0f, 0xf7, 0x4f, 0xd9, 0x06, 0x55, 0x1a, 0xd6, 0x97, 0xe9,
+	0xa2, 0x7a, 0x07, 0xa6, 0xcf, 0x18, 0xdd, 0x6e, 0x4b, 0xd7, 0xc6, 0xca, 0x28, 0x78, 0x02, 0xd3,
+	0xfd, 0xef, 0x16, 0xac, 0x97, 0x0d, 0x8e, 0x09, 0x98, 0xee, 0xc2, 0xf4, 0xc0, 0xcf, 0xfb, 0x67,
+	0xc5, 0xd9, 0x98, 0x7f, 0xda, 0x6f, 0xc1, 0xf4, 0x20, 0xcc, 0x32, 0x3a, 0x6f, 0x9b, 0xfa, 0xda,
+	0x57, 0xb2, 0x27, 0x3c, 0x81, 0x67, 0x7f, 0x91, 0x4e, 0xe8, 0x4c, 0xb4, 0xd7, 0x1a, 0x5f, 0x4b,
+	0x41, 0xb5, 0x6f, 0xc1, 0xf4, 0x28, 0x7e, 0x1a, 0x27, 0xcf, 0xe2, 0xee, 0x94, 0xc1, 0xa6, 0x12,
+	0x85, 0xee, 0xff, 0xd4, 0xef, 0x48, 0xd8, 0xa5, 0xe4, 0xaf, 0xa4, 0xc3, 0xb5, 0x7a, 0x8d, 0xdc,
+	0xae, 0x5e, 0x23, 0x53, 0x5a, 0xf4, 0xb1, 0x21, 0xe3, 0x9f, 0x07, 0x4e, 0x77, 0xc8, 0x73, 0xb1,
+	0x96, 0xfd, 0x27, 0x76, 0x8e, 0xaf, 0x74, 0xf7, 0x85, 0x2a, 0xa3, 0xec, 0x50, 0xd3, 0xd0, 0xa1,
+	0x56, 0xd1, 0x21, 0xf5, 0xda, 0xb8, 0x3d, 0xe6, 0xda, 0xb8, 0x59, 0x7f, 0x6d, 0xec, 0xfe, 0x1d,
+	0x0b, 0xda, 0x0c, 0x84, 0x07, 0x81, 0xe2, 0x7d, 0x1d, 0xfe, 0x16, 0x93, 0xbe, 0x51, 0x4c, 0x7a,
+	0xb1, 0x34, 0x34, 0x95, 0xa5, 0x41, 0x2c, 0x21, 0x2d, 0x65, 0x09, 0x91, 0x8b, 0xc3, 0x94, 0xba,
+	0x38, 0x14, 0x13, 0xbf, 0xad, 0x4e, 0x7c, 0xf7, 0x0f, 0x2c, 0x80, 0xc2, 0x7d, 0x24, 0xcf, 0x24,
+	0x96, 0x72, 0x26, 0xb9, 0x01, 0x10, 0x06, 0x24, 0xce, 0xa9, 0x8b, 0x46, 0x98, 0xc3, 0x0a, 0x04,
+	0xe7, 0x0d, 0xc9, 0x32, 0x91, 0x35, 0xa1, 0xe3, 0x89, 0x4f, 0x7d, 0x5d, 0x6c, 0x95, 0xd7, 0x45,
+	0xda, 0xa1, 0xc2, 0x6f, 0x84, 0xbf, 0xdd, 0x63, 0xe8, 0x3c, 0x38, 0x38, 0x3a, 0x44, 0x5b, 0x8c,
+	0x22, 0x7c, 0xf4, 0xd1, 0xc3, 0xfb, 0x82, 0x19, 0xfa, 0xdb, 0x68, 0x22, 0x08, 0x2f, 0x63, 0x53,
+	0xf1, 0x32, 0x6e, 0xc2, 0x0c, 0x5e, 0x80, 0xa4, 0x23, 0x11, 0x04, 0x3c, 0x4d, 0xbf, 0xbd, 0x51,
+	0xec, 0xde, 0x87, 0x0d, 0x49, 0xe3, 0x3d, 0x66, 0xb6, 0x8b, 0x69, 0x73, 0x07, 0xda, 0xcc, 0x0e,
+	0xe4, 0x29, 0x71, 0x96, 0x65, 0xfc, 0x90, 0xa8, 0xe0, 0x71, 0x04, 0x77, 0x1f, 0x56, 0x25, 0xf0,
+	0x30, 0x4f, 0x86, 0x9f, 0xa2, 0x89, 0x4d, 0xd8, 0xd0, 0x9a, 0xd8, 0x8f, 0x84, 0xcf, 0x18, 0x33,
+	0xe6, 0x15, 0x45, 0xaa, 0xe9, 0xa2, 0x56, 0x7a, 0x14, 0x66, 0xb9, 0x52, 0xe9, 0x5f, 0x58, 0x4a,
+	0xad, 0x8f, 0x86, 0x51, 0xe2, 0x07, 0x82, 0x2b, 0xea, 0x4a, 0x45, 0xb0, 0x1a, 0x83, 0x07, 0x0c,
+	0x84, 0x21, 0x76, 0x05, 0x02, 0xe6, 0x37, 0x69, 0xa8, 0x08, 0x74, 0xe3, 0x97, 0x99, 0x4f, 0x9a,
+	0x45, 0xe6, 0x13, 0x3a, 0x0f, 0xfc, 0xb4, 0x7f, 0x16, 0x9e, 0x73, 0x67, 0xc2, 0x8c, 0x27, 0xbf,
+	0xe9, 0xd8, 0x27, 0xe7, 0x24, 0x7d, 0x96, 0x86, 0x7c, 0xcf, 0x9d, 0xf1, 0x0a, 0x80, 0xfb, 0x00,
+	0x9c, 0x42, 0x1e, 0xc4, 0x0f, 0xc4, 0xaf, 0x2b, 0xcb, 0xf0, 0x1e, 0xac, 0x49, 0xe0, 0x6f, 0x52,
+	0x4f, 0xf2, 0xa7, 0x68, 0xe3, 0x9b, 0xd0, 0x95, 0xc0, 0xfd, 0x51, 0x9e, 0x3c, 0x52, 0x04, 0xb7,
+	0xae, 0x35, 0xd3, 0x11, 0x75, 0x4a, 0x01, 0xd2, 0x33, 0x32, 0xde, 0xf3, 0x7b, 0xda, 0x98, 0x96,
+	0xcd, 0x4a, 0x99, 0x9d, 0x53, 0x3d, 0xd7, 0xbc, 0x0e, 0xd3, 0xac, 0x51, 0xf1, 0x42, 0xc9, 0xc0,
+	0xaa, 0xc0, 0x70, 0x13, 0x58, 0x2f, 0xf7, 0xf7, 0x92, 0xe6, 0x0b, 0x41, 0x34, 0x2e, 0x11, 0x84,
+	0x36, 0xc6, 0x1d, 0x9e, 0xdd, 0xe6, 0x37, 0x60, 0x91, 0x27, 0xa4, 0xbc, 0x94, 0x92, 0xa8, 0xde,
+	0x50, 0xaa, 0xf7, 0x31, 0x9c, 0x50, 0xdc, 0xf3, 0x62, 0xec, 0xdc, 0xa7, 0x8e, 0x02, 0x54, 0x02,
+	0xd5, 0x9a, 0x5a, 0xa0, 0xda, 0x63, 0x70, 0x54, 0x22, 0x51, 0x34, 0x71, 0xb4, 0x61, 0xd1, 0x62,
+	0x43, 0x6b, 0x71, 0x1f, 0x6e, 0xb2, 0x6b, 0x4d, 0xd1, 0xa8, 0x0c, 0xdd, 0x9b, 0xb4, 0x69, 0xf7,
+	0x0b, 0x5a, 0xc4, 0x22, 0xf6, 0x7c, 0xa2, 0x7a, 0xef, 0xc0, 0xa6, 0xa1, 0x5e, 0x21, 0x7a, 0x19,
+	0xe0, 0x88, 0xa2, 0x67, 0x5f, 0xee, 0xe7, 0x61, 0xe3, 0x63, 0x72, 0x9c, 0x25, 0xfd, 0xa7, 0x24,
+	0xd7, 0xb3, 0x47, 0x8f, 0xa5, 0xf5, 0x93, 0x06, 0x74, 0xab, 0xf5, 0x26, 0xd8, 0x53, 0x31, 0x89,
+	0x2d, 0x97, 0x08, 0x17, 0x5d, 0x01, 0x50, 0xd3, 0x40, 0x34, 0xf5, 0x34, 0x10, 0x5f, 0x84, 0x0d,
+	0x3d, 0x71, 0x62, 0xd1, 0x0a, 0x5b, 0x40, 0xd6, 0xb5, 0x62, 0x29, 0x75, 0xfb, 0xd7, 0x60, 0x5e,
+	0x2b, 0xe1, 0x4b, 0x8a, 0x0e, 0xa4, 0xab, 0x58, 0x3a, 0x8a, 0x63, 0x7a, 0xe3, 0x3d, 0x4a, 0xc5,
+	0xde, 0x0c, 0x1c, 0xf4, 0x51, 0x1a, 0x51, 0x53, 0x04, 0x93, 0x4b, 0xca, 0x73, 0x38, 0x73, 0xbe,
+	0xcd, 0x21, 0x50, 0x24, 0x90, 0x7d, 0x0c, 0x8e, 0x14, 0x0a, 0xd5, 0x2b, 0xc6, 0xfb, 0x67, 0x51,
+	0xa7, 0xaf, 0xc1, 0x8e, 0x2a, 0x66, 0x9a, 0x4d, 0x57, 0xb8, 0x1b, 0x26, 0xd2, 0x89, 0xdf, 0x82,
+	0xb5, 0x82, 0x23, 0xa5, 0x32, 0xbb, 0xcf, 0xf1, 0xe3, 0x98, 0x44, 0xc2, 0x7d, 0xc5, 0x3f, 0xc7,
+	0xde, 0x5e, 0xc8, 0xd9, 0xd5, 0x2c, 0xcd, 0x2e, 0xe5, 0xd1, 0x5c, 0xc7, 0xe3, 0x5f, 0xd4, 0x52,
+	0xd9, 0x1d, 0xc3, 0xfd, 0x04, 0xda, 0x72, 0x00, 0xf3, 0x99, 0x5a, 0x89, 0xaf, 0x73, 0x32, 0xaa,
+	0xdc, 0xd8, 0x37, 0x4f, 0xaf, 0xe3, 0x3e, 0x52, 0x54, 0xf5, 0x90, 0xe4, 0x98, 0x12, 0x74, 0xc2,
+	0xa5, 0x04, 0x47, 0x57, 0x2c, 0x25, 0xf8, 0xe1, 0xbe, 0x0f, 0xeb, 0x6a, 0x6b, 0x1f, 0x79, 0x8f,
+	0x26, 0x69, 0x6b, 0x09, 0x9a, 0x54, 0xaf, 0x58, 0x4b, 0xf4, 0xe7, 0xdb, 0x7f, 0xfe, 0x18, 0x16,
+	0x1e, 0x24, 0x2c, 0x74, 0x1c, 0x43, 0x7e, 0x52, 0xfb, 0x43, 0x98, 0xe6, 0x53, 0xc9, 0x5e, 0xaf,
+	0x64, 0x15, 0x47, 0x1a, 0xce, 0x46, 0x4d, 0xb6, 0x71, 0x77, 0xe5, 0x47, 0xff, 0xf9, 0x2f, 0x7e,
+	0xd2, 0x98, 0xb7, 0x67, 0xef, 0x9e, 0xbf, 0x75, 0xf7, 0x94, 0xe4, 0x18, 0xd2, 0x7d, 0x0a, 0xf3,
+	0x5a, 0x4e, 0x68, 0xfb, 0xba, 0x96, 0xd7, 0xb9, 0x94, 0x2a, 0xda, 0xd9, 0x1a, 0x9b, 0xf5, 0xd9,
+	0xdd, 0x44, 0x12, 0x2b, 0xf6, 0x32, 0x27, 0x51, 0xa4, 0x7b, 0xb6, 0xcf, 0x60, 0x91, 0x29, 0xbb,
+	0x6c, 0xd4, 0xde, 0x2e, 0x1a, 0x33, 0xa6, 0xb3, 0x76, 0x36, 0x4a, 0x08, 0x92, 0xce, 0x35, 0xa4,
+	0xb3, 0x66, 0xaf, 0x50, 0x3a, 0x6c, 0x1e, 0x48, 0x52, 0xf6, 0xf7, 0x61, 0x89, 0xa7, 0xd4, 0x7d,
+	0x11, 0xa4, 0xae, 0x23, 0xa9, 0x75, 0x7b, 0x95, 0x92, 0x0a, 0xc2, 0x4c, 0xa7, 0x95, 0xe0, 0x75,
+	0x84, 0x9a, 0xda, 0xd9, 0xbe, 0x51, 0x9b, 0xf3, 0x99, 0x51, 0xda, 0xbe, 0x24, 0x27, 0xb4, 0xde,
+	0xb9, 0x53, 0x42, 0x71, 0x65, 0x5a, 0x68, 0xfb, 0x27, 0xec, 0xd8, 0x6c, 0x4c, 0x34, 0x6e, 0xbf,
+	0x7a, 0x79, 0x76, 0x73, 0xc6, 0xc3, 0xed, 0x49, 0xd3, 0xa0, 0xbb, 0xbf, 0x86, 0xcc, 0xdc, 0xb0,
+	0xaf, 0x73, 0x66, 0xb4, 0xd4, 0xe7, 0x22, 0xb9, 0xba, 0xdd, 0x87, 0x39, 0x65, 0x5f, 0xc9, 0xec,
+	0x6b, 0x86, 0xd8, 0x78, 0x49, 0xfc, 0xba, 0xb9, 0x90, 0x13, 0xec, 0x22, 0x41, 0xdb, 0x5e, 0xe2,
+	0x04, 0x89, 0x6c, 0x34, 0x86, 0xc5, 0x52, 0xaa, 0x64, 0xdb, 0x2d, 0x8d, 0x9a, 0x21, 0xaf, 0x75,
+	0xfd, 0xc8, 0xde, 0x40, 0x4a, 0x5d, 0x77, 0x45, 0x19, 0x59, 0x41, 0xed, 0xcb, 0xd6, 0x6b, 0x76,
+	0x86, 0x63, 0xab, 0x66, 0xf2, 0x9d, 0x88, 0xde, 0xf6, 0x25, 0x69, 0x80, 0x2b, 0xe3, 0x2b, 0x68,
+	0xe2, 0x7c, 0xcc, 0xc0, 0x56, 0xea, 0x7d, 0x78, 0xf4, 0x18, 0x83, 0x11, 0x26, 0xa1, 0xbb, 0x65,
+	0xce, 0x5f, 0xcd, 0x53, 0x68, 0xbb, 0x0e, 0x52, 0x5d, 0xb5, 0xed, 0x12, 0xd5, 0x24, 0x1f, 0xda,
+	0x19, 0xac, 0x54, 0x89, 0xea, 0x9a, 0x6c, 0x48, 0xb0, 0xed, 0x6c, 0xd7, 0x96, 0x5f, 0xd2, 0xd3,
+	0x24, 0x1f, 0x66, 0x76, 0x44, 0x13, 0x9c, 0xbf, 0xb8, 0xd1, 0xdc, 0x42, 0x5a, 0x1b, 0xae, 0x5d,
+	0x2c, 0x09, 0xea, 0x60, 0x7e, 0x0c, 0x1d, 0xe9, 0x6d, 0xb2, 0xbb, 0x0a, 0xe3, 0x5a, 0x6a, 0x60,
+	0xa7, 0x26, 0xf1, 0xab, 0xd0, 0x4a, 0x77, 0x9e, 0xf7, 0x84, 0xa5, 0x71, 0xa5, 0x0d, 0x7f, 0x17,
+	0x40, 0xb6, 0x92, 0xd9, 0x9b, 0x95, 0x96, 0xa5, 0xb4, 0x1c, 0x53, 0x11, 0x6f, 0x7e, 0x1d, 0x9b,
+	0x5f, 0xb2, 0x17, 0xb4, 0xe6, 0xc5, 0xbc, 0x92, 0x4f, 0x13, 0xb4, 0x79, 0x55, 0xce, 0x1d, 0xeb,
+	0xd4, 0x27, 0x0d, 0x15, 0x03, 0xe1, 0x8a, 0x49, 0x25, 0x1f, 0xd7, 0xd3, 0x1e, 0xb0, 0x2d, 0x40,
+	0x56, 0xd2, 0xb7, 0x80, 0x4a, 0x66, 0x53, 0x67, 0xab, 0xa6, 0xb4, 0x66, 0x0b, 0x48, 0x8a, 0x76,
+	0x9f, 0xe2, 0x5f, 0x1f, 0x51, 0x92, 0x6d, 0xda, 0x6a, 0x5b, 0xd5, 0xcc, 0xa3, 0xce, 0x8d, 0xba,
+	0xe2, 0xcc, 0xac, 0xd3, 0x3c, 0x1e, 0x01, 0x27, 0xd2, 0x05, 0x0b, 0x0b, 0x2b, 0x6a, 0xb1, 0xc8,
+	0xcf, 0xcf, 0x4a, 0x72, 0x07, 0x49, 0x3a, 0x76, 0xb7, 0x4a, 0x32, 0x43, 0x02, 0x9f, 0xb3, 0xb8,
+	0xae, 0xb1, 0xec, 0x9e, 0x9a, 0xae, 0x69, 0x49, 0x40, 0x9d, 0x4d, 0x43, 0x09, 0xa7, 0xb2, 0x86,
+	0x54, 0x16, 0xed, 0x79, 0xb9, 0xea, 0x62, 0x5b, 0x4c, 0x1d, 0x64, 0x9c, 0x82, 0xa6, 0x0e, 0xe5,
+	0xdc, 0x9c, 0xce, 0x75, 0x73, 0x61, 0xcd, 0x32, 0x2b, 0x73, 0x70, 0xda, 0x3f, 0xd4, 0x53, 0x7d,
+	0x8a, 0xd4, 0x83, 0xee, 0xd8, 0x5c, 0x81, 0x8c, 0xe4, 0xcd, 0x09, 0xf2, 0x09, 0xba, 0xdb, 0x48,
+	0x79, 0xd3, 0xde, 0x28, 0x53, 0xe6, 0xb9, 0x09, 0xed, 0x73, 0x58, 0x31, 0x64, 0xe2, 0x2b, 0x18,
+	0xa8, 0x4f, 0xd3, 0x57, 0xbf, 0x3a, 0xb8, 0x48, 0xf4, 0xba, 0x8b, 0x44, 0xfd, 0x20, 0x90, 0x44,
+	0xb9, 0xad, 0x4e, 0xe7, 0xc1, 0x0f, 0x61, 0x9d, 0xe5, 0x0c, 0xa8, 0x90, 0x7e, 0xa5, 0x70, 0xae,
+	0x8e, 0x49, 0x9e, 0x57, 0x4f, 0xfd, 0x15, 0xa4, 0xbe, 0xed, 0x3a, 0x94, 0x7a, 0x8a, 0x6d, 0x98,
+	0x18, 0x78, 0x86, 0x89, 0x2b, 0xf4, 0xbc, 0x70, 0xf6, 0x8e, 0x22, 0x53, 0x63, 0xfa, 0x3c, 0x67,
+	0x77, 0x0c, 0x86, 0xbe, 0x38, 0xda, 0x6b, 0x5c, 0xe6, 0x98, 0x4c, 0x4d, 0x26, 0x98, 0xe3, 0x2b,
+	0x40, 0x91, 0x77, 0x4d, 0x5b, 0x01, 0x2a, 0xa9, 0xe4, 0x9c, 0xad, 0x9a, 0xd2, 0x9a, 0x15, 0x00,
+	0x89, 0xa5, 0xd8, 0xee, 0x77, 0xa0, 0x23, 0x56, 0x8d, 0x4c, 0x9b, 0x19, 0x5a, 0xee, 0x17, 0x67,
+	0xd3, 0x50, 0x52, 0xb3, 0x10, 0xe3, 0xf2, 0x82, 0xd2, 0xf3, 0x60, 0x46, 0xa0, 0xdb, 0x1b, 0xe5,
+	0x06, 0x44, 0xcb, 0xc6, 0x54, 0x58, 0xee, 0x06, 0x36, 0xba, 0xec, 0xce, 0xa9, 0x8d, 0xd2, 0x36,
+	0x8f, 0x61, 0x56, 0x49, 0xfb, 0x64, 0xcb, 0x25, 0xbc, 0x9a, 0xe5, 0xca, 0xb9, 0x66, 0x2c, 0xd3,
+	0x17, 0x2a, 0x77, 0x91, 0x12, 0xc8, 0x10, 0x41, 0xd2, 0xf8, 0x3e, 0xcc, 0x6b, 0x99, 0x97, 0x0a,
+	0xe1, 0x9b, 0x72, 0x43, 0x39, 0x5b, 0x35, 0xa5, 0xba, 0xb9, 0xea, 0xa2, 0xf0, 0x33, 0x8e, 0x22,
+	0x69, 0x7d, 0x0f, 0x3a, 0x32, 0xe1, 0x51, 0x21, 0xff, 0x72, 0x0e, 0xa4, 0xcb, 0x68, 0x68, 0x63,
+	0xf0, 0x8c, 0x56, 0x3e, 0x4e, 0x06, 0xc7, 0xac, 0xfd, 0x59, 0x25, 0x7d, 0x51, 0x21, 0xaf, 0x6a,
+	0x4e, 0xa3, 0xfa, 0xc9, 0xa2, 0xc9, 0xaa, 0x8f, 0x15, 0x25, 0xff, 0x29, 0x2c, 0x96, 0x32, 0xeb,
+	0x14, 0x46, 0x8a, 0x39, 0x8f, 0x90, 0xb3, 0x5d, 0x5b, 0x6e, 0x32, 0x03, 0x19, 0x3d, 0x3f, 0x8a,
+	0x0a, 0xbd, 0x62, 0xab, 0x39, 0x8b, 0x80, 0xd4, 0x74, 0x56, 0x0b, 0xb6, 0x74, 0x36, 0x0d, 0x25,
+	0x35, 0xab, 0x39, 0x0b, 0xec, 0xb5, 0x9f, 0xc0, 0x8c, 0xc8, 0x63, 0x52, 0x28, 0x6c, 0x29, 0x83,
+	0x8b, 0xd3, 0xad, 0x16, 0xf0, 0x56, 0x35, 0xa5, 0xf5, 0x83, 0x00, 0x5b, 0xe5, 0x83, 0xa0, 0xe4,
+	0x3e, 0x29, 0x06, 0xa1, 0x9a, 0x10, 0x65, 0xc2, 0x41, 0x60, 0x2b, 0x96, 0x6c, 0xff, 0x5f, 0x5a,
+	0xf8, 0x70, 0x72, 0x7c, 0x9e, 0x12, 0xfb, 0x73, 0x57, 0x48, 0x69, 0xc2, 0x98, 0x79, 0xeb, 0xca,
+	0x49, 0x50, 0xdc, 0xdb, 0xc8, 0xa6, 0xeb, 0x6e, 0x89, 0x7d, 0x12, 0xab, 0x05, 0x0c, 0x5d, 0x66,
+	0x44, 0xa1, 0x4c, 0xff, 0xb1, 0xc5, 0xfe, 0x62, 0xd5, 0x98, 0x76, 0xed, 0xbd, 0x09, 0x19, 0x10,
+	0x0c, 0xdf, 0x9d, 0x18, 0x9f, 0xb3, 0x7b, 0x0b, 0xd9, 0xdd, 0x71, 0xaf, 0x8d, 0x61, 0x97, 0x32,
+	0x1b, 0xc1, 0xb2, 0x9a, 0xcf, 0x84, 0x06, 0x41, 0x28, 0x67, 0x2a, 0x43, 0xaa, 0x13, 0xa7, 0x5b,
+	0x2e, 0x2c, 0x1b, 0x2c, 0x2e, 0x2e, 0xfd, 0x22, 0x64, 0x83, 0x06, 0xf3, 0xd1, 0xe7, 0x1b, 0x48,
+	0xed, 0xf7, 0xad, 0x22, 0x95, 0x86, 0xde, 0x0d, 0x46, 0x78, 0xab, 0xdc, 0xb6, 0x96, 0xb1, 0x64,
+	0x0c, 0xe9, 0x77, 0x90, 0xf4, 0x9b, 0xee, 0x6d, 0x95, 0x34, 0xff, 0x8f, 0x75, 0x1d, 0x79, 0xd0,
+	0xb9, 0xf9, 0x91, 0x92, 0xcc, 0x45, 0x49, 0xec, 0x51, 0x6c, 0xff, 0xf5, 0x39, 0x42, 0x9c, 0x9b,
+	0x63, 0x71, 0x4c, 0xa6, 0x40, 0x11, 0xc3, 0x82, 0xea, 0x7d, 0x7c, 0x11, 0x06, 0x94, 0x89, 0x9f,
+	0x59, 0xe0, 0xd4, 0x67, 0xc9, 0xb0, 0xef, 0xd4, 0xd0, 0xa9, 0xe6, 0x0a, 0x71, 0x5e, 0x9b, 0x04,
+	0xf5, 0x0a, 0x9c, 0xfd, 0x03, 0x2d, 0xe7, 0x83, 0x9a, 0x3a, 0xa4, 0xb0, 0x52, 0xc6, 0xa6, 0x16,
+	0xb9, 0x12, 0x47, 0xfc, 0xf4, 0xef, 0x6e, 0x1a, 0x39, 0x0a, 0xfc, 0x9c, 0x1f, 0x94, 0x97, 0xca,
+	0x69, 0x04, 0x54, 0x87, 0x8b, 0xf1, 0xc1, 0xbf, 0xb3, 0x53, 0x8f, 0x60, 0xf2, 0xbc, 0x9c, 0x92,
+	0x9c, 0x65, 0x04, 0x08, 0x38, 0x81, 0x73, 0x58, 0x3a, 0xac, 0x25, 0x7a, 0xf8, 0xa9, 0x89, 0x72,
+	0xeb, 0xd4, 0x45, 0xa2, 0x59, 0x89, 0x28, 0xed, 0xec, 0x39, 0x4b, 0xae, 0xa6, 0x3e, 0xf8, 0xb7,
+	0xb7, 0xeb, 0x53, 0x01, 0x54, 0xe9, 0x1a, 0x73, 0x05, 0xe8, 0x74, 0x95, 0xa3, 0x32, 0xbe, 0xa2,
+	0x67, 0x66, 0xc2, 0x62, 0xe9, 0x25, 0x7f, 0xb1, 0xf5, 0x99, 0x9f, 0xf8, 0x4f, 0xe8, 0xf9, 0xc8,
+	0x74, 0x62, 0x94, 0x56, 0x8e, 0x4e, 0x88, 0xd2, 0x8b, 0x78, 0x7b, 0xd7, 0x74, 0xf0, 0xd3, 0xde,
+	0xd3, 0x8d, 0x3b, 0x82, 0x72, 0x9a, 0xf6, 0x7a, 0xe5, 0x5c, 0x28, 0x8e, 0x4d, 0x7f, 0xc0, 0xae,
+	0xe1, 0x6b, 0x1e, 0xe4, 0xdb, 0x77, 0x4c, 0xde, 0x86, 0x2b, 0xb3, 0xc1, 0x97, 0x60, 0xfb, 0x46,
+	0xd9, 0x25, 0x51, 0x61, 0xe7, 0x0c, 0x16, 0xe5, 0x49, 0x9d, 0xb3, 0x70, 0xa3, 0x72, 0x84, 0xd7,
+	0xe9, 0xd6, 0x79, 0x0f, 0xca, 0x7e, 0x10, 0x7e, 0xbc, 0x17, 0x94, 0x7e, 0x47, 0xff, 0x43, 0x65,
+	0x1a, 0xc9, 0x5b, 0x86, 0x5e, 0x5f, 0x85, 0xf4, 0x4d, 0x24, 0xbd, 0x65, 0x5f, 0x2b, 0xf5, 0xb7,
+	0xc4, 0x42, 0x8c, 0x9d, 0x55, 0x1f, 0xcb, 0x6b, 0x9d, 0x35, 0xbc, 0xbc, 0x2f, 0xce, 0x97, 0xa6,
+	0x27, 0xf6, 0x95, 0x2e, 0xb3, 0xc8, 0x04, 0x49, 0xef, 0x77, 0xf5, 0x2e, 0x6b, 0x84, 0x4d, 0x5d,
+	0xbe, 0x3a, 0x03, 0x75, 0x1d, 0x2f, 0x31, 0x12, 0x61, 0xc7, 0xd5, 0x77, 0xd6, 0x5a, 0xc7, 0x0d,
+	0x4f, 0xec, 0x0b, 0xba, 0xa6, 0xd7, 0xd9, 0x95, 0x8e, 0xa7, 0x88, 0xc4, 0x9e, 0xe8, 0xda, 0x01,
+	0x7a, 0x40, 0x94, 0x87, 0xf3, 0x9a, 0x3b, 0xa2, 0xfa, 0xa0, 0xde, 0x31, 0x3f, 0xf9, 0xad, 0x38,
+	0x3e, 0x58, 0xf3, 0xa2, 0x4f, 0xec, 0x1c, 0xa9, 0xbf, 0x70, 0xd6, 0xce, 0x91, 0xc6, 0x67, 0xda,
+	0xce, 0xee, 0x18, 0x8c, 0x9a, 0x73, 0xe4, 0x19, 0x47, 0xe3, 0xdd, 0xcb, 0x91, 0xb0, 0xfe, 0x68,
+	0x56, 0x23, 0x6c, 0x7c, 0x9c, 0x5c, 0xf8, 0x5c, 0xcc, 0xcf, 0x6d, 0x2b, 0x54, 0x03, 0x89, 0x86,
+	0x9e, 0x9e, 0xdf, 0x63, 0xba, 0x64, 0x7a, 0xe7, 0xab, 0xe9, 0xd2, 0x98, 0x87, 0xc0, 0x97, 0xb2,
+	0x50, 0xd6, 0x26, 0x9d, 0x05, 0x29, 0xf9, 0x7f, 0xca, 0xac, 0xcc, 0x71, 0x4f, 0x8f, 0x35, 0x2b,
+	0x73, 0x82, 0x37, 0xca, 0x97, 0xb2, 0xf6, 0x3a, 0xb2, 0xf6, 0x8a, 0x7d, 0xb3, 0xa4, 0xe8, 0x35,
+	0x2c, 0xb2, 0x1b, 0x0b, 0xf5, 0x61, 0xa8, 0xa6, 0xf0, 0x86, 0x17, 0xb6, 0xce, 0x76, 0x6d, 0x79,
+	0x8d, 0xce, 0xab, 0x0f, 0x34, 0xb9, 0x73, 0x41, 0x89, 0x03, 0x52, 0x9d, 0x0b, 0x95, 0x67, 0x9b,
+	0xce, 0x56, 0x4d, 0x69, 0x8d, 0x73, 0xc1, 0xa7, 0x28, 0x68, 0x8f, 0x70, 0xb5, 0xd7, 0x5f, 0xcb,
+	0x69, 0xda, 0x67, 0x7c, 0x42, 0xa9, 0xa9, 0xbd, 0xf9, 0x6d, 0x5e, 0x45, 0x01, 0x79, 0x24, 0xd9,
+	0xf7, 0x39, 0x8d, 0x67, 0xb0, 0x5c, 0x79, 0x2b, 0x57, 0x10, 0xae, 0x7b, 0x9e, 0xe7, 0xec, 0x8e,
+	0xc1, 0x30, 0x11, 0xc6, 0x47, 0x6e, 0x17, 0x45, 0x87, 0xb3, 0xb2, 0xab, 0x4e, 0x24, 0x57, 0x37,
+	0xba, 0xea, 0xf4, 0xc8, 0x59, 0xe7, 0xe6, 0x58, 0x9c, 0xcb, 0x5c, 0x75, 0x3c, 0x1a, 0x92, 0x7b,
+	0x74, 0x95, 0x57, 0x3d, 0xda, 0x7a, 0x56, 0x7d, 0xf9, 0xe4, 0xdc, 0xa8, 0x2b, 0xae, 0x59, 0xd8,
+	0x08, 0xe2, 0xf4, 0xb1, 0xe9, 0x43, 0x74, 0xf0, 0x60, 0xcc, 0xa5, 0xe6, 0xe0, 0x51, 0x9f, 0x7d,
+	0x38, 0xdd, 0x6a, 0x01, 0x6f, 0x7a, 0x15, 0x9b, 0x5e, 0xb0, 0x85, 0x93, 0x07, 0x1f, 0x90, 0xd0,
+	0x1e, 0xe8, 0xe1, 0x9c, 0x45, 0x0f, 0x8c, 0xef, 0x4a, 0x9c, 0x1b, 0x75, 0xc5, 0xa6, 0x1e, 0xa4,
+	0x02, 0x87, 0x11, 0xe3, 0x0e, 0x3e, 0x2d, 0x0c, 0x5e, 0x77, 0xf0, 0x99, 0x9e, 0x6b, 0x38, 0xbb,
+	0x63, 0x30, 0xea, 0x1c, 0x7c, 0x0c, 0x4d, 0x8c, 0x13, 0x23, 0xac, 0x87, 0xa4, 0xeb, 0x0b, 0xb3,
+	0x29, 0x94, 0xdd, 0xd9, 0x1d, 0x83, 0x51, 0xb7, 0x36, 0x73, 0x34, 0x1e, 0xb6, 0x93, 0xc2, 0x62,
+	0x29, 0x4c, 0xbc, 0x58, 0x6d, 0xcc, 0x91, 0xe5, 0xce, 0x76, 0x6d, 0xb9, 0xc9, 0x7a, 0x65, 0xc1,
+	0xea, 0x44, 0x90, 0x2d, 0x8e, 0x23, 0x5a, 0x0c, 0xaf, 0x66, 0xa1, 0x9b, 0x22, 0xc9, 0x9d, 0x9d,
+	0x7a, 0x84, 0x9a, 0xe3, 0x08, 0xb3, 0xa0, 0x8a, 0x99, 0xb0, 0x54, 0x0e, 0xfc, 0x53, 0x88, 0x9a,
+	0x43, 0x02, 0x2f, 0xbd, 0xcc, 0xe2, 0x52, 0xed, 0xe7, 0x2c, 0x54, 0xe1, 0x2e, 0x7f, 0x08, 0x64,
+	0x3f, 0x85, 0xc5, 0x52, 0x2c, 0x9e, 0xb2, 0x86, 0x1b, 0x83, 0xf4, 0xea, 0x49, 0xe9, 0x07, 0x0f,
+	0x49, 0x6a, 0x84, 0xb5, 0xa9, 0x38, 0x9f, 0xc3, 0x8a, 0x21, 0x9c, 0x4e, 0x59, 0x64, 0x6a, 0x63,
+	0xed, 0x9c, 0x2a, 0x53, 0x5a, 0x58, 0x99, 0x7e, 0x67, 0x57, 0xd0, 0x4e, 0x09, 0xa3, 0x3c, 0x54,
+	0xba, 0xc9, 0x75, 0xb6, 0xda, 0xa2, 0xae, 0xb1, 0xdb, 0xb5, 0xe5, 0xc6, 0x51, 0x94, 0x24, 0xb9,
+	0xba, 0x46, 0xb0, 0xa0, 0xb3, 0xaa, 0xac, 0x67, 0xa6, 0x48, 0xc0, 0x4b, 0x7b, 0xa8, 0xef, 0x8c,
+	0x92, 0x1c, 0x7b, 0x97, 0x4e, 0x60, 0x5e, 0x8b, 0xd1, 0x54, 0x76, 0x46, 0x43, 0xf4, 0xe7, 0x84,
+	0x57, 0x9f, 0x6a, 0x9f, 0x92, 0x21, 0x15, 0xa3, 0xaa, 0x9a, 0x3c, 0x14, 0xd4, 0xde, 0x36, 0x52,
+	0x2a, 0xe2, 0x3d, 0x3f, 0x35, 0xb1, 0x0c, 0x96, 0xca, 0x21, 0xa4, 0x06, 0x62, 0x7a, 0x70, 0xe9,
+	0xe5, 0xa3, 0x76, 0x09, 0xd1, 0x67, 0xb0, 0x51, 0x09, 0xb2, 0x3c, 0x4a, 0x4e, 0x4f, 0x23, 0xa2,
+	0x2c, 0x72, 0x35, 0x51, 0x98, 0xf5, 0x3d, 0xdd, 0x45, 0xa2, 0xd7, 0xdc, 0x75, 0x9d, 0xa8, 0x3f,
+	0xca, 0x13, 0x31, 0x37, 0x7e, 0x0b, 0x0f, 0xca, 0xa5, 0xc0, 0x71, 0xdb, 0x64, 0x48, 0xeb, 0x31,
+	0xf4, 0x8e, 0x3b, 0x0e, 0xa5, 0xe6, 0xc4, 0x2c, 0x8c, 0x6d, 0x7e, 0x82, 0xa1, 0x71, 0x2e, 0xe5,
+	0xf0, 0x47, 0xcd, 0x03, 0x62, 0x0a, 0x8c, 0x9c, 0x30, 0xce, 0x45, 0xf1, 0x09, 0xb0, 0xf8, 0xad,
+	0x0c, 0x56, 0x0e, 0x09, 0x1d, 0x32, 0xdd, 0xf1, 0xe1, 0x9a, 0xc8, 0xe9, 0x21, 0x92, 0x97, 0xae,
+	0x3c, 0xcc, 0xba, 0xc8, 0x48, 0xee, 0x47, 0x91, 0xe6, 0xf5, 0xb0, 0xff, 0xd0, 0x82, 0xeb, 0xe3,
+	0x22, 0x25, 0xed, 0xd7, 0x45, 0xd3, 0x13, 0xc4, 0x53, 0xd6, 0xf3, 0xc1, 0x9d, 0xc8, 0xf6, 0x0e,
+	0xe5, 0x83, 0xe5, 0xa0, 0x11, 0x7c, 0xc8, 0x08, 0x42, 0xc6, 0x10, 0xdb, 0x46, 0x35, 0xb9, 0xea,
+	0xdb, 0xa8, 0x31, 0x22, 0xd3, 0xd9, 0x1d, 0x83, 0x51, 0xb3, 0x8d, 0x6a, 0xd2, 0xcf, 0xe8, 0xac,
+	0x2a, 0x87, 0x52, 0x16, 0x43, 0x5d, 0x13, 0x9c, 0xe9, 0xec, 0xd4, 0x23, 0x98, 0xc6, 0xfc, 0x99,
+	0xc0, 0x12, 0xa1, 0x61, 0x19, 0xac, 0x18, 0x42, 0x15, 0x15, 0x47, 0x6c, 0x6d, 0x1c, 0xe3, 0x84,
+	0x63, 0x2e, 0x29, 0x66, 0x24, 0x17, 0x41, 0x9c, 0x3f, 0xb3, 0x60, 0xb3, 0x36, 0x20, 0xd0, 0xbe,
+	0x6d, 0xea, 0x92, 0x29, 0xe2, 0xd1, 0xb9, 0x33, 0x01, 0xa6, 0x7e, 0x3b, 0x6b, 0x6f, 0x95, 0xa5,
+	0xa0, 0xc5, 0x08, 0xda, 0x03, 0x58, 0xae, 0xc4, 0x08, 0xda, 0x3b, 0x26, 0x61, 0xa8, 0xe1, 0x83,
+	0x13, 0xee, 0xf1, 0xaa, 0x28, 0x30, 0x88, 0xd0, 0x3e, 0x85, 0xc5, 0x52, 0x10, 0x61, 0xb1, 0xf9,
+	0x99, 0xa3, 0x0b, 0x27, 0x0c, 0x97, 0x53, 0x49, 0x8d, 0xd2, 0xe8, 0xb8, 0x8d, 0xa9, 0x8b, 0xdf,
+	0xf9, 0xff, 0x03, 0x00, 0xbb, 0x14, 0x81, 0xf7, 0x6e, 0x86, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -7682,7 +11014,26 @@ type GoCryptoTraderClient interface {
 	GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeOrderbookStreamClient, error)
 	GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTickerStreamClient, error)
 	GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeTickerStreamClient, error)
+	GetCandleStream(ctx context.Context, in *GetCandleStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetCandleStreamClient, error)
+	GetExchangeCandleStream(ctx context.Context, in *GetExchangeCandleStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeCandleStreamClient, error)
+	GetRecentTrades(ctx context.Context, in *GetRecentTradesRequest, opts ...grpc.CallOption) (*RecentTradesResponse, error)
+	GetTradeStream(ctx context.Context, in *GetTradeStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTradeStreamClient, error)
+	GetHistoricTrades(ctx context.Context, in *GetHistoricTradesRequest, opts ...grpc.CallOption) (*GetHistoricTradesResponse, error)
+	GetDerivativeInfo(ctx context.Context, in *GetDerivativeInfoRequest, opts ...grpc.CallOption) (*DerivativeInfoResponse, error)
+	GetDerivativeInfoStream(ctx context.Context, in *GetDerivativeInfoStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetDerivativeInfoStreamClient, error)
+	GetExchangeDerivativeInfoStream(ctx context.Context, in *GetExchangeDerivativeInfoStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeDerivativeInfoStreamClient, error)
+	GetLiquidations(ctx context.Context, in *GetLiquidationsRequest, opts ...grpc.CallOption) (*GetLiquidationsResponse, error)
 	GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error)
+	GetRequestJournal(ctx context.Context, in *GetRequestJournalRequest, opts ...grpc.CallOption) (*GetRequestJournalResponse, error)
+	VerifyAuditEvents(ctx context.Context, in *VerifyAuditEventsRequest, opts ...grpc.CallOption) (*VerifyAuditEventsResponse, error)
+	GetPortfolioHistory(ctx context.Context, in *GetPortfolioHistoryRequest, opts ...grpc.CallOption) (*GetPortfolioHistoryResponse, error)
+	GetEquityCurve(ctx context.Context, in *GetEquityCurveRequest, opts ...grpc.CallOption) (*GetEquityCurveResponse, error)
+	GetFills(ctx context.Context, in *GetFillsRequest, opts ...grpc.CallOption) (*GetFillsResponse, error)
+	ReconcileFills(ctx context.Context, in *ReconcileFillsRequest, opts ...grpc.CallOption) (*ReconcileFillsResponse, error)
+	GetFundingHistory(ctx context.Context, in *GetFundingHistoryRequest, opts ...grpc.CallOption) (*GetFundingHistoryResponse, error)
+	GetDatabaseStatus(ctx context.Context, in *GetDatabaseStatusRequest, opts ...grpc.CallOption) (*GetDatabaseStatusResponse, error)
+	MigrateDatabase(ctx context.Context, in *MigrateDatabaseRequest, opts ...grpc.CallOption) (*MigrateDatabaseResponse, error)
+	GetTickerHistory(ctx context.Context, in *GetTickerHistoryRequest, opts ...grpc.CallOption) (*GetTickerHistoryResponse, error)
 	GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	GCTScriptUpload(ctx context.Context, in *GCTScriptUploadRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	GCTScriptReadScript(ctx context.Context, in *GCTScriptReadScriptRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error)
@@ -8277,6 +11628,202 @@ func (x *goCryptoTraderGetExchangeTickerStreamClient) Recv() (*TickerResponse, e
 	return m, nil
 }
 
+func (c *goCryptoTraderClient) GetCandleStream(ctx context.Context, in *GetCandleStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetCandleStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[5], "/gctrpc.GoCryptoTrader/GetCandleStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetCandleStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetCandleStreamClient interface {
+	Recv() (*CandleStreamResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetCandleStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetCandleStreamClient) Recv() (*CandleStreamResponse, error) {
+	m := new(CandleStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeCandleStream(ctx context.Context, in *GetExchangeCandleStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeCandleStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[6], "/gctrpc.GoCryptoTrader/GetExchangeCandleStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetExchangeCandleStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetExchangeCandleStreamClient interface {
+	Recv() (*CandleStreamResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetExchangeCandleStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetExchangeCandleStreamClient) Recv() (*CandleStreamResponse, error) {
+	m := new(CandleStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetRecentTrades(ctx context.Context, in *GetRecentTradesRequest, opts ...grpc.CallOption) (*RecentTradesResponse, error) {
+	out := new(RecentTradesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetRecentTrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTradeStream(ctx context.Context, in *GetTradeStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTradeStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[7], "/gctrpc.GoCryptoTrader/GetTradeStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetTradeStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetTradeStreamClient interface {
+	Recv() (*TradeResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetTradeStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetTradeStreamClient) Recv() (*TradeResponse, error) {
+	m := new(TradeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetHistoricTrades(ctx context.Context, in *GetHistoricTradesRequest, opts ...grpc.CallOption) (*GetHistoricTradesResponse, error) {
+	out := new(GetHistoricTradesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetHistoricTrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetDerivativeInfo(ctx context.Context, in *GetDerivativeInfoRequest, opts ...grpc.CallOption) (*DerivativeInfoResponse, error) {
+	out := new(DerivativeInfoResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetDerivativeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetDerivativeInfoStream(ctx context.Context, in *GetDerivativeInfoStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetDerivativeInfoStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[8], "/gctrpc.GoCryptoTrader/GetDerivativeInfoStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetDerivativeInfoStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetDerivativeInfoStreamClient interface {
+	Recv() (*DerivativeInfoResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetDerivativeInfoStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetDerivativeInfoStreamClient) Recv() (*DerivativeInfoResponse, error) {
+	m := new(DerivativeInfoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeDerivativeInfoStream(ctx context.Context, in *GetExchangeDerivativeInfoStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeDerivativeInfoStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[9], "/gctrpc.GoCryptoTrader/GetExchangeDerivativeInfoStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetExchangeDerivativeInfoStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetExchangeDerivativeInfoStreamClient interface {
+	Recv() (*DerivativeInfoResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetExchangeDerivativeInfoStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetExchangeDerivativeInfoStreamClient) Recv() (*DerivativeInfoResponse, error) {
+	m := new(DerivativeInfoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetLiquidations(ctx context.Context, in *GetLiquidationsRequest, opts ...grpc.CallOption) (*GetLiquidationsResponse, error) {
+	out := new(GetLiquidationsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetLiquidations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderClient) GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error) {
 	out := new(GetAuditEventResponse)
 	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAuditEvent", in, out, opts...)
@@ -8286,6 +11833,96 @@ func (c *goCryptoTraderClient) GetAuditEvent(ctx context.Context, in *GetAuditEv
 	return out, nil
 }
 
+func (c *goCryptoTraderClient) GetRequestJournal(ctx context.Context, in *GetRequestJournalRequest, opts ...grpc.CallOption) (*GetRequestJournalResponse, error) {
+	out := new(GetRequestJournalResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetRequestJournal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) VerifyAuditEvents(ctx context.Context, in *VerifyAuditEventsRequest, opts ...grpc.CallOption) (*VerifyAuditEventsResponse, error) {
+	out := new(VerifyAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/VerifyAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetPortfolioHistory(ctx context.Context, in *GetPortfolioHistoryRequest, opts ...grpc.CallOption) (*GetPortfolioHistoryResponse, error) {
+	out := new(GetPortfolioHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolioHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetEquityCurve(ctx context.Context, in *GetEquityCurveRequest, opts ...grpc.CallOption) (*GetEquityCurveResponse, error) {
+	out := new(GetEquityCurveResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetEquityCurve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetFills(ctx context.Context, in *GetFillsRequest, opts ...grpc.CallOption) (*GetFillsResponse, error) {
+	out := new(GetFillsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetFills", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ReconcileFills(ctx context.Context, in *ReconcileFillsRequest, opts ...grpc.CallOption) (*ReconcileFillsResponse, error) {
+	out := new(ReconcileFillsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ReconcileFills", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetFundingHistory(ctx context.Context, in *GetFundingHistoryRequest, opts ...grpc.CallOption) (*GetFundingHistoryResponse, error) {
+	out := new(GetFundingHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetFundingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetDatabaseStatus(ctx context.Context, in *GetDatabaseStatusRequest, opts ...grpc.CallOption) (*GetDatabaseStatusResponse, error) {
+	out := new(GetDatabaseStatusResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetDatabaseStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) MigrateDatabase(ctx context.Context, in *MigrateDatabaseRequest, opts ...grpc.CallOption) (*MigrateDatabaseResponse, error) {
+	out := new(MigrateDatabaseResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/MigrateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTickerHistory(ctx context.Context, in *GetTickerHistoryRequest, opts ...grpc.CallOption) (*GetTickerHistoryResponse, error) {
+	out := new(GetTickerHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTickerHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderClient) GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
 	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptExecute", in, out, opts...)
@@ -8509,7 +12146,26 @@ type GoCryptoTraderServer interface {
 	GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error
 	GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error
 	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTrader_GetExchangeTickerStreamServer) error
+	GetCandleStream(*GetCandleStreamRequest, GoCryptoTrader_GetCandleStreamServer) error
+	GetExchangeCandleStream(*GetExchangeCandleStreamRequest, GoCryptoTrader_GetExchangeCandleStreamServer) error
+	GetRecentTrades(context.Context, *GetRecentTradesRequest) (*RecentTradesResponse, error)
+	GetTradeStream(*GetTradeStreamRequest, GoCryptoTrader_GetTradeStreamServer) error
+	GetHistoricTrades(context.Context, *GetHistoricTradesRequest) (*GetHistoricTradesResponse, error)
+	GetDerivativeInfo(context.Context, *GetDerivativeInfoRequest) (*DerivativeInfoResponse, error)
+	GetDerivativeInfoStream(*GetDerivativeInfoStreamRequest, GoCryptoTrader_GetDerivativeInfoStreamServer) error
+	GetExchangeDerivativeInfoStream(*GetExchangeDerivativeInfoStreamRequest, GoCryptoTrader_GetExchangeDerivativeInfoStreamServer) error
+	GetLiquidations(context.Context, *GetLiquidationsRequest) (*GetLiquidationsResponse, error)
 	GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error)
+	GetRequestJournal(context.Context, *GetRequestJournalRequest) (*GetRequestJournalResponse, error)
+	VerifyAuditEvents(context.Context, *VerifyAuditEventsRequest) (*VerifyAuditEventsResponse, error)
+	GetPortfolioHistory(context.Context, *GetPortfolioHistoryRequest) (*GetPortfolioHistoryResponse, error)
+	GetEquityCurve(context.Context, *GetEquityCurveRequest) (*GetEquityCurveResponse, error)
+	GetFills(context.Context, *GetFillsRequest) (*GetFillsResponse, error)
+	ReconcileFills(context.Context, *ReconcileFillsRequest) (*ReconcileFillsResponse, error)
+	GetFundingHistory(context.Context, *GetFundingHistoryRequest) (*GetFundingHistoryResponse, error)
+	GetDatabaseStatus(context.Context, *GetDatabaseStatusRequest) (*GetDatabaseStatusResponse, error)
+	MigrateDatabase(context.Context, *MigrateDatabaseRequest) (*MigrateDatabaseResponse, error)
+	GetTickerHistory(context.Context, *GetTickerHistoryRequest) (*GetTickerHistoryResponse, error)
 	GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GenericResponse, error)
 	GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GenericResponse, error)
 	GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error)
@@ -8667,26 +12323,83 @@ func (*UnimplementedGoCryptoTraderServer) GetLoggerDetails(ctx context.Context,
 func (*UnimplementedGoCryptoTraderServer) SetLoggerDetails(ctx context.Context, req *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetLoggerDetails not implemented")
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangePairs(ctx context.Context, req *GetExchangePairsRequest) (*GetExchangePairsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangePairs not implemented")
+func (*UnimplementedGoCryptoTraderServer) GetExchangePairs(ctx context.Context, req *GetExchangePairsRequest) (*GetExchangePairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangePairs not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) SetExchangePair(ctx context.Context, req *SetExchangePairRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangePair not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrderbookStream(req *GetOrderbookStreamRequest, srv GoCryptoTrader_GetOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetOrderbookStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeOrderbookStream(req *GetExchangeOrderbookStreamRequest, srv GoCryptoTrader_GetExchangeOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetExchangeOrderbookStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTickerStream(req *GetTickerStreamRequest, srv GoCryptoTrader_GetTickerStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTickerStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeTickerStream(req *GetExchangeTickerStreamRequest, srv GoCryptoTrader_GetExchangeTickerStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetExchangeTickerStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetCandleStream(req *GetCandleStreamRequest, srv GoCryptoTrader_GetCandleStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetCandleStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeCandleStream(req *GetExchangeCandleStreamRequest, srv GoCryptoTrader_GetExchangeCandleStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetExchangeCandleStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetRecentTrades(ctx context.Context, req *GetRecentTradesRequest) (*RecentTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentTrades not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTradeStream(req *GetTradeStreamRequest, srv GoCryptoTrader_GetTradeStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTradeStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetHistoricTrades(ctx context.Context, req *GetHistoricTradesRequest) (*GetHistoricTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistoricTrades not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetDerivativeInfo(ctx context.Context, req *GetDerivativeInfoRequest) (*DerivativeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDerivativeInfo not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetDerivativeInfoStream(req *GetDerivativeInfoStreamRequest, srv GoCryptoTrader_GetDerivativeInfoStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetDerivativeInfoStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeDerivativeInfoStream(req *GetExchangeDerivativeInfoStreamRequest, srv GoCryptoTrader_GetExchangeDerivativeInfoStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetExchangeDerivativeInfoStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetLiquidations(ctx context.Context, req *GetLiquidationsRequest) (*GetLiquidationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidations not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetAuditEvent(ctx context.Context, req *GetAuditEventRequest) (*GetAuditEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditEvent not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetRequestJournal(ctx context.Context, req *GetRequestJournalRequest) (*GetRequestJournalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRequestJournal not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) VerifyAuditEvents(ctx context.Context, req *VerifyAuditEventsRequest) (*VerifyAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditEvents not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetPortfolioHistory(ctx context.Context, req *GetPortfolioHistoryRequest) (*GetPortfolioHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioHistory not implemented")
 }
-func (*UnimplementedGoCryptoTraderServer) SetExchangePair(ctx context.Context, req *SetExchangePairRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetExchangePair not implemented")
+func (*UnimplementedGoCryptoTraderServer) GetEquityCurve(ctx context.Context, req *GetEquityCurveRequest) (*GetEquityCurveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEquityCurve not implemented")
 }
-func (*UnimplementedGoCryptoTraderServer) GetOrderbookStream(req *GetOrderbookStreamRequest, srv GoCryptoTrader_GetOrderbookStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetOrderbookStream not implemented")
+func (*UnimplementedGoCryptoTraderServer) GetFills(ctx context.Context, req *GetFillsRequest) (*GetFillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFills not implemented")
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangeOrderbookStream(req *GetExchangeOrderbookStreamRequest, srv GoCryptoTrader_GetExchangeOrderbookStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetExchangeOrderbookStream not implemented")
+func (*UnimplementedGoCryptoTraderServer) ReconcileFills(ctx context.Context, req *ReconcileFillsRequest) (*ReconcileFillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileFills not implemented")
 }
-func (*UnimplementedGoCryptoTraderServer) GetTickerStream(req *GetTickerStreamRequest, srv GoCryptoTrader_GetTickerStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetTickerStream not implemented")
+func (*UnimplementedGoCryptoTraderServer) GetFundingHistory(ctx context.Context, req *GetFundingHistoryRequest) (*GetFundingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFundingHistory not implemented")
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangeTickerStream(req *GetExchangeTickerStreamRequest, srv GoCryptoTrader_GetExchangeTickerStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetExchangeTickerStream not implemented")
+func (*UnimplementedGoCryptoTraderServer) GetDatabaseStatus(ctx context.Context, req *GetDatabaseStatusRequest) (*GetDatabaseStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDatabaseStatus not implemented")
 }
-func (*UnimplementedGoCryptoTraderServer) GetAuditEvent(ctx context.Context, req *GetAuditEventRequest) (*GetAuditEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAuditEvent not implemented")
+func (*UnimplementedGoCryptoTraderServer) MigrateDatabase(ctx context.Context, req *MigrateDatabaseRequest) (*MigrateDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateDatabase not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTickerHistory(ctx context.Context, req *GetTickerHistoryRequest) (*GetTickerHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTickerHistory not implemented")
 }
 func (*UnimplementedGoCryptoTraderServer) GCTScriptExecute(ctx context.Context, req *GCTScriptExecuteRequest) (*GenericResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptExecute not implemented")
@@ -9077,608 +12790,965 @@ func (x *goCryptoTraderGetAccountInfoStreamServer) Send(m *GetAccountInfoRespons
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GoCryptoTrader_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConfigRequest)
+func _GoCryptoTrader_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPortfolioSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolioSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, req.(*GetPortfolioSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_AddPortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPortfolioAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/AddPortfolioAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, req.(*AddPortfolioAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_RemovePortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePortfolioAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/RemovePortfolioAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, req.(*RemovePortfolioAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetForexProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForexProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetForexProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, req.(*GetForexProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetForexRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForexRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetForexRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetForexRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetForexRates(ctx, req.(*GetForexRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrders(ctx, req.(*GetOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SimulateOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, req.(*SimulateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_WhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhaleBombRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/WhaleBomb",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, req.(*WhaleBombRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAllOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelAllOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetConfig(ctx, in)
+		return srv.(GoCryptoTraderServer).GetEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetConfig",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetConfig(ctx, req.(*GetConfigRequest))
+		return srv.(GoCryptoTraderServer).GetEvents(ctx, req.(*GetEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPortfolioRequest)
+func _GoCryptoTrader_AddEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, in)
+		return srv.(GoCryptoTraderServer).AddEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolio",
+		FullMethod: "/gctrpc.GoCryptoTrader/AddEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+		return srv.(GoCryptoTraderServer).AddEvent(ctx, req.(*AddEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetPortfolioSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPortfolioSummaryRequest)
+func _GoCryptoTrader_RemoveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, in)
+		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolioSummary",
+		FullMethod: "/gctrpc.GoCryptoTrader/RemoveEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, req.(*GetPortfolioSummaryRequest))
+		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, req.(*RemoveEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_AddPortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPortfolioAddressRequest)
+func _GoCryptoTrader_GetCryptocurrencyDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptocurrencyDepositAddressesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/AddPortfolioAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, req.(*AddPortfolioAddressRequest))
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, req.(*GetCryptocurrencyDepositAddressesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_RemovePortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemovePortfolioAddressRequest)
+func _GoCryptoTrader_GetCryptocurrencyDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptocurrencyDepositAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/RemovePortfolioAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, req.(*RemovePortfolioAddressRequest))
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, req.(*GetCryptocurrencyDepositAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetForexProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForexProvidersRequest)
+func _GoCryptoTrader_WithdrawFiatFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawFiatRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetForexProviders",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawFiatFunds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, req.(*GetForexProvidersRequest))
+		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, req.(*WithdrawFiatRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetForexRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForexRatesRequest)
+func _GoCryptoTrader_WithdrawCryptocurrencyFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawCryptoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetForexRates(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetForexRates",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetForexRates(ctx, req.(*GetForexRatesRequest))
+		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, req.(*WithdrawCryptoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrdersRequest)
+func _GoCryptoTrader_WithdrawalEventByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalEventByIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrders(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawalEventByID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrders",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventByID",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrders(ctx, req.(*GetOrdersRequest))
+		return srv.(GoCryptoTraderServer).WithdrawalEventByID(ctx, req.(*WithdrawalEventByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderRequest)
+func _GoCryptoTrader_WithdrawalEventsByExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalEventsByExchangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawalEventsByExchange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventsByExchange",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrder(ctx, req.(*GetOrderRequest))
+		return srv.(GoCryptoTraderServer).WithdrawalEventsByExchange(ctx, req.(*WithdrawalEventsByExchangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitOrderRequest)
+func _GoCryptoTrader_WithdrawalEventsByDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalEventsByDateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawalEventsByDate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventsByDate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
+		return srv.(GoCryptoTraderServer).WithdrawalEventsByDate(ctx, req.(*WithdrawalEventsByDateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SimulateOrderRequest)
+func _GoCryptoTrader_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoggerDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SimulateOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetLoggerDetails",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, req.(*SimulateOrderRequest))
+		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, req.(*GetLoggerDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WhaleBombRequest)
+func _GoCryptoTrader_SetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLoggerDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, in)
+		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WhaleBomb",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetLoggerDetails",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, req.(*WhaleBombRequest))
+		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, req.(*SetLoggerDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelOrderRequest)
+func _GoCryptoTrader_GetExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangePairsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CancelOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangePairs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, req.(*GetExchangePairsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelAllOrdersRequest)
+func _GoCryptoTrader_SetExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangePairRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, in)
+		return srv.(GoCryptoTraderServer).SetExchangePair(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CancelAllOrders",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetExchangePair",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
+		return srv.(GoCryptoTraderServer).SetExchangePair(ctx, req.(*SetExchangePairRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEventsRequest)
+func _GoCryptoTrader_GetOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrderbookStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetOrderbookStream(m, &goCryptoTraderGetOrderbookStreamServer{stream})
+}
+
+type GoCryptoTrader_GetOrderbookStreamServer interface {
+	Send(*OrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetOrderbookStreamServer) Send(m *OrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetExchangeOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExchangeOrderbookStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetExchangeOrderbookStream(m, &goCryptoTraderGetExchangeOrderbookStreamServer{stream})
+}
+
+type GoCryptoTrader_GetExchangeOrderbookStreamServer interface {
+	Send(*OrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetExchangeOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetExchangeOrderbookStreamServer) Send(m *OrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTickerStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetTickerStream(m, &goCryptoTraderGetTickerStreamServer{stream})
+}
+
+type GoCryptoTrader_GetTickerStreamServer interface {
+	Send(*TickerResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetTickerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetTickerStreamServer) Send(m *TickerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetExchangeTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExchangeTickerStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetExchangeTickerStream(m, &goCryptoTraderGetExchangeTickerStreamServer{stream})
+}
+
+type GoCryptoTrader_GetExchangeTickerStreamServer interface {
+	Send(*TickerResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetExchangeTickerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetExchangeTickerStreamServer) Send(m *TickerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetCandleStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCandleStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetCandleStream(m, &goCryptoTraderGetCandleStreamServer{stream})
+}
+
+type GoCryptoTrader_GetCandleStreamServer interface {
+	Send(*CandleStreamResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetCandleStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetCandleStreamServer) Send(m *CandleStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetExchangeCandleStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExchangeCandleStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetExchangeCandleStream(m, &goCryptoTraderGetExchangeCandleStreamServer{stream})
+}
+
+type GoCryptoTrader_GetExchangeCandleStreamServer interface {
+	Send(*CandleStreamResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetExchangeCandleStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetExchangeCandleStreamServer) Send(m *CandleStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetRecentTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecentTradesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetEvents(ctx, in)
+		return srv.(GoCryptoTraderServer).GetRecentTrades(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetEvents",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetRecentTrades",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetEvents(ctx, req.(*GetEventsRequest))
+		return srv.(GoCryptoTraderServer).GetRecentTrades(ctx, req.(*GetRecentTradesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_AddEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddEventRequest)
+func _GoCryptoTrader_GetTradeStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTradeStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetTradeStream(m, &goCryptoTraderGetTradeStreamServer{stream})
+}
+
+type GoCryptoTrader_GetTradeStreamServer interface {
+	Send(*TradeResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetTradeStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetTradeStreamServer) Send(m *TradeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetHistoricTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoricTradesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).AddEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).GetHistoricTrades(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/AddEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetHistoricTrades",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).AddEvent(ctx, req.(*AddEventRequest))
+		return srv.(GoCryptoTraderServer).GetHistoricTrades(ctx, req.(*GetHistoricTradesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_RemoveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveEventRequest)
+func _GoCryptoTrader_GetDerivativeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDerivativeInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).GetDerivativeInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/RemoveEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDerivativeInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, req.(*RemoveEventRequest))
+		return srv.(GoCryptoTraderServer).GetDerivativeInfo(ctx, req.(*GetDerivativeInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCryptocurrencyDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCryptocurrencyDepositAddressesRequest)
+func _GoCryptoTrader_GetDerivativeInfoStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetDerivativeInfoStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetDerivativeInfoStream(m, &goCryptoTraderGetDerivativeInfoStreamServer{stream})
+}
+
+type GoCryptoTrader_GetDerivativeInfoStreamServer interface {
+	Send(*DerivativeInfoResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetDerivativeInfoStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetDerivativeInfoStreamServer) Send(m *DerivativeInfoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetExchangeDerivativeInfoStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExchangeDerivativeInfoStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetExchangeDerivativeInfoStream(m, &goCryptoTraderGetExchangeDerivativeInfoStreamServer{stream})
+}
+
+type GoCryptoTrader_GetExchangeDerivativeInfoStreamServer interface {
+	Send(*DerivativeInfoResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetExchangeDerivativeInfoStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetExchangeDerivativeInfoStreamServer) Send(m *DerivativeInfoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetLiquidations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLiquidationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, in)
+		return srv.(GoCryptoTraderServer).GetLiquidations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetLiquidations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, req.(*GetCryptocurrencyDepositAddressesRequest))
+		return srv.(GoCryptoTraderServer).GetLiquidations(ctx, req.(*GetLiquidationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCryptocurrencyDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCryptocurrencyDepositAddressRequest)
+func _GoCryptoTrader_GetAuditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetAuditEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, req.(*GetCryptocurrencyDepositAddressRequest))
+		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, req.(*GetAuditEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawFiatFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawFiatRequest)
+func _GoCryptoTrader_GetRequestJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequestJournalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, in)
+		return srv.(GoCryptoTraderServer).GetRequestJournal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawFiatFunds",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetRequestJournal",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, req.(*WithdrawFiatRequest))
+		return srv.(GoCryptoTraderServer).GetRequestJournal(ctx, req.(*GetRequestJournalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawCryptocurrencyFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawCryptoRequest)
+func _GoCryptoTrader_VerifyAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAuditEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, in)
+		return srv.(GoCryptoTraderServer).VerifyAuditEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds",
+		FullMethod: "/gctrpc.GoCryptoTrader/VerifyAuditEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, req.(*WithdrawCryptoRequest))
+		return srv.(GoCryptoTraderServer).VerifyAuditEvents(ctx, req.(*VerifyAuditEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawalEventByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawalEventByIDRequest)
+func _GoCryptoTrader_GetPortfolioHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawalEventByID(ctx, in)
+		return srv.(GoCryptoTraderServer).GetPortfolioHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventByID",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolioHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawalEventByID(ctx, req.(*WithdrawalEventByIDRequest))
+		return srv.(GoCryptoTraderServer).GetPortfolioHistory(ctx, req.(*GetPortfolioHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawalEventsByExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawalEventsByExchangeRequest)
+func _GoCryptoTrader_GetEquityCurve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEquityCurveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawalEventsByExchange(ctx, in)
+		return srv.(GoCryptoTraderServer).GetEquityCurve(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventsByExchange",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetEquityCurve",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawalEventsByExchange(ctx, req.(*WithdrawalEventsByExchangeRequest))
+		return srv.(GoCryptoTraderServer).GetEquityCurve(ctx, req.(*GetEquityCurveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawalEventsByDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawalEventsByDateRequest)
+func _GoCryptoTrader_GetFills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFillsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawalEventsByDate(ctx, in)
+		return srv.(GoCryptoTraderServer).GetFills(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventsByDate",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetFills",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawalEventsByDate(ctx, req.(*WithdrawalEventsByDateRequest))
+		return srv.(GoCryptoTraderServer).GetFills(ctx, req.(*GetFillsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLoggerDetailsRequest)
+func _GoCryptoTrader_ReconcileFills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileFillsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, in)
+		return srv.(GoCryptoTraderServer).ReconcileFills(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetLoggerDetails",
+		FullMethod: "/gctrpc.GoCryptoTrader/ReconcileFills",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, req.(*GetLoggerDetailsRequest))
+		return srv.(GoCryptoTraderServer).ReconcileFills(ctx, req.(*ReconcileFillsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetLoggerDetailsRequest)
+func _GoCryptoTrader_GetFundingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFundingHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, in)
+		return srv.(GoCryptoTraderServer).GetFundingHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetLoggerDetails",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetFundingHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, req.(*SetLoggerDetailsRequest))
+		return srv.(GoCryptoTraderServer).GetFundingHistory(ctx, req.(*GetFundingHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangePairsRequest)
+func _GoCryptoTrader_GetDatabaseStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDatabaseStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, in)
+		return srv.(GoCryptoTraderServer).GetDatabaseStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangePairs",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDatabaseStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, req.(*GetExchangePairsRequest))
+		return srv.(GoCryptoTraderServer).GetDatabaseStatus(ctx, req.(*GetDatabaseStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetExchangePairRequest)
+func _GoCryptoTrader_MigrateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateDatabaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetExchangePair(ctx, in)
+		return srv.(GoCryptoTraderServer).MigrateDatabase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetExchangePair",
+		FullMethod: "/gctrpc.GoCryptoTrader/MigrateDatabase",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetExchangePair(ctx, req.(*SetExchangePairRequest))
+		return srv.(GoCryptoTraderServer).MigrateDatabase(ctx, req.(*MigrateDatabaseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetOrderbookStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GoCryptoTraderServer).GetOrderbookStream(m, &goCryptoTraderGetOrderbookStreamServer{stream})
-}
-
-type GoCryptoTrader_GetOrderbookStreamServer interface {
-	Send(*OrderbookResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetOrderbookStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetOrderbookStreamServer) Send(m *OrderbookResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetExchangeOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetExchangeOrderbookStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GoCryptoTraderServer).GetExchangeOrderbookStream(m, &goCryptoTraderGetExchangeOrderbookStreamServer{stream})
-}
-
-type GoCryptoTrader_GetExchangeOrderbookStreamServer interface {
-	Send(*OrderbookResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetExchangeOrderbookStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetExchangeOrderbookStreamServer) Send(m *OrderbookResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetTickerStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GoCryptoTraderServer).GetTickerStream(m, &goCryptoTraderGetTickerStreamServer{stream})
-}
-
-type GoCryptoTrader_GetTickerStreamServer interface {
-	Send(*TickerResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetTickerStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetTickerStreamServer) Send(m *TickerResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetExchangeTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetExchangeTickerStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GoCryptoTraderServer).GetExchangeTickerStream(m, &goCryptoTraderGetExchangeTickerStreamServer{stream})
-}
-
-type GoCryptoTrader_GetExchangeTickerStreamServer interface {
-	Send(*TickerResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetExchangeTickerStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetExchangeTickerStreamServer) Send(m *TickerResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetAuditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAuditEventRequest)
+func _GoCryptoTrader_GetTickerHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickerHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).GetTickerHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetAuditEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTickerHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, req.(*GetAuditEventRequest))
+		return srv.(GoCryptoTraderServer).GetTickerHistory(ctx, req.(*GetTickerHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -10209,10 +14279,66 @@ var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetExchangePair",
 			Handler:    _GoCryptoTrader_SetExchangePair_Handler,
 		},
+		{
+			MethodName: "GetRecentTrades",
+			Handler:    _GoCryptoTrader_GetRecentTrades_Handler,
+		},
+		{
+			MethodName: "GetHistoricTrades",
+			Handler:    _GoCryptoTrader_GetHistoricTrades_Handler,
+		},
+		{
+			MethodName: "GetDerivativeInfo",
+			Handler:    _GoCryptoTrader_GetDerivativeInfo_Handler,
+		},
+		{
+			MethodName: "GetLiquidations",
+			Handler:    _GoCryptoTrader_GetLiquidations_Handler,
+		},
 		{
 			MethodName: "GetAuditEvent",
 			Handler:    _GoCryptoTrader_GetAuditEvent_Handler,
 		},
+		{
+			MethodName: "GetRequestJournal",
+			Handler:    _GoCryptoTrader_GetRequestJournal_Handler,
+		},
+		{
+			MethodName: "VerifyAuditEvents",
+			Handler:    _GoCryptoTrader_VerifyAuditEvents_Handler,
+		},
+		{
+			MethodName: "GetPortfolioHistory",
+			Handler:    _GoCryptoTrader_GetPortfolioHistory_Handler,
+		},
+		{
+			MethodName: "GetEquityCurve",
+			Handler:    _GoCryptoTrader_GetEquityCurve_Handler,
+		},
+		{
+			MethodName: "GetFills",
+			Handler:    _GoCryptoTrader_GetFills_Handler,
+		},
+		{
+			MethodName: "ReconcileFills",
+			Handler:    _GoCryptoTrader_ReconcileFills_Handler,
+		},
+		{
+			MethodName: "GetFundingHistory",
+			Handler:    _GoCryptoTrader_GetFundingHistory_Handler,
+		},
+		{
+			MethodName: "GetDatabaseStatus",
+			Handler:    _GoCryptoTrader_GetDatabaseStatus_Handler,
+		},
+		{
+			MethodName: "MigrateDatabase",
+			Handler:    _GoCryptoTrader_MigrateDatabase_Handler,
+		},
+		{
+			MethodName: "GetTickerHistory",
+			Handler:    _GoCryptoTrader_GetTickerHistory_Handler,
+		},
 		{
 			MethodName: "GCTScriptExecute",
 			Handler:    _GoCryptoTrader_GCTScriptExecute_Handler,
@@ -10316,6 +14442,31 @@ var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTrader_GetExchangeTickerStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetCandleStream",
+			Handler:       _GoCryptoTrader_GetCandleStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetExchangeCandleStream",
+			Handler:       _GoCryptoTrader_GetExchangeCandleStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetTradeStream",
+			Handler:       _GoCryptoTrader_GetTradeStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetDerivativeInfoStream",
+			Handler:       _GoCryptoTrader_GetDerivativeInfoStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetExchangeDerivativeInfoStream",
+			Handler:       _GoCryptoTrader_GetExchangeDerivativeInfoStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
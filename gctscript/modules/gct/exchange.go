@@ -29,6 +29,7 @@ var exchangeModule = map[string]objects.Object{
 	"withdrawcrypto": &objects.UserFunction{Name: "withdrawcrypto", Value: ExchangeWithdrawCrypto},
 	"withdrawfiat":   &objects.UserFunction{Name: "withdrawfiat", Value: ExchangeWithdrawFiat},
 	"ohlcv":          &objects.UserFunction{Name: "ohlcv", Value: exchangeOHLCV},
+	"livecandle":     &objects.UserFunction{Name: "livecandle", Value: exchangeLiveCandle},
 }
 
 // ExchangeOrderbook returns orderbook for requested exchange & currencypair
@@ -598,6 +599,68 @@ func exchangeOHLCV(args ...objects.Object) (objects.Object, error) {
 	return c, nil
 }
 
+func exchangeLiveCandle(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+	}
+	currencyPair, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, currencyPair)
+	}
+	delimiter, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, delimiter)
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, assetTypeParam)
+	}
+	intervalStr, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, intervalStr)
+	}
+	interval, err := parseInterval(intervalStr)
+	if err != nil {
+		return nil, err
+	}
+	pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return nil, err
+	}
+	assetType := asset.Item(assetTypeParam)
+
+	l, err := wrappers.GetWrapper().LiveCandle(exchangeName, pair, assetType, kline.Interval(interval))
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]objects.Object, 11)
+	data["exchange"] = &objects.String{Value: l.Exchange}
+	data["pair"] = &objects.String{Value: l.Pair.String()}
+	data["asset"] = &objects.String{Value: l.Asset.String()}
+	data["interval"] = &objects.String{Value: l.Interval.String()}
+	data["time"] = &objects.Time{Value: l.Candle.Time}
+	data["open"] = &objects.Float{Value: l.Candle.Open}
+	data["high"] = &objects.Float{Value: l.Candle.High}
+	data["low"] = &objects.Float{Value: l.Candle.Low}
+	data["close"] = &objects.Float{Value: l.Candle.Close}
+	data["volume"] = &objects.Float{Value: l.Candle.Volume}
+	if l.Closed {
+		data["closed"] = objects.TrueValue
+	} else {
+		data["closed"] = objects.FalseValue
+	}
+
+	return &objects.Map{
+		Value: data,
+	}, nil
+}
+
 // parseInterval will parse the interval param of indictors that have them and convert to time.Duration
 func parseInterval(in string) (time.Duration, error) {
 	if !common.StringDataContainsInsensitive(supportedDurations, in) {
@@ -78,6 +78,30 @@ func TestExchangeTicker(t *testing.T) {
 	}
 }
 
+func TestExchangeLiveCandle(t *testing.T) {
+	t.Parallel()
+	interval := &objects.String{Value: "1m"}
+	_, err := exchangeLiveCandle(exch, currencyPair, delimiter, assetType, interval)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = exchangeLiveCandle(exchError, currencyPair, delimiter, assetType, interval)
+	if err != nil && errors.Is(err, errTestFailed) {
+		t.Fatal(err)
+	}
+
+	_, err = exchangeLiveCandle(exch, currencyPair, delimiter, assetType, &objects.String{Value: "2m"})
+	if !errors.Is(err, errInvalidInterval) {
+		t.Fatal(err)
+	}
+
+	_, err = exchangeLiveCandle()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Fatal(err)
+	}
+}
+
 func TestExchangeExchanges(t *testing.T) {
 	t.Parallel()
 
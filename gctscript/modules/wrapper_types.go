@@ -43,6 +43,7 @@ type Exchange interface {
 	WithdrawalFiatFunds(exch, bankAccountID string, request *withdraw.Request) (out string, err error)
 	WithdrawalCryptoFunds(exch string, request *withdraw.Request) (out string, err error)
 	OHLCV(exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error)
+	LiveCandle(exch string, pair currency.Pair, item asset.Item, interval kline.Interval) (*kline.Live, error)
 }
 
 // SetModuleWrapper link the wrapper and interface to use for modules
@@ -1,6 +1,7 @@
 package exchange
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sort"
@@ -16,6 +17,7 @@ import (
 	"github.com/yurulab/gocryptotrader/exchanges/order"
 	"github.com/yurulab/gocryptotrader/exchanges/orderbook"
 	"github.com/yurulab/gocryptotrader/exchanges/ticker"
+	"github.com/yurulab/gocryptotrader/gctscript/vm"
 	"github.com/yurulab/gocryptotrader/portfolio/banking"
 	"github.com/yurulab/gocryptotrader/portfolio/withdraw"
 )
@@ -23,6 +25,16 @@ import (
 // Exchange implements all required methods for Wrapper
 type Exchange struct{}
 
+// scriptContext returns a context bounded by the configured script timeout so
+// that exchange calls made from a script do not outlive it
+func scriptContext() (context.Context, context.CancelFunc) {
+	timeout := vm.GCTScriptConfig.ScriptTimeout
+	if timeout <= 0 {
+		timeout = vm.DefaultTimeoutValue
+	}
+	return context.WithTimeout(context.Background(), timeout)
+}
+
 // Exchanges returns slice of all current exchanges
 func (e Exchange) Exchanges(enabledOnly bool) []string {
 	return engine.GetExchangeNames(enabledOnly)
@@ -60,7 +72,9 @@ func (e Exchange) Ticker(exch string, pair currency.Pair, item asset.Item) (*tic
 		return nil, err
 	}
 
-	return ex.FetchTicker(pair, item)
+	ctx, cancel := scriptContext()
+	defer cancel()
+	return ex.FetchTicker(ctx, pair, item)
 }
 
 // Pairs returns either all or enabled currency pairs
@@ -88,7 +102,9 @@ func (e Exchange) QueryOrder(exch, orderID string) (*order.Detail, error) {
 		return nil, err
 	}
 
-	r, err := ex.GetOrderInfo(orderID)
+	ctx, cancel := scriptContext()
+	defer cancel()
+	r, err := ex.GetOrderInfo(ctx, orderID)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +150,9 @@ func (e Exchange) AccountInformation(exch string) (account.Holdings, error) {
 		return account.Holdings{}, err
 	}
 
-	accountInfo, err := ex.FetchAccountInfo()
+	ctx, cancel := scriptContext()
+	defer cancel()
+	accountInfo, err := ex.FetchAccountInfo(ctx)
 	if err != nil {
 		return account.Holdings{}, err
 	}
@@ -222,7 +240,9 @@ func (e Exchange) OHLCV(exch string, pair currency.Pair, item asset.Item, start,
 	if err != nil {
 		return kline.Item{}, err
 	}
-	ret, err := ex.GetHistoricCandles(pair, item, start, end, interval)
+	ctx, cancel := scriptContext()
+	defer cancel()
+	ret, err := ex.GetHistoricCandles(ctx, pair, item, start, end, interval)
 	if err != nil {
 		return kline.Item{}, err
 	}
@@ -233,3 +253,9 @@ func (e Exchange) OHLCV(exch string, pair currency.Pair, item asset.Item, start,
 
 	return ret, nil
 }
+
+// LiveCandle returns the latest live candle for requested exchange/pair/asset
+// & interval
+func (e Exchange) LiveCandle(exch string, pair currency.Pair, item asset.Item, interval kline.Interval) (*kline.Live, error) {
+	return kline.GetLiveCandle(exch, pair, item, interval)
+}
@@ -269,3 +269,24 @@ func (w Wrapper) OHLCV(exch string, p currency.Pair, a asset.Item, start, end ti
 		Candles:  candles,
 	}, nil
 }
+
+// LiveCandle validator for test execution/scripts
+func (w Wrapper) LiveCandle(exch string, p currency.Pair, a asset.Item, i kline.Interval) (*kline.Live, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return &kline.Live{
+		Exchange: exch,
+		Pair:     p,
+		Asset:    a,
+		Interval: i,
+		Candle: kline.Candle{
+			Time:   time.Now().Truncate(i.Duration()),
+			Open:   validatorOpen,
+			High:   validatorHigh,
+			Low:    validatorLow,
+			Close:  validatorClose,
+			Volume: validatorVol,
+		},
+	}, nil
+}
@@ -230,3 +230,18 @@ func TestWrapper_OHLCV(t *testing.T) {
 		t.Fatal("expected OHLCV to return error with invalid name")
 	}
 }
+
+func TestWrapper_LiveCandle(t *testing.T) {
+	c, err := currency.NewPairDelimiter(pairs, delimiter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = testWrapper.LiveCandle("test", c, asset.Spot, kline.OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = testWrapper.LiveCandle(exchError.String(), c, asset.Spot, kline.OneMin)
+	if err == nil {
+		t.Fatal("expected LiveCandle to return error with invalid name")
+	}
+}
@@ -17,6 +17,7 @@ var (
 	WebsocketMgr     *subLogger
 	EventMgr         *subLogger
 	DispatchMgr      *subLogger
+	CandleMgr        *subLogger
 
 	RequestSys  *subLogger
 	ExchangeSys *subLogger
@@ -0,0 +1,397 @@
+package metrics
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yurulab/gocryptotrader/log"
+)
+
+// NewRegistry returns an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{collectors: make(map[string]Collector)}
+}
+
+// Register adds collectors to the registry, returning an error if a collector
+// with the same name is already registered
+func (r *Registry) Register(cs ...Collector) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	for i := range cs {
+		name := cs[i].Name()
+		if _, ok := r.collectors[name]; ok {
+			return fmt.Errorf("metric %s already registered", name)
+		}
+		r.collectors[name] = cs[i]
+	}
+	return nil
+}
+
+// MustRegister adds collectors to the registry and panics if any of them are
+// already registered. It is intended for package level metric declarations
+func (r *Registry) MustRegister(cs ...Collector) {
+	if err := r.Register(cs...); err != nil {
+		panic(err)
+	}
+}
+
+// Unregister removes a collector from the registry
+func (r *Registry) Unregister(c Collector) {
+	r.m.Lock()
+	delete(r.collectors, c.Name())
+	r.m.Unlock()
+}
+
+// WriteTo writes all registered collectors sorted by name in the Prometheus
+// text exposition format
+func (r *Registry) WriteTo(w io.Writer) (int64, error) {
+	r.m.RLock()
+	cs := make([]Collector, 0, len(r.collectors))
+	for _, c := range r.collectors {
+		cs = append(cs, c)
+	}
+	r.m.RUnlock()
+
+	sort.Slice(cs, func(i, j int) bool { return cs[i].Name() < cs[j].Name() })
+
+	bw := bufio.NewWriter(w)
+	cw := &countingWriter{w: bw}
+	for i := range cs {
+		if err := cs[i].Write(cw); err != nil {
+			return cw.n, err
+		}
+	}
+	return cw.n, bw.Flush()
+}
+
+// Handler returns a http.Handler serving the registry to a Prometheus scrape
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		var buf bytes.Buffer
+		if _, err := r.WriteTo(&buf); err != nil {
+			log.Errorf(log.Global, "Metrics: failed to write metrics: %s", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", ContentType)
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			log.Errorf(log.Global, "Metrics: failed to write response: %s", err)
+		}
+	})
+}
+
+// MustRegister adds collectors to the default registry
+func MustRegister(cs ...Collector) {
+	defaultRegistry.MustRegister(cs...)
+}
+
+// Unregister removes a collector from the default registry
+func Unregister(c Collector) {
+	defaultRegistry.Unregister(c)
+}
+
+// WriteTo writes the default registry in the Prometheus text exposition format
+func WriteTo(w io.Writer) (int64, error) {
+	return defaultRegistry.WriteTo(w)
+}
+
+// Handler returns a http.Handler serving the default registry
+func Handler() http.Handler {
+	return defaultRegistry.Handler()
+}
+
+// NewCounterVec returns a CounterVec partitioned by the supplied label names
+func NewCounterVec(name, help string, labels ...string) *CounterVec {
+	return &CounterVec{vec: newVec(name, help, labels)}
+}
+
+// Inc increments the counter for the label values by one
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Add adds v to the counter for the label values. Negative values are ignored
+func (c *CounterVec) Add(v float64, labelValues ...string) {
+	if v < 0 {
+		return
+	}
+	c.update(labelValues, func(s *series) { s.value += v })
+}
+
+// Value returns the current counter value for the label values
+func (c *CounterVec) Value(labelValues ...string) float64 {
+	return c.get(labelValues)
+}
+
+// Write implements the Collector interface
+func (c *CounterVec) Write(w io.Writer) error {
+	return c.write(w, typeCounter, nil)
+}
+
+// NewGaugeVec returns a GaugeVec partitioned by the supplied label names
+func NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	return &GaugeVec{vec: newVec(name, help, labels)}
+}
+
+// Set sets the gauge for the label values
+func (g *GaugeVec) Set(v float64, labelValues ...string) {
+	g.update(labelValues, func(s *series) { s.value = v })
+}
+
+// Add adds v to the gauge for the label values, which may be negative
+func (g *GaugeVec) Add(v float64, labelValues ...string) {
+	g.update(labelValues, func(s *series) { s.value += v })
+}
+
+// Value returns the current gauge value for the label values
+func (g *GaugeVec) Value(labelValues ...string) float64 {
+	return g.get(labelValues)
+}
+
+// Delete removes the gauge for the label values so that it is no longer
+// reported
+func (g *GaugeVec) Delete(labelValues ...string) {
+	g.m.Lock()
+	delete(g.series, seriesKey(labelValues))
+	g.m.Unlock()
+}
+
+// Write implements the Collector interface
+func (g *GaugeVec) Write(w io.Writer) error {
+	return g.write(w, typeGauge, nil)
+}
+
+// NewHistogramVec returns a HistogramVec partitioned by the supplied label
+// names. Buckets must be sorted in increasing order, a nil slice uses
+// DefaultLatencyBuckets
+func NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	if buckets == nil {
+		buckets = DefaultLatencyBuckets
+	}
+	return &HistogramVec{
+		vec:         newVec(name, help, labels),
+		upperBounds: buckets,
+	}
+}
+
+// Observe adds a single observation to the histogram for the label values
+func (h *HistogramVec) Observe(v float64, labelValues ...string) {
+	i := sort.SearchFloat64s(h.upperBounds, v)
+	h.update(labelValues, func(s *series) {
+		if s.buckets == nil {
+			s.buckets = make([]uint64, len(h.upperBounds))
+		}
+		if i < len(s.buckets) {
+			s.buckets[i]++
+		}
+		s.count++
+		s.value += v
+	})
+}
+
+// Count returns the number of observations for the label values
+func (h *HistogramVec) Count(labelValues ...string) uint64 {
+	h.m.Lock()
+	defer h.m.Unlock()
+	if s, ok := h.series[seriesKey(labelValues)]; ok {
+		return s.count
+	}
+	return 0
+}
+
+// Write implements the Collector interface
+func (h *HistogramVec) Write(w io.Writer) error {
+	return h.write(w, typeHistogram, h.upperBounds)
+}
+
+// NewGaugeFunc returns a gauge reporting the value returned by fn at collection
+// time
+func NewGaugeFunc(name, help string, fn func() float64) *GaugeFunc {
+	return &GaugeFunc{desc: desc{name: name, help: help}, fn: fn}
+}
+
+// Name implements the Collector interface
+func (g *GaugeFunc) Name() string {
+	return g.name
+}
+
+// Write implements the Collector interface
+func (g *GaugeFunc) Write(w io.Writer) error {
+	if err := g.writeHeader(w, typeGauge); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%s %s\n", g.name, formatFloat(g.fn()))
+	return err
+}
+
+func newVec(name, help string, labels []string) vec {
+	return vec{
+		desc:   desc{name: name, help: help, labels: labels},
+		series: make(map[string]*series),
+	}
+}
+
+// Name implements the Collector interface
+func (v *vec) Name() string {
+	return v.name
+}
+
+// update applies fn to the series for the label values, creating it if
+// needed. Label values not matching the declared label names are dropped as
+// a metric must never interrupt the code path it measures
+func (v *vec) update(labelValues []string, fn func(*series)) {
+	if len(labelValues) != len(v.labels) {
+		log.Errorf(log.Global,
+			"Metrics: %s expects %d label values, received %d",
+			v.name, len(v.labels), len(labelValues))
+		return
+	}
+	key := seriesKey(labelValues)
+	v.m.Lock()
+	s, ok := v.series[key]
+	if !ok {
+		s = &series{labelValues: append([]string(nil), labelValues...)}
+		v.series[key] = s
+	}
+	fn(s)
+	v.m.Unlock()
+}
+
+func (v *vec) get(labelValues []string) float64 {
+	v.m.Lock()
+	defer v.m.Unlock()
+	if s, ok := v.series[seriesKey(labelValues)]; ok {
+		return s.value
+	}
+	return 0
+}
+
+// write writes all series sorted by label values. Upper bounds are supplied
+// for histograms only
+func (v *vec) write(w io.Writer, metricType string, upperBounds []float64) error {
+	if err := v.writeHeader(w, metricType); err != nil {
+		return err
+	}
+
+	v.m.Lock()
+	defer v.m.Unlock()
+	keys := make([]string, 0, len(v.series))
+	for k := range v.series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for i := range keys {
+		s := v.series[keys[i]]
+		if metricType != typeHistogram {
+			if _, err := fmt.Fprintf(w, "%s%s %s\n",
+				v.name,
+				formatLabels(v.labels, s.labelValues, "", ""),
+				formatFloat(s.value)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var cumulative uint64
+		for j := range upperBounds {
+			cumulative += s.buckets[j]
+			if _, err := fmt.Fprintf(w, "%s_bucket%s %d\n",
+				v.name,
+				formatLabels(v.labels, s.labelValues, "le", formatFloat(upperBounds[j])),
+				cumulative); err != nil {
+				return err
+			}
+		}
+		labels := formatLabels(v.labels, s.labelValues, "", "")
+		if _, err := fmt.Fprintf(w, "%s_bucket%s %d\n%s_sum%s %s\n%s_count%s %d\n",
+			v.name, formatLabels(v.labels, s.labelValues, "le", "+Inf"), s.count,
+			v.name, labels, formatFloat(s.value),
+			v.name, labels, s.count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *desc) writeHeader(w io.Writer, metricType string) error {
+	_, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n",
+		d.name, escapeHelp(d.help), d.name, metricType)
+	return err
+}
+
+// seriesKey joins label values with a separator that cannot appear in valid
+// UTF-8 text
+func seriesKey(labelValues []string) string {
+	return strings.Join(labelValues, "\xff")
+}
+
+// formatLabels returns the label set in exposition format with an optional
+// extra label appended, such as the histogram le label
+func formatLabels(names, values []string, extraName, extraValue string) string {
+	if len(names) == 0 && extraName == "" {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for i := range names {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(names[i])
+		b.WriteString(`="`)
+		b.WriteString(labelEscaper.Replace(values[i]))
+		b.WriteByte('"')
+	}
+	if extraName != "" {
+		if len(names) > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(extraName)
+		b.WriteString(`="`)
+		b.WriteString(extraValue)
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+var (
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+)
+
+func escapeHelp(s string) string {
+	return helpEscaper.Replace(s)
+}
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "+Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	case math.IsNaN(f):
+		return "NaN"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// countingWriter tracks the number of bytes written for WriteTo
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,132 @@
+package metrics
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRegister(t *testing.T) {
+	r := NewRegistry()
+	c := NewCounterVec("test_total", "test counter")
+	if err := r.Register(c); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Register(NewGaugeVec("test_total", "duplicate")); err == nil {
+		t.Error("expected error registering a duplicate metric name")
+	}
+	r.Unregister(c)
+	if err := r.Register(c); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCounterVec(t *testing.T) {
+	c := NewCounterVec("test_requests_total", "Requests sent.", "exchange", "code")
+	c.Inc("Binance", "200")
+	c.Add(2, "Binance", "200")
+	c.Add(-1, "Binance", "200")
+	c.Inc("Bitmex", "error")
+	c.Inc("Bitmex")
+	if v := c.Value("Binance", "200"); v != 3 {
+		t.Errorf("expected 3, received %v", v)
+	}
+
+	var b bytes.Buffer
+	if err := c.Write(&b); err != nil {
+		t.Fatal(err)
+	}
+	expected := `# HELP test_requests_total Requests sent.
+# TYPE test_requests_total counter
+test_requests_total{exchange="Binance",code="200"} 3
+test_requests_total{exchange="Bitmex",code="error"} 1
+`
+	if b.String() != expected {
+		t.Errorf("expected:\n%s\nreceived:\n%s", expected, b.String())
+	}
+}
+
+func TestGaugeVec(t *testing.T) {
+	g := NewGaugeVec("test_connected", "Connected.", "exchange")
+	g.Set(1, `a"b\c`)
+	g.Add(-3, "x")
+	g.Set(0.5, "y")
+	g.Delete("y")
+	if v := g.Value("x"); v != -3 {
+		t.Errorf("expected -3, received %v", v)
+	}
+
+	var b bytes.Buffer
+	if err := g.Write(&b); err != nil {
+		t.Fatal(err)
+	}
+	expected := `# HELP test_connected Connected.
+# TYPE test_connected gauge
+test_connected{exchange="a\"b\\c"} 1
+test_connected{exchange="x"} -3
+`
+	if b.String() != expected {
+		t.Errorf("expected:\n%s\nreceived:\n%s", expected, b.String())
+	}
+}
+
+func TestHistogramVec(t *testing.T) {
+	h := NewHistogramVec("test_duration_seconds", "Duration.", []float64{0.1, 1}, "exchange")
+	h.Observe(0.05, "Binance")
+	h.Observe(0.1, "Binance")
+	h.Observe(0.5, "Binance")
+	h.Observe(3, "Binance")
+	if c := h.Count("Binance"); c != 4 {
+		t.Errorf("expected 4, received %v", c)
+	}
+
+	var b bytes.Buffer
+	if err := h.Write(&b); err != nil {
+		t.Fatal(err)
+	}
+	expected := `# HELP test_duration_seconds Duration.
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{exchange="Binance",le="0.1"} 2
+test_duration_seconds_bucket{exchange="Binance",le="1"} 3
+test_duration_seconds_bucket{exchange="Binance",le="+Inf"} 4
+test_duration_seconds_sum{exchange="Binance"} 3.65
+test_duration_seconds_count{exchange="Binance"} 4
+`
+	if b.String() != expected {
+		t.Errorf("expected:\n%s\nreceived:\n%s", expected, b.String())
+	}
+}
+
+func TestHandler(t *testing.T) {
+	r := NewRegistry()
+	var depth float64 = 7
+	r.MustRegister(
+		NewGaugeFunc("test_queue_depth", "Queue depth.", func() float64 { return depth }),
+		NewCounterVec("test_events_total", "Events."),
+	)
+
+	s := httptest.NewServer(r.Handler())
+	defer s.Close()
+
+	resp, err := http.Get(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.Header.Get("Content-Type") != ContentType {
+		t.Errorf("unexpected content type %s", resp.Header.Get("Content-Type"))
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := strings.Index(string(body), "# TYPE test_events_total counter")
+	queue := strings.Index(string(body), "test_queue_depth 7\n")
+	if events == -1 || queue == -1 || events > queue {
+		t.Errorf("unexpected scrape output:\n%s", body)
+	}
+}
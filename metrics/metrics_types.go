@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"io"
+	"sync"
+)
+
+// Metric types as reported in the exposition format
+const (
+	typeCounter   = "counter"
+	typeGauge     = "gauge"
+	typeHistogram = "histogram"
+
+	// ContentType is the content type of the Prometheus text exposition
+	// format written by a Registry
+	ContentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// DefaultLatencyBuckets are histogram buckets in seconds suited to exchange
+// request and rate limit wait durations
+var DefaultLatencyBuckets = []float64{
+	.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30,
+}
+
+// defaultRegistry holds the metrics exported by the bot
+var defaultRegistry = NewRegistry()
+
+// Collector is a metric that can be registered and written out in the
+// Prometheus text exposition format
+type Collector interface {
+	// Name returns the fully qualified metric name
+	Name() string
+	// Write writes the HELP and TYPE lines followed by all samples
+	Write(w io.Writer) error
+}
+
+// Registry holds a set of uniquely named collectors
+type Registry struct {
+	m          sync.RWMutex
+	collectors map[string]Collector
+}
+
+// desc holds the fields shared by all metric types
+type desc struct {
+	name   string
+	help   string
+	labels []string
+}
+
+// series is a single labelled sample set of a vector
+type series struct {
+	labelValues []string
+	value       float64
+	// buckets and count are only used by histograms, with buckets holding
+	// non-cumulative counts per upper bound
+	buckets []uint64
+	count   uint64
+}
+
+// vec holds the labelled series of a metric
+type vec struct {
+	desc
+	m      sync.Mutex
+	series map[string]*series
+}
+
+// CounterVec is a set of counters partitioned by label values. Counters only
+// ever increase
+type CounterVec struct {
+	vec
+}
+
+// GaugeVec is a set of gauges partitioned by label values
+type GaugeVec struct {
+	vec
+}
+
+// HistogramVec is a set of histograms partitioned by label values
+type HistogramVec struct {
+	vec
+	upperBounds []float64
+}
+
+// GaugeFunc is an unlabelled gauge whose value is read from a function each
+// time it is collected
+type GaugeFunc struct {
+	desc
+	fn func() float64
+}
@@ -2,12 +2,15 @@ package ntpclient
 
 import (
 	"encoding/binary"
+	"errors"
 	"net"
 	"time"
 
 	"github.com/yurulab/gocryptotrader/log"
 )
 
+var errNoValidServers = errors.New("no valid NTP servers found")
+
 type ntppacket struct {
 	Settings       uint8  // leap yr indicator, ver number, and mode
 	Stratum        uint8  // stratum of local clock
@@ -29,6 +32,16 @@ type ntppacket struct {
 // NTPClient create's a new NTPClient and returns local based on ntp servers provided timestamp
 // if no server can be reached will return local time in UTC()
 func NTPClient(pool []string) time.Time {
+	t, err := FetchTime(pool)
+	if err != nil {
+		log.Warnln(log.TimeMgr, "No valid NTP servers found, using current system time")
+		return time.Now().UTC()
+	}
+	return t
+}
+
+// FetchTime returns the time from the first reachable server in the pool
+func FetchTime(pool []string) (time.Time, error) {
 	for i := range pool {
 		con, err := net.DialTimeout("udp", pool[i], 5*time.Second)
 		if err != nil {
@@ -58,8 +71,7 @@ func NTPClient(pool []string) time.Time {
 		nanos := (int64(rsp.TxTimeFrac) * 1e9) >> 32
 
 		con.Close()
-		return time.Unix(int64(secs), nanos)
+		return time.Unix(int64(secs), nanos), nil
 	}
-	log.Warnln(log.TimeMgr, "No valid NTP servers found, using current system time")
-	return time.Now().UTC()
+	return time.Time{}, errNoValidServers
 }
@@ -24,3 +24,9 @@ func TestNTPClient(t *testing.T) {
 		t.Errorf("NTPClient returned incorrect time received: %v", v.UTC().Format(timeFormat))
 	}
 }
+
+func TestFetchTime(t *testing.T) {
+	if _, err := FetchTime(nil); err != errNoValidServers {
+		t.Errorf("expected %v, received %v", errNoValidServers, err)
+	}
+}